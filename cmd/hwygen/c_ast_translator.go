@@ -7,6 +7,8 @@ import (
 	"go/token"
 	"sort"
 	"strings"
+
+	"golang.org/x/tools/go/ast/astutil"
 )
 
 // CASTTranslator walks a ParsedFunc's Go AST and emits GOAT-compatible C code
@@ -19,6 +21,10 @@ type CASTTranslator struct {
 	lanes    int    // number of elements per vector at primary tier
 	elemType string // "float32", "float64"
 
+	// err is the first construct found that has no lowering for the
+	// profile; TranslateToC returns it.
+	err error
+
 	// Variable tracking
 	vars   map[string]cVarInfo   // declared local variables
 	params map[string]cParamInfo // function parameters
@@ -32,6 +38,12 @@ type CASTTranslator struct {
 	// Maps scalar variable name → accumulator info.
 	deferredAccums map[string]*deferredAccum
 
+	// Deferred ReduceSum accumulation: scalars updated only by
+	// acc += hwy.ReduceSum(v) in the loops being translated are kept in
+	// vector accumulators and reduced once after their loop.
+	// Maps scalar variable name → accumulator info.
+	reduceAccums map[string]*deferredAccum
+
 	// Struct type tracking: maps C struct type name → struct info.
 	// e.g. "ImageF32" → {goType: "*Image[T]", elemCType: "float"}
 	// Used to emit struct typedefs for any generic struct pointer parameters.
@@ -46,13 +58,24 @@ type CASTTranslator struct {
 	packageGlobals    map[string]*PackageGlobal // name → global
 	referencedGlobals map[string]bool           // globals actually used in function body
 
+	// Functions of the package, keyed by name. Calls to small pure helpers
+	// among them are inlined (see pureHelperBody). Set via SetHelpers.
+	helpers     map[string]*ParsedFunc
+	inlineDepth int // nesting of helper expansions being translated
+
+	// //hwy:unroll factors by loop statement, from ParsedFunc.LoopUnroll.
+	loopUnroll map[ast.Stmt]int
+
+	// Main loop whose remainder is synthesized (see findTailLoop), or nil.
+	tail *tailLoop
+
 	buf      *bytes.Buffer
 	indent   int
 	tmpCount int // counter for unique temporary variable names
 }
 
 // deferredAccum tracks a scalar variable being replaced by a vector accumulator
-// for deferred horizontal reduction of popcount results.
+// for deferred horizontal reduction of popcount results or ReduceSum operands.
 type deferredAccum struct {
 	scalarVar string // Go scalar variable name, e.g. "sum1_0"
 	accVar    string // C vector accumulator name, e.g. "_pacc_0"
@@ -123,6 +146,26 @@ func (t *CASTTranslator) SetPackageGlobals(globals []PackageGlobal) {
 	}
 }
 
+// SetHelpers provides the translator with the functions of the package so
+// calls to small pure helpers (e.g. getSignBit) can be inlined, since C code
+// can't call back into Go.
+func (t *CASTTranslator) SetHelpers(helpers map[string]*ParsedFunc) {
+	t.helpers = helpers
+}
+
+// inlineHelper returns the expression a call to a pure package helper
+// reduces to, or nil if call is not such a call.
+func (t *CASTTranslator) inlineHelper(call *ast.CallExpr) ast.Expr {
+	if t.inlineDepth >= maxInlineHelperDepth {
+		return nil
+	}
+	return inlineHelperCall(call, t.helpers, nil, func(name string) bool {
+		_, isVar := t.vars[name]
+		_, isParam := t.params[name]
+		return isVar || isParam
+	})
+}
+
 // primaryTier returns the first non-scalar tier name and its lane count.
 func primaryTier(p *CIntrinsicProfile) (string, int) {
 	for _, t := range p.Tiers {
@@ -137,10 +180,13 @@ func primaryTier(p *CIntrinsicProfile) (string, int) {
 // TranslateToC translates a ParsedFunc to GOAT-compatible C source code.
 func (t *CASTTranslator) TranslateToC(pf *ParsedFunc) (string, error) {
 	t.buf.Reset()
+	t.err = nil
 	t.vars = make(map[string]cVarInfo)
 	t.params = make(map[string]cParamInfo)
 	t.requiredStructTypes = make(map[string]structTypeInfo)
 	t.typeParamNames = make(map[string]bool)
+	t.loopUnroll = pf.LoopUnroll
+	t.tail = findTailLoop(pf)
 	for _, tp := range pf.TypeParams {
 		t.typeParamNames[tp.Name] = true
 	}
@@ -181,9 +227,20 @@ func (t *CASTTranslator) TranslateToC(pf *ParsedFunc) (string, error) {
 	t.indent = 0
 	t.writef("}\n")
 
+	if t.err != nil {
+		return "", t.err
+	}
 	return t.buf.String(), nil
 }
 
+// fail records that the function cannot be translated for this profile.
+// Only the first failure is kept.
+func (t *CASTTranslator) fail(format string, args ...any) {
+	if t.err == nil {
+		t.err = fmt.Errorf(format, args...)
+	}
+}
+
 // discoverStructFields walks the function body to discover struct fields
 // from field accesses and method calls. This uses a convention-based approach:
 //   - Direct field accesses (e.g., img.height, img.width) → scalar fields (long)
@@ -937,6 +994,18 @@ func (t *CASTTranslator) translateAssignStmt(s *ast.AssignStmt) {
 		}
 	}
 
+	// Handle 2-way multi-assign from hwy.Compress
+	if len(s.Lhs) == 2 && len(s.Rhs) == 1 {
+		if call, ok := s.Rhs[0].(*ast.CallExpr); ok {
+			if sel := extractSelectorExpr(call.Fun); sel != nil {
+				if pkg, ok := sel.X.(*ast.Ident); ok && pkg.Name == "hwy" && sel.Sel.Name == "Compress" {
+					t.translateCompressAssign(s.Lhs, call.Args, s.Tok)
+					return
+				}
+			}
+		}
+	}
+
 	// Handle multi-value assignments from ictCoeffs[T]()
 	if len(s.Lhs) > 1 && len(s.Rhs) == 1 {
 		if call, ok := s.Rhs[0].(*ast.CallExpr); ok {
@@ -1034,6 +1103,13 @@ func (t *CASTTranslator) translateAssignStmt(s *ast.AssignStmt) {
 		t.writef("%s = %s;\n", lhsName, rhsStr)
 
 	case token.ADD_ASSIGN: // +=
+		// Check for deferred ReduceSum accumulation rewrite
+		if acc, ok := t.reduceAccums[lhsName]; ok {
+			if v := t.extractReduceSumArg(rhs); v != nil {
+				t.writef("%s = %s;\n", acc.accVar, t.emitReduceSumAccumAdd(acc.accVar, v))
+				return
+			}
+		}
 		// Check for deferred popcount accumulation rewrite
 		if t.deferredAccums != nil {
 			if acc, ok := t.deferredAccums[lhsName]; ok {
@@ -1225,15 +1301,198 @@ func (t *CASTTranslator) scanForPopCountAccumPattern(block *ast.BlockStmt) []def
 	return accums
 }
 
+// ---------------------------------------------------------------------------
+// Deferred ReduceSum Accumulation
+// ---------------------------------------------------------------------------
+
+// Loops like
+//
+//	for i := 0; i+lanes <= n; i += lanes {
+//		sum += hwy.ReduceSum(hwy.Mul(va, vb))
+//	}
+//
+// reduce a vector to a scalar every iteration. When sum is only updated this
+// way in the loop, the translator keeps a vector accumulator instead
+// (_racc_N = vaddq_f32(_racc_N, ...), or an FMA for a Mul operand) and adds
+// its horizontal sum to sum once after the loop. This generalizes the
+// deferred popcount accumulation above to dot products, norms and sums. The
+// lanes are summed in a different order, so float results may differ in the
+// last bits.
+
+// extractReduceSumArg returns v for an expression of the form
+// hwy.ReduceSum(v), optionally wrapped in a numeric conversion such as
+// float64(...) or T(...), or nil.
+func (t *CASTTranslator) extractReduceSumArg(expr ast.Expr) ast.Expr {
+	call, ok := expr.(*ast.CallExpr)
+	if !ok || len(call.Args) != 1 {
+		return nil
+	}
+	if ident, ok := call.Fun.(*ast.Ident); ok {
+		if !isNumericGoType(ident.Name) && !t.typeParamNames[ident.Name] {
+			return nil
+		}
+		if call, ok = call.Args[0].(*ast.CallExpr); !ok || len(call.Args) != 1 {
+			return nil
+		}
+	}
+	sel := extractSelectorExpr(call.Fun)
+	if sel == nil || sel.Sel.Name != "ReduceSum" {
+		return nil
+	}
+	if pkg, ok := sel.X.(*ast.Ident); !ok || pkg.Name != "hwy" {
+		return nil
+	}
+	return call.Args[0]
+}
+
+// isNumericGoType reports whether name is a predeclared Go numeric type.
+func isNumericGoType(name string) bool {
+	switch name {
+	case "int", "int8", "int16", "int32", "int64",
+		"uint", "uint8", "uint16", "uint32", "uint64", "byte",
+		"float32", "float64":
+		return true
+	}
+	return false
+}
+
+// scanReduceSumAccums returns the scalars of a loop body that can be
+// accumulated in vectors: those whose every use in the loop (body and
+// header parts in extra) is a top-level acc += hwy.ReduceSum(v) statement.
+// A loop that can leave the function or an enclosing loop would skip the
+// final reduction, so it gets none.
+func (t *CASTTranslator) scanReduceSumAccums(body *ast.BlockStmt, extra ...ast.Node) []deferredAccum {
+	if body == nil || t.profile.ReduceSumFn[t.tier] == "" || t.profile.AddFn[t.tier] == "" {
+		return nil
+	}
+
+	updates := make(map[string]int)
+	var order []string
+	for _, stmt := range body.List {
+		assign, ok := stmt.(*ast.AssignStmt)
+		if !ok || assign.Tok != token.ADD_ASSIGN || len(assign.Lhs) != 1 || len(assign.Rhs) != 1 {
+			continue
+		}
+		ident, ok := assign.Lhs[0].(*ast.Ident)
+		if !ok || t.extractReduceSumArg(assign.Rhs[0]) == nil {
+			continue
+		}
+		// The popcount rewrite handles its own pattern, and an enclosing
+		// loop may already be accumulating this scalar.
+		if _, ok := isPopCountAccumPattern(assign); ok && t.profile.PopCountPartialFn[t.tier] != "" {
+			continue
+		}
+		if t.deferredAccums[ident.Name] != nil || t.reduceAccums[ident.Name] != nil {
+			continue
+		}
+		if updates[ident.Name] == 0 {
+			order = append(order, ident.Name)
+		}
+		updates[ident.Name]++
+	}
+	if len(order) == 0 {
+		return nil
+	}
+
+	uses := make(map[string]int)
+	escapes := false
+	for _, n := range append(extra, body) {
+		if n == nil {
+			continue
+		}
+		ast.Inspect(n, func(n ast.Node) bool {
+			switch n := n.(type) {
+			case *ast.Ident:
+				uses[n.Name]++
+			case *ast.ReturnStmt:
+				escapes = true
+			case *ast.BranchStmt:
+				if n.Label != nil || n.Tok == token.GOTO {
+					escapes = true
+				}
+			}
+			return true
+		})
+	}
+	if escapes {
+		return nil
+	}
+
+	var accums []deferredAccum
+	for _, name := range order {
+		if uses[name] != updates[name] {
+			continue
+		}
+		accums = append(accums, deferredAccum{
+			scalarVar: name,
+			accVar:    fmt.Sprintf("_racc_%d", t.tmpCount),
+		})
+		t.tmpCount++
+	}
+	return accums
+}
+
+// beginReduceSumAccums declares zeroed vector accumulators for the
+// ReduceSum scalars of a loop about to be translated and activates the
+// rewrite for its body. It returns the accumulators for endReduceSumAccums.
+func (t *CASTTranslator) beginReduceSumAccums(body *ast.BlockStmt, extra ...ast.Node) []deferredAccum {
+	accums := t.scanReduceSumAccums(body, extra...)
+	if len(accums) == 0 {
+		return nil
+	}
+	if t.reduceAccums == nil {
+		t.reduceAccums = make(map[string]*deferredAccum)
+	}
+	for i := range accums {
+		t.writef("%s %s = %s;\n", t.profile.VecTypes[t.tier], accums[i].accVar, t.emitHwyZero())
+		t.reduceAccums[accums[i].scalarVar] = &accums[i]
+	}
+	return accums
+}
+
+// endReduceSumAccums emits the horizontal reduction of each accumulator
+// after its loop and deactivates the rewrite.
+func (t *CASTTranslator) endReduceSumAccums(accums []deferredAccum) {
+	fn := t.profile.ReduceSumFn[t.tier]
+	for _, acc := range accums {
+		if t.profile.NeedsPredicate {
+			t.writef("%s += %s(pg, %s);\n", acc.scalarVar, fn, acc.accVar)
+		} else {
+			t.writef("%s += %s(%s);\n", acc.scalarVar, fn, acc.accVar)
+		}
+		delete(t.reduceAccums, acc.scalarVar)
+	}
+}
+
+// emitReduceSumAccumAdd returns accVar + v as a vector expression, fusing
+// the multiply of hwy.ReduceSum(hwy.Mul(a, b)) into an FMA.
+func (t *CASTTranslator) emitReduceSumAccumAdd(accVar string, v ast.Expr) string {
+	acc := &ast.Ident{Name: accVar}
+	if call, ok := v.(*ast.CallExpr); ok && len(call.Args) == 2 && t.profile.FmaFn[t.tier] != "" {
+		if sel := extractSelectorExpr(call.Fun); sel != nil && sel.Sel.Name == "Mul" {
+			if pkg, ok := sel.X.(*ast.Ident); ok && pkg.Name == "hwy" {
+				return t.emitHwyMulAdd([]ast.Expr{call.Args[0], call.Args[1], acc})
+			}
+		}
+	}
+	return t.emitHwyBinaryOp(t.profile.AddFn, []ast.Expr{acc, v})
+}
+
 // translateForStmt handles C-style for loops.
 func (t *CASTTranslator) translateForStmt(s *ast.ForStmt) {
 	initStr := ""
 	condStr := ""
 	postStr := ""
 
-	// Init
+	// Init. The iterator of a loop with a synthesized tail must outlive the
+	// loop, so it is declared before it.
+	isTail := t.tail != nil && s == t.tail.loop
 	if s.Init != nil {
 		initStr = t.translateForInit(s.Init)
+		if isTail {
+			t.writef("%s;\n", initStr)
+			initStr = ""
+		}
 	}
 
 	// Condition
@@ -1246,6 +1505,8 @@ func (t *CASTTranslator) translateForStmt(s *ast.ForStmt) {
 		postStr = t.translateForPost(s.Post)
 	}
 
+	reduceAccums := t.beginReduceSumAccums(s.Body, s.Cond, s.Post)
+
 	// Deferred popcount accumulation. If deferredAccums is already set,
 	// a parent block is managing the lifecycle (cross-loop sharing).
 	// Otherwise, this loop manages its own accumulators.
@@ -1266,16 +1527,15 @@ func (t *CASTTranslator) translateForStmt(s *ast.ForStmt) {
 		}
 	}
 
-	// Prevent clang from auto-vectorizing scalar loops into NEON code
-	// with constant pool references (adrp+ldr from .rodata), which GOAT
-	// cannot relocate in Go assembly.
-	t.writef("#pragma clang loop vectorize(disable) interleave(disable)\n")
+	t.writeLoopPragma(s)
 	t.writef("for (%s; %s; %s) {\n", initStr, condStr, postStr)
 	t.indent++
 	t.translateBlockStmtContents(s.Body)
 	t.indent--
 	t.writef("}\n")
 
+	t.endReduceSumAccums(reduceAccums)
+
 	// Only reduce and clear if this loop owns the accumulators
 	if !externalAccums && t.deferredAccums != nil {
 		for _, acc := range t.deferredAccumsOrdered() {
@@ -1284,6 +1544,87 @@ func (t *CASTTranslator) translateForStmt(s *ast.ForStmt) {
 		}
 		t.deferredAccums = nil
 	}
+
+	if isTail {
+		t.emitSynthesizedTail()
+	}
+}
+
+// emitSynthesizedTail processes the elements left over by the main loop
+// t.tail by running its body once more. Predicated profiles (SVE) narrow pg
+// with a whilelt predicate; fixed-width profiles run the body on zero-padded
+// stack copies of the remaining elements and copy the stored lanes back.
+// Profiles with a runtime lane count and no whilelt leave the remainder to
+// the author.
+func (t *CASTTranslator) emitSynthesizedTail() {
+	tl := t.tail
+	iter := tl.iter
+	end := t.translateExpr(tl.end)
+
+	if t.profile.NeedsPredicate && t.profile.WhileLtFn != "" {
+		t.writef("if (%s < %s) {\n", iter, end)
+		t.indent++
+		t.writef("pg = %s(%s, %s);\n", t.profile.WhileLtFn, iter, end)
+		t.translateBlockStmtContents(tl.loop.Body)
+		t.indent--
+		t.writef("}\n")
+		return
+	}
+	if t.lanesExpr() != fmt.Sprintf("%d", t.lanes) {
+		return
+	}
+
+	// Replace param[iter:] with the stack buffer _tail_param.
+	body := cloneBlockStmt(tl.loop.Body)
+	astutil.Apply(body, func(c *astutil.Cursor) bool {
+		if expr, ok := c.Node().(ast.Expr); ok {
+			if param, ok := loopIndexSlice(expr, iter); ok {
+				c.Replace(ast.NewIdent("_tail_" + param))
+				return false
+			}
+		}
+		return true
+	}, nil)
+
+	t.writef("if (%s < %s) {\n", iter, end)
+	t.indent++
+	for _, param := range tl.slices {
+		buf := "_tail_" + param
+		elemC := strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(t.params[param].cType), "*"))
+		t.writef("%s %s[%d];\n", elemC, buf, t.lanes)
+		t.writeLoopPragma(nil)
+		t.writef("for (long _k = 0; _k < %d; _k++) { %s[_k] = (%s + _k < %s) ? %s[%s + _k] : 0; }\n",
+			t.lanes, buf, iter, end, param, iter)
+		t.vars[buf] = cVarInfo{cType: elemC + " *", isPtr: true}
+	}
+	t.translateBlockStmtContents(body)
+	for _, param := range tl.slices {
+		if !tl.stored[param] {
+			continue
+		}
+		t.writeLoopPragma(nil)
+		t.writef("for (long _k = 0; %s + _k < %s; _k++) { %s[%s + _k] = _tail_%s[_k]; }\n",
+			iter, end, param, iter, param)
+	}
+	t.indent--
+	t.writef("}\n")
+}
+
+// writeLoopPragma emits the clang loop pragma preceding a translated loop.
+func (t *CASTTranslator) writeLoopPragma(loop ast.Stmt) {
+	// Prevent clang from auto-vectorizing scalar loops into NEON code
+	// with constant pool references (adrp+ldr from .rodata), which GOAT
+	// cannot relocate in Go assembly.
+	pragma := "#pragma clang loop vectorize(disable) interleave(disable)"
+	// Pass //hwy:unroll N on to clang.
+	if factor, ok := t.loopUnroll[loop]; ok {
+		if factor > 1 {
+			pragma += fmt.Sprintf(" unroll_count(%d)", factor)
+		} else {
+			pragma += " unroll(disable)"
+		}
+	}
+	t.writef("%s\n", pragma)
 }
 
 // translateForInit translates a for-loop init statement.
@@ -1342,15 +1683,14 @@ func (t *CASTTranslator) translateRangeStmt(s *ast.RangeStmt) {
 	// Register the iterator variable
 	t.vars[iter] = cVarInfo{cType: "long"}
 
-	// Prevent clang from auto-vectorizing scalar loops into NEON code
-	// with constant pool references (adrp+ldr from .rodata), which GOAT
-	// cannot relocate in Go assembly.
-	t.writef("#pragma clang loop vectorize(disable) interleave(disable)\n")
+	reduceAccums := t.beginReduceSumAccums(s.Body)
+	t.writeLoopPragma(s)
 	t.writef("for (long %s = 0; %s < %s; %s++) {\n", iter, iter, rangeOver, iter)
 	t.indent++
 	t.translateBlockStmtContents(s.Body)
 	t.indent--
 	t.writef("}\n")
+	t.endReduceSumAccums(reduceAccums)
 }
 
 // translateExprStmt handles standalone expression statements (function calls).
@@ -1380,6 +1720,33 @@ func (t *CASTTranslator) translateExprStmt(s *ast.ExprStmt) {
 				t.emitHwyStore(call.Args)
 				return
 			}
+			if sel.Sel.Name == "StoreStream" {
+				t.writef("%s;\n", t.emitHwyStoreStream(call.Args))
+				return
+			}
+			if sel.Sel.Name == "FlushStream" {
+				t.emitHwyFlushStream()
+				return
+			}
+			switch sel.Sel.Name {
+			case "ScatterIndex", "ScatterIndexMasked":
+				t.emitHwyScatterIndex(call.Args, sel.Sel.Name == "ScatterIndexMasked")
+				return
+			case "MaskStore":
+				if len(call.Args) >= 3 {
+					t.emitHwyMaskStore(call.Args[0], call.Args[1], call.Args[2])
+				}
+				return
+			case "BlendedStore":
+				if len(call.Args) >= 3 {
+					t.emitHwyMaskStore(call.Args[1], call.Args[0], call.Args[2])
+				}
+				return
+			case "CompressStore":
+				// The count is discarded.
+				t.emitHwyCompressStore(call.Args)
+				return
+			}
 		}
 	}
 
@@ -1757,12 +2124,11 @@ func (t *CASTTranslator) translateCallExpr(e *ast.CallExpr) string {
 		return t.translateMakeExpr(e)
 	}
 
-	// Check for getSignBit(x) → (float_to_bits(x) >> 31)
-	if ident, ok := e.Fun.(*ast.Ident); ok && ident.Name == "getSignBit" {
-		if len(e.Args) == 1 {
-			arg := t.translateExpr(e.Args[0])
-			return fmt.Sprintf("(float_to_bits(%s) >> 31)", arg)
-		}
+	// Inline small pure helpers, e.g. getSignBit(x) → (float_to_bits(x) >> 31)
+	if inlined := t.inlineHelper(e); inlined != nil {
+		t.inlineDepth++
+		defer func() { t.inlineDepth-- }()
+		return t.translateExpr(inlined)
 	}
 
 	// Check for type conversions: uint64(x), float64(x), uint32(x), int(x), etc.
@@ -1913,10 +2279,14 @@ func (t *CASTTranslator) translateHwyCall(funcName string, args []ast.Expr) stri
 		return t.emitHwyUnaryOp(t.profile.SqrtFn, args)
 	case "ReduceSum":
 		return t.emitHwyReduceSum(args)
-	case "InterleaveLower":
-		return t.emitHwyBinaryOp(t.profile.InterleaveLowerFn, args)
-	case "InterleaveUpper":
-		return t.emitHwyBinaryOp(t.profile.InterleaveUpperFn, args)
+	case "InterleaveLower", "ZipLower":
+		return t.emitHwyPermuteOp(t.profile.InterleaveLowerFn, args)
+	case "InterleaveUpper", "ZipUpper":
+		return t.emitHwyPermuteOp(t.profile.InterleaveUpperFn, args)
+	case "ConcatEven":
+		return t.emitHwyPermuteOp(t.profile.ConcatEvenFn, args)
+	case "ConcatOdd":
+		return t.emitHwyPermuteOp(t.profile.ConcatOddFn, args)
 	case "And":
 		return t.emitHwyBinaryOp(t.profile.AndFn, args)
 	case "Or":
@@ -1948,14 +2318,33 @@ func (t *CASTTranslator) translateHwyCall(funcName string, args []ast.Expr) stri
 		return t.emitHwyLoad(args)
 	case "SlideUpLanes":
 		return t.emitHwySlideUpLanes(args)
+	case "RotateLanes":
+		return t.emitHwyRotateLanes(args)
 	case "LoadSlice":
 		return t.emitHwyLoad(args) // same semantics as Load for C
 	case "StoreSlice":
 		return t.emitHwyStoreExpr(args) // same semantics as Store for C
+	case "StoreStream":
+		return t.emitHwyStoreStream(args)
+	case "Prefetch":
+		return t.emitHwyPrefetch(args)
 	case "MaxLanes", "NumLanes":
 		return t.lanesExpr()
 	case "GetLane":
 		return t.emitHwyGetLane(args)
+	case "GatherIndex":
+		return t.emitHwyGatherIndex(args, false)
+	case "GatherIndexMasked":
+		return t.emitHwyGatherIndex(args, true)
+	case "MaskLoad":
+		return t.emitHwyMaskLoad(args)
+	case "Compress":
+		// Compress is handled as a two-value assignment in
+		// translateAssignStmt; as an expression it yields the vector.
+		vec, _ := t.emitHwyCompress(args)
+		return vec
+	case "CompressStore":
+		return t.emitHwyCompressStore(args)
 	default:
 		// Unknown hwy call — emit as-is
 		var argStrs []string
@@ -2022,6 +2411,62 @@ func (t *CASTTranslator) emitHwyStoreExpr(args []ast.Expr) string {
 	return fmt.Sprintf("%s(%s, %s)", storeFn, ptr, vec)
 }
 
+// emitHwyStoreStream: hwy.StoreStream(vec, slice[off:]) → _mm256_stream_ps
+// on x86 when the destination is vector-aligned, as the stream intrinsics
+// require, and an ordinary store otherwise. Targets without non-temporal
+// vector stores (NEON, SVE, RVV, promoted half-precision) use Store.
+func (t *CASTTranslator) emitHwyStoreStream(args []ast.Expr) string {
+	streamFn := t.streamStoreFn()
+	if streamFn == "" || len(args) < 2 {
+		return t.emitHwyStoreExpr(args)
+	}
+	storeFn := t.profile.StoreFn[t.tier]
+	vec := t.translateExpr(args[0])
+	ptr := t.translateExpr(args[1])
+	dst := t.newTemp("_dst")
+	t.writef("%s *%s = %s;\n", t.profile.CType, dst, ptr)
+	v := t.newTemp("_sv")
+	t.writef("__typeof__(%s) %s = %s;\n", vec, v, vec)
+	align := t.lanes * elemTypeSize(t.elemType)
+	return fmt.Sprintf("((((unsigned long)%s & %d) == 0) ? %s(%s, %s) : %s(%s, %s))",
+		dst, align-1, streamFn, dst, v, storeFn, dst, v)
+}
+
+// streamStoreFn returns the non-temporal counterpart of the profile's store
+// intrinsic, or "" if it has none.
+func (t *CASTTranslator) streamStoreFn() string {
+	switch t.profile.StoreFn[t.tier] {
+	case "_mm256_storeu_ps":
+		return "_mm256_stream_ps"
+	case "_mm256_storeu_pd":
+		return "_mm256_stream_pd"
+	case "_mm512_storeu_ps":
+		return "_mm512_stream_ps"
+	case "_mm512_storeu_pd":
+		return "_mm512_stream_pd"
+	}
+	return ""
+}
+
+// emitHwyPrefetch: hwy.Prefetch(slice[off:], dist) → __builtin_prefetch(ptr + off + dist)
+func (t *CASTTranslator) emitHwyPrefetch(args []ast.Expr) string {
+	if len(args) < 2 {
+		return "/* Prefetch: missing args */"
+	}
+	ptr := t.translateExpr(args[0])
+	dist := t.translateExpr(args[1])
+	return fmt.Sprintf("__builtin_prefetch(%s + %s)", ptr, dist)
+}
+
+// emitHwyFlushStream: hwy.FlushStream() → _mm_sfence() on x86. StoreStream
+// lowers to ordinary stores on ARM, so those targets need no fence.
+func (t *CASTTranslator) emitHwyFlushStream() {
+	switch t.profile.TargetName {
+	case "AVX2", "AVX512":
+		t.writef("_mm_sfence();\n")
+	}
+}
+
 // emitHwySet: hwy.Set(val) → vdupq_n_f32(val)
 func (t *CASTTranslator) emitHwySet(args []ast.Expr) string {
 	if len(args) < 1 {
@@ -2106,7 +2551,7 @@ func (t *CASTTranslator) emitHwyShiftRight(args []ast.Expr) string {
 		default:
 			fn = "vshrq_n_s32" // fallback
 		}
-	case "SVE_DARWIN", "SVE_LINUX":
+	case "SVE_DARWIN", "SVE_LINUX", "SVE2_LINUX":
 		needsPg = true
 		switch t.elemType {
 		case "int32":
@@ -2202,6 +2647,21 @@ func (t *CASTTranslator) emitHwyBinaryOp(fnMap map[string]string, args []ast.Exp
 	return fmt.Sprintf("%s(%s, %s)", fn, a, b)
 }
 
+// emitHwyPermuteOp: hwy.ConcatEven(a, b) → vuzp1q_f32(a, b)
+// Unlike arithmetic, SVE permutes (svzip1, svuzp1) take no governing predicate.
+func (t *CASTTranslator) emitHwyPermuteOp(fnMap map[string]string, args []ast.Expr) string {
+	if len(args) < 2 {
+		return "/* permute op: missing args */"
+	}
+	fn := fnMap[t.tier]
+	if fn == "" {
+		return "/* permute op: no intrinsic for this profile */"
+	}
+	a := t.translateExpr(args[0])
+	b := t.translateExpr(args[1])
+	return fmt.Sprintf("%s(%s, %s)", fn, a, b)
+}
+
 // emitHwyUnaryOp: hwy.Neg(x) → vnegq_f32(x)
 // SVE: svneg_f32_x(pg, x) — predicate first
 func (t *CASTTranslator) emitHwyUnaryOp(fnMap map[string]string, args []ast.Expr) string {
@@ -2305,6 +2765,415 @@ func (t *CASTTranslator) emitHwySlideUpLanes(args []ast.Expr) string {
 	return fmt.Sprintf("/* SlideUpLanes: fallback for offset=%s */", offset)
 }
 
+// emitHwyRotateLanes: hwy.RotateLanes(v, n) → vextq_f32(v, v, n)
+// Extracting from the same vector twice wraps the low lanes around to the top.
+// That needs a constant lane count; otherwise the vector is rotated through a
+// stack buffer, and profiles without lane buffers fail to translate.
+func (t *CASTTranslator) emitHwyRotateLanes(args []ast.Expr) string {
+	if len(args) < 2 {
+		return "/* RotateLanes: missing args */"
+	}
+	vec := t.translateExpr(args[0])
+	nExpr := args[1]
+
+	extFn := ""
+	if t.profile.SlideUpExtFn != nil {
+		extFn = t.profile.SlideUpExtFn[t.tier]
+	}
+
+	if extFn != "" {
+		if lit, ok := nExpr.(*ast.BasicLit); ok && lit.Kind == token.INT {
+			n := 0
+			fmt.Sscanf(lit.Value, "%d", &n)
+			n %= t.lanes
+			if n == 0 {
+				return vec
+			}
+			return fmt.Sprintf("%s(%s, %s, %d)", extFn, vec, vec, n)
+		}
+	}
+
+	// Rotate through stack buffers: result[l] = v[(l + n) mod lanes].
+	if !t.hasLaneBuffers() {
+		t.fail("RotateLanes: %s %s has no lowering for a non-constant lane count", t.profile.TargetName, t.elemType)
+		return vec
+	}
+	n := t.translateExpr(nExpr)
+	src := t.spillVec(vec)
+	shift := t.newTemp("_rot")
+	t.writef("int %s = (int)(%s) %% %d;\n", shift, n, t.lanes)
+	buf := t.newTemp("_rotated")
+	t.writef("%s %s[%d];\n", t.profile.CType, buf, t.lanes)
+	t.writef("for (int _l = 0; _l < %d; _l++) {\n", t.lanes)
+	t.writef("    %s[_l] = %s[(_l + %s + %d) %% %d];\n", buf, src, shift, t.lanes, t.lanes)
+	t.writef("}\n")
+	return fmt.Sprintf("%s(%s)", t.profile.LoadFn[t.tier], t.laneBufPtr(buf))
+}
+
+// ---------------------------------------------------------------------------
+// Gather/scatter, masked loads/stores and compress
+// ---------------------------------------------------------------------------
+//
+// SVE has native gathers, scatters and svcompact, and its masks are load/store
+// predicates; AVX-512 has masked loads/stores and vcompress. Elsewhere these
+// ops are lowered lane by lane: the operands are spilled to stack buffers, a
+// scalar loop does the indexed or masked accesses, and the result is loaded
+// back. As with hardware gathers, indices are not bounds-checked, and they
+// must have the element width (int32 indices for float32, int64 for float64).
+
+// hasLaneBuffers reports whether vectors of the profile can be spilled to
+// fixed-size stack buffers: not for sizeless SVE/RVV vectors, nor for
+// profiles that compute on a promoted type (their StoreFn takes the storage
+// type).
+func (t *CASTTranslator) hasLaneBuffers() bool {
+	for _, tier := range t.profile.Tiers {
+		if tier.Name == t.tier && tier.DynamicLanes != "" {
+			return false
+		}
+	}
+	if t.profile.NeedsPredicate {
+		return false
+	}
+	return t.profile.MathStrategy != "promoted" || t.profile.NativeArithmetic
+}
+
+// newTemp returns a fresh C variable name with the given prefix.
+func (t *CASTTranslator) newTemp(prefix string) string {
+	name := fmt.Sprintf("%s_%d", prefix, t.tmpCount)
+	t.tmpCount++
+	return name
+}
+
+// laneBufPtr returns buf as a load/store pointer argument.
+func (t *CASTTranslator) laneBufPtr(buf string) string {
+	if t.profile.CastExpr != "" {
+		return t.profile.CastExpr + buf
+	}
+	return buf
+}
+
+// spillVec stores vector v to a new stack buffer of the element type and
+// returns the buffer's name.
+func (t *CASTTranslator) spillVec(v string) string {
+	buf := t.newTemp("_lanes")
+	t.writef("%s %s[%d];\n", t.profile.CType, buf, t.lanes)
+	t.writef("%s(%s, %s);\n", t.profile.StoreFn[t.tier], t.laneBufPtr(buf), v)
+	return buf
+}
+
+// spillBits copies the bits of vector v to a new stack buffer of laneType and
+// returns the buffer's name. It reinterprets index vectors, which have the
+// data vector's C type.
+func (t *CASTTranslator) spillBits(v, laneType, prefix string) string {
+	buf := t.newTemp(prefix)
+	t.writef("__typeof__(%s) %s_v = %s;\n", v, buf, v)
+	t.writef("%s %s[%d];\n", laneType, buf, t.lanes)
+	t.writef("__builtin_memcpy(%s, &%s_v, sizeof(%s));\n", buf, buf, buf)
+	return buf
+}
+
+// spillMask evaluates mask m once and returns a function producing the C
+// condition for a lane: a bit test for AVX-512 kmasks, otherwise a load from
+// a buffer of the mask's all-ones/all-zeros lanes.
+func (t *CASTTranslator) spillMask(m string) func(lane string) string {
+	if maskType := t.profile.MaskType[t.tier]; strings.HasPrefix(maskType, "__mmask") {
+		name := t.newTemp("_mask")
+		t.writef("%s %s = %s;\n", maskType, name, m)
+		return func(lane string) string { return fmt.Sprintf("((%s >> %s) & 1)", name, lane) }
+	}
+	buf := t.spillBits(m, "unsigned "+laneIntCType(t.elemType), "_mask")
+	return func(lane string) string { return fmt.Sprintf("%s[%s]", buf, lane) }
+}
+
+// laneIntCType returns the C integer type with the width of elemType.
+func laneIntCType(elemType string) string {
+	switch elemTypeSize(elemType) {
+	case 1:
+		return "char"
+	case 4:
+		return "int"
+	case 8:
+		return "long"
+	default:
+		return "short" // int16/uint16 and half-precision floats
+	}
+}
+
+// indexLoadPtr returns the pointer expression of an index vector that is
+// loaded straight from a slice, e.g. "idx + i" for hwy.Load(idx[i:]).
+func (t *CASTTranslator) indexLoadPtr(e ast.Expr) (string, bool) {
+	call, ok := e.(*ast.CallExpr)
+	if !ok || len(call.Args) != 1 {
+		return "", false
+	}
+	sel := extractSelectorExpr(call.Fun)
+	if sel == nil || (sel.Sel.Name != "Load" && sel.Sel.Name != "LoadSlice") {
+		return "", false
+	}
+	if pkg, ok := sel.X.(*ast.Ident); !ok || pkg.Name != "hwy" {
+		return "", false
+	}
+	return t.translateExpr(call.Args[0]), true
+}
+
+// indexVec translates an index vector argument to the type the gather and
+// scatter intrinsics expect. The index slice has a different element type
+// than the profile, so a direct load goes through a pointer cast.
+func (t *CASTTranslator) indexVec(e ast.Expr) string {
+	idx := t.translateExpr(e)
+	if ptr, ok := t.indexLoadPtr(e); ok {
+		ptr = fmt.Sprintf("(%s *)(%s)", t.profile.CType, ptr)
+		idx = fmt.Sprintf("%s(%s)", t.profile.LoadFn[t.tier], ptr)
+		if t.profile.NeedsPredicate {
+			idx = fmt.Sprintf("%s(pg, %s)", t.profile.LoadFn[t.tier], ptr)
+		}
+	}
+	if fn := t.profile.IndexCastFn[t.tier]; fn != "" {
+		return fmt.Sprintf("%s(%s)", fn, idx)
+	}
+	return idx
+}
+
+// indexLanes returns a buffer holding the lanes of an index vector argument:
+// the index slice itself when the vector is loaded straight from one.
+func (t *CASTTranslator) indexLanes(e ast.Expr) string {
+	if ptr, ok := t.indexLoadPtr(e); ok {
+		return "(" + ptr + ")"
+	}
+	return t.spillBits(t.translateExpr(e), laneIntCType(t.elemType), "_idx")
+}
+
+// emitHwyGatherIndex: hwy.GatherIndex(src, idx) → svld1_gather_s32index_f32(pg, src, idx)
+// GatherIndexMasked passes its mask as the predicate; lanes outside it are zero.
+func (t *CASTTranslator) emitHwyGatherIndex(args []ast.Expr, masked bool) string {
+	if len(args) < 2 || (masked && len(args) < 3) {
+		return "/* GatherIndex: missing args */"
+	}
+	src := t.translateExpr(args[0])
+	if fn := t.profile.GatherIndexFn[t.tier]; fn != "" {
+		pred := "pg"
+		if masked {
+			pred = t.translateExpr(args[2])
+		}
+		return fmt.Sprintf("%s(%s, %s, %s)", fn, pred, src, t.indexVec(args[1]))
+	}
+	if !t.hasLaneBuffers() || elemTypeSize(t.elemType) < 4 {
+		return "/* GatherIndex: no lowering for this profile */"
+	}
+
+	idxBuf := t.indexLanes(args[1])
+	var inMask func(string) string
+	if masked {
+		inMask = t.spillMask(t.translateExpr(args[2]))
+	}
+	buf := t.newTemp("_gather")
+	t.writef("%s %s[%d];\n", t.profile.CType, buf, t.lanes)
+	t.writef("for (int _l = 0; _l < %d; _l++) {\n", t.lanes)
+	if masked {
+		t.writef("    %s[_l] = %s ? (%s)[%s[_l]] : 0;\n", buf, inMask("_l"), src, idxBuf)
+	} else {
+		t.writef("    %s[_l] = (%s)[%s[_l]];\n", buf, src, idxBuf)
+	}
+	t.writef("}\n")
+	return fmt.Sprintf("%s(%s)", t.profile.LoadFn[t.tier], t.laneBufPtr(buf))
+}
+
+// emitHwyScatterIndex: hwy.ScatterIndex(v, dst, idx) → svst1_scatter_s32index_f32(pg, dst, idx, v)
+// ScatterIndexMasked stores only the lanes in its mask.
+func (t *CASTTranslator) emitHwyScatterIndex(args []ast.Expr, masked bool) {
+	if len(args) < 3 || (masked && len(args) < 4) {
+		t.writef("/* ScatterIndex: missing args */\n")
+		return
+	}
+	v := t.translateExpr(args[0])
+	dst := t.translateExpr(args[1])
+	if fn := t.profile.ScatterIndexFn[t.tier]; fn != "" {
+		pred := "pg"
+		if masked {
+			pred = t.translateExpr(args[3])
+		}
+		t.writef("%s(%s, %s, %s, %s);\n", fn, pred, dst, t.indexVec(args[2]), v)
+		return
+	}
+	if !t.hasLaneBuffers() || elemTypeSize(t.elemType) < 4 {
+		t.writef("/* ScatterIndex: no lowering for this profile */\n")
+		return
+	}
+
+	vBuf := t.spillVec(v)
+	idxBuf := t.indexLanes(args[2])
+	var inMask func(string) string
+	if masked {
+		inMask = t.spillMask(t.translateExpr(args[3]))
+	}
+	t.writef("for (int _l = 0; _l < %d; _l++) {\n", t.lanes)
+	if masked {
+		t.writef("    if (%s) (%s)[%s[_l]] = %s[_l];\n", inMask("_l"), dst, idxBuf, vBuf)
+	} else {
+		t.writef("    (%s)[%s[_l]] = %s[_l];\n", dst, idxBuf, vBuf)
+	}
+	t.writef("}\n")
+}
+
+// emitHwyMaskLoad: hwy.MaskLoad(mask, src) → svld1_f32(mask, src), or
+// _mm512_maskz_loadu_ps(mask, src). Lanes outside the mask are zero.
+func (t *CASTTranslator) emitHwyMaskLoad(args []ast.Expr) string {
+	if len(args) < 2 {
+		return "/* MaskLoad: missing args */"
+	}
+	mask := t.translateExpr(args[0])
+	src := t.translateExpr(args[1])
+	ptr := src
+	if t.profile.CastExpr != "" {
+		ptr = fmt.Sprintf("%s(%s)", t.profile.CastExpr, src)
+	}
+	switch {
+	case t.profile.NeedsPredicate:
+		return fmt.Sprintf("%s(%s, %s)", t.profile.LoadFn[t.tier], mask, ptr)
+	case t.profile.MaskedLoadFn != "":
+		return fmt.Sprintf("%s(%s, %s)", t.profile.MaskedLoadFn, mask, ptr)
+	case !t.hasLaneBuffers():
+		return "/* MaskLoad: no lowering for this profile */"
+	}
+
+	inMask := t.spillMask(mask)
+	buf := t.newTemp("_maskload")
+	t.writef("%s %s[%d];\n", t.profile.CType, buf, t.lanes)
+	t.writef("for (int _l = 0; _l < %d; _l++) {\n", t.lanes)
+	t.writef("    %s[_l] = %s ? (%s)[_l] : 0;\n", buf, inMask("_l"), src)
+	t.writef("}\n")
+	return fmt.Sprintf("%s(%s)", t.profile.LoadFn[t.tier], t.laneBufPtr(buf))
+}
+
+// emitHwyMaskStore stores the lanes of v selected by mask to dst, leaving
+// the others untouched, for hwy.MaskStore(mask, v, dst) and
+// hwy.BlendedStore(v, mask, dst):
+// SVE: svst1_f32(mask, dst, v); AVX-512: _mm512_mask_storeu_ps(dst, mask, v).
+func (t *CASTTranslator) emitHwyMaskStore(maskArg, vArg, dstArg ast.Expr) {
+	mask := t.translateExpr(maskArg)
+	v := t.translateExpr(vArg)
+	dst := t.translateExpr(dstArg)
+	ptr := dst
+	if t.profile.CastExpr != "" {
+		ptr = fmt.Sprintf("%s(%s)", t.profile.CastExpr, dst)
+	}
+	switch {
+	case t.profile.NeedsPredicate:
+		t.writef("%s(%s, %s, %s);\n", t.profile.StoreFn[t.tier], mask, ptr, v)
+		return
+	case t.profile.MaskedStoreFn != "":
+		t.writef("%s(%s, %s, %s);\n", t.profile.MaskedStoreFn, ptr, mask, v)
+		return
+	case !t.hasLaneBuffers():
+		t.writef("/* MaskStore: no lowering for this profile */\n")
+		return
+	}
+
+	vBuf := t.spillVec(v)
+	inMask := t.spillMask(mask)
+	t.writef("for (int _l = 0; _l < %d; _l++) {\n", t.lanes)
+	t.writef("    if (%s) (%s)[_l] = %s[_l];\n", inMask("_l"), dst, vBuf)
+	t.writef("}\n")
+}
+
+// emitHwyCompress packs the lanes of v selected by mask to the front of a
+// vector, zeroing the rest, and returns the vector and count expressions:
+// SVE: svcompact_f32(mask, v), svcntp_b32(pg, mask);
+// AVX-512: _mm512_maskz_compress_ps(mask, v), __builtin_popcount(mask).
+func (t *CASTTranslator) emitHwyCompress(args []ast.Expr) (vec, count string) {
+	if len(args) < 2 {
+		return "/* Compress: missing args */", "0"
+	}
+	v := t.translateExpr(args[0])
+	mask := t.translateExpr(args[1])
+	if fn := t.profile.CompressFn[t.tier]; fn != "" {
+		m := t.newTemp("_mask")
+		t.writef("%s %s = %s;\n", t.profile.MaskType[t.tier], m, mask)
+		count = fmt.Sprintf("%s(%s)", t.profile.CountTrueFn[t.tier], m)
+		if t.profile.NeedsPredicate {
+			count = fmt.Sprintf("%s(pg, %s)", t.profile.CountTrueFn[t.tier], m)
+		}
+		return fmt.Sprintf("%s(%s, %s)", fn, m, v), count
+	}
+	if !t.hasLaneBuffers() {
+		return "/* Compress: no lowering for this profile */", "0"
+	}
+
+	vBuf := t.spillVec(v)
+	inMask := t.spillMask(mask)
+	buf := t.newTemp("_compress")
+	count = t.newTemp("_count")
+	t.writef("%s %s[%d] = {0};\n", t.profile.CType, buf, t.lanes)
+	t.writef("long %s = 0;\n", count)
+	t.writef("for (int _l = 0; _l < %d; _l++) {\n", t.lanes)
+	t.writef("    if (%s) %s[%s++] = %s[_l];\n", inMask("_l"), buf, count, vBuf)
+	t.writef("}\n")
+	return fmt.Sprintf("%s(%s)", t.profile.LoadFn[t.tier], t.laneBufPtr(buf)), count
+}
+
+// emitHwyCompressStore: hwy.CompressStore(v, mask, dst) stores the selected
+// lanes of v contiguously at dst and returns their count. SVE stores the
+// compacted vector under a whilelt predicate, AVX-512 under a tail mask;
+// other targets store lane by lane, so nothing past the count is written.
+func (t *CASTTranslator) emitHwyCompressStore(args []ast.Expr) string {
+	if len(args) < 3 {
+		return "/* CompressStore: missing args */"
+	}
+	dst := t.translateExpr(args[2])
+	ptr := dst
+	if t.profile.CastExpr != "" {
+		ptr = fmt.Sprintf("%s(%s)", t.profile.CastExpr, dst)
+	}
+	switch {
+	case t.profile.CompressFn[t.tier] != "" && t.profile.WhileLtFn != "":
+		vec, count := t.emitHwyCompress(args[:2])
+		n := t.newTemp("_count")
+		t.writef("long %s = %s;\n", n, count)
+		t.writef("%s(%s(0, %s), %s, %s);\n", t.profile.StoreFn[t.tier], t.profile.WhileLtFn, n, ptr, vec)
+		return n
+	case t.profile.CompressFn[t.tier] != "" && t.profile.TailMaskFn != "":
+		vec, count := t.emitHwyCompress(args[:2])
+		n := t.newTemp("_count")
+		t.writef("long %s = %s;\n", n, count)
+		tail := fmt.Sprintf(t.profile.TailMaskFn, n)
+		t.writef("%s(%s, %s, %s);\n", t.profile.MaskedStoreFn, ptr, tail, vec)
+		return n
+	case !t.hasLaneBuffers():
+		return "/* CompressStore: no lowering for this profile */"
+	}
+
+	vBuf := t.spillVec(t.translateExpr(args[0]))
+	inMask := t.spillMask(t.translateExpr(args[1]))
+	n := t.newTemp("_count")
+	t.writef("long %s = 0;\n", n)
+	t.writef("for (int _l = 0; _l < %d; _l++) {\n", t.lanes)
+	t.writef("    if (%s) (%s)[%s++] = %s[_l];\n", inMask("_l"), dst, n, vBuf)
+	t.writef("}\n")
+	return n
+}
+
+// translateCompressAssign handles: packed, n := hwy.Compress(v, mask)
+func (t *CASTTranslator) translateCompressAssign(lhs []ast.Expr, args []ast.Expr, tok token.Token) {
+	vec, count := t.emitHwyCompress(args)
+	vecType := t.profile.VecTypes[t.tier]
+	for i, rhs := range []string{vec, count} {
+		ident, ok := lhs[i].(*ast.Ident)
+		if !ok || ident.Name == "_" {
+			continue
+		}
+		if tok == token.DEFINE {
+			info := cVarInfo{cType: "long"}
+			if i == 0 {
+				info = cVarInfo{cType: vecType, isVector: true}
+			}
+			t.vars[ident.Name] = info
+			t.writef("%s %s = %s;\n", info.cType, ident.Name, rhs)
+		} else {
+			t.writef("%s = %s;\n", ident.Name, rhs)
+		}
+	}
+}
+
 // translateLoad4Assign handles: a, b, c, d := hwy.Load4(slice[off:])
 // On NEON (VecX4Type populated): emits vld1q_u64_x4 + .val[i] destructuring.
 // On AVX (VecX4Type nil): emits 4 individual loads with ptr + i*lanes offsets.
@@ -2689,9 +3558,13 @@ func (t *CASTTranslator) inferCallType(e *ast.CallExpr) cVarInfo {
 			case "Load", "Load4", "Zero", "Set", "MulAdd", "FMA", "Add", "Sub", "Mul", "Div",
 				"Min", "Max", "Neg", "Abs", "Sqrt", "ShiftRight",
 				"LoadSlice", "InterleaveLower", "InterleaveUpper",
+				"ZipLower", "ZipUpper", "ConcatEven", "ConcatOdd",
 				"And", "Or", "Xor", "PopCount", "TableLookupBytes",
-				"IfThenElse", "SlideUpLanes":
+				"IfThenElse", "SlideUpLanes", "RotateLanes",
+				"GatherIndex", "GatherIndexMasked", "MaskLoad", "Compress":
 				return cVarInfo{cType: vecType, isVector: true}
+			case "CompressStore":
+				return cVarInfo{cType: "long"}
 			case "ReduceMin", "ReduceMax":
 				// ReduceMin/Max return a scalar
 				if t.profile.ScalarArithType != "" {
@@ -2792,9 +3665,11 @@ func (t *CASTTranslator) inferCallType(e *ast.CallExpr) cVarInfo {
 		if ident.Name == "len" {
 			return cVarInfo{cType: "long"}
 		}
-		// getSignBit() → unsigned int
-		if ident.Name == "getSignBit" {
-			return cVarInfo{cType: "unsigned int"}
+		// Pure helper calls have the type of the expression they inline to
+		if inlined := t.inlineHelper(e); inlined != nil {
+			t.inlineDepth++
+			defer func() { t.inlineDepth-- }()
+			return t.inferType(inlined)
 		}
 		// make() → infer from the type argument
 		if ident.Name == "make" && len(e.Args) >= 1 {
@@ -2976,7 +3851,8 @@ func IsASTCEligible(pf *ParsedFunc) bool {
 }
 
 // hasIntegerSIMDOps returns true if the function uses SIMD operations that
-// indicate integer/bitwise processing (RaBitQ, varint, etc.)
+// indicate integer/bitwise processing (RaBitQ, varint, etc.), or indexed,
+// masked or compressing memory accesses.
 func hasIntegerSIMDOps(pf *ParsedFunc) bool {
 	intOps := map[string]bool{
 		"And": true, "Or": true, "Xor": true,
@@ -2984,6 +3860,12 @@ func hasIntegerSIMDOps(pf *ParsedFunc) bool {
 		"LessThan": true, "TableLookupBytes": true,
 		"IfThenElse": true, "LoadSlice": true,
 		"Load4": true,
+		// Gathers, scatters, masked memory ops and compress only have a
+		// lowering in the AST translator.
+		"GatherIndex": true, "GatherIndexMasked": true,
+		"ScatterIndex": true, "ScatterIndexMasked": true,
+		"MaskLoad": true, "MaskStore": true, "BlendedStore": true,
+		"Compress": true, "CompressStore": true,
 	}
 	for _, call := range pf.HwyCalls {
 		if call.Package == "hwy" && intOps[call.FuncName] {
@@ -15,8 +15,9 @@ type CEmitter struct {
 	pkgName        string
 	elemType       string // "float32", "float64", "hwy.Float16", "hwy.BFloat16"
 	target         Target
-	profile        *CIntrinsicProfile // target+type specific intrinsics (nil = use legacy if/else)
-	packageGlobals []PackageGlobal    // package-level array vars for static const emission
+	profile        *CIntrinsicProfile     // target+type specific intrinsics (nil = use legacy if/else)
+	packageGlobals []PackageGlobal        // package-level array vars for static const emission
+	helpers        map[string]*ParsedFunc // package functions, for inlining pure helpers
 }
 
 // NewCEmitter creates a new C emitter for the given element type.
@@ -38,14 +39,11 @@ func (e *CEmitter) EmitC(pf *ParsedFunc, outPath string) (string, error) {
 	include := "#include <arm_neon.h>"
 	targetLabel := "ARM64 NEON"
 	targetSuffix := "neon"
-	archSuffix := "arm64"
+	archSuffix := e.archSuffix()
 	if e.profile != nil {
 		include = e.profile.Include
 		targetLabel = e.profile.TargetName + " " + e.profile.ElemType
 		targetSuffix = strings.ToLower(e.profile.TargetName)
-		if e.profile.GoatTarget == "amd64" {
-			archSuffix = "amd64"
-		}
 	}
 
 	// File header
@@ -105,11 +103,7 @@ func (e *CEmitter) emitCFunction(buf *bytes.Buffer, pf *ParsedFunc) error {
 	if e.profile != nil && e.profile.FuncAttrs != "" {
 		funcAttrs = " " + e.profile.FuncAttrs
 	}
-	simdLabel := "NEON"
-	if e.isSVE() {
-		simdLabel = "SVE"
-	}
-	fmt.Fprintf(buf, "// %s: processes entire array with %s SIMD\n", funcName, simdLabel)
+	fmt.Fprintf(buf, "// %s: processes entire array with %s SIMD\n", funcName, e.simdLabel())
 	fmt.Fprintf(buf, "void %s(%s *input, %s *result, long *len)%s {\n", funcName, cType, cType, funcAttrs)
 	fmt.Fprintf(buf, "    long n = *len;\n")
 	fmt.Fprintf(buf, "    long i = 0;\n")
@@ -124,12 +118,18 @@ func (e *CEmitter) emitCFunction(buf *bytes.Buffer, pf *ParsedFunc) error {
 	if e.hasDynamicLanes() {
 		fmt.Fprintf(buf, "    long lanes = %s;\n", e.dynamicLanesExpr())
 	}
+	// RVV active vector length, shortened for the final partial vector
+	if e.isRVV() {
+		fmt.Fprintf(buf, "    size_t vl = lanes;\n")
+	}
 	fmt.Fprintf(buf, "\n")
 
 	// Emit constants
 	e.emitConstants(buf, pf)
 
-	if e.isSVE() {
+	if e.isRVV() {
+		e.emitRVVLoops(buf, pf, "input", "result", e.emitVecComputation, e.emitVecComputationSingle)
+	} else if e.isSVE() {
 		// SVE path: predicated loads/stores
 		// Main SIMD loop - process 4 vectors at once for better throughput
 		fmt.Fprintf(buf, "    // Process %s*4 elements at a time (4 vectors)\n", lExpr)
@@ -153,22 +153,7 @@ func (e *CEmitter) emitCFunction(buf *bytes.Buffer, pf *ParsedFunc) error {
 		}
 		fmt.Fprintf(buf, "    }\n\n")
 
-		// Single vector loop for remaining elements
-		fmt.Fprintf(buf, "    // Process %s elements at a time\n", lExpr)
-		fmt.Fprintf(buf, "    for (; i + %s - 1 < n; i += %s) {\n", lExpr, lExpr)
-		fmt.Fprintf(buf, "        %s x = %s(pg, input + i);\n", vecType, e.loadIntrinsic())
-		e.emitVecComputationSingle(buf, pf, "        ")
-		fmt.Fprintf(buf, "        %s(pg, result + i, res);\n", e.storeIntrinsic())
-		fmt.Fprintf(buf, "    }\n\n")
-
-		// Scalar tail - use SVE dup + extract
-		fmt.Fprintf(buf, "    // Scalar remainder using SVE for consistency\n")
-		fmt.Fprintf(buf, "    for (; i < n; i++) {\n")
-		fmt.Fprintf(buf, "        %s xv = input[i];\n", cType)
-		fmt.Fprintf(buf, "        %s x = %s(xv);\n", vecType, e.dupIntrinsic())
-		e.emitVecComputationSingle(buf, pf, "        ")
-		fmt.Fprintf(buf, "        result[i] = %s(pg, res);\n", e.getLaneIntrinsic())
-		fmt.Fprintf(buf, "    }\n")
+		e.emitSVERemainder(buf, pf, "input", "result", e.emitVecComputationSingle)
 	} else {
 		// NEON/AVX path (original)
 		// Main SIMD loop - process multiple vectors at once for better throughput
@@ -193,22 +178,7 @@ func (e *CEmitter) emitCFunction(buf *bytes.Buffer, pf *ParsedFunc) error {
 		}
 		fmt.Fprintf(buf, "    }\n\n")
 
-		// Single vector loop for remaining elements
-		fmt.Fprintf(buf, "    // Process %d elements at a time\n", lanes)
-		fmt.Fprintf(buf, "    for (; i + %d < n; i += %d) {\n", lanes-1, lanes)
-		fmt.Fprintf(buf, "        %s x = %s(%s(input + i));\n", vecType, e.loadIntrinsic(), castExpr)
-		e.emitVecComputationSingle(buf, pf, "        ")
-		fmt.Fprintf(buf, "        %s(%s(result + i), res);\n", e.storeIntrinsic(), castExpr)
-		fmt.Fprintf(buf, "    }\n\n")
-
-		// Scalar tail - use NEON for single elements
-		fmt.Fprintf(buf, "    // Scalar remainder using NEON for consistency\n")
-		fmt.Fprintf(buf, "    for (; i < n; i++) {\n")
-		fmt.Fprintf(buf, "        %s xv = input[i];\n", cType)
-		fmt.Fprintf(buf, "        %s x = %s(xv);\n", vecType, e.dupIntrinsic())
-		e.emitVecComputationSingle(buf, pf, "        ")
-		fmt.Fprintf(buf, "        result[i] = %s(res, 0);\n", e.getLaneIntrinsic())
-		fmt.Fprintf(buf, "    }\n")
+		e.emitFixedRemainder(buf, pf, "input", "result", castExpr, e.emitVecComputationSingle)
 	}
 
 	fmt.Fprintf(buf, "}\n")
@@ -241,7 +211,7 @@ func (e *CEmitter) emitExpConstants(buf *bytes.Buffer, vecType string) {
 		fmt.Fprintf(buf, "    // Exp constants (f32)\n")
 		fmt.Fprintf(buf, "    %s invLn2 = %s;\n", vecType, e.fmtConstHex("0x3FB8AA3B"))
 		fmt.Fprintf(buf, "    %s ln2Hi = %s;\n", vecType, e.fmtConstHex("0x3F317200"))
-		fmt.Fprintf(buf, "    %s ln2Lo = %s;\n", vecType, e.fmtConstHex("0xB95E8083"))
+		fmt.Fprintf(buf, "    %s ln2Lo = %s;\n", vecType, e.fmtConstHex("0x35BFBE8E"))
 		fmt.Fprintf(buf, "    %s overflow = %s;\n", vecType, e.fmtConstHex("0x42B17218"))
 		fmt.Fprintf(buf, "    %s underflow = %s;\n", vecType, e.fmtConstHex("0xC2AEAC50"))
 		fmt.Fprintf(buf, "    %s c1 = %s;\n", vecType, e.fmtConstFloat("1.0f"))
@@ -257,7 +227,7 @@ func (e *CEmitter) emitExpConstants(buf *bytes.Buffer, vecType string) {
 		fmt.Fprintf(buf, "    // Exp constants (f64)\n")
 		fmt.Fprintf(buf, "    %s%s invLn2 = %s;\n", vol, vecType, e.fmtConstHex("0x3FF71547652B82FELL"))
 		fmt.Fprintf(buf, "    %s%s ln2Hi = %s;\n", vol, vecType, e.fmtConstHex("0x3FE62E42FEFA39EFLL"))
-		fmt.Fprintf(buf, "    %s%s ln2Lo = %s;\n", vol, vecType, e.fmtConstHex("0xBDE8BFBE8E7BCD5ELL"))
+		fmt.Fprintf(buf, "    %s%s ln2Lo = %s;\n", vol, vecType, e.fmtConstHex("0x3C7ABC9E3B39803FLL"))
 		fmt.Fprintf(buf, "    %s%s overflow = %s;\n", vol, vecType, e.fmtConstHex("0x40862E42FEFA39EFLL"))
 		fmt.Fprintf(buf, "    %s%s underflow = %s;\n", vol, vecType, e.fmtConstHex("0xC0862E42FEFA39EFLL"))
 		fmt.Fprintf(buf, "    %s%s c1 = %s;\n", vol, vecType, e.fmtConstHex("0x3FF0000000000000LL"))
@@ -300,12 +270,12 @@ func (e *CEmitter) emitErfConstants(buf *bytes.Buffer, vecType string) {
 		fmt.Fprintf(buf, "    %s erfP = %s;\n", vecType, e.fmtConstHex("0x3EA7BA27"))
 		fmt.Fprintf(buf, "    %s one = %s;\n", vecType, e.fmtConstFloat("1.0f"))
 	} else {
-		fmt.Fprintf(buf, "    %s%s a1 = %s;\n", vol, vecType, e.fmtConstHex("0x3FD04DDF3B72BEF5LL"))
-		fmt.Fprintf(buf, "    %s%s a2 = %s;\n", vol, vecType, e.fmtConstHex("0xBFD2327F14F2FE0ELL"))
-		fmt.Fprintf(buf, "    %s%s a3 = %s;\n", vol, vecType, e.fmtConstHex("0x3FF6C0CC1ECAC78CLL"))
-		fmt.Fprintf(buf, "    %s%s a4 = %s;\n", vol, vecType, e.fmtConstHex("0xBFF7412D14DC4B0DLL"))
-		fmt.Fprintf(buf, "    %s%s a5 = %s;\n", vol, vecType, e.fmtConstHex("0x3FF0FB926CE8EF39LL"))
-		fmt.Fprintf(buf, "    %s%s erfP = %s;\n", vol, vecType, e.fmtConstHex("0x3FD4F80D2CC7BBF6LL"))
+		fmt.Fprintf(buf, "    %s%s a1 = %s;\n", vol, vecType, e.fmtConstHex("0x3FD04F20C6EC5A7ELL"))
+		fmt.Fprintf(buf, "    %s%s a2 = %s;\n", vol, vecType, e.fmtConstHex("0xBFD23531CC3C1469LL"))
+		fmt.Fprintf(buf, "    %s%s a3 = %s;\n", vol, vecType, e.fmtConstHex("0x3FF6BE1C55BAE157LL"))
+		fmt.Fprintf(buf, "    %s%s a4 = %s;\n", vol, vecType, e.fmtConstHex("0xBFF7401C57014C39LL"))
+		fmt.Fprintf(buf, "    %s%s a5 = %s;\n", vol, vecType, e.fmtConstHex("0x3FF0FB844255A12DLL"))
+		fmt.Fprintf(buf, "    %s%s erfP = %s;\n", vol, vecType, e.fmtConstHex("0x3FD4F740A93D7B8CLL"))
 		fmt.Fprintf(buf, "    %s%s one = %s;\n", vol, vecType, e.fmtConstHex("0x3FF0000000000000LL"))
 	}
 }
@@ -423,8 +393,8 @@ func (e *CEmitter) emitErfComputation(buf *bytes.Buffer, x, res, indent string)
 	fmt.Fprintf(buf, "%s// t = 1 / (1 + p * |x|)\n", indent)
 	fmt.Fprintf(buf, "%s%s t_%s = %s;\n", indent, vecType, res, e.fmtDiv("one", e.fmtFma("one", "erfP", "absX_"+res)))
 	fmt.Fprintf(buf, "%s\n", indent)
-	fmt.Fprintf(buf, "%s// Compute -x^2\n", indent)
-	fmt.Fprintf(buf, "%s%s negX2_%s = %s;\n", indent, vecType, res, e.fmtNeg(e.fmtMul(x, x)))
+	fmt.Fprintf(buf, "%s// Compute -x^2, clamped so the 2^k scale below stays a normal number\n", indent)
+	fmt.Fprintf(buf, "%s%s negX2_%s = %s;\n", indent, vecType, res, e.fmtMax(e.fmtNeg(e.fmtMul(x, x)), "underflow"))
 	fmt.Fprintf(buf, "%s\n", indent)
 	fmt.Fprintf(buf, "%s// Compute exp(-x^2) inline\n", indent)
 	fmt.Fprintf(buf, "%s%s kf_%s = %s;\n", indent, vecType, res, e.fmtRound(e.fmtMul("negX2_"+res, "invLn2")))
@@ -456,6 +426,147 @@ func (e *CEmitter) emitErfComputation(buf *bytes.Buffer, x, res, indent string)
 	fmt.Fprintf(buf, "%s%s %s = %s;\n", indent, vecType, res, e.fmtSel("isNeg_"+res, e.fmtNeg("erfAbs_"+res), "erfAbs_"+res))
 }
 
+// emitSVERemainder emits the loops that follow the 4-vector SVE main loop.
+// When the profile has a WhileLtFn, the rest of the array is processed by a
+// single vector-length-agnostic loop: whilelt activates lanes i..n-1, so the
+// final partial vector is loaded with zero fill and stored without touching
+// memory past n, and no scalar epilogue is needed. Otherwise it emits a
+// single-vector loop followed by a scalar remainder using dup + extract.
+func (e *CEmitter) emitSVERemainder(buf *bytes.Buffer, pf *ParsedFunc, in, out string, computeSingle func(*bytes.Buffer, *ParsedFunc, string)) {
+	vecType := e.vecType()
+	lExpr := e.lanesExpr()
+
+	if e.profile.WhileLtFn != "" {
+		fmt.Fprintf(buf, "    // Process the remainder with a whilelt predicate (VLA)\n")
+		fmt.Fprintf(buf, "    for (; i < n; i += %s) {\n", lExpr)
+		fmt.Fprintf(buf, "        svbool_t pt = %s(i, n);\n", e.profile.WhileLtFn)
+		fmt.Fprintf(buf, "        %s x = %s(pt, %s + i);\n", vecType, e.loadIntrinsic(), in)
+		computeSingle(buf, pf, "        ")
+		fmt.Fprintf(buf, "        %s(pt, %s + i, res);\n", e.storeIntrinsic(), out)
+		fmt.Fprintf(buf, "    }\n")
+		return
+	}
+
+	// Single vector loop for remaining elements
+	fmt.Fprintf(buf, "    // Process %s elements at a time\n", lExpr)
+	fmt.Fprintf(buf, "    for (; i + %s - 1 < n; i += %s) {\n", lExpr, lExpr)
+	fmt.Fprintf(buf, "        %s x = %s(pg, %s + i);\n", vecType, e.loadIntrinsic(), in)
+	computeSingle(buf, pf, "        ")
+	fmt.Fprintf(buf, "        %s(pg, %s + i, res);\n", e.storeIntrinsic(), out)
+	fmt.Fprintf(buf, "    }\n\n")
+
+	// Scalar tail - use SVE dup + extract
+	fmt.Fprintf(buf, "    // Scalar remainder using SVE for consistency\n")
+	fmt.Fprintf(buf, "    for (; i < n; i++) {\n")
+	fmt.Fprintf(buf, "        %s xv = %s[i];\n", e.cType(), in)
+	fmt.Fprintf(buf, "        %s x = %s(xv);\n", vecType, e.dupIntrinsic())
+	computeSingle(buf, pf, "        ")
+	fmt.Fprintf(buf, "        %s[i] = %s(pg, res);\n", out, e.getLaneIntrinsic())
+	fmt.Fprintf(buf, "    }\n")
+}
+
+// emitRVVLoops emits the RVV loops of an elementwise function: a main loop
+// over 4 full vectors at VLMAX, then a vector-length-agnostic remainder that
+// sets vl to the number of elements left (capped at VLMAX). Every intrinsic
+// reads vl, so the final partial vector only loads and stores n - i lanes
+// and no scalar epilogue is needed.
+func (e *CEmitter) emitRVVLoops(buf *bytes.Buffer, pf *ParsedFunc, in, out string,
+	compute func(*bytes.Buffer, *ParsedFunc, int, string),
+	computeSingle func(*bytes.Buffer, *ParsedFunc, string)) {
+	vecType := e.vecType()
+	sew := e.rvvSEW()
+	load := e.rvvOp("vle" + sew + "_v")
+	store := e.rvvOp("vse" + sew + "_v")
+
+	fmt.Fprintf(buf, "    // Process lanes*4 elements at a time (4 vectors)\n")
+	fmt.Fprintf(buf, "    for (; i + lanes * 4 - 1 < n; i += lanes * 4) {\n")
+	for j := range 4 {
+		fmt.Fprintf(buf, "        %s x%d = %s(%s + i + lanes * %d, vl);\n", vecType, j, load, in, j)
+	}
+	fmt.Fprintf(buf, "\n")
+	for j := range 4 {
+		compute(buf, pf, j, "        ")
+	}
+	fmt.Fprintf(buf, "\n")
+	for j := range 4 {
+		fmt.Fprintf(buf, "        %s(%s + i + lanes * %d, res%d, vl);\n", store, out, j, j)
+	}
+	fmt.Fprintf(buf, "    }\n\n")
+
+	fmt.Fprintf(buf, "    // Process the remainder with a shortened vector length (VLA)\n")
+	fmt.Fprintf(buf, "    for (; i < n; i += vl) {\n")
+	fmt.Fprintf(buf, "        vl = %s(n - i);\n", e.profile.SetVLFn)
+	fmt.Fprintf(buf, "        %s x = %s(%s + i, vl);\n", vecType, load, in)
+	computeSingle(buf, pf, "        ")
+	fmt.Fprintf(buf, "        %s(%s + i, res, vl);\n", store, out)
+	fmt.Fprintf(buf, "    }\n")
+}
+
+// emitFixedRemainder emits the loops that follow the 4-vector main loop on
+// fixed-width targets (NEON, AVX2, AVX-512): a single-vector loop, then the
+// last partial vector. Profiles with a TailMaskFn finish with one masked
+// load/store; the others run a scalar loop that broadcasts each element,
+// computes on the full vector and extracts lane 0.
+func (e *CEmitter) emitFixedRemainder(buf *bytes.Buffer, pf *ParsedFunc, in, out, castExpr string, computeSingle func(*bytes.Buffer, *ParsedFunc, string)) {
+	vecType := e.vecType()
+	lanes := e.lanes()
+
+	inPtr, outPtr := in+" + i", out+" + i"
+	if castExpr != "" {
+		inPtr, outPtr = castExpr+"("+inPtr+")", castExpr+"("+outPtr+")"
+	}
+
+	fmt.Fprintf(buf, "    // Process %d elements at a time\n", lanes)
+	fmt.Fprintf(buf, "    for (; i + %d < n; i += %d) {\n", lanes-1, lanes)
+	fmt.Fprintf(buf, "        %s x = %s(%s);\n", vecType, e.loadIntrinsic(), inPtr)
+	computeSingle(buf, pf, "        ")
+	fmt.Fprintf(buf, "        %s(%s, res);\n", e.storeIntrinsic(), outPtr)
+	fmt.Fprintf(buf, "    }\n\n")
+
+	if e.profile != nil && e.profile.TailMaskFn != "" {
+		fmt.Fprintf(buf, "    // Masked remainder: inactive lanes are zero-filled and not stored\n")
+		fmt.Fprintf(buf, "    if (i < n) {\n")
+		fmt.Fprintf(buf, "        %s tail = %s;\n", e.maskType(), fmt.Sprintf(e.profile.TailMaskFn, "n - i"))
+		fmt.Fprintf(buf, "        %s x = %s(tail, %s + i);\n", vecType, e.profile.MaskedLoadFn, in)
+		computeSingle(buf, pf, "        ")
+		fmt.Fprintf(buf, "        %s(%s + i, tail, res);\n", e.profile.MaskedStoreFn, out)
+		fmt.Fprintf(buf, "    }\n")
+		return
+	}
+
+	fmt.Fprintf(buf, "    // Scalar remainder using %s for consistency\n", e.simdLabel())
+	fmt.Fprintf(buf, "    for (; i < n; i++) {\n")
+	fmt.Fprintf(buf, "        %s xv = %s[i];\n", e.cType(), in)
+	fmt.Fprintf(buf, "        %s x = %s(xv);\n", vecType, e.dupIntrinsic())
+	computeSingle(buf, pf, "        ")
+	fmt.Fprintf(buf, "        %s[i] = %s;\n", out, e.fmtLane0("res"))
+	fmt.Fprintf(buf, "    }\n")
+}
+
+// simdLabel names the SIMD family in generated comments.
+func (e *CEmitter) simdLabel() string {
+	switch {
+	case e.isSVE():
+		return "SVE"
+	case e.isRVV():
+		return "RVV"
+	case e.isAVX512():
+		return "AVX-512"
+	case e.isAVX():
+		return "AVX2"
+	}
+	return "NEON"
+}
+
+// archSuffix returns the GOARCH used in C file names, which GOAT carries over
+// to the generated assembly: the profile's GoatTarget, or arm64 by default.
+func (e *CEmitter) archSuffix() string {
+	if e.profile != nil && e.profile.GoatTarget != "" {
+		return e.profile.GoatTarget
+	}
+	return "arm64"
+}
+
 // isSVE returns true if the profile targets SVE (either darwin or linux).
 func (e *CEmitter) isSVE() bool {
 	return e.profile != nil && e.profile.NeedsPredicate
@@ -679,14 +790,11 @@ func (e *CEmitter) EmitCompositeC(pf *ParsedFunc, outPath string) (string, error
 	include := "#include <arm_neon.h>"
 	targetLabel := "ARM64 NEON"
 	targetSuffix := "neon"
-	archSuffix := "arm64"
+	archSuffix := e.archSuffix()
 	if e.profile != nil {
 		include = e.profile.Include
 		targetLabel = e.profile.TargetName + " " + e.profile.ElemType
 		targetSuffix = strings.ToLower(e.profile.TargetName)
-		if e.profile.GoatTarget == "amd64" {
-			archSuffix = "amd64"
-		}
 	}
 
 	// File header
@@ -723,11 +831,7 @@ func (e *CEmitter) emitCompositeFunction(buf *bytes.Buffer, pf *ParsedFunc) erro
 	if e.profile != nil && e.profile.FuncAttrs != "" {
 		funcAttrs = " " + e.profile.FuncAttrs
 	}
-	simdLabel := "NEON"
-	if e.isSVE() {
-		simdLabel = "SVE"
-	}
-	fmt.Fprintf(buf, "// %s: processes entire array with %s SIMD\n", funcName, simdLabel)
+	fmt.Fprintf(buf, "// %s: processes entire array with %s SIMD\n", funcName, e.simdLabel())
 	fmt.Fprintf(buf, "void %s(%s *input, %s *output, long *len)%s {\n", funcName, cType, cType, funcAttrs)
 	fmt.Fprintf(buf, "    long n = *len;\n")
 	fmt.Fprintf(buf, "    long i = 0;\n")
@@ -742,12 +846,18 @@ func (e *CEmitter) emitCompositeFunction(buf *bytes.Buffer, pf *ParsedFunc) erro
 	if e.hasDynamicLanes() {
 		fmt.Fprintf(buf, "    long lanes = %s;\n", e.dynamicLanesExpr())
 	}
+	// RVV active vector length, shortened for the final partial vector
+	if e.isRVV() {
+		fmt.Fprintf(buf, "    size_t vl = lanes;\n")
+	}
 	fmt.Fprintf(buf, "\n")
 
 	// Emit constants based on composite function type
 	e.emitCompositeConstants(buf, pf)
 
-	if e.isSVE() {
+	if e.isRVV() {
+		e.emitRVVLoops(buf, pf, "input", "output", e.emitCompositeComputation, e.emitCompositeComputationSingle)
+	} else if e.isSVE() {
 		// SVE path
 		fmt.Fprintf(buf, "    // Process %s*4 elements at a time (4 vectors)\n", lExpr)
 		fmt.Fprintf(buf, "    for (; i + %s * 4 - 1 < n; i += %s * 4) {\n", lExpr, lExpr)
@@ -767,20 +877,7 @@ func (e *CEmitter) emitCompositeFunction(buf *bytes.Buffer, pf *ParsedFunc) erro
 		}
 		fmt.Fprintf(buf, "    }\n\n")
 
-		fmt.Fprintf(buf, "    // Process %s elements at a time\n", lExpr)
-		fmt.Fprintf(buf, "    for (; i + %s - 1 < n; i += %s) {\n", lExpr, lExpr)
-		fmt.Fprintf(buf, "        %s x = %s(pg, input + i);\n", vecType, e.loadIntrinsic())
-		e.emitCompositeComputationSingle(buf, pf, "        ")
-		fmt.Fprintf(buf, "        %s(pg, output + i, res);\n", e.storeIntrinsic())
-		fmt.Fprintf(buf, "    }\n\n")
-
-		fmt.Fprintf(buf, "    // Scalar remainder using SVE for consistency\n")
-		fmt.Fprintf(buf, "    for (; i < n; i++) {\n")
-		fmt.Fprintf(buf, "        %s xv = input[i];\n", cType)
-		fmt.Fprintf(buf, "        %s x = %s(xv);\n", vecType, e.dupIntrinsic())
-		e.emitCompositeComputationSingle(buf, pf, "        ")
-		fmt.Fprintf(buf, "        output[i] = %s(pg, res);\n", e.getLaneIntrinsic())
-		fmt.Fprintf(buf, "    }\n")
+		e.emitSVERemainder(buf, pf, "input", "output", e.emitCompositeComputationSingle)
 	} else {
 		// NEON/AVX path (original)
 		// Main SIMD loop - process multiple vectors at once
@@ -802,20 +899,7 @@ func (e *CEmitter) emitCompositeFunction(buf *bytes.Buffer, pf *ParsedFunc) erro
 		}
 		fmt.Fprintf(buf, "    }\n\n")
 
-		fmt.Fprintf(buf, "    // Process %d elements at a time\n", lanes)
-		fmt.Fprintf(buf, "    for (; i + %d < n; i += %d) {\n", lanes-1, lanes)
-		fmt.Fprintf(buf, "        %s x = %s(input + i);\n", vecType, e.loadIntrinsic())
-		e.emitCompositeComputationSingle(buf, pf, "        ")
-		fmt.Fprintf(buf, "        %s(output + i, res);\n", e.storeIntrinsic())
-		fmt.Fprintf(buf, "    }\n\n")
-
-		fmt.Fprintf(buf, "    // Scalar remainder using NEON for consistency\n")
-		fmt.Fprintf(buf, "    for (; i < n; i++) {\n")
-		fmt.Fprintf(buf, "        %s xv = input[i];\n", cType)
-		fmt.Fprintf(buf, "        %s x = %s(xv);\n", vecType, e.dupIntrinsic())
-		e.emitCompositeComputationSingle(buf, pf, "        ")
-		fmt.Fprintf(buf, "        output[i] = %s(res, 0);\n", e.getLaneIntrinsic())
-		fmt.Fprintf(buf, "    }\n")
+		e.emitFixedRemainder(buf, pf, "input", "output", "", e.emitCompositeComputationSingle)
 	}
 
 	fmt.Fprintf(buf, "}\n")
@@ -1258,7 +1342,7 @@ func (e *CEmitter) emitPromotedExpConstants(buf *bytes.Buffer, f32Vec, dupFn str
 		// NEON path - use hex bit patterns via vreinterpret
 		fmt.Fprintf(buf, "    %s invLn2 = vreinterpretq_f32_s32(vdupq_n_s32(0x3FB8AA3B));\n", f32Vec)
 		fmt.Fprintf(buf, "    %s ln2Hi = vreinterpretq_f32_s32(vdupq_n_s32(0x3F317200));\n", f32Vec)
-		fmt.Fprintf(buf, "    %s ln2Lo = vreinterpretq_f32_s32(vdupq_n_s32(0xB95E8083));\n", f32Vec)
+		fmt.Fprintf(buf, "    %s ln2Lo = vreinterpretq_f32_s32(vdupq_n_s32(0x35BFBE8E));\n", f32Vec)
 		fmt.Fprintf(buf, "    %s overflow = vreinterpretq_f32_s32(vdupq_n_s32(0x42B17218));\n", f32Vec)
 		fmt.Fprintf(buf, "    %s underflow = vreinterpretq_f32_s32(vdupq_n_s32(0xC2AEAC50));\n", f32Vec)
 		fmt.Fprintf(buf, "    %s c1 = vdupq_n_f32(1.0f);\n", f32Vec)
@@ -1702,6 +1786,7 @@ func (e *CEmitter) EmitASTTranslatedC(pf *ParsedFunc, outPath string) (string, e
 	if len(e.packageGlobals) > 0 {
 		translator.SetPackageGlobals(e.packageGlobals)
 	}
+	translator.SetHelpers(e.helpers)
 	cCode, err := translator.TranslateToC(pf)
 	if err != nil {
 		return "", fmt.Errorf("AST translate %s: %w", pf.Name, err)
@@ -1711,10 +1796,7 @@ func (e *CEmitter) EmitASTTranslatedC(pf *ParsedFunc, outPath string) (string, e
 	include := e.profile.Include
 	targetLabel := e.profile.TargetName + " " + e.profile.ElemType
 	targetSuffix := strings.ToLower(e.profile.TargetName)
-	archSuffix := "arm64"
-	if e.profile.GoatTarget == "amd64" {
-		archSuffix = "amd64"
-	}
+	archSuffix := e.archSuffix()
 
 	// Build full file
 	var buf bytes.Buffer
@@ -1,11 +1,14 @@
 package main
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
 
 // ---------------------------------------------------------------------------
 // Generic intrinsic helpers for CEmitter.
-// These methods abstract NEON and SVE intrinsic formatting, allowing math
-// computation code to be written once for all targets.
+// These methods abstract NEON, SVE, AVX2/AVX-512 and RVV intrinsic formatting,
+// allowing math computation code to be written once for all targets.
 // ---------------------------------------------------------------------------
 
 // intSuffix returns the integer type suffix corresponding to the float element type.
@@ -17,11 +20,106 @@ func (e *CEmitter) intSuffix() string {
 	return "s64"
 }
 
+// isAVX returns true for the native AVX2 and AVX-512 float32/float64
+// profiles. The half-precision x86 profiles use the promoted code paths.
+func (e *CEmitter) isAVX() bool {
+	if e.profile == nil || (e.elemType != "float32" && e.elemType != "float64") {
+		return false
+	}
+	return e.profile.TargetName == "AVX2" || e.profile.TargetName == "AVX512"
+}
+
+// isAVX512 returns true if comparisons produce __mmask registers.
+func (e *CEmitter) isAVX512() bool {
+	return e.isAVX() && e.profile.TargetName == "AVX512"
+}
+
+// avxWidth returns the intrinsic prefix for the vector width: "_mm256" or "_mm512".
+func (e *CEmitter) avxWidth() string {
+	if e.isAVX512() {
+		return "_mm512"
+	}
+	return "_mm256"
+}
+
+// avxOp formats an x86 float intrinsic: avxOp("add") → "_mm256_add_ps"
+// (AVX2 f32) or "_mm512_add_pd" (AVX-512 f64).
+func (e *CEmitter) avxOp(op string) string {
+	if e.elemType == "float32" {
+		return e.avxWidth() + "_" + op + "_ps"
+	}
+	return e.avxWidth() + "_" + op + "_pd"
+}
+
+// avxIntOp formats an x86 integer intrinsic for the lane width matching the
+// float element type: avxIntOp("add") → "_mm256_add_epi32" (AVX2 f32).
+func (e *CEmitter) avxIntOp(op string) string {
+	if e.elemType == "float32" {
+		return e.avxWidth() + "_" + op + "_epi32"
+	}
+	return e.avxWidth() + "_" + op + "_epi64"
+}
+
+// avxCast returns the integer-to-float bit cast: "_mm256_castsi256_ps" etc.
+func (e *CEmitter) avxCast() string {
+	bits := strings.TrimPrefix(e.avxWidth(), "_mm")
+	if e.elemType == "float32" {
+		return e.avxWidth() + "_castsi" + bits + "_ps"
+	}
+	return e.avxWidth() + "_castsi" + bits + "_pd"
+}
+
+// avxSignZero returns a broadcast -0.0, used to flip or clear sign bits.
+func (e *CEmitter) avxSignZero() string {
+	if e.elemType == "float32" {
+		return e.avxOp("set1") + "(-0.0f)"
+	}
+	return e.avxOp("set1") + "(-0.0)"
+}
+
+// isRVV returns true for the RISC-V Vector profiles, whose intrinsics take
+// the active vector length as a trailing "vl" argument.
+func (e *CEmitter) isRVV() bool {
+	return e.profile != nil && e.profile.SetVLFn != ""
+}
+
+// rvvSEW returns the element width in bits: "32" (f32) or "64" (f64).
+func (e *CEmitter) rvvSEW() string {
+	if e.elemType == "float32" {
+		return "32"
+	}
+	return "64"
+}
+
+// rvvOp formats an RVV float intrinsic for LMUL=1: rvvOp("vfadd_vv") →
+// "__riscv_vfadd_vv_f32m1".
+func (e *CEmitter) rvvOp(op string) string {
+	return "__riscv_" + op + "_" + e.typeSuffix() + "m1"
+}
+
+// rvvIntOp formats an RVV signed integer intrinsic with the float lane
+// width: rvvIntOp("vadd_vv") → "__riscv_vadd_vv_i32m1".
+func (e *CEmitter) rvvIntOp(op string) string {
+	return "__riscv_" + op + "_i" + e.rvvSEW() + "m1"
+}
+
 // maskType returns the C type for comparison result masks.
 func (e *CEmitter) maskType() string {
 	if e.isSVE() {
 		return "svbool_t"
 	}
+	if e.isRVV() {
+		return "vbool" + e.rvvSEW() + "_t"
+	}
+	if e.isAVX512() {
+		if e.elemType == "float32" {
+			return "__mmask16"
+		}
+		return "__mmask8"
+	}
+	if e.isAVX() {
+		return e.vecType()
+	}
 	if e.elemType == "float32" {
 		return "uint32x4_t"
 	}
@@ -36,6 +134,15 @@ func (e *CEmitter) intVecType() string {
 		}
 		return "svint64_t"
 	}
+	if e.isRVV() {
+		return "vint" + e.rvvSEW() + "m1_t"
+	}
+	if e.isAVX512() {
+		return "__m512i"
+	}
+	if e.isAVX() {
+		return "__m256i"
+	}
 	if e.elemType == "float32" {
 		return "int32x4_t"
 	}
@@ -61,7 +168,7 @@ func (e *CEmitter) expShift() string {
 // volatilePrefix returns "volatile " for NEON f64 constants (GOAT workaround),
 // or "" for all other targets.
 func (e *CEmitter) volatilePrefix() string {
-	if !e.isSVE() && e.elemType == "float64" {
+	if !e.isSVE() && !e.isAVX() && !e.isRVV() && e.elemType == "float64" {
 		return "volatile "
 	}
 	return ""
@@ -73,41 +180,71 @@ func (e *CEmitter) volatilePrefix() string {
 
 func (e *CEmitter) fmtMul(a, b string) string {
 	s := e.typeSuffix()
+	if e.isAVX() {
+		return fmt.Sprintf("%s(%s, %s)", e.avxOp("mul"), a, b)
+	}
 	if e.isSVE() {
 		return fmt.Sprintf("svmul_%s_x(pg, %s, %s)", s, a, b)
 	}
+	if e.isRVV() {
+		return fmt.Sprintf("%s(%s, %s, vl)", e.rvvOp("vfmul_vv"), a, b)
+	}
 	return fmt.Sprintf("vmulq_%s(%s, %s)", s, a, b)
 }
 
 func (e *CEmitter) fmtAdd(a, b string) string {
 	s := e.typeSuffix()
+	if e.isAVX() {
+		return fmt.Sprintf("%s(%s, %s)", e.avxOp("add"), a, b)
+	}
 	if e.isSVE() {
 		return fmt.Sprintf("svadd_%s_x(pg, %s, %s)", s, a, b)
 	}
+	if e.isRVV() {
+		return fmt.Sprintf("%s(%s, %s, vl)", e.rvvOp("vfadd_vv"), a, b)
+	}
 	return fmt.Sprintf("vaddq_%s(%s, %s)", s, a, b)
 }
 
 func (e *CEmitter) fmtDiv(a, b string) string {
 	s := e.typeSuffix()
+	if e.isAVX() {
+		return fmt.Sprintf("%s(%s, %s)", e.avxOp("div"), a, b)
+	}
 	if e.isSVE() {
 		return fmt.Sprintf("svdiv_%s_x(pg, %s, %s)", s, a, b)
 	}
+	if e.isRVV() {
+		return fmt.Sprintf("%s(%s, %s, vl)", e.rvvOp("vfdiv_vv"), a, b)
+	}
 	return fmt.Sprintf("vdivq_%s(%s, %s)", s, a, b)
 }
 
 func (e *CEmitter) fmtMin(a, b string) string {
 	s := e.typeSuffix()
+	if e.isAVX() {
+		return fmt.Sprintf("%s(%s, %s)", e.avxOp("min"), a, b)
+	}
 	if e.isSVE() {
 		return fmt.Sprintf("svmin_%s_x(pg, %s, %s)", s, a, b)
 	}
+	if e.isRVV() {
+		return fmt.Sprintf("%s(%s, %s, vl)", e.rvvOp("vfmin_vv"), a, b)
+	}
 	return fmt.Sprintf("vminq_%s(%s, %s)", s, a, b)
 }
 
 func (e *CEmitter) fmtMax(a, b string) string {
 	s := e.typeSuffix()
+	if e.isAVX() {
+		return fmt.Sprintf("%s(%s, %s)", e.avxOp("max"), a, b)
+	}
 	if e.isSVE() {
 		return fmt.Sprintf("svmax_%s_x(pg, %s, %s)", s, a, b)
 	}
+	if e.isRVV() {
+		return fmt.Sprintf("%s(%s, %s, vl)", e.rvvOp("vfmax_vv"), a, b)
+	}
 	return fmt.Sprintf("vmaxq_%s(%s, %s)", s, a, b)
 }
 
@@ -115,11 +252,19 @@ func (e *CEmitter) fmtMax(a, b string) string {
 //
 //	NEON: vfmaq_f32(acc, a, b)
 //	SVE:  svmla_f32_x(pg, acc, a, b)
+//	AVX:  _mm256_fmadd_ps(a, b, acc)
+//	RVV:  __riscv_vfmacc_vv_f32m1(acc, a, b, vl)
 func (e *CEmitter) fmtFma(acc, a, b string) string {
 	s := e.typeSuffix()
+	if e.isAVX() {
+		return fmt.Sprintf("%s(%s, %s, %s)", e.avxOp("fmadd"), a, b, acc)
+	}
 	if e.isSVE() {
 		return fmt.Sprintf("svmla_%s_x(pg, %s, %s, %s)", s, acc, a, b)
 	}
+	if e.isRVV() {
+		return fmt.Sprintf("%s(%s, %s, %s, vl)", e.rvvOp("vfmacc_vv"), acc, a, b)
+	}
 	return fmt.Sprintf("vfmaq_%s(%s, %s, %s)", s, acc, a, b)
 }
 
@@ -127,11 +272,19 @@ func (e *CEmitter) fmtFma(acc, a, b string) string {
 //
 //	NEON: vfmsq_f32(a, b, c)
 //	SVE:  svmls_f32_x(pg, a, b, c)
+//	AVX:  _mm256_fnmadd_ps(b, c, a)
+//	RVV:  __riscv_vfnmsac_vv_f32m1(a, b, c, vl)
 func (e *CEmitter) fmtFms(a, b, c string) string {
 	s := e.typeSuffix()
+	if e.isAVX() {
+		return fmt.Sprintf("%s(%s, %s, %s)", e.avxOp("fnmadd"), b, c, a)
+	}
 	if e.isSVE() {
 		return fmt.Sprintf("svmls_%s_x(pg, %s, %s, %s)", s, a, b, c)
 	}
+	if e.isRVV() {
+		return fmt.Sprintf("%s(%s, %s, %s, vl)", e.rvvOp("vfnmsac_vv"), a, b, c)
+	}
 	return fmt.Sprintf("vfmsq_%s(%s, %s, %s)", s, a, b, c)
 }
 
@@ -141,25 +294,51 @@ func (e *CEmitter) fmtFms(a, b, c string) string {
 
 func (e *CEmitter) fmtNeg(x string) string {
 	s := e.typeSuffix()
+	if e.isAVX() {
+		return fmt.Sprintf("%s(%s, %s)", e.avxOp("xor"), x, e.avxSignZero())
+	}
 	if e.isSVE() {
 		return fmt.Sprintf("svneg_%s_x(pg, %s)", s, x)
 	}
+	if e.isRVV() {
+		return fmt.Sprintf("%s(%s, vl)", e.rvvOp("vfneg_v"), x)
+	}
 	return fmt.Sprintf("vnegq_%s(%s)", s, x)
 }
 
 func (e *CEmitter) fmtAbs(x string) string {
 	s := e.typeSuffix()
+	if e.isAVX512() {
+		return fmt.Sprintf("%s(%s)", e.avxOp("abs"), x)
+	}
+	if e.isAVX() {
+		return fmt.Sprintf("%s(%s, %s)", e.avxOp("andnot"), e.avxSignZero(), x)
+	}
 	if e.isSVE() {
 		return fmt.Sprintf("svabs_%s_x(pg, %s)", s, x)
 	}
+	if e.isRVV() {
+		return fmt.Sprintf("%s(%s, vl)", e.rvvOp("vfabs_v"), x)
+	}
 	return fmt.Sprintf("vabsq_%s(%s)", s, x)
 }
 
 func (e *CEmitter) fmtRound(x string) string {
 	s := e.typeSuffix()
+	if e.isAVX512() {
+		return fmt.Sprintf("%s(%s, _MM_FROUND_TO_NEAREST_INT | _MM_FROUND_NO_EXC)", e.avxOp("roundscale"), x)
+	}
+	if e.isAVX() {
+		return fmt.Sprintf("%s(%s, _MM_FROUND_TO_NEAREST_INT | _MM_FROUND_NO_EXC)", e.avxOp("round"), x)
+	}
 	if e.isSVE() {
 		return fmt.Sprintf("svrintn_%s_x(pg, %s)", s, x)
 	}
+	if e.isRVV() {
+		// Round trip through integers using the default round-to-nearest-even
+		// rounding mode; exp arguments are well within the integer range.
+		return fmt.Sprintf("%s(%s, vl)", e.rvvOp("vfcvt_f_x_v"), e.fmtCvtFloatToInt(x))
+	}
 	return fmt.Sprintf("vrndnq_%s(%s)", s, x)
 }
 
@@ -167,19 +346,40 @@ func (e *CEmitter) fmtRound(x string) string {
 // Comparison operations (predicated for SVE, return mask type).
 // ---------------------------------------------------------------------------
 
+// fmtAVXCmp formats an ordered, non-signalling x86 comparison. AVX-512
+// returns a __mmask; AVX2 returns an all-ones/all-zeros vector.
+func (e *CEmitter) fmtAVXCmp(a, b, pred string) string {
+	if e.isAVX512() {
+		return fmt.Sprintf("%s_mask(%s, %s, %s)", e.avxOp("cmp"), a, b, pred)
+	}
+	return fmt.Sprintf("%s(%s, %s, %s)", e.avxOp("cmp"), a, b, pred)
+}
+
 func (e *CEmitter) fmtCmpGt(a, b string) string {
 	s := e.typeSuffix()
+	if e.isAVX() {
+		return e.fmtAVXCmp(a, b, "_CMP_GT_OQ")
+	}
 	if e.isSVE() {
 		return fmt.Sprintf("svcmpgt_%s(pg, %s, %s)", s, a, b)
 	}
+	if e.isRVV() {
+		return fmt.Sprintf("%s_b%s(%s, %s, vl)", e.rvvOp("vmfgt_vv"), e.rvvSEW(), a, b)
+	}
 	return fmt.Sprintf("vcgtq_%s(%s, %s)", s, a, b)
 }
 
 func (e *CEmitter) fmtCmpLt(a, b string) string {
 	s := e.typeSuffix()
+	if e.isAVX() {
+		return e.fmtAVXCmp(a, b, "_CMP_LT_OQ")
+	}
 	if e.isSVE() {
 		return fmt.Sprintf("svcmplt_%s(pg, %s, %s)", s, a, b)
 	}
+	if e.isRVV() {
+		return fmt.Sprintf("%s_b%s(%s, %s, vl)", e.rvvOp("vmflt_vv"), e.rvvSEW(), a, b)
+	}
 	return fmt.Sprintf("vcltq_%s(%s, %s)", s, a, b)
 }
 
@@ -191,11 +391,23 @@ func (e *CEmitter) fmtCmpLt(a, b string) string {
 //
 //	NEON: vbslq_f32(mask, a, b)
 //	SVE:  svsel_f32(mask, a, b)
+//	AVX2: _mm256_blendv_ps(b, a, mask)
+//	AVX-512: _mm512_mask_blend_ps(mask, b, a)
+//	RVV:  __riscv_vmerge_vvm_f32m1(b, a, mask, vl)
 func (e *CEmitter) fmtSel(mask, a, b string) string {
 	s := e.typeSuffix()
+	if e.isAVX512() {
+		return fmt.Sprintf("%s(%s, %s, %s)", e.avxOp("mask_blend"), mask, b, a)
+	}
+	if e.isAVX() {
+		return fmt.Sprintf("%s(%s, %s, %s)", e.avxOp("blendv"), b, a, mask)
+	}
 	if e.isSVE() {
 		return fmt.Sprintf("svsel_%s(%s, %s, %s)", s, mask, a, b)
 	}
+	if e.isRVV() {
+		return fmt.Sprintf("%s(%s, %s, %s, vl)", e.rvvOp("vmerge_vvm"), b, a, mask)
+	}
 	return fmt.Sprintf("vbslq_%s(%s, %s, %s)", s, mask, a, b)
 }
 
@@ -208,12 +420,29 @@ func (e *CEmitter) fmtSel(mask, a, b string) string {
 //	NEON f32: vcvtnq_s32_f32(x) (round to nearest)
 //	NEON f64: vcvtq_s64_f64(x)  (truncation; input pre-rounded)
 //	SVE:      svcvt_sXX_fXX_x(pg, x)
+//	AVX f32:  _mm256_cvtps_epi32(x) (round to nearest)
+//	AVX2 f64: no cvtpd_epi64 before AVX-512DQ; adding 1.5*2^52 moves the
+//	          (pre-rounded) integer into the low mantissa bits
+//	AVX-512 f64: _mm512_cvtpd_epi64(x)
+//	RVV:      __riscv_vfcvt_x_f_v_i32m1(x, vl) (dynamic rounding mode, RNE)
 func (e *CEmitter) fmtCvtFloatToInt(x string) string {
 	fs := e.typeSuffix()
 	is := e.intSuffix()
+	if e.isAVX() {
+		if e.elemType == "float32" {
+			return fmt.Sprintf("%s(%s)", e.avxWidth()+"_cvtps_epi32", x)
+		}
+		if e.isAVX512() {
+			return fmt.Sprintf("_mm512_cvtpd_epi64(%s)", x)
+		}
+		return fmt.Sprintf("_mm256_sub_epi64(_mm256_castpd_si256(_mm256_add_pd(%s, _mm256_set1_pd(6755399441055744.0))), _mm256_castpd_si256(_mm256_set1_pd(6755399441055744.0)))", x)
+	}
 	if e.isSVE() {
 		return fmt.Sprintf("svcvt_%s_%s_x(pg, %s)", is, fs, x)
 	}
+	if e.isRVV() {
+		return fmt.Sprintf("%s(%s, vl)", e.rvvIntOp("vfcvt_x_f_v"), x)
+	}
 	if e.elemType == "float32" {
 		return fmt.Sprintf("vcvtnq_%s_%s(%s)", is, fs, x)
 	}
@@ -224,11 +453,19 @@ func (e *CEmitter) fmtCvtFloatToInt(x string) string {
 //
 //	NEON: vshlq_n_s32(x, n)
 //	SVE:  svlsl_n_s32_x(pg, x, n)
+//	AVX:  _mm256_slli_epi32(x, n)
+//	RVV:  __riscv_vsll_vx_i32m1(x, n, vl)
 func (e *CEmitter) fmtIntShl(x, n string) string {
 	is := e.intSuffix()
+	if e.isAVX() {
+		return fmt.Sprintf("%s(%s, %s)", e.avxIntOp("slli"), x, n)
+	}
 	if e.isSVE() {
 		return fmt.Sprintf("svlsl_n_%s_x(pg, %s, %s)", is, x, n)
 	}
+	if e.isRVV() {
+		return fmt.Sprintf("%s(%s, %s, vl)", e.rvvIntOp("vsll_vx"), x, n)
+	}
 	return fmt.Sprintf("vshlq_n_%s(%s, %s)", is, x, n)
 }
 
@@ -236,11 +473,19 @@ func (e *CEmitter) fmtIntShl(x, n string) string {
 //
 //	NEON: vaddq_s32(a, b)
 //	SVE:  svadd_s32_x(pg, a, b)
+//	AVX:  _mm256_add_epi32(a, b)
+//	RVV:  __riscv_vadd_vv_i32m1(a, b, vl)
 func (e *CEmitter) fmtIntAdd(a, b string) string {
 	is := e.intSuffix()
+	if e.isAVX() {
+		return fmt.Sprintf("%s(%s, %s)", e.avxIntOp("add"), a, b)
+	}
 	if e.isSVE() {
 		return fmt.Sprintf("svadd_%s_x(pg, %s, %s)", is, a, b)
 	}
+	if e.isRVV() {
+		return fmt.Sprintf("%s(%s, %s, vl)", e.rvvIntOp("vadd_vv"), a, b)
+	}
 	return fmt.Sprintf("vaddq_%s(%s, %s)", is, a, b)
 }
 
@@ -252,15 +497,41 @@ func (e *CEmitter) fmtIntAdd(a, b string) string {
 //
 //	NEON: vreinterpretq_f32_s32(x)
 //	SVE:  svreinterpret_f32_s32(x)
+//	AVX:  _mm256_castsi256_ps(x)
+//	RVV:  __riscv_vreinterpret_v_i32m1_f32m1(x)
 func (e *CEmitter) fmtReinterpretFloatFromInt(x string) string {
 	fs := e.typeSuffix()
 	is := e.intSuffix()
+	if e.isAVX() {
+		return fmt.Sprintf("%s(%s)", e.avxCast(), x)
+	}
 	if e.isSVE() {
 		return fmt.Sprintf("svreinterpret_%s_%s(%s)", fs, is, x)
 	}
+	if e.isRVV() {
+		return fmt.Sprintf("__riscv_vreinterpret_v_i%sm1_%sm1(%s)", e.rvvSEW(), fs, x)
+	}
 	return fmt.Sprintf("vreinterpretq_%s_%s(%s)", fs, is, x)
 }
 
+// fmtLane0 extracts the first lane of a float vector as a scalar.
+//
+//	NEON: vgetq_lane_f32(x, 0)
+//	AVX:  _mm256_cvtss_f32(x)
+//	RVV:  __riscv_vfmv_f_s_f32m1_f32(x)
+func (e *CEmitter) fmtLane0(x string) string {
+	if e.isRVV() {
+		return fmt.Sprintf("%s_%s(%s)", e.rvvOp("vfmv_f_s"), e.typeSuffix(), x)
+	}
+	if e.isAVX() {
+		if e.elemType == "float32" {
+			return fmt.Sprintf("%s_cvtss_f32(%s)", e.avxWidth(), x)
+		}
+		return fmt.Sprintf("%s_cvtsd_f64(%s)", e.avxWidth(), x)
+	}
+	return fmt.Sprintf("%s(%s, 0)", e.getLaneIntrinsic(), x)
+}
+
 // ---------------------------------------------------------------------------
 // Constant emission helpers.
 // ---------------------------------------------------------------------------
@@ -269,12 +540,20 @@ func (e *CEmitter) fmtReinterpretFloatFromInt(x string) string {
 //
 //	NEON: vreinterpretq_f32_s32(vdupq_n_s32(0xHEX))
 //	SVE:  svreinterpret_f32_s32(svdup_s32(0xHEX))
+//	AVX:  _mm256_castsi256_ps(_mm256_set1_epi32(0xHEX))
+//	RVV:  __riscv_vreinterpret_v_i32m1_f32m1(__riscv_vmv_v_x_i32m1(0xHEX, vl))
 func (e *CEmitter) fmtConstHex(hexVal string) string {
 	fs := e.typeSuffix()
 	is := e.intSuffix()
+	if e.isAVX() {
+		return fmt.Sprintf("%s(%s)", e.avxCast(), e.fmtConstInt(hexVal))
+	}
 	if e.isSVE() {
 		return fmt.Sprintf("svreinterpret_%s_%s(svdup_%s(%s))", fs, is, is, hexVal)
 	}
+	if e.isRVV() {
+		return e.fmtReinterpretFloatFromInt(e.fmtConstInt(hexVal))
+	}
 	return fmt.Sprintf("vreinterpretq_%s_%s(vdupq_n_%s(%s))", fs, is, is, hexVal)
 }
 
@@ -282,11 +561,19 @@ func (e *CEmitter) fmtConstHex(hexVal string) string {
 //
 //	NEON: vdupq_n_f32(val)
 //	SVE:  svdup_f32(val)
+//	AVX:  _mm256_set1_ps(val)
+//	RVV:  __riscv_vfmv_v_f_f32m1(val, vl)
 func (e *CEmitter) fmtConstFloat(val string) string {
 	s := e.typeSuffix()
+	if e.isAVX() {
+		return fmt.Sprintf("%s(%s)", e.avxOp("set1"), val)
+	}
 	if e.isSVE() {
 		return fmt.Sprintf("svdup_%s(%s)", s, val)
 	}
+	if e.isRVV() {
+		return fmt.Sprintf("%s(%s, vl)", e.rvvOp("vfmv_v_f"), val)
+	}
 	return fmt.Sprintf("vdupq_n_%s(%s)", s, val)
 }
 
@@ -294,10 +581,21 @@ func (e *CEmitter) fmtConstFloat(val string) string {
 //
 //	NEON: vdupq_n_s32(val)
 //	SVE:  svdup_s32(val)
+//	AVX:  _mm256_set1_epi32(val), _mm256_set1_epi64x(val) or _mm512_set1_epi64(val)
+//	RVV:  __riscv_vmv_v_x_i32m1(val, vl)
 func (e *CEmitter) fmtConstInt(val string) string {
 	is := e.intSuffix()
+	if e.isAVX() {
+		if e.elemType == "float64" && !e.isAVX512() {
+			return fmt.Sprintf("_mm256_set1_epi64x(%s)", val)
+		}
+		return fmt.Sprintf("%s(%s)", e.avxIntOp("set1"), val)
+	}
 	if e.isSVE() {
 		return fmt.Sprintf("svdup_%s(%s)", is, val)
 	}
+	if e.isRVV() {
+		return fmt.Sprintf("%s(%s, vl)", e.rvvIntOp("vmv_v_x"), val)
+	}
 	return fmt.Sprintf("vdupq_n_%s(%s)", is, val)
 }
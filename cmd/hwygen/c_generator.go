@@ -82,7 +82,7 @@ func (g *Generator) runCModeInternal(result *ParseResult, targets []Target, asmM
 			astFuncs = append(astFuncs, pf)
 		} else if IsCEligible(&pf) {
 			vecFuncs = append(vecFuncs, pf)
-		} else if IsSliceFunction(&pf) {
+		} else if IsSliceFunction(&pf) || (g.FusionMode && isKernelChain(&pf, result.Imports)) {
 			sliceFuncs = append(sliceFuncs, pf)
 		}
 	}
@@ -131,6 +131,15 @@ func (g *Generator) runCModeInternal(result *ParseResult, targets []Target, asmM
 
 		// Track generated C files for GOAT compilation
 		var cFiles []string
+		goAsmFuncs := 0
+
+		// The SME target only generates the matmul-shaped functions; the
+		// rest keep their NEON/SVE implementations and must not get
+		// wrappers or dispatch overrides for it.
+		vecFuncs, sliceFuncs, astFuncs := vecFuncs, sliceFuncs, astFuncs
+		if target.Name == "SME" {
+			vecFuncs, sliceFuncs, astFuncs = nil, nil, smeMatMulFuncs(astFuncs)
+		}
 
 		// Generate C code for Vec→Vec functions
 		for _, pf := range vecFuncs {
@@ -181,9 +190,28 @@ func (g *Generator) runCModeInternal(result *ParseResult, targets []Target, asmM
 				if profile.MathStrategy == "promoted" && !profile.NativeArithmetic {
 					continue
 				}
+				if asmMode && g.usesGoAsm(target) {
+					ok, err := g.emitGoAsm(&pf, elemType, target, cOutputDir)
+					if err != nil {
+						return nil, fmt.Errorf("emit Go assembly for %s (%s, %s): %w", pf.Name, elemType, target.Name, err)
+					}
+					if ok {
+						goAsmFuncs++
+						continue
+					}
+				}
 				emitter := NewCEmitter(g.PackageOut, elemType, target)
 				emitter.profile = profile
 				emitter.packageGlobals = result.PackageGlobals
+				emitter.helpers = result.AllFuncs
+				if target.Name == "SME" {
+					cFile, err := emitter.EmitSMEMatMulC(&pf, cOutputDir)
+					if err != nil {
+						return nil, fmt.Errorf("emit SME C for %s (%s): %w", pf.Name, elemType, err)
+					}
+					cFiles = append(cFiles, cFile)
+					continue
+				}
 				cFile, err := emitter.EmitASTTranslatedC(&pf, cOutputDir)
 				if err != nil {
 					return nil, fmt.Errorf("emit AST C for %s (%s, %s): %w", pf.Name, elemType, target.Name, err)
@@ -192,9 +220,10 @@ func (g *Generator) runCModeInternal(result *ParseResult, targets []Target, asmM
 			}
 		}
 
-		if len(cFiles) == 0 {
+		if len(cFiles) == 0 && goAsmFuncs == 0 {
 			continue
 		}
+		g.recordGolden(target, cFiles...)
 
 		// If asm mode, compile C files with GOAT and generate wrappers
 		if asmMode {
@@ -209,6 +238,7 @@ func (g *Generator) runCModeInternal(result *ParseResult, targets []Target, asmM
 					continue
 				}
 				compiledFiles = append(compiledFiles, cFile)
+				g.recordGolden(target, strings.TrimSuffix(cFile, ".c")+".s")
 				fmt.Printf("  Compiled: %s\n", filepath.Base(cFile))
 			}
 			cFiles = compiledFiles
@@ -310,6 +340,9 @@ func (g *Generator) runFusionCMode(result *ParseResult, sliceFuncs []ParsedFunc,
 
 	// Create function registry for cross-package resolution
 	registry := NewFunctionRegistry(moduleRoot, moduleName)
+	registry.AddImports(result.Imports)
+
+	var fusionReport strings.Builder
 
 	for _, target := range targets {
 		fmt.Printf("\nGenerating C for target: %s (with fusion)\n", target.Name)
@@ -364,19 +397,24 @@ func (g *Generator) runFusionCMode(result *ParseResult, sliceFuncs []ParsedFunc,
 					return fmt.Errorf("build IR for %s: %w", pf.Name, err)
 				}
 
-				// Run data flow analysis
-				ir.Analyze(irFunc)
-
-				// Apply fusion rules
-				ir.ApplyFusionRules(irFunc)
+				// Inline cross-package calls, merge the loop chains they
+				// form, and apply the fusion rules
+				report := ir.FuseAcrossCalls(irFunc, registry)
 
 				// Print fusion statistics if verbose
 				if g.Verbose {
-					stats := ir.ComputeFusionStats(irFunc)
+					stats := report.Stats
 					fmt.Printf("  %s: %d→%d passes, %d allocs eliminated, %d fusion groups\n",
 						pf.Name, stats.OriginalPasses, stats.FusedPasses,
 						stats.EliminatedAllocs, stats.FusionGroups)
 				}
+				if g.FusionReport != "" {
+					fmt.Fprintf(&fusionReport, "[%s] %s", target.Name, report)
+					if g.Verbose {
+						fusionReport.WriteString(irFunc.Dump())
+					}
+					fusionReport.WriteString("\n")
+				}
 
 				// Create profile adapter for IR emitter
 				profileAdapter := newCProfileAdapter(profile)
@@ -404,6 +442,7 @@ func (g *Generator) runFusionCMode(result *ParseResult, sliceFuncs []ParsedFunc,
 		if len(cFiles) == 0 {
 			continue
 		}
+		g.recordGolden(target, cFiles...)
 
 		if g.AsmMode() {
 			fmt.Printf("Compiling %d C files with GOAT...\n", len(cFiles))
@@ -417,6 +456,7 @@ func (g *Generator) runFusionCMode(result *ParseResult, sliceFuncs []ParsedFunc,
 					continue
 				}
 				compiledFiles = append(compiledFiles, cFile)
+				g.recordGolden(target, strings.TrimSuffix(cFile, ".c")+".s")
 				fmt.Printf("  Compiled: %s\n", filepath.Base(cFile))
 			}
 			cFiles = compiledFiles
@@ -436,6 +476,17 @@ func (g *Generator) runFusionCMode(result *ParseResult, sliceFuncs []ParsedFunc,
 		}
 	}
 
+	if g.FusionReport == "" {
+		return nil
+	}
+	if g.FusionReport == "-" {
+		fmt.Printf("\nFusion report:\n%s", fusionReport.String())
+		return nil
+	}
+	if err := os.WriteFile(g.FusionReport, []byte(fusionReport.String()), 0o644); err != nil {
+		return fmt.Errorf("write fusion report: %w", err)
+	}
+	fmt.Printf("Wrote fusion report: %s\n", g.FusionReport)
 	return nil
 }
 
@@ -615,6 +666,37 @@ func IsSliceFunction(pf *ParsedFunc) bool {
 	return mathOpFromFuncName(pf.Name) != ""
 }
 
+// isKernelChain reports whether pf is a slice function that calls Base*
+// kernels from other go-highway packages, e.g. a dequantize followed by an
+// activation. It has no hwy operations of its own, but -fusion can inline
+// the kernels it calls and fuse their loops.
+func isKernelChain(pf *ParsedFunc, imports map[string]string) bool {
+	if pf.Body == nil || !slices.ContainsFunc(pf.Params, func(p Param) bool {
+		return strings.HasPrefix(p.Type, "[]")
+	}) {
+		return false
+	}
+	found := false
+	ast.Inspect(pf.Body, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok || found {
+			return !found
+		}
+		fun := call.Fun
+		if idx, ok := fun.(*ast.IndexExpr); ok {
+			fun = idx.X
+		}
+		if sel, ok := fun.(*ast.SelectorExpr); ok {
+			if pkg, ok := sel.X.(*ast.Ident); ok && hasBasePrefix(sel.Sel.Name) &&
+				strings.Contains(imports[pkg.Name], "/hwy/") {
+				found = true
+			}
+		}
+		return !found
+	})
+	return found
+}
+
 // getCProfileForFile determines the CIntrinsicProfile for a generated C file
 // based on its filename convention.
 func getCProfileForFile(cFile string, target Target) *CIntrinsicProfile {
@@ -746,6 +828,7 @@ func runGOAT(cFile string, profile *CIntrinsicProfile) error {
 func crossObjdumpPath(targetArch string) string {
 	prefixes := map[string]string{
 		"arm64":   "aarch64-linux-gnu-objdump",
+		"amd64":   "x86_64-linux-gnu-objdump",
 		"riscv64": "riscv64-linux-gnu-objdump",
 	}
 	name, ok := prefixes[targetArch]
@@ -852,18 +935,14 @@ func (g *Generator) emitCWrappers(funcs []ParsedFunc, target Target, outputDir s
 	pkgName := goatPackageName(outputDir)
 
 	// Determine build tag and file suffix based on target
-	buildTag := target.BuildTag
-	if buildTag == "" {
-		buildTag = "!noasm"
-	} else {
-		buildTag = "!noasm && " + buildTag
-	}
+	buildTag := asmBuildTag(target)
 	archSuffix := target.Arch()
 	targetSuffix := strings.ToLower(target.Name)
 
 	// Check if we need the hwy import (for f16/bf16 AST-translated functions
-	// that actually have native math — promoted math types are skipped)
-	needsHwy := false
+	// that actually have native math — promoted math types are skipped, and
+	// for the SME wrappers' hwy.SMEGuard)
+	needsHwy := target.Name == "SME"
 	for _, pf := range funcs {
 		if IsASTCEligible(&pf) {
 			for _, et := range getCElemTypes(&pf) {
@@ -943,12 +1022,7 @@ func (g *Generator) emitStructAsmPassthrough(funcs []ParsedFunc, target Target,
 
 	pkgName := goatPackageName(asmDir)
 
-	buildTag := target.BuildTag
-	if buildTag == "" {
-		buildTag = "!noasm"
-	} else {
-		buildTag = "!noasm && " + buildTag
-	}
+	buildTag := asmBuildTag(target)
 	archSuffix := target.Arch()
 	targetSuffix := strings.ToLower(target.Name)
 
@@ -999,9 +1073,21 @@ func (g *Generator) emitStructAsmPassthrough(funcs []ParsedFunc, target Target,
 	return nil
 }
 
-// isSVETarget returns true if the target is an SVE target (SVE_DARWIN or SVE_LINUX).
+// isSVETarget returns true if the target is an SVE target (SVE_DARWIN,
+// SVE_LINUX or SVE2_LINUX).
 func isSVETarget(target Target) bool {
-	return target.Name == "SVE_DARWIN" || target.Name == "SVE_LINUX"
+	switch target.Name {
+	case "SVE_DARWIN", "SVE_LINUX", "SVE2_LINUX":
+		return true
+	}
+	return false
+}
+
+// isAsmOnlyTarget returns true for targets without a Go SIMD OpMap (SVE, RVV
+// and SME). They are only generated in C/asm mode, and their dispatch is
+// handled entirely by the z_c_*.gen.go init() functions.
+func isAsmOnlyTarget(target Target) bool {
+	return isSVETarget(target) || target.Name == "RVV" || target.Name == "SME"
 }
 
 // isSVEStreamingTarget returns true for SVE targets that require SME streaming
@@ -1013,19 +1099,61 @@ func isSVEStreamingTarget(target Target) bool {
 	return target.Name == "SVE_DARWIN"
 }
 
-// sveRuntimeGuard returns the runtime detection function call for SVE targets.
-// SVE_DARWIN uses hwy.HasSME(), SVE_LINUX uses hwy.HasSVE().
-func sveRuntimeGuard(target Target) string {
+// asmBuildTag returns the build constraint for files generated from GOAT
+// assembly. The x86 targets' BuildTag requires goexperiment.simd because the
+// Go SIMD code uses archsimd; the assembly does not, so it is built for
+// every amd64 toolchain.
+func asmBuildTag(target Target) string {
 	switch target.Name {
-	case "SVE_DARWIN":
+	case "AVX2", "AVX512":
+		return "!noasm && amd64"
+	}
+	if target.BuildTag == "" {
+		return "!noasm"
+	}
+	return "!noasm && " + target.BuildTag
+}
+
+// asmRuntimeGuard returns the runtime detection function call that gates the
+// dispatch overrides of an assembly target. SVE_DARWIN and SME use hwy.HasSME(),
+// SVE_LINUX uses hwy.HasSVE(), SVE2_LINUX uses hwy.HasSVE2(), AVX2 and
+// AVX512 use hwy.HasAVX2() and hwy.HasAVX512(), and RVV uses hwy.HasRVV().
+// NEON needs no guard.
+func asmRuntimeGuard(target Target) string {
+	switch target.Name {
+	case "SVE_DARWIN", "SME":
 		return "hwy.HasSME()"
 	case "SVE_LINUX":
 		return "hwy.HasSVE()"
+	case "SVE2_LINUX":
+		return "hwy.HasSVE2()"
+	case "AVX2":
+		return "hwy.HasAVX2()"
+	case "AVX512":
+		return "hwy.HasAVX512()"
+	case "RVV":
+		return "hwy.HasRVV()"
 	default:
 		return ""
 	}
 }
 
+// sveSkipGuard returns a guard expression for SVE_LINUX that makes its init()
+// leave dispatch to SVE2_LINUX when both are generated and SVE2 is present.
+// Both files override the same dispatch variables, and z_c_*_sve2_linux sorts
+// before z_c_*_sve_linux, so without the guard baseline SVE would win.
+func (g *Generator) sveSkipGuard(target Target) string {
+	if target.Name != "SVE_LINUX" {
+		return ""
+	}
+	for _, ts := range g.TargetSpecs {
+		if ts.Target.Name == "SVE2_LINUX" && ts.Mode != TargetModeGoSimd {
+			return "hwy.HasSVE2()"
+		}
+	}
+	return ""
+}
+
 // neonSMESkipGuard returns a guard expression for NEON asm targets that
 // causes the init() to skip dispatch overrides when SME is available.
 // SME dispatch (in *_sme.go files) provides better implementations;
@@ -1045,12 +1173,7 @@ func neonSMESkipGuard(target Target) string {
 func (g *Generator) emitZCDispatch(funcs []ParsedFunc, target Target) error {
 	var buf bytes.Buffer
 
-	buildTag := target.BuildTag
-	if buildTag == "" {
-		buildTag = "!noasm"
-	} else {
-		buildTag = "!noasm && " + buildTag
-	}
+	buildTag := asmBuildTag(target)
 	archSuffix := target.Arch()
 	targetSuffix := strings.ToLower(target.Name)
 
@@ -1062,8 +1185,9 @@ func (g *Generator) emitZCDispatch(funcs []ParsedFunc, target Target) error {
 
 	// Determine if hwy import is needed. SVE streaming targets (Darwin) skip
 	// init() generation, so only need hwy for half-precision types. Non-streaming
-	// SVE targets (Linux) generate init() with a runtime guard (hwy.HasSVE()).
-	needsHwy := isSVETarget(target) && !isSVEStreamingTarget(target)
+	// SVE targets (Linux) and the x86 targets generate init() with a runtime
+	// guard (hwy.HasSVE(), hwy.HasAVX2(), ...).
+	needsHwy := asmRuntimeGuard(target) != "" && !isSVEStreamingTarget(target)
 	// NEON asm targets need hwy for the SME skip guard (hwy.HasSME()).
 	if !needsHwy && neonSMESkipGuard(target) != "" {
 		needsHwy = true
@@ -1106,16 +1230,18 @@ func (g *Generator) emitZCDispatch(funcs []ParsedFunc, target Target) error {
 	// Non-streaming SVE targets (Linux) override dispatch normally.
 	if !isSVEStreamingTarget(target) {
 		fmt.Fprintf(&buf, "func init() {\n")
-		guard := sveRuntimeGuard(target)
+		guard := asmRuntimeGuard(target)
 		if guard != "" {
 			fmt.Fprintf(&buf, "\tif !%s {\n", guard)
 			fmt.Fprintf(&buf, "\t\treturn\n")
 			fmt.Fprintf(&buf, "\t}\n")
 		}
-		if skipGuard := neonSMESkipGuard(target); skipGuard != "" {
-			fmt.Fprintf(&buf, "\tif %s {\n", skipGuard)
-			fmt.Fprintf(&buf, "\t\treturn\n")
-			fmt.Fprintf(&buf, "\t}\n")
+		for _, skipGuard := range []string{neonSMESkipGuard(target), g.sveSkipGuard(target)} {
+			if skipGuard != "" {
+				fmt.Fprintf(&buf, "\tif %s {\n", skipGuard)
+				fmt.Fprintf(&buf, "\t\treturn\n")
+				fmt.Fprintf(&buf, "\t}\n")
+			}
 		}
 		for _, pf := range funcs {
 			for _, elemType := range getCElemTypes(&pf) {
@@ -1169,12 +1295,7 @@ func (g *Generator) emitSliceAsmPassthrough(funcs []ParsedFunc, target Target, a
 
 	pkgName := goatPackageName(asmDir)
 
-	buildTag := target.BuildTag
-	if buildTag == "" {
-		buildTag = "!noasm"
-	} else {
-		buildTag = "!noasm && " + buildTag
-	}
+	buildTag := asmBuildTag(target)
 	archSuffix := target.Arch()
 	targetSuffix := strings.ToLower(target.Name)
 
@@ -1182,7 +1303,11 @@ func (g *Generator) emitSliceAsmPassthrough(funcs []ParsedFunc, target Target, a
 	fmt.Fprintf(&buf, "//go:build %s\n", buildTag)
 	fmt.Fprintf(&buf, "// Code generated by hwygen -c. DO NOT EDIT.\n\n")
 	fmt.Fprintf(&buf, "package %s\n\n", pkgName)
-	fmt.Fprintf(&buf, "import \"unsafe\"\n\n")
+	if target.Name == "SME" {
+		fmt.Fprintf(&buf, "import (\n\t\"unsafe\"\n\n\t\"github.com/ajroetker/go-highway/hwy\"\n)\n\n")
+	} else {
+		fmt.Fprintf(&buf, "import \"unsafe\"\n\n")
+	}
 
 	for _, pf := range funcs {
 		elemTypes := getCElemTypes(&pf)
@@ -1253,6 +1378,9 @@ func (g *Generator) emitSliceAsmPassthrough(funcs []ParsedFunc, target Target, a
 				exportedName, strings.ToUpper(target.Name))
 			fmt.Fprintf(&buf, "func %s(%s) {\n",
 				exportedName, strings.Join(paramDefs, ", "))
+			if target.Name == "SME" {
+				fmt.Fprintf(&buf, "\tdefer hwy.SMEGuard()()\n")
+			}
 			fmt.Fprintf(&buf, "\t%s(%s)\n", asmName, strings.Join(paramNames, ", "))
 			fmt.Fprintf(&buf, "}\n\n")
 		}
@@ -1294,12 +1422,7 @@ func slicePassthroughScalarType(elemType string) string {
 func (g *Generator) emitZCDispatchForSlices(funcs []ParsedFunc, target Target) error {
 	var buf bytes.Buffer
 
-	buildTag := target.BuildTag
-	if buildTag == "" {
-		buildTag = "!noasm"
-	} else {
-		buildTag = "!noasm && " + buildTag
-	}
+	buildTag := asmBuildTag(target)
 	archSuffix := target.Arch()
 	targetSuffix := strings.ToLower(target.Name)
 
@@ -1311,8 +1434,9 @@ func (g *Generator) emitZCDispatchForSlices(funcs []ParsedFunc, target Target) e
 
 	// Determine if hwy import is needed. SVE streaming targets (Darwin) skip
 	// init() generation, so only need hwy for half-precision types. Non-streaming
-	// SVE targets (Linux) generate init() with a runtime guard (hwy.HasSVE()).
-	needsHwy := isSVETarget(target) && !isSVEStreamingTarget(target)
+	// SVE targets (Linux) and the x86 targets generate init() with a runtime
+	// guard (hwy.HasSVE(), hwy.HasAVX2(), ...).
+	needsHwy := asmRuntimeGuard(target) != "" && !isSVEStreamingTarget(target)
 	// NEON asm targets need hwy for the SME skip guard (hwy.HasSME()).
 	if !needsHwy && neonSMESkipGuard(target) != "" {
 		needsHwy = true
@@ -1355,16 +1479,18 @@ func (g *Generator) emitZCDispatchForSlices(funcs []ParsedFunc, target Target) e
 	// Non-streaming SVE targets (Linux) override dispatch normally.
 	if !isSVEStreamingTarget(target) {
 		fmt.Fprintf(&buf, "func init() {\n")
-		guard := sveRuntimeGuard(target)
+		guard := asmRuntimeGuard(target)
 		if guard != "" {
 			fmt.Fprintf(&buf, "\tif !%s {\n", guard)
 			fmt.Fprintf(&buf, "\t\treturn\n")
 			fmt.Fprintf(&buf, "\t}\n")
 		}
-		if skipGuard := neonSMESkipGuard(target); skipGuard != "" {
-			fmt.Fprintf(&buf, "\tif %s {\n", skipGuard)
-			fmt.Fprintf(&buf, "\t\treturn\n")
-			fmt.Fprintf(&buf, "\t}\n")
+		for _, skipGuard := range []string{neonSMESkipGuard(target), g.sveSkipGuard(target)} {
+			if skipGuard != "" {
+				fmt.Fprintf(&buf, "\tif %s {\n", skipGuard)
+				fmt.Fprintf(&buf, "\t\treturn\n")
+				fmt.Fprintf(&buf, "\t}\n")
+			}
 		}
 		for _, pf := range funcs {
 			for _, elemType := range getCElemTypes(&pf) {
@@ -1610,6 +1736,14 @@ func typeNameToDispatchSuffix(elemType string) string {
 		return "Int32"
 	case "int64":
 		return "Int64"
+	case "int8":
+		return "Int8"
+	case "int16":
+		return "Int16"
+	case "uint8":
+		return "Uint8"
+	case "uint16":
+		return "Uint16"
 	case "uint32":
 		return "Uint32"
 	case "uint64":
@@ -2048,6 +2182,12 @@ func emitASTCWrapperFunc(buf *bytes.Buffer, pf *ParsedFunc, elemType, targetSuff
 		}
 	}
 
+	// SME kernels accumulate in ZA, which async preemption signals do not
+	// preserve; pin the thread and block them for the duration of the call.
+	if targetSuffix == "sme" {
+		fmt.Fprintf(buf, "\tdefer hwy.SMEGuard()()\n")
+	}
+
 	// Call assembly function
 	fmt.Fprintf(buf, "\t%s(\n", asmName)
 
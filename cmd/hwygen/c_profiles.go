@@ -1,5 +1,7 @@
 package main
 
+import "fmt"
+
 // CIntrinsicProfile defines the complete set of C intrinsics and metadata
 // for a specific target architecture + element type combination.
 // The CEmitter uses these profiles to generate correct GOAT-compatible C code
@@ -47,6 +49,8 @@ type CIntrinsicProfile struct {
 	// Shuffle/Permute
 	InterleaveLowerFn  map[string]string // vzip1q_f32, _mm256_unpacklo_ps
 	InterleaveUpperFn  map[string]string // vzip2q_f32, _mm256_unpackhi_ps
+	ConcatEvenFn       map[string]string // vuzp1q_f32 (de-interleave even lanes)
+	ConcatOddFn        map[string]string // vuzp2q_f32 (de-interleave odd lanes)
 	TableLookupBytesFn map[string]string // vqtbl1q_u8, _mm256_shuffle_epi8
 
 	// Bitwise
@@ -84,6 +88,14 @@ type CIntrinsicProfile struct {
 	ReduceMinFn map[string]string // vminvq_f32
 	ReduceMaxFn map[string]string // vmaxvq_f32
 
+	// Gather/scatter and compress. Profiles without them lower GatherIndex,
+	// ScatterIndex and Compress to lane loops over stack buffers.
+	GatherIndexFn  map[string]string // svld1_gather_s32index_f32(pred, base, idx)
+	ScatterIndexFn map[string]string // svst1_scatter_s32index_f32(pred, base, idx, v)
+	IndexCastFn    map[string]string // svreinterpret_s32_f32: index vector to intrinsic type
+	CompressFn     map[string]string // svcompact_f32, _mm512_maskz_compress_ps(mask, v)
+	CountTrueFn    map[string]string // svcntp_b32(pg, mask), __builtin_popcount(mask)
+
 	// InlineHelpers contains C helper function source code that should be
 	// emitted at the top of generated C files (before main functions).
 	// Used for complex intrinsic sequences like NEON popcount chains.
@@ -138,6 +150,26 @@ type CIntrinsicProfile struct {
 	NeedsPredicate bool   // true for SVE — every intrinsic needs svbool_t pg
 	PredicateDecl  string // e.g. "svptrue_b32()" or "svptrue_b64()"
 
+	// Masked remainder support (AVX-512): when TailMaskFn is set, the
+	// CEmitter finishes elementwise loops with a single masked load/store
+	// instead of a scalar loop. TailMaskFn is a fmt template taking the
+	// remaining element count, e.g. "(__mmask16)((1u << (%s)) - 1)".
+	MaskedLoadFn  string // _mm512_maskz_loadu_ps(mask, ptr)
+	MaskedStoreFn string // _mm512_mask_storeu_ps(ptr, mask, v)
+	TailMaskFn    string
+
+	// WhileLtFn builds the loop predicate for vector-length-agnostic
+	// remainders, e.g. "svwhilelt_b32_s64". Empty means remainders use a
+	// scalar loop.
+	WhileLtFn string
+
+	// SetVLFn sets the active vector length for RVV, e.g.
+	// "__riscv_vsetvl_e32m1". RVV intrinsics take a trailing vl argument:
+	// the CEmitter keeps it in a "vl" variable that starts at VLMAX and is
+	// shortened for the final partial vector instead of running a scalar
+	// tail. Empty for all other targets.
+	SetVLFn string
+
 	// FuncAttrs is appended after the parameter list in C function signatures.
 	// Used for SVE streaming mode on darwin: "__arm_streaming".
 	FuncAttrs string
@@ -170,6 +202,10 @@ func init() {
 		avx2F16Profile(),
 		avx512F16Profile(),
 		avx512BF16Profile(),
+		avx2F32Profile(),
+		avx2F64Profile(),
+		avx512F32Profile(),
+		avx512F64Profile(),
 		neonUint64Profile(),
 		neonUint8Profile(),
 		neonUint32Profile(),
@@ -179,6 +215,12 @@ func init() {
 		sveDarwinF64Profile(),
 		sveLinuxF32Profile(),
 		sveLinuxF64Profile(),
+		sve2LinuxF32Profile(),
+		sve2LinuxF64Profile(),
+		rvvF32Profile(),
+		rvvF64Profile(),
+		smeF32Profile(),
+		smeF64Profile(),
 	} {
 		// Primary key: "TargetName:ElemType"
 		key := p.TargetName + ":" + p.ElemType
@@ -191,7 +233,7 @@ func init() {
 		"bfloat16": "hwy.BFloat16",
 	}
 	for bare, qualified := range aliases {
-		for _, target := range []string{"NEON", "AVX2", "AVX512", "SVE_DARWIN", "SVE_LINUX"} {
+		for _, target := range []string{"NEON", "AVX2", "AVX512", "SVE_DARWIN", "SVE_LINUX", "SVE2_LINUX"} {
 			qualifiedKey := target + ":" + qualified
 			if p, ok := cProfileRegistry[qualifiedKey]; ok {
 				cProfileRegistry[target+":"+bare] = p
@@ -247,6 +289,8 @@ func neonF32Profile() *CIntrinsicProfile {
 		ReduceSumFn:       map[string]string{"q": "vaddvq_f32"},
 		InterleaveLowerFn: map[string]string{"q": "vzip1q_f32"},
 		InterleaveUpperFn: map[string]string{"q": "vzip2q_f32"},
+		ConcatEvenFn:      map[string]string{"q": "vuzp1q_f32"},
+		ConcatOddFn:       map[string]string{"q": "vuzp2q_f32"},
 		LessThanFn:        map[string]string{"q": "vcltq_f32"},
 		EqualFn:           map[string]string{"q": "vceqq_f32"},
 		GreaterThanFn:     map[string]string{"q": "vcgtq_f32"},
@@ -315,6 +359,8 @@ func neonF64Profile() *CIntrinsicProfile {
 		ReduceSumFn:       map[string]string{"q": "vaddvq_f64"},
 		InterleaveLowerFn: map[string]string{"q": "vzip1q_f64"},
 		InterleaveUpperFn: map[string]string{"q": "vzip2q_f64"},
+		ConcatEvenFn:      map[string]string{"q": "vuzp1q_f64"},
+		ConcatOddFn:       map[string]string{"q": "vuzp2q_f64"},
 		LessThanFn:        map[string]string{"q": "vcltq_f64"},
 		EqualFn:           map[string]string{"q": "vceqq_f64"},
 		GreaterThanFn:     map[string]string{"q": "vcgtq_f64"},
@@ -423,6 +469,8 @@ func neonF16Profile() *CIntrinsicProfile {
 		ReduceSumFn:       map[string]string{"q": "vaddvq_f16", "d": "vaddv_f16"},
 		InterleaveLowerFn: map[string]string{"q": "vzip1q_f16", "d": "vzip1_f16"},
 		InterleaveUpperFn: map[string]string{"q": "vzip2q_f16", "d": "vzip2_f16"},
+		ConcatEvenFn:      map[string]string{"q": "vuzp1q_f16", "d": "vuzp1_f16"},
+		ConcatOddFn:       map[string]string{"q": "vuzp2q_f16", "d": "vuzp2_f16"},
 		LessThanFn:        map[string]string{"q": "vcltq_f16", "d": "vclt_f16"},
 		EqualFn:           map[string]string{"q": "vceqq_f16", "d": "vceq_f16"},
 		GreaterThanFn:     map[string]string{"q": "vcgtq_f16", "d": "vcgt_f16"},
@@ -762,6 +810,308 @@ func avx512BF16Profile() *CIntrinsicProfile {
 	}
 }
 
+// ---------------------------------------------------------------------------
+// AVX2 float32 / float64 (Haswell+, Zen+: AVX2 + FMA3)
+// ---------------------------------------------------------------------------
+// AVX2 has no mask registers: comparisons return an all-ones/all-zeros vector
+// of the same type as the operands, and selection uses VBLENDVPS/VBLENDVPD,
+// whose argument order (no, yes, mask) matches the translator's "acc_last"
+// IfThenElse convention. Operations without a single intrinsic (neg, abs,
+// comparisons with a predicate immediate, horizontal reductions, variable
+// lane extraction) are provided as static inline helpers.
+
+func avx2F32Profile() *CIntrinsicProfile {
+	return &CIntrinsicProfile{
+		ElemType:   "float32",
+		TargetName: "AVX2",
+		Include:    "#include <immintrin.h>",
+		CType:      "float",
+		VecTypes:   map[string]string{"ymm": "__m256"},
+		Tiers: []CLoopTier{
+			{Name: "ymm", Lanes: 8, Unroll: 4, IsScalar: false},
+			{Name: "ymm", Lanes: 8, Unroll: 1, IsScalar: false},
+			{Name: "scalar", Lanes: 1, Unroll: 1, IsScalar: true},
+		},
+		LoadFn:    map[string]string{"ymm": "_mm256_loadu_ps"},
+		StoreFn:   map[string]string{"ymm": "_mm256_storeu_ps"},
+		AddFn:     map[string]string{"ymm": "_mm256_add_ps"},
+		SubFn:     map[string]string{"ymm": "_mm256_sub_ps"},
+		MulFn:     map[string]string{"ymm": "_mm256_mul_ps"},
+		DivFn:     map[string]string{"ymm": "_mm256_div_ps"},
+		FmaFn:     map[string]string{"ymm": "_mm256_fmadd_ps"},
+		NegFn:     map[string]string{"ymm": "hwy_neg_ps256"},
+		AbsFn:     map[string]string{"ymm": "hwy_abs_ps256"},
+		SqrtFn:    map[string]string{"ymm": "_mm256_sqrt_ps"},
+		MinFn:     map[string]string{"ymm": "_mm256_min_ps"},
+		MaxFn:     map[string]string{"ymm": "_mm256_max_ps"},
+		DupFn:     map[string]string{"ymm": "_mm256_set1_ps"},
+		GetLaneFn: map[string]string{"ymm": "hwy_getlane_ps256"},
+
+		ReduceSumFn:    map[string]string{"ymm": "hwy_reduce_add_ps256"},
+		LessThanFn:     map[string]string{"ymm": "hwy_lt_ps256"},
+		EqualFn:        map[string]string{"ymm": "hwy_eq_ps256"},
+		GreaterThanFn:  map[string]string{"ymm": "hwy_gt_ps256"},
+		IfThenElseFn:   map[string]string{"ymm": "_mm256_blendv_ps"},
+		BitsFromMaskFn: map[string]string{"ymm": "_mm256_movemask_ps"},
+		MaskType:       map[string]string{"ymm": "__m256"},
+		ReduceMinFn:    map[string]string{"ymm": "hwy_reduce_min_ps256"},
+		ReduceMaxFn:    map[string]string{"ymm": "hwy_reduce_max_ps256"},
+
+		InlineHelpers: avx2FloatHelpers("float32"),
+
+		MathStrategy:     "native",
+		NativeArithmetic: true,
+		FmaArgOrder:      "acc_last",
+		GoatTarget:       "amd64",
+		GoatExtraFlags:   []string{"-mavx2", "-mfma"},
+	}
+}
+
+func avx2F64Profile() *CIntrinsicProfile {
+	return &CIntrinsicProfile{
+		ElemType:   "float64",
+		TargetName: "AVX2",
+		Include:    "#include <immintrin.h>",
+		CType:      "double",
+		VecTypes:   map[string]string{"ymm": "__m256d"},
+		Tiers: []CLoopTier{
+			{Name: "ymm", Lanes: 4, Unroll: 4, IsScalar: false},
+			{Name: "ymm", Lanes: 4, Unroll: 1, IsScalar: false},
+			{Name: "scalar", Lanes: 1, Unroll: 1, IsScalar: true},
+		},
+		LoadFn:    map[string]string{"ymm": "_mm256_loadu_pd"},
+		StoreFn:   map[string]string{"ymm": "_mm256_storeu_pd"},
+		AddFn:     map[string]string{"ymm": "_mm256_add_pd"},
+		SubFn:     map[string]string{"ymm": "_mm256_sub_pd"},
+		MulFn:     map[string]string{"ymm": "_mm256_mul_pd"},
+		DivFn:     map[string]string{"ymm": "_mm256_div_pd"},
+		FmaFn:     map[string]string{"ymm": "_mm256_fmadd_pd"},
+		NegFn:     map[string]string{"ymm": "hwy_neg_pd256"},
+		AbsFn:     map[string]string{"ymm": "hwy_abs_pd256"},
+		SqrtFn:    map[string]string{"ymm": "_mm256_sqrt_pd"},
+		MinFn:     map[string]string{"ymm": "_mm256_min_pd"},
+		MaxFn:     map[string]string{"ymm": "_mm256_max_pd"},
+		DupFn:     map[string]string{"ymm": "_mm256_set1_pd"},
+		GetLaneFn: map[string]string{"ymm": "hwy_getlane_pd256"},
+
+		ReduceSumFn:    map[string]string{"ymm": "hwy_reduce_add_pd256"},
+		LessThanFn:     map[string]string{"ymm": "hwy_lt_pd256"},
+		EqualFn:        map[string]string{"ymm": "hwy_eq_pd256"},
+		GreaterThanFn:  map[string]string{"ymm": "hwy_gt_pd256"},
+		IfThenElseFn:   map[string]string{"ymm": "_mm256_blendv_pd"},
+		BitsFromMaskFn: map[string]string{"ymm": "_mm256_movemask_pd"},
+		MaskType:       map[string]string{"ymm": "__m256d"},
+		ReduceMinFn:    map[string]string{"ymm": "hwy_reduce_min_pd256"},
+		ReduceMaxFn:    map[string]string{"ymm": "hwy_reduce_max_pd256"},
+
+		InlineHelpers: avx2FloatHelpers("float64"),
+
+		MathStrategy:     "native",
+		NativeArithmetic: true,
+		FmaArgOrder:      "acc_last",
+		GoatTarget:       "amd64",
+		GoatExtraFlags:   []string{"-mavx2", "-mfma"},
+	}
+}
+
+// avx2FloatHelpers returns the inline helpers referenced by the AVX2 float
+// profiles. The f32 and f64 variants differ only in the intrinsic suffix,
+// the lane count and the final 128-bit reduction step.
+func avx2FloatHelpers(elemType string) []string {
+	sfx, vec, ctype, lanes, signZero := "ps", "__m256", "float", 8, "-0.0f"
+	if elemType == "float64" {
+		sfx, vec, ctype, lanes, signZero = "pd", "__m256d", "double", 4, "-0.0"
+	}
+	helpers := []string{
+		fmt.Sprintf(`static inline %[1]s hwy_neg_%[2]s256(%[1]s x) {
+    return _mm256_xor_%[2]s(x, _mm256_set1_%[2]s(%[3]s));
+}`, vec, sfx, signZero),
+		fmt.Sprintf(`static inline %[1]s hwy_abs_%[2]s256(%[1]s x) {
+    return _mm256_andnot_%[2]s(_mm256_set1_%[2]s(%[3]s), x);
+}`, vec, sfx, signZero),
+	}
+	for _, cmp := range []struct{ name, pred string }{
+		{"lt", "_CMP_LT_OQ"},
+		{"eq", "_CMP_EQ_OQ"},
+		{"gt", "_CMP_GT_OQ"},
+	} {
+		helpers = append(helpers, fmt.Sprintf(`static inline %[1]s hwy_%[3]s_%[2]s256(%[1]s a, %[1]s b) {
+    return _mm256_cmp_%[2]s(a, b, %[4]s);
+}`, vec, sfx, cmp.name, cmp.pred))
+	}
+	for _, op := range []string{"add", "min", "max"} {
+		if elemType == "float64" {
+			helpers = append(helpers, fmt.Sprintf(`static inline double hwy_reduce_%[1]s_pd256(__m256d v) {
+    __m128d s = _mm_%[1]s_pd(_mm256_castpd256_pd128(v), _mm256_extractf128_pd(v, 1));
+    s = _mm_%[1]s_pd(s, _mm_unpackhi_pd(s, s));
+    return _mm_cvtsd_f64(s);
+}`, op))
+			continue
+		}
+		helpers = append(helpers, fmt.Sprintf(`static inline float hwy_reduce_%[1]s_ps256(__m256 v) {
+    __m128 s = _mm_%[1]s_ps(_mm256_castps256_ps128(v), _mm256_extractf128_ps(v, 1));
+    s = _mm_%[1]s_ps(s, _mm_movehl_ps(s, s));
+    s = _mm_%[1]s_ps(s, _mm_shuffle_ps(s, s, 0x55));
+    return _mm_cvtss_f32(s);
+}`, op))
+	}
+	helpers = append(helpers, fmt.Sprintf(`static inline %[1]s hwy_getlane_%[3]s256(%[2]s v, long idx) {
+    %[1]s tmp[%[4]d];
+    _mm256_storeu_%[3]s(tmp, v);
+    return tmp[idx];
+}`, ctype, vec, sfx, lanes))
+	return helpers
+}
+
+// ---------------------------------------------------------------------------
+// AVX-512 float32 / float64 (Skylake-X+, Zen4+: F + DQ + BW + VL)
+// ---------------------------------------------------------------------------
+// Comparisons produce __mmask16/__mmask8 mask registers and selection uses
+// VBLENDMPS/VBLENDMPD, which takes the mask first; the hwy_select_* helpers
+// reorder the arguments to the (no, yes, mask) convention. The remainder of
+// elementwise loops is handled with a masked load/store instead of a scalar
+// loop.
+
+func avx512F32Profile() *CIntrinsicProfile {
+	return &CIntrinsicProfile{
+		ElemType:   "float32",
+		TargetName: "AVX512",
+		Include:    "#include <immintrin.h>",
+		CType:      "float",
+		VecTypes:   map[string]string{"zmm": "__m512"},
+		Tiers: []CLoopTier{
+			{Name: "zmm", Lanes: 16, Unroll: 4, IsScalar: false},
+			{Name: "zmm", Lanes: 16, Unroll: 1, IsScalar: false},
+			{Name: "scalar", Lanes: 1, Unroll: 1, IsScalar: true},
+		},
+		LoadFn:    map[string]string{"zmm": "_mm512_loadu_ps"},
+		StoreFn:   map[string]string{"zmm": "_mm512_storeu_ps"},
+		AddFn:     map[string]string{"zmm": "_mm512_add_ps"},
+		SubFn:     map[string]string{"zmm": "_mm512_sub_ps"},
+		MulFn:     map[string]string{"zmm": "_mm512_mul_ps"},
+		DivFn:     map[string]string{"zmm": "_mm512_div_ps"},
+		FmaFn:     map[string]string{"zmm": "_mm512_fmadd_ps"},
+		NegFn:     map[string]string{"zmm": "hwy_neg_ps512"},
+		AbsFn:     map[string]string{"zmm": "_mm512_abs_ps"},
+		SqrtFn:    map[string]string{"zmm": "_mm512_sqrt_ps"},
+		MinFn:     map[string]string{"zmm": "_mm512_min_ps"},
+		MaxFn:     map[string]string{"zmm": "_mm512_max_ps"},
+		DupFn:     map[string]string{"zmm": "_mm512_set1_ps"},
+		GetLaneFn: map[string]string{"zmm": "hwy_getlane_ps512"},
+
+		ReduceSumFn:    map[string]string{"zmm": "_mm512_reduce_add_ps"},
+		LessThanFn:     map[string]string{"zmm": "hwy_lt_ps512"},
+		EqualFn:        map[string]string{"zmm": "hwy_eq_ps512"},
+		GreaterThanFn:  map[string]string{"zmm": "hwy_gt_ps512"},
+		IfThenElseFn:   map[string]string{"zmm": "hwy_select_ps512"},
+		BitsFromMaskFn: map[string]string{"zmm": "_cvtmask16_u32"},
+		MaskType:       map[string]string{"zmm": "__mmask16"},
+		ReduceMinFn:    map[string]string{"zmm": "_mm512_reduce_min_ps"},
+		ReduceMaxFn:    map[string]string{"zmm": "_mm512_reduce_max_ps"},
+
+		MaskedLoadFn:  "_mm512_maskz_loadu_ps",
+		MaskedStoreFn: "_mm512_mask_storeu_ps",
+		TailMaskFn:    "(__mmask16)((1u << (%s)) - 1)",
+		CompressFn:    map[string]string{"zmm": "_mm512_maskz_compress_ps"},
+		CountTrueFn:   map[string]string{"zmm": "__builtin_popcount"},
+
+		InlineHelpers: avx512FloatHelpers("float32"),
+
+		MathStrategy:     "native",
+		NativeArithmetic: true,
+		FmaArgOrder:      "acc_last",
+		GoatTarget:       "amd64",
+		GoatExtraFlags:   []string{"-mavx512f", "-mavx512dq", "-mavx512bw", "-mavx512vl", "-mfma"},
+	}
+}
+
+func avx512F64Profile() *CIntrinsicProfile {
+	return &CIntrinsicProfile{
+		ElemType:   "float64",
+		TargetName: "AVX512",
+		Include:    "#include <immintrin.h>",
+		CType:      "double",
+		VecTypes:   map[string]string{"zmm": "__m512d"},
+		Tiers: []CLoopTier{
+			{Name: "zmm", Lanes: 8, Unroll: 4, IsScalar: false},
+			{Name: "zmm", Lanes: 8, Unroll: 1, IsScalar: false},
+			{Name: "scalar", Lanes: 1, Unroll: 1, IsScalar: true},
+		},
+		LoadFn:    map[string]string{"zmm": "_mm512_loadu_pd"},
+		StoreFn:   map[string]string{"zmm": "_mm512_storeu_pd"},
+		AddFn:     map[string]string{"zmm": "_mm512_add_pd"},
+		SubFn:     map[string]string{"zmm": "_mm512_sub_pd"},
+		MulFn:     map[string]string{"zmm": "_mm512_mul_pd"},
+		DivFn:     map[string]string{"zmm": "_mm512_div_pd"},
+		FmaFn:     map[string]string{"zmm": "_mm512_fmadd_pd"},
+		NegFn:     map[string]string{"zmm": "hwy_neg_pd512"},
+		AbsFn:     map[string]string{"zmm": "_mm512_abs_pd"},
+		SqrtFn:    map[string]string{"zmm": "_mm512_sqrt_pd"},
+		MinFn:     map[string]string{"zmm": "_mm512_min_pd"},
+		MaxFn:     map[string]string{"zmm": "_mm512_max_pd"},
+		DupFn:     map[string]string{"zmm": "_mm512_set1_pd"},
+		GetLaneFn: map[string]string{"zmm": "hwy_getlane_pd512"},
+
+		ReduceSumFn:    map[string]string{"zmm": "_mm512_reduce_add_pd"},
+		LessThanFn:     map[string]string{"zmm": "hwy_lt_pd512"},
+		EqualFn:        map[string]string{"zmm": "hwy_eq_pd512"},
+		GreaterThanFn:  map[string]string{"zmm": "hwy_gt_pd512"},
+		IfThenElseFn:   map[string]string{"zmm": "hwy_select_pd512"},
+		BitsFromMaskFn: map[string]string{"zmm": "_cvtmask8_u32"},
+		MaskType:       map[string]string{"zmm": "__mmask8"},
+		ReduceMinFn:    map[string]string{"zmm": "_mm512_reduce_min_pd"},
+		ReduceMaxFn:    map[string]string{"zmm": "_mm512_reduce_max_pd"},
+
+		MaskedLoadFn:  "_mm512_maskz_loadu_pd",
+		MaskedStoreFn: "_mm512_mask_storeu_pd",
+		TailMaskFn:    "(__mmask8)((1u << (%s)) - 1)",
+		CompressFn:    map[string]string{"zmm": "_mm512_maskz_compress_pd"},
+		CountTrueFn:   map[string]string{"zmm": "__builtin_popcount"},
+
+		InlineHelpers: avx512FloatHelpers("float64"),
+
+		MathStrategy:     "native",
+		NativeArithmetic: true,
+		FmaArgOrder:      "acc_last",
+		GoatTarget:       "amd64",
+		GoatExtraFlags:   []string{"-mavx512f", "-mavx512dq", "-mavx512bw", "-mavx512vl", "-mfma"},
+	}
+}
+
+// avx512FloatHelpers returns the inline helpers referenced by the AVX-512
+// float profiles.
+func avx512FloatHelpers(elemType string) []string {
+	sfx, vec, mask, ctype, lanes, signZero := "ps", "__m512", "__mmask16", "float", 16, "-0.0f"
+	if elemType == "float64" {
+		sfx, vec, mask, ctype, lanes, signZero = "pd", "__m512d", "__mmask8", "double", 8, "-0.0"
+	}
+	helpers := []string{
+		fmt.Sprintf(`static inline %[1]s hwy_neg_%[2]s512(%[1]s x) {
+    return _mm512_xor_%[2]s(x, _mm512_set1_%[2]s(%[3]s));
+}`, vec, sfx, signZero),
+	}
+	for _, cmp := range []struct{ name, pred string }{
+		{"lt", "_CMP_LT_OQ"},
+		{"eq", "_CMP_EQ_OQ"},
+		{"gt", "_CMP_GT_OQ"},
+	} {
+		helpers = append(helpers, fmt.Sprintf(`static inline %[3]s hwy_%[4]s_%[2]s512(%[1]s a, %[1]s b) {
+    return _mm512_cmp_%[2]s_mask(a, b, %[5]s);
+}`, vec, sfx, mask, cmp.name, cmp.pred))
+	}
+	helpers = append(helpers,
+		fmt.Sprintf(`static inline %[1]s hwy_select_%[2]s512(%[1]s no, %[1]s yes, %[3]s mask) {
+    return _mm512_mask_blend_%[2]s(mask, no, yes);
+}`, vec, sfx, mask),
+		fmt.Sprintf(`static inline %[1]s hwy_getlane_%[3]s512(%[2]s v, long idx) {
+    %[1]s tmp[%[4]d];
+    _mm512_storeu_%[3]s(tmp, v);
+    return tmp[idx];
+}`, ctype, vec, sfx, lanes),
+	)
+	return helpers
+}
+
 // ---------------------------------------------------------------------------
 // NEON uint64 (for RaBitQ bit product)
 // ---------------------------------------------------------------------------
@@ -796,6 +1146,8 @@ func neonUint64Profile() *CIntrinsicProfile {
 
 		InterleaveLowerFn: map[string]string{"q": "vzip1q_u64"},
 		InterleaveUpperFn: map[string]string{"q": "vzip2q_u64"},
+		ConcatEvenFn:      map[string]string{"q": "vuzp1q_u64"},
+		ConcatOddFn:       map[string]string{"q": "vuzp2q_u64"},
 
 		// Deferred popcount accumulation: accumulate at uint32x4_t width
 		// inside the loop, reduce once after the loop with vaddvq_u32.
@@ -914,6 +1266,8 @@ func neonUint32Profile() *CIntrinsicProfile {
 
 		InterleaveLowerFn: map[string]string{"q": "vzip1q_u32"},
 		InterleaveUpperFn: map[string]string{"q": "vzip2q_u32"},
+		ConcatEvenFn:      map[string]string{"q": "vuzp1q_u32"},
+		ConcatOddFn:       map[string]string{"q": "vuzp2q_u32"},
 
 		MathStrategy:   "native",
 		GoatTarget:     "arm64",
@@ -959,6 +1313,8 @@ func neonInt32Profile() *CIntrinsicProfile {
 
 		InterleaveLowerFn: map[string]string{"q": "vzip1q_s32"},
 		InterleaveUpperFn: map[string]string{"q": "vzip2q_s32"},
+		ConcatEvenFn:      map[string]string{"q": "vuzp1q_s32"},
+		ConcatOddFn:       map[string]string{"q": "vuzp2q_s32"},
 
 		MathStrategy:     "native",
 		NativeArithmetic: true,
@@ -1000,6 +1356,8 @@ func neonInt64Profile() *CIntrinsicProfile {
 
 		InterleaveLowerFn: map[string]string{"q": "vzip1q_s64"},
 		InterleaveUpperFn: map[string]string{"q": "vzip2q_s64"},
+		ConcatEvenFn:      map[string]string{"q": "vuzp1q_s64"},
+		ConcatOddFn:       map[string]string{"q": "vuzp2q_s64"},
 
 		MathStrategy:     "native",
 		NativeArithmetic: true,
@@ -1050,12 +1408,20 @@ func sveDarwinF32Profile() *CIntrinsicProfile {
 		ReduceMaxFn:       map[string]string{"sve": "svmaxv_f32"},
 		InterleaveLowerFn: map[string]string{"sve": "svzip1_f32"},
 		InterleaveUpperFn: map[string]string{"sve": "svzip2_f32"},
+		ConcatEvenFn:      map[string]string{"sve": "svuzp1_f32"},
+		ConcatOddFn:       map[string]string{"sve": "svuzp2_f32"},
 		LessThanFn:        map[string]string{"sve": "svcmplt_f32"},
 		EqualFn:           map[string]string{"sve": "svcmpeq_f32"},
 		GreaterThanFn:     map[string]string{"sve": "svcmpgt_f32"},
 		IfThenElseFn:      map[string]string{"sve": "svsel_f32"},
 		MaskType:          map[string]string{"sve": "svbool_t"},
 
+		GatherIndexFn:  map[string]string{"sve": "svld1_gather_s32index_f32"},
+		ScatterIndexFn: map[string]string{"sve": "svst1_scatter_s32index_f32"},
+		IndexCastFn:    map[string]string{"sve": "svreinterpret_s32_f32"},
+		CompressFn:     map[string]string{"sve": "svcompact_f32"},
+		CountTrueFn:    map[string]string{"sve": "svcntp_b32"},
+
 		MathStrategy:     "native",
 		NativeArithmetic: true,
 		FmaArgOrder:      "acc_first",
@@ -1063,6 +1429,7 @@ func sveDarwinF32Profile() *CIntrinsicProfile {
 		GoatExtraFlags:   []string{"-march=armv9-a+sme"},
 		NeedsPredicate:   true,
 		PredicateDecl:    "svptrue_b32()",
+		WhileLtFn:        "svwhilelt_b32_s64",
 		// NOTE: Do NOT use __arm_streaming here. Clang places smstart after
 		// early-exit branches, creating paths where SVE instructions execute
 		// outside streaming mode. Instead, GOAT injects smstart/smstop
@@ -1109,12 +1476,20 @@ func sveDarwinF64Profile() *CIntrinsicProfile {
 		ReduceMaxFn:       map[string]string{"sve": "svmaxv_f64"},
 		InterleaveLowerFn: map[string]string{"sve": "svzip1_f64"},
 		InterleaveUpperFn: map[string]string{"sve": "svzip2_f64"},
+		ConcatEvenFn:      map[string]string{"sve": "svuzp1_f64"},
+		ConcatOddFn:       map[string]string{"sve": "svuzp2_f64"},
 		LessThanFn:        map[string]string{"sve": "svcmplt_f64"},
 		EqualFn:           map[string]string{"sve": "svcmpeq_f64"},
 		GreaterThanFn:     map[string]string{"sve": "svcmpgt_f64"},
 		IfThenElseFn:      map[string]string{"sve": "svsel_f64"},
 		MaskType:          map[string]string{"sve": "svbool_t"},
 
+		GatherIndexFn:  map[string]string{"sve": "svld1_gather_s64index_f64"},
+		ScatterIndexFn: map[string]string{"sve": "svst1_scatter_s64index_f64"},
+		IndexCastFn:    map[string]string{"sve": "svreinterpret_s64_f64"},
+		CompressFn:     map[string]string{"sve": "svcompact_f64"},
+		CountTrueFn:    map[string]string{"sve": "svcntp_b64"},
+
 		MathStrategy:     "native",
 		NativeArithmetic: true,
 		FmaArgOrder:      "acc_first",
@@ -1122,6 +1497,7 @@ func sveDarwinF64Profile() *CIntrinsicProfile {
 		GoatExtraFlags:   []string{"-march=armv9-a+sme"},
 		NeedsPredicate:   true,
 		PredicateDecl:    "svptrue_b64()",
+		WhileLtFn:        "svwhilelt_b64_s64",
 		// NOTE: Do NOT use __arm_streaming here — see f32 profile comment.
 	}
 }
@@ -1130,7 +1506,10 @@ func sveDarwinF64Profile() *CIntrinsicProfile {
 // SVE linux float32 (Graviton 3/4, Neoverse — native SVE, dynamic VL)
 // ---------------------------------------------------------------------------
 // On Linux ARM64 with SVE, the vector length is determined at runtime via
-// svcntw() (f32) or svcntd() (f64). No streaming mode needed.
+// svcntw() (f32) or svcntd() (f64). No streaming mode needed. Loops are
+// vector-length agnostic: remainders use a whilelt predicate instead of a
+// scalar tail. The C is compiled for baseline SVE so it runs on Graviton 3
+// (Neoverse V1), which lacks SVE2; SVE2_LINUX covers SVE2 hardware.
 
 func sveLinuxF32Profile() *CIntrinsicProfile {
 	return &CIntrinsicProfile{
@@ -1167,19 +1546,28 @@ func sveLinuxF32Profile() *CIntrinsicProfile {
 		ReduceMaxFn:       map[string]string{"sve": "svmaxv_f32"},
 		InterleaveLowerFn: map[string]string{"sve": "svzip1_f32"},
 		InterleaveUpperFn: map[string]string{"sve": "svzip2_f32"},
+		ConcatEvenFn:      map[string]string{"sve": "svuzp1_f32"},
+		ConcatOddFn:       map[string]string{"sve": "svuzp2_f32"},
 		LessThanFn:        map[string]string{"sve": "svcmplt_f32"},
 		EqualFn:           map[string]string{"sve": "svcmpeq_f32"},
 		GreaterThanFn:     map[string]string{"sve": "svcmpgt_f32"},
 		IfThenElseFn:      map[string]string{"sve": "svsel_f32"},
 		MaskType:          map[string]string{"sve": "svbool_t"},
 
+		GatherIndexFn:  map[string]string{"sve": "svld1_gather_s32index_f32"},
+		ScatterIndexFn: map[string]string{"sve": "svst1_scatter_s32index_f32"},
+		IndexCastFn:    map[string]string{"sve": "svreinterpret_s32_f32"},
+		CompressFn:     map[string]string{"sve": "svcompact_f32"},
+		CountTrueFn:    map[string]string{"sve": "svcntp_b32"},
+
 		MathStrategy:     "native",
 		NativeArithmetic: true,
 		FmaArgOrder:      "acc_first",
 		GoatTarget:       "arm64",
-		GoatExtraFlags:   []string{"-march=armv9-a+sve2"},
+		GoatExtraFlags:   []string{"-march=armv8.2-a+sve"},
 		NeedsPredicate:   true,
 		PredicateDecl:    "svptrue_b32()",
+		WhileLtFn:        "svwhilelt_b32_s64",
 	}
 }
 
@@ -1222,18 +1610,209 @@ func sveLinuxF64Profile() *CIntrinsicProfile {
 		ReduceMaxFn:       map[string]string{"sve": "svmaxv_f64"},
 		InterleaveLowerFn: map[string]string{"sve": "svzip1_f64"},
 		InterleaveUpperFn: map[string]string{"sve": "svzip2_f64"},
+		ConcatEvenFn:      map[string]string{"sve": "svuzp1_f64"},
+		ConcatOddFn:       map[string]string{"sve": "svuzp2_f64"},
 		LessThanFn:        map[string]string{"sve": "svcmplt_f64"},
 		EqualFn:           map[string]string{"sve": "svcmpeq_f64"},
 		GreaterThanFn:     map[string]string{"sve": "svcmpgt_f64"},
 		IfThenElseFn:      map[string]string{"sve": "svsel_f64"},
 		MaskType:          map[string]string{"sve": "svbool_t"},
 
+		GatherIndexFn:  map[string]string{"sve": "svld1_gather_s64index_f64"},
+		ScatterIndexFn: map[string]string{"sve": "svst1_scatter_s64index_f64"},
+		IndexCastFn:    map[string]string{"sve": "svreinterpret_s64_f64"},
+		CompressFn:     map[string]string{"sve": "svcompact_f64"},
+		CountTrueFn:    map[string]string{"sve": "svcntp_b64"},
+
 		MathStrategy:     "native",
 		NativeArithmetic: true,
 		FmaArgOrder:      "acc_first",
 		GoatTarget:       "arm64",
-		GoatExtraFlags:   []string{"-march=armv9-a+sve2"},
+		GoatExtraFlags:   []string{"-march=armv8.2-a+sve"},
 		NeedsPredicate:   true,
 		PredicateDecl:    "svptrue_b64()",
+		WhileLtFn:        "svwhilelt_b64_s64",
 	}
 }
+
+// ---------------------------------------------------------------------------
+// SVE2 linux (Graviton 4, Neoverse V2/N2 — native SVE2, dynamic VL)
+// ---------------------------------------------------------------------------
+// The SVE2 profiles use the same intrinsics as SVE_LINUX; the difference is
+// that the compiler may use SVE2 instructions, so dispatch to them is
+// guarded by hwy.HasSVE2().
+
+func sve2LinuxF32Profile() *CIntrinsicProfile {
+	p := sveLinuxF32Profile()
+	p.TargetName = "SVE2_LINUX"
+	p.GoatExtraFlags = []string{"-march=armv9-a+sve2"}
+	return p
+}
+
+func sve2LinuxF64Profile() *CIntrinsicProfile {
+	p := sveLinuxF64Profile()
+	p.TargetName = "SVE2_LINUX"
+	p.GoatExtraFlags = []string{"-march=armv9-a+sve2"}
+	return p
+}
+
+// ---------------------------------------------------------------------------
+// RVV float32 / float64 (RISC-V Vector 1.0 — dynamic VL, LMUL=1)
+// ---------------------------------------------------------------------------
+// Every RVV intrinsic takes the active vector length as its last argument.
+// The AST translator calls ops as fn(args), so the profile maps point at
+// static inline helpers that run at VLMAX; the translated Go loops handle
+// their own remainders. The CEmitter uses the intrinsics directly and
+// processes the remainder by shrinking vl with SetVLFn, so no scalar tail is
+// needed. Comparisons produce vbool32_t (f32) or vbool64_t (f64) masks.
+
+func rvvF32Profile() *CIntrinsicProfile {
+	return rvvFloatProfile("float32")
+}
+
+func rvvF64Profile() *CIntrinsicProfile {
+	return rvvFloatProfile("float64")
+}
+
+// rvvFloatProfile builds the RVV profile for float32 or float64. The two
+// differ only in SEW (element width) and the mask type.
+func rvvFloatProfile(elemType string) *CIntrinsicProfile {
+	sfx, vec, ctype, sew, mask := "f32", "vfloat32m1_t", "float", 32, "vbool32_t"
+	if elemType == "float64" {
+		sfx, vec, ctype, sew, mask = "f64", "vfloat64m1_t", "double", 64, "vbool64_t"
+	}
+	helper := func(op string) map[string]string {
+		return map[string]string{"rvv": "hwy_rvv_" + op + "_" + sfx}
+	}
+	vlmax := fmt.Sprintf("__riscv_vsetvlmax_e%dm1()", sew)
+	return &CIntrinsicProfile{
+		ElemType:   elemType,
+		TargetName: "RVV",
+		Include:    "#include <riscv_vector.h>",
+		CType:      ctype,
+		VecTypes:   map[string]string{"rvv": vec},
+		Tiers: []CLoopTier{
+			{Name: "rvv", DynamicLanes: vlmax, Unroll: 4, IsScalar: false},
+			{Name: "rvv", DynamicLanes: vlmax, Unroll: 1, IsScalar: false},
+			{Name: "scalar", Lanes: 1, Unroll: 1, IsScalar: true},
+		},
+		LoadFn:    helper("load"),
+		StoreFn:   helper("store"),
+		AddFn:     helper("add"),
+		SubFn:     helper("sub"),
+		MulFn:     helper("mul"),
+		DivFn:     helper("div"),
+		FmaFn:     helper("fma"),
+		NegFn:     helper("neg"),
+		AbsFn:     helper("abs"),
+		SqrtFn:    helper("sqrt"),
+		MinFn:     helper("min"),
+		MaxFn:     helper("max"),
+		DupFn:     helper("dup"),
+		GetLaneFn: helper("getlane"),
+
+		ReduceSumFn:   helper("reduce_add"),
+		ReduceMinFn:   helper("reduce_min"),
+		ReduceMaxFn:   helper("reduce_max"),
+		LessThanFn:    helper("lt"),
+		EqualFn:       helper("eq"),
+		GreaterThanFn: helper("gt"),
+		IfThenElseFn:  helper("select"),
+		MaskType:      map[string]string{"rvv": mask},
+
+		InlineHelpers: rvvFloatHelpers(elemType),
+
+		MathStrategy:     "native",
+		NativeArithmetic: true,
+		FmaArgOrder:      "acc_last",
+		GoatTarget:       "riscv64",
+		GoatExtraFlags:   []string{"-march=rv64gcv"},
+		SetVLFn:          fmt.Sprintf("__riscv_vsetvl_e%dm1", sew),
+	}
+}
+
+// rvvFloatHelpers returns the VLMAX wrappers referenced by the RVV float
+// profiles. hwy_rvv_select follows the (no, yes, mask) IfThenElse
+// convention of "acc_last" profiles, which is also vmerge's operand order.
+func rvvFloatHelpers(elemType string) []string {
+	sfx, vec, ctype, sew, mask, zero := "f32", "vfloat32m1_t", "float", 32, "b32", "0.0f"
+	if elemType == "float64" {
+		sfx, vec, ctype, sew, mask, zero = "f64", "vfloat64m1_t", "double", 64, "b64", "0.0"
+	}
+	vl := fmt.Sprintf("__riscv_vsetvlmax_e%dm1()", sew)
+	helpers := []string{
+		fmt.Sprintf(`static inline %[1]s hwy_rvv_load_%[2]s(const %[3]s *p) {
+    return __riscv_vle%[4]d_v_%[2]sm1(p, %[5]s);
+}`, vec, sfx, ctype, sew, vl),
+		fmt.Sprintf(`static inline void hwy_rvv_store_%[2]s(%[3]s *p, %[1]s v) {
+    __riscv_vse%[4]d_v_%[2]sm1(p, v, %[5]s);
+}`, vec, sfx, ctype, sew, vl),
+		fmt.Sprintf(`static inline %[1]s hwy_rvv_dup_%[2]s(%[3]s x) {
+    return __riscv_vfmv_v_f_%[2]sm1(x, %[4]s);
+}`, vec, sfx, ctype, vl),
+		fmt.Sprintf(`static inline %[1]s hwy_rvv_fma_%[2]s(%[1]s a, %[1]s b, %[1]s acc) {
+    return __riscv_vfmacc_vv_%[2]sm1(acc, a, b, %[3]s);
+}`, vec, sfx, vl),
+		fmt.Sprintf(`static inline %[1]s hwy_rvv_select_%[2]s(%[1]s no, %[1]s yes, vbool%[4]d_t mask) {
+    return __riscv_vmerge_vvm_%[2]sm1(no, yes, mask, %[3]s);
+}`, vec, sfx, vl, sew),
+		fmt.Sprintf(`static inline %[3]s hwy_rvv_getlane_%[2]s(%[1]s v, long idx) {
+    return __riscv_vfmv_f_s_%[2]sm1_%[2]s(__riscv_vslidedown_vx_%[2]sm1(v, idx, %[4]s));
+}`, vec, sfx, ctype, vl),
+		fmt.Sprintf(`static inline %[3]s hwy_rvv_reduce_add_%[2]s(%[1]s v) {
+    size_t vl = %[4]s;
+    return __riscv_vfmv_f_s_%[2]sm1_%[2]s(__riscv_vfredusum_vs_%[2]sm1_%[2]sm1(v, __riscv_vfmv_s_f_%[2]sm1(%[5]s, vl), vl));
+}`, vec, sfx, ctype, vl, zero),
+	}
+	for _, op := range []string{"add", "sub", "mul", "div", "min", "max"} {
+		helpers = append(helpers, fmt.Sprintf(`static inline %[1]s hwy_rvv_%[3]s_%[2]s(%[1]s a, %[1]s b) {
+    return __riscv_vf%[3]s_vv_%[2]sm1(a, b, %[4]s);
+}`, vec, sfx, op, vl))
+	}
+	for _, op := range []string{"neg", "abs", "sqrt"} {
+		helpers = append(helpers, fmt.Sprintf(`static inline %[1]s hwy_rvv_%[3]s_%[2]s(%[1]s x) {
+    return __riscv_vf%[3]s_v_%[2]sm1(x, %[4]s);
+}`, vec, sfx, op, vl))
+	}
+	for _, cmp := range []string{"lt", "eq", "gt"} {
+		helpers = append(helpers, fmt.Sprintf(`static inline vbool%[5]d_t hwy_rvv_%[3]s_%[2]s(%[1]s a, %[1]s b) {
+    return __riscv_vmf%[3]s_vv_%[2]sm1_%[6]s(a, b, %[4]s);
+}`, vec, sfx, cmp, vl, sew, mask))
+	}
+	// Seeding min/max reductions with v itself avoids an infinity constant:
+	// element 0 of the scalar operand is one of the reduced values anyway.
+	for _, op := range []string{"min", "max"} {
+		helpers = append(helpers, fmt.Sprintf(`static inline %[3]s hwy_rvv_reduce_%[4]s_%[2]s(%[1]s v) {
+    size_t vl = %[5]s;
+    return __riscv_vfmv_f_s_%[2]sm1_%[2]s(__riscv_vfred%[4]s_vs_%[2]sm1_%[2]sm1(v, v, vl));
+}`, vec, sfx, ctype, op, vl))
+	}
+	return helpers
+}
+
+// ---------------------------------------------------------------------------
+// SME float32 / float64 (Apple M4+ outer-product units)
+// ---------------------------------------------------------------------------
+// The SME target compiles matmul-shaped functions to FMOPA kernels (see
+// EmitSMEMatMulC) rather than translating them. The kernels are streaming
+// functions that own ZA: GOAT wraps them in smstart/smstop, and the Go
+// wrappers hold hwy.SMEGuard for the call. Double-precision outer products
+// need the sme-f64f64 extension.
+
+func smeF32Profile() *CIntrinsicProfile {
+	p := sveDarwinF32Profile()
+	p.TargetName = "SME"
+	p.Include = "#include <arm_sme.h>"
+	p.FuncAttrs = `__arm_streaming __arm_out("za")`
+	p.GoatExtraFlags = []string{"-march=armv9-a+sme"}
+	return p
+}
+
+func smeF64Profile() *CIntrinsicProfile {
+	p := sveDarwinF64Profile()
+	p.TargetName = "SME"
+	p.Include = "#include <arm_sme.h>"
+	p.FuncAttrs = `__arm_streaming __arm_out("za")`
+	p.GoatExtraFlags = []string{"-march=armv9-a+sme+sme-f64f64"}
+	return p
+}
@@ -0,0 +1,239 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// smeMatMulFuncs returns the functions of funcs that the SME target compiles
+// to FMOPA kernels.
+func smeMatMulFuncs(funcs []ParsedFunc) []ParsedFunc {
+	var out []ParsedFunc
+	for _, pf := range funcs {
+		if _, ok := smeMatMulBTransposed(&pf); ok {
+			out = append(out, pf)
+		}
+	}
+	return out
+}
+
+// smeMatMulBTransposed reports whether pf is matmul-shaped, i.e.
+//
+//	func BaseX[T hwy.Floats](a, b, c []T, m, n, k int)
+//
+// computing the row-major product C[m,n] = A[m,k] * B with hwy.MulAdd or
+// hwy.FMA, and whether B is stored transposed as [n,k] (as in MatMulKLast)
+// rather than as [k,n]. The layouts are inferred from the row strides of
+// the slice indexes in the body: A must be indexed by multiples of k and C
+// by multiples of n.
+func smeMatMulBTransposed(pf *ParsedFunc) (transposed, ok bool) {
+	if len(pf.Params) != 6 || pf.Body == nil {
+		return false, false
+	}
+	for i, p := range pf.Params {
+		if i < 3 && p.Type != "[]T" || i >= 3 && p.Type != "int" {
+			return false, false
+		}
+	}
+	hasFMA := false
+	for _, call := range pf.HwyCalls {
+		if call.Package == "hwy" && (call.FuncName == "MulAdd" || call.FuncName == "FMA") {
+			hasFMA = true
+		}
+	}
+	if !hasFMA {
+		return false, false
+	}
+
+	a, b, c := pf.Params[0].Name, pf.Params[1].Name, pf.Params[2].Name
+	n, k := pf.Params[4].Name, pf.Params[5].Name
+	strides := smeRowStrides(pf.Body, []string{pf.Params[3].Name, n, k})
+	if strides[a] != k || strides[c] != n {
+		return false, false
+	}
+	switch strides[b] {
+	case n:
+		return false, true
+	case k:
+		return true, true
+	}
+	return false, false
+}
+
+// smeRowStrides returns, for each slice indexed in body, the dimension
+// (one of dims) its indexes are multiples of, following local variables
+// such as bRow := j * k. Indexes without a stride are ignored; slices indexed
+// with inconsistent strides map to "".
+func smeRowStrides(body *ast.BlockStmt, dims []string) map[string]string {
+	defs := make(map[string]ast.Expr)
+	ast.Inspect(body, func(node ast.Node) bool {
+		if as, ok := node.(*ast.AssignStmt); ok && len(as.Lhs) == len(as.Rhs) {
+			for i, lhs := range as.Lhs {
+				if id, ok := lhs.(*ast.Ident); ok && defs[id.Name] == nil {
+					defs[id.Name] = as.Rhs[i]
+				}
+			}
+		}
+		return true
+	})
+
+	var stride func(e ast.Expr, depth int) string
+	stride = func(e ast.Expr, depth int) string {
+		switch e := e.(type) {
+		case *ast.ParenExpr:
+			return stride(e.X, depth)
+		case *ast.Ident:
+			if def := defs[e.Name]; def != nil && depth < 4 {
+				return stride(def, depth+1)
+			}
+		case *ast.BinaryExpr:
+			switch e.Op {
+			case token.MUL:
+				for _, operand := range []ast.Expr{e.X, e.Y} {
+					if id, ok := operand.(*ast.Ident); ok {
+						for _, d := range dims {
+							if id.Name == d {
+								return d
+							}
+						}
+					}
+				}
+			case token.ADD, token.SUB:
+				if s := stride(e.X, depth); s != "" {
+					return s
+				}
+				return stride(e.Y, depth)
+			}
+		}
+		return ""
+	}
+
+	strides := make(map[string]string)
+	record := func(x, index ast.Expr) {
+		id, ok := x.(*ast.Ident)
+		if !ok || index == nil {
+			return
+		}
+		s := stride(index, 0)
+		if s == "" {
+			return // flat accesses like c[i] = 0 say nothing about the layout
+		}
+		if prev, seen := strides[id.Name]; seen && prev != s {
+			s = ""
+		}
+		strides[id.Name] = s
+	}
+	ast.Inspect(body, func(node ast.Node) bool {
+		switch node := node.(type) {
+		case *ast.IndexExpr:
+			record(node.X, node.Index)
+		case *ast.SliceExpr:
+			record(node.X, node.Low)
+		}
+		return true
+	})
+	return strides
+}
+
+// EmitSMEMatMulC writes an FMOPA kernel for a matmul-shaped function (see
+// smeMatMulBTransposed) in place of its AST translation. C is computed one
+// ZA0 tile at a time: for each block of K, the matching rows of A are loaded
+// into ZA1 and read back as columns, so A is transposed in ZA rather than in
+// a Go-side buffer, and each column of A and row of B add one outer product
+// to ZA0. A transposed B goes through ZA2 the same way. The M, N and K edges
+// are handled with predicates, so no padding is needed.
+func (e *CEmitter) EmitSMEMatMulC(pf *ParsedFunc, outPath string) (string, error) {
+	transposed, ok := smeMatMulBTransposed(pf)
+	if !ok {
+		return "", fmt.Errorf("%s is not matmul-shaped", pf.Name)
+	}
+	bits, cnt := "32", "svcntw()"
+	if e.elemType == "float64" {
+		bits, cnt = "64", "svcntd()"
+	} else if e.elemType != "float32" {
+		return "", fmt.Errorf("SME kernels support float32 and float64, not %s", e.elemType)
+	}
+	ctype := e.profile.CType
+	sfx := cTypeSuffix(e.elemType)
+	vec := e.profile.VecTypes["sve"]
+	whilelt := "svwhilelt_b" + bits + "_s64"
+	read := fmt.Sprintf("svread_ver_za%s_%s_m(svdup_%s(0)", bits, sfx, sfx)
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Generated by hwygen -c (SME FMOPA). DO NOT EDIT.\n")
+	fmt.Fprintf(&buf, "// %s for SME %s\n\n", pf.Name, e.elemType)
+	fmt.Fprintf(&buf, "#ifndef GOAT_PARSER\n%s\n#endif\n\n", e.profile.Include)
+	if transposed {
+		fmt.Fprintf(&buf, "// C[m,n] = A[m,k] * B[n,k]^T\n")
+	} else {
+		fmt.Fprintf(&buf, "// C[m,n] = A[m,k] * B[k,n]\n")
+	}
+	fmt.Fprintf(&buf, "void %s(%s *a, %s *b, %s *c, long *pm, long *pn, long *pk) %s {\n",
+		cAsmFuncName(pf.Name, e.elemType, "sme"), ctype, ctype, ctype, e.profile.FuncAttrs)
+	fmt.Fprintf(&buf, "    long m = *pm;\n    long n = *pn;\n    long k = *pk;\n")
+	fmt.Fprintf(&buf, "    long vl = %s;\n", cnt)
+	fmt.Fprintf(&buf, "    for (long i = 0; i < m; i += vl) {\n")
+	fmt.Fprintf(&buf, "        svbool_t pi = %s(i, m);\n", whilelt)
+	fmt.Fprintf(&buf, "        long rows = m - i < vl ? m - i : vl;\n")
+	fmt.Fprintf(&buf, "        for (long j = 0; j < n; j += vl) {\n")
+	fmt.Fprintf(&buf, "            svbool_t pj = %s(j, n);\n", whilelt)
+	if transposed {
+		fmt.Fprintf(&buf, "            long cols = n - j < vl ? n - j : vl;\n")
+	}
+	fmt.Fprintf(&buf, "            svzero_za();\n")
+	fmt.Fprintf(&buf, "            for (long p = 0; p < k; p += vl) {\n")
+	fmt.Fprintf(&buf, "                svbool_t pp = %s(p, k);\n", whilelt)
+	fmt.Fprintf(&buf, "                long depth = k - p < vl ? k - p : vl;\n")
+	fmt.Fprintf(&buf, "                for (long r = 0; r < rows; r++) {\n")
+	fmt.Fprintf(&buf, "                    svld1_hor_za%s(1, r, pp, a + (i + r) * k + p);\n", bits)
+	fmt.Fprintf(&buf, "                }\n")
+	if transposed {
+		fmt.Fprintf(&buf, "                for (long r = 0; r < cols; r++) {\n")
+		fmt.Fprintf(&buf, "                    svld1_hor_za%s(2, r, pp, b + (j + r) * k + p);\n", bits)
+		fmt.Fprintf(&buf, "                }\n")
+	}
+	fmt.Fprintf(&buf, "                for (long q = 0; q < depth; q++) {\n")
+	fmt.Fprintf(&buf, "                    %s va = %s, pi, 1, q);\n", vec, read)
+	if transposed {
+		fmt.Fprintf(&buf, "                    %s vb = %s, pj, 2, q);\n", vec, read)
+	} else {
+		fmt.Fprintf(&buf, "                    %s vb = svld1_%s(pj, b + (p + q) * n + j);\n", vec, sfx)
+	}
+	fmt.Fprintf(&buf, "                    svmopa_za%s_%s_m(0, pi, pj, va, vb);\n", bits, sfx)
+	fmt.Fprintf(&buf, "                }\n")
+	fmt.Fprintf(&buf, "            }\n")
+	fmt.Fprintf(&buf, "            for (long r = 0; r < rows; r++) {\n")
+	fmt.Fprintf(&buf, "                svst1_hor_za%s(0, r, pj, c + (i + r) * n + j);\n", bits)
+	fmt.Fprintf(&buf, "            }\n")
+	fmt.Fprintf(&buf, "        }\n")
+	fmt.Fprintf(&buf, "    }\n")
+	fmt.Fprintf(&buf, "}\n")
+
+	filename := filepath.Join(outPath, fmt.Sprintf("%s_c_%s_sme_%s.c",
+		strings.ToLower(pf.Name), sfx, e.archSuffix()))
+	if err := os.WriteFile(filename, buf.Bytes(), 0644); err != nil {
+		return "", fmt.Errorf("write C file: %w", err)
+	}
+
+	fmt.Printf("Generated %s\n", filename)
+	return filename, nil
+}
@@ -9,6 +9,7 @@ import (
 	"go/token"
 	"os"
 	"path/filepath"
+	"slices"
 	"sort"
 	"strings"
 
@@ -56,6 +57,8 @@ func targetPriority(name string) int {
 		return 3
 	case "AVX2":
 		return 2
+	case "SVE2_LINUX":
+		return 3
 	case "SVE_DARWIN", "SVE_LINUX":
 		return 2
 	case "NEON":
@@ -434,6 +437,7 @@ func emitArchDispatcher(funcs []ParsedFunc, archTargets []Target, hasFallback bo
 	// Add CPU detection for each target.
 	// IMPORTANT: Sort targets so more capable SIMD is checked first.
 	// AVX512 CPUs also have AVX2, so we must check AVX512 before AVX2.
+	// hwy.TargetEnabled lets HWY_TARGET cap the selected target.
 	sortedTargets := make([]Target, len(archTargets))
 	copy(sortedTargets, archTargets)
 	sort.Slice(sortedTargets, func(i, j int) bool {
@@ -443,16 +447,16 @@ func emitArchDispatcher(funcs []ParsedFunc, archTargets []Target, hasFallback bo
 	for _, target := range sortedTargets {
 		switch target.Name {
 		case "AVX512":
-			fmt.Fprintf(&buf, "\tif archsimd.X86.AVX512() {\n")
+			fmt.Fprintf(&buf, "\tif archsimd.X86.AVX512() && hwy.TargetEnabled(hwy.DispatchAVX512) {\n")
 			fmt.Fprintf(&buf, "\t\tinit%sAVX512()\n", capPrefix)
 			fmt.Fprintf(&buf, "\t\treturn\n")
 			fmt.Fprintf(&buf, "\t}\n")
 		case "AVX2":
-			fmt.Fprintf(&buf, "\tif archsimd.X86.AVX2() {\n")
+			fmt.Fprintf(&buf, "\tif archsimd.X86.AVX2() && hwy.TargetEnabled(hwy.DispatchAVX2) {\n")
 			fmt.Fprintf(&buf, "\t\tinit%sAVX2()\n", capPrefix)
 			fmt.Fprintf(&buf, "\t\treturn\n")
 			fmt.Fprintf(&buf, "\t}\n")
-		case "SVE_DARWIN", "SVE_LINUX":
+		case "SVE_DARWIN", "SVE_LINUX", "SVE2_LINUX":
 			// SVE dispatch is handled entirely by z_c_*.gen.go init() functions
 			// which override dispatch vars when SVE/SME is detected at runtime.
 			// No GoSimd init function is generated for SVE targets.
@@ -472,9 +476,9 @@ func emitArchDispatcher(funcs []ParsedFunc, archTargets []Target, hasFallback bo
 	fmt.Fprintf(&buf, "}\n\n")
 
 	// Generate init functions for each target
-	// SVE targets are skipped — their dispatch is handled by z_c_*.gen.go init() functions.
+	// SVE and RVV targets are skipped — their dispatch is handled by z_c_*.gen.go init() functions.
 	for _, target := range archTargets {
-		if isSVETarget(target) {
+		if isAsmOnlyTarget(target) {
 			continue
 		}
 		initFuncName := "init" + capPrefix + target.Name
@@ -498,15 +502,25 @@ func emitArchDispatcher(funcs []ParsedFunc, archTargets []Target, hasFallback bo
 					baseName = makeUnexported(baseName)
 				}
 				var implName string
-				if hasInterfaceTypeParams(pf.TypeParams) {
+				var variants [][]string
+				if hasInterfaceTypeParams(pf.TypeParams) || (isGeneric && !target.SupportsElemType(elemType)) {
 					implName = baseName + "_fallback"
 				} else {
 					implName = baseName + target.Suffix()
+					variants = featureVariants(runtimeFeatures(&pf, target))
 				}
+				typeSuffix := ""
 				if elemType != "float32" && isGeneric {
-					implName = implName + "_" + typeNameToSuffix(elemType)
+					typeSuffix = "_" + typeNameToSuffix(elemType)
+				}
+				fmt.Fprintf(&buf, "\t%s = %s%s\n", dispatchName, implName, typeSuffix)
+				// Variants for runtime-detected features (//hwy:if vnni, ...),
+				// smallest first so the most specific match is assigned last
+				for _, features := range variants {
+					fmt.Fprintf(&buf, "\tif %s {\n", featureCheck(features, target))
+					fmt.Fprintf(&buf, "\t\t%s = %s%s%s\n", dispatchName, implName, featureSuffix(features), typeSuffix)
+					fmt.Fprintf(&buf, "\t}\n")
 				}
-				fmt.Fprintf(&buf, "\t%s = %s\n", dispatchName, implName)
 			}
 		}
 
@@ -541,6 +555,18 @@ func emitArchDispatcher(funcs []ParsedFunc, archTargets []Target, hasFallback bo
 		fmt.Fprintf(&buf, "}\n")
 	}
 
+	// Per-target bindings, for hwy.ForTarget
+	var levels []kernelTargetInit
+	for _, target := range archTargets {
+		if level := dispatchLevelName(target); level != "" && !isAsmOnlyTarget(target) {
+			levels = append(levels, kernelTargetInit{level, "init" + capPrefix + target.Name})
+		}
+	}
+	if hasFallback {
+		levels = append(levels, kernelTargetInit{"DispatchScalar", "init" + capPrefix + "Fallback"})
+	}
+	emitKernelRegistration(&buf, dispatchableFuncs, pkgName, levels)
+
 	// Compute output filename
 	filePrefix := "dispatch_"
 	if useCustomPrefix {
@@ -642,6 +668,9 @@ func emitFallbackOnlyDispatcher(funcs []ParsedFunc, pkgName, outPath, prefix, su
 	}
 	fmt.Fprintf(&buf, "}\n")
 
+	emitKernelRegistration(&buf, dispatchableFuncs, pkgName,
+		[]kernelTargetInit{{"DispatchScalar", "init" + capPrefix + "Fallback"}})
+
 	// Compute output filename
 	filePrefix := "dispatch_"
 	if useCustomPrefix {
@@ -794,17 +823,14 @@ func EmitTarget(funcs []*ast.FuncDecl, target Target, pkgName, baseName, outPath
 	}
 
 	// Always add "unsafe" if it's used in the generated code (e.g. by Load),
-	// even if it wasn't in the source file.
-	if usedPkgs["unsafe"] {
-		alreadyImported := false
-		for _, imp := range imports {
-			if strings.Contains(imp, `"unsafe"`) {
-				alreadyImported = true
-				break
-			}
-		}
-		if !alreadyImported {
-			imports = append(imports, `"unsafe"`)
+	// and stdmath if math calls were renamed to it (e.g. in an inlined
+	// helper), even if the source file doesn't import them that way.
+	for _, imp := range []struct{ name, spec string }{
+		{"unsafe", `"unsafe"`},
+		{"stdmath", `stdmath "math"`},
+	} {
+		if usedPkgs[imp.name] && !slices.Contains(imports, imp.spec) {
+			imports = append(imports, imp.spec)
 		}
 	}
 
@@ -933,6 +959,59 @@ func toCamelCase(s string) string {
 	return strings.Join(parts, "")
 }
 
+// kernelTargetInit names the hwy.DispatchLevel constant of a target and the
+// init function binding the dispatch variables to its implementations.
+type kernelTargetInit struct {
+	level, initFunc string
+}
+
+// dispatchLevelName returns the name of the hwy.DispatchLevel constant of
+// target, or "" if it has none.
+func dispatchLevelName(target Target) string {
+	switch target.Name {
+	case "AVX2", "AVX512", "NEON":
+		return "Dispatch" + target.Name
+	case "Fallback":
+		return "DispatchScalar"
+	}
+	return ""
+}
+
+// emitKernelRegistration generates an init() that registers every dispatch
+// function variable with hwy.RegisterKernel, so applications can list and
+// override kernels at runtime, and records their implementations for each
+// of levels with hwy.RegisterKernelTarget, for hwy.ForTarget:
+//
+//	func init() {
+//	    hwy.RegisterKernel("vec.DotFloat32", &DotFloat32)
+//	    hwyKernels := []string{"vec.DotFloat32"}
+//	    hwy.RegisterKernelTarget(hwy.DispatchAVX2, initDotAVX2, hwyKernels...)
+//	    hwy.RegisterKernelTarget(hwy.DispatchScalar, initDotFallback, hwyKernels...)
+//	}
+func emitKernelRegistration(buf *bytes.Buffer, funcs []ParsedFunc, pkgName string, levels []kernelTargetInit) {
+	var names []string
+	fmt.Fprintf(buf, "\nfunc init() {\n")
+	for _, pf := range funcs {
+		isGeneric := len(pf.TypeParams) > 0
+		concreteTypes := []string{"float32"}
+		if isGeneric {
+			concreteTypes = GetConcreteTypes(pf.TypeParams[0].Constraint)
+		}
+		for _, elemType := range concreteTypes {
+			funcName := buildDispatchFuncName(pf.Name, elemType, isGeneric, pf.Private)
+			fmt.Fprintf(buf, "\thwy.RegisterKernel(%q, &%s)\n", pkgName+"."+funcName, funcName)
+			names = append(names, fmt.Sprintf("%q", pkgName+"."+funcName))
+		}
+	}
+	if len(levels) > 0 {
+		fmt.Fprintf(buf, "\thwyKernels := []string{%s}\n", strings.Join(names, ", "))
+		for _, l := range levels {
+			fmt.Fprintf(buf, "\thwy.RegisterKernelTarget(hwy.%s, %s, hwyKernels...)\n", l.level, l.initFunc)
+		}
+	}
+	fmt.Fprintf(buf, "}\n")
+}
+
 // emitGenericDispatcher generates a generic function that dispatches based on type.
 // For a function like BaseMatMul[T hwy.Floats], this generates:
 //
@@ -952,6 +1031,10 @@ func emitGenericDispatcher(buf *bytes.Buffer, pf ParsedFunc) {
 	// Copy doc comments from the base function, then add dispatch note
 	if pf.Doc != nil {
 		for _, comment := range pf.Doc.List {
+			// hwygen directives such as //hwy:parallel stay with the Base function.
+			if strings.HasPrefix(comment.Text, "//hwy:") {
+				continue
+			}
 			// Rewrite the first line to use the dispatch function name instead of the base name
 			text := comment.Text
 			if after, ok := strings.CutPrefix(text, "// "+pf.Name+" "); ok {
@@ -1005,14 +1088,21 @@ func emitGenericDispatcher(buf *bytes.Buffer, pf ParsedFunc) {
 
 	fmt.Fprintf(buf, " {\n")
 
-	// Find the first parameter with a generic type to use for type switch
+	// Find the first parameter with a generic type to use for type switch,
+	// preferring one of the first type parameter: paired type parameters
+	// (U in [T int8 | int16, U int32]) may not tell the variants apart.
 	var switchParam string
 	var switchParamType string
-	for _, param := range pf.Params {
-		// Check if this parameter contains a type parameter
-		if containsTypeParam(param.Type, pf.TypeParams) {
-			switchParam = param.Name
-			switchParamType = param.Type
+	for _, typeParams := range [][]TypeParam{pf.TypeParams[:1], pf.TypeParams} {
+		for _, param := range pf.Params {
+			// Check if this parameter contains a type parameter
+			if containsTypeParam(param.Type, typeParams) {
+				switchParam = param.Name
+				switchParamType = param.Type
+				break
+			}
+		}
+		if switchParam != "" {
 			break
 		}
 	}
@@ -1236,11 +1326,7 @@ func isInterfaceTypeParam(paramType string, typeParams []TypeParam) bool {
 	for _, tp := range typeParams {
 		if tp.Name == paramType {
 			// Check if this is NOT an element type constraint
-			if !strings.Contains(tp.Constraint, "Lanes") &&
-				!strings.Contains(tp.Constraint, "Floats") &&
-				!strings.Contains(tp.Constraint, "Integers") &&
-				!strings.Contains(tp.Constraint, "SignedInts") &&
-				!strings.Contains(tp.Constraint, "UnsignedInts") {
+			if !isElemTypeConstraint(tp.Constraint) {
 				return true
 			}
 		}
@@ -1267,11 +1353,7 @@ func getConstraintForParam(paramType string, typeParams []TypeParam) string {
 func containsTypeParam(typeStr string, typeParams []TypeParam) bool {
 	for _, tp := range typeParams {
 		// Check if this is an element type parameter (not an interface constraint)
-		if !strings.Contains(tp.Constraint, "Lanes") &&
-			!strings.Contains(tp.Constraint, "Floats") &&
-			!strings.Contains(tp.Constraint, "Integers") &&
-			!strings.Contains(tp.Constraint, "SignedInts") &&
-			!strings.Contains(tp.Constraint, "UnsignedInts") {
+		if !isElemTypeConstraint(tp.Constraint) {
 			continue // Skip interface type params
 		}
 
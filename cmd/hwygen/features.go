@@ -0,0 +1,115 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"slices"
+	"strings"
+)
+
+// featureConditions maps the CPU feature predicates of //hwy:if to, for each
+// target that may have the feature, the runtime check that detects it, or ""
+// if every CPU the target dispatches to has it. Targets not listed never have
+// the feature. The element type predicate bf16 keeps its meaning, so the BF16
+// dot-product extension is spelled bf16dot.
+var featureConditions = map[string]map[string]string{
+	"fma":       {"AVX2": "", "AVX512": "", "NEON": ""},
+	"f16c":      {"AVX2": "", "AVX512": ""},
+	"fp16":      {"AVX512": "hwy.HasAVX512FP16()", "NEON": "hwy.HasARMFP16()"},
+	"bf16dot":   {"AVX512": "hwy.HasAVX512BF16()", "NEON": "hwy.HasARMBF16()"},
+	"vnni":      {"AVX512": "hwy.HasAVX512VNNI()"},
+	"vpopcntdq": {"AVX512": "hwy.HasAVX512VPOPCNTDQ()"},
+	"sme":       {"NEON": "hwy.HasSME()"},
+}
+
+// featureAlways reports whether every CPU that targetName dispatches to has
+// feature.
+func featureAlways(feature, targetName string) bool {
+	check, ok := featureConditions[feature][targetName]
+	return ok && check == ""
+}
+
+// collectFeatures appends to features the feature predicates named in pc,
+// skipping duplicates.
+func (pc *ParsedCondition) collectFeatures(features []string) []string {
+	if pc == nil {
+		return features
+	}
+	if pc.Op != "" {
+		return pc.Right.collectFeatures(pc.Left.collectFeatures(features))
+	}
+	if pc.IsFeature && !slices.Contains(features, pc.Value) {
+		features = append(features, pc.Value)
+	}
+	return features
+}
+
+// blockFeatures returns the feature predicates named by the //hwy:if blocks
+// of file between lines start and end, in order of appearance.
+func blockFeatures(blocks []ConditionalBlock, file string, start, end int) []string {
+	var features []string
+	for _, block := range blocks {
+		if block.File == file && block.StartLine > start && block.EndLine < end {
+			features = block.ParsedCondition.collectFeatures(features)
+		}
+	}
+	return features
+}
+
+// runtimeFeatures returns the features of pf that target can only detect at
+// runtime. Each subset of them gets its own variant of pf for target.
+func runtimeFeatures(pf *ParsedFunc, target Target) []string {
+	var out []string
+	for _, f := range pf.Features {
+		if check := featureConditions[f][target.Name]; check != "" {
+			out = append(out, f)
+		}
+	}
+	return out
+}
+
+// featureVariants returns the non-empty subsets of features, smallest
+// first, so a dispatcher that assigns them in order ends with the largest
+// subset whose checks all pass.
+func featureVariants(features []string) [][]string {
+	var variants [][]string
+	for mask := 1; mask < 1<<len(features); mask++ {
+		var subset []string
+		for i, f := range features {
+			if mask&(1<<i) != 0 {
+				subset = append(subset, f)
+			}
+		}
+		variants = append(variants, subset)
+	}
+	slices.SortStableFunc(variants, func(a, b []string) int { return len(a) - len(b) })
+	return variants
+}
+
+// featureSuffix returns the name suffix of the variant for features, e.g.
+// "_vnni" for BaseDot_avx512_vnni.
+func featureSuffix(features []string) string {
+	return "_" + strings.Join(features, "_")
+}
+
+// featureCheck returns the dispatcher condition selecting the variant for
+// features on target.
+func featureCheck(features []string, target Target) string {
+	checks := make([]string, len(features))
+	for i, f := range features {
+		checks[i] = featureConditions[f][target.Name]
+	}
+	return strings.Join(checks, " && ")
+}
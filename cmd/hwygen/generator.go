@@ -17,6 +17,7 @@ package main
 import (
 	"fmt"
 	"go/ast"
+	"os"
 	"sort"
 	"strings"
 )
@@ -46,14 +47,25 @@ type AsmAdapterInfo struct {
 
 // Generator orchestrates the code generation process.
 type Generator struct {
-	InputFile      string       // Input Go source file
+	InputFile      string       // Input Go source file, or a package directory (see ParsePackage)
 	OutputDir      string       // Output directory
-	OutputPrefix   string       // Output file prefix (defaults to input file name without .go)
+	OutputPrefix   string       // Output file prefix (defaults to input file name without .go, or the package name)
 	TargetSpecs    []TargetSpec // Target architectures with generation modes
 	PackageOut     string       // Output package name (defaults to input package)
 	DispatchPrefix string       // Dispatch file prefix (defaults to function name)
 	FusionMode     bool         // Enable IR-based fusion optimization
+	FusionReport   string       // Write a fusion report to this file ("-" for stdout); implies FusionMode
 	Verbose        bool         // Verbose output for debugging
+	Parallel       bool         // Emit XxxParallel wrappers for all eligible functions, not just //hwy:parallel ones
+	Tests          bool         // Emit reference tests and benchmarks (<prefix>_gen_test.go)
+	AsmBackend     string       // AsmBackendGOAT (default) or AsmBackendGo for AVX-512 asm targets
+	Prune          bool         // Skip targets the cost model says cannot win (see pruneTargets)
+	PruneReport    string       // Write the pruning report to this file ("-" for stdout); implies Prune
+	Instrument     bool         // Emit z_instrument_<prefix>.gen.go with per-kernel counters for -tags hwy_instrument
+	Golden         string       // Write the generated C and assembly to this directory in canonical form (see golden.go)
+	GoldenVerify   bool         // Compare the generated C and assembly against Golden instead of writing it
+
+	goldens map[string]map[string][]byte // canonical outputs by lowercase target name and file name
 }
 
 // Targets returns the list of target name strings (for backward compatibility).
@@ -85,10 +97,25 @@ func (g *Generator) AsmMode() bool {
 	return false
 }
 
+// isPackageInput reports whether InputFile names a directory, in which case
+// the whole package is parsed so Base* functions may use helpers, constants
+// and types from any of its files.
+func (g *Generator) isPackageInput() bool {
+	info, err := os.Stat(g.InputFile)
+	return err == nil && info.IsDir()
+}
+
 // Run executes the code generation pipeline.
 func (g *Generator) Run() error {
-	// 1. Parse the input file
-	result, err := Parse(g.InputFile)
+	// 1. Parse the input file, or every file of the input package
+	packageMode := g.isPackageInput()
+	var result *ParseResult
+	var err error
+	if packageMode {
+		result, err = ParsePackage(g.InputFile)
+	} else {
+		result, err = Parse(g.InputFile)
+	}
 	if err != nil {
 		return fmt.Errorf("parse input: %w", err)
 	}
@@ -102,6 +129,21 @@ func (g *Generator) Run() error {
 		g.PackageOut = result.PackageName
 	}
 
+	baseFilename := g.OutputPrefix
+	if baseFilename == "" {
+		if packageMode {
+			baseFilename = result.PackageName
+		} else {
+			baseFilename = getBaseFilename(g.InputFile)
+		}
+	}
+
+	if g.Prune {
+		if err := g.pruneTargets(result); err != nil {
+			return err
+		}
+	}
+
 	// Handle legacy C-only mode (all targets are C or ASM, no Go SIMD targets)
 	hasGoSimd := false
 	for _, ts := range g.TargetSpecs {
@@ -111,16 +153,19 @@ func (g *Generator) Run() error {
 		}
 	}
 	if !hasGoSimd {
-		return g.runCMode(result)
+		if err := g.runCMode(result); err != nil {
+			return err
+		}
+		return g.finishGolden(baseFilename)
 	}
 
 	// Partition target specs by mode.
 	// ASM targets also get Go SIMD generation because not all functions
 	// may be ASM-eligible (e.g., Interleave, BFloat16 variants). The ASM
 	// adapter init() selectively overrides the ASM-eligible dispatch vars.
-	// SVE targets are excluded from Go SIMD generation because they have no
-	// OpMap — their dispatch is handled entirely by the z_c_*.gen.go files
-	// generated during ASM mode.
+	// SVE and RVV targets are excluded from Go SIMD generation because they
+	// have no OpMap — their dispatch is handled entirely by the z_c_*.gen.go
+	// files generated during ASM mode.
 	var goSimdSpecs []TargetSpec
 	var asmSpecs []TargetSpec
 	var cOnlySpecs []TargetSpec
@@ -130,9 +175,10 @@ func (g *Generator) Run() error {
 			goSimdSpecs = append(goSimdSpecs, ts)
 		case TargetModeAsm:
 			asmSpecs = append(asmSpecs, ts)
-			// Also generate Go SIMD for this target, unless it's an SVE target
-			// (SVE has no Go SIMD OpMap; dispatch is handled by C/ASM init files)
-			if !isSVETarget(ts.Target) {
+			// Also generate Go SIMD for this target, unless it's an SVE or RVV
+			// target (they have no Go SIMD OpMap; dispatch is handled by C/ASM
+			// init files)
+			if !isAsmOnlyTarget(ts.Target) {
 				goSimdSpecs = append(goSimdSpecs, TargetSpec{Target: ts.Target, Mode: TargetModeGoSimd})
 			}
 		case TargetModeC:
@@ -179,6 +225,9 @@ func (g *Generator) Run() error {
 			}
 
 			for _, elemType := range concreteTypes {
+				if len(pf.TypeParams) > 0 && !target.SupportsElemType(elemType) {
+					continue // dispatched to the fallback
+				}
 				if target.Name == "NEON" &&
 					(elemType == "hwy.Float16" || elemType == "hwy.BFloat16") &&
 					genericHalfPrecFuncs[pf.Name] {
@@ -187,21 +236,32 @@ func (g *Generator) Run() error {
 					transformOpts.SkipHalfPrecNEON = false
 				}
 
-				transformResult := TransformWithOptions(&pf, target, elemType, transformOpts)
+				// One variant without the runtime-detected features, plus one
+				// per subset of them; the dispatcher picks at init.
+				variants := append([][]string{nil}, featureVariants(runtimeFeatures(&pf, target))...)
+				for _, features := range variants {
+					transformOpts.Features = features
+					transformResult := TransformWithOptions(&pf, target, elemType, transformOpts)
 
-				if elemType != "float32" && len(pf.TypeParams) > 0 {
-					transformResult.FuncDecl.Name.Name = transformResult.FuncDecl.Name.Name + "_" + typeNameToSuffix(elemType)
-				}
+					if len(features) > 0 {
+						transformResult.FuncDecl.Name.Name += featureSuffix(features)
+					}
 
-				if pf.Private {
-					transformResult.FuncDecl.Name.Name = makeUnexported(transformResult.FuncDecl.Name.Name)
-				}
+					if elemType != "float32" && len(pf.TypeParams) > 0 {
+						transformResult.FuncDecl.Name.Name = transformResult.FuncDecl.Name.Name + "_" + typeNameToSuffix(elemType)
+					}
+
+					if pf.Private {
+						transformResult.FuncDecl.Name.Name = makeUnexported(transformResult.FuncDecl.Name.Name)
+					}
 
-				transformed = append(transformed, transformResult.FuncDecl)
+					transformed = append(transformed, transformResult.FuncDecl)
 
-				for _, hc := range transformResult.HoistedConsts {
-					hoistedMap[hc.VarName] = hc
+					for _, hc := range transformResult.HoistedConsts {
+						hoistedMap[hc.VarName] = hc
+					}
 				}
+				transformOpts.Features = nil
 			}
 		}
 
@@ -258,12 +318,27 @@ func (g *Generator) Run() error {
 		return fmt.Errorf("emit dispatcher: %w", err)
 	}
 
-	// 7. Emit target-specific files (Go SIMD only — ASM targets don't get Go SIMD impl files)
-	baseFilename := g.OutputPrefix
-	if baseFilename == "" {
-		baseFilename = getBaseFilename(g.InputFile)
+	// 6b. Wrap the dispatched functions with counters for -tags hwy_instrument
+	if g.Instrument {
+		if err := EmitInstrumentation(result.Funcs, g.PackageOut, g.OutputDir, g.DispatchPrefix); err != nil {
+			return fmt.Errorf("emit instrumentation: %w", err)
+		}
 	}
 
+	// 7. Emit XxxParallel wrappers around the dispatched functions
+	if err := EmitParallelWrappers(result.Funcs, g.Parallel, g.PackageOut, g.OutputDir, baseFilename); err != nil {
+		return fmt.Errorf("emit parallel wrappers: %w", err)
+	}
+
+	// 8. Emit reference tests and benchmarks against the fallback
+	if g.Tests {
+		if err := EmitReferenceTests(result.Funcs, g.PackageOut, g.OutputDir, baseFilename); err != nil {
+			return fmt.Errorf("emit reference tests: %w", err)
+		}
+	}
+
+	// 9. Emit target-specific files (Go SIMD only — ASM targets don't get Go SIMD impl files)
+
 	for _, target := range goSimdTargets {
 		funcDecls := targetFuncs[target.Name]
 		if len(funcDecls) == 0 {
@@ -277,7 +352,8 @@ func (g *Generator) Run() error {
 		}
 	}
 
-	return nil
+	// 10. Write or verify the golden C and assembly
+	return g.finishGolden(baseFilename)
 }
 
 // inferTypesFromParams examines function parameters to infer the element type.
@@ -309,11 +385,7 @@ func inferTypesFromParams(params []Param) []string {
 func hasInterfaceTypeParams(typeParams []TypeParam) bool {
 	for _, tp := range typeParams {
 		// Element type constraints - these are NOT interface constraints
-		if strings.Contains(tp.Constraint, "Lanes") ||
-			strings.Contains(tp.Constraint, "Floats") ||
-			strings.Contains(tp.Constraint, "Integers") ||
-			strings.Contains(tp.Constraint, "SignedInts") ||
-			strings.Contains(tp.Constraint, "UnsignedInts") {
+		if isElemTypeConstraint(tp.Constraint) {
 			continue
 		}
 		// Any other constraint is considered an interface constraint
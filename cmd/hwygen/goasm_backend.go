@@ -0,0 +1,84 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ajroetker/go-highway/cmd/hwygen/ir"
+)
+
+// Assembly backends selectable with -asm-backend.
+const (
+	AsmBackendGOAT = "goat" // compile the generated C with GOAT (needs clang)
+	AsmBackendGo   = "go"   // write AVX-512 Go assembly directly from the IR
+)
+
+// usesGoAsm reports whether AST-translated functions for target are first
+// offered to the Go assembly backend instead of going through C and GOAT.
+func (g *Generator) usesGoAsm(target Target) bool {
+	return g.AsmBackend == AsmBackendGo && target.Name == "AVX512"
+}
+
+// emitGoAsm compiles one instantiation of pf to Go assembly with
+// ir.EmitAVX512GoAsm and writes the .s and .gen.go files GOAT would have
+// produced for the equivalent C file, so the wrappers and dispatch emitted
+// afterwards are the same for both backends. It reports false, after
+// printing why, when pf is outside what the backend supports; the caller
+// then falls back to C and GOAT.
+func (g *Generator) emitGoAsm(pf *ParsedFunc, elemType string, target Target, outPath string) (bool, error) {
+	irFunc, err := ir.NewBuilder(ir.WithElemType(elemType)).Build(convertParsedFunc(pf))
+	if err != nil {
+		fmt.Printf("  %s (%s): Go assembly backend: build IR: %v; using GOAT\n", pf.Name, elemType, err)
+		return false, nil
+	}
+	targetSuffix := strings.ToLower(target.Name)
+	fn, err := ir.EmitAVX512GoAsm(irFunc, cAsmFuncName(pf.Name, elemType, targetSuffix))
+	if err != nil {
+		fmt.Printf("  %s (%s): Go assembly backend: %v; using GOAT\n", pf.Name, elemType, err)
+		return false, nil
+	}
+
+	base := filepath.Join(outPath, fmt.Sprintf("%s_c_%s_%s_%s",
+		strings.ToLower(pf.Name), cTypeSuffix(elemType), targetSuffix, target.Arch()))
+	header := fmt.Sprintf("//go:build %s\n// Code generated by hwygen -asm-backend=go. DO NOT EDIT.\n// %s for %s %s\n\n",
+		asmBuildTag(target), pf.Name, target.Name, elemType)
+
+	var s bytes.Buffer
+	s.WriteString(header)
+	s.WriteString("#include \"textflag.h\"\n\n")
+	s.WriteString(fn.Text)
+	if err := os.WriteFile(base+".s", s.Bytes(), 0o644); err != nil {
+		return false, fmt.Errorf("write assembly: %w", err)
+	}
+	g.recordGolden(target, base+".s")
+
+	var stub bytes.Buffer
+	stub.WriteString(header)
+	fmt.Fprintf(&stub, "package %s\n\n", goatPackageName(outPath))
+	stub.WriteString("import \"unsafe\"\n\n")
+	stub.WriteString(fn.Decl)
+	stub.WriteString("\n")
+	if err := os.WriteFile(base+".gen.go", stub.Bytes(), 0o644); err != nil {
+		return false, fmt.Errorf("write assembly stub: %w", err)
+	}
+
+	fmt.Printf("  Assembled: %s\n", filepath.Base(base)+".s")
+	return true, nil
+}
@@ -0,0 +1,217 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// Golden files.
+//
+// With -golden dir, every C file the C and asm modes generate, and the
+// assembly compiled from it, is also written in canonical form (see
+// canonicalGolden) to dir/<prefix>/<target>/. With -golden-verify the same
+// outputs are compared against those files instead, and any difference,
+// missing or no longer generated file is an error, so an upgrade of hwygen
+// that changes the code of a kernel does not go unnoticed.
+
+// recordGolden notes that target is generated in this run, and records the
+// canonical contents of the given files for it. Files that do not exist,
+// such as the assembly of a C file GOAT failed on, are skipped.
+func (g *Generator) recordGolden(target Target, paths ...string) {
+	if g.Golden == "" {
+		return
+	}
+	if g.goldens == nil {
+		g.goldens = make(map[string]map[string][]byte)
+	}
+	name := strings.ToLower(target.Name)
+	if g.goldens[name] == nil {
+		g.goldens[name] = make(map[string][]byte)
+	}
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			continue
+		}
+		g.goldens[name][filepath.Base(path)] = canonicalGolden(data)
+	}
+}
+
+// canonicalGolden returns data with what depends on the machine that
+// generated it removed: GOAT's toolchain versions block and the directory
+// of its source path, carriage returns and trailing spaces.
+func canonicalGolden(data []byte) []byte {
+	var out bytes.Buffer
+	inVersions := false
+	for line := range strings.SplitSeq(strings.ReplaceAll(string(data), "\r\n", "\n"), "\n") {
+		line = strings.TrimRight(line, " \t")
+		if inVersions && strings.HasPrefix(line, "// \t") {
+			continue
+		}
+		inVersions = line == "// versions:"
+		if inVersions {
+			continue
+		}
+		if src, ok := strings.CutPrefix(line, "// source: "); ok {
+			line = "// source: " + filepath.Base(src)
+		}
+		out.WriteString(line)
+		out.WriteByte('\n')
+	}
+	return append(bytes.TrimRight(out.Bytes(), "\n"), '\n')
+}
+
+// finishGolden writes the recorded outputs to the golden directory of
+// prefix, or with GoldenVerify compares them against it.
+func (g *Generator) finishGolden(prefix string) error {
+	if g.Golden == "" {
+		return nil
+	}
+	targets := make([]string, 0, len(g.goldens))
+	for name := range g.goldens {
+		targets = append(targets, name)
+	}
+	slices.Sort(targets)
+
+	var problems []string
+	for _, name := range targets {
+		dir := filepath.Join(g.Golden, prefix, name)
+		if g.GoldenVerify {
+			problems = append(problems, verifyGoldenDir(dir, g.goldens[name])...)
+			continue
+		}
+		if err := writeGoldenDir(dir, g.goldens[name]); err != nil {
+			return fmt.Errorf("write goldens: %w", err)
+		}
+		fmt.Printf("Wrote %d golden files to %s\n", len(g.goldens[name]), dir)
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("generated code differs from the goldens in %s (rerun with -golden and without -golden-verify to accept):\n%s",
+			filepath.Join(g.Golden, prefix), strings.Join(problems, "\n"))
+	}
+	if g.GoldenVerify {
+		fmt.Printf("Generated code matches the goldens in %s\n", filepath.Join(g.Golden, prefix))
+	}
+	return nil
+}
+
+// readGoldenDir returns the contents of the files in dir, which may not
+// exist.
+func readGoldenDir(dir string) (map[string][]byte, error) {
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	files := make(map[string][]byte)
+	for _, e := range entries {
+		if !e.Type().IsRegular() {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(dir, e.Name()))
+		if err != nil {
+			return nil, err
+		}
+		files[e.Name()] = data
+	}
+	return files, nil
+}
+
+// writeGoldenDir makes dir hold exactly files, removing goldens of outputs
+// that are no longer generated.
+func writeGoldenDir(dir string, files map[string][]byte) error {
+	old, err := readGoldenDir(dir)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	for name := range old {
+		if _, ok := files[name]; !ok {
+			if err := os.Remove(filepath.Join(dir, name)); err != nil {
+				return err
+			}
+		}
+	}
+	for name, data := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), data, 0o644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// verifyGoldenDir returns a description of each difference between files
+// and the goldens in dir.
+func verifyGoldenDir(dir string, files map[string][]byte) []string {
+	want, err := readGoldenDir(dir)
+	if err != nil {
+		return []string{fmt.Sprintf("%s: %v", dir, err)}
+	}
+	names := make([]string, 0, len(files)+len(want))
+	for name := range files {
+		names = append(names, name)
+	}
+	for name := range want {
+		if _, ok := files[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+
+	var problems []string
+	for _, name := range names {
+		path := filepath.Join(dir, name)
+		got, generated := files[name]
+		golden, ok := want[name]
+		switch {
+		case !ok:
+			problems = append(problems, fmt.Sprintf("%s: no golden file", path))
+		case !generated:
+			problems = append(problems, fmt.Sprintf("%s: no longer generated", path))
+		case !bytes.Equal(got, golden):
+			problems = append(problems, fmt.Sprintf("%s: %s", path, firstDifference(golden, got)))
+		}
+	}
+	return problems
+}
+
+// firstDifference describes the first line at which got differs from
+// golden.
+func firstDifference(golden, got []byte) string {
+	a := strings.Split(string(golden), "\n")
+	b := strings.Split(string(got), "\n")
+	for i := 0; ; i++ {
+		switch {
+		case i >= len(a):
+			return fmt.Sprintf("line %d added:\n\t+ %s", i+1, b[i])
+		case i >= len(b):
+			return fmt.Sprintf("line %d removed:\n\t- %s", i+1, a[i])
+		case a[i] != b[i]:
+			return fmt.Sprintf("line %d changed:\n\t- %s\n\t+ %s", i+1, a[i], b[i])
+		}
+	}
+}
@@ -6,10 +6,12 @@ import (
 	"go/ast"
 	"go/parser"
 	"go/token"
+	"maps"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"runtime"
+	"slices"
 	"strings"
 	"testing"
 )
@@ -82,7 +84,8 @@ func TestGetConcreteTypes(t *testing.T) {
 		{"hwy.FloatsNative", 2, "float32"}, // float32, float64 only (no half-precision)
 		{"hwy.SignedInts", 2, "int32"},
 		{"hwy.Integers", 4, "int32"},
-		{"unknown", 2, "float32"}, // Default
+		{"uint8 | ~uint16 | int16", 3, "uint8"}, // explicit lane types
+		{"unknown", 2, "float32"},               // Default
 	}
 
 	for _, tt := range tests {
@@ -273,6 +276,31 @@ func BaseAdd[T hwy.Floats](a, b, result []T) {
 	if !strings.Contains(dispatchStr, "func initAddFallback()") {
 		t.Error("Dispatcher missing initAddFallback function")
 	}
+
+	// CPU detection should honor HWY_TARGET via hwy.TargetEnabled
+	if !strings.Contains(dispatchStr, "if archsimd.X86.AVX2() && hwy.TargetEnabled(hwy.DispatchAVX2) {") {
+		t.Error("Dispatcher init does not check hwy.TargetEnabled for AVX2")
+	}
+
+	// Dispatch variables are registered so they can be listed and overridden
+	if !strings.Contains(dispatchStr, `hwy.RegisterKernel("testadd.AddFloat32", &AddFloat32)`) {
+		t.Error("Dispatcher does not register AddFloat32 with hwy.RegisterKernel")
+	}
+	otherContent, err := os.ReadFile(filepath.Join(tmpDir, "dispatch_add_other.gen.go"))
+	if err != nil {
+		t.Fatalf("Failed to read fallback dispatcher: %v", err)
+	}
+	if !strings.Contains(string(otherContent), `hwy.RegisterKernel("testadd.AddFloat64", &AddFloat64)`) {
+		t.Error("Fallback dispatcher does not register AddFloat64 with hwy.RegisterKernel")
+	}
+
+	// and their implementations for each target, for hwy.ForTarget
+	if !strings.Contains(dispatchStr, "hwy.RegisterKernelTarget(hwy.DispatchAVX2, initAddAVX2, hwyKernels...)") {
+		t.Error("Dispatcher does not register the AVX2 implementations with hwy.RegisterKernelTarget")
+	}
+	if !strings.Contains(string(otherContent), "hwy.RegisterKernelTarget(hwy.DispatchScalar, initAddFallback, hwyKernels...)") {
+		t.Error("Fallback dispatcher does not register the fallback implementations with hwy.RegisterKernelTarget")
+	}
 }
 
 func TestSpecializeType(t *testing.T) {
@@ -536,6 +564,14 @@ func TestParseCondition(t *testing.T) {
 		{"int || uint matches int32", "int || uint", "AVX2", "int32", true},
 		{"int || uint matches uint64", "int || uint", "AVX2", "uint64", true},
 		{"int || uint doesn't match float32", "int || uint", "AVX2", "float32", false},
+
+		// Feature conditions: true only where every CPU of the target has it
+		{"fma matches AVX2", "fma", "AVX2", "float32", true},
+		{"fma doesn't match Fallback", "fma", "Fallback", "float32", false},
+		{"vnni is runtime-detected on AVX512", "vnni", "AVX512", "int8", false},
+		{"!vnni matches AVX512", "!vnni", "AVX512", "int8", true},
+		{"sme doesn't match AVX2", "sme", "AVX2", "float32", false},
+		{"bf16 is still a type", "bf16", "NEON", "hwy.BFloat16", true},
 	}
 
 	for _, tt := range tests {
@@ -3127,6 +3163,7 @@ func TestTranslateRaBitQQuantizeVectors(t *testing.T) {
 	}
 
 	translator := NewCASTTranslator(profile, "float32")
+	translator.SetHelpers(result.AllFuncs)
 	cCode, err := translator.TranslateToC(fn)
 	if err != nil {
 		t.Fatalf("TranslateToC failed: %v", err)
@@ -3916,6 +3953,183 @@ func BaseGetLaneTest(data []float32, n int) {
 	}
 }
 
+// TestTranslateGatherScatterCompress verifies that gathers, scatters, masked
+// loads/stores and compress ops translate to native intrinsics on SVE and
+// AVX-512, and to lane loops over stack buffers on NEON.
+func TestTranslateGatherScatterCompress(t *testing.T) {
+	fset := token.NewFileSet()
+	src := `package test
+import "github.com/ajroetker/go-highway/hwy"
+func BaseGatherFilter(table []float32, idx []int32, dst []float32) {
+	lanes := hwy.MaxLanes[float32]()
+	n := 0
+	for i := 0; i+lanes <= len(idx); i += lanes {
+		v := hwy.GatherIndex(table, hwy.Load(idx[i:]))
+		m := hwy.GreaterThan(v, hwy.Zero[float32]())
+		w := hwy.MaskLoad(m, dst[i:])
+		hwy.MaskStore(m, hwy.Add(v, w), dst[i:])
+		packed, cnt := hwy.Compress(v, m)
+		slots := hwy.Load(idx[i:])
+		hwy.ScatterIndex(packed, table, slots)
+		n += cnt
+		n += hwy.CompressStore(v, m, dst[n:])
+	}
+}
+`
+	file, err := parser.ParseFile(fset, "test.go", src, parser.SkipObjectResolution)
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	funcDecl := file.Decls[1].(*ast.FuncDecl)
+
+	pf := &ParsedFunc{
+		Name: "BaseGatherFilter",
+		Params: []Param{
+			{Name: "table", Type: "[]float32"},
+			{Name: "idx", Type: "[]int32"},
+			{Name: "dst", Type: "[]float32"},
+		},
+		Body: funcDecl.Body,
+		HwyCalls: []HwyCall{
+			{Package: "hwy", FuncName: "GatherIndex"},
+			{Package: "hwy", FuncName: "MaskLoad"},
+			{Package: "hwy", FuncName: "MaskStore"},
+			{Package: "hwy", FuncName: "Compress"},
+			{Package: "hwy", FuncName: "ScatterIndex"},
+			{Package: "hwy", FuncName: "CompressStore"},
+		},
+	}
+	if !IsASTCEligible(pf) {
+		t.Fatal("function using GatherIndex/Compress should be AST-C eligible")
+	}
+
+	tests := []struct {
+		target string
+		want   []string
+	}{
+		{"NEON", []string{
+			"_gather_0[_l] = (table)[(idx + i)[_l]];",
+			"int _idx_",
+			"_maskload_",
+			"if (_mask_",
+			"float32x4_t packed = vld1q_f32(_compress_",
+			"long cnt = _count_",
+			"(table)[_idx_",
+			"(dst + n)[_count_",
+		}},
+		{"SVE_LINUX", []string{
+			"svld1_f32(m, ",
+			"svst1_f32(m, ",
+			"svcompact_f32(_mask_",
+			"svcntp_b32(pg, _mask_",
+			"svld1_gather_s32index_f32(pg, table, svreinterpret_s32_f32(svld1_f32(pg, (float *)(idx + i))))",
+			"svst1_scatter_s32index_f32(pg, table, svreinterpret_s32_f32(slots), packed);",
+			"svst1_f32(svwhilelt_b32_s64(0, _count_",
+		}},
+		{"AVX512", []string{
+			"_gather_0[_l] = (table)[(idx + i)[_l]];",
+			"_mm512_maskz_loadu_ps(m, ",
+			"_mm512_mask_storeu_ps(",
+			"_mm512_maskz_compress_ps(_mask_",
+			"__builtin_popcount(_mask_",
+			"(__mmask16)((1u << (_count_",
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.target, func(t *testing.T) {
+			profile := GetCProfile(tt.target, "float32")
+			if profile == nil {
+				t.Fatalf("%s float32 profile not found", tt.target)
+			}
+			cCode, err := NewCASTTranslator(profile, "float32").TranslateToC(pf)
+			if err != nil {
+				t.Fatalf("TranslateToC failed: %v", err)
+			}
+			for _, op := range []string{"hwy_gatherindex(", "hwy_maskload(", "hwy_compress"} {
+				if strings.Contains(cCode, op) {
+					t.Errorf("%s fell through to the unknown-op placeholder:\n%s", op, cCode)
+				}
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(cCode, want) {
+					t.Errorf("missing %q in:\n%s", want, cCode)
+				}
+			}
+		})
+	}
+}
+
+// TestTranslateDeferredReduceSum verifies that acc += hwy.ReduceSum(v) in a
+// loop keeps a vector accumulator and reduces it once after the loop, and
+// that scalars read elsewhere in the loop keep the per-iteration reduction.
+func TestTranslateDeferredReduceSum(t *testing.T) {
+	fset := token.NewFileSet()
+	src := `package test
+import "github.com/ajroetker/go-highway/hwy"
+func BaseSums(a, b []float32, n int) {
+	var dot, total, peak float32
+	lanes := hwy.MaxLanes[float32]()
+	for i := 0; i+lanes <= n; i += lanes {
+		va := hwy.Load(a[i:])
+		dot += hwy.ReduceSum(hwy.Mul(va, hwy.Load(b[i:])))
+		total += hwy.ReduceSum(va)
+		peak += hwy.ReduceSum(va)
+		if peak > 100 {
+			break
+		}
+	}
+	a[0] = dot + total + peak
+}
+`
+	file, err := parser.ParseFile(fset, "test.go", src, parser.SkipObjectResolution)
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	pf := &ParsedFunc{
+		Name: "BaseSums",
+		Params: []Param{
+			{Name: "a", Type: "[]float32"},
+			{Name: "b", Type: "[]float32"},
+			{Name: "n", Type: "int"},
+		},
+		Body:     file.Decls[1].(*ast.FuncDecl).Body,
+		HwyCalls: []HwyCall{{Package: "hwy", FuncName: "ReduceSum"}},
+	}
+
+	tests := []struct {
+		target string
+		want   []string
+	}{
+		{"NEON", []string{
+			"float32x4_t _racc_0 = vdupq_n_f32(0.0f);",
+			"_racc_0 = vfmaq_f32(_racc_0, va, vld1q_f32(b + i));",
+			"_racc_1 = vaddq_f32(_racc_1, va);",
+			"peak += vaddvq_f32(va);",
+			"}\n    dot += vaddvq_f32(_racc_0);\n    total += vaddvq_f32(_racc_1);",
+		}},
+		{"SVE_LINUX", []string{
+			"_racc_0 = svmla_f32_x(pg, _racc_0, va, svld1_f32(pg, b + i));",
+			"dot += svaddv_f32(pg, _racc_0);",
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.target, func(t *testing.T) {
+			cCode, err := NewCASTTranslator(GetCProfile(tt.target, "float32"), "float32").TranslateToC(pf)
+			if err != nil {
+				t.Fatalf("TranslateToC failed: %v", err)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(cCode, want) {
+					t.Errorf("missing %q in:\n%s", want, cCode)
+				}
+			}
+			if strings.Contains(cCode, "peak += _racc") || strings.Contains(cCode, "dot += vaddvq_f32(vmulq") {
+				t.Errorf("wrong scalars deferred:\n%s", cCode)
+			}
+		})
+	}
+}
+
 // TestTranslateMathFloat32bits verifies that math.Float32bits and math.Float32frombits
 // are translated to float_to_bits() and bits_to_float() helper calls.
 func TestTranslateMathFloat32bits(t *testing.T) {
@@ -3984,7 +4198,7 @@ func BaseSignTest(data []float32, n int) {
 }
 
 // TestTranslateGetSignBit verifies that the getSignBit helper is inlined as
-// (float_to_bits(x) >> 31) in the translator.
+// (float_to_bits(x) >> 31) in the translator, like any small pure helper.
 func TestTranslateGetSignBit(t *testing.T) {
 	profile := GetCProfile("NEON", "float32")
 	if profile == nil {
@@ -4009,16 +4223,20 @@ func BaseSignBitTest(data []float32, n int) {
 		t.Fatalf("parse: %v", err)
 	}
 
-	// Find the BaseSignBitTest function
-	var funcDecl *ast.FuncDecl
+	// Find the BaseSignBitTest function and its helper
+	var funcDecl, helperDecl *ast.FuncDecl
 	for _, decl := range file.Decls {
-		if fd, ok := decl.(*ast.FuncDecl); ok && fd.Name.Name == "BaseSignBitTest" {
-			funcDecl = fd
-			break
+		if fd, ok := decl.(*ast.FuncDecl); ok {
+			switch fd.Name.Name {
+			case "BaseSignBitTest":
+				funcDecl = fd
+			case "getSignBit":
+				helperDecl = fd
+			}
 		}
 	}
-	if funcDecl == nil {
-		t.Fatal("BaseSignBitTest not found")
+	if funcDecl == nil || helperDecl == nil {
+		t.Fatal("BaseSignBitTest or getSignBit not found")
 	}
 
 	pf := &ParsedFunc{
@@ -4034,6 +4252,14 @@ func BaseSignBitTest(data []float32, n int) {
 	}
 
 	translator := NewCASTTranslator(profile, "float32")
+	translator.SetHelpers(map[string]*ParsedFunc{
+		"getSignBit": {
+			Name:    "getSignBit",
+			Params:  []Param{{Name: "f", Type: "float32"}},
+			Returns: []Param{{Type: "uint32"}},
+			Body:    helperDecl.Body,
+		},
+	})
 	cCode, err := translator.TranslateToC(pf)
 	if err != nil {
 		t.Fatalf("TranslateToC failed: %v", err)
@@ -4492,6 +4718,245 @@ func BaseSlideUp(data []float32, n int) {
 	}
 }
 
+func TestASTTranslatorRotateLanes(t *testing.T) {
+	profile := GetCProfile("NEON", "float32")
+	if profile == nil {
+		t.Fatal("NEON float32 profile not found")
+	}
+
+	fset := token.NewFileSet()
+	src := `package test
+
+import "github.com/ajroetker/go-highway/hwy"
+
+func BaseRotate(data []float32, n int) {
+	for i := 0; i < n; i += 4 {
+		v := hwy.Load(data[i:])
+		r := hwy.RotateLanes(v, 1)
+		hwy.Store(r, data[i:])
+	}
+}
+`
+	file, err := parser.ParseFile(fset, "test.go", src, parser.SkipObjectResolution)
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+
+	var funcDecl *ast.FuncDecl
+	for _, decl := range file.Decls {
+		if fd, ok := decl.(*ast.FuncDecl); ok && fd.Name.Name == "BaseRotate" {
+			funcDecl = fd
+			break
+		}
+	}
+	if funcDecl == nil {
+		t.Fatal("no function found")
+	}
+
+	pf := &ParsedFunc{
+		Name: "BaseRotate",
+		Params: []Param{
+			{Name: "data", Type: "[]float32"},
+			{Name: "n", Type: "int"},
+		},
+		Body:     funcDecl.Body,
+		HwyCalls: []HwyCall{{Package: "hwy", FuncName: "Load"}, {Package: "hwy", FuncName: "RotateLanes"}, {Package: "hwy", FuncName: "Store"}},
+	}
+
+	translator := NewCASTTranslator(profile, "float32")
+	cCode, err := translator.TranslateToC(pf)
+	if err != nil {
+		t.Fatalf("TranslateToC failed: %v", err)
+	}
+
+	// RotateLanes(v, 1) → vextq_f32(v, v, 1)
+	if !strings.Contains(cCode, "vextq_f32(v, v, 1)") {
+		t.Errorf("missing vextq_f32(v, v, 1) for RotateLanes(v, 1):\n%s", cCode)
+	}
+}
+
+func TestASTTranslatorRotateLanesVariable(t *testing.T) {
+	src := `package test
+
+import "github.com/ajroetker/go-highway/hwy"
+
+func BaseRotate(data []float32, k, n int) {
+	for i := 0; i < n; i += 4 {
+		v := hwy.Load(data[i:])
+		hwy.Store(hwy.RotateLanes(v, k), data[i:])
+	}
+}
+`
+	file, err := parser.ParseFile(token.NewFileSet(), "test.go", src, parser.SkipObjectResolution)
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	funcDecl := file.Decls[1].(*ast.FuncDecl)
+
+	tests := []struct {
+		target  string
+		want    []string
+		wantErr bool
+	}{
+		{target: "NEON", want: []string{"_rotated", "vld1q_f32(_rotated"}},
+		{target: "AVX2", want: []string{"_rotated", "_mm256_loadu_ps(_rotated"}},
+		// Sizeless vectors cannot be spilled to fixed-size buffers.
+		{target: "SVE_LINUX", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.target, func(t *testing.T) {
+			profile := GetCProfile(tt.target, "float32")
+			if profile == nil {
+				t.Fatalf("%s float32 profile not found", tt.target)
+			}
+			pf := &ParsedFunc{
+				Name: "BaseRotate",
+				Params: []Param{
+					{Name: "data", Type: "[]float32"},
+					{Name: "k", Type: "int"},
+					{Name: "n", Type: "int"},
+				},
+				Body:     funcDecl.Body,
+				HwyCalls: []HwyCall{{Package: "hwy", FuncName: "Load"}, {Package: "hwy", FuncName: "RotateLanes"}, {Package: "hwy", FuncName: "Store"}},
+			}
+			cCode, err := NewCASTTranslator(profile, "float32").TranslateToC(pf)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("TranslateToC succeeded, want an error:\n%s", cCode)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("TranslateToC failed: %v", err)
+			}
+			for _, w := range tt.want {
+				if !strings.Contains(cCode, w) {
+					t.Errorf("missing %q:\n%s", w, cCode)
+				}
+			}
+			if strings.Contains(cCode, "/* RotateLanes") {
+				t.Errorf("RotateLanes left as a placeholder:\n%s", cCode)
+			}
+		})
+	}
+}
+
+func TestASTTranslatorZipConcat(t *testing.T) {
+	src := `package test
+
+import "github.com/ajroetker/go-highway/hwy"
+
+func BaseDeinterleave(xy, x, y []float32, n int) {
+	for i := 0; i < n; i += 4 {
+		lo := hwy.Load(xy[2*i:])
+		hi := hwy.Load(xy[2*i+4:])
+		hwy.Store(hwy.ConcatEven(lo, hi), x[i:])
+		hwy.Store(hwy.ConcatOdd(lo, hi), y[i:])
+		hwy.Store(hwy.ZipLower(lo, hi), xy[2*i:])
+	}
+}
+`
+	file, err := parser.ParseFile(token.NewFileSet(), "test.go", src, parser.SkipObjectResolution)
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	funcDecl := file.Decls[1].(*ast.FuncDecl)
+
+	tests := []struct {
+		target string
+		want   []string
+	}{
+		{"NEON", []string{"vuzp1q_f32(lo, hi)", "vuzp2q_f32(lo, hi)", "vzip1q_f32(lo, hi)"}},
+		// SVE permutes take no predicate.
+		{"SVE_LINUX", []string{"svuzp1_f32(lo, hi)", "svuzp2_f32(lo, hi)", "svzip1_f32(lo, hi)"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.target, func(t *testing.T) {
+			profile := GetCProfile(tt.target, "float32")
+			if profile == nil {
+				t.Fatalf("%s float32 profile not found", tt.target)
+			}
+			pf := &ParsedFunc{
+				Name: "BaseDeinterleave",
+				Params: []Param{
+					{Name: "xy", Type: "[]float32"},
+					{Name: "x", Type: "[]float32"},
+					{Name: "y", Type: "[]float32"},
+					{Name: "n", Type: "int"},
+				},
+				Body: funcDecl.Body,
+				HwyCalls: []HwyCall{
+					{Package: "hwy", FuncName: "Load"},
+					{Package: "hwy", FuncName: "Store"},
+					{Package: "hwy", FuncName: "ConcatEven"},
+					{Package: "hwy", FuncName: "ConcatOdd"},
+					{Package: "hwy", FuncName: "ZipLower"},
+				},
+			}
+			cCode, err := NewCASTTranslator(profile, "float32").TranslateToC(pf)
+			if err != nil {
+				t.Fatalf("TranslateToC failed: %v", err)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(cCode, want) {
+					t.Errorf("missing %s in:\n%s", want, cCode)
+				}
+			}
+		})
+	}
+}
+
+// TestPermutationOpsLowering verifies that lane permutations are lowered to the
+// per-target hwy wrappers rather than to methods the vector packages lack.
+func TestPermutationOpsLowering(t *testing.T) {
+	tmpDir := t.TempDir()
+	inputFile := filepath.Join(tmpDir, "perm.go")
+	content := `package testperm
+
+import "github.com/ajroetker/go-highway/hwy"
+
+func BaseRotateOddEven(a, b, dst []float32) {
+	n := hwy.NumLanes[float32]()
+	for i := 0; i <= len(a)-n; i += n {
+		va := hwy.Load(a[i:])
+		vb := hwy.Load(b[i:])
+		r := hwy.RotateLanes(va, 1)
+		hwy.Store(hwy.OddEven(r, vb), dst[i:])
+	}
+}
+`
+	if err := os.WriteFile(inputFile, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to create input file: %v", err)
+	}
+
+	gen := &Generator{
+		InputFile:   inputFile,
+		OutputDir:   tmpDir,
+		TargetSpecs: makeTestSpecs(TargetModeGoSimd, "avx2", "neon"),
+	}
+	if err := gen.Run(); err != nil {
+		t.Fatalf("Generator.Run() failed: %v", err)
+	}
+
+	for _, tc := range []struct {
+		file string
+		want []string
+	}{
+		{"perm_avx2.gen.go", []string{"hwy.RotateLanes_AVX2_F32x8(", "hwy.OddEven_AVX2_F32x8("}},
+		{"perm_neon.gen.go", []string{"hwy.RotateLanes_NEON_F32x4(", "hwy.OddEven_NEON_F32x4("}},
+	} {
+		data, err := os.ReadFile(filepath.Join(tmpDir, tc.file))
+		if err != nil {
+			t.Fatalf("Failed to read %s: %v", tc.file, err)
+		}
+		for _, want := range tc.want {
+			if !strings.Contains(string(data), want) {
+				t.Errorf("%s: missing %s", tc.file, want)
+			}
+		}
+	}
+}
+
 func TestASTTranslatorSlideUpLanesF64(t *testing.T) {
 	profile := GetCProfile("NEON", "float64")
 	if profile == nil {
@@ -4679,3 +5144,2401 @@ func TestGroupGoParams_MixedSliceTypes(t *testing.T) {
 		t.Errorf("groupGoParams should preserve concrete []float32 type: %q", sig)
 	}
 }
+
+// TestStreamingOpsLowering verifies that StoreStream is lowered to the
+// per-target wrappers while Prefetch and FlushStream stay generic hwy calls.
+func TestStreamingOpsLowering(t *testing.T) {
+	tmpDir := t.TempDir()
+	inputFile := filepath.Join(tmpDir, "stream.go")
+	content := `package teststream
+
+import "github.com/ajroetker/go-highway/hwy"
+
+func BaseScaleStream(src, dst []float32, s float32) {
+	n := hwy.NumLanes[float32]()
+	vs := hwy.Set(s)
+	for i := 0; i <= len(src)-n; i += n {
+		hwy.Prefetch(src[i:], 8*n)
+		v := hwy.Load(src[i:])
+		hwy.StoreStream(hwy.Mul(v, vs), dst[i:])
+	}
+	hwy.FlushStream()
+}
+`
+	if err := os.WriteFile(inputFile, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to create input file: %v", err)
+	}
+
+	gen := &Generator{
+		InputFile:   inputFile,
+		OutputDir:   tmpDir,
+		TargetSpecs: makeTestSpecs(TargetModeGoSimd, "avx2", "neon"),
+	}
+	if err := gen.Run(); err != nil {
+		t.Fatalf("Generator.Run() failed: %v", err)
+	}
+
+	for _, tc := range []struct {
+		file string
+		want []string
+	}{
+		{"stream_avx2.gen.go", []string{"hwy.StoreStream_AVX2_F32x8(", "hwy.Prefetch(", "hwy.FlushStream()"}},
+		{"stream_neon.gen.go", []string{"hwy.StoreStream_NEON_F32x4(", "hwy.Prefetch(", "hwy.FlushStream()"}},
+	} {
+		data, err := os.ReadFile(filepath.Join(tmpDir, tc.file))
+		if err != nil {
+			t.Fatalf("Failed to read %s: %v", tc.file, err)
+		}
+		for _, want := range tc.want {
+			if !strings.Contains(string(data), want) {
+				t.Errorf("%s: missing %s", tc.file, want)
+			}
+		}
+	}
+}
+
+// TestMaskedArithmeticLowering verifies that the masked arithmetic ops are
+// lowered to the per-target hwy wrappers.
+func TestMaskedArithmeticLowering(t *testing.T) {
+	tmpDir := t.TempDir()
+	inputFile := filepath.Join(tmpDir, "masked.go")
+	content := `package testmasked
+
+import "github.com/ajroetker/go-highway/hwy"
+
+func BaseSumPositive(src, dst []float32) {
+	n := hwy.NumLanes[float32]()
+	acc := hwy.Zero[float32]()
+	zero := hwy.Zero[float32]()
+	for i := 0; i <= len(src)-n; i += n {
+		v := hwy.Load(src[i:])
+		acc = hwy.MaskedAdd(hwy.GreaterThan(v, zero), acc, v)
+		hwy.Store(hwy.ZeroIfNegative(v), dst[i:])
+	}
+	hwy.Store(acc, dst)
+}
+`
+	if err := os.WriteFile(inputFile, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to create input file: %v", err)
+	}
+
+	gen := &Generator{
+		InputFile:   inputFile,
+		OutputDir:   tmpDir,
+		TargetSpecs: makeTestSpecs(TargetModeGoSimd, "avx2", "avx512", "neon"),
+	}
+	if err := gen.Run(); err != nil {
+		t.Fatalf("Generator.Run() failed: %v", err)
+	}
+
+	for _, tc := range []struct {
+		file string
+		want []string
+	}{
+		{"masked_avx2.gen.go", []string{"hwy.MaskedAdd_AVX2_F32x8(", "hwy.ZeroIfNegative_AVX2_F32x8("}},
+		{"masked_avx512.gen.go", []string{"hwy.MaskedAdd_AVX512_F32x16(", "hwy.ZeroIfNegative_AVX512_F32x16("}},
+		{"masked_neon.gen.go", []string{"hwy.MaskedAdd_NEON_F32x4(", "hwy.ZeroIfNegative_NEON_F32x4("}},
+	} {
+		data, err := os.ReadFile(filepath.Join(tmpDir, tc.file))
+		if err != nil {
+			t.Fatalf("Failed to read %s: %v", tc.file, err)
+		}
+		for _, want := range tc.want {
+			if !strings.Contains(string(data), want) {
+				t.Errorf("%s: missing %s", tc.file, want)
+			}
+		}
+	}
+}
+
+// TestStridedOpsLowering verifies that LoadStrided/StoreStrided are lowered
+// to the per-target wrappers and stay generic on the fallback target.
+func TestStridedOpsLowering(t *testing.T) {
+	tmpDir := t.TempDir()
+	inputFile := filepath.Join(tmpDir, "colscale.go")
+	content := `package testcol
+
+import "github.com/ajroetker/go-highway/hwy"
+
+func BaseColumnScale(m []float32, rows, cols, c int, s float32) {
+	n := hwy.NumLanes[float32]()
+	vs := hwy.Set(s)
+	for r := 0; r+n <= rows; r += n {
+		v := hwy.LoadStrided(m[r*cols+c:], cols)
+		hwy.StoreStrided(hwy.Mul(v, vs), m[r*cols+c:], cols)
+	}
+}
+`
+	if err := os.WriteFile(inputFile, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to create input file: %v", err)
+	}
+
+	gen := &Generator{
+		InputFile:   inputFile,
+		OutputDir:   tmpDir,
+		TargetSpecs: makeTestSpecs(TargetModeGoSimd, "avx2", "neon", "fallback"),
+	}
+	if err := gen.Run(); err != nil {
+		t.Fatalf("Generator.Run() failed: %v", err)
+	}
+
+	for _, tc := range []struct {
+		file string
+		want []string
+	}{
+		{"colscale_avx2.gen.go", []string{"hwy.LoadStrided_AVX2_F32x8(", "hwy.StoreStrided_AVX2_F32x8("}},
+		{"colscale_neon.gen.go", []string{"hwy.LoadStrided_NEON_F32x4(", "hwy.StoreStrided_NEON_F32x4("}},
+		{"colscale_fallback.gen.go", []string{"hwy.LoadStrided(", "hwy.StoreStrided("}},
+	} {
+		data, err := os.ReadFile(filepath.Join(tmpDir, tc.file))
+		if err != nil {
+			t.Fatalf("Failed to read %s: %v", tc.file, err)
+		}
+		for _, want := range tc.want {
+			if !strings.Contains(string(data), want) {
+				t.Errorf("%s: missing %s", tc.file, want)
+			}
+		}
+	}
+}
+
+// TestLanePermuteLowering verifies that Reverse2, Reverse4, ConcatLowerLower
+// and SwapAdjacentBlocks lower to per-target wrappers for float and int lanes
+// and that the generated code builds.
+func TestLanePermuteLowering(t *testing.T) {
+	tmpDir := t.TempDir()
+	inputFile := filepath.Join(tmpDir, "permute.go")
+	content := `package testpermute
+
+import "github.com/ajroetker/go-highway/hwy"
+
+func BasePermute[T float32 | float64 | int32 | int64](src, dst []T) {
+	n := hwy.NumLanes[T]()
+	for i := 0; i+n <= len(src); i += n {
+		v := hwy.Load(src[i:])
+		r := hwy.ConcatLowerLower(hwy.Reverse2(v), hwy.Reverse4(v))
+		hwy.Store(hwy.SwapAdjacentBlocks(r), dst[i:])
+	}
+}
+`
+	if err := os.WriteFile(inputFile, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to create input file: %v", err)
+	}
+
+	gen := &Generator{
+		InputFile:   inputFile,
+		OutputDir:   tmpDir,
+		TargetSpecs: makeTestSpecs(TargetModeGoSimd, "avx2", "avx512", "neon", "fallback"),
+	}
+	if err := gen.Run(); err != nil {
+		t.Fatalf("Generator.Run() failed: %v", err)
+	}
+
+	for _, tc := range []struct {
+		file string
+		want []string
+	}{
+		{"permute_avx2.gen.go", []string{
+			"hwy.SwapAdjacentBlocks_AVX2_F32x8(",
+			"hwy.ConcatLowerLower_AVX2_I32x8(hwy.Reverse2_AVX2_I32x8(v), hwy.Reverse4_AVX2_I32x8(v))",
+			"hwy.SwapAdjacentBlocks_AVX2_I64x4(",
+		}},
+		{"permute_avx512.gen.go", []string{
+			"hwy.SwapAdjacentBlocks_AVX512_F64x8(",
+			"hwy.ConcatLowerLower_AVX512_I32x16(hwy.Reverse2_AVX512_I32x16(v), hwy.Reverse4_AVX512_I32x16(v))",
+			"hwy.SwapAdjacentBlocks_AVX512_I64x8(",
+		}},
+		{"permute_neon.gen.go", []string{
+			"hwy.ConcatLowerLower_NEON_F32x4(hwy.Reverse2_NEON_F32x4(v), hwy.Reverse4_NEON_F32x4(v))",
+			"hwy.ConcatLowerLower_NEON_I64x2(hwy.Reverse2_NEON_I64x2(v), hwy.Reverse4_NEON_I64x2(v))",
+			"hwy.SwapAdjacentBlocks_NEON_I32x4(",
+		}},
+		{"permute_fallback.gen.go", []string{"hwy.SwapAdjacentBlocks(", "hwy.Reverse4("}},
+	} {
+		data, err := os.ReadFile(filepath.Join(tmpDir, tc.file))
+		if err != nil {
+			t.Fatalf("Failed to read %s: %v", tc.file, err)
+		}
+		for _, want := range tc.want {
+			if !strings.Contains(string(data), want) {
+				t.Errorf("%s: missing %s", tc.file, want)
+			}
+		}
+	}
+
+	buildGeneratedPackage(t, tmpDir, "testpermute")
+}
+
+// TestHalfVectorOpsLowering verifies that the half/quarter vector ops and
+// PromoteI8ToI32 are lowered to the per-target wrappers named after the full
+// vector, and that the generated package builds.
+func TestHalfVectorOpsLowering(t *testing.T) {
+	tmpDir := t.TempDir()
+	inputFile := filepath.Join(tmpDir, "halves.go")
+	content := `package testhalves
+
+import "github.com/ajroetker/go-highway/hwy"
+
+func BaseSwapHalves(src, dst []float32) {
+	n := hwy.NumLanes[float32]()
+	for i := 0; i+n <= len(src); i += n {
+		v := hwy.Load(src[i:])
+		hwy.Store(hwy.Combine(hwy.LowerHalf(v), hwy.UpperHalf(v)), dst[i:])
+		hwy.Store(hwy.ZeroExtendVector(hwy.LowerHalf(v)), dst[i:])
+	}
+}
+
+func BaseWidenBytes(src []int8, dst []int32) {
+	n := hwy.NumLanes[int32]()
+	for i := 0; i+4*n <= len(src); i += 4 * n {
+		v := hwy.Load(src[i:])
+		hwy.Store(hwy.PromoteI8ToI32(hwy.LowerQuarter(v)), dst[i/4:])
+	}
+}
+`
+	if err := os.WriteFile(inputFile, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to create input file: %v", err)
+	}
+
+	gen := &Generator{
+		InputFile:   inputFile,
+		OutputDir:   tmpDir,
+		TargetSpecs: makeTestSpecs(TargetModeGoSimd, "avx2", "avx512", "neon", "fallback"),
+	}
+	if err := gen.Run(); err != nil {
+		t.Fatalf("Generator.Run() failed: %v", err)
+	}
+
+	for _, tc := range []struct {
+		file string
+		want []string
+	}{
+		{"halves_avx2.gen.go", []string{
+			"hwy.Combine_AVX2_F32x8(hwy.LowerHalf_AVX2_F32x8(v), hwy.UpperHalf_AVX2_F32x8(v))",
+			"hwy.ZeroExtendVector_AVX2_F32x8(",
+			"hwy.PromoteI8ToI32_AVX2_Int8x32(hwy.LowerQuarter_AVX2_Int8x32(v))",
+		}},
+		{"halves_avx512.gen.go", []string{
+			"hwy.Combine_AVX512_F32x16(hwy.LowerHalf_AVX512_F32x16(v), hwy.UpperHalf_AVX512_F32x16(v))",
+			"hwy.ZeroExtendVector_AVX512_F32x16(",
+			"hwy.PromoteI8ToI32_AVX512_Int8x64(hwy.LowerQuarter_AVX512_Int8x64(v))",
+		}},
+		{"halves_neon.gen.go", []string{
+			"hwy.Combine_NEON_F32x4(hwy.LowerHalf_NEON_F32x4(v), hwy.UpperHalf_NEON_F32x4(v))",
+			"hwy.ZeroExtendVector_NEON_F32x4(",
+			"hwy.PromoteI8ToI32_NEON_Int8x16(hwy.LowerQuarter_NEON_Int8x16(v))",
+		}},
+		{"halves_fallback.gen.go", []string{"hwy.Combine(", "hwy.PromoteI8ToI32(hwy.LowerQuarter(v))"}},
+	} {
+		data, err := os.ReadFile(filepath.Join(tmpDir, tc.file))
+		if err != nil {
+			t.Fatalf("Failed to read %s: %v", tc.file, err)
+		}
+		for _, want := range tc.want {
+			if !strings.Contains(string(data), want) {
+				t.Errorf("%s: missing %s", tc.file, want)
+			}
+		}
+	}
+
+	buildGeneratedPackage(t, tmpDir, "testhalves")
+}
+
+// TestWideningMulLowering verifies that the int8 and int16 dot-product ops
+// are lowered to the per-target wrappers named after their narrow inputs,
+// and that int8 and int16 dot kernels build.
+func TestWideningMulLowering(t *testing.T) {
+	tmpDir := t.TempDir()
+	inputFile := filepath.Join(tmpDir, "dot.go")
+	content := `package testdot
+
+import "github.com/ajroetker/go-highway/hwy"
+
+func BaseDotI8(a, b []int8) int32 {
+	n := hwy.NumLanes[int8]()
+	acc := hwy.Zero[int32]()
+	i := 0
+	for ; i+n <= len(a); i += n {
+		va := hwy.Load(a[i:])
+		vb := hwy.Load(b[i:])
+		acc = hwy.SumOfMulQuadAccumulateI8ToI32(va, vb, acc)
+	}
+	sum := int32(hwy.ReduceSum(acc))
+	for ; i < len(a); i++ {
+		sum += int32(a[i]) * int32(b[i])
+	}
+	return sum
+}
+`
+	if err := os.WriteFile(inputFile, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to create input file: %v", err)
+	}
+
+	gen := &Generator{
+		InputFile:   inputFile,
+		OutputDir:   tmpDir,
+		TargetSpecs: makeTestSpecs(TargetModeGoSimd, "avx2", "avx512", "neon", "fallback"),
+	}
+	if err := gen.Run(); err != nil {
+		t.Fatalf("Generator.Run() failed: %v", err)
+	}
+
+	i16Dir := t.TempDir()
+	i16File := filepath.Join(i16Dir, "dot16.go")
+	i16Content := `package testdot16
+
+import "github.com/ajroetker/go-highway/hwy"
+
+func BaseDotI16(a, b []int16) int32 {
+	n := hwy.NumLanes[int16]()
+	sum0 := hwy.Zero[int32]()
+	sum1 := hwy.Zero[int32]()
+	for i := 0; i+n <= len(a); i += n {
+		va := hwy.Load(a[i:])
+		vb := hwy.Load(b[i:])
+		sum0 = hwy.ReorderWidenMulAccumulateI16ToI32(va, vb, sum0, &sum1)
+	}
+	total := hwy.RearrangeToOddPlusEvenI32(sum0, sum1)
+	return int32(hwy.ReduceSum(total))
+}
+`
+	if err := os.WriteFile(i16File, []byte(i16Content), 0644); err != nil {
+		t.Fatalf("Failed to create input file: %v", err)
+	}
+	gen16 := &Generator{
+		InputFile:   i16File,
+		OutputDir:   i16Dir,
+		TargetSpecs: makeTestSpecs(TargetModeGoSimd, "avx2", "avx512", "neon", "fallback"),
+	}
+	if err := gen16.Run(); err != nil {
+		t.Fatalf("Generator.Run() failed: %v", err)
+	}
+
+	for _, tc := range []struct {
+		file string
+		want []string
+	}{
+		{filepath.Join(tmpDir, "dot_avx2.gen.go"), []string{"hwy.SumOfMulQuadAccumulateI8ToI32_AVX2_Int8x32(va, vb, acc)", "hwy.ReduceSum_AVX2_I32x8(acc)"}},
+		{filepath.Join(tmpDir, "dot_avx512.gen.go"), []string{"hwy.SumOfMulQuadAccumulateI8ToI32_AVX512_Int8x64(va, vb, acc)"}},
+		{filepath.Join(tmpDir, "dot_neon.gen.go"), []string{"hwy.SumOfMulQuadAccumulateI8ToI32_NEON_Int8x16(va, vb, acc)"}},
+		{filepath.Join(tmpDir, "dot_fallback.gen.go"), []string{"hwy.SumOfMulQuadAccumulateI8ToI32(va, vb, acc)"}},
+		{filepath.Join(i16Dir, "dot16_avx2.gen.go"), []string{
+			"hwy.ReorderWidenMulAccumulateI16ToI32_AVX2_Int16x16(va, vb, sum0, &sum1)",
+			"hwy.RearrangeToOddPlusEvenI32_AVX2_I32x8(sum0, sum1)",
+			"hwy.ReduceSum_AVX2_I32x8(total)",
+		}},
+		{filepath.Join(i16Dir, "dot16_avx512.gen.go"), []string{
+			"hwy.ReorderWidenMulAccumulateI16ToI32_AVX512_Int16x32(va, vb, sum0, &sum1)",
+			"hwy.RearrangeToOddPlusEvenI32_AVX512_I32x16(sum0, sum1)",
+		}},
+		{filepath.Join(i16Dir, "dot16_neon.gen.go"), []string{
+			"hwy.ReorderWidenMulAccumulateI16ToI32_NEON_Int16x8(va, vb, sum0, &sum1)",
+			"hwy.RearrangeToOddPlusEvenI32_NEON_I32x4(sum0, sum1)",
+		}},
+	} {
+		data, err := os.ReadFile(tc.file)
+		if err != nil {
+			t.Fatalf("Failed to read %s: %v", tc.file, err)
+		}
+		for _, want := range tc.want {
+			if !strings.Contains(string(data), want) {
+				t.Errorf("%s: missing %s", filepath.Base(tc.file), want)
+			}
+		}
+	}
+
+	buildGeneratedPackage(t, tmpDir, "testdot")
+	buildGeneratedPackage(t, i16Dir, "testdot16")
+}
+
+// buildGeneratedPackage makes dir a module named modName that uses this
+// repository's hwy package and type-checks it for arm64 and for the host
+// without GOEXPERIMENT=simd, i.e. the NEON and fallback code paths.
+func buildGeneratedPackage(t *testing.T, dir, modName string) {
+	t.Helper()
+	repoRoot, err := filepath.Abs(filepath.Join("..", ".."))
+	if err != nil {
+		t.Fatal(err)
+	}
+	goMod := fmt.Sprintf("module %s\n\ngo 1.26\n\nrequire github.com/ajroetker/go-highway v0.0.0\n\nreplace github.com/ajroetker/go-highway => %s\n", modName, repoRoot)
+	if err := os.WriteFile(filepath.Join(dir, "go.mod"), []byte(goMod), 0644); err != nil {
+		t.Fatalf("write go.mod: %v", err)
+	}
+
+	goBin := filepath.Join(goRoot(), "bin", "go")
+	tidyCmd := exec.Command(goBin, "mod", "tidy")
+	tidyCmd.Dir = dir
+	tidyCmd.Env = append(os.Environ(), "GOWORK=off")
+	if output, err := tidyCmd.CombinedOutput(); err != nil {
+		t.Fatalf("go mod tidy failed: %v\n%s", err, output)
+	}
+
+	for _, goarch := range []string{"arm64", runtime.GOARCH} {
+		cmd := exec.Command(goBin, "vet", ".")
+		cmd.Dir = dir
+		cmd.Env = append(os.Environ(), "GOWORK=off", "GOARCH="+goarch)
+		if output, err := cmd.CombinedOutput(); err != nil {
+			t.Errorf("go vet (GOARCH=%s) of the generated package failed: %v\n%s", goarch, err, output)
+		}
+	}
+}
+
+// TestDoubleWordOpsLowering verifies that the multi-result uint64 ops are
+// lowered to the per-target wrappers with their tuple assignments intact.
+func TestDoubleWordOpsLowering(t *testing.T) {
+	tmpDir := t.TempDir()
+	inputFile := filepath.Join(tmpDir, "mix.go")
+	content := `package testmix
+
+import "github.com/ajroetker/go-highway/hwy"
+
+func BaseMix(a, b, dst []uint64) {
+	n := hwy.NumLanes[uint64]()
+	for i := 0; i+n <= len(a); i += n {
+		va := hwy.Load(a[i:])
+		vb := hwy.Load(b[i:])
+		lo, hi := hwy.Mul128(va, vb)
+		sum, _ := hwy.AddWithCarry(lo, hi, hwy.Zero[uint64]())
+		hwy.Store(hwy.Xor(sum, hwy.MulHigh64(va, vb)), dst[i:])
+	}
+}
+`
+	if err := os.WriteFile(inputFile, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to create input file: %v", err)
+	}
+
+	gen := &Generator{
+		InputFile:   inputFile,
+		OutputDir:   tmpDir,
+		TargetSpecs: makeTestSpecs(TargetModeGoSimd, "avx2", "neon"),
+	}
+	if err := gen.Run(); err != nil {
+		t.Fatalf("Generator.Run() failed: %v", err)
+	}
+
+	for _, tc := range []struct {
+		file string
+		want []string
+	}{
+		{"mix_avx2.gen.go", []string{"lo, hi := hwy.Mul128_AVX2_Uint64x4(", "hwy.AddWithCarry_AVX2_Uint64x4(", "hwy.MulHigh64_AVX2_Uint64x4("}},
+		{"mix_neon.gen.go", []string{"lo, hi := hwy.Mul128_NEON_Uint64x2(", "hwy.AddWithCarry_NEON_Uint64x2(", "hwy.MulHigh64_NEON_Uint64x2("}},
+	} {
+		data, err := os.ReadFile(filepath.Join(tmpDir, tc.file))
+		if err != nil {
+			t.Fatalf("Failed to read %s: %v", tc.file, err)
+		}
+		for _, want := range tc.want {
+			if !strings.Contains(string(data), want) {
+				t.Errorf("%s: missing %s", tc.file, want)
+			}
+		}
+	}
+}
+
+func TestASTTranslatorStoreStream(t *testing.T) {
+	src := `package test
+
+import "github.com/ajroetker/go-highway/hwy"
+
+func BaseCopyStream(src, dst []float32, n int) {
+	for i := 0; i < n; i += 4 {
+		hwy.Prefetch(src[i:], 64)
+		v := hwy.Load(src[i:])
+		hwy.StoreStream(v, dst[i:])
+	}
+	hwy.FlushStream()
+}
+`
+	file, err := parser.ParseFile(token.NewFileSet(), "test.go", src, parser.SkipObjectResolution)
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	funcDecl := file.Decls[1].(*ast.FuncDecl)
+
+	tests := []struct {
+		target string
+		want   []string
+		fence  bool
+	}{
+		{target: "NEON", want: []string{"__builtin_prefetch(", "vst1q_f32("}},
+		// The stream intrinsics fault on unaligned addresses, so unaligned
+		// destinations fall back to an ordinary store.
+		{target: "AVX2", want: []string{"& 31) == 0) ? _mm256_stream_ps(", ": _mm256_storeu_ps("}, fence: true},
+		{target: "AVX512", want: []string{"& 63) == 0) ? _mm512_stream_ps(", ": _mm512_storeu_ps("}, fence: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.target, func(t *testing.T) {
+			profile := GetCProfile(tt.target, "float32")
+			if profile == nil {
+				t.Fatalf("%s float32 profile not found", tt.target)
+			}
+			pf := &ParsedFunc{
+				Name: "BaseCopyStream",
+				Params: []Param{
+					{Name: "src", Type: "[]float32"},
+					{Name: "dst", Type: "[]float32"},
+					{Name: "n", Type: "int"},
+				},
+				Body: funcDecl.Body,
+				HwyCalls: []HwyCall{
+					{Package: "hwy", FuncName: "Prefetch"},
+					{Package: "hwy", FuncName: "Load"},
+					{Package: "hwy", FuncName: "StoreStream"},
+					{Package: "hwy", FuncName: "FlushStream"},
+				},
+			}
+			cCode, err := NewCASTTranslator(profile, "float32").TranslateToC(pf)
+			if err != nil {
+				t.Fatalf("TranslateToC failed: %v", err)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(cCode, want) {
+					t.Errorf("missing %s in:\n%s", want, cCode)
+				}
+			}
+			if got := strings.Contains(cCode, "_mm_sfence()"); got != tt.fence {
+				t.Errorf("FlushStream emitted a fence: %v, want %v:\n%s", got, tt.fence, cCode)
+			}
+		})
+	}
+}
+
+// TestMaskQueryOpsLowering verifies that mask queries lower to functions that
+// exist for every target and lane type.
+func TestMaskQueryOpsLowering(t *testing.T) {
+	tmpDir := t.TempDir()
+	inputFile := filepath.Join(tmpDir, "maskq.go")
+	content := `package testmaskq
+
+import "github.com/ajroetker/go-highway/hwy"
+
+func BaseMaskQuery(a []int32, x int32) (int, int, int, uint64) {
+	v := hwy.Load(a)
+	m := hwy.Equal(v, hwy.Set(x))
+	return hwy.CountTrue(m), hwy.FindFirstTrue(m), hwy.FindLastTrue(m), hwy.BitsFromMask(m)
+}
+`
+	if err := os.WriteFile(inputFile, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to create input file: %v", err)
+	}
+
+	gen := &Generator{
+		InputFile:   inputFile,
+		OutputDir:   tmpDir,
+		TargetSpecs: makeTestSpecs(TargetModeGoSimd, "avx2", "neon"),
+	}
+	if err := gen.Run(); err != nil {
+		t.Fatalf("Generator.Run() failed: %v", err)
+	}
+
+	for _, tc := range []struct {
+		file string
+		want []string
+	}{
+		{"maskq_avx2.gen.go", []string{"hwy.CountTrue_AVX2_I32x8(", "hwy.FindLastTrue_AVX2_I32x8(", "hwy.BitsFromMask_AVX2_I32x8("}},
+		{"maskq_neon.gen.go", []string{"asm.CountTrue(", "asm.FindFirstTrue(", "asm.FindLastTrue(", "hwy.BitsFromMask_NEON_I32x4("}},
+	} {
+		data, err := os.ReadFile(filepath.Join(tmpDir, tc.file))
+		if err != nil {
+			t.Fatalf("Failed to read %s: %v", tc.file, err)
+		}
+		for _, want := range tc.want {
+			if !strings.Contains(string(data), want) {
+				t.Errorf("%s: missing %s", tc.file, want)
+			}
+		}
+	}
+}
+
+// TestCompressExpandLowering verifies that CompressStore and Expand lower to
+// hwy wrappers on NEON, and that the wrappers for unsigned lanes accept the
+// signed masks hwy.Mask[T] lowers to there.
+func TestCompressExpandLowering(t *testing.T) {
+	tmpDir := t.TempDir()
+	inputFile := filepath.Join(tmpDir, "filter.go")
+	content := `package testfilter
+
+import "github.com/ajroetker/go-highway/hwy"
+
+func BaseFilterExpand[T int32 | int64 | uint32 | uint64](a, dst []T, pred func(hwy.Vec[T]) hwy.Mask[T]) int {
+	n := 0
+	lanes := hwy.NumLanes[T]()
+	for i := 0; i+lanes <= len(a); i += lanes {
+		v := hwy.Load(a[i:])
+		m := pred(v)
+		hwy.Store(hwy.Expand(v, m), a[i:])
+		n += hwy.CompressStore(v, m, dst[n:])
+	}
+	return n
+}
+`
+	if err := os.WriteFile(inputFile, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to create input file: %v", err)
+	}
+
+	gen := &Generator{
+		InputFile:   inputFile,
+		OutputDir:   tmpDir,
+		TargetSpecs: makeTestSpecs(TargetModeGoSimd, "avx2", "avx512", "neon", "fallback"),
+	}
+	if err := gen.Run(); err != nil {
+		t.Fatalf("Generator.Run() failed: %v", err)
+	}
+
+	for _, tc := range []struct {
+		file string
+		want []string
+	}{
+		{"filter_avx512.gen.go", []string{"hwy.Expand_AVX512_I32x16(", "hwy.CompressStore_AVX512_I32x16("}},
+		{"filter_neon.gen.go", []string{
+			"hwy.Expand_NEON_I32x4(", "hwy.CompressStore_NEON_I32x4(",
+			"hwy.Expand_NEON_Uint32x4(", "hwy.CompressStore_NEON_Uint64x2(",
+		}},
+	} {
+		data, err := os.ReadFile(filepath.Join(tmpDir, tc.file))
+		if err != nil {
+			t.Fatalf("Failed to read %s: %v", tc.file, err)
+		}
+		for _, want := range tc.want {
+			if !strings.Contains(string(data), want) {
+				t.Errorf("%s: missing %s", tc.file, want)
+			}
+		}
+	}
+
+	buildGeneratedPackage(t, tmpDir, "testfilter")
+}
+
+func TestCModeSVEVLALoop(t *testing.T) {
+	tmpDir := t.TempDir()
+	inputFile := filepath.Join(tmpDir, "exp_base.go")
+	content := `package testexp
+
+import "github.com/ajroetker/go-highway/hwy"
+
+func BaseExpVec[T hwy.Floats](x hwy.Vec[T]) hwy.Vec[T] {
+	return hwy.Mul(x, x)
+}
+`
+	if err := os.WriteFile(inputFile, []byte(content), 0644); err != nil {
+		t.Fatalf("write input: %v", err)
+	}
+
+	gen := &Generator{
+		InputFile:   inputFile,
+		OutputDir:   tmpDir,
+		TargetSpecs: makeTestSpecs(TargetModeC, "sve_linux", "sve2_linux"),
+	}
+	if err := gen.Run(); err != nil {
+		t.Fatalf("Generator.Run() in CMode failed: %v", err)
+	}
+
+	for _, tc := range []struct {
+		file, whilelt, lanes string
+	}{
+		{"baseexpvec_c_f32_sve_linux_arm64.c", "svwhilelt_b32_s64(i, n)", "svcntw()"},
+		{"baseexpvec_c_f64_sve_linux_arm64.c", "svwhilelt_b64_s64(i, n)", "svcntd()"},
+		{"baseexpvec_c_f32_sve2_linux_arm64.c", "svwhilelt_b32_s64(i, n)", "svcntw()"},
+	} {
+		data, err := os.ReadFile(filepath.Join(tmpDir, tc.file))
+		if err != nil {
+			t.Fatalf("read %s: %v", tc.file, err)
+		}
+		c := string(data)
+		for _, want := range []string{
+			"long lanes = " + tc.lanes,
+			"for (; i < n; i += lanes)",
+			"svbool_t pt = " + tc.whilelt,
+			"(pt, input + i)",
+			"(pt, result + i, res)",
+		} {
+			if !strings.Contains(c, want) {
+				t.Errorf("%s: missing %q", tc.file, want)
+			}
+		}
+		// The predicated loop replaces the scalar epilogue.
+		if strings.Contains(c, "for (; i < n; i++)") {
+			t.Errorf("%s: unexpected scalar remainder loop", tc.file)
+		}
+	}
+}
+
+func TestSVE2LinuxTarget(t *testing.T) {
+	sve, sve2 := GetCProfile("SVE_LINUX", "float32"), GetCProfile("SVE2_LINUX", "float32")
+	if sve == nil || sve2 == nil {
+		t.Fatal("missing SVE_LINUX or SVE2_LINUX float32 profile")
+	}
+	// Baseline SVE must not be compiled for SVE2, or it would fault on
+	// Graviton 3, which HasSVE() accepts.
+	if got := strings.Join(sve.GoatExtraFlags, " "); strings.Contains(got, "sve2") {
+		t.Errorf("SVE_LINUX flags = %q, want baseline SVE", got)
+	}
+	if got := strings.Join(sve2.GoatExtraFlags, " "); !strings.Contains(got, "sve2") {
+		t.Errorf("SVE2_LINUX flags = %q, want sve2", got)
+	}
+
+	target, err := GetTarget("sve2_linux")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if target.Suffix() != "_sve2_linux" || target.Arch() != "arm64" || !isSVETarget(target) {
+		t.Errorf("sve2_linux: suffix %q, arch %q, isSVE %v", target.Suffix(), target.Arch(), isSVETarget(target))
+	}
+	if got := asmRuntimeGuard(target); got != "hwy.HasSVE2()" {
+		t.Errorf("asmRuntimeGuard(SVE2_LINUX) = %q, want hwy.HasSVE2()", got)
+	}
+	if targetPriority("SVE2_LINUX") <= targetPriority("SVE_LINUX") {
+		t.Error("SVE2_LINUX should be preferred over SVE_LINUX")
+	}
+
+	// SVE_LINUX defers to SVE2_LINUX only when both are generated.
+	sveTarget, _ := GetTarget("sve_linux")
+	both := &Generator{TargetSpecs: makeTestSpecs(TargetModeAsm, "sve_linux", "sve2_linux")}
+	if got := both.sveSkipGuard(sveTarget); got != "hwy.HasSVE2()" {
+		t.Errorf("sveSkipGuard with sve2_linux = %q, want hwy.HasSVE2()", got)
+	}
+	only := &Generator{TargetSpecs: makeTestSpecs(TargetModeAsm, "sve_linux")}
+	if got := only.sveSkipGuard(sveTarget); got != "" {
+		t.Errorf("sveSkipGuard without sve2_linux = %q, want none", got)
+	}
+}
+
+func TestCModeAVXGeneration(t *testing.T) {
+	tmpDir := t.TempDir()
+	inputFile := filepath.Join(tmpDir, "exp_base.go")
+	content := `package testexp
+
+import "github.com/ajroetker/go-highway/hwy"
+
+func BaseExpVec[T hwy.Floats](x hwy.Vec[T]) hwy.Vec[T] {
+	return hwy.Mul(x, x)
+}
+`
+	if err := os.WriteFile(inputFile, []byte(content), 0644); err != nil {
+		t.Fatalf("write input: %v", err)
+	}
+
+	gen := &Generator{
+		InputFile:   inputFile,
+		OutputDir:   tmpDir,
+		TargetSpecs: makeTestSpecs(TargetModeC, "avx2", "avx512"),
+	}
+	if err := gen.Run(); err != nil {
+		t.Fatalf("Generator.Run() in CMode failed: %v", err)
+	}
+
+	for _, tc := range []struct {
+		file string
+		want []string
+	}{
+		{"baseexpvec_c_f32_avx2_amd64.c", []string{
+			"#include <immintrin.h>",
+			"void exp_c_f32_avx2(float *input, float *result, long *len)",
+			"_mm256_loadu_ps(input + i)",
+			"_mm256_fmadd_ps(",
+			"_mm256_cmp_ps(x, overflow, _CMP_GT_OQ)",
+			"_mm256_cvtps_epi32(",
+			"_mm256_blendv_ps(",
+			"for (; i < n; i++)",
+		}},
+		{"baseexpvec_c_f64_avx2_amd64.c", []string{
+			"void exp_c_f64_avx2(double *input, double *result, long *len)",
+			"_mm256_fmadd_pd(",
+			"_mm256_set1_epi64x(1023)",
+			"_mm256_slli_epi64(",
+		}},
+		{"baseexpvec_c_f32_avx512_amd64.c", []string{
+			"void exp_c_f32_avx512(float *input, float *result, long *len)",
+			"_mm512_fmadd_ps(",
+			"__mmask16 over_res0 = _mm512_cmp_ps_mask(x0, overflow, _CMP_GT_OQ)",
+			"_mm512_roundscale_ps(",
+			"_mm512_mask_blend_ps(",
+			"__mmask16 tail = (__mmask16)((1u << (n - i)) - 1)",
+			"_mm512_maskz_loadu_ps(tail, input + i)",
+			"_mm512_mask_storeu_ps(result + i, tail, res)",
+		}},
+		{"baseexpvec_c_f64_avx512_amd64.c", []string{
+			"_mm512_cvtpd_epi64(",
+			"__mmask8 tail",
+		}},
+	} {
+		data, err := os.ReadFile(filepath.Join(tmpDir, tc.file))
+		if err != nil {
+			t.Fatalf("read %s: %v", tc.file, err)
+		}
+		c := string(data)
+		for _, want := range tc.want {
+			if !strings.Contains(c, want) {
+				t.Errorf("%s: missing %q", tc.file, want)
+			}
+		}
+		for _, neon := range []string{"vdupq_n_", "vfmaq_", "arm_neon.h", "volatile"} {
+			if strings.Contains(c, neon) {
+				t.Errorf("%s: unexpected NEON construct %q", tc.file, neon)
+			}
+		}
+		if strings.Contains(tc.file, "avx512") && strings.Contains(c, "for (; i < n; i++)") {
+			t.Errorf("%s: masked tail should replace the scalar remainder", tc.file)
+		}
+	}
+}
+
+func TestTranslateAVXFloatOps(t *testing.T) {
+	goCode := `package test
+import "github.com/ajroetker/go-highway/hwy"
+func BaseTest(a []float32, b []float32, n int) {
+	va := hwy.Load(a[:])
+	vb := hwy.Load(b[:])
+	m := hwy.GreaterThan(va, vb)
+	acc := hwy.MulAdd(va, vb, hwy.IfThenElse(m, va, vb))
+	a[0] = hwy.ReduceSum(acc)
+}`
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "test.go", goCode, parser.SkipObjectResolution)
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	funcDecl := file.Decls[1].(*ast.FuncDecl)
+
+	for _, tc := range []struct {
+		target, elemType string
+		want             []string
+	}{
+		{"AVX2", "float32", []string{
+			"__m256 va = _mm256_loadu_ps(a)",
+			"__m256 m = hwy_gt_ps256(va, vb)",
+			"_mm256_fmadd_ps(va, vb, _mm256_blendv_ps(vb, va, m))",
+			"hwy_reduce_add_ps256(acc)",
+			"_mm256_cmp_ps(a, b, _CMP_GT_OQ)",
+		}},
+		{"AVX2", "float64", []string{
+			"_mm256_fmadd_pd(va, vb, _mm256_blendv_pd(vb, va, m))",
+			"_mm_cvtsd_f64(s)",
+		}},
+		{"AVX512", "float32", []string{
+			"__mmask16 m = hwy_gt_ps512(va, vb)",
+			"_mm512_fmadd_ps(va, vb, hwy_select_ps512(vb, va, m))",
+			"_mm512_mask_blend_ps(mask, no, yes)",
+			"_mm512_reduce_add_ps(acc)",
+		}},
+		{"AVX512", "float64", []string{
+			"__mmask8 m = hwy_gt_pd512(va, vb)",
+			"_mm512_reduce_add_pd(acc)",
+		}},
+	} {
+		t.Run(tc.target+"_"+tc.elemType, func(t *testing.T) {
+			profile := GetCProfile(tc.target, tc.elemType)
+			if profile == nil {
+				t.Fatalf("%s %s profile not found", tc.target, tc.elemType)
+			}
+			goType := "[]" + tc.elemType
+			pf := &ParsedFunc{
+				Name: "BaseTest",
+				Params: []Param{
+					{Name: "a", Type: goType},
+					{Name: "b", Type: goType},
+					{Name: "n", Type: "int"},
+				},
+				Body:     funcDecl.Body,
+				HwyCalls: []HwyCall{{Package: "hwy", FuncName: "Load"}},
+			}
+			cCode, err := NewCASTTranslator(profile, tc.elemType).TranslateToC(pf)
+			if err != nil {
+				t.Fatalf("TranslateToC failed: %v", err)
+			}
+			c := strings.Join(profile.InlineHelpers, "\n") + cCode
+			for _, want := range tc.want {
+				if !strings.Contains(c, want) {
+					t.Errorf("missing %q in:\n%s", want, c)
+				}
+			}
+		})
+	}
+}
+
+func TestAsmBuildTagAndGuards(t *testing.T) {
+	for _, tc := range []struct {
+		target, tag, guard string
+	}{
+		{"avx2", "!noasm && amd64", "hwy.HasAVX2()"},
+		{"avx512", "!noasm && amd64", "hwy.HasAVX512()"},
+		{"neon", "!noasm && arm64", ""},
+		{"sve_linux", "!noasm && linux && arm64", "hwy.HasSVE()"},
+		{"rvv", "!noasm && linux && riscv64", "hwy.HasRVV()"},
+		{"sme", "!noasm && darwin && arm64", "hwy.HasSME()"},
+	} {
+		target, err := GetTarget(tc.target)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := asmBuildTag(target); got != tc.tag {
+			t.Errorf("asmBuildTag(%s) = %q, want %q", tc.target, got, tc.tag)
+		}
+		if got := asmRuntimeGuard(target); got != tc.guard {
+			t.Errorf("asmRuntimeGuard(%s) = %q, want %q", tc.target, got, tc.guard)
+		}
+	}
+}
+
+func TestRVVTarget(t *testing.T) {
+	target, err := GetTarget("rvv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if target.Suffix() != "_rvv" || target.Arch() != "riscv64" || !isAsmOnlyTarget(target) {
+		t.Errorf("rvv: suffix %q, arch %q, asm-only %v", target.Suffix(), target.Arch(), isAsmOnlyTarget(target))
+	}
+
+	tmpDir := t.TempDir()
+	inputFile := filepath.Join(tmpDir, "exp_base.go")
+	content := `package testexp
+
+import "github.com/ajroetker/go-highway/hwy"
+
+func BaseExpVec[T hwy.Floats](x hwy.Vec[T]) hwy.Vec[T] {
+	return hwy.Mul(x, x)
+}
+`
+	if err := os.WriteFile(inputFile, []byte(content), 0644); err != nil {
+		t.Fatalf("write input: %v", err)
+	}
+	gen := &Generator{
+		InputFile:   inputFile,
+		OutputDir:   tmpDir,
+		TargetSpecs: makeTestSpecs(TargetModeC, "rvv"),
+	}
+	if err := gen.Run(); err != nil {
+		t.Fatalf("Generator.Run() in CMode failed: %v", err)
+	}
+
+	for _, tc := range []struct {
+		file string
+		want []string
+	}{
+		{"baseexpvec_c_f32_rvv_riscv64.c", []string{
+			"#include <riscv_vector.h>",
+			"void exp_c_f32_rvv(float *input, float *result, long *len)",
+			"long lanes = __riscv_vsetvlmax_e32m1();",
+			"size_t vl = lanes;",
+			"__riscv_vle32_v_f32m1(input + i + lanes * 1, vl)",
+			"__riscv_vfmacc_vv_f32m1(c5, c6, r_res0, vl)",
+			"vbool32_t over_res0 = __riscv_vmfgt_vv_f32m1_b32(x0, overflow, vl)",
+			"__riscv_vmerge_vvm_f32m1(",
+			"for (; i < n; i += vl) {",
+			"vl = __riscv_vsetvl_e32m1(n - i);",
+			"__riscv_vse32_v_f32m1(result + i, res, vl)",
+		}},
+		{"baseexpvec_c_f64_rvv_riscv64.c", []string{
+			"vint64m1_t bias = __riscv_vmv_v_x_i64m1(1023, vl)",
+			"__riscv_vsll_vx_i64m1(",
+			"vl = __riscv_vsetvl_e64m1(n - i);",
+		}},
+	} {
+		data, err := os.ReadFile(filepath.Join(tmpDir, tc.file))
+		if err != nil {
+			t.Fatalf("read %s: %v", tc.file, err)
+		}
+		c := string(data)
+		for _, want := range tc.want {
+			if !strings.Contains(c, want) {
+				t.Errorf("%s: missing %q", tc.file, want)
+			}
+		}
+		for _, other := range []string{"vdupq_n_", "svbool_t", "volatile", "for (; i < n; i++)"} {
+			if strings.Contains(c, other) {
+				t.Errorf("%s: unexpected %q", tc.file, other)
+			}
+		}
+	}
+
+	// The AST translator calls the VLMAX helpers.
+	goCode := `package test
+import "github.com/ajroetker/go-highway/hwy"
+func BaseTest(a []float32, b []float32, n int) {
+	va := hwy.Load(a[:])
+	vb := hwy.Load(b[:])
+	m := hwy.GreaterThan(va, vb)
+	acc := hwy.MulAdd(va, vb, hwy.IfThenElse(m, va, vb))
+	a[0] = hwy.ReduceSum(acc)
+}`
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "test.go", goCode, parser.SkipObjectResolution)
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	profile := GetCProfile("RVV", "float32")
+	if profile == nil {
+		t.Fatal("RVV float32 profile not found")
+	}
+	pf := &ParsedFunc{
+		Name: "BaseTest",
+		Params: []Param{
+			{Name: "a", Type: "[]float32"},
+			{Name: "b", Type: "[]float32"},
+			{Name: "n", Type: "int"},
+		},
+		Body:     file.Decls[1].(*ast.FuncDecl).Body,
+		HwyCalls: []HwyCall{{Package: "hwy", FuncName: "Load"}},
+	}
+	cCode, err := NewCASTTranslator(profile, "float32").TranslateToC(pf)
+	if err != nil {
+		t.Fatalf("TranslateToC failed: %v", err)
+	}
+	c := strings.Join(profile.InlineHelpers, "\n") + cCode
+	for _, want := range []string{
+		"vfloat32m1_t va = hwy_rvv_load_f32(a)",
+		"vbool32_t m = hwy_rvv_gt_f32(va, vb)",
+		"hwy_rvv_fma_f32(va, vb, hwy_rvv_select_f32(vb, va, m))",
+		"hwy_rvv_reduce_add_f32(acc)",
+		"__riscv_vmerge_vvm_f32m1(no, yes, mask, __riscv_vsetvlmax_e32m1())",
+	} {
+		if !strings.Contains(c, want) {
+			t.Errorf("missing %q in:\n%s", want, c)
+		}
+	}
+}
+
+func TestSMETarget(t *testing.T) {
+	target, err := GetTarget("sme")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if target.Suffix() != "_sme" || target.Arch() != "arm64" || !isAsmOnlyTarget(target) {
+		t.Errorf("sme: suffix %q, arch %q, asm-only %v", target.Suffix(), target.Arch(), isAsmOnlyTarget(target))
+	}
+
+	tmpDir := t.TempDir()
+	inputFile := filepath.Join(tmpDir, "mm_base.go")
+	content := `package testmm
+
+import "github.com/ajroetker/go-highway/hwy"
+
+func BaseMatMul[T hwy.Floats](a, b, c []T, m, n, k int) {
+	lanes := hwy.Zero[T]().NumLanes()
+	for i := range m {
+		cRow := c[i*n : (i+1)*n]
+		for j := range n {
+			cRow[j] = 0
+		}
+		for p := range k {
+			vA := hwy.Set(a[i*k+p])
+			bRow := b[p*n : (p+1)*n]
+			for j := 0; j+lanes <= n; j += lanes {
+				hwy.Store(hwy.MulAdd(vA, hwy.Load(bRow[j:]), hwy.Load(cRow[j:])), cRow[j:])
+			}
+		}
+	}
+}
+
+func BaseMatMulKLast[T hwy.Floats](a, b, c []T, m, n, k int) {
+	lanes := hwy.Zero[T]().NumLanes()
+	for i := range m {
+		for j := range n {
+			aRow := i * k
+			bRow := j * k
+			acc := hwy.Zero[T]()
+			for p := 0; p+lanes <= k; p += lanes {
+				acc = hwy.MulAdd(hwy.Load(a[aRow+p:]), hwy.Load(b[bRow+p:]), acc)
+			}
+			c[i*n+j] = hwy.ReduceSum(acc)
+		}
+	}
+}
+
+func BaseScale[T hwy.Floats](x, y, z []T, m, n, k int) {
+	v := hwy.Set(T(2))
+	for i := 0; i < m; i += v.NumLanes() {
+		hwy.Store(hwy.MulAdd(v, hwy.Load(x[i:]), hwy.Load(y[i:])), z[i:])
+	}
+}
+`
+	if err := os.WriteFile(inputFile, []byte(content), 0644); err != nil {
+		t.Fatalf("write input: %v", err)
+	}
+	gen := &Generator{
+		InputFile:   inputFile,
+		OutputDir:   tmpDir,
+		TargetSpecs: makeTestSpecs(TargetModeC, "sme"),
+	}
+	if err := gen.Run(); err != nil {
+		t.Fatalf("Generator.Run() in CMode failed: %v", err)
+	}
+
+	for _, tc := range []struct {
+		file string
+		want []string
+	}{
+		{"basematmul_c_f32_sme_arm64.c", []string{
+			"#include <arm_sme.h>",
+			"// C[m,n] = A[m,k] * B[k,n]",
+			`void matmul_c_f32_sme(float *a, float *b, float *c, long *pm, long *pn, long *pk) __arm_streaming __arm_out("za") {`,
+			"long vl = svcntw();",
+			"svbool_t pi = svwhilelt_b32_s64(i, m);",
+			"svzero_za();",
+			"svld1_hor_za32(1, r, pp, a + (i + r) * k + p);",
+			"svfloat32_t va = svread_ver_za32_f32_m(svdup_f32(0), pi, 1, q);",
+			"svfloat32_t vb = svld1_f32(pj, b + (p + q) * n + j);",
+			"svmopa_za32_f32_m(0, pi, pj, va, vb);",
+			"svst1_hor_za32(0, r, pj, c + (i + r) * n + j);",
+		}},
+		{"basematmul_c_f64_sme_arm64.c", []string{
+			"long vl = svcntd();",
+			"svfloat64_t va = svread_ver_za64_f64_m(svdup_f64(0), pi, 1, q);",
+			"svmopa_za64_f64_m(0, pi, pj, va, vb);",
+		}},
+		{"basematmulklast_c_f32_sme_arm64.c", []string{
+			"// C[m,n] = A[m,k] * B[n,k]^T",
+			"svld1_hor_za32(2, r, pp, b + (j + r) * k + p);",
+			"svfloat32_t vb = svread_ver_za32_f32_m(svdup_f32(0), pj, 2, q);",
+		}},
+	} {
+		data, err := os.ReadFile(filepath.Join(tmpDir, tc.file))
+		if err != nil {
+			t.Fatalf("read %s: %v", tc.file, err)
+		}
+		for _, want := range tc.want {
+			if !strings.Contains(string(data), want) {
+				t.Errorf("%s: missing %q", tc.file, want)
+			}
+		}
+	}
+
+	// BaseScale has the matmul signature but not its strides.
+	if _, err := os.Stat(filepath.Join(tmpDir, "basescale_c_f32_sme_arm64.c")); err == nil {
+		t.Error("BaseScale: unexpected SME kernel")
+	}
+	if got := GetCProfile("SME", "float64").GoatExtraFlags; len(got) != 1 || got[0] != "-march=armv9-a+sme+sme-f64f64" {
+		t.Errorf("SME float64 flags = %v", got)
+	}
+}
+
+func TestFeatureConditionVariants(t *testing.T) {
+	tmpDir := t.TempDir()
+	inputFile := filepath.Join(tmpDir, "scale.go")
+	content := `package testfeature
+
+import "github.com/ajroetker/go-highway/hwy"
+
+func BaseScale(x []float32, s float32) {
+	vs := hwy.Set(s)
+	lanes := hwy.MaxLanes[float32]()
+	for i := 0; i+lanes <= len(x); i += lanes {
+		v := hwy.Load(x[i:])
+		//hwy:if vnni || sme
+		v = hwy.Mul(v, vs)
+		//hwy:else
+		v = hwy.Add(v, vs)
+		//hwy:endif
+		hwy.Store(v, x[i:])
+	}
+}
+`
+	if err := os.WriteFile(inputFile, []byte(content), 0644); err != nil {
+		t.Fatalf("write input: %v", err)
+	}
+	gen := &Generator{
+		InputFile:   inputFile,
+		OutputDir:   tmpDir,
+		TargetSpecs: makeTestSpecs(TargetModeGoSimd, "avx2", "avx512", "neon", "fallback"),
+	}
+	if err := gen.Run(); err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+
+	read := func(name string) string {
+		data, err := os.ReadFile(filepath.Join(tmpDir, name))
+		if err != nil {
+			t.Fatalf("read %s: %v", name, err)
+		}
+		return string(data)
+	}
+
+	avx512 := read("scale_avx512.gen.go")
+	for _, want := range []string{"func BaseScale_avx512(", "func BaseScale_avx512_vnni("} {
+		if !strings.Contains(avx512, want) {
+			t.Errorf("AVX512 file missing %q", want)
+		}
+	}
+	if avx2 := read("scale_avx2.gen.go"); strings.Contains(avx2, "_vnni") || strings.Contains(avx2, "Mul(") {
+		t.Errorf("AVX2 has no VNNI, so it should get only the else branch:\n%s", avx2)
+	}
+
+	amd64 := read("dispatch_scale_amd64.gen.go")
+	if !strings.Contains(amd64, "if hwy.HasAVX512VNNI() {\n\t\tScale = BaseScale_avx512_vnni\n\t}") {
+		t.Errorf("AVX512 init does not select the VNNI variant:\n%s", amd64)
+	}
+	arm64 := read("dispatch_scale_arm64.gen.go")
+	if !strings.Contains(arm64, "if hwy.HasSME() {\n\t\tScale = BaseScale_neon_sme\n\t}") {
+		t.Errorf("NEON init does not select the SME variant:\n%s", arm64)
+	}
+}
+
+func TestInstrumentation(t *testing.T) {
+	tmpDir := t.TempDir()
+	inputFile := filepath.Join(tmpDir, "sum.go")
+	content := `package testinstrument
+
+import "github.com/ajroetker/go-highway/hwy"
+
+func BaseSum(x []float32, n int) float32 {
+	acc := hwy.Zero[float32]()
+	lanes := hwy.MaxLanes[float32]()
+	for i := 0; i+lanes <= n; i += lanes {
+		acc = hwy.Add(acc, hwy.Load(x[i:]))
+	}
+	return hwy.ReduceSum(acc)
+}
+`
+	if err := os.WriteFile(inputFile, []byte(content), 0644); err != nil {
+		t.Fatalf("write input: %v", err)
+	}
+	gen := &Generator{
+		InputFile:   inputFile,
+		OutputDir:   tmpDir,
+		TargetSpecs: makeTestSpecs(TargetModeGoSimd, "avx2", "fallback"),
+		Instrument:  true,
+	}
+	if err := gen.Run(); err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(tmpDir, "z_instrument_sum.gen.go"))
+	if err != nil {
+		t.Fatalf("read instrumentation: %v", err)
+	}
+	got := string(data)
+	for _, want := range []string{
+		"//go:build hwy_instrument",
+		`hwyStats := hwy.InstrumentKernel("testinstrument.Sum", hwyImpl)`,
+		"hwyLanes := hwy.MaxLanes[float32]()",
+		"Sum = func(x []float32, n int) float32 {",
+		"hwyStats.Record(len(x)*int(unsafe.Sizeof(x[0])), n, hwyLanes)",
+		"return hwyImpl(x, n)",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("instrumentation missing %q:\n%s", want, got)
+		}
+	}
+}
+
+func TestScalarFallbackUnroll(t *testing.T) {
+	tmpDir := t.TempDir()
+	inputFile := filepath.Join(tmpDir, "scale.go")
+	content := `package testunroll
+
+import "github.com/ajroetker/go-highway/hwy"
+
+func BaseScale(x []float32, s float32) {
+	vs := hwy.Set(s)
+	lanes := hwy.MaxLanes[float32]()
+	for i := 0; i < len(x); i += lanes {
+		hwy.Store(hwy.Mul(hwy.Load(x[i:]), vs), x[i:])
+	}
+}
+
+func BaseSum(x []float32) float32 {
+	acc := hwy.Zero[float32]()
+	lanes := hwy.MaxLanes[float32]()
+	for i := 0; i < len(x); i += lanes {
+		acc = hwy.Add(acc, hwy.Load(x[i:]))
+	}
+	return hwy.ReduceSum(acc)
+}
+`
+	if err := os.WriteFile(inputFile, []byte(content), 0644); err != nil {
+		t.Fatalf("write input: %v", err)
+	}
+	gen := &Generator{
+		InputFile:   inputFile,
+		OutputDir:   tmpDir,
+		TargetSpecs: makeTestSpecs(TargetModeGoSimd, "fallback"),
+	}
+	if err := gen.Run(); err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(tmpDir, "scale_fallback.gen.go"))
+	if err != nil {
+		t.Fatalf("read fallback: %v", err)
+	}
+	got := string(data)
+	for _, want := range []string{
+		"for ; i+4 <= len(x); i += 4 {",
+		"x4 := x[i : i+4 : i+4]",
+		"x4[3] = x4[3] * vs",
+		"for ; i < len(x); i++ {",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("fallback missing %q:\n%s", want, got)
+		}
+	}
+	// The accumulator of BaseSum carries a dependency between iterations,
+	// which unrolling would not break, so its loop is left alone.
+	if strings.Count(got, "i += 4") != 1 {
+		t.Errorf("want only BaseScale unrolled:\n%s", got)
+	}
+}
+
+func TestGoldenFiles(t *testing.T) {
+	tmpDir := t.TempDir()
+	inputFile := filepath.Join(tmpDir, "scale.go")
+	content := `package testgolden
+
+import "github.com/ajroetker/go-highway/hwy"
+
+func BaseScale(x []float32, s float32) {
+	vs := hwy.Set(s)
+	for i := 0; i < len(x); i += vs.NumLanes() {
+		hwy.Store(hwy.Mul(hwy.Load(x[i:]), vs), x[i:])
+	}
+}
+`
+	if err := os.WriteFile(inputFile, []byte(content), 0644); err != nil {
+		t.Fatalf("write input: %v", err)
+	}
+	goldenDir := filepath.Join(tmpDir, "golden")
+	run := func(verify bool) error {
+		gen := &Generator{
+			InputFile:    inputFile,
+			OutputDir:    tmpDir,
+			TargetSpecs:  makeTestSpecs(TargetModeC, "neon"),
+			Golden:       goldenDir,
+			GoldenVerify: verify,
+		}
+		return gen.Run()
+	}
+
+	if err := run(true); err == nil || !strings.Contains(err.Error(), "no golden file") {
+		t.Fatalf("verify without goldens: got %v, want a missing golden error", err)
+	}
+	if err := run(false); err != nil {
+		t.Fatalf("write goldens: %v", err)
+	}
+	golden := filepath.Join(goldenDir, "scale", "neon", "basescale_c_f32_neon_arm64.c")
+	data, err := os.ReadFile(golden)
+	if err != nil {
+		t.Fatalf("read golden: %v", err)
+	}
+	if err := run(true); err != nil {
+		t.Fatalf("verify unchanged output: %v", err)
+	}
+
+	// A changed golden and a stale one are both reported.
+	changed := strings.Replace(string(data), "vld1q_f32", "vld1q_f32_old", 1)
+	if err := os.WriteFile(golden, []byte(changed), 0644); err != nil {
+		t.Fatalf("write golden: %v", err)
+	}
+	stale := filepath.Join(goldenDir, "scale", "neon", "baseold_c_f32_neon_arm64.c")
+	if err := os.WriteFile(stale, []byte("void old(void) {}\n"), 0644); err != nil {
+		t.Fatalf("write golden: %v", err)
+	}
+	err = run(true)
+	if err == nil {
+		t.Fatal("verify changed goldens: want an error")
+	}
+	for _, want := range []string{
+		"baseold_c_f32_neon_arm64.c: no longer generated",
+		"basescale_c_f32_neon_arm64.c: line ",
+		"- ", "vld1q_f32_old",
+	} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("verify error missing %q:\n%v", want, err)
+		}
+	}
+
+	// Rewriting the goldens accepts the output and drops the stale file.
+	if err := run(false); err != nil {
+		t.Fatalf("rewrite goldens: %v", err)
+	}
+	if _, err := os.Stat(stale); !os.IsNotExist(err) {
+		t.Errorf("stale golden not removed: %v", err)
+	}
+	if err := run(true); err != nil {
+		t.Fatalf("verify rewritten goldens: %v", err)
+	}
+}
+
+func TestCanonicalGolden(t *testing.T) {
+	in := "//go:build !noasm && arm64\r\n" +
+		"// Code generated by GoAT. DO NOT EDIT.\n" +
+		"// versions:\n" +
+		"// \tclang   21.1.8\n" +
+		"// \tobjdump 2.45.1\n" +
+		"// flags: -O3\n" +
+		"// source: /home/me/src/kernels/asm/foo_c_f32_neon_arm64.c\n" +
+		"\n" +
+		"TEXT ·foo(SB), $0-8   \n\n\n"
+	want := "//go:build !noasm && arm64\n" +
+		"// Code generated by GoAT. DO NOT EDIT.\n" +
+		"// flags: -O3\n" +
+		"// source: foo_c_f32_neon_arm64.c\n" +
+		"\n" +
+		"TEXT ·foo(SB), $0-8\n"
+	if got := string(canonicalGolden([]byte(in))); got != want {
+		t.Errorf("canonicalGolden() =\n%q\nwant\n%q", got, want)
+	}
+}
+
+func TestPruneTargets(t *testing.T) {
+	const addTo = `
+func BaseAddTo[T hwy.Floats](x, y, dst []T) {
+	lanes := hwy.Zero[T]().NumLanes()
+	for i := 0; i+lanes <= len(dst); i += lanes {
+		hwy.Store(hwy.Add(hwy.Load(x[i:]), hwy.Load(y[i:])), dst[i:])
+	}
+}
+`
+	const softplus = `
+func BaseSoftplus[T hwy.Floats](x, dst []T) {
+	lanes := hwy.Zero[T]().NumLanes()
+	for i := 0; i+lanes <= len(dst); i += lanes {
+		hwy.Store(math.BaseLog1pVec(math.BaseExpVec(hwy.Load(x[i:]))), dst[i:])
+	}
+}
+`
+	for _, tc := range []struct {
+		name    string
+		funcs   string
+		targets []string
+		want    []string
+	}{
+		{"memory-bound", addTo, []string{"avx2", "neon", "fallback"}, []string{
+			"AVX512 vs AVX2 (amd64):",
+			"BaseAddTo (float32): 3 loads/stores, 1 ops, memory-bound: 0.44 vs 0.38 cycles/element, 0.85x",
+			"pruned AVX512: best speedup 0.85x is below 1.05x",
+		}},
+		{"compute-bound", addTo + softplus, []string{"avx2", "avx512", "neon", "fallback"}, []string{
+			"BaseSoftplus (float32): 2 loads/stores, 2 ops, compute-bound: 2.94 vs 5.00 cycles/element, 1.70x",
+			"kept AVX512: best speedup 1.70x",
+		}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			tmpDir := t.TempDir()
+			inputFile := filepath.Join(tmpDir, "k_base.go")
+			content := `package testprune
+
+import (
+	"github.com/ajroetker/go-highway/hwy"
+	"github.com/ajroetker/go-highway/hwy/contrib/math"
+)
+` + tc.funcs
+			if err := os.WriteFile(inputFile, []byte(content), 0644); err != nil {
+				t.Fatalf("write input: %v", err)
+			}
+			result, err := Parse(inputFile)
+			if err != nil {
+				t.Fatalf("Parse: %v", err)
+			}
+			reportFile := filepath.Join(tmpDir, "report.txt")
+			gen := &Generator{
+				TargetSpecs: makeTestSpecs(TargetModeGoSimd, "avx2", "avx512", "neon", "fallback"),
+				PruneReport: reportFile,
+			}
+			if err := gen.pruneTargets(result); err != nil {
+				t.Fatalf("pruneTargets: %v", err)
+			}
+			if got := gen.Targets(); !slices.Equal(got, tc.targets) {
+				t.Errorf("targets = %v, want %v", got, tc.targets)
+			}
+			data, err := os.ReadFile(reportFile)
+			if err != nil {
+				t.Fatalf("read report: %v", err)
+			}
+			for _, want := range tc.want {
+				if !strings.Contains(string(data), want) {
+					t.Errorf("report missing %q in:\n%s", want, data)
+				}
+			}
+		})
+	}
+}
+
+func TestPackageMode(t *testing.T) {
+	tmpDir := t.TempDir()
+	files := map[string]string{
+		"scale_base.go": `package testpkg
+
+import "github.com/ajroetker/go-highway/hwy"
+
+func BaseScaleSquare[T hwy.Floats](in, out []T) {
+	vScale := hwy.Set(T(scaleFactor))
+	for i := 0; i+vScale.NumLanes() <= len(in); i += vScale.NumLanes() {
+		x := hwy.Load(in[i:])
+		hwy.Store(baseSquare(hwy.Mul(x, vScale)), out[i:])
+	}
+}
+`,
+		"helpers.go": `package testpkg
+
+import "github.com/ajroetker/go-highway/hwy"
+
+var (
+	scaleFactor_f32 float32 = 0.5
+	scaleFactor_f64 float64 = 0.25
+)
+
+func baseSquare[T hwy.Floats](v hwy.Vec[T]) hwy.Vec[T] {
+	//hwy:if f32
+	return hwy.Mul(v, v)
+	//hwy:else
+	return hwy.MulAdd(v, v, hwy.Zero[T]())
+	//hwy:endif
+}
+`,
+		// Skipped: tests, generated output and platform-specific files.
+		"helpers_test.go":       "package testpkg\n\nfunc baseSquare() {}\n",
+		"old_fallback.gen.go":   "package testpkg\n\nfunc baseSquare() {}\n",
+		"helpers_arm64.go":      "package testpkg\n\nfunc baseSquare() {}\n",
+		"helpers_tagged.go":     "//go:build amd64\n\npackage testpkg\n\nfunc baseSquare() {}\n",
+		"z_hand_written_asm.go": "//go:build !noasm && arm64\n\npackage testpkg\n\nfunc BaseIgnored() {}\n",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(tmpDir, name), []byte(content), 0644); err != nil {
+			t.Fatalf("write %s: %v", name, err)
+		}
+	}
+
+	result, err := ParsePackage(tmpDir)
+	if err != nil {
+		t.Fatalf("ParsePackage: %v", err)
+	}
+	var names []string
+	for _, pf := range result.Funcs {
+		names = append(names, pf.Name)
+	}
+	if got := strings.Join(names, ","); got != "baseSquare,BaseScaleSquare" {
+		t.Errorf("Funcs = %s, want baseSquare,BaseScaleSquare (file name order)", got)
+	}
+	if tsc := result.TypeSpecificConsts["scaleFactor"]; tsc == nil || len(tsc.Variants) != 2 {
+		t.Errorf("scaleFactor type-specific constant not collected from helpers.go: %+v", tsc)
+	}
+	if len(result.ConditionalBlocks) != 1 || filepath.Base(result.ConditionalBlocks[0].File) != "helpers.go" {
+		t.Errorf("ConditionalBlocks = %+v, want one block from helpers.go", result.ConditionalBlocks)
+	}
+
+	outDir := filepath.Join(tmpDir, "out")
+	if err := os.Mkdir(outDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	gen := &Generator{
+		InputFile:   tmpDir,
+		OutputDir:   outDir,
+		TargetSpecs: makeTestSpecs(TargetModeGoSimd, "avx2", "fallback"),
+	}
+	if err := gen.Run(); err != nil {
+		t.Fatalf("Generator.Run() in package mode failed: %v", err)
+	}
+	// Output files are prefixed with the package name.
+	data, err := os.ReadFile(filepath.Join(outDir, "testpkg_fallback.gen.go"))
+	if err != nil {
+		t.Fatalf("read fallback output: %v", err)
+	}
+	code := string(data)
+	for _, want := range []string{
+		"func BaseScaleSquare_fallback(",
+		"func baseSquare_fallback(",
+		"baseSquare_fallback(",
+		"scaleFactor_f32",
+		"scaleFactor_f64",
+		// //hwy:if in helpers.go applies to helpers.go lines only.
+		"return hwy.Mul(v, v)",
+		"return hwy.MulAdd(v, v, hwy.Zero[float64]())",
+	} {
+		if !strings.Contains(code, want) {
+			t.Errorf("fallback output missing %q", want)
+		}
+	}
+	if strings.Contains(code, "BaseIgnored") {
+		t.Error("fallback output includes a function from a build-constrained file")
+	}
+	if _, err := os.Stat(filepath.Join(outDir, "testpkg_avx2.gen.go")); err != nil {
+		t.Errorf("avx2 output not written: %v", err)
+	}
+}
+
+func TestInlinePureHelpers(t *testing.T) {
+	tmpDir := t.TempDir()
+	inputFile := filepath.Join(tmpDir, "scale_base.go")
+	content := `package testinline
+
+import "github.com/ajroetker/go-highway/hwy"
+
+func scaleBias[T hwy.Floats](v hwy.Vec[T], s T) hwy.Vec[T] {
+	vs := hwy.Set(s)
+	return hwy.MulAdd(v, vs, vs)
+}
+
+func BaseScaleBias[T hwy.Floats](in, out []T, s T, n int) {
+	lanes := hwy.MaxLanes[T]()
+	for i := 0; i+lanes <= n; i += lanes {
+		x := hwy.Load(in[i:])
+		hwy.Store(scaleBias(hwy.Add(x, x), s), out[i:])
+	}
+}
+`
+	if err := os.WriteFile(inputFile, []byte(content), 0644); err != nil {
+		t.Fatalf("write input: %v", err)
+	}
+
+	gen := &Generator{
+		InputFile:   inputFile,
+		OutputDir:   tmpDir,
+		TargetSpecs: makeTestSpecs(TargetModeGoSimd, "avx2", "fallback"),
+	}
+	if err := gen.Run(); err != nil {
+		t.Fatalf("Generator.Run() failed: %v", err)
+	}
+	data, err := os.ReadFile(filepath.Join(tmpDir, "scale_base_avx2.gen.go"))
+	if err != nil {
+		t.Fatalf("read avx2 output: %v", err)
+	}
+	code := string(data)
+	if strings.Contains(code, "scaleBias(") {
+		t.Error("avx2 output still calls the scaleBias helper")
+	}
+	if !strings.Contains(code, "x.Add(x).MulAdd(archsimd.BroadcastFloat32x8(s), archsimd.BroadcastFloat32x8(s))") {
+		t.Errorf("avx2 output missing the inlined helper expression:\n%s", code)
+	}
+
+	cDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(cDir, "scale_base.go"), []byte(content), 0644); err != nil {
+		t.Fatalf("write input: %v", err)
+	}
+	gen = &Generator{
+		InputFile:   filepath.Join(cDir, "scale_base.go"),
+		OutputDir:   cDir,
+		TargetSpecs: makeTestSpecs(TargetModeC, "neon"),
+	}
+	if err := gen.Run(); err != nil {
+		t.Fatalf("Generator.Run() in CMode failed: %v", err)
+	}
+	data, err = os.ReadFile(filepath.Join(cDir, "basescalebias_c_f32_neon_arm64.c"))
+	if err != nil {
+		t.Fatalf("read C output: %v", err)
+	}
+	cCode := string(data)
+	if strings.Contains(cCode, "scaleBias(") {
+		t.Error("C output still calls the scaleBias helper")
+	}
+	if !strings.Contains(cCode, "vst1q_f32(out + i, vfmaq_f32(vdupq_n_f32(s), vaddq_f32(x, x), vdupq_n_f32(s)));") {
+		t.Errorf("C output missing the inlined helper expression:\n%s", cCode)
+	}
+}
+
+// TestInlineMathHelper verifies that inlining a helper that calls the
+// standard math package imports it in every generated file.
+func TestInlineMathHelper(t *testing.T) {
+	tmpDir := t.TempDir()
+	inputFile := filepath.Join(tmpDir, "sign_base.go")
+	content := `package testsign
+
+import (
+	"math"
+
+	"github.com/ajroetker/go-highway/hwy"
+)
+
+func signBit(f float32) uint32 {
+	return math.Float32bits(f) >> 31
+}
+
+func BaseCountNegative(x []float32) int {
+	n := 0
+	lanes := hwy.MaxLanes[float32]()
+	i := 0
+	for ; i+lanes <= len(x); i += lanes {
+		v := hwy.Load(x[i:])
+		for j := range lanes {
+			n += int(signBit(hwy.GetLane(v, j)))
+		}
+	}
+	for ; i < len(x); i++ {
+		n += int(signBit(x[i]))
+	}
+	return n
+}
+`
+	if err := os.WriteFile(inputFile, []byte(content), 0644); err != nil {
+		t.Fatalf("write input: %v", err)
+	}
+
+	gen := &Generator{
+		InputFile:   inputFile,
+		OutputDir:   tmpDir,
+		TargetSpecs: makeTestSpecs(TargetModeGoSimd, "avx2", "avx512", "neon", "fallback"),
+	}
+	if err := gen.Run(); err != nil {
+		t.Fatalf("Generator.Run() failed: %v", err)
+	}
+	for _, target := range []string{"avx2", "avx512", "neon", "fallback"} {
+		file := "sign_base_" + target + ".gen.go"
+		data, err := os.ReadFile(filepath.Join(tmpDir, file))
+		if err != nil {
+			t.Fatalf("read %s: %v", file, err)
+		}
+		code := string(data)
+		for _, want := range []string{`stdmath "math"`, "(stdmath.Float32bits(x[i]) >> 31)"} {
+			if !strings.Contains(code, want) {
+				t.Errorf("%s: missing %s:\n%s", file, want, code)
+			}
+		}
+	}
+
+	buildGeneratedPackage(t, tmpDir, "testsign")
+}
+
+func TestPureHelperBody(t *testing.T) {
+	src := `package test
+
+func pure(a, b float32) float32 {
+	s := a + b
+	return s * s
+}
+
+func stores(v hwy.Vec[float32], dst []float32) int {
+	hwy.Store(v, dst)
+	return 0
+}
+
+func storesInExpr(v hwy.Vec[float32], dst []float32) int {
+	return hwy.StoreN(v, dst)
+}
+
+func recursive(x int) int {
+	return recursive(x - 1)
+}
+
+func multiResult(x int) (int, int) {
+	return x, x
+}
+
+func scaled(a float32) float32 {
+	return a * scale
+}
+
+func BaseKernel(x float32) float32 {
+	return x
+}
+`
+	file, err := parser.ParseFile(token.NewFileSet(), "test.go", src, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]bool{
+		"pure": true, "stores": false, "storesInExpr": false,
+		"recursive": false, "multiResult": false, "BaseKernel": false, "scaled": true,
+	}
+	for _, decl := range file.Decls {
+		fd := decl.(*ast.FuncDecl)
+		pf := &ParsedFunc{Name: fd.Name.Name, Body: fd.Body}
+		for _, field := range fd.Type.Results.List {
+			pf.Returns = append(pf.Returns, Param{Type: exprToString(field.Type)})
+		}
+		if _, _, ok := pureHelperBody(pf); ok != want[pf.Name] {
+			t.Errorf("pureHelperBody(%s) = %v, want %v", pf.Name, ok, want[pf.Name])
+		}
+	}
+
+	helpers := map[string]*ParsedFunc{}
+	for _, decl := range file.Decls {
+		fd := decl.(*ast.FuncDecl)
+		pf := &ParsedFunc{Name: fd.Name.Name, Body: fd.Body, Returns: []Param{{Type: "float32"}}}
+		for _, field := range fd.Type.Params.List {
+			for _, name := range field.Names {
+				pf.Params = append(pf.Params, Param{Name: name.Name, Type: exprToString(field.Type)})
+			}
+		}
+		helpers[pf.Name] = pf
+	}
+	noLocals := func(string) bool { return false }
+	for _, tc := range []struct {
+		expr, want string
+		isLocal    func(string) bool
+	}{
+		// Arguments and results are parenthesized so precedence is kept.
+		{"2 * pure(x+1, y)", "2 * (((x + 1) + y) * ((x + 1) + y))", noLocals},
+		{"scaled(x)", "(x * scale)", noLocals},
+		// A caller variable named like the helper's free identifier would
+		// capture it, so the call is kept.
+		{"scaled(x)", "scaled(x)", func(name string) bool { return name == "scale" }},
+	} {
+		expr, err := parser.ParseExpr(tc.expr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := exprToString(inlineHelperExprs(expr, helpers, nil, tc.isLocal).(ast.Expr))
+		if got != tc.want {
+			t.Errorf("inline %q = %q, want %q", tc.expr, got, tc.want)
+		}
+	}
+}
+
+func TestLoopDirectives(t *testing.T) {
+	content := `package testdirectives
+
+import "github.com/ajroetker/go-highway/hwy"
+
+func BaseAddScaled[T hwy.Floats](a, b, out []T, s T, n int) {
+	lanes := hwy.MaxLanes[T]()
+	vs := hwy.Set(s)
+	i := 0
+	//hwy:unroll 2
+	//hwy:prefetch 256
+	for ; i+lanes <= n; i += lanes {
+		va := hwy.Load(a[i:])
+		vb := hwy.Load(b[i:])
+		hwy.Store(hwy.MulAdd(vb, vs, va), out[i:])
+	}
+	//hwy:unroll 0
+	for ; i < n; i++ {
+		out[i] = a[i] + b[i]*s
+	}
+}
+`
+	tmpDir := t.TempDir()
+	inputFile := filepath.Join(tmpDir, "addscaled_base.go")
+	if err := os.WriteFile(inputFile, []byte(content), 0644); err != nil {
+		t.Fatalf("write input: %v", err)
+	}
+
+	result, err := Parse(inputFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pf := result.Funcs[0]
+	if pf.LoopInfo == nil || pf.LoopInfo.UnrollHint != 2 {
+		t.Errorf("LoopInfo = %+v, want UnrollHint 2", pf.LoopInfo)
+	}
+	if len(pf.LoopUnroll) != 2 {
+		t.Errorf("LoopUnroll has %d loops, want 2", len(pf.LoopUnroll))
+	}
+
+	gen := &Generator{
+		InputFile:   inputFile,
+		OutputDir:   tmpDir,
+		TargetSpecs: makeTestSpecs(TargetModeGoSimd, "avx2", "fallback"),
+	}
+	if err := gen.Run(); err != nil {
+		t.Fatalf("Generator.Run() failed: %v", err)
+	}
+	data, err := os.ReadFile(filepath.Join(tmpDir, "addscaled_base_avx2.gen.go"))
+	if err != nil {
+		t.Fatalf("read avx2 output: %v", err)
+	}
+	code := string(data)
+	for _, want := range []string{
+		"hwy.Prefetch(a[i:], 256)",
+		"hwy.Prefetch(b[i:], 256)",
+		"i += lanes * 2", // //hwy:unroll 2
+	} {
+		if !strings.Contains(code, want) {
+			t.Errorf("avx2 output missing %q:\n%s", want, code)
+		}
+	}
+
+	cDir := t.TempDir()
+	cInput := filepath.Join(cDir, "addscaled_base.go")
+	if err := os.WriteFile(cInput, []byte(content), 0644); err != nil {
+		t.Fatalf("write input: %v", err)
+	}
+	gen = &Generator{
+		InputFile:   cInput,
+		OutputDir:   cDir,
+		TargetSpecs: makeTestSpecs(TargetModeC, "neon"),
+	}
+	if err := gen.Run(); err != nil {
+		t.Fatalf("Generator.Run() in CMode failed: %v", err)
+	}
+	data, err = os.ReadFile(filepath.Join(cDir, "baseaddscaled_c_f32_neon_arm64.c"))
+	if err != nil {
+		t.Fatalf("read C output: %v", err)
+	}
+	cCode := string(data)
+	for _, want := range []string{
+		"#pragma clang loop vectorize(disable) interleave(disable) unroll_count(2)",
+		"#pragma clang loop vectorize(disable) interleave(disable) unroll(disable)",
+		"__builtin_prefetch(a + i + 256)",
+		"__builtin_prefetch(b + i + 256)",
+	} {
+		if !strings.Contains(cCode, want) {
+			t.Errorf("C output missing %q:\n%s", want, cCode)
+		}
+	}
+}
+
+func TestSynthesizedTail(t *testing.T) {
+	content := `package testtail
+
+import "github.com/ajroetker/go-highway/hwy"
+
+func BaseAxpy[T hwy.Floats](a T, x, y []T, n int) {
+	lanes := hwy.MaxLanes[T]()
+	va := hwy.Set(a)
+	for i := 0; i+lanes <= n; i += lanes {
+		vx := hwy.Load(x[i:])
+		vy := hwy.Load(y[i:])
+		hwy.Store(hwy.MulAdd(va, vx, vy), y[i:])
+	}
+}
+
+func BaseSumTo[T hwy.Floats](x []T, out []T) {
+	lanes := hwy.MaxLanes[T]()
+	acc := hwy.Zero[T]()
+	for i := 0; i+lanes <= len(x); i += lanes {
+		acc = hwy.Add(acc, hwy.Load(x[i:]))
+	}
+	hwy.Store(acc, out)
+}
+`
+	tmpDir := t.TempDir()
+	inputFile := filepath.Join(tmpDir, "axpy_base.go")
+	if err := os.WriteFile(inputFile, []byte(content), 0644); err != nil {
+		t.Fatalf("write input: %v", err)
+	}
+
+	result, err := Parse(inputFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, pf := range result.Funcs {
+		tail := findTailLoop(&pf)
+		switch pf.Name {
+		case "BaseAxpy":
+			if tail == nil || tail.iter != "i" || tail.endArg != "n" || !slices.Equal(tail.slices, []string{"x", "y"}) || !tail.stored["y"] {
+				t.Errorf("findTailLoop(BaseAxpy) = %+v", tail)
+			}
+		case "BaseSumTo":
+			// Reductions carry state across iterations: no synthesized tail.
+			if tail != nil {
+				t.Errorf("findTailLoop(BaseSumTo) = %+v, want nil", tail)
+			}
+		}
+	}
+
+	gen := &Generator{
+		InputFile:   inputFile,
+		OutputDir:   tmpDir,
+		TargetSpecs: makeTestSpecs(TargetModeGoSimd, "avx2", "fallback"),
+	}
+	if err := gen.Run(); err != nil {
+		t.Fatalf("Generator.Run() failed: %v", err)
+	}
+	for file, wants := range map[string][]string{
+		"axpy_base_avx2.gen.go": {
+			"if i < n {",
+			"BaseAxpy_fallback(a, x[i:n], y[i:n], n-i)",
+		},
+		"axpy_base_fallback.gen.go": {
+			"tailMask := hwy.TailMask[hwy.Float16](n - i)",
+			"hwy.MaskStore(tailMask,",
+		},
+	} {
+		data, err := os.ReadFile(filepath.Join(tmpDir, file))
+		if err != nil {
+			t.Fatalf("read %s: %v", file, err)
+		}
+		for _, want := range wants {
+			if !strings.Contains(string(data), want) {
+				t.Errorf("%s missing %q:\n%s", file, want, data)
+			}
+		}
+	}
+
+	cDir := t.TempDir()
+	cInput := filepath.Join(cDir, "axpy_base.go")
+	if err := os.WriteFile(cInput, []byte(content), 0644); err != nil {
+		t.Fatalf("write input: %v", err)
+	}
+	gen = &Generator{
+		InputFile:   cInput,
+		OutputDir:   cDir,
+		TargetSpecs: makeTestSpecs(TargetModeC, "neon", "sve_linux"),
+	}
+	if err := gen.Run(); err != nil {
+		t.Fatalf("Generator.Run() in CMode failed: %v", err)
+	}
+	for file, wants := range map[string][]string{
+		"baseaxpy_c_f32_neon_arm64.c": {
+			"float _tail_x[4];",
+			"vst1q_f32(_tail_y, ",
+			"for (long _k = 0; i + _k < n; _k++) { y[i + _k] = _tail_y[_k]; }",
+		},
+		"baseaxpy_c_f32_sve_linux_arm64.c": {
+			"pg = svwhilelt_b32_s64(i, n);",
+		},
+	} {
+		data, err := os.ReadFile(filepath.Join(cDir, file))
+		if err != nil {
+			t.Fatalf("read %s: %v", file, err)
+		}
+		for _, want := range wants {
+			if !strings.Contains(string(data), want) {
+				t.Errorf("%s missing %q:\n%s", file, want, data)
+			}
+		}
+	}
+}
+
+func TestParallelWrappers(t *testing.T) {
+	content := `package testparallel
+
+import "github.com/ajroetker/go-highway/hwy"
+
+// BaseAxpy computes y += a*x.
+//
+//hwy:parallel
+func BaseAxpy[T hwy.Floats](a T, x, y []T, n int) {
+	lanes := hwy.MaxLanes[T]()
+	va := hwy.Set(a)
+	i := 0
+	for ; i+lanes <= n; i += lanes {
+		hwy.Store(hwy.MulAdd(va, hwy.Load(x[i:]), hwy.Load(y[i:])), y[i:])
+	}
+	for ; i < n; i++ {
+		y[i] += a * x[i]
+	}
+}
+
+// BaseMatVec computes out[r] = dot(m[r*cols:(r+1)*cols], v).
+//
+//hwy:parallel 4
+func BaseMatVec[T hwy.Floats](m, v, out []T, rows, cols int) {
+	lanes := hwy.MaxLanes[T]()
+	for r := range rows {
+		row := m[r*cols : (r+1)*cols]
+		acc := hwy.Zero[T]()
+		j := 0
+		for ; j+lanes <= cols; j += lanes {
+			acc = hwy.MulAdd(hwy.Load(row[j:]), hwy.Load(v[j:]), acc)
+		}
+		sum := hwy.ReduceSum(acc)
+		for ; j < cols; j++ {
+			sum += row[j] * v[j]
+		}
+		out[r] = sum
+	}
+}
+
+// BaseSum accumulates x into out[0].
+func BaseSum[T hwy.Floats](x, out []T) {
+	lanes := hwy.MaxLanes[T]()
+	acc := hwy.Zero[T]()
+	for i := 0; i+lanes <= len(x); i += lanes {
+		acc = hwy.Add(acc, hwy.Load(x[i:]))
+	}
+	out[0] += hwy.ReduceSum(acc)
+}
+`
+	tmpDir := t.TempDir()
+	inputFile := filepath.Join(tmpDir, "kernels_base.go")
+	if err := os.WriteFile(inputFile, []byte(content), 0644); err != nil {
+		t.Fatalf("write input: %v", err)
+	}
+
+	result, err := Parse(inputFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, pf := range result.Funcs {
+		shard, err := findParallelShard(&pf)
+		switch pf.Name {
+		case "BaseAxpy":
+			if err != nil || pf.Parallel == nil || pf.Parallel.Grain != 0 || shard.bound != "n" || shard.strides["x"] != "1" {
+				t.Errorf("BaseAxpy: directive %+v, shard %+v, err %v", pf.Parallel, shard, err)
+			}
+		case "BaseMatVec":
+			if err != nil || pf.Parallel == nil || pf.Parallel.Grain != 4 || shard.strides["m"] != "cols" || shard.strides["out"] != "1" {
+				t.Errorf("BaseMatVec: directive %+v, shard %+v, err %v", pf.Parallel, shard, err)
+			}
+			if _, shared := shard.strides["v"]; shared {
+				t.Errorf("BaseMatVec: v should be shared, got strides %v", shard.strides)
+			}
+		case "BaseSum":
+			// Every task would write out[0].
+			if err == nil {
+				t.Errorf("BaseSum: got shard %+v, want error", shard)
+			}
+		}
+	}
+
+	gen := &Generator{
+		InputFile:   inputFile,
+		OutputDir:   tmpDir,
+		TargetSpecs: makeTestSpecs(TargetModeGoSimd, "avx2", "fallback"),
+		Parallel:    true,
+	}
+	if err := gen.Run(); err != nil {
+		t.Fatalf("Generator.Run() failed: %v", err)
+	}
+	data, err := os.ReadFile(filepath.Join(tmpDir, "kernels_base_parallel.gen.go"))
+	if err != nil {
+		t.Fatalf("read parallel wrappers: %v", err)
+	}
+	code := string(data)
+	for _, want := range []string{
+		"func AxpyParallel[T hwy.Floats](pool *workerpool.Pool, a T, x []T, y []T, n int) {",
+		"Axpy[T](a, x[start:], y[start:], end-start)",
+		"grain := 4",
+		"MatVec[T](m[start*cols:], v, out[start:], end-start, cols)",
+	} {
+		if !strings.Contains(code, want) {
+			t.Errorf("parallel wrappers missing %q:\n%s", want, code)
+		}
+	}
+	if strings.Contains(code, "SumParallel") {
+		t.Errorf("unexpected SumParallel wrapper:\n%s", code)
+	}
+
+	dispatch, err := os.ReadFile(filepath.Join(tmpDir, "dispatch_axpy_other.gen.go"))
+	if err != nil {
+		t.Fatalf("read dispatcher: %v", err)
+	}
+	if strings.Contains(string(dispatch), "hwy:parallel") {
+		t.Errorf("dispatcher doc kept the //hwy:parallel directive:\n%s", dispatch)
+	}
+}
+
+func TestReferenceTests(t *testing.T) {
+	content := `package testreftests
+
+import "github.com/ajroetker/go-highway/hwy"
+
+func BaseAxpy[T hwy.Floats](a T, x, y []T, n int) {
+	lanes := hwy.MaxLanes[T]()
+	va := hwy.Set(a)
+	for i := 0; i+lanes <= n; i += lanes {
+		hwy.Store(hwy.MulAdd(va, hwy.Load(x[i:]), hwy.Load(y[i:])), y[i:])
+	}
+}
+
+func BaseDot[T hwy.FloatsNative](x, y []T) T {
+	acc := hwy.Zero[T]()
+	lanes := hwy.MaxLanes[T]()
+	i := 0
+	for ; i+lanes <= len(x); i += lanes {
+		acc = hwy.MulAdd(hwy.Load(x[i:]), hwy.Load(y[i:]), acc)
+	}
+	sum := hwy.ReduceSum(acc)
+	for ; i < len(x); i++ {
+		sum += x[i] * y[i]
+	}
+	return sum
+}
+
+func BaseApply[T hwy.Floats](x []T, fn func(T) T) {
+	for i := range x {
+		x[i] = fn(x[i])
+	}
+}
+`
+	tmpDir := t.TempDir()
+	inputFile := filepath.Join(tmpDir, "kernels_base.go")
+	if err := os.WriteFile(inputFile, []byte(content), 0644); err != nil {
+		t.Fatalf("write input: %v", err)
+	}
+	gen := &Generator{
+		InputFile:   inputFile,
+		OutputDir:   tmpDir,
+		TargetSpecs: makeTestSpecs(TargetModeGoSimd, "avx2", "fallback"),
+		Tests:       true,
+	}
+	if err := gen.Run(); err != nil {
+		t.Fatalf("Generator.Run() failed: %v", err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(tmpDir, "kernels_base_gen_test.go"))
+	if err != nil {
+		t.Fatalf("read reference tests: %v", err)
+	}
+	code := string(data)
+	for _, want := range []string{
+		"func TestGeneratedAxpy(t *testing.T) {",
+		`t.Run("Float16", func(t *testing.T) {`,
+		"BaseAxpy_fallback_Float64(a, xRef, yRef, n)",
+		"AxpyFloat64(a, x, y, n)",
+		"want0 = BaseDot_fallback(xRef, yRef)",
+		`hwygenCheck(t, tc, "result 0", []float32{got0}, []float32{want0})`,
+		"func BenchmarkGeneratedDot(b *testing.B) {",
+	} {
+		if !strings.Contains(code, want) {
+			t.Errorf("reference tests missing %q:\n%s", want, code)
+		}
+	}
+	// Function-valued parameters can't be generated.
+	if strings.Contains(code, "TestGeneratedApply") {
+		t.Errorf("unexpected TestGeneratedApply:\n%s", code)
+	}
+	if _, err := os.Stat(filepath.Join(tmpDir, testGenHelpersFile)); err != nil {
+		t.Errorf("helpers not written: %v", err)
+	}
+}
+
+func TestSmallIntElemTypes(t *testing.T) {
+	content := `package testsmall
+
+import "github.com/ajroetker/go-highway/hwy"
+
+func BaseMaxTo[T uint8 | uint16 | int16](x, y, out []T) {
+	lanes := hwy.MaxLanes[T]()
+	for i := 0; i+lanes <= len(x); i += lanes {
+		vx := hwy.Load(x[i:])
+		vy := hwy.Load(y[i:])
+		hwy.Store(hwy.Max(vx, vy), out[i:])
+	}
+}
+
+func BaseWidenBytes(src []uint8, dst []uint16) {
+	lanes := hwy.MaxLanes[uint8]()
+	half := hwy.MaxLanes[uint16]()
+	for i := 0; i+lanes <= len(src); i += lanes {
+		v := hwy.Load(src[i:])
+		hwy.Store(hwy.PromoteLowerU8ToU16(v), dst[i:])
+		hwy.Store(hwy.PromoteUpperU8ToU16(v), dst[i+half:])
+	}
+}
+`
+	if !isElemTypeConstraint("uint8 | ~uint16 | int16") || isElemTypeConstraint("Predicate[T]") {
+		t.Error("isElemTypeConstraint misclassifies lane-type unions")
+	}
+
+	tmpDir := t.TempDir()
+	inputFile := filepath.Join(tmpDir, "small_base.go")
+	if err := os.WriteFile(inputFile, []byte(content), 0644); err != nil {
+		t.Fatalf("write input: %v", err)
+	}
+	gen := &Generator{
+		InputFile:   inputFile,
+		OutputDir:   tmpDir,
+		TargetSpecs: makeTestSpecs(TargetModeGoSimd, "avx2", "neon", "fallback"),
+	}
+	if err := gen.Run(); err != nil {
+		t.Fatalf("Generator.Run() failed: %v", err)
+	}
+	for file, wants := range map[string][]string{
+		"small_base_avx2.gen.go": {
+			"func BaseMaxTo_avx2_Uint8(",
+			"archsimd.LoadUint16x16((*[16]uint16)",
+			"archsimd.LoadInt16x16((*[16]int16)",
+			"hwy.PromoteLowerU8ToU16_AVX2_Uint8x32(v).Store((*[16]uint16)(unsafe.Pointer(&dst[i])))",
+			"&dst[i+half+32]",
+		},
+		"small_base_neon.gen.go": {
+			"asm.LoadUint16x8((*[8]uint16)",
+			"hwy.PromoteUpperU8ToU16_NEON_Uint8x16(v)",
+		},
+		"dispatch_maxto_arm64.gen.go": {
+			"MaxToUint16 = BaseMaxTo_neon_Uint16",
+			"MaxToInt16 = BaseMaxTo_fallback_Int16", // no Int16x8 in asm
+		},
+		"dispatch_maxto_amd64.gen.go": {
+			"MaxToInt16 = BaseMaxTo_avx2_Int16",
+		},
+	} {
+		data, err := os.ReadFile(filepath.Join(tmpDir, file))
+		if err != nil {
+			t.Fatalf("read %s: %v", file, err)
+		}
+		for _, want := range wants {
+			if !strings.Contains(string(data), want) {
+				t.Errorf("%s missing %q:\n%s", file, want, data)
+			}
+		}
+	}
+}
+
+func TestPairedTypeParams(t *testing.T) {
+	typeParams := []TypeParam{
+		{Name: "T", Constraint: "int8 | int16"},
+		{Name: "U", Constraint: "int16 | int32"},
+		{Name: "A", Constraint: "uint32"},
+		{Name: "P", Constraint: "Predicate[T]"},
+	}
+	got := typeParamBindings(typeParams, "int16")
+	want := map[string]string{"T": "int16", "U": "int32", "A": "uint32"}
+	if !maps.Equal(got, want) {
+		t.Errorf("typeParamBindings = %v, want %v", got, want)
+	}
+	if got := specializeType("func([]U) A", typeParams, "int8"); got != "func([]int16) uint32" {
+		t.Errorf("specializeType = %q", got)
+	}
+	if got := specializeType("P", typeParams, "int8"); got != "Predicate[int8]" {
+		t.Errorf("specializeType(P) = %q", got)
+	}
+	if err := checkPairedTypeParams(typeParams); err != nil {
+		t.Errorf("checkPairedTypeParams: %v", err)
+	}
+	bad := []TypeParam{{Name: "T", Constraint: "hwy.Floats"}, {Name: "U", Constraint: "int32 | int64"}}
+	if err := checkPairedTypeParams(bad); err == nil {
+		t.Error("checkPairedTypeParams accepted 4 T types paired with 2 U types")
+	}
+
+	content := `package testpaired
+
+import "github.com/ajroetker/go-highway/hwy"
+
+func BaseDotWiden[T int16, U int32](a, b []T) U {
+	acc := hwy.Zero[U]()
+	lanes := hwy.MaxLanes[T]()
+	var i int
+	for i = 0; i+lanes <= len(a); i += lanes {
+		va := hwy.Load(a[i:])
+		vb := hwy.Load(b[i:])
+		lo := hwy.Mul(hwy.PromoteLowerTo[U](va), hwy.PromoteLowerTo[U](vb))
+		hi := hwy.Mul(hwy.PromoteUpperTo[U](va), hwy.PromoteUpperTo[U](vb))
+		acc = hwy.Add(acc, hwy.Add(lo, hi))
+	}
+	sum := hwy.ReduceSum(acc)
+	for ; i < len(a); i++ {
+		sum += U(a[i]) * U(b[i])
+	}
+	return sum
+}
+
+func BaseScaleTo[T hwy.FloatsNative, U int32 | int64](dst []U, src []T, scale T) {
+	for i := range src {
+		dst[i] = U(src[i] * scale)
+	}
+}
+`
+	tmpDir := t.TempDir()
+	inputFile := filepath.Join(tmpDir, "paired_base.go")
+	if err := os.WriteFile(inputFile, []byte(content), 0644); err != nil {
+		t.Fatalf("write input: %v", err)
+	}
+	gen := &Generator{
+		InputFile:   inputFile,
+		OutputDir:   tmpDir,
+		TargetSpecs: makeTestSpecs(TargetModeGoSimd, "avx2", "fallback"),
+	}
+	if err := gen.Run(); err != nil {
+		t.Fatalf("Generator.Run() failed: %v", err)
+	}
+	for file, wants := range map[string][]string{
+		"paired_base_avx2.gen.go": {
+			"func BaseDotWiden_avx2_Int16(a []int16, b []int16) int32 {",
+			"acc := archsimd.BroadcastInt32x8(0)",
+			"hwy.PromoteLowerI16ToI32_AVX2_Int16x16(va).Mul(",
+			"sum := hwy.ReduceSum_AVX2_I32x8(acc)",
+			"sum += int32(a[i]) * int32(b[i])",
+			"func BaseScaleTo_avx2_Float64(dst []int64, src []float64, scale float64) {",
+		},
+		"paired_base_fallback.gen.go": {
+			"acc := hwy.Zero[int32]()",
+			"hwy.PromoteUpperTo[int32](va)",
+		},
+		"dispatch_dotwiden_amd64.gen.go": {
+			"var ScaleToFloat32 func(dst []int32, src []float32, scale float32)",
+			"switch any(src).(type) {", // not dst: U alone may not identify the variant
+		},
+	} {
+		data, err := os.ReadFile(filepath.Join(tmpDir, file))
+		if err != nil {
+			t.Fatalf("read %s: %v", file, err)
+		}
+		for _, want := range wants {
+			if !strings.Contains(string(data), want) {
+				t.Errorf("%s missing %q:\n%s", file, want, data)
+			}
+		}
+	}
+}
@@ -0,0 +1,253 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"go/ast"
+	"go/token"
+	"strings"
+
+	"golang.org/x/tools/go/ast/astutil"
+)
+
+// Expression inlining of small pure helpers.
+//
+// A Base* kernel may be factored into helpers such as
+//
+//	func square[T hwy.Floats](v hwy.Vec[T]) hwy.Vec[T] { return hwy.Mul(v, v) }
+//	func getSignBit(f float32) uint32 { return math.Float32bits(f) >> 31 }
+//
+// Calls to such helpers are replaced by the helper's result expression with
+// the arguments substituted for the parameters, so the target code (Go SIMD or
+// C) sees plain hwy operations instead of a call it can't specialize. Helpers
+// with statement bodies that return nothing are handled by inlineHelperCalls.
+
+const (
+	// maxInlineHelperStmts bounds the body size of helpers inlined into expressions.
+	maxInlineHelperStmts = 8
+
+	// maxInlineHelperDepth bounds nested helper expansion (and stops recursion).
+	maxInlineHelperDepth = 4
+)
+
+// impureHelperCalls are builtins that make a helper unsuitable for inlining.
+var impureHelperCalls = map[string]bool{
+	"append": true, "clear": true, "close": true, "copy": true, "delete": true,
+	"panic": true, "print": true, "println": true, "recover": true,
+}
+
+// pureHelperBody returns the definitions and result expression of helper when
+// it can be inlined into an expression: a non-Base function with a single
+// result whose body is at most maxInlineHelperStmts statements of the form
+// "x := expr", followed by "return expr", without stores, closures or
+// side-effecting builtins.
+func pureHelperBody(helper *ParsedFunc) (defs []*ast.AssignStmt, result ast.Expr, ok bool) {
+	if helper == nil || helper.Body == nil || hasBasePrefix(helper.Name) || len(helper.Returns) != 1 {
+		return nil, nil, false
+	}
+	stmts := helper.Body.List
+	if len(stmts) == 0 || len(stmts) > maxInlineHelperStmts {
+		return nil, nil, false
+	}
+	for _, p := range helper.Params {
+		if strings.HasPrefix(p.Type, "...") {
+			return nil, nil, false
+		}
+	}
+	for _, stmt := range stmts[:len(stmts)-1] {
+		assign, isAssign := stmt.(*ast.AssignStmt)
+		if !isAssign || assign.Tok != token.DEFINE || len(assign.Lhs) != 1 || len(assign.Rhs) != 1 {
+			return nil, nil, false
+		}
+		if ident, isIdent := assign.Lhs[0].(*ast.Ident); !isIdent || ident.Name == "_" {
+			return nil, nil, false
+		}
+		defs = append(defs, assign)
+	}
+	ret, isReturn := stmts[len(stmts)-1].(*ast.ReturnStmt)
+	if !isReturn || len(ret.Results) != 1 {
+		return nil, nil, false
+	}
+
+	pure := true
+	ast.Inspect(helper.Body, func(n ast.Node) bool {
+		switch node := n.(type) {
+		case *ast.FuncLit:
+			pure = false
+		case *ast.UnaryExpr:
+			if node.Op == token.ARROW {
+				pure = false
+			}
+		case *ast.CallExpr:
+			switch fun := node.Fun.(type) {
+			case *ast.Ident:
+				pure = pure && !impureHelperCalls[fun.Name] && fun.Name != helper.Name
+			case *ast.SelectorExpr:
+				name := fun.Sel.Name
+				pure = pure && !strings.HasPrefix(name, "Store") && !strings.HasPrefix(name, "Scatter")
+			}
+		}
+		return pure
+	})
+	if !pure {
+		return nil, nil, false
+	}
+	return defs, ret.Results[0], true
+}
+
+// inlineHelperExprs replaces calls to pure helpers (see pureHelperBody) in
+// root with the helpers' result expressions and returns the rewritten node.
+// want selects which helpers to inline; isLocal reports names bound in the
+// calling function, and helpers referring to such a name (other than through
+// their own parameters and definitions) are left alone so inlining can't
+// capture the caller's variables.
+func inlineHelperExprs(root ast.Node, helpers map[string]*ParsedFunc, want func(*ParsedFunc) bool, isLocal func(string) bool) ast.Node {
+	return inlineHelperExprsDepth(root, helpers, want, isLocal, 0)
+}
+
+func inlineHelperExprsDepth(root ast.Node, helpers map[string]*ParsedFunc, want func(*ParsedFunc) bool, isLocal func(string) bool, depth int) ast.Node {
+	if root == nil || len(helpers) == 0 || depth >= maxInlineHelperDepth {
+		return root
+	}
+	return astutil.Apply(root, nil, func(c *astutil.Cursor) bool {
+		call, ok := c.Node().(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		expr := inlineHelperCall(call, helpers, want, isLocal)
+		if expr == nil {
+			return true
+		}
+		if nested, ok := inlineHelperExprsDepth(expr, helpers, want, isLocal, depth+1).(ast.Expr); ok {
+			expr = nested
+		}
+		c.Replace(expr)
+		return true
+	})
+}
+
+// inlineHelperCall returns the expression call reduces to, or nil if call
+// is not a call to a helper that should be inlined.
+func inlineHelperCall(call *ast.CallExpr, helpers map[string]*ParsedFunc, want func(*ParsedFunc) bool, isLocal func(string) bool) ast.Expr {
+	var name string
+	var typeArgs []ast.Expr
+	switch fun := call.Fun.(type) {
+	case *ast.Ident:
+		name = fun.Name
+	case *ast.IndexExpr:
+		if ident, ok := fun.X.(*ast.Ident); ok {
+			name, typeArgs = ident.Name, []ast.Expr{fun.Index}
+		}
+	case *ast.IndexListExpr:
+		if ident, ok := fun.X.(*ast.Ident); ok {
+			name, typeArgs = ident.Name, fun.Indices
+		}
+	}
+	helper, ok := helpers[name]
+	if !ok || (want != nil && !want(helper)) || len(call.Args) != len(helper.Params) {
+		return nil
+	}
+	defs, result, ok := pureHelperBody(helper)
+	if !ok {
+		return nil
+	}
+
+	// Parameters, explicit type arguments and definitions, in binding order.
+	subst := make(map[string]ast.Expr)
+	for i, p := range helper.Params {
+		subst[p.Name] = call.Args[i]
+	}
+	for i, tp := range helper.TypeParams {
+		if i < len(typeArgs) {
+			subst[tp.Name] = typeArgs[i]
+		}
+	}
+	if isLocal != nil && refersToLocal(helper.Body, subst, defs, isLocal) {
+		return nil
+	}
+	for _, def := range defs {
+		subst[def.Lhs[0].(*ast.Ident).Name] = substituteHelperIdents(def.Rhs[0], subst)
+	}
+	return parenthesize(substituteHelperIdents(result, subst))
+}
+
+// refersToLocal reports whether body uses a free identifier that isLocal
+// claims for the calling function.
+func refersToLocal(body *ast.BlockStmt, subst map[string]ast.Expr, defs []*ast.AssignStmt, isLocal func(string) bool) bool {
+	bound := make(map[string]bool, len(defs))
+	for _, def := range defs {
+		bound[def.Lhs[0].(*ast.Ident).Name] = true
+	}
+	found := false
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch node := n.(type) {
+		case *ast.SelectorExpr:
+			// Only the operand can refer to a variable.
+			ast.Inspect(node.X, func(m ast.Node) bool {
+				if ident, ok := m.(*ast.Ident); ok && !bound[ident.Name] && subst[ident.Name] == nil && isLocal(ident.Name) {
+					found = true
+				}
+				return !found
+			})
+			return false
+		case *ast.Ident:
+			if !bound[node.Name] && subst[node.Name] == nil && isLocal(node.Name) {
+				found = true
+			}
+		}
+		return !found
+	})
+	return found
+}
+
+// substituteHelperIdents returns a copy of expr with identifiers in subst
+// replaced by copies of their values. Non-trivial values are parenthesized so
+// operator precedence is preserved, and each use gets its own copy so later
+// in-place transformations don't see shared nodes.
+func substituteHelperIdents(expr ast.Expr, subst map[string]ast.Expr) ast.Expr {
+	out := astutil.Apply(cloneExpr(expr), func(c *astutil.Cursor) bool {
+		// Field and method names are not variables.
+		if sel, ok := c.Parent().(*ast.SelectorExpr); ok && c.Node() == sel.Sel {
+			return false
+		}
+		ident, ok := c.Node().(*ast.Ident)
+		if !ok {
+			return true
+		}
+		value, ok := subst[ident.Name]
+		if !ok {
+			return true
+		}
+		c.Replace(parenthesize(cloneExpr(value)))
+		return false
+	}, nil)
+	return out.(ast.Expr)
+}
+
+// parenthesize wraps expr in parentheses unless it is an operand that binds
+// tighter than any operator.
+func parenthesize(expr ast.Expr) ast.Expr {
+	switch expr.(type) {
+	case *ast.Ident, *ast.BasicLit, *ast.ParenExpr, *ast.SelectorExpr, *ast.IndexExpr, *ast.CallExpr:
+		return expr
+	}
+	return &ast.ParenExpr{X: expr}
+}
+
+// usesHwy reports whether helper works on hwy vectors or lanes, i.e. whether
+// leaving a call to it in Go SIMD code would lose vectorization.
+func usesHwy(helper *ParsedFunc) bool {
+	return len(helper.HwyCalls) > 0 || returnsVecType(helper.Returns) || hasHwyLanesConstraint(helper.TypeParams)
+}
@@ -0,0 +1,174 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+// instrumentBuildTag gates the files written by -instrument.
+const instrumentBuildTag = "hwy_instrument"
+
+// laneElemTypes are the element types hwy.MaxLanes accepts.
+var laneElemTypes = map[string]bool{
+	"float32": true, "float64": true, "hwy.Float16": true, "hwy.BFloat16": true,
+	"int8": true, "int16": true, "int32": true, "int64": true,
+	"uint8": true, "uint16": true, "uint32": true, "uint64": true,
+}
+
+// EmitInstrumentation writes z_instrument_<prefix>.gen.go, which wraps every
+// dispatched function with hwy.KernelStats counters when built with -tags
+// hwy_instrument. The file name sorts after the dispatch and z_c_* files, so
+// its init runs once all of them have bound the dispatch variables.
+func EmitInstrumentation(funcs []ParsedFunc, pkgName, outPath, dispatchName string) error {
+	prefix := dispatchName
+	if prefix == "" {
+		prefix = deriveDispatchPrefix(funcs)
+	}
+
+	var body bytes.Buffer
+	for _, pf := range filterDispatchableFuncs(funcs) {
+		isGeneric := len(pf.TypeParams) > 0
+		concreteTypes := []string{"float32"}
+		if isGeneric {
+			concreteTypes = GetConcreteTypes(pf.TypeParams[0].Constraint)
+		}
+		for _, elemType := range concreteTypes {
+			emitInstrumentWrapper(&body, pf, elemType, pkgName)
+		}
+	}
+	if body.Len() == 0 {
+		return nil
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, HeaderNote)
+	fmt.Fprintf(&buf, "//go:build %s\n\n", instrumentBuildTag)
+	fmt.Fprintf(&buf, "package %s\n\n", pkgName)
+	fmt.Fprintf(&buf, "import (\n")
+	fmt.Fprintf(&buf, "\t\"unsafe\"\n\n")
+	fmt.Fprintf(&buf, "\t\"github.com/ajroetker/go-highway/hwy\"\n")
+	fmt.Fprintf(&buf, ")\n\n")
+	fmt.Fprintf(&buf, "func init() {\n")
+	buf.Write(body.Bytes())
+	fmt.Fprintf(&buf, "}\n")
+
+	filename := filepath.Join(outPath, fmt.Sprintf("z_instrument_%s.gen.go", prefix))
+	if err := writeGoFile(filename, buf.Bytes()); err != nil {
+		return fmt.Errorf("write instrumentation: %w", err)
+	}
+	return nil
+}
+
+// emitInstrumentWrapper writes the block of init that replaces the dispatch
+// variable of pf for elemType with a wrapper recording each call: the total
+// size of its slice arguments, and the length of its loop (see
+// instrumentLoopLen) split into full vectors and tail.
+func emitInstrumentWrapper(buf *bytes.Buffer, pf ParsedFunc, elemType, pkgName string) {
+	dispatchName := buildDispatchFuncName(pf.Name, elemType, len(pf.TypeParams) > 0, pf.Private)
+
+	// Name every parameter, so the wrapper can forward them. The wrapper's
+	// own variables are hwy-prefixed so parameters cannot shadow them.
+	wrapped := pf
+	wrapped.Params = make([]Param, len(pf.Params))
+	var args, sizes []string
+	for i, p := range pf.Params {
+		name := p.Name
+		if name == "" || name == "_" {
+			name = fmt.Sprintf("p%d", i)
+		}
+		wrapped.Params[i] = Param{Name: name, Type: p.Type}
+		typ := specializeType(p.Type, pf.TypeParams, elemType)
+		switch {
+		case strings.HasPrefix(typ, "..."):
+			args = append(args, name+"...")
+		case strings.HasPrefix(typ, "[]"):
+			args = append(args, name)
+			sizes = append(sizes, fmt.Sprintf("len(%s)*int(unsafe.Sizeof(%s[0]))", name, name))
+		default:
+			args = append(args, name)
+		}
+	}
+	bytesExpr := "0"
+	if len(sizes) > 0 {
+		bytesExpr = strings.Join(sizes, " + ")
+	}
+	n, lanesType := instrumentLoopLen(wrapped, elemType)
+	lanes := "0"
+	if lanesType != "" {
+		lanes = fmt.Sprintf("hwy.MaxLanes[%s]()", lanesType)
+	}
+
+	call := fmt.Sprintf("hwyImpl(%s)", strings.Join(args, ", "))
+	if len(pf.Returns) > 0 {
+		call = "return " + call
+	}
+	fmt.Fprintf(buf, "\t{\n")
+	fmt.Fprintf(buf, "\t\thwyImpl := %s\n", dispatchName)
+	fmt.Fprintf(buf, "\t\thwyStats := hwy.InstrumentKernel(%q, hwyImpl)\n", pkgName+"."+dispatchName)
+	fmt.Fprintf(buf, "\t\thwyLanes := %s\n", lanes)
+	fmt.Fprintf(buf, "\t\t%s = func%s {\n", dispatchName, buildFuncSignature(wrapped, elemType))
+	fmt.Fprintf(buf, "\t\t\thwyStats.Record(%s, %s, hwyLanes)\n", bytesExpr, n)
+	fmt.Fprintf(buf, "\t\t\t%s\n", call)
+	fmt.Fprintf(buf, "\t\t}\n")
+	fmt.Fprintf(buf, "\t}\n")
+}
+
+// instrumentLoopLen returns the expression for the number of elements the
+// main loop of pf covers, and the element type whose lane count it steps by.
+// The loop bound is used when it is an int parameter or the length of a
+// slice parameter; otherwise it is the length of the first slice parameter.
+// It returns "0", "" for functions without slice parameters.
+func instrumentLoopLen(pf ParsedFunc, elemType string) (n, lanesType string) {
+	var first string
+	sliceElems := make(map[string]string)
+	intParams := make(map[string]bool)
+	for _, p := range pf.Params {
+		typ := specializeType(p.Type, pf.TypeParams, elemType)
+		if elem, ok := strings.CutPrefix(typ, "[]"); ok {
+			sliceElems[p.Name] = elem
+			if first == "" {
+				first = p.Name
+			}
+		} else if typ == "int" {
+			intParams[p.Name] = true
+		}
+	}
+	if first == "" {
+		return "0", ""
+	}
+	lanesOf := func(slice string) string {
+		if elem := sliceElems[slice]; laneElemTypes[elem] {
+			return elem
+		}
+		return ""
+	}
+
+	if pf.LoopInfo != nil {
+		end := strings.TrimSpace(pf.LoopInfo.End)
+		if intParams[end] {
+			return end, lanesOf(first)
+		}
+		if inner, ok := strings.CutPrefix(end, "len("); ok {
+			if slice := strings.TrimSuffix(inner, ")"); sliceElems[slice] != "" {
+				return end, lanesOf(slice)
+			}
+		}
+	}
+	return "len(" + first + ")", lanesOf(first)
+}
@@ -426,6 +426,20 @@ func (b *Builder) buildFor(stmt *ast.ForStmt) error {
 		}
 	}
 
+	// Post: i++
+	if post, ok := stmt.Post.(*ast.IncDecStmt); ok && post.Tok == token.INC {
+		lr.Step = "1"
+		if lr.LoopVar == "" {
+			lr.LoopVar = b.exprToName(post.X)
+		}
+	}
+
+	// A loop without an init statement ("for ; i+lanes <= n; i += lanes")
+	// continues the index named by its post statement.
+	if post, ok := stmt.Post.(*ast.AssignStmt); ok && lr.LoopVar == "" && len(post.Lhs) > 0 {
+		lr.LoopVar = b.exprToName(post.Lhs[0])
+	}
+
 	loopNode.LoopRange = lr
 
 	// Save current loop context
@@ -1169,6 +1183,15 @@ func (b *Builder) exprToString(expr ast.Expr) string {
 		return b.exprToString(e.X) + "." + e.Sel.Name
 	case *ast.IndexExpr:
 		return b.exprToString(e.X) + "[" + b.exprToString(e.Index) + "]"
+	case *ast.SliceExpr:
+		var lo, hi string
+		if e.Low != nil {
+			lo = b.exprToString(e.Low)
+		}
+		if e.High != nil {
+			hi = b.exprToString(e.High)
+		}
+		return b.exprToString(e.X) + "[" + lo + ":" + hi + "]"
 	case *ast.ParenExpr:
 		return "(" + b.exprToString(e.X) + ")"
 	case *ast.UnaryExpr:
@@ -352,7 +352,7 @@ func (e *Emitter) emitStore(op *IRNode) {
 
 	// Get the value to store
 	var value string
-	if len(op.Inputs) > 0 && op.Inputs[0] != nil {
+	if len(op.Inputs) > 0 && op.Inputs[0] != nil && len(op.Inputs[0].Outputs) > 0 {
 		value = op.Inputs[0].Outputs[0]
 	} else if len(op.InputNames) >= 3 {
 		value = op.InputNames[2]
@@ -19,6 +19,7 @@ import (
 	"os"
 	"slices"
 	"sort"
+	"strings"
 )
 
 // debugFusion enables debug output for fusion passes.
@@ -653,3 +654,231 @@ func fuseSumLoop(fn *IRFunction, crossLoopTemps []CrossLoopTempArray) {
 		sumLoop.IsFusionRoot = true
 	}
 }
+
+// LoopMerge records a loop merged into its predecessor by FuseLoopChains.
+type LoopMerge struct {
+	// Into and From are the headers of the surviving and the merged loop.
+	Into, From string
+
+	// Arrays are the temporaries the first loop stores and the second loads.
+	Arrays []string
+
+	// Loops is the number of loops the merge removed: one, or two when
+	// both nests have a tail loop.
+	Loops int
+}
+
+// loopNest is a top-level loop together with the tail loop that follows it,
+// if any. A tail loop starts where the main loop's index stopped, either by
+// continuing it ("for ; i < n; i++") or by starting from it
+// ("for i := ii; i < n; i++").
+type loopNest struct {
+	loops []*IRNode
+}
+
+// FuseLoopChains merges each top-level loop nest into the one before it when
+// the first stores an array the second loads, typically a temporary passed
+// between two inlined kernels such as a dequantize and an activation. The
+// nests must be adjacent, have the same iteration spaces, contain no control
+// flow, and only access slices at the loop index, so that running both
+// bodies in one iteration preserves every read-after-write. Merged loops
+// make a single pass over memory, and the temporary stays in cache.
+//
+// It returns the merges done and, for producer/consumer nests it could not
+// merge, the reason.
+func FuseLoopChains(fn *IRFunction) (merged []LoopMerge, missed []string) {
+	var ops []*IRNode
+	var prev *loopNest
+	prevStart := 0 // index of prev's first loop in ops
+	for i := 0; i < len(fn.Operations); i++ {
+		op := fn.Operations[i]
+		if op.Kind != OpKindLoop || op.LoopRange == nil {
+			ops = append(ops, op)
+			if !isLoopSetup(op) {
+				prev = nil
+			}
+			continue
+		}
+		nest := &loopNest{loops: []*IRNode{op}}
+		if i+1 < len(fn.Operations) {
+			next := fn.Operations[i+1]
+			if next.Kind == OpKindLoop && next.LoopRange != nil &&
+				(next.LoopRange.Start == "" || next.LoopRange.Start == op.LoopRange.LoopVar) {
+				nest.loops = append(nest.loops, next)
+				i++
+			}
+		}
+
+		if prev != nil {
+			if arrays := passedArrays(prev, nest); len(arrays) > 0 {
+				prevEnd := prevStart + len(prev.loops)
+				setup := ops[prevEnd:]
+				reason := cannotMergeNests(prev, nest)
+				if reason == "" && slices.ContainsFunc(setup, func(n *IRNode) bool { return readsAny(n, arrays) }) {
+					reason = "setup between the loops reads " + strings.Join(arrays, ", ")
+				}
+				if reason != "" {
+					missed = append(missed, fmt.Sprintf("%s: %s\n    %s\n    %s",
+						strings.Join(arrays, ", "), reason,
+						prev.loops[0].LoopRange.header(), nest.loops[0].LoopRange.header()))
+				} else {
+					merged = append(merged, LoopMerge{
+						Into:   prev.loops[0].LoopRange.header(),
+						From:   nest.loops[0].LoopRange.header(),
+						Arrays: arrays,
+						Loops:  len(nest.loops),
+					})
+					mergeNests(fn, prev, nest)
+
+					// Hoist the second nest's setup (index and constant
+					// definitions) above the merged loops.
+					moved := slices.Concat(setup, ops[prevStart:prevEnd])
+					ops = append(ops[:prevStart], moved...)
+					prevStart += len(setup)
+					continue
+				}
+			}
+		}
+		prevStart = len(ops)
+		ops = append(ops, nest.loops...)
+		prev = nest
+	}
+	fn.Operations = ops
+	if len(merged) > 0 {
+		renumberNodes(fn)
+	}
+	return merged, missed
+}
+
+// isLoopSetup reports whether a top-level node between two loops only sets
+// up the second (an index, a constant, an allocation) and so may be hoisted
+// above the first.
+func isLoopSetup(node *IRNode) bool {
+	switch node.Kind {
+	case OpKindScalar, OpKindBroadcast, OpKindAlloc, OpKindNoop:
+		return node.Op != "copy"
+	case OpKindControl:
+		return node.Op == "if"
+	case OpKindCall:
+		// hwy constructors and lane-count queries, e.g. hwy.Const[T](0)
+		// and v.NumLanes().
+		return strings.HasPrefix(node.CallTarget, "hwy.") ||
+			node.Op == "NumLanes" || node.Op == "MaxLanes"
+	default:
+		return false
+	}
+}
+
+// readsAny reports whether node names any of the arrays.
+func readsAny(node *IRNode, arrays []string) bool {
+	for _, name := range node.InputNames {
+		for _, id := range identRe.FindAllString(name, -1) {
+			if slices.Contains(arrays, id) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// passedArrays returns the arrays stored in a and loaded in b.
+func passedArrays(a, b *loopNest) []string {
+	stored := make(map[string]bool)
+	for _, loop := range a.loops {
+		for _, child := range loop.Children {
+			if child.Kind == OpKindStore {
+				for _, acc := range sliceAccesses(child) {
+					stored[acc[0]] = true
+				}
+			}
+		}
+	}
+	var arrays []string
+	for _, loop := range b.loops {
+		for _, child := range loop.Children {
+			if child.Kind != OpKindLoad {
+				continue
+			}
+			for _, acc := range sliceAccesses(child) {
+				if stored[acc[0]] && !slices.Contains(arrays, acc[0]) {
+					arrays = append(arrays, acc[0])
+				}
+			}
+		}
+	}
+	return arrays
+}
+
+// cannotMergeNests returns why b cannot be merged into a, or "".
+func cannotMergeNests(a, b *loopNest) string {
+	if len(a.loops) != len(b.loops) {
+		return "only one loop has a tail"
+	}
+	// A tail loop starting from its main loop's index is compared as if
+	// b's main index were already renamed to a's.
+	rename := map[string]string{b.loops[0].LoopRange.LoopVar: a.loops[0].LoopRange.LoopVar}
+	for i := range a.loops {
+		blr := b.loops[i].LoopRange.Clone()
+		blr.Start = renameIdents(blr.Start, rename)
+		if !a.loops[i].LoopRange.Same(blr) {
+			if i > 0 {
+				return "tail loops iterate differently"
+			}
+			return "iteration spaces differ"
+		}
+	}
+	for _, nest := range []*loopNest{a, b} {
+		for _, loop := range nest.loops {
+			for _, child := range loop.Children {
+				if child.Kind == OpKindControl || child.Kind == OpKindLoop {
+					return "loop body has control flow"
+				}
+				for _, acc := range sliceAccesses(child) {
+					if acc[1] != loop.LoopRange.LoopVar {
+						return fmt.Sprintf("%s is accessed at %s, not at the loop index", acc[0], acc[1])
+					}
+				}
+			}
+		}
+	}
+	return ""
+}
+
+// mergeNests appends b's loop bodies to a's, renaming b's loop indexes to
+// a's, and drops b's loop nodes from the function.
+func mergeNests(fn *IRFunction, a, b *loopNest) {
+	for i, loop := range b.loops {
+		rename := map[string]string{loop.LoopRange.LoopVar: a.loops[i].LoopRange.LoopVar}
+		for _, child := range loop.Children {
+			child.InputNames = renameAll(child.InputNames, rename)
+			if child.LoopRange != nil {
+				child.LoopRange = a.loops[i].LoopRange.Clone()
+			}
+			a.loops[i].Children = append(a.loops[i].Children, child)
+		}
+		delete(fn.AllNodes, loop.ID)
+	}
+}
+
+// sliceAccesses returns the (array, index) pairs a load or store node reads
+// or writes: either a Store/LoadScalar's [array, index] names or a
+// vector access written as "array[index:]".
+func sliceAccesses(node *IRNode) [][2]string {
+	if node.Kind != OpKindLoad && node.Kind != OpKindStore {
+		return nil
+	}
+	names := node.InputNames
+	if (node.Op == "Store" || node.Op == "LoadScalar") && len(names) == 2 && isIdent(names[0]) {
+		return [][2]string{{names[0], names[1]}}
+	}
+	var accesses [][2]string
+	for _, name := range names {
+		open := strings.IndexByte(name, '[')
+		if open <= 0 || !strings.HasSuffix(name, "]") || !isIdent(name[:open]) {
+			continue
+		}
+		index := strings.TrimSuffix(name[open+1:len(name)-1], ":")
+		accesses = append(accesses, [2]string{name[:open], index})
+	}
+	return accesses
+}
@@ -0,0 +1,644 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ir
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// GoAsmFunc is a kernel compiled to Go (Plan 9) assembly by EmitAVX512GoAsm.
+type GoAsmFunc struct {
+	// Symbol is the assembly function name, without the leading "·".
+	Symbol string
+
+	// Text is the TEXT block, ready to be written after #include "textflag.h".
+	Text string
+
+	// Decl is the matching //go:noescape Go declaration.
+	Decl string
+}
+
+// goAsmElem describes how an element type maps onto AVX-512 instructions.
+type goAsmElem struct {
+	size     int    // bytes per lane
+	lanes    int    // lanes per ZMM register
+	suffix   string // PS or PD
+	bcast    string // VBROADCASTSS or VBROADCASTSD
+	gprBcast string // VPBROADCASTD or VPBROADCASTQ
+	and, xor string // integer ops for Abs and Neg
+	movImm   string // MOVL or MOVQ
+	scalar   string // Go type of by-value scalars
+}
+
+var goAsmElems = map[string]goAsmElem{
+	"float32": {4, 16, "PS", "VBROADCASTSS", "VPBROADCASTD", "VPANDD", "VPXORD", "MOVL", "float32"},
+	"float64": {8, 8, "PD", "VBROADCASTSD", "VPBROADCASTQ", "VPANDQ", "VPXORQ", "MOVQ", "float64"},
+}
+
+// goAsmPtrRegs are the registers slice base pointers are kept in. AX holds
+// the loop index, DX the length, and BX and CX are scratch.
+var goAsmPtrRegs = []string{"SI", "DI", "R8", "R9", "R10", "R11", "R12", "R13"}
+
+// goAsmRound maps rounding ops to VRNDSCALE immediates (bit 3 suppresses the
+// precision exception). hwy.Round rounds halves away from zero, which
+// VRNDSCALE cannot do, so it is not supported.
+var goAsmRound = map[string]int{
+	"RoundToEven": 8, "Floor": 9, "Ceil": 10, "Trunc": 11,
+}
+
+var sliceAtLoopVarRe = regexp.MustCompile(`^([A-Za-z_][A-Za-z0-9_]*)\[([A-Za-z_][A-Za-z0-9_]*):\]$`)
+
+// EmitAVX512GoAsm compiles fn to AVX-512 Go assembly without going through
+// C. Only elementwise map kernels are supported: a single vectorized loop
+// that loads from slice parameters at the loop index, combines the loads
+// with arithmetic ops and broadcasts of scalar parameters or constants, and
+// stores the results back at the loop index. The scalar tail loop that
+// follows such a loop is replaced by one masked iteration.
+//
+// The assembly takes its arguments the way hwygen's C wrappers pass them to
+// GOAT-compiled functions: slices as data pointers, ints as pointers to
+// int64, and T scalars by value. An error is returned for any function
+// outside the supported subset so callers can fall back to GOAT.
+func EmitAVX512GoAsm(fn *IRFunction, symbol string) (*GoAsmFunc, error) {
+	elem, ok := goAsmElems[fn.ElemType]
+	if !ok {
+		return nil, fmt.Errorf("element type %s is not supported", fn.ElemType)
+	}
+	if len(fn.Returns) > 0 {
+		return nil, fmt.Errorf("functions with results are not supported")
+	}
+	e := &goAsmEmitter{
+		fn:       fn,
+		elem:     elem,
+		args:     make(map[string]goAsmArg),
+		ptrRegs:  make(map[string]string),
+		regs:     make(map[*IRNode]int),
+		consts:   make(map[string]int),
+		lastUse:  make(map[*IRNode]int),
+		visiting: make(map[*IRNode]bool),
+	}
+	if err := e.layoutArgs(); err != nil {
+		return nil, err
+	}
+	if err := e.findLoop(); err != nil {
+		return nil, err
+	}
+	if err := e.schedule(); err != nil {
+		return nil, err
+	}
+	return e.emit(symbol)
+}
+
+// goAsmArg is one argument of the assembly function.
+type goAsmArg struct {
+	name   string // name in the Go declaration and FP references
+	goType string // unsafe.Pointer or the scalar type
+	offset int
+}
+
+type goAsmEmitter struct {
+	fn   *IRFunction
+	elem goAsmElem
+
+	args     map[string]goAsmArg // by Go parameter name
+	order    []goAsmArg
+	argSize  int
+	length   string // FP name of the pointer to the loop bound
+	ptrRegs  map[string]string
+	loopVar  string
+	loop     *IRNode
+	body     []*IRNode // vector values and stores in emission order
+	stores   []*IRNode
+	regs     map[*IRNode]int
+	consts   map[string]int // hoisted broadcasts by key
+	setup    []string       // instructions run once before the loop
+	nextReg  int
+	lastUse  map[*IRNode]int
+	visiting map[*IRNode]bool
+}
+
+// layoutArgs assigns frame offsets following hwygen's wrapper convention.
+func (e *goAsmEmitter) layoutArgs() error {
+	add := func(paramName, name, goType string, size int) {
+		e.argSize = (e.argSize + size - 1) / size * size
+		a := goAsmArg{name: name, goType: goType, offset: e.argSize}
+		e.argSize += size
+		e.order = append(e.order, a)
+		if paramName != "" {
+			e.args[paramName] = a
+		}
+	}
+	hasInt := false
+	var firstSlice string
+	for _, p := range e.fn.Params {
+		switch {
+		case p.IsSlice:
+			if p.ElemType != e.fn.ElemType {
+				return fmt.Errorf("parameter %s: only []%s slices are supported", p.Name, e.fn.ElemType)
+			}
+			if firstSlice == "" {
+				firstSlice = p.Name
+			}
+			add(p.Name, p.Name, "unsafe.Pointer", 8)
+		case p.IsInt:
+			hasInt = true
+			add(p.Name, "p"+p.Name, "unsafe.Pointer", 8)
+		case p.Type == "T":
+			add(p.Name, p.Name, e.elem.scalar, e.elem.size)
+		default:
+			return fmt.Errorf("parameter %s of type %s is not supported", p.Name, p.Type)
+		}
+	}
+	if !hasInt && firstSlice != "" {
+		add("len("+firstSlice+")", "plen", "unsafe.Pointer", 8)
+	}
+	e.argSize = (e.argSize + 7) / 8 * 8
+	return nil
+}
+
+// findLoop locates the vectorized loop and checks that everything around it
+// is something the assembly can reproduce.
+func (e *goAsmEmitter) findLoop() error {
+	for i, op := range e.fn.Operations {
+		if isBroadcast(op) || op.Kind == OpKindNoop {
+			continue
+		}
+		if op.Kind != OpKindLoop {
+			return fmt.Errorf("unsupported %s %s outside the vector loop", op.Kind, op.Op)
+		}
+		lr := op.LoopRange
+		if lr == nil {
+			return fmt.Errorf("loop without a recognizable range")
+		}
+		if e.loop == nil && lr.IsVectorized {
+			if lr.Start != "" && lr.Start != "0" {
+				return fmt.Errorf("vector loop starts at %s, not 0", lr.Start)
+			}
+			a, ok := e.args[lr.End]
+			if !ok || a.goType != "unsafe.Pointer" || a.name == lr.End {
+				return fmt.Errorf("vector loop bound %s is not an int parameter", lr.End)
+			}
+			e.length = a.name
+			e.loop = op
+			e.loopVar = lr.LoopVar
+			continue
+		}
+		// The only other loop allowed is the scalar tail directly after the
+		// vector loop, which the masked tail iteration replaces.
+		if e.loop == nil || e.fn.Operations[i-1] != e.loop || lr.IsVectorized ||
+			lr.Step != "1" || lr.LoopVar != e.loopVar || lr.End != e.loop.LoopRange.End ||
+			(lr.Start != "" && lr.Start != e.loopVar) {
+			return fmt.Errorf("unsupported loop over %s", lr.LoopVar)
+		}
+	}
+	if e.loop == nil {
+		return fmt.Errorf("no vectorized loop")
+	}
+	return nil
+}
+
+// operands returns the vector operands of an elementwise node in source
+// order. The builder records arguments it produced nodes for in Inputs and
+// drops the rest to text in InputNames, so the call's AST is used to put
+// them back in order. A nil entry is an hwy.Zero argument, the only
+// textual operand supported; ok is false if there are others.
+func operands(n *IRNode) (nodes []*IRNode, ok bool) {
+	if len(n.InputNames) == 0 {
+		return n.Inputs, true
+	}
+	call, isCall := n.ASTNode.(*ast.CallExpr)
+	if !isCall || len(call.Args) != len(n.Inputs)+len(n.InputNames) {
+		return nil, false
+	}
+	in := 0
+	for _, arg := range call.Args {
+		if isZeroCall(arg) {
+			nodes = append(nodes, nil)
+			continue
+		}
+		if in == len(n.Inputs) {
+			return nil, false
+		}
+		nodes = append(nodes, n.Inputs[in])
+		in++
+	}
+	return nodes, in == len(n.Inputs)
+}
+
+// goAsmArity is the number of vector operands of each supported op.
+var goAsmArity = map[string]int{
+	"Add": 2, "Sub": 2, "Mul": 2, "Div": 2, "Min": 2, "Max": 2,
+	"MulAdd": 3, "FMA": 3,
+	"Sqrt": 1, "Abs": 1, "Neg": 1,
+	"RoundToEven": 1, "Floor": 1, "Ceil": 1, "Trunc": 1,
+}
+
+// schedule orders the loop body so every value is computed before its
+// first use, hoists broadcasts out of the loop, and records last uses for
+// register allocation.
+func (e *goAsmEmitter) schedule() error {
+	for _, n := range e.loop.Children {
+		switch n.Kind {
+		case OpKindStore:
+			if n.Op != "Store" || len(n.Inputs) != 1 || len(n.InputNames) != 1 || n.Inputs[0] == nil {
+				return fmt.Errorf("unsupported store")
+			}
+			if _, err := e.slicePtr(n.InputNames[0]); err != nil {
+				return err
+			}
+			if err := e.visit(n.Inputs[0]); err != nil {
+				return err
+			}
+			e.body = append(e.body, n)
+			e.stores = append(e.stores, n)
+		case OpKindLoad, OpKindElementwise, OpKindBroadcast, OpKindNoop:
+			// Scheduled when a store reaches them; dead values are dropped.
+		default:
+			if !isBroadcast(n) {
+				return fmt.Errorf("unsupported %s %s in the vector loop", n.Kind, n.Op)
+			}
+		}
+	}
+	if len(e.stores) == 0 {
+		return fmt.Errorf("vector loop does not store anything")
+	}
+	for i, n := range e.body {
+		for _, in := range e.inputs(n) {
+			e.lastUse[in] = i
+		}
+	}
+	return nil
+}
+
+// inputs returns the vector operands of a scheduled node.
+func (e *goAsmEmitter) inputs(n *IRNode) []*IRNode {
+	if n.Kind == OpKindStore {
+		return n.Inputs
+	}
+	nodes, _ := operands(n)
+	var ins []*IRNode
+	for _, in := range nodes {
+		if in != nil {
+			ins = append(ins, in)
+		}
+	}
+	return ins
+}
+
+// visit schedules n after its operands.
+func (e *goAsmEmitter) visit(n *IRNode) error {
+	if _, done := e.regs[n]; done {
+		return nil
+	}
+	if e.visiting[n] {
+		return fmt.Errorf("cyclic value %s", n.Op)
+	}
+	e.visiting[n] = true
+	defer delete(e.visiting, n)
+
+	if isBroadcast(n) {
+		reg, err := e.broadcast(n)
+		if err != nil {
+			return err
+		}
+		e.regs[n] = reg
+		return nil
+	}
+	switch n.Kind {
+	case OpKindLoad:
+		if n.Op != "Load" || len(n.InputNames) != 1 || len(n.Inputs) != 0 {
+			return fmt.Errorf("unsupported load %s", n.Op)
+		}
+		if _, err := e.slicePtr(n.InputNames[0]); err != nil {
+			return err
+		}
+	case OpKindElementwise:
+		arity, ok := goAsmArity[n.Op]
+		if !ok {
+			return fmt.Errorf("op %s is not supported", n.Op)
+		}
+		nodes, ok := operands(n)
+		if !ok || len(nodes) != arity {
+			return fmt.Errorf("op %s: operands are not all vector values", n.Op)
+		}
+		for _, in := range nodes {
+			if in == nil {
+				e.zeroConst()
+				continue
+			}
+			if err := e.visit(in); err != nil {
+				return err
+			}
+		}
+		if n.Op == "Abs" || n.Op == "Neg" {
+			e.signConst(n.Op == "Abs")
+		}
+	default:
+		return fmt.Errorf("unsupported %s %s in the vector loop", n.Kind, n.Op)
+	}
+	e.regs[n] = -1 // allocated during emission
+	e.body = append(e.body, n)
+	return nil
+}
+
+// slicePtr returns the register holding the base of the slice named by an
+// "s[i:]" operand, where i is the loop variable.
+func (e *goAsmEmitter) slicePtr(expr string) (string, error) {
+	m := sliceAtLoopVarRe.FindStringSubmatch(expr)
+	if m == nil || m[2] != e.loopVar {
+		return "", fmt.Errorf("memory operand %s is not indexed by the loop variable", expr)
+	}
+	a, ok := e.args[m[1]]
+	if !ok || a.name != m[1] || a.goType != "unsafe.Pointer" {
+		return "", fmt.Errorf("memory operand %s is not a slice parameter", expr)
+	}
+	if reg, ok := e.ptrRegs[m[1]]; ok {
+		return reg, nil
+	}
+	if len(e.ptrRegs) == len(goAsmPtrRegs) {
+		return "", fmt.Errorf("too many slices")
+	}
+	reg := goAsmPtrRegs[len(e.ptrRegs)]
+	e.ptrRegs[m[1]] = reg
+	e.setup = append(e.setup, fmt.Sprintf("MOVQ %s+%d(FP), %s", a.name, a.offset, reg))
+	return reg, nil
+}
+
+// broadcast hoists a Set, Const or Zero into a register before the loop.
+func (e *goAsmEmitter) broadcast(n *IRNode) (int, error) {
+	var arg string
+	switch {
+	case n.Op == "Zero":
+		return e.zeroConst(), nil
+	case (n.Op == "Set" || n.Op == "Const") && len(n.InputNames) == 1 && len(n.Inputs) == 0:
+		arg = n.InputNames[0]
+	case (n.Op == "Set" || n.Op == "Const") && len(n.Inputs) == 1 && n.Inputs[0] != nil &&
+		n.Inputs[0].Kind == OpKindBroadcast && n.Inputs[0].Op == "Const" && len(n.Inputs[0].Outputs) == 1:
+		arg = n.Inputs[0].Outputs[0]
+	default:
+		return 0, fmt.Errorf("unsupported broadcast %s", n.Op)
+	}
+	if a, ok := e.args[arg]; ok && a.name == arg && a.goType == e.elem.scalar {
+		return e.hoist("param:"+arg, func(z string) []string {
+			return []string{fmt.Sprintf("%s %s+%d(FP), %s", e.elem.bcast, a.name, a.offset, z)}
+		}), nil
+	}
+	v, err := strconv.ParseFloat(stripConversion(arg), 64)
+	if err != nil {
+		return 0, fmt.Errorf("broadcast of %s: not a %s parameter or constant", arg, e.elem.scalar)
+	}
+	bits := math.Float64bits(v)
+	if e.elem.size == 4 {
+		bits = uint64(math.Float32bits(float32(v)))
+	}
+	return e.hoistBits(bits), nil
+}
+
+// signConst hoists the mask Abs (abs=true) or Neg needs and returns its
+// register.
+func (e *goAsmEmitter) signConst(abs bool) int {
+	sign := uint64(1) << (8*e.elem.size - 1)
+	if abs {
+		return e.hoistBits(sign - 1) // every bit but the sign
+	}
+	return e.hoistBits(sign)
+}
+
+// zeroConst hoists a zero vector and returns its register.
+func (e *goAsmEmitter) zeroConst() int {
+	return e.hoist("zero", func(z string) []string {
+		return []string{fmt.Sprintf("%s %s, %s, %s", e.elem.xor, z, z, z)}
+	})
+}
+
+// hoistBits broadcasts a raw lane bit pattern.
+func (e *goAsmEmitter) hoistBits(bits uint64) int {
+	return e.hoist(fmt.Sprintf("bits:%#x", bits), func(z string) []string {
+		return []string{
+			fmt.Sprintf("%s $%#x, BX", e.elem.movImm, bits),
+			fmt.Sprintf("%s BX, %s", e.elem.gprBcast, z),
+		}
+	})
+}
+
+// hoist returns the register holding the loop invariant named key,
+// emitting its setup the first time.
+func (e *goAsmEmitter) hoist(key string, setup func(z string) []string) int {
+	if reg, ok := e.consts[key]; ok {
+		return reg
+	}
+	reg := e.nextReg
+	e.nextReg++
+	e.consts[key] = reg
+	e.setup = append(e.setup, setup(zreg(reg))...)
+	return reg
+}
+
+// emit allocates registers and writes the TEXT block and declaration.
+func (e *goAsmEmitter) emit(symbol string) (*GoAsmFunc, error) {
+	// Loop values take the registers left after the hoisted invariants.
+	var free []int
+	for r := 31; r >= e.nextReg; r-- {
+		free = append(free, r)
+	}
+	for i, n := range e.body {
+		if n.Kind == OpKindStore {
+			continue
+		}
+		if len(free) == 0 {
+			return nil, fmt.Errorf("out of vector registers")
+		}
+		// The destination is taken before operands are released so that
+		// MulAdd's copy of the addend cannot clobber a multiplicand.
+		e.regs[n] = free[len(free)-1]
+		free = free[:len(free)-1]
+		for _, in := range e.inputs(n) {
+			if e.lastUse[in] == i && e.regs[in] >= e.nextReg {
+				free = append(free, e.regs[in])
+			}
+		}
+		if _, used := e.lastUse[n]; !used {
+			free = append(free, e.regs[n])
+		}
+	}
+
+	var sb strings.Builder
+	w := func(format string, args ...any) {
+		sb.WriteString("\t")
+		fmt.Fprintf(&sb, format, args...)
+		sb.WriteString("\n")
+	}
+	fmt.Fprintf(&sb, "// func %s\n", e.decl(symbol))
+	fmt.Fprintf(&sb, "TEXT ·%s(SB), NOSPLIT, $0-%d\n", symbol, e.argSize)
+	for _, line := range e.setup {
+		w("%s", line)
+	}
+	w("MOVQ %s+%d(FP), DX", e.length, e.argOffset(e.length))
+	w("MOVQ (DX), DX")
+	w("XORQ AX, AX")
+	sb.WriteString("\nloop:\n")
+	w("LEAQ %d(AX), BX", e.elem.lanes)
+	w("CMPQ BX, DX")
+	w("JGT  tail")
+	for _, line := range e.bodyLines(false) {
+		w("%s", line)
+	}
+	w("MOVQ BX, AX")
+	w("JMP  loop")
+	sb.WriteString("\ntail:\n")
+	w("MOVQ DX, CX")
+	w("SUBQ AX, CX")
+	w("JLE  done")
+	w("MOVQ $1, BX")
+	w("SHLQ CX, BX")
+	w("DECQ BX")
+	w("KMOVW BX, K1")
+	for _, line := range e.bodyLines(true) {
+		w("%s", line)
+	}
+	sb.WriteString("\ndone:\n")
+	w("VZEROUPPER")
+	w("RET")
+
+	return &GoAsmFunc{
+		Symbol: symbol,
+		Text:   sb.String(),
+		Decl:   "//go:noescape\nfunc " + e.decl(symbol),
+	}, nil
+}
+
+// bodyLines returns the loop body; the masked tail loads and stores only
+// the lanes selected by K1.
+func (e *goAsmEmitter) bodyLines(masked bool) []string {
+	s := e.elem.suffix
+	var lines []string
+	mem := func(expr string) string {
+		reg, _ := e.slicePtr(expr)
+		return fmt.Sprintf("(%s)(AX*%d)", reg, e.elem.size)
+	}
+	for _, n := range e.body {
+		switch n.Kind {
+		case OpKindLoad:
+			if masked {
+				lines = append(lines, fmt.Sprintf("VMOVU%s.Z %s, K1, %s", s, mem(n.InputNames[0]), e.reg(n)))
+			} else {
+				lines = append(lines, fmt.Sprintf("VMOVU%s %s, %s", s, mem(n.InputNames[0]), e.reg(n)))
+			}
+			continue
+		case OpKindStore:
+			if masked {
+				lines = append(lines, fmt.Sprintf("VMOVU%s %s, K1, %s", s, e.reg(n.Inputs[0]), mem(n.InputNames[0])))
+			} else {
+				lines = append(lines, fmt.Sprintf("VMOVU%s %s, %s", s, e.reg(n.Inputs[0]), mem(n.InputNames[0])))
+			}
+			continue
+		}
+
+		nodes, _ := operands(n)
+		ops := make([]string, len(nodes))
+		for i, in := range nodes {
+			if in == nil {
+				ops[i] = zreg(e.zeroConst())
+				continue
+			}
+			ops[i] = e.reg(in)
+		}
+		d := e.reg(n)
+		switch n.Op {
+		case "Add", "Sub", "Mul", "Div", "Min", "Max":
+			lines = append(lines, fmt.Sprintf("V%s%s %s, %s, %s", strings.ToUpper(n.Op), s, ops[1], ops[0], d))
+		case "MulAdd", "FMA":
+			lines = append(lines,
+				fmt.Sprintf("VMOVAP%s %s, %s", s[1:], ops[2], d),
+				fmt.Sprintf("VFMADD231%s %s, %s, %s", s, ops[1], ops[0], d))
+		case "Sqrt":
+			lines = append(lines, fmt.Sprintf("VSQRT%s %s, %s", s, ops[0], d))
+		case "Abs":
+			lines = append(lines, fmt.Sprintf("%s %s, %s, %s", e.elem.and, zreg(e.signConst(true)), ops[0], d))
+		case "Neg":
+			lines = append(lines, fmt.Sprintf("%s %s, %s, %s", e.elem.xor, zreg(e.signConst(false)), ops[0], d))
+		default:
+			lines = append(lines, fmt.Sprintf("VRNDSCALE%s $%d, %s, %s", s, goAsmRound[n.Op], ops[0], d))
+		}
+	}
+	return lines
+}
+
+// decl returns the Go signature of the assembly function.
+func (e *goAsmEmitter) decl(symbol string) string {
+	var groups []string
+	for i, a := range e.order {
+		if i+1 < len(e.order) && e.order[i+1].goType == a.goType {
+			groups = append(groups, a.name)
+			continue
+		}
+		groups = append(groups, a.name+" "+a.goType)
+	}
+	return symbol + "(" + strings.Join(groups, ", ") + ")"
+}
+
+// argOffset returns the frame offset of the argument with FP name name.
+func (e *goAsmEmitter) argOffset(name string) int {
+	for _, a := range e.order {
+		if a.name == name {
+			return a.offset
+		}
+	}
+	return 0
+}
+
+func (e *goAsmEmitter) reg(n *IRNode) string { return zreg(e.regs[n]) }
+
+func zreg(r int) string { return "Z" + strconv.Itoa(r) }
+
+// isZeroCall reports whether expr is hwy.Zero[T]().
+func isZeroCall(expr ast.Expr) bool {
+	call, ok := expr.(*ast.CallExpr)
+	if !ok || len(call.Args) != 0 {
+		return false
+	}
+	idx, ok := call.Fun.(*ast.IndexExpr)
+	return ok && types.ExprString(idx.X) == "hwy.Zero"
+}
+
+// isBroadcast reports whether n is a loop-invariant Set, Const or Zero. The
+// builder records the explicitly instantiated forms (hwy.Zero[T]()) as
+// calls.
+func isBroadcast(n *IRNode) bool {
+	switch n.Kind {
+	case OpKindBroadcast:
+		return true
+	case OpKindCall:
+		return n.CallTarget == "hwy.Zero" || n.CallTarget == "hwy.Set" || n.CallTarget == "hwy.Const"
+	}
+	return false
+}
+
+// stripConversion removes a T(...), float32(...) or float64(...)
+// conversion around a literal.
+func stripConversion(s string) string {
+	for _, conv := range []string{"T(", "float32(", "float64("} {
+		if strings.HasPrefix(s, conv) && strings.HasSuffix(s, ")") {
+			return strings.TrimSuffix(strings.TrimPrefix(s, conv), ")")
+		}
+	}
+	return s
+}
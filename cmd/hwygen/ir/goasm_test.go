@@ -0,0 +1,181 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ir
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strings"
+	"testing"
+)
+
+const goAsmTestSrc = `
+package test
+
+func BaseAxpyClamp[T hwy.Floats](x, y, dst []T, alpha, lo T, n int) {
+	va := hwy.Set(alpha)
+	vlo := hwy.Set(lo)
+	half := hwy.Set(T(0.5))
+	lanes := hwy.Zero[T]().NumLanes()
+	i := 0
+	for ; i+lanes <= n; i += lanes {
+		r := hwy.MulAdd(va, hwy.Load(x[i:]), hwy.Load(y[i:]))
+		r = hwy.Max(r, vlo)
+		r = hwy.Sub(hwy.Zero[T](), hwy.Mul(hwy.Abs(r), half))
+		hwy.Store(r, dst[i:])
+	}
+	for ; i < n; i++ {
+		dst[i] = -max(alpha*x[i]+y[i], lo) * 0.5
+	}
+}
+
+func BaseExp[T hwy.Floats](x, dst []T, n int) {
+	lanes := hwy.Zero[T]().NumLanes()
+	for i := 0; i+lanes <= n; i += lanes {
+		hwy.Store(hwy.Exp(hwy.Load(x[i:])), dst[i:])
+	}
+}
+
+func BaseReverse[T hwy.Floats](input, output []T, n int) {
+	for i := 0; i < n; i++ {
+		output[i] = input[n-1-i]
+	}
+}
+`
+
+// buildGoAsmTestFunc builds the IR of a function in goAsmTestSrc.
+func buildGoAsmTestFunc(t *testing.T, name, elemType string) *IRFunction {
+	t.Helper()
+	file, err := parser.ParseFile(token.NewFileSet(), "test.go", goAsmTestSrc, 0)
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	for _, decl := range file.Decls {
+		fd, ok := decl.(*ast.FuncDecl)
+		if !ok || fd.Name.Name != name {
+			continue
+		}
+		pf := &ParsedFunc{
+			Name:       name,
+			Body:       fd.Body,
+			TypeParams: []TypeParamInput{{Name: "T", Constraint: "hwy.Floats"}},
+		}
+		for _, field := range fd.Type.Params.List {
+			typ := "int"
+			switch ft := field.Type.(type) {
+			case *ast.ArrayType:
+				typ = "[]T"
+			case *ast.Ident:
+				typ = ft.Name
+			}
+			for _, n := range field.Names {
+				pf.Params = append(pf.Params, ParamInput{Name: n.Name, Type: typ})
+			}
+		}
+		fn, err := NewBuilder(WithElemType(elemType)).Build(pf)
+		if err != nil {
+			t.Fatalf("build IR: %v", err)
+		}
+		return fn
+	}
+	t.Fatalf("function %s not found", name)
+	return nil
+}
+
+// TestEmitAVX512GoAsm tests that an elementwise kernel becomes a ZMM loop
+// with a masked tail, taking its arguments as hwygen's C wrappers pass them.
+func TestEmitAVX512GoAsm(t *testing.T) {
+	tests := []struct {
+		elemType string
+		decl     string
+		want     []string
+	}{
+		{
+			elemType: "float32",
+			decl:     "func axpyclamp_c_f32_avx512(x, y, dst unsafe.Pointer, alpha, lo float32, pn unsafe.Pointer)",
+			want: []string{
+				"TEXT ·axpyclamp_c_f32_avx512(SB), NOSPLIT, $0-40",
+				"VBROADCASTSS alpha+24(FP), ",
+				"VBROADCASTSS lo+28(FP), ",
+				"MOVL $0x3f000000, BX",
+				"MOVQ pn+32(FP), DX",
+				"LEAQ 16(AX), BX",
+				"VFMADD231PS ",
+				"VMAXPS ",
+				"VPANDD ",
+				"VSUBPS ",
+				"KMOVW BX, K1",
+				"VMOVUPS.Z (",
+				", K1, (",
+				"VZEROUPPER",
+			},
+		},
+		{
+			elemType: "float64",
+			decl:     "func axpyclamp_c_f64_avx512(x, y, dst unsafe.Pointer, alpha, lo float64, pn unsafe.Pointer)",
+			want: []string{
+				"TEXT ·axpyclamp_c_f64_avx512(SB), NOSPLIT, $0-48",
+				"VBROADCASTSD lo+32(FP), ",
+				"MOVQ $0x3fe0000000000000, BX",
+				"LEAQ 8(AX), BX",
+				"VFMADD231PD ",
+				"VPANDQ ",
+				"VMOVUPD.Z (",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.elemType, func(t *testing.T) {
+			fn := buildGoAsmTestFunc(t, "BaseAxpyClamp", tt.elemType)
+			symbol := "axpyclamp_c_" + map[string]string{"float32": "f32", "float64": "f64"}[tt.elemType] + "_avx512"
+			out, err := EmitAVX512GoAsm(fn, symbol)
+			if err != nil {
+				t.Fatalf("EmitAVX512GoAsm: %v", err)
+			}
+			t.Logf("\n%s", out.Text)
+			if out.Decl != "//go:noescape\n"+tt.decl {
+				t.Errorf("Decl = %q, want %q", out.Decl, tt.decl)
+			}
+			for _, w := range tt.want {
+				if !strings.Contains(out.Text, w) {
+					t.Errorf("assembly missing %q", w)
+				}
+			}
+			// The invariants are broadcast once, before the loop.
+			if loop := out.Text[strings.Index(out.Text, "loop:"):]; strings.Contains(loop, "BROADCAST") {
+				t.Error("broadcast inside the loop")
+			}
+		})
+	}
+}
+
+// TestEmitAVX512GoAsmUnsupported tests that kernels outside the supported
+// subset are rejected so hwygen can fall back to GOAT.
+func TestEmitAVX512GoAsmUnsupported(t *testing.T) {
+	for name, want := range map[string]string{
+		"BaseExp":     "Exp",
+		"BaseReverse": "unsupported loop",
+	} {
+		fn := buildGoAsmTestFunc(t, name, "float32")
+		if _, err := EmitAVX512GoAsm(fn, "f"); err == nil || !strings.Contains(err.Error(), want) {
+			t.Errorf("%s: err = %v, want %q", name, err, want)
+		}
+	}
+	fn := buildGoAsmTestFunc(t, "BaseAxpyClamp", "hwy.Float16")
+	if _, err := EmitAVX512GoAsm(fn, "f"); err == nil {
+		t.Error("hwy.Float16: want an error")
+	}
+}
@@ -0,0 +1,325 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ir
+
+import (
+	"go/ast"
+	"go/types"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// maxInlineDepth bounds how many levels of nested cross-package calls
+// InlineCalls expands (e.g. nn.BaseSoftmax → algo.BaseApply → math.BaseExpVec).
+const maxInlineDepth = 4
+
+// InlinedCall records the outcome of inlining one cross-package call.
+type InlinedCall struct {
+	// Target is the qualified callee name, e.g. "activation.BaseGELU".
+	Target string
+
+	// TypeArgs are the concrete type arguments the callee was resolved with.
+	TypeArgs []string
+
+	// Loops is the number of loops the callee contributed to the caller.
+	Loops int
+
+	// Skipped explains why the call was left in place; empty if it was inlined.
+	Skipped string
+}
+
+// InlineCalls replaces top-level calls to Base* kernels in other packages
+// (OpKindCall nodes) with the callee's operations, as resolved by r. The callee's parameters are
+// renamed to the call's arguments and its locals are prefixed with the
+// callee name, so the data flow between, say, a dequantize loop writing a
+// temporary and a matmul loop reading it becomes visible to Analyze and the
+// fusion passes. Calls whose result is used, that pass function arguments,
+// or that fail to resolve are left in place.
+//
+// InlineCalls renumbers the function's nodes in program order, so it must
+// run before Analyze and ApplyFusionRules.
+func InlineCalls(fn *IRFunction, r Resolver) []InlinedCall {
+	if r == nil {
+		return nil
+	}
+
+	var calls []InlinedCall
+	skipped := make(map[*IRNode]bool)
+	for range maxInlineDepth {
+		changed := false
+		var ops []*IRNode
+		for _, op := range fn.Operations {
+			if op.Kind != OpKindCall || !isKernelCall(op.CallTarget) || skipped[op] {
+				ops = append(ops, op)
+				continue
+			}
+			call, inlined := inlineCall(fn, op, r)
+			calls = append(calls, call)
+			if inlined == nil {
+				skipped[op] = true
+				ops = append(ops, op)
+				continue
+			}
+			delete(fn.AllNodes, op.ID)
+			ops = append(ops, inlined...)
+			changed = true
+		}
+		fn.Operations = ops
+		if !changed {
+			break
+		}
+	}
+
+	renumberNodes(fn)
+	return calls
+}
+
+// FuseAcrossCalls runs hwygen's -fusion pipeline on fn: it inlines the
+// cross-package calls r can resolve, merges the resulting producer/consumer
+// loop chains, and applies the fusion rules. It returns a report of what
+// was done.
+func FuseAcrossCalls(fn *IRFunction, r Resolver) *FusionReport {
+	inlined := InlineCalls(fn, r)
+	merged, missed := FuseLoopChains(fn)
+	ApplyFusionRules(fn)
+	return NewFusionReport(fn, inlined, merged, missed)
+}
+
+// isKernelCall reports whether target ("pkg.Func") names a Base* kernel in
+// another package, as opposed to an hwy op or a method call.
+func isKernelCall(target string) bool {
+	pkg, name, ok := strings.Cut(target, ".")
+	return ok && pkg != "hwy" && (strings.HasPrefix(name, "Base") || strings.HasPrefix(name, "base"))
+}
+
+// inlineCall resolves a single call node and returns copies of the callee's
+// operations, renamed into the caller's namespace, or nil if the call cannot
+// be inlined.
+func inlineCall(fn *IRFunction, call *IRNode, r Resolver) (InlinedCall, []*IRNode) {
+	result := InlinedCall{Target: call.CallTarget}
+	switch {
+	case len(call.Outputs) > 0:
+		result.Skipped = "result is used by the caller"
+		return result, nil
+	case call.FuncArg != "":
+		result.Skipped = "passes function argument " + call.FuncArg
+		return result, nil
+	}
+
+	args := callArgNames(call)
+	if args == nil {
+		result.Skipped = "call arguments are unknown"
+		return result, nil
+	}
+
+	// Type arguments naming the caller's type parameters resolve to the
+	// caller's element type; a non-generic call site inherits it too.
+	for _, ta := range call.CallTypeArgs {
+		if slices.ContainsFunc(fn.TypeParams, func(tp TypeParam) bool { return tp.Name == ta }) {
+			ta = fn.ElemType
+		}
+		result.TypeArgs = append(result.TypeArgs, ta)
+	}
+	if len(result.TypeArgs) == 0 && fn.ElemType != "" {
+		result.TypeArgs = []string{fn.ElemType}
+	}
+
+	callee, err := r.Resolve(call.CallTarget, result.TypeArgs)
+	if err != nil {
+		result.Skipped = err.Error()
+		return result, nil
+	}
+	if callee == nil {
+		result.Skipped = "not found"
+		return result, nil
+	}
+	if len(callee.Params) != len(args) {
+		result.Skipped = "argument count does not match " + callee.Name
+		return result, nil
+	}
+	if len(callee.Returns) > 0 {
+		result.Skipped = callee.Name + " returns values"
+		return result, nil
+	}
+	if slices.ContainsFunc(callee.Operations, func(n *IRNode) bool {
+		return n.Kind == OpKindControl && n.Op == "return"
+	}) {
+		result.Skipped = callee.Name + " has a return statement"
+		return result, nil
+	}
+
+	// Build the renaming: parameters become arguments, every other name the
+	// callee defines gets a prefix so it cannot collide with the caller's.
+	rename := make(map[string]string)
+	prefix := strings.ToLower(callee.Name) + "_"
+	var collectLocals func([]*IRNode)
+	collectLocals = func(nodes []*IRNode) {
+		for _, node := range nodes {
+			for _, out := range node.Outputs {
+				if isIdent(out) {
+					rename[out] = prefix + out
+				}
+			}
+			if node.LoopRange != nil && node.LoopRange.LoopVar != "" {
+				rename[node.LoopRange.LoopVar] = prefix + node.LoopRange.LoopVar
+			}
+			collectLocals(node.Children)
+		}
+	}
+	collectLocals(callee.Operations)
+	for i, p := range callee.Params {
+		rename[p.Name] = args[i]
+	}
+
+	idMap := make(map[int]*IRNode)
+	var copyNodes func([]*IRNode, *IRNode) []*IRNode
+	copyNodes = func(nodes []*IRNode, parent *IRNode) []*IRNode {
+		var copied []*IRNode
+		for _, node := range nodes {
+			var c *IRNode
+			if parent == nil {
+				c = NewNode(fn.NewNodeID(), node.Kind, node.Op)
+				fn.AllNodes[c.ID] = c
+			} else {
+				c = fn.AddChildNode(parent, node.Kind, node.Op)
+			}
+			c.Inputs = slices.Clone(node.Inputs)
+			c.InputNames = renameAll(node.InputNames, rename)
+			c.Outputs = renameAll(node.Outputs, rename)
+			c.OutputTypes = slices.Clone(node.OutputTypes)
+			c.CallTarget = node.CallTarget
+			c.CallTypeArgs = slices.Clone(node.CallTypeArgs)
+			c.FuncArg = node.FuncArg
+			c.AllocSize = renameIdents(node.AllocSize, rename)
+			c.AllocElemType = node.AllocElemType
+			c.ASTNode = node.ASTNode
+			if node.LoopRange != nil {
+				c.LoopRange = node.LoopRange.Clone()
+				c.LoopRange.LoopVar = renameIdents(c.LoopRange.LoopVar, rename)
+				c.LoopRange.Start = renameIdents(c.LoopRange.Start, rename)
+				c.LoopRange.End = renameIdents(c.LoopRange.End, rename)
+				c.LoopRange.Step = renameIdents(c.LoopRange.Step, rename)
+			}
+			idMap[node.ID] = c
+			if len(node.Children) > 0 {
+				copyNodes(node.Children, c)
+			}
+			if parent == nil {
+				copied = append(copied, c)
+			}
+		}
+		return copied
+	}
+	inlined := copyNodes(callee.Operations, nil)
+
+	// Point inputs at the copies rather than the callee's nodes.
+	var fixInputs func([]*IRNode)
+	fixInputs = func(nodes []*IRNode) {
+		for _, node := range nodes {
+			for i, in := range node.Inputs {
+				if in == nil {
+					continue
+				}
+				if c, ok := idMap[in.ID]; ok {
+					node.Inputs[i] = c
+				}
+			}
+			fixInputs(node.Children)
+		}
+	}
+	fixInputs(inlined)
+
+	for _, node := range inlined {
+		if node.Kind == OpKindLoop {
+			result.Loops++
+		}
+	}
+	return result, inlined
+}
+
+// callArgNames returns the source text of each argument of a call node, or
+// nil if the call's AST is not available.
+func callArgNames(call *IRNode) []string {
+	ce, ok := call.ASTNode.(*ast.CallExpr)
+	if !ok {
+		return nil
+	}
+	args := make([]string, len(ce.Args))
+	for i, arg := range ce.Args {
+		args[i] = types.ExprString(arg)
+	}
+	return args
+}
+
+// renumberNodes reassigns node IDs in program order (parents before their
+// children) and rebuilds AllNodes. Fusion treats a higher ID as a later
+// operation, which inlined nodes would otherwise violate.
+func renumberNodes(fn *IRFunction) {
+	fn.AllNodes = make(map[int]*IRNode)
+	fn.nextID = 0
+	var walk func([]*IRNode)
+	walk = func(nodes []*IRNode) {
+		for _, node := range nodes {
+			node.ID = fn.NewNodeID()
+			fn.AllNodes[node.ID] = node
+			walk(node.Children)
+		}
+	}
+	walk(fn.Operations)
+}
+
+var identRe = regexp.MustCompile(`[A-Za-z_][A-Za-z0-9_]*`)
+
+// isIdent reports whether s is a plain identifier.
+func isIdent(s string) bool {
+	return identRe.FindString(s) == s && s != ""
+}
+
+// renameIdents rewrites the identifiers in expression text s using rename.
+// Selector names (the "Lanes" in "v.Lanes") and number suffixes (the "e5"
+// in "1e5") are left alone.
+func renameIdents(s string, rename map[string]string) string {
+	if s == "" {
+		return s
+	}
+	locs := identRe.FindAllStringIndex(s, -1)
+	var sb strings.Builder
+	last := 0
+	for _, loc := range locs {
+		name := s[loc[0]:loc[1]]
+		to, ok := rename[name]
+		if !ok || (loc[0] > 0 && strings.IndexByte(".0123456789", s[loc[0]-1]) >= 0) {
+			continue
+		}
+		sb.WriteString(s[last:loc[0]])
+		sb.WriteString(to)
+		last = loc[1]
+	}
+	sb.WriteString(s[last:])
+	return sb.String()
+}
+
+// renameAll applies renameIdents to each element of names.
+func renameAll(names []string, rename map[string]string) []string {
+	if names == nil {
+		return nil
+	}
+	out := make([]string, len(names))
+	for i, name := range names {
+		out[i] = renameIdents(name, rename)
+	}
+	return out
+}
@@ -0,0 +1,203 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ir
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strings"
+	"testing"
+)
+
+// inlineTestSrc holds a caller chaining kernels from two packages and the
+// kernels themselves, as they would appear in their *_base.go files.
+const inlineTestSrc = `
+package test
+
+func BaseChain[T hwy.Floats](x, out []T, n int) {
+	tmp := make([]T, n)
+	quant.BaseDequant(x, tmp, n)
+	activation.BaseReLU(tmp, out, n)
+}
+
+func BaseDequant[T hwy.Floats](src, dst []T, n int) {
+	lanes := hwy.Zero[T]().NumLanes()
+	ii := 0
+	for ; ii+lanes <= n; ii += lanes {
+		v := hwy.Load(src[ii:])
+		hwy.Store(hwy.Mul(v, v), dst[ii:])
+	}
+	for i := ii; i < n; i++ {
+		dst[i] = src[i] * src[i]
+	}
+}
+
+func BaseReLU[T hwy.Floats](input, output []T, n int) {
+	lanes := hwy.Zero[T]().NumLanes()
+	ii := 0
+	for ; ii+lanes <= n; ii += lanes {
+		v := hwy.Load(input[ii:])
+		hwy.Store(hwy.Max(v, hwy.Zero[T]()), output[ii:])
+	}
+	for i := ii; i < n; i++ {
+		output[i] = max(input[i], 0)
+	}
+}
+
+func BaseReverse[T hwy.Floats](input, output []T, n int) {
+	for i := 0; i < n; i++ {
+		output[i] = input[n-1-i]
+	}
+}
+`
+
+// testResolver resolves "pkg.Func" to a function in inlineTestSrc.
+type testResolver struct {
+	funcs map[string]*ast.FuncDecl
+}
+
+func newTestResolver(t *testing.T) *testResolver {
+	t.Helper()
+	file, err := parser.ParseFile(token.NewFileSet(), "test.go", inlineTestSrc, 0)
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	r := &testResolver{funcs: make(map[string]*ast.FuncDecl)}
+	for _, decl := range file.Decls {
+		if fd, ok := decl.(*ast.FuncDecl); ok {
+			r.funcs[fd.Name.Name] = fd
+		}
+	}
+	return r
+}
+
+func (r *testResolver) Resolve(qualifiedName string, typeArgs []string) (*IRFunction, error) {
+	_, name, _ := strings.Cut(qualifiedName, ".")
+	fd, ok := r.funcs[name]
+	if !ok {
+		return nil, fmt.Errorf("function %s not found", qualifiedName)
+	}
+	return r.build(fd, typeArgs[0])
+}
+
+func (r *testResolver) build(fd *ast.FuncDecl, elemType string) (*IRFunction, error) {
+	pf := &ParsedFunc{
+		Name:       fd.Name.Name,
+		Body:       fd.Body,
+		TypeParams: []TypeParamInput{{Name: "T", Constraint: "hwy.Floats"}},
+	}
+	for _, field := range fd.Type.Params.List {
+		typ := "int"
+		if _, ok := field.Type.(*ast.ArrayType); ok {
+			typ = "[]T"
+		}
+		for _, name := range field.Names {
+			pf.Params = append(pf.Params, ParamInput{Name: name.Name, Type: typ})
+		}
+	}
+	return NewBuilder(WithElemType(elemType)).Build(pf)
+}
+
+// TestFuseAcrossCalls tests that a dequantize→activation chain written as
+// two cross-package calls becomes one pass over memory.
+func TestFuseAcrossCalls(t *testing.T) {
+	r := newTestResolver(t)
+	fn, err := r.build(r.funcs["BaseChain"], "float32")
+	if err != nil {
+		t.Fatalf("build IR: %v", err)
+	}
+
+	report := FuseAcrossCalls(fn, r)
+	t.Logf("%s%s", report, fn.Dump())
+
+	if len(report.Inlined) != 2 {
+		t.Fatalf("inlined %d calls, want 2: %+v", len(report.Inlined), report.Inlined)
+	}
+	for _, c := range report.Inlined {
+		if c.Skipped != "" || c.Loops != 2 {
+			t.Errorf("%s: loops=%d skipped=%q, want 2 loops inlined", c.Target, c.Loops, c.Skipped)
+		}
+	}
+	if len(report.Merged) != 1 || report.Merged[0].Arrays[0] != "tmp" {
+		t.Fatalf("merged = %+v, want one merge via tmp (missed: %v)", report.Merged, report.Missed)
+	}
+
+	// One main loop and one tail loop remain, each running both bodies.
+	var loops []*IRNode
+	for _, op := range fn.Operations {
+		if op.Kind == OpKindCall {
+			t.Errorf("call to %s was not inlined", op.CallTarget)
+		}
+		if op.Kind == OpKindLoop {
+			loops = append(loops, op)
+		}
+	}
+	if len(loops) != 2 {
+		t.Fatalf("got %d loops after fusion, want 2", len(loops))
+	}
+	var ops []string
+	for _, child := range loops[0].Children {
+		ops = append(ops, child.Op+"("+strings.Join(child.InputNames, ", ")+")")
+	}
+	if got, want := strings.Join(ops, " "),
+		"Load(x[basedequant_ii:]) Store(tmp[basedequant_ii:]) Mul() "+
+			"Load(tmp[basedequant_ii:]) Store(out[basedequant_ii:]) Max(hwy.Zero[T](...))"; got != want {
+		t.Errorf("fused loop body = %q, want %q", got, want)
+	}
+
+	if report.Stats.OriginalPasses-report.Stats.FusedPasses < 2 {
+		t.Errorf("passes %d -> %d, want at least 2 saved", report.Stats.OriginalPasses, report.Stats.FusedPasses)
+	}
+	if report.SavedBytesPerElem == 0 {
+		t.Error("SavedBytesPerElem = 0, want an estimate")
+	}
+	if s := report.String(); !strings.Contains(s, "inlined quant.BaseDequant[float32]") ||
+		!strings.Contains(s, "merged loops via tmp") {
+		t.Errorf("report missing inlining or merge:\n%s", s)
+	}
+}
+
+// TestFuseLoopChainsMissed tests that a consumer reading the temporary at a
+// different index is not merged, and that the report says why.
+func TestFuseLoopChainsMissed(t *testing.T) {
+	r := newTestResolver(t)
+	src := `package test
+func BaseChainReverse[T hwy.Floats](x, out []T, n int) {
+	tmp := make([]T, n)
+	for i := 0; i < n; i++ {
+		tmp[i] = x[i] * x[i]
+	}
+	reverse.BaseReverse(tmp, out, n)
+}
+`
+	file, err := parser.ParseFile(token.NewFileSet(), "chain.go", src, 0)
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	fn, err := r.build(file.Decls[0].(*ast.FuncDecl), "float32")
+	if err != nil {
+		t.Fatalf("build IR: %v", err)
+	}
+
+	report := FuseAcrossCalls(fn, r)
+	if len(report.Merged) != 0 {
+		t.Errorf("merged = %+v, want none", report.Merged)
+	}
+	if len(report.Missed) != 1 || !strings.Contains(report.Missed[0], "not at the loop index") {
+		t.Errorf("missed = %q, want one entry about the loop index", report.Missed)
+	}
+}
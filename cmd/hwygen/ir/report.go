@@ -0,0 +1,215 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ir
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// FusionReport summarizes what inlining and fusion did to one function.
+// hwygen prints it for -fusion-report so users can check which loops were
+// fused, and why others were not.
+type FusionReport struct {
+	// Function and ElemType identify the specialization.
+	Function string
+	ElemType string
+
+	// Inlined lists the cross-package calls InlineCalls handled, including
+	// those it had to skip.
+	Inlined []InlinedCall
+
+	// Merged lists the loops FuseLoopChains merged into their producers.
+	Merged []LoopMerge
+
+	// Missed lists producer/consumer loops FuseLoopChains could not merge,
+	// with the reason.
+	Missed []string
+
+	// Groups describes each fusion group.
+	Groups []FusionGroupReport
+
+	// Stats are the pass and allocation counts from ComputeFusionStats,
+	// with OriginalPasses counting merged loops.
+	Stats FusionStats
+
+	// SavedBytesPerElem estimates the memory traffic fusion removed per
+	// element of the iteration space: each eliminated pass saves one read
+	// and one write of ElemType.
+	SavedBytesPerElem int
+}
+
+// FusionGroupReport describes one fusion group.
+type FusionGroupReport struct {
+	ID      int
+	Pattern string
+
+	// Loops are the headers of the loops merged into this group.
+	Loops []string
+
+	// Ops are the operations of the group's non-loop members, in order.
+	Ops []string
+
+	// EliminatedAllocs are the names of the temporaries the group removed.
+	EliminatedAllocs []string
+}
+
+// NewFusionReport builds the report for fn after the fusion passes have run.
+// inlined, merged and missed are the results of InlineCalls and
+// FuseLoopChains, if they ran.
+func NewFusionReport(fn *IRFunction, inlined []InlinedCall, merged []LoopMerge, missed []string) *FusionReport {
+	r := &FusionReport{
+		Function: fn.Name,
+		ElemType: fn.ElemType,
+		Inlined:  inlined,
+		Merged:   merged,
+		Missed:   missed,
+		Stats:    ComputeFusionStats(fn),
+	}
+
+	// FuseLoopChains removed the merged loops from fn, so they are missing
+	// from the original pass count.
+	for _, m := range merged {
+		r.Stats.OriginalPasses += m.Loops
+	}
+
+	for _, group := range fn.FusionGroups {
+		gr := FusionGroupReport{ID: group.ID, Pattern: group.Pattern}
+		members := slices.Clone(group.Members)
+		slices.Sort(members)
+		for _, id := range members {
+			node := fn.GetNode(id)
+			switch {
+			case node == nil:
+			case node.Kind == OpKindLoop:
+				gr.Loops = append(gr.Loops, node.LoopRange.header())
+			case node.Kind != OpKindAlloc:
+				gr.Ops = append(gr.Ops, node.Op)
+			}
+		}
+		for _, id := range group.EliminatedAllocs {
+			if node := fn.GetNode(id); node != nil && len(node.Outputs) > 0 {
+				gr.EliminatedAllocs = append(gr.EliminatedAllocs, node.Outputs[0])
+			}
+		}
+		r.Groups = append(r.Groups, gr)
+	}
+
+	saved := r.Stats.OriginalPasses - r.Stats.FusedPasses
+	r.SavedBytesPerElem = saved * 2 * elemTypeSize(fn.ElemType)
+	return r
+}
+
+// elemTypeSize returns the size in bytes of an element type, defaulting to 4.
+func elemTypeSize(elemType string) int {
+	switch elemType {
+	case "int8", "uint8":
+		return 1
+	case "int16", "uint16", "hwy.Float16", "hwy.BFloat16", "float16", "bfloat16":
+		return 2
+	case "float64", "int64", "uint64":
+		return 8
+	default:
+		return 4
+	}
+}
+
+// String formats the report for humans.
+func (r *FusionReport) String() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%s (%s)\n", r.Function, r.ElemType)
+	for _, c := range r.Inlined {
+		target := c.Target
+		if len(c.TypeArgs) > 0 {
+			target += "[" + strings.Join(c.TypeArgs, ", ") + "]"
+		}
+		if c.Skipped != "" {
+			fmt.Fprintf(&sb, "  not inlined %s: %s\n", target, c.Skipped)
+		} else {
+			fmt.Fprintf(&sb, "  inlined %s: %d loop(s)\n", target, c.Loops)
+		}
+	}
+	for _, m := range r.Merged {
+		fmt.Fprintf(&sb, "  merged loops via %s:\n    %s\n    %s\n", strings.Join(m.Arrays, ", "), m.Into, m.From)
+	}
+	for _, m := range r.Missed {
+		fmt.Fprintf(&sb, "  not merged %s\n", m)
+	}
+	for _, g := range r.Groups {
+		fmt.Fprintf(&sb, "  group %d %s", g.ID, g.Pattern)
+		if len(g.Loops) > 0 {
+			fmt.Fprintf(&sb, ", %d loop(s) fused:", len(g.Loops))
+			for _, l := range g.Loops {
+				fmt.Fprintf(&sb, "\n    %s", l)
+			}
+		}
+		if len(g.Ops) > 0 {
+			fmt.Fprintf(&sb, "\n    ops: %s", strings.Join(g.Ops, " "))
+		}
+		if len(g.EliminatedAllocs) > 0 {
+			fmt.Fprintf(&sb, "\n    eliminated: %s", strings.Join(g.EliminatedAllocs, ", "))
+		}
+		sb.WriteString("\n")
+	}
+	fmt.Fprintf(&sb, "  passes: %d -> %d, allocations eliminated: %d\n",
+		r.Stats.OriginalPasses, r.Stats.FusedPasses, r.Stats.EliminatedAllocs)
+	fmt.Fprintf(&sb, "  estimated memory traffic saved: %d bytes/element\n", r.SavedBytesPerElem)
+	return sb.String()
+}
+
+// header renders a loop range as a Go for-loop header.
+func (lr *LoopRange) header() string {
+	if lr == nil {
+		return "for"
+	}
+	return fmt.Sprintf("for %s := %s; %s < %s; %s += %s",
+		lr.LoopVar, lr.Start, lr.LoopVar, lr.End, lr.LoopVar, lr.Step)
+}
+
+// Dump returns a multi-line listing of the function's operations, indented
+// by loop nesting, with each node's fusion group. Nodes the emitter will
+// drop are marked "(eliminated)".
+func (f *IRFunction) Dump() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%s\n", f)
+	var dump func([]*IRNode, string)
+	dump = func(nodes []*IRNode, indent string) {
+		for _, node := range nodes {
+			sb.WriteString(indent)
+			if node.Kind == OpKindLoop {
+				fmt.Fprintf(&sb, "%d: %s", node.ID, node.LoopRange.header())
+			} else {
+				fmt.Fprintf(&sb, "%d: %s %s", node.ID, node.Kind, node.Op)
+				if len(node.InputNames) > 0 {
+					fmt.Fprintf(&sb, " (%s)", strings.Join(node.InputNames, ", "))
+				}
+				if len(node.Outputs) > 0 {
+					fmt.Fprintf(&sb, " -> %s", strings.Join(node.Outputs, ", "))
+				}
+			}
+			if node.FusionGroup >= 0 {
+				fmt.Fprintf(&sb, " [group %d]", node.FusionGroup)
+			}
+			if node.IsFusionEliminated {
+				sb.WriteString(" (eliminated)")
+			}
+			sb.WriteString("\n")
+			dump(node.Children, indent+"  ")
+		}
+	}
+	dump(f.Operations, "  ")
+	return sb.String()
+}
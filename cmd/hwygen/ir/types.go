@@ -429,7 +429,7 @@ func ClassifyHwyOp(op string) OpKind {
 	switch op {
 	// Elementwise arithmetic
 	case "Add", "Sub", "Mul", "Div", "Neg", "Abs",
-		"MulAdd", "MulSub", "NegMulAdd", "NegMulSub",
+		"MulAdd", "FMA", "MulSub", "NegMulAdd", "NegMulSub",
 		"Min", "Max", "Sqrt", "Rsqrt",
 		"Floor", "Ceil", "Round", "RoundToEven", "Trunc":
 		return OpKindElementwise
@@ -24,10 +24,22 @@
 //
 //	//go:generate hwygen -input $GOFILE -output . -targets avx2,fallback
 //
+// Passing a directory as -input processes the whole package, so kernels can
+// be split across files that share helpers, constants and types:
+//
+//	//go:generate hwygen -input . -output . -targets avx2,fallback
+//
 // The generator takes Go source files containing hwy.* calls and produces:
 //  1. A dispatcher file with runtime CPU detection
 //  2. Target-specific implementation files (AVX2, AVX512, fallback)
 //
+// A //hwy:parallel directive in a Base function's doc comment (or the
+// -parallel flag, for every eligible function) adds an XxxParallel wrapper
+// that splits the function's outer loop across a workerpool.Pool.
+//
+// The -tests flag also emits tests comparing each dispatched function with its
+// fallback on generated inputs, and benchmarks.
+//
 // The -c flag generates GOAT-compatible C files for inspection.
 // The -asm flag generates C files, compiles them to Go assembly via GOAT,
 // and emits Go wrapper functions.
@@ -41,16 +53,25 @@ import (
 )
 
 var (
-	inputFile      = flag.String("input", "", "Input Go source file (required)")
+	inputFile      = flag.String("input", "", "Input Go source file, or package directory to process all its files (required)")
 	outputDir      = flag.String("output", ".", "Output directory (default: current directory)")
-	outputPrefix   = flag.String("output_prefix", "", "Output file prefix, the default (if empty) is the input file name without .go")
+	outputPrefix   = flag.String("output_prefix", "", "Output file prefix, the default (if empty) is the input file name without .go, or the package name for a directory input")
 	targets        = flag.String("targets", "avx2,fallback", "Comma-separated targets ("+strings.Join(AvailableTargets(), ",")+") or 'all'")
 	packageOut     = flag.String("pkg", "", "Output package name (default: same as input)")
 	dispatchPrefix = flag.String("dispatch", "", "Dispatch file prefix (default: derived from function name)")
-	cMode          = flag.Bool("c", false, "Generate C code only (supports neon, sve_darwin, sve_linux, avx2, avx512 targets)")
-	asmMode        = flag.Bool("asm", false, "Generate C code and compile to Go assembly via GOAT (supports neon, sve_darwin, sve_linux, avx2, avx512 targets)")
+	cMode          = flag.Bool("c", false, "Generate C code only (supports neon, sve_darwin, sve_linux, sve2_linux, avx2, avx512, rvv, sme targets)")
+	asmMode        = flag.Bool("asm", false, "Generate C code and compile to Go assembly via GOAT (supports neon, sve_darwin, sve_linux, sve2_linux, avx2, avx512, rvv, sme targets)")
+	asmBackend     = flag.String("asm-backend", AsmBackendGOAT, "Assembly backend for -asm: 'goat' compiles the generated C with GOAT, 'go' writes AVX-512 Go assembly directly from the IR for the kernels it supports (no C compiler needed) and uses GOAT for the rest")
 	fusionMode     = flag.Bool("fusion", false, "Enable IR-based fusion optimization for cross-package function inlining and loop fusion")
+	fusionReport   = flag.String("fusion-report", "", "Write a report of inlined calls, fused loops and estimated memory traffic saved to this file ('-' for stdout); implies -fusion, add -v to include IR dumps")
 	verboseMode    = flag.Bool("v", false, "Verbose output (show fusion statistics, IR dumps, etc.)")
+	testsMode      = flag.Bool("tests", false, "Also emit <prefix>_gen_test.go with tests comparing each dispatched function against its fallback, and benchmarks")
+	pruneMode      = flag.Bool("prune", false, "Skip targets a cost model estimates cannot beat a narrower target of the same architecture for any function (e.g. AVX-512 for memory-bound kernels)")
+	pruneReport    = flag.String("prune-report", "", "Write the cost model's per-function estimates and pruning decisions to this file ('-' for stdout); implies -prune")
+	instrumentMode = flag.Bool("instrument", false, "Also emit z_instrument_<prefix>.gen.go, built with -tags hwy_instrument, counting calls, bytes and vector/tail elements of every dispatched function (see hwy.KernelStatsVar)")
+	goldenDir      = flag.String("golden", "", "Also write the generated C and assembly, in canonical form, to <dir>/<prefix>/<target>/ as goldens for regression checks")
+	goldenVerify   = flag.Bool("golden-verify", false, "With -golden, compare the generated C and assembly against the goldens instead of writing them, and fail on any difference")
+	parallelMode   = flag.Bool("parallel", false, "Emit XxxParallel worker-pool wrappers for every function whose outer loop can be split, not only those marked //hwy:parallel")
 )
 
 func main() {
@@ -62,6 +83,11 @@ func main() {
 		os.Exit(1)
 	}
 
+	if *asmBackend != AsmBackendGOAT && *asmBackend != AsmBackendGo {
+		fmt.Fprintf(os.Stderr, "Error: -asm-backend must be %q or %q\n", AsmBackendGOAT, AsmBackendGo)
+		os.Exit(1)
+	}
+
 	// Parse target list with per-target mode suffixes
 	targetSpecs, err := parseTargets(*targets, *cMode, *asmMode)
 	if err != nil {
@@ -73,6 +99,11 @@ func main() {
 		os.Exit(1)
 	}
 
+	if *goldenVerify && *goldenDir == "" {
+		fmt.Fprintf(os.Stderr, "Error: -golden-verify requires -golden\n")
+		os.Exit(1)
+	}
+
 	// Create and run generator
 	gen := &Generator{
 		InputFile:      *inputFile,
@@ -81,8 +112,21 @@ func main() {
 		TargetSpecs:    targetSpecs,
 		PackageOut:     *packageOut,
 		DispatchPrefix: *dispatchPrefix,
-		FusionMode:     *fusionMode,
+		FusionMode:     *fusionMode || *fusionReport != "",
+		FusionReport:   *fusionReport,
 		Verbose:        *verboseMode,
+		Parallel:       *parallelMode,
+		Tests:          *testsMode,
+		AsmBackend:     *asmBackend,
+		Prune:          *pruneMode || *pruneReport != "",
+		PruneReport:    *pruneReport,
+		Instrument:     *instrumentMode,
+		Golden:         *goldenDir,
+		GoldenVerify:   *goldenVerify,
+	}
+	if gen.Golden != "" && !gen.CMode() {
+		fmt.Fprintf(os.Stderr, "Error: -golden requires -c, -asm or a :c/:asm target\n")
+		os.Exit(1)
 	}
 
 	if err := gen.Run(); err != nil {
@@ -92,7 +136,7 @@ func main() {
 
 	// Build display string
 	var names []string
-	for _, ts := range targetSpecs {
+	for _, ts := range gen.TargetSpecs {
 		name := strings.ToLower(ts.Target.Name)
 		switch ts.Mode {
 		case TargetModeAsm:
@@ -0,0 +1,645 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/token"
+	"path/filepath"
+	"strings"
+)
+
+// Parallel wrappers.
+//
+// A Base function marked //hwy:parallel (or every eligible one, with
+// -parallel) gets an extra XxxParallel wrapper that takes a
+// *workerpool.Pool, splits the function's outer loop over [0, bound) into
+// contiguous ranges and calls the dispatched Xxx on each range:
+//
+//	func BaseScaleRows[T hwy.Floats](in, out []T, s T, rows, cols int) {
+//	    for r := 0; r < rows; r++ {
+//	        ... in[r*cols:] ... out[r*cols+j] ...
+//	    }
+//	}
+//
+// becomes, per range [start, end),
+//
+//	ScaleRows(in[start*cols:], out[start*cols:], s, end-start, cols)
+//
+// This is only sound when every access to a slice parameter is affine in the
+// outer loop index with a fixed stride (the parameter is then rebased by
+// start*stride) or doesn't involve the index at all (the parameter is shared),
+// and the index is used for nothing else. findParallelShard checks this; the
+// author asserts with the directive that iterations are independent.
+
+// defaultParallelElems is the default minimum work per task, in slice
+// elements; the default grain is this divided by the row stride.
+const defaultParallelElems = 16384
+
+// ParallelDirective is a //hwy:parallel [grain] directive on a Base function.
+type ParallelDirective struct {
+	Grain int // Minimum outer-loop iterations per task (0 = derived from defaultParallelElems)
+}
+
+// parseParallelDirective returns the //hwy:parallel directive in a function's
+// doc comment, or nil.
+func parseParallelDirective(doc *ast.CommentGroup) *ParallelDirective {
+	if doc == nil {
+		return nil
+	}
+	for _, c := range doc.List {
+		text := strings.TrimSpace(strings.TrimPrefix(c.Text, "//"))
+		if text == "hwy:parallel" {
+			return &ParallelDirective{}
+		}
+		if after, ok := strings.CutPrefix(text, "hwy:parallel "); ok {
+			grain := 0
+			if _, err := fmt.Sscanf(after, "%d", &grain); err == nil && grain > 0 {
+				return &ParallelDirective{Grain: grain}
+			}
+		}
+	}
+	return nil
+}
+
+// parallelShard describes how to split a function along its outer loop.
+type parallelShard struct {
+	bound    string            // int parameter bounding the outer loop, or "" for len(boundLen)
+	boundLen string            // slice parameter whose length bounds the outer loop
+	strides  map[string]string // rebased slice parameters and their strides ("1" for element-wise)
+	rowWork  string            // elements per outer iteration, for the default grain
+}
+
+// boundExpr returns the outer loop bound as Go source.
+func (s *parallelShard) boundExpr() string {
+	if s.bound != "" {
+		return s.bound
+	}
+	return "len(" + s.boundLen + ")"
+}
+
+// findParallelShard analyzes pf for a parallel wrapper. The outer loop is
+// the first top-level for or range loop; it must start at 0 and be bounded
+// by an int parameter or by len of a slice parameter. Later top-level loops
+// with the same index and bound (e.g. a scalar remainder loop) are part of it.
+func findParallelShard(pf *ParsedFunc) (*parallelShard, error) {
+	if pf.Body == nil {
+		return nil, fmt.Errorf("no body")
+	}
+	if len(pf.Returns) > 0 {
+		return nil, fmt.Errorf("has results")
+	}
+	a := &shardAnalyzer{
+		params:  make(map[string]string, len(pf.Params)),
+		affine:  make(map[string]string),
+		uses:    make(map[string]string),
+		headers: make(map[ast.Stmt]bool),
+		written: make(map[string]bool),
+	}
+	for _, p := range pf.Params {
+		if p.Name == "" || p.Name == "_" {
+			return nil, fmt.Errorf("has unnamed parameters")
+		}
+		a.params[p.Name] = p.Type
+	}
+
+	for _, stmt := range pf.Body.List {
+		iter, bound := a.outerLoop(stmt)
+		if iter == "" {
+			continue
+		}
+		if a.iter == "" {
+			a.iter, a.bound = iter, bound
+		}
+		if iter == a.iter && bound == a.bound {
+			a.headers[stmt] = true
+		}
+	}
+	if a.iter == "" {
+		return nil, fmt.Errorf("no outer loop bounded by an int parameter or len(slice parameter)")
+	}
+
+	for _, stmt := range pf.Body.List {
+		a.stmt(stmt, true)
+	}
+	if a.err != nil {
+		return nil, a.err
+	}
+
+	// Tasks must not write to the same elements.
+	for _, p := range pf.Params {
+		if a.written[p.Name] && a.uses[p.Name] == "0" {
+			return nil, fmt.Errorf("writes to %s, which is not indexed by the outer loop", p.Name)
+		}
+	}
+
+	shard := &parallelShard{strides: make(map[string]string), rowWork: "1"}
+	if after, ok := strings.CutPrefix(a.bound, "len:"); ok {
+		shard.boundLen = after
+		if stride := a.uses[after]; stride != "1" {
+			return nil, fmt.Errorf("loop bound len(%s) but %s is not indexed element-wise", after, after)
+		}
+	} else {
+		shard.bound = a.bound
+	}
+	for _, p := range pf.Params {
+		stride, used := a.uses[p.Name]
+		if !used || stride == "0" {
+			continue
+		}
+		shard.strides[p.Name] = stride
+		if shard.rowWork == "1" {
+			shard.rowWork = stride
+		}
+	}
+	if len(shard.strides) == 0 {
+		return nil, fmt.Errorf("no slice parameter is indexed by the outer loop")
+	}
+	return shard, nil
+}
+
+// shardAnalyzer walks a function body for findParallelShard.
+type shardAnalyzer struct {
+	params  map[string]string // parameter name → type
+	iter    string            // outer loop index
+	bound   string            // int parameter name, or "len:" + slice parameter name
+	headers map[ast.Stmt]bool // top-level loops over [0, bound) with index iter
+	affine  map[string]string // locals affine in iter → coefficient, e.g. off := i*k
+	uses    map[string]string // slice parameter → stride of its accesses ("0" = not indexed by iter)
+	written map[string]bool   // slice parameters assigned to or stored into
+	err     error
+}
+
+func (a *shardAnalyzer) fail(format string, args ...any) {
+	if a.err == nil {
+		a.err = fmt.Errorf(format, args...)
+	}
+}
+
+func (a *shardAnalyzer) isSliceParam(name string) bool {
+	return strings.HasPrefix(a.params[name], "[]")
+}
+
+// outerLoop returns the index and bound of stmt if it is a loop over
+// [0, bound): "for i := 0; i < n; ..." (or with i+k <= n, or without init),
+// "for i := range n" or "for i := range x".
+func (a *shardAnalyzer) outerLoop(stmt ast.Stmt) (iter, bound string) {
+	var boundExpr ast.Expr
+	switch s := stmt.(type) {
+	case *ast.ForStmt:
+		cond, ok := s.Cond.(*ast.BinaryExpr)
+		if !ok || (cond.Op != token.LSS && cond.Op != token.LEQ) {
+			return "", ""
+		}
+		lhs := cond.X
+		if sum, ok := lhs.(*ast.BinaryExpr); ok && sum.Op == token.ADD && cond.Op == token.LEQ {
+			lhs = sum.X
+		} else if cond.Op != token.LSS {
+			return "", ""
+		}
+		ident, ok := lhs.(*ast.Ident)
+		if !ok {
+			return "", ""
+		}
+		if s.Init != nil && !isZeroAssign(s.Init, ident.Name) {
+			return "", ""
+		}
+		iter, boundExpr = ident.Name, cond.Y
+	case *ast.RangeStmt:
+		ident, ok := s.Key.(*ast.Ident)
+		if !ok || s.Value != nil || s.Tok != token.DEFINE {
+			return "", ""
+		}
+		if x, ok := s.X.(*ast.Ident); ok && a.isSliceParam(x.Name) {
+			return ident.Name, "len:" + x.Name
+		}
+		iter, boundExpr = ident.Name, s.X
+	default:
+		return "", ""
+	}
+	switch b := boundExpr.(type) {
+	case *ast.Ident:
+		if typ := a.params[b.Name]; typ == "int" || typ == "int64" {
+			return iter, b.Name
+		}
+	case *ast.CallExpr:
+		if !isIdentNamed(b.Fun, "len") || len(b.Args) != 1 {
+			break
+		}
+		if x, ok := b.Args[0].(*ast.Ident); ok && a.isSliceParam(x.Name) {
+			return iter, "len:" + x.Name
+		}
+	}
+	return "", ""
+}
+
+// isZeroAssign matches "name := 0" and "name = 0".
+func isZeroAssign(stmt ast.Stmt, name string) bool {
+	assign, ok := stmt.(*ast.AssignStmt)
+	if !ok || len(assign.Lhs) != 1 || len(assign.Rhs) != 1 || !isIdentNamed(assign.Lhs[0], name) {
+		return false
+	}
+	lit, ok := assign.Rhs[0].(*ast.BasicLit)
+	return ok && lit.Value == "0"
+}
+
+// stmt checks a statement. topLevel is true for statements of the function
+// body itself.
+func (a *shardAnalyzer) stmt(stmt ast.Stmt, topLevel bool) {
+	if a.err != nil || stmt == nil {
+		return
+	}
+	switch s := stmt.(type) {
+	case *ast.AssignStmt:
+		if topLevel && isZeroAssign(s, a.iter) {
+			return
+		}
+		for _, rhs := range s.Rhs {
+			if len(s.Lhs) != len(s.Rhs) {
+				a.noIndex(rhs)
+			}
+		}
+		for i, lhs := range s.Lhs {
+			var coef string
+			if len(s.Lhs) == len(s.Rhs) {
+				coef = a.coef(s.Rhs[i])
+			}
+			ident, ok := lhs.(*ast.Ident)
+			if !ok {
+				a.write(lhs)
+				a.noIndex(lhs)
+				continue
+			}
+			switch {
+			case ident.Name == a.iter || ident.Name == strings.TrimPrefix(a.bound, "len:") || a.isSliceParam(ident.Name):
+				a.fail("assigns to %s", ident.Name)
+			case s.Tok == token.DEFINE && coef != "" && coef != "0":
+				a.affine[ident.Name] = coef
+			case s.Tok == token.DEFINE:
+				delete(a.affine, ident.Name)
+			case a.affine[ident.Name] != "":
+				// off = i*k keeps the coefficient; off += k leaves it unchanged.
+				want := a.affine[ident.Name]
+				if s.Tok == token.ADD_ASSIGN || s.Tok == token.SUB_ASSIGN {
+					want = "0"
+				}
+				if coef != want {
+					a.fail("%s is not affine in %s", ident.Name, a.iter)
+				}
+			case coef != "" && coef != "0":
+				a.fail("%s depends on %s", ident.Name, a.iter)
+			}
+		}
+	case *ast.ExprStmt:
+		a.noIndex(s.X)
+	case *ast.IncDecStmt:
+		if ident, ok := s.X.(*ast.Ident); ok && a.affine[ident.Name] != "" {
+			return
+		}
+		a.write(s.X)
+		a.noIndex(s.X)
+	case *ast.DeclStmt:
+		a.noIndex(s)
+	case *ast.BlockStmt:
+		for _, inner := range s.List {
+			a.stmt(inner, false)
+		}
+	case *ast.IfStmt:
+		a.stmt(s.Init, false)
+		a.noIndex(s.Cond)
+		a.stmt(s.Body, false)
+		a.stmt(s.Else, false)
+	case *ast.ForStmt:
+		if !a.headers[s] {
+			a.stmt(s.Init, false)
+			if s.Cond != nil {
+				a.noIndex(s.Cond)
+			}
+			a.stmt(s.Post, false)
+		}
+		a.stmt(s.Body, false)
+	case *ast.RangeStmt:
+		if !a.headers[s] {
+			a.noIndex(s.X)
+		}
+		a.stmt(s.Body, false)
+	case *ast.ReturnStmt, *ast.BranchStmt, *ast.EmptyStmt:
+	default:
+		a.noIndex(s)
+	}
+}
+
+// noIndex checks n, which must not depend on the outer loop index except
+// through accesses to slice parameters.
+func (a *shardAnalyzer) noIndex(n ast.Node) {
+	if n == nil {
+		return
+	}
+	if expr, ok := n.(ast.Expr); ok {
+		if a.coef(expr) != "0" {
+			a.fail("%s depends on %s", exprToString(expr), a.iter)
+		}
+		return
+	}
+	ast.Inspect(n, func(m ast.Node) bool {
+		if expr, ok := m.(ast.Expr); ok {
+			a.noIndex(expr)
+			return false
+		}
+		return a.err == nil
+	})
+}
+
+// coef returns the coefficient of the outer loop index in expr ("0" if expr
+// doesn't depend on it), recording slice parameter accesses along the way.
+func (a *shardAnalyzer) coef(expr ast.Expr) string {
+	if a.err != nil || expr == nil {
+		return "0"
+	}
+	switch e := expr.(type) {
+	case *ast.Ident:
+		switch {
+		case e.Name == a.iter:
+			return "1"
+		case a.affine[e.Name] != "":
+			return a.affine[e.Name]
+		case a.isSliceParam(e.Name):
+			a.use(e.Name, "0")
+		}
+		return "0"
+	case *ast.BasicLit:
+		return "0"
+	case *ast.ParenExpr:
+		return a.coef(e.X)
+	case *ast.BinaryExpr:
+		cx, cy := a.coef(e.X), a.coef(e.Y)
+		switch e.Op {
+		case token.ADD:
+			if cx == "0" {
+				return cy
+			}
+			if cy == "0" {
+				return cx
+			}
+		case token.SUB:
+			if cy == "0" {
+				return cx
+			}
+		case token.MUL:
+			if cx == "0" && cy == "0" {
+				return "0"
+			}
+			if cy == "0" {
+				return a.scale(cx, e.Y)
+			}
+			if cx == "0" {
+				return a.scale(cy, e.X)
+			}
+		case token.LSS, token.LEQ, token.GTR, token.GEQ, token.EQL, token.NEQ:
+			// Comparisons of equally rebased values are unaffected.
+			if cx == cy {
+				return "0"
+			}
+		default:
+			if cx == "0" && cy == "0" {
+				return "0"
+			}
+		}
+		a.fail("%s is not affine in %s", exprToString(e), a.iter)
+		return "0"
+	case *ast.IndexExpr:
+		if x, ok := e.X.(*ast.Ident); ok && a.isSliceParam(x.Name) {
+			a.use(x.Name, a.coef(e.Index))
+			return "0"
+		}
+		a.noIndex(e.X)
+		a.noIndex(e.Index)
+		return "0"
+	case *ast.SliceExpr:
+		if x, ok := e.X.(*ast.Ident); ok && a.isSliceParam(x.Name) {
+			low := a.coef(e.Low)
+			if e.High != nil && a.coef(e.High) != low {
+				a.fail("%s is not affine in %s", exprToString(e.High), a.iter)
+			}
+			a.use(x.Name, low)
+			return "0"
+		}
+		a.noIndex(e.X)
+		a.noIndex(e.Low)
+		a.noIndex(e.High)
+		return "0"
+	case *ast.CallExpr:
+		if isIdentNamed(e.Fun, "len") && len(e.Args) == 1 {
+			if x, ok := e.Args[0].(*ast.Ident); ok && a.isSliceParam(x.Name) {
+				return "0"
+			}
+		}
+		if isIdentNamed(e.Fun, "copy") && len(e.Args) == 2 {
+			a.write(e.Args[0])
+		} else if sel := extractSelectorExpr(e.Fun); sel != nil && isStoreOp(sel.Sel.Name) {
+			for _, arg := range e.Args {
+				a.write(arg)
+			}
+		}
+		a.noIndex(e.Fun)
+		for _, arg := range e.Args {
+			a.noIndex(arg)
+		}
+		return "0"
+	case *ast.SelectorExpr:
+		a.noIndex(e.X)
+		return "0"
+	case *ast.UnaryExpr:
+		a.noIndex(e.X)
+		return "0"
+	case *ast.StarExpr:
+		a.noIndex(e.X)
+		return "0"
+	case *ast.FuncLit:
+		a.stmt(e.Body, false)
+		return "0"
+	}
+	// Types, composite literals and the like: only plain uses allowed.
+	ast.Inspect(expr, func(n ast.Node) bool {
+		if ident, ok := n.(*ast.Ident); ok {
+			if ident.Name == a.iter || a.affine[ident.Name] != "" {
+				a.fail("unsupported use of %s", ident.Name)
+			} else if a.isSliceParam(ident.Name) {
+				a.use(ident.Name, "0")
+			}
+		}
+		return a.err == nil
+	})
+	return "0"
+}
+
+// scale returns coef*factor. The factor becomes part of a stride, so it may
+// only refer to parameters other than the loop bound.
+func (a *shardAnalyzer) scale(coef string, factor ast.Expr) string {
+	ast.Inspect(factor, func(n ast.Node) bool {
+		ident, ok := n.(*ast.Ident)
+		if !ok {
+			return true
+		}
+		if typ, isParam := a.params[ident.Name]; !isParam || strings.HasPrefix(typ, "[]") || ident.Name == a.bound {
+			a.fail("stride %s must only use int parameters other than the loop bound", exprToString(factor))
+		}
+		return a.err == nil
+	})
+	f := exprToString(factor)
+	if _, isBinary := factor.(*ast.BinaryExpr); isBinary {
+		f = "(" + f + ")"
+	}
+	if coef == "1" {
+		return f
+	}
+	return coef + " * " + f
+}
+
+// write records a write through expr if it is rooted at a slice parameter:
+// p, p[i] or p[lo:hi].
+func (a *shardAnalyzer) write(expr ast.Expr) {
+	switch e := expr.(type) {
+	case *ast.IndexExpr:
+		expr = e.X
+	case *ast.SliceExpr:
+		expr = e.X
+	}
+	if ident, ok := expr.(*ast.Ident); ok && a.isSliceParam(ident.Name) {
+		a.written[ident.Name] = true
+	}
+}
+
+// isStoreOp reports whether an hwy (or contrib) operation writes to memory.
+func isStoreOp(name string) bool {
+	return strings.Contains(name, "Store") || strings.HasPrefix(name, "Scatter")
+}
+
+// use records an access to slice parameter name with the given stride.
+func (a *shardAnalyzer) use(name, stride string) {
+	if prev, seen := a.uses[name]; seen && prev != stride {
+		a.fail("%s is accessed with strides %s and %s", name, prev, stride)
+		return
+	}
+	a.uses[name] = stride
+}
+
+// EmitParallelWrappers writes XxxParallel wrappers for the functions with a
+// //hwy:parallel directive, or for every eligible function if all is set, to
+// <baseName>_parallel.gen.go. A function with the directive that can't be
+// split is an error; with all, such functions are skipped.
+func EmitParallelWrappers(funcs []ParsedFunc, all bool, pkgName, outPath, baseName string) error {
+	var body bytes.Buffer
+	for _, pf := range filterDispatchableFuncs(funcs) {
+		if pf.Parallel == nil && !all {
+			continue
+		}
+		shard, err := findParallelShard(&pf)
+		if err != nil {
+			if pf.Parallel != nil {
+				return fmt.Errorf("%s: //hwy:parallel: %w", pf.Name, err)
+			}
+			continue
+		}
+		grain := 0
+		if pf.Parallel != nil {
+			grain = pf.Parallel.Grain
+		}
+		emitParallelWrapper(&body, pf, shard, grain)
+	}
+	if body.Len() == 0 {
+		return nil
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, HeaderNote)
+	fmt.Fprintf(&buf, "package %s\n\n", pkgName)
+	fmt.Fprintf(&buf, "import (\n")
+	fmt.Fprintf(&buf, "\t\"github.com/ajroetker/go-highway/hwy\"\n")
+	fmt.Fprintf(&buf, "\t\"github.com/ajroetker/go-highway/hwy/contrib/workerpool\"\n")
+	fmt.Fprintf(&buf, ")\n\n")
+	buf.Write(body.Bytes())
+
+	if err := writeGoFile(filepath.Join(outPath, baseName+"_parallel.gen.go"), buf.Bytes()); err != nil {
+		return fmt.Errorf("write parallel wrappers: %w", err)
+	}
+	return nil
+}
+
+// emitParallelWrapper writes the XxxParallel wrapper of pf.
+func emitParallelWrapper(buf *bytes.Buffer, pf ParsedFunc, shard *parallelShard, grain int) {
+	callee := buildGenericFuncName(pf.Name, hasInterfaceTypeParams(pf.TypeParams), pf.Private)
+	if len(pf.TypeParams) == 0 {
+		callee = buildDispatchFuncName(pf.Name, "float32", false, pf.Private)
+	}
+	name := callee + "Parallel"
+
+	var typeParams, typeArgs, params, args, shardArgs []string
+	for _, tp := range pf.TypeParams {
+		typeParams = append(typeParams, tp.Name+" "+tp.Constraint)
+		typeArgs = append(typeArgs, tp.Name)
+	}
+	for _, p := range pf.Params {
+		params = append(params, p.Name+" "+p.Type)
+		args = append(args, p.Name)
+		stride, rebased := shard.strides[p.Name]
+		switch {
+		case p.Name == shard.bound:
+			shardArgs = append(shardArgs, "end-start")
+		case p.Name == shard.boundLen:
+			shardArgs = append(shardArgs, p.Name+"[start:end]")
+		case rebased && stride == "1":
+			shardArgs = append(shardArgs, p.Name+"[start:]")
+		case rebased:
+			shardArgs = append(shardArgs, fmt.Sprintf("%s[start*%s:]", p.Name, stride))
+		default:
+			shardArgs = append(shardArgs, p.Name)
+		}
+	}
+	call := callee
+	if len(typeArgs) > 0 {
+		call += "[" + strings.Join(typeArgs, ", ") + "]"
+	}
+	bound := shard.boundExpr()
+
+	fmt.Fprintf(buf, "// %s runs %s with its outer loop over [0, %s) split into\n", name, callee, bound)
+	fmt.Fprintf(buf, "// contiguous ranges across the workers of pool. It calls %s directly\n", callee)
+	fmt.Fprintf(buf, "// when pool is nil or there is too little work for two tasks.\n")
+	fmt.Fprintf(buf, "func %s", name)
+	if len(typeParams) > 0 {
+		fmt.Fprintf(buf, "[%s]", strings.Join(typeParams, ", "))
+	}
+	fmt.Fprintf(buf, "(pool *workerpool.Pool, %s) {\n", strings.Join(params, ", "))
+	fmt.Fprintf(buf, "\ttotal := %s\n", bound)
+	switch {
+	case grain > 0:
+		fmt.Fprintf(buf, "\tgrain := %d\n", grain)
+	case shard.rowWork == "1":
+		fmt.Fprintf(buf, "\tgrain := %d\n", defaultParallelElems)
+	default:
+		fmt.Fprintf(buf, "\tgrain := max(1, %d/max(1, %s))\n", defaultParallelElems, shard.rowWork)
+	}
+	fmt.Fprintf(buf, "\tif pool == nil || total < 2*grain {\n")
+	fmt.Fprintf(buf, "\t\t%s(%s)\n", call, strings.Join(args, ", "))
+	fmt.Fprintf(buf, "\t\treturn\n")
+	fmt.Fprintf(buf, "\t}\n")
+	fmt.Fprintf(buf, "\tpool.ParallelFor((total+grain-1)/grain, func(lo, hi int) {\n")
+	fmt.Fprintf(buf, "\t\tstart, end := lo*grain, min(hi*grain, total)\n")
+	fmt.Fprintf(buf, "\t\t%s(%s)\n", call, strings.Join(shardArgs, ", "))
+	fmt.Fprintf(buf, "\t})\n")
+	fmt.Fprintf(buf, "}\n\n")
+}
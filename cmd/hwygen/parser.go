@@ -17,6 +17,7 @@ package main
 import (
 	"fmt"
 	"go/ast"
+	"go/build"
 	"go/parser"
 	"go/token"
 	"os"
@@ -28,15 +29,18 @@ import (
 
 // ParsedFunc represents a function that has been parsed from the input file.
 type ParsedFunc struct {
-	Name       string            // Function name
-	TypeParams []TypeParam       // Generic type parameters
-	Params     []Param           // Function parameters
-	Returns    []Param           // Return values
-	Body       *ast.BlockStmt    // Function body
-	HwyCalls   []HwyCall         // Detected hwy.* and contrib.* calls
-	LoopInfo   *LoopInfo         // Main processing loop info
-	Doc        *ast.CommentGroup // Function documentation
-	Private    bool              // true if base function uses lowercase "base" prefix (generates unexported dispatch)
+	Name       string             // Function name
+	TypeParams []TypeParam        // Generic type parameters
+	Params     []Param            // Function parameters
+	Returns    []Param            // Return values
+	Body       *ast.BlockStmt     // Function body
+	HwyCalls   []HwyCall          // Detected hwy.* and contrib.* calls
+	LoopInfo   *LoopInfo          // Main processing loop info
+	LoopUnroll map[ast.Stmt]int   // //hwy:unroll factors by loop statement (0 or 1 disables unrolling)
+	Doc        *ast.CommentGroup  // Function documentation
+	Parallel   *ParallelDirective // //hwy:parallel directive from the doc comment (nil if absent)
+	Features   []string           // CPU feature predicates named by //hwy:if blocks in the body
+	Private    bool               // true if base function uses lowercase "base" prefix (generates unexported dispatch)
 }
 
 // TypeParam represents a generic type parameter.
@@ -87,12 +91,13 @@ type TypeSpecificConst struct {
 
 // ParsedCondition represents a parsed conditional expression.
 // Supports type conditions (f32, f64), target conditions (avx2, avx512, neon, fallback),
-// and compound conditions with && (AND) and || (OR).
+// CPU feature conditions (see featureConditions), and compound conditions with && (AND) and || (OR).
 type ParsedCondition struct {
 	// For simple conditions (leaf nodes)
 	IsType     bool   // true if this is a type condition (f32, f64)
 	IsTarget   bool   // true if this is a target condition (avx2, avx512, neon, fallback)
 	IsCategory bool   // true if this is a category condition (float, int, uint)
+	IsFeature  bool   // true if this is a CPU feature condition (vnni, sme, ...)
 	Value      string // the condition value (e.g., "f64", "avx2", "float")
 	Negated    bool   // true if condition is negated (e.g., "!avx2")
 
@@ -109,6 +114,7 @@ type ConditionalBlock struct {
 	StartLine       int              // Line number of //hwy:if
 	ElseLine        int              // Line number of //hwy:else (0 if no else)
 	EndLine         int              // Line number of //hwy:endif
+	File            string           // Source file of the directives (package mode parses several files)
 }
 
 // PackageGlobal represents a package-level variable with a fixed-size array type
@@ -120,10 +126,11 @@ type PackageGlobal struct {
 	Values   []string // raw Go literal values, e.g., ["-1.0", "0.0", "0.07958..."]
 }
 
-// ParseResult contains all parsed information from a source file.
+// ParseResult contains all parsed information from a source file, or from all
+// files of a package in package mode (see ParsePackage).
 type ParseResult struct {
 	Funcs              []ParsedFunc
-	AllFuncs           map[string]*ParsedFunc        // ALL functions in file, keyed by name (for inlining)
+	AllFuncs           map[string]*ParsedFunc        // ALL functions in file(s), keyed by name (for inlining)
 	PackageName        string
 	TypeSpecificConsts map[string]*TypeSpecificConst // map[base_name]variants
 	ConditionalBlocks  []ConditionalBlock
@@ -140,13 +147,105 @@ func Parse(filename string) (*ParseResult, error) {
 		return nil, fmt.Errorf("parse file: %w", err)
 	}
 
-	result := &ParseResult{
-		PackageName:        file.Name.Name,
+	result := newParseResult(file.Name.Name, fset)
+	if err := result.addFile(file); err != nil {
+		return nil, err
+	}
+
+	// Scan sibling .go files for package-level array variables (e.g., nf4LookupTable)
+	result.PackageGlobals = scanPackageGlobals(filepath.Dir(filename))
+
+	return result, nil
+}
+
+// ParsePackage parses every portable Go source file in dir as one unit, so
+// Base* functions can call helpers and use type-specific constants defined
+// in other files of the package. Test files, generated .gen.go files and
+// files restricted by build constraints or GOOS/GOARCH file name suffixes
+// (hand-written target code) are skipped. Base* functions from all files are
+// generated, in file name order.
+func ParsePackage(dir string) (*ParseResult, error) {
+	names, err := packageSourceFiles(dir)
+	if err != nil {
+		return nil, err
+	}
+	if len(names) == 0 {
+		return nil, fmt.Errorf("no Go source files in %s", dir)
+	}
+
+	fset := token.NewFileSet()
+	var result *ParseResult
+	for _, name := range names {
+		file, err := parser.ParseFile(fset, filepath.Join(dir, name), nil, parser.ParseComments)
+		if err != nil {
+			return nil, fmt.Errorf("parse file: %w", err)
+		}
+		if result == nil {
+			result = newParseResult(file.Name.Name, fset)
+		} else if file.Name.Name != result.PackageName {
+			return nil, fmt.Errorf("%s: package %s, expected %s", name, file.Name.Name, result.PackageName)
+		}
+		if err := result.addFile(file); err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+	}
+
+	result.PackageGlobals = scanPackageGlobals(dir)
+	return result, nil
+}
+
+// packageSourceFiles returns the names of the Go files in dir that ParsePackage
+// considers, sorted. A file is included when it builds on every platform:
+// it must match both a linux/amd64 and a darwin/arm64 build context.
+func packageSourceFiles(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("read package dir: %w", err)
+	}
+	amd64Ctx, arm64Ctx := build.Default, build.Default
+	amd64Ctx.GOOS, amd64Ctx.GOARCH = "linux", "amd64"
+	arm64Ctx.GOOS, arm64Ctx.GOARCH = "darwin", "arm64"
+	amd64Ctx.BuildTags, arm64Ctx.BuildTags = nil, nil
+	amd64Ctx.ToolTags, arm64Ctx.ToolTags = nil, nil
+
+	var names []string
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".go") ||
+			strings.HasSuffix(name, "_test.go") || strings.HasSuffix(name, ".gen.go") {
+			continue
+		}
+		okAMD64, err := amd64Ctx.MatchFile(dir, name)
+		if err != nil {
+			return nil, fmt.Errorf("match %s: %w", name, err)
+		}
+		okARM64, err := arm64Ctx.MatchFile(dir, name)
+		if err != nil {
+			return nil, fmt.Errorf("match %s: %w", name, err)
+		}
+		if okAMD64 && okARM64 {
+			names = append(names, name)
+		}
+	}
+	return names, nil
+}
+
+// newParseResult returns an empty ParseResult whose positions refer to fset.
+func newParseResult(pkgName string, fset *token.FileSet) *ParseResult {
+	return &ParseResult{
+		PackageName:        pkgName,
 		AllFuncs:           make(map[string]*ParsedFunc),
 		TypeSpecificConsts: make(map[string]*TypeSpecificConst),
 		FileSet:            fset,
 		Imports:            make(map[string]string),
 	}
+}
+
+// addFile extracts the imports, type-specific constants, directives and
+// functions of file into r. It fails if file redefines a function already
+// added or imports a different package under the same local name.
+func (r *ParseResult) addFile(file *ast.File) error {
+	fset := r.FileSet
 
 	// Extract imports to map local names to import paths
 	for _, imp := range file.Imports {
@@ -164,9 +263,13 @@ func Parse(filename string) (*ParseResult, error) {
 		}
 
 		// Skip blank imports (import _ "pkg")
-		if localName != "_" {
-			result.Imports[localName] = importPath
+		if localName == "_" {
+			continue
+		}
+		if prev, ok := r.Imports[localName]; ok && prev != importPath {
+			return fmt.Errorf("import %s refers to both %q and %q", localName, prev, importPath)
 		}
+		r.Imports[localName] = importPath
 	}
 
 	// First pass: collect type-specific constants from var declarations
@@ -183,16 +286,18 @@ func Parse(filename string) (*ParseResult, error) {
 			}
 
 			for _, name := range valueSpec.Names {
-				parseTypeSpecificConst(name.Name, result.TypeSpecificConsts)
+				parseTypeSpecificConst(name.Name, r.TypeSpecificConsts)
 			}
 		}
 	}
 
 	// Parse conditional directives from comments
-	result.ConditionalBlocks = parseConditionalDirectives(file, fset)
+	conditionalBlocks := parseConditionalDirectives(file, fset)
+	r.ConditionalBlocks = append(r.ConditionalBlocks, conditionalBlocks...)
 
-	// Parse unroll directives from comments
+	// Parse unroll and prefetch directives from comments
 	unrollDirectives := parseUnrollDirectives(file, fset)
+	prefetchDirectives := parsePrefetchDirectives(file, fset)
 
 	for _, decl := range file.Decls {
 		funcDecl, ok := decl.(*ast.FuncDecl)
@@ -210,10 +315,13 @@ func Parse(filename string) (*ParseResult, error) {
 		isPrivateBase := !isExportedBase && strings.HasPrefix(name, "base")
 
 		pf := ParsedFunc{
-			Name:    name,
-			Body:    funcDecl.Body,
-			Doc:     funcDecl.Doc,
-			Private: isPrivateBase,
+			Name:     name,
+			Body:     funcDecl.Body,
+			Doc:      funcDecl.Doc,
+			Private:  isPrivateBase,
+			Parallel: parseParallelDirective(funcDecl.Doc),
+			Features: blockFeatures(conditionalBlocks, fset.Position(funcDecl.Pos()).Filename,
+				fset.Position(funcDecl.Pos()).Line, fset.Position(funcDecl.End()).Line),
 		}
 
 		// Extract type parameters
@@ -258,6 +366,9 @@ func Parse(filename string) (*ParseResult, error) {
 			}
 		}
 
+		// Attach //hwy:unroll and //hwy:prefetch directives to their loops
+		applyLoopDirectives(&pf, fset, unrollDirectives, prefetchDirectives, r.Imports)
+
 		// Find hwy.* and contrib.* calls
 		pf.HwyCalls = findHwyCalls(funcDecl.Body)
 
@@ -265,24 +376,27 @@ func Parse(filename string) (*ParseResult, error) {
 		pf.LoopInfo = detectLoopWithUnroll(funcDecl.Body, fset, unrollDirectives)
 
 		// Store ALL functions in AllFuncs for potential inlining
+		if _, dup := r.AllFuncs[name]; dup && name != "init" {
+			return fmt.Errorf("function %s is defined more than once", name)
+		}
 		pfCopy := pf // Make a copy since pf is reused
-		result.AllFuncs[name] = &pfCopy
+		r.AllFuncs[name] = &pfCopy
 
 		// Only add Base*/base* functions to Funcs for code generation
 		// Include functions that use hwy operations OR have hwy.Lanes type parameters
 		// (generic functions with hwy.Lanes need type specialization even without hwy ops)
 		if isExportedBase || isPrivateBase {
+			if err := checkPairedTypeParams(pf.TypeParams); err != nil {
+				return fmt.Errorf("%s: %w", name, err)
+			}
 			hasHwyLanesTypeParam := hasHwyLanesConstraint(pf.TypeParams)
 			if len(pf.HwyCalls) > 0 || hasHwyLanesTypeParam {
-				result.Funcs = append(result.Funcs, pf)
+				r.Funcs = append(r.Funcs, pf)
 			}
 		}
 	}
 
-	// Scan sibling .go files for package-level array variables (e.g., nf4LookupTable)
-	result.PackageGlobals = scanPackageGlobals(filename)
-
-	return result, nil
+	return nil
 }
 
 // hasBasePrefix returns true if the name starts with "Base" or "base".
@@ -451,6 +565,125 @@ func parseUnrollDirectives(file *ast.File, fset *token.FileSet) []UnrollDirectiv
 	return directives
 }
 
+// PrefetchDirective represents a parsed //hwy:prefetch directive.
+type PrefetchDirective struct {
+	Line     int // Line number of the directive
+	Distance int // Prefetch distance in elements
+}
+
+// parsePrefetchDirectives parses //hwy:prefetch N comments from the file.
+func parsePrefetchDirectives(file *ast.File, fset *token.FileSet) []PrefetchDirective {
+	var directives []PrefetchDirective
+
+	for _, cg := range file.Comments {
+		for _, c := range cg.List {
+			text := strings.TrimSpace(strings.TrimPrefix(c.Text, "//"))
+			line := fset.Position(c.Pos()).Line
+
+			if after, ok := strings.CutPrefix(text, "hwy:prefetch "); ok {
+				distance := 0
+				if _, err := fmt.Sscanf(after, "%d", &distance); err == nil && distance > 0 {
+					directives = append(directives, PrefetchDirective{
+						Line:     line,
+						Distance: distance,
+					})
+				}
+			}
+		}
+	}
+
+	return directives
+}
+
+// isLoopDirectiveLine reports whether a directive on directiveLine applies to
+// a loop starting on loopLine: it must be on one of the two lines before the
+// loop, so //hwy:unroll and //hwy:prefetch can be stacked.
+func isLoopDirectiveLine(directiveLine, loopLine int) bool {
+	return directiveLine == loopLine-1 || directiveLine == loopLine-2
+}
+
+// applyLoopDirectives attaches //hwy:unroll and //hwy:prefetch directives to
+// the loops of pf at any nesting depth. Unroll factors are recorded in
+// pf.LoopUnroll for the C emitter (the Go SIMD transformer reads the main
+// loop's factor from LoopInfo). A prefetch directive inserts
+//
+//	hwy.Prefetch(src[off:], distance)
+//
+// at the top of the loop body for each slice src the loop reads with
+// hwy.Load*(src[off:]), so both the Go SIMD and C paths emit it.
+func applyLoopDirectives(pf *ParsedFunc, fset *token.FileSet, unroll []UnrollDirective, prefetch []PrefetchDirective, imports map[string]string) {
+	if pf.Body == nil || (len(unroll) == 0 && len(prefetch) == 0) {
+		return
+	}
+	ast.Inspect(pf.Body, func(n ast.Node) bool {
+		var loop ast.Stmt
+		var body *ast.BlockStmt
+		switch s := n.(type) {
+		case *ast.ForStmt:
+			loop, body = s, s.Body
+		case *ast.RangeStmt:
+			loop, body = s, s.Body
+		default:
+			return true
+		}
+		loopLine := fset.Position(loop.Pos()).Line
+		for _, ud := range unroll {
+			if isLoopDirectiveLine(ud.Line, loopLine) {
+				if pf.LoopUnroll == nil {
+					pf.LoopUnroll = make(map[ast.Stmt]int)
+				}
+				pf.LoopUnroll[loop] = ud.Factor
+			}
+		}
+		for _, pd := range prefetch {
+			if isLoopDirectiveLine(pd.Line, loopLine) {
+				body.List = append(loopPrefetches(body, pd.Distance, imports), body.List...)
+			}
+		}
+		return true
+	})
+}
+
+// loopPrefetches returns hwy.Prefetch statements for the slices loaded in
+// body, in order of first load, each prefetching distance elements past the
+// offset of that first load.
+func loopPrefetches(body *ast.BlockStmt, distance int, imports map[string]string) []ast.Stmt {
+	var stmts []ast.Stmt
+	seen := make(map[string]bool)
+	ast.Inspect(body, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok || len(call.Args) == 0 {
+			return true
+		}
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok || !strings.HasPrefix(sel.Sel.Name, "Load") {
+			return true
+		}
+		pkg, ok := sel.X.(*ast.Ident)
+		if !ok || !strings.HasSuffix(imports[pkg.Name], "/hwy") {
+			return true
+		}
+		slice, ok := call.Args[0].(*ast.SliceExpr)
+		if !ok || slice.High != nil {
+			return true
+		}
+		src := exprToString(slice.X)
+		if seen[src] {
+			return true
+		}
+		seen[src] = true
+		stmts = append(stmts, &ast.ExprStmt{X: &ast.CallExpr{
+			Fun: &ast.SelectorExpr{X: ast.NewIdent(pkg.Name), Sel: ast.NewIdent("Prefetch")},
+			Args: []ast.Expr{
+				&ast.SliceExpr{X: cloneExpr(slice.X), Low: cloneExpr(slice.Low)},
+				&ast.BasicLit{Kind: token.INT, Value: strconv.Itoa(distance)},
+			},
+		}})
+		return true
+	})
+	return stmts
+}
+
 // detectLoopWithUnroll attempts to find the main vectorized loop pattern
 // and also checks for //hwy:unroll directives.
 // Looks for: for ii := 0; ii < size; ii += stride
@@ -532,8 +765,9 @@ func detectLoopWithUnroll(body *ast.BlockStmt, fset *token.FileSet, unrollDirect
 				loopLine := fset.Position(forStmt.Pos()).Line
 				for _, ud := range unrollDirectives {
 					// Directive should be on the line immediately before the loop
-					if ud.Line == loopLine-1 || ud.Line == loopLine-2 {
-						info.UnrollHint = ud.Factor
+					if isLoopDirectiveLine(ud.Line, loopLine) {
+						// //hwy:unroll 0 disables unrolling like //hwy:unroll 1
+						info.UnrollHint = max(ud.Factor, 1)
 						break
 					}
 				}
@@ -733,6 +967,17 @@ func GetConcreteTypes(constraint string) []string {
 		addTypes([]string{"int32", "int64", "uint32", "uint64"})
 	}
 
+	// Explicit lane types in a union, e.g. "uint8 | ~uint16 | int16"
+	for term := range strings.SplitSeq(constraint, "|") {
+		term = strings.TrimPrefix(strings.TrimSpace(term), "~")
+		if term == "byte" {
+			term = "uint8"
+		}
+		if laneTypes[term] {
+			addTypes([]string{term})
+		}
+	}
+
 	// If no types were added, default to common types
 	if len(types) == 0 {
 		return []string{"float32", "float64"}
@@ -741,6 +986,86 @@ func GetConcreteTypes(constraint string) []string {
 	return types
 }
 
+// laneTypes are the Go element types that can appear directly in a type
+// parameter's union constraint.
+var laneTypes = map[string]bool{
+	"float32": true, "float64": true,
+	"int8": true, "int16": true, "int32": true, "int64": true,
+	"uint8": true, "uint16": true, "uint32": true, "uint64": true,
+}
+
+// isElemTypeConstraint reports whether constraint restricts a type parameter
+// to SIMD lane types (hwy.Lanes, hwy.Floats, ..., or a union of lane types
+// such as "uint8 | uint16") rather than being an interface like Predicate[T].
+func isElemTypeConstraint(constraint string) bool {
+	if strings.Contains(constraint, "Lanes") ||
+		strings.Contains(constraint, "Floats") ||
+		strings.Contains(constraint, "Integers") ||
+		strings.Contains(constraint, "SignedInts") ||
+		strings.Contains(constraint, "UnsignedInts") {
+		return true
+	}
+	for term := range strings.SplitSeq(constraint, "|") {
+		term = strings.TrimPrefix(strings.TrimSpace(term), "~")
+		if !laneTypes[term] && term != "byte" {
+			return false
+		}
+	}
+	return true
+}
+
+// typeParamBindings maps each element type parameter to its concrete type
+// for the variant of a function generated for elemType. The first type
+// parameter takes elemType; further element type parameters are paired with
+// it: one listing a single type always binds to it, one listing as many
+// types as the first binds to the type at the same position. For example
+// [T int8 | int16, U int32 | int64] yields (int8, int32) and (int16, int64).
+func typeParamBindings(typeParams []TypeParam, elemType string) map[string]string {
+	bindings := make(map[string]string)
+	if len(typeParams) == 0 {
+		return bindings
+	}
+	index := slices.Index(GetConcreteTypes(typeParams[0].Constraint), elemType)
+	for i, tp := range typeParams {
+		if !isElemTypeConstraint(tp.Constraint) {
+			continue
+		}
+		if i == 0 {
+			bindings[tp.Name] = elemType
+			continue
+		}
+		types := GetConcreteTypes(tp.Constraint)
+		switch {
+		case len(types) == 1:
+			bindings[tp.Name] = types[0]
+		case index >= 0 && index < len(types):
+			bindings[tp.Name] = types[index]
+		default:
+			bindings[tp.Name] = elemType
+		}
+	}
+	return bindings
+}
+
+// checkPairedTypeParams reports an error if an element type parameter after
+// the first cannot be paired with it (see typeParamBindings).
+func checkPairedTypeParams(typeParams []TypeParam) error {
+	if len(typeParams) < 2 || !isElemTypeConstraint(typeParams[0].Constraint) {
+		return nil
+	}
+	n := len(GetConcreteTypes(typeParams[0].Constraint))
+	for _, tp := range typeParams[1:] {
+		if !isElemTypeConstraint(tp.Constraint) {
+			continue
+		}
+		if m := len(GetConcreteTypes(tp.Constraint)); m != 1 && m != n {
+			return fmt.Errorf("type parameter %s has %d types, want 1 or %d to pair with %s",
+				tp.Name, m, n, typeParams[0].Name)
+		}
+	}
+	return nil
+}
+
 // typeSuffixes are recognized type suffixes for type-specific constants.
 var typeSuffixes = []string{"_f16", "_bf16", "_f32", "_f64", "_i32", "_i64", "_u32", "_u64"}
 
@@ -772,7 +1097,8 @@ func parseConditionalDirectives(file *ast.File, fset *token.FileSet) []Condition
 	for _, cg := range file.Comments {
 		for _, c := range cg.List {
 			text := strings.TrimSpace(strings.TrimPrefix(c.Text, "//"))
-			line := fset.Position(c.Pos()).Line
+			pos := fset.Position(c.Pos())
+			line := pos.Line
 
 			if after, ok := strings.CutPrefix(text, "hwy:if "); ok {
 				condition := strings.TrimSpace(after)
@@ -780,6 +1106,7 @@ func parseConditionalDirectives(file *ast.File, fset *token.FileSet) []Condition
 					Condition:       condition,
 					ParsedCondition: parseCondition(condition),
 					StartLine:       line,
+					File:            pos.Filename,
 				}
 				stack = append(stack, block)
 			} else if text == "hwy:else" {
@@ -886,6 +1213,8 @@ func parseCondition(cond string) *ParsedCondition {
 		pc.IsTarget = true
 	} else if categoryConditions[cond] {
 		pc.IsCategory = true
+	} else if featureConditions[cond] != nil {
+		pc.IsFeature = true
 	}
 
 	return pc
@@ -922,17 +1251,26 @@ func splitOnOperator(cond, op string) []string {
 // Evaluate evaluates a ParsedCondition against a target and element type.
 // targetName is like "AVX2", "AVX512", "NEON", "Fallback"
 // elemType is like "float32", "float64"
+// Feature conditions hold only if every CPU the target dispatches to has the
+// feature.
 func (pc *ParsedCondition) Evaluate(targetName, elemType string) bool {
+	return pc.EvaluateFeatures(targetName, elemType, nil)
+}
+
+// EvaluateFeatures is like Evaluate, but also treats the runtime-detected
+// features as present, for the variant of a function the dispatcher selects
+// when they are.
+func (pc *ParsedCondition) EvaluateFeatures(targetName, elemType string, features []string) bool {
 	if pc == nil {
 		return true // No condition = always true
 	}
 
 	// Handle compound conditions
 	if pc.Op == "&&" {
-		return pc.Left.Evaluate(targetName, elemType) && pc.Right.Evaluate(targetName, elemType)
+		return pc.Left.EvaluateFeatures(targetName, elemType, features) && pc.Right.EvaluateFeatures(targetName, elemType, features)
 	}
 	if pc.Op == "||" {
-		return pc.Left.Evaluate(targetName, elemType) || pc.Right.Evaluate(targetName, elemType)
+		return pc.Left.EvaluateFeatures(targetName, elemType, features) || pc.Right.EvaluateFeatures(targetName, elemType, features)
 	}
 
 	// Handle simple conditions
@@ -953,6 +1291,8 @@ func (pc *ParsedCondition) Evaluate(targetName, elemType string) bool {
 				result = true
 			}
 		}
+	} else if pc.IsFeature {
+		result = featureAlways(pc.Value, targetName) || slices.Contains(features, pc.Value)
 	} else {
 		// Unknown condition type - try both
 		typeSuffix := GetTypeSuffix(elemType)
@@ -1000,11 +1340,10 @@ func GetTypeSuffix(elemType string) string {
 	}
 }
 
-// scanPackageGlobals scans all .go files in the same directory as filename
-// for package-level var declarations with fixed-size array types and constant
-// initializers. These are emitted as static const arrays in generated C code.
-func scanPackageGlobals(filename string) []PackageGlobal {
-	dir := filepath.Dir(filename)
+// scanPackageGlobals scans all .go files in dir for package-level var
+// declarations with fixed-size array types and constant initializers. These
+// are emitted as static const arrays in generated C code.
+func scanPackageGlobals(dir string) []PackageGlobal {
 	entries, err := os.ReadDir(dir)
 	if err != nil {
 		return nil
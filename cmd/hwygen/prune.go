@@ -0,0 +1,241 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"os"
+	"slices"
+	"strings"
+)
+
+// Cost model constants for -prune. They are rough per-core figures, meant to
+// rank the targets of one architecture against each other rather than to
+// predict absolute speed.
+const (
+	// pruneBytesPerCycle is the sustained load/store bandwidth a kernel
+	// streaming through L2 gets, whatever its vector width.
+	pruneBytesPerCycle = 32.0
+
+	// pruneMinSpeedup is how much faster than the next narrower target a
+	// wider target must be, for at least one function, to be generated.
+	pruneMinSpeedup = 1.05
+)
+
+// pruneFrequency returns the clock of a target relative to the narrower
+// targets of its architecture. Many Intel parts run AVX-512 code at a lower
+// frequency license, which kernels with little arithmetic do not win back.
+func pruneFrequency(target Target) float64 {
+	if target.Name == "AVX512" {
+		return 0.85
+	}
+	return 1
+}
+
+// opMix counts the vector operations in the loops of a function.
+type opMix struct {
+	memory  int     // loads and stores, each moving one element per lane
+	ops     int     // other operations
+	compute float64 // weighted cost of ops, in vector instructions
+}
+
+// funcOpMix counts the hwy and contrib calls inside the loops of pf, or in
+// its whole body if it has no loops. Broadcasts and lane counts are free,
+// division and square roots count 4 instructions, and contrib math functions
+// (Exp, Tanh, ...) 20.
+func funcOpMix(pf *ParsedFunc, imports map[string]string) opMix {
+	var mix opMix
+	if pf.Body == nil {
+		return mix
+	}
+	count := func(node ast.Node) {
+		ast.Inspect(node, func(n ast.Node) bool {
+			call, ok := n.(*ast.CallExpr)
+			if !ok {
+				return true
+			}
+			fun := call.Fun
+			if idx, ok := fun.(*ast.IndexExpr); ok {
+				fun = idx.X
+			}
+			sel, ok := fun.(*ast.SelectorExpr)
+			if !ok {
+				return true
+			}
+			pkg, ok := sel.X.(*ast.Ident)
+			if !ok {
+				return true
+			}
+			name := sel.Sel.Name
+			switch {
+			case strings.Contains(imports[pkg.Name], "/hwy/contrib/"):
+				mix.ops++
+				mix.compute += 20
+			case pkg.Name != "hwy":
+			case name == "Load4" || name == "Store4":
+				mix.memory += 4
+			case strings.HasPrefix(name, "Load") || strings.HasPrefix(name, "Store") ||
+				strings.HasPrefix(name, "Gather") || strings.HasPrefix(name, "Scatter") ||
+				name == "MaskLoad" || name == "MaskStore" || name == "BlendedStore" || name == "CompressStore":
+				mix.memory++
+			case name == "Set" || name == "Const" || name == "Zero" || name == "NumLanes" || name == "MaxLanes":
+			case name == "Div" || name == "Sqrt":
+				mix.ops++
+				mix.compute += 4
+			default:
+				mix.ops++
+				mix.compute++
+			}
+			return true
+		})
+	}
+
+	var loops []ast.Node
+	ast.Inspect(pf.Body, func(n ast.Node) bool {
+		switch n.(type) {
+		case *ast.ForStmt, *ast.RangeStmt:
+			loops = append(loops, n)
+			return false
+		}
+		return true
+	})
+	if len(loops) == 0 {
+		loops = append(loops, pf.Body)
+	}
+	for _, loop := range loops {
+		count(loop)
+	}
+	return mix
+}
+
+// pruneElemType returns the element type the cost model uses for pf: the
+// element type of its first concrete slice parameter, or float32 for
+// generic functions.
+func pruneElemType(pf *ParsedFunc) string {
+	for _, p := range pf.Params {
+		if elem, ok := strings.CutPrefix(p.Type, "[]"); ok && elem != "T" {
+			return elem
+		}
+	}
+	return "float32"
+}
+
+// cyclesPerElem estimates the cycles per element of a function with the
+// given op mix on target: the larger of its arithmetic, spread over the
+// target's lanes, and its memory traffic, then scaled by the target's clock.
+func cyclesPerElem(mix opMix, target Target, elemType string) (cycles float64, memoryBound bool) {
+	size := elemTypeSize(elemType)
+	if size == 0 {
+		size = 2 // hwy.Float16, hwy.BFloat16
+	}
+	compute := mix.compute / float64(target.LanesFor(elemType))
+	memory := float64(mix.memory*size) / pruneBytesPerCycle
+	return max(compute, memory) / pruneFrequency(target), memory >= compute
+}
+
+// pruneTargets removes from g.TargetSpecs the targets the cost model says
+// cannot win: for each architecture, targets are ordered by vector width,
+// and a wider target is kept only if it beats the widest target kept so far
+// by pruneMinSpeedup for at least one function. Memory-bound kernels gain
+// nothing from wider vectors, so on AVX-512 they only lose clock speed. The
+// fallback target and the asm-only targets (SVE and RVV, whose vector length
+// is only known at runtime, and SME) are never pruned. The reasoning is
+// printed to g.PruneReport ("-" for stdout), if set.
+func (g *Generator) pruneTargets(result *ParseResult) error {
+	byArch := make(map[string][]Target)
+	var arches []string
+	for _, ts := range g.TargetSpecs {
+		t := ts.Target
+		if t.Name == "Fallback" || isAsmOnlyTarget(t) {
+			continue
+		}
+		if _, ok := byArch[t.Arch()]; !ok {
+			arches = append(arches, t.Arch())
+		}
+		if !slices.ContainsFunc(byArch[t.Arch()], func(o Target) bool { return o.Name == t.Name }) {
+			byArch[t.Arch()] = append(byArch[t.Arch()], t)
+		}
+	}
+
+	var report strings.Builder
+	pruned := make(map[string]bool)
+	for _, arch := range arches {
+		targets := byArch[arch]
+		slices.SortStableFunc(targets, func(a, b Target) int { return a.VecWidth - b.VecWidth })
+		if len(targets) < 2 {
+			continue
+		}
+		kept := targets[0]
+		for _, t := range targets[1:] {
+			if t.VecWidth == kept.VecWidth {
+				continue
+			}
+			fmt.Fprintf(&report, "%s vs %s (%s):\n", t.Name, kept.Name, arch)
+			best, counted := 0.0, 0
+			for i := range result.Funcs {
+				pf := &result.Funcs[i]
+				mix := funcOpMix(pf, result.Imports)
+				if mix.memory+mix.ops == 0 {
+					continue
+				}
+				elemType := pruneElemType(pf)
+				base, _ := cyclesPerElem(mix, kept, elemType)
+				wide, memoryBound := cyclesPerElem(mix, t, elemType)
+				speedup := base / wide
+				best = max(best, speedup)
+				counted++
+				bound := "compute-bound"
+				if memoryBound {
+					bound = "memory-bound"
+				}
+				fmt.Fprintf(&report, "  %s (%s): %d loads/stores, %d ops, %s: %.2f vs %.2f cycles/element, %.2fx\n",
+					pf.Name, elemType, mix.memory, mix.ops, bound, wide, base, speedup)
+			}
+			switch {
+			case counted == 0:
+				fmt.Fprintf(&report, "  kept %s: no vector operations to compare\n", t.Name)
+			case best < pruneMinSpeedup:
+				pruned[t.Name] = true
+				fmt.Fprintf(&report, "  pruned %s: best speedup %.2fx is below %.2fx\n", t.Name, best, pruneMinSpeedup)
+				fmt.Printf("Pruned target %s: no function is estimated to beat %s\n", t.Name, kept.Name)
+				continue
+			default:
+				fmt.Fprintf(&report, "  kept %s: best speedup %.2fx\n", t.Name, best)
+			}
+			kept = t
+		}
+	}
+
+	if len(pruned) > 0 {
+		g.TargetSpecs = slices.DeleteFunc(g.TargetSpecs, func(ts TargetSpec) bool { return pruned[ts.Target.Name] })
+	}
+
+	if g.PruneReport == "" {
+		return nil
+	}
+	if report.Len() == 0 {
+		report.WriteString("no targets to compare\n")
+	}
+	if g.PruneReport == "-" {
+		fmt.Printf("\nPruning report:\n%s", report.String())
+		return nil
+	}
+	if err := os.WriteFile(g.PruneReport, []byte(report.String()), 0o644); err != nil {
+		return fmt.Errorf("write pruning report: %w", err)
+	}
+	fmt.Printf("Wrote pruning report: %s\n", g.PruneReport)
+	return nil
+}
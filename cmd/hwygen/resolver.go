@@ -42,6 +42,10 @@ type FunctionRegistry struct {
 	// resolvedFuncs caches resolved functions by qualified name.
 	resolvedFuncs map[string]*ResolvedFunc
 
+	// imports maps the calling file's package names to import paths, so
+	// calls into any contrib package it imports can be resolved.
+	imports map[string]string
+
 	// fset is shared across all parsed files.
 	fset *token.FileSet
 }
@@ -79,10 +83,17 @@ func NewFunctionRegistry(moduleRoot, moduleName string) *FunctionRegistry {
 		moduleName:    moduleName,
 		parsedPkgs:    make(map[string]*ParseResult),
 		resolvedFuncs: make(map[string]*ResolvedFunc),
+		imports:       make(map[string]string),
 		fset:          token.NewFileSet(),
 	}
 }
 
+// AddImports makes the package names imported by the file being generated
+// (e.g. "activation", "quantization") resolvable.
+func (r *FunctionRegistry) AddImports(imports map[string]string) {
+	maps.Copy(r.imports, imports)
+}
+
 // Resolve looks up a function by its qualified name (e.g., "math.BaseExpVec")
 // and returns the resolved function with the given type arguments.
 func (r *FunctionRegistry) Resolve(qualifiedName string, typeArgs []string) (*ir.IRFunction, error) {
@@ -190,6 +201,10 @@ func (r *FunctionRegistry) resolveImportPath(alias string) (string, error) {
 		return "math", nil
 	}
 
+	if path, ok := r.imports[alias]; ok {
+		return path, nil
+	}
+
 	// Try to find in parsed imports
 	for _, pkg := range r.parsedPkgs {
 		if path, ok := pkg.Imports[alias]; ok {
@@ -17,7 +17,10 @@ package main
 import (
 	"go/ast"
 	"go/token"
+	"strconv"
 	"strings"
+
+	"golang.org/x/tools/go/ast/astutil"
 )
 
 // scalarizableHwyOps is the set of hwy operations that can be scalarized.
@@ -28,6 +31,7 @@ var scalarizableHwyOps = map[string]bool{
 	"LoadSlice": true,
 	"Store":     true,
 	"StoreSlice": true,
+	"StoreStream": true,
 
 	// Initialization
 	"Zero":  true,
@@ -59,6 +63,8 @@ var scalarizableHwyOps = map[string]bool{
 	// Interleave operations (identity for scalar, but with lanes=1 loops never execute)
 	"InterleaveLower": true,
 	"InterleaveUpper": true,
+	"ZipLower":        true,
+	"ZipUpper":        true,
 
 	// Float16/BFloat16 conversion functions (pass through unchanged in scalar code)
 	"Float32ToFloat16":  true,
@@ -105,6 +111,10 @@ var nonScalarizableHwyOps = map[string]bool{
 	"ConcatLo":     true,
 	"ConcatHi":     true,
 	"OddEven":      true,
+	"RotateLanes":  true,
+	"TableLookupLanes": true,
+	"ConcatEven":   true,
+	"ConcatOdd":    true,
 
 	// Shift operations
 	"ShiftLeft":     true,
@@ -426,6 +436,16 @@ func canScalarizeFallback(funcDecl *ast.FuncDecl) bool {
 			}
 		}
 
+		// Same-package Base*Vec helpers (already renamed to their target
+		// variant, e.g. BaseFooVec_fallback) take and return vectors, so a
+		// loop calling them cannot be turned into scalar code.
+		if ident, ok := call.Fun.(*ast.Ident); ok {
+			if strings.HasPrefix(ident.Name, "Base") && strings.Contains(ident.Name, "Vec_") {
+				canScalarize = false
+				return false
+			}
+		}
+
 		// Check for method calls like v.NumLanes()
 		if sel, ok := call.Fun.(*ast.SelectorExpr); ok {
 			methodName := sel.Sel.Name
@@ -451,7 +471,20 @@ func scalarizeFallback(funcDecl *ast.FuncDecl, elemType string) {
 		return
 	}
 
+	// Loops stepping by a lane count become unit-stride loops; only those
+	// are worth unrolling, the author's scalar tail loops run a few times.
+	vectorLoops := make(map[*ast.ForStmt]bool)
+	ast.Inspect(funcDecl.Body, func(n ast.Node) bool {
+		if loop, ok := n.(*ast.ForStmt); ok {
+			if _, scalar := loop.Post.(*ast.IncDecStmt); !scalar && loop.Post != nil {
+				vectorLoops[loop] = true
+			}
+		}
+		return true
+	})
+
 	funcDecl.Body.List = scalarizeStmts(funcDecl.Body.List, elemType)
+	unrollScalarLoops(funcDecl, vectorLoops)
 }
 
 // scalarizeStmts transforms a list of statements to use scalar operations.
@@ -946,12 +979,12 @@ func scalarizeHwyCall(opName string, args []ast.Expr, elemType string) ast.Expr
 		}
 	case "NumLanes", "MaxLanes":
 		return &ast.BasicLit{Kind: token.INT, Value: "1"}
-	case "InterleaveLower":
+	case "InterleaveLower", "ZipLower":
 		// With lanes=1, interleave lower is identity (take first element)
 		if len(args) >= 1 {
 			return args[0]
 		}
-	case "InterleaveUpper":
+	case "InterleaveUpper", "ZipUpper":
 		// With lanes=1, interleave upper takes from second arg
 		if len(args) >= 2 {
 			return args[1]
@@ -1030,11 +1063,13 @@ func scalarizeStoreCall(call *ast.CallExpr, elemType string) []ast.Stmt {
 	}
 }
 
-// isHwyStoreCall checks if a call is hwy.Store or hwy.StoreSlice.
+// isHwyStoreCall checks if a call is hwy.Store, hwy.StoreSlice or
+// hwy.StoreStream. Streaming is only a cache hint, so scalarized code
+// uses a plain store.
 func isHwyStoreCall(call *ast.CallExpr) bool {
 	if sel, ok := call.Fun.(*ast.SelectorExpr); ok {
 		if pkg, ok := sel.X.(*ast.Ident); ok && pkg.Name == "hwy" {
-			return sel.Sel.Name == "Store" || sel.Sel.Name == "StoreSlice"
+			return sel.Sel.Name == "Store" || sel.Sel.Name == "StoreSlice" || sel.Sel.Name == "StoreStream"
 		}
 	}
 	return false
@@ -1147,3 +1182,334 @@ func makeTypedZero(elemType string) ast.Expr {
 		}
 	}
 }
+
+// scalarUnroll is the number of iterations of a scalarized loop the fallback
+// runs per trip of its unrolled copy (see unrollScalarLoop).
+const scalarUnroll = 4
+
+// unrollScalarLoops unrolls the scalarized vector loops of a fallback
+// function that unrollScalarLoop accepts, at any depth.
+func unrollScalarLoops(funcDecl *ast.FuncDecl, vectorLoops map[*ast.ForStmt]bool) {
+	sliceParams := make(map[string]bool)
+	for _, field := range funcDecl.Type.Params.List {
+		if arr, ok := field.Type.(*ast.ArrayType); ok && arr.Len == nil {
+			for _, name := range field.Names {
+				sliceParams[name.Name] = true
+			}
+		}
+	}
+	names := make(map[string]bool)
+	ast.Inspect(funcDecl, func(n ast.Node) bool {
+		if id, ok := n.(*ast.Ident); ok {
+			names[id.Name] = true
+		}
+		return true
+	})
+
+	var rewrite func(list []ast.Stmt) []ast.Stmt
+	rewrite = func(list []ast.Stmt) []ast.Stmt {
+		var out []ast.Stmt
+		for _, stmt := range list {
+			switch s := stmt.(type) {
+			case *ast.ForStmt:
+				if !vectorLoops[s] {
+					s.Body.List = rewrite(s.Body.List)
+					break
+				}
+				if unrolled := unrollScalarLoop(s, sliceParams, names); unrolled != nil {
+					out = append(out, unrolled...)
+					continue
+				}
+				s.Body.List = rewrite(s.Body.List)
+			case *ast.RangeStmt:
+				s.Body.List = rewrite(s.Body.List)
+			case *ast.BlockStmt:
+				s.List = rewrite(s.List)
+			case *ast.IfStmt:
+				s.Body.List = rewrite(s.Body.List)
+				if e, ok := s.Else.(*ast.BlockStmt); ok {
+					e.List = rewrite(e.List)
+				}
+			}
+			out = append(out, stmt)
+		}
+		return out
+	}
+	funcDecl.Body.List = rewrite(funcDecl.Body.List)
+}
+
+// unrollScalarLoop rewrites a unit-stride loop with a straight-line body,
+//
+//	for i := 0; i < n; i++ {
+//		dst[i] = a[i] + b[i]
+//	}
+//
+// into a loop running scalarUnroll iterations per trip followed by the
+// original loop for the remainder:
+//
+//	{
+//		i := 0
+//		for ; i+4 <= n; i += 4 {
+//			dst4 := dst[i : i+4 : i+4]
+//			a4 := a[i : i+4 : i+4]
+//			b4 := b[i : i+4 : i+4]
+//			dst4[0] = a4[0] + b4[0]
+//			...
+//			dst4[3] = a4[3] + b4[3]
+//		}
+//		for ; i < n; i++ {
+//			dst[i] = a[i] + b[i]
+//		}
+//	}
+//
+// Slice parameters only ever indexed by the loop variable are resliced once
+// per trip, so the compiler checks one bound per slice instead of one per
+// element, and the constant indexes need none. Other uses of i become i+k in
+// the k-th copy. It returns nil, leaving the loop alone, if the loop is not
+// of that shape: the body must be assignments, declarations and calls only,
+// without closures, must not assign the loop variable or anything the bound
+// reads, and must not carry a value from one iteration to the next.
+func unrollScalarLoop(loop *ast.ForStmt, sliceParams, names map[string]bool) []ast.Stmt {
+	cond, ok := loop.Cond.(*ast.BinaryExpr)
+	if !ok || cond.Op != token.LSS {
+		return nil
+	}
+	iter, ok := cond.X.(*ast.Ident)
+	if !ok {
+		return nil
+	}
+	post, ok := loop.Post.(*ast.IncDecStmt)
+	if !ok || post.Tok != token.INC {
+		return nil
+	}
+	if id, ok := post.X.(*ast.Ident); !ok || id.Name != iter.Name {
+		return nil
+	}
+	if loop.Body == nil || len(loop.Body.List) == 0 {
+		return nil
+	}
+
+	// The bound must be cheap and side-effect free: names, constants,
+	// arithmetic, len and min.
+	boundVars := make(map[string]bool)
+	simpleBound := true
+	ast.Inspect(cond.Y, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.Ident:
+			boundVars[n.Name] = true
+		case *ast.CallExpr:
+			if fn, ok := n.Fun.(*ast.Ident); !ok || (fn.Name != "len" && fn.Name != "min") {
+				simpleBound = false
+			}
+		case *ast.BinaryExpr, *ast.ParenExpr, *ast.BasicLit, nil:
+		default:
+			simpleBound = false
+		}
+		return simpleBound
+	})
+	if !simpleBound {
+		return nil
+	}
+
+	// The body must be straight-line code that leaves i and the bound alone.
+	// Variables declared outside it that it both reads and writes, such as
+	// scalar accumulators, form a dependency chain through the iterations
+	// that unrolling does not shorten, so such loops are left alone too.
+	assigned := make(map[string]bool)
+	outerWrites := make(map[string]int)
+	declares := false
+	shortCircuit := false
+	carried := false
+	for _, stmt := range loop.Body.List {
+		switch s := stmt.(type) {
+		case *ast.AssignStmt:
+			for _, lhs := range s.Lhs {
+				if id, ok := lhs.(*ast.Ident); ok {
+					if s.Tok != token.DEFINE && !assigned[id.Name] {
+						outerWrites[id.Name]++
+						carried = carried || s.Tok != token.ASSIGN
+					}
+					assigned[id.Name] = true
+				}
+			}
+			if s.Tok == token.DEFINE {
+				declares = true
+			}
+		case *ast.IncDecStmt:
+			if id, ok := s.X.(*ast.Ident); ok {
+				carried = carried || !assigned[id.Name]
+				assigned[id.Name] = true
+			}
+		case *ast.DeclStmt:
+			declares = true
+			ast.Inspect(s, func(n ast.Node) bool {
+				if vs, ok := n.(*ast.ValueSpec); ok {
+					for _, name := range vs.Names {
+						assigned[name.Name] = true
+					}
+				}
+				return true
+			})
+		case *ast.ExprStmt:
+		default:
+			return nil
+		}
+		simple := true
+		ast.Inspect(stmt, func(n ast.Node) bool {
+			switch n := n.(type) {
+			case *ast.FuncLit:
+				simple = false
+			case *ast.UnaryExpr:
+				if id, ok := n.X.(*ast.Ident); ok && n.Op == token.AND {
+					assigned[id.Name] = true // may be written through the pointer
+				}
+			case *ast.BinaryExpr:
+				if n.Op == token.LAND || n.Op == token.LOR {
+					shortCircuit = true
+				}
+			}
+			return simple
+		})
+		if !simple {
+			return nil
+		}
+	}
+	if assigned[iter.Name] {
+		return nil
+	}
+	for v := range boundVars {
+		if assigned[v] {
+			return nil
+		}
+	}
+
+	// Reslice the slice parameters the body only uses as s[i]. Every copy
+	// of the body reads or writes all of them, so the reslice cannot fail
+	// where the original loop would not; with && or || some accesses may
+	// be skipped, so then nothing is resliced.
+	uses := make(map[string]int)
+	iterUses := make(map[string]int)
+	var order []string
+	ast.Inspect(loop.Body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.Ident:
+			uses[n.Name]++
+		case *ast.IndexExpr:
+			x, ok1 := n.X.(*ast.Ident)
+			idx, ok2 := n.Index.(*ast.Ident)
+			if ok1 && ok2 && idx.Name == iter.Name && sliceParams[x.Name] {
+				if iterUses[x.Name] == 0 {
+					order = append(order, x.Name)
+				}
+				iterUses[x.Name]++
+			}
+		}
+		return true
+	})
+	for name, writes := range outerWrites {
+		if uses[name] > writes {
+			carried = true
+		}
+	}
+	if carried {
+		return nil
+	}
+
+	resliced := make(map[string]string)
+	var prologue []ast.Stmt
+	for _, name := range order {
+		if shortCircuit || assigned[name] || uses[name] != iterUses[name] {
+			continue
+		}
+		blk := name + strconv.Itoa(scalarUnroll)
+		for names[blk] {
+			blk += "_"
+		}
+		names[blk] = true
+		resliced[name] = blk
+		end := &ast.BinaryExpr{X: ast.NewIdent(iter.Name), Op: token.ADD, Y: intLit(scalarUnroll)}
+		prologue = append(prologue, &ast.AssignStmt{
+			Lhs: []ast.Expr{ast.NewIdent(blk)},
+			Tok: token.DEFINE,
+			Rhs: []ast.Expr{&ast.SliceExpr{
+				X:      ast.NewIdent(name),
+				Low:    ast.NewIdent(iter.Name),
+				High:   end,
+				Max:    cloneExpr(end),
+				Slice3: true,
+			}},
+		})
+	}
+
+	unrolledBody := &ast.BlockStmt{List: prologue}
+	for k := range scalarUnroll {
+		var copyStmts []ast.Stmt
+		for _, stmt := range loop.Body.List {
+			copyStmts = append(copyStmts, offsetLoopVar(cloneStmt(stmt), iter.Name, k, resliced))
+		}
+		if declares {
+			unrolledBody.List = append(unrolledBody.List, &ast.BlockStmt{List: copyStmts})
+		} else {
+			unrolledBody.List = append(unrolledBody.List, copyStmts...)
+		}
+	}
+
+	unrolled := &ast.ForStmt{
+		Cond: &ast.BinaryExpr{
+			X:  &ast.BinaryExpr{X: ast.NewIdent(iter.Name), Op: token.ADD, Y: intLit(scalarUnroll)},
+			Op: token.LEQ,
+			Y:  cloneExpr(cond.Y),
+		},
+		Post: &ast.AssignStmt{
+			Lhs: []ast.Expr{ast.NewIdent(iter.Name)},
+			Tok: token.ADD_ASSIGN,
+			Rhs: []ast.Expr{intLit(scalarUnroll)},
+		},
+		Body: unrolledBody,
+	}
+	init := loop.Init
+	loop.Init = nil
+	if as, ok := init.(*ast.AssignStmt); ok && as.Tok == token.DEFINE {
+		// i := 0 moves out of the loops, into a block that keeps it scoped.
+		return []ast.Stmt{&ast.BlockStmt{List: []ast.Stmt{init, unrolled, loop}}}
+	}
+	unrolled.Init = init
+	return []ast.Stmt{unrolled, loop}
+}
+
+// offsetLoopVar rewrites stmt for the k-th copy of an unrolled loop body:
+// s[iter] becomes resliced[s][k] and other uses of iter become iter+k.
+func offsetLoopVar(stmt ast.Stmt, iter string, k int, resliced map[string]string) ast.Stmt {
+	return astutil.Apply(stmt, func(c *astutil.Cursor) bool {
+		switch n := c.Node().(type) {
+		case *ast.IndexExpr:
+			x, ok1 := n.X.(*ast.Ident)
+			idx, ok2 := n.Index.(*ast.Ident)
+			if ok1 && ok2 && idx.Name == iter {
+				if blk, ok := resliced[x.Name]; ok {
+					c.Replace(&ast.IndexExpr{X: ast.NewIdent(blk), Index: intLit(k)})
+					return false
+				}
+			}
+		case *ast.Ident:
+			if n.Name != iter || k == 0 {
+				return true
+			}
+			if sel, ok := c.Parent().(*ast.SelectorExpr); ok && sel.Sel == n {
+				return true
+			}
+			var offset ast.Expr = &ast.BinaryExpr{X: ast.NewIdent(iter), Op: token.ADD, Y: intLit(k)}
+			switch c.Parent().(type) {
+			case *ast.BinaryExpr, *ast.UnaryExpr, *ast.StarExpr, *ast.SelectorExpr:
+				offset = &ast.ParenExpr{X: offset}
+			}
+			c.Replace(offset)
+		}
+		return true
+	}, nil).(ast.Stmt)
+}
+
+// intLit returns an integer literal.
+func intLit(v int) *ast.BasicLit {
+	return &ast.BasicLit{Kind: token.INT, Value: strconv.Itoa(v)}
+}
@@ -0,0 +1,251 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"go/ast"
+	"go/token"
+	"slices"
+	"strings"
+)
+
+// Automatic tail synthesis.
+//
+// A Base function whose body ends with its main SIMD loop, written as
+//
+//	for i := 0; i+lanes <= n; i += lanes {
+//	    v := hwy.Load(in[i:])
+//	    hwy.Store(hwy.Mul(v, v), out[i:])
+//	}
+//
+// and nothing after it, leaves the last n%lanes elements unprocessed. When the
+// loop is element-wise (it only loads and stores slice parameters at the loop
+// index and defines loop-local values), hwygen appends the remainder itself:
+//
+//   - Go SIMD targets call the fallback implementation on the remaining
+//     sub-slices (insertTailHandling).
+//   - The fallback target, when not scalarized, runs the body once more with
+//     hwy.TailMask and masked loads/stores (maskedTailStmt).
+//   - C targets with predicates (SVE) run the body once more under a whilelt
+//     predicate; fixed-width C targets run it on zero-padded stack copies of
+//     the remaining elements (CASTTranslator.emitSynthesizedTail).
+
+// tailLoop describes a main SIMD loop that needs a synthesized tail.
+type tailLoop struct {
+	loop   *ast.ForStmt
+	iter   string   // loop index, e.g. "i"
+	end    ast.Expr // loop bound, e.g. n or len(in)
+	endArg string   // int parameter used as the bound ("" if the bound is len(slice))
+	slices []string // slice parameters accessed at the loop index, in order of first use
+	stored map[string]bool
+}
+
+// findTailLoop returns the main SIMD loop of pf if hwygen should synthesize
+// its tail, or nil if the function has none or handles the remainder itself.
+func findTailLoop(pf *ParsedFunc) *tailLoop {
+	if pf.Body == nil || pf.LoopInfo == nil || len(pf.Returns) > 0 || len(pf.Body.List) == 0 {
+		return nil
+	}
+	loop, ok := pf.Body.List[len(pf.Body.List)-1].(*ast.ForStmt)
+	if !ok || !matchesLoopIterator(loop, pf.LoopInfo.Iterator) {
+		return nil
+	}
+	iter := pf.LoopInfo.Iterator
+
+	// Condition: iter+step <= end; post: iter += step.
+	cond, ok := loop.Cond.(*ast.BinaryExpr)
+	if !ok || cond.Op != token.LEQ {
+		return nil
+	}
+	if sum, ok := cond.X.(*ast.BinaryExpr); !ok || sum.Op != token.ADD || !isIdentNamed(sum.X, iter) {
+		return nil
+	}
+	if post, ok := loop.Post.(*ast.AssignStmt); !ok || post.Tok != token.ADD_ASSIGN || !isIdentNamed(post.Lhs[0], iter) {
+		return nil
+	}
+
+	paramTypes := make(map[string]string, len(pf.Params))
+	for _, p := range pf.Params {
+		paramTypes[p.Name] = p.Type
+	}
+	tl := &tailLoop{loop: loop, iter: iter, end: cond.Y, stored: make(map[string]bool)}
+	switch end := cond.Y.(type) {
+	case *ast.Ident:
+		if typ := paramTypes[end.Name]; typ != "int" && typ != "int64" {
+			return nil
+		}
+		tl.endArg = end.Name
+	case *ast.CallExpr:
+		if !isIdentNamed(end.Fun, "len") || len(end.Args) != 1 {
+			return nil
+		}
+		arg, ok := end.Args[0].(*ast.Ident)
+		if !ok || !strings.HasPrefix(paramTypes[arg.Name], "[]") {
+			return nil
+		}
+	default:
+		return nil
+	}
+
+	// The body must be element-wise: loop-local definitions and stores only,
+	// with every use of the index inside a Load/Store of a parameter slice.
+	for _, stmt := range loop.Body.List {
+		switch s := stmt.(type) {
+		case *ast.AssignStmt:
+			if s.Tok != token.DEFINE {
+				return nil
+			}
+		case *ast.ExprStmt:
+			if _, isCall := s.X.(*ast.CallExpr); !isCall {
+				return nil
+			}
+		default:
+			return nil
+		}
+	}
+	elementWise := true
+	ast.Inspect(loop.Body, func(n ast.Node) bool {
+		if !elementWise {
+			return false
+		}
+		switch node := n.(type) {
+		case *ast.CallExpr:
+			name, isHwy := hwyCallName(node)
+			if !isHwy || (name != "Load" && name != "Store") {
+				return true
+			}
+			sliceArg := node.Args[len(node.Args)-1]
+			param, ok := loopIndexSlice(sliceArg, iter)
+			if !ok || !strings.HasPrefix(paramTypes[param], "[]") {
+				elementWise = false
+				return false
+			}
+			if !slices.Contains(tl.slices, param) {
+				tl.slices = append(tl.slices, param)
+			}
+			if name == "Store" {
+				tl.stored[param] = true
+			}
+			// Inspect the other arguments, but not the slice expression.
+			for _, arg := range node.Args[:len(node.Args)-1] {
+				ast.Inspect(arg, func(m ast.Node) bool {
+					if isIdentNamed(m, iter) {
+						elementWise = false
+					}
+					return elementWise
+				})
+			}
+			return false
+		case *ast.Ident:
+			if node.Name == iter || strings.HasPrefix(paramTypes[node.Name], "[]") {
+				elementWise = false
+			}
+		}
+		return elementWise
+	})
+	if !elementWise || len(tl.stored) == 0 {
+		return nil
+	}
+	return tl
+}
+
+// hwyCallName returns the operation name of an hwy.Op(...) call.
+func hwyCallName(call *ast.CallExpr) (string, bool) {
+	sel := extractSelectorExpr(call.Fun)
+	if sel == nil || !isIdentNamed(sel.X, "hwy") {
+		return "", false
+	}
+	return sel.Sel.Name, len(call.Args) > 0
+}
+
+// loopIndexSlice matches param[iter:] and returns param.
+func loopIndexSlice(expr ast.Expr, iter string) (string, bool) {
+	s, ok := expr.(*ast.SliceExpr)
+	if !ok || s.High != nil || !isIdentNamed(s.Low, iter) {
+		return "", false
+	}
+	ident, ok := s.X.(*ast.Ident)
+	if !ok {
+		return "", false
+	}
+	return ident.Name, true
+}
+
+// isIdentNamed reports whether n is the identifier name.
+func isIdentNamed(n ast.Node, name string) bool {
+	ident, ok := n.(*ast.Ident)
+	return ok && ident.Name == name
+}
+
+// fallbackTailArgs returns the arguments for calling the fallback
+// implementation on the remainder: slices become param[iter:end] and the
+// int bound parameter becomes end-iter.
+func (tl *tailLoop) fallbackTailArgs(params []Param) []ast.Expr {
+	var args []ast.Expr
+	for _, p := range params {
+		switch {
+		case strings.HasPrefix(p.Type, "[]"):
+			args = append(args, &ast.SliceExpr{
+				X:    ast.NewIdent(p.Name),
+				Low:  ast.NewIdent(tl.iter),
+				High: cloneExpr(tl.end),
+			})
+		case p.Name == tl.endArg:
+			args = append(args, &ast.BinaryExpr{X: ast.NewIdent(p.Name), Op: token.SUB, Y: ast.NewIdent(tl.iter)})
+		default:
+			args = append(args, ast.NewIdent(p.Name))
+		}
+	}
+	return args
+}
+
+// maskedTailStmt returns the tail for hwy.Vec code:
+//
+//	if iter < end {
+//	    tailMask := hwy.TailMask[elemType](end - iter)
+//	    <loop body with hwy.Load/hwy.Store replaced by hwy.MaskLoad/hwy.MaskStore>
+//	}
+func (tl *tailLoop) maskedTailStmt(elemType ast.Expr) *ast.IfStmt {
+	body := cloneBlockStmt(tl.loop.Body)
+	ast.Inspect(body, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		name, isHwy := hwyCallName(call)
+		if !isHwy || (name != "Load" && name != "Store") {
+			return true
+		}
+		sel := extractSelectorExpr(call.Fun)
+		call.Fun = &ast.SelectorExpr{X: ast.NewIdent("hwy"), Sel: ast.NewIdent("Mask" + sel.Sel.Name)}
+		call.Args = append([]ast.Expr{ast.NewIdent("tailMask")}, call.Args...)
+		return true
+	})
+	mask := &ast.AssignStmt{
+		Lhs: []ast.Expr{ast.NewIdent("tailMask")},
+		Tok: token.DEFINE,
+		Rhs: []ast.Expr{&ast.CallExpr{
+			Fun: &ast.IndexExpr{
+				X:     &ast.SelectorExpr{X: ast.NewIdent("hwy"), Sel: ast.NewIdent("TailMask")},
+				Index: elemType,
+			},
+			Args: []ast.Expr{&ast.BinaryExpr{X: cloneExpr(tl.end), Op: token.SUB, Y: ast.NewIdent(tl.iter)}},
+		}},
+	}
+	return &ast.IfStmt{
+		Cond: &ast.BinaryExpr{X: ast.NewIdent(tl.iter), Op: token.LSS, Y: cloneExpr(tl.end)},
+		Body: &ast.BlockStmt{List: append([]ast.Stmt{mask}, body.List...)},
+	}
+}
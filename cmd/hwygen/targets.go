@@ -83,6 +83,10 @@ func AVX2Target() Target {
 			"int64":        "Int64x4",
 			"uint32":       "Uint32x8",
 			"uint64":       "Uint64x4",
+			"int8":         "Int8x32",
+			"int16":        "Int16x16",
+			"uint8":        "Uint8x32",
+			"uint16":       "Uint16x16",
 			"hwy.Float16":  "Float16x8AVX2",
 			"hwy.BFloat16": "BFloat16x8AVX2",
 		},
@@ -93,6 +97,9 @@ func AVX2Target() Target {
 			"Load4":      {Package: "hwy", Name: "Load4", IsMethod: false},     // hwy.Load4_AVX2_Float32 - 4 separate loads
 			"Store":      {Name: "Store", IsMethod: true},                      // v.Store (pointer based, fast)
 			"StoreSlice": {Name: "StoreSlice", IsMethod: true},                 // v.StoreSlice (slice based, safe)
+			"StoreStream": {Package: "hwy", Name: "StoreStream", IsMethod: false}, // hwy.StoreStream_<target> (non-temporal)
+			"LoadStrided":  {Package: "hwy", Name: "LoadStrided", IsMethod: false},  // hwy.LoadStrided_<target> (column walk)
+			"StoreStrided": {Package: "hwy", Name: "StoreStrided", IsMethod: false}, // hwy.StoreStrided_<target>
 			"Set":       {Name: "Broadcast", IsMethod: false}, // archsimd.BroadcastFloat32x8
 			"Const":     {Name: "Broadcast", IsMethod: false}, // archsimd.BroadcastFloat32x8 (same as Set)
 			"Zero":      {Package: "special", Name: "Zero", IsMethod: false}, // Use Broadcast(0)
@@ -124,8 +131,8 @@ func AVX2Target() Target {
 			// ===== Core math operations (hardware instructions) =====
 			"Sqrt":              {Name: "Sqrt", IsMethod: true},            // VSQRTPS/VSQRTPD
 			"RSqrt":             {Name: "ReciprocalSqrt", IsMethod: true},  // VRSQRTPS/VRSQRTPD (~12-bit precision)
-			"RSqrtNewtonRaphson": {Package: "hwy", Name: "RSqrtNewtonRaphson_AVX2", IsMethod: false}, // N-R refined
-			"RSqrtPrecise":      {Package: "hwy", Name: "RSqrtPrecise_AVX2", IsMethod: false},        // sqrt + div
+			"RSqrtNewtonRaphson": {Package: "hwy", Name: "RSqrtNewtonRaphson", IsMethod: false}, // N-R refined
+			"RSqrtPrecise":      {Package: "hwy", Name: "RSqrtPrecise", IsMethod: false},        // sqrt + div
 			"FMA":               {Name: "MulAdd", IsMethod: true}, // archsimd uses MulAdd for FMA
 			"MulAdd": {Name: "MulAdd", IsMethod: true}, // a.MulAdd(b, c) = a*b + c
 
@@ -193,29 +200,59 @@ func AVX2Target() Target {
 			"Mask": {Package: "special", Name: "Mask", IsMethod: false}, // Type, not function
 
 			// ===== Permutation/Shuffle =====
-			"Reverse":            {Name: "Reverse", IsMethod: true},
-			"Reverse2":           {Name: "Reverse2", IsMethod: false},
-			"Reverse4":           {Name: "Reverse4", IsMethod: false},
+			"Reverse":            {Package: "hwy", Name: "Reverse", IsMethod: false},
+			"Reverse2":           {Package: "hwy", Name: "Reverse2", IsMethod: false},
+			"Reverse4":           {Package: "hwy", Name: "Reverse4", IsMethod: false},
 			"Reverse8":           {Name: "Reverse8", IsMethod: false},
 			"Broadcast":          {Name: "Broadcast", IsMethod: true},
 			"GetLane":            {Package: "hwy", Name: "GetLane", IsMethod: false},
 			"InsertLane":         {Name: "InsertLane", IsMethod: false},
 			"InterleaveLower":    {Package: "hwy", Name: "InterleaveLower", IsMethod: false},
 			"InterleaveUpper":    {Package: "hwy", Name: "InterleaveUpper", IsMethod: false},
-			"ConcatLowerLower":   {Name: "ConcatLowerLower", IsMethod: false},
+			"ConcatLowerLower":   {Package: "hwy", Name: "ConcatLowerLower", IsMethod: false},
 			"ConcatUpperUpper":   {Name: "ConcatUpperUpper", IsMethod: false},
 			"ConcatLowerUpper":   {Name: "ConcatLowerUpper", IsMethod: false},
 			"ConcatUpperLower":   {Name: "ConcatUpperLower", IsMethod: false},
-			"OddEven":            {Name: "OddEven", IsMethod: false},
-			"DupEven":            {Name: "DupEven", IsMethod: false},
-			"DupOdd":             {Name: "DupOdd", IsMethod: false},
-			"SwapAdjacentBlocks": {Name: "SwapAdjacentBlocks", IsMethod: false},
+			"OddEven":            {Package: "hwy", Name: "OddEven", IsMethod: false},
+			"DupEven":            {Package: "hwy", Name: "DupEven", IsMethod: false},
+			"DupOdd":             {Package: "hwy", Name: "DupOdd", IsMethod: false},
+			"SwapAdjacentBlocks": {Package: "hwy", Name: "SwapAdjacentBlocks", IsMethod: false},
 			"SlideUpLanes":       {Package: "hwy", Name: "SlideUpLanes", IsMethod: false},
 			"SlideDownLanes":     {Package: "hwy", Name: "SlideDownLanes", IsMethod: false},
+			"RotateLanes":        {Package: "hwy", Name: "RotateLanes", IsMethod: false},
+			"TableLookupLanes":   {Package: "hwy", Name: "TableLookupLanes", IsMethod: false},
+			"ZipLower":           {Package: "hwy", Name: "ZipLower", IsMethod: false},
+			"ZipUpper":           {Package: "hwy", Name: "ZipUpper", IsMethod: false},
+			"ConcatEven":         {Package: "hwy", Name: "ConcatEven", IsMethod: false},
+			"ConcatOdd":          {Package: "hwy", Name: "ConcatOdd", IsMethod: false},
 
 			// ===== Type Conversions =====
 			"ConvertToInt32":   {Name: "ConvertToInt32", IsMethod: true},
 			"ConvertToFloat32": {Name: "ConvertToFloat32", IsMethod: true},
+
+			// 8/16-bit widening: hwy wrappers named after the narrow source vector,
+			// e.g. hwy.PromoteLowerU8ToU16_AVX2_Uint8x32 (see promoteSourceType).
+			"PromoteLowerU8ToU16":  {Package: "hwy", Name: "PromoteLowerU8ToU16", IsMethod: false},
+			"PromoteUpperU8ToU16":  {Package: "hwy", Name: "PromoteUpperU8ToU16", IsMethod: false},
+			"PromoteLowerI8ToI16":  {Package: "hwy", Name: "PromoteLowerI8ToI16", IsMethod: false},
+			"PromoteUpperI8ToI16":  {Package: "hwy", Name: "PromoteUpperI8ToI16", IsMethod: false},
+			"PromoteLowerU16ToU32": {Package: "hwy", Name: "PromoteLowerU16ToU32", IsMethod: false},
+			"PromoteUpperU16ToU32": {Package: "hwy", Name: "PromoteUpperU16ToU32", IsMethod: false},
+			"PromoteLowerI16ToI32": {Package: "hwy", Name: "PromoteLowerI16ToI32", IsMethod: false},
+			"PromoteUpperI16ToI32": {Package: "hwy", Name: "PromoteUpperI16ToI32", IsMethod: false},
+
+			// Half/quarter vectors: hwy wrappers named after the full vector,
+			// e.g. hwy.LowerHalf_AVX2_F32x8 returning archsimd.Float32x4.
+			// PromoteI8ToI32/PromoteU8ToU32 widen a LowerQuarter result and are
+			// named after the full byte vector (see promoteSourceType).
+			"LowerHalf":        {Package: "hwy", Name: "LowerHalf", IsMethod: false},
+			"UpperHalf":        {Package: "hwy", Name: "UpperHalf", IsMethod: false},
+			"LowerQuarter":     {Package: "hwy", Name: "LowerQuarter", IsMethod: false},
+			"Combine":          {Package: "hwy", Name: "Combine", IsMethod: false},
+			"ZeroExtendVector": {Package: "hwy", Name: "ZeroExtendVector", IsMethod: false},
+			"PromoteI8ToI32":   {Package: "hwy", Name: "PromoteI8ToI32", IsMethod: false},
+			"PromoteU8ToU32":   {Package: "hwy", Name: "PromoteU8ToU32", IsMethod: false},
+
 			"Round":            {Name: "Round", IsMethod: false},
 			"Trunc":            {Name: "Trunc", IsMethod: false},
 			"Ceil":             {Name: "Ceil", IsMethod: false},
@@ -234,6 +271,14 @@ func AVX2Target() Target {
 			// archsimd doesn't have IfThenElse. Using hwy wrapper.
 			"IfThenElse": {Package: "hwy", Name: "IfThenElse", IsMethod: false},
 
+			// Masked arithmetic: a op b where mask is true, a otherwise.
+			"MaskedAdd":      {Package: "hwy", Name: "MaskedAdd", IsMethod: false},
+			"MaskedSub":      {Package: "hwy", Name: "MaskedSub", IsMethod: false},
+			"MaskedMul":      {Package: "hwy", Name: "MaskedMul", IsMethod: false},
+			"IfThenElseZero": {Package: "hwy", Name: "IfThenElseZero", IsMethod: false},
+			"IfThenZeroElse": {Package: "hwy", Name: "IfThenZeroElse", IsMethod: false},
+			"ZeroIfNegative": {Package: "hwy", Name: "ZeroIfNegative", IsMethod: false},
+
 			// ===== Mask operations =====
 			// archsimd Mask types don't have these methods. Using hwy wrappers.
 			"AllTrue":       {Package: "hwy", Name: "AllTrue", IsMethod: false},
@@ -244,6 +289,28 @@ func AVX2Target() Target {
 			"Expand":        {Package: "hwy", Name: "Expand", IsMethod: false},
 			"BitsFromMask":  {Package: "hwy", Name: "BitsFromMask", IsMethod: false},
 
+			// ===== Widening multiply =====
+			// The int16/int8 forms are named after their narrow input vector,
+			// e.g. hwy.SumOfMulQuadAccumulateI8ToI32_AVX2_Int8x32 (see promoteSourceType).
+			"MulEvenI32ToI64":                   {Package: "hwy", Name: "MulEven", IsMethod: false},
+			"MulOddI32ToI64":                    {Package: "hwy", Name: "MulOdd", IsMethod: false},
+			"WidenMulPairwiseAddI16ToI32":       {Package: "hwy", Name: "WidenMulPairwiseAddI16ToI32", IsMethod: false},
+			"ReorderWidenMulAccumulateI16ToI32": {Package: "hwy", Name: "ReorderWidenMulAccumulateI16ToI32", IsMethod: false},
+			"RearrangeToOddPlusEvenI32":         {Package: "hwy", Name: "RearrangeToOddPlusEvenI32", IsMethod: false},
+			"SumOfMulQuadAccumulateI8ToI32":     {Package: "hwy", Name: "SumOfMulQuadAccumulateI8ToI32", IsMethod: false},
+			"SumOfMulQuadAccumulateU8ToU32":     {Package: "hwy", Name: "SumOfMulQuadAccumulateU8ToU32", IsMethod: false},
+
+			// ===== Double-word (128-bit) integer arithmetic on uint64 lanes =====
+			"MulHigh64":     {Package: "hwy", Name: "MulHigh64", IsMethod: false},
+			"Mul128":        {Package: "hwy", Name: "Mul128", IsMethod: false},
+			"AddWithCarry":  {Package: "hwy", Name: "AddWithCarry", IsMethod: false},
+			"SubWithBorrow": {Package: "hwy", Name: "SubWithBorrow", IsMethod: false},
+
+			// ===== Crypto: AESENC/AESENCLAST per 16-byte block, per-lane CLMUL =====
+			"AESRound":          {Package: "hwy", Name: "AESRound", IsMethod: false},
+			"AESLastRound":      {Package: "hwy", Name: "AESLastRound", IsMethod: false},
+			"CarrylessMultiply": {Package: "hwy", Name: "CarrylessMultiply", IsMethod: false},
+
 			// ===== contrib/math: Transcendental functions =====
 			// The transformer adds target and type suffix (e.g., Exp -> BaseExpVec_avx2)
 			"Exp":     {Package: "math", SubPackage: "math", Name: "BaseExpVec", IsMethod: false},
@@ -293,6 +360,10 @@ func AVX512Target() Target {
 			"int64":        "Int64x8",
 			"uint32":       "Uint32x16",
 			"uint64":       "Uint64x8",
+			"int8":         "Int8x64",
+			"int16":        "Int16x32",
+			"uint8":        "Uint8x64",
+			"uint16":       "Uint16x32",
 			"hwy.Float16":  "Float16x16AVX512",
 			"hwy.BFloat16": "BFloat16x16AVX512",
 		},
@@ -303,6 +374,9 @@ func AVX512Target() Target {
 			"Load4":      {Package: "hwy", Name: "Load4", IsMethod: false},     // hwy.Load4_AVX512_Float32 - 4 separate loads
 			"Store":      {Name: "Store", IsMethod: true},                      // v.Store (pointer based, fast)
 			"StoreSlice": {Name: "StoreSlice", IsMethod: true},                 // v.StoreSlice (slice based, safe)
+			"StoreStream": {Package: "hwy", Name: "StoreStream", IsMethod: false}, // hwy.StoreStream_<target> (non-temporal)
+			"LoadStrided":  {Package: "hwy", Name: "LoadStrided", IsMethod: false},  // hwy.LoadStrided_<target> (column walk)
+			"StoreStrided": {Package: "hwy", Name: "StoreStrided", IsMethod: false}, // hwy.StoreStrided_<target>
 			"Set":        {Name: "Broadcast", IsMethod: false},
 			"Const":     {Name: "Broadcast", IsMethod: false}, // Same as Set
 			"Zero":      {Package: "special", Name: "Zero", IsMethod: false}, // Use Broadcast(0)
@@ -334,8 +408,8 @@ func AVX512Target() Target {
 			// ===== Core math operations =====
 			"Sqrt":               {Name: "Sqrt", IsMethod: true},
 			"RSqrt":              {Name: "ReciprocalSqrt", IsMethod: true},  // VRSQRT14PS/VRSQRT14PD (~14-bit precision)
-			"RSqrtNewtonRaphson": {Package: "hwy", Name: "RSqrtNewtonRaphson_AVX512", IsMethod: false}, // N-R refined
-			"RSqrtPrecise":       {Package: "hwy", Name: "RSqrtPrecise_AVX512", IsMethod: false},       // sqrt + div
+			"RSqrtNewtonRaphson": {Package: "hwy", Name: "RSqrtNewtonRaphson", IsMethod: false}, // N-R refined
+			"RSqrtPrecise":       {Package: "hwy", Name: "RSqrtPrecise", IsMethod: false},       // sqrt + div
 			"FMA":                {Name: "MulAdd", IsMethod: true}, // archsimd uses MulAdd for FMA
 			"MulAdd":             {Name: "MulAdd", IsMethod: true}, // a.MulAdd(b, c) = a*b + c
 
@@ -404,30 +478,60 @@ func AVX512Target() Target {
 			"Mask": {Package: "special", Name: "Mask", IsMethod: false}, // Type, not function
 
 			// ===== Permutation/Shuffle =====
-			"Reverse":            {Name: "Reverse", IsMethod: true},
-			"Reverse2":           {Name: "Reverse2", IsMethod: false},
-			"Reverse4":           {Name: "Reverse4", IsMethod: false},
-			"Reverse8":           {Name: "Reverse8", IsMethod: false},
+			"Reverse":            {Package: "hwy", Name: "Reverse", IsMethod: false},
+			"Reverse2":           {Package: "hwy", Name: "Reverse2", IsMethod: false},
+			"Reverse4":           {Package: "hwy", Name: "Reverse4", IsMethod: false},
+			"Reverse8":           {Package: "hwy", Name: "Reverse8", IsMethod: false},
 			"Broadcast":          {Name: "Broadcast", IsMethod: true},
 			"GetLane":            {Package: "hwy", Name: "GetLane", IsMethod: false},
 			"InsertLane":         {Name: "InsertLane", IsMethod: false},
 			"InterleaveLower":    {Package: "hwy", Name: "InterleaveLower", IsMethod: false},
 			"InterleaveUpper":    {Package: "hwy", Name: "InterleaveUpper", IsMethod: false},
-			"ConcatLowerLower":   {Name: "ConcatLowerLower", IsMethod: false},
+			"ConcatLowerLower":   {Package: "hwy", Name: "ConcatLowerLower", IsMethod: false},
 			"ConcatUpperUpper":   {Name: "ConcatUpperUpper", IsMethod: false},
 			"ConcatLowerUpper":   {Name: "ConcatLowerUpper", IsMethod: false},
 			"ConcatUpperLower":   {Name: "ConcatUpperLower", IsMethod: false},
-			"OddEven":            {Name: "OddEven", IsMethod: false},
-			"DupEven":            {Name: "DupEven", IsMethod: false},
-			"DupOdd":             {Name: "DupOdd", IsMethod: false},
-			"SwapAdjacentBlocks": {Name: "SwapAdjacentBlocks", IsMethod: false},
+			"OddEven":            {Package: "hwy", Name: "OddEven", IsMethod: false},
+			"DupEven":            {Package: "hwy", Name: "DupEven", IsMethod: false},
+			"DupOdd":             {Package: "hwy", Name: "DupOdd", IsMethod: false},
+			"SwapAdjacentBlocks": {Package: "hwy", Name: "SwapAdjacentBlocks", IsMethod: false},
 			"SlideUpLanes":       {Package: "hwy", Name: "SlideUpLanes", IsMethod: false},
 			"SlideDownLanes":     {Package: "hwy", Name: "SlideDownLanes", IsMethod: false},
+			"RotateLanes":        {Package: "hwy", Name: "RotateLanes", IsMethod: false},
+			"TableLookupLanes":   {Package: "hwy", Name: "TableLookupLanes", IsMethod: false},
+			"ZipLower":           {Package: "hwy", Name: "ZipLower", IsMethod: false},
+			"ZipUpper":           {Package: "hwy", Name: "ZipUpper", IsMethod: false},
+			"ConcatEven":         {Package: "hwy", Name: "ConcatEven", IsMethod: false},
+			"ConcatOdd":          {Package: "hwy", Name: "ConcatOdd", IsMethod: false},
 
 			// ===== Type Conversions =====
 			"ConvertToInt32":   {Name: "ConvertToInt32", IsMethod: true},
 			"ConvertToInt64":   {Name: "ConvertToInt64", IsMethod: true},
 			"ConvertToFloat32": {Name: "ConvertToFloat32", IsMethod: true},
+
+			// 8/16-bit widening: hwy wrappers named after the narrow source vector,
+			// e.g. hwy.PromoteLowerU8ToU16_AVX2_Uint8x32 (see promoteSourceType).
+			"PromoteLowerU8ToU16":  {Package: "hwy", Name: "PromoteLowerU8ToU16", IsMethod: false},
+			"PromoteUpperU8ToU16":  {Package: "hwy", Name: "PromoteUpperU8ToU16", IsMethod: false},
+			"PromoteLowerI8ToI16":  {Package: "hwy", Name: "PromoteLowerI8ToI16", IsMethod: false},
+			"PromoteUpperI8ToI16":  {Package: "hwy", Name: "PromoteUpperI8ToI16", IsMethod: false},
+			"PromoteLowerU16ToU32": {Package: "hwy", Name: "PromoteLowerU16ToU32", IsMethod: false},
+			"PromoteUpperU16ToU32": {Package: "hwy", Name: "PromoteUpperU16ToU32", IsMethod: false},
+			"PromoteLowerI16ToI32": {Package: "hwy", Name: "PromoteLowerI16ToI32", IsMethod: false},
+			"PromoteUpperI16ToI32": {Package: "hwy", Name: "PromoteUpperI16ToI32", IsMethod: false},
+
+			// Half/quarter vectors: hwy wrappers named after the full vector,
+			// e.g. hwy.LowerHalf_AVX512_F32x16 returning archsimd.Float32x8.
+			// PromoteI8ToI32/PromoteU8ToU32 widen a LowerQuarter result and are
+			// named after the full byte vector (see promoteSourceType).
+			"LowerHalf":        {Package: "hwy", Name: "LowerHalf", IsMethod: false},
+			"UpperHalf":        {Package: "hwy", Name: "UpperHalf", IsMethod: false},
+			"LowerQuarter":     {Package: "hwy", Name: "LowerQuarter", IsMethod: false},
+			"Combine":          {Package: "hwy", Name: "Combine", IsMethod: false},
+			"ZeroExtendVector": {Package: "hwy", Name: "ZeroExtendVector", IsMethod: false},
+			"PromoteI8ToI32":   {Package: "hwy", Name: "PromoteI8ToI32", IsMethod: false},
+			"PromoteU8ToU32":   {Package: "hwy", Name: "PromoteU8ToU32", IsMethod: false},
+
 			"ConvertToFloat64": {Name: "ConvertToFloat64", IsMethod: true},
 			"Round":            {Name: "Round", IsMethod: false},
 			"Trunc":            {Name: "Trunc", IsMethod: false},
@@ -447,6 +551,14 @@ func AVX512Target() Target {
 			// archsimd doesn't have IfThenElse. Using hwy wrapper.
 			"IfThenElse": {Package: "hwy", Name: "IfThenElse", IsMethod: false},
 
+			// Masked arithmetic: a op b where mask is true, a otherwise.
+			"MaskedAdd":      {Package: "hwy", Name: "MaskedAdd", IsMethod: false},
+			"MaskedSub":      {Package: "hwy", Name: "MaskedSub", IsMethod: false},
+			"MaskedMul":      {Package: "hwy", Name: "MaskedMul", IsMethod: false},
+			"IfThenElseZero": {Package: "hwy", Name: "IfThenElseZero", IsMethod: false},
+			"IfThenZeroElse": {Package: "hwy", Name: "IfThenZeroElse", IsMethod: false},
+			"ZeroIfNegative": {Package: "hwy", Name: "ZeroIfNegative", IsMethod: false},
+
 			// ===== Mask operations =====
 			// archsimd Mask types don't have these methods. Using hwy wrappers.
 			"AllTrue":       {Package: "hwy", Name: "AllTrue", IsMethod: false},
@@ -457,6 +569,28 @@ func AVX512Target() Target {
 			"Expand":        {Package: "hwy", Name: "Expand", IsMethod: false},
 			"BitsFromMask":  {Package: "hwy", Name: "BitsFromMask", IsMethod: false},
 
+			// ===== Widening multiply =====
+			// The int16/int8 forms are named after their narrow input vector,
+			// e.g. hwy.SumOfMulQuadAccumulateI8ToI32_AVX512_Int8x64 (see promoteSourceType).
+			"MulEvenI32ToI64":                   {Package: "hwy", Name: "MulEven", IsMethod: false},
+			"MulOddI32ToI64":                    {Package: "hwy", Name: "MulOdd", IsMethod: false},
+			"WidenMulPairwiseAddI16ToI32":       {Package: "hwy", Name: "WidenMulPairwiseAddI16ToI32", IsMethod: false},
+			"ReorderWidenMulAccumulateI16ToI32": {Package: "hwy", Name: "ReorderWidenMulAccumulateI16ToI32", IsMethod: false},
+			"RearrangeToOddPlusEvenI32":         {Package: "hwy", Name: "RearrangeToOddPlusEvenI32", IsMethod: false},
+			"SumOfMulQuadAccumulateI8ToI32":     {Package: "hwy", Name: "SumOfMulQuadAccumulateI8ToI32", IsMethod: false},
+			"SumOfMulQuadAccumulateU8ToU32":     {Package: "hwy", Name: "SumOfMulQuadAccumulateU8ToU32", IsMethod: false},
+
+			// ===== Double-word (128-bit) integer arithmetic on uint64 lanes =====
+			"MulHigh64":     {Package: "hwy", Name: "MulHigh64", IsMethod: false},
+			"Mul128":        {Package: "hwy", Name: "Mul128", IsMethod: false},
+			"AddWithCarry":  {Package: "hwy", Name: "AddWithCarry", IsMethod: false},
+			"SubWithBorrow": {Package: "hwy", Name: "SubWithBorrow", IsMethod: false},
+
+			// ===== Crypto: AESENC/AESENCLAST per 16-byte block, per-lane CLMUL =====
+			"AESRound":          {Package: "hwy", Name: "AESRound", IsMethod: false},
+			"AESLastRound":      {Package: "hwy", Name: "AESLastRound", IsMethod: false},
+			"CarrylessMultiply": {Package: "hwy", Name: "CarrylessMultiply", IsMethod: false},
+
 			// ===== contrib/math: Transcendental functions =====
 			// The transformer adds target and type suffix (e.g., Exp -> BaseExpVec_avx512)
 			"Exp":     {Package: "math", SubPackage: "math", Name: "BaseExpVec", IsMethod: false},
@@ -507,6 +641,10 @@ func FallbackTarget() Target {
 			"int64":        "hwy.Vec[int64]",
 			"uint32":       "hwy.Vec[uint32]",
 			"uint64":       "hwy.Vec[uint64]",
+			"int8":         "hwy.Vec[int8]",
+			"int16":        "hwy.Vec[int16]",
+			"uint8":        "hwy.Vec[uint8]",
+			"uint16":       "hwy.Vec[uint16]",
 		},
 		OpMap: map[string]OpInfo{
 			// ===== Load/Store operations - use hwy package =====
@@ -515,6 +653,9 @@ func FallbackTarget() Target {
 			"Load4":      {Package: "hwy", Name: "Load4", IsMethod: false},     // hwy.Load4 fallback (4 separate loads)
 			"Store":      {Package: "hwy", Name: "Store", IsMethod: false},     // hwy.Store (pointer based, fast)
 			"StoreSlice": {Package: "hwy", Name: "StoreSlice", IsMethod: false}, // hwy.StoreSlice (slice based, safe)
+			"StoreStream": {Package: "hwy", Name: "StoreStream", IsMethod: false}, // hwy.StoreStream (non-temporal)
+			"LoadStrided":  {Package: "hwy", Name: "LoadStrided", IsMethod: false},  // hwy.LoadStrided (column walk)
+			"StoreStrided": {Package: "hwy", Name: "StoreStrided", IsMethod: false}, // hwy.StoreStrided
 			"Set":       {Package: "hwy", Name: "Set", IsMethod: false},
 			"Zero":      {Package: "hwy", Name: "Zero", IsMethod: false},
 			"MaskLoad":  {Package: "hwy", Name: "MaskLoad", IsMethod: false},
@@ -597,6 +738,14 @@ func FallbackTarget() Target {
 			// ===== Conditional =====
 			"IfThenElse": {Package: "hwy", Name: "IfThenElse", IsMethod: false},
 
+			// Masked arithmetic: a op b where mask is true, a otherwise.
+			"MaskedAdd":      {Package: "hwy", Name: "MaskedAdd", IsMethod: false},
+			"MaskedSub":      {Package: "hwy", Name: "MaskedSub", IsMethod: false},
+			"MaskedMul":      {Package: "hwy", Name: "MaskedMul", IsMethod: false},
+			"IfThenElseZero": {Package: "hwy", Name: "IfThenElseZero", IsMethod: false},
+			"IfThenZeroElse": {Package: "hwy", Name: "IfThenZeroElse", IsMethod: false},
+			"ZeroIfNegative": {Package: "hwy", Name: "ZeroIfNegative", IsMethod: false},
+
 			// ===== Initialization =====
 			"Iota":     {Package: "hwy", Name: "Iota", IsMethod: false},
 			"SignBit":  {Package: "hwy", Name: "SignBit", IsMethod: false},
@@ -628,6 +777,12 @@ func FallbackTarget() Target {
 			"SwapAdjacentBlocks": {Package: "hwy", Name: "SwapAdjacentBlocks", IsMethod: false},
 			"SlideUpLanes":       {Package: "hwy", Name: "SlideUpLanes", IsMethod: false},
 			"SlideDownLanes":     {Package: "hwy", Name: "SlideDownLanes", IsMethod: false},
+			"RotateLanes":        {Package: "hwy", Name: "RotateLanes", IsMethod: false},
+			"TableLookupLanes":   {Package: "hwy", Name: "TableLookupLanes", IsMethod: false},
+			"ZipLower":           {Package: "hwy", Name: "ZipLower", IsMethod: false},
+			"ZipUpper":           {Package: "hwy", Name: "ZipUpper", IsMethod: false},
+			"ConcatEven":         {Package: "hwy", Name: "ConcatEven", IsMethod: false},
+			"ConcatOdd":          {Package: "hwy", Name: "ConcatOdd", IsMethod: false},
 
 			// ===== Type Conversions =====
 			"ConvertToInt32":   {Package: "hwy", Name: "ConvertToInt32", IsMethod: false},
@@ -657,6 +812,21 @@ func FallbackTarget() Target {
 			"LastN":         {Package: "hwy", Name: "LastN", IsMethod: false},
 			"BitsFromMask":  {Package: "hwy", Name: "BitsFromMask", IsMethod: false},
 
+			// ===== Widening multiply =====
+			"MulEvenI32ToI64": {Package: "hwy", Name: "MulEvenI32ToI64", IsMethod: false},
+			"MulOddI32ToI64":  {Package: "hwy", Name: "MulOddI32ToI64", IsMethod: false},
+
+			// ===== Double-word (128-bit) integer arithmetic on uint64 lanes =====
+			"MulHigh64":     {Package: "hwy", Name: "MulHigh64", IsMethod: false},
+			"Mul128":        {Package: "hwy", Name: "Mul128", IsMethod: false},
+			"AddWithCarry":  {Package: "hwy", Name: "AddWithCarry", IsMethod: false},
+			"SubWithBorrow": {Package: "hwy", Name: "SubWithBorrow", IsMethod: false},
+
+			// ===== Crypto: AESENC/AESENCLAST per 16-byte block, per-lane CLMUL =====
+			"AESRound":          {Package: "hwy", Name: "AESRound", IsMethod: false},
+			"AESLastRound":      {Package: "hwy", Name: "AESLastRound", IsMethod: false},
+			"CarrylessMultiply": {Package: "hwy", Name: "CarrylessMultiply", IsMethod: false},
+
 			// ===== contrib/math: Transcendental functions =====
 			// The transformer adds target and type suffix (e.g., Exp -> BaseExpVec_fallback)
 			"Exp":     {Package: "math", SubPackage: "math", Name: "BaseExpVec", IsMethod: false},
@@ -705,6 +875,8 @@ func NEONTarget() Target {
 			"int64":        "Int64x2",
 			"uint32":       "Uint32x4",
 			"uint64":       "Uint64x2",
+			"uint8":        "Uint8x16", // asm Int8x16/Int16x8 lack arithmetic: int8/int16 dispatch to the fallback
+			"uint16":       "Uint16x8",
 			"hwy.Float16":  "Float16x8",  // Use concrete asm type with in-place methods
 			"hwy.BFloat16": "BFloat16x8", // Use concrete asm type with in-place methods
 		},
@@ -715,6 +887,9 @@ func NEONTarget() Target {
 			"Load4":      {Name: "Load4", IsMethod: false},      // asm.Load4Float32x4Slice - single ld1 instruction
 			"Store":      {Name: "Store", IsMethod: true},       // v.Store (pointer based, fast)
 			"StoreSlice": {Name: "StoreSlice", IsMethod: true},  // v.StoreSlice (slice based, safe)
+			"StoreStream": {Package: "hwy", Name: "StoreStream", IsMethod: false}, // hwy.StoreStream_NEON_* (plain store)
+			"LoadStrided":  {Package: "hwy", Name: "LoadStrided", IsMethod: false},  // hwy.LoadStrided_<target> (column walk)
+			"StoreStrided": {Package: "hwy", Name: "StoreStrided", IsMethod: false}, // hwy.StoreStrided_<target>
 			"Set":       {Name: "Broadcast", IsMethod: false},
 			"Const":     {Name: "Broadcast", IsMethod: false}, // Same as Set
 			"Zero":      {Name: "Zero", IsMethod: false},
@@ -744,8 +919,8 @@ func NEONTarget() Target {
 			// ===== Core math operations =====
 			"Sqrt":               {Name: "Sqrt", IsMethod: true},
 			"RSqrt":              {Name: "ReciprocalSqrt", IsMethod: true}, // v.ReciprocalSqrt() (~12-bit precision)
-			"RSqrtNewtonRaphson": {Package: "hwy", Name: "RSqrtNewtonRaphson_NEON", IsMethod: false}, // N-R refined
-			"RSqrtPrecise":       {Package: "hwy", Name: "RSqrtPrecise_NEON", IsMethod: false},       // sqrt + div
+			"RSqrtNewtonRaphson": {Package: "hwy", Name: "RSqrtNewtonRaphson", IsMethod: false}, // N-R refined
+			"RSqrtPrecise":       {Package: "hwy", Name: "RSqrtPrecise", IsMethod: false},       // sqrt + div
 			"FMA":                {Name: "MulAdd", IsMethod: true}, // FMA maps to MulAdd in NEON asm
 			"MulAdd":             {Name: "MulAdd", IsMethod: true}, // a.MulAdd(b, c) = a*b + c
 			"Pow":                {Name: "Pow", IsMethod: true},    // v.Pow(exp) = v^exp element-wise
@@ -810,6 +985,14 @@ func NEONTarget() Target {
 			// ===== Conditional =====
 			"IfThenElse": {Name: "IfThenElse", IsMethod: false},
 
+			// Masked arithmetic: a op b where mask is true, a otherwise.
+			"MaskedAdd":      {Package: "hwy", Name: "MaskedAdd", IsMethod: false},
+			"MaskedSub":      {Package: "hwy", Name: "MaskedSub", IsMethod: false},
+			"MaskedMul":      {Package: "hwy", Name: "MaskedMul", IsMethod: false},
+			"IfThenElseZero": {Package: "hwy", Name: "IfThenElseZero", IsMethod: false},
+			"IfThenZeroElse": {Package: "hwy", Name: "IfThenZeroElse", IsMethod: false},
+			"ZeroIfNegative": {Package: "hwy", Name: "ZeroIfNegative", IsMethod: false},
+
 			// ===== Initialization =====
 			"Iota":     {Name: "Iota", IsMethod: false},
 			"SignBit":  {Name: "SignBit", IsMethod: false},
@@ -822,28 +1005,53 @@ func NEONTarget() Target {
 			"Mask": {Package: "special", Name: "Mask", IsMethod: false}, // Type, not function
 
 			// ===== Permutation/Shuffle =====
-			"Reverse":            {Name: "Reverse", IsMethod: true},
-			"Reverse2":           {Name: "Reverse2", IsMethod: false},
-			"Reverse4":           {Name: "Reverse4", IsMethod: false},
+			"Reverse":            {Package: "hwy", Name: "Reverse", IsMethod: false},
+			"Reverse2":           {Package: "hwy", Name: "Reverse2", IsMethod: false},
+			"Reverse4":           {Package: "hwy", Name: "Reverse4", IsMethod: false},
 			"Broadcast":          {Name: "Broadcast", IsMethod: true},
 			"GetLane":            {Name: "Get", IsMethod: true},
 			"InsertLane":         {Name: "InsertLane", IsMethod: false},
 			"InterleaveLower":    {Package: "hwy", Name: "InterleaveLower", IsMethod: false},
 			"InterleaveUpper":    {Package: "hwy", Name: "InterleaveUpper", IsMethod: false},
-			"ConcatLowerLower":   {Name: "ConcatLowerLower", IsMethod: false},
-			"ConcatUpperUpper":   {Name: "ConcatUpperUpper", IsMethod: false},
-			"ConcatLowerUpper":   {Name: "ConcatLowerUpper", IsMethod: false},
-			"ConcatUpperLower":   {Name: "ConcatUpperLower", IsMethod: false},
-			"OddEven":            {Name: "OddEven", IsMethod: false},
-			"DupEven":            {Name: "DupEven", IsMethod: false},
-			"DupOdd":             {Name: "DupOdd", IsMethod: false},
-			"SwapAdjacentBlocks": {Name: "SwapAdjacentBlocks", IsMethod: false},
+			"ConcatLowerLower":   {Package: "hwy", Name: "ConcatLowerLower", IsMethod: false},
+			"ConcatUpperUpper":   {Package: "hwy", Name: "ConcatUpperUpper", IsMethod: false},
+			"ConcatLowerUpper":   {Package: "hwy", Name: "ConcatLowerUpper", IsMethod: false},
+			"ConcatUpperLower":   {Package: "hwy", Name: "ConcatUpperLower", IsMethod: false},
+			"OddEven":            {Package: "hwy", Name: "OddEven", IsMethod: false},
+			"DupEven":            {Package: "hwy", Name: "DupEven", IsMethod: false},
+			"DupOdd":             {Package: "hwy", Name: "DupOdd", IsMethod: false},
+			"SwapAdjacentBlocks": {Package: "hwy", Name: "SwapAdjacentBlocks", IsMethod: false},
 			"SlideUpLanes":       {Package: "asm", Name: "SlideUpLanes", IsMethod: false},
 			"SlideDownLanes":     {Package: "asm", Name: "SlideDownLanes", IsMethod: false},
+			"RotateLanes":        {Package: "hwy", Name: "RotateLanes", IsMethod: false},
+			"TableLookupLanes":   {Package: "hwy", Name: "TableLookupLanes", IsMethod: false},
+			"ZipLower":           {Package: "hwy", Name: "ZipLower", IsMethod: false},
+			"ZipUpper":           {Package: "hwy", Name: "ZipUpper", IsMethod: false},
+			"ConcatEven":         {Package: "hwy", Name: "ConcatEven", IsMethod: false},
+			"ConcatOdd":          {Package: "hwy", Name: "ConcatOdd", IsMethod: false},
 
 			// ===== Type Conversions =====
 			"ConvertToInt32":   {Name: "ConvertToInt32", IsMethod: true},
 			"ConvertToFloat32": {Name: "ConvertToFloat32", IsMethod: true},
+
+			// 8/16-bit widening (unsigned only: asm has no signed promotes)
+			"PromoteLowerU8ToU16":  {Package: "hwy", Name: "PromoteLowerU8ToU16", IsMethod: false},
+			"PromoteUpperU8ToU16":  {Package: "hwy", Name: "PromoteUpperU8ToU16", IsMethod: false},
+			"PromoteLowerU16ToU32": {Package: "hwy", Name: "PromoteLowerU16ToU32", IsMethod: false},
+			"PromoteUpperU16ToU32": {Package: "hwy", Name: "PromoteUpperU16ToU32", IsMethod: false},
+
+			// Half/quarter vectors: hwy wrappers named after the full vector,
+			// e.g. hwy.LowerHalf_NEON_F32x4 returning asm.Float32x2.
+			// PromoteI8ToI32/PromoteU8ToU32 widen a LowerQuarter result and are
+			// named after the full byte vector (see promoteSourceType).
+			"LowerHalf":        {Package: "hwy", Name: "LowerHalf", IsMethod: false},
+			"UpperHalf":        {Package: "hwy", Name: "UpperHalf", IsMethod: false},
+			"LowerQuarter":     {Package: "hwy", Name: "LowerQuarter", IsMethod: false},
+			"Combine":          {Package: "hwy", Name: "Combine", IsMethod: false},
+			"ZeroExtendVector": {Package: "hwy", Name: "ZeroExtendVector", IsMethod: false},
+			"PromoteI8ToI32":   {Package: "hwy", Name: "PromoteI8ToI32", IsMethod: false},
+			"PromoteU8ToU32":   {Package: "hwy", Name: "PromoteU8ToU32", IsMethod: false},
+
 			"Round":            {Name: "Round", IsMethod: false},
 			"Trunc":            {Name: "Trunc", IsMethod: false},
 			"Ceil":             {Name: "Ceil", IsMethod: false},
@@ -852,8 +1060,8 @@ func NEONTarget() Target {
 
 			// ===== Compress/Expand =====
 			"Compress":      {Name: "Compress", IsMethod: false},
-			"Expand":        {Name: "Expand", IsMethod: false},
-			"CompressStore": {Name: "CompressStore", IsMethod: false},
+			"Expand":        {Package: "hwy", Name: "Expand", IsMethod: false},        // hwy.Expand_NEON_* (scalar scatter)
+			"CompressStore": {Package: "hwy", Name: "CompressStore", IsMethod: false}, // hwy.CompressStore_NEON_* (asm.CompressKeys*, partial dst)
 			"CountTrue":     {Name: "CountTrue", IsMethod: false},
 			"AllTrue":       {Name: "AllTrue", IsMethod: false},
 			"AllFalse":      {Name: "AllFalse", IsMethod: false},
@@ -863,6 +1071,28 @@ func NEONTarget() Target {
 			"LastN":         {Name: "LastN", IsMethod: false},
 			"BitsFromMask":  {Package: "hwy", Name: "BitsFromMask", IsMethod: false},
 
+			// ===== Widening multiply =====
+			// The int16/int8 forms are named after their narrow input vector,
+			// e.g. hwy.SumOfMulQuadAccumulateI8ToI32_NEON_Int8x16 (see promoteSourceType).
+			"MulEvenI32ToI64":                   {Package: "hwy", Name: "MulEven", IsMethod: false},
+			"MulOddI32ToI64":                    {Package: "hwy", Name: "MulOdd", IsMethod: false},
+			"WidenMulPairwiseAddI16ToI32":       {Package: "hwy", Name: "WidenMulPairwiseAddI16ToI32", IsMethod: false},
+			"ReorderWidenMulAccumulateI16ToI32": {Package: "hwy", Name: "ReorderWidenMulAccumulateI16ToI32", IsMethod: false},
+			"RearrangeToOddPlusEvenI32":         {Package: "hwy", Name: "RearrangeToOddPlusEvenI32", IsMethod: false},
+			"SumOfMulQuadAccumulateI8ToI32":     {Package: "hwy", Name: "SumOfMulQuadAccumulateI8ToI32", IsMethod: false},
+			"SumOfMulQuadAccumulateU8ToU32":     {Package: "hwy", Name: "SumOfMulQuadAccumulateU8ToU32", IsMethod: false},
+
+			// ===== Double-word (128-bit) integer arithmetic on uint64 lanes =====
+			"MulHigh64":     {Package: "hwy", Name: "MulHigh64", IsMethod: false},
+			"Mul128":        {Package: "hwy", Name: "Mul128", IsMethod: false},
+			"AddWithCarry":  {Package: "hwy", Name: "AddWithCarry", IsMethod: false},
+			"SubWithBorrow": {Package: "hwy", Name: "SubWithBorrow", IsMethod: false},
+
+			// ===== Crypto: AESENC/AESENCLAST per 16-byte block, per-lane CLMUL =====
+			"AESRound":          {Package: "hwy", Name: "AESRound", IsMethod: false},
+			"AESLastRound":      {Package: "hwy", Name: "AESLastRound", IsMethod: false},
+			"CarrylessMultiply": {Package: "hwy", Name: "CarrylessMultiply", IsMethod: false},
+
 			// ===== IEEE 754 Exponent/Mantissa operations =====
 			"GetExponent": {Name: "GetExponent", IsMethod: true},
 			"GetMantissa": {Name: "GetMantissa", IsMethod: true},
@@ -1312,6 +1542,39 @@ func SVELinuxTarget() Target {
 	}
 }
 
+// SVE2LinuxTarget returns the target configuration for SVE2 on Linux
+// (Graviton 4, Neoverse V2/N2). It shares SVE_LINUX's ops and dynamic vector
+// length, but its C is compiled for armv9-a+sve2 and its dispatch is guarded
+// by hwy.HasSVE2(), so SVE_LINUX can stay on baseline SVE for Graviton 3.
+func SVE2LinuxTarget() Target {
+	t := SVELinuxTarget()
+	t.Name = "SVE2_LINUX"
+	return t
+}
+
+// RVVTarget returns the target configuration for the RISC-V Vector extension
+// (RVV 1.0) on Linux. Like SVE, RVV is vector-length agnostic: VecWidth is
+// only a nominal size for the Go side, and the C/asm kernels query VLMAX at
+// runtime. RVV is supported in C/asm mode only; dispatch is guarded by
+// hwy.HasRVV().
+func RVVTarget() Target {
+	t := SVELinuxTarget()
+	t.Name = "RVV"
+	t.BuildTag = "linux && riscv64"
+	return t
+}
+
+// SMETarget returns the target configuration for the SME outer-product units
+// on macOS (Apple M4+). It covers only matmul-shaped functions, which are
+// compiled to FMOPA kernels accumulating into the ZA tiles; all other
+// functions keep their NEON or SVE implementations. SME is supported in
+// C/asm mode only; dispatch is guarded by hwy.HasSME().
+func SMETarget() Target {
+	t := SVEDarwinTarget()
+	t.Name = "SME"
+	return t
+}
+
 // targetRegistry maps target names to their constructor functions.
 var targetRegistry = map[string]func() Target{
 	"avx2":       AVX2Target,
@@ -1319,6 +1582,9 @@ var targetRegistry = map[string]func() Target{
 	"neon":       NEONTarget,
 	"sve_darwin": SVEDarwinTarget,
 	"sve_linux":  SVELinuxTarget,
+	"sve2_linux": SVE2LinuxTarget,
+	"rvv":        RVVTarget,
+	"sme":        SMETarget,
 	"fallback":   FallbackTarget,
 }
 
@@ -1354,6 +1620,12 @@ func (t Target) Suffix() string {
 		return "_sve_darwin"
 	case "SVE_LINUX":
 		return "_sve_linux"
+	case "SVE2_LINUX":
+		return "_sve2_linux"
+	case "RVV":
+		return "_rvv"
+	case "SME":
+		return "_sme"
 	case "Fallback":
 		return "_fallback"
 	default:
@@ -1366,13 +1638,23 @@ func (t Target) Arch() string {
 	switch t.Name {
 	case "AVX2", "AVX512":
 		return "amd64"
-	case "NEON", "SVE_DARWIN", "SVE_LINUX":
+	case "NEON", "SVE_DARWIN", "SVE_LINUX", "SVE2_LINUX", "SME":
 		return "arm64"
+	case "RVV":
+		return "riscv64"
 	default:
 		return ""
 	}
 }
 
+// SupportsElemType reports whether the target has a vector type for elemType.
+// Generic functions are not generated for unsupported element types; their
+// dispatch entries use the fallback implementation instead.
+func (t Target) SupportsElemType(elemType string) bool {
+	_, ok := t.TypeMap[elemType]
+	return ok
+}
+
 // LanesFor returns the number of lanes for the given element type.
 func (t Target) LanesFor(elemType string) int {
 	var elemSize int
@@ -1381,7 +1663,9 @@ func (t Target) LanesFor(elemType string) int {
 		elemSize = 4
 	case "float64", "int64", "uint64":
 		elemSize = 8
-	case "int16", "uint16", "hwy.Float16", "hwy.BFloat16", "Float16", "BFloat16":
+	case "int16", "uint16":
+		elemSize = 2
+	case "hwy.Float16", "hwy.BFloat16", "Float16", "BFloat16":
 		// On AVX2/AVX512, half-precision uses promoted float32 storage
 		if t.Name == "AVX2" || t.Name == "AVX512" {
 			elemSize = 4
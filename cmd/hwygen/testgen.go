@@ -0,0 +1,417 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Reference tests and benchmarks (-tests).
+//
+// For each dispatched function hwygen can drive, <prefix>_gen_test.go gets a
+// TestGeneratedXxx that calls the dispatched implementation (whatever target
+// the CPU selects) and the Base fallback on the same inputs, and compares
+// every slice argument and result afterwards, plus a BenchmarkGeneratedXxx.
+// Inputs are random, zero and one-filled slices over sizes around the common
+// lane counts. int parameters are all set to the case size, and slices get
+// size^k elements for k int parameters, so row-major kernels such as
+// MatVec(m, v, out []T, rows, cols int) stay in bounds. Cases where the
+// fallback panics are skipped, so kernels with stricter preconditions don't
+// fail spuriously. Shared helpers go to hwygen_gen_test.go, which every
+// -tests run in a package writes identically.
+
+// testGenHelpersFile is the helper file shared by all generated tests of a package.
+const testGenHelpersFile = "hwygen_gen_test.go"
+
+// testGenElemTypes are the element types the generated tests can fill and compare.
+var testGenElemTypes = map[string]bool{
+	"float32": true, "float64": true, "hwy.Float16": true, "hwy.BFloat16": true,
+	"int8": true, "int16": true, "int32": true, "int64": true, "int": true,
+	"uint8": true, "uint16": true, "uint32": true, "uint64": true, "byte": true,
+}
+
+// testGenArg is one argument of a generated test call.
+type testGenArg struct {
+	name  string // local variable name in the test
+	typ   string // concrete Go type
+	slice bool   // compared after the call
+	size  bool   // int parameter set to the case size
+}
+
+// testGenSignature returns the arguments and result types of pf specialized
+// to elemType, or ok=false if the generated tests can't drive it.
+func testGenSignature(pf ParsedFunc, elemType string) (args []testGenArg, results []string, ok bool) {
+	reserved := map[string]bool{"t": true, "b": true, "tc": true, "rng": true}
+	for _, p := range pf.Params {
+		if p.Name == "" || p.Name == "_" {
+			return nil, nil, false
+		}
+		typ := specializeType(p.Type, pf.TypeParams, elemType)
+		name := p.Name
+		if reserved[name] {
+			name += "Arg"
+		}
+		arg := testGenArg{name: name, typ: typ}
+		switch {
+		case typ == "int" || typ == "int64":
+			arg.size = true
+		case strings.HasPrefix(typ, "[]") && testGenElemTypes[typ[2:]]:
+			arg.slice = true
+		case testGenElemTypes[typ]:
+		default:
+			return nil, nil, false
+		}
+		args = append(args, arg)
+	}
+	for _, r := range pf.Returns {
+		typ := specializeType(r.Type, pf.TypeParams, elemType)
+		if !testGenElemTypes[typ] && typ != "bool" {
+			return nil, nil, false
+		}
+		results = append(results, typ)
+	}
+	return args, results, true
+}
+
+// EmitReferenceTests writes <baseName>_gen_test.go with reference tests and
+// benchmarks for funcs, and the shared helpers to hwygen_gen_test.go.
+func EmitReferenceTests(funcs []ParsedFunc, pkgName, outPath, baseName string) error {
+	var body bytes.Buffer
+	for _, pf := range filterDispatchableFuncs(funcs) {
+		if len(pf.TypeParams) > 1 || hasInterfaceTypeParams(pf.TypeParams) {
+			continue
+		}
+		emitReferenceTest(&body, pf)
+	}
+	if body.Len() == 0 {
+		return nil
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, HeaderNote)
+	fmt.Fprintf(&buf, "package %s\n\n", pkgName)
+	fmt.Fprintf(&buf, "import (\n")
+	fmt.Fprintf(&buf, "\t\"slices\"\n")
+	fmt.Fprintf(&buf, "\t\"testing\"\n\n")
+	fmt.Fprintf(&buf, "\t\"github.com/ajroetker/go-highway/hwy\"\n")
+	fmt.Fprintf(&buf, ")\n\n")
+	buf.Write(body.Bytes())
+	if err := writeGoFile(filepath.Join(outPath, baseName+"_gen_test.go"), buf.Bytes()); err != nil {
+		return fmt.Errorf("write reference tests: %w", err)
+	}
+
+	helpers := HeaderNote + "package " + pkgName + "\n\n" + testGenHelpers
+	if err := writeGoFile(filepath.Join(outPath, testGenHelpersFile), []byte(helpers)); err != nil {
+		return fmt.Errorf("write reference test helpers: %w", err)
+	}
+	return nil
+}
+
+// writeGoFile formats src and writes it to filename.
+func writeGoFile(filename string, src []byte) error {
+	formatted, err := formatAndFixImports(filename, src)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: formatting failed: %v\n", err)
+		formatted = src
+	}
+	return os.WriteFile(filename, formatted, 0644)
+}
+
+// emitReferenceTest writes TestGeneratedXxx and BenchmarkGeneratedXxx for pf,
+// with one subtest per element type.
+func emitReferenceTest(buf *bytes.Buffer, pf ParsedFunc) {
+	isGeneric := len(pf.TypeParams) > 0
+	elemTypes := []string{"float32"}
+	if isGeneric {
+		elemTypes = GetConcreteTypes(pf.TypeParams[0].Constraint)
+	}
+	baseName := pf.Name
+	if pf.Private {
+		baseName = makeUnexported(baseName)
+	}
+
+	var tests, benches bytes.Buffer
+	for _, elemType := range elemTypes {
+		args, results, ok := testGenSignature(pf, elemType)
+		if !ok {
+			continue
+		}
+		dims, needsRand := 0, false
+		for _, arg := range args {
+			if arg.size {
+				dims++
+			} else {
+				needsRand = true
+			}
+		}
+		dims = max(dims, 1)
+		dispatchName := buildDispatchFuncName(pf.Name, elemType, isGeneric, pf.Private)
+		refName := baseName + "_fallback"
+		if elemType != "float32" && isGeneric {
+			refName += "_" + typeNameToSuffix(elemType)
+		}
+
+		var names, refNames []string
+		for _, arg := range args {
+			names = append(names, arg.name)
+			if arg.slice {
+				refNames = append(refNames, arg.name+"Ref")
+			} else {
+				refNames = append(refNames, arg.name)
+			}
+		}
+
+		fmt.Fprintf(&tests, "\tt.Run(%q, func(t *testing.T) {\n", strings.TrimPrefix(elemType, "hwy."))
+		fmt.Fprintf(&tests, "\t\tfor _, tc := range hwygenCases(%d) {\n", dims)
+		if needsRand {
+			fmt.Fprintf(&tests, "\t\t\trng := hwygenRand(tc)\n")
+		}
+		emitTestGenArgs(&tests, "\t\t\t", args)
+		for _, arg := range args {
+			if arg.slice {
+				fmt.Fprintf(&tests, "\t\t\t%sRef := slices.Clone(%s)\n", arg.name, arg.name)
+			}
+		}
+		got, want := "", ""
+		if len(results) > 0 {
+			var gots, wants []string
+			for i, typ := range results {
+				fmt.Fprintf(&tests, "\t\t\tvar got%d, want%d %s\n", i, i, typ)
+				gots = append(gots, fmt.Sprintf("got%d", i))
+				wants = append(wants, fmt.Sprintf("want%d", i))
+			}
+			got, want = strings.Join(gots, ", ")+" = ", strings.Join(wants, ", ")+" = "
+		}
+		fmt.Fprintf(&tests, "\t\t\tif hwygenRun(func() { %s%s(%s) }) != nil {\n", want, refName, strings.Join(refNames, ", "))
+		fmt.Fprintf(&tests, "\t\t\t\tcontinue // inputs outside the kernel's preconditions\n")
+		fmt.Fprintf(&tests, "\t\t\t}\n")
+		fmt.Fprintf(&tests, "\t\t\tif p := hwygenRun(func() { %s%s(%s) }); p != nil {\n", got, dispatchName, strings.Join(names, ", "))
+		fmt.Fprintf(&tests, "\t\t\t\tt.Fatalf(\"%%v: %s panicked: %%v\", tc, p)\n", dispatchName)
+		fmt.Fprintf(&tests, "\t\t\t}\n")
+		for _, arg := range args {
+			if arg.slice {
+				fmt.Fprintf(&tests, "\t\t\thwygenCheck(t, tc, %q, %s, %sRef)\n", arg.name, arg.name, arg.name)
+			}
+		}
+		for i, typ := range results {
+			fmt.Fprintf(&tests, "\t\t\thwygenCheck(t, tc, \"result %d\", []%s{got%d}, []%s{want%d})\n", i, typ, i, typ, i)
+		}
+		fmt.Fprintf(&tests, "\t\t}\n")
+		fmt.Fprintf(&tests, "\t})\n")
+
+		fmt.Fprintf(&benches, "\tb.Run(%q, func(b *testing.B) {\n", strings.TrimPrefix(elemType, "hwy."))
+		fmt.Fprintf(&benches, "\t\ttc := hwygenBenchCase(%d)\n", dims)
+		if needsRand {
+			fmt.Fprintf(&benches, "\t\trng := hwygenRand(tc)\n")
+		}
+		emitTestGenArgs(&benches, "\t\t", args)
+		fmt.Fprintf(&benches, "\t\tif hwygenRun(func() { %s(%s) }) != nil {\n", dispatchName, strings.Join(names, ", "))
+		fmt.Fprintf(&benches, "\t\t\tb.Skip(\"inputs outside the kernel's preconditions\")\n")
+		fmt.Fprintf(&benches, "\t\t}\n")
+		fmt.Fprintf(&benches, "\t\tfor b.Loop() {\n")
+		fmt.Fprintf(&benches, "\t\t\t%s(%s)\n", dispatchName, strings.Join(names, ", "))
+		fmt.Fprintf(&benches, "\t\t}\n")
+		fmt.Fprintf(&benches, "\t})\n")
+	}
+	if tests.Len() == 0 {
+		return
+	}
+
+	name := buildGenericFuncName(pf.Name, false, false)
+	fmt.Fprintf(buf, "// TestGenerated%s compares the dispatched %s with its fallback.\n", name, baseName)
+	fmt.Fprintf(buf, "func TestGenerated%s(t *testing.T) {\n", name)
+	buf.Write(tests.Bytes())
+	fmt.Fprintf(buf, "}\n\n")
+	fmt.Fprintf(buf, "func BenchmarkGenerated%s(b *testing.B) {\n", name)
+	buf.Write(benches.Bytes())
+	fmt.Fprintf(buf, "}\n\n")
+}
+
+// emitTestGenArgs declares the arguments of a generated call for case tc.
+func emitTestGenArgs(buf *bytes.Buffer, indent string, args []testGenArg) {
+	for _, arg := range args {
+		switch {
+		case arg.size && arg.typ == "int":
+			fmt.Fprintf(buf, "%s%s := tc.Size\n", indent, arg.name)
+		case arg.size:
+			fmt.Fprintf(buf, "%s%s := %s(tc.Size)\n", indent, arg.name, arg.typ)
+		case arg.slice:
+			fmt.Fprintf(buf, "%s%s := hwygenSlice[%s](rng, tc)\n", indent, arg.name, arg.typ[2:])
+		default:
+			fmt.Fprintf(buf, "%s%s := hwygenValue[%s](rng, tc)\n", indent, arg.name, arg.typ)
+		}
+	}
+}
+
+// testGenHelpers is the body of hwygen_gen_test.go.
+const testGenHelpers = `import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/ajroetker/go-highway/hwy"
+)
+
+// hwygenCase is one input configuration of the generated reference tests.
+type hwygenCase struct {
+	Size int    // value of int parameters
+	Len  int    // length of slice parameters
+	Fill string // "random", "zero" or "one"
+}
+
+func (tc hwygenCase) String() string {
+	return fmt.Sprintf("size=%d/len=%d/%s", tc.Size, tc.Len, tc.Fill)
+}
+
+// hwygenCases returns the test cases for a function with dims int
+// parameters: sizes around the common lane counts, each with random,
+// zero and one-filled inputs.
+func hwygenCases(dims int) []hwygenCase {
+	sizes := []int{1, 2, 3, 4, 5, 7, 8, 9, 15, 16, 17, 31, 32, 33, 63, 64, 65, 127, 128, 129, 255, 256, 257}
+	switch {
+	case dims == 2:
+		sizes = []int{1, 2, 3, 4, 5, 7, 8, 9, 16, 17, 33}
+	case dims > 2:
+		sizes = []int{1, 2, 3, 5, 8, 9}
+	}
+	var cases []hwygenCase
+	for _, size := range sizes {
+		n := 1
+		for range dims {
+			n *= size
+		}
+		for _, fill := range []string{"random", "zero", "one"} {
+			cases = append(cases, hwygenCase{Size: size, Len: n, Fill: fill})
+		}
+	}
+	return cases
+}
+
+// hwygenBenchCase returns the benchmark input for a function with dims int parameters.
+func hwygenBenchCase(dims int) hwygenCase {
+	size := 4096
+	switch {
+	case dims == 2:
+		size = 64
+	case dims > 2:
+		size = 16
+	}
+	n := 1
+	for range dims {
+		n *= size
+	}
+	return hwygenCase{Size: size, Len: n, Fill: "random"}
+}
+
+func hwygenRand(tc hwygenCase) *rand.Rand {
+	return rand.New(rand.NewPCG(uint64(tc.Len), uint64(tc.Size)))
+}
+
+// hwygenValue returns an input value of type E: uniform in [-1, 1) for
+// floats and in [-64, 64) (or [0, 64) if unsigned) for integers.
+func hwygenValue[E any](rng *rand.Rand, tc hwygenCase) E {
+	var f float64
+	switch tc.Fill {
+	case "random":
+		f = rng.Float64()*2 - 1
+	case "one":
+		f = 1
+	}
+	var v E
+	switch p := any(&v).(type) {
+	case *float32:
+		*p = float32(f)
+	case *float64:
+		*p = f
+	case *hwy.Float16:
+		*p = hwy.Float32ToFloat16(float32(f))
+	case *hwy.BFloat16:
+		*p = hwy.Float32ToBFloat16(float32(f))
+	case *int8:
+		*p = int8(f * 64)
+	case *int16:
+		*p = int16(f * 64)
+	case *int32:
+		*p = int32(f * 64)
+	case *int64:
+		*p = int64(f * 64)
+	case *int:
+		*p = int(f * 64)
+	case *uint8:
+		*p = uint8(math.Abs(f) * 64)
+	case *uint16:
+		*p = uint16(math.Abs(f) * 64)
+	case *uint32:
+		*p = uint32(math.Abs(f) * 64)
+	case *uint64:
+		*p = uint64(math.Abs(f) * 64)
+	}
+	return v
+}
+
+func hwygenSlice[E any](rng *rand.Rand, tc hwygenCase) []E {
+	s := make([]E, tc.Len)
+	for i := range s {
+		s[i] = hwygenValue[E](rng, tc)
+	}
+	return s
+}
+
+// hwygenRun calls fn and returns the value it panicked with, if any.
+func hwygenRun(fn func()) (p any) {
+	defer func() { p = recover() }()
+	fn()
+	return nil
+}
+
+// hwygenClose reports whether got matches want: exactly for integers and
+// booleans, within a relative tolerance for floats (SIMD code may fuse
+// multiply-adds and reorder reductions).
+func hwygenClose(got, want any) bool {
+	var g, w, tol float64
+	switch gv := got.(type) {
+	case float32:
+		g, w, tol = float64(gv), float64(want.(float32)), 1e-4
+	case float64:
+		g, w, tol = gv, want.(float64), 1e-9
+	case hwy.Float16:
+		g, w, tol = float64(gv.Float32()), float64(want.(hwy.Float16).Float32()), 1e-2
+	case hwy.BFloat16:
+		g, w, tol = float64(gv.Float32()), float64(want.(hwy.BFloat16).Float32()), 2e-2
+	default:
+		return got == want
+	}
+	if g == w || (math.IsNaN(g) && math.IsNaN(w)) {
+		return true
+	}
+	return math.Abs(g-w) <= tol*max(1, math.Abs(g), math.Abs(w))
+}
+
+// hwygenCheck reports the first element where got differs from want.
+func hwygenCheck[E any](t *testing.T, tc hwygenCase, name string, got, want []E) {
+	t.Helper()
+	for i := range want {
+		if !hwygenClose(any(got[i]), any(want[i])) {
+			t.Errorf("%v: %s[%d] = %v, want %v", tc, name, i, got[i], want[i])
+			return
+		}
+	}
+}
+`
@@ -19,6 +19,7 @@ import (
 	"go/ast"
 	"go/token"
 	"maps"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -103,6 +104,7 @@ type TransformOptions struct {
 	Imports            map[string]string      // map[local_name]import_path for resolving package references
 	AllFuncs           map[string]*ParsedFunc // All functions in file for inlining helpers
 	SkipHalfPrecNEON   bool                   // Skip NEON asm specialization for this half-precision function
+	Features           []string               // Runtime-detected CPU features to assume present (see runtimeFeatures)
 }
 
 // Transform transforms a parsed function for a specific target and element type.
@@ -121,7 +123,7 @@ func TransformWithOptions(pf *ParsedFunc, target Target, elemType string, opts *
 	// We need to do this BEFORE cloning because the original AST has valid positions.
 	var filteredBody *ast.BlockStmt
 	if len(opts.ConditionalBlocks) > 0 && opts.FileSet != nil {
-		filteredBody = filterConditionalBlocks(pf.Body, opts.ConditionalBlocks, opts.FileSet, target.Name, elemType)
+		filteredBody = filterConditionalBlocks(pf.Body, opts.ConditionalBlocks, opts.FileSet, target.Name, elemType, opts.Features)
 	} else {
 		filteredBody = pf.Body
 	}
@@ -182,6 +184,7 @@ func TransformWithOptions(pf *ParsedFunc, target Target, elemType string, opts *
 		varVecElemType:          make(map[string]string),
 		allFuncs:                opts.AllFuncs,
 		skipHalfPrecNEON:        opts.SkipHalfPrecNEON,
+		typeBindings:            typeParamBindings(pf.TypeParams, elemType),
 	}
 
 	// Add function parameters to localVars to prevent them from being hoisted
@@ -212,6 +215,10 @@ func TransformWithOptions(pf *ParsedFunc, target Target, elemType string, opts *
 	// Collect all locally-defined variable names to avoid hoisting them as constants
 	collectLocalVariables(funcDecl.Body, ctx)
 
+	// Inline calls to small pure helpers that work on vectors, so their hwy
+	// operations are specialized along with the caller.
+	inlineHelperExprs(funcDecl.Body, ctx.allFuncs, usesHwy, func(name string) bool { return ctx.localVars[name] })
+
 	// Pre-scan for Load sizes to determine inferredFuncLanes before processing Set calls.
 	// This ensures hoisted constants match the actual vector width used by Load operations.
 	if loadSize := findMaxLoadSizeForElemType(funcDecl.Body, elemType); loadSize > 0 {
@@ -243,6 +250,18 @@ func TransformWithOptions(pf *ParsedFunc, target Target, elemType string, opts *
 		}
 	}
 
+	// Synthesize the remainder of a main SIMD loop the author left without one.
+	// The scalarized fallback already steps one element at a time; other
+	// fallback code finishes with a masked pass over the loop body.
+	tail := findTailLoop(pf)
+	if tail != nil && target.Name == "Fallback" && !wasScalarized {
+		tailBlock := &ast.BlockStmt{List: []ast.Stmt{tail.maskedTailStmt(parseTypeExpr(elemType))}}
+		transformIdentifiers(tailBlock, ctx)
+		transformNode(tailBlock, ctx)
+		hoistLoopIterator(funcDecl.Body, tail.iter)
+		funcDecl.Body.List = append(funcDecl.Body.List, tailBlock.List...)
+	}
+
 	// Post-process: convert "_ = expr" assignments to expression statements.
 	// This is needed because tryTransformToInPlace marks in-place ops with _ = voidFunc()
 	// which is invalid Go when the function returns nothing (e.g., MulAddAcc).
@@ -274,6 +293,11 @@ func TransformWithOptions(pf *ParsedFunc, target Target, elemType string, opts *
 	// Apply loop unrolling if there's a SIMD loop (not for fallback)
 	if pf.LoopInfo != nil && target.Name != "Fallback" {
 		lanes := target.LanesFor(elemType)
+		if n := constStrideLanes(funcDecl.Body, pf.LoopInfo.Stride); n > 0 {
+			// The stride may count lanes of another type, e.g. lanes := hwy.MaxLanes[uint16]()
+			// in a kernel reading []uint8.
+			lanes = n
+		}
 		unrollFactor := computeUnrollFactor(pf.LoopInfo, pf.HwyCalls, target)
 		if unrollFactor > 1 {
 			// Find the main SIMD loop and unroll it
@@ -286,7 +310,7 @@ func TransformWithOptions(pf *ParsedFunc, target Target, elemType string, opts *
 	// Insert tail handling if there's a loop and function doesn't return a value
 	// (functions that return values have their own tail handling in the template)
 	if pf.LoopInfo != nil && len(pf.Returns) == 0 {
-		insertTailHandling(funcDecl.Body, pf.LoopInfo, elemType, target, pf.Name, pf.Params, pf.TypeParams)
+		insertTailHandling(funcDecl.Body, pf.LoopInfo, elemType, target, pf.Name, pf.Params, pf.TypeParams, tail)
 	}
 
 	// Collect hoisted constants in deterministic order
@@ -386,16 +410,10 @@ func computeUnrollFactor(loopInfo *LoopInfo, hwyCalls []HwyCall, target Target)
 		return 1
 	}
 
-	// Honor explicit //hwy:unroll directive
+	// Honor explicit //hwy:unroll directive (//hwy:unroll 0 or 1 disables unrolling)
 	if loopInfo.UnrollHint > 0 {
 		return loopInfo.UnrollHint
 	}
-	// //hwy:unroll 0 or //hwy:unroll 1 disables unrolling
-	if loopInfo.UnrollHint == 0 {
-		// No directive - use automatic heuristics
-	} else {
-		return 1 // Explicit disable
-	}
 
 	// Analyze operation complexity
 	complexity := analyzeLoopComplexity(hwyCalls)
@@ -767,6 +785,7 @@ type transformContext struct {
 	allFuncs                map[string]*ParsedFunc        // All functions in file for inlining helpers
 	inlineCounter           int                           // Counter for unique variable naming during inlining
 	skipHalfPrecNEON        bool                          // Skip NEON asm specialization for half-precision (use generic hwy.Vec[T] path)
+	typeBindings            map[string]string             // map[type_param]concrete type, including paired params like U
 }
 
 // vecLoadInfo contains inferred information from an hwy.Load call.
@@ -960,6 +979,10 @@ func collectLocalVariables(node ast.Node, ctx *transformContext) {
 								if ctx.inferredFuncLanes == 0 {
 									ctx.inferredFuncLanes = loadInfo.lanes
 								}
+							} else if elemType := inferVecElemType(stmt.Rhs[i], ctx); elemType != "" {
+								// Full vectors of another lane type, e.g. a U accumulator
+								ctx.varVecLanes[ident.Name] = ctx.target.LanesFor(elemType)
+								ctx.varVecElemType[ident.Name] = elemType
 							}
 						}
 					}
@@ -1152,6 +1175,9 @@ func transformTypeConversion(call *ast.CallExpr, ctx *transformContext) {
 		if ident.Name == tp.Name {
 			// Replace T with the concrete element type
 			ident.Name = ctx.elemType
+			if concrete := ctx.typeBindings[tp.Name]; concrete != "" {
+				ident.Name = concrete
+			}
 			return
 		}
 	}
@@ -1471,6 +1497,18 @@ func transformCallExpr(call *ast.CallExpr, ctx *transformContext) {
 					call.Args = nil
 				}
 				return
+			case "PromoteLowerTo", "PromoteUpperTo":
+				// hwy.PromoteLowerTo[int32](v) with v an Int16x16 ->
+				// hwy.PromoteLowerI16ToI32, transformed below
+				if dst, ok := fun.Index.(*ast.Ident); ok && len(call.Args) == 1 {
+					src := inferVecElemType(call.Args[0], ctx)
+					if src == "" {
+						src = ctx.elemType
+					}
+					selExpr.Sel.Name = strings.TrimSuffix(funcName, "To") +
+						laneTypeAbbrev(src) + "To" + laneTypeAbbrev(dst.Name)
+				}
+				call.Fun = selExpr
 			default:
 				// Strip the type param (will be transformed later)
 				// BUT preserve IndexExpr if we have an explicit concrete type param
@@ -2546,7 +2584,11 @@ func transformToMethod(call *ast.CallExpr, funcName string, opInfo OpInfo, ctx *
 		// hwy.StoreSlice(v, dst) -> v.Store((*[8]float32)(unsafe.Pointer(&dst[0])))
 		if len(call.Args) >= 2 {
 			methodName := "Store"
-			lanes := ctx.target.LanesFor(ctx.elemType)
+			storeElemType := ctx.elemType
+			if elemType := paramSliceElemType(call.Args[1], ctx); elemType != "" {
+				storeElemType = elemType
+			}
+			lanes := ctx.target.LanesFor(storeElemType)
 
 			// unsafe.Pointer(&dst[idx]) - optimized to avoid &dst[i:][0]
 			dst := call.Args[1]
@@ -2565,7 +2607,7 @@ func transformToMethod(call *ast.CallExpr, funcName string, opInfo OpInfo, ctx *
 					X: &ast.StarExpr{
 						X: &ast.ArrayType{
 							Len: &ast.BasicLit{Kind: token.INT, Value: strconv.Itoa(lanes)},
-							Elt: ast.NewIdent(ctx.elemType),
+							Elt: ast.NewIdent(storeElemType),
 						},
 					},
 				},
@@ -3969,13 +4011,14 @@ func transformToFunction(call *ast.CallExpr, funcName string, opInfo OpInfo, ctx
 				Index: ast.NewIdent(ctx.elemType),
 			}
 			return
-		case "InterleaveLower", "InterleaveUpper":
+		case "InterleaveLower", "InterleaveUpper", "ZipLower", "ZipUpper":
 			// For NEON or AVX promoted with half-precision types: hwy.InterleaveLower(a, b) -> a.InterleaveLower(b)
+			// ZipLower/ZipUpper are aliases and lower to the same methods.
 			if len(call.Args) >= 2 {
 				if (ctx.target.Name == "NEON" && !ctx.skipHalfPrecNEON && isHalfPrecisionType(ctx.elemType)) || isAVXPromotedHalfPrec(ctx.target, ctx.elemType) {
 					call.Fun = &ast.SelectorExpr{
 						X:   call.Args[0],
-						Sel: ast.NewIdent(funcName),
+						Sel: ast.NewIdent(strings.Replace(funcName, "Zip", "Interleave", 1)),
 					}
 					call.Args = call.Args[1:]
 					return
@@ -4060,6 +4103,11 @@ func transformToFunction(call *ast.CallExpr, funcName string, opInfo OpInfo, ctx
 
 	// For SIMD targets, transform to package calls (archsimd for AVX, asm for NEON)
 	var fullName string
+	// Loads from a slice parameter of another lane type (e.g. the []uint8 input
+	// of a kernel widening into []uint16) load that type.
+	if explicitTypeParam == "" && (funcName == "Load" || funcName == "LoadSlice") && len(call.Args) > 0 {
+		explicitTypeParam = paramSliceElemType(call.Args[0], ctx)
+	}
 	// Determine element type: explicit type param > function's default
 	effectiveElemType := ctx.elemType
 	if explicitTypeParam != "" {
@@ -4087,7 +4135,10 @@ func transformToFunction(call *ast.CallExpr, funcName string, opInfo OpInfo, ctx
 				}
 			}
 		}
-		if inferredLanes > 0 {
+		if src := promoteSourceType(opInfo.Name); src != "" {
+			// Widening ops are named after their narrow input vector.
+			shortTypeName = getShortTypeName(src, ctx.target)
+		} else if inferredLanes > 0 {
 			shortTypeName = getShortTypeNameForLanes(inferredElemType, inferredLanes)
 		} else if ctx.inferredFuncLanes > 0 {
 			// Fall back to function-level inferred lanes (from Load calls)
@@ -4689,6 +4740,178 @@ func getShortTypeName(elemType string, target Target) string {
 	return getShortTypeNameForLanes(elemType, lanes)
 }
 
+// constStrideLanes returns the constant a loop stride variable was set to
+// (lanes := 16 after MaxLanes specialization), or 0 if it has none.
+func constStrideLanes(body *ast.BlockStmt, stride string) int {
+	for _, stmt := range body.List {
+		assign, ok := stmt.(*ast.AssignStmt)
+		if !ok || assign.Tok != token.DEFINE || len(assign.Lhs) != 1 || len(assign.Rhs) != 1 || !isIdentNamed(assign.Lhs[0], stride) {
+			continue
+		}
+		if lit, ok := assign.Rhs[0].(*ast.BasicLit); ok && lit.Kind == token.INT {
+			n, _ := strconv.Atoi(lit.Value)
+			return n
+		}
+	}
+	return 0
+}
+
+// paramSliceElemType returns the element type of a slice parameter used as
+// s or s[lo:hi] when it is a concrete lane type other than the function's
+// element type, as in kernels that widen []uint8 into []uint16. It returns ""
+// otherwise.
+func paramSliceElemType(expr ast.Expr, ctx *transformContext) string {
+	if slice, ok := expr.(*ast.SliceExpr); ok {
+		expr = slice.X
+	}
+	ident, ok := expr.(*ast.Ident)
+	if !ok {
+		return ""
+	}
+	elemType, ok := strings.CutPrefix(ctx.varTypes[ident.Name], "[]")
+	if concrete := ctx.typeBindings[elemType]; concrete != "" {
+		elemType = concrete
+	}
+	if !ok || !laneTypes[elemType] || elemType == ctx.elemType {
+		return ""
+	}
+	return elemType
+}
+
+// promoteSourceType returns the input lane type of an 8/16-bit widening op
+// such as PromoteLowerU8ToU16 or SumOfMulQuadAccumulateI8ToI32 ("uint8",
+// "int8"), or "" for other ops.
+func promoteSourceType(opName string) string {
+	src, _ := promoteLaneTypes(opName)
+	if src == "int8" || src == "uint8" || src == "int16" || src == "uint16" {
+		return src
+	}
+	return ""
+}
+
+// wideningOpPrefixes are the prefixes of the widening ops whose names end in
+// their input and output lane types, longest match first.
+var wideningOpPrefixes = []string{
+	"PromoteLower", "PromoteUpper", "Promote",
+	"WidenMulPairwiseAdd", "ReorderWidenMulAccumulate", "SumOfMulQuadAccumulate",
+}
+
+// promoteLaneTypes returns the input and output lane types of a widening op
+// such as PromoteLowerI16ToI32, PromoteI8ToI32 or
+// SumOfMulQuadAccumulateI8ToI32, e.g. ("int16", "int32") for
+// PromoteLowerI16ToI32.
+func promoteLaneTypes(opName string) (src, dst string) {
+	for _, prefix := range wideningOpPrefixes {
+		rest, ok := strings.CutPrefix(opName, prefix)
+		if !ok {
+			continue
+		}
+		from, to, ok := strings.Cut(rest, "To")
+		if !ok {
+			return "", ""
+		}
+		return shortLaneTypes[from], shortLaneTypes[to]
+	}
+	return "", ""
+}
+
+// shortLaneTypes maps the lane abbreviations used in hwy op names to types.
+var shortLaneTypes = map[string]string{
+	"I8": "int8", "I16": "int16", "I32": "int32", "I64": "int64",
+	"U8": "uint8", "U16": "uint16", "U32": "uint32", "U64": "uint64",
+	"F32": "float32", "F64": "float64",
+}
+
+// laneTypeAbbrev returns the abbreviation of a lane type used in hwy op
+// names, e.g. "I16" for int16.
+func laneTypeAbbrev(elemType string) string {
+	for abbrev, t := range shortLaneTypes {
+		if t == elemType {
+			return abbrev
+		}
+	}
+	return ""
+}
+
+// laneTypePreservingOps are hwy ops whose result has the lane type of their
+// vector arguments.
+var laneTypePreservingOps = map[string]bool{
+	"Add": true, "Sub": true, "Mul": true, "Neg": true, "Abs": true,
+	"Min": true, "Max": true, "MulAdd": true, "FMA": true,
+	"And": true, "Or": true, "Xor": true, "AndNot": true,
+	"RearrangeToOddPlusEvenI32": true,
+}
+
+// inferVecElemType returns the lane type of a vector expression when it is
+// known to differ from ctx.elemType: a hwy.Zero[U]/hwy.Set[U] of a paired
+// type parameter, a load from a slice parameter of another lane type, a
+// widening op, or a lane-preserving op over such vectors. It returns "" when
+// the expression has the function's own element type or is not recognized.
+func inferVecElemType(expr ast.Expr, ctx *transformContext) string {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		return ctx.varVecElemType[e.Name]
+	case *ast.ParenExpr:
+		return inferVecElemType(e.X, ctx)
+	case *ast.CallExpr:
+		var opName string
+		var typeArg ast.Expr
+		switch fun := e.Fun.(type) {
+		case *ast.IndexExpr:
+			sel, ok := fun.X.(*ast.SelectorExpr)
+			if !ok {
+				return ""
+			}
+			if pkg, ok := sel.X.(*ast.Ident); !ok || pkg.Name != "hwy" {
+				return ""
+			}
+			opName, typeArg = sel.Sel.Name, fun.Index
+		case *ast.SelectorExpr:
+			opName = fun.Sel.Name
+			if pkg, ok := fun.X.(*ast.Ident); !ok || pkg.Name != "hwy" {
+				// Method call such as a.Add(b): the receiver decides.
+				if laneTypePreservingOps[opName] {
+					return inferVecElemType(fun.X, ctx)
+				}
+				return ""
+			}
+		default:
+			return ""
+		}
+		switch opName {
+		case "Zero", "Set", "Const", "Load", "LoadSlice", "PromoteLowerTo", "PromoteUpperTo":
+			if ident, ok := typeArg.(*ast.Ident); ok {
+				elemType := ident.Name
+				if concrete := ctx.typeBindings[elemType]; concrete != "" {
+					elemType = concrete
+				}
+				if laneTypes[elemType] && elemType != ctx.elemType {
+					return elemType
+				}
+				return ""
+			}
+			if (opName == "Load" || opName == "LoadSlice") && len(e.Args) > 0 {
+				return paramSliceElemType(e.Args[0], ctx)
+			}
+			return ""
+		}
+		if _, dst := promoteLaneTypes(opName); dst != "" {
+			if dst == ctx.elemType {
+				return ""
+			}
+			return dst
+		}
+		if laneTypePreservingOps[opName] {
+			for _, arg := range e.Args {
+				if elemType := inferVecElemType(arg, ctx); elemType != "" {
+					return elemType
+				}
+			}
+		}
+	}
+	return ""
+}
+
 // getShortTypeNameForLanes returns the short type name for a specific lane count.
 func getShortTypeNameForLanes(elemType string, lanes int) string {
 	switch elemType {
@@ -4700,6 +4923,10 @@ func getShortTypeNameForLanes(elemType string, lanes int) string {
 		return fmt.Sprintf("I32x%d", lanes)
 	case "int64":
 		return fmt.Sprintf("I64x%d", lanes)
+	case "int8":
+		return fmt.Sprintf("Int8x%d", lanes)
+	case "int16":
+		return fmt.Sprintf("Int16x%d", lanes)
 	case "uint8":
 		return fmt.Sprintf("Uint8x%d", lanes)
 	case "uint16":
@@ -5366,7 +5593,7 @@ func matchesLoopIterator(forStmt *ast.ForStmt, iteratorName string) bool {
 }
 
 // insertTailHandling adds scalar tail handling after the vectorized loop.
-func insertTailHandling(body *ast.BlockStmt, loopInfo *LoopInfo, elemType string, target Target, funcName string, params []Param, typeParams []TypeParam) {
+func insertTailHandling(body *ast.BlockStmt, loopInfo *LoopInfo, elemType string, target Target, funcName string, params []Param, typeParams []TypeParam, tail *tailLoop) {
 	if body == nil || loopInfo == nil {
 		return
 	}
@@ -5376,6 +5603,29 @@ func insertTailHandling(body *ast.BlockStmt, loopInfo *LoopInfo, elemType string
 		return
 	}
 
+	fallbackFuncName := funcName + "_fallback"
+	// Add type suffix for non-float32 types only for generic functions
+	// (matches how generator.go names functions in generator.go:100-102)
+	if elemType != "float32" && len(typeParams) > 0 {
+		fallbackFuncName = fallbackFuncName + "_" + typeNameToSuffix(elemType)
+	}
+
+	// The author wrote no remainder handling at all (see findTailLoop):
+	// finish with the fallback on the remaining sub-slices, after any
+	// unrolled and cleanup loops.
+	//   if ii < size { BaseFoo_fallback(in[ii:size], out[ii:size], size-ii) }
+	if tail != nil {
+		hoistLoopIterator(body, tail.iter)
+		body.List = append(body.List, &ast.IfStmt{
+			Cond: &ast.BinaryExpr{X: ast.NewIdent(tail.iter), Op: token.LSS, Y: cloneExpr(tail.end)},
+			Body: &ast.BlockStmt{List: []ast.Stmt{&ast.ExprStmt{X: &ast.CallExpr{
+				Fun:  ast.NewIdent(fallbackFuncName),
+				Args: tail.fallbackTailArgs(params),
+			}}}},
+		})
+		return
+	}
+
 	// Count SIMD loops that use the same iterator. If there are multiple SIMD loops,
 	// the function has a multi-phase algorithm (e.g., Normalize: accumulate then scale)
 	// and automatic tail handling would break the data dependencies between phases.
@@ -5424,12 +5674,6 @@ func insertTailHandling(body *ast.BlockStmt, loopInfo *LoopInfo, elemType string
 	// if ii < size {
 	//     BaseSigmoid_fallback(in[ii:size], out[ii:size])
 	// }
-	fallbackFuncName := funcName + "_fallback"
-	// Add type suffix for non-float32 types only for generic functions
-	// (matches how generator.go names functions in generator.go:100-102)
-	if elemType != "float32" && len(typeParams) > 0 {
-		fallbackFuncName = fallbackFuncName + "_" + typeNameToSuffix(elemType)
-	}
 
 	// Build arguments for the fallback call
 	// For slice parameters: param[ii:size]
@@ -5497,6 +5741,24 @@ func insertTailHandling(body *ast.BlockStmt, loopInfo *LoopInfo, elemType string
 	body.List = newStmts
 }
 
+// hoistLoopIterator moves the init statement of the first loop over iter in
+// body ("for ii := 0; ..." becomes "ii := 0; for ; ...") so the iterator
+// stays in scope after the loop.
+func hoistLoopIterator(body *ast.BlockStmt, iter string) {
+	for i, stmt := range body.List {
+		forStmt, ok := stmt.(*ast.ForStmt)
+		if !ok || !matchesLoopIterator(forStmt, iter) {
+			continue
+		}
+		if forStmt.Init != nil {
+			init := forStmt.Init
+			forStmt.Init = nil
+			body.List = slices.Insert(body.List, i, init)
+		}
+		return
+	}
+}
+
 // isScalarTailLoop checks if a statement is a scalar tail loop that should be
 // replaced by the fallback call. A scalar tail loop has the form:
 //
@@ -5731,11 +5993,7 @@ func specializeType(typeStr string, typeParams []TypeParam, elemType string) str
 
 	for _, tp := range typeParams {
 		// Element type constraints
-		if strings.Contains(tp.Constraint, "Lanes") ||
-			strings.Contains(tp.Constraint, "Floats") ||
-			strings.Contains(tp.Constraint, "Integers") ||
-			strings.Contains(tp.Constraint, "SignedInts") ||
-			strings.Contains(tp.Constraint, "UnsignedInts") {
+		if isElemTypeConstraint(tp.Constraint) {
 			elementTypeParams[tp.Name] = true
 		} else {
 			// Interface constraint (like Predicate[T])
@@ -5744,16 +6002,18 @@ func specializeType(typeStr string, typeParams []TypeParam, elemType string) str
 	}
 
 	// Replace element type parameters and hwy.Vec[T]/hwy.Mask[T]
+	bindings := typeParamBindings(typeParams, elemType)
 	for _, tp := range typeParams {
 		if elementTypeParams[tp.Name] {
+			concrete := bindings[tp.Name]
 			// Replace hwy.Vec[T] with concrete vector type placeholder
-			typeStr = strings.ReplaceAll(typeStr, "hwy.Vec["+tp.Name+"]", "hwy.Vec["+elemType+"]")
+			typeStr = strings.ReplaceAll(typeStr, "hwy.Vec["+tp.Name+"]", "hwy.Vec["+concrete+"]")
 			// Replace hwy.Mask[T] with concrete mask type placeholder
-			typeStr = strings.ReplaceAll(typeStr, "hwy.Mask["+tp.Name+"]", "hwy.Mask["+elemType+"]")
+			typeStr = strings.ReplaceAll(typeStr, "hwy.Mask["+tp.Name+"]", "hwy.Mask["+concrete+"]")
 			// Replace []T with []float32, etc.
-			typeStr = strings.ReplaceAll(typeStr, "[]"+tp.Name, "[]"+elemType)
+			typeStr = strings.ReplaceAll(typeStr, "[]"+tp.Name, "[]"+concrete)
 			// Replace standalone T with concrete type
-			typeStr = replaceTypeParam(typeStr, tp.Name, elemType)
+			typeStr = replaceTypeParam(typeStr, tp.Name, concrete)
 		}
 	}
 
@@ -5766,7 +6026,7 @@ func specializeType(typeStr string, typeParams []TypeParam, elemType string) str
 			specializedConstraint := constraint
 			for _, tp := range typeParams {
 				if elementTypeParams[tp.Name] {
-					specializedConstraint = strings.ReplaceAll(specializedConstraint, "["+tp.Name+"]", "["+elemType+"]")
+					specializedConstraint = strings.ReplaceAll(specializedConstraint, "["+tp.Name+"]", "["+bindings[tp.Name]+"]")
 				}
 			}
 			typeStr = specializedConstraint
@@ -6033,6 +6293,10 @@ func getMaskTypeName(elemType string, target Target) string {
 			return fmt.Sprintf("Mask32x%d", lanes)
 		case "float64", "int64", "uint64":
 			return fmt.Sprintf("Mask64x%d", lanes)
+		case "int8", "uint8":
+			return fmt.Sprintf("Mask8x%d", lanes)
+		case "int16", "uint16":
+			return fmt.Sprintf("Mask16x%d", lanes)
 		default:
 			// Half-precision on AVX uses float32 promoted storage, so Mask32xN
 			if isHalfPrecisionType(elemType) {
@@ -6051,6 +6315,10 @@ func getMaskTypeName(elemType string, target Target) string {
 		return fmt.Sprintf("Int32x%d", lanes)
 	case "int64", "uint64":
 		return fmt.Sprintf("Int64x%d", lanes)
+	case "uint8":
+		return fmt.Sprintf("Uint8x%d", lanes)
+	case "uint16":
+		return fmt.Sprintf("Uint16x%d", lanes)
 	default:
 		return ""
 	}
@@ -6073,6 +6341,10 @@ func getVectorTypeNameForLanes(elemType string, lanes int) string {
 		return fmt.Sprintf("Int32x%d", lanes)
 	case "int64":
 		return fmt.Sprintf("Int64x%d", lanes)
+	case "int8":
+		return fmt.Sprintf("Int8x%d", lanes)
+	case "int16":
+		return fmt.Sprintf("Int16x%d", lanes)
 	case "uint8":
 		return fmt.Sprintf("Uint8x%d", lanes)
 	case "uint16":
@@ -6934,9 +7206,10 @@ func createReduceSumExpr(call *ast.CallExpr, lanes int, vecTypeName, elemType st
 }
 
 // filterConditionalBlocks filters statements based on //hwy:if, //hwy:else, //hwy:endif directives.
-// It returns a new BlockStmt with only the statements that match the current target and element type.
+// It returns a new BlockStmt with only the statements that match the current target and element type,
+// with the given runtime-detected features present.
 // The original AST is not modified.
-func filterConditionalBlocks(body *ast.BlockStmt, blocks []ConditionalBlock, fset *token.FileSet, targetName, elemType string) *ast.BlockStmt {
+func filterConditionalBlocks(body *ast.BlockStmt, blocks []ConditionalBlock, fset *token.FileSet, targetName, elemType string, features []string) *ast.BlockStmt {
 	if body == nil || len(blocks) == 0 {
 		return body
 	}
@@ -6949,14 +7222,19 @@ func filterConditionalBlocks(body *ast.BlockStmt, blocks []ConditionalBlock, fse
 
 	for _, stmt := range body.List {
 		// Get the line number of this statement
-		stmtLine := fset.Position(stmt.Pos()).Line
+		stmtPos := fset.Position(stmt.Pos())
+		stmtLine := stmtPos.Line
 
 		// Check if this statement is within any conditional block
 		included := true
 		for _, block := range blocks {
+			if block.File != "" && block.File != stmtPos.Filename {
+				// Directive from another file of the package
+				continue
+			}
 			if stmtLine > block.StartLine && stmtLine < block.EndLine {
 				// Statement is within this conditional block
-				conditionMatches := block.ParsedCondition.Evaluate(targetName, elemType)
+				conditionMatches := block.ParsedCondition.EvaluateFeatures(targetName, elemType, features)
 
 				if block.ElseLine > 0 {
 					// Block has an else clause
@@ -6977,7 +7255,7 @@ func filterConditionalBlocks(body *ast.BlockStmt, blocks []ConditionalBlock, fse
 
 		if included {
 			// Recursively filter nested blocks (e.g., for statements, if statements)
-			filteredStmt := filterNestedConditionalBlocks(stmt, blocks, fset, targetName, elemType)
+			filteredStmt := filterNestedConditionalBlocks(stmt, blocks, fset, targetName, elemType, features)
 			newBody.List = append(newBody.List, filteredStmt)
 		}
 	}
@@ -6986,47 +7264,47 @@ func filterConditionalBlocks(body *ast.BlockStmt, blocks []ConditionalBlock, fse
 }
 
 // filterNestedConditionalBlocks recursively filters conditional blocks within nested statements.
-func filterNestedConditionalBlocks(stmt ast.Stmt, blocks []ConditionalBlock, fset *token.FileSet, targetName, elemType string) ast.Stmt {
+func filterNestedConditionalBlocks(stmt ast.Stmt, blocks []ConditionalBlock, fset *token.FileSet, targetName, elemType string, features []string) ast.Stmt {
 	switch s := stmt.(type) {
 	case *ast.BlockStmt:
-		return filterConditionalBlocks(s, blocks, fset, targetName, elemType)
+		return filterConditionalBlocks(s, blocks, fset, targetName, elemType, features)
 	case *ast.IfStmt:
 		newIf := *s // shallow copy
 		if s.Body != nil {
-			newIf.Body = filterConditionalBlocks(s.Body, blocks, fset, targetName, elemType)
+			newIf.Body = filterConditionalBlocks(s.Body, blocks, fset, targetName, elemType, features)
 		}
 		if s.Else != nil {
-			newIf.Else = filterNestedConditionalBlocks(s.Else, blocks, fset, targetName, elemType)
+			newIf.Else = filterNestedConditionalBlocks(s.Else, blocks, fset, targetName, elemType, features)
 		}
 		return &newIf
 	case *ast.ForStmt:
 		newFor := *s // shallow copy
 		if s.Body != nil {
-			newFor.Body = filterConditionalBlocks(s.Body, blocks, fset, targetName, elemType)
+			newFor.Body = filterConditionalBlocks(s.Body, blocks, fset, targetName, elemType, features)
 		}
 		return &newFor
 	case *ast.RangeStmt:
 		newRange := *s // shallow copy
 		if s.Body != nil {
-			newRange.Body = filterConditionalBlocks(s.Body, blocks, fset, targetName, elemType)
+			newRange.Body = filterConditionalBlocks(s.Body, blocks, fset, targetName, elemType, features)
 		}
 		return &newRange
 	case *ast.SwitchStmt:
 		newSwitch := *s // shallow copy
 		if s.Body != nil {
-			newSwitch.Body = filterConditionalBlocks(s.Body, blocks, fset, targetName, elemType)
+			newSwitch.Body = filterConditionalBlocks(s.Body, blocks, fset, targetName, elemType, features)
 		}
 		return &newSwitch
 	case *ast.TypeSwitchStmt:
 		newSwitch := *s // shallow copy
 		if s.Body != nil {
-			newSwitch.Body = filterConditionalBlocks(s.Body, blocks, fset, targetName, elemType)
+			newSwitch.Body = filterConditionalBlocks(s.Body, blocks, fset, targetName, elemType, features)
 		}
 		return &newSwitch
 	case *ast.SelectStmt:
 		newSelect := *s // shallow copy
 		if s.Body != nil {
-			newSelect.Body = filterConditionalBlocks(s.Body, blocks, fset, targetName, elemType)
+			newSelect.Body = filterConditionalBlocks(s.Body, blocks, fset, targetName, elemType, features)
 		}
 		return &newSelect
 	default:
@@ -7096,6 +7374,9 @@ func transformIdentifiers(node ast.Node, ctx *transformContext) {
 			for _, tp := range ctx.typeParams {
 				if expr.Name == tp.Name {
 					expr.Name = ctx.elemType
+					if concrete := ctx.typeBindings[tp.Name]; concrete != "" {
+						expr.Name = concrete
+					}
 					return true
 				}
 			}
@@ -7236,11 +7517,7 @@ func transformFuncRefArgs(call *ast.CallExpr, ctx *transformContext) {
 func hasPredicateParam(pf *ParsedFunc) bool {
 	for _, tp := range pf.TypeParams {
 		// Skip element type constraints
-		if strings.Contains(tp.Constraint, "Lanes") ||
-			strings.Contains(tp.Constraint, "Floats") ||
-			strings.Contains(tp.Constraint, "Integers") ||
-			strings.Contains(tp.Constraint, "SignedInts") ||
-			strings.Contains(tp.Constraint, "UnsignedInts") {
+		if isElemTypeConstraint(tp.Constraint) {
 			continue
 		}
 		// This is likely a predicate or other interface type param
@@ -7608,6 +7885,11 @@ func inlineHelper(helper *ParsedFunc, call *ast.CallExpr, ctx *transformContext)
 	// Substitute parameters and rename local variables
 	substituteAndRename(clonedBody, paramMap, localVars, suffix)
 
+	// Inline pure vector helpers called from the helper
+	inlineHelperExprs(clonedBody, ctx.allFuncs, usesHwy, func(name string) bool {
+		return ctx.localVars[name] || localVars[strings.TrimSuffix(name, suffix)]
+	})
+
 	// Now transform the cloned body for the current target/elemType
 	// Create a mini-context for transforming the helper
 	helperCtx := &transformContext{
@@ -7631,6 +7913,7 @@ func inlineHelper(helper *ParsedFunc, call *ast.CallExpr, ctx *transformContext)
 		varVecElemType:          make(map[string]string),
 		allFuncs:                ctx.allFuncs,
 		inlineCounter:           ctx.inlineCounter,
+		typeBindings:            typeParamBindings(helper.TypeParams, ctx.elemType),
 	}
 
 	// Copy relevant tracking from parent context
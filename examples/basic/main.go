@@ -130,5 +130,37 @@ func main() {
 
 	fmt.Printf("  %v + %v = %v\n\n", intData1, intData2, intResult)
 
+	// Example 7: Unrolled and parallel loop drivers
+	fmt.Println("7. Unrolled + Parallel Scaling:")
+	big := make([]float32, 10000)
+	for i := range big {
+		big[i] = float32(i)
+	}
+	scaled := make([]float32, len(big))
+	lanes := hwy.MaxLanes[float32]()
+	scale := hwy.Set[float32](0.5)
+
+	hwy.ProcessParallel[float32](len(big), 2048, func(start, end int) {
+		src, dst := big[start:end], scaled[start:end]
+		hwy.ProcessUnrolled4[float32](len(src),
+			func(offset int) {
+				v0, v1, v2, v3 := hwy.Load4(src[offset:])
+				hwy.Store(hwy.Mul(v0, scale), dst[offset:])
+				hwy.Store(hwy.Mul(v1, scale), dst[offset+lanes:])
+				hwy.Store(hwy.Mul(v2, scale), dst[offset+2*lanes:])
+				hwy.Store(hwy.Mul(v3, scale), dst[offset+3*lanes:])
+			},
+			func(offset int) {
+				hwy.Store(hwy.Mul(hwy.Load(src[offset:]), scale), dst[offset:])
+			},
+			func(offset, count int) {
+				mask := hwy.TailMask[float32](count)
+				hwy.MaskStore(mask, hwy.Mul(hwy.MaskLoad(mask, src[offset:]), scale), dst[offset:])
+			},
+		)
+	})
+
+	fmt.Printf("  0.5 * [0..%d): first=%v last=%v\n\n", len(big), scaled[:4], scaled[len(scaled)-1])
+
 	fmt.Println("=== Example Complete ===")
 }
@@ -67,11 +67,11 @@ func init() {
 		initGeluFallback()
 		return
 	}
-	if archsimd.X86.AVX512() {
+	if archsimd.X86.AVX512() && hwy.TargetEnabled(hwy.DispatchAVX512) {
 		initGeluAVX512()
 		return
 	}
-	if archsimd.X86.AVX2() {
+	if archsimd.X86.AVX2() && hwy.TargetEnabled(hwy.DispatchAVX2) {
 		initGeluAVX2()
 		return
 	}
@@ -110,3 +110,18 @@ func initGeluFallback() {
 	GELUApproxFloat32 = BaseGELUApprox_fallback
 	GELUApproxFloat64 = BaseGELUApprox_fallback_Float64
 }
+
+func init() {
+	hwy.RegisterKernel("gelu.GELUFloat16", &GELUFloat16)
+	hwy.RegisterKernel("gelu.GELUBFloat16", &GELUBFloat16)
+	hwy.RegisterKernel("gelu.GELUFloat32", &GELUFloat32)
+	hwy.RegisterKernel("gelu.GELUFloat64", &GELUFloat64)
+	hwy.RegisterKernel("gelu.GELUApproxFloat16", &GELUApproxFloat16)
+	hwy.RegisterKernel("gelu.GELUApproxBFloat16", &GELUApproxBFloat16)
+	hwy.RegisterKernel("gelu.GELUApproxFloat32", &GELUApproxFloat32)
+	hwy.RegisterKernel("gelu.GELUApproxFloat64", &GELUApproxFloat64)
+	hwyKernels := []string{"gelu.GELUFloat16", "gelu.GELUBFloat16", "gelu.GELUFloat32", "gelu.GELUFloat64", "gelu.GELUApproxFloat16", "gelu.GELUApproxBFloat16", "gelu.GELUApproxFloat32", "gelu.GELUApproxFloat64"}
+	hwy.RegisterKernelTarget(hwy.DispatchAVX2, initGeluAVX2, hwyKernels...)
+	hwy.RegisterKernelTarget(hwy.DispatchAVX512, initGeluAVX512, hwyKernels...)
+	hwy.RegisterKernelTarget(hwy.DispatchScalar, initGeluFallback, hwyKernels...)
+}
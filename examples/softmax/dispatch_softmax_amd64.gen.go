@@ -63,11 +63,11 @@ func init() {
 		initSoftmaxFallback()
 		return
 	}
-	if archsimd.X86.AVX512() {
+	if archsimd.X86.AVX512() && hwy.TargetEnabled(hwy.DispatchAVX512) {
 		initSoftmaxAVX512()
 		return
 	}
-	if archsimd.X86.AVX2() {
+	if archsimd.X86.AVX2() && hwy.TargetEnabled(hwy.DispatchAVX2) {
 		initSoftmaxAVX2()
 		return
 	}
@@ -106,3 +106,18 @@ func initSoftmaxFallback() {
 	SoftmaxScalarFloat32 = BaseSoftmaxScalar_fallback
 	SoftmaxScalarFloat64 = BaseSoftmaxScalar_fallback_Float64
 }
+
+func init() {
+	hwy.RegisterKernel("softmax.SoftmaxFloat16", &SoftmaxFloat16)
+	hwy.RegisterKernel("softmax.SoftmaxBFloat16", &SoftmaxBFloat16)
+	hwy.RegisterKernel("softmax.SoftmaxFloat32", &SoftmaxFloat32)
+	hwy.RegisterKernel("softmax.SoftmaxFloat64", &SoftmaxFloat64)
+	hwy.RegisterKernel("softmax.SoftmaxScalarFloat16", &SoftmaxScalarFloat16)
+	hwy.RegisterKernel("softmax.SoftmaxScalarBFloat16", &SoftmaxScalarBFloat16)
+	hwy.RegisterKernel("softmax.SoftmaxScalarFloat32", &SoftmaxScalarFloat32)
+	hwy.RegisterKernel("softmax.SoftmaxScalarFloat64", &SoftmaxScalarFloat64)
+	hwyKernels := []string{"softmax.SoftmaxFloat16", "softmax.SoftmaxBFloat16", "softmax.SoftmaxFloat32", "softmax.SoftmaxFloat64", "softmax.SoftmaxScalarFloat16", "softmax.SoftmaxScalarBFloat16", "softmax.SoftmaxScalarFloat32", "softmax.SoftmaxScalarFloat64"}
+	hwy.RegisterKernelTarget(hwy.DispatchAVX2, initSoftmaxAVX2, hwyKernels...)
+	hwy.RegisterKernelTarget(hwy.DispatchAVX512, initSoftmaxAVX512, hwyKernels...)
+	hwy.RegisterKernelTarget(hwy.DispatchScalar, initSoftmaxFallback, hwyKernels...)
+}
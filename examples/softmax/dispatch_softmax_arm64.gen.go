@@ -86,3 +86,17 @@ func initSoftmaxFallback() {
 	SoftmaxScalarFloat32 = BaseSoftmaxScalar_fallback
 	SoftmaxScalarFloat64 = BaseSoftmaxScalar_fallback_Float64
 }
+
+func init() {
+	hwy.RegisterKernel("softmax.SoftmaxFloat16", &SoftmaxFloat16)
+	hwy.RegisterKernel("softmax.SoftmaxBFloat16", &SoftmaxBFloat16)
+	hwy.RegisterKernel("softmax.SoftmaxFloat32", &SoftmaxFloat32)
+	hwy.RegisterKernel("softmax.SoftmaxFloat64", &SoftmaxFloat64)
+	hwy.RegisterKernel("softmax.SoftmaxScalarFloat16", &SoftmaxScalarFloat16)
+	hwy.RegisterKernel("softmax.SoftmaxScalarBFloat16", &SoftmaxScalarBFloat16)
+	hwy.RegisterKernel("softmax.SoftmaxScalarFloat32", &SoftmaxScalarFloat32)
+	hwy.RegisterKernel("softmax.SoftmaxScalarFloat64", &SoftmaxScalarFloat64)
+	hwyKernels := []string{"softmax.SoftmaxFloat16", "softmax.SoftmaxBFloat16", "softmax.SoftmaxFloat32", "softmax.SoftmaxFloat64", "softmax.SoftmaxScalarFloat16", "softmax.SoftmaxScalarBFloat16", "softmax.SoftmaxScalarFloat32", "softmax.SoftmaxScalarFloat64"}
+	hwy.RegisterKernelTarget(hwy.DispatchNEON, initSoftmaxNEON, hwyKernels...)
+	hwy.RegisterKernelTarget(hwy.DispatchScalar, initSoftmaxFallback, hwyKernels...)
+}
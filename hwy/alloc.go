@@ -0,0 +1,137 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hwy
+
+import (
+	"sync"
+	"unsafe"
+)
+
+// Alignment is the byte alignment of buffers returned by AllocAligned and
+// Scratch. It matches the cache line size and the AVX-512 vector width, so
+// aligned buffers never split a vector load across cache lines.
+const Alignment = 64
+
+// AllocAligned returns a zeroed slice of n elements whose first element is
+// Alignment-byte aligned. Its capacity is padded to a multiple of
+// MaxLanes[T](), so a full-vector Load or Store at the last partial vector
+// stays within the allocation; extending it with s[:cap(s)] avoids a masked
+// tail.
+func AllocAligned[T Lanes](n int) []T {
+	if n <= 0 {
+		return []T{}
+	}
+	padded := AlignedSize[T](n)
+	buf := make([]T, padded+alignElems[T]())
+	off := alignOffset(buf)
+	return buf[off : off+n : off+padded]
+}
+
+// IsAlignedSlice reports whether the first element of s is Alignment-byte
+// aligned. It returns true for empty slices.
+func IsAlignedSlice[T Lanes](s []T) bool {
+	if cap(s) == 0 {
+		return true
+	}
+	return uintptr(unsafe.Pointer(unsafe.SliceData(s)))%Alignment == 0
+}
+
+// alignElems returns the number of elements of T spanning Alignment bytes.
+func alignElems[T Lanes]() int {
+	var zero T
+	return Alignment / int(unsafe.Sizeof(zero))
+}
+
+// alignOffset returns the index of the first Alignment-aligned element of buf.
+func alignOffset[T Lanes](buf []T) int {
+	var zero T
+	addr := uintptr(unsafe.Pointer(unsafe.SliceData(buf)))
+	misalign := (Alignment - addr%Alignment) % Alignment
+	return int(misalign / unsafe.Sizeof(zero))
+}
+
+// Scratch is a reusable arena of aligned scratch buffers for element type T.
+// Get carves buffers out of one backing allocation, and Reset makes the whole
+// arena available again, so steady-state use does not allocate. The zero
+// value is ready to use.
+//
+// A Scratch is not safe for concurrent use. Use a ScratchPool to hand out
+// one arena per goroutine, as the nn *Auto functions do:
+//
+//	var pool hwy.ScratchPool[float32]
+//
+//	func attention(...) {
+//	    s := pool.Get()
+//	    defer pool.Put(s)
+//	    scores := s.Get(seqLen * kvLen)
+//	    ...
+//	}
+type Scratch[T Lanes] struct {
+	buf  []T
+	used int
+}
+
+// Get returns a slice of n elements from the arena. Like AllocAligned, the
+// slice is Alignment-byte aligned and its capacity is padded to a multiple
+// of MaxLanes[T](). The contents are not zeroed and may hold data from
+// earlier use. Slices stay valid until Reset; growing the arena does not
+// invalidate slices already returned.
+func (s *Scratch[T]) Get(n int) []T {
+	if n <= 0 {
+		return []T{}
+	}
+	padded := AlignedSize[T](n)
+	align := alignElems[T]()
+	start := (s.used + align - 1) / align * align
+	if start+padded > len(s.buf) {
+		// Start a new backing buffer; earlier slices keep the old one alive.
+		s.buf = AllocAligned[T](max(2*len(s.buf), padded))
+		s.buf = s.buf[:cap(s.buf)]
+		start = 0
+	}
+	s.used = start + padded
+	return s.buf[start : start+n : start+padded]
+}
+
+// Reset makes the whole arena available for reuse. Slices returned by Get
+// before Reset must no longer be used.
+func (s *Scratch[T]) Reset() {
+	s.used = 0
+}
+
+// Cap returns the size of the arena's current backing buffer in elements.
+func (s *Scratch[T]) Cap() int {
+	return len(s.buf)
+}
+
+// ScratchPool is a concurrency-safe pool of Scratch arenas, backed by
+// sync.Pool. The zero value is ready to use.
+type ScratchPool[T Lanes] struct {
+	pool sync.Pool
+}
+
+// Get returns an arena from the pool, or a new empty one.
+func (p *ScratchPool[T]) Get() *Scratch[T] {
+	if s, ok := p.pool.Get().(*Scratch[T]); ok {
+		return s
+	}
+	return &Scratch[T]{}
+}
+
+// Put resets s and returns it to the pool.
+func (p *ScratchPool[T]) Put(s *Scratch[T]) {
+	s.Reset()
+	p.pool.Put(s)
+}
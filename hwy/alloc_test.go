@@ -0,0 +1,110 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hwy
+
+import "testing"
+
+func TestAllocAligned(t *testing.T) {
+	for _, n := range []int{1, 3, 17, 100, 1000} {
+		f32 := AllocAligned[float32](n)
+		if len(f32) != n || cap(f32) != AlignedSize[float32](n) {
+			t.Errorf("AllocAligned[float32](%d): len %d cap %d, want len %d cap %d",
+				n, len(f32), cap(f32), n, AlignedSize[float32](n))
+		}
+		if !IsAlignedSlice(f32) {
+			t.Errorf("AllocAligned[float32](%d) is not %d-byte aligned", n, Alignment)
+		}
+		for i, v := range f32[:cap(f32)] {
+			if v != 0 {
+				t.Fatalf("AllocAligned[float32](%d)[%d] = %v, want 0", n, i, v)
+			}
+		}
+
+		if u8 := AllocAligned[uint8](n); !IsAlignedSlice(u8) || len(u8) != n {
+			t.Errorf("AllocAligned[uint8](%d) not aligned or wrong length", n)
+		}
+		if f16 := AllocAligned[Float16](n); !IsAlignedSlice(f16) || len(f16) != n {
+			t.Errorf("AllocAligned[Float16](%d) not aligned or wrong length", n)
+		}
+	}
+
+	if s := AllocAligned[float64](0); s == nil || len(s) != 0 {
+		t.Errorf("AllocAligned[float64](0) = %v, want empty non-nil slice", s)
+	}
+}
+
+func TestScratch(t *testing.T) {
+	var s Scratch[float32]
+	a := s.Get(10)
+	b := s.Get(33)
+	c := s.Get(1000) // forces growth
+	for _, buf := range [][]float32{a, b, c} {
+		if !IsAlignedSlice(buf) {
+			t.Error("Scratch.Get returned an unaligned slice")
+		}
+	}
+	if len(a) != 10 || len(b) != 33 || len(c) != 1000 {
+		t.Fatalf("Scratch.Get lengths = %d, %d, %d", len(a), len(b), len(c))
+	}
+
+	// Buffers handed out before a reset must not overlap.
+	for i := range a {
+		a[i] = 1
+	}
+	for i := range b {
+		b[i] = 2
+	}
+	for i := range c {
+		c[i] = 3
+	}
+	for i := range a {
+		if a[i] != 1 {
+			t.Fatalf("Scratch buffers overlap at a[%d]", i)
+		}
+	}
+	for i := range b {
+		if b[i] != 2 {
+			t.Fatalf("Scratch buffers overlap at b[%d]", i)
+		}
+	}
+
+	// After Reset the arena is reused without allocating.
+	s.Reset()
+	allocs := testing.AllocsPerRun(100, func() {
+		s.Reset()
+		_ = s.Get(10)
+		_ = s.Get(33)
+		_ = s.Get(500)
+	})
+	if allocs != 0 {
+		t.Errorf("Scratch.Get after Reset allocated %v times per run, want 0", allocs)
+	}
+}
+
+func TestScratchPool(t *testing.T) {
+	var pool ScratchPool[float64]
+	s := pool.Get()
+	if s == nil {
+		t.Fatal("ScratchPool.Get returned nil")
+	}
+	buf := s.Get(64)
+	if len(buf) != 64 || !IsAlignedSlice(buf) {
+		t.Errorf("pooled Scratch.Get(64): len %d aligned %v", len(buf), IsAlignedSlice(buf))
+	}
+	pool.Put(s)
+	if s.used != 0 {
+		t.Error("ScratchPool.Put did not reset the arena")
+	}
+}
@@ -0,0 +1,128 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !noasm && arm64
+
+// The tests are in package asm_test because BF16 detection lives in hwy,
+// which imports asm.
+package asm_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/ajroetker/go-highway/hwy"
+	"github.com/ajroetker/go-highway/hwy/asm"
+)
+
+func bf16s(vals ...float32) []uint16 {
+	out := make([]uint16, len(vals))
+	for i, v := range vals {
+		out[i] = uint16(math.Float32bits(v) >> 16)
+	}
+	return out
+}
+
+func f32x4Data(v asm.Float32x4) [4]float32 {
+	return [4]float32{v.Get(0), v.Get(1), v.Get(2), v.Get(3)}
+}
+
+func TestBFloat16x8_DotAndMatMul(t *testing.T) {
+	if !hwy.HasARMBF16() {
+		t.Skip("CPU does not support BF16")
+	}
+	a := asm.LoadBFloat16x8Slice(bf16s(1, 2, 3, 4, -1, 0.5, 2, -2))
+	b := asm.LoadBFloat16x8Slice(bf16s(2, 1, -1, 0.5, 4, 2, 3, 1))
+	acc := asm.LoadFloat32x4Slice([]float32{1, 2, 3, 4})
+
+	tests := []struct {
+		name string
+		got  asm.Float32x4
+		want [4]float32
+	}{
+		{"BFDot", a.BFDot(b, acc), [4]float32{5, 1, 0, 8}},
+		{"BFMatMulAcc", a.BFMatMulAcc(b, acc), [4]float32{4, 23, -1.5, 5}},
+		{"BFMulAddEven", a.BFMulAddEven(b, acc), [4]float32{3, -1, -1, 10}},
+		{"BFMulAddOdd", a.BFMulAddOdd(b, acc), [4]float32{3, 4, 4, 2}},
+	}
+	for _, tt := range tests {
+		if got := f32x4Data(tt.got); got != tt.want {
+			t.Errorf("%s = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+
+	acc2 := acc
+	a.BFDotF32Acc(b, &acc2)
+	if got, want := f32x4Data(acc2), [4]float32{5, 1, 0, 8}; got != want {
+		t.Errorf("BFDotF32Acc = %v, want %v", got, want)
+	}
+}
+
+func TestDotBF16NEON(t *testing.T) {
+	if !hwy.HasARMBF16() {
+		t.Skip("CPU does not support BF16")
+	}
+	// 45 elements cover the unrolled loop, the single-vector loop and the
+	// scalar tail.
+	const n = 45
+	af := make([]float32, n)
+	bf := make([]float32, n)
+	var want float32 = 10
+	for i := range n {
+		af[i] = float32(i%7 - 3)
+		bf[i] = float32(i%5) - 1.5
+		want += af[i] * bf[i]
+	}
+	got := float32(10)
+	asm.DotBF16NEON(bf16s(af...), bf16s(bf...), &got, n)
+	if got != want {
+		t.Errorf("DotBF16NEON = %v, want %v", got, want)
+	}
+}
+
+func TestMatMulBF16NEON(t *testing.T) {
+	if !hwy.HasARMBF16() {
+		t.Skip("CPU does not support BF16")
+	}
+	for _, dims := range [][3]int{{2, 2, 4}, {3, 5, 9}, {4, 4, 8}} {
+		m, n, k := dims[0], dims[1], dims[2]
+		af := make([]float32, m*k)
+		bf := make([]float32, k*n)
+		for i := range af {
+			af[i] = float32(i%5 - 2)
+		}
+		for i := range bf {
+			bf[i] = float32(i%3) - 0.5
+		}
+		c := make([]float32, m*n)
+		want := make([]float32, m*n)
+		for i := range c {
+			c[i] = 1
+			want[i] = 1
+		}
+		for i := range m {
+			for j := range n {
+				for p := range k {
+					want[i*n+j] += af[i*k+p] * bf[p*n+j]
+				}
+			}
+		}
+		asm.MatMulBF16NEON(bf16s(af...), bf16s(bf...), c, m, n, k, k, n, n)
+		for i := range c {
+			if c[i] != want[i] {
+				t.Errorf("MatMulBF16NEON %dx%dx%d: c[%d] = %v, want %v", m, n, k, i, c[i], want[i])
+			}
+		}
+	}
+}
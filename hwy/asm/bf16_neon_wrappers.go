@@ -19,7 +19,9 @@ package asm
 
 import "unsafe"
 
-// -march=armv8.6-a+bf16 enables BFDOT and BFMMLA instructions
+// -march=armv8.6-a+bf16 enables BFDOT and BFMMLA instructions. GoAT emits
+// them as WORDs without operands; after regenerating, restore the operands
+// of each "// bfdot" and "// bfmmla" line in ops_bf16_neon_arm64.s.
 //go:generate go tool goat ../c/ops_bf16_neon_arm64.c -O3 --target arm64 -e="-march=armv8.6-a+bf16"
 
 // ============================================================================
@@ -550,6 +552,32 @@ func (v BFloat16x8) BFDotF32Acc(other BFloat16x8, acc *Float32x4) {
 	bfdot_bf16x8_f32x4_acc([16]byte(v), [16]byte(other), unsafe.Pointer(acc))
 }
 
+// BFDot returns acc plus the pairwise dot products of v and other (BFDOT):
+// lane i of the result is acc[i] + v[2i]*other[2i] + v[2i+1]*other[2i+1].
+func (v BFloat16x8) BFDot(other BFloat16x8, acc Float32x4) Float32x4 {
+	return Float32x4(bfdot_f32x4([16]byte(acc), [16]byte(v), [16]byte(other)))
+}
+
+// BFMatMulAcc returns acc plus the product of v and other (BFMMLA). v is a
+// row-major 2x4 matrix, other holds the two columns of a 4x2 matrix, one
+// after the other, and acc and the result are row-major 2x2 matrices.
+// Products are computed as with BFDot.
+func (v BFloat16x8) BFMatMulAcc(other BFloat16x8, acc Float32x4) Float32x4 {
+	return Float32x4(bfmmla_f32x4([16]byte(acc), [16]byte(v), [16]byte(other)))
+}
+
+// BFMulAddEven returns acc plus the products of the even lanes of v and
+// other (BFMLALB): lane i of the result is acc[i] + v[2i]*other[2i].
+func (v BFloat16x8) BFMulAddEven(other BFloat16x8, acc Float32x4) Float32x4 {
+	return Float32x4(bfmlalb_f32x4([16]byte(acc), [16]byte(v), [16]byte(other)))
+}
+
+// BFMulAddOdd returns acc plus the products of the odd lanes of v and other
+// (BFMLALT): lane i of the result is acc[i] + v[2i+1]*other[2i+1].
+func (v BFloat16x8) BFMulAddOdd(other BFloat16x8, acc Float32x4) Float32x4 {
+	return Float32x4(bfmlalt_f32x4([16]byte(acc), [16]byte(v), [16]byte(other)))
+}
+
 // SignBitBFloat16x8 returns a vector with the sign bit (0x8000) set in each lane.
 func SignBitBFloat16x8() BFloat16x8 {
 	return BroadcastBFloat16x8(0x8000)
@@ -12,7 +12,7 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-//go:build !noasm && arm64
+//go:build !noasm && (arm64 || amd64)
 
 package asm
 
@@ -0,0 +1,22 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !noasm && amd64
+
+package asm
+
+// CPUID executes the CPUID instruction for the given leaf and subleaf and
+// returns the resulting EAX, EBX, ECX and EDX registers. It is used to detect
+// features that golang.org/x/sys/cpu does not report yet, like AVX512-FP16.
+func CPUID(leaf, subleaf uint32) (eax, ebx, ecx, edx uint32)
@@ -0,0 +1,41 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !noasm && amd64
+
+package asm
+
+import (
+	"testing"
+
+	"golang.org/x/sys/cpu"
+)
+
+func TestCPUID(t *testing.T) {
+	maxLeaf, ebx, ecx, edx := CPUID(0, 0)
+	if maxLeaf == 0 {
+		t.Fatal("CPUID(0) reported no leaves")
+	}
+	vendor := make([]byte, 0, 12)
+	for _, r := range []uint32{ebx, edx, ecx} {
+		vendor = append(vendor, byte(r), byte(r>>8), byte(r>>16), byte(r>>24))
+	}
+	t.Logf("vendor %q, max leaf %d", vendor, maxLeaf)
+
+	// Leaf 1 ECX bit 28 is AVX, which x/sys/cpu also reports (gated on OS support).
+	_, _, ecx1, _ := CPUID(1, 0)
+	if cpu.X86.HasAVX && ecx1&(1<<28) == 0 {
+		t.Error("x/sys/cpu reports AVX but CPUID leaf 1 does not")
+	}
+}
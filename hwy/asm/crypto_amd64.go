@@ -0,0 +1,60 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !noasm && amd64
+
+package asm
+
+import "golang.org/x/sys/cpu"
+
+// HasAES reports whether AESRound and AESLastRound can run (AES-NI).
+var HasAES = cpu.X86.HasAES
+
+// HasCLMUL reports whether CarrylessMultiply can run (PCLMULQDQ).
+var HasCLMUL = cpu.X86.HasPCLMULQDQ
+
+// AESRound applies one AES encryption round (AESENC) to each 16-byte block
+// of state: dst = MixColumns(ShiftRows(SubBytes(state))) ^ key.
+// It processes min(len(dst), len(state), len(key)) / 16 blocks.
+func AESRound(dst, state, key []uint8) {
+	if n := min(len(dst), len(state), len(key)) / 16; n > 0 {
+		aesRound(&dst[0], &state[0], &key[0], n)
+	}
+}
+
+// AESLastRound applies the final AES encryption round (AESENCLAST), which
+// omits MixColumns: dst = ShiftRows(SubBytes(state)) ^ key.
+func AESLastRound(dst, state, key []uint8) {
+	if n := min(len(dst), len(state), len(key)) / 16; n > 0 {
+		aesLastRound(&dst[0], &state[0], &key[0], n)
+	}
+}
+
+// CarrylessMultiply computes the 128-bit carry-less (GF(2)[x]) product of
+// each pair of lanes with PCLMULQDQ, storing the halves in lo and hi.
+// It processes min(len(a), len(b), len(lo), len(hi)) lanes.
+func CarrylessMultiply(a, b, lo, hi []uint64) {
+	if n := min(len(a), len(b), len(lo), len(hi)); n > 0 {
+		clmul(&a[0], &b[0], &lo[0], &hi[0], n)
+	}
+}
+
+//go:noescape
+func aesRound(dst, state, key *uint8, blocks int)
+
+//go:noescape
+func aesLastRound(dst, state, key *uint8, blocks int)
+
+//go:noescape
+func clmul(a, b, lo, hi *uint64, n int)
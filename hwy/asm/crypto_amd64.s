@@ -0,0 +1,77 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//go:build !noasm && amd64
+
+#include "textflag.h"
+
+// func aesRound(dst, state, key *uint8, blocks int)
+TEXT ·aesRound(SB), NOSPLIT, $0-32
+	MOVQ dst+0(FP), DI
+	MOVQ state+8(FP), SI
+	MOVQ key+16(FP), DX
+	MOVQ blocks+24(FP), CX
+
+round:
+	MOVOU  (SI), X0
+	MOVOU  (DX), X1
+	AESENC X1, X0
+	MOVOU  X0, (DI)
+	ADDQ   $16, SI
+	ADDQ   $16, DX
+	ADDQ   $16, DI
+	DECQ   CX
+	JNZ    round
+	RET
+
+// func aesLastRound(dst, state, key *uint8, blocks int)
+TEXT ·aesLastRound(SB), NOSPLIT, $0-32
+	MOVQ dst+0(FP), DI
+	MOVQ state+8(FP), SI
+	MOVQ key+16(FP), DX
+	MOVQ blocks+24(FP), CX
+
+last:
+	MOVOU      (SI), X0
+	MOVOU      (DX), X1
+	AESENCLAST X1, X0
+	MOVOU      X0, (DI)
+	ADDQ       $16, SI
+	ADDQ       $16, DX
+	ADDQ       $16, DI
+	DECQ       CX
+	JNZ        last
+	RET
+
+// func clmul(a, b, lo, hi *uint64, n int)
+TEXT ·clmul(SB), NOSPLIT, $0-40
+	MOVQ a+0(FP), SI
+	MOVQ b+8(FP), DX
+	MOVQ lo+16(FP), DI
+	MOVQ hi+24(FP), R8
+	MOVQ n+32(FP), CX
+
+lanes:
+	MOVQ      (SI), X0
+	MOVQ      (DX), X1
+	PCLMULQDQ $0x00, X1, X0
+	MOVQ      X0, (DI)
+	PSRLDQ    $8, X0
+	MOVQ      X0, (R8)
+	ADDQ      $8, SI
+	ADDQ      $8, DX
+	ADDQ      $8, DI
+	ADDQ      $8, R8
+	DECQ      CX
+	JNZ       lanes
+	RET
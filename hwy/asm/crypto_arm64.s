@@ -0,0 +1,77 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//go:build !noasm && arm64
+
+#include "textflag.h"
+
+// ARM's AESE adds the round key before SubBytes/ShiftRows, while the x86
+// rounds add it after MixColumns. Running AESE with a zero key and XORing
+// the real key at the end gives the x86 (AESENC) semantics.
+
+// func aesRound(dst, state, key *uint8, blocks int)
+TEXT ·aesRound(SB), NOSPLIT, $0-32
+	MOVD dst+0(FP), R0
+	MOVD state+8(FP), R1
+	MOVD key+16(FP), R2
+	MOVD blocks+24(FP), R3
+	VEOR V2.B16, V2.B16, V2.B16
+
+round:
+	VLD1.P 16(R1), [V0.B16]
+	VLD1.P 16(R2), [V1.B16]
+	AESE   V2.B16, V0.B16
+	AESMC  V0.B16, V0.B16
+	VEOR   V1.B16, V0.B16, V0.B16
+	VST1.P [V0.B16], 16(R0)
+	SUBS   $1, R3, R3
+	BNE    round
+	RET
+
+// func aesLastRound(dst, state, key *uint8, blocks int)
+TEXT ·aesLastRound(SB), NOSPLIT, $0-32
+	MOVD dst+0(FP), R0
+	MOVD state+8(FP), R1
+	MOVD key+16(FP), R2
+	MOVD blocks+24(FP), R3
+	VEOR V2.B16, V2.B16, V2.B16
+
+last:
+	VLD1.P 16(R1), [V0.B16]
+	VLD1.P 16(R2), [V1.B16]
+	AESE   V2.B16, V0.B16
+	VEOR   V1.B16, V0.B16, V0.B16
+	VST1.P [V0.B16], 16(R0)
+	SUBS   $1, R3, R3
+	BNE    last
+	RET
+
+// func clmul(a, b, lo, hi *uint64, n int)
+TEXT ·clmul(SB), NOSPLIT, $0-40
+	MOVD a+0(FP), R0
+	MOVD b+8(FP), R1
+	MOVD lo+16(FP), R2
+	MOVD hi+24(FP), R3
+	MOVD n+32(FP), R4
+
+lanes:
+	FMOVD.P 8(R0), F0
+	FMOVD.P 8(R1), F1
+	VPMULL  V0.D1, V1.D1, V2.Q1
+	VMOV    V2.D[0], R5
+	VMOV    V2.D[1], R6
+	MOVD.P  R5, 8(R2)
+	MOVD.P  R6, 8(R3)
+	SUBS    $1, R4, R4
+	BNE     lanes
+	RET
@@ -0,0 +1,32 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build noasm || !(amd64 || arm64)
+
+package asm
+
+// HasAES is false on platforms without AES instructions.
+var HasAES = false
+
+// HasCLMUL is false on platforms without carry-less multiply.
+var HasCLMUL = false
+
+// AESRound is not available on this platform; callers must check HasAES.
+func AESRound(dst, state, key []uint8) { panic("AES not available") }
+
+// AESLastRound is not available on this platform; callers must check HasAES.
+func AESLastRound(dst, state, key []uint8) { panic("AES not available") }
+
+// CarrylessMultiply is not available on this platform; callers must check HasCLMUL.
+func CarrylessMultiply(a, b, lo, hi []uint64) { panic("CLMUL not available") }
@@ -0,0 +1,74 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !noasm && (amd64 || arm64)
+
+package asm
+
+import (
+	"bytes"
+	"testing"
+)
+
+// Round vectors from FIPS 197 Appendix B (AES-128 cipher example).
+func TestAESRound(t *testing.T) {
+	if !HasAES {
+		t.Skip("CPU does not support AES")
+	}
+	state := []uint8{0x19, 0x3d, 0xe3, 0xbe, 0xa0, 0xf4, 0xe2, 0x2b, 0x9a, 0xc6, 0x8d, 0x2a, 0xe9, 0xf8, 0x48, 0x08}
+	key := []uint8{0xa0, 0xfa, 0xfe, 0x17, 0x88, 0x54, 0x2c, 0xb1, 0x23, 0xa3, 0x39, 0x39, 0x2a, 0x6c, 0x76, 0x05}
+	want := []uint8{0xa4, 0x9c, 0x7f, 0xf2, 0x68, 0x9f, 0x35, 0x2b, 0x6b, 0x5b, 0xea, 0x43, 0x02, 0x6a, 0x50, 0x49}
+
+	// Two copies check that blocks are processed independently.
+	dst := make([]uint8, 32)
+	AESRound(dst, append(state, state...), append(key, key...))
+	if !bytes.Equal(dst, append(want, want...)) {
+		t.Errorf("AESRound = %x, want %x", dst, append(want, want...))
+	}
+}
+
+func TestAESLastRound(t *testing.T) {
+	if !HasAES {
+		t.Skip("CPU does not support AES")
+	}
+	state := []uint8{0xeb, 0x40, 0xf2, 0x1e, 0x59, 0x2e, 0x38, 0x84, 0x8b, 0xa1, 0x13, 0xe7, 0x1b, 0xc3, 0x42, 0xd2}
+	key := []uint8{0xd0, 0x14, 0xf9, 0xa8, 0xc9, 0xee, 0x25, 0x89, 0xe1, 0x3f, 0x0c, 0xc8, 0xb6, 0x63, 0x0c, 0xa6}
+	want := []uint8{0x39, 0x25, 0x84, 0x1d, 0x02, 0xdc, 0x09, 0xfb, 0xdc, 0x11, 0x85, 0x97, 0x19, 0x6a, 0x0b, 0x32}
+
+	dst := make([]uint8, 16)
+	AESLastRound(dst, state, key)
+	if !bytes.Equal(dst, want) {
+		t.Errorf("AESLastRound = %x, want %x", dst, want)
+	}
+}
+
+func TestCarrylessMultiply(t *testing.T) {
+	if !HasCLMUL {
+		t.Skip("CPU does not support carry-less multiply")
+	}
+	a := []uint64{3, 0xDEADBEEF, 1 << 63, ^uint64(0)}
+	b := []uint64{3, 1, 1 << 63, 2}
+	wantLo := []uint64{5, 0xDEADBEEF, 0, ^uint64(1)}
+	wantHi := []uint64{0, 0, 1 << 62, 1}
+
+	lo := make([]uint64, len(a))
+	hi := make([]uint64, len(a))
+	CarrylessMultiply(a, b, lo, hi)
+	for i := range a {
+		if lo[i] != wantLo[i] || hi[i] != wantHi[i] {
+			t.Errorf("CarrylessMultiply(%#x, %#x) = (%#x, %#x), want (%#x, %#x)",
+				a[i], b[i], lo[i], hi[i], wantLo[i], wantHi[i])
+		}
+	}
+}
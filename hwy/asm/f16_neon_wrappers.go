@@ -406,87 +406,48 @@ func (v Float16x8) ReduceSum() float32 {
 // GreaterThan compares element-wise: result[i] = (v[i] > other[i]) ? 0xFFFF : 0x0000.
 // Returns a Uint16x8 mask suitable for IfThenElseFloat16.
 func (v Float16x8) GreaterThan(other Float16x8) Uint16x8 {
-	var f32a, f32b [8]float32
-	u16a := unsafe.Slice((*uint16)(unsafe.Pointer(&v)), 8)
-	u16b := unsafe.Slice((*uint16)(unsafe.Pointer(&other)), 8)
-	PromoteF16ToF32NEON(u16a, f32a[:])
-	PromoteF16ToF32NEON(u16b, f32b[:])
-	var result Uint16x8
-	r := (*[8]uint16)(unsafe.Pointer(&result))
-	for i := range 8 {
-		if f32a[i] > f32b[i] {
-			r[i] = 0xFFFF
-		}
-	}
-	return result
+	return Uint16x8(gt_f16x8([16]byte(v), [16]byte(other)))
 }
 
 // LessThan compares element-wise: result[i] = (v[i] < other[i]) ? 0xFFFF : 0x0000.
 func (v Float16x8) LessThan(other Float16x8) Uint16x8 {
-	var f32a, f32b [8]float32
-	u16a := unsafe.Slice((*uint16)(unsafe.Pointer(&v)), 8)
-	u16b := unsafe.Slice((*uint16)(unsafe.Pointer(&other)), 8)
-	PromoteF16ToF32NEON(u16a, f32a[:])
-	PromoteF16ToF32NEON(u16b, f32b[:])
-	var result Uint16x8
-	r := (*[8]uint16)(unsafe.Pointer(&result))
-	for i := range 8 {
-		if f32a[i] < f32b[i] {
-			r[i] = 0xFFFF
-		}
-	}
-	return result
+	return Uint16x8(gt_f16x8([16]byte(other), [16]byte(v)))
 }
 
 // GreaterThanOrEqual compares element-wise: result[i] = (v[i] >= other[i]) ? 0xFFFF : 0x0000.
 func (v Float16x8) GreaterThanOrEqual(other Float16x8) Uint16x8 {
-	var f32a, f32b [8]float32
-	u16a := unsafe.Slice((*uint16)(unsafe.Pointer(&v)), 8)
-	u16b := unsafe.Slice((*uint16)(unsafe.Pointer(&other)), 8)
-	PromoteF16ToF32NEON(u16a, f32a[:])
-	PromoteF16ToF32NEON(u16b, f32b[:])
-	var result Uint16x8
-	r := (*[8]uint16)(unsafe.Pointer(&result))
-	for i := range 8 {
-		if f32a[i] >= f32b[i] {
-			r[i] = 0xFFFF
-		}
-	}
-	return result
+	return Uint16x8(ge_f16x8([16]byte(v), [16]byte(other)))
 }
 
 // LessThanOrEqual compares element-wise: result[i] = (v[i] <= other[i]) ? 0xFFFF : 0x0000.
 func (v Float16x8) LessThanOrEqual(other Float16x8) Uint16x8 {
-	var f32a, f32b [8]float32
-	u16a := unsafe.Slice((*uint16)(unsafe.Pointer(&v)), 8)
-	u16b := unsafe.Slice((*uint16)(unsafe.Pointer(&other)), 8)
-	PromoteF16ToF32NEON(u16a, f32a[:])
-	PromoteF16ToF32NEON(u16b, f32b[:])
-	var result Uint16x8
-	r := (*[8]uint16)(unsafe.Pointer(&result))
-	for i := range 8 {
-		if f32a[i] <= f32b[i] {
-			r[i] = 0xFFFF
-		}
-	}
-	return result
+	return Uint16x8(ge_f16x8([16]byte(other), [16]byte(v)))
+}
+
+// Equal compares element-wise: result[i] = (v[i] == other[i]) ? 0xFFFF : 0x0000.
+func (v Float16x8) Equal(other Float16x8) Uint16x8 {
+	return Uint16x8(eq_f16x8([16]byte(v), [16]byte(other)))
 }
 
 // NotEqual compares element-wise: result[i] = (v[i] != other[i]) ? 0xFFFF : 0x0000.
 func (v Float16x8) NotEqual(other Float16x8) Uint16x8 {
-	var f32a, f32b [8]float32
-	u16a := unsafe.Slice((*uint16)(unsafe.Pointer(&v)), 8)
-	u16b := unsafe.Slice((*uint16)(unsafe.Pointer(&other)), 8)
-	PromoteF16ToF32NEON(u16a, f32a[:])
-	PromoteF16ToF32NEON(u16b, f32b[:])
-	var result Uint16x8
-	r := (*[8]uint16)(unsafe.Pointer(&result))
-	for i := range 8 {
-		if f32a[i] != f32b[i] {
-			r[i] = 0xFFFF
-		}
-	}
-	return result
+	return Uint16x8(ne_f16x8([16]byte(v), [16]byte(other)))
+}
+
+// ===== Float16x8 conversions =====
+
+// PromoteToFloat32x4 converts the lower and upper 4 lanes to float32 (FCVTL,
+// FCVTL2). The conversion is exact.
+func (v Float16x8) PromoteToFloat32x4() (lo, hi Float32x4) {
+	l, h := promote_f16x8([16]byte(v))
+	return Float32x4(l), Float32x4(h)
+}
+
+// DemoteFloat32x4ToFloat16x8 converts two float32 vectors to one float16
+// vector, lo in the lower 4 lanes and hi in the upper 4 (FCVTN, FCVTN2),
+// rounding to nearest even.
+func DemoteFloat32x4ToFloat16x8(lo, hi Float32x4) Float16x8 {
+	return Float16x8(demote_f32x4x2([16]byte(lo), [16]byte(hi)))
 }
 
 // ===== Float16x8 conditional select =====
@@ -19,6 +19,8 @@ package asm
 import (
 	"math"
 	"testing"
+
+	"golang.org/x/sys/cpu"
 )
 
 func TestAddF32(t *testing.T) {
@@ -3302,3 +3304,156 @@ func TestUint32x4_Shifts(t *testing.T) {
 		}
 	}
 }
+
+func TestUint32x4_Parity(t *testing.T) {
+	a := LoadUint32x4Slice([]uint32{0xFFFFFFFF, 7, 0x80000000, 3})
+	b := LoadUint32x4Slice([]uint32{1, 9, 5, 3})
+
+	conv := a.ConvertToFloat32()
+	for i, expected := range []float32{4294967296, 7, 2147483648, 3} {
+		if conv.Get(i) != expected {
+			t.Errorf("ConvertToFloat32[%d]: got %v, want %v", i, conv.Get(i), expected)
+		}
+	}
+
+	// Unsigned: 0xFFFFFFFF and 0x80000000 are large, not negative
+	merged := a.Merge(b, a.Greater(b))
+	for i, expected := range []uint32{0xFFFFFFFF, 9, 0x80000000, 3} {
+		if merged.Get(i) != expected {
+			t.Errorf("Merge(Greater)[%d]: got %x, want %x", i, merged.Get(i), expected)
+		}
+	}
+	if got := a.Less(b).Data(); got[0] != 0 || got[1] != 0xFFFFFFFF {
+		t.Errorf("Less: got %x", got)
+	}
+	if got := a.ReduceMin(); got != 3 {
+		t.Errorf("ReduceMin: got %d, want 3", got)
+	}
+
+	var add, mul, minv Uint32x4
+	a.AddInto(b, &add)
+	a.MulInto(b, &mul)
+	a.MinInto(b, &minv)
+	for i := range 4 {
+		if add.Get(i) != a.Get(i)+b.Get(i) {
+			t.Errorf("AddInto[%d]: got %d, want %d", i, add.Get(i), a.Get(i)+b.Get(i))
+		}
+		if mul.Get(i) != a.Get(i)*b.Get(i) {
+			t.Errorf("MulInto[%d]: got %d, want %d", i, mul.Get(i), a.Get(i)*b.Get(i))
+		}
+		if minv.Get(i) != min(a.Get(i), b.Get(i)) {
+			t.Errorf("MinInto[%d]: got %d, want %d", i, minv.Get(i), min(a.Get(i), b.Get(i)))
+		}
+	}
+}
+
+func TestUint64x2_Parity(t *testing.T) {
+	a := LoadUint64x2Slice([]uint64{0xFFFFFFFFFFFFFFFF, 2})
+	b := LoadUint64x2Slice([]uint64{1, 0xFF})
+
+	conv := a.ConvertToFloat64()
+	if conv.Get(0) != 18446744073709551616 || conv.Get(1) != 2 {
+		t.Errorf("ConvertToFloat64: got [%v %v]", conv.Get(0), conv.Get(1))
+	}
+	if got := a.AndNot(b).Data(); got[0] != 0xFFFFFFFFFFFFFFFE || got[1] != 2 {
+		t.Errorf("AndNot: got %x", got)
+	}
+	if got := a.Greater(b).Data(); got[0] != 0xFFFFFFFFFFFFFFFF || got[1] != 0 {
+		t.Errorf("Greater: got %x", got)
+	}
+	if got := a.ReduceMin(); got != 2 {
+		t.Errorf("ReduceMin: got %d, want 2", got)
+	}
+
+	var sub, maxv Uint64x2
+	a.SubInto(b, &sub)
+	a.MaxInto(b, &maxv)
+	if sub.Get(0) != 0xFFFFFFFFFFFFFFFE || sub.Get(1) != 0xFFFFFFFFFFFFFF03 {
+		t.Errorf("SubInto: got %x", sub.Data())
+	}
+	if maxv.Get(0) != 0xFFFFFFFFFFFFFFFF || maxv.Get(1) != 0xFF {
+		t.Errorf("MaxInto: got %x", maxv.Data())
+	}
+}
+
+func TestFindFirstLastTrue(t *testing.T) {
+	tests := []struct {
+		mask      [4]int32
+		wantFirst int
+		wantLast  int
+	}{
+		{[4]int32{0, 0, 0, 0}, -1, -1},
+		{[4]int32{-1, 0, 0, 0}, 0, 0},
+		{[4]int32{0, -1, 0, -1}, 1, 3},
+		{[4]int32{-1, -1, -1, -1}, 0, 3},
+	}
+
+	for _, tt := range tests {
+		m := LoadInt32x4(&tt.mask)
+		if got := FindFirstTrue(m); got != tt.wantFirst {
+			t.Errorf("FindFirstTrue(%v): got %d, want %d", tt.mask, got, tt.wantFirst)
+		}
+		if got := FindLastTrue(m); got != tt.wantLast {
+			t.Errorf("FindLastTrue(%v): got %d, want %d", tt.mask, got, tt.wantLast)
+		}
+	}
+
+	m64 := [2]uint64{^uint64(0), 0}
+	if got := FindLastTrue(LoadUint64x2(&m64)); got != 0 {
+		t.Errorf("FindLastTrue(%v): got %d, want 0", m64, got)
+	}
+}
+
+func TestFloat16x8_Compare(t *testing.T) {
+	if !cpu.ARM64.HasASIMDHP {
+		t.Skip("CPU does not support NEON FP16")
+	}
+	// 1, 2, -1, 0.5, NaN, 3, 2, 1
+	a := LoadFloat16x8([]uint16{0x3C00, 0x4000, 0xBC00, 0x3800, 0x7E00, 0x4200, 0x4000, 0x3C00})
+	b := BroadcastFloat16x8(0x4000) // 2
+
+	tests := []struct {
+		name string
+		got  Uint16x8
+		want [8]bool
+	}{
+		{"GreaterThan", a.GreaterThan(b), [8]bool{false, false, false, false, false, true, false, false}},
+		{"LessThan", a.LessThan(b), [8]bool{true, false, true, true, false, false, false, true}},
+		{"GreaterThanOrEqual", a.GreaterThanOrEqual(b), [8]bool{false, true, false, false, false, true, true, false}},
+		{"LessThanOrEqual", a.LessThanOrEqual(b), [8]bool{true, true, true, true, false, false, true, true}},
+		{"Equal", a.Equal(b), [8]bool{false, true, false, false, false, false, true, false}},
+		{"NotEqual", a.NotEqual(b), [8]bool{true, false, true, true, true, true, false, true}},
+	}
+	for _, tt := range tests {
+		for i, want := range tt.want {
+			if got := tt.got.Get(i); (got != 0) != want || (got != 0 && got != 0xFFFF) {
+				t.Errorf("%s lane %d = %#x, want %v", tt.name, i, got, want)
+			}
+		}
+	}
+}
+
+func TestFloat16x8_Float32x4Conversions(t *testing.T) {
+	// 1, 2, -1, 0.5, 65504 (max), 3, -0, 6.1035156e-05 (min normal)
+	in := []uint16{0x3C00, 0x4000, 0xBC00, 0x3800, 0x7BFF, 0x4200, 0x8000, 0x0400}
+	want := []float32{1, 2, -1, 0.5, 65504, 3, float32(math.Copysign(0, -1)), 6.1035156e-05}
+
+	lo, hi := LoadFloat16x8(in).PromoteToFloat32x4()
+	for i := range 4 {
+		if lo.Get(i) != want[i] || math.Signbit(float64(lo.Get(i))) != math.Signbit(float64(want[i])) {
+			t.Errorf("lo lane %d = %v, want %v", i, lo.Get(i), want[i])
+		}
+		if hi.Get(i) != want[4+i] || math.Signbit(float64(hi.Get(i))) != math.Signbit(float64(want[4+i])) {
+			t.Errorf("hi lane %d = %v, want %v", i, hi.Get(i), want[4+i])
+		}
+	}
+
+	if got := DemoteFloat32x4ToFloat16x8(lo, hi).Data(); got != [8]uint16(in) {
+		t.Errorf("round trip = %#x, want %#x", got, in)
+	}
+	// 1 + 2^-11 is halfway between 1 and the next float16; ties go to even.
+	halfway := BroadcastFloat32x4(1 + 1.0/2048)
+	if got := DemoteFloat32x4ToFloat16x8(halfway, halfway).Data()[0]; got != 0x3C00 {
+		t.Errorf("Demote(1+2^-11) = %#x, want 0x3c00", got)
+	}
+}
@@ -377,10 +377,10 @@ BB2_2:
 	WORD $0xacc21d46 // ldp	q6, q7, [x10], #64
 	WORD $0xad7f4530 // ldp	q16, q17, [x9, #-32]
 	WORD $0xacc24d32 // ldp	q18, q19, [x9], #64
-	WORD $0x2e40fc00 // bfdot
-	WORD $0x2e40fc00 // bfdot
-	WORD $0x2e40fc00 // bfdot
-	WORD $0x2e40fc00 // bfdot
+	WORD $0x6e50fc80 // bfdot	v0.4s, v4.8h, v16.8h
+	WORD $0x6e51fca1 // bfdot	v1.4s, v5.8h, v17.8h
+	WORD $0x6e52fcc2 // bfdot	v2.4s, v6.8h, v18.8h
+	WORD $0x6e53fce3 // bfdot	v3.4s, v7.8h, v19.8h
 	WORD $0x9100816b // add	x11, x11, #32
 	WORD $0xeb08017f // cmp	x11, x8
 	BLT  BB2_2
@@ -411,7 +411,7 @@ BB2_6:
 BB2_7:
 	WORD $0x3cc10544 // ldr	q4, [x10], #16
 	WORD $0x3cc10585 // ldr	q5, [x12], #16
-	WORD $0x2e40fc00 // bfdot
+	WORD $0x6e45fc80 // bfdot	v0.4s, v4.8h, v5.8h
 	WORD $0x9100212b // add	x11, x9, #8
 	WORD $0x91003d2d // add	x13, x9, #15
 	WORD $0xaa0b03e9 // mov	x9, x11
@@ -552,7 +552,7 @@ BB3_8:
 	WORD $0x4d404942 // ld1.h	{ v2 }[5], [x10]
 	WORD $0x4d405002 // ld1.h	{ v2 }[6], [x0]
 	WORD $0x4d4058e2 // ld1.h	{ v2 }[7], [x7]
-	WORD $0x6e40ec00 // bfmmla
+	WORD $0x6e42ec20 // bfmmla	v0.4s, v1.8h, v2.8h
 	WORD $0x910021ef // add	x15, x15, #8
 	WORD $0x8b1101ad // add	x13, x13, x17
 	WORD $0x9100116b // add	x11, x11, #4
@@ -1133,6 +1133,6 @@ TEXT ·bfdot_bf16x8_f32x4_acc(SB), $0-40
 	VMOV R10, V1.D[1]
 	MOVD acc+32(FP), R0
 	WORD $0x3dc00002     // ldr	q2, [x0]
-	WORD $0x2e40fc00     // bfdot
+	WORD $0x6e41fc02      // bfdot	v2.4s, v0.8h, v1.8h
 	WORD $0x3d800002     // str	q2, [x0]
 	RET
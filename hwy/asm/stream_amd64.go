@@ -0,0 +1,68 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !noasm && amd64
+
+package asm
+
+import "unsafe"
+
+// Prefetch hints that the cache line containing p will be read soon
+// (PREFETCHT0). It never faults, even if p is not a valid address.
+//
+//go:noescape
+func Prefetch(p unsafe.Pointer)
+
+// StreamCopy copies n bytes from src to dst using non-temporal stores
+// (MOVNTI), bypassing the cache for the destination. Call StreamFence
+// before the written data is read by another goroutine.
+//
+//go:noescape
+func StreamCopy(dst, src unsafe.Pointer, n int)
+
+// StreamStorePS256 copies the 32 bytes at src to dst with one non-temporal
+// AVX store (VMOVNTPS). dst must be 32-byte aligned.
+//
+//go:noescape
+func StreamStorePS256(dst, src unsafe.Pointer)
+
+// StreamStorePD256 is StreamStorePS256 for float64 lanes (VMOVNTPD).
+//
+//go:noescape
+func StreamStorePD256(dst, src unsafe.Pointer)
+
+// StreamStoreDQ256 is StreamStorePS256 for integer lanes (VMOVNTDQ).
+//
+//go:noescape
+func StreamStoreDQ256(dst, src unsafe.Pointer)
+
+// StreamStorePS512 copies the 64 bytes at src to dst with one non-temporal
+// AVX-512 store (VMOVNTPS). dst must be 64-byte aligned.
+//
+//go:noescape
+func StreamStorePS512(dst, src unsafe.Pointer)
+
+// StreamStorePD512 is StreamStorePS512 for float64 lanes (VMOVNTPD).
+//
+//go:noescape
+func StreamStorePD512(dst, src unsafe.Pointer)
+
+// StreamStoreDQ512 is StreamStorePS512 for integer lanes (VMOVNTDQ).
+//
+//go:noescape
+func StreamStoreDQ512(dst, src unsafe.Pointer)
+
+// StreamFence orders preceding non-temporal stores before any later
+// stores (SFENCE).
+func StreamFence()
@@ -0,0 +1,115 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !noasm && amd64
+
+#include "textflag.h"
+
+// func Prefetch(p unsafe.Pointer)
+TEXT ·Prefetch(SB), NOSPLIT, $0-8
+	MOVQ p+0(FP), AX
+	PREFETCHT0 (AX)
+	RET
+
+// func StreamCopy(dst, src unsafe.Pointer, n int)
+TEXT ·StreamCopy(SB), NOSPLIT, $0-24
+	MOVQ dst+0(FP), DI
+	MOVQ src+8(FP), SI
+	MOVQ n+16(FP), CX
+	MOVQ CX, DX
+	SHRQ $3, CX
+	TESTQ CX, CX
+	JZ   tail
+
+words:
+	MOVQ    (SI), AX
+	MOVNTIQ AX, (DI)
+	ADDQ    $8, SI
+	ADDQ    $8, DI
+	DECQ    CX
+	JNZ     words
+
+tail:
+	ANDQ $7, DX
+	JZ   done
+
+bytes:
+	MOVB (SI), AX
+	MOVB AX, (DI)
+	INCQ SI
+	INCQ DI
+	DECQ DX
+	JNZ  bytes
+
+done:
+	RET
+
+// func StreamStorePS256(dst, src unsafe.Pointer)
+TEXT ·StreamStorePS256(SB), NOSPLIT, $0-16
+	MOVQ dst+0(FP), DI
+	MOVQ src+8(FP), SI
+	VMOVUPS (SI), Y0
+	VMOVNTPS Y0, (DI)
+	VZEROUPPER
+	RET
+
+// func StreamStorePD256(dst, src unsafe.Pointer)
+TEXT ·StreamStorePD256(SB), NOSPLIT, $0-16
+	MOVQ dst+0(FP), DI
+	MOVQ src+8(FP), SI
+	VMOVUPD (SI), Y0
+	VMOVNTPD Y0, (DI)
+	VZEROUPPER
+	RET
+
+// func StreamStoreDQ256(dst, src unsafe.Pointer)
+TEXT ·StreamStoreDQ256(SB), NOSPLIT, $0-16
+	MOVQ dst+0(FP), DI
+	MOVQ src+8(FP), SI
+	VMOVDQU (SI), Y0
+	VMOVNTDQ Y0, (DI)
+	VZEROUPPER
+	RET
+
+// func StreamStorePS512(dst, src unsafe.Pointer)
+TEXT ·StreamStorePS512(SB), NOSPLIT, $0-16
+	MOVQ dst+0(FP), DI
+	MOVQ src+8(FP), SI
+	VMOVUPS (SI), Z0
+	VMOVNTPS Z0, (DI)
+	VZEROUPPER
+	RET
+
+// func StreamStorePD512(dst, src unsafe.Pointer)
+TEXT ·StreamStorePD512(SB), NOSPLIT, $0-16
+	MOVQ dst+0(FP), DI
+	MOVQ src+8(FP), SI
+	VMOVUPD (SI), Z0
+	VMOVNTPD Z0, (DI)
+	VZEROUPPER
+	RET
+
+// func StreamStoreDQ512(dst, src unsafe.Pointer)
+TEXT ·StreamStoreDQ512(SB), NOSPLIT, $0-16
+	MOVQ dst+0(FP), DI
+	MOVQ src+8(FP), SI
+	VMOVDQU64 (SI), Z0
+	VMOVNTDQ Z0, (DI)
+	VZEROUPPER
+	RET
+
+// func StreamFence()
+TEXT ·StreamFence(SB), NOSPLIT, $0-0
+	SFENCE
+	RET
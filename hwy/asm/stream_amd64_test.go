@@ -0,0 +1,63 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !noasm && amd64
+
+package asm
+
+import (
+	"testing"
+	"unsafe"
+
+	"golang.org/x/sys/cpu"
+)
+
+func TestStreamStoreVector(t *testing.T) {
+	tests := []struct {
+		name  string
+		size  int
+		store func(dst, src unsafe.Pointer)
+		ok    bool
+	}{
+		{"PS256", 32, StreamStorePS256, cpu.X86.HasAVX},
+		{"PD256", 32, StreamStorePD256, cpu.X86.HasAVX},
+		{"DQ256", 32, StreamStoreDQ256, cpu.X86.HasAVX},
+		{"PS512", 64, StreamStorePS512, cpu.X86.HasAVX512F},
+		{"PD512", 64, StreamStorePD512, cpu.X86.HasAVX512F},
+		{"DQ512", 64, StreamStoreDQ512, cpu.X86.HasAVX512F},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !tt.ok {
+				t.Skip("CPU lacks the required vector extension")
+			}
+			src := make([]byte, tt.size)
+			for i := range src {
+				src[i] = byte(i*7 + 1)
+			}
+			// Over-allocate so that dst can be aligned to the vector size.
+			buf := make([]byte, 2*tt.size)
+			off := (tt.size - int(uintptr(unsafe.Pointer(&buf[0])))%tt.size) % tt.size
+			dst := buf[off : off+tt.size]
+
+			tt.store(unsafe.Pointer(&dst[0]), unsafe.Pointer(&src[0]))
+			StreamFence()
+			for i := range dst {
+				if dst[i] != src[i] {
+					t.Fatalf("dst[%d] = %d, want %d", i, dst[i], src[i])
+				}
+			}
+		})
+	}
+}
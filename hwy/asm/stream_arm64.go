@@ -0,0 +1,34 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !noasm && arm64
+
+package asm
+
+import "unsafe"
+
+// Prefetch hints that the cache line containing p will be read soon
+// (PRFM PLDL1KEEP). It never faults, even if p is not a valid address.
+//
+//go:noescape
+func Prefetch(p unsafe.Pointer)
+
+// StreamCopy copies n bytes from src to dst. NEON has no non-temporal
+// store that is worth using for single vectors, so this is a plain copy.
+func StreamCopy(dst, src unsafe.Pointer, n int) {
+	copy(unsafe.Slice((*byte)(dst), n), unsafe.Slice((*byte)(src), n))
+}
+
+// StreamFence is a no-op on ARM64, since StreamCopy uses ordinary stores.
+func StreamFence() {}
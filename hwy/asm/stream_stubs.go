@@ -0,0 +1,48 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build noasm || !(amd64 || arm64)
+
+package asm
+
+import "unsafe"
+
+// Prefetch is a no-op on platforms without prefetch support.
+func Prefetch(p unsafe.Pointer) {}
+
+// StreamCopy copies n bytes from src to dst.
+func StreamCopy(dst, src unsafe.Pointer, n int) {
+	copy(unsafe.Slice((*byte)(dst), n), unsafe.Slice((*byte)(src), n))
+}
+
+// StreamStorePS256 copies 32 bytes from src to dst.
+func StreamStorePS256(dst, src unsafe.Pointer) { StreamCopy(dst, src, 32) }
+
+// StreamStorePD256 copies 32 bytes from src to dst.
+func StreamStorePD256(dst, src unsafe.Pointer) { StreamCopy(dst, src, 32) }
+
+// StreamStoreDQ256 copies 32 bytes from src to dst.
+func StreamStoreDQ256(dst, src unsafe.Pointer) { StreamCopy(dst, src, 32) }
+
+// StreamStorePS512 copies 64 bytes from src to dst.
+func StreamStorePS512(dst, src unsafe.Pointer) { StreamCopy(dst, src, 64) }
+
+// StreamStorePD512 copies 64 bytes from src to dst.
+func StreamStorePD512(dst, src unsafe.Pointer) { StreamCopy(dst, src, 64) }
+
+// StreamStoreDQ512 copies 64 bytes from src to dst.
+func StreamStoreDQ512(dst, src unsafe.Pointer) { StreamCopy(dst, src, 64) }
+
+// StreamFence is a no-op on platforms without non-temporal stores.
+func StreamFence() {}
@@ -0,0 +1,23 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//go:build !noasm && arm64
+
+#include "textflag.h"
+
+// func SVEVectorBytes() int
+TEXT ·SVEVectorBytes(SB), NOSPLIT, $0-8
+	// RDVL X0, #1: the Go assembler has no SVE mnemonics.
+	WORD $0x04bf5020
+	MOVD R0, ret+0(FP)
+	RET
@@ -0,0 +1,27 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !noasm && arm64
+
+package asm
+
+// Hand-written kernels in vec_bf16_neon_arm64.s.
+
+func bfdot_f32x4(acc, a, b [16]byte) (result [16]byte)
+
+func bfmmla_f32x4(acc, a, b [16]byte) (result [16]byte)
+
+func bfmlalb_f32x4(acc, a, b [16]byte) (result [16]byte)
+
+func bfmlalt_f32x4(acc, a, b [16]byte) (result [16]byte)
@@ -0,0 +1,105 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//go:build !noasm && arm64
+
+#include "textflag.h"
+
+// BF16 (ARMv8.6-A) dot-product and matrix-multiply building blocks for
+// BFloat16x8. The Go assembler has no mnemonics for them, so they are
+// encoded with WORD; each takes the Float32x4 accumulator in V2 and the
+// BF16 operands in V0 and V1.
+
+// func bfdot_f32x4(acc, a, b [16]byte) (result [16]byte)
+TEXT ·bfdot_f32x4(SB), NOSPLIT, $0-64
+	MOVD acc_0+0(FP), R9
+	MOVD acc_8+8(FP), R10
+	VMOV R9, V2.D[0]
+	VMOV R10, V2.D[1]
+	MOVD a_0+16(FP), R9
+	MOVD a_8+24(FP), R10
+	VMOV R9, V0.D[0]
+	VMOV R10, V0.D[1]
+	MOVD b_0+32(FP), R9
+	MOVD b_8+40(FP), R10
+	VMOV R9, V1.D[0]
+	VMOV R10, V1.D[1]
+	WORD $0x6e41fc02 // bfdot v2.4s, v0.8h, v1.8h
+	VMOV V2.D[0], R9
+	VMOV V2.D[1], R10
+	MOVD R9, result_0+48(FP)
+	MOVD R10, result_8+56(FP)
+	RET
+
+// func bfmmla_f32x4(acc, a, b [16]byte) (result [16]byte)
+TEXT ·bfmmla_f32x4(SB), NOSPLIT, $0-64
+	MOVD acc_0+0(FP), R9
+	MOVD acc_8+8(FP), R10
+	VMOV R9, V2.D[0]
+	VMOV R10, V2.D[1]
+	MOVD a_0+16(FP), R9
+	MOVD a_8+24(FP), R10
+	VMOV R9, V0.D[0]
+	VMOV R10, V0.D[1]
+	MOVD b_0+32(FP), R9
+	MOVD b_8+40(FP), R10
+	VMOV R9, V1.D[0]
+	VMOV R10, V1.D[1]
+	WORD $0x6e41ec02 // bfmmla v2.4s, v0.8h, v1.8h
+	VMOV V2.D[0], R9
+	VMOV V2.D[1], R10
+	MOVD R9, result_0+48(FP)
+	MOVD R10, result_8+56(FP)
+	RET
+
+// func bfmlalb_f32x4(acc, a, b [16]byte) (result [16]byte)
+TEXT ·bfmlalb_f32x4(SB), NOSPLIT, $0-64
+	MOVD acc_0+0(FP), R9
+	MOVD acc_8+8(FP), R10
+	VMOV R9, V2.D[0]
+	VMOV R10, V2.D[1]
+	MOVD a_0+16(FP), R9
+	MOVD a_8+24(FP), R10
+	VMOV R9, V0.D[0]
+	VMOV R10, V0.D[1]
+	MOVD b_0+32(FP), R9
+	MOVD b_8+40(FP), R10
+	VMOV R9, V1.D[0]
+	VMOV R10, V1.D[1]
+	WORD $0x2ec1fc02 // bfmlalb v2.4s, v0.8h, v1.8h
+	VMOV V2.D[0], R9
+	VMOV V2.D[1], R10
+	MOVD R9, result_0+48(FP)
+	MOVD R10, result_8+56(FP)
+	RET
+
+// func bfmlalt_f32x4(acc, a, b [16]byte) (result [16]byte)
+TEXT ·bfmlalt_f32x4(SB), NOSPLIT, $0-64
+	MOVD acc_0+0(FP), R9
+	MOVD acc_8+8(FP), R10
+	VMOV R9, V2.D[0]
+	VMOV R10, V2.D[1]
+	MOVD a_0+16(FP), R9
+	MOVD a_8+24(FP), R10
+	VMOV R9, V0.D[0]
+	VMOV R10, V0.D[1]
+	MOVD b_0+32(FP), R9
+	MOVD b_8+40(FP), R10
+	VMOV R9, V1.D[0]
+	VMOV R10, V1.D[1]
+	WORD $0x6ec1fc02 // bfmlalt v2.4s, v0.8h, v1.8h
+	VMOV V2.D[0], R9
+	VMOV V2.D[1], R10
+	MOVD R9, result_0+48(FP)
+	MOVD R10, result_8+56(FP)
+	RET
@@ -0,0 +1,73 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !noasm && arm64
+
+package asm
+
+import "golang.org/x/sys/cpu"
+
+// HasDotProd reports whether the Dot methods of Int8x16 and Uint8x16 can run
+// (FEAT_DotProd, SDOT/UDOT).
+var HasDotProd = cpu.ARM64.HasASIMDDP
+
+// HasI8MM reports whether DotInt8 and the MatMulAcc methods of Int8x16 and
+// Uint8x16 can run (FEAT_I8MM, USDOT/SMMLA/UMMLA).
+var HasI8MM = cpu.ARM64.HasI8MM
+
+// Dot returns acc plus the dot products of groups of 4 lanes of v and other
+// (SDOT): lane i of the result is acc[i] + sum(v[4i+j]*other[4i+j], j<4).
+// Callers must check HasDotProd.
+func (v Int8x16) Dot(other Int8x16, acc Int32x4) Int32x4 {
+	return Int32x4(sdot_i32x4([16]byte(acc), [16]byte(v), [16]byte(other)))
+}
+
+// Dot returns acc plus the dot products of groups of 4 lanes of v and other
+// (UDOT), as Int8x16.Dot. Callers must check HasDotProd.
+func (v Uint8x16) Dot(other Uint8x16, acc Uint32x4) Uint32x4 {
+	return Uint32x4(udot_u32x4([16]byte(acc), [16]byte(v), [16]byte(other)))
+}
+
+// DotInt8 returns acc plus the dot products of groups of 4 lanes of the
+// unsigned v and the signed other (USDOT), as Int8x16.Dot. This is the usual
+// product of quantized activations and weights. Callers must check HasI8MM.
+func (v Uint8x16) DotInt8(other Int8x16, acc Int32x4) Int32x4 {
+	return Int32x4(usdot_i32x4([16]byte(acc), [16]byte(v), [16]byte(other)))
+}
+
+// MatMulAcc returns acc plus the product of v and other (SMMLA). v is a
+// row-major 2x8 matrix, other holds the two columns of an 8x2 matrix, one
+// after the other, and acc and the result are row-major 2x2 matrices.
+// Callers must check HasI8MM.
+func (v Int8x16) MatMulAcc(other Int8x16, acc Int32x4) Int32x4 {
+	return Int32x4(smmla_i32x4([16]byte(acc), [16]byte(v), [16]byte(other)))
+}
+
+// MatMulAcc returns acc plus the product of v and other (UMMLA), as
+// Int8x16.MatMulAcc. Callers must check HasI8MM.
+func (v Uint8x16) MatMulAcc(other Uint8x16, acc Uint32x4) Uint32x4 {
+	return Uint32x4(ummla_u32x4([16]byte(acc), [16]byte(v), [16]byte(other)))
+}
+
+// Hand-written kernels in vec_dot_neon_arm64.s.
+
+func sdot_i32x4(acc, a, b [16]byte) (result [16]byte)
+
+func udot_u32x4(acc, a, b [16]byte) (result [16]byte)
+
+func usdot_i32x4(acc, a, b [16]byte) (result [16]byte)
+
+func smmla_i32x4(acc, a, b [16]byte) (result [16]byte)
+
+func ummla_u32x4(acc, a, b [16]byte) (result [16]byte)
@@ -0,0 +1,126 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//go:build !noasm && arm64
+
+#include "textflag.h"
+
+// 8-bit integer dot-product (FEAT_DotProd) and matrix-multiply (FEAT_I8MM)
+// building blocks. The Go assembler has no mnemonics for them, so they are
+// encoded with WORD; each takes the 32-bit accumulator in V2 and the 8-bit
+// operands in V0 and V1.
+
+// func sdot_i32x4(acc, a, b [16]byte) (result [16]byte)
+TEXT ·sdot_i32x4(SB), NOSPLIT, $0-64
+	MOVD acc_0+0(FP), R9
+	MOVD acc_8+8(FP), R10
+	VMOV R9, V2.D[0]
+	VMOV R10, V2.D[1]
+	MOVD a_0+16(FP), R9
+	MOVD a_8+24(FP), R10
+	VMOV R9, V0.D[0]
+	VMOV R10, V0.D[1]
+	MOVD b_0+32(FP), R9
+	MOVD b_8+40(FP), R10
+	VMOV R9, V1.D[0]
+	VMOV R10, V1.D[1]
+	WORD $0x4e819402 // sdot v2.4s, v0.16b, v1.16b
+	VMOV V2.D[0], R9
+	VMOV V2.D[1], R10
+	MOVD R9, result_0+48(FP)
+	MOVD R10, result_8+56(FP)
+	RET
+
+// func udot_u32x4(acc, a, b [16]byte) (result [16]byte)
+TEXT ·udot_u32x4(SB), NOSPLIT, $0-64
+	MOVD acc_0+0(FP), R9
+	MOVD acc_8+8(FP), R10
+	VMOV R9, V2.D[0]
+	VMOV R10, V2.D[1]
+	MOVD a_0+16(FP), R9
+	MOVD a_8+24(FP), R10
+	VMOV R9, V0.D[0]
+	VMOV R10, V0.D[1]
+	MOVD b_0+32(FP), R9
+	MOVD b_8+40(FP), R10
+	VMOV R9, V1.D[0]
+	VMOV R10, V1.D[1]
+	WORD $0x6e819402 // udot v2.4s, v0.16b, v1.16b
+	VMOV V2.D[0], R9
+	VMOV V2.D[1], R10
+	MOVD R9, result_0+48(FP)
+	MOVD R10, result_8+56(FP)
+	RET
+
+// func usdot_i32x4(acc, a, b [16]byte) (result [16]byte)
+TEXT ·usdot_i32x4(SB), NOSPLIT, $0-64
+	MOVD acc_0+0(FP), R9
+	MOVD acc_8+8(FP), R10
+	VMOV R9, V2.D[0]
+	VMOV R10, V2.D[1]
+	MOVD a_0+16(FP), R9
+	MOVD a_8+24(FP), R10
+	VMOV R9, V0.D[0]
+	VMOV R10, V0.D[1]
+	MOVD b_0+32(FP), R9
+	MOVD b_8+40(FP), R10
+	VMOV R9, V1.D[0]
+	VMOV R10, V1.D[1]
+	WORD $0x4e819c02 // usdot v2.4s, v0.16b, v1.16b
+	VMOV V2.D[0], R9
+	VMOV V2.D[1], R10
+	MOVD R9, result_0+48(FP)
+	MOVD R10, result_8+56(FP)
+	RET
+
+// func smmla_i32x4(acc, a, b [16]byte) (result [16]byte)
+TEXT ·smmla_i32x4(SB), NOSPLIT, $0-64
+	MOVD acc_0+0(FP), R9
+	MOVD acc_8+8(FP), R10
+	VMOV R9, V2.D[0]
+	VMOV R10, V2.D[1]
+	MOVD a_0+16(FP), R9
+	MOVD a_8+24(FP), R10
+	VMOV R9, V0.D[0]
+	VMOV R10, V0.D[1]
+	MOVD b_0+32(FP), R9
+	MOVD b_8+40(FP), R10
+	VMOV R9, V1.D[0]
+	VMOV R10, V1.D[1]
+	WORD $0x4e81a402 // smmla v2.4s, v0.16b, v1.16b
+	VMOV V2.D[0], R9
+	VMOV V2.D[1], R10
+	MOVD R9, result_0+48(FP)
+	MOVD R10, result_8+56(FP)
+	RET
+
+// func ummla_u32x4(acc, a, b [16]byte) (result [16]byte)
+TEXT ·ummla_u32x4(SB), NOSPLIT, $0-64
+	MOVD acc_0+0(FP), R9
+	MOVD acc_8+8(FP), R10
+	VMOV R9, V2.D[0]
+	VMOV R10, V2.D[1]
+	MOVD a_0+16(FP), R9
+	MOVD a_8+24(FP), R10
+	VMOV R9, V0.D[0]
+	VMOV R10, V0.D[1]
+	MOVD b_0+32(FP), R9
+	MOVD b_8+40(FP), R10
+	VMOV R9, V1.D[0]
+	VMOV R10, V1.D[1]
+	WORD $0x6e81a402 // ummla v2.4s, v0.16b, v1.16b
+	VMOV V2.D[0], R9
+	VMOV V2.D[1], R10
+	MOVD R9, result_0+48(FP)
+	MOVD R10, result_8+56(FP)
+	RET
@@ -0,0 +1,32 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build noasm || !arm64
+
+package asm
+
+// HasDotProd is false on platforms without the NEON dot-product extension.
+var HasDotProd = false
+
+// HasI8MM is false on platforms without the NEON int8 matrix extension.
+var HasI8MM = false
+
+// Stub implementations of the 8-bit dot-product methods for non-ARM64 or
+// noasm builds; callers must check HasDotProd and HasI8MM.
+
+func (v Int8x16) Dot(other Int8x16, acc Int32x4) Int32x4           { panic("DotProd not available") }
+func (v Uint8x16) Dot(other Uint8x16, acc Uint32x4) Uint32x4       { panic("DotProd not available") }
+func (v Uint8x16) DotInt8(other Int8x16, acc Int32x4) Int32x4      { panic("I8MM not available") }
+func (v Int8x16) MatMulAcc(other Int8x16, acc Int32x4) Int32x4     { panic("I8MM not available") }
+func (v Uint8x16) MatMulAcc(other Uint8x16, acc Uint32x4) Uint32x4 { panic("I8MM not available") }
@@ -0,0 +1,96 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !noasm && arm64
+
+package asm
+
+import "testing"
+
+func dotTestInputs() (a, b []int8, ua, ub []uint8) {
+	a = make([]int8, 16)
+	b = make([]int8, 16)
+	ua = make([]uint8, 16)
+	ub = make([]uint8, 16)
+	for i := range 16 {
+		a[i] = int8(i*37 - 128)
+		b[i] = int8(100 - i*13)
+		ua[i] = uint8(255 - i*7)
+		ub[i] = uint8(i*29 + 3)
+	}
+	return a, b, ua, ub
+}
+
+func TestInt8x16Dot(t *testing.T) {
+	if !HasDotProd {
+		t.Skip("CPU does not support DotProd")
+	}
+	a, b, ua, ub := dotTestInputs()
+	acc := []int32{1, -2, 3, -4}
+	uacc := []uint32{1, 2, 3, 4}
+
+	got := LoadInt8x16Slice(a).Dot(LoadInt8x16Slice(b), LoadInt32x4Slice(acc))
+	ugot := LoadUint8x16Slice(ua).Dot(LoadUint8x16Slice(ub), LoadUint32x4Slice(uacc))
+	for i := range 4 {
+		want, uwant := acc[i], uacc[i]
+		for j := 4 * i; j < 4*i+4; j++ {
+			want += int32(a[j]) * int32(b[j])
+			uwant += uint32(ua[j]) * uint32(ub[j])
+		}
+		if got.Get(i) != want {
+			t.Errorf("Int8x16.Dot lane %d = %d, want %d", i, got.Get(i), want)
+		}
+		if ugot.Get(i) != uwant {
+			t.Errorf("Uint8x16.Dot lane %d = %d, want %d", i, ugot.Get(i), uwant)
+		}
+	}
+}
+
+func TestInt8x16MatMulAcc(t *testing.T) {
+	if !HasI8MM {
+		t.Skip("CPU does not support I8MM")
+	}
+	a, b, ua, ub := dotTestInputs()
+	acc := []int32{1, -2, 3, -4}
+	uacc := []uint32{1, 2, 3, 4}
+
+	got := LoadInt8x16Slice(a).MatMulAcc(LoadInt8x16Slice(b), LoadInt32x4Slice(acc))
+	ugot := LoadUint8x16Slice(ua).MatMulAcc(LoadUint8x16Slice(ub), LoadUint32x4Slice(uacc))
+	for r := range 2 {
+		for c := range 2 {
+			want, uwant := acc[2*r+c], uacc[2*r+c]
+			for k := range 8 {
+				want += int32(a[8*r+k]) * int32(b[8*c+k])
+				uwant += uint32(ua[8*r+k]) * uint32(ub[8*c+k])
+			}
+			if got.Get(2*r+c) != want {
+				t.Errorf("Int8x16.MatMulAcc [%d][%d] = %d, want %d", r, c, got.Get(2*r+c), want)
+			}
+			if ugot.Get(2*r+c) != uwant {
+				t.Errorf("Uint8x16.MatMulAcc [%d][%d] = %d, want %d", r, c, ugot.Get(2*r+c), uwant)
+			}
+		}
+	}
+
+	mixed := LoadUint8x16Slice(ua).DotInt8(LoadInt8x16Slice(b), LoadInt32x4Slice(acc))
+	for i := range 4 {
+		want := acc[i]
+		for j := 4 * i; j < 4*i+4; j++ {
+			want += int32(ua[j]) * int32(b[j])
+		}
+		if mixed.Get(i) != want {
+			t.Errorf("Uint8x16.DotInt8 lane %d = %d, want %d", i, mixed.Get(i), want)
+		}
+	}
+}
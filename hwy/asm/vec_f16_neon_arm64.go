@@ -0,0 +1,31 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !noasm && arm64
+
+package asm
+
+// Hand-written kernels in vec_f16_neon_arm64.s.
+
+func gt_f16x8(a, b [16]byte) (result [16]byte)
+
+func ge_f16x8(a, b [16]byte) (result [16]byte)
+
+func eq_f16x8(a, b [16]byte) (result [16]byte)
+
+func ne_f16x8(a, b [16]byte) (result [16]byte)
+
+func promote_f16x8(a [16]byte) (lo, hi [16]byte)
+
+func demote_f32x4x2(lo, hi [16]byte) (result [16]byte)
@@ -0,0 +1,126 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//go:build !noasm && arm64
+
+#include "textflag.h"
+
+// Float16x8 comparisons and conversions to and from Float32x4. The compares
+// need FP16 (ARMv8.2-A), like the rest of Float16x8's arithmetic; the
+// conversions are base ARMv8. The Go assembler has no mnemonics for them,
+// so they are encoded with WORD.
+
+// func gt_f16x8(a, b [16]byte) (result [16]byte)
+TEXT ·gt_f16x8(SB), NOSPLIT, $0-48
+	MOVD a_0+0(FP), R9
+	MOVD a_8+8(FP), R10
+	VMOV R9, V0.D[0]
+	VMOV R10, V0.D[1]
+	MOVD b_0+16(FP), R9
+	MOVD b_8+24(FP), R10
+	VMOV R9, V1.D[0]
+	VMOV R10, V1.D[1]
+	WORD $0x6ec12400 // fcmgt v0.8h, v0.8h, v1.8h
+	VMOV V0.D[0], R9
+	VMOV V0.D[1], R10
+	MOVD R9, result_0+32(FP)
+	MOVD R10, result_8+40(FP)
+	RET
+
+// func ge_f16x8(a, b [16]byte) (result [16]byte)
+TEXT ·ge_f16x8(SB), NOSPLIT, $0-48
+	MOVD a_0+0(FP), R9
+	MOVD a_8+8(FP), R10
+	VMOV R9, V0.D[0]
+	VMOV R10, V0.D[1]
+	MOVD b_0+16(FP), R9
+	MOVD b_8+24(FP), R10
+	VMOV R9, V1.D[0]
+	VMOV R10, V1.D[1]
+	WORD $0x6e412400 // fcmge v0.8h, v0.8h, v1.8h
+	VMOV V0.D[0], R9
+	VMOV V0.D[1], R10
+	MOVD R9, result_0+32(FP)
+	MOVD R10, result_8+40(FP)
+	RET
+
+// func eq_f16x8(a, b [16]byte) (result [16]byte)
+TEXT ·eq_f16x8(SB), NOSPLIT, $0-48
+	MOVD a_0+0(FP), R9
+	MOVD a_8+8(FP), R10
+	VMOV R9, V0.D[0]
+	VMOV R10, V0.D[1]
+	MOVD b_0+16(FP), R9
+	MOVD b_8+24(FP), R10
+	VMOV R9, V1.D[0]
+	VMOV R10, V1.D[1]
+	WORD $0x4e412400 // fcmeq v0.8h, v0.8h, v1.8h
+	VMOV V0.D[0], R9
+	VMOV V0.D[1], R10
+	MOVD R9, result_0+32(FP)
+	MOVD R10, result_8+40(FP)
+	RET
+
+// func ne_f16x8(a, b [16]byte) (result [16]byte)
+TEXT ·ne_f16x8(SB), NOSPLIT, $0-48
+	MOVD a_0+0(FP), R9
+	MOVD a_8+8(FP), R10
+	VMOV R9, V0.D[0]
+	VMOV R10, V0.D[1]
+	MOVD b_0+16(FP), R9
+	MOVD b_8+24(FP), R10
+	VMOV R9, V1.D[0]
+	VMOV R10, V1.D[1]
+	WORD $0x4e412400 // fcmeq v0.8h, v0.8h, v1.8h
+	WORD $0x6e205800 // mvn v0.16b, v0.16b
+	VMOV V0.D[0], R9
+	VMOV V0.D[1], R10
+	MOVD R9, result_0+32(FP)
+	MOVD R10, result_8+40(FP)
+	RET
+
+// func promote_f16x8(a [16]byte) (lo, hi [16]byte)
+TEXT ·promote_f16x8(SB), NOSPLIT, $0-48
+	MOVD a_0+0(FP), R9
+	MOVD a_8+8(FP), R10
+	VMOV R9, V0.D[0]
+	VMOV R10, V0.D[1]
+	WORD $0x0e217801 // fcvtl v1.4s, v0.4h
+	WORD $0x4e217802 // fcvtl2 v2.4s, v0.8h
+	VMOV V1.D[0], R9
+	VMOV V1.D[1], R10
+	MOVD R9, lo_0+16(FP)
+	MOVD R10, lo_8+24(FP)
+	VMOV V2.D[0], R9
+	VMOV V2.D[1], R10
+	MOVD R9, hi_0+32(FP)
+	MOVD R10, hi_8+40(FP)
+	RET
+
+// func demote_f32x4x2(lo, hi [16]byte) (result [16]byte)
+TEXT ·demote_f32x4x2(SB), NOSPLIT, $0-48
+	MOVD lo_0+0(FP), R9
+	MOVD lo_8+8(FP), R10
+	VMOV R9, V1.D[0]
+	VMOV R10, V1.D[1]
+	MOVD hi_0+16(FP), R9
+	MOVD hi_8+24(FP), R10
+	VMOV R9, V2.D[0]
+	VMOV R10, V2.D[1]
+	WORD $0x0e216820 // fcvtn v0.4h, v1.4s
+	WORD $0x4e216840 // fcvtn2 v0.8h, v2.4s
+	VMOV V0.D[0], R9
+	VMOV V0.D[1], R10
+	MOVD R9, result_0+32(FP)
+	MOVD R10, result_8+40(FP)
+	RET
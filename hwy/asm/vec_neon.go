@@ -12,7 +12,7 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-//go:build !noasm && arm64
+//go:build !noasm && (arm64 || amd64)
 
 package asm
 
@@ -1065,6 +1065,11 @@ func BroadcastInt32x4(v int32) Int32x4 {
 	return *(*Int32x4)(unsafe.Pointer(&arr))
 }
 
+// ZeroInt32x4 returns a zero vector.
+func ZeroInt32x4() Int32x4 {
+	return Int32x4{}
+}
+
 // LoadInt32x4 loads 4 int32 values from an array pointer (no bounds check).
 func LoadInt32x4(p *[4]int32) Int32x4 {
 	return *(*Int32x4)(unsafe.Pointer(p))
@@ -1314,6 +1319,11 @@ func BroadcastInt64x2(v int64) Int64x2 {
 	return *(*Int64x2)(unsafe.Pointer(&arr))
 }
 
+// ZeroInt64x2 returns a zero vector.
+func ZeroInt64x2() Int64x2 {
+	return Int64x2{}
+}
+
 // LoadInt64x2 loads 2 int64 values from an array pointer (no bounds check).
 func LoadInt64x2(p *[2]int64) Int64x2 {
 	return *(*Int64x2)(unsafe.Pointer(p))
@@ -1602,6 +1612,42 @@ func FindFirstTrue[T Int32x4 | Int64x2 | Uint32x4 | Uint64x2](mask T) int {
 	return -1
 }
 
+// FindLastTrue returns the index of the last true lane in the mask, or -1 if none.
+// For integer masks, a non-zero value indicates true.
+func FindLastTrue[T Int32x4 | Int64x2 | Uint32x4 | Uint64x2](mask T) int {
+	switch m := any(mask).(type) {
+	case Int32x4:
+		a := (*[4]int32)(unsafe.Pointer(&m))
+		for i := 3; i >= 0; i-- {
+			if a[i] != 0 {
+				return i
+			}
+		}
+	case Int64x2:
+		a := (*[2]int64)(unsafe.Pointer(&m))
+		for i := 1; i >= 0; i-- {
+			if a[i] != 0 {
+				return i
+			}
+		}
+	case Uint32x4:
+		a := (*[4]uint32)(unsafe.Pointer(&m))
+		for i := 3; i >= 0; i-- {
+			if a[i] != 0 {
+				return i
+			}
+		}
+	case Uint64x2:
+		a := (*[2]uint64)(unsafe.Pointer(&m))
+		for i := 1; i >= 0; i-- {
+			if a[i] != 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
 // CountTrue returns the number of true lanes in the mask.
 // For integer masks, a non-zero value indicates true.
 func CountTrue[T Int32x4 | Int64x2 | Uint32x4 | Uint64x2](mask T) int {
@@ -2188,6 +2234,115 @@ func AllFalseValUint64(mask Uint64x2) bool {
 	return m[0] == 0 && m[1] == 0
 }
 
+// ============================================================================
+// Int8x16 - 128-bit vector of 16 int8 values
+// ============================================================================
+
+// Int8x16 represents a 128-bit NEON vector of 16 int8 values.
+type Int8x16 [16]byte
+
+// BroadcastInt8x16 creates a vector with all lanes set to the given value.
+func BroadcastInt8x16(v int8) Int8x16 {
+	var arr [16]int8
+	for i := range arr {
+		arr[i] = v
+	}
+	return *(*Int8x16)(unsafe.Pointer(&arr))
+}
+
+// LoadInt8x16 loads 16 int8 values from an array pointer (no bounds check).
+func LoadInt8x16(p *[16]int8) Int8x16 {
+	return *(*Int8x16)(unsafe.Pointer(p))
+}
+
+// LoadInt8x16Slice loads 16 int8 values from a slice (has bounds check).
+func LoadInt8x16Slice(s []int8) Int8x16 {
+	return *(*Int8x16)(unsafe.Pointer(&s[0]))
+}
+
+// ZeroInt8x16 returns a zero vector.
+func ZeroInt8x16() Int8x16 {
+	return Int8x16{}
+}
+
+// Get returns the element at the given index.
+func (v Int8x16) Get(i int) int8 {
+	return int8(v[i])
+}
+
+// Set sets the element at the given index.
+func (v *Int8x16) Set(i int, val int8) {
+	v[i] = byte(val)
+}
+
+// Store stores the vector to an array pointer (no bounds check).
+func (v Int8x16) Store(p *[16]int8) {
+	*(*Int8x16)(unsafe.Pointer(p)) = v
+}
+
+// StoreSlice stores the vector to a slice (has bounds check).
+func (v Int8x16) StoreSlice(s []int8) {
+	*(*Int8x16)(unsafe.Pointer(&s[0])) = v
+}
+
+// Data returns the underlying data as a slice.
+func (v Int8x16) Data() []int8 {
+	return (*[16]int8)(unsafe.Pointer(&v))[:]
+}
+
+// ============================================================================
+// Int16x8 - 128-bit vector of 8 int16 values
+// ============================================================================
+
+// Int16x8 represents a 128-bit NEON vector of 8 int16 values.
+type Int16x8 [16]byte
+
+// BroadcastInt16x8 creates a vector with all lanes set to the given value.
+func BroadcastInt16x8(v int16) Int16x8 {
+	arr := [8]int16{v, v, v, v, v, v, v, v}
+	return *(*Int16x8)(unsafe.Pointer(&arr))
+}
+
+// LoadInt16x8 loads 8 int16 values from an array pointer (no bounds check).
+func LoadInt16x8(p *[8]int16) Int16x8 {
+	return *(*Int16x8)(unsafe.Pointer(p))
+}
+
+// LoadInt16x8Slice loads 8 int16 values from a slice (has bounds check).
+func LoadInt16x8Slice(s []int16) Int16x8 {
+	return *(*Int16x8)(unsafe.Pointer(&s[0]))
+}
+
+// ZeroInt16x8 returns a zero vector.
+func ZeroInt16x8() Int16x8 {
+	return Int16x8{}
+}
+
+// Get returns the element at the given index.
+func (v Int16x8) Get(i int) int16 {
+	return (*[8]int16)(unsafe.Pointer(&v))[i]
+}
+
+// Set sets the element at the given index.
+func (v *Int16x8) Set(i int, val int16) {
+	(*[8]int16)(unsafe.Pointer(v))[i] = val
+}
+
+// Store stores the vector to an array pointer (no bounds check).
+func (v Int16x8) Store(p *[8]int16) {
+	*(*Int16x8)(unsafe.Pointer(p)) = v
+}
+
+// StoreSlice stores the vector to a slice (has bounds check).
+func (v Int16x8) StoreSlice(s []int16) {
+	*(*Int16x8)(unsafe.Pointer(&s[0])) = v
+}
+
+// Data returns the underlying data as a slice.
+func (v Int16x8) Data() []int16 {
+	return (*[8]int16)(unsafe.Pointer(&v))[:]
+}
+
 // ============================================================================
 // Uint8x16 - 128-bit vector of 16 uint8 values
 // ============================================================================
@@ -2692,6 +2847,74 @@ func (v Uint32x4) AsInt32x4() Int32x4 {
 	return Int32x4(v)
 }
 
+// AsFloat32x4 reinterprets bits as float32.
+func (v Uint32x4) AsFloat32x4() Float32x4 {
+	return Float32x4(v)
+}
+
+// ConvertToFloat32 converts uint32 to float32 (UCVTF).
+func (v Uint32x4) ConvertToFloat32() Float32x4 {
+	return Float32x4(cvt_u32x4_f32x4([16]byte(v)))
+}
+
+// Greater is an alias for GreaterThan (matches Int32x4).
+func (v Uint32x4) Greater(other Uint32x4) Uint32x4 {
+	return v.GreaterThan(other)
+}
+
+// Less is an alias for LessThan (matches Int32x4).
+func (v Uint32x4) Less(other Uint32x4) Uint32x4 {
+	return v.LessThan(other)
+}
+
+// Merge selects elements: mask ? v : other
+// mask should have all bits set for true, 0 for false.
+func (v Uint32x4) Merge(other Uint32x4, mask Uint32x4) Uint32x4 {
+	// Bitwise select, the same for signed and unsigned lanes
+	return Uint32x4(sel_i32x4([16]byte(mask), [16]byte(v), [16]byte(other)))
+}
+
+// ReduceMin returns the minimum element.
+func (v Uint32x4) ReduceMin() uint32 {
+	a := (*[4]uint32)(unsafe.Pointer(&v))
+	minVal := a[0]
+	for i := 1; i < 4; i++ {
+		if a[i] < minVal {
+			minVal = a[i]
+		}
+	}
+	return minVal
+}
+
+// ===== Uint32x4 in-place methods (allocation-free) =====
+
+// AddInto performs element-wise addition, storing result in *result.
+func (v Uint32x4) AddInto(other Uint32x4, result *Uint32x4) {
+	// Wrapping addition is the same for signed and unsigned lanes
+	add_i32x4_ip([16]byte(v), [16]byte(other), unsafe.Pointer(result))
+}
+
+// SubInto performs element-wise subtraction, storing result in *result.
+func (v Uint32x4) SubInto(other Uint32x4, result *Uint32x4) {
+	sub_i32x4_ip([16]byte(v), [16]byte(other), unsafe.Pointer(result))
+}
+
+// MulInto performs element-wise multiplication (low 32 bits), storing
+// result in *result.
+func (v Uint32x4) MulInto(other Uint32x4, result *Uint32x4) {
+	mul_i32x4_ip([16]byte(v), [16]byte(other), unsafe.Pointer(result))
+}
+
+// MinInto performs element-wise unsigned minimum, storing result in *result.
+func (v Uint32x4) MinInto(other Uint32x4, result *Uint32x4) {
+	*result = v.Min(other)
+}
+
+// MaxInto performs element-wise unsigned maximum, storing result in *result.
+func (v Uint32x4) MaxInto(other Uint32x4, result *Uint32x4) {
+	*result = v.Max(other)
+}
+
 // ============================================================================
 // Uint64x2 - 128-bit vector of 2 uint64 values
 // ============================================================================
@@ -2889,6 +3112,65 @@ func (v Uint64x2) AsInt64x2() Int64x2 {
 	return Int64x2(v)
 }
 
+// AsFloat64x2 reinterprets bits as float64.
+func (v Uint64x2) AsFloat64x2() Float64x2 {
+	return Float64x2(v)
+}
+
+// ConvertToFloat64 converts uint64 to float64.
+func (v Uint64x2) ConvertToFloat64() Float64x2 {
+	a := (*[2]uint64)(unsafe.Pointer(&v))
+	result := [2]float64{float64(a[0]), float64(a[1])}
+	return *(*Float64x2)(unsafe.Pointer(&result))
+}
+
+// AndNot performs a AND (NOT b).
+func (v Uint64x2) AndNot(other Uint64x2) Uint64x2 {
+	// Bitwise, the same for any lane width
+	return Uint64x2(andnot_u32x4([16]byte(v), [16]byte(other)))
+}
+
+// Greater is an alias for GreaterThan (matches Int64x2).
+func (v Uint64x2) Greater(other Uint64x2) Uint64x2 {
+	return v.GreaterThan(other)
+}
+
+// Less is an alias for LessThan (matches Int64x2).
+func (v Uint64x2) Less(other Uint64x2) Uint64x2 {
+	return v.LessThan(other)
+}
+
+// ReduceMin returns the minimum element.
+func (v Uint64x2) ReduceMin() uint64 {
+	a := (*[2]uint64)(unsafe.Pointer(&v))
+	if a[0] < a[1] {
+		return a[0]
+	}
+	return a[1]
+}
+
+// ===== Uint64x2 in-place methods (allocation-free) =====
+
+// AddInto performs element-wise addition, storing result in *result.
+func (v Uint64x2) AddInto(other Uint64x2, result *Uint64x2) {
+	*result = v.Add(other)
+}
+
+// SubInto performs element-wise subtraction, storing result in *result.
+func (v Uint64x2) SubInto(other Uint64x2, result *Uint64x2) {
+	*result = v.Sub(other)
+}
+
+// MinInto performs element-wise unsigned minimum, storing result in *result.
+func (v Uint64x2) MinInto(other Uint64x2, result *Uint64x2) {
+	*result = v.Min(other)
+}
+
+// MaxInto performs element-wise unsigned maximum, storing result in *result.
+func (v Uint64x2) MaxInto(other Uint64x2, result *Uint64x2) {
+	*result = v.Max(other)
+}
+
 // ===== SIMD Sorting Networks =====
 // These use Min/Max operations for efficient small array sorting.
 
@@ -0,0 +1,162 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !noasm && arm64
+
+package asm
+
+// ===== Pairwise adds (ADDP) =====
+
+// PairwiseAdd adds adjacent pairs of lanes (ADDP, wrapping): the lower half
+// of the result holds the sums of the pairs of v, the upper half those of
+// other.
+func (v Int8x16) PairwiseAdd(other Int8x16) Int8x16 {
+	return Int8x16(addp_8x16([16]byte(v), [16]byte(other)))
+}
+
+// PairwiseAdd adds adjacent pairs of lanes (ADDP, wrapping): the lower half
+// of the result holds the sums of the pairs of v, the upper half those of
+// other.
+func (v Uint8x16) PairwiseAdd(other Uint8x16) Uint8x16 {
+	return Uint8x16(addp_8x16([16]byte(v), [16]byte(other)))
+}
+
+// PairwiseAdd adds adjacent pairs of lanes (ADDP, wrapping): the lower half
+// of the result holds the sums of the pairs of v, the upper half those of
+// other.
+func (v Uint16x8) PairwiseAdd(other Uint16x8) Uint16x8 {
+	return Uint16x8(addp_16x8([16]byte(v), [16]byte(other)))
+}
+
+// PairwiseAdd adds adjacent pairs of lanes (ADDP, wrapping): the lower half
+// of the result holds the sums of the pairs of v, the upper half those of
+// other.
+func (v Int32x4) PairwiseAdd(other Int32x4) Int32x4 {
+	return Int32x4(addp_32x4([16]byte(v), [16]byte(other)))
+}
+
+// PairwiseAdd adds adjacent pairs of lanes (ADDP, wrapping): the lower half
+// of the result holds the sums of the pairs of v, the upper half those of
+// other.
+func (v Uint32x4) PairwiseAdd(other Uint32x4) Uint32x4 {
+	return Uint32x4(addp_32x4([16]byte(v), [16]byte(other)))
+}
+
+// PairwiseAdd adds adjacent pairs of lanes (ADDP, wrapping): the lower half
+// of the result holds the sums of the pairs of v, the upper half those of
+// other.
+func (v Int64x2) PairwiseAdd(other Int64x2) Int64x2 {
+	return Int64x2(addp_64x2([16]byte(v), [16]byte(other)))
+}
+
+// PairwiseAdd adds adjacent pairs of lanes (ADDP, wrapping): the lower half
+// of the result holds the sums of the pairs of v, the upper half those of
+// other.
+func (v Uint64x2) PairwiseAdd(other Uint64x2) Uint64x2 {
+	return Uint64x2(addp_64x2([16]byte(v), [16]byte(other)))
+}
+
+// ===== Pairwise widening adds (UADDLP, SADDLP, UADALP, SADALP) =====
+
+// AddWiden adds adjacent pairs of lanes into uint16 lanes (UADDLP): lane i
+// of the result is v[2i] + v[2i+1], which cannot overflow.
+func (v Uint8x16) AddWiden() Uint16x8 {
+	return Uint16x8(uaddlp_u8x16([16]byte(v)))
+}
+
+// AddWidenAcc returns acc plus v.AddWiden() (UADALP).
+func (v Uint8x16) AddWidenAcc(acc Uint16x8) Uint16x8 {
+	return Uint16x8(uadalp_u8x16([16]byte(acc), [16]byte(v)))
+}
+
+// AddWiden adds adjacent pairs of lanes into uint32 lanes (UADDLP): lane i
+// of the result is v[2i] + v[2i+1], which cannot overflow.
+func (v Uint16x8) AddWiden() Uint32x4 {
+	return Uint32x4(uaddlp_u16x8([16]byte(v)))
+}
+
+// AddWidenAcc returns acc plus v.AddWiden() (UADALP).
+func (v Uint16x8) AddWidenAcc(acc Uint32x4) Uint32x4 {
+	return Uint32x4(uadalp_u16x8([16]byte(acc), [16]byte(v)))
+}
+
+// AddWiden adds adjacent pairs of lanes into uint64 lanes (UADDLP): lane i
+// of the result is v[2i] + v[2i+1], which cannot overflow.
+func (v Uint32x4) AddWiden() Uint64x2 {
+	return Uint64x2(uaddlp_u32x4([16]byte(v)))
+}
+
+// AddWidenAcc returns acc plus v.AddWiden() (UADALP).
+func (v Uint32x4) AddWidenAcc(acc Uint64x2) Uint64x2 {
+	return Uint64x2(uadalp_u32x4([16]byte(acc), [16]byte(v)))
+}
+
+// AddWiden adds adjacent pairs of lanes into int64 lanes (SADDLP): lane i
+// of the result is v[2i] + v[2i+1], which cannot overflow.
+func (v Int32x4) AddWiden() Int64x2 {
+	return Int64x2(saddlp_i32x4([16]byte(v)))
+}
+
+// AddWidenAcc returns acc plus v.AddWiden() (SADALP).
+func (v Int32x4) AddWidenAcc(acc Int64x2) Int64x2 {
+	return Int64x2(sadalp_i32x4([16]byte(acc), [16]byte(v)))
+}
+
+// ===== Widening multiplies (SMULL, SMLAL) =====
+
+// MulWidenPairwiseAdd multiplies the lanes of v and other into int32
+// products and adds adjacent pairs (SMULL, SMULL2, ADDP): lane i of the
+// result is v[2i]*other[2i] + v[2i+1]*other[2i+1] (wrapping).
+func (v Int16x8) MulWidenPairwiseAdd(other Int16x8) Int32x4 {
+	return Int32x4(smull_addp_i16x8([16]byte(v), [16]byte(other)))
+}
+
+// MulWidenAcc multiplies the lanes of v and other into int32 products and
+// adds those of the lower 4 lanes to lo and those of the upper 4 to hi
+// (SMLAL, SMLAL2). lo.PairwiseAdd(hi) then holds the same sums as
+// MulWidenPairwiseAdd.
+func (v Int16x8) MulWidenAcc(other Int16x8, lo, hi Int32x4) (Int32x4, Int32x4) {
+	rlo, rhi := smlal_i16x8([16]byte(lo), [16]byte(hi), [16]byte(v), [16]byte(other))
+	return Int32x4(rlo), Int32x4(rhi)
+}
+
+// Hand-written kernels in vec_pairwise_neon_arm64.s.
+
+func addp_8x16(a, b [16]byte) (result [16]byte)
+
+func addp_16x8(a, b [16]byte) (result [16]byte)
+
+func addp_32x4(a, b [16]byte) (result [16]byte)
+
+func addp_64x2(a, b [16]byte) (result [16]byte)
+
+func uaddlp_u8x16(a [16]byte) (result [16]byte)
+
+func uaddlp_u16x8(a [16]byte) (result [16]byte)
+
+func uaddlp_u32x4(a [16]byte) (result [16]byte)
+
+func saddlp_i32x4(a [16]byte) (result [16]byte)
+
+func uadalp_u8x16(acc, a [16]byte) (result [16]byte)
+
+func uadalp_u16x8(acc, a [16]byte) (result [16]byte)
+
+func uadalp_u32x4(acc, a [16]byte) (result [16]byte)
+
+func sadalp_i32x4(acc, a [16]byte) (result [16]byte)
+
+func smull_addp_i16x8(a, b [16]byte) (result [16]byte)
+
+func smlal_i16x8(lo, hi, a, b [16]byte) (rlo, rhi [16]byte)
@@ -0,0 +1,259 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !noasm && arm64
+
+#include "textflag.h"
+
+// Pairwise adds (ADDP), pairwise widening adds (UADDLP, SADDLP) with
+// their accumulating forms (UADALP, SADALP), and int16 widening multiplies
+// (SMULL, SMLAL). The Go assembler has no mnemonics for the widening ones,
+// so they are encoded with WORD.
+
+// func addp_8x16(a, b [16]byte) (result [16]byte)
+TEXT ·addp_8x16(SB), NOSPLIT, $0-48
+	MOVD a_0+0(FP), R9
+	MOVD a_8+8(FP), R10
+	VMOV R9, V0.D[0]
+	VMOV R10, V0.D[1]
+	MOVD b_0+16(FP), R9
+	MOVD b_8+24(FP), R10
+	VMOV R9, V1.D[0]
+	VMOV R10, V1.D[1]
+	VADDP V1.B16, V0.B16, V2.B16
+	VMOV V2.D[0], R9
+	VMOV V2.D[1], R10
+	MOVD R9, result_0+32(FP)
+	MOVD R10, result_8+40(FP)
+	RET
+
+// func addp_16x8(a, b [16]byte) (result [16]byte)
+TEXT ·addp_16x8(SB), NOSPLIT, $0-48
+	MOVD a_0+0(FP), R9
+	MOVD a_8+8(FP), R10
+	VMOV R9, V0.D[0]
+	VMOV R10, V0.D[1]
+	MOVD b_0+16(FP), R9
+	MOVD b_8+24(FP), R10
+	VMOV R9, V1.D[0]
+	VMOV R10, V1.D[1]
+	VADDP V1.H8, V0.H8, V2.H8
+	VMOV V2.D[0], R9
+	VMOV V2.D[1], R10
+	MOVD R9, result_0+32(FP)
+	MOVD R10, result_8+40(FP)
+	RET
+
+// func addp_32x4(a, b [16]byte) (result [16]byte)
+TEXT ·addp_32x4(SB), NOSPLIT, $0-48
+	MOVD a_0+0(FP), R9
+	MOVD a_8+8(FP), R10
+	VMOV R9, V0.D[0]
+	VMOV R10, V0.D[1]
+	MOVD b_0+16(FP), R9
+	MOVD b_8+24(FP), R10
+	VMOV R9, V1.D[0]
+	VMOV R10, V1.D[1]
+	VADDP V1.S4, V0.S4, V2.S4
+	VMOV V2.D[0], R9
+	VMOV V2.D[1], R10
+	MOVD R9, result_0+32(FP)
+	MOVD R10, result_8+40(FP)
+	RET
+
+// func addp_64x2(a, b [16]byte) (result [16]byte)
+TEXT ·addp_64x2(SB), NOSPLIT, $0-48
+	MOVD a_0+0(FP), R9
+	MOVD a_8+8(FP), R10
+	VMOV R9, V0.D[0]
+	VMOV R10, V0.D[1]
+	MOVD b_0+16(FP), R9
+	MOVD b_8+24(FP), R10
+	VMOV R9, V1.D[0]
+	VMOV R10, V1.D[1]
+	VADDP V1.D2, V0.D2, V2.D2
+	VMOV V2.D[0], R9
+	VMOV V2.D[1], R10
+	MOVD R9, result_0+32(FP)
+	MOVD R10, result_8+40(FP)
+	RET
+
+// func uaddlp_u8x16(a [16]byte) (result [16]byte)
+TEXT ·uaddlp_u8x16(SB), NOSPLIT, $0-32
+	MOVD a_0+0(FP), R9
+	MOVD a_8+8(FP), R10
+	VMOV R9, V0.D[0]
+	VMOV R10, V0.D[1]
+	WORD $0x6e202802 // uaddlp v2.8h, v0.16b
+	VMOV V2.D[0], R9
+	VMOV V2.D[1], R10
+	MOVD R9, result_0+16(FP)
+	MOVD R10, result_8+24(FP)
+	RET
+
+// func uaddlp_u16x8(a [16]byte) (result [16]byte)
+TEXT ·uaddlp_u16x8(SB), NOSPLIT, $0-32
+	MOVD a_0+0(FP), R9
+	MOVD a_8+8(FP), R10
+	VMOV R9, V0.D[0]
+	VMOV R10, V0.D[1]
+	WORD $0x6e602802 // uaddlp v2.4s, v0.8h
+	VMOV V2.D[0], R9
+	VMOV V2.D[1], R10
+	MOVD R9, result_0+16(FP)
+	MOVD R10, result_8+24(FP)
+	RET
+
+// func uaddlp_u32x4(a [16]byte) (result [16]byte)
+TEXT ·uaddlp_u32x4(SB), NOSPLIT, $0-32
+	MOVD a_0+0(FP), R9
+	MOVD a_8+8(FP), R10
+	VMOV R9, V0.D[0]
+	VMOV R10, V0.D[1]
+	WORD $0x6ea02802 // uaddlp v2.2d, v0.4s
+	VMOV V2.D[0], R9
+	VMOV V2.D[1], R10
+	MOVD R9, result_0+16(FP)
+	MOVD R10, result_8+24(FP)
+	RET
+
+// func saddlp_i32x4(a [16]byte) (result [16]byte)
+TEXT ·saddlp_i32x4(SB), NOSPLIT, $0-32
+	MOVD a_0+0(FP), R9
+	MOVD a_8+8(FP), R10
+	VMOV R9, V0.D[0]
+	VMOV R10, V0.D[1]
+	WORD $0x4ea02802 // saddlp v2.2d, v0.4s
+	VMOV V2.D[0], R9
+	VMOV V2.D[1], R10
+	MOVD R9, result_0+16(FP)
+	MOVD R10, result_8+24(FP)
+	RET
+
+// func uadalp_u8x16(acc, a [16]byte) (result [16]byte)
+TEXT ·uadalp_u8x16(SB), NOSPLIT, $0-48
+	MOVD acc_0+0(FP), R9
+	MOVD acc_8+8(FP), R10
+	VMOV R9, V2.D[0]
+	VMOV R10, V2.D[1]
+	MOVD a_0+16(FP), R9
+	MOVD a_8+24(FP), R10
+	VMOV R9, V0.D[0]
+	VMOV R10, V0.D[1]
+	WORD $0x6e206802 // uadalp v2.8h, v0.16b
+	VMOV V2.D[0], R9
+	VMOV V2.D[1], R10
+	MOVD R9, result_0+32(FP)
+	MOVD R10, result_8+40(FP)
+	RET
+
+// func uadalp_u16x8(acc, a [16]byte) (result [16]byte)
+TEXT ·uadalp_u16x8(SB), NOSPLIT, $0-48
+	MOVD acc_0+0(FP), R9
+	MOVD acc_8+8(FP), R10
+	VMOV R9, V2.D[0]
+	VMOV R10, V2.D[1]
+	MOVD a_0+16(FP), R9
+	MOVD a_8+24(FP), R10
+	VMOV R9, V0.D[0]
+	VMOV R10, V0.D[1]
+	WORD $0x6e606802 // uadalp v2.4s, v0.8h
+	VMOV V2.D[0], R9
+	VMOV V2.D[1], R10
+	MOVD R9, result_0+32(FP)
+	MOVD R10, result_8+40(FP)
+	RET
+
+// func uadalp_u32x4(acc, a [16]byte) (result [16]byte)
+TEXT ·uadalp_u32x4(SB), NOSPLIT, $0-48
+	MOVD acc_0+0(FP), R9
+	MOVD acc_8+8(FP), R10
+	VMOV R9, V2.D[0]
+	VMOV R10, V2.D[1]
+	MOVD a_0+16(FP), R9
+	MOVD a_8+24(FP), R10
+	VMOV R9, V0.D[0]
+	VMOV R10, V0.D[1]
+	WORD $0x6ea06802 // uadalp v2.2d, v0.4s
+	VMOV V2.D[0], R9
+	VMOV V2.D[1], R10
+	MOVD R9, result_0+32(FP)
+	MOVD R10, result_8+40(FP)
+	RET
+
+// func sadalp_i32x4(acc, a [16]byte) (result [16]byte)
+TEXT ·sadalp_i32x4(SB), NOSPLIT, $0-48
+	MOVD acc_0+0(FP), R9
+	MOVD acc_8+8(FP), R10
+	VMOV R9, V2.D[0]
+	VMOV R10, V2.D[1]
+	MOVD a_0+16(FP), R9
+	MOVD a_8+24(FP), R10
+	VMOV R9, V0.D[0]
+	VMOV R10, V0.D[1]
+	WORD $0x4ea06802 // sadalp v2.2d, v0.4s
+	VMOV V2.D[0], R9
+	VMOV V2.D[1], R10
+	MOVD R9, result_0+32(FP)
+	MOVD R10, result_8+40(FP)
+	RET
+
+// func smull_addp_i16x8(a, b [16]byte) (result [16]byte)
+TEXT ·smull_addp_i16x8(SB), NOSPLIT, $0-48
+	MOVD a_0+0(FP), R9
+	MOVD a_8+8(FP), R10
+	VMOV R9, V0.D[0]
+	VMOV R10, V0.D[1]
+	MOVD b_0+16(FP), R9
+	MOVD b_8+24(FP), R10
+	VMOV R9, V1.D[0]
+	VMOV R10, V1.D[1]
+	WORD $0x0e61c003 // smull v3.4s, v0.4h, v1.4h
+	WORD $0x4e61c004 // smull2 v4.4s, v0.8h, v1.8h
+	VADDP V4.S4, V3.S4, V2.S4
+	VMOV V2.D[0], R9
+	VMOV V2.D[1], R10
+	MOVD R9, result_0+32(FP)
+	MOVD R10, result_8+40(FP)
+	RET
+
+// func smlal_i16x8(lo, hi, a, b [16]byte) (rlo, rhi [16]byte)
+TEXT ·smlal_i16x8(SB), NOSPLIT, $0-96
+	MOVD lo_0+0(FP), R9
+	MOVD lo_8+8(FP), R10
+	VMOV R9, V2.D[0]
+	VMOV R10, V2.D[1]
+	MOVD hi_0+16(FP), R9
+	MOVD hi_8+24(FP), R10
+	VMOV R9, V3.D[0]
+	VMOV R10, V3.D[1]
+	MOVD a_0+32(FP), R9
+	MOVD a_8+40(FP), R10
+	VMOV R9, V0.D[0]
+	VMOV R10, V0.D[1]
+	MOVD b_0+48(FP), R9
+	MOVD b_8+56(FP), R10
+	VMOV R9, V1.D[0]
+	VMOV R10, V1.D[1]
+	WORD $0x0e618002 // smlal v2.4s, v0.4h, v1.4h
+	WORD $0x4e618003 // smlal2 v3.4s, v0.8h, v1.8h
+	VMOV V2.D[0], R9
+	VMOV V2.D[1], R10
+	MOVD R9, rlo_0+64(FP)
+	MOVD R10, rlo_8+72(FP)
+	VMOV V3.D[0], R9
+	VMOV V3.D[1], R10
+	MOVD R9, rhi_0+80(FP)
+	MOVD R10, rhi_8+88(FP)
+	RET
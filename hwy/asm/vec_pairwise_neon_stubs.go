@@ -0,0 +1,42 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build noasm || !arm64
+
+package asm
+
+// Stub implementations of the pairwise add methods for non-ARM64 or noasm
+// builds.
+
+func (v Int8x16) PairwiseAdd(other Int8x16) Int8x16    { panic("NEON not available") }
+func (v Uint8x16) PairwiseAdd(other Uint8x16) Uint8x16 { panic("NEON not available") }
+func (v Uint16x8) PairwiseAdd(other Uint16x8) Uint16x8 { panic("NEON not available") }
+func (v Int32x4) PairwiseAdd(other Int32x4) Int32x4    { panic("NEON not available") }
+func (v Uint32x4) PairwiseAdd(other Uint32x4) Uint32x4 { panic("NEON not available") }
+func (v Int64x2) PairwiseAdd(other Int64x2) Int64x2    { panic("NEON not available") }
+func (v Uint64x2) PairwiseAdd(other Uint64x2) Uint64x2 { panic("NEON not available") }
+
+func (v Uint8x16) AddWiden() Uint16x8                { panic("NEON not available") }
+func (v Uint8x16) AddWidenAcc(acc Uint16x8) Uint16x8 { panic("NEON not available") }
+func (v Uint16x8) AddWiden() Uint32x4                { panic("NEON not available") }
+func (v Uint16x8) AddWidenAcc(acc Uint32x4) Uint32x4 { panic("NEON not available") }
+func (v Uint32x4) AddWiden() Uint64x2                { panic("NEON not available") }
+func (v Uint32x4) AddWidenAcc(acc Uint64x2) Uint64x2 { panic("NEON not available") }
+func (v Int32x4) AddWiden() Int64x2                  { panic("NEON not available") }
+func (v Int32x4) AddWidenAcc(acc Int64x2) Int64x2    { panic("NEON not available") }
+
+func (v Int16x8) MulWidenPairwiseAdd(other Int16x8) Int32x4 { panic("NEON not available") }
+func (v Int16x8) MulWidenAcc(other Int16x8, lo, hi Int32x4) (Int32x4, Int32x4) {
+	panic("NEON not available")
+}
@@ -0,0 +1,105 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !noasm && arm64
+
+package asm
+
+import "testing"
+
+func TestPairwiseAdd(t *testing.T) {
+	var a, b [16]uint8
+	for i := range 16 {
+		a[i] = uint8(250 + i) // pairs wrap
+		b[i] = uint8(i)
+	}
+	got := LoadUint8x16(&a).PairwiseAdd(LoadUint8x16(&b))
+	for i := range 8 {
+		if want := a[2*i] + a[2*i+1]; got.Get(i) != want {
+			t.Errorf("Uint8x16.PairwiseAdd[%d]: got %d, want %d", i, got.Get(i), want)
+		}
+		if want := b[2*i] + b[2*i+1]; got.Get(8+i) != want {
+			t.Errorf("Uint8x16.PairwiseAdd[%d]: got %d, want %d", 8+i, got.Get(8+i), want)
+		}
+	}
+
+	s := LoadInt32x4Slice([]int32{1, -5, 7, 100}).PairwiseAdd(LoadInt32x4Slice([]int32{-1, -2, 3, 4}))
+	for i, want := range []int32{-4, 107, -3, 7} {
+		if s.Get(i) != want {
+			t.Errorf("Int32x4.PairwiseAdd[%d]: got %d, want %d", i, s.Get(i), want)
+		}
+	}
+}
+
+func TestAddWiden(t *testing.T) {
+	var a [16]uint8
+	for i := range 16 {
+		a[i] = uint8(240 + i)
+	}
+	v := LoadUint8x16(&a)
+
+	// u8 -> u16 -> u32 -> u64 chain sums all lanes without overflow.
+	u16 := v.AddWiden()
+	for i := range 8 {
+		if want := uint16(a[2*i]) + uint16(a[2*i+1]); u16.Get(i) != want {
+			t.Errorf("Uint8x16.AddWiden[%d]: got %d, want %d", i, u16.Get(i), want)
+		}
+	}
+	u64 := u16.AddWiden().AddWiden()
+	var total uint64
+	for _, x := range a {
+		total += uint64(x)
+	}
+	if got := u64.Get(0) + u64.Get(1); got != total {
+		t.Errorf("AddWiden chain: got %d, want %d", got, total)
+	}
+
+	acc := BroadcastUint16x8(1000)
+	accGot := v.AddWidenAcc(acc)
+	for i := range 8 {
+		if want := 1000 + u16.Get(i); accGot.Get(i) != want {
+			t.Errorf("Uint8x16.AddWidenAcc[%d]: got %d, want %d", i, accGot.Get(i), want)
+		}
+	}
+
+	s := LoadInt32x4Slice([]int32{-2147483648, -1, 2147483647, 2147483647}).AddWiden()
+	if s.Get(0) != -2147483649 || s.Get(1) != 4294967294 {
+		t.Errorf("Int32x4.AddWiden: got [%d %d]", s.Get(0), s.Get(1))
+	}
+}
+
+func TestInt16x8MulWiden(t *testing.T) {
+	a := []int16{-32768, -32768, 32767, -32768, 1000, -7, 300, 12345}
+	b := []int16{-32768, -32768, 32767, 2, -3, 9, -300, 3}
+	va, vb := LoadInt16x8Slice(a), LoadInt16x8Slice(b)
+
+	// The first pair sums two -32768*-32768 products and wraps to int32 min.
+	sums := va.MulWidenPairwiseAdd(vb)
+	for i := range 4 {
+		want := int32(a[2*i])*int32(b[2*i]) + int32(a[2*i+1])*int32(b[2*i+1])
+		if sums.Get(i) != want {
+			t.Errorf("Int16x8.MulWidenPairwiseAdd[%d]: got %d, want %d", i, sums.Get(i), want)
+		}
+	}
+
+	lo, hi := va.MulWidenAcc(vb, LoadInt32x4Slice([]int32{1, 2, 3, 4}), LoadInt32x4Slice([]int32{-1, -2, -3, -4}))
+	for i := range 4 {
+		if want := int32(i+1) + int32(a[i])*int32(b[i]); lo.Get(i) != want {
+			t.Errorf("Int16x8.MulWidenAcc lo[%d]: got %d, want %d", i, lo.Get(i), want)
+		}
+		if want := -int32(i+1) + int32(a[4+i])*int32(b[4+i]); hi.Get(i) != want {
+			t.Errorf("Int16x8.MulWidenAcc hi[%d]: got %d, want %d", i, hi.Get(i), want)
+		}
+	}
+}
@@ -0,0 +1,639 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !noasm && amd64
+
+package asm
+
+import (
+	"math"
+	"unsafe"
+
+	"golang.org/x/sys/cpu"
+)
+
+// On amd64 the vector types of vec_neon.go are backed by the SSE2 kernels in
+// vec_sse2_amd64.s, which take the names of the NEON kernels they stand in
+// for. SSE2 is part of the amd64 baseline, so they work with any Go
+// toolchain and without GOEXPERIMENT=simd. Where x86 differs from NEON the
+// kernels keep the x86 behavior:
+//   - Min and Max of floats return the second operand when either is NaN.
+//   - ConvertToInt32 gives math.MinInt32 for NaN and out-of-range lanes.
+//   - Float64x2.ReciprocalSqrt is exact rather than an estimate.
+//   - MulAdd and MulSub are fused only when the CPU has FMA3.
+
+// x86HasFMA selects the fused forms of the multiply-add kernels.
+var x86HasFMA = cpu.X86.HasFMA && cpu.X86.HasAVX
+
+// x86HasSSSE3 selects the PSHUFB loop of tbl_u8_neon.
+var x86HasSSSE3 = cpu.X86.HasSSSE3
+
+// Pow2F32 computes 2^k for each int32 k, result as float32.
+// Like the NEON version, k < -126 gives 0 and k > 127 gives +Inf.
+func Pow2F32(k []int32, result []float32) {
+	for i, e := range k[:len(result)] {
+		switch {
+		case e < -126:
+			result[i] = 0
+		case e > 127:
+			result[i] = float32(math.Inf(1))
+		default:
+			result[i] = math.Float32frombits(uint32(e+127) << 23)
+		}
+	}
+}
+
+// Pow2F64 computes 2^k for each int32 k, result as float64.
+// Like the NEON version, k < -1022 gives 0 and k > 1023 gives +Inf.
+func Pow2F64(k []int32, result []float64) {
+	for i, e := range k[:len(result)] {
+		switch {
+		case e < -1022:
+			result[i] = 0
+		case e > 1023:
+			result[i] = math.Inf(1)
+		default:
+			result[i] = math.Float64frombits(uint64(e+1023) << 52)
+		}
+	}
+}
+
+// PowF64 computes power: result[i] = base[i] ^ exp[i]
+func PowF64(base, exp, result []float64) {
+	for i := range base {
+		result[i] = math.Pow(base[i], exp[i])
+	}
+}
+
+// firstNI32x4Asm creates a 4-element int32 mask with the first n lanes set to -1.
+func firstNI32x4Asm(count int, result *[4]int32) {
+	for i := range result {
+		result[i] = 0
+		if i < count {
+			result[i] = -1
+		}
+	}
+}
+
+// firstNI64x2Asm creates a 2-element int64 mask with the first n lanes set to -1.
+func firstNI64x2Asm(count int, result *[2]int64) {
+	for i := range result {
+		result[i] = 0
+		if i < count {
+			result[i] = -1
+		}
+	}
+}
+
+// Hand-written kernels in vec_sse2_amd64.s.
+
+//go:noescape
+func add_f32x4(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func sub_f32x4(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func mul_f32x4(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func div_f32x4(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func min_f32x4(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func max_f32x4(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func fma_f32x4(a, b, c [16]byte) (result [16]byte)
+
+//go:noescape
+func fms_f32x4(a, b, c [16]byte) (result [16]byte)
+
+//go:noescape
+func sqrt_f32x4(a [16]byte) (result [16]byte)
+
+//go:noescape
+func abs_f32x4(a [16]byte) (result [16]byte)
+
+//go:noescape
+func neg_f32x4(a [16]byte) (result [16]byte)
+
+//go:noescape
+func recip_f32x4(a [16]byte) (result [16]byte)
+
+//go:noescape
+func rsqrt_f32x4(a [16]byte) (result [16]byte)
+
+//go:noescape
+func and_f32x4(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func or_f32x4(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func xor_f32x4(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func eq_f32x4(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func lt_f32x4(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func le_f32x4(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func ne_f32x4(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func gt_f32x4(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func ge_f32x4(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func sel_f32x4(mask, yes, no [16]byte) (result [16]byte)
+
+//go:noescape
+func hsum_f32x4(v [16]byte) (result float32)
+
+//go:noescape
+func hmin_f32x4(v [16]byte) (result float32)
+
+//go:noescape
+func hmax_f32x4(v [16]byte) (result float32)
+
+//go:noescape
+func dot_f32x4(a, b [16]byte) (result float32)
+
+//go:noescape
+func round_f32x4(v [16]byte) (result [16]byte)
+
+//go:noescape
+func floor_f32x4(v [16]byte) (result [16]byte)
+
+//go:noescape
+func ceil_f32x4(v [16]byte) (result [16]byte)
+
+//go:noescape
+func trunc_f32x4(v [16]byte) (result [16]byte)
+
+//go:noescape
+func add_f32x2(a, b [8]byte) (result [8]byte)
+
+//go:noescape
+func sub_f32x2(a, b [8]byte) (result [8]byte)
+
+//go:noescape
+func mul_f32x2(a, b [8]byte) (result [8]byte)
+
+//go:noescape
+func div_f32x2(a, b [8]byte) (result [8]byte)
+
+//go:noescape
+func min_f32x2(a, b [8]byte) (result [8]byte)
+
+//go:noescape
+func max_f32x2(a, b [8]byte) (result [8]byte)
+
+//go:noescape
+func hsum_f32x2(v [8]byte) (result float32)
+
+//go:noescape
+func dot_f32x2(a, b [8]byte) (result float32)
+
+//go:noescape
+func cvt_f32x2_f64x2(v [8]byte) (result [16]byte)
+
+//go:noescape
+func add_f64x2(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func sub_f64x2(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func mul_f64x2(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func div_f64x2(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func min_f64x2(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func max_f64x2(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func fma_f64x2(a, b, c [16]byte) (result [16]byte)
+
+//go:noescape
+func sqrt_f64x2(a [16]byte) (result [16]byte)
+
+//go:noescape
+func abs_f64x2(a [16]byte) (result [16]byte)
+
+//go:noescape
+func neg_f64x2(a [16]byte) (result [16]byte)
+
+//go:noescape
+func rsqrt_f64x2(a [16]byte) (result [16]byte)
+
+//go:noescape
+func hsum_f64x2(v [16]byte) (result float64)
+
+//go:noescape
+func dot_f64x2(a, b [16]byte) (result float64)
+
+//go:noescape
+func add_f32x4_ip(a, b [16]byte, result unsafe.Pointer)
+
+//go:noescape
+func sub_f32x4_ip(a, b [16]byte, result unsafe.Pointer)
+
+//go:noescape
+func mul_f32x4_ip(a, b [16]byte, result unsafe.Pointer)
+
+//go:noescape
+func div_f32x4_ip(a, b [16]byte, result unsafe.Pointer)
+
+//go:noescape
+func min_f32x4_ip(a, b [16]byte, result unsafe.Pointer)
+
+//go:noescape
+func max_f32x4_ip(a, b [16]byte, result unsafe.Pointer)
+
+//go:noescape
+func muladd_f32x4_acc(a, b [16]byte, acc unsafe.Pointer)
+
+//go:noescape
+func muladd_f32x4_ip(a, b, c [16]byte, result unsafe.Pointer)
+
+//go:noescape
+func add_f64x2_ip(a, b [16]byte, result unsafe.Pointer)
+
+//go:noescape
+func sub_f64x2_ip(a, b [16]byte, result unsafe.Pointer)
+
+//go:noescape
+func mul_f64x2_ip(a, b [16]byte, result unsafe.Pointer)
+
+//go:noescape
+func div_f64x2_ip(a, b [16]byte, result unsafe.Pointer)
+
+//go:noescape
+func min_f64x2_ip(a, b [16]byte, result unsafe.Pointer)
+
+//go:noescape
+func max_f64x2_ip(a, b [16]byte, result unsafe.Pointer)
+
+//go:noescape
+func muladd_f64x2_acc(a, b [16]byte, acc unsafe.Pointer)
+
+//go:noescape
+func muladd_f64x2_ip(a, b, c [16]byte, result unsafe.Pointer)
+
+//go:noescape
+func add_i32x4(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func sub_i32x4(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func mul_i32x4(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func and_i32x4(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func or_i32x4(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func xor_i32x4(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func andnot_i32x4(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func not_i32x4(a [16]byte) (result [16]byte)
+
+//go:noescape
+func eq_i32x4(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func add_u32x4(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func sub_u32x4(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func mul_u32x4(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func and_u32x4(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func or_u32x4(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func xor_u32x4(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func andnot_u32x4(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func not_u32x4(a [16]byte) (result [16]byte)
+
+//go:noescape
+func eq_u32x4(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func gt_i32x4(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func lt_i32x4(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func min_i32x4(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func max_i32x4(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func add_i32x4_ip(a, b [16]byte, result unsafe.Pointer)
+
+//go:noescape
+func sub_i32x4_ip(a, b [16]byte, result unsafe.Pointer)
+
+//go:noescape
+func mul_i32x4_ip(a, b [16]byte, result unsafe.Pointer)
+
+//go:noescape
+func min_i32x4_ip(a, b [16]byte, result unsafe.Pointer)
+
+//go:noescape
+func max_i32x4_ip(a, b [16]byte, result unsafe.Pointer)
+
+//go:noescape
+func abs_i32x4(a [16]byte) (result [16]byte)
+
+//go:noescape
+func neg_i32x4(a [16]byte) (result [16]byte)
+
+//go:noescape
+func sel_i32x4(mask, yes, no [16]byte) (result [16]byte)
+
+//go:noescape
+func hsum_i32x4(v [16]byte) (result int64)
+
+//go:noescape
+func hsum_u32x4(v [16]byte) (result int64)
+
+//go:noescape
+func gt_u32x4(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func lt_u32x4(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func ge_u32x4(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func le_u32x4(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func min_u32x4(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func max_u32x4(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func adds_u32x4(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func subs_u32x4(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func cvt_f32x4_i32x4(v [16]byte) (result [16]byte)
+
+//go:noescape
+func cvt_i32x4_f32x4(v [16]byte) (result [16]byte)
+
+//go:noescape
+func cvt_u32x4_f32x4(a [16]byte) (result [16]byte)
+
+//go:noescape
+func counttrue_i32x4(mask [16]byte) (result int64)
+
+//go:noescape
+func alltrue_i32x4(mask [16]byte) (result int64)
+
+//go:noescape
+func anytrue_i32x4(mask [16]byte) (result int64)
+
+//go:noescape
+func add_i64x2(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func sub_i64x2(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func and_i64x2(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func or_i64x2(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func xor_i64x2(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func eq_i64x2(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func add_u64x2(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func sub_u64x2(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func and_u64x2(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func or_u64x2(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func xor_u64x2(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func eq_u64x2(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func sel_u64x2(mask, yes, no [16]byte) (result [16]byte)
+
+//go:noescape
+func lt_u64x2(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func gt_u64x2(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func le_u64x2(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func ge_u64x2(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func min_u64x2(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func max_u64x2(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func adds_u64x2(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func subs_u64x2(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func and_u16x8(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func or_u16x8(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func xor_u16x8(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func not_u16x8(a [16]byte) (result [16]byte)
+
+//go:noescape
+func eq_u16x8(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func adds_u16x8(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func subs_u16x8(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func gt_u16x8(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func lt_u16x8(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func ge_u16x8(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func le_u16x8(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func min_u16x8(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func max_u16x8(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func and_u8x16(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func or_u8x16(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func xor_u8x16(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func not_u8x16(a [16]byte) (result [16]byte)
+
+//go:noescape
+func eq_u8x16(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func min_u8x16(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func max_u8x16(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func adds_u8x16(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func subs_u8x16(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func ge_u8x16(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func le_u8x16(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func gt_u8x16(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func lt_u8x16(a, b [16]byte) (result [16]byte)
+
+//go:noescape
+func slide_up_1_f32x4(v [16]byte) (result [16]byte)
+
+//go:noescape
+func slide_up_2_f32x4(v [16]byte) (result [16]byte)
+
+//go:noescape
+func slide_up_1_i32x4(v [16]byte) (result [16]byte)
+
+//go:noescape
+func slide_up_2_i32x4(v [16]byte) (result [16]byte)
+
+//go:noescape
+func slide_up_1_u32x4(v [16]byte) (result [16]byte)
+
+//go:noescape
+func slide_up_2_u32x4(v [16]byte) (result [16]byte)
+
+//go:noescape
+func slide_up_1_f64x2(v [16]byte) (result [16]byte)
+
+//go:noescape
+func slide_up_1_i64x2(v [16]byte) (result [16]byte)
+
+//go:noescape
+func slide_up_1_u64x2(v [16]byte) (result [16]byte)
+
+//go:noescape
+func load4_f32x4(ptr, out0, out1, out2, out3 unsafe.Pointer)
+
+//go:noescape
+func load4_f64x2(ptr, out0, out1, out2, out3 unsafe.Pointer)
+
+//go:noescape
+func load4_i32x4(ptr, out0, out1, out2, out3 unsafe.Pointer)
+
+//go:noescape
+func load4_i64x2(ptr, out0, out1, out2, out3 unsafe.Pointer)
+
+//go:noescape
+func load4_u8x16(ptr, out0, out1, out2, out3 unsafe.Pointer)
+
+//go:noescape
+func load4_u16x8(ptr, out0, out1, out2, out3 unsafe.Pointer)
+
+//go:noescape
+func load4_u32x4(ptr, out0, out1, out2, out3 unsafe.Pointer)
+
+//go:noescape
+func load4_u64x2(ptr, out0, out1, out2, out3 unsafe.Pointer)
+
+//go:noescape
+func tbl_u8_neon(tbl, idx, result, len unsafe.Pointer)
@@ -0,0 +1,2074 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !noasm && amd64
+
+#include "textflag.h"
+
+// SSE2 kernels backing the vector types of vec_neon.go on amd64. Each has
+// the name and semantics of the NEON kernel it stands in for; comments note
+// where the x86 instruction differs. SSE2 is part of the amd64 baseline, so
+// only the FMA3 and SSSE3 paths are gated, on x86HasFMA and x86HasSSSE3.
+
+// ============================================================================
+// Float32x4
+// ============================================================================
+
+// func add_f32x4(a, b [16]byte) (result [16]byte)
+TEXT ·add_f32x4(SB), NOSPLIT, $0-48
+	MOVUPS a+0(FP), X0
+	MOVUPS b+16(FP), X1
+	ADDPS  X1, X0
+	MOVUPS X0, result+32(FP)
+	RET
+
+// func sub_f32x4(a, b [16]byte) (result [16]byte)
+TEXT ·sub_f32x4(SB), NOSPLIT, $0-48
+	MOVUPS a+0(FP), X0
+	MOVUPS b+16(FP), X1
+	SUBPS  X1, X0
+	MOVUPS X0, result+32(FP)
+	RET
+
+// func mul_f32x4(a, b [16]byte) (result [16]byte)
+TEXT ·mul_f32x4(SB), NOSPLIT, $0-48
+	MOVUPS a+0(FP), X0
+	MOVUPS b+16(FP), X1
+	MULPS  X1, X0
+	MOVUPS X0, result+32(FP)
+	RET
+
+// func div_f32x4(a, b [16]byte) (result [16]byte)
+TEXT ·div_f32x4(SB), NOSPLIT, $0-48
+	MOVUPS a+0(FP), X0
+	MOVUPS b+16(FP), X1
+	DIVPS  X1, X0
+	MOVUPS X0, result+32(FP)
+	RET
+
+// MINPS and MAXPS return b when either lane is NaN, where NEON returns NaN.
+// The same holds for the other min and max kernels on floats.
+
+// func min_f32x4(a, b [16]byte) (result [16]byte)
+TEXT ·min_f32x4(SB), NOSPLIT, $0-48
+	MOVUPS a+0(FP), X0
+	MOVUPS b+16(FP), X1
+	MINPS  X1, X0
+	MOVUPS X0, result+32(FP)
+	RET
+
+// func max_f32x4(a, b [16]byte) (result [16]byte)
+TEXT ·max_f32x4(SB), NOSPLIT, $0-48
+	MOVUPS a+0(FP), X0
+	MOVUPS b+16(FP), X1
+	MAXPS  X1, X0
+	MOVUPS X0, result+32(FP)
+	RET
+
+// func fma_f32x4(a, b, c [16]byte) (result [16]byte)
+TEXT ·fma_f32x4(SB), NOSPLIT, $0-64
+	MOVUPS      a+0(FP), X0
+	MOVUPS      b+16(FP), X1
+	MOVUPS      c+32(FP), X2
+	CMPB        ·x86HasFMA(SB), $0
+	JEQ         nofma
+	VFMADD231PS X1, X0, X2
+	MOVUPS      X2, result+48(FP)
+	RET
+nofma:
+	MULPS  X1, X0
+	ADDPS  X2, X0
+	MOVUPS X0, result+48(FP)
+	RET
+
+// fms_f32x4 computes a*b - c, as documented by Float32x4.MulSub.
+
+// func fms_f32x4(a, b, c [16]byte) (result [16]byte)
+TEXT ·fms_f32x4(SB), NOSPLIT, $0-64
+	MOVUPS      a+0(FP), X0
+	MOVUPS      b+16(FP), X1
+	MOVUPS      c+32(FP), X2
+	CMPB        ·x86HasFMA(SB), $0
+	JEQ         nofma
+	VFMSUB231PS X1, X0, X2
+	MOVUPS      X2, result+48(FP)
+	RET
+nofma:
+	MULPS  X1, X0
+	SUBPS  X2, X0
+	MOVUPS X0, result+48(FP)
+	RET
+
+// func sqrt_f32x4(a [16]byte) (result [16]byte)
+TEXT ·sqrt_f32x4(SB), NOSPLIT, $0-32
+	MOVUPS a+0(FP), X0
+	SQRTPS X0, X0
+	MOVUPS X0, result+16(FP)
+	RET
+
+// func abs_f32x4(a [16]byte) (result [16]byte)
+TEXT ·abs_f32x4(SB), NOSPLIT, $0-32
+	MOVUPS  a+0(FP), X0
+	PCMPEQL X1, X1
+	PSRLL   $1, X1
+	ANDPS   X1, X0
+	MOVUPS  X0, result+16(FP)
+	RET
+
+// func neg_f32x4(a [16]byte) (result [16]byte)
+TEXT ·neg_f32x4(SB), NOSPLIT, $0-32
+	MOVUPS  a+0(FP), X0
+	PCMPEQL X1, X1
+	PSLLL   $31, X1
+	XORPS   X1, X0
+	MOVUPS  X0, result+16(FP)
+	RET
+
+// func recip_f32x4(a [16]byte) (result [16]byte)
+TEXT ·recip_f32x4(SB), NOSPLIT, $0-32
+	MOVUPS a+0(FP), X0
+	RCPPS  X0, X0
+	MOVUPS X0, result+16(FP)
+	RET
+
+// func rsqrt_f32x4(a [16]byte) (result [16]byte)
+TEXT ·rsqrt_f32x4(SB), NOSPLIT, $0-32
+	MOVUPS  a+0(FP), X0
+	RSQRTPS X0, X0
+	MOVUPS  X0, result+16(FP)
+	RET
+
+// func and_f32x4(a, b [16]byte) (result [16]byte)
+TEXT ·and_f32x4(SB), NOSPLIT, $0-48
+	MOVUPS a+0(FP), X0
+	MOVUPS b+16(FP), X1
+	ANDPS  X1, X0
+	MOVUPS X0, result+32(FP)
+	RET
+
+// func or_f32x4(a, b [16]byte) (result [16]byte)
+TEXT ·or_f32x4(SB), NOSPLIT, $0-48
+	MOVUPS a+0(FP), X0
+	MOVUPS b+16(FP), X1
+	ORPS   X1, X0
+	MOVUPS X0, result+32(FP)
+	RET
+
+// func xor_f32x4(a, b [16]byte) (result [16]byte)
+TEXT ·xor_f32x4(SB), NOSPLIT, $0-48
+	MOVUPS a+0(FP), X0
+	MOVUPS b+16(FP), X1
+	XORPS  X1, X0
+	MOVUPS X0, result+32(FP)
+	RET
+
+// func eq_f32x4(a, b [16]byte) (result [16]byte)
+TEXT ·eq_f32x4(SB), NOSPLIT, $0-48
+	MOVUPS a+0(FP), X0
+	MOVUPS b+16(FP), X1
+	CMPPS  X1, X0, $0
+	MOVUPS X0, result+32(FP)
+	RET
+
+// func lt_f32x4(a, b [16]byte) (result [16]byte)
+TEXT ·lt_f32x4(SB), NOSPLIT, $0-48
+	MOVUPS a+0(FP), X0
+	MOVUPS b+16(FP), X1
+	CMPPS  X1, X0, $1
+	MOVUPS X0, result+32(FP)
+	RET
+
+// func le_f32x4(a, b [16]byte) (result [16]byte)
+TEXT ·le_f32x4(SB), NOSPLIT, $0-48
+	MOVUPS a+0(FP), X0
+	MOVUPS b+16(FP), X1
+	CMPPS  X1, X0, $2
+	MOVUPS X0, result+32(FP)
+	RET
+
+// func ne_f32x4(a, b [16]byte) (result [16]byte)
+TEXT ·ne_f32x4(SB), NOSPLIT, $0-48
+	MOVUPS a+0(FP), X0
+	MOVUPS b+16(FP), X1
+	CMPPS  X1, X0, $4
+	MOVUPS X0, result+32(FP)
+	RET
+
+// func gt_f32x4(a, b [16]byte) (result [16]byte)
+TEXT ·gt_f32x4(SB), NOSPLIT, $0-48
+	MOVUPS b+16(FP), X0
+	MOVUPS a+0(FP), X1
+	CMPPS  X1, X0, $1
+	MOVUPS X0, result+32(FP)
+	RET
+
+// func ge_f32x4(a, b [16]byte) (result [16]byte)
+TEXT ·ge_f32x4(SB), NOSPLIT, $0-48
+	MOVUPS b+16(FP), X0
+	MOVUPS a+0(FP), X1
+	CMPPS  X1, X0, $2
+	MOVUPS X0, result+32(FP)
+	RET
+
+// func sel_f32x4(mask, yes, no [16]byte) (result [16]byte)
+TEXT ·sel_f32x4(SB), NOSPLIT, $0-64
+	MOVUPS mask+0(FP), X0
+	MOVUPS yes+16(FP), X1
+	MOVUPS no+32(FP), X2
+	PAND   X0, X1
+	PANDN  X2, X0
+	POR    X1, X0
+	MOVUPS X0, result+48(FP)
+	RET
+
+// func hsum_f32x4(v [16]byte) (result float32)
+TEXT ·hsum_f32x4(SB), NOSPLIT, $0-20
+	MOVUPS v+0(FP), X0
+	PSHUFD $0xB1, X0, X1
+	ADDPS  X1, X0
+	PSHUFD $0x4E, X0, X1
+	ADDSS  X1, X0
+	MOVSS  X0, result+16(FP)
+	RET
+
+// func hmin_f32x4(v [16]byte) (result float32)
+TEXT ·hmin_f32x4(SB), NOSPLIT, $0-20
+	MOVUPS v+0(FP), X0
+	PSHUFD $0xB1, X0, X1
+	MINPS  X1, X0
+	PSHUFD $0x4E, X0, X1
+	MINSS  X1, X0
+	MOVSS  X0, result+16(FP)
+	RET
+
+// func hmax_f32x4(v [16]byte) (result float32)
+TEXT ·hmax_f32x4(SB), NOSPLIT, $0-20
+	MOVUPS v+0(FP), X0
+	PSHUFD $0xB1, X0, X1
+	MAXPS  X1, X0
+	PSHUFD $0x4E, X0, X1
+	MAXSS  X1, X0
+	MOVSS  X0, result+16(FP)
+	RET
+
+// func dot_f32x4(a, b [16]byte) (result float32)
+TEXT ·dot_f32x4(SB), NOSPLIT, $0-36
+	MOVUPS a+0(FP), X0
+	MOVUPS b+16(FP), X1
+	MULPS  X1, X0
+	PSHUFD $0xB1, X0, X1
+	ADDPS  X1, X0
+	PSHUFD $0x4E, X0, X1
+	ADDSS  X1, X0
+	MOVSS  X0, result+32(FP)
+	RET
+
+// The rounding kernels work on |v| and restore the sign at the end, so that
+// results rounding to zero keep the sign of v. Lanes with |v| >= 2^23 are
+// integers already (or NaN or Inf) and pass through unchanged.
+
+// func round_f32x4(v [16]byte) (result [16]byte)
+TEXT ·round_f32x4(SB), NOSPLIT, $0-32
+	MOVUPS  v+0(FP), X0
+	PCMPEQL X4, X4
+	PSRLL   $1, X4
+	MOVAPS  X4, X2
+	ANDNPS  X0, X2
+	MOVAPS  X0, X1
+	ANDPS   X4, X1
+	MOVL    $0x4b000000, AX
+	MOVQ    AX, X5
+	PSHUFD  $0, X5, X5
+	MOVAPS  X1, X3
+	ADDPS   X5, X3
+	SUBPS   X5, X3
+	MOVAPS  X1, X6
+	CMPPS   X5, X6, $1
+	ANDPS   X6, X3
+	ANDNPS  X1, X6
+	ORPS    X6, X3
+	ORPS    X2, X3
+	MOVUPS  X3, result+16(FP)
+	RET
+
+// func floor_f32x4(v [16]byte) (result [16]byte)
+TEXT ·floor_f32x4(SB), NOSPLIT, $0-32
+	MOVUPS  v+0(FP), X0
+	PCMPEQL X4, X4
+	PSRLL   $1, X4
+	MOVAPS  X4, X2
+	ANDNPS  X0, X2
+	MOVAPS  X0, X1
+	ANDPS   X4, X1
+	MOVL    $0x4b000000, AX
+	MOVQ    AX, X5
+	PSHUFD  $0, X5, X5
+	MOVAPS  X1, X3
+	ADDPS   X5, X3
+	SUBPS   X5, X3
+	MOVAPS  X1, X6
+	CMPPS   X5, X6, $1
+	ANDPS   X6, X3
+	ANDNPS  X1, X6
+	ORPS    X6, X3
+	ORPS    X2, X3
+	MOVAPS  X0, X6
+	CMPPS   X3, X6, $1
+	MOVL    $0x3f800000, AX
+	MOVQ    AX, X7
+	PSHUFD  $0, X7, X7
+	ANDPS   X7, X6
+	SUBPS   X6, X3
+	MOVUPS  X3, result+16(FP)
+	RET
+
+// func ceil_f32x4(v [16]byte) (result [16]byte)
+TEXT ·ceil_f32x4(SB), NOSPLIT, $0-32
+	MOVUPS  v+0(FP), X0
+	PCMPEQL X4, X4
+	PSRLL   $1, X4
+	MOVAPS  X4, X2
+	ANDNPS  X0, X2
+	MOVAPS  X0, X1
+	ANDPS   X4, X1
+	MOVL    $0x4b000000, AX
+	MOVQ    AX, X5
+	PSHUFD  $0, X5, X5
+	MOVAPS  X1, X3
+	ADDPS   X5, X3
+	SUBPS   X5, X3
+	MOVAPS  X1, X6
+	CMPPS   X5, X6, $1
+	ANDPS   X6, X3
+	ANDNPS  X1, X6
+	ORPS    X6, X3
+	ORPS    X2, X3
+	MOVAPS  X3, X6
+	CMPPS   X0, X6, $1
+	MOVL    $0x3f800000, AX
+	MOVQ    AX, X7
+	PSHUFD  $0, X7, X7
+	ANDPS   X7, X6
+	ADDPS   X6, X3
+	ORPS    X2, X3
+	MOVUPS  X3, result+16(FP)
+	RET
+
+// func trunc_f32x4(v [16]byte) (result [16]byte)
+TEXT ·trunc_f32x4(SB), NOSPLIT, $0-32
+	MOVUPS    v+0(FP), X0
+	PCMPEQL   X4, X4
+	PSRLL     $1, X4
+	MOVAPS    X4, X2
+	ANDNPS    X0, X2
+	MOVAPS    X0, X1
+	ANDPS     X4, X1
+	MOVL      $0x4b000000, AX
+	MOVQ      AX, X5
+	PSHUFD    $0, X5, X5
+	CVTTPS2PL X1, X3
+	CVTPL2PS  X3, X3
+	MOVAPS    X1, X6
+	CMPPS     X5, X6, $1
+	ANDPS     X6, X3
+	ANDNPS    X1, X6
+	ORPS      X6, X3
+	ORPS      X2, X3
+	MOVUPS    X3, result+16(FP)
+	RET
+
+// ============================================================================
+// Float32x2
+// ============================================================================
+
+// func add_f32x2(a, b [8]byte) (result [8]byte)
+TEXT ·add_f32x2(SB), NOSPLIT, $0-24
+	MOVLPS a+0(FP), X0
+	MOVLPS b+8(FP), X1
+	ADDPS  X1, X0
+	MOVLPS X0, result+16(FP)
+	RET
+
+// func sub_f32x2(a, b [8]byte) (result [8]byte)
+TEXT ·sub_f32x2(SB), NOSPLIT, $0-24
+	MOVLPS a+0(FP), X0
+	MOVLPS b+8(FP), X1
+	SUBPS  X1, X0
+	MOVLPS X0, result+16(FP)
+	RET
+
+// func mul_f32x2(a, b [8]byte) (result [8]byte)
+TEXT ·mul_f32x2(SB), NOSPLIT, $0-24
+	MOVLPS a+0(FP), X0
+	MOVLPS b+8(FP), X1
+	MULPS  X1, X0
+	MOVLPS X0, result+16(FP)
+	RET
+
+// func div_f32x2(a, b [8]byte) (result [8]byte)
+TEXT ·div_f32x2(SB), NOSPLIT, $0-24
+	MOVLPS a+0(FP), X0
+	MOVLPS b+8(FP), X1
+	DIVPS  X1, X0
+	MOVLPS X0, result+16(FP)
+	RET
+
+// func min_f32x2(a, b [8]byte) (result [8]byte)
+TEXT ·min_f32x2(SB), NOSPLIT, $0-24
+	MOVLPS a+0(FP), X0
+	MOVLPS b+8(FP), X1
+	MINPS  X1, X0
+	MOVLPS X0, result+16(FP)
+	RET
+
+// func max_f32x2(a, b [8]byte) (result [8]byte)
+TEXT ·max_f32x2(SB), NOSPLIT, $0-24
+	MOVLPS a+0(FP), X0
+	MOVLPS b+8(FP), X1
+	MAXPS  X1, X0
+	MOVLPS X0, result+16(FP)
+	RET
+
+// func hsum_f32x2(v [8]byte) (result float32)
+TEXT ·hsum_f32x2(SB), NOSPLIT, $0-12
+	MOVLPS v+0(FP), X0
+	PSHUFD $1, X0, X1
+	ADDSS  X1, X0
+	MOVSS  X0, result+8(FP)
+	RET
+
+// func dot_f32x2(a, b [8]byte) (result float32)
+TEXT ·dot_f32x2(SB), NOSPLIT, $0-20
+	MOVLPS a+0(FP), X0
+	MOVLPS b+8(FP), X1
+	MULPS  X1, X0
+	PSHUFD $1, X0, X1
+	ADDSS  X1, X0
+	MOVSS  X0, result+16(FP)
+	RET
+
+// func cvt_f32x2_f64x2(v [8]byte) (result [16]byte)
+TEXT ·cvt_f32x2_f64x2(SB), NOSPLIT, $0-24
+	MOVLPS   v+0(FP), X0
+	CVTPS2PD X0, X0
+	MOVUPS   X0, result+8(FP)
+	RET
+
+// ============================================================================
+// Float64x2
+// ============================================================================
+
+// func add_f64x2(a, b [16]byte) (result [16]byte)
+TEXT ·add_f64x2(SB), NOSPLIT, $0-48
+	MOVUPS a+0(FP), X0
+	MOVUPS b+16(FP), X1
+	ADDPD  X1, X0
+	MOVUPS X0, result+32(FP)
+	RET
+
+// func sub_f64x2(a, b [16]byte) (result [16]byte)
+TEXT ·sub_f64x2(SB), NOSPLIT, $0-48
+	MOVUPS a+0(FP), X0
+	MOVUPS b+16(FP), X1
+	SUBPD  X1, X0
+	MOVUPS X0, result+32(FP)
+	RET
+
+// func mul_f64x2(a, b [16]byte) (result [16]byte)
+TEXT ·mul_f64x2(SB), NOSPLIT, $0-48
+	MOVUPS a+0(FP), X0
+	MOVUPS b+16(FP), X1
+	MULPD  X1, X0
+	MOVUPS X0, result+32(FP)
+	RET
+
+// func div_f64x2(a, b [16]byte) (result [16]byte)
+TEXT ·div_f64x2(SB), NOSPLIT, $0-48
+	MOVUPS a+0(FP), X0
+	MOVUPS b+16(FP), X1
+	DIVPD  X1, X0
+	MOVUPS X0, result+32(FP)
+	RET
+
+// func min_f64x2(a, b [16]byte) (result [16]byte)
+TEXT ·min_f64x2(SB), NOSPLIT, $0-48
+	MOVUPS a+0(FP), X0
+	MOVUPS b+16(FP), X1
+	MINPD  X1, X0
+	MOVUPS X0, result+32(FP)
+	RET
+
+// func max_f64x2(a, b [16]byte) (result [16]byte)
+TEXT ·max_f64x2(SB), NOSPLIT, $0-48
+	MOVUPS a+0(FP), X0
+	MOVUPS b+16(FP), X1
+	MAXPD  X1, X0
+	MOVUPS X0, result+32(FP)
+	RET
+
+// func fma_f64x2(a, b, c [16]byte) (result [16]byte)
+TEXT ·fma_f64x2(SB), NOSPLIT, $0-64
+	MOVUPS      a+0(FP), X0
+	MOVUPS      b+16(FP), X1
+	MOVUPS      c+32(FP), X2
+	CMPB        ·x86HasFMA(SB), $0
+	JEQ         nofma
+	VFMADD231PD X1, X0, X2
+	MOVUPS      X2, result+48(FP)
+	RET
+nofma:
+	MULPD  X1, X0
+	ADDPD  X2, X0
+	MOVUPS X0, result+48(FP)
+	RET
+
+// func sqrt_f64x2(a [16]byte) (result [16]byte)
+TEXT ·sqrt_f64x2(SB), NOSPLIT, $0-32
+	MOVUPS a+0(FP), X0
+	SQRTPD X0, X0
+	MOVUPS X0, result+16(FP)
+	RET
+
+// func abs_f64x2(a [16]byte) (result [16]byte)
+TEXT ·abs_f64x2(SB), NOSPLIT, $0-32
+	MOVUPS  a+0(FP), X0
+	PCMPEQL X1, X1
+	PSRLQ   $1, X1
+	ANDPD   X1, X0
+	MOVUPS  X0, result+16(FP)
+	RET
+
+// func neg_f64x2(a [16]byte) (result [16]byte)
+TEXT ·neg_f64x2(SB), NOSPLIT, $0-32
+	MOVUPS  a+0(FP), X0
+	PCMPEQL X1, X1
+	PSLLQ   $63, X1
+	XORPD   X1, X0
+	MOVUPS  X0, result+16(FP)
+	RET
+
+// There is no packed double rsqrt estimate before AVX-512, so rsqrt_f64x2
+// computes 1/sqrt(a) exactly.
+
+// func rsqrt_f64x2(a [16]byte) (result [16]byte)
+TEXT ·rsqrt_f64x2(SB), NOSPLIT, $0-32
+	MOVUPS     a+0(FP), X0
+	SQRTPD     X0, X0
+	MOVQ       $0x3ff0000000000000, AX
+	MOVQ       AX, X1
+	PUNPCKLQDQ X1, X1
+	DIVPD      X0, X1
+	MOVAPD     X1, X0
+	MOVUPS     X0, result+16(FP)
+	RET
+
+// func hsum_f64x2(v [16]byte) (result float64)
+TEXT ·hsum_f64x2(SB), NOSPLIT, $0-24
+	MOVUPS v+0(FP), X0
+	PSHUFD $0x4E, X0, X1
+	ADDSD  X1, X0
+	MOVSD  X0, result+16(FP)
+	RET
+
+// func dot_f64x2(a, b [16]byte) (result float64)
+TEXT ·dot_f64x2(SB), NOSPLIT, $0-40
+	MOVUPS a+0(FP), X0
+	MOVUPS b+16(FP), X1
+	MULPD  X1, X0
+	PSHUFD $0x4E, X0, X1
+	ADDSD  X1, X0
+	MOVSD  X0, result+32(FP)
+	RET
+
+// ============================================================================
+// In-place and accumulating forms
+// ============================================================================
+
+// func add_f32x4_ip(a, b [16]byte, result unsafe.Pointer)
+TEXT ·add_f32x4_ip(SB), NOSPLIT, $0-40
+	MOVUPS a+0(FP), X0
+	MOVUPS b+16(FP), X1
+	ADDPS  X1, X0
+	MOVQ   result+32(FP), DI
+	MOVOU  X0, (DI)
+	RET
+
+// func sub_f32x4_ip(a, b [16]byte, result unsafe.Pointer)
+TEXT ·sub_f32x4_ip(SB), NOSPLIT, $0-40
+	MOVUPS a+0(FP), X0
+	MOVUPS b+16(FP), X1
+	SUBPS  X1, X0
+	MOVQ   result+32(FP), DI
+	MOVOU  X0, (DI)
+	RET
+
+// func mul_f32x4_ip(a, b [16]byte, result unsafe.Pointer)
+TEXT ·mul_f32x4_ip(SB), NOSPLIT, $0-40
+	MOVUPS a+0(FP), X0
+	MOVUPS b+16(FP), X1
+	MULPS  X1, X0
+	MOVQ   result+32(FP), DI
+	MOVOU  X0, (DI)
+	RET
+
+// func div_f32x4_ip(a, b [16]byte, result unsafe.Pointer)
+TEXT ·div_f32x4_ip(SB), NOSPLIT, $0-40
+	MOVUPS a+0(FP), X0
+	MOVUPS b+16(FP), X1
+	DIVPS  X1, X0
+	MOVQ   result+32(FP), DI
+	MOVOU  X0, (DI)
+	RET
+
+// func min_f32x4_ip(a, b [16]byte, result unsafe.Pointer)
+TEXT ·min_f32x4_ip(SB), NOSPLIT, $0-40
+	MOVUPS a+0(FP), X0
+	MOVUPS b+16(FP), X1
+	MINPS  X1, X0
+	MOVQ   result+32(FP), DI
+	MOVOU  X0, (DI)
+	RET
+
+// func max_f32x4_ip(a, b [16]byte, result unsafe.Pointer)
+TEXT ·max_f32x4_ip(SB), NOSPLIT, $0-40
+	MOVUPS a+0(FP), X0
+	MOVUPS b+16(FP), X1
+	MAXPS  X1, X0
+	MOVQ   result+32(FP), DI
+	MOVOU  X0, (DI)
+	RET
+
+// func muladd_f32x4_acc(a, b [16]byte, acc unsafe.Pointer)
+TEXT ·muladd_f32x4_acc(SB), NOSPLIT, $0-40
+	MOVUPS      a+0(FP), X0
+	MOVUPS      b+16(FP), X1
+	MOVQ        acc+32(FP), DI
+	MOVOU       (DI), X2
+	CMPB        ·x86HasFMA(SB), $0
+	JEQ         nofma
+	VFMADD231PS X1, X0, X2
+	MOVOU       X2, (DI)
+	RET
+nofma:
+	MULPS X1, X0
+	ADDPS X2, X0
+	MOVOU X0, (DI)
+	RET
+
+// func muladd_f32x4_ip(a, b, c [16]byte, result unsafe.Pointer)
+TEXT ·muladd_f32x4_ip(SB), NOSPLIT, $0-56
+	MOVUPS      a+0(FP), X0
+	MOVUPS      b+16(FP), X1
+	MOVUPS      c+32(FP), X2
+	MOVQ        result+48(FP), DI
+	CMPB        ·x86HasFMA(SB), $0
+	JEQ         nofma
+	VFMADD231PS X1, X0, X2
+	MOVOU       X2, (DI)
+	RET
+nofma:
+	MULPS X1, X0
+	ADDPS X2, X0
+	MOVOU X0, (DI)
+	RET
+
+// func add_f64x2_ip(a, b [16]byte, result unsafe.Pointer)
+TEXT ·add_f64x2_ip(SB), NOSPLIT, $0-40
+	MOVUPS a+0(FP), X0
+	MOVUPS b+16(FP), X1
+	ADDPD  X1, X0
+	MOVQ   result+32(FP), DI
+	MOVOU  X0, (DI)
+	RET
+
+// func sub_f64x2_ip(a, b [16]byte, result unsafe.Pointer)
+TEXT ·sub_f64x2_ip(SB), NOSPLIT, $0-40
+	MOVUPS a+0(FP), X0
+	MOVUPS b+16(FP), X1
+	SUBPD  X1, X0
+	MOVQ   result+32(FP), DI
+	MOVOU  X0, (DI)
+	RET
+
+// func mul_f64x2_ip(a, b [16]byte, result unsafe.Pointer)
+TEXT ·mul_f64x2_ip(SB), NOSPLIT, $0-40
+	MOVUPS a+0(FP), X0
+	MOVUPS b+16(FP), X1
+	MULPD  X1, X0
+	MOVQ   result+32(FP), DI
+	MOVOU  X0, (DI)
+	RET
+
+// func div_f64x2_ip(a, b [16]byte, result unsafe.Pointer)
+TEXT ·div_f64x2_ip(SB), NOSPLIT, $0-40
+	MOVUPS a+0(FP), X0
+	MOVUPS b+16(FP), X1
+	DIVPD  X1, X0
+	MOVQ   result+32(FP), DI
+	MOVOU  X0, (DI)
+	RET
+
+// func min_f64x2_ip(a, b [16]byte, result unsafe.Pointer)
+TEXT ·min_f64x2_ip(SB), NOSPLIT, $0-40
+	MOVUPS a+0(FP), X0
+	MOVUPS b+16(FP), X1
+	MINPD  X1, X0
+	MOVQ   result+32(FP), DI
+	MOVOU  X0, (DI)
+	RET
+
+// func max_f64x2_ip(a, b [16]byte, result unsafe.Pointer)
+TEXT ·max_f64x2_ip(SB), NOSPLIT, $0-40
+	MOVUPS a+0(FP), X0
+	MOVUPS b+16(FP), X1
+	MAXPD  X1, X0
+	MOVQ   result+32(FP), DI
+	MOVOU  X0, (DI)
+	RET
+
+// func muladd_f64x2_acc(a, b [16]byte, acc unsafe.Pointer)
+TEXT ·muladd_f64x2_acc(SB), NOSPLIT, $0-40
+	MOVUPS      a+0(FP), X0
+	MOVUPS      b+16(FP), X1
+	MOVQ        acc+32(FP), DI
+	MOVOU       (DI), X2
+	CMPB        ·x86HasFMA(SB), $0
+	JEQ         nofma
+	VFMADD231PD X1, X0, X2
+	MOVOU       X2, (DI)
+	RET
+nofma:
+	MULPD X1, X0
+	ADDPD X2, X0
+	MOVOU X0, (DI)
+	RET
+
+// func muladd_f64x2_ip(a, b, c [16]byte, result unsafe.Pointer)
+TEXT ·muladd_f64x2_ip(SB), NOSPLIT, $0-56
+	MOVUPS      a+0(FP), X0
+	MOVUPS      b+16(FP), X1
+	MOVUPS      c+32(FP), X2
+	MOVQ        result+48(FP), DI
+	CMPB        ·x86HasFMA(SB), $0
+	JEQ         nofma
+	VFMADD231PD X1, X0, X2
+	MOVOU       X2, (DI)
+	RET
+nofma:
+	MULPD X1, X0
+	ADDPD X2, X0
+	MOVOU X0, (DI)
+	RET
+
+// ============================================================================
+// Int32x4 and Uint32x4
+// ============================================================================
+
+// func add_i32x4(a, b [16]byte) (result [16]byte)
+TEXT ·add_i32x4(SB), NOSPLIT, $0-48
+	MOVUPS a+0(FP), X0
+	MOVUPS b+16(FP), X1
+	PADDL  X1, X0
+	MOVUPS X0, result+32(FP)
+	RET
+
+// func sub_i32x4(a, b [16]byte) (result [16]byte)
+TEXT ·sub_i32x4(SB), NOSPLIT, $0-48
+	MOVUPS a+0(FP), X0
+	MOVUPS b+16(FP), X1
+	PSUBL  X1, X0
+	MOVUPS X0, result+32(FP)
+	RET
+
+// SSE2 has no 32-bit multiply keeping the low halves: mul_i32x4 multiplies
+// the even and odd lanes with PMULUDQ and interleaves the low dwords.
+
+// func mul_i32x4(a, b [16]byte) (result [16]byte)
+TEXT ·mul_i32x4(SB), NOSPLIT, $0-48
+	MOVUPS    a+0(FP), X0
+	MOVUPS    b+16(FP), X1
+	MOVO      X0, X2
+	PMULULQ   X1, X2
+	PSRLQ     $32, X0
+	PSRLQ     $32, X1
+	PMULULQ   X1, X0
+	PSHUFD    $0x08, X2, X2
+	PSHUFD    $0x08, X0, X0
+	PUNPCKLLQ X0, X2
+	MOVO      X2, X0
+	MOVUPS    X0, result+32(FP)
+	RET
+
+// func and_i32x4(a, b [16]byte) (result [16]byte)
+TEXT ·and_i32x4(SB), NOSPLIT, $0-48
+	MOVUPS a+0(FP), X0
+	MOVUPS b+16(FP), X1
+	PAND   X1, X0
+	MOVUPS X0, result+32(FP)
+	RET
+
+// func or_i32x4(a, b [16]byte) (result [16]byte)
+TEXT ·or_i32x4(SB), NOSPLIT, $0-48
+	MOVUPS a+0(FP), X0
+	MOVUPS b+16(FP), X1
+	POR    X1, X0
+	MOVUPS X0, result+32(FP)
+	RET
+
+// func xor_i32x4(a, b [16]byte) (result [16]byte)
+TEXT ·xor_i32x4(SB), NOSPLIT, $0-48
+	MOVUPS a+0(FP), X0
+	MOVUPS b+16(FP), X1
+	PXOR   X1, X0
+	MOVUPS X0, result+32(FP)
+	RET
+
+// func andnot_i32x4(a, b [16]byte) (result [16]byte)
+TEXT ·andnot_i32x4(SB), NOSPLIT, $0-48
+	MOVUPS a+0(FP), X0
+	MOVUPS b+16(FP), X1
+	PANDN  X0, X1
+	MOVO   X1, X0
+	MOVUPS X0, result+32(FP)
+	RET
+
+// func not_i32x4(a [16]byte) (result [16]byte)
+TEXT ·not_i32x4(SB), NOSPLIT, $0-32
+	MOVUPS  a+0(FP), X0
+	PCMPEQL X1, X1
+	PXOR    X1, X0
+	MOVUPS  X0, result+16(FP)
+	RET
+
+// func eq_i32x4(a, b [16]byte) (result [16]byte)
+TEXT ·eq_i32x4(SB), NOSPLIT, $0-48
+	MOVUPS  a+0(FP), X0
+	MOVUPS  b+16(FP), X1
+	PCMPEQL X1, X0
+	MOVUPS  X0, result+32(FP)
+	RET
+
+// func add_u32x4(a, b [16]byte) (result [16]byte)
+TEXT ·add_u32x4(SB), NOSPLIT, $0-48
+	MOVUPS a+0(FP), X0
+	MOVUPS b+16(FP), X1
+	PADDL  X1, X0
+	MOVUPS X0, result+32(FP)
+	RET
+
+// func sub_u32x4(a, b [16]byte) (result [16]byte)
+TEXT ·sub_u32x4(SB), NOSPLIT, $0-48
+	MOVUPS a+0(FP), X0
+	MOVUPS b+16(FP), X1
+	PSUBL  X1, X0
+	MOVUPS X0, result+32(FP)
+	RET
+
+// func mul_u32x4(a, b [16]byte) (result [16]byte)
+TEXT ·mul_u32x4(SB), NOSPLIT, $0-48
+	MOVUPS    a+0(FP), X0
+	MOVUPS    b+16(FP), X1
+	MOVO      X0, X2
+	PMULULQ   X1, X2
+	PSRLQ     $32, X0
+	PSRLQ     $32, X1
+	PMULULQ   X1, X0
+	PSHUFD    $0x08, X2, X2
+	PSHUFD    $0x08, X0, X0
+	PUNPCKLLQ X0, X2
+	MOVO      X2, X0
+	MOVUPS    X0, result+32(FP)
+	RET
+
+// func and_u32x4(a, b [16]byte) (result [16]byte)
+TEXT ·and_u32x4(SB), NOSPLIT, $0-48
+	MOVUPS a+0(FP), X0
+	MOVUPS b+16(FP), X1
+	PAND   X1, X0
+	MOVUPS X0, result+32(FP)
+	RET
+
+// func or_u32x4(a, b [16]byte) (result [16]byte)
+TEXT ·or_u32x4(SB), NOSPLIT, $0-48
+	MOVUPS a+0(FP), X0
+	MOVUPS b+16(FP), X1
+	POR    X1, X0
+	MOVUPS X0, result+32(FP)
+	RET
+
+// func xor_u32x4(a, b [16]byte) (result [16]byte)
+TEXT ·xor_u32x4(SB), NOSPLIT, $0-48
+	MOVUPS a+0(FP), X0
+	MOVUPS b+16(FP), X1
+	PXOR   X1, X0
+	MOVUPS X0, result+32(FP)
+	RET
+
+// func andnot_u32x4(a, b [16]byte) (result [16]byte)
+TEXT ·andnot_u32x4(SB), NOSPLIT, $0-48
+	MOVUPS a+0(FP), X0
+	MOVUPS b+16(FP), X1
+	PANDN  X0, X1
+	MOVO   X1, X0
+	MOVUPS X0, result+32(FP)
+	RET
+
+// func not_u32x4(a [16]byte) (result [16]byte)
+TEXT ·not_u32x4(SB), NOSPLIT, $0-32
+	MOVUPS  a+0(FP), X0
+	PCMPEQL X1, X1
+	PXOR    X1, X0
+	MOVUPS  X0, result+16(FP)
+	RET
+
+// func eq_u32x4(a, b [16]byte) (result [16]byte)
+TEXT ·eq_u32x4(SB), NOSPLIT, $0-48
+	MOVUPS  a+0(FP), X0
+	MOVUPS  b+16(FP), X1
+	PCMPEQL X1, X0
+	MOVUPS  X0, result+32(FP)
+	RET
+
+// func gt_i32x4(a, b [16]byte) (result [16]byte)
+TEXT ·gt_i32x4(SB), NOSPLIT, $0-48
+	MOVUPS  a+0(FP), X0
+	MOVUPS  b+16(FP), X1
+	PCMPGTL X1, X0
+	MOVUPS  X0, result+32(FP)
+	RET
+
+// func lt_i32x4(a, b [16]byte) (result [16]byte)
+TEXT ·lt_i32x4(SB), NOSPLIT, $0-48
+	MOVUPS  b+16(FP), X0
+	MOVUPS  a+0(FP), X1
+	PCMPGTL X1, X0
+	MOVUPS  X0, result+32(FP)
+	RET
+
+// func min_i32x4(a, b [16]byte) (result [16]byte)
+TEXT ·min_i32x4(SB), NOSPLIT, $0-48
+	MOVUPS  a+0(FP), X0
+	MOVUPS  b+16(FP), X1
+	MOVO    X0, X2
+	PCMPGTL X1, X2
+	MOVO    X2, X3
+	PAND    X1, X2
+	PANDN   X0, X3
+	POR     X3, X2
+	MOVO    X2, X0
+	MOVUPS  X0, result+32(FP)
+	RET
+
+// func max_i32x4(a, b [16]byte) (result [16]byte)
+TEXT ·max_i32x4(SB), NOSPLIT, $0-48
+	MOVUPS  a+0(FP), X0
+	MOVUPS  b+16(FP), X1
+	MOVO    X1, X2
+	PCMPGTL X0, X2
+	MOVO    X2, X3
+	PAND    X1, X2
+	PANDN   X0, X3
+	POR     X3, X2
+	MOVO    X2, X0
+	MOVUPS  X0, result+32(FP)
+	RET
+
+// func add_i32x4_ip(a, b [16]byte, result unsafe.Pointer)
+TEXT ·add_i32x4_ip(SB), NOSPLIT, $0-40
+	MOVUPS a+0(FP), X0
+	MOVUPS b+16(FP), X1
+	PADDL  X1, X0
+	MOVQ   result+32(FP), DI
+	MOVOU  X0, (DI)
+	RET
+
+// func sub_i32x4_ip(a, b [16]byte, result unsafe.Pointer)
+TEXT ·sub_i32x4_ip(SB), NOSPLIT, $0-40
+	MOVUPS a+0(FP), X0
+	MOVUPS b+16(FP), X1
+	PSUBL  X1, X0
+	MOVQ   result+32(FP), DI
+	MOVOU  X0, (DI)
+	RET
+
+// func mul_i32x4_ip(a, b [16]byte, result unsafe.Pointer)
+TEXT ·mul_i32x4_ip(SB), NOSPLIT, $0-40
+	MOVUPS    a+0(FP), X0
+	MOVUPS    b+16(FP), X1
+	MOVO      X0, X2
+	PMULULQ   X1, X2
+	PSRLQ     $32, X0
+	PSRLQ     $32, X1
+	PMULULQ   X1, X0
+	PSHUFD    $0x08, X2, X2
+	PSHUFD    $0x08, X0, X0
+	PUNPCKLLQ X0, X2
+	MOVO      X2, X0
+	MOVQ      result+32(FP), DI
+	MOVOU     X0, (DI)
+	RET
+
+// func min_i32x4_ip(a, b [16]byte, result unsafe.Pointer)
+TEXT ·min_i32x4_ip(SB), NOSPLIT, $0-40
+	MOVUPS  a+0(FP), X0
+	MOVUPS  b+16(FP), X1
+	MOVO    X0, X2
+	PCMPGTL X1, X2
+	MOVO    X2, X3
+	PAND    X1, X2
+	PANDN   X0, X3
+	POR     X3, X2
+	MOVO    X2, X0
+	MOVQ    result+32(FP), DI
+	MOVOU   X0, (DI)
+	RET
+
+// func max_i32x4_ip(a, b [16]byte, result unsafe.Pointer)
+TEXT ·max_i32x4_ip(SB), NOSPLIT, $0-40
+	MOVUPS  a+0(FP), X0
+	MOVUPS  b+16(FP), X1
+	MOVO    X1, X2
+	PCMPGTL X0, X2
+	MOVO    X2, X3
+	PAND    X1, X2
+	PANDN   X0, X3
+	POR     X3, X2
+	MOVO    X2, X0
+	MOVQ    result+32(FP), DI
+	MOVOU   X0, (DI)
+	RET
+
+// func abs_i32x4(a [16]byte) (result [16]byte)
+TEXT ·abs_i32x4(SB), NOSPLIT, $0-32
+	MOVUPS a+0(FP), X0
+	MOVO   X0, X1
+	PSRAL  $31, X1
+	PXOR   X1, X0
+	PSUBL  X1, X0
+	MOVUPS X0, result+16(FP)
+	RET
+
+// func neg_i32x4(a [16]byte) (result [16]byte)
+TEXT ·neg_i32x4(SB), NOSPLIT, $0-32
+	MOVUPS a+0(FP), X0
+	PXOR   X1, X1
+	PSUBL  X0, X1
+	MOVO   X1, X0
+	MOVUPS X0, result+16(FP)
+	RET
+
+// func sel_i32x4(mask, yes, no [16]byte) (result [16]byte)
+TEXT ·sel_i32x4(SB), NOSPLIT, $0-64
+	MOVUPS mask+0(FP), X0
+	MOVUPS yes+16(FP), X1
+	MOVUPS no+32(FP), X2
+	PAND   X0, X1
+	PANDN  X2, X0
+	POR    X1, X0
+	MOVUPS X0, result+48(FP)
+	RET
+
+// func hsum_i32x4(v [16]byte) (result int64)
+TEXT ·hsum_i32x4(SB), NOSPLIT, $0-24
+	MOVUPS  v+0(FP), X0
+	PSHUFD  $0xB1, X0, X1
+	PADDL   X1, X0
+	PSHUFD  $0x4E, X0, X1
+	PADDL   X1, X0
+	MOVQ    X0, AX
+	MOVLQSX AX, AX
+	MOVQ    AX, result+16(FP)
+	RET
+
+// func hsum_u32x4(v [16]byte) (result int64)
+TEXT ·hsum_u32x4(SB), NOSPLIT, $0-24
+	MOVUPS v+0(FP), X0
+	PSHUFD $0xB1, X0, X1
+	PADDL  X1, X0
+	PSHUFD $0x4E, X0, X1
+	PADDL  X1, X0
+	MOVQ   X0, AX
+	MOVL   AX, AX
+	MOVQ   AX, result+16(FP)
+	RET
+
+// The unsigned comparisons flip the sign bits and compare signed.
+
+// func gt_u32x4(a, b [16]byte) (result [16]byte)
+TEXT ·gt_u32x4(SB), NOSPLIT, $0-48
+	MOVUPS  a+0(FP), X0
+	MOVUPS  b+16(FP), X1
+	PCMPEQL X2, X2
+	PSLLL   $31, X2
+	PXOR    X2, X0
+	PXOR    X2, X1
+	PCMPGTL X1, X0
+	MOVUPS  X0, result+32(FP)
+	RET
+
+// func lt_u32x4(a, b [16]byte) (result [16]byte)
+TEXT ·lt_u32x4(SB), NOSPLIT, $0-48
+	MOVUPS  b+16(FP), X0
+	MOVUPS  a+0(FP), X1
+	PCMPEQL X2, X2
+	PSLLL   $31, X2
+	PXOR    X2, X0
+	PXOR    X2, X1
+	PCMPGTL X1, X0
+	MOVUPS  X0, result+32(FP)
+	RET
+
+// func ge_u32x4(a, b [16]byte) (result [16]byte)
+TEXT ·ge_u32x4(SB), NOSPLIT, $0-48
+	MOVUPS  b+16(FP), X0
+	MOVUPS  a+0(FP), X1
+	PCMPEQL X2, X2
+	PSLLL   $31, X2
+	PXOR    X2, X0
+	PXOR    X2, X1
+	PCMPGTL X1, X0
+	PCMPEQL X3, X3
+	PXOR    X3, X0
+	MOVUPS  X0, result+32(FP)
+	RET
+
+// func le_u32x4(a, b [16]byte) (result [16]byte)
+TEXT ·le_u32x4(SB), NOSPLIT, $0-48
+	MOVUPS  a+0(FP), X0
+	MOVUPS  b+16(FP), X1
+	PCMPEQL X2, X2
+	PSLLL   $31, X2
+	PXOR    X2, X0
+	PXOR    X2, X1
+	PCMPGTL X1, X0
+	PCMPEQL X3, X3
+	PXOR    X3, X0
+	MOVUPS  X0, result+32(FP)
+	RET
+
+// func min_u32x4(a, b [16]byte) (result [16]byte)
+TEXT ·min_u32x4(SB), NOSPLIT, $0-48
+	MOVUPS  a+0(FP), X0
+	MOVUPS  b+16(FP), X1
+	PCMPEQL X2, X2
+	PSLLL   $31, X2
+	MOVO    X0, X3
+	PXOR    X2, X3
+	MOVO    X1, X4
+	PXOR    X2, X4
+	MOVO    X3, X2
+	PCMPGTL X4, X2
+	MOVO    X2, X3
+	PAND    X1, X2
+	PANDN   X0, X3
+	POR     X3, X2
+	MOVO    X2, X0
+	MOVUPS  X0, result+32(FP)
+	RET
+
+// func max_u32x4(a, b [16]byte) (result [16]byte)
+TEXT ·max_u32x4(SB), NOSPLIT, $0-48
+	MOVUPS  a+0(FP), X0
+	MOVUPS  b+16(FP), X1
+	PCMPEQL X2, X2
+	PSLLL   $31, X2
+	MOVO    X0, X3
+	PXOR    X2, X3
+	MOVO    X1, X4
+	PXOR    X2, X4
+	MOVO    X4, X2
+	PCMPGTL X3, X2
+	MOVO    X2, X3
+	PAND    X1, X2
+	PANDN   X0, X3
+	POR     X3, X2
+	MOVO    X2, X0
+	MOVUPS  X0, result+32(FP)
+	RET
+
+// The saturating forms detect the wrap-around with an unsigned compare of
+// the wrapped result against a.
+
+// func adds_u32x4(a, b [16]byte) (result [16]byte)
+TEXT ·adds_u32x4(SB), NOSPLIT, $0-48
+	MOVUPS  a+0(FP), X0
+	MOVUPS  b+16(FP), X1
+	PCMPEQL X2, X2
+	PSLLL   $31, X2
+	MOVO    X0, X3
+	PADDL   X1, X0
+	PXOR    X2, X3
+	MOVO    X0, X4
+	PXOR    X2, X4
+	PCMPGTL X4, X3
+	POR     X3, X0
+	MOVUPS  X0, result+32(FP)
+	RET
+
+// func subs_u32x4(a, b [16]byte) (result [16]byte)
+TEXT ·subs_u32x4(SB), NOSPLIT, $0-48
+	MOVUPS  a+0(FP), X0
+	MOVUPS  b+16(FP), X1
+	PCMPEQL X2, X2
+	PSLLL   $31, X2
+	MOVO    X0, X3
+	PSUBL   X1, X0
+	PXOR    X2, X3
+	PXOR    X2, X1
+	PCMPGTL X3, X1
+	PANDN   X0, X1
+	MOVO    X1, X0
+	MOVUPS  X0, result+32(FP)
+	RET
+
+// func cvt_f32x4_i32x4(v [16]byte) (result [16]byte)
+TEXT ·cvt_f32x4_i32x4(SB), NOSPLIT, $0-32
+	MOVUPS    v+0(FP), X0
+	CVTTPS2PL X0, X0
+	MOVUPS    X0, result+16(FP)
+	RET
+
+// func cvt_i32x4_f32x4(v [16]byte) (result [16]byte)
+TEXT ·cvt_i32x4_f32x4(SB), NOSPLIT, $0-32
+	MOVUPS   v+0(FP), X0
+	CVTPL2PS X0, X0
+	MOVUPS   X0, result+16(FP)
+	RET
+
+// cvt_u32x4_f32x4 converts the high and low 16 bits separately; both are
+// exact, so the final add rounds once.
+
+// func cvt_u32x4_f32x4(a [16]byte) (result [16]byte)
+TEXT ·cvt_u32x4_f32x4(SB), NOSPLIT, $0-32
+	MOVUPS   a+0(FP), X0
+	MOVO     X0, X1
+	PSRLL    $16, X1
+	PSLLL    $16, X0
+	PSRLL    $16, X0
+	CVTPL2PS X1, X1
+	MOVL     $0x47800000, AX
+	MOVQ     AX, X2
+	PSHUFD   $0, X2, X2
+	MULPS    X2, X1
+	CVTPL2PS X0, X0
+	ADDPS    X1, X0
+	MOVUPS   X0, result+16(FP)
+	RET
+
+// func counttrue_i32x4(mask [16]byte) (result int64)
+TEXT ·counttrue_i32x4(SB), NOSPLIT, $0-24
+	MOVUPS   mask+0(FP), X0
+	MOVMSKPS X0, CX
+	SHLQ     $2, CX
+	MOVQ     $0x4332322132212110, AX
+	SHRQ     CX, AX
+	ANDQ     $15, AX
+	MOVQ     AX, result+16(FP)
+	RET
+
+// func alltrue_i32x4(mask [16]byte) (result int64)
+TEXT ·alltrue_i32x4(SB), NOSPLIT, $0-24
+	MOVUPS   mask+0(FP), X0
+	PXOR     X1, X1
+	PCMPEQL  X0, X1
+	MOVMSKPS X1, CX
+	XORL     AX, AX
+	TESTQ    CX, CX
+	SETEQ    AX
+	MOVQ     AX, result+16(FP)
+	RET
+
+// func anytrue_i32x4(mask [16]byte) (result int64)
+TEXT ·anytrue_i32x4(SB), NOSPLIT, $0-24
+	MOVUPS   mask+0(FP), X0
+	PXOR     X1, X1
+	PCMPEQL  X0, X1
+	MOVMSKPS X1, CX
+	XORL     AX, AX
+	CMPQ     CX, $15
+	SETNE    AX
+	MOVQ     AX, result+16(FP)
+	RET
+
+// ============================================================================
+// Int64x2 and Uint64x2
+// ============================================================================
+
+// func add_i64x2(a, b [16]byte) (result [16]byte)
+TEXT ·add_i64x2(SB), NOSPLIT, $0-48
+	MOVUPS a+0(FP), X0
+	MOVUPS b+16(FP), X1
+	PADDQ  X1, X0
+	MOVUPS X0, result+32(FP)
+	RET
+
+// func sub_i64x2(a, b [16]byte) (result [16]byte)
+TEXT ·sub_i64x2(SB), NOSPLIT, $0-48
+	MOVUPS a+0(FP), X0
+	MOVUPS b+16(FP), X1
+	PSUBQ  X1, X0
+	MOVUPS X0, result+32(FP)
+	RET
+
+// func and_i64x2(a, b [16]byte) (result [16]byte)
+TEXT ·and_i64x2(SB), NOSPLIT, $0-48
+	MOVUPS a+0(FP), X0
+	MOVUPS b+16(FP), X1
+	PAND   X1, X0
+	MOVUPS X0, result+32(FP)
+	RET
+
+// func or_i64x2(a, b [16]byte) (result [16]byte)
+TEXT ·or_i64x2(SB), NOSPLIT, $0-48
+	MOVUPS a+0(FP), X0
+	MOVUPS b+16(FP), X1
+	POR    X1, X0
+	MOVUPS X0, result+32(FP)
+	RET
+
+// func xor_i64x2(a, b [16]byte) (result [16]byte)
+TEXT ·xor_i64x2(SB), NOSPLIT, $0-48
+	MOVUPS a+0(FP), X0
+	MOVUPS b+16(FP), X1
+	PXOR   X1, X0
+	MOVUPS X0, result+32(FP)
+	RET
+
+// func eq_i64x2(a, b [16]byte) (result [16]byte)
+TEXT ·eq_i64x2(SB), NOSPLIT, $0-48
+	MOVUPS  a+0(FP), X0
+	MOVUPS  b+16(FP), X1
+	PCMPEQL X1, X0
+	PSHUFD  $0xB1, X0, X1
+	PAND    X1, X0
+	MOVUPS  X0, result+32(FP)
+	RET
+
+// func add_u64x2(a, b [16]byte) (result [16]byte)
+TEXT ·add_u64x2(SB), NOSPLIT, $0-48
+	MOVUPS a+0(FP), X0
+	MOVUPS b+16(FP), X1
+	PADDQ  X1, X0
+	MOVUPS X0, result+32(FP)
+	RET
+
+// func sub_u64x2(a, b [16]byte) (result [16]byte)
+TEXT ·sub_u64x2(SB), NOSPLIT, $0-48
+	MOVUPS a+0(FP), X0
+	MOVUPS b+16(FP), X1
+	PSUBQ  X1, X0
+	MOVUPS X0, result+32(FP)
+	RET
+
+// func and_u64x2(a, b [16]byte) (result [16]byte)
+TEXT ·and_u64x2(SB), NOSPLIT, $0-48
+	MOVUPS a+0(FP), X0
+	MOVUPS b+16(FP), X1
+	PAND   X1, X0
+	MOVUPS X0, result+32(FP)
+	RET
+
+// func or_u64x2(a, b [16]byte) (result [16]byte)
+TEXT ·or_u64x2(SB), NOSPLIT, $0-48
+	MOVUPS a+0(FP), X0
+	MOVUPS b+16(FP), X1
+	POR    X1, X0
+	MOVUPS X0, result+32(FP)
+	RET
+
+// func xor_u64x2(a, b [16]byte) (result [16]byte)
+TEXT ·xor_u64x2(SB), NOSPLIT, $0-48
+	MOVUPS a+0(FP), X0
+	MOVUPS b+16(FP), X1
+	PXOR   X1, X0
+	MOVUPS X0, result+32(FP)
+	RET
+
+// func eq_u64x2(a, b [16]byte) (result [16]byte)
+TEXT ·eq_u64x2(SB), NOSPLIT, $0-48
+	MOVUPS  a+0(FP), X0
+	MOVUPS  b+16(FP), X1
+	PCMPEQL X1, X0
+	PSHUFD  $0xB1, X0, X1
+	PAND    X1, X0
+	MOVUPS  X0, result+32(FP)
+	RET
+
+// func sel_u64x2(mask, yes, no [16]byte) (result [16]byte)
+TEXT ·sel_u64x2(SB), NOSPLIT, $0-64
+	MOVUPS mask+0(FP), X0
+	MOVUPS yes+16(FP), X1
+	MOVUPS no+32(FP), X2
+	PAND   X0, X1
+	PANDN  X2, X0
+	POR    X1, X0
+	MOVUPS X0, result+48(FP)
+	RET
+
+// SSE2 has no 64-bit compares, so the remaining Uint64x2 kernels work on
+// general-purpose registers, a lane at a time.
+
+// func lt_u64x2(a, b [16]byte) (result [16]byte)
+TEXT ·lt_u64x2(SB), NOSPLIT, $0-48
+	LEAQ a+0(FP), SI
+	LEAQ b+16(FP), DX
+	LEAQ result+32(FP), DI
+	MOVQ (SI), AX
+	MOVQ (DX), BX
+	CMPQ AX, BX
+	SBBQ AX, AX
+	MOVQ AX, (DI)
+	MOVQ 8(SI), AX
+	MOVQ 8(DX), BX
+	CMPQ AX, BX
+	SBBQ AX, AX
+	MOVQ AX, 8(DI)
+	RET
+
+// func gt_u64x2(a, b [16]byte) (result [16]byte)
+TEXT ·gt_u64x2(SB), NOSPLIT, $0-48
+	LEAQ a+0(FP), SI
+	LEAQ b+16(FP), DX
+	LEAQ result+32(FP), DI
+	MOVQ (SI), AX
+	MOVQ (DX), BX
+	CMPQ BX, AX
+	SBBQ AX, AX
+	MOVQ AX, (DI)
+	MOVQ 8(SI), AX
+	MOVQ 8(DX), BX
+	CMPQ BX, AX
+	SBBQ AX, AX
+	MOVQ AX, 8(DI)
+	RET
+
+// func le_u64x2(a, b [16]byte) (result [16]byte)
+TEXT ·le_u64x2(SB), NOSPLIT, $0-48
+	LEAQ a+0(FP), SI
+	LEAQ b+16(FP), DX
+	LEAQ result+32(FP), DI
+	MOVQ (SI), AX
+	MOVQ (DX), BX
+	CMPQ BX, AX
+	SBBQ AX, AX
+	NOTQ AX
+	MOVQ AX, (DI)
+	MOVQ 8(SI), AX
+	MOVQ 8(DX), BX
+	CMPQ BX, AX
+	SBBQ AX, AX
+	NOTQ AX
+	MOVQ AX, 8(DI)
+	RET
+
+// func ge_u64x2(a, b [16]byte) (result [16]byte)
+TEXT ·ge_u64x2(SB), NOSPLIT, $0-48
+	LEAQ a+0(FP), SI
+	LEAQ b+16(FP), DX
+	LEAQ result+32(FP), DI
+	MOVQ (SI), AX
+	MOVQ (DX), BX
+	CMPQ AX, BX
+	SBBQ AX, AX
+	NOTQ AX
+	MOVQ AX, (DI)
+	MOVQ 8(SI), AX
+	MOVQ 8(DX), BX
+	CMPQ AX, BX
+	SBBQ AX, AX
+	NOTQ AX
+	MOVQ AX, 8(DI)
+	RET
+
+// func min_u64x2(a, b [16]byte) (result [16]byte)
+TEXT ·min_u64x2(SB), NOSPLIT, $0-48
+	LEAQ    a+0(FP), SI
+	LEAQ    b+16(FP), DX
+	LEAQ    result+32(FP), DI
+	MOVQ    (SI), AX
+	MOVQ    (DX), BX
+	CMPQ    AX, BX
+	CMOVQCC BX, AX
+	MOVQ    AX, (DI)
+	MOVQ    8(SI), AX
+	MOVQ    8(DX), BX
+	CMPQ    AX, BX
+	CMOVQCC BX, AX
+	MOVQ    AX, 8(DI)
+	RET
+
+// func max_u64x2(a, b [16]byte) (result [16]byte)
+TEXT ·max_u64x2(SB), NOSPLIT, $0-48
+	LEAQ    a+0(FP), SI
+	LEAQ    b+16(FP), DX
+	LEAQ    result+32(FP), DI
+	MOVQ    (SI), AX
+	MOVQ    (DX), BX
+	CMPQ    AX, BX
+	CMOVQCS BX, AX
+	MOVQ    AX, (DI)
+	MOVQ    8(SI), AX
+	MOVQ    8(DX), BX
+	CMPQ    AX, BX
+	CMOVQCS BX, AX
+	MOVQ    AX, 8(DI)
+	RET
+
+// func adds_u64x2(a, b [16]byte) (result [16]byte)
+TEXT ·adds_u64x2(SB), NOSPLIT, $0-48
+	LEAQ a+0(FP), SI
+	LEAQ b+16(FP), DX
+	LEAQ result+32(FP), DI
+	MOVQ (SI), AX
+	MOVQ (DX), BX
+	ADDQ BX, AX
+	SBBQ CX, CX
+	ORQ  CX, AX
+	MOVQ AX, (DI)
+	MOVQ 8(SI), AX
+	MOVQ 8(DX), BX
+	ADDQ BX, AX
+	SBBQ CX, CX
+	ORQ  CX, AX
+	MOVQ AX, 8(DI)
+	RET
+
+// func subs_u64x2(a, b [16]byte) (result [16]byte)
+TEXT ·subs_u64x2(SB), NOSPLIT, $0-48
+	LEAQ a+0(FP), SI
+	LEAQ b+16(FP), DX
+	LEAQ result+32(FP), DI
+	MOVQ (SI), AX
+	MOVQ (DX), BX
+	SUBQ BX, AX
+	SBBQ CX, CX
+	NOTQ CX
+	ANDQ CX, AX
+	MOVQ AX, (DI)
+	MOVQ 8(SI), AX
+	MOVQ 8(DX), BX
+	SUBQ BX, AX
+	SBBQ CX, CX
+	NOTQ CX
+	ANDQ CX, AX
+	MOVQ AX, 8(DI)
+	RET
+
+// ============================================================================
+// Uint16x8
+// ============================================================================
+
+// func and_u16x8(a, b [16]byte) (result [16]byte)
+TEXT ·and_u16x8(SB), NOSPLIT, $0-48
+	MOVUPS a+0(FP), X0
+	MOVUPS b+16(FP), X1
+	PAND   X1, X0
+	MOVUPS X0, result+32(FP)
+	RET
+
+// func or_u16x8(a, b [16]byte) (result [16]byte)
+TEXT ·or_u16x8(SB), NOSPLIT, $0-48
+	MOVUPS a+0(FP), X0
+	MOVUPS b+16(FP), X1
+	POR    X1, X0
+	MOVUPS X0, result+32(FP)
+	RET
+
+// func xor_u16x8(a, b [16]byte) (result [16]byte)
+TEXT ·xor_u16x8(SB), NOSPLIT, $0-48
+	MOVUPS a+0(FP), X0
+	MOVUPS b+16(FP), X1
+	PXOR   X1, X0
+	MOVUPS X0, result+32(FP)
+	RET
+
+// func not_u16x8(a [16]byte) (result [16]byte)
+TEXT ·not_u16x8(SB), NOSPLIT, $0-32
+	MOVUPS  a+0(FP), X0
+	PCMPEQL X1, X1
+	PXOR    X1, X0
+	MOVUPS  X0, result+16(FP)
+	RET
+
+// func eq_u16x8(a, b [16]byte) (result [16]byte)
+TEXT ·eq_u16x8(SB), NOSPLIT, $0-48
+	MOVUPS  a+0(FP), X0
+	MOVUPS  b+16(FP), X1
+	PCMPEQW X1, X0
+	MOVUPS  X0, result+32(FP)
+	RET
+
+// func adds_u16x8(a, b [16]byte) (result [16]byte)
+TEXT ·adds_u16x8(SB), NOSPLIT, $0-48
+	MOVUPS  a+0(FP), X0
+	MOVUPS  b+16(FP), X1
+	PADDUSW X1, X0
+	MOVUPS  X0, result+32(FP)
+	RET
+
+// func subs_u16x8(a, b [16]byte) (result [16]byte)
+TEXT ·subs_u16x8(SB), NOSPLIT, $0-48
+	MOVUPS  a+0(FP), X0
+	MOVUPS  b+16(FP), X1
+	PSUBUSW X1, X0
+	MOVUPS  X0, result+32(FP)
+	RET
+
+// func gt_u16x8(a, b [16]byte) (result [16]byte)
+TEXT ·gt_u16x8(SB), NOSPLIT, $0-48
+	MOVUPS  a+0(FP), X0
+	MOVUPS  b+16(FP), X1
+	PCMPEQW X2, X2
+	PSLLW   $15, X2
+	PXOR    X2, X0
+	PXOR    X2, X1
+	PCMPGTW X1, X0
+	MOVUPS  X0, result+32(FP)
+	RET
+
+// func lt_u16x8(a, b [16]byte) (result [16]byte)
+TEXT ·lt_u16x8(SB), NOSPLIT, $0-48
+	MOVUPS  b+16(FP), X0
+	MOVUPS  a+0(FP), X1
+	PCMPEQW X2, X2
+	PSLLW   $15, X2
+	PXOR    X2, X0
+	PXOR    X2, X1
+	PCMPGTW X1, X0
+	MOVUPS  X0, result+32(FP)
+	RET
+
+// func ge_u16x8(a, b [16]byte) (result [16]byte)
+TEXT ·ge_u16x8(SB), NOSPLIT, $0-48
+	MOVUPS  b+16(FP), X0
+	MOVUPS  a+0(FP), X1
+	PCMPEQW X2, X2
+	PSLLW   $15, X2
+	PXOR    X2, X0
+	PXOR    X2, X1
+	PCMPGTW X1, X0
+	PCMPEQL X3, X3
+	PXOR    X3, X0
+	MOVUPS  X0, result+32(FP)
+	RET
+
+// func le_u16x8(a, b [16]byte) (result [16]byte)
+TEXT ·le_u16x8(SB), NOSPLIT, $0-48
+	MOVUPS  a+0(FP), X0
+	MOVUPS  b+16(FP), X1
+	PCMPEQW X2, X2
+	PSLLW   $15, X2
+	PXOR    X2, X0
+	PXOR    X2, X1
+	PCMPGTW X1, X0
+	PCMPEQL X3, X3
+	PXOR    X3, X0
+	MOVUPS  X0, result+32(FP)
+	RET
+
+// func min_u16x8(a, b [16]byte) (result [16]byte)
+TEXT ·min_u16x8(SB), NOSPLIT, $0-48
+	MOVUPS  a+0(FP), X0
+	MOVUPS  b+16(FP), X1
+	PCMPEQW X2, X2
+	PSLLW   $15, X2
+	PXOR    X2, X0
+	PXOR    X2, X1
+	PMINSW  X1, X0
+	PXOR    X2, X0
+	MOVUPS  X0, result+32(FP)
+	RET
+
+// func max_u16x8(a, b [16]byte) (result [16]byte)
+TEXT ·max_u16x8(SB), NOSPLIT, $0-48
+	MOVUPS  a+0(FP), X0
+	MOVUPS  b+16(FP), X1
+	PCMPEQW X2, X2
+	PSLLW   $15, X2
+	PXOR    X2, X0
+	PXOR    X2, X1
+	PMAXSW  X1, X0
+	PXOR    X2, X0
+	MOVUPS  X0, result+32(FP)
+	RET
+
+// ============================================================================
+// Uint8x16
+// ============================================================================
+
+// func and_u8x16(a, b [16]byte) (result [16]byte)
+TEXT ·and_u8x16(SB), NOSPLIT, $0-48
+	MOVUPS a+0(FP), X0
+	MOVUPS b+16(FP), X1
+	PAND   X1, X0
+	MOVUPS X0, result+32(FP)
+	RET
+
+// func or_u8x16(a, b [16]byte) (result [16]byte)
+TEXT ·or_u8x16(SB), NOSPLIT, $0-48
+	MOVUPS a+0(FP), X0
+	MOVUPS b+16(FP), X1
+	POR    X1, X0
+	MOVUPS X0, result+32(FP)
+	RET
+
+// func xor_u8x16(a, b [16]byte) (result [16]byte)
+TEXT ·xor_u8x16(SB), NOSPLIT, $0-48
+	MOVUPS a+0(FP), X0
+	MOVUPS b+16(FP), X1
+	PXOR   X1, X0
+	MOVUPS X0, result+32(FP)
+	RET
+
+// func not_u8x16(a [16]byte) (result [16]byte)
+TEXT ·not_u8x16(SB), NOSPLIT, $0-32
+	MOVUPS  a+0(FP), X0
+	PCMPEQL X1, X1
+	PXOR    X1, X0
+	MOVUPS  X0, result+16(FP)
+	RET
+
+// func eq_u8x16(a, b [16]byte) (result [16]byte)
+TEXT ·eq_u8x16(SB), NOSPLIT, $0-48
+	MOVUPS  a+0(FP), X0
+	MOVUPS  b+16(FP), X1
+	PCMPEQB X1, X0
+	MOVUPS  X0, result+32(FP)
+	RET
+
+// func min_u8x16(a, b [16]byte) (result [16]byte)
+TEXT ·min_u8x16(SB), NOSPLIT, $0-48
+	MOVUPS a+0(FP), X0
+	MOVUPS b+16(FP), X1
+	PMINUB X1, X0
+	MOVUPS X0, result+32(FP)
+	RET
+
+// func max_u8x16(a, b [16]byte) (result [16]byte)
+TEXT ·max_u8x16(SB), NOSPLIT, $0-48
+	MOVUPS a+0(FP), X0
+	MOVUPS b+16(FP), X1
+	PMAXUB X1, X0
+	MOVUPS X0, result+32(FP)
+	RET
+
+// func adds_u8x16(a, b [16]byte) (result [16]byte)
+TEXT ·adds_u8x16(SB), NOSPLIT, $0-48
+	MOVUPS  a+0(FP), X0
+	MOVUPS  b+16(FP), X1
+	PADDUSB X1, X0
+	MOVUPS  X0, result+32(FP)
+	RET
+
+// func subs_u8x16(a, b [16]byte) (result [16]byte)
+TEXT ·subs_u8x16(SB), NOSPLIT, $0-48
+	MOVUPS  a+0(FP), X0
+	MOVUPS  b+16(FP), X1
+	PSUBUSB X1, X0
+	MOVUPS  X0, result+32(FP)
+	RET
+
+// func ge_u8x16(a, b [16]byte) (result [16]byte)
+TEXT ·ge_u8x16(SB), NOSPLIT, $0-48
+	MOVUPS  a+0(FP), X0
+	MOVUPS  b+16(FP), X1
+	MOVO    X0, X2
+	PMAXUB  X1, X2
+	PCMPEQB X2, X0
+	MOVUPS  X0, result+32(FP)
+	RET
+
+// func le_u8x16(a, b [16]byte) (result [16]byte)
+TEXT ·le_u8x16(SB), NOSPLIT, $0-48
+	MOVUPS  a+0(FP), X0
+	MOVUPS  b+16(FP), X1
+	MOVO    X0, X2
+	PMINUB  X1, X2
+	PCMPEQB X2, X0
+	MOVUPS  X0, result+32(FP)
+	RET
+
+// func gt_u8x16(a, b [16]byte) (result [16]byte)
+TEXT ·gt_u8x16(SB), NOSPLIT, $0-48
+	MOVUPS  a+0(FP), X0
+	MOVUPS  b+16(FP), X1
+	MOVO    X0, X2
+	PMINUB  X1, X2
+	PCMPEQB X2, X0
+	PCMPEQL X3, X3
+	PXOR    X3, X0
+	MOVUPS  X0, result+32(FP)
+	RET
+
+// func lt_u8x16(a, b [16]byte) (result [16]byte)
+TEXT ·lt_u8x16(SB), NOSPLIT, $0-48
+	MOVUPS  a+0(FP), X0
+	MOVUPS  b+16(FP), X1
+	MOVO    X0, X2
+	PMAXUB  X1, X2
+	PCMPEQB X2, X0
+	PCMPEQL X3, X3
+	PXOR    X3, X0
+	MOVUPS  X0, result+32(FP)
+	RET
+
+// ============================================================================
+// Slides and loads
+// ============================================================================
+
+// func slide_up_1_f32x4(v [16]byte) (result [16]byte)
+TEXT ·slide_up_1_f32x4(SB), NOSPLIT, $0-32
+	MOVUPS v+0(FP), X0
+	PSLLDQ $4, X0
+	MOVUPS X0, result+16(FP)
+	RET
+
+// func slide_up_2_f32x4(v [16]byte) (result [16]byte)
+TEXT ·slide_up_2_f32x4(SB), NOSPLIT, $0-32
+	MOVUPS v+0(FP), X0
+	PSLLDQ $8, X0
+	MOVUPS X0, result+16(FP)
+	RET
+
+// func slide_up_1_i32x4(v [16]byte) (result [16]byte)
+TEXT ·slide_up_1_i32x4(SB), NOSPLIT, $0-32
+	MOVUPS v+0(FP), X0
+	PSLLDQ $4, X0
+	MOVUPS X0, result+16(FP)
+	RET
+
+// func slide_up_2_i32x4(v [16]byte) (result [16]byte)
+TEXT ·slide_up_2_i32x4(SB), NOSPLIT, $0-32
+	MOVUPS v+0(FP), X0
+	PSLLDQ $8, X0
+	MOVUPS X0, result+16(FP)
+	RET
+
+// func slide_up_1_u32x4(v [16]byte) (result [16]byte)
+TEXT ·slide_up_1_u32x4(SB), NOSPLIT, $0-32
+	MOVUPS v+0(FP), X0
+	PSLLDQ $4, X0
+	MOVUPS X0, result+16(FP)
+	RET
+
+// func slide_up_2_u32x4(v [16]byte) (result [16]byte)
+TEXT ·slide_up_2_u32x4(SB), NOSPLIT, $0-32
+	MOVUPS v+0(FP), X0
+	PSLLDQ $8, X0
+	MOVUPS X0, result+16(FP)
+	RET
+
+// func slide_up_1_f64x2(v [16]byte) (result [16]byte)
+TEXT ·slide_up_1_f64x2(SB), NOSPLIT, $0-32
+	MOVUPS v+0(FP), X0
+	PSLLDQ $8, X0
+	MOVUPS X0, result+16(FP)
+	RET
+
+// func slide_up_1_i64x2(v [16]byte) (result [16]byte)
+TEXT ·slide_up_1_i64x2(SB), NOSPLIT, $0-32
+	MOVUPS v+0(FP), X0
+	PSLLDQ $8, X0
+	MOVUPS X0, result+16(FP)
+	RET
+
+// func slide_up_1_u64x2(v [16]byte) (result [16]byte)
+TEXT ·slide_up_1_u64x2(SB), NOSPLIT, $0-32
+	MOVUPS v+0(FP), X0
+	PSLLDQ $8, X0
+	MOVUPS X0, result+16(FP)
+	RET
+
+// func load4_f32x4(ptr, out0, out1, out2, out3 unsafe.Pointer)
+TEXT ·load4_f32x4(SB), NOSPLIT, $0-40
+	MOVQ  ptr+0(FP), SI
+	MOVOU (SI), X0
+	MOVOU 16(SI), X1
+	MOVOU 32(SI), X2
+	MOVOU 48(SI), X3
+	MOVQ  out0+8(FP), DI
+	MOVOU X0, (DI)
+	MOVQ  out1+16(FP), DI
+	MOVOU X1, (DI)
+	MOVQ  out2+24(FP), DI
+	MOVOU X2, (DI)
+	MOVQ  out3+32(FP), DI
+	MOVOU X3, (DI)
+	RET
+
+// func load4_f64x2(ptr, out0, out1, out2, out3 unsafe.Pointer)
+TEXT ·load4_f64x2(SB), NOSPLIT, $0-40
+	MOVQ  ptr+0(FP), SI
+	MOVOU (SI), X0
+	MOVOU 16(SI), X1
+	MOVOU 32(SI), X2
+	MOVOU 48(SI), X3
+	MOVQ  out0+8(FP), DI
+	MOVOU X0, (DI)
+	MOVQ  out1+16(FP), DI
+	MOVOU X1, (DI)
+	MOVQ  out2+24(FP), DI
+	MOVOU X2, (DI)
+	MOVQ  out3+32(FP), DI
+	MOVOU X3, (DI)
+	RET
+
+// func load4_i32x4(ptr, out0, out1, out2, out3 unsafe.Pointer)
+TEXT ·load4_i32x4(SB), NOSPLIT, $0-40
+	MOVQ  ptr+0(FP), SI
+	MOVOU (SI), X0
+	MOVOU 16(SI), X1
+	MOVOU 32(SI), X2
+	MOVOU 48(SI), X3
+	MOVQ  out0+8(FP), DI
+	MOVOU X0, (DI)
+	MOVQ  out1+16(FP), DI
+	MOVOU X1, (DI)
+	MOVQ  out2+24(FP), DI
+	MOVOU X2, (DI)
+	MOVQ  out3+32(FP), DI
+	MOVOU X3, (DI)
+	RET
+
+// func load4_i64x2(ptr, out0, out1, out2, out3 unsafe.Pointer)
+TEXT ·load4_i64x2(SB), NOSPLIT, $0-40
+	MOVQ  ptr+0(FP), SI
+	MOVOU (SI), X0
+	MOVOU 16(SI), X1
+	MOVOU 32(SI), X2
+	MOVOU 48(SI), X3
+	MOVQ  out0+8(FP), DI
+	MOVOU X0, (DI)
+	MOVQ  out1+16(FP), DI
+	MOVOU X1, (DI)
+	MOVQ  out2+24(FP), DI
+	MOVOU X2, (DI)
+	MOVQ  out3+32(FP), DI
+	MOVOU X3, (DI)
+	RET
+
+// func load4_u8x16(ptr, out0, out1, out2, out3 unsafe.Pointer)
+TEXT ·load4_u8x16(SB), NOSPLIT, $0-40
+	MOVQ  ptr+0(FP), SI
+	MOVOU (SI), X0
+	MOVOU 16(SI), X1
+	MOVOU 32(SI), X2
+	MOVOU 48(SI), X3
+	MOVQ  out0+8(FP), DI
+	MOVOU X0, (DI)
+	MOVQ  out1+16(FP), DI
+	MOVOU X1, (DI)
+	MOVQ  out2+24(FP), DI
+	MOVOU X2, (DI)
+	MOVQ  out3+32(FP), DI
+	MOVOU X3, (DI)
+	RET
+
+// func load4_u16x8(ptr, out0, out1, out2, out3 unsafe.Pointer)
+TEXT ·load4_u16x8(SB), NOSPLIT, $0-40
+	MOVQ  ptr+0(FP), SI
+	MOVOU (SI), X0
+	MOVOU 16(SI), X1
+	MOVOU 32(SI), X2
+	MOVOU 48(SI), X3
+	MOVQ  out0+8(FP), DI
+	MOVOU X0, (DI)
+	MOVQ  out1+16(FP), DI
+	MOVOU X1, (DI)
+	MOVQ  out2+24(FP), DI
+	MOVOU X2, (DI)
+	MOVQ  out3+32(FP), DI
+	MOVOU X3, (DI)
+	RET
+
+// func load4_u32x4(ptr, out0, out1, out2, out3 unsafe.Pointer)
+TEXT ·load4_u32x4(SB), NOSPLIT, $0-40
+	MOVQ  ptr+0(FP), SI
+	MOVOU (SI), X0
+	MOVOU 16(SI), X1
+	MOVOU 32(SI), X2
+	MOVOU 48(SI), X3
+	MOVQ  out0+8(FP), DI
+	MOVOU X0, (DI)
+	MOVQ  out1+16(FP), DI
+	MOVOU X1, (DI)
+	MOVQ  out2+24(FP), DI
+	MOVOU X2, (DI)
+	MOVQ  out3+32(FP), DI
+	MOVOU X3, (DI)
+	RET
+
+// func load4_u64x2(ptr, out0, out1, out2, out3 unsafe.Pointer)
+TEXT ·load4_u64x2(SB), NOSPLIT, $0-40
+	MOVQ  ptr+0(FP), SI
+	MOVOU (SI), X0
+	MOVOU 16(SI), X1
+	MOVOU 32(SI), X2
+	MOVOU 48(SI), X3
+	MOVQ  out0+8(FP), DI
+	MOVOU X0, (DI)
+	MOVQ  out1+16(FP), DI
+	MOVOU X1, (DI)
+	MOVQ  out2+24(FP), DI
+	MOVOU X2, (DI)
+	MOVQ  out3+32(FP), DI
+	MOVOU X3, (DI)
+	RET
+
+// tbl_u8_neon sets result[i] = tbl[idx[i]], or 0 when idx[i] >= 16, for
+// the *len bytes of idx. Adding 0x70 with unsigned saturation keeps the low
+// nibble of in-range indices and sets bit 7 of the others, which PSHUFB
+// turns into zeros.
+
+// func tbl_u8_neon(tbl, idx, result, len unsafe.Pointer)
+TEXT ·tbl_u8_neon(SB), NOSPLIT, $0-32
+	MOVQ   tbl+0(FP), SI
+	MOVQ   idx+8(FP), DX
+	MOVQ   result+16(FP), DI
+	MOVQ   len+24(FP), CX
+	MOVQ   (CX), CX
+	CMPB   ·x86HasSSSE3(SB), $0
+	JEQ    tail
+	MOVOU  (SI), X0
+	MOVL   $0x70707070, AX
+	MOVQ   AX, X2
+	PSHUFD $0, X2, X2
+vec:
+	CMPQ    CX, $16
+	JLT     tail
+	MOVOU   (DX), X1
+	PADDUSB X2, X1
+	MOVO    X0, X3
+	PSHUFB  X1, X3
+	MOVOU   X3, (DI)
+	ADDQ    $16, DX
+	ADDQ    $16, DI
+	SUBQ    $16, CX
+	JMP     vec
+tail:
+	TESTQ   CX, CX
+	JLE     done
+	MOVBQZX (DX), AX
+	XORL    BX, BX
+	CMPQ    AX, $16
+	JAE     store
+	MOVBLZX (SI)(AX*1), BX
+store:
+	MOVB BX, (DI)
+	INCQ DX
+	INCQ DI
+	DECQ CX
+	JMP  tail
+done:
+	RET
@@ -0,0 +1,605 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !noasm && amd64
+
+package asm
+
+import (
+	"math"
+	"math/bits"
+	"math/rand/v2"
+	"testing"
+	"unsafe"
+)
+
+// The SSE2 kernels are checked lane by lane against scalar references, on
+// edge values and random vectors. Both the FMA3 and the plain multiply-add
+// paths are run when the CPU has FMA3.
+
+func lanesOf[T any, V ~[16]byte](v V) []T {
+	var zero T
+	return unsafe.Slice((*T)(unsafe.Pointer(&v[0])), 16/int(unsafe.Sizeof(zero)))
+}
+
+func vecOf[V ~[16]byte, T any](xs []T) V {
+	var v V
+	var zero T
+	copy(unsafe.Slice((*T)(unsafe.Pointer(&v[0])), 16/int(unsafe.Sizeof(zero))), xs)
+	return v
+}
+
+func maskLane[T ~uint8 | ~uint16 | ~uint32 | ~uint64](b bool) T {
+	if b {
+		return ^T(0)
+	}
+	return 0
+}
+
+// testVectors returns n vectors of the lane type T: the edge values first,
+// then random lanes mixed with edge values.
+func testVectors[T any](edges []T, random func(r *rand.Rand) T) [][]T {
+	var zero T
+	lanes := 16 / int(unsafe.Sizeof(zero))
+	r := rand.New(rand.NewPCG(1, 2))
+	var out [][]T
+	for i := 0; i < len(edges); i += lanes {
+		v := make([]T, lanes)
+		for j := range v {
+			v[j] = edges[(i+j)%len(edges)]
+		}
+		out = append(out, v)
+	}
+	for range 64 {
+		v := make([]T, lanes)
+		for j := range v {
+			if r.IntN(4) == 0 {
+				v[j] = edges[r.IntN(len(edges))]
+			} else {
+				v[j] = random(r)
+			}
+		}
+		out = append(out, v)
+	}
+	return out
+}
+
+var (
+	f32Edges = []float32{0, float32(math.Copysign(0, -1)), 1, -1, 0.5, -0.5, 1.5, -1.5, 2.5, -2.5, 0.7, -0.7,
+		1 << 23, -(1 << 23), 1<<23 + 1, 1 << 24, 3e38, -3e38, 1e-40, float32(math.Inf(1)), float32(math.Inf(-1)), float32(math.NaN())}
+	f64Edges = []float64{0, math.Copysign(0, -1), 1, -1, 0.5, -2.5, 1e300, -1e-300, math.Inf(1), math.NaN()}
+	u8Edges  = []uint8{0, 1, 2, 15, 16, 0x7f, 0x80, 0x81, 0xfe, 0xff}
+	u16Edges = []uint16{0, 1, 0x7fff, 0x8000, 0x8001, 0xfffe, 0xffff}
+	u32Edges = []uint32{0, 1, 2, 0x7fffffff, 0x80000000, 0x80000001, 0xfffffffe, 0xffffffff}
+	u64Edges = []uint64{0, 1, 1<<63 - 1, 1 << 63, 1<<63 + 1, math.MaxUint64 - 1, math.MaxUint64}
+)
+
+func f32Vectors() [][]float32 {
+	return testVectors(f32Edges, func(r *rand.Rand) float32 { return float32(r.NormFloat64() * 1000) })
+}
+
+func f64Vectors() [][]float64 {
+	return testVectors(f64Edges, func(r *rand.Rand) float64 { return r.NormFloat64() * 1000 })
+}
+
+func sameF32(a, b float32) bool {
+	return math.Float32bits(a) == math.Float32bits(b) || a != a && b != b
+}
+
+func sameF64(a, b float64) bool {
+	return math.Float64bits(a) == math.Float64bits(b) || a != a && b != b
+}
+
+// checkLanes applies kernel to each pair of vectors of xs and ys and compares
+// every lane of the result with ref.
+func checkLanes[T, R comparable](t *testing.T, name string, xs, ys [][]T, kernel func(a, b [16]byte) [16]byte, ref func(x, y T) R, same func(a, b R) bool) {
+	t.Helper()
+	for _, x := range xs {
+		for _, y := range ys {
+			got := lanesOf[R](kernel(vecOf[[16]byte](x), vecOf[[16]byte](y)))
+			for i := range x {
+				if want := ref(x[i], y[i]); !same(got[i], want) {
+					t.Errorf("%s(%v, %v)[%d] = %v, want %v", name, x, y, i, got[i], want)
+					return
+				}
+			}
+		}
+	}
+}
+
+func eq[T comparable](a, b T) bool { return a == b }
+
+func unary(f func(a [16]byte) [16]byte) func(a, _ [16]byte) [16]byte {
+	return func(a, _ [16]byte) [16]byte { return f(a) }
+}
+
+// replaceLanes returns vs with the lanes for which drop is true set to 3.
+func replaceLanes(vs [][]float32, drop func(x float32) bool) [][]float32 {
+	var out [][]float32
+	for _, v := range vs {
+		w := make([]float32, len(v))
+		for i, x := range v {
+			if drop(x) {
+				x = 3
+			}
+			w[i] = x
+		}
+		out = append(out, w)
+	}
+	return out
+}
+
+func isNaN32(x float32) bool { return x != x }
+
+func TestSSE2Float32x4(t *testing.T) {
+	vs := f32Vectors()
+	one := [][]float32{{0, 0, 0, 0}}
+	for _, tt := range []struct {
+		name   string
+		kernel func(a, b [16]byte) [16]byte
+		ref    func(x, y float32) float32
+	}{
+		{"add", add_f32x4, func(x, y float32) float32 { return x + y }},
+		{"sub", sub_f32x4, func(x, y float32) float32 { return x - y }},
+		{"mul", mul_f32x4, func(x, y float32) float32 { return x * y }},
+		{"div", div_f32x4, func(x, y float32) float32 { return x / y }},
+		{"and", and_f32x4, func(x, y float32) float32 { return math.Float32frombits(math.Float32bits(x) & math.Float32bits(y)) }},
+		{"or", or_f32x4, func(x, y float32) float32 { return math.Float32frombits(math.Float32bits(x) | math.Float32bits(y)) }},
+		{"xor", xor_f32x4, func(x, y float32) float32 { return math.Float32frombits(math.Float32bits(x) ^ math.Float32bits(y)) }},
+	} {
+		checkLanes(t, tt.name, vs, vs, tt.kernel, tt.ref, sameF32)
+	}
+	// x86 and NEON differ on NaN and on the sign of zero results.
+	nn := replaceLanes(vs, isNaN32)
+	checkLanes(t, "min", nn, nn, min_f32x4, func(x, y float32) float32 {
+		if x < y {
+			return x
+		}
+		return y
+	}, func(a, b float32) bool { return a == b })
+	checkLanes(t, "max", nn, nn, max_f32x4, func(x, y float32) float32 {
+		if x > y {
+			return x
+		}
+		return y
+	}, func(a, b float32) bool { return a == b })
+
+	for _, tt := range []struct {
+		name   string
+		kernel func(a [16]byte) [16]byte
+		ref    func(x float32) float32
+	}{
+		{"sqrt", sqrt_f32x4, func(x float32) float32 { return float32(math.Sqrt(float64(x))) }},
+		{"abs", abs_f32x4, func(x float32) float32 { return float32(math.Abs(float64(x))) }},
+		{"neg", neg_f32x4, func(x float32) float32 { return -x }},
+		{"round", round_f32x4, func(x float32) float32 { return float32(math.RoundToEven(float64(x))) }},
+		{"floor", floor_f32x4, func(x float32) float32 { return float32(math.Floor(float64(x))) }},
+		{"ceil", ceil_f32x4, func(x float32) float32 { return float32(math.Ceil(float64(x))) }},
+		{"trunc", trunc_f32x4, func(x float32) float32 { return float32(math.Trunc(float64(x))) }},
+	} {
+		checkLanes(t, tt.name, vs, one, unary(tt.kernel), func(x, _ float32) float32 { return tt.ref(x) }, sameF32)
+	}
+
+	// The estimates flush subnormal inputs to zero.
+	normal := replaceLanes(vs, func(x float32) bool { return x != 0 && math.Abs(float64(x)) < 0x1p-126 })
+	for _, tt := range []struct {
+		name   string
+		kernel func(a [16]byte) [16]byte
+		ref    float64
+	}{
+		{"recip", recip_f32x4, -1},
+		{"rsqrt", rsqrt_f32x4, -0.5},
+	} {
+		checkLanes(t, tt.name, normal, one, unary(tt.kernel), func(x, _ float32) float32 {
+			return float32(math.Pow(float64(x), tt.ref))
+		}, func(got, want float32) bool {
+			if want != want || math.IsInf(float64(want), 0) || want == 0 || math.Abs(float64(want)) < 1e-37 || math.Abs(float64(want)) > 1e37 {
+				return true
+			}
+			return math.Abs(float64(got/want-1)) < 1.5/4096
+		})
+	}
+
+	for _, tt := range []struct {
+		name   string
+		kernel func(a, b [16]byte) [16]byte
+		ref    func(x, y float32) bool
+	}{
+		{"eq", eq_f32x4, func(x, y float32) bool { return x == y }},
+		{"ne", ne_f32x4, func(x, y float32) bool { return x != y }},
+		{"lt", lt_f32x4, func(x, y float32) bool { return x < y }},
+		{"le", le_f32x4, func(x, y float32) bool { return x <= y }},
+		{"gt", gt_f32x4, func(x, y float32) bool { return x > y }},
+		{"ge", ge_f32x4, func(x, y float32) bool { return x >= y }},
+	} {
+		checkLanes(t, tt.name, vs, vs, tt.kernel, func(x, y float32) uint32 { return maskLane[uint32](tt.ref(x, y)) }, eq)
+	}
+
+	checkLanes(t, "cvt_f32x4_i32x4", replaceLanes(vs, isNaN32), one, unary(cvt_f32x4_i32x4), func(x, _ float32) int32 {
+		if x >= 1<<31 || x < -(1<<31) {
+			return math.MinInt32
+		}
+		return int32(x)
+	}, eq)
+
+	a := vecOf[[16]byte]([]float32{1, 2, 3, 4})
+	b := vecOf[[16]byte]([]float32{10, 20, 30, 40})
+	if got := lanesOf[float32](slide_up_1_f32x4(a)); got[0] != 0 || got[1] != 1 || got[3] != 3 {
+		t.Errorf("slide_up_1_f32x4 = %v", got)
+	}
+	if got := lanesOf[float32](slide_up_2_f32x4(a)); got[1] != 0 || got[2] != 1 || got[3] != 2 {
+		t.Errorf("slide_up_2_f32x4 = %v", got)
+	}
+	if got := hsum_f32x4(a); got != 10 {
+		t.Errorf("hsum_f32x4 = %v, want 10", got)
+	}
+	if got := hmin_f32x4(b); got != 10 {
+		t.Errorf("hmin_f32x4 = %v, want 10", got)
+	}
+	if got := hmax_f32x4(b); got != 40 {
+		t.Errorf("hmax_f32x4 = %v, want 40", got)
+	}
+	if got := dot_f32x4(a, b); got != 300 {
+		t.Errorf("dot_f32x4 = %v, want 300", got)
+	}
+	mask := vecOf[[16]byte]([]uint32{0, math.MaxUint32, 0, math.MaxUint32})
+	if got := lanesOf[float32](sel_f32x4(mask, a, b)); got[0] != 10 || got[1] != 2 || got[2] != 30 || got[3] != 4 {
+		t.Errorf("sel_f32x4 = %v", got)
+	}
+}
+
+func TestSSE2MulAdd(t *testing.T) {
+	paths := []bool{false}
+	if x86HasFMA {
+		paths = append(paths, true)
+	}
+	defer func(old bool) { x86HasFMA = old }(x86HasFMA)
+
+	a := vecOf[[16]byte]([]float32{1, 2, 3, 4})
+	b := vecOf[[16]byte]([]float32{5, 6, 7, 8})
+	c := vecOf[[16]byte]([]float32{1, 1, 1, 1})
+	d := vecOf[[16]byte]([]float64{1.5, -2})
+	e := vecOf[[16]byte]([]float64{4, 3})
+	for _, fma := range paths {
+		x86HasFMA = fma
+		if got := lanesOf[float32](fma_f32x4(a, b, c)); got[0] != 6 || got[3] != 33 {
+			t.Errorf("fma_f32x4 (fma %v) = %v", fma, got)
+		}
+		if got := lanesOf[float32](fms_f32x4(a, b, c)); got[0] != 4 || got[3] != 31 {
+			t.Errorf("fms_f32x4 (fma %v) = %v", fma, got)
+		}
+		if got := lanesOf[float64](fma_f64x2(d, e, d)); got[0] != 7.5 || got[1] != -8 {
+			t.Errorf("fma_f64x2 (fma %v) = %v", fma, got)
+		}
+		acc := Float32x4(c)
+		muladd_f32x4_acc(a, b, unsafe.Pointer(&acc))
+		if got := lanesOf[float32](acc); got[1] != 13 {
+			t.Errorf("muladd_f32x4_acc (fma %v) = %v", fma, got)
+		}
+		var res Float64x2
+		muladd_f64x2_ip(d, e, e, unsafe.Pointer(&res))
+		if got := lanesOf[float64](res); got[0] != 10 || got[1] != -3 {
+			t.Errorf("muladd_f64x2_ip (fma %v) = %v", fma, got)
+		}
+	}
+}
+
+func TestSSE2Float64x2(t *testing.T) {
+	vs := f64Vectors()
+	one := [][]float64{{0, 0}}
+	for _, tt := range []struct {
+		name   string
+		kernel func(a, b [16]byte) [16]byte
+		ref    func(x, y float64) float64
+	}{
+		{"add", add_f64x2, func(x, y float64) float64 { return x + y }},
+		{"sub", sub_f64x2, func(x, y float64) float64 { return x - y }},
+		{"mul", mul_f64x2, func(x, y float64) float64 { return x * y }},
+		{"div", div_f64x2, func(x, y float64) float64 { return x / y }},
+		{"sqrt", unary(sqrt_f64x2), func(x, _ float64) float64 { return math.Sqrt(x) }},
+		{"abs", unary(abs_f64x2), func(x, _ float64) float64 { return math.Abs(x) }},
+		{"neg", unary(neg_f64x2), func(x, _ float64) float64 { return -x }},
+		{"rsqrt", unary(rsqrt_f64x2), func(x, _ float64) float64 { return 1 / math.Sqrt(x) }},
+	} {
+		ys := vs
+		if tt.name == "sqrt" || tt.name == "abs" || tt.name == "neg" || tt.name == "rsqrt" {
+			ys = one
+		}
+		checkLanes(t, tt.name, vs, ys, tt.kernel, tt.ref, sameF64)
+	}
+	a := vecOf[[16]byte]([]float64{1.5, 2})
+	if got := hsum_f64x2(a); got != 3.5 {
+		t.Errorf("hsum_f64x2 = %v, want 3.5", got)
+	}
+	if got := dot_f64x2(a, a); got != 6.25 {
+		t.Errorf("dot_f64x2 = %v, want 6.25", got)
+	}
+	if got := lanesOf[float64](slide_up_1_f64x2(a)); got[0] != 0 || got[1] != 1.5 {
+		t.Errorf("slide_up_1_f64x2 = %v", got)
+	}
+}
+
+func TestSSE2Float32x2(t *testing.T) {
+	f32x2 := func(x, y float32) [8]byte {
+		v := vecOf[[16]byte]([]float32{x, y})
+		return [8]byte(v[:8])
+	}
+	a, b := f32x2(1.5, -2), f32x2(4, 0.5)
+	check := func(name string, got [8]byte, want0, want1 float32) {
+		t.Helper()
+		var v [16]byte
+		copy(v[:], got[:])
+		if l := lanesOf[float32](v); l[0] != want0 || l[1] != want1 {
+			t.Errorf("%s = %v, want [%v %v]", name, l[:2], want0, want1)
+		}
+	}
+	check("add_f32x2", add_f32x2(a, b), 5.5, -1.5)
+	check("sub_f32x2", sub_f32x2(a, b), -2.5, -2.5)
+	check("mul_f32x2", mul_f32x2(a, b), 6, -1)
+	check("div_f32x2", div_f32x2(a, b), 0.375, -4)
+	check("min_f32x2", min_f32x2(a, b), 1.5, -2)
+	check("max_f32x2", max_f32x2(a, b), 4, 0.5)
+	if got := hsum_f32x2(a); got != -0.5 {
+		t.Errorf("hsum_f32x2 = %v, want -0.5", got)
+	}
+	if got := dot_f32x2(a, b); got != 5 {
+		t.Errorf("dot_f32x2 = %v, want 5", got)
+	}
+	if got := lanesOf[float64](cvt_f32x2_f64x2(a)); got[0] != 1.5 || got[1] != -2 {
+		t.Errorf("cvt_f32x2_f64x2 = %v", got)
+	}
+}
+
+func TestSSE2Int32x4(t *testing.T) {
+	vs := testVectors(u32Edges, func(r *rand.Rand) uint32 { return r.Uint32() >> r.IntN(32) })
+	one := [][]uint32{{0, 0, 0, 0}}
+	s := func(x uint32) int32 { return int32(x) }
+	for _, tt := range []struct {
+		name   string
+		kernel func(a, b [16]byte) [16]byte
+		ref    func(x, y uint32) uint32
+	}{
+		{"add_i32x4", add_i32x4, func(x, y uint32) uint32 { return x + y }},
+		{"sub_i32x4", sub_i32x4, func(x, y uint32) uint32 { return x - y }},
+		{"mul_i32x4", mul_i32x4, func(x, y uint32) uint32 { return x * y }},
+		{"mul_u32x4", mul_u32x4, func(x, y uint32) uint32 { return x * y }},
+		{"and_i32x4", and_i32x4, func(x, y uint32) uint32 { return x & y }},
+		{"or_u32x4", or_u32x4, func(x, y uint32) uint32 { return x | y }},
+		{"xor_i32x4", xor_i32x4, func(x, y uint32) uint32 { return x ^ y }},
+		{"andnot_i32x4", andnot_i32x4, func(x, y uint32) uint32 { return x &^ y }},
+		{"andnot_u32x4", andnot_u32x4, func(x, y uint32) uint32 { return x &^ y }},
+		{"min_i32x4", min_i32x4, func(x, y uint32) uint32 { return uint32(min(s(x), s(y))) }},
+		{"max_i32x4", max_i32x4, func(x, y uint32) uint32 { return uint32(max(s(x), s(y))) }},
+		{"min_u32x4", min_u32x4, func(x, y uint32) uint32 { return min(x, y) }},
+		{"max_u32x4", max_u32x4, func(x, y uint32) uint32 { return max(x, y) }},
+		{"adds_u32x4", adds_u32x4, func(x, y uint32) uint32 {
+			if sum, carry := bits.Add32(x, y, 0); carry == 0 {
+				return sum
+			}
+			return math.MaxUint32
+		}},
+		{"subs_u32x4", subs_u32x4, func(x, y uint32) uint32 {
+			if x < y {
+				return 0
+			}
+			return x - y
+		}},
+		{"eq_i32x4", eq_i32x4, func(x, y uint32) uint32 { return maskLane[uint32](x == y) }},
+		{"eq_u32x4", eq_u32x4, func(x, y uint32) uint32 { return maskLane[uint32](x == y) }},
+		{"gt_i32x4", gt_i32x4, func(x, y uint32) uint32 { return maskLane[uint32](s(x) > s(y)) }},
+		{"lt_i32x4", lt_i32x4, func(x, y uint32) uint32 { return maskLane[uint32](s(x) < s(y)) }},
+		{"gt_u32x4", gt_u32x4, func(x, y uint32) uint32 { return maskLane[uint32](x > y) }},
+		{"lt_u32x4", lt_u32x4, func(x, y uint32) uint32 { return maskLane[uint32](x < y) }},
+		{"ge_u32x4", ge_u32x4, func(x, y uint32) uint32 { return maskLane[uint32](x >= y) }},
+		{"le_u32x4", le_u32x4, func(x, y uint32) uint32 { return maskLane[uint32](x <= y) }},
+	} {
+		checkLanes(t, tt.name, vs, vs, tt.kernel, tt.ref, eq)
+	}
+	for _, tt := range []struct {
+		name   string
+		kernel func(a [16]byte) [16]byte
+		ref    func(x uint32) uint32
+	}{
+		{"abs_i32x4", abs_i32x4, func(x uint32) uint32 { return uint32(max(s(x), -s(x))) }},
+		{"neg_i32x4", neg_i32x4, func(x uint32) uint32 { return -x }},
+		{"not_u32x4", not_u32x4, func(x uint32) uint32 { return ^x }},
+		{"cvt_i32x4_f32x4", cvt_i32x4_f32x4, func(x uint32) uint32 { return math.Float32bits(float32(s(x))) }},
+		{"cvt_u32x4_f32x4", cvt_u32x4_f32x4, func(x uint32) uint32 { return math.Float32bits(float32(x)) }},
+	} {
+		checkLanes(t, tt.name, vs, one, unary(tt.kernel), func(x, _ uint32) uint32 { return tt.ref(x) }, eq)
+	}
+
+	for _, v := range vs {
+		var sum uint32
+		var count int64
+		all, any := int64(1), int64(0)
+		for _, x := range v {
+			sum += x
+			count += int64(x >> 31)
+			if x == 0 {
+				all = 0
+			} else {
+				any = 1
+			}
+		}
+		in := vecOf[[16]byte](v)
+		if got := hsum_i32x4(in); got != int64(int32(sum)) {
+			t.Errorf("hsum_i32x4(%v) = %d, want %d", v, got, int32(sum))
+		}
+		if got := hsum_u32x4(in); got != int64(sum) {
+			t.Errorf("hsum_u32x4(%v) = %d, want %d", v, got, sum)
+		}
+		if got := counttrue_i32x4(in); got != count {
+			t.Errorf("counttrue_i32x4(%v) = %d, want %d", v, got, count)
+		}
+		if got := alltrue_i32x4(in); got != all {
+			t.Errorf("alltrue_i32x4(%v) = %d, want %d", v, got, all)
+		}
+		if got := anytrue_i32x4(in); got != any {
+			t.Errorf("anytrue_i32x4(%v) = %d, want %d", v, got, any)
+		}
+	}
+}
+
+func TestSSE2Uint64x2(t *testing.T) {
+	vs := testVectors(u64Edges, func(r *rand.Rand) uint64 { return r.Uint64() >> r.IntN(64) })
+	for _, tt := range []struct {
+		name   string
+		kernel func(a, b [16]byte) [16]byte
+		ref    func(x, y uint64) uint64
+	}{
+		{"add_u64x2", add_u64x2, func(x, y uint64) uint64 { return x + y }},
+		{"sub_i64x2", sub_i64x2, func(x, y uint64) uint64 { return x - y }},
+		{"and_u64x2", and_u64x2, func(x, y uint64) uint64 { return x & y }},
+		{"xor_i64x2", xor_i64x2, func(x, y uint64) uint64 { return x ^ y }},
+		{"eq_i64x2", eq_i64x2, func(x, y uint64) uint64 { return maskLane[uint64](x == y) }},
+		{"eq_u64x2", eq_u64x2, func(x, y uint64) uint64 { return maskLane[uint64](x == y) }},
+		{"lt_u64x2", lt_u64x2, func(x, y uint64) uint64 { return maskLane[uint64](x < y) }},
+		{"gt_u64x2", gt_u64x2, func(x, y uint64) uint64 { return maskLane[uint64](x > y) }},
+		{"le_u64x2", le_u64x2, func(x, y uint64) uint64 { return maskLane[uint64](x <= y) }},
+		{"ge_u64x2", ge_u64x2, func(x, y uint64) uint64 { return maskLane[uint64](x >= y) }},
+		{"min_u64x2", min_u64x2, func(x, y uint64) uint64 { return min(x, y) }},
+		{"max_u64x2", max_u64x2, func(x, y uint64) uint64 { return max(x, y) }},
+		{"adds_u64x2", adds_u64x2, func(x, y uint64) uint64 {
+			if sum, carry := bits.Add64(x, y, 0); carry == 0 {
+				return sum
+			}
+			return math.MaxUint64
+		}},
+		{"subs_u64x2", subs_u64x2, func(x, y uint64) uint64 {
+			if x < y {
+				return 0
+			}
+			return x - y
+		}},
+	} {
+		checkLanes(t, tt.name, vs, vs, tt.kernel, tt.ref, eq)
+	}
+}
+
+func TestSSE2Uint16x8(t *testing.T) {
+	vs := testVectors(u16Edges, func(r *rand.Rand) uint16 { return uint16(r.Uint32()) })
+	for _, tt := range []struct {
+		name   string
+		kernel func(a, b [16]byte) [16]byte
+		ref    func(x, y uint16) uint16
+	}{
+		{"min", min_u16x8, func(x, y uint16) uint16 { return min(x, y) }},
+		{"max", max_u16x8, func(x, y uint16) uint16 { return max(x, y) }},
+		{"adds", adds_u16x8, func(x, y uint16) uint16 { return uint16(min(uint32(x)+uint32(y), math.MaxUint16)) }},
+		{"subs", subs_u16x8, func(x, y uint16) uint16 { return uint16(max(int32(x)-int32(y), 0)) }},
+		{"eq", eq_u16x8, func(x, y uint16) uint16 { return maskLane[uint16](x == y) }},
+		{"lt", lt_u16x8, func(x, y uint16) uint16 { return maskLane[uint16](x < y) }},
+		{"gt", gt_u16x8, func(x, y uint16) uint16 { return maskLane[uint16](x > y) }},
+		{"le", le_u16x8, func(x, y uint16) uint16 { return maskLane[uint16](x <= y) }},
+		{"ge", ge_u16x8, func(x, y uint16) uint16 { return maskLane[uint16](x >= y) }},
+		{"xor", xor_u16x8, func(x, y uint16) uint16 { return x ^ y }},
+		{"not", unary(not_u16x8), func(x, _ uint16) uint16 { return ^x }},
+	} {
+		checkLanes(t, tt.name+"_u16x8", vs, vs, tt.kernel, tt.ref, eq)
+	}
+}
+
+func TestSSE2Uint8x16(t *testing.T) {
+	vs := testVectors(u8Edges, func(r *rand.Rand) uint8 { return uint8(r.Uint32()) })
+	for _, tt := range []struct {
+		name   string
+		kernel func(a, b [16]byte) [16]byte
+		ref    func(x, y uint8) uint8
+	}{
+		{"min", min_u8x16, func(x, y uint8) uint8 { return min(x, y) }},
+		{"max", max_u8x16, func(x, y uint8) uint8 { return max(x, y) }},
+		{"adds", adds_u8x16, func(x, y uint8) uint8 { return uint8(min(uint32(x)+uint32(y), math.MaxUint8)) }},
+		{"subs", subs_u8x16, func(x, y uint8) uint8 { return uint8(max(int32(x)-int32(y), 0)) }},
+		{"eq", eq_u8x16, func(x, y uint8) uint8 { return maskLane[uint8](x == y) }},
+		{"lt", lt_u8x16, func(x, y uint8) uint8 { return maskLane[uint8](x < y) }},
+		{"gt", gt_u8x16, func(x, y uint8) uint8 { return maskLane[uint8](x > y) }},
+		{"le", le_u8x16, func(x, y uint8) uint8 { return maskLane[uint8](x <= y) }},
+		{"ge", ge_u8x16, func(x, y uint8) uint8 { return maskLane[uint8](x >= y) }},
+		{"or", or_u8x16, func(x, y uint8) uint8 { return x | y }},
+		{"not", unary(not_u8x16), func(x, _ uint8) uint8 { return ^x }},
+	} {
+		checkLanes(t, tt.name+"_u8x16", vs, vs, tt.kernel, tt.ref, eq)
+	}
+}
+
+func TestSSE2TableLookupBytes(t *testing.T) {
+	defer func(old bool) { x86HasSSSE3 = old }(x86HasSSSE3)
+	var tbl Uint8x16
+	for i := range tbl {
+		tbl[i] = uint8(100 + i)
+	}
+	for _, ssse3 := range []bool{false, x86HasSSSE3} {
+		x86HasSSSE3 = ssse3
+		for _, idx := range [][]uint8{u8Edges, {15, 14, 13, 12, 11, 10, 9, 8, 7, 6, 5, 4, 3, 2, 1, 0}} {
+			got := tbl.TableLookupBytes(vecOf[Uint8x16](idx))
+			for i, x := range idx {
+				want := uint8(0)
+				if x < 16 {
+					want = tbl[x]
+				}
+				if got[i] != want {
+					t.Errorf("TableLookupBytes (ssse3 %v) idx %d: got %d, want %d", ssse3, x, got[i], want)
+				}
+			}
+		}
+	}
+}
+
+func TestSSE2Load4(t *testing.T) {
+	src := make([]float32, 16)
+	for i := range src {
+		src[i] = float32(i)
+	}
+	a, b, c, d := Load4Float32x4Slice(src)
+	for i, v := range []Float32x4{a, b, c, d} {
+		if got := v.Get(1); got != float32(4*i+1) {
+			t.Errorf("Load4Float32x4Slice vector %d lane 1 = %v, want %d", i, got, 4*i+1)
+		}
+	}
+}
+
+func TestSSE2InPlace(t *testing.T) {
+	a := vecOf[[16]byte]([]float32{1, -2, 3.5, 40})
+	b := vecOf[[16]byte]([]float32{-5, 6, 7, 0.25})
+	for _, tt := range []struct {
+		name  string
+		ip    func(a, b [16]byte, result unsafe.Pointer)
+		plain func(a, b [16]byte) [16]byte
+	}{
+		{"add_f32x4", add_f32x4_ip, add_f32x4},
+		{"sub_f32x4", sub_f32x4_ip, sub_f32x4},
+		{"mul_f32x4", mul_f32x4_ip, mul_f32x4},
+		{"div_f32x4", div_f32x4_ip, div_f32x4},
+		{"min_f32x4", min_f32x4_ip, min_f32x4},
+		{"max_f32x4", max_f32x4_ip, max_f32x4},
+		{"add_f64x2", add_f64x2_ip, add_f64x2},
+		{"sub_f64x2", sub_f64x2_ip, sub_f64x2},
+		{"mul_f64x2", mul_f64x2_ip, mul_f64x2},
+		{"div_f64x2", div_f64x2_ip, div_f64x2},
+		{"min_f64x2", min_f64x2_ip, min_f64x2},
+		{"max_f64x2", max_f64x2_ip, max_f64x2},
+		{"add_i32x4", add_i32x4_ip, add_i32x4},
+		{"sub_i32x4", sub_i32x4_ip, sub_i32x4},
+		{"mul_i32x4", mul_i32x4_ip, mul_i32x4},
+		{"min_i32x4", min_i32x4_ip, min_i32x4},
+		{"max_i32x4", max_i32x4_ip, max_i32x4},
+	} {
+		var got [16]byte
+		tt.ip(a, b, unsafe.Pointer(&got))
+		if want := tt.plain(a, b); got != want {
+			t.Errorf("%s_ip = %x, want %x", tt.name, got, want)
+		}
+	}
+}
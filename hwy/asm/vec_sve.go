@@ -0,0 +1,753 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !noasm && arm64
+
+package asm
+
+import (
+	"unsafe"
+
+	"golang.org/x/sys/cpu"
+)
+
+// HasSVE reports whether the SVE vector types below can be used.
+var HasSVE = cpu.ARM64.HasSVE
+
+// SVEBits is the SVE vector length in bits, detected at init, or 0 without
+// SVE. It is 256 on Neoverse V1 (Graviton3) and 128 on Neoverse V2
+// (Graviton4). The SVE vector types work with any vector length: each
+// operation runs a WHILELO loop over the lanes, so a type no wider than
+// SVEBits takes a single iteration and a wider one takes several. Pick
+// the widest type that fits for the best throughput.
+var SVEBits = detectSVEBits()
+
+func detectSVEBits() int {
+	if !HasSVE {
+		return 0
+	}
+	return 8 * SVEVectorBytes()
+}
+
+// Float32x8SVE represents a 256-bit SVE vector of 8 float32 values.
+// Unlike the NEON types, operations pass it to assembly by pointer, since
+// SVE registers have no fixed size the Go ABI could pass them in.
+type Float32x8SVE [32]byte
+
+// Float32x16SVE represents a 512-bit SVE vector of 16 float32 values.
+type Float32x16SVE [64]byte
+
+// Float64x4SVE represents a 256-bit SVE vector of 4 float64 values.
+type Float64x4SVE [32]byte
+
+// Float64x8SVE represents a 512-bit SVE vector of 8 float64 values.
+type Float64x8SVE [64]byte
+
+// ===== Float32x8SVE constructors =====
+
+// BroadcastFloat32x8SVE creates a vector with all lanes set to the given value.
+func BroadcastFloat32x8SVE(v float32) Float32x8SVE {
+	var arr [8]float32
+	for i := range arr {
+		arr[i] = v
+	}
+	return *(*Float32x8SVE)(unsafe.Pointer(&arr))
+}
+
+// LoadFloat32x8SVE loads 8 float32 values from an array pointer (no bounds check).
+func LoadFloat32x8SVE(p *[8]float32) Float32x8SVE {
+	return *(*Float32x8SVE)(unsafe.Pointer(p))
+}
+
+// LoadFloat32x8SVESlice loads 8 float32 values from a slice (has bounds check).
+func LoadFloat32x8SVESlice(s []float32) Float32x8SVE {
+	return *(*Float32x8SVE)(unsafe.Pointer((*[8]float32)(s)))
+}
+
+// ZeroFloat32x8SVE returns a zero vector.
+func ZeroFloat32x8SVE() Float32x8SVE {
+	return Float32x8SVE{}
+}
+
+// ===== Float32x8SVE accessors =====
+
+// Get returns the element at the given index.
+func (v Float32x8SVE) Get(i int) float32 {
+	return (*[8]float32)(unsafe.Pointer(&v))[i]
+}
+
+// Set sets the element at the given index.
+func (v *Float32x8SVE) Set(i int, val float32) {
+	(*[8]float32)(unsafe.Pointer(v))[i] = val
+}
+
+// Data returns the underlying data as a slice.
+func (v Float32x8SVE) Data() []float32 {
+	return (*[8]float32)(unsafe.Pointer(&v))[:]
+}
+
+// ===== Float32x8SVE methods =====
+
+// Store stores the vector to an array pointer (no bounds check).
+func (v Float32x8SVE) Store(p *[8]float32) {
+	*(*Float32x8SVE)(unsafe.Pointer(p)) = v
+}
+
+// StoreSlice stores the vector to a slice (has bounds check).
+func (v Float32x8SVE) StoreSlice(s []float32) {
+	*(*Float32x8SVE)(unsafe.Pointer((*[8]float32)(s))) = v
+}
+
+// Add performs element-wise addition.
+func (v Float32x8SVE) Add(other Float32x8SVE) Float32x8SVE {
+	var r Float32x8SVE
+	add_f32_sve(unsafe.Pointer(&v), unsafe.Pointer(&other), unsafe.Pointer(&r), 8)
+	return r
+}
+
+// Sub performs element-wise subtraction.
+func (v Float32x8SVE) Sub(other Float32x8SVE) Float32x8SVE {
+	var r Float32x8SVE
+	sub_f32_sve(unsafe.Pointer(&v), unsafe.Pointer(&other), unsafe.Pointer(&r), 8)
+	return r
+}
+
+// Mul performs element-wise multiplication.
+func (v Float32x8SVE) Mul(other Float32x8SVE) Float32x8SVE {
+	var r Float32x8SVE
+	mul_f32_sve(unsafe.Pointer(&v), unsafe.Pointer(&other), unsafe.Pointer(&r), 8)
+	return r
+}
+
+// Div performs element-wise division.
+func (v Float32x8SVE) Div(other Float32x8SVE) Float32x8SVE {
+	var r Float32x8SVE
+	div_f32_sve(unsafe.Pointer(&v), unsafe.Pointer(&other), unsafe.Pointer(&r), 8)
+	return r
+}
+
+// Min performs element-wise minimum.
+func (v Float32x8SVE) Min(other Float32x8SVE) Float32x8SVE {
+	var r Float32x8SVE
+	min_f32_sve(unsafe.Pointer(&v), unsafe.Pointer(&other), unsafe.Pointer(&r), 8)
+	return r
+}
+
+// Max performs element-wise maximum.
+func (v Float32x8SVE) Max(other Float32x8SVE) Float32x8SVE {
+	var r Float32x8SVE
+	max_f32_sve(unsafe.Pointer(&v), unsafe.Pointer(&other), unsafe.Pointer(&r), 8)
+	return r
+}
+
+// Sqrt computes the element-wise square root.
+func (v Float32x8SVE) Sqrt() Float32x8SVE {
+	var r Float32x8SVE
+	sqrt_f32_sve(unsafe.Pointer(&v), unsafe.Pointer(&r), 8)
+	return r
+}
+
+// Abs computes the element-wise absolute value.
+func (v Float32x8SVE) Abs() Float32x8SVE {
+	var r Float32x8SVE
+	abs_f32_sve(unsafe.Pointer(&v), unsafe.Pointer(&r), 8)
+	return r
+}
+
+// Neg computes the element-wise negation.
+func (v Float32x8SVE) Neg() Float32x8SVE {
+	var r Float32x8SVE
+	neg_f32_sve(unsafe.Pointer(&v), unsafe.Pointer(&r), 8)
+	return r
+}
+
+// MulAdd performs fused multiply-add: v * a + b
+func (v Float32x8SVE) MulAdd(a, b Float32x8SVE) Float32x8SVE {
+	var r Float32x8SVE
+	fma_f32_sve(unsafe.Pointer(&v), unsafe.Pointer(&a), unsafe.Pointer(&b), unsafe.Pointer(&r), 8)
+	return r
+}
+
+// MulSub performs fused multiply-subtract: v * a - b
+func (v Float32x8SVE) MulSub(a, b Float32x8SVE) Float32x8SVE {
+	var r Float32x8SVE
+	fms_f32_sve(unsafe.Pointer(&v), unsafe.Pointer(&a), unsafe.Pointer(&b), unsafe.Pointer(&r), 8)
+	return r
+}
+
+// ReduceSum returns the sum of all elements.
+func (v Float32x8SVE) ReduceSum() float32 {
+	return hsum_f32_sve(unsafe.Pointer(&v), 8)
+}
+
+// ReduceMin returns the minimum element.
+func (v Float32x8SVE) ReduceMin() float32 {
+	return hmin_f32_sve(unsafe.Pointer(&v), 8)
+}
+
+// ReduceMax returns the maximum element.
+func (v Float32x8SVE) ReduceMax() float32 {
+	return hmax_f32_sve(unsafe.Pointer(&v), 8)
+}
+
+// Dot returns the dot product of two vectors.
+func (v Float32x8SVE) Dot(other Float32x8SVE) float32 {
+	return dot_f32_sve(unsafe.Pointer(&v), unsafe.Pointer(&other), 8)
+}
+
+// ===== Float32x16SVE constructors =====
+
+// BroadcastFloat32x16SVE creates a vector with all lanes set to the given value.
+func BroadcastFloat32x16SVE(v float32) Float32x16SVE {
+	var arr [16]float32
+	for i := range arr {
+		arr[i] = v
+	}
+	return *(*Float32x16SVE)(unsafe.Pointer(&arr))
+}
+
+// LoadFloat32x16SVE loads 16 float32 values from an array pointer (no bounds check).
+func LoadFloat32x16SVE(p *[16]float32) Float32x16SVE {
+	return *(*Float32x16SVE)(unsafe.Pointer(p))
+}
+
+// LoadFloat32x16SVESlice loads 16 float32 values from a slice (has bounds check).
+func LoadFloat32x16SVESlice(s []float32) Float32x16SVE {
+	return *(*Float32x16SVE)(unsafe.Pointer((*[16]float32)(s)))
+}
+
+// ZeroFloat32x16SVE returns a zero vector.
+func ZeroFloat32x16SVE() Float32x16SVE {
+	return Float32x16SVE{}
+}
+
+// ===== Float32x16SVE accessors =====
+
+// Get returns the element at the given index.
+func (v Float32x16SVE) Get(i int) float32 {
+	return (*[16]float32)(unsafe.Pointer(&v))[i]
+}
+
+// Set sets the element at the given index.
+func (v *Float32x16SVE) Set(i int, val float32) {
+	(*[16]float32)(unsafe.Pointer(v))[i] = val
+}
+
+// Data returns the underlying data as a slice.
+func (v Float32x16SVE) Data() []float32 {
+	return (*[16]float32)(unsafe.Pointer(&v))[:]
+}
+
+// ===== Float32x16SVE methods =====
+
+// Store stores the vector to an array pointer (no bounds check).
+func (v Float32x16SVE) Store(p *[16]float32) {
+	*(*Float32x16SVE)(unsafe.Pointer(p)) = v
+}
+
+// StoreSlice stores the vector to a slice (has bounds check).
+func (v Float32x16SVE) StoreSlice(s []float32) {
+	*(*Float32x16SVE)(unsafe.Pointer((*[16]float32)(s))) = v
+}
+
+// Add performs element-wise addition.
+func (v Float32x16SVE) Add(other Float32x16SVE) Float32x16SVE {
+	var r Float32x16SVE
+	add_f32_sve(unsafe.Pointer(&v), unsafe.Pointer(&other), unsafe.Pointer(&r), 16)
+	return r
+}
+
+// Sub performs element-wise subtraction.
+func (v Float32x16SVE) Sub(other Float32x16SVE) Float32x16SVE {
+	var r Float32x16SVE
+	sub_f32_sve(unsafe.Pointer(&v), unsafe.Pointer(&other), unsafe.Pointer(&r), 16)
+	return r
+}
+
+// Mul performs element-wise multiplication.
+func (v Float32x16SVE) Mul(other Float32x16SVE) Float32x16SVE {
+	var r Float32x16SVE
+	mul_f32_sve(unsafe.Pointer(&v), unsafe.Pointer(&other), unsafe.Pointer(&r), 16)
+	return r
+}
+
+// Div performs element-wise division.
+func (v Float32x16SVE) Div(other Float32x16SVE) Float32x16SVE {
+	var r Float32x16SVE
+	div_f32_sve(unsafe.Pointer(&v), unsafe.Pointer(&other), unsafe.Pointer(&r), 16)
+	return r
+}
+
+// Min performs element-wise minimum.
+func (v Float32x16SVE) Min(other Float32x16SVE) Float32x16SVE {
+	var r Float32x16SVE
+	min_f32_sve(unsafe.Pointer(&v), unsafe.Pointer(&other), unsafe.Pointer(&r), 16)
+	return r
+}
+
+// Max performs element-wise maximum.
+func (v Float32x16SVE) Max(other Float32x16SVE) Float32x16SVE {
+	var r Float32x16SVE
+	max_f32_sve(unsafe.Pointer(&v), unsafe.Pointer(&other), unsafe.Pointer(&r), 16)
+	return r
+}
+
+// Sqrt computes the element-wise square root.
+func (v Float32x16SVE) Sqrt() Float32x16SVE {
+	var r Float32x16SVE
+	sqrt_f32_sve(unsafe.Pointer(&v), unsafe.Pointer(&r), 16)
+	return r
+}
+
+// Abs computes the element-wise absolute value.
+func (v Float32x16SVE) Abs() Float32x16SVE {
+	var r Float32x16SVE
+	abs_f32_sve(unsafe.Pointer(&v), unsafe.Pointer(&r), 16)
+	return r
+}
+
+// Neg computes the element-wise negation.
+func (v Float32x16SVE) Neg() Float32x16SVE {
+	var r Float32x16SVE
+	neg_f32_sve(unsafe.Pointer(&v), unsafe.Pointer(&r), 16)
+	return r
+}
+
+// MulAdd performs fused multiply-add: v * a + b
+func (v Float32x16SVE) MulAdd(a, b Float32x16SVE) Float32x16SVE {
+	var r Float32x16SVE
+	fma_f32_sve(unsafe.Pointer(&v), unsafe.Pointer(&a), unsafe.Pointer(&b), unsafe.Pointer(&r), 16)
+	return r
+}
+
+// MulSub performs fused multiply-subtract: v * a - b
+func (v Float32x16SVE) MulSub(a, b Float32x16SVE) Float32x16SVE {
+	var r Float32x16SVE
+	fms_f32_sve(unsafe.Pointer(&v), unsafe.Pointer(&a), unsafe.Pointer(&b), unsafe.Pointer(&r), 16)
+	return r
+}
+
+// ReduceSum returns the sum of all elements.
+func (v Float32x16SVE) ReduceSum() float32 {
+	return hsum_f32_sve(unsafe.Pointer(&v), 16)
+}
+
+// ReduceMin returns the minimum element.
+func (v Float32x16SVE) ReduceMin() float32 {
+	return hmin_f32_sve(unsafe.Pointer(&v), 16)
+}
+
+// ReduceMax returns the maximum element.
+func (v Float32x16SVE) ReduceMax() float32 {
+	return hmax_f32_sve(unsafe.Pointer(&v), 16)
+}
+
+// Dot returns the dot product of two vectors.
+func (v Float32x16SVE) Dot(other Float32x16SVE) float32 {
+	return dot_f32_sve(unsafe.Pointer(&v), unsafe.Pointer(&other), 16)
+}
+
+// ===== Float64x4SVE constructors =====
+
+// BroadcastFloat64x4SVE creates a vector with all lanes set to the given value.
+func BroadcastFloat64x4SVE(v float64) Float64x4SVE {
+	var arr [4]float64
+	for i := range arr {
+		arr[i] = v
+	}
+	return *(*Float64x4SVE)(unsafe.Pointer(&arr))
+}
+
+// LoadFloat64x4SVE loads 4 float64 values from an array pointer (no bounds check).
+func LoadFloat64x4SVE(p *[4]float64) Float64x4SVE {
+	return *(*Float64x4SVE)(unsafe.Pointer(p))
+}
+
+// LoadFloat64x4SVESlice loads 4 float64 values from a slice (has bounds check).
+func LoadFloat64x4SVESlice(s []float64) Float64x4SVE {
+	return *(*Float64x4SVE)(unsafe.Pointer((*[4]float64)(s)))
+}
+
+// ZeroFloat64x4SVE returns a zero vector.
+func ZeroFloat64x4SVE() Float64x4SVE {
+	return Float64x4SVE{}
+}
+
+// ===== Float64x4SVE accessors =====
+
+// Get returns the element at the given index.
+func (v Float64x4SVE) Get(i int) float64 {
+	return (*[4]float64)(unsafe.Pointer(&v))[i]
+}
+
+// Set sets the element at the given index.
+func (v *Float64x4SVE) Set(i int, val float64) {
+	(*[4]float64)(unsafe.Pointer(v))[i] = val
+}
+
+// Data returns the underlying data as a slice.
+func (v Float64x4SVE) Data() []float64 {
+	return (*[4]float64)(unsafe.Pointer(&v))[:]
+}
+
+// ===== Float64x4SVE methods =====
+
+// Store stores the vector to an array pointer (no bounds check).
+func (v Float64x4SVE) Store(p *[4]float64) {
+	*(*Float64x4SVE)(unsafe.Pointer(p)) = v
+}
+
+// StoreSlice stores the vector to a slice (has bounds check).
+func (v Float64x4SVE) StoreSlice(s []float64) {
+	*(*Float64x4SVE)(unsafe.Pointer((*[4]float64)(s))) = v
+}
+
+// Add performs element-wise addition.
+func (v Float64x4SVE) Add(other Float64x4SVE) Float64x4SVE {
+	var r Float64x4SVE
+	add_f64_sve(unsafe.Pointer(&v), unsafe.Pointer(&other), unsafe.Pointer(&r), 4)
+	return r
+}
+
+// Sub performs element-wise subtraction.
+func (v Float64x4SVE) Sub(other Float64x4SVE) Float64x4SVE {
+	var r Float64x4SVE
+	sub_f64_sve(unsafe.Pointer(&v), unsafe.Pointer(&other), unsafe.Pointer(&r), 4)
+	return r
+}
+
+// Mul performs element-wise multiplication.
+func (v Float64x4SVE) Mul(other Float64x4SVE) Float64x4SVE {
+	var r Float64x4SVE
+	mul_f64_sve(unsafe.Pointer(&v), unsafe.Pointer(&other), unsafe.Pointer(&r), 4)
+	return r
+}
+
+// Div performs element-wise division.
+func (v Float64x4SVE) Div(other Float64x4SVE) Float64x4SVE {
+	var r Float64x4SVE
+	div_f64_sve(unsafe.Pointer(&v), unsafe.Pointer(&other), unsafe.Pointer(&r), 4)
+	return r
+}
+
+// Min performs element-wise minimum.
+func (v Float64x4SVE) Min(other Float64x4SVE) Float64x4SVE {
+	var r Float64x4SVE
+	min_f64_sve(unsafe.Pointer(&v), unsafe.Pointer(&other), unsafe.Pointer(&r), 4)
+	return r
+}
+
+// Max performs element-wise maximum.
+func (v Float64x4SVE) Max(other Float64x4SVE) Float64x4SVE {
+	var r Float64x4SVE
+	max_f64_sve(unsafe.Pointer(&v), unsafe.Pointer(&other), unsafe.Pointer(&r), 4)
+	return r
+}
+
+// Sqrt computes the element-wise square root.
+func (v Float64x4SVE) Sqrt() Float64x4SVE {
+	var r Float64x4SVE
+	sqrt_f64_sve(unsafe.Pointer(&v), unsafe.Pointer(&r), 4)
+	return r
+}
+
+// Abs computes the element-wise absolute value.
+func (v Float64x4SVE) Abs() Float64x4SVE {
+	var r Float64x4SVE
+	abs_f64_sve(unsafe.Pointer(&v), unsafe.Pointer(&r), 4)
+	return r
+}
+
+// Neg computes the element-wise negation.
+func (v Float64x4SVE) Neg() Float64x4SVE {
+	var r Float64x4SVE
+	neg_f64_sve(unsafe.Pointer(&v), unsafe.Pointer(&r), 4)
+	return r
+}
+
+// MulAdd performs fused multiply-add: v * a + b
+func (v Float64x4SVE) MulAdd(a, b Float64x4SVE) Float64x4SVE {
+	var r Float64x4SVE
+	fma_f64_sve(unsafe.Pointer(&v), unsafe.Pointer(&a), unsafe.Pointer(&b), unsafe.Pointer(&r), 4)
+	return r
+}
+
+// MulSub performs fused multiply-subtract: v * a - b
+func (v Float64x4SVE) MulSub(a, b Float64x4SVE) Float64x4SVE {
+	var r Float64x4SVE
+	fms_f64_sve(unsafe.Pointer(&v), unsafe.Pointer(&a), unsafe.Pointer(&b), unsafe.Pointer(&r), 4)
+	return r
+}
+
+// ReduceSum returns the sum of all elements.
+func (v Float64x4SVE) ReduceSum() float64 {
+	return hsum_f64_sve(unsafe.Pointer(&v), 4)
+}
+
+// ReduceMin returns the minimum element.
+func (v Float64x4SVE) ReduceMin() float64 {
+	return hmin_f64_sve(unsafe.Pointer(&v), 4)
+}
+
+// ReduceMax returns the maximum element.
+func (v Float64x4SVE) ReduceMax() float64 {
+	return hmax_f64_sve(unsafe.Pointer(&v), 4)
+}
+
+// Dot returns the dot product of two vectors.
+func (v Float64x4SVE) Dot(other Float64x4SVE) float64 {
+	return dot_f64_sve(unsafe.Pointer(&v), unsafe.Pointer(&other), 4)
+}
+
+// ===== Float64x8SVE constructors =====
+
+// BroadcastFloat64x8SVE creates a vector with all lanes set to the given value.
+func BroadcastFloat64x8SVE(v float64) Float64x8SVE {
+	var arr [8]float64
+	for i := range arr {
+		arr[i] = v
+	}
+	return *(*Float64x8SVE)(unsafe.Pointer(&arr))
+}
+
+// LoadFloat64x8SVE loads 8 float64 values from an array pointer (no bounds check).
+func LoadFloat64x8SVE(p *[8]float64) Float64x8SVE {
+	return *(*Float64x8SVE)(unsafe.Pointer(p))
+}
+
+// LoadFloat64x8SVESlice loads 8 float64 values from a slice (has bounds check).
+func LoadFloat64x8SVESlice(s []float64) Float64x8SVE {
+	return *(*Float64x8SVE)(unsafe.Pointer((*[8]float64)(s)))
+}
+
+// ZeroFloat64x8SVE returns a zero vector.
+func ZeroFloat64x8SVE() Float64x8SVE {
+	return Float64x8SVE{}
+}
+
+// ===== Float64x8SVE accessors =====
+
+// Get returns the element at the given index.
+func (v Float64x8SVE) Get(i int) float64 {
+	return (*[8]float64)(unsafe.Pointer(&v))[i]
+}
+
+// Set sets the element at the given index.
+func (v *Float64x8SVE) Set(i int, val float64) {
+	(*[8]float64)(unsafe.Pointer(v))[i] = val
+}
+
+// Data returns the underlying data as a slice.
+func (v Float64x8SVE) Data() []float64 {
+	return (*[8]float64)(unsafe.Pointer(&v))[:]
+}
+
+// ===== Float64x8SVE methods =====
+
+// Store stores the vector to an array pointer (no bounds check).
+func (v Float64x8SVE) Store(p *[8]float64) {
+	*(*Float64x8SVE)(unsafe.Pointer(p)) = v
+}
+
+// StoreSlice stores the vector to a slice (has bounds check).
+func (v Float64x8SVE) StoreSlice(s []float64) {
+	*(*Float64x8SVE)(unsafe.Pointer((*[8]float64)(s))) = v
+}
+
+// Add performs element-wise addition.
+func (v Float64x8SVE) Add(other Float64x8SVE) Float64x8SVE {
+	var r Float64x8SVE
+	add_f64_sve(unsafe.Pointer(&v), unsafe.Pointer(&other), unsafe.Pointer(&r), 8)
+	return r
+}
+
+// Sub performs element-wise subtraction.
+func (v Float64x8SVE) Sub(other Float64x8SVE) Float64x8SVE {
+	var r Float64x8SVE
+	sub_f64_sve(unsafe.Pointer(&v), unsafe.Pointer(&other), unsafe.Pointer(&r), 8)
+	return r
+}
+
+// Mul performs element-wise multiplication.
+func (v Float64x8SVE) Mul(other Float64x8SVE) Float64x8SVE {
+	var r Float64x8SVE
+	mul_f64_sve(unsafe.Pointer(&v), unsafe.Pointer(&other), unsafe.Pointer(&r), 8)
+	return r
+}
+
+// Div performs element-wise division.
+func (v Float64x8SVE) Div(other Float64x8SVE) Float64x8SVE {
+	var r Float64x8SVE
+	div_f64_sve(unsafe.Pointer(&v), unsafe.Pointer(&other), unsafe.Pointer(&r), 8)
+	return r
+}
+
+// Min performs element-wise minimum.
+func (v Float64x8SVE) Min(other Float64x8SVE) Float64x8SVE {
+	var r Float64x8SVE
+	min_f64_sve(unsafe.Pointer(&v), unsafe.Pointer(&other), unsafe.Pointer(&r), 8)
+	return r
+}
+
+// Max performs element-wise maximum.
+func (v Float64x8SVE) Max(other Float64x8SVE) Float64x8SVE {
+	var r Float64x8SVE
+	max_f64_sve(unsafe.Pointer(&v), unsafe.Pointer(&other), unsafe.Pointer(&r), 8)
+	return r
+}
+
+// Sqrt computes the element-wise square root.
+func (v Float64x8SVE) Sqrt() Float64x8SVE {
+	var r Float64x8SVE
+	sqrt_f64_sve(unsafe.Pointer(&v), unsafe.Pointer(&r), 8)
+	return r
+}
+
+// Abs computes the element-wise absolute value.
+func (v Float64x8SVE) Abs() Float64x8SVE {
+	var r Float64x8SVE
+	abs_f64_sve(unsafe.Pointer(&v), unsafe.Pointer(&r), 8)
+	return r
+}
+
+// Neg computes the element-wise negation.
+func (v Float64x8SVE) Neg() Float64x8SVE {
+	var r Float64x8SVE
+	neg_f64_sve(unsafe.Pointer(&v), unsafe.Pointer(&r), 8)
+	return r
+}
+
+// MulAdd performs fused multiply-add: v * a + b
+func (v Float64x8SVE) MulAdd(a, b Float64x8SVE) Float64x8SVE {
+	var r Float64x8SVE
+	fma_f64_sve(unsafe.Pointer(&v), unsafe.Pointer(&a), unsafe.Pointer(&b), unsafe.Pointer(&r), 8)
+	return r
+}
+
+// MulSub performs fused multiply-subtract: v * a - b
+func (v Float64x8SVE) MulSub(a, b Float64x8SVE) Float64x8SVE {
+	var r Float64x8SVE
+	fms_f64_sve(unsafe.Pointer(&v), unsafe.Pointer(&a), unsafe.Pointer(&b), unsafe.Pointer(&r), 8)
+	return r
+}
+
+// ReduceSum returns the sum of all elements.
+func (v Float64x8SVE) ReduceSum() float64 {
+	return hsum_f64_sve(unsafe.Pointer(&v), 8)
+}
+
+// ReduceMin returns the minimum element.
+func (v Float64x8SVE) ReduceMin() float64 {
+	return hmin_f64_sve(unsafe.Pointer(&v), 8)
+}
+
+// ReduceMax returns the maximum element.
+func (v Float64x8SVE) ReduceMax() float64 {
+	return hmax_f64_sve(unsafe.Pointer(&v), 8)
+}
+
+// Dot returns the dot product of two vectors.
+func (v Float64x8SVE) Dot(other Float64x8SVE) float64 {
+	return dot_f64_sve(unsafe.Pointer(&v), unsafe.Pointer(&other), 8)
+}
+
+//go:noescape
+func add_f32_sve(a, b, result unsafe.Pointer, n int)
+
+//go:noescape
+func sub_f32_sve(a, b, result unsafe.Pointer, n int)
+
+//go:noescape
+func mul_f32_sve(a, b, result unsafe.Pointer, n int)
+
+//go:noescape
+func div_f32_sve(a, b, result unsafe.Pointer, n int)
+
+//go:noescape
+func min_f32_sve(a, b, result unsafe.Pointer, n int)
+
+//go:noescape
+func max_f32_sve(a, b, result unsafe.Pointer, n int)
+
+//go:noescape
+func fma_f32_sve(a, b, c, result unsafe.Pointer, n int)
+
+//go:noescape
+func fms_f32_sve(a, b, c, result unsafe.Pointer, n int)
+
+//go:noescape
+func sqrt_f32_sve(a, result unsafe.Pointer, n int)
+
+//go:noescape
+func abs_f32_sve(a, result unsafe.Pointer, n int)
+
+//go:noescape
+func neg_f32_sve(a, result unsafe.Pointer, n int)
+
+//go:noescape
+func hsum_f32_sve(a unsafe.Pointer, n int) (result float32)
+
+//go:noescape
+func hmin_f32_sve(a unsafe.Pointer, n int) (result float32)
+
+//go:noescape
+func hmax_f32_sve(a unsafe.Pointer, n int) (result float32)
+
+//go:noescape
+func dot_f32_sve(a, b unsafe.Pointer, n int) (result float32)
+
+//go:noescape
+func add_f64_sve(a, b, result unsafe.Pointer, n int)
+
+//go:noescape
+func sub_f64_sve(a, b, result unsafe.Pointer, n int)
+
+//go:noescape
+func mul_f64_sve(a, b, result unsafe.Pointer, n int)
+
+//go:noescape
+func div_f64_sve(a, b, result unsafe.Pointer, n int)
+
+//go:noescape
+func min_f64_sve(a, b, result unsafe.Pointer, n int)
+
+//go:noescape
+func max_f64_sve(a, b, result unsafe.Pointer, n int)
+
+//go:noescape
+func fma_f64_sve(a, b, c, result unsafe.Pointer, n int)
+
+//go:noescape
+func fms_f64_sve(a, b, c, result unsafe.Pointer, n int)
+
+//go:noescape
+func sqrt_f64_sve(a, result unsafe.Pointer, n int)
+
+//go:noescape
+func abs_f64_sve(a, result unsafe.Pointer, n int)
+
+//go:noescape
+func neg_f64_sve(a, result unsafe.Pointer, n int)
+
+//go:noescape
+func hsum_f64_sve(a unsafe.Pointer, n int) (result float64)
+
+//go:noescape
+func hmin_f64_sve(a unsafe.Pointer, n int) (result float64)
+
+//go:noescape
+func hmax_f64_sve(a unsafe.Pointer, n int) (result float64)
+
+//go:noescape
+func dot_f64_sve(a, b unsafe.Pointer, n int) (result float64)
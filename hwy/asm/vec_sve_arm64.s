@@ -0,0 +1,658 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//go:build !noasm && arm64
+
+#include "textflag.h"
+
+// Kernels for the SVE vector types in vec_sve.go. The Go assembler has no
+// SVE mnemonics, so SVE instructions are encoded with WORD.
+//
+// Each kernel processes n lanes in a WHILELO loop, so it works with any SVE
+// vector length: a vector that fits in one register takes one iteration,
+// a wider one takes several. Reductions accumulate across iterations into
+// Z2 and reduce it once at the end.
+
+// ===== float32 =====
+
+// func add_f32_sve(a, b, result unsafe.Pointer, n int)
+TEXT ·add_f32_sve(SB), NOSPLIT, $0-32
+	MOVD a+0(FP), R0
+	MOVD b+8(FP), R1
+	MOVD result+16(FP), R2
+	MOVD n+24(FP), R3
+	MOVD $0, R4
+
+loop:
+	WORD $0x25a31c80 // whilelo p0.s, x4, x3
+	BEQ  done
+	WORD $0xa5444000 // ld1w {z0.s}, p0/z, [x0, x4, lsl #2]
+	WORD $0xa5444021 // ld1w {z1.s}, p0/z, [x1, x4, lsl #2]
+	WORD $0x65808020 // fadd z0.s, p0/m, z0.s, z1.s
+	WORD $0xe5444040 // st1w {z0.s}, p0, [x2, x4, lsl #2]
+	WORD $0x04b0e3e4 // incw x4
+	B    loop
+
+done:
+	RET
+
+// func sub_f32_sve(a, b, result unsafe.Pointer, n int)
+TEXT ·sub_f32_sve(SB), NOSPLIT, $0-32
+	MOVD a+0(FP), R0
+	MOVD b+8(FP), R1
+	MOVD result+16(FP), R2
+	MOVD n+24(FP), R3
+	MOVD $0, R4
+
+loop:
+	WORD $0x25a31c80 // whilelo p0.s, x4, x3
+	BEQ  done
+	WORD $0xa5444000 // ld1w {z0.s}, p0/z, [x0, x4, lsl #2]
+	WORD $0xa5444021 // ld1w {z1.s}, p0/z, [x1, x4, lsl #2]
+	WORD $0x65818020 // fsub z0.s, p0/m, z0.s, z1.s
+	WORD $0xe5444040 // st1w {z0.s}, p0, [x2, x4, lsl #2]
+	WORD $0x04b0e3e4 // incw x4
+	B    loop
+
+done:
+	RET
+
+// func mul_f32_sve(a, b, result unsafe.Pointer, n int)
+TEXT ·mul_f32_sve(SB), NOSPLIT, $0-32
+	MOVD a+0(FP), R0
+	MOVD b+8(FP), R1
+	MOVD result+16(FP), R2
+	MOVD n+24(FP), R3
+	MOVD $0, R4
+
+loop:
+	WORD $0x25a31c80 // whilelo p0.s, x4, x3
+	BEQ  done
+	WORD $0xa5444000 // ld1w {z0.s}, p0/z, [x0, x4, lsl #2]
+	WORD $0xa5444021 // ld1w {z1.s}, p0/z, [x1, x4, lsl #2]
+	WORD $0x65828020 // fmul z0.s, p0/m, z0.s, z1.s
+	WORD $0xe5444040 // st1w {z0.s}, p0, [x2, x4, lsl #2]
+	WORD $0x04b0e3e4 // incw x4
+	B    loop
+
+done:
+	RET
+
+// func div_f32_sve(a, b, result unsafe.Pointer, n int)
+TEXT ·div_f32_sve(SB), NOSPLIT, $0-32
+	MOVD a+0(FP), R0
+	MOVD b+8(FP), R1
+	MOVD result+16(FP), R2
+	MOVD n+24(FP), R3
+	MOVD $0, R4
+
+loop:
+	WORD $0x25a31c80 // whilelo p0.s, x4, x3
+	BEQ  done
+	WORD $0xa5444000 // ld1w {z0.s}, p0/z, [x0, x4, lsl #2]
+	WORD $0xa5444021 // ld1w {z1.s}, p0/z, [x1, x4, lsl #2]
+	WORD $0x658d8020 // fdiv z0.s, p0/m, z0.s, z1.s
+	WORD $0xe5444040 // st1w {z0.s}, p0, [x2, x4, lsl #2]
+	WORD $0x04b0e3e4 // incw x4
+	B    loop
+
+done:
+	RET
+
+// func min_f32_sve(a, b, result unsafe.Pointer, n int)
+TEXT ·min_f32_sve(SB), NOSPLIT, $0-32
+	MOVD a+0(FP), R0
+	MOVD b+8(FP), R1
+	MOVD result+16(FP), R2
+	MOVD n+24(FP), R3
+	MOVD $0, R4
+
+loop:
+	WORD $0x25a31c80 // whilelo p0.s, x4, x3
+	BEQ  done
+	WORD $0xa5444000 // ld1w {z0.s}, p0/z, [x0, x4, lsl #2]
+	WORD $0xa5444021 // ld1w {z1.s}, p0/z, [x1, x4, lsl #2]
+	WORD $0x65878020 // fmin z0.s, p0/m, z0.s, z1.s
+	WORD $0xe5444040 // st1w {z0.s}, p0, [x2, x4, lsl #2]
+	WORD $0x04b0e3e4 // incw x4
+	B    loop
+
+done:
+	RET
+
+// func max_f32_sve(a, b, result unsafe.Pointer, n int)
+TEXT ·max_f32_sve(SB), NOSPLIT, $0-32
+	MOVD a+0(FP), R0
+	MOVD b+8(FP), R1
+	MOVD result+16(FP), R2
+	MOVD n+24(FP), R3
+	MOVD $0, R4
+
+loop:
+	WORD $0x25a31c80 // whilelo p0.s, x4, x3
+	BEQ  done
+	WORD $0xa5444000 // ld1w {z0.s}, p0/z, [x0, x4, lsl #2]
+	WORD $0xa5444021 // ld1w {z1.s}, p0/z, [x1, x4, lsl #2]
+	WORD $0x65868020 // fmax z0.s, p0/m, z0.s, z1.s
+	WORD $0xe5444040 // st1w {z0.s}, p0, [x2, x4, lsl #2]
+	WORD $0x04b0e3e4 // incw x4
+	B    loop
+
+done:
+	RET
+
+// func fma_f32_sve(a, b, c, result unsafe.Pointer, n int)
+TEXT ·fma_f32_sve(SB), NOSPLIT, $0-40
+	MOVD a+0(FP), R0
+	MOVD b+8(FP), R1
+	MOVD c+16(FP), R2
+	MOVD result+24(FP), R5
+	MOVD n+32(FP), R3
+	MOVD $0, R4
+
+loop:
+	WORD $0x25a31c80 // whilelo p0.s, x4, x3
+	BEQ  done
+	WORD $0xa5444000 // ld1w {z0.s}, p0/z, [x0, x4, lsl #2]
+	WORD $0xa5444021 // ld1w {z1.s}, p0/z, [x1, x4, lsl #2]
+	WORD $0xa5444042 // ld1w {z2.s}, p0/z, [x2, x4, lsl #2]
+	WORD $0x65a28020 // fmad z0.s, p0/m, z1.s, z2.s
+	WORD $0xe54440a0 // st1w {z0.s}, p0, [x5, x4, lsl #2]
+	WORD $0x04b0e3e4 // incw x4
+	B    loop
+
+done:
+	RET
+
+// func fms_f32_sve(a, b, c, result unsafe.Pointer, n int)
+TEXT ·fms_f32_sve(SB), NOSPLIT, $0-40
+	MOVD a+0(FP), R0
+	MOVD b+8(FP), R1
+	MOVD c+16(FP), R2
+	MOVD result+24(FP), R5
+	MOVD n+32(FP), R3
+	MOVD $0, R4
+
+loop:
+	WORD $0x25a31c80 // whilelo p0.s, x4, x3
+	BEQ  done
+	WORD $0xa5444000 // ld1w {z0.s}, p0/z, [x0, x4, lsl #2]
+	WORD $0xa5444021 // ld1w {z1.s}, p0/z, [x1, x4, lsl #2]
+	WORD $0xa5444042 // ld1w {z2.s}, p0/z, [x2, x4, lsl #2]
+	WORD $0x65a2e020 // fnmsb z0.s, p0/m, z1.s, z2.s
+	WORD $0xe54440a0 // st1w {z0.s}, p0, [x5, x4, lsl #2]
+	WORD $0x04b0e3e4 // incw x4
+	B    loop
+
+done:
+	RET
+
+// func sqrt_f32_sve(a, result unsafe.Pointer, n int)
+TEXT ·sqrt_f32_sve(SB), NOSPLIT, $0-24
+	MOVD a+0(FP), R0
+	MOVD result+8(FP), R1
+	MOVD n+16(FP), R3
+	MOVD $0, R4
+
+loop:
+	WORD $0x25a31c80 // whilelo p0.s, x4, x3
+	BEQ  done
+	WORD $0xa5444000 // ld1w {z0.s}, p0/z, [x0, x4, lsl #2]
+	WORD $0x658da000 // fsqrt z0.s, p0/m, z0.s
+	WORD $0xe5444020 // st1w {z0.s}, p0, [x1, x4, lsl #2]
+	WORD $0x04b0e3e4 // incw x4
+	B    loop
+
+done:
+	RET
+
+// func abs_f32_sve(a, result unsafe.Pointer, n int)
+TEXT ·abs_f32_sve(SB), NOSPLIT, $0-24
+	MOVD a+0(FP), R0
+	MOVD result+8(FP), R1
+	MOVD n+16(FP), R3
+	MOVD $0, R4
+
+loop:
+	WORD $0x25a31c80 // whilelo p0.s, x4, x3
+	BEQ  done
+	WORD $0xa5444000 // ld1w {z0.s}, p0/z, [x0, x4, lsl #2]
+	WORD $0x049ca000 // fabs z0.s, p0/m, z0.s
+	WORD $0xe5444020 // st1w {z0.s}, p0, [x1, x4, lsl #2]
+	WORD $0x04b0e3e4 // incw x4
+	B    loop
+
+done:
+	RET
+
+// func neg_f32_sve(a, result unsafe.Pointer, n int)
+TEXT ·neg_f32_sve(SB), NOSPLIT, $0-24
+	MOVD a+0(FP), R0
+	MOVD result+8(FP), R1
+	MOVD n+16(FP), R3
+	MOVD $0, R4
+
+loop:
+	WORD $0x25a31c80 // whilelo p0.s, x4, x3
+	BEQ  done
+	WORD $0xa5444000 // ld1w {z0.s}, p0/z, [x0, x4, lsl #2]
+	WORD $0x049da000 // fneg z0.s, p0/m, z0.s
+	WORD $0xe5444020 // st1w {z0.s}, p0, [x1, x4, lsl #2]
+	WORD $0x04b0e3e4 // incw x4
+	B    loop
+
+done:
+	RET
+
+// func hsum_f32_sve(a unsafe.Pointer, n int) (result float32)
+TEXT ·hsum_f32_sve(SB), NOSPLIT, $0-20
+	MOVD a+0(FP), R0
+	MOVD n+8(FP), R3
+	WORD $0x2598e3e1 // ptrue p1.s
+	WORD $0x25b8c002 // mov z2.s, #0
+	MOVD $0, R4
+
+loop:
+	WORD $0x25a31c80 // whilelo p0.s, x4, x3
+	BEQ  done
+	WORD $0xa5444000 // ld1w {z0.s}, p0/z, [x0, x4, lsl #2]
+	WORD $0x65808002 // fadd z2.s, p0/m, z2.s, z0.s
+	WORD $0x04b0e3e4 // incw x4
+	B    loop
+
+done:
+	WORD $0x65802440 // faddv s0, p1, z2.s
+	FMOVS F0, result+16(FP)
+	RET
+
+// func hmin_f32_sve(a unsafe.Pointer, n int) (result float32)
+TEXT ·hmin_f32_sve(SB), NOSPLIT, $0-20
+	MOVD a+0(FP), R0
+	MOVD n+8(FP), R3
+	WORD $0x2598e3e1 // ptrue p1.s
+	WORD $0x8540c402 // ld1rw {z2.s}, p1/z, [x0]
+	MOVD $0, R4
+
+loop:
+	WORD $0x25a31c80 // whilelo p0.s, x4, x3
+	BEQ  done
+	WORD $0xa5444000 // ld1w {z0.s}, p0/z, [x0, x4, lsl #2]
+	WORD $0x65878002 // fmin z2.s, p0/m, z2.s, z0.s
+	WORD $0x04b0e3e4 // incw x4
+	B    loop
+
+done:
+	WORD $0x65872440 // fminv s0, p1, z2.s
+	FMOVS F0, result+16(FP)
+	RET
+
+// func hmax_f32_sve(a unsafe.Pointer, n int) (result float32)
+TEXT ·hmax_f32_sve(SB), NOSPLIT, $0-20
+	MOVD a+0(FP), R0
+	MOVD n+8(FP), R3
+	WORD $0x2598e3e1 // ptrue p1.s
+	WORD $0x8540c402 // ld1rw {z2.s}, p1/z, [x0]
+	MOVD $0, R4
+
+loop:
+	WORD $0x25a31c80 // whilelo p0.s, x4, x3
+	BEQ  done
+	WORD $0xa5444000 // ld1w {z0.s}, p0/z, [x0, x4, lsl #2]
+	WORD $0x65868002 // fmax z2.s, p0/m, z2.s, z0.s
+	WORD $0x04b0e3e4 // incw x4
+	B    loop
+
+done:
+	WORD $0x65862440 // fmaxv s0, p1, z2.s
+	FMOVS F0, result+16(FP)
+	RET
+
+// func dot_f32_sve(a, b unsafe.Pointer, n int) (result float32)
+TEXT ·dot_f32_sve(SB), NOSPLIT, $0-28
+	MOVD a+0(FP), R0
+	MOVD b+8(FP), R1
+	MOVD n+16(FP), R3
+	WORD $0x2598e3e1 // ptrue p1.s
+	WORD $0x25b8c002 // mov z2.s, #0
+	MOVD $0, R4
+
+loop:
+	WORD $0x25a31c80 // whilelo p0.s, x4, x3
+	BEQ  done
+	WORD $0xa5444000 // ld1w {z0.s}, p0/z, [x0, x4, lsl #2]
+	WORD $0xa5444021 // ld1w {z1.s}, p0/z, [x1, x4, lsl #2]
+	WORD $0x65a10002 // fmla z2.s, p0/m, z0.s, z1.s
+	WORD $0x04b0e3e4 // incw x4
+	B    loop
+
+done:
+	WORD $0x65802440 // faddv s0, p1, z2.s
+	FMOVS F0, result+24(FP)
+	RET
+
+// ===== float64 =====
+
+// func add_f64_sve(a, b, result unsafe.Pointer, n int)
+TEXT ·add_f64_sve(SB), NOSPLIT, $0-32
+	MOVD a+0(FP), R0
+	MOVD b+8(FP), R1
+	MOVD result+16(FP), R2
+	MOVD n+24(FP), R3
+	MOVD $0, R4
+
+loop:
+	WORD $0x25e31c80 // whilelo p0.d, x4, x3
+	BEQ  done
+	WORD $0xa5e44000 // ld1d {z0.d}, p0/z, [x0, x4, lsl #3]
+	WORD $0xa5e44021 // ld1d {z1.d}, p0/z, [x1, x4, lsl #3]
+	WORD $0x65c08020 // fadd z0.d, p0/m, z0.d, z1.d
+	WORD $0xe5e44040 // st1d {z0.d}, p0, [x2, x4, lsl #3]
+	WORD $0x04f0e3e4 // incd x4
+	B    loop
+
+done:
+	RET
+
+// func sub_f64_sve(a, b, result unsafe.Pointer, n int)
+TEXT ·sub_f64_sve(SB), NOSPLIT, $0-32
+	MOVD a+0(FP), R0
+	MOVD b+8(FP), R1
+	MOVD result+16(FP), R2
+	MOVD n+24(FP), R3
+	MOVD $0, R4
+
+loop:
+	WORD $0x25e31c80 // whilelo p0.d, x4, x3
+	BEQ  done
+	WORD $0xa5e44000 // ld1d {z0.d}, p0/z, [x0, x4, lsl #3]
+	WORD $0xa5e44021 // ld1d {z1.d}, p0/z, [x1, x4, lsl #3]
+	WORD $0x65c18020 // fsub z0.d, p0/m, z0.d, z1.d
+	WORD $0xe5e44040 // st1d {z0.d}, p0, [x2, x4, lsl #3]
+	WORD $0x04f0e3e4 // incd x4
+	B    loop
+
+done:
+	RET
+
+// func mul_f64_sve(a, b, result unsafe.Pointer, n int)
+TEXT ·mul_f64_sve(SB), NOSPLIT, $0-32
+	MOVD a+0(FP), R0
+	MOVD b+8(FP), R1
+	MOVD result+16(FP), R2
+	MOVD n+24(FP), R3
+	MOVD $0, R4
+
+loop:
+	WORD $0x25e31c80 // whilelo p0.d, x4, x3
+	BEQ  done
+	WORD $0xa5e44000 // ld1d {z0.d}, p0/z, [x0, x4, lsl #3]
+	WORD $0xa5e44021 // ld1d {z1.d}, p0/z, [x1, x4, lsl #3]
+	WORD $0x65c28020 // fmul z0.d, p0/m, z0.d, z1.d
+	WORD $0xe5e44040 // st1d {z0.d}, p0, [x2, x4, lsl #3]
+	WORD $0x04f0e3e4 // incd x4
+	B    loop
+
+done:
+	RET
+
+// func div_f64_sve(a, b, result unsafe.Pointer, n int)
+TEXT ·div_f64_sve(SB), NOSPLIT, $0-32
+	MOVD a+0(FP), R0
+	MOVD b+8(FP), R1
+	MOVD result+16(FP), R2
+	MOVD n+24(FP), R3
+	MOVD $0, R4
+
+loop:
+	WORD $0x25e31c80 // whilelo p0.d, x4, x3
+	BEQ  done
+	WORD $0xa5e44000 // ld1d {z0.d}, p0/z, [x0, x4, lsl #3]
+	WORD $0xa5e44021 // ld1d {z1.d}, p0/z, [x1, x4, lsl #3]
+	WORD $0x65cd8020 // fdiv z0.d, p0/m, z0.d, z1.d
+	WORD $0xe5e44040 // st1d {z0.d}, p0, [x2, x4, lsl #3]
+	WORD $0x04f0e3e4 // incd x4
+	B    loop
+
+done:
+	RET
+
+// func min_f64_sve(a, b, result unsafe.Pointer, n int)
+TEXT ·min_f64_sve(SB), NOSPLIT, $0-32
+	MOVD a+0(FP), R0
+	MOVD b+8(FP), R1
+	MOVD result+16(FP), R2
+	MOVD n+24(FP), R3
+	MOVD $0, R4
+
+loop:
+	WORD $0x25e31c80 // whilelo p0.d, x4, x3
+	BEQ  done
+	WORD $0xa5e44000 // ld1d {z0.d}, p0/z, [x0, x4, lsl #3]
+	WORD $0xa5e44021 // ld1d {z1.d}, p0/z, [x1, x4, lsl #3]
+	WORD $0x65c78020 // fmin z0.d, p0/m, z0.d, z1.d
+	WORD $0xe5e44040 // st1d {z0.d}, p0, [x2, x4, lsl #3]
+	WORD $0x04f0e3e4 // incd x4
+	B    loop
+
+done:
+	RET
+
+// func max_f64_sve(a, b, result unsafe.Pointer, n int)
+TEXT ·max_f64_sve(SB), NOSPLIT, $0-32
+	MOVD a+0(FP), R0
+	MOVD b+8(FP), R1
+	MOVD result+16(FP), R2
+	MOVD n+24(FP), R3
+	MOVD $0, R4
+
+loop:
+	WORD $0x25e31c80 // whilelo p0.d, x4, x3
+	BEQ  done
+	WORD $0xa5e44000 // ld1d {z0.d}, p0/z, [x0, x4, lsl #3]
+	WORD $0xa5e44021 // ld1d {z1.d}, p0/z, [x1, x4, lsl #3]
+	WORD $0x65c68020 // fmax z0.d, p0/m, z0.d, z1.d
+	WORD $0xe5e44040 // st1d {z0.d}, p0, [x2, x4, lsl #3]
+	WORD $0x04f0e3e4 // incd x4
+	B    loop
+
+done:
+	RET
+
+// func fma_f64_sve(a, b, c, result unsafe.Pointer, n int)
+TEXT ·fma_f64_sve(SB), NOSPLIT, $0-40
+	MOVD a+0(FP), R0
+	MOVD b+8(FP), R1
+	MOVD c+16(FP), R2
+	MOVD result+24(FP), R5
+	MOVD n+32(FP), R3
+	MOVD $0, R4
+
+loop:
+	WORD $0x25e31c80 // whilelo p0.d, x4, x3
+	BEQ  done
+	WORD $0xa5e44000 // ld1d {z0.d}, p0/z, [x0, x4, lsl #3]
+	WORD $0xa5e44021 // ld1d {z1.d}, p0/z, [x1, x4, lsl #3]
+	WORD $0xa5e44042 // ld1d {z2.d}, p0/z, [x2, x4, lsl #3]
+	WORD $0x65e28020 // fmad z0.d, p0/m, z1.d, z2.d
+	WORD $0xe5e440a0 // st1d {z0.d}, p0, [x5, x4, lsl #3]
+	WORD $0x04f0e3e4 // incd x4
+	B    loop
+
+done:
+	RET
+
+// func fms_f64_sve(a, b, c, result unsafe.Pointer, n int)
+TEXT ·fms_f64_sve(SB), NOSPLIT, $0-40
+	MOVD a+0(FP), R0
+	MOVD b+8(FP), R1
+	MOVD c+16(FP), R2
+	MOVD result+24(FP), R5
+	MOVD n+32(FP), R3
+	MOVD $0, R4
+
+loop:
+	WORD $0x25e31c80 // whilelo p0.d, x4, x3
+	BEQ  done
+	WORD $0xa5e44000 // ld1d {z0.d}, p0/z, [x0, x4, lsl #3]
+	WORD $0xa5e44021 // ld1d {z1.d}, p0/z, [x1, x4, lsl #3]
+	WORD $0xa5e44042 // ld1d {z2.d}, p0/z, [x2, x4, lsl #3]
+	WORD $0x65e2e020 // fnmsb z0.d, p0/m, z1.d, z2.d
+	WORD $0xe5e440a0 // st1d {z0.d}, p0, [x5, x4, lsl #3]
+	WORD $0x04f0e3e4 // incd x4
+	B    loop
+
+done:
+	RET
+
+// func sqrt_f64_sve(a, result unsafe.Pointer, n int)
+TEXT ·sqrt_f64_sve(SB), NOSPLIT, $0-24
+	MOVD a+0(FP), R0
+	MOVD result+8(FP), R1
+	MOVD n+16(FP), R3
+	MOVD $0, R4
+
+loop:
+	WORD $0x25e31c80 // whilelo p0.d, x4, x3
+	BEQ  done
+	WORD $0xa5e44000 // ld1d {z0.d}, p0/z, [x0, x4, lsl #3]
+	WORD $0x65cda000 // fsqrt z0.d, p0/m, z0.d
+	WORD $0xe5e44020 // st1d {z0.d}, p0, [x1, x4, lsl #3]
+	WORD $0x04f0e3e4 // incd x4
+	B    loop
+
+done:
+	RET
+
+// func abs_f64_sve(a, result unsafe.Pointer, n int)
+TEXT ·abs_f64_sve(SB), NOSPLIT, $0-24
+	MOVD a+0(FP), R0
+	MOVD result+8(FP), R1
+	MOVD n+16(FP), R3
+	MOVD $0, R4
+
+loop:
+	WORD $0x25e31c80 // whilelo p0.d, x4, x3
+	BEQ  done
+	WORD $0xa5e44000 // ld1d {z0.d}, p0/z, [x0, x4, lsl #3]
+	WORD $0x04dca000 // fabs z0.d, p0/m, z0.d
+	WORD $0xe5e44020 // st1d {z0.d}, p0, [x1, x4, lsl #3]
+	WORD $0x04f0e3e4 // incd x4
+	B    loop
+
+done:
+	RET
+
+// func neg_f64_sve(a, result unsafe.Pointer, n int)
+TEXT ·neg_f64_sve(SB), NOSPLIT, $0-24
+	MOVD a+0(FP), R0
+	MOVD result+8(FP), R1
+	MOVD n+16(FP), R3
+	MOVD $0, R4
+
+loop:
+	WORD $0x25e31c80 // whilelo p0.d, x4, x3
+	BEQ  done
+	WORD $0xa5e44000 // ld1d {z0.d}, p0/z, [x0, x4, lsl #3]
+	WORD $0x04dda000 // fneg z0.d, p0/m, z0.d
+	WORD $0xe5e44020 // st1d {z0.d}, p0, [x1, x4, lsl #3]
+	WORD $0x04f0e3e4 // incd x4
+	B    loop
+
+done:
+	RET
+
+// func hsum_f64_sve(a unsafe.Pointer, n int) (result float64)
+TEXT ·hsum_f64_sve(SB), NOSPLIT, $0-24
+	MOVD a+0(FP), R0
+	MOVD n+8(FP), R3
+	WORD $0x25d8e3e1 // ptrue p1.d
+	WORD $0x25f8c002 // mov z2.d, #0
+	MOVD $0, R4
+
+loop:
+	WORD $0x25e31c80 // whilelo p0.d, x4, x3
+	BEQ  done
+	WORD $0xa5e44000 // ld1d {z0.d}, p0/z, [x0, x4, lsl #3]
+	WORD $0x65c08002 // fadd z2.d, p0/m, z2.d, z0.d
+	WORD $0x04f0e3e4 // incd x4
+	B    loop
+
+done:
+	WORD $0x65c02440 // faddv d0, p1, z2.d
+	FMOVD F0, result+16(FP)
+	RET
+
+// func hmin_f64_sve(a unsafe.Pointer, n int) (result float64)
+TEXT ·hmin_f64_sve(SB), NOSPLIT, $0-24
+	MOVD a+0(FP), R0
+	MOVD n+8(FP), R3
+	WORD $0x25d8e3e1 // ptrue p1.d
+	WORD $0x85c0e402 // ld1rd {z2.d}, p1/z, [x0]
+	MOVD $0, R4
+
+loop:
+	WORD $0x25e31c80 // whilelo p0.d, x4, x3
+	BEQ  done
+	WORD $0xa5e44000 // ld1d {z0.d}, p0/z, [x0, x4, lsl #3]
+	WORD $0x65c78002 // fmin z2.d, p0/m, z2.d, z0.d
+	WORD $0x04f0e3e4 // incd x4
+	B    loop
+
+done:
+	WORD $0x65c72440 // fminv d0, p1, z2.d
+	FMOVD F0, result+16(FP)
+	RET
+
+// func hmax_f64_sve(a unsafe.Pointer, n int) (result float64)
+TEXT ·hmax_f64_sve(SB), NOSPLIT, $0-24
+	MOVD a+0(FP), R0
+	MOVD n+8(FP), R3
+	WORD $0x25d8e3e1 // ptrue p1.d
+	WORD $0x85c0e402 // ld1rd {z2.d}, p1/z, [x0]
+	MOVD $0, R4
+
+loop:
+	WORD $0x25e31c80 // whilelo p0.d, x4, x3
+	BEQ  done
+	WORD $0xa5e44000 // ld1d {z0.d}, p0/z, [x0, x4, lsl #3]
+	WORD $0x65c68002 // fmax z2.d, p0/m, z2.d, z0.d
+	WORD $0x04f0e3e4 // incd x4
+	B    loop
+
+done:
+	WORD $0x65c62440 // fmaxv d0, p1, z2.d
+	FMOVD F0, result+16(FP)
+	RET
+
+// func dot_f64_sve(a, b unsafe.Pointer, n int) (result float64)
+TEXT ·dot_f64_sve(SB), NOSPLIT, $0-32
+	MOVD a+0(FP), R0
+	MOVD b+8(FP), R1
+	MOVD n+16(FP), R3
+	WORD $0x25d8e3e1 // ptrue p1.d
+	WORD $0x25f8c002 // mov z2.d, #0
+	MOVD $0, R4
+
+loop:
+	WORD $0x25e31c80 // whilelo p0.d, x4, x3
+	BEQ  done
+	WORD $0xa5e44000 // ld1d {z0.d}, p0/z, [x0, x4, lsl #3]
+	WORD $0xa5e44021 // ld1d {z1.d}, p0/z, [x1, x4, lsl #3]
+	WORD $0x65e10002 // fmla z2.d, p0/m, z0.d, z1.d
+	WORD $0x04f0e3e4 // incd x4
+	B    loop
+
+done:
+	WORD $0x65c02440 // faddv d0, p1, z2.d
+	FMOVD F0, result+24(FP)
+	RET
@@ -0,0 +1,186 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build noasm || !arm64
+
+package asm
+
+import "unsafe"
+
+// Stub implementations of the SVE vector types for non-ARM64 or noasm
+// builds. Arithmetic panics; callers must check HasSVE.
+
+// HasSVE is false on platforms without SVE.
+var HasSVE = false
+
+// SVEBits is 0 on platforms without SVE.
+var SVEBits = 0
+
+// Float32x8SVE represents a 256-bit SVE vector of 8 float32 values.
+type Float32x8SVE [32]byte
+
+// Float32x16SVE represents a 512-bit SVE vector of 16 float32 values.
+type Float32x16SVE [64]byte
+
+// Float64x4SVE represents a 256-bit SVE vector of 4 float64 values.
+type Float64x4SVE [32]byte
+
+// Float64x8SVE represents a 512-bit SVE vector of 8 float64 values.
+type Float64x8SVE [64]byte
+
+// ===== Float32x8SVE stub methods =====
+
+func BroadcastFloat32x8SVE(v float32) Float32x8SVE {
+	var arr [8]float32
+	for i := range arr {
+		arr[i] = v
+	}
+	return *(*Float32x8SVE)(unsafe.Pointer(&arr))
+}
+
+func LoadFloat32x8SVE(p *[8]float32) Float32x8SVE { return *(*Float32x8SVE)(unsafe.Pointer(p)) }
+func LoadFloat32x8SVESlice(s []float32) Float32x8SVE {
+	return *(*Float32x8SVE)(unsafe.Pointer((*[8]float32)(s)))
+}
+func ZeroFloat32x8SVE() Float32x8SVE                         { return Float32x8SVE{} }
+func (v Float32x8SVE) Get(i int) float32                     { return (*[8]float32)(unsafe.Pointer(&v))[i] }
+func (v *Float32x8SVE) Set(i int, val float32)               { (*[8]float32)(unsafe.Pointer(v))[i] = val }
+func (v Float32x8SVE) Data() []float32                       { return (*[8]float32)(unsafe.Pointer(&v))[:] }
+func (v Float32x8SVE) Store(p *[8]float32)                   { *(*Float32x8SVE)(unsafe.Pointer(p)) = v }
+func (v Float32x8SVE) StoreSlice(s []float32)                { *(*Float32x8SVE)(unsafe.Pointer((*[8]float32)(s))) = v }
+func (v Float32x8SVE) Add(other Float32x8SVE) Float32x8SVE   { panic("SVE not available") }
+func (v Float32x8SVE) Sub(other Float32x8SVE) Float32x8SVE   { panic("SVE not available") }
+func (v Float32x8SVE) Mul(other Float32x8SVE) Float32x8SVE   { panic("SVE not available") }
+func (v Float32x8SVE) Div(other Float32x8SVE) Float32x8SVE   { panic("SVE not available") }
+func (v Float32x8SVE) Min(other Float32x8SVE) Float32x8SVE   { panic("SVE not available") }
+func (v Float32x8SVE) Max(other Float32x8SVE) Float32x8SVE   { panic("SVE not available") }
+func (v Float32x8SVE) Sqrt() Float32x8SVE                    { panic("SVE not available") }
+func (v Float32x8SVE) Abs() Float32x8SVE                     { panic("SVE not available") }
+func (v Float32x8SVE) Neg() Float32x8SVE                     { panic("SVE not available") }
+func (v Float32x8SVE) MulAdd(a, b Float32x8SVE) Float32x8SVE { panic("SVE not available") }
+func (v Float32x8SVE) MulSub(a, b Float32x8SVE) Float32x8SVE { panic("SVE not available") }
+func (v Float32x8SVE) ReduceSum() float32                    { panic("SVE not available") }
+func (v Float32x8SVE) ReduceMin() float32                    { panic("SVE not available") }
+func (v Float32x8SVE) ReduceMax() float32                    { panic("SVE not available") }
+func (v Float32x8SVE) Dot(other Float32x8SVE) float32        { panic("SVE not available") }
+
+// ===== Float32x16SVE stub methods =====
+
+func BroadcastFloat32x16SVE(v float32) Float32x16SVE {
+	var arr [16]float32
+	for i := range arr {
+		arr[i] = v
+	}
+	return *(*Float32x16SVE)(unsafe.Pointer(&arr))
+}
+
+func LoadFloat32x16SVE(p *[16]float32) Float32x16SVE { return *(*Float32x16SVE)(unsafe.Pointer(p)) }
+func LoadFloat32x16SVESlice(s []float32) Float32x16SVE {
+	return *(*Float32x16SVE)(unsafe.Pointer((*[16]float32)(s)))
+}
+func ZeroFloat32x16SVE() Float32x16SVE          { return Float32x16SVE{} }
+func (v Float32x16SVE) Get(i int) float32       { return (*[16]float32)(unsafe.Pointer(&v))[i] }
+func (v *Float32x16SVE) Set(i int, val float32) { (*[16]float32)(unsafe.Pointer(v))[i] = val }
+func (v Float32x16SVE) Data() []float32         { return (*[16]float32)(unsafe.Pointer(&v))[:] }
+func (v Float32x16SVE) Store(p *[16]float32)    { *(*Float32x16SVE)(unsafe.Pointer(p)) = v }
+func (v Float32x16SVE) StoreSlice(s []float32) {
+	*(*Float32x16SVE)(unsafe.Pointer((*[16]float32)(s))) = v
+}
+func (v Float32x16SVE) Add(other Float32x16SVE) Float32x16SVE   { panic("SVE not available") }
+func (v Float32x16SVE) Sub(other Float32x16SVE) Float32x16SVE   { panic("SVE not available") }
+func (v Float32x16SVE) Mul(other Float32x16SVE) Float32x16SVE   { panic("SVE not available") }
+func (v Float32x16SVE) Div(other Float32x16SVE) Float32x16SVE   { panic("SVE not available") }
+func (v Float32x16SVE) Min(other Float32x16SVE) Float32x16SVE   { panic("SVE not available") }
+func (v Float32x16SVE) Max(other Float32x16SVE) Float32x16SVE   { panic("SVE not available") }
+func (v Float32x16SVE) Sqrt() Float32x16SVE                     { panic("SVE not available") }
+func (v Float32x16SVE) Abs() Float32x16SVE                      { panic("SVE not available") }
+func (v Float32x16SVE) Neg() Float32x16SVE                      { panic("SVE not available") }
+func (v Float32x16SVE) MulAdd(a, b Float32x16SVE) Float32x16SVE { panic("SVE not available") }
+func (v Float32x16SVE) MulSub(a, b Float32x16SVE) Float32x16SVE { panic("SVE not available") }
+func (v Float32x16SVE) ReduceSum() float32                      { panic("SVE not available") }
+func (v Float32x16SVE) ReduceMin() float32                      { panic("SVE not available") }
+func (v Float32x16SVE) ReduceMax() float32                      { panic("SVE not available") }
+func (v Float32x16SVE) Dot(other Float32x16SVE) float32         { panic("SVE not available") }
+
+// ===== Float64x4SVE stub methods =====
+
+func BroadcastFloat64x4SVE(v float64) Float64x4SVE {
+	var arr [4]float64
+	for i := range arr {
+		arr[i] = v
+	}
+	return *(*Float64x4SVE)(unsafe.Pointer(&arr))
+}
+
+func LoadFloat64x4SVE(p *[4]float64) Float64x4SVE { return *(*Float64x4SVE)(unsafe.Pointer(p)) }
+func LoadFloat64x4SVESlice(s []float64) Float64x4SVE {
+	return *(*Float64x4SVE)(unsafe.Pointer((*[4]float64)(s)))
+}
+func ZeroFloat64x4SVE() Float64x4SVE                         { return Float64x4SVE{} }
+func (v Float64x4SVE) Get(i int) float64                     { return (*[4]float64)(unsafe.Pointer(&v))[i] }
+func (v *Float64x4SVE) Set(i int, val float64)               { (*[4]float64)(unsafe.Pointer(v))[i] = val }
+func (v Float64x4SVE) Data() []float64                       { return (*[4]float64)(unsafe.Pointer(&v))[:] }
+func (v Float64x4SVE) Store(p *[4]float64)                   { *(*Float64x4SVE)(unsafe.Pointer(p)) = v }
+func (v Float64x4SVE) StoreSlice(s []float64)                { *(*Float64x4SVE)(unsafe.Pointer((*[4]float64)(s))) = v }
+func (v Float64x4SVE) Add(other Float64x4SVE) Float64x4SVE   { panic("SVE not available") }
+func (v Float64x4SVE) Sub(other Float64x4SVE) Float64x4SVE   { panic("SVE not available") }
+func (v Float64x4SVE) Mul(other Float64x4SVE) Float64x4SVE   { panic("SVE not available") }
+func (v Float64x4SVE) Div(other Float64x4SVE) Float64x4SVE   { panic("SVE not available") }
+func (v Float64x4SVE) Min(other Float64x4SVE) Float64x4SVE   { panic("SVE not available") }
+func (v Float64x4SVE) Max(other Float64x4SVE) Float64x4SVE   { panic("SVE not available") }
+func (v Float64x4SVE) Sqrt() Float64x4SVE                    { panic("SVE not available") }
+func (v Float64x4SVE) Abs() Float64x4SVE                     { panic("SVE not available") }
+func (v Float64x4SVE) Neg() Float64x4SVE                     { panic("SVE not available") }
+func (v Float64x4SVE) MulAdd(a, b Float64x4SVE) Float64x4SVE { panic("SVE not available") }
+func (v Float64x4SVE) MulSub(a, b Float64x4SVE) Float64x4SVE { panic("SVE not available") }
+func (v Float64x4SVE) ReduceSum() float64                    { panic("SVE not available") }
+func (v Float64x4SVE) ReduceMin() float64                    { panic("SVE not available") }
+func (v Float64x4SVE) ReduceMax() float64                    { panic("SVE not available") }
+func (v Float64x4SVE) Dot(other Float64x4SVE) float64        { panic("SVE not available") }
+
+// ===== Float64x8SVE stub methods =====
+
+func BroadcastFloat64x8SVE(v float64) Float64x8SVE {
+	var arr [8]float64
+	for i := range arr {
+		arr[i] = v
+	}
+	return *(*Float64x8SVE)(unsafe.Pointer(&arr))
+}
+
+func LoadFloat64x8SVE(p *[8]float64) Float64x8SVE { return *(*Float64x8SVE)(unsafe.Pointer(p)) }
+func LoadFloat64x8SVESlice(s []float64) Float64x8SVE {
+	return *(*Float64x8SVE)(unsafe.Pointer((*[8]float64)(s)))
+}
+func ZeroFloat64x8SVE() Float64x8SVE                         { return Float64x8SVE{} }
+func (v Float64x8SVE) Get(i int) float64                     { return (*[8]float64)(unsafe.Pointer(&v))[i] }
+func (v *Float64x8SVE) Set(i int, val float64)               { (*[8]float64)(unsafe.Pointer(v))[i] = val }
+func (v Float64x8SVE) Data() []float64                       { return (*[8]float64)(unsafe.Pointer(&v))[:] }
+func (v Float64x8SVE) Store(p *[8]float64)                   { *(*Float64x8SVE)(unsafe.Pointer(p)) = v }
+func (v Float64x8SVE) StoreSlice(s []float64)                { *(*Float64x8SVE)(unsafe.Pointer((*[8]float64)(s))) = v }
+func (v Float64x8SVE) Add(other Float64x8SVE) Float64x8SVE   { panic("SVE not available") }
+func (v Float64x8SVE) Sub(other Float64x8SVE) Float64x8SVE   { panic("SVE not available") }
+func (v Float64x8SVE) Mul(other Float64x8SVE) Float64x8SVE   { panic("SVE not available") }
+func (v Float64x8SVE) Div(other Float64x8SVE) Float64x8SVE   { panic("SVE not available") }
+func (v Float64x8SVE) Min(other Float64x8SVE) Float64x8SVE   { panic("SVE not available") }
+func (v Float64x8SVE) Max(other Float64x8SVE) Float64x8SVE   { panic("SVE not available") }
+func (v Float64x8SVE) Sqrt() Float64x8SVE                    { panic("SVE not available") }
+func (v Float64x8SVE) Abs() Float64x8SVE                     { panic("SVE not available") }
+func (v Float64x8SVE) Neg() Float64x8SVE                     { panic("SVE not available") }
+func (v Float64x8SVE) MulAdd(a, b Float64x8SVE) Float64x8SVE { panic("SVE not available") }
+func (v Float64x8SVE) MulSub(a, b Float64x8SVE) Float64x8SVE { panic("SVE not available") }
+func (v Float64x8SVE) ReduceSum() float64                    { panic("SVE not available") }
+func (v Float64x8SVE) ReduceMin() float64                    { panic("SVE not available") }
+func (v Float64x8SVE) ReduceMax() float64                    { panic("SVE not available") }
+func (v Float64x8SVE) Dot(other Float64x8SVE) float64        { panic("SVE not available") }
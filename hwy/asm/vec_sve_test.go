@@ -0,0 +1,110 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !noasm && arm64
+
+package asm
+
+import (
+	"math"
+	"testing"
+)
+
+func TestFloat32x8SVE(t *testing.T) {
+	if !HasSVE {
+		t.Skip("CPU does not support SVE")
+	}
+	a := []float32{1, -2, 3, -4, 5, -6, 7, -8}
+	b := []float32{0.5, 4, -1, 2, 9, 0.25, -3, 1}
+	va, vb := LoadFloat32x8SVESlice(a), LoadFloat32x8SVESlice(b)
+
+	check := func(name string, got Float32x8SVE, want func(x, y float32) float32) {
+		t.Helper()
+		for i := range a {
+			if w := want(a[i], b[i]); got.Get(i) != w {
+				t.Errorf("%s lane %d = %v, want %v", name, i, got.Get(i), w)
+			}
+		}
+	}
+	check("Add", va.Add(vb), func(x, y float32) float32 { return x + y })
+	check("Sub", va.Sub(vb), func(x, y float32) float32 { return x - y })
+	check("Mul", va.Mul(vb), func(x, y float32) float32 { return x * y })
+	check("Div", va.Div(vb), func(x, y float32) float32 { return x / y })
+	check("Min", va.Min(vb), func(x, y float32) float32 { return min(x, y) })
+	check("Max", va.Max(vb), func(x, y float32) float32 { return max(x, y) })
+	check("Abs", va.Abs(), func(x, _ float32) float32 { return float32(math.Abs(float64(x))) })
+	check("Neg", va.Neg(), func(x, _ float32) float32 { return -x })
+	check("MulAdd", va.MulAdd(vb, va), func(x, y float32) float32 { return x*y + x })
+	check("MulSub", va.MulSub(vb, va), func(x, y float32) float32 { return x*y - x })
+	check("Sqrt", va.Abs().Sqrt(), func(x, _ float32) float32 { return float32(math.Sqrt(math.Abs(float64(x)))) })
+
+	if got := va.ReduceSum(); got != -4 {
+		t.Errorf("ReduceSum = %v, want -4", got)
+	}
+	if got := va.ReduceMin(); got != -8 {
+		t.Errorf("ReduceMin = %v, want -8", got)
+	}
+	if got := va.ReduceMax(); got != 7 {
+		t.Errorf("ReduceMax = %v, want 7", got)
+	}
+	if got := va.Dot(vb); got != -4 {
+		t.Errorf("Dot = %v, want -4", got)
+	}
+}
+
+// The 512-bit types take several iterations on shorter SVE vectors, which
+// the reductions must combine.
+func TestFloat64x8SVE(t *testing.T) {
+	if !HasSVE {
+		t.Skip("CPU does not support SVE")
+	}
+	var a [8]float64
+	for i := range a {
+		a[i] = float64(i + 1)
+	}
+	va := LoadFloat64x8SVE(&a)
+	vb := BroadcastFloat64x8SVE(2)
+
+	var sum [8]float64
+	va.Add(vb).Store(&sum)
+	for i := range sum {
+		if sum[i] != a[i]+2 {
+			t.Errorf("Add lane %d = %v, want %v", i, sum[i], a[i]+2)
+		}
+	}
+	if got := va.ReduceSum(); got != 36 {
+		t.Errorf("ReduceSum = %v, want 36", got)
+	}
+	if got := va.ReduceMin(); got != 1 {
+		t.Errorf("ReduceMin = %v, want 1", got)
+	}
+	if got := va.ReduceMax(); got != 8 {
+		t.Errorf("ReduceMax = %v, want 8", got)
+	}
+	if got := va.Dot(vb); got != 72 {
+		t.Errorf("Dot = %v, want 72", got)
+	}
+}
+
+func TestSVEBits(t *testing.T) {
+	if !HasSVE {
+		if SVEBits != 0 {
+			t.Errorf("SVEBits = %d without SVE, want 0", SVEBits)
+		}
+		return
+	}
+	if SVEBits < 128 || SVEBits > 2048 || SVEBits%128 != 0 {
+		t.Errorf("SVEBits = %d, want a multiple of 128 between 128 and 2048", SVEBits)
+	}
+}
@@ -0,0 +1,130 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !noasm && arm64
+
+package asm
+
+// ===== Byte table lookups (TBL, TBX) =====
+
+// TableLookupBytesOr performs byte-level table lookup like TableLookupBytes,
+// but keeps fallback[i] where idx[i] >= 16 (NEON TBX).
+func (v Uint8x16) TableLookupBytesOr(idx, fallback Uint8x16) Uint8x16 {
+	return Uint8x16(tbx_u8x16([16]byte(v), [16]byte(idx), [16]byte(fallback)))
+}
+
+// TwoTablesLookupBytes performs byte-level lookup in the 32-byte table formed
+// by v followed by hi: result[i] is v[idx[i]] for idx[i] < 16,
+// hi[idx[i]-16] for idx[i] < 32, and 0 otherwise (two-register NEON TBL).
+func (v Uint8x16) TwoTablesLookupBytes(hi, idx Uint8x16) Uint8x16 {
+	return Uint8x16(tbl2_u8x16([16]byte(v), [16]byte(hi), [16]byte(idx)))
+}
+
+// ===== Per-lane shuffles =====
+
+// TableLookupLanes returns the lanes of v selected by idx: result[i] =
+// v[idx[i]]. Only the low 2 bits of each index are used.
+func (v Float32x4) TableLookupLanes(idx Int32x4) Float32x4 {
+	return Float32x4(tbl_lanes_32x4([16]byte(v), [16]byte(idx)))
+}
+
+// TableLookupLanes returns the lanes of v selected by idx: result[i] =
+// v[idx[i]]. Only the low 2 bits of each index are used.
+func (v Int32x4) TableLookupLanes(idx Int32x4) Int32x4 {
+	return Int32x4(tbl_lanes_32x4([16]byte(v), [16]byte(idx)))
+}
+
+// TableLookupLanes returns the lanes of v selected by idx: result[i] =
+// v[idx[i]]. Only the low 2 bits of each index are used.
+func (v Uint32x4) TableLookupLanes(idx Int32x4) Uint32x4 {
+	return Uint32x4(tbl_lanes_32x4([16]byte(v), [16]byte(idx)))
+}
+
+// ===== Extract (VEXT) =====
+
+// Extract returns lanes n..n+3 of v followed by other (VEXT): the upper
+// 4 - n lanes of v, then the lower n lanes of other. n must be in [0, 4].
+func (v Float32x4) Extract(other Float32x4, n int) Float32x4 {
+	return Float32x4(ext_u8x16([16]byte(v), [16]byte(other), n*4))
+}
+
+// Extract returns lanes n..n+1 of v followed by other (VEXT): the upper
+// 2 - n lanes of v, then the lower n lanes of other. n must be in [0, 2].
+func (v Float64x2) Extract(other Float64x2, n int) Float64x2 {
+	return Float64x2(ext_u8x16([16]byte(v), [16]byte(other), n*8))
+}
+
+// Extract returns lanes n..n+15 of v followed by other (VEXT): the upper
+// 16 - n lanes of v, then the lower n lanes of other. n must be in [0, 16].
+func (v Int8x16) Extract(other Int8x16, n int) Int8x16 {
+	return Int8x16(ext_u8x16([16]byte(v), [16]byte(other), n))
+}
+
+// Extract returns lanes n..n+3 of v followed by other (VEXT): the upper
+// 4 - n lanes of v, then the lower n lanes of other. n must be in [0, 4].
+func (v Int32x4) Extract(other Int32x4, n int) Int32x4 {
+	return Int32x4(ext_u8x16([16]byte(v), [16]byte(other), n*4))
+}
+
+// Extract returns lanes n..n+1 of v followed by other (VEXT): the upper
+// 2 - n lanes of v, then the lower n lanes of other. n must be in [0, 2].
+func (v Int64x2) Extract(other Int64x2, n int) Int64x2 {
+	return Int64x2(ext_u8x16([16]byte(v), [16]byte(other), n*8))
+}
+
+// Extract returns lanes n..n+15 of v followed by other (VEXT): the upper
+// 16 - n lanes of v, then the lower n lanes of other. n must be in [0, 16].
+func (v Uint8x16) Extract(other Uint8x16, n int) Uint8x16 {
+	return Uint8x16(ext_u8x16([16]byte(v), [16]byte(other), n))
+}
+
+// Extract returns lanes n..n+7 of v followed by other (VEXT): the upper
+// 8 - n lanes of v, then the lower n lanes of other. n must be in [0, 8].
+func (v Uint16x8) Extract(other Uint16x8, n int) Uint16x8 {
+	return Uint16x8(ext_u8x16([16]byte(v), [16]byte(other), n*2))
+}
+
+// Extract returns lanes n..n+3 of v followed by other (VEXT): the upper
+// 4 - n lanes of v, then the lower n lanes of other. n must be in [0, 4].
+func (v Uint32x4) Extract(other Uint32x4, n int) Uint32x4 {
+	return Uint32x4(ext_u8x16([16]byte(v), [16]byte(other), n*4))
+}
+
+// Extract returns lanes n..n+1 of v followed by other (VEXT): the upper
+// 2 - n lanes of v, then the lower n lanes of other. n must be in [0, 2].
+func (v Uint64x2) Extract(other Uint64x2, n int) Uint64x2 {
+	return Uint64x2(ext_u8x16([16]byte(v), [16]byte(other), n*8))
+}
+
+// Extract returns lanes n..n+7 of v followed by other (VEXT): the upper
+// 8 - n lanes of v, then the lower n lanes of other. n must be in [0, 8].
+func (v Float16x8) Extract(other Float16x8, n int) Float16x8 {
+	return Float16x8(ext_u8x16([16]byte(v), [16]byte(other), n*2))
+}
+
+// Extract returns lanes n..n+7 of v followed by other (VEXT): the upper
+// 8 - n lanes of v, then the lower n lanes of other. n must be in [0, 8].
+func (v BFloat16x8) Extract(other BFloat16x8, n int) BFloat16x8 {
+	return BFloat16x8(ext_u8x16([16]byte(v), [16]byte(other), n*2))
+}
+
+// Hand-written kernels in vec_tbl_neon_arm64.s.
+
+func tbl2_u8x16(lo, hi, idx [16]byte) (result [16]byte)
+
+func tbx_u8x16(tbl, idx, fallback [16]byte) (result [16]byte)
+
+func tbl_lanes_32x4(a, idx [16]byte) (result [16]byte)
+
+func ext_u8x16(a, b [16]byte, n int) (result [16]byte)
@@ -0,0 +1,116 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//go:build !noasm && arm64
+
+#include "textflag.h"
+
+// Byte indices 0..15, the base of the Extract indices.
+DATA extIota<>+0(SB)/8, $0x0706050403020100
+DATA extIota<>+8(SB)/8, $0x0f0e0d0c0b0a0908
+GLOBL extIota<>(SB), RODATA|NOPTR, $16
+
+// func tbl2_u8x16(lo, hi, idx [16]byte) (result [16]byte)
+TEXT ·tbl2_u8x16(SB), NOSPLIT, $0-64
+	MOVD lo_0+0(FP), R9
+	MOVD lo_8+8(FP), R10
+	VMOV R9, V0.D[0]
+	VMOV R10, V0.D[1]
+	MOVD hi_0+16(FP), R9
+	MOVD hi_8+24(FP), R10
+	VMOV R9, V1.D[0]
+	VMOV R10, V1.D[1]
+	MOVD idx_0+32(FP), R9
+	MOVD idx_8+40(FP), R10
+	VMOV R9, V3.D[0]
+	VMOV R10, V3.D[1]
+	VTBL V3.B16, [V0.B16, V1.B16], V2.B16
+	VMOV V2.D[0], R9
+	VMOV V2.D[1], R10
+	MOVD R9, result_0+48(FP)
+	MOVD R10, result_8+56(FP)
+	RET
+
+// func tbx_u8x16(tbl, idx, fallback [16]byte) (result [16]byte)
+TEXT ·tbx_u8x16(SB), NOSPLIT, $0-64
+	MOVD tbl_0+0(FP), R9
+	MOVD tbl_8+8(FP), R10
+	VMOV R9, V0.D[0]
+	VMOV R10, V0.D[1]
+	MOVD idx_0+16(FP), R9
+	MOVD idx_8+24(FP), R10
+	VMOV R9, V3.D[0]
+	VMOV R10, V3.D[1]
+	MOVD fallback_0+32(FP), R9
+	MOVD fallback_8+40(FP), R10
+	VMOV R9, V2.D[0]
+	VMOV R10, V2.D[1]
+	VTBX V3.B16, [V0.B16], V2.B16
+	VMOV V2.D[0], R9
+	VMOV V2.D[1], R10
+	MOVD R9, result_0+48(FP)
+	MOVD R10, result_8+56(FP)
+	RET
+
+// Lane index k becomes the byte indices 4k..4k+3: (k&3)*0x04040404 + 0x03020100.
+//
+// func tbl_lanes_32x4(a, idx [16]byte) (result [16]byte)
+TEXT ·tbl_lanes_32x4(SB), NOSPLIT, $0-48
+	MOVD a_0+0(FP), R9
+	MOVD a_8+8(FP), R10
+	VMOV R9, V0.D[0]
+	VMOV R10, V0.D[1]
+	MOVD idx_0+16(FP), R9
+	MOVD idx_8+24(FP), R10
+	VMOV R9, V1.D[0]
+	VMOV R10, V1.D[1]
+	MOVW $3, R11
+	VDUP R11, V3.S4
+	VAND V3.B16, V1.B16, V1.B16
+	MOVW $0x04040404, R11
+	VDUP R11, V3.S4
+	VMUL V3.S4, V1.S4, V1.S4
+	MOVW $0x03020100, R11
+	VDUP R11, V3.S4
+	VADD V3.S4, V1.S4, V1.S4
+	VTBL V1.B16, [V0.B16], V2.B16
+	VMOV V2.D[0], R9
+	VMOV V2.D[1], R10
+	MOVD R9, result_0+32(FP)
+	MOVD R10, result_8+40(FP)
+	RET
+
+// VEXT takes its byte offset as an immediate, so a two-register TBL with
+// the indices n..n+15 does the same for a run-time n.
+//
+// func ext_u8x16(a, b [16]byte, n int) (result [16]byte)
+TEXT ·ext_u8x16(SB), NOSPLIT, $0-56
+	MOVD a_0+0(FP), R9
+	MOVD a_8+8(FP), R10
+	VMOV R9, V0.D[0]
+	VMOV R10, V0.D[1]
+	MOVD b_0+16(FP), R9
+	MOVD b_8+24(FP), R10
+	VMOV R9, V1.D[0]
+	VMOV R10, V1.D[1]
+	MOVD n+32(FP), R11
+	VDUP R11, V4.B16
+	MOVD $extIota<>(SB), R12
+	VLD1 (R12), [V3.B16]
+	VADD V4.B16, V3.B16, V3.B16
+	VTBL V3.B16, [V0.B16, V1.B16], V2.B16
+	VMOV V2.D[0], R9
+	VMOV V2.D[1], R10
+	MOVD R9, result_0+40(FP)
+	MOVD R10, result_8+48(FP)
+	RET
@@ -0,0 +1,36 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build noasm || !arm64
+
+package asm
+
+// Stub implementations of the table lookup, shuffle and Extract methods for
+// non-ARM64 or noasm builds.
+
+func (v Uint8x16) TableLookupBytesOr(idx, fallback Uint8x16) Uint8x16 { panic("NEON not available") }
+func (v Uint8x16) TwoTablesLookupBytes(hi, idx Uint8x16) Uint8x16     { panic("NEON not available") }
+func (v Float32x4) TableLookupLanes(idx Int32x4) Float32x4            { panic("NEON not available") }
+func (v Int32x4) TableLookupLanes(idx Int32x4) Int32x4                { panic("NEON not available") }
+func (v Uint32x4) TableLookupLanes(idx Int32x4) Uint32x4              { panic("NEON not available") }
+
+func (v Float32x4) Extract(other Float32x4, n int) Float32x4 { panic("NEON not available") }
+func (v Float64x2) Extract(other Float64x2, n int) Float64x2 { panic("NEON not available") }
+func (v Int8x16) Extract(other Int8x16, n int) Int8x16       { panic("NEON not available") }
+func (v Int32x4) Extract(other Int32x4, n int) Int32x4       { panic("NEON not available") }
+func (v Int64x2) Extract(other Int64x2, n int) Int64x2       { panic("NEON not available") }
+func (v Uint8x16) Extract(other Uint8x16, n int) Uint8x16    { panic("NEON not available") }
+func (v Uint16x8) Extract(other Uint16x8, n int) Uint16x8    { panic("NEON not available") }
+func (v Uint32x4) Extract(other Uint32x4, n int) Uint32x4    { panic("NEON not available") }
+func (v Uint64x2) Extract(other Uint64x2, n int) Uint64x2    { panic("NEON not available") }
@@ -0,0 +1,97 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !noasm && arm64
+
+package asm
+
+import "testing"
+
+func TestUint8x16_TableLookups(t *testing.T) {
+	var lo, hi, idx, fallback [16]uint8
+	for i := range 16 {
+		lo[i] = uint8(100 + i)
+		hi[i] = uint8(200 + i)
+		idx[i] = uint8(i * 3) // 0..45: in lo, in hi, and out of range
+		fallback[i] = uint8(i)
+	}
+	v, h, x, f := LoadUint8x16(&lo), LoadUint8x16(&hi), LoadUint8x16(&idx), LoadUint8x16(&fallback)
+
+	tbx := v.TableLookupBytesOr(x, f)
+	tbl2 := v.TwoTablesLookupBytes(h, x)
+	for i := range 16 {
+		k := idx[i]
+		wantTbx, wantTbl2 := fallback[i], uint8(0)
+		switch {
+		case k < 16:
+			wantTbx, wantTbl2 = lo[k], lo[k]
+		case k < 32:
+			wantTbl2 = hi[k-16]
+		}
+		if tbx.Get(i) != wantTbx {
+			t.Errorf("TableLookupBytesOr[%d]: got %d, want %d", i, tbx.Get(i), wantTbx)
+		}
+		if tbl2.Get(i) != wantTbl2 {
+			t.Errorf("TwoTablesLookupBytes[%d]: got %d, want %d", i, tbl2.Get(i), wantTbl2)
+		}
+	}
+}
+
+func TestTableLookupLanes(t *testing.T) {
+	v := LoadFloat32x4Slice([]float32{1.5, -2, 3, 4})
+	idx := LoadInt32x4Slice([]int32{3, 0, 5, 2}) // 5 wraps to 1
+	got := v.TableLookupLanes(idx)
+	for i, want := range []float32{4, 1.5, -2, 3} {
+		if got.Get(i) != want {
+			t.Errorf("Float32x4.TableLookupLanes[%d]: got %v, want %v", i, got.Get(i), want)
+		}
+	}
+
+	u := LoadUint32x4Slice([]uint32{10, 20, 30, 0xFFFFFFFF})
+	gotU := u.TableLookupLanes(LoadInt32x4Slice([]int32{3, 3, 1, 0}))
+	for i, want := range []uint32{0xFFFFFFFF, 0xFFFFFFFF, 20, 10} {
+		if gotU.Get(i) != want {
+			t.Errorf("Uint32x4.TableLookupLanes[%d]: got %d, want %d", i, gotU.Get(i), want)
+		}
+	}
+}
+
+func TestExtract(t *testing.T) {
+	var a, b [16]uint8
+	for i := range 16 {
+		a[i] = uint8(i)
+		b[i] = uint8(16 + i)
+	}
+	va, vb := LoadUint8x16(&a), LoadUint8x16(&b)
+	for n := 0; n <= 16; n++ {
+		got := va.Extract(vb, n)
+		for i := range 16 {
+			if got.Get(i) != uint8(n+i) {
+				t.Errorf("Uint8x16.Extract(%d)[%d]: got %d, want %d", n, i, got.Get(i), n+i)
+			}
+		}
+	}
+
+	f := LoadFloat32x4Slice([]float32{0, 1, 2, 3}).Extract(LoadFloat32x4Slice([]float32{4, 5, 6, 7}), 3)
+	for i, want := range []float32{3, 4, 5, 6} {
+		if f.Get(i) != want {
+			t.Errorf("Float32x4.Extract(3)[%d]: got %v, want %v", i, f.Get(i), want)
+		}
+	}
+
+	u := LoadUint64x2Slice([]uint64{1, 2}).Extract(LoadUint64x2Slice([]uint64{3, 4}), 1)
+	if u.Get(0) != 2 || u.Get(1) != 3 {
+		t.Errorf("Uint64x2.Extract(1): got %v", u.Data())
+	}
+}
@@ -0,0 +1,43 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build amd64
+
+package hwy
+
+import "golang.org/x/sys/cpu"
+
+// hasAVX2 indicates AVX2 with FMA (Haswell+, Zen+), the baseline that
+// hwygen's AVX2 C/asm kernels are compiled for.
+var hasAVX2 = cpu.X86.HasAVX2 && cpu.X86.HasFMA
+
+// hasAVX512 indicates the AVX-512 subsets hwygen's AVX-512 C/asm kernels are
+// compiled for (F, DQ, BW, VL; Skylake-X+, Zen 4+). x/sys/cpu also checks
+// that the OS saves ZMM state.
+var hasAVX512 = cpu.X86.HasAVX512F && cpu.X86.HasAVX512DQ &&
+	cpu.X86.HasAVX512BW && cpu.X86.HasAVX512VL
+
+// HasAVX2 returns true if the CPU supports AVX2 and FMA and they have not
+// been disabled via HWY_NO_SIMD or a lower HWY_TARGET. Unlike the dispatch
+// level, it does not depend on GOEXPERIMENT=simd, so assembly kernels
+// generated by hwygen -asm can be dispatched in any build.
+func HasAVX2() bool {
+	return hasAVX2 && !NoSimdEnv() && TargetEnabled(DispatchAVX2)
+}
+
+// HasAVX512 returns true if the CPU supports AVX-512 F/DQ/BW/VL and it has
+// not been disabled (see HasAVX2).
+func HasAVX512() bool {
+	return hasAVX512 && !NoSimdEnv() && TargetEnabled(DispatchAVX512)
+}
@@ -0,0 +1,29 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !amd64
+
+package hwy
+
+// HasAVX2 returns true if the CPU supports AVX2 and FMA.
+// On non-amd64 platforms, this always returns false.
+func HasAVX2() bool {
+	return false
+}
+
+// HasAVX512 returns true if the CPU supports AVX-512 F/DQ/BW/VL.
+// On non-amd64 platforms, this always returns false.
+func HasAVX512() bool {
+	return false
+}
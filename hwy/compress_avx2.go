@@ -542,6 +542,16 @@ func FindLastTrue_AVX2_I64x4(mask archsimd.Mask64x4) int {
 	return FindLastTrue_AVX2_F64x4(mask)
 }
 
+// BitsFromMask_AVX2_I32x8 converts mask to bitmask integer.
+func BitsFromMask_AVX2_I32x8(mask archsimd.Mask32x8) uint64 {
+	return BitsFromMask_AVX2_F32x8(mask)
+}
+
+// BitsFromMask_AVX2_I64x4 converts mask to bitmask integer.
+func BitsFromMask_AVX2_I64x4(mask archsimd.Mask64x4) uint64 {
+	return BitsFromMask_AVX2_F64x4(mask)
+}
+
 // ============================================================================
 // Unsigned integer wrappers (use same masks as signed)
 // ============================================================================
@@ -620,6 +630,44 @@ func CompressStore_AVX2_Uint64x4(v archsimd.Uint64x4, mask archsimd.Mask64x4, ds
 	return count
 }
 
+// Expand_AVX2_Uint32x8 expands elements into positions where mask is true.
+func Expand_AVX2_Uint32x8(v archsimd.Uint32x8, mask archsimd.Mask32x8) archsimd.Uint32x8 {
+	var data [8]uint32
+	v.Store(&data)
+
+	bits := mask32x8ToBits(mask)
+	var result [8]uint32
+	srcIdx := 0
+
+	for i := 0; i < 8; i++ {
+		if (bits & (1 << i)) != 0 {
+			result[i] = data[srcIdx]
+			srcIdx++
+		}
+	}
+
+	return archsimd.LoadUint32x8(&result)
+}
+
+// Expand_AVX2_Uint64x4 expands elements into positions where mask is true.
+func Expand_AVX2_Uint64x4(v archsimd.Uint64x4, mask archsimd.Mask64x4) archsimd.Uint64x4 {
+	var data [4]uint64
+	v.Store(&data)
+
+	bits := mask64x4ToBits(mask)
+	var result [4]uint64
+	srcIdx := 0
+
+	for i := 0; i < 4; i++ {
+		if (bits & (1 << i)) != 0 {
+			result[i] = data[srcIdx]
+			srcIdx++
+		}
+	}
+
+	return archsimd.LoadUint64x4(&result)
+}
+
 // CountTrue_AVX2_Uint32x8 counts true lanes in mask.
 func CountTrue_AVX2_Uint32x8(mask archsimd.Mask32x8) int {
 	return CountTrue_AVX2_F32x8(mask)
@@ -690,6 +738,16 @@ func FindLastTrue_AVX2_Uint64x4(mask archsimd.Mask64x4) int {
 	return FindLastTrue_AVX2_F64x4(mask)
 }
 
+// BitsFromMask_AVX2_Uint32x8 converts mask to bitmask integer.
+func BitsFromMask_AVX2_Uint32x8(mask archsimd.Mask32x8) uint64 {
+	return BitsFromMask_AVX2_F32x8(mask)
+}
+
+// BitsFromMask_AVX2_Uint64x4 converts mask to bitmask integer.
+func BitsFromMask_AVX2_Uint64x4(mask archsimd.Mask64x4) uint64 {
+	return BitsFromMask_AVX2_F64x4(mask)
+}
+
 // ============================================================================
 // IfThenElse wrappers
 // ============================================================================
@@ -17,6 +17,7 @@
 package hwy
 
 import (
+	"math/bits"
 	"simd/archsimd"
 )
 
@@ -24,102 +25,41 @@ import (
 // These work directly with archsimd vector and mask types.
 // Note: archsimd uses proper Mask types (Mask32x16, Mask64x8) for comparison results.
 // These Mask types have ToBits() method to convert to a bitmask integer.
+//
+// Compress and Expand lower to VCOMPRESSPS/VPCOMPRESSD and VEXPANDPS/VPEXPANDD
+// via archsimd; CompressStore compresses in-register and copies out only the
+// selected lanes, so dst may be shorter than a full vector.
 
 // Compress_AVX512_F32x16 compresses elements where mask is true to the front.
 // The mask should be the result of a comparison operation (e.g., Less, Equal).
 // Returns compressed vector and count of valid elements.
 func Compress_AVX512_F32x16(v archsimd.Float32x16, mask archsimd.Mask32x16) (archsimd.Float32x16, int) {
-	var data [16]float32
-	v.Store(&data)
-
-	bits := mask.ToBits()
-	var result [16]float32
-	count := 0
-
-	for i := 0; i < 16; i++ {
-		if (bits & (1 << i)) != 0 {
-			result[count] = data[i]
-			count++
-		}
-	}
-
-	return archsimd.LoadFloat32x16Slice(result[:]), count
+	return v.Compress(mask), bits.OnesCount16(uint16(mask.ToBits()))
 }
 
 // Compress_AVX512_F64x8 compresses elements where mask is true to the front.
 // Returns compressed vector and count of valid elements.
 func Compress_AVX512_F64x8(v archsimd.Float64x8, mask archsimd.Mask64x8) (archsimd.Float64x8, int) {
-	var data [8]float64
-	v.Store(&data)
-
-	bits := mask.ToBits()
-	var result [8]float64
-	count := 0
-
-	for i := 0; i < 8; i++ {
-		if (bits & (1 << i)) != 0 {
-			result[count] = data[i]
-			count++
-		}
-	}
-
-	return archsimd.LoadFloat64x8Slice(result[:]), count
+	return v.Compress(mask), bits.OnesCount8(uint8(mask.ToBits()))
 }
 
 // Expand_AVX512_F32x16 expands elements into positions where mask is true.
 func Expand_AVX512_F32x16(v archsimd.Float32x16, mask archsimd.Mask32x16) archsimd.Float32x16 {
-	var data [16]float32
-	v.Store(&data)
-
-	bits := mask.ToBits()
-	var result [16]float32
-	srcIdx := 0
-
-	for i := 0; i < 16; i++ {
-		if (bits & (1 << i)) != 0 {
-			result[i] = data[srcIdx]
-			srcIdx++
-		}
-	}
-
-	return archsimd.LoadFloat32x16Slice(result[:])
+	return v.Expand(mask)
 }
 
 // Expand_AVX512_F64x8 expands elements into positions where mask is true.
 func Expand_AVX512_F64x8(v archsimd.Float64x8, mask archsimd.Mask64x8) archsimd.Float64x8 {
-	var data [8]float64
-	v.Store(&data)
-
-	bits := mask.ToBits()
-	var result [8]float64
-	srcIdx := 0
-
-	for i := 0; i < 8; i++ {
-		if (bits & (1 << i)) != 0 {
-			result[i] = data[srcIdx]
-			srcIdx++
-		}
-	}
-
-	return archsimd.LoadFloat64x8Slice(result[:])
+	return v.Expand(mask)
 }
 
 // CompressStore_AVX512_F32x16 compresses and stores directly to slice.
 // Returns number of elements stored.
 func CompressStore_AVX512_F32x16(v archsimd.Float32x16, mask archsimd.Mask32x16, dst []float32) int {
 	var data [16]float32
-	v.Store(&data)
-
-	bits := mask.ToBits()
-	count := 0
-	for i := 0; i < 16; i++ {
-		if (bits & (1 << i)) != 0 {
-			if count < len(dst) {
-				dst[count] = data[i]
-			}
-			count++
-		}
-	}
+	v.Compress(mask).Store(&data)
+	count := bits.OnesCount16(uint16(mask.ToBits()))
+	copy(dst, data[:count])
 	return count
 }
 
@@ -127,43 +67,20 @@ func CompressStore_AVX512_F32x16(v archsimd.Float32x16, mask archsimd.Mask32x16,
 // Returns number of elements stored.
 func CompressStore_AVX512_F64x8(v archsimd.Float64x8, mask archsimd.Mask64x8, dst []float64) int {
 	var data [8]float64
-	v.Store(&data)
-
-	bits := mask.ToBits()
-	count := 0
-	for i := 0; i < 8; i++ {
-		if (bits & (1 << i)) != 0 {
-			if count < len(dst) {
-				dst[count] = data[i]
-			}
-			count++
-		}
-	}
+	v.Compress(mask).Store(&data)
+	count := bits.OnesCount8(uint8(mask.ToBits()))
+	copy(dst, data[:count])
 	return count
 }
 
 // CountTrue_AVX512_F32x16 counts true lanes in mask.
 func CountTrue_AVX512_F32x16(mask archsimd.Mask32x16) int {
-	bits := mask.ToBits()
-	count := 0
-	for i := 0; i < 16; i++ {
-		if (bits & (1 << i)) != 0 {
-			count++
-		}
-	}
-	return count
+	return bits.OnesCount16(uint16(mask.ToBits()))
 }
 
 // CountTrue_AVX512_F64x8 counts true lanes in mask.
 func CountTrue_AVX512_F64x8(mask archsimd.Mask64x8) int {
-	bits := mask.ToBits()
-	count := 0
-	for i := 0; i < 8; i++ {
-		if (bits & (1 << i)) != 0 {
-			count++
-		}
-	}
-	return count
+	return bits.OnesCount8(uint8(mask.ToBits()))
 }
 
 // AllTrue_AVX512_F32x16 returns true if all lanes are true.
@@ -326,113 +243,39 @@ func BitsFromMask_AVX512_Uint8x64(mask archsimd.Mask8x64) uint64 {
 
 // Compress_AVX512_I32x16 compresses elements where mask is true to the front.
 func Compress_AVX512_I32x16(v archsimd.Int32x16, mask archsimd.Mask32x16) (archsimd.Int32x16, int) {
-	var data [16]int32
-	v.Store(&data)
-
-	bits := mask.ToBits()
-	var result [16]int32
-	count := 0
-
-	for i := 0; i < 16; i++ {
-		if (bits & (1 << i)) != 0 {
-			result[count] = data[i]
-			count++
-		}
-	}
-
-	return archsimd.LoadInt32x16Slice(result[:]), count
+	return v.Compress(mask), bits.OnesCount16(uint16(mask.ToBits()))
 }
 
 // Compress_AVX512_I64x8 compresses elements where mask is true to the front.
 func Compress_AVX512_I64x8(v archsimd.Int64x8, mask archsimd.Mask64x8) (archsimd.Int64x8, int) {
-	var data [8]int64
-	v.Store(&data)
-
-	bits := mask.ToBits()
-	var result [8]int64
-	count := 0
-
-	for i := 0; i < 8; i++ {
-		if (bits & (1 << i)) != 0 {
-			result[count] = data[i]
-			count++
-		}
-	}
-
-	return archsimd.LoadInt64x8Slice(result[:]), count
+	return v.Compress(mask), bits.OnesCount8(uint8(mask.ToBits()))
 }
 
 // Expand_AVX512_I32x16 expands elements into positions where mask is true.
 func Expand_AVX512_I32x16(v archsimd.Int32x16, mask archsimd.Mask32x16) archsimd.Int32x16 {
-	var data [16]int32
-	v.Store(&data)
-
-	bits := mask.ToBits()
-	var result [16]int32
-	srcIdx := 0
-
-	for i := 0; i < 16; i++ {
-		if (bits & (1 << i)) != 0 {
-			result[i] = data[srcIdx]
-			srcIdx++
-		}
-	}
-
-	return archsimd.LoadInt32x16Slice(result[:])
+	return v.Expand(mask)
 }
 
 // Expand_AVX512_I64x8 expands elements into positions where mask is true.
 func Expand_AVX512_I64x8(v archsimd.Int64x8, mask archsimd.Mask64x8) archsimd.Int64x8 {
-	var data [8]int64
-	v.Store(&data)
-
-	bits := mask.ToBits()
-	var result [8]int64
-	srcIdx := 0
-
-	for i := 0; i < 8; i++ {
-		if (bits & (1 << i)) != 0 {
-			result[i] = data[srcIdx]
-			srcIdx++
-		}
-	}
-
-	return archsimd.LoadInt64x8Slice(result[:])
+	return v.Expand(mask)
 }
 
 // CompressStore_AVX512_I32x16 compresses and stores directly to slice.
 func CompressStore_AVX512_I32x16(v archsimd.Int32x16, mask archsimd.Mask32x16, dst []int32) int {
 	var data [16]int32
-	v.Store(&data)
-
-	bits := mask.ToBits()
-	count := 0
-	for i := 0; i < 16; i++ {
-		if (bits & (1 << i)) != 0 {
-			if count < len(dst) {
-				dst[count] = data[i]
-			}
-			count++
-		}
-	}
+	v.Compress(mask).Store(&data)
+	count := bits.OnesCount16(uint16(mask.ToBits()))
+	copy(dst, data[:count])
 	return count
 }
 
 // CompressStore_AVX512_I64x8 compresses and stores directly to slice.
 func CompressStore_AVX512_I64x8(v archsimd.Int64x8, mask archsimd.Mask64x8, dst []int64) int {
 	var data [8]int64
-	v.Store(&data)
-
-	bits := mask.ToBits()
-	count := 0
-	for i := 0; i < 8; i++ {
-		if (bits & (1 << i)) != 0 {
-			if count < len(dst) {
-				dst[count] = data[i]
-			}
-			count++
-		}
-	}
+	v.Compress(mask).Store(&data)
+	count := bits.OnesCount8(uint8(mask.ToBits()))
+	copy(dst, data[:count])
 	return count
 }
 
@@ -506,84 +349,58 @@ func FindLastTrue_AVX512_I64x8(mask archsimd.Mask64x8) int {
 	return FindLastTrue_AVX512_F64x8(mask)
 }
 
+// BitsFromMask_AVX512_I32x16 converts mask to bitmask integer.
+func BitsFromMask_AVX512_I32x16(mask archsimd.Mask32x16) uint64 {
+	return BitsFromMask_AVX512_F32x16(mask)
+}
+
+// BitsFromMask_AVX512_I64x8 converts mask to bitmask integer.
+func BitsFromMask_AVX512_I64x8(mask archsimd.Mask64x8) uint64 {
+	return BitsFromMask_AVX512_F64x8(mask)
+}
+
 // ============================================================================
 // Unsigned integer wrappers (use same masks as signed)
 // ============================================================================
 
 // Compress_AVX512_Uint32x16 compresses elements where mask is true to the front.
 func Compress_AVX512_Uint32x16(v archsimd.Uint32x16, mask archsimd.Mask32x16) (archsimd.Uint32x16, int) {
-	var data [16]uint32
-	v.Store(&data)
-
-	bits := mask.ToBits()
-	var result [16]uint32
-	count := 0
-
-	for i := 0; i < 16; i++ {
-		if (bits & (1 << i)) != 0 {
-			result[count] = data[i]
-			count++
-		}
-	}
-
-	return archsimd.LoadUint32x16Slice(result[:]), count
+	return v.Compress(mask), bits.OnesCount16(uint16(mask.ToBits()))
 }
 
 // Compress_AVX512_Uint64x8 compresses elements where mask is true to the front.
 func Compress_AVX512_Uint64x8(v archsimd.Uint64x8, mask archsimd.Mask64x8) (archsimd.Uint64x8, int) {
-	var data [8]uint64
-	v.Store(&data)
-
-	bits := mask.ToBits()
-	var result [8]uint64
-	count := 0
-
-	for i := 0; i < 8; i++ {
-		if (bits & (1 << i)) != 0 {
-			result[count] = data[i]
-			count++
-		}
-	}
-
-	return archsimd.LoadUint64x8Slice(result[:]), count
+	return v.Compress(mask), bits.OnesCount8(uint8(mask.ToBits()))
 }
 
 // CompressStore_AVX512_Uint32x16 compresses and stores directly to slice.
 func CompressStore_AVX512_Uint32x16(v archsimd.Uint32x16, mask archsimd.Mask32x16, dst []uint32) int {
 	var data [16]uint32
-	v.Store(&data)
-
-	bits := mask.ToBits()
-	count := 0
-	for i := 0; i < 16; i++ {
-		if (bits & (1 << i)) != 0 {
-			if count < len(dst) {
-				dst[count] = data[i]
-			}
-			count++
-		}
-	}
+	v.Compress(mask).Store(&data)
+	count := bits.OnesCount16(uint16(mask.ToBits()))
+	copy(dst, data[:count])
 	return count
 }
 
 // CompressStore_AVX512_Uint64x8 compresses and stores directly to slice.
 func CompressStore_AVX512_Uint64x8(v archsimd.Uint64x8, mask archsimd.Mask64x8, dst []uint64) int {
 	var data [8]uint64
-	v.Store(&data)
-
-	bits := mask.ToBits()
-	count := 0
-	for i := 0; i < 8; i++ {
-		if (bits & (1 << i)) != 0 {
-			if count < len(dst) {
-				dst[count] = data[i]
-			}
-			count++
-		}
-	}
+	v.Compress(mask).Store(&data)
+	count := bits.OnesCount8(uint8(mask.ToBits()))
+	copy(dst, data[:count])
 	return count
 }
 
+// Expand_AVX512_Uint32x16 expands elements into positions where mask is true.
+func Expand_AVX512_Uint32x16(v archsimd.Uint32x16, mask archsimd.Mask32x16) archsimd.Uint32x16 {
+	return v.Expand(mask)
+}
+
+// Expand_AVX512_Uint64x8 expands elements into positions where mask is true.
+func Expand_AVX512_Uint64x8(v archsimd.Uint64x8, mask archsimd.Mask64x8) archsimd.Uint64x8 {
+	return v.Expand(mask)
+}
+
 // CountTrue_AVX512_Uint32x16 counts true lanes in mask.
 func CountTrue_AVX512_Uint32x16(mask archsimd.Mask32x16) int {
 	return CountTrue_AVX512_F32x16(mask)
@@ -654,6 +471,16 @@ func FindLastTrue_AVX512_Uint64x8(mask archsimd.Mask64x8) int {
 	return FindLastTrue_AVX512_F64x8(mask)
 }
 
+// BitsFromMask_AVX512_Uint32x16 converts mask to bitmask integer.
+func BitsFromMask_AVX512_Uint32x16(mask archsimd.Mask32x16) uint64 {
+	return BitsFromMask_AVX512_F32x16(mask)
+}
+
+// BitsFromMask_AVX512_Uint64x8 converts mask to bitmask integer.
+func BitsFromMask_AVX512_Uint64x8(mask archsimd.Mask64x8) uint64 {
+	return BitsFromMask_AVX512_F64x8(mask)
+}
+
 // ============================================================================
 // IfThenElse wrappers
 // ============================================================================
@@ -91,3 +91,205 @@ func BitsFromMask_NEON_Uint64x2(mask asm.Uint64x2) uint64 {
 	}
 	return result
 }
+
+// Short-name aliases matching the hwygen naming scheme (BitsFromMask_NEON_F32x4
+// etc.), so generated code can call BitsFromMask for every lane type.
+
+// BitsFromMask_NEON_F32x4 converts a float32 comparison result to a bitmask.
+func BitsFromMask_NEON_F32x4(mask asm.Int32x4) uint64 {
+	return BitsFromMask_NEON_Float32x4(mask)
+}
+
+// BitsFromMask_NEON_F64x2 converts a float64 comparison result to a bitmask.
+func BitsFromMask_NEON_F64x2(mask asm.Int64x2) uint64 {
+	return BitsFromMask_NEON_Float64x2(mask)
+}
+
+// BitsFromMask_NEON_I32x4 converts an int32 comparison result to a bitmask.
+func BitsFromMask_NEON_I32x4(mask asm.Int32x4) uint64 {
+	return BitsFromMask_NEON_Float32x4(mask)
+}
+
+// BitsFromMask_NEON_I64x2 converts an int64 comparison result to a bitmask.
+func BitsFromMask_NEON_I64x2(mask asm.Int64x2) uint64 {
+	return BitsFromMask_NEON_Float64x2(mask)
+}
+
+// ============================================================================
+// CompressStore and Expand
+// ============================================================================
+//
+// NEON has no compress/expand instruction. CompressStore uses the TBL-based
+// asm.CompressKeys* and copies out only the selected lanes, so dst may be
+// shorter than a full vector. Expand scatters lanes with scalar code.
+//
+// As in asm.CompressStoreU32x4, masks for unsigned lanes have the signed
+// type that hwy.Mask[uint32] and asm.FirstN produce.
+
+// CompressStore_NEON_F32x4 stores the lanes of v where mask is true to the
+// front of dst. Returns the number of selected lanes.
+func CompressStore_NEON_F32x4(v asm.Float32x4, mask asm.Int32x4, dst []float32) int {
+	c, count := asm.CompressKeysF32x4(v, mask)
+	var data [4]float32
+	c.Store(&data)
+	copy(dst, data[:count])
+	return count
+}
+
+// Expand_NEON_F32x4 expands elements into positions where mask is true.
+func Expand_NEON_F32x4(v asm.Float32x4, mask asm.Int32x4) asm.Float32x4 {
+	var data [4]float32
+	v.Store(&data)
+	var bits [4]int32
+	mask.Store(&bits)
+
+	var result [4]float32
+	srcIdx := 0
+	for i := range 4 {
+		if bits[i] != 0 {
+			result[i] = data[srcIdx]
+			srcIdx++
+		}
+	}
+	return asm.LoadFloat32x4(&result)
+}
+
+// CompressStore_NEON_F64x2 stores the lanes of v where mask is true to the
+// front of dst. Returns the number of selected lanes.
+func CompressStore_NEON_F64x2(v asm.Float64x2, mask asm.Int64x2, dst []float64) int {
+	c, count := asm.CompressKeysF64x2(v, mask)
+	var data [2]float64
+	c.Store(&data)
+	copy(dst, data[:count])
+	return count
+}
+
+// Expand_NEON_F64x2 expands elements into positions where mask is true.
+func Expand_NEON_F64x2(v asm.Float64x2, mask asm.Int64x2) asm.Float64x2 {
+	var data [2]float64
+	v.Store(&data)
+	var bits [2]int64
+	mask.Store(&bits)
+
+	var result [2]float64
+	srcIdx := 0
+	for i := range 2 {
+		if bits[i] != 0 {
+			result[i] = data[srcIdx]
+			srcIdx++
+		}
+	}
+	return asm.LoadFloat64x2(&result)
+}
+
+// CompressStore_NEON_I32x4 stores the lanes of v where mask is true to the
+// front of dst. Returns the number of selected lanes.
+func CompressStore_NEON_I32x4(v asm.Int32x4, mask asm.Int32x4, dst []int32) int {
+	c, count := asm.CompressKeysI32x4(v, mask)
+	var data [4]int32
+	c.Store(&data)
+	copy(dst, data[:count])
+	return count
+}
+
+// Expand_NEON_I32x4 expands elements into positions where mask is true.
+func Expand_NEON_I32x4(v asm.Int32x4, mask asm.Int32x4) asm.Int32x4 {
+	var data [4]int32
+	v.Store(&data)
+	var bits [4]int32
+	mask.Store(&bits)
+
+	var result [4]int32
+	srcIdx := 0
+	for i := range 4 {
+		if bits[i] != 0 {
+			result[i] = data[srcIdx]
+			srcIdx++
+		}
+	}
+	return asm.LoadInt32x4(&result)
+}
+
+// CompressStore_NEON_I64x2 stores the lanes of v where mask is true to the
+// front of dst. Returns the number of selected lanes.
+func CompressStore_NEON_I64x2(v asm.Int64x2, mask asm.Int64x2, dst []int64) int {
+	c, count := asm.CompressKeysI64x2(v, mask)
+	var data [2]int64
+	c.Store(&data)
+	copy(dst, data[:count])
+	return count
+}
+
+// Expand_NEON_I64x2 expands elements into positions where mask is true.
+func Expand_NEON_I64x2(v asm.Int64x2, mask asm.Int64x2) asm.Int64x2 {
+	var data [2]int64
+	v.Store(&data)
+	var bits [2]int64
+	mask.Store(&bits)
+
+	var result [2]int64
+	srcIdx := 0
+	for i := range 2 {
+		if bits[i] != 0 {
+			result[i] = data[srcIdx]
+			srcIdx++
+		}
+	}
+	return asm.LoadInt64x2(&result)
+}
+
+// CompressStore_NEON_Uint32x4 stores the lanes of v where mask is true to the
+// front of dst. Returns the number of selected lanes.
+func CompressStore_NEON_Uint32x4(v asm.Uint32x4, mask asm.Int32x4, dst []uint32) int {
+	c, count := asm.CompressKeysU32x4(v, asm.Uint32x4(mask))
+	var data [4]uint32
+	c.Store(&data)
+	copy(dst, data[:count])
+	return count
+}
+
+// Expand_NEON_Uint32x4 expands elements into positions where mask is true.
+func Expand_NEON_Uint32x4(v asm.Uint32x4, mask asm.Int32x4) asm.Uint32x4 {
+	var data [4]uint32
+	v.Store(&data)
+	var bits [4]int32
+	mask.Store(&bits)
+
+	var result [4]uint32
+	srcIdx := 0
+	for i := range 4 {
+		if bits[i] != 0 {
+			result[i] = data[srcIdx]
+			srcIdx++
+		}
+	}
+	return asm.LoadUint32x4(&result)
+}
+
+// CompressStore_NEON_Uint64x2 stores the lanes of v where mask is true to the
+// front of dst. Returns the number of selected lanes.
+func CompressStore_NEON_Uint64x2(v asm.Uint64x2, mask asm.Int64x2, dst []uint64) int {
+	c, count := asm.CompressKeysU64x2(v, asm.Uint64x2(mask))
+	var data [2]uint64
+	c.Store(&data)
+	copy(dst, data[:count])
+	return count
+}
+
+// Expand_NEON_Uint64x2 expands elements into positions where mask is true.
+func Expand_NEON_Uint64x2(v asm.Uint64x2, mask asm.Int64x2) asm.Uint64x2 {
+	var data [2]uint64
+	v.Store(&data)
+	var bits [2]int64
+	mask.Store(&bits)
+
+	var result [2]uint64
+	srcIdx := 0
+	for i := range 2 {
+		if bits[i] != 0 {
+			result[i] = data[srcIdx]
+			srcIdx++
+		}
+	}
+	return asm.LoadUint64x2(&result)
+}
@@ -15,6 +15,7 @@
 package hwy
 
 import (
+	"reflect"
 	"testing"
 )
 
@@ -706,3 +707,65 @@ func BenchmarkCompressStore(b *testing.B) {
 		_ = CompressStore(v, mask, dst)
 	}
 }
+
+// compressExpandRoundTrip checks that Expand(Compress(v)) restores the
+// selected lanes and zeroes the rest, for any lane type.
+func compressExpandRoundTrip[T Lanes](t *testing.T, data []T, mask []bool) {
+	t.Helper()
+	v := Vec[T]{data: data}
+	m := Mask[T]{bits: mask}
+
+	compressed, count := Compress(v, m)
+	dst := make([]T, len(data))
+	if n := CompressStore(v, m, dst); n != count {
+		t.Errorf("CompressStore count = %d, Compress count = %d", n, count)
+	}
+	if !reflect.DeepEqual(dst[:count], compressed.data[:count]) {
+		t.Errorf("CompressStore = %v, Compress = %v", dst[:count], compressed.data[:count])
+	}
+
+	expanded := Expand(compressed, m)
+	for i := range data {
+		var want T
+		if mask[i] {
+			want = data[i]
+		}
+		if expanded.data[i] != want {
+			t.Errorf("lane %d: Expand(Compress()) = %v, want %v", i, expanded.data[i], want)
+		}
+	}
+}
+
+func TestCompressExpandAllTypes(t *testing.T) {
+	mask := []bool{true, false, false, true, true, false, true, false}
+	t.Run("int8", func(t *testing.T) {
+		compressExpandRoundTrip(t, []int8{-1, 2, -3, 4, -5, 6, -7, 8}, mask)
+	})
+	t.Run("uint8", func(t *testing.T) {
+		compressExpandRoundTrip(t, []uint8{1, 2, 3, 4, 5, 6, 7, 255}, mask)
+	})
+	t.Run("int16", func(t *testing.T) {
+		compressExpandRoundTrip(t, []int16{-100, 2, 3, 400, 5, 6, 7, 8}, mask)
+	})
+	t.Run("uint16", func(t *testing.T) {
+		compressExpandRoundTrip(t, []uint16{1, 2, 3, 4, 5, 6, 7, 65535}, mask)
+	})
+	t.Run("int32", func(t *testing.T) {
+		compressExpandRoundTrip(t, []int32{1, -2, 3, -4, 5, -6, 7, -8}, mask)
+	})
+	t.Run("uint32", func(t *testing.T) {
+		compressExpandRoundTrip(t, []uint32{1, 2, 3, 4, 5, 6, 7, 8}, mask)
+	})
+	t.Run("int64", func(t *testing.T) {
+		compressExpandRoundTrip(t, []int64{1, 2, 3, 4, 5, 6, 7, 8}, mask)
+	})
+	t.Run("uint64", func(t *testing.T) {
+		compressExpandRoundTrip(t, []uint64{1, 2, 3, 4, 5, 6, 7, 8}, mask)
+	})
+	t.Run("float32", func(t *testing.T) {
+		compressExpandRoundTrip(t, []float32{1.5, 2, 3, 4, 5, 6, 7, 8}, mask)
+	})
+	t.Run("float64", func(t *testing.T) {
+		compressExpandRoundTrip(t, []float64{1.5, 2, 3, 4, 5, 6, 7, 8}, mask)
+	})
+}
@@ -68,6 +68,46 @@ func BaseGELU_fallback(input []float32, output []float32) {
 	vOne := float32(1.0)
 	vInvSqrt2 := float32(0.7071067811865476)
 	ii := 0
+	for ; ii+4 <= size; ii += 4 {
+		input4 := input[ii : ii+4 : ii+4]
+		output4 := output[ii : ii+4 : ii+4]
+		{
+			x := input4[0]
+			xScaled := x * vInvSqrt2
+			erfX := float32(stdmath.Erf(float64(xScaled)))
+			onePlusErf := vOne + erfX
+			halfOnePlusErf := vHalf * onePlusErf
+			result := x * halfOnePlusErf
+			output4[0] = result
+		}
+		{
+			x := input4[1]
+			xScaled := x * vInvSqrt2
+			erfX := float32(stdmath.Erf(float64(xScaled)))
+			onePlusErf := vOne + erfX
+			halfOnePlusErf := vHalf * onePlusErf
+			result := x * halfOnePlusErf
+			output4[1] = result
+		}
+		{
+			x := input4[2]
+			xScaled := x * vInvSqrt2
+			erfX := float32(stdmath.Erf(float64(xScaled)))
+			onePlusErf := vOne + erfX
+			halfOnePlusErf := vHalf * onePlusErf
+			result := x * halfOnePlusErf
+			output4[2] = result
+		}
+		{
+			x := input4[3]
+			xScaled := x * vInvSqrt2
+			erfX := float32(stdmath.Erf(float64(xScaled)))
+			onePlusErf := vOne + erfX
+			halfOnePlusErf := vHalf * onePlusErf
+			result := x * halfOnePlusErf
+			output4[3] = result
+		}
+	}
 	for ; ii < size; ii++ {
 		x := input[ii]
 		xScaled := x * vInvSqrt2
@@ -92,6 +132,46 @@ func BaseGELU_fallback_Float64(input []float64, output []float64) {
 	vOne := float64(1.0)
 	vInvSqrt2 := float64(0.7071067811865476)
 	ii := 0
+	for ; ii+4 <= size; ii += 4 {
+		input4 := input[ii : ii+4 : ii+4]
+		output4 := output[ii : ii+4 : ii+4]
+		{
+			x := input4[0]
+			xScaled := x * vInvSqrt2
+			erfX := float64(stdmath.Erf(float64(xScaled)))
+			onePlusErf := vOne + erfX
+			halfOnePlusErf := vHalf * onePlusErf
+			result := x * halfOnePlusErf
+			output4[0] = result
+		}
+		{
+			x := input4[1]
+			xScaled := x * vInvSqrt2
+			erfX := float64(stdmath.Erf(float64(xScaled)))
+			onePlusErf := vOne + erfX
+			halfOnePlusErf := vHalf * onePlusErf
+			result := x * halfOnePlusErf
+			output4[1] = result
+		}
+		{
+			x := input4[2]
+			xScaled := x * vInvSqrt2
+			erfX := float64(stdmath.Erf(float64(xScaled)))
+			onePlusErf := vOne + erfX
+			halfOnePlusErf := vHalf * onePlusErf
+			result := x * halfOnePlusErf
+			output4[2] = result
+		}
+		{
+			x := input4[3]
+			xScaled := x * vInvSqrt2
+			erfX := float64(stdmath.Erf(float64(xScaled)))
+			onePlusErf := vOne + erfX
+			halfOnePlusErf := vHalf * onePlusErf
+			result := x * halfOnePlusErf
+			output4[3] = result
+		}
+	}
 	for ; ii < size; ii++ {
 		x := input[ii]
 		xScaled := x * vInvSqrt2
@@ -246,6 +326,30 @@ func BaseReLU_fallback(input []float32, output []float32) {
 	}
 	vZero := float32(0.0)
 	ii := 0
+	for ; ii+4 <= size; ii += 4 {
+		input4 := input[ii : ii+4 : ii+4]
+		output4 := output[ii : ii+4 : ii+4]
+		{
+			x := input4[0]
+			result := max(x, vZero)
+			output4[0] = result
+		}
+		{
+			x := input4[1]
+			result := max(x, vZero)
+			output4[1] = result
+		}
+		{
+			x := input4[2]
+			result := max(x, vZero)
+			output4[2] = result
+		}
+		{
+			x := input4[3]
+			result := max(x, vZero)
+			output4[3] = result
+		}
+	}
 	for ; ii < size; ii++ {
 		x := input[ii]
 		result := max(x, vZero)
@@ -267,6 +371,30 @@ func BaseReLU_fallback_Float64(input []float64, output []float64) {
 	}
 	vZero := float64(0.0)
 	ii := 0
+	for ; ii+4 <= size; ii += 4 {
+		input4 := input[ii : ii+4 : ii+4]
+		output4 := output[ii : ii+4 : ii+4]
+		{
+			x := input4[0]
+			result := max(x, vZero)
+			output4[0] = result
+		}
+		{
+			x := input4[1]
+			result := max(x, vZero)
+			output4[1] = result
+		}
+		{
+			x := input4[2]
+			result := max(x, vZero)
+			output4[2] = result
+		}
+		{
+			x := input4[3]
+			result := max(x, vZero)
+			output4[3] = result
+		}
+	}
 	for ; ii < size; ii++ {
 		x := input[ii]
 		result := max(x, vZero)
@@ -414,6 +542,34 @@ func BaseLeakyReLU_fallback(input []float32, output []float32, alpha float32) {
 	}
 	vAlpha := float32(alpha)
 	ii := 0
+	for ; ii+4 <= size; ii += 4 {
+		input4 := input[ii : ii+4 : ii+4]
+		output4 := output[ii : ii+4 : ii+4]
+		{
+			x := input4[0]
+			negPart := x * vAlpha
+			result := max(x, negPart)
+			output4[0] = result
+		}
+		{
+			x := input4[1]
+			negPart := x * vAlpha
+			result := max(x, negPart)
+			output4[1] = result
+		}
+		{
+			x := input4[2]
+			negPart := x * vAlpha
+			result := max(x, negPart)
+			output4[2] = result
+		}
+		{
+			x := input4[3]
+			negPart := x * vAlpha
+			result := max(x, negPart)
+			output4[3] = result
+		}
+	}
 	for ; ii < size; ii++ {
 		x := input[ii]
 		negPart := x * vAlpha
@@ -436,6 +592,34 @@ func BaseLeakyReLU_fallback_Float64(input []float64, output []float64, alpha flo
 	}
 	vAlpha := float64(alpha)
 	ii := 0
+	for ; ii+4 <= size; ii += 4 {
+		input4 := input[ii : ii+4 : ii+4]
+		output4 := output[ii : ii+4 : ii+4]
+		{
+			x := input4[0]
+			negPart := x * vAlpha
+			result := max(x, negPart)
+			output4[0] = result
+		}
+		{
+			x := input4[1]
+			negPart := x * vAlpha
+			result := max(x, negPart)
+			output4[1] = result
+		}
+		{
+			x := input4[2]
+			negPart := x * vAlpha
+			result := max(x, negPart)
+			output4[2] = result
+		}
+		{
+			x := input4[3]
+			negPart := x * vAlpha
+			result := max(x, negPart)
+			output4[3] = result
+		}
+	}
 	for ; ii < size; ii++ {
 		x := input[ii]
 		negPart := x * vAlpha
@@ -493,6 +677,30 @@ func BaseTanh_fallback(input []float32, output []float32) {
 		return
 	}
 	ii := 0
+	for ; ii+4 <= size; ii += 4 {
+		input4 := input[ii : ii+4 : ii+4]
+		output4 := output[ii : ii+4 : ii+4]
+		{
+			x := input4[0]
+			result := float32(stdmath.Tanh(float64(x)))
+			output4[0] = result
+		}
+		{
+			x := input4[1]
+			result := float32(stdmath.Tanh(float64(x)))
+			output4[1] = result
+		}
+		{
+			x := input4[2]
+			result := float32(stdmath.Tanh(float64(x)))
+			output4[2] = result
+		}
+		{
+			x := input4[3]
+			result := float32(stdmath.Tanh(float64(x)))
+			output4[3] = result
+		}
+	}
 	for ; ii < size; ii++ {
 		x := input[ii]
 		result := float32(stdmath.Tanh(float64(x)))
@@ -510,6 +718,30 @@ func BaseTanh_fallback_Float64(input []float64, output []float64) {
 		return
 	}
 	ii := 0
+	for ; ii+4 <= size; ii += 4 {
+		input4 := input[ii : ii+4 : ii+4]
+		output4 := output[ii : ii+4 : ii+4]
+		{
+			x := input4[0]
+			result := float64(stdmath.Tanh(float64(x)))
+			output4[0] = result
+		}
+		{
+			x := input4[1]
+			result := float64(stdmath.Tanh(float64(x)))
+			output4[1] = result
+		}
+		{
+			x := input4[2]
+			result := float64(stdmath.Tanh(float64(x)))
+			output4[2] = result
+		}
+		{
+			x := input4[3]
+			result := float64(stdmath.Tanh(float64(x)))
+			output4[3] = result
+		}
+	}
 	for ; ii < size; ii++ {
 		x := input[ii]
 		result := float64(stdmath.Tanh(float64(x)))
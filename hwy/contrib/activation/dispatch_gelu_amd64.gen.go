@@ -186,11 +186,11 @@ func init() {
 		initGeluFallback()
 		return
 	}
-	if archsimd.X86.AVX512() {
+	if archsimd.X86.AVX512() && hwy.TargetEnabled(hwy.DispatchAVX512) {
 		initGeluAVX512()
 		return
 	}
-	if archsimd.X86.AVX2() {
+	if archsimd.X86.AVX2() && hwy.TargetEnabled(hwy.DispatchAVX2) {
 		initGeluAVX2()
 		return
 	}
@@ -289,3 +289,38 @@ func initGeluFallback() {
 	ELUFloat32 = BaseELU_fallback
 	ELUFloat64 = BaseELU_fallback_Float64
 }
+
+func init() {
+	hwy.RegisterKernel("activation.GELUFloat16", &GELUFloat16)
+	hwy.RegisterKernel("activation.GELUBFloat16", &GELUBFloat16)
+	hwy.RegisterKernel("activation.GELUFloat32", &GELUFloat32)
+	hwy.RegisterKernel("activation.GELUFloat64", &GELUFloat64)
+	hwy.RegisterKernel("activation.GELUApproxFloat16", &GELUApproxFloat16)
+	hwy.RegisterKernel("activation.GELUApproxBFloat16", &GELUApproxBFloat16)
+	hwy.RegisterKernel("activation.GELUApproxFloat32", &GELUApproxFloat32)
+	hwy.RegisterKernel("activation.GELUApproxFloat64", &GELUApproxFloat64)
+	hwy.RegisterKernel("activation.ReLUFloat16", &ReLUFloat16)
+	hwy.RegisterKernel("activation.ReLUBFloat16", &ReLUBFloat16)
+	hwy.RegisterKernel("activation.ReLUFloat32", &ReLUFloat32)
+	hwy.RegisterKernel("activation.ReLUFloat64", &ReLUFloat64)
+	hwy.RegisterKernel("activation.SiLUFloat16", &SiLUFloat16)
+	hwy.RegisterKernel("activation.SiLUBFloat16", &SiLUBFloat16)
+	hwy.RegisterKernel("activation.SiLUFloat32", &SiLUFloat32)
+	hwy.RegisterKernel("activation.SiLUFloat64", &SiLUFloat64)
+	hwy.RegisterKernel("activation.LeakyReLUFloat16", &LeakyReLUFloat16)
+	hwy.RegisterKernel("activation.LeakyReLUBFloat16", &LeakyReLUBFloat16)
+	hwy.RegisterKernel("activation.LeakyReLUFloat32", &LeakyReLUFloat32)
+	hwy.RegisterKernel("activation.LeakyReLUFloat64", &LeakyReLUFloat64)
+	hwy.RegisterKernel("activation.TanhFloat16", &TanhFloat16)
+	hwy.RegisterKernel("activation.TanhBFloat16", &TanhBFloat16)
+	hwy.RegisterKernel("activation.TanhFloat32", &TanhFloat32)
+	hwy.RegisterKernel("activation.TanhFloat64", &TanhFloat64)
+	hwy.RegisterKernel("activation.ELUFloat16", &ELUFloat16)
+	hwy.RegisterKernel("activation.ELUBFloat16", &ELUBFloat16)
+	hwy.RegisterKernel("activation.ELUFloat32", &ELUFloat32)
+	hwy.RegisterKernel("activation.ELUFloat64", &ELUFloat64)
+	hwyKernels := []string{"activation.GELUFloat16", "activation.GELUBFloat16", "activation.GELUFloat32", "activation.GELUFloat64", "activation.GELUApproxFloat16", "activation.GELUApproxBFloat16", "activation.GELUApproxFloat32", "activation.GELUApproxFloat64", "activation.ReLUFloat16", "activation.ReLUBFloat16", "activation.ReLUFloat32", "activation.ReLUFloat64", "activation.SiLUFloat16", "activation.SiLUBFloat16", "activation.SiLUFloat32", "activation.SiLUFloat64", "activation.LeakyReLUFloat16", "activation.LeakyReLUBFloat16", "activation.LeakyReLUFloat32", "activation.LeakyReLUFloat64", "activation.TanhFloat16", "activation.TanhBFloat16", "activation.TanhFloat32", "activation.TanhFloat64", "activation.ELUFloat16", "activation.ELUBFloat16", "activation.ELUFloat32", "activation.ELUFloat64"}
+	hwy.RegisterKernelTarget(hwy.DispatchAVX2, initGeluAVX2, hwyKernels...)
+	hwy.RegisterKernelTarget(hwy.DispatchAVX512, initGeluAVX512, hwyKernels...)
+	hwy.RegisterKernelTarget(hwy.DispatchScalar, initGeluFallback, hwyKernels...)
+}
@@ -0,0 +1,137 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package algo
+
+import (
+	"math/bits"
+	"unsafe"
+)
+
+// The byte-order functions process min(len(dst), len(src)) elements and
+// return their number. dst may be src, to work in place, but must not
+// otherwise overlap it.
+
+// swapTable16, swapTable32 and swapTable64 reverse the bytes of each
+// element of a 16-byte block, see baseShuffleBytes.
+var (
+	swapTable16 = swapTable(2)
+	swapTable32 = swapTable(4)
+	swapTable64 = swapTable(8)
+)
+
+func swapTable(size int) []uint8 {
+	t := make([]uint8, 64)
+	for i := range t {
+		b := i % 16
+		t[i] = uint8(b - b%size + size - 1 - b%size)
+	}
+	return t
+}
+
+// ByteSwap16 stores in dst the elements of src with their two bytes
+// swapped, converting between little and big endian.
+func ByteSwap16(dst, src []uint16) int {
+	n := min(len(dst), len(src))
+	done := shuffleBytes(asBytes(dst[:n]), asBytes(src[:n]), swapTable16) / 2
+	for i := done; i < n; i++ {
+		dst[i] = bits.ReverseBytes16(src[i])
+	}
+	return n
+}
+
+// ByteSwap32 stores in dst the elements of src with their bytes reversed,
+// converting between little and big endian.
+func ByteSwap32(dst, src []uint32) int {
+	n := min(len(dst), len(src))
+	done := shuffleBytes(asBytes(dst[:n]), asBytes(src[:n]), swapTable32) / 4
+	for i := done; i < n; i++ {
+		dst[i] = bits.ReverseBytes32(src[i])
+	}
+	return n
+}
+
+// ByteSwap64 stores in dst the elements of src with their bytes reversed,
+// converting between little and big endian.
+func ByteSwap64(dst, src []uint64) int {
+	n := min(len(dst), len(src))
+	done := shuffleBytes(asBytes(dst[:n]), asBytes(src[:n]), swapTable64) / 8
+	for i := done; i < n; i++ {
+		dst[i] = bits.ReverseBytes64(src[i])
+	}
+	return n
+}
+
+// BitReverse8 stores in dst the elements of src with the order of their
+// bits reversed, like bits.Reverse8.
+func BitReverse8(dst, src []uint8) int {
+	n := copy(dst, src)
+	reverseBits(dst[:n])
+	return n
+}
+
+// BitReverse16 stores in dst the elements of src with the order of their
+// bits reversed, like bits.Reverse16.
+func BitReverse16(dst, src []uint16) int {
+	n := ByteSwap16(dst, src)
+	reverseBits(asBytes(dst[:n]))
+	return n
+}
+
+// BitReverse32 stores in dst the elements of src with the order of their
+// bits reversed, like bits.Reverse32.
+//
+// The bit-reversal permutation of a radix-2 FFT of 2^k points exchanges
+// each index i with the reversal of its low k bits, which is
+// bits.Reverse32(i) >> (32-k):
+//
+//	BitReverse32(rev, idx)
+//	for i := range rev {
+//	    rev[i] >>= 32 - k
+//	}
+func BitReverse32(dst, src []uint32) int {
+	n := ByteSwap32(dst, src)
+	reverseBits(asBytes(dst[:n]))
+	return n
+}
+
+// BitReverse64 stores in dst the elements of src with the order of their
+// bits reversed, like bits.Reverse64.
+func BitReverse64(dst, src []uint64) int {
+	n := ByteSwap64(dst, src)
+	reverseBits(asBytes(dst[:n]))
+	return n
+}
+
+// reverseBits reverses the order of the bits within each byte of b, eight
+// bytes at a time from the first 8-byte aligned one.
+func reverseBits(b []uint8) {
+	head := min(int(-uintptr(unsafe.Pointer(unsafe.SliceData(b)))%8), len(b))
+	words := (len(b) - head) / 8
+	if words > 0 {
+		reverseBitsInBytes(unsafe.Slice((*uint64)(unsafe.Pointer(&b[head])), words))
+	}
+	for i := range head {
+		b[i] = bits.Reverse8(b[i])
+	}
+	for i := head + words*8; i < len(b); i++ {
+		b[i] = bits.Reverse8(b[i])
+	}
+}
+
+// asBytes returns the memory of s as bytes.
+func asBytes[T uint16 | uint32 | uint64](s []T) []uint8 {
+	var zero T
+	return unsafe.Slice((*uint8)(unsafe.Pointer(unsafe.SliceData(s))), len(s)*int(unsafe.Sizeof(zero)))
+}
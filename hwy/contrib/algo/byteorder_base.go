@@ -0,0 +1,61 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package algo
+
+import "github.com/ajroetker/go-highway/hwy"
+
+//go:generate go run ../../../cmd/hwygen -input byteorder_base.go -output . -targets avx2,avx512,neon,fallback -dispatch byteorder
+
+// baseShuffleBytes stores in dst the bytes of src permuted within each
+// 16-byte block by table, whose first 16 entries give, for each byte of a
+// block, the index of its source byte in the block (PSHUFB on x86, TBL on
+// ARM). The table is stored repeated to 64 bytes, which fills a vector of
+// any width. It returns the number of bytes done, a whole number of
+// vectors; the caller finishes the rest.
+func baseShuffleBytes(dst, src, table []uint8) int {
+	n := min(len(dst), len(src))
+	lanes := hwy.MaxLanes[uint8]()
+	tbl := hwy.Load(table)
+	i := 0
+	for ; i+lanes <= n; i += lanes {
+		hwy.Store(hwy.TableLookupBytes(hwy.Load(src[i:]), tbl), dst[i:])
+	}
+	return i
+}
+
+// baseReverseBitsInBytes reverses in place the order of the bits within
+// each byte of data, swapping adjacent bits, then pairs, then nibbles.
+func baseReverseBitsInBytes(data []uint64) {
+	n := len(data)
+	m1 := hwy.Set(uint64(0x5555555555555555))
+	m2 := hwy.Set(uint64(0x3333333333333333))
+	m4 := hwy.Set(uint64(0x0F0F0F0F0F0F0F0F))
+	lanes := hwy.MaxLanes[uint64]()
+	i := 0
+	for ; i+lanes <= n; i += lanes {
+		v := hwy.Load(data[i:])
+		v = hwy.Or(hwy.And(hwy.ShiftRight(v, 1), m1), hwy.ShiftLeft(hwy.And(v, m1), 1))
+		v = hwy.Or(hwy.And(hwy.ShiftRight(v, 2), m2), hwy.ShiftLeft(hwy.And(v, m2), 2))
+		v = hwy.Or(hwy.And(hwy.ShiftRight(v, 4), m4), hwy.ShiftLeft(hwy.And(v, m4), 4))
+		hwy.Store(v, data[i:])
+	}
+	for ; i < n; i++ {
+		v := data[i]
+		v = (v>>1)&0x5555555555555555 | (v&0x5555555555555555)<<1
+		v = (v>>2)&0x3333333333333333 | (v&0x3333333333333333)<<2
+		v = (v>>4)&0x0F0F0F0F0F0F0F0F | (v&0x0F0F0F0F0F0F0F0F)<<4
+		data[i] = v
+	}
+}
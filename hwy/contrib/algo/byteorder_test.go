@@ -0,0 +1,123 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package algo
+
+import (
+	"math/bits"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+var byteOrderLengths = []int{0, 1, 3, 7, 8, 9, 31, 64, 100, 1000}
+
+func TestByteSwap(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	for _, n := range byteOrderLengths {
+		src64 := make([]uint64, n)
+		for i := range src64 {
+			src64[i] = r.Uint64()
+		}
+		src32 := make([]uint32, n)
+		src16 := make([]uint16, n)
+		for i, v := range src64 {
+			src32[i] = uint32(v)
+			src16[i] = uint16(v)
+		}
+		dst64 := make([]uint64, n)
+		dst32 := make([]uint32, n)
+		dst16 := make([]uint16, n)
+		if got := ByteSwap64(dst64, src64); got != n {
+			t.Fatalf("n=%d: ByteSwap64 = %d", n, got)
+		}
+		ByteSwap32(dst32, src32)
+		ByteSwap16(dst16, src16)
+		for i := range n {
+			if dst64[i] != bits.ReverseBytes64(src64[i]) || dst32[i] != bits.ReverseBytes32(src32[i]) ||
+				dst16[i] != bits.ReverseBytes16(src16[i]) {
+				t.Fatalf("n=%d: ByteSwap[%d] = %#x, %#x, %#x, want %#x, %#x, %#x", n, i, dst64[i], dst32[i], dst16[i],
+					bits.ReverseBytes64(src64[i]), bits.ReverseBytes32(src32[i]), bits.ReverseBytes16(src16[i]))
+			}
+		}
+		// In place, back to the source.
+		ByteSwap64(dst64, dst64)
+		ByteSwap32(dst32, dst32)
+		ByteSwap16(dst16, dst16)
+		if !slices.Equal(dst64, src64) || !slices.Equal(dst32, src32) || !slices.Equal(dst16, src16) {
+			t.Errorf("n=%d: ByteSwap in place does not invert", n)
+		}
+	}
+	if got := ByteSwap32(make([]uint32, 3), []uint32{1, 2, 3, 4, 5}); got != 3 {
+		t.Errorf("ByteSwap32 into a short dst = %d, want 3", got)
+	}
+}
+
+func TestBitReverse(t *testing.T) {
+	r := rand.New(rand.NewPCG(3, 4))
+	for _, n := range byteOrderLengths {
+		src64 := make([]uint64, n)
+		src32 := make([]uint32, n)
+		src16 := make([]uint16, n)
+		for i := range src64 {
+			src64[i] = r.Uint64()
+			src32[i] = uint32(src64[i])
+			src16[i] = uint16(src64[i])
+		}
+		dst64 := make([]uint64, n)
+		dst32 := make([]uint32, n)
+		dst16 := make([]uint16, n)
+		BitReverse64(dst64, src64)
+		BitReverse32(dst32, src32)
+		BitReverse16(dst16, src16)
+		for i := range n {
+			if dst64[i] != bits.Reverse64(src64[i]) || dst32[i] != bits.Reverse32(src32[i]) ||
+				dst16[i] != bits.Reverse16(src16[i]) {
+				t.Fatalf("n=%d: BitReverse[%d] = %#x, %#x, %#x, want %#x, %#x, %#x", n, i, dst64[i], dst32[i], dst16[i],
+					bits.Reverse64(src64[i]), bits.Reverse32(src32[i]), bits.Reverse16(src16[i]))
+			}
+		}
+	}
+	// Bytes at every alignment, for the unaligned head and tail.
+	buf := make([]uint8, 300)
+	for i := range buf {
+		buf[i] = uint8(r.Uint32())
+	}
+	for off := range 9 {
+		src := buf[off:]
+		dst := make([]uint8, len(src)+off)[off:]
+		BitReverse8(dst, src)
+		for i, v := range src {
+			if dst[i] != bits.Reverse8(v) {
+				t.Fatalf("offset %d: BitReverse8[%d] = %#x, want %#x", off, i, dst[i], bits.Reverse8(v))
+			}
+		}
+	}
+}
+
+func TestBitReverseIndices(t *testing.T) {
+	const k = 4
+	idx := make([]uint32, 1<<k)
+	for i := range idx {
+		idx[i] = uint32(i)
+	}
+	BitReverse32(idx, idx)
+	for i := range idx {
+		idx[i] >>= 32 - k
+	}
+	want := []uint32{0, 8, 4, 12, 2, 10, 6, 14, 1, 9, 5, 13, 3, 11, 7, 15}
+	if !slices.Equal(idx, want) {
+		t.Errorf("bit-reversed indices = %v, want %v", idx, want)
+	}
+}
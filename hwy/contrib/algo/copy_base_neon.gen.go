@@ -7,6 +7,7 @@ package algo
 import (
 	"unsafe"
 
+	"github.com/ajroetker/go-highway/hwy"
 	"github.com/ajroetker/go-highway/hwy/asm"
 )
 
@@ -23,7 +24,7 @@ func BaseCopyIf_neon(src []float32, dst []float32, pred func(asm.Float32x4) asm.
 		v := asm.LoadFloat32x4((*[4]float32)(unsafe.Pointer(&src[i])))
 		mask := pred(v)
 		remaining := dstLen - dstIdx
-		count := min(asm.CompressStore(v, mask, dst[dstIdx:]), remaining)
+		count := min(hwy.CompressStore_NEON_F32x4(v, mask, dst[dstIdx:]), remaining)
 		dstIdx += count
 		if dstIdx >= dstLen {
 			break
@@ -37,7 +38,7 @@ func BaseCopyIf_neon(src []float32, dst []float32, pred func(asm.Float32x4) asm.
 		tailMask := asm.FirstN(remaining)
 		mask = mask.And(tailMask)
 		dstRemaining := dstLen - dstIdx
-		count := min(asm.CompressStore(v, mask, dst[dstIdx:]), dstRemaining)
+		count := min(hwy.CompressStore_NEON_F32x4(v, mask, dst[dstIdx:]), dstRemaining)
 		dstIdx += count
 	}
 	return dstIdx
@@ -56,7 +57,7 @@ func BaseCopyIf_neon_Float64(src []float64, dst []float64, pred func(asm.Float64
 		v := asm.LoadFloat64x2((*[2]float64)(unsafe.Pointer(&src[i])))
 		mask := pred(v)
 		remaining := dstLen - dstIdx
-		count := min(asm.CompressStoreFloat64(v, mask, dst[dstIdx:]), remaining)
+		count := min(hwy.CompressStore_NEON_F64x2(v, mask, dst[dstIdx:]), remaining)
 		dstIdx += count
 		if dstIdx >= dstLen {
 			break
@@ -70,7 +71,7 @@ func BaseCopyIf_neon_Float64(src []float64, dst []float64, pred func(asm.Float64
 		tailMask := asm.FirstNFloat64(remaining)
 		mask = mask.And(tailMask)
 		dstRemaining := dstLen - dstIdx
-		count := min(asm.CompressStoreFloat64(v, mask, dst[dstIdx:]), dstRemaining)
+		count := min(hwy.CompressStore_NEON_F64x2(v, mask, dst[dstIdx:]), dstRemaining)
 		dstIdx += count
 	}
 	return dstIdx
@@ -89,7 +90,7 @@ func BaseCopyIf_neon_Int32(src []int32, dst []int32, pred func(asm.Int32x4) asm.
 		v := asm.LoadInt32x4((*[4]int32)(unsafe.Pointer(&src[i])))
 		mask := pred(v)
 		remaining := dstLen - dstIdx
-		count := min(asm.CompressStoreInt32(v, mask, dst[dstIdx:]), remaining)
+		count := min(hwy.CompressStore_NEON_I32x4(v, mask, dst[dstIdx:]), remaining)
 		dstIdx += count
 		if dstIdx >= dstLen {
 			break
@@ -103,7 +104,7 @@ func BaseCopyIf_neon_Int32(src []int32, dst []int32, pred func(asm.Int32x4) asm.
 		tailMask := asm.FirstN(remaining)
 		mask = mask.And(tailMask)
 		dstRemaining := dstLen - dstIdx
-		count := min(asm.CompressStoreInt32(v, mask, dst[dstIdx:]), dstRemaining)
+		count := min(hwy.CompressStore_NEON_I32x4(v, mask, dst[dstIdx:]), dstRemaining)
 		dstIdx += count
 	}
 	return dstIdx
@@ -122,7 +123,7 @@ func BaseCopyIf_neon_Int64(src []int64, dst []int64, pred func(asm.Int64x2) asm.
 		v := asm.LoadInt64x2((*[2]int64)(unsafe.Pointer(&src[i])))
 		mask := pred(v)
 		remaining := dstLen - dstIdx
-		count := min(asm.CompressStoreInt64(v, mask, dst[dstIdx:]), remaining)
+		count := min(hwy.CompressStore_NEON_I64x2(v, mask, dst[dstIdx:]), remaining)
 		dstIdx += count
 		if dstIdx >= dstLen {
 			break
@@ -136,7 +137,7 @@ func BaseCopyIf_neon_Int64(src []int64, dst []int64, pred func(asm.Int64x2) asm.
 		tailMask := asm.FirstNInt64(remaining)
 		mask = mask.And(tailMask)
 		dstRemaining := dstLen - dstIdx
-		count := min(asm.CompressStoreInt64(v, mask, dst[dstIdx:]), dstRemaining)
+		count := min(hwy.CompressStore_NEON_I64x2(v, mask, dst[dstIdx:]), dstRemaining)
 		dstIdx += count
 	}
 	return dstIdx
@@ -155,7 +156,7 @@ func BaseCopyIf_neon_Uint32(src []uint32, dst []uint32, pred func(asm.Uint32x4)
 		v := asm.LoadUint32x4((*[4]uint32)(unsafe.Pointer(&src[i])))
 		mask := pred(v)
 		remaining := dstLen - dstIdx
-		count := min(asm.CompressStoreUint32(v, mask, dst[dstIdx:]), remaining)
+		count := min(hwy.CompressStore_NEON_Uint32x4(v, mask, dst[dstIdx:]), remaining)
 		dstIdx += count
 		if dstIdx >= dstLen {
 			break
@@ -169,7 +170,7 @@ func BaseCopyIf_neon_Uint32(src []uint32, dst []uint32, pred func(asm.Uint32x4)
 		tailMask := asm.FirstN(remaining)
 		mask = mask.And(tailMask)
 		dstRemaining := dstLen - dstIdx
-		count := min(asm.CompressStoreUint32(v, mask, dst[dstIdx:]), dstRemaining)
+		count := min(hwy.CompressStore_NEON_Uint32x4(v, mask, dst[dstIdx:]), dstRemaining)
 		dstIdx += count
 	}
 	return dstIdx
@@ -188,7 +189,7 @@ func BaseCopyIf_neon_Uint64(src []uint64, dst []uint64, pred func(asm.Uint64x2)
 		v := asm.LoadUint64x2((*[2]uint64)(unsafe.Pointer(&src[i])))
 		mask := pred(v)
 		remaining := dstLen - dstIdx
-		count := min(asm.CompressStoreUint64(v, mask, dst[dstIdx:]), remaining)
+		count := min(hwy.CompressStore_NEON_Uint64x2(v, mask, dst[dstIdx:]), remaining)
 		dstIdx += count
 		if dstIdx >= dstLen {
 			break
@@ -202,7 +203,7 @@ func BaseCopyIf_neon_Uint64(src []uint64, dst []uint64, pred func(asm.Uint64x2)
 		tailMask := asm.FirstNInt64(remaining)
 		mask = mask.And(tailMask)
 		dstRemaining := dstLen - dstIdx
-		count := min(asm.CompressStoreUint64(v, mask, dst[dstIdx:]), dstRemaining)
+		count := min(hwy.CompressStore_NEON_Uint64x2(v, mask, dst[dstIdx:]), dstRemaining)
 		dstIdx += count
 	}
 	return dstIdx
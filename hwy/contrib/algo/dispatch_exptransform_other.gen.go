@@ -185,3 +185,36 @@ func initExptransformFallback() {
 	ErfTransformFloat32 = BaseErfTransform_fallback
 	ErfTransformFloat64 = BaseErfTransform_fallback_Float64
 }
+
+func init() {
+	hwy.RegisterKernel("algo.ExpTransformFloat16", &ExpTransformFloat16)
+	hwy.RegisterKernel("algo.ExpTransformBFloat16", &ExpTransformBFloat16)
+	hwy.RegisterKernel("algo.ExpTransformFloat32", &ExpTransformFloat32)
+	hwy.RegisterKernel("algo.ExpTransformFloat64", &ExpTransformFloat64)
+	hwy.RegisterKernel("algo.LogTransformFloat16", &LogTransformFloat16)
+	hwy.RegisterKernel("algo.LogTransformBFloat16", &LogTransformBFloat16)
+	hwy.RegisterKernel("algo.LogTransformFloat32", &LogTransformFloat32)
+	hwy.RegisterKernel("algo.LogTransformFloat64", &LogTransformFloat64)
+	hwy.RegisterKernel("algo.SinTransformFloat16", &SinTransformFloat16)
+	hwy.RegisterKernel("algo.SinTransformBFloat16", &SinTransformBFloat16)
+	hwy.RegisterKernel("algo.SinTransformFloat32", &SinTransformFloat32)
+	hwy.RegisterKernel("algo.SinTransformFloat64", &SinTransformFloat64)
+	hwy.RegisterKernel("algo.CosTransformFloat16", &CosTransformFloat16)
+	hwy.RegisterKernel("algo.CosTransformBFloat16", &CosTransformBFloat16)
+	hwy.RegisterKernel("algo.CosTransformFloat32", &CosTransformFloat32)
+	hwy.RegisterKernel("algo.CosTransformFloat64", &CosTransformFloat64)
+	hwy.RegisterKernel("algo.TanhTransformFloat16", &TanhTransformFloat16)
+	hwy.RegisterKernel("algo.TanhTransformBFloat16", &TanhTransformBFloat16)
+	hwy.RegisterKernel("algo.TanhTransformFloat32", &TanhTransformFloat32)
+	hwy.RegisterKernel("algo.TanhTransformFloat64", &TanhTransformFloat64)
+	hwy.RegisterKernel("algo.SigmoidTransformFloat16", &SigmoidTransformFloat16)
+	hwy.RegisterKernel("algo.SigmoidTransformBFloat16", &SigmoidTransformBFloat16)
+	hwy.RegisterKernel("algo.SigmoidTransformFloat32", &SigmoidTransformFloat32)
+	hwy.RegisterKernel("algo.SigmoidTransformFloat64", &SigmoidTransformFloat64)
+	hwy.RegisterKernel("algo.ErfTransformFloat16", &ErfTransformFloat16)
+	hwy.RegisterKernel("algo.ErfTransformBFloat16", &ErfTransformBFloat16)
+	hwy.RegisterKernel("algo.ErfTransformFloat32", &ErfTransformFloat32)
+	hwy.RegisterKernel("algo.ErfTransformFloat64", &ErfTransformFloat64)
+	hwyKernels := []string{"algo.ExpTransformFloat16", "algo.ExpTransformBFloat16", "algo.ExpTransformFloat32", "algo.ExpTransformFloat64", "algo.LogTransformFloat16", "algo.LogTransformBFloat16", "algo.LogTransformFloat32", "algo.LogTransformFloat64", "algo.SinTransformFloat16", "algo.SinTransformBFloat16", "algo.SinTransformFloat32", "algo.SinTransformFloat64", "algo.CosTransformFloat16", "algo.CosTransformBFloat16", "algo.CosTransformFloat32", "algo.CosTransformFloat64", "algo.TanhTransformFloat16", "algo.TanhTransformBFloat16", "algo.TanhTransformFloat32", "algo.TanhTransformFloat64", "algo.SigmoidTransformFloat16", "algo.SigmoidTransformBFloat16", "algo.SigmoidTransformFloat32", "algo.SigmoidTransformFloat64", "algo.ErfTransformFloat16", "algo.ErfTransformBFloat16", "algo.ErfTransformFloat32", "algo.ErfTransformFloat64"}
+	hwy.RegisterKernelTarget(hwy.DispatchScalar, initExptransformFallback, hwyKernels...)
+}
@@ -56,6 +56,28 @@
 //	    return output
 //	}
 //
+// # Byte Order
+//
+// ByteSwap16, ByteSwap32 and ByteSwap64 reverse the bytes of each element
+// of a slice, for converting between little and big endian, with a byte
+// shuffle (PSHUFB on x86, TBL on ARM). BitReverse8 to BitReverse64
+// reverse the bits of each element, for bit-reversed FFT indices, by also
+// reversing the bits within each byte with shifts and masks.
+//
+// # Scans
+//
+// ScanMax and ScanMin replace each element of a float32 or float64 slice
+// by the running maximum or minimum of the elements up to it, as used for
+// drawdowns, signal envelopes and dynamic programming. Each vector is
+// scanned in log2(lanes) shift-and-combine steps and then combined with
+// the carry from the previous vector.
+//
+// CumProd computes running products the same way. LogCumSumExp computes
+// log(exp(x[0]) + ... + exp(x[i])), the prefix sum of log-space
+// probabilities used by HMM and CTC forward recursions, combining pairs as
+// max(a, b) + log(1 + exp(-|a-b|)) so it neither overflows nor underflows.
+// LogAddExp applies the same combination element-wise to two slices.
+//
 // # Build Requirements
 //
 // The SIMD implementations require:
@@ -292,3 +292,56 @@ func initFindFallback() {
 	CountIfUint32 = BaseCountIf_fallback_Uint32
 	CountIfUint64 = BaseCountIf_fallback_Uint64
 }
+
+func init() {
+	hwy.RegisterKernel("algo.FindFloat32", &FindFloat32)
+	hwy.RegisterKernel("algo.FindFloat64", &FindFloat64)
+	hwy.RegisterKernel("algo.FindInt32", &FindInt32)
+	hwy.RegisterKernel("algo.FindInt64", &FindInt64)
+	hwy.RegisterKernel("algo.FindUint32", &FindUint32)
+	hwy.RegisterKernel("algo.FindUint64", &FindUint64)
+	hwy.RegisterKernel("algo.CountFloat32", &CountFloat32)
+	hwy.RegisterKernel("algo.CountFloat64", &CountFloat64)
+	hwy.RegisterKernel("algo.CountInt32", &CountInt32)
+	hwy.RegisterKernel("algo.CountInt64", &CountInt64)
+	hwy.RegisterKernel("algo.CountUint32", &CountUint32)
+	hwy.RegisterKernel("algo.CountUint64", &CountUint64)
+	hwy.RegisterKernel("algo.ContainsFloat32", &ContainsFloat32)
+	hwy.RegisterKernel("algo.ContainsFloat64", &ContainsFloat64)
+	hwy.RegisterKernel("algo.ContainsInt32", &ContainsInt32)
+	hwy.RegisterKernel("algo.ContainsInt64", &ContainsInt64)
+	hwy.RegisterKernel("algo.ContainsUint32", &ContainsUint32)
+	hwy.RegisterKernel("algo.ContainsUint64", &ContainsUint64)
+	hwy.RegisterKernel("algo.AllFloat32", &AllFloat32)
+	hwy.RegisterKernel("algo.AllFloat64", &AllFloat64)
+	hwy.RegisterKernel("algo.AllInt32", &AllInt32)
+	hwy.RegisterKernel("algo.AllInt64", &AllInt64)
+	hwy.RegisterKernel("algo.AllUint32", &AllUint32)
+	hwy.RegisterKernel("algo.AllUint64", &AllUint64)
+	hwy.RegisterKernel("algo.AnyFloat32", &AnyFloat32)
+	hwy.RegisterKernel("algo.AnyFloat64", &AnyFloat64)
+	hwy.RegisterKernel("algo.AnyInt32", &AnyInt32)
+	hwy.RegisterKernel("algo.AnyInt64", &AnyInt64)
+	hwy.RegisterKernel("algo.AnyUint32", &AnyUint32)
+	hwy.RegisterKernel("algo.AnyUint64", &AnyUint64)
+	hwy.RegisterKernel("algo.NoneFloat32", &NoneFloat32)
+	hwy.RegisterKernel("algo.NoneFloat64", &NoneFloat64)
+	hwy.RegisterKernel("algo.NoneInt32", &NoneInt32)
+	hwy.RegisterKernel("algo.NoneInt64", &NoneInt64)
+	hwy.RegisterKernel("algo.NoneUint32", &NoneUint32)
+	hwy.RegisterKernel("algo.NoneUint64", &NoneUint64)
+	hwy.RegisterKernel("algo.FindIfFloat32", &FindIfFloat32)
+	hwy.RegisterKernel("algo.FindIfFloat64", &FindIfFloat64)
+	hwy.RegisterKernel("algo.FindIfInt32", &FindIfInt32)
+	hwy.RegisterKernel("algo.FindIfInt64", &FindIfInt64)
+	hwy.RegisterKernel("algo.FindIfUint32", &FindIfUint32)
+	hwy.RegisterKernel("algo.FindIfUint64", &FindIfUint64)
+	hwy.RegisterKernel("algo.CountIfFloat32", &CountIfFloat32)
+	hwy.RegisterKernel("algo.CountIfFloat64", &CountIfFloat64)
+	hwy.RegisterKernel("algo.CountIfInt32", &CountIfInt32)
+	hwy.RegisterKernel("algo.CountIfInt64", &CountIfInt64)
+	hwy.RegisterKernel("algo.CountIfUint32", &CountIfUint32)
+	hwy.RegisterKernel("algo.CountIfUint64", &CountIfUint64)
+	hwyKernels := []string{"algo.FindFloat32", "algo.FindFloat64", "algo.FindInt32", "algo.FindInt64", "algo.FindUint32", "algo.FindUint64", "algo.CountFloat32", "algo.CountFloat64", "algo.CountInt32", "algo.CountInt64", "algo.CountUint32", "algo.CountUint64", "algo.ContainsFloat32", "algo.ContainsFloat64", "algo.ContainsInt32", "algo.ContainsInt64", "algo.ContainsUint32", "algo.ContainsUint64", "algo.AllFloat32", "algo.AllFloat64", "algo.AllInt32", "algo.AllInt64", "algo.AllUint32", "algo.AllUint64", "algo.AnyFloat32", "algo.AnyFloat64", "algo.AnyInt32", "algo.AnyInt64", "algo.AnyUint32", "algo.AnyUint64", "algo.NoneFloat32", "algo.NoneFloat64", "algo.NoneInt32", "algo.NoneInt64", "algo.NoneUint32", "algo.NoneUint64", "algo.FindIfFloat32", "algo.FindIfFloat64", "algo.FindIfInt32", "algo.FindIfInt64", "algo.FindIfUint32", "algo.FindIfUint64", "algo.CountIfFloat32", "algo.CountIfFloat64", "algo.CountIfInt32", "algo.CountIfInt64", "algo.CountIfUint32", "algo.CountIfUint64"}
+	hwy.RegisterKernelTarget(hwy.DispatchScalar, initFindFallback, hwyKernels...)
+}
@@ -119,3 +119,19 @@ func initPrefix_sumFallback() {
 	DeltaDecodeUint32 = BaseDeltaDecode_fallback_Uint32
 	DeltaDecodeUint64 = BaseDeltaDecode_fallback_Uint64
 }
+
+func init() {
+	hwy.RegisterKernel("algo.PrefixSumFloat32", &PrefixSumFloat32)
+	hwy.RegisterKernel("algo.PrefixSumFloat64", &PrefixSumFloat64)
+	hwy.RegisterKernel("algo.PrefixSumInt32", &PrefixSumInt32)
+	hwy.RegisterKernel("algo.PrefixSumInt64", &PrefixSumInt64)
+	hwy.RegisterKernel("algo.PrefixSumUint32", &PrefixSumUint32)
+	hwy.RegisterKernel("algo.PrefixSumUint64", &PrefixSumUint64)
+	hwy.RegisterKernel("algo.DeltaDecodeInt32", &DeltaDecodeInt32)
+	hwy.RegisterKernel("algo.DeltaDecodeInt64", &DeltaDecodeInt64)
+	hwy.RegisterKernel("algo.DeltaDecodeUint32", &DeltaDecodeUint32)
+	hwy.RegisterKernel("algo.DeltaDecodeUint64", &DeltaDecodeUint64)
+	hwyKernels := []string{"algo.PrefixSumFloat32", "algo.PrefixSumFloat64", "algo.PrefixSumInt32", "algo.PrefixSumInt64", "algo.PrefixSumUint32", "algo.PrefixSumUint64", "algo.DeltaDecodeInt32", "algo.DeltaDecodeInt64", "algo.DeltaDecodeUint32", "algo.DeltaDecodeUint64"}
+	hwy.RegisterKernelTarget(hwy.DispatchNEON, initPrefix_sumNEON, hwyKernels...)
+	hwy.RegisterKernelTarget(hwy.DispatchScalar, initPrefix_sumFallback, hwyKernels...)
+}
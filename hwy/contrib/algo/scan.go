@@ -0,0 +1,32 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package algo
+
+import (
+	stdmath "math"
+
+	"github.com/ajroetker/go-highway/hwy"
+)
+
+// logAddExp returns log(exp(a) + exp(b)) without overflow. It is the scalar
+// counterpart of BaseLogAddExpVec, used for the tails of BaseLogCumSumExp
+// and BaseLogAddExp.
+func logAddExp[T hwy.FloatsNative](a, b T) T {
+	hi, lo := max(a, b), min(a, b)
+	if hi == lo {
+		return hi + T(stdmath.Ln2)
+	}
+	return hi + T(stdmath.Log1p(stdmath.Exp(float64(lo-hi))))
+}
@@ -0,0 +1,311 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package algo
+
+import (
+	stdmath "math"
+
+	"github.com/ajroetker/go-highway/hwy"
+	"github.com/ajroetker/go-highway/hwy/contrib/math"
+)
+
+//go:generate go run ../../../cmd/hwygen -input scan_base.go -output . -targets avx2,avx512,neon,fallback -dispatch scan
+
+// BaseScanMax computes the inclusive running maximum in place.
+// Result[i] = max(data[0], data[1], ..., data[i])
+//
+// Running maxima give the peak-to-date series used for drawdowns and upper
+// envelopes. The result for inputs containing NaN is unspecified.
+//
+// Example:
+//
+//	data := []float32{3, 1, 4, 1, 5, 9, 2, 6}
+//	BaseScanMax(data)
+//	// data = [3, 3, 4, 4, 5, 9, 9, 9]
+func BaseScanMax[T hwy.FloatsNative](data []T) {
+	n := len(data)
+	if n == 0 {
+		return
+	}
+
+	lanes := hwy.MaxLanes[T]()
+	carry := data[0]
+	i := 0
+
+	for ; i+lanes <= n; i += lanes {
+		v := BaseScanMaxVec(hwy.Load(data[i:]))
+		v = hwy.Max(v, hwy.Set[T](carry))
+		hwy.Store(v, data[i:])
+		carry = hwy.GetLane(v, lanes-1)
+	}
+
+	for ; i < n; i++ {
+		carry = max(carry, data[i])
+		data[i] = carry
+	}
+}
+
+// BaseScanMin computes the inclusive running minimum in place.
+// Result[i] = min(data[0], data[1], ..., data[i])
+//
+// The result for inputs containing NaN is unspecified.
+//
+// Example:
+//
+//	data := []float32{3, 1, 4, 1, 5, 0, 2, 6}
+//	BaseScanMin(data)
+//	// data = [3, 1, 1, 1, 1, 0, 0, 0]
+func BaseScanMin[T hwy.FloatsNative](data []T) {
+	n := len(data)
+	if n == 0 {
+		return
+	}
+
+	lanes := hwy.MaxLanes[T]()
+	carry := data[0]
+	i := 0
+
+	for ; i+lanes <= n; i += lanes {
+		v := BaseScanMinVec(hwy.Load(data[i:]))
+		v = hwy.Min(v, hwy.Set[T](carry))
+		hwy.Store(v, data[i:])
+		carry = hwy.GetLane(v, lanes-1)
+	}
+
+	for ; i < n; i++ {
+		carry = min(carry, data[i])
+		data[i] = carry
+	}
+}
+
+// BaseScanMaxVec computes the inclusive running maximum within a single
+// vector with the same Hillis-Steele steps as BasePrefixSumVec.
+//
+// SlideUpLanes shifts in zeros, which are not neutral for max, so each
+// step only combines the lanes that received a real value: the mask comes
+// from sliding a vector of ones by the same amount.
+func BaseScanMaxVec[T hwy.FloatsNative](v hwy.Vec[T]) hwy.Vec[T] {
+	n := v.NumLanes()
+	zero := hwy.Zero[T]()
+	one := hwy.Set[T](1)
+
+	if n >= 2 {
+		valid := hwy.Greater(hwy.SlideUpLanes(one, 1), zero)
+		v = hwy.Max(v, hwy.Merge(hwy.SlideUpLanes(v, 1), v, valid))
+	}
+	if n >= 4 {
+		valid := hwy.Greater(hwy.SlideUpLanes(one, 2), zero)
+		v = hwy.Max(v, hwy.Merge(hwy.SlideUpLanes(v, 2), v, valid))
+	}
+	if n >= 8 {
+		valid := hwy.Greater(hwy.SlideUpLanes(one, 4), zero)
+		v = hwy.Max(v, hwy.Merge(hwy.SlideUpLanes(v, 4), v, valid))
+	}
+	if n >= 16 {
+		valid := hwy.Greater(hwy.SlideUpLanes(one, 8), zero)
+		v = hwy.Max(v, hwy.Merge(hwy.SlideUpLanes(v, 8), v, valid))
+	}
+
+	return v
+}
+
+// BaseScanMinVec computes the inclusive running minimum within a single
+// vector. See BaseScanMaxVec.
+func BaseScanMinVec[T hwy.FloatsNative](v hwy.Vec[T]) hwy.Vec[T] {
+	n := v.NumLanes()
+	zero := hwy.Zero[T]()
+	one := hwy.Set[T](1)
+
+	if n >= 2 {
+		valid := hwy.Greater(hwy.SlideUpLanes(one, 1), zero)
+		v = hwy.Min(v, hwy.Merge(hwy.SlideUpLanes(v, 1), v, valid))
+	}
+	if n >= 4 {
+		valid := hwy.Greater(hwy.SlideUpLanes(one, 2), zero)
+		v = hwy.Min(v, hwy.Merge(hwy.SlideUpLanes(v, 2), v, valid))
+	}
+	if n >= 8 {
+		valid := hwy.Greater(hwy.SlideUpLanes(one, 4), zero)
+		v = hwy.Min(v, hwy.Merge(hwy.SlideUpLanes(v, 4), v, valid))
+	}
+	if n >= 16 {
+		valid := hwy.Greater(hwy.SlideUpLanes(one, 8), zero)
+		v = hwy.Min(v, hwy.Merge(hwy.SlideUpLanes(v, 8), v, valid))
+	}
+
+	return v
+}
+
+// BaseCumProd computes the inclusive cumulative product in place.
+// Result[i] = data[0] * data[1] * ... * data[i]
+//
+// The products are associated in a different order than a sequential
+// loop, so results may differ from it in the last bits.
+//
+// Example:
+//
+//	data := []float32{1, 2, 3, 4, 0.5}
+//	BaseCumProd(data)
+//	// data = [1, 2, 6, 24, 12]
+func BaseCumProd[T hwy.FloatsNative](data []T) {
+	n := len(data)
+	if n == 0 {
+		return
+	}
+
+	lanes := hwy.MaxLanes[T]()
+	carry := T(1)
+	i := 0
+
+	for ; i+lanes <= n; i += lanes {
+		v := BaseCumProdVec(hwy.Load(data[i:]))
+		v = hwy.Mul(v, hwy.Set[T](carry))
+		hwy.Store(v, data[i:])
+		carry = hwy.GetLane(v, lanes-1)
+	}
+
+	for ; i < n; i++ {
+		carry *= data[i]
+		data[i] = carry
+	}
+}
+
+// BaseLogCumSumExp computes the inclusive log-cumulative-sum-exp in place.
+// Result[i] = log(exp(data[0]) + exp(data[1]) + ... + exp(data[i]))
+//
+// This is the prefix sum of probabilities kept in log space, as in the
+// forward recursions of HMMs and CTC. Values are combined pairwise as
+// max(a, b) + log(1 + exp(-|a-b|)), so the result neither overflows for
+// large inputs nor underflows to -Inf for very negative ones. -Inf
+// elements (zero probability) are neutral.
+//
+// Example:
+//
+//	data := []float32{0, 0, 1000, float32(math.Inf(-1))}
+//	BaseLogCumSumExp(data)
+//	// data = [0, 0.6931, 1000, 1000]
+func BaseLogCumSumExp[T hwy.FloatsNative](data []T) {
+	n := len(data)
+	if n == 0 {
+		return
+	}
+
+	lanes := hwy.MaxLanes[T]()
+	carry := T(stdmath.Inf(-1))
+	i := 0
+
+	for ; i+lanes <= n; i += lanes {
+		v := BaseLogCumSumExpVec(hwy.Load(data[i:]))
+		v = BaseLogAddExpVec(v, hwy.Set[T](carry))
+		hwy.Store(v, data[i:])
+		carry = hwy.GetLane(v, lanes-1)
+	}
+
+	for ; i < n; i++ {
+		carry = logAddExp(carry, data[i])
+		data[i] = carry
+	}
+}
+
+// BaseLogAddExp computes dst[i] = log(exp(a[i]) + exp(b[i])) without
+// overflow, for adding probabilities held in log space element by element,
+// as in the forward recursion of CTC. dst may alias a or b.
+//
+// a, b and dst must have the same length.
+func BaseLogAddExp[T hwy.FloatsNative](a, b, dst []T) {
+	n := len(dst)
+	lanes := hwy.MaxLanes[T]()
+	i := 0
+
+	for ; i+lanes <= n; i += lanes {
+		va := hwy.Load(a[i:])
+		vb := hwy.Load(b[i:])
+		hwy.Store(BaseLogAddExpVec(va, vb), dst[i:])
+	}
+
+	for ; i < n; i++ {
+		dst[i] = logAddExp(a[i], b[i])
+	}
+}
+
+// BaseCumProdVec computes the inclusive cumulative product within a single
+// vector. The lanes SlideUpLanes fills with zeros are replaced by ones, the
+// neutral element of the product; see BaseScanMaxVec.
+func BaseCumProdVec[T hwy.FloatsNative](v hwy.Vec[T]) hwy.Vec[T] {
+	n := v.NumLanes()
+	zero := hwy.Zero[T]()
+	one := hwy.Set[T](1)
+
+	if n >= 2 {
+		valid := hwy.Greater(hwy.SlideUpLanes(one, 1), zero)
+		v = hwy.Mul(v, hwy.Merge(hwy.SlideUpLanes(v, 1), one, valid))
+	}
+	if n >= 4 {
+		valid := hwy.Greater(hwy.SlideUpLanes(one, 2), zero)
+		v = hwy.Mul(v, hwy.Merge(hwy.SlideUpLanes(v, 2), one, valid))
+	}
+	if n >= 8 {
+		valid := hwy.Greater(hwy.SlideUpLanes(one, 4), zero)
+		v = hwy.Mul(v, hwy.Merge(hwy.SlideUpLanes(v, 4), one, valid))
+	}
+	if n >= 16 {
+		valid := hwy.Greater(hwy.SlideUpLanes(one, 8), zero)
+		v = hwy.Mul(v, hwy.Merge(hwy.SlideUpLanes(v, 8), one, valid))
+	}
+
+	return v
+}
+
+// BaseLogCumSumExpVec computes the inclusive log-cumulative-sum-exp within
+// a single vector, combining lanes with BaseLogAddExpVec. The lanes
+// SlideUpLanes fills with zeros are replaced by -Inf.
+func BaseLogCumSumExpVec[T hwy.FloatsNative](v hwy.Vec[T]) hwy.Vec[T] {
+	n := v.NumLanes()
+	zero := hwy.Zero[T]()
+	one := hwy.Set[T](1)
+	negInf := hwy.Set[T](T(stdmath.Inf(-1)))
+
+	if n >= 2 {
+		valid := hwy.Greater(hwy.SlideUpLanes(one, 1), zero)
+		v = BaseLogAddExpVec(v, hwy.Merge(hwy.SlideUpLanes(v, 1), negInf, valid))
+	}
+	if n >= 4 {
+		valid := hwy.Greater(hwy.SlideUpLanes(one, 2), zero)
+		v = BaseLogAddExpVec(v, hwy.Merge(hwy.SlideUpLanes(v, 2), negInf, valid))
+	}
+	if n >= 8 {
+		valid := hwy.Greater(hwy.SlideUpLanes(one, 4), zero)
+		v = BaseLogAddExpVec(v, hwy.Merge(hwy.SlideUpLanes(v, 4), negInf, valid))
+	}
+	if n >= 16 {
+		valid := hwy.Greater(hwy.SlideUpLanes(one, 8), zero)
+		v = BaseLogAddExpVec(v, hwy.Merge(hwy.SlideUpLanes(v, 8), negInf, valid))
+	}
+
+	return v
+}
+
+// BaseLogAddExpVec computes log(exp(a) + exp(b)) lane-wise as
+// max(a, b) + log(1 + exp(min(a, b) - max(a, b))).
+//
+// Equal lanes use a zero difference, which also keeps two -Inf or two +Inf
+// inputs from producing the NaN of Inf - Inf.
+func BaseLogAddExpVec[T hwy.FloatsNative](a, b hwy.Vec[T]) hwy.Vec[T] {
+	one := hwy.Set[T](1)
+	hi := hwy.Max(a, b)
+	lo := hwy.Min(a, b)
+	d := hwy.Merge(hwy.Zero[T](), hwy.Sub(lo, hi), hwy.Equal(lo, hi))
+	return hwy.Add(hi, math.BaseLogVec(hwy.Add(one, math.BaseExpVec(d))))
+}
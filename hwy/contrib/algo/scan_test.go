@@ -0,0 +1,187 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package algo
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+)
+
+func TestScanMaxMin(t *testing.T) {
+	rng := rand.New(rand.NewPCG(1, 2))
+	for n := range 70 {
+		src := make([]float64, n)
+		for i := range src {
+			src[i] = rng.NormFloat64() * 100
+		}
+		if n > 3 {
+			src[3] = math.Inf(-1)
+		}
+
+		wantMax := make([]float64, n)
+		wantMin := make([]float64, n)
+		for i, x := range src {
+			wantMax[i], wantMin[i] = x, x
+			if i > 0 {
+				wantMax[i] = max(wantMax[i-1], x)
+				wantMin[i] = min(wantMin[i-1], x)
+			}
+		}
+
+		got64 := append([]float64(nil), src...)
+		ScanMax(got64)
+		got32 := make([]float32, n)
+		for i, x := range src {
+			got32[i] = float32(x)
+		}
+		ScanMax(got32)
+		for i := range n {
+			if got64[i] != wantMax[i] || got32[i] != float32(wantMax[i]) {
+				t.Fatalf("n=%d: ScanMax[%d] = %v (float32 %v), want %v", n, i, got64[i], got32[i], wantMax[i])
+			}
+		}
+
+		copy(got64, src)
+		ScanMin(got64)
+		for i, x := range src {
+			got32[i] = float32(x)
+		}
+		ScanMin(got32)
+		for i := range n {
+			if got64[i] != wantMin[i] || got32[i] != float32(wantMin[i]) {
+				t.Fatalf("n=%d: ScanMin[%d] = %v (float32 %v), want %v", n, i, got64[i], got32[i], wantMin[i])
+			}
+		}
+	}
+}
+
+func TestScanMaxVec(t *testing.T) {
+	// All-negative input checks that the zeros shifted in by SlideUpLanes
+	// never leak into the result.
+	data := make([]float32, 64)
+	for i := range data {
+		data[i] = -float32(100 + (i*37)%50)
+	}
+	want := append([]float32(nil), data...)
+	for i := 1; i < len(want); i++ {
+		want[i] = max(want[i-1], want[i])
+	}
+	BaseScanMax(data)
+	for i := range data {
+		if data[i] != want[i] {
+			t.Fatalf("BaseScanMax[%d] = %v, want %v", i, data[i], want[i])
+		}
+	}
+}
+
+func TestCumProd(t *testing.T) {
+	rng := rand.New(rand.NewPCG(3, 4))
+	for n := range 70 {
+		src := make([]float64, n)
+		for i := range src {
+			src[i] = 0.5 + rng.Float64()
+		}
+		want := make([]float64, n)
+		p := 1.0
+		for i, x := range src {
+			p *= x
+			want[i] = p
+		}
+
+		got64 := append([]float64(nil), src...)
+		CumProd(got64)
+		got32 := make([]float32, n)
+		for i, x := range src {
+			got32[i] = float32(x)
+		}
+		CumProd(got32)
+		for i := range n {
+			if rel := math.Abs(got64[i]-want[i]) / want[i]; rel > 1e-13 {
+				t.Fatalf("n=%d: CumProd[%d] = %v, want %v", n, i, got64[i], want[i])
+			}
+			if rel := math.Abs(float64(got32[i])-want[i]) / want[i]; rel > 1e-5 {
+				t.Fatalf("n=%d: CumProd float32[%d] = %v, want %v", n, i, got32[i], want[i])
+			}
+		}
+	}
+}
+
+func TestLogCumSumExp(t *testing.T) {
+	rng := rand.New(rand.NewPCG(5, 6))
+	for n := range 70 {
+		src := make([]float64, n)
+		for i := range src {
+			src[i] = rng.NormFloat64() * 10
+		}
+		if n > 20 {
+			// Magnitudes whose exp over- or underflows, and zero probability.
+			src[2] = 1000
+			src[9] = math.Inf(-1)
+			src[17] = -1000
+		}
+
+		want := make([]float64, n)
+		acc := math.Inf(-1)
+		for i, x := range src {
+			m := max(acc, x)
+			if !math.IsInf(m, -1) {
+				acc = m + math.Log(math.Exp(acc-m)+math.Exp(x-m))
+			}
+			want[i] = acc
+		}
+
+		got64 := append([]float64(nil), src...)
+		LogCumSumExp(got64)
+		got32 := make([]float32, n)
+		for i, x := range src {
+			got32[i] = float32(x)
+		}
+		LogCumSumExp(got32)
+		for i := range n {
+			tol := 1e-6 * max(1, math.Abs(want[i]))
+			if math.Abs(got64[i]-want[i]) > tol {
+				t.Fatalf("n=%d: LogCumSumExp[%d] = %v, want %v", n, i, got64[i], want[i])
+			}
+			if math.Abs(float64(got32[i])-want[i]) > 100*tol {
+				t.Fatalf("n=%d: LogCumSumExp float32[%d] = %v, want %v", n, i, got32[i], want[i])
+			}
+		}
+	}
+
+	data := []float32{float32(math.Inf(-1)), float32(math.Inf(-1)), 0, 0}
+	LogCumSumExp(data)
+	if !math.IsInf(float64(data[0]), -1) || !math.IsInf(float64(data[1]), -1) ||
+		data[2] != 0 || math.Abs(float64(data[3])-math.Ln2) > 1e-6 {
+		t.Errorf("LogCumSumExp with leading -Inf = %v", data)
+	}
+}
+
+func TestLogAddExp(t *testing.T) {
+	a := []float64{0, 1000, -1000, math.Inf(-1), math.Inf(-1), math.Inf(1), 3, -2, 5, 0.5, -7}
+	b := []float64{0, 999, -1001, 2, math.Inf(-1), math.Inf(1), -4, -2, 7, 1.5, 1}
+	dst := make([]float64, len(a))
+	LogAddExp(a, b, dst)
+	for i := range a {
+		hi, lo := max(a[i], b[i]), min(a[i], b[i])
+		want := hi + math.Ln2
+		if hi != lo {
+			want = hi + math.Log1p(math.Exp(lo-hi))
+		}
+		if dst[i] != want && math.Abs(dst[i]-want) > 1e-6*max(1, math.Abs(want)) {
+			t.Errorf("LogAddExp(%v, %v) = %v, want %v", a[i], b[i], dst[i], want)
+		}
+	}
+}
@@ -0,0 +1,35 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package algo
+
+import (
+	"math"
+	"testing"
+
+	hwytest "github.com/ajroetker/go-highway/hwy/testing"
+)
+
+// These run on every target, including the fallback, and bound the error
+// in ULP over ranges where the outputs are finite and normal.
+
+func TestExpTransformULP(t *testing.T) {
+	in := append([]float32{0, 1, -1, 0.5}, hwytest.RandomValues[float32](4096, -80, 80, 1)...)
+	hwytest.CheckUnary(t, "ExpTransform", ExpTransform[float32], math.Exp, in, 4)
+}
+
+func TestLogTransformULP(t *testing.T) {
+	in := append([]float32{1, 2, 0.5, math.E}, hwytest.RandomValues[float32](4096, 1e-3, 1e3, 1)...)
+	hwytest.CheckUnary(t, "LogTransform", LogTransform[float32], math.Log, in, 4)
+}
@@ -0,0 +1,56 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audio
+
+//go:generate go run ../../../cmd/hwygen -input audio_base.go -output . -targets avx2,avx512,neon,fallback -dispatch audio
+
+import (
+	stdmath "math"
+
+	"github.com/ajroetker/go-highway/hwy"
+	"github.com/ajroetker/go-highway/hwy/contrib/math"
+)
+
+// BasePower stores the squared magnitudes re² + im² of a spectrum in dst.
+// re, im and dst must have the same length.
+func BasePower[T hwy.FloatsNative](re, im, dst []T) {
+	n := len(dst)
+	lanes := hwy.Zero[T]().NumLanes()
+	i := 0
+	for ; i+lanes <= n; i += lanes {
+		r := hwy.Load(re[i:])
+		m := hwy.Load(im[i:])
+		hwy.Store(hwy.Add(hwy.Mul(r, r), hwy.Mul(m, m)), dst[i:])
+	}
+	for ; i < n; i++ {
+		dst[i] = re[i]*re[i] + im[i]*im[i]
+	}
+}
+
+// BaseLogMel replaces each value of x with log10(max(x, floor)), the
+// log compression of mel spectrograms. floor must be positive.
+func BaseLogMel[T hwy.FloatsNative](x []T, floor T) {
+	n := len(x)
+	vFloor := hwy.Set(floor)
+	lanes := hwy.Zero[T]().NumLanes()
+	i := 0
+	for ; i+lanes <= n; i += lanes {
+		v := hwy.Max(hwy.Load(x[i:]), vFloor)
+		hwy.Store(math.BaseLog10Vec(v), x[i:])
+	}
+	for ; i < n; i++ {
+		x[i] = T(stdmath.Log10(float64(max(x[i], floor))))
+	}
+}
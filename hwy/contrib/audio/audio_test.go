@@ -0,0 +1,174 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audio
+
+import (
+	stdmath "math"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+func randomSignal(n int, seed uint64) []float32 {
+	r := rand.New(rand.NewPCG(seed, 0))
+	x := make([]float32, n)
+	for i := range x {
+		x[i] = float32(r.Float64()*2 - 1)
+	}
+	return x
+}
+
+func TestPower(t *testing.T) {
+	for _, n := range []int{0, 1, 7, 16, 33, 257} {
+		re, im := randomSignal(n, 1), randomSignal(n, 2)
+		got := make([]float32, n)
+		Power(re, im, got)
+		for i := range n {
+			if want := re[i]*re[i] + im[i]*im[i]; stdmath.Abs(float64(got[i]-want)) > 1e-6 {
+				t.Errorf("n=%d: Power[%d] = %g, want %g", n, i, got[i], want)
+			}
+		}
+	}
+}
+
+func TestLogMel(t *testing.T) {
+	for _, n := range []int{0, 1, 7, 16, 33, 257} {
+		x := randomSignal(n, 3)
+		for i := range x {
+			x[i] = x[i] * x[i] * 100 // [0, 100], some below the floor
+		}
+		if n > 0 {
+			x[0] = 0
+		}
+		orig := slices.Clone(x)
+		LogMel(x, float32(1e-3))
+		for i := range n {
+			want := stdmath.Log10(max(float64(orig[i]), 1e-3))
+			if stdmath.Abs(float64(x[i])-want) > 1e-5 {
+				t.Errorf("n=%d: LogMel(%g) = %g, want %g", n, orig[i], x[i], want)
+			}
+		}
+	}
+}
+
+func TestSpectrogram(t *testing.T) {
+	const (
+		winLen = 100
+		nfft   = 128
+		hop    = 37
+	)
+	signal := randomSignal(1000, 4)
+	window := HannWindow[float32](winLen)
+	s := NewSpectrogram(window, nfft, hop)
+	if got, want := s.Frames(len(signal)), 1+(1000-winLen)/hop; got != want {
+		t.Fatalf("Frames = %d, want %d", got, want)
+	}
+	dst := make([]float32, s.Frames(len(signal))*s.Bins())
+	frames := s.Compute(signal, dst)
+
+	for f := range frames {
+		for k := range s.Bins() {
+			var re, im float64
+			for j := range winLen {
+				v := float64(signal[f*hop+j]) * float64(window[j])
+				sin, cos := stdmath.Sincos(-2 * stdmath.Pi * float64(j*k) / nfft)
+				re += v * cos
+				im += v * sin
+			}
+			want := re*re + im*im
+			if got := float64(dst[f*s.Bins()+k]); stdmath.Abs(got-want) > 1e-4*max(1, want) {
+				t.Errorf("frame %d bin %d: got %g, want %g", f, k, got, want)
+			}
+		}
+	}
+}
+
+func TestReflectPad(t *testing.T) {
+	got := ReflectPad([]float32{1, 2, 3, 4}, 2)
+	if want := []float32{3, 2, 1, 2, 3, 4, 3, 2}; !slices.Equal(got, want) {
+		t.Errorf("ReflectPad = %v, want %v", got, want)
+	}
+}
+
+func TestMelScale(t *testing.T) {
+	for _, tc := range []struct{ hz, mel float64 }{
+		{0, 0}, {500, 7.5}, {1000, 15}, {6400, 42},
+	} {
+		if got := hzToMel(tc.hz); stdmath.Abs(got-tc.mel) > 1e-9 {
+			t.Errorf("hzToMel(%g) = %g, want %g", tc.hz, got, tc.mel)
+		}
+		if got := melToHz(tc.mel); stdmath.Abs(got-tc.hz) > 1e-6 {
+			t.Errorf("melToHz(%g) = %g, want %g", tc.mel, got, tc.hz)
+		}
+	}
+}
+
+func TestMelFilterbank(t *testing.T) {
+	const (
+		nfft       = 8192
+		sampleRate = 16000
+	)
+	f := NewMelFilterbank[float32](40, nfft, sampleRate, 0, sampleRate/2)
+	if f.NumMels() != 40 || f.Bins() != nfft/2+1 {
+		t.Fatalf("NumMels, Bins = %d, %d, want 40, %d", f.NumMels(), f.Bins(), nfft/2+1)
+	}
+
+	// Filters are normalized to unit area.
+	df := float64(sampleRate) / nfft
+	for m, w := range f.weights {
+		var area float64
+		for _, v := range w {
+			area += float64(v) * df
+		}
+		if stdmath.Abs(area-1) > 0.02 {
+			t.Errorf("filter %d: area %g, want 1", m, area)
+		}
+	}
+
+	// Apply matches the dense projection.
+	power := randomSignal(3*f.Bins(), 5)
+	for i := range power {
+		power[i] *= power[i]
+	}
+	got := make([]float32, 3*f.NumMels())
+	if frames := f.Apply(power, got); frames != 3 {
+		t.Fatalf("Apply returned %d frames, want 3", frames)
+	}
+	for frame := range 3 {
+		for m, w := range f.weights {
+			var want float64
+			for i, v := range w {
+				want += float64(v) * float64(power[frame*f.Bins()+f.starts[m]+i])
+			}
+			if g := float64(got[frame*f.NumMels()+m]); stdmath.Abs(g-want) > 1e-4*max(1, want) {
+				t.Errorf("frame %d mel %d: got %g, want %g", frame, m, g, want)
+			}
+		}
+	}
+}
+
+func BenchmarkLogMelSpectrogram(b *testing.B) {
+	signal := randomSignal(16000*30, 6) // 30 s at 16 kHz
+	s := NewSpectrogram(HannWindow[float32](400), 512, 160)
+	f := NewMelFilterbank[float32](80, 512, 16000, 0, 8000)
+	power := make([]float32, s.Frames(len(signal))*s.Bins())
+	mel := make([]float32, s.Frames(len(signal))*f.NumMels())
+	b.SetBytes(int64(4 * len(signal)))
+	for b.Loop() {
+		s.Compute(signal, power)
+		f.Apply(power, mel)
+		LogMel(mel, float32(1e-10))
+	}
+}
@@ -0,0 +1,44 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package audio provides SIMD-accelerated building blocks of speech model
+// frontends: power spectrograms, mel filterbanks and log compression.
+//
+// # Log-mel spectrograms
+//
+// A Whisper-style frontend chains the three stages:
+//
+//	window := audio.HannWindow[float32](400)
+//	spec := audio.NewSpectrogram(window, 512, 160)
+//	mel := audio.NewMelFilterbank[float32](80, 512, 16000, 0, 8000)
+//
+//	padded := audio.ReflectPad(samples, 200) // frames centered on hops
+//	power := make([]float32, spec.Frames(len(padded))*spec.Bins())
+//	frames := spec.Compute(padded, power)
+//	logMel := make([]float32, frames*mel.NumMels())
+//	mel.Apply(power, logMel)
+//	audio.LogMel(logMel, float32(1e-10))
+//
+// Spectrogram windows each frame with vec.MulTo, transforms it with an
+// fft.RealPlan and squares the magnitudes with Power. MelFilterbank keeps
+// only the nonzero weights of each triangular filter and projects with
+// vec.Dot. LogMel computes log10(max(x, floor)).
+//
+// # FFT sizes
+//
+// The FFTs of package fft are limited to powers of two, while Whisper uses
+// a 400-point FFT. A 400-sample window zero-padded to a 512-point FFT
+// gives a finer grid of bins, so its spectrograms are close to, but not
+// the same as, Whisper's.
+package audio
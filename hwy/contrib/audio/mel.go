@@ -0,0 +1,123 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audio
+
+import (
+	stdmath "math"
+
+	"github.com/ajroetker/go-highway/hwy"
+	"github.com/ajroetker/go-highway/hwy/contrib/vec"
+)
+
+// MelFilterbank projects power spectra onto triangular mel-frequency
+// filters. It follows librosa.filters.mel with its defaults, which Whisper
+// also uses: the Slaney mel scale, linear below 1 kHz and logarithmic
+// above, and filters normalized to unit area.
+//
+// A MelFilterbank is immutable once created and may be used concurrently.
+type MelFilterbank[T hwy.FloatsNative] struct {
+	bins    int
+	starts  []int // first bin of each filter
+	weights [][]T // nonzero weights of each filter, from its first bin
+}
+
+// NewMelFilterbank returns numMels filters between fmin and fmax Hz, for
+// spectra of nfft/2+1 bins of a signal sampled at sampleRate Hz.
+func NewMelFilterbank[T hwy.FloatsNative](numMels, nfft int, sampleRate, fmin, fmax float64) *MelFilterbank[T] {
+	bins := nfft/2 + 1
+	melMin, melMax := hzToMel(fmin), hzToMel(fmax)
+	edges := make([]float64, numMels+2)
+	for i := range edges {
+		edges[i] = melToHz(melMin + (melMax-melMin)*float64(i)/float64(numMels+1))
+	}
+
+	f := &MelFilterbank[T]{
+		bins:    bins,
+		starts:  make([]int, numMels),
+		weights: make([][]T, numMels),
+	}
+	for m := range numMels {
+		lo, center, hi := edges[m], edges[m+1], edges[m+2]
+		norm := 2 / (hi - lo)
+		var w []T
+		for k := range bins {
+			freq := float64(k) * sampleRate / float64(nfft)
+			weight := max(0, min((freq-lo)/(center-lo), (hi-freq)/(hi-center)))
+			if weight == 0 {
+				if len(w) > 0 {
+					break
+				}
+				continue
+			}
+			if len(w) == 0 {
+				f.starts[m] = k
+			}
+			w = append(w, T(weight*norm))
+		}
+		f.weights[m] = w
+	}
+	return f
+}
+
+// Slaney mel scale: linear below 1 kHz, logarithmic above.
+const (
+	melLinearHz = 200.0 / 3 // Hz per mel below melBreakHz
+	melBreakHz  = 1000.0
+	melBreak    = melBreakHz / melLinearHz
+	melLogStep  = 0.06875177742094912 // ln(6.4) / 27
+)
+
+func hzToMel(hz float64) float64 {
+	if hz < melBreakHz {
+		return hz / melLinearHz
+	}
+	return melBreak + stdmath.Log(hz/melBreakHz)/melLogStep
+}
+
+func melToHz(mel float64) float64 {
+	if mel < melBreak {
+		return mel * melLinearHz
+	}
+	return melBreakHz * stdmath.Exp(melLogStep*(mel-melBreak))
+}
+
+// NumMels returns the number of filters of f.
+func (f *MelFilterbank[T]) NumMels() int { return len(f.weights) }
+
+// Bins returns the number of bins of the spectra f applies to.
+func (f *MelFilterbank[T]) Bins() int { return f.bins }
+
+// Apply projects the power spectra in power, Bins() values per frame, onto
+// the filters, and stores NumMels() values per frame in dst. It returns
+// the number of frames.
+func (f *MelFilterbank[T]) Apply(power, dst []T) int {
+	frames := len(power) / f.bins
+	mels := f.NumMels()
+	if len(dst) < frames*mels {
+		panic("audio: mel destination too short")
+	}
+	for t := range frames {
+		spectrum := power[t*f.bins : (t+1)*f.bins]
+		out := dst[t*mels : (t+1)*mels]
+		for m, w := range f.weights {
+			if len(w) == 0 {
+				out[m] = 0
+				continue
+			}
+			out[m] = vec.Dot(w, spectrum[f.starts[m]:f.starts[m]+len(w)])
+		}
+	}
+	return frames
+}
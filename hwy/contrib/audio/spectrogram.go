@@ -0,0 +1,117 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package audio
+
+import (
+	stdmath "math"
+
+	"github.com/ajroetker/go-highway/hwy"
+	"github.com/ajroetker/go-highway/hwy/contrib/fft"
+	"github.com/ajroetker/go-highway/hwy/contrib/vec"
+)
+
+// HannWindow returns the periodic Hann window of length n,
+// 0.5 - 0.5 cos(2πi/n), as used by short-time Fourier transforms.
+func HannWindow[T hwy.FloatsNative](n int) []T {
+	w := make([]T, n)
+	for i := range w {
+		w[i] = T(0.5 - 0.5*stdmath.Cos(2*stdmath.Pi*float64(i)/float64(n)))
+	}
+	return w
+}
+
+// Spectrogram computes power spectrograms with a short-time Fourier
+// transform: frames of the signal hop samples apart are multiplied by the
+// window, zero-padded to the FFT size and transformed, and the squared
+// magnitudes of their n/2+1 bins are stored.
+//
+// A Spectrogram holds scratch space: it must not be used concurrently.
+type Spectrogram[T hwy.FloatsNative] struct {
+	window []T
+	hop    int
+	plan   *fft.RealPlan[T]
+	frame  []T
+	re, im []T
+}
+
+// NewSpectrogram returns a Spectrogram with the given window, FFT size and
+// hop. nfft must be a power of two of at least 2 and at least len(window),
+// and hop must be positive. It panics otherwise.
+func NewSpectrogram[T hwy.FloatsNative](window []T, nfft, hop int) *Spectrogram[T] {
+	if len(window) == 0 || len(window) > nfft {
+		panic("audio: window must be non-empty and no longer than the FFT")
+	}
+	if hop <= 0 {
+		panic("audio: hop must be positive")
+	}
+	return &Spectrogram[T]{
+		window: window,
+		hop:    hop,
+		plan:   fft.NewRealPlan[T](nfft),
+		frame:  make([]T, nfft),
+		re:     make([]T, nfft/2+1),
+		im:     make([]T, nfft/2+1),
+	}
+}
+
+// Bins returns the number of frequency bins of each frame, nfft/2+1.
+func (s *Spectrogram[T]) Bins() int { return len(s.re) }
+
+// Frames returns the number of frames of a signal of length n: the number
+// of windows that fit in it.
+func (s *Spectrogram[T]) Frames(n int) int {
+	if n < len(s.window) {
+		return 0
+	}
+	return 1 + (n-len(s.window))/s.hop
+}
+
+// Compute stores the power spectrogram of signal in dst, frame after frame:
+// bin k of frame t is dst[t*Bins()+k]. dst must hold at least
+// Frames(len(signal))*Bins() values. It returns the number of frames.
+//
+// Frames start at the first sample: pad the signal first for frames
+// centered on multiples of hop.
+func (s *Spectrogram[T]) Compute(signal, dst []T) int {
+	frames := s.Frames(len(signal))
+	bins := s.Bins()
+	if len(dst) < frames*bins {
+		panic("audio: spectrogram destination too short")
+	}
+	w := len(s.window)
+	for t := range frames {
+		vec.MulTo(s.frame[:w], signal[t*s.hop:t*s.hop+w], s.window)
+		s.plan.Forward(s.frame, s.re, s.im)
+		Power(s.re, s.im, dst[t*bins:(t+1)*bins])
+	}
+	return frames
+}
+
+// ReflectPad returns signal padded with pad samples on each side, mirrored
+// around its first and last samples, like the centered frames of librosa
+// and Whisper. pad must be smaller than len(signal).
+func ReflectPad[T hwy.FloatsNative](signal []T, pad int) []T {
+	n := len(signal)
+	if pad >= n {
+		panic("audio: reflect padding must be shorter than the signal")
+	}
+	out := make([]T, n+2*pad)
+	copy(out[pad:], signal)
+	for i := range pad {
+		out[pad-1-i] = signal[i+1]
+		out[pad+n+i] = signal[n-2-i]
+	}
+	return out
+}
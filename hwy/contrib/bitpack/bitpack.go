@@ -14,6 +14,13 @@
 
 package bitpack
 
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+	"unsafe"
+)
+
 // This file provides additional API helpers for the bitpack package.
 // The main dispatch functions (Pack32, Unpack32, etc.) are generated
 // in dispatch_bitpack_*.gen.go files.
@@ -22,3 +29,97 @@ package bitpack
 func MaxBits64(src []uint64) int {
 	return MaxBits(src)
 }
+
+// EncodeBlocks bit-packs src in blocks of blockSize values, each with its
+// own bit width from MaxBits, so a few large values only widen their own
+// block. It is the one-call form of the MaxBits and Pack32/Pack64 loop
+// for compressing a column.
+//
+// The output starts with a header: the value count and blockSize as
+// uvarints, then one byte per block holding its bit width. The packed
+// blocks follow back to back, each PackedSize(blockLen, width) bytes, so a
+// block of zeros takes no payload at all. Decode it with DecodeBlocks.
+func EncodeBlocks[T uint32 | uint64](src []T, blockSize int) []byte {
+	if blockSize <= 0 {
+		panic("bitpack: block size must be positive")
+	}
+	n := len(src)
+	numBlocks := (n + blockSize - 1) / blockSize
+
+	out := binary.AppendUvarint(nil, uint64(n))
+	out = binary.AppendUvarint(out, uint64(blockSize))
+	widths := len(out)
+	out = append(out, make([]byte, numBlocks)...)
+
+	for b := range numBlocks {
+		block := src[b*blockSize : min((b+1)*blockSize, n)]
+		width := MaxBits(block)
+		out[widths+b] = byte(width)
+
+		start := len(out)
+		out = append(out, make([]byte, PackedSize(len(block), width))...)
+		packBlock(block, width, out[start:])
+	}
+	return out
+}
+
+// DecodeBlocks decodes the output of EncodeBlocks, returning an error if
+// src is truncated or its header is malformed.
+func DecodeBlocks[T uint32 | uint64](src []byte) ([]T, error) {
+	n, k := binary.Uvarint(src)
+	if k <= 0 {
+		return nil, errors.New("bitpack: invalid value count")
+	}
+	src = src[k:]
+	blockSize, k := binary.Uvarint(src)
+	if k <= 0 || blockSize == 0 {
+		return nil, errors.New("bitpack: invalid block size")
+	}
+	src = src[k:]
+
+	// Each block has a width byte, so the count is bounded by the input
+	// length before anything is allocated.
+	numBlocks := (n + blockSize - 1) / blockSize
+	if n > math.MaxInt || numBlocks > uint64(len(src)) {
+		return nil, errors.New("bitpack: truncated header")
+	}
+	widths := src[:numBlocks]
+	src = src[numBlocks:]
+
+	var zero T
+	maxWidth := int(unsafe.Sizeof(zero)) * 8
+	dst := make([]T, n)
+	for b, width := range widths {
+		if int(width) > maxWidth {
+			return nil, errors.New("bitpack: invalid bit width")
+		}
+		block := dst[uint64(b)*blockSize : min(uint64(b+1)*blockSize, n)]
+		size := PackedSize(len(block), int(width))
+		if size > len(src) {
+			return nil, errors.New("bitpack: truncated block")
+		}
+		unpackBlock(src[:size], int(width), block)
+		src = src[size:]
+	}
+	return dst, nil
+}
+
+// packBlock dispatches to Pack32 or Pack64.
+func packBlock[T uint32 | uint64](src []T, bitWidth int, dst []byte) {
+	switch s := any(src).(type) {
+	case []uint32:
+		Pack32(s, bitWidth, dst)
+	case []uint64:
+		Pack64(s, bitWidth, dst)
+	}
+}
+
+// unpackBlock dispatches to Unpack32 or Unpack64.
+func unpackBlock[T uint32 | uint64](src []byte, bitWidth int, dst []T) {
+	switch d := any(dst).(type) {
+	case []uint32:
+		Unpack32(src, bitWidth, d)
+	case []uint64:
+		Unpack64(src, bitWidth, d)
+	}
+}
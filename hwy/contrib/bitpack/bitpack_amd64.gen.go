@@ -22,11 +22,11 @@ func init() {
 		initBitpackFallback()
 		return
 	}
-	if archsimd.X86.AVX512() {
+	if archsimd.X86.AVX512() && hwy.TargetEnabled(hwy.DispatchAVX512) {
 		initBitpackAVX512()
 		return
 	}
-	if archsimd.X86.AVX2() {
+	if archsimd.X86.AVX2() && hwy.TargetEnabled(hwy.DispatchAVX2) {
 		initBitpackAVX2()
 		return
 	}
@@ -59,3 +59,16 @@ func initBitpackFallback() {
 	DeltaEncode32 = BaseDeltaEncode32_fallback
 	DeltaEncode64 = BaseDeltaEncode64_fallback
 }
+
+func init() {
+	hwy.RegisterKernel("bitpack.Pack32", &Pack32)
+	hwy.RegisterKernel("bitpack.Unpack32", &Unpack32)
+	hwy.RegisterKernel("bitpack.Pack64", &Pack64)
+	hwy.RegisterKernel("bitpack.Unpack64", &Unpack64)
+	hwy.RegisterKernel("bitpack.DeltaEncode32", &DeltaEncode32)
+	hwy.RegisterKernel("bitpack.DeltaEncode64", &DeltaEncode64)
+	hwyKernels := []string{"bitpack.Pack32", "bitpack.Unpack32", "bitpack.Pack64", "bitpack.Unpack64", "bitpack.DeltaEncode32", "bitpack.DeltaEncode64"}
+	hwy.RegisterKernelTarget(hwy.DispatchAVX2, initBitpackAVX2, hwyKernels...)
+	hwy.RegisterKernelTarget(hwy.DispatchAVX512, initBitpackAVX512, hwyKernels...)
+	hwy.RegisterKernelTarget(hwy.DispatchScalar, initBitpackFallback, hwyKernels...)
+}
@@ -28,3 +28,14 @@ func initBitpackFallback() {
 	DeltaEncode32 = BaseDeltaEncode32_fallback
 	DeltaEncode64 = BaseDeltaEncode64_fallback
 }
+
+func init() {
+	hwy.RegisterKernel("bitpack.Pack32", &Pack32)
+	hwy.RegisterKernel("bitpack.Unpack32", &Unpack32)
+	hwy.RegisterKernel("bitpack.Pack64", &Pack64)
+	hwy.RegisterKernel("bitpack.Unpack64", &Unpack64)
+	hwy.RegisterKernel("bitpack.DeltaEncode32", &DeltaEncode32)
+	hwy.RegisterKernel("bitpack.DeltaEncode64", &DeltaEncode64)
+	hwyKernels := []string{"bitpack.Pack32", "bitpack.Unpack32", "bitpack.Pack64", "bitpack.Unpack64", "bitpack.DeltaEncode32", "bitpack.DeltaEncode64"}
+	hwy.RegisterKernelTarget(hwy.DispatchScalar, initBitpackFallback, hwyKernels...)
+}
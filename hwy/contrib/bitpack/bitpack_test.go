@@ -16,6 +16,7 @@ package bitpack
 
 import (
 	"math/rand"
+	"slices"
 	"testing"
 )
 
@@ -430,6 +431,62 @@ func TestEmptyInputs(t *testing.T) {
 	}
 }
 
+func TestEncodeDecodeBlocks(t *testing.T) {
+	t.Run("uint32", func(t *testing.T) { testEncodeDecodeBlocks(t, rand.Uint32) })
+	t.Run("uint64", func(t *testing.T) { testEncodeDecodeBlocks(t, rand.Uint64) })
+}
+
+func testEncodeDecodeBlocks[T uint32 | uint64](t *testing.T, gen func() T) {
+	for _, n := range []int{0, 1, 100, 1000, 1029} {
+		for _, blockSize := range []int{1, 7, 128} {
+			// Mostly small values with one outlier and runs of zeros.
+			src := make([]T, n)
+			for i := range src {
+				switch {
+				case i%300 < 130:
+				case i == 500:
+					src[i] = gen()
+				default:
+					src[i] = gen() % 50
+				}
+			}
+
+			enc := EncodeBlocks(src, blockSize)
+			got, err := DecodeBlocks[T](enc)
+			if err != nil {
+				t.Fatalf("n=%d blockSize=%d: DecodeBlocks: %v", n, blockSize, err)
+			}
+			if !slices.Equal(got, src) {
+				t.Fatalf("n=%d blockSize=%d: round trip mismatch", n, blockSize)
+			}
+			if n == 1029 && blockSize == 128 && len(enc) >= n*4/2 {
+				t.Errorf("n=%d blockSize=%d: encoded to %d bytes, expected compression", n, blockSize, len(enc))
+			}
+
+			if n > 0 {
+				if _, err := DecodeBlocks[T](enc[:len(enc)-1]); err == nil {
+					t.Errorf("n=%d blockSize=%d: DecodeBlocks accepted truncated input", n, blockSize)
+				}
+			}
+		}
+	}
+}
+
+func TestDecodeBlocksInvalid(t *testing.T) {
+	for _, src := range [][]byte{
+		nil,
+		{10},       // missing block size
+		{10, 0},    // zero block size
+		{10, 4, 3}, // missing widths
+		{4, 4, 33}, // width too large for uint32
+		{4, 4, 8},  // missing payload
+	} {
+		if _, err := DecodeBlocks[uint32](src); err == nil {
+			t.Errorf("DecodeBlocks(%v) succeeded, want error", src)
+		}
+	}
+}
+
 // Benchmarks
 
 func BenchmarkMaxBits32(b *testing.B) {
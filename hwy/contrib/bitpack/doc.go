@@ -35,6 +35,16 @@
 //   - DeltaEncode[T](src []T, base T, dst []T) - Compute deltas from base value
 //   - DeltaDecode[T](src []T, base T, dst []T) - Reconstruct values from deltas
 //
+// # Block Encoding
+//
+// EncodeBlocks compresses a whole column in one call: it splits the values
+// into blocks, packs each with its own MaxBits width, and writes a compact
+// header of the value count, block size and per-block widths.
+// DecodeBlocks reverses it and reports malformed input as an error:
+//
+//	enc := bitpack.EncodeBlocks(column, 128)
+//	column, err := bitpack.DecodeBlocks[uint32](enc)
+//
 // # Algorithm
 //
 // The implementation uses SIMD shift and mask operations:
@@ -0,0 +1,232 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bloom
+
+import (
+	"math"
+	"math/bits"
+)
+
+// blockWords is the number of 32-bit words of a block: 256 bits, a cache
+// line fraction that one AVX2 register holds.
+const blockWords = 8
+
+// hashMix is the odd multiplier that hashes keys, from wyhash.
+const hashMix = 0xa0761d6478bd642f
+
+// salts are the odd multipliers that derive the bit of each word of a
+// block from a hash, as in the split block Bloom filters of Parquet.
+var salts = [blockWords]uint32{
+	0x47b6137b, 0x44974d91, 0x8824ad5b, 0xa2b7289d,
+	0x705495c7, 0x2df1424b, 0x9efc4947, 0x5c6bfb31,
+}
+
+// batchSize is the number of keys whose probes AddBatch and ContainsBatch
+// compute at once.
+const batchSize = 256
+
+// Filter is a blocked Bloom filter of uint64 keys: every key sets one bit
+// in each of the 8 words of one 256-bit block, so that adding or testing
+// it touches a single block. It answers whether a key may have been added,
+// with false positives at the rate it was sized for, but never a false
+// negative.
+//
+// Keys are hashed again by the filter; hash strings and other keys to
+// uint64 first, for example with hash/maphash.
+type Filter struct {
+	words []uint32
+	seed  uint64
+}
+
+// New returns an empty filter for about n keys with a false positive rate
+// near fpRate, in (0, 1). It panics if n is negative or fpRate is outside
+// (0, 1).
+func New(n int, fpRate float64) *Filter {
+	if n < 0 {
+		panic("bloom: negative number of keys")
+	}
+	if !(fpRate > 0 && fpRate < 1) {
+		panic("bloom: false positive rate outside (0, 1)")
+	}
+	bitsPerKey := BitsPerKey(fpRate)
+	return NewBlocks(int(math.Ceil(float64(max(n, 1)) * bitsPerKey / (32 * blockWords))))
+}
+
+// NewBlocks returns an empty filter of numBlocks blocks of 256 bits, at
+// least one. It panics if numBlocks is not positive.
+func NewBlocks(numBlocks int) *Filter {
+	if numBlocks <= 0 || uint64(numBlocks) > math.MaxUint32 {
+		panic("bloom: number of blocks out of range")
+	}
+	return &Filter{words: make([]uint32, numBlocks*blockWords), seed: 0x9e3779b97f4a7c15}
+}
+
+// NumBlocks returns the number of 256-bit blocks of f.
+func (f *Filter) NumBlocks() int { return len(f.words) / blockWords }
+
+// Add adds key to f.
+func (f *Filter) Add(key uint64) {
+	var probes [blockWords]uint64
+	block := probesScalar(&probes, key, f.seed, uint64(f.NumBlocks()))
+	words := f.words[block*blockWords : block*blockWords+blockWords]
+	for w, p := range probes {
+		words[w] |= 1 << p
+	}
+}
+
+// Contains reports whether key may have been added to f.
+func (f *Filter) Contains(key uint64) bool {
+	var probes [blockWords]uint64
+	block := probesScalar(&probes, key, f.seed, uint64(f.NumBlocks()))
+	words := f.words[block*blockWords : block*blockWords+blockWords]
+	var miss uint32
+	for w, p := range probes {
+		miss |= ^words[w] & (1 << p)
+	}
+	return miss == 0
+}
+
+// AddBatch adds keys to f, hashing them a SIMD vector at a time.
+func (f *Filter) AddBatch(keys []uint64) {
+	var probes [blockWords * batchSize]uint64
+	var blocks [batchSize]uint64
+	for start := 0; start < len(keys); start += batchSize {
+		batch := keys[start:min(start+batchSize, len(keys))]
+		n := len(batch)
+		computeProbes(probes[:blockWords*n], blocks[:n], batch, f.seed, uint64(f.NumBlocks()))
+		for j, b := range blocks[:n] {
+			words := f.words[b*blockWords : b*blockWords+blockWords]
+			for w := range words {
+				words[w] |= 1 << probes[w*n+j]
+			}
+		}
+	}
+}
+
+// ContainsBatch stores in dst[j] whether keys[j] may have been added to
+// f, and returns the number of such keys: the rows a join pre-filter lets
+// through. It panics if dst is shorter than keys.
+func (f *Filter) ContainsBatch(dst []bool, keys []uint64) int {
+	if len(dst) < len(keys) {
+		panic("bloom: dst is shorter than keys")
+	}
+	var probes [blockWords * batchSize]uint64
+	var blocks [batchSize]uint64
+	count := 0
+	for start := 0; start < len(keys); start += batchSize {
+		batch := keys[start:min(start+batchSize, len(keys))]
+		n := len(batch)
+		computeProbes(probes[:blockWords*n], blocks[:n], batch, f.seed, uint64(f.NumBlocks()))
+		for j, b := range blocks[:n] {
+			words := f.words[b*blockWords : b*blockWords+blockWords]
+			var miss uint32
+			for w := range words {
+				miss |= ^words[w] & (1 << probes[w*n+j])
+			}
+			dst[start+j] = miss == 0
+			if miss == 0 {
+				count++
+			}
+		}
+	}
+	return count
+}
+
+// Union adds the keys of g to f. It panics unless both have the same
+// number of blocks.
+func (f *Filter) Union(g *Filter) {
+	if len(f.words) != len(g.words) || f.seed != g.seed {
+		panic("bloom: union of filters of different sizes")
+	}
+	for i, w := range g.words {
+		f.words[i] |= w
+	}
+}
+
+// Reset removes all keys from f.
+func (f *Filter) Reset() {
+	clear(f.words)
+}
+
+// FillRatio returns the fraction of the bits of f that are set, from which
+// the false positive rate of a full filter can be estimated.
+func (f *Filter) FillRatio() float64 {
+	set := 0
+	for _, w := range f.words {
+		set += bits.OnesCount32(w)
+	}
+	return float64(set) / float64(32*len(f.words))
+}
+
+// probesScalar is baseComputeProbes for one key: it stores the bit of each word
+// in probes and returns the block.
+func probesScalar(probes *[blockWords]uint64, key, seed, numBlocks uint64) uint64 {
+	hi, lo := bits.Mul64(key^seed, hashMix)
+	h := lo ^ hi
+	for w := range probes {
+		probes[w] = uint64(uint32(h)*salts[w]) >> 27
+	}
+	return (h >> 32) * numBlocks >> 32
+}
+
+// BitsPerKey returns the bits of a blocked filter per key that give a
+// false positive rate of fpRate, in (0, 1).
+//
+// A key tests positive if its bit is set in each of the 8 words of its
+// block. With b bits per key, a block holds a Poisson number of keys of
+// mean 256/b, and each word bit is set by one of i keys with probability
+// 1-(31/32)^i; the rate is the average of the 8th power over i.
+func BitsPerKey(fpRate float64) float64 {
+	lo, hi := 1.0, 128.0
+	if falsePositiveRate(hi) > fpRate {
+		return hi
+	}
+	for range 50 {
+		mid := (lo + hi) / 2
+		if falsePositiveRate(mid) > fpRate {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return hi
+}
+
+// falsePositiveRate returns the false positive rate of a filter with
+// bitsPerKey bits per key, see BitsPerKey.
+func falsePositiveRate(bitsPerKey float64) float64 {
+	lambda := 32 * blockWords / bitsPerKey
+	p := math.Exp(-lambda) // Poisson probability of i keys
+	rate := 0.0
+	for i := 0; i < int(lambda+12*math.Sqrt(lambda)+20); i++ {
+		if i > 0 {
+			p *= lambda / float64(i)
+		}
+		rate += p * math.Pow(1-math.Pow(31.0/32, float64(i)), blockWords)
+	}
+	return rate
+}
+
+// computeProbesScalar is baseComputeProbes a key at a time.
+func computeProbesScalar(probes, blocks, keys []uint64, seed, numBlocks uint64) {
+	n := len(keys)
+	var p [blockWords]uint64
+	for j, key := range keys {
+		blocks[j] = probesScalar(&p, key, seed, numBlocks)
+		for w, v := range p {
+			probes[w*n+j] = v
+		}
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bloom
+
+//go:generate go run ../../../cmd/hwygen -input bloom_base.go -output . -targets avx2,avx512,neon,fallback -dispatch bloom
+
+import (
+	"math/bits"
+
+	"github.com/ajroetker/go-highway/hwy"
+)
+
+// baseComputeProbes computes the probes of keys into a filter of numBlocks
+// blocks: the block of keys[j] in blocks[j], and the bit it sets or tests
+// in word w of that block in probes[w*len(keys)+j].
+//
+// A key is hashed with one 64×64→128-bit multiply of the key and the
+// seed, whose halves are folded together. The high 32 bits of the hash
+// choose the block, as a fraction of numBlocks; the low 32, multiplied by
+// a different odd salt per word, give the word bits from their top mask5.
+// See probesScalar for one key.
+func baseComputeProbes(probes, blocks, keys []uint64, seed, numBlocks uint64) {
+	n := len(keys)
+	vSeed := hwy.Set(seed)
+	vMix := hwy.Set(uint64(hashMix))
+	vBlocks := hwy.Set(numBlocks)
+	low32 := hwy.Set(uint64(0xFFFFFFFF))
+	mask5 := hwy.Set(uint64(31))
+	lanes := hwy.MaxLanes[uint64]()
+	j := 0
+	//hwy:unroll 1
+	for ; j+lanes <= n; j += lanes {
+		lo, hi := hwy.Mul128(hwy.Xor(hwy.Load(keys[j:]), vSeed), vMix)
+		h := hwy.Xor(lo, hi)
+		b, _ := hwy.Mul128(hwy.ShiftRight(h, 32), vBlocks)
+		hwy.Store(hwy.ShiftRight(b, 32), blocks[j:])
+		h = hwy.And(h, low32)
+		//hwy:unroll 1
+		for w := 0; w < blockWords; w++ {
+			p, _ := hwy.Mul128(h, hwy.Set(uint64(salts[w])))
+			hwy.Store(hwy.And(hwy.ShiftRight(p, 27), mask5), probes[w*n+j:])
+		}
+	}
+	for ; j < n; j++ {
+		hi, lo := bits.Mul64(keys[j]^seed, hashMix)
+		h := lo ^ hi
+		blocks[j] = (h >> 32) * numBlocks >> 32
+		for w := 0; w < blockWords; w++ {
+			probes[w*n+j] = uint64(uint32(h)*salts[w]) >> 27
+		}
+	}
+}
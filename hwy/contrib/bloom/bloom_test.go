@@ -0,0 +1,131 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bloom
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+func TestFilter(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	for _, n := range []int{0, 1, 7, 1000, 20000} {
+		keys := make([]uint64, n)
+		for i := range keys {
+			keys[i] = r.Uint64()
+		}
+		f := New(n, 0.01)
+		f.AddBatch(keys)
+		g := New(n, 0.01)
+		for _, k := range keys {
+			g.Add(k)
+		}
+		if !slices.Equal(f.words, g.words) {
+			t.Fatalf("n=%d: AddBatch and Add set different bits", n)
+		}
+		maybe := make([]bool, n)
+		if got := f.ContainsBatch(maybe, keys); got != n {
+			t.Fatalf("n=%d: ContainsBatch of the added keys = %d", n, got)
+		}
+		for _, k := range keys {
+			if !f.Contains(k) {
+				t.Fatalf("n=%d: false negative for %#x", n, k)
+			}
+		}
+		if n < 1000 {
+			continue
+		}
+		// The false positive rate of keys not added.
+		others := make([]uint64, 100000)
+		for i := range others {
+			others[i] = r.Uint64()
+		}
+		maybe = make([]bool, len(others))
+		positives := f.ContainsBatch(maybe, others)
+		for i, k := range others {
+			if maybe[i] != f.Contains(k) {
+				t.Fatalf("n=%d: ContainsBatch and Contains differ for %#x", n, k)
+			}
+		}
+		if rate := float64(positives) / float64(len(others)); rate > 0.015 {
+			t.Errorf("n=%d: false positive rate %.4f, want about 0.01", n, rate)
+		}
+	}
+}
+
+func TestSequentialKeys(t *testing.T) {
+	// Dense integer keys, as join keys often are, must spread over the
+	// blocks as well as random ones.
+	const n = 50000
+	keys := make([]uint64, n)
+	others := make([]uint64, n)
+	for i := range keys {
+		keys[i] = uint64(i)
+		others[i] = uint64(n + i)
+	}
+	f := New(n, 0.01)
+	f.AddBatch(keys)
+	positives := f.ContainsBatch(make([]bool, n), others)
+	if rate := float64(positives) / n; rate > 0.015 {
+		t.Errorf("false positive rate of sequential keys %.4f, want about 0.01", rate)
+	}
+}
+
+func TestComputeProbes(t *testing.T) {
+	// The hwy.Vec kernel against the scalar one.
+	r := rand.New(rand.NewPCG(3, 4))
+	keys := make([]uint64, 37)
+	for i := range keys {
+		keys[i] = r.Uint64()
+	}
+	got := make([]uint64, blockWords*len(keys))
+	want := make([]uint64, blockWords*len(keys))
+	gotBlocks := make([]uint64, len(keys))
+	wantBlocks := make([]uint64, len(keys))
+	baseComputeProbes(got, gotBlocks, keys, 99, 1000)
+	computeProbesScalar(want, wantBlocks, keys, 99, 1000)
+	if !slices.Equal(got, want) || !slices.Equal(gotBlocks, wantBlocks) {
+		t.Errorf("baseComputeProbes differs from computeProbesScalar")
+	}
+}
+
+func TestUnionReset(t *testing.T) {
+	f, g := NewBlocks(16), NewBlocks(16)
+	f.Add(1)
+	g.Add(2)
+	f.Union(g)
+	if !f.Contains(1) || !f.Contains(2) {
+		t.Errorf("union lost a key")
+	}
+	if r := f.FillRatio(); r <= 0 || r > 16.0/(16*256) {
+		t.Errorf("FillRatio = %g after two keys", r)
+	}
+	f.Reset()
+	if f.Contains(1) || f.FillRatio() != 0 {
+		t.Errorf("Reset left keys")
+	}
+}
+
+func TestBitsPerKey(t *testing.T) {
+	prev := 0.0
+	for _, fp := range []float64{0.1, 0.01, 0.001} {
+		b := BitsPerKey(fp)
+		if b <= prev || falsePositiveRate(b) > fp*1.0001 {
+			t.Errorf("BitsPerKey(%g) = %g, rate %g", fp, b, falsePositiveRate(b))
+		}
+		prev = b
+	}
+}
@@ -0,0 +1,31 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package bloom provides a blocked Bloom filter of uint64 keys, which
+// hashes and probes a batch of keys at a time, for join pre-filters and
+// other membership tests that tolerate false positives.
+//
+//	f := bloom.New(len(buildKeys), 0.01)
+//	f.AddBatch(buildKeys)
+//	n := f.ContainsBatch(maybe, probeKeys) // rows that may join
+//
+// Each key selects one 256-bit block of the filter and sets one bit in
+// each of its eight 32-bit words, as in the split block Bloom filters of
+// Parquet and Impala, so that it touches a single cache line. The keys of
+// a batch are hashed, and their blocks and bits computed, a SIMD vector of
+// keys at a time with 64×64→128-bit multiplies; the blocks are then read
+// or updated one key at a time, which is what a cache miss costs anyway.
+// At the same number of bits, the blocks give a somewhat higher false
+// positive rate than a classic Bloom filter; New sizes the filter for it.
+package bloom
@@ -0,0 +1,27 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bloom
+
+import "github.com/ajroetker/go-highway/hwy"
+
+// The hwy.Vec fallback of the probe kernel allocates on every operation.
+// Where dispatch bound it, bind a scalar loop instead. The file name sorts
+// after the dispatch files, so this init runs last.
+func init() {
+	if hwy.KernelImplementation("bloom.computeProbes") != hwy.BoundImplementation(baseComputeProbes_fallback) {
+		return
+	}
+	computeProbes = computeProbesScalar
+}
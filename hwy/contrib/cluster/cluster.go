@@ -0,0 +1,109 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cluster
+
+import (
+	"github.com/ajroetker/go-highway/hwy"
+	"github.com/ajroetker/go-highway/hwy/contrib/vec"
+)
+
+// The functions take points and centroids as row-major matrices: n points
+// of dims elements in data, k centroids in centroids. Distances are
+// squared Euclidean distances, from vec.BatchL2SquaredDistance.
+
+// PairwiseDistanceMatrix stores in dst, m×n row-major, the squared
+// Euclidean distance between every row of a, m×dims, and every row of b,
+// n×dims. It panics if a slice is too short.
+func PairwiseDistanceMatrix[T hwy.FloatsNative](dst, a, b []T, m, n, dims int) {
+	checkLen(a, m*dims, "a")
+	checkLen(b, n*dims, "b")
+	checkLen(dst, m*n, "dst")
+	if dims == 0 {
+		clear(dst[:m*n])
+		return
+	}
+	for i := range m {
+		vec.BatchL2SquaredDistance(a[i*dims:(i+1)*dims], b, dst[i*n:(i+1)*n], n, dims)
+	}
+}
+
+// AssignClusters stores in assign[i] the index of the centroid nearest to
+// point i, and in dists[i], unless dists is nil, the squared distance to
+// it. It returns the inertia, the sum of those distances. Ties go to the
+// lowest index. It panics if a slice is too short or k is not positive.
+func AssignClusters[T hwy.FloatsNative](assign []int, dists, data, centroids []T, n, k, dims int) float64 {
+	if k <= 0 {
+		panic("cluster: no centroids")
+	}
+	checkLen(data, n*dims, "data")
+	checkLen(centroids, k*dims, "centroids")
+	checkLen(assign, n, "assign")
+	if dists != nil {
+		checkLen(dists, n, "dists")
+	}
+	if dims == 0 {
+		clear(assign[:n])
+		if dists != nil {
+			clear(dists[:n])
+		}
+		return 0
+	}
+	d := make([]T, k)
+	inertia := 0.0
+	for i := range n {
+		vec.BatchL2SquaredDistance(data[i*dims:(i+1)*dims], centroids, d, k, dims)
+		c := vec.Argmin(d)
+		assign[i] = c
+		if dists != nil {
+			dists[i] = d[c]
+		}
+		inertia += float64(d[c])
+	}
+	return inertia
+}
+
+// UpdateCentroids moves each centroid to the mean of the points assigned
+// to it, and returns the number of points of each. The centroids without
+// points are left unchanged. It panics if a slice is too short or an
+// assignment is outside [0, k).
+func UpdateCentroids[T hwy.FloatsNative](centroids, data []T, assign []int, n, k, dims int) []int {
+	checkLen(data, n*dims, "data")
+	checkLen(centroids, k*dims, "centroids")
+	checkLen(assign, n, "assign")
+	counts := make([]int, k)
+	sums := make([]T, k*dims)
+	for i, c := range assign[:n] {
+		if c < 0 || c >= k {
+			panic("cluster: assignment outside [0, k)")
+		}
+		counts[c]++
+		vec.Add(sums[c*dims:(c+1)*dims], data[i*dims:(i+1)*dims])
+	}
+	for c, count := range counts {
+		if count == 0 {
+			continue
+		}
+		mean := centroids[c*dims : (c+1)*dims]
+		copy(mean, sums[c*dims:(c+1)*dims])
+		vec.Scale(1/T(count), mean)
+	}
+	return counts
+}
+
+func checkLen[S ~[]E, E any](s S, n int, name string) {
+	if len(s) < n {
+		panic("cluster: " + name + " too short")
+	}
+}
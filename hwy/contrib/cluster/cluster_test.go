@@ -0,0 +1,164 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cluster
+
+import (
+	stdmath "math"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+func randomMatrix(r *rand.Rand, rows, cols int) []float32 {
+	m := make([]float32, rows*cols)
+	for i := range m {
+		m[i] = float32(r.NormFloat64())
+	}
+	return m
+}
+
+func squaredDistance(a, b []float32) float64 {
+	s := 0.0
+	for i := range a {
+		d := float64(a[i]) - float64(b[i])
+		s += d * d
+	}
+	return s
+}
+
+func TestPairwiseDistanceMatrix(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	for _, shape := range [][3]int{{1, 1, 1}, {3, 5, 7}, {10, 4, 16}, {17, 33, 100}} {
+		m, n, dims := shape[0], shape[1], shape[2]
+		a, b := randomMatrix(r, m, dims), randomMatrix(r, n, dims)
+		dst := make([]float32, m*n)
+		PairwiseDistanceMatrix(dst, a, b, m, n, dims)
+		for i := range m {
+			for j := range n {
+				want := squaredDistance(a[i*dims:(i+1)*dims], b[j*dims:(j+1)*dims])
+				if got := float64(dst[i*n+j]); stdmath.Abs(got-want) > 1e-4*max(1, want) {
+					t.Errorf("%v: dst[%d,%d] = %g, want %g", shape, i, j, got, want)
+				}
+			}
+		}
+	}
+}
+
+func TestAssignUpdate(t *testing.T) {
+	r := rand.New(rand.NewPCG(3, 4))
+	const n, k, dims = 200, 7, 13
+	data := randomMatrix(r, n, dims)
+	centroids := randomMatrix(r, k, dims)
+	assign := make([]int, n)
+	dists := make([]float32, n)
+	inertia := AssignClusters(assign, dists, data, centroids, n, k, dims)
+
+	wantInertia := 0.0
+	for i := range n {
+		point := data[i*dims : (i+1)*dims]
+		best, bestDist := 0, stdmath.Inf(1)
+		for c := range k {
+			if d := squaredDistance(point, centroids[c*dims:(c+1)*dims]); d < bestDist {
+				best, bestDist = c, d
+			}
+		}
+		if assign[i] != best || stdmath.Abs(float64(dists[i])-bestDist) > 1e-4*bestDist {
+			t.Errorf("point %d: assigned %d at %g, want %d at %g", i, assign[i], dists[i], best, bestDist)
+		}
+		wantInertia += bestDist
+	}
+	if stdmath.Abs(inertia-wantInertia) > 1e-4*wantInertia {
+		t.Errorf("inertia = %g, want %g", inertia, wantInertia)
+	}
+	if got := AssignClusters(assign, nil, data, centroids, n, k, dims); got != inertia {
+		t.Errorf("inertia without dists = %g, want %g", got, inertia)
+	}
+
+	// Leave cluster 0 empty.
+	for i := range assign {
+		assign[i] = 1 + i%(k-1)
+	}
+	old := slices.Clone(centroids)
+	counts := UpdateCentroids(centroids, data, assign, n, k, dims)
+	if !slices.Equal(centroids[:dims], old[:dims]) || counts[0] != 0 {
+		t.Errorf("empty cluster moved: count %d", counts[0])
+	}
+	for c := 1; c < k; c++ {
+		for j := range dims {
+			sum, count := 0.0, 0
+			for i := range n {
+				if assign[i] == c {
+					sum += float64(data[i*dims+j])
+					count++
+				}
+			}
+			if got, want := float64(centroids[c*dims+j]), sum/float64(count); counts[c] != count || stdmath.Abs(got-want) > 1e-5 {
+				t.Errorf("centroid %d[%d] = %g of %d points, want %g of %d", c, j, got, counts[c], want, count)
+			}
+		}
+	}
+}
+
+func TestKMeans(t *testing.T) {
+	// Well separated blobs.
+	r := rand.New(rand.NewPCG(5, 6))
+	const k, perBlob, dims = 5, 100, 8
+	centers := randomMatrix(r, k, dims)
+	for i := range centers {
+		centers[i] *= 20
+	}
+	n := k * perBlob
+	data := make([]float32, 0, n*dims)
+	for b := range k {
+		for range perBlob {
+			for j := range dims {
+				data = append(data, centers[b*dims+j]+float32(r.NormFloat64()))
+			}
+		}
+	}
+
+	centroids, assign, inertia := KMeans(data, n, dims, k, KMeansOptions{Seed: 1})
+	// Each blob is one cluster, whatever its label.
+	for b := range k {
+		label := assign[b*perBlob]
+		for i := b * perBlob; i < (b+1)*perBlob; i++ {
+			if assign[i] != label {
+				t.Fatalf("blob %d split between clusters %d and %d", b, label, assign[i])
+			}
+		}
+		if d := squaredDistance(centroids[label*dims:(label+1)*dims], centers[b*dims:(b+1)*dims]); d > 1 {
+			t.Errorf("centroid of blob %d off by %g", b, stdmath.Sqrt(d))
+		}
+	}
+	if want := float64(n * dims); inertia > 1.3*want {
+		t.Errorf("inertia = %g, want about %g", inertia, want)
+	}
+
+	again, _, _ := KMeans(data, n, dims, k, KMeansOptions{Seed: 1})
+	if !slices.Equal(again, centroids) {
+		t.Errorf("KMeans is not deterministic for a seed")
+	}
+}
+
+func BenchmarkAssignClusters(b *testing.B) {
+	r := rand.New(rand.NewPCG(7, 8))
+	const n, k, dims = 4096, 256, 32
+	data := randomMatrix(r, n, dims)
+	centroids := randomMatrix(r, k, dims)
+	assign := make([]int, n)
+	for b.Loop() {
+		AssignClusters(assign, nil, data, centroids, n, k, dims)
+	}
+}
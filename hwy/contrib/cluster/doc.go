@@ -0,0 +1,32 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cluster provides k-means clustering and distance matrices over
+// dense float vectors, for clustering embeddings and training product
+// quantization (PQ) codebooks.
+//
+//	centroids, assign, inertia := cluster.KMeans(data, n, dims, k, cluster.KMeansOptions{Seed: 1})
+//
+// KMeans is built on three steps that can be used on their own, for
+// example to run Lloyd's iterations on the subvectors of a PQ codebook:
+//
+//   - AssignClusters finds the nearest centroid of each point, with the
+//     batched squared L2 distance kernel of package vec and vec.Argmin.
+//   - UpdateCentroids moves each centroid to the mean of its points.
+//   - PairwiseDistanceMatrix computes every distance between two sets of
+//     points.
+//
+// Distances are squared Euclidean distances, computed directly rather
+// than as ‖a‖² - 2a·b + ‖b‖², which loses precision for nearby points.
+package cluster
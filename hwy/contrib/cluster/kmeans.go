@@ -0,0 +1,108 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cluster
+
+import (
+	"math/rand/v2"
+
+	"github.com/ajroetker/go-highway/hwy"
+	"github.com/ajroetker/go-highway/hwy/contrib/vec"
+)
+
+// KMeansOptions configures KMeans. The zero value runs up to 100
+// iterations, until the inertia improves by less than 1e-4 of itself.
+type KMeansOptions struct {
+	MaxIter   int     // iterations of Lloyd's algorithm, 100 if 0
+	Tolerance float64 // relative improvement of the inertia to stop at, 1e-4 if 0
+	Seed      uint64  // seed of the k-means++ initialization
+}
+
+// KMeans clusters the n points of data, n×dims row-major, into k clusters
+// with Lloyd's algorithm from a k-means++ initialization. It returns the
+// k×dims centroids, the cluster of each point and the inertia. A cluster
+// left empty is moved to the point farthest from its centroid. It panics
+// if k is not in [1, n] or data is too short.
+func KMeans[T hwy.FloatsNative](data []T, n, dims, k int, opts KMeansOptions) (centroids []T, assign []int, inertia float64) {
+	if k < 1 || k > n {
+		panic("cluster: k outside [1, n]")
+	}
+	checkLen(data, n*dims, "data")
+	maxIter := opts.MaxIter
+	if maxIter == 0 {
+		maxIter = 100
+	}
+	tol := opts.Tolerance
+	if tol == 0 {
+		tol = 1e-4
+	}
+
+	r := rand.New(rand.NewPCG(opts.Seed, 0x9e3779b97f4a7c15))
+	centroids = seedPlusPlus(r, data, n, dims, k)
+	assign = make([]int, n)
+	dists := make([]T, n)
+	inertia = AssignClusters(assign, dists, data, centroids, n, k, dims)
+	for range maxIter {
+		counts := UpdateCentroids(centroids, data, assign, n, k, dims)
+		for c, count := range counts {
+			if count > 0 {
+				continue
+			}
+			far := vec.Argmax(dists)
+			copy(centroids[c*dims:(c+1)*dims], data[far*dims:(far+1)*dims])
+			dists[far] = 0
+		}
+		prev := inertia
+		inertia = AssignClusters(assign, dists, data, centroids, n, k, dims)
+		if prev-inertia <= tol*prev {
+			break
+		}
+	}
+	return centroids, assign, inertia
+}
+
+// seedPlusPlus returns k initial centroids chosen with k-means++: the
+// first uniformly, each next one with probability proportional to the
+// squared distance of a point to the nearest centroid chosen so far.
+func seedPlusPlus[T hwy.FloatsNative](r *rand.Rand, data []T, n, dims, k int) []T {
+	centroids := make([]T, k*dims)
+	first := r.IntN(n)
+	copy(centroids, data[first*dims:(first+1)*dims])
+	nearest := make([]T, n)
+	d := make([]T, n)
+	vec.BatchL2SquaredDistance(centroids[:dims], data, nearest, n, dims)
+	for c := 1; c < k; c++ {
+		total := 0.0
+		for _, v := range nearest {
+			total += float64(v)
+		}
+		next := r.IntN(n) // all points coincide with a centroid
+		if total > 0 {
+			target := r.Float64() * total
+			for i, v := range nearest {
+				if target -= float64(v); target < 0 {
+					next = i
+					break
+				}
+			}
+		}
+		centroid := centroids[c*dims : (c+1)*dims]
+		copy(centroid, data[next*dims:(next+1)*dims])
+		vec.BatchL2SquaredDistance(centroid, data, d, n, dims)
+		for i, v := range d {
+			nearest[i] = min(nearest[i], v)
+		}
+	}
+	return centroids
+}
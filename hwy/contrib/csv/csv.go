@@ -0,0 +1,128 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package csv
+
+import (
+	"errors"
+	"math"
+	"math/bits"
+
+	hjson "github.com/ajroetker/go-highway/hwy/contrib/json"
+)
+
+// ErrUnclosedQuote is returned for text that ends inside a quoted field.
+var ErrUnclosedQuote = errors.New("csv: unclosed quote")
+
+// chunkBlocks is the number of 64-byte blocks classified per kernel call.
+const chunkBlocks = 64
+
+// FindDelimiters appends to dst the index of each delim and '\n' of buf
+// outside quoted fields, and returns the extended slice: the ends of the
+// fields and records of the CSV (or, with '\t', TSV) text. A record ending
+// in "\r\n" keeps the '\r' at the end of its last field. It returns
+// ErrUnclosedQuote, with the indices found, if buf ends inside a quoted
+// field. delim, quote and '\n' must differ.
+func FindDelimiters(dst []uint32, buf []byte, delim, quote byte) ([]uint32, error) {
+	if uint64(len(buf)) > math.MaxUint32 {
+		panic("csv: buf longer than 4 GiB")
+	}
+	s := scanner{delim: delim, quote: quote}
+	var fields, records [chunkBlocks]uint64
+	for start := 0; start < len(buf); start += chunkBlocks * 64 {
+		chunk := buf[start:min(len(buf), start+chunkBlocks*64)]
+		s.scan(fields[:], records[:], chunk)
+		for k := range (len(chunk) + 63) / 64 {
+			for b := fields[k] | records[k]; b != 0; b &= b - 1 {
+				dst = append(dst, uint32(start+64*k+bits.TrailingZeros64(b)))
+			}
+		}
+	}
+	if s.inQuote != 0 {
+		return dst, ErrUnclosedQuote
+	}
+	return dst, nil
+}
+
+// DelimiterBits is FindDelimiters returning bitmaps: bit j of fields[k]
+// and records[k] is set when byte 64k+j of buf is a delim or a '\n'
+// outside quoted fields. fields and records must have an element for each
+// 64-byte block of buf, the last one possibly partial.
+func DelimiterBits(fields, records []uint64, buf []byte, delim, quote byte) error {
+	if nb := (len(buf) + 63) / 64; len(fields) < nb || len(records) < nb {
+		panic("csv: fields or records shorter than the blocks of buf")
+	}
+	s := scanner{delim: delim, quote: quote}
+	for start := 0; start < len(buf); start += chunkBlocks * 64 {
+		k := start / 64
+		s.scan(fields[k:], records[k:], buf[start:min(len(buf), start+chunkBlocks*64)])
+	}
+	if s.inQuote != 0 {
+		return ErrUnclosedQuote
+	}
+	return nil
+}
+
+// scanner carries the quote state from one chunk of text to the next.
+type scanner struct {
+	delim, quote byte
+	inQuote      uint64 // all ones if the next chunk starts inside quotes
+	quotes       [chunkBlocks]uint64
+}
+
+// scan stores the delimiter bits of chunk, at most chunkBlocks blocks, in
+// fields and records.
+func (s *scanner) scan(fields, records []uint64, chunk []byte) {
+	nb := len(chunk) / 64
+	quotes := s.quotes[:]
+	Classify(chunk[:64*nb], s.delim, s.quote, fields[:nb], quotes[:nb], records[:nb])
+	if rest := chunk[64*nb:]; len(rest) > 0 {
+		var pad [64]byte
+		copy(pad[:], rest)
+		Classify(pad[:], s.delim, s.quote, fields[nb:nb+1], quotes[nb:nb+1], records[nb:nb+1])
+		valid := uint64(1)<<len(rest) - 1
+		fields[nb] &= valid
+		quotes[nb] &= valid
+		records[nb] &= valid
+		nb++
+	}
+	// An escaped quote inside a quoted field is doubled, so it toggles the
+	// state twice: the prefix XOR of the quotes sets the bits from each
+	// opening quote to the byte before its closing one.
+	hjson.PrefixXor(quotes[:nb], quotes[:nb])
+	for k := range nb {
+		quoted := quotes[k] ^ s.inQuote
+		s.inQuote = uint64(int64(quoted) >> 63)
+		fields[k] &^= quoted
+		records[k] &^= quoted
+	}
+}
+
+func classifyScalar(buf []byte, delim, quote byte, delims, quotes, newlines []uint64) {
+	for k := range delims {
+		var d, q, nl uint64
+		for j, c := range buf[64*k : 64*k+64] {
+			bit := uint64(1) << j
+			switch c {
+			case delim:
+				d |= bit
+			case quote:
+				q |= bit
+			case '\n':
+				nl |= bit
+			}
+		}
+		delims[k], quotes[k], newlines[k] = d, q, nl
+	}
+}
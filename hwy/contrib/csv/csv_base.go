@@ -0,0 +1,41 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package csv
+
+//go:generate go run ../../../cmd/hwygen -input csv_base.go -output . -targets avx2,avx512,neon,fallback -dispatch csv
+
+import "github.com/ajroetker/go-highway/hwy"
+
+// BaseClassify classifies the 64-byte blocks of buf, one per element of
+// delims, quotes and newlines: bit j of element k is set when byte 64k+j
+// of buf is delim, quote or '\n'. buf must hold 64 bytes per block.
+func BaseClassify(buf []byte, delim, quote byte, delims, quotes, newlines []uint64) {
+	n := len(delims)
+	vDelim := hwy.Set(delim)
+	vQuote := hwy.Set(quote)
+	vNewline := hwy.Set[uint8]('\n')
+	lanes := hwy.Zero[uint8]().NumLanes()
+	for k := 0; k < n; k++ {
+		var d, q, nl uint64
+		//hwy:unroll 1
+		for j := 0; j < 64; j += lanes {
+			v := hwy.Load(buf[64*k+j:])
+			d |= hwy.BitsFromMask(hwy.Equal(v, vDelim)) << j
+			q |= hwy.BitsFromMask(hwy.Equal(v, vQuote)) << j
+			nl |= hwy.BitsFromMask(hwy.Equal(v, vNewline)) << j
+		}
+		delims[k], quotes[k], newlines[k] = d, q, nl
+	}
+}
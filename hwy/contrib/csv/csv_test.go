@@ -0,0 +1,134 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package csv
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// findDelimitersScalar is FindDelimiters a byte at a time.
+func findDelimitersScalar(buf []byte, delim, quote byte) ([]uint32, bool) {
+	var idx []uint32
+	inQuote := false
+	for i, c := range buf {
+		switch {
+		case c == quote:
+			inQuote = !inQuote
+		case !inQuote && (c == delim || c == '\n'):
+			idx = append(idx, uint32(i))
+		}
+	}
+	return idx, !inQuote
+}
+
+// testRecords returns CSV-like text of many lengths, with quoted fields
+// holding delimiters, newlines and doubled quotes.
+func testRecords(delim byte) [][]byte {
+	r := rand.New(rand.NewPCG(1, 2))
+	d := string(delim)
+	tokens := []string{"a", "42", "x y", d, d, "\n", "\r\n", `"q"`, `"a` + d + `b"`, `"line` + "\n" + `two"`, `"say ""hi"""`, `""`, `"`}
+	var texts [][]byte
+	for _, n := range []int{0, 1, 10, 63, 64, 65, 200, 1000, 5000, 10000} {
+		for range 4 {
+			var b []byte
+			for len(b) < n {
+				b = append(b, tokens[r.IntN(len(tokens))]...)
+			}
+			texts = append(texts, b)
+		}
+	}
+	return append(texts, []byte(`"`+strings.Repeat("a,b\n", 100)+`",x`+"\n"))
+}
+
+func TestFindDelimiters(t *testing.T) {
+	for _, delim := range []byte{',', '\t'} {
+		for _, text := range testRecords(delim) {
+			want, closed := findDelimitersScalar(text, delim, '"')
+			got, err := FindDelimiters(nil, text, delim, '"')
+			if !slices.Equal(got, want) {
+				t.Errorf("FindDelimiters(%q) = %v, want %v", text, got, want)
+			}
+			if (err == nil) != closed {
+				t.Errorf("FindDelimiters(%q) error = %v, want closed = %v", text, err, closed)
+			}
+
+			nb := (len(text) + 63) / 64
+			fields, records := make([]uint64, nb), make([]uint64, nb)
+			err = DelimiterBits(fields, records, text, delim, '"')
+			var fromBits []uint32
+			for i := range text {
+				if (fields[i/64]|records[i/64])>>(i%64)&1 != 0 {
+					fromBits = append(fromBits, uint32(i))
+				}
+				if records[i/64]>>(i%64)&1 != 0 && text[i] != '\n' {
+					t.Fatalf("DelimiterBits(%q): record end at %d is %q", text, i, text[i])
+				}
+			}
+			if !slices.Equal(fromBits, want) || (err == nil) != closed {
+				t.Errorf("DelimiterBits(%q) = %v, %v, want %v", text, fromBits, err, want)
+			}
+		}
+	}
+}
+
+func TestClassify(t *testing.T) {
+	r := rand.New(rand.NewPCG(3, 4))
+	for _, blocks := range []int{0, 1, 2, 3, 17} {
+		const alphabet = "ab,;\"'\n\r\t \x00\xac\xbb"
+		buf := make([]byte, 64*blocks)
+		for i := range buf {
+			buf[i] = alphabet[r.IntN(len(alphabet))]
+		}
+		for _, delim := range []byte{',', ';', '\t'} {
+			for _, quote := range []byte{'"', '\''} {
+				want := make([][]uint64, 3)
+				got := make([][]uint64, 3)
+				for i := range want {
+					want[i] = make([]uint64, blocks)
+					got[i] = make([]uint64, blocks)
+				}
+				classifyScalar(buf, delim, quote, want[0], want[1], want[2])
+				for _, impl := range []struct {
+					name     string
+					classify func(buf []byte, delim, quote byte, delims, quotes, newlines []uint64)
+				}{
+					{"dispatch", Classify},
+					{"base", BaseClassify},
+				} {
+					impl.classify(buf, delim, quote, got[0], got[1], got[2])
+					if !slices.EqualFunc(got, want, slices.Equal) {
+						t.Errorf("%s: Classify(%q, %q, %q) = %x, want %x", impl.name, buf, delim, quote, got, want)
+					}
+				}
+			}
+		}
+	}
+}
+
+func BenchmarkFindDelimiters(b *testing.B) {
+	var text []byte
+	for i := 0; len(text) < 1<<20; i++ {
+		text = fmt.Appendf(text, "%d,item %d,\"a, \"\"quoted\"\" field\",%d.25\n", i, i, i)
+	}
+	idx := make([]uint32, 0, len(text)/4)
+	b.SetBytes(int64(len(text)))
+	for b.Loop() {
+		idx, _ = FindDelimiters(idx[:0], text, ',', '"')
+	}
+}
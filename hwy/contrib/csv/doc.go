@@ -0,0 +1,49 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package csv provides SIMD scanning of CSV and TSV text for the ends of
+// fields and records, the first pass of a high-throughput CSV reader.
+//
+// The package name shadows the standard library; import it under another
+// name:
+//
+//	import hcsv "github.com/ajroetker/go-highway/hwy/contrib/csv"
+//
+//	ends, err := hcsv.FindDelimiters(ends[:0], buf, ',', '"')
+//	for _, i := range ends {
+//		field := buf[start:i] // still quoted, if it was
+//		if buf[i] == '\n' { ... end of record ... }
+//		start = i + 1
+//	}
+//
+// # Semantics
+//
+// Delimiters and newlines between quotes do not end a field. Quotes are
+// escaped by doubling them, as in RFC 4180, which needs no special case:
+// the state toggles twice. A quote in the middle of an unquoted field
+// also opens a quoted section, unlike encoding/csv's LazyQuotes. Fields are
+// returned raw: the reader removes the quotes, undoubles them and drops
+// the '\r' of "\r\n" line ends.
+//
+// # Implementation
+//
+// Classify compares each vector of bytes with the delimiter, the quote and
+// '\n', and packs the results into a 64-bit bitmap per 64-byte block with
+// BitsFromMask. The prefix XOR of the quote bits, computed by the
+// carry-less multiplication kernel of package json, marks the quoted
+// bytes, whose delimiter and newline bits are cleared. The quote state is
+// carried from block to block by the sign bit.
+//
+// Without SIMD, Classify is a scalar loop.
+package csv
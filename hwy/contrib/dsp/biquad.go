@@ -0,0 +1,111 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dsp
+
+import (
+	stdmath "math"
+
+	"github.com/ajroetker/go-highway/hwy"
+)
+
+// Biquad holds the coefficients of a second-order IIR section, normalized
+// so that a0 = 1:
+//
+//	H(z) = (B0 + B1 z⁻¹ + B2 z⁻²) / (1 + A1 z⁻¹ + A2 z⁻²)
+type Biquad[T hwy.FloatsNative] struct {
+	B0, B1, B2 T
+	A1, A2     T
+}
+
+// LowpassBiquad returns the second-order low-pass filter of the Audio EQ
+// Cookbook, with the given cutoff frequency and quality factor
+// (1/√2 for a Butterworth response).
+func LowpassBiquad[T hwy.FloatsNative](cutoff, q, sampleRate float64) Biquad[T] {
+	cos, alpha := cookbook(cutoff, q, sampleRate)
+	return normalize[T](
+		(1-cos)/2, 1-cos, (1-cos)/2,
+		1+alpha, -2*cos, 1-alpha,
+	)
+}
+
+// HighpassBiquad returns the second-order high-pass filter of the Audio EQ
+// Cookbook, with the given cutoff frequency and quality factor.
+func HighpassBiquad[T hwy.FloatsNative](cutoff, q, sampleRate float64) Biquad[T] {
+	cos, alpha := cookbook(cutoff, q, sampleRate)
+	return normalize[T](
+		(1+cos)/2, -(1 + cos), (1+cos)/2,
+		1+alpha, -2*cos, 1-alpha,
+	)
+}
+
+// cookbook returns the intermediate values cos(w0) and alpha of the Audio
+// EQ Cookbook formulas.
+func cookbook(cutoff, q, sampleRate float64) (cos, alpha float64) {
+	sin, cos := stdmath.Sincos(2 * stdmath.Pi * cutoff / sampleRate)
+	return cos, sin / (2 * q)
+}
+
+func normalize[T hwy.FloatsNative](b0, b1, b2, a0, a1, a2 float64) Biquad[T] {
+	return Biquad[T]{
+		B0: T(b0 / a0), B1: T(b1 / a0), B2: T(b2 / a0),
+		A1: T(a1 / a0), A2: T(a2 / a0),
+	}
+}
+
+// BiquadCascade filters interleaved multichannel signals through a series
+// of biquad sections, keeping the state of every channel between calls so
+// that a stream can be processed in blocks. The channels are filtered in
+// parallel, one per SIMD lane: a mono signal runs the scalar tail.
+//
+// A BiquadCascade is stateful: it must not be used concurrently.
+type BiquadCascade[T hwy.FloatsNative] struct {
+	sections []Biquad[T]
+	channels int
+	z1, z2   []T // state of section s, channel c at s*channels+c
+}
+
+// NewBiquadCascade returns a cascade of the given sections, applied in
+// order, for signals of the given number of interleaved channels.
+func NewBiquadCascade[T hwy.FloatsNative](sections []Biquad[T], channels int) *BiquadCascade[T] {
+	if channels <= 0 {
+		panic("dsp: channels must be positive")
+	}
+	return &BiquadCascade[T]{
+		sections: append([]Biquad[T](nil), sections...),
+		channels: channels,
+		z1:       make([]T, len(sections)*channels),
+		z2:       make([]T, len(sections)*channels),
+	}
+}
+
+// Process filters data in place. Sample t of channel c is
+// data[t*channels+c]: len(data) must be a multiple of the channel count.
+func (f *BiquadCascade[T]) Process(data []T) {
+	if len(data)%f.channels != 0 {
+		panic("dsp: data length must be a multiple of the channel count")
+	}
+	for s, b := range f.sections {
+		state := s * f.channels
+		BiquadSection(data, f.channels, b.B0, b.B1, b.B2, b.A1, b.A2,
+			f.z1[state:state+f.channels], f.z2[state:state+f.channels])
+	}
+}
+
+// Reset clears the state of all channels, as before the first call of
+// Process.
+func (f *BiquadCascade[T]) Reset() {
+	clear(f.z1)
+	clear(f.z2)
+}
@@ -0,0 +1,102 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dsp
+
+import (
+	"fmt"
+	stdmath "math"
+	"testing"
+)
+
+// referenceBiquad filters one channel of data through the sections in
+// float64, with fresh state.
+func referenceBiquad(sections []Biquad[float32], x []float64) []float64 {
+	y := append([]float64(nil), x...)
+	for _, b := range sections {
+		var z1, z2 float64
+		for t, v := range y {
+			out := float64(b.B0)*v + z1
+			z1 = float64(b.B1)*v - float64(b.A1)*out + z2
+			z2 = float64(b.B2)*v - float64(b.A2)*out
+			y[t] = out
+		}
+	}
+	return y
+}
+
+func TestBiquadCascade(t *testing.T) {
+	sections := []Biquad[float32]{
+		LowpassBiquad[float32](4000, 1/stdmath.Sqrt2, 44100),
+		HighpassBiquad[float32](100, 0.7, 44100),
+	}
+	const frames = 500
+	for _, channels := range []int{1, 2, 3, 8, 11, 16, 19} {
+		data := randomSignal[float32](frames*channels, uint64(channels))
+		want := make([][]float64, channels)
+		for c := range channels {
+			x := make([]float64, frames)
+			for t := range x {
+				x[t] = float64(data[t*channels+c])
+			}
+			want[c] = referenceBiquad(sections, x)
+		}
+
+		// Two blocks: the state carries over.
+		f := NewBiquadCascade(sections, channels)
+		split := 123 * channels
+		f.Process(data[:split])
+		f.Process(data[split:])
+		for c := range channels {
+			for tt := range frames {
+				if got := float64(data[tt*channels+c]); stdmath.Abs(got-want[c][tt]) > 1e-4 {
+					t.Fatalf("channels=%d: channel %d sample %d = %g, want %g", channels, c, tt, got, want[c][tt])
+				}
+			}
+		}
+	}
+}
+
+func TestLowpassBiquadGain(t *testing.T) {
+	b := LowpassBiquad[float64](1000, 1/stdmath.Sqrt2, 48000)
+	// H(1), the gain at DC, is 1.
+	if dc := (b.B0 + b.B1 + b.B2) / (1 + b.A1 + b.A2); stdmath.Abs(dc-1) > 1e-12 {
+		t.Errorf("DC gain = %g, want 1", dc)
+	}
+	// The Butterworth response is 3 dB down at the cutoff.
+	w := 2 * stdmath.Pi * 1000 / 48000
+	z1, z2 := complex(stdmath.Cos(-w), stdmath.Sin(-w)), complex(stdmath.Cos(-2*w), stdmath.Sin(-2*w))
+	h := (complex(b.B0, 0) + complex(b.B1, 0)*z1 + complex(b.B2, 0)*z2) /
+		(1 + complex(b.A1, 0)*z1 + complex(b.A2, 0)*z2)
+	if gain := stdmath.Hypot(real(h), imag(h)); stdmath.Abs(gain-1/stdmath.Sqrt2) > 1e-9 {
+		t.Errorf("gain at cutoff = %g, want %g", gain, 1/stdmath.Sqrt2)
+	}
+}
+
+func BenchmarkBiquadCascade(b *testing.B) {
+	sections := []Biquad[float32]{
+		LowpassBiquad[float32](4000, 0.7, 48000),
+		LowpassBiquad[float32](4000, 1.3, 48000),
+	}
+	for _, channels := range []int{1, 8, 16} {
+		b.Run(fmt.Sprint(channels), func(b *testing.B) {
+			data := randomSignal[float32](4096*channels, 1)
+			f := NewBiquadCascade(sections, channels)
+			b.SetBytes(int64(4 * len(data)))
+			for b.Loop() {
+				f.Process(data)
+			}
+		})
+	}
+}
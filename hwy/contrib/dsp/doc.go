@@ -0,0 +1,51 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package dsp provides SIMD-accelerated 1D signal processing for audio and
+// sensor data: FIR filtering, convolution and cross-correlation, IIR
+// biquad cascades and sample rate conversion.
+//
+// # Functions
+//
+//	FIRFilter(signal, taps, out)  // causal filter, len(out) == len(signal)
+//	Convolve1D(a, b, out)         // full convolution, len(a)+len(b)-1 values
+//	CrossCorrelate(a, b, out)     // full cross-correlation, lag 0 at len(b)-1
+//
+// and two stateful stream processors:
+//
+//	f := dsp.NewBiquadCascade(sections, channels) // e.g. LowpassBiquad sections
+//	f.Process(interleaved)                        // in place
+//
+//	r := dsp.NewResampler[float32](44100, 16000)
+//	out = r.Process(out, block) // appends the resampled block
+//
+// # Algorithms
+//
+// Where the kernel fully overlaps the signal, ConvolveValid computes a
+// vector of outputs at a time as a sliding dot product: one FMA per tap,
+// with the tap broadcast and the signal loaded at a shifting offset. The
+// edges, where the kernel only partly overlaps, are computed in scalar
+// code.
+//
+// From FFTThreshold taps, Convolve1D and CrossCorrelate multiply the
+// spectra of the zero-padded inputs instead (see package fft). FFT
+// convolution rounds differently: results can differ from direct
+// convolution in the last bits, more so for float32.
+//
+// BiquadCascade runs each section in transposed direct form II, with SIMD
+// across channels: lane c filters channel c of the interleaved frames, so
+// a mono signal gains nothing from SIMD. Resampler is a polyphase FIR
+// filter: each output is a dot product (vec.Dot) of one phase of a
+// Kaiser-windowed sinc with the recent input.
+package dsp
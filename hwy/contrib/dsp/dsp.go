@@ -0,0 +1,146 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dsp
+
+import (
+	"math/bits"
+	"slices"
+	"sync"
+
+	"github.com/ajroetker/go-highway/hwy"
+	"github.com/ajroetker/go-highway/hwy/contrib/fft"
+)
+
+// FFTThreshold is the kernel length from which Convolve1D and
+// CrossCorrelate switch from sliding dot products to FFT convolution, whose
+// cost grows with log(len(a)+len(b)) per output instead of len(b).
+const FFTThreshold = 128
+
+// FIRFilter applies the causal FIR filter with the given taps to signal,
+// as if it were preceded by zeros:
+//
+//	out[i] = Σ_j taps[j] signal[i-j]
+//
+// out must have the length of signal. Filtering always uses sliding dot
+// products.
+func FIRFilter[T hwy.FloatsNative](signal, taps, out []T) {
+	if len(out) != len(signal) {
+		panic("dsp: FIR output length must match the signal")
+	}
+	if len(taps) == 0 {
+		clear(out)
+		return
+	}
+	head := min(len(taps)-1, len(signal))
+	convolveScalar(signal, taps, out, 0, head)
+	if head < len(signal) {
+		ConvolveValid(signal, taps, out[head:])
+	}
+}
+
+// Convolve1D stores the full convolution of a and b in out:
+//
+//	out[k] = Σ_j a[j] b[k-j], k < len(a)+len(b)-1
+//
+// out must hold len(a)+len(b)-1 values. When the shorter input has at least
+// FFTThreshold values, the convolution is computed with FFTs.
+func Convolve1D[T hwy.FloatsNative](a, b, out []T) {
+	if len(a) == 0 || len(b) == 0 {
+		return
+	}
+	if len(out) != len(a)+len(b)-1 {
+		panic("dsp: convolution output must hold len(a)+len(b)-1 values")
+	}
+	if len(b) > len(a) {
+		a, b = b, a
+	}
+	if len(b) >= FFTThreshold {
+		convolveFFT(a, b, out)
+		return
+	}
+	m := len(b)
+	convolveScalar(a, b, out, 0, m-1)
+	ConvolveValid(a, b, out[m-1:len(a)])
+	convolveScalar(a, b, out, len(a), len(out))
+}
+
+// CrossCorrelate stores the full cross-correlation of a and b in out:
+//
+//	out[k] = Σ_j a[j+k-len(b)+1] b[j], k < len(a)+len(b)-1
+//
+// so out[len(b)-1] is the correlation at lag 0. out must hold
+// len(a)+len(b)-1 values. It is the convolution of a with b reversed.
+func CrossCorrelate[T hwy.FloatsNative](a, b, out []T) {
+	reversed := slices.Clone(b)
+	slices.Reverse(reversed)
+	Convolve1D(a, reversed, out)
+}
+
+// convolveScalar stores the values of the full convolution of a and b at
+// [lo, hi) in out. It is used for the edges, where b only partly overlaps a.
+func convolveScalar[T hwy.FloatsNative](a, b, out []T, lo, hi int) {
+	for k := lo; k < hi; k++ {
+		var sum T
+		for j := max(0, k-len(a)+1); j <= min(k, len(b)-1); j++ {
+			sum += b[j] * a[k-j]
+		}
+		out[k] = sum
+	}
+}
+
+// convolveFFT computes the full convolution of a and b as the inverse FFT
+// of the product of their FFTs, zero-padded to a power of two.
+func convolveFFT[T hwy.FloatsNative](a, b, out []T) {
+	n := max(1<<bits.Len(uint(len(out)-1)), 2)
+	p := getRealPlan[T](n)
+	defer putRealPlan(p)
+	x := make([]T, n)
+	ar, ai := make([]T, n/2+1), make([]T, n/2+1)
+	br, bi := make([]T, n/2+1), make([]T, n/2+1)
+	copy(x, a)
+	p.Forward(x, ar, ai)
+	clear(x)
+	copy(x, b)
+	p.Forward(x, br, bi)
+	ComplexMul(ar, ai, br, bi)
+	p.Inverse(ar, ai, x)
+	copy(out, x)
+}
+
+// realPlans caches the plans of convolveFFT, which are costly to create,
+// by size and element type. A RealPlan holds scratch space, so each entry
+// is a pool.
+var realPlans sync.Map // planKey → *sync.Pool
+
+type planKey struct {
+	n    int
+	elem any // T(0), to tell float32 and float64 plans apart
+}
+
+func getRealPlan[T hwy.FloatsNative](n int) *fft.RealPlan[T] {
+	pool, ok := realPlans.Load(planKey{n, T(0)})
+	if !ok {
+		pool, _ = realPlans.LoadOrStore(planKey{n, T(0)}, &sync.Pool{})
+	}
+	if p, ok := pool.(*sync.Pool).Get().(*fft.RealPlan[T]); ok {
+		return p
+	}
+	return fft.NewRealPlan[T](n)
+}
+
+func putRealPlan[T hwy.FloatsNative](p *fft.RealPlan[T]) {
+	pool, _ := realPlans.Load(planKey{p.Len(), T(0)})
+	pool.(*sync.Pool).Put(p)
+}
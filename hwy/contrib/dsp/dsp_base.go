@@ -0,0 +1,115 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dsp
+
+//go:generate go run ../../../cmd/hwygen -input dsp_base.go -output . -targets avx2,avx512,neon,fallback -dispatch dsp
+
+import "github.com/ajroetker/go-highway/hwy"
+
+// BaseConvolveValid stores the fully overlapping part of the convolution of
+// x with h in out:
+//
+//	out[i] = Σ_j h[j] x[i+len(h)-1-j], i < len(x)-len(h)+1
+//
+// Each output vector is a sliding dot product: one FMA per tap, with the
+// tap broadcast and x loaded at a shifting offset. len(h) must be at least
+// 1 and out must hold len(x)-len(h)+1 values.
+func BaseConvolveValid[T hwy.FloatsNative](x, h, out []T) {
+	m := len(h)
+	n := len(x) - m + 1
+	lanes := hwy.Zero[T]().NumLanes()
+	i := 0
+	//hwy:unroll 1
+	for ; i+lanes <= n; i += lanes {
+		acc := hwy.Zero[T]()
+		for j := range m {
+			acc = hwy.MulAdd(hwy.Set(h[j]), hwy.Load(x[i+m-1-j:]), acc)
+		}
+		hwy.Store(acc, out[i:])
+	}
+	for ; i < n; i++ {
+		var sum T
+		for j := range m {
+			sum += h[j] * x[i+m-1-j]
+		}
+		out[i] = sum
+	}
+}
+
+// BaseComplexMul multiplies the complex values a by b in place. Complex
+// values are split into real and imaginary slices of the same length.
+func BaseComplexMul[T hwy.FloatsNative](ar, ai, br, bi []T) {
+	n := len(ar)
+	lanes := hwy.Zero[T]().NumLanes()
+	i := 0
+	for ; i+lanes <= n; i += lanes {
+		xr := hwy.Load(ar[i:])
+		xi := hwy.Load(ai[i:])
+		yr := hwy.Load(br[i:])
+		yi := hwy.Load(bi[i:])
+		hwy.Store(hwy.Sub(hwy.Mul(xr, yr), hwy.Mul(xi, yi)), ar[i:])
+		hwy.Store(hwy.Add(hwy.Mul(xr, yi), hwy.Mul(xi, yr)), ai[i:])
+	}
+	for ; i < n; i++ {
+		xr, xi := ar[i], ai[i]
+		ar[i] = xr*br[i] - xi*bi[i]
+		ai[i] = xr*bi[i] + xi*br[i]
+	}
+}
+
+// BaseBiquadSection runs one biquad section, in transposed direct form II, over
+// interleaved multichannel samples in place: sample t of channel c is
+// data[t*channels+c]. Each lane filters one channel:
+//
+//	y = b0 x + z1
+//	z1 = b1 x - a1 y + z2
+//	z2 = b2 x - a2 y
+//
+// z1 and z2 hold the state of each channel, and are updated.
+func BaseBiquadSection[T hwy.FloatsNative](data []T, channels int, b0, b1, b2, a1, a2 T, z1, z2 []T) {
+	frames := len(data) / channels
+	vb0 := hwy.Set(b0)
+	vb1 := hwy.Set(b1)
+	vb2 := hwy.Set(b2)
+	va1 := hwy.Set(a1)
+	va2 := hwy.Set(a2)
+	lanes := hwy.Zero[T]().NumLanes()
+	c := 0
+	//hwy:unroll 1
+	for ; c+lanes <= channels; c += lanes {
+		s1 := hwy.Load(z1[c:])
+		s2 := hwy.Load(z2[c:])
+		for t := range frames {
+			x := hwy.Load(data[t*channels+c:])
+			y := hwy.MulAdd(vb0, x, s1)
+			s1 = hwy.Sub(hwy.MulAdd(vb1, x, s2), hwy.Mul(va1, y))
+			s2 = hwy.Sub(hwy.Mul(vb2, x), hwy.Mul(va2, y))
+			hwy.Store(y, data[t*channels+c:])
+		}
+		hwy.Store(s1, z1[c:])
+		hwy.Store(s2, z2[c:])
+	}
+	for ; c < channels; c++ {
+		s1, s2 := z1[c], z2[c]
+		for t := range frames {
+			x := data[t*channels+c]
+			y := b0*x + s1
+			s1 = b1*x - a1*y + s2
+			s2 = b2*x - a2*y
+			data[t*channels+c] = y
+		}
+		z1[c], z2[c] = s1, s2
+	}
+}
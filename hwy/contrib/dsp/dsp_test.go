@@ -0,0 +1,125 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dsp
+
+import (
+	"fmt"
+	stdmath "math"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/ajroetker/go-highway/hwy"
+)
+
+func randomSignal[T hwy.FloatsNative](n int, seed uint64) []T {
+	r := rand.New(rand.NewPCG(seed, 0))
+	x := make([]T, n)
+	for i := range x {
+		x[i] = T(r.Float64()*2 - 1)
+	}
+	return x
+}
+
+// naiveConvolve returns the full convolution of a and b in float64.
+func naiveConvolve[T hwy.FloatsNative](a, b []T) []float64 {
+	out := make([]float64, len(a)+len(b)-1)
+	for i, x := range a {
+		for j, y := range b {
+			out[i+j] += float64(x) * float64(y)
+		}
+	}
+	return out
+}
+
+func checkClose[T hwy.FloatsNative](t *testing.T, name string, got []T, want []float64, tol float64) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("%s: got %d values, want %d", name, len(got), len(want))
+	}
+	for i := range want {
+		if d := stdmath.Abs(float64(got[i]) - want[i]); d > tol*max(1, stdmath.Abs(want[i])) {
+			t.Errorf("%s: [%d] = %g, want %g", name, i, got[i], want[i])
+			return
+		}
+	}
+}
+
+var convSizes = [][2]int{
+	{1, 1}, {5, 1}, {1, 5}, {7, 3}, {3, 7}, {100, 9}, {64, 64},
+	{300, 31}, {1000, FFTThreshold - 1}, {1000, FFTThreshold}, {2000, 500},
+}
+
+func TestConvolve1D(t *testing.T) {
+	for _, size := range convSizes {
+		a := randomSignal[float32](size[0], 1)
+		b := randomSignal[float32](size[1], 2)
+		got := make([]float32, len(a)+len(b)-1)
+		Convolve1D(a, b, got)
+		checkClose(t, fmt.Sprintf("Convolve1D %v", size), got, naiveConvolve(a, b), 1e-4)
+
+		a64 := randomSignal[float64](size[0], 1)
+		b64 := randomSignal[float64](size[1], 2)
+		got64 := make([]float64, len(a64)+len(b64)-1)
+		Convolve1D(a64, b64, got64)
+		checkClose(t, fmt.Sprintf("Convolve1D[float64] %v", size), got64, naiveConvolve(a64, b64), 1e-10)
+	}
+}
+
+func TestCrossCorrelate(t *testing.T) {
+	for _, size := range convSizes {
+		a := randomSignal[float32](size[0], 3)
+		b := randomSignal[float32](size[1], 4)
+		want := make([]float64, len(a)+len(b)-1)
+		for k := range want {
+			for j := range b {
+				if i := j + k - len(b) + 1; i >= 0 && i < len(a) {
+					want[k] += float64(a[i]) * float64(b[j])
+				}
+			}
+		}
+		got := make([]float32, len(want))
+		CrossCorrelate(a, b, got)
+		checkClose(t, fmt.Sprintf("CrossCorrelate %v", size), got, want, 1e-4)
+	}
+}
+
+func TestFIRFilter(t *testing.T) {
+	for _, size := range [][2]int{{0, 3}, {1, 3}, {2, 5}, {10, 0}, {10, 1}, {100, 7}, {1000, 65}} {
+		signal := randomSignal[float32](size[0], 5)
+		taps := randomSignal[float32](size[1], 6)
+		got := make([]float32, len(signal))
+		FIRFilter(signal, taps, got)
+		want := make([]float64, len(signal))
+		if len(taps) > 0 {
+			want = naiveConvolve(signal, taps)[:len(signal)]
+		}
+		checkClose(t, fmt.Sprintf("FIRFilter %v", size), got, want, 1e-4)
+	}
+}
+
+func BenchmarkConvolve1D(b *testing.B) {
+	const n = 1 << 14
+	for _, m := range []int{16, 64, FFTThreshold - 1, FFTThreshold, 512} {
+		b.Run(fmt.Sprint(m), func(b *testing.B) {
+			x := randomSignal[float32](n, 1)
+			h := randomSignal[float32](m, 2)
+			out := make([]float32, n+m-1)
+			b.SetBytes(int64(4 * n))
+			for b.Loop() {
+				Convolve1D(x, h, out)
+			}
+		})
+	}
+}
@@ -0,0 +1,149 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dsp
+
+import (
+	stdmath "math"
+
+	"github.com/ajroetker/go-highway/hwy"
+	"github.com/ajroetker/go-highway/hwy/contrib/vec"
+)
+
+// Parameters of the anti-aliasing filter of Resampler: a Kaiser-windowed
+// sinc with resampleZeroCrossings zero crossings on each side, and its
+// cutoff at resampleRolloff times the lower Nyquist frequency.
+const (
+	resampleZeroCrossings = 16
+	resampleRolloff       = 0.9
+	resampleKaiserBeta    = 8.6 // about 90 dB stopband attenuation
+)
+
+// Resampler converts a mono signal between two sample rates with a
+// polyphase FIR filter. The rate ratio is reduced to up/down, e.g.
+// 160/441 for 44.1 kHz to 16 kHz: conceptually the signal is upsampled by
+// up, low-pass filtered and downsampled by down, but only the filter taps
+// that meet nonzero input samples of the kept outputs are evaluated, as a
+// dot product per output.
+//
+// The filter is linear-phase: the output is delayed by Delay input
+// samples. A Resampler keeps the tail of its input between calls, so a
+// stream can be processed in blocks of any size; it must not be used
+// concurrently.
+type Resampler[T hwy.FloatsNative] struct {
+	up, down int
+	phases   [][]T // taps of each phase, reversed for the dot products
+	history  []T   // the last len(phases[0])-1 input samples
+	pos      int   // position of the next output in the upsampled signal, relative to the next input
+	buf      []T
+}
+
+// NewResampler returns a Resampler from inRate to outRate samples per
+// second. Both must be positive.
+func NewResampler[T hwy.FloatsNative](inRate, outRate int) *Resampler[T] {
+	if inRate <= 0 || outRate <= 0 {
+		panic("dsp: sample rates must be positive")
+	}
+	g := gcd(inRate, outRate)
+	up, down := outRate/g, inRate/g
+
+	// The prototype filter runs at the upsampled rate, where the lower of
+	// the two Nyquist frequencies is 0.5/max(up, down) cycles per sample.
+	factor := max(up, down)
+	cutoff := resampleRolloff * 0.5 / float64(factor)
+	taps := (2*resampleZeroCrossings*factor + up) / up // per phase
+	n := taps * up
+	center := float64(2*resampleZeroCrossings*factor) / 2
+	i0Beta := besselI0(resampleKaiserBeta)
+
+	phases := make([][]T, up)
+	for p := range phases {
+		phases[p] = make([]T, taps)
+	}
+	for i := range n {
+		x := float64(i) - center
+		if stdmath.Abs(x) > center {
+			continue
+		}
+		r := x / center
+		w := besselI0(resampleKaiserBeta*stdmath.Sqrt(1-r*r)) / i0Beta
+		h := 2 * cutoff * sinc(2*cutoff*x) * w * float64(up)
+		// Tap j of phase p is tap p+j*up of the prototype.
+		p, j := i%up, i/up
+		phases[p][taps-1-j] = T(h)
+	}
+	return &Resampler[T]{
+		up:      up,
+		down:    down,
+		phases:  phases,
+		history: make([]T, taps-1),
+	}
+}
+
+// Ratio returns the reduced ratio of the output rate to the input rate.
+func (r *Resampler[T]) Ratio() (up, down int) { return r.up, r.down }
+
+// Delay returns the delay of the filter, in input samples.
+func (r *Resampler[T]) Delay() float64 {
+	return float64(resampleZeroCrossings*max(r.up, r.down)) / float64(r.up)
+}
+
+// Process resamples in, the continuation of the signal of the previous
+// calls, appends the output samples it completes to dst and returns the
+// extended slice. Over a stream, the number of outputs follows the rate
+// ratio: n inputs yield about n*up/down outputs.
+func (r *Resampler[T]) Process(dst, in []T) []T {
+	taps := len(r.phases[0])
+	r.buf = append(append(r.buf[:0], r.history...), in...)
+	// The output at upsampled position pos, in phase pos%up, ends at input
+	// sample pos/up, which is buf[pos/up+taps-1].
+	for ; r.pos/r.up < len(in); r.pos += r.down {
+		i := r.pos / r.up
+		dst = append(dst, vec.Dot(r.phases[r.pos%r.up], r.buf[i:i+taps]))
+	}
+	r.pos -= len(in) * r.up
+	copy(r.history, r.buf[len(r.buf)-len(r.history):])
+	return dst
+}
+
+// Reset clears the history of r, as before the first call of Process.
+func (r *Resampler[T]) Reset() {
+	clear(r.history)
+	r.pos = 0
+}
+
+func gcd(a, b int) int {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
+
+func sinc(x float64) float64 {
+	if x == 0 {
+		return 1
+	}
+	return stdmath.Sin(stdmath.Pi*x) / (stdmath.Pi * x)
+}
+
+// besselI0 returns the modified Bessel function of the first kind of order
+// 0, from its power series.
+func besselI0(x float64) float64 {
+	sum, term := 1.0, 1.0
+	for k := 1; term > 1e-12*sum; k++ {
+		term *= (x / (2 * float64(k))) * (x / (2 * float64(k)))
+		sum += term
+	}
+	return sum
+}
@@ -0,0 +1,83 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dsp
+
+import (
+	"fmt"
+	stdmath "math"
+	"testing"
+)
+
+func TestResampler(t *testing.T) {
+	for _, rates := range [][2]int{{44100, 16000}, {16000, 48000}, {48000, 16000}, {22050, 44100}} {
+		t.Run(fmt.Sprintf("%d-%d", rates[0], rates[1]), func(t *testing.T) {
+			in, out := float64(rates[0]), float64(rates[1])
+			const freq = 440
+			signal := make([]float32, rates[0]/4)
+			for i := range signal {
+				signal[i] = float32(stdmath.Sin(2 * stdmath.Pi * freq * float64(i) / in))
+			}
+
+			r := NewResampler[float32](rates[0], rates[1])
+			whole := r.Process(nil, signal)
+			up, down := r.Ratio()
+			if want := len(signal) * up / down; len(whole) < want-1 || len(whole) > want+1 {
+				t.Errorf("got %d outputs, want about %d", len(whole), want)
+			}
+
+			// Away from the start-up transient, the output is the sine,
+			// delayed by the filter.
+			delay := r.Delay() / in
+			for k := len(whole) / 4; k < len(whole); k++ {
+				want := stdmath.Sin(2 * stdmath.Pi * freq * (float64(k)/out - delay))
+				if d := stdmath.Abs(float64(whole[k]) - want); d > 2e-3 {
+					t.Fatalf("output %d = %g, want %g", k, whole[k], want)
+				}
+			}
+
+			// Processing in blocks gives the same output.
+			r.Reset()
+			var blocks []float32
+			for start := 0; start < len(signal); start += 1000 {
+				blocks = r.Process(blocks, signal[start:min(start+1000, len(signal))])
+			}
+			if len(blocks) != len(whole) {
+				t.Fatalf("blocks: got %d outputs, want %d", len(blocks), len(whole))
+			}
+			for k := range whole {
+				if stdmath.Abs(float64(blocks[k]-whole[k])) > 1e-6 {
+					t.Fatalf("blocks: output %d = %g, want %g", k, blocks[k], whole[k])
+				}
+			}
+		})
+	}
+}
+
+func TestResamplerRatio(t *testing.T) {
+	r := NewResampler[float32](44100, 16000)
+	if up, down := r.Ratio(); up != 160 || down != 441 {
+		t.Errorf("Ratio() = %d/%d, want 160/441", up, down)
+	}
+}
+
+func BenchmarkResampler(b *testing.B) {
+	signal := randomSignal[float32](44100, 1)
+	r := NewResampler[float32](44100, 16000)
+	out := make([]float32, 0, 16001)
+	b.SetBytes(int64(4 * len(signal)))
+	for b.Loop() {
+		out = r.Process(out[:0], signal)
+	}
+}
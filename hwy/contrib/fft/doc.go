@@ -0,0 +1,53 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package fft provides SIMD-accelerated fast Fourier transforms of
+// power-of-two sizes, for spectrograms and convolution by FFT.
+//
+// # Complex FFT
+//
+// A Plan holds the bit-reversal permutation and twiddle factors of one
+// size. Complex values are split into real and imaginary slices, which
+// lets every butterfly work on full vectors:
+//
+//	p := fft.NewPlan[float32](1024)
+//	p.Forward(re, im) // in place
+//	p.Inverse(re, im) // scaled by 1/n: undoes Forward
+//
+// The transform is an iterative decimation-in-time FFT: the first two
+// stages run as plain 4-point DFTs, the following ones are fused in pairs
+// into radix-4 passes (Radix4), with a final radix-2 pass (Radix2) when
+// the number of stages is odd. Stages whose blocks are narrower than a
+// vector run the scalar tails of the kernels.
+//
+// # Real FFT
+//
+// A RealPlan computes the FFT of n real values with a complex FFT of size
+// n/2, and returns the n/2+1 non-redundant values of the spectrum:
+//
+//	p := fft.NewRealPlan[float32](1024)
+//	p.Forward(x, re, im) // len(x) == 1024, len(re) == len(im) == 513
+//	p.Inverse(re, im, x)
+//
+// Splitting the complex spectrum into those of the even and odd samples
+// pairs bin k with bin n/2-k; RealPost and RealPre load the partners with
+// the Reverse shuffle.
+//
+// # Precision
+//
+// The twiddle factors of float32 plans are computed with the SIMD sine and
+// cosine kernels of package algo, and carry their error of a few ULP;
+// float64 plans use package math. The error of a transform grows with
+// log2(n): use float64 plans when that matters.
+package fft
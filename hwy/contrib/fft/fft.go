@@ -0,0 +1,247 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fft
+
+import (
+	stdmath "math"
+	"math/bits"
+
+	"github.com/ajroetker/go-highway/hwy"
+	"github.com/ajroetker/go-highway/hwy/contrib/algo"
+	"github.com/ajroetker/go-highway/hwy/contrib/vec"
+)
+
+// Plan computes complex FFTs of one power-of-two size. Complex values are
+// split into a slice of real parts and a slice of imaginary parts.
+//
+// A Plan is immutable once created and may be used concurrently.
+type Plan[T hwy.FloatsNative] struct {
+	n     int
+	swaps []int32 // index pairs exchanged by the bit-reversal permutation
+	// Twiddle factors exp(-πij/m), j < m, of the stage with half size m,
+	// at [m-1 : 2m-1].
+	twr, twi []T
+}
+
+// NewPlan returns a Plan for complex FFTs of size n. It panics if n is not
+// a power of two.
+func NewPlan[T hwy.FloatsNative](n int) *Plan[T] {
+	if n <= 0 || n&(n-1) != 0 {
+		panic("fft: size must be a power of two")
+	}
+	p := &Plan[T]{n: n}
+	shift := 64 - bits.Len(uint(n)) + 1
+	for i := range n {
+		if j := int(bits.Reverse64(uint64(i)) >> shift); i < j {
+			p.swaps = append(p.swaps, int32(i), int32(j))
+		}
+	}
+	if n > 1 {
+		angles := make([]T, n-1)
+		for m := 1; m < n; m *= 2 {
+			for j := range m {
+				angles[m-1+j] = T(-stdmath.Pi * float64(j) / float64(m))
+			}
+		}
+		p.twr, p.twi = twiddles(angles)
+	}
+	return p
+}
+
+// twiddles returns cos and sin of angles. float32 plans use the SIMD
+// kernels of package algo. Their float64 versions are only accurate to
+// about 1e-8, so float64 plans use package math.
+func twiddles[T hwy.FloatsNative](angles []T) (re, im []T) {
+	re = make([]T, len(angles))
+	im = make([]T, len(angles))
+	if _, ok := any(angles).([]float32); ok {
+		algo.CosTransform(angles, re)
+		algo.SinTransform(angles, im)
+		return re, im
+	}
+	for i, a := range angles {
+		s, c := stdmath.Sincos(float64(a))
+		re[i], im[i] = T(c), T(s)
+	}
+	return re, im
+}
+
+// Len returns the size of the FFTs computed by p.
+func (p *Plan[T]) Len() int { return p.n }
+
+// Forward replaces re, im with their discrete Fourier transform:
+//
+//	X[k] = Σ x[j] exp(-2πijk/n)
+//
+// re and im must have length p.Len().
+func (p *Plan[T]) Forward(re, im []T) {
+	if len(re) != p.n || len(im) != p.n {
+		panic("fft: slice length does not match plan size")
+	}
+	for i := 0; i < len(p.swaps); i += 2 {
+		a, b := p.swaps[i], p.swaps[i+1]
+		re[a], re[b] = re[b], re[a]
+		im[a], im[b] = im[b], im[a]
+	}
+
+	// The first two stages have trivial twiddle factors: run them together
+	// as plain 4-point DFTs.
+	m := 1
+	if p.n >= 4 {
+		dft4(re, im)
+		m = 4
+	}
+	for ; 4*m <= p.n; m *= 4 {
+		w1r, w1i := p.twr[m-1:2*m-1], p.twi[m-1:2*m-1]
+		w2r, w2i := p.twr[2*m-1:3*m-1], p.twi[2*m-1:3*m-1]
+		for k := 0; k < p.n; k += 4 * m {
+			Radix4(re[k:k+4*m], im[k:k+4*m], w1r, w1i, w2r, w2i)
+		}
+	}
+	if 2*m <= p.n {
+		wr, wi := p.twr[m-1:2*m-1], p.twi[m-1:2*m-1]
+		for k := 0; k < p.n; k += 2 * m {
+			Radix2(re[k:k+m], im[k:k+m], re[k+m:k+2*m], im[k+m:k+2*m], wr, wi)
+		}
+	}
+}
+
+// Inverse replaces re, im with their inverse discrete Fourier transform,
+// scaled so that Inverse undoes Forward:
+//
+//	x[j] = 1/n Σ X[k] exp(2πijk/n)
+//
+// re and im must have length p.Len().
+func (p *Plan[T]) Inverse(re, im []T) {
+	// Swapping the real and imaginary parts maps z to i conj(z), and
+	// FFT(i conj(z)) = i conj(n IFFT(z)).
+	p.Forward(im, re)
+	vec.Scale(T(1)/T(p.n), re)
+	vec.Scale(T(1)/T(p.n), im)
+}
+
+// dft4 computes the 4-point DFTs of consecutive groups of 4 values.
+func dft4[T hwy.FloatsNative](re, im []T) {
+	for k := 0; k+4 <= len(re); k += 4 {
+		r, i := re[k:k+4:k+4], im[k:k+4:k+4]
+		a0r, a0i := r[0]+r[1], i[0]+i[1]
+		a1r, a1i := r[0]-r[1], i[0]-i[1]
+		a2r, a2i := r[2]+r[3], i[2]+i[3]
+		a3r, a3i := r[2]-r[3], i[2]-i[3]
+		r[0], i[0] = a0r+a2r, a0i+a2i
+		r[2], i[2] = a0r-a2r, a0i-a2i
+		r[1], i[1] = a1r+a3i, a1i-a3r
+		r[3], i[3] = a1r-a3i, a1i+a3r
+	}
+}
+
+// RealPlan computes FFTs of real input of one power-of-two size n, using a
+// complex FFT of size n/2. The spectrum of real input is conjugate
+// symmetric, so only its first n/2+1 values are computed.
+//
+// A RealPlan holds scratch space: it must not be used concurrently.
+type RealPlan[T hwy.FloatsNative] struct {
+	n      int
+	half   *Plan[T]
+	wr, wi []T // exp(-2πik/n), k < n/2
+	zr, zi []T // scratch for the complex FFT
+}
+
+// NewRealPlan returns a RealPlan for real FFTs of size n. It panics if n is
+// not a power of two of at least 2.
+func NewRealPlan[T hwy.FloatsNative](n int) *RealPlan[T] {
+	if n < 2 || n&(n-1) != 0 {
+		panic("fft: real size must be a power of two of at least 2")
+	}
+	h := n / 2
+	p := &RealPlan[T]{
+		n:    n,
+		half: NewPlan[T](h),
+		zr:   make([]T, h),
+		zi:   make([]T, h),
+	}
+	angles := make([]T, h)
+	for k := range angles {
+		angles[k] = T(-2 * stdmath.Pi * float64(k) / float64(n))
+	}
+	p.wr, p.wi = twiddles(angles)
+	return p
+}
+
+// Len returns the size of the real FFTs computed by p.
+func (p *RealPlan[T]) Len() int { return p.n }
+
+// Forward stores the first n/2+1 values of the discrete Fourier transform
+// of x in re, im. x must have length p.Len() and re, im length p.Len()/2+1.
+func (p *RealPlan[T]) Forward(x, re, im []T) {
+	h := p.n / 2
+	if len(x) != p.n || len(re) != h+1 || len(im) != h+1 {
+		panic("fft: slice length does not match plan size")
+	}
+	for k := range h {
+		p.zr[k], p.zi[k] = x[2*k], x[2*k+1]
+	}
+	p.half.Forward(p.zr, p.zi)
+	re[0], im[0] = p.zr[0]+p.zi[0], 0
+	re[h], im[h] = p.zr[0]-p.zi[0], 0
+	RealPost(p.zr[1:], p.zi[1:], p.wr[1:], p.wi[1:], re[1:h], im[1:h])
+}
+
+// Inverse stores in x the real signal whose spectrum has the first n/2+1
+// values re, im, so that Inverse undoes Forward. The imaginary parts of
+// re[0] and re[n/2] are ignored. x must have length p.Len() and re, im
+// length p.Len()/2+1.
+func (p *RealPlan[T]) Inverse(re, im, x []T) {
+	h := p.n / 2
+	if len(x) != p.n || len(re) != h+1 || len(im) != h+1 {
+		panic("fft: slice length does not match plan size")
+	}
+	im0, imh := im[0], im[h]
+	im[0], im[h] = 0, 0
+	RealPre(re, im, p.wr, p.wi, p.zr, p.zi)
+	im[0], im[h] = im0, imh
+	p.half.Inverse(p.zr, p.zi)
+	for k := range h {
+		x[2*k], x[2*k+1] = p.zr[k], p.zi[k]
+	}
+}
+
+// realPostScalar is the scalar version of BaseRealPost, from index j on.
+func realPostScalar[T hwy.FloatsNative](zr, zi, wr, wi, xr, xi []T, j int) {
+	n := len(zr)
+	for ; j < n; j++ {
+		ar, ai := zr[j], zi[j]
+		br, bi := zr[n-1-j], zi[n-1-j]
+		er, ei := (ar+br)/2, (ai-bi)/2
+		or, oi := (ai+bi)/2, (br-ar)/2
+		xr[j] = er + or*wr[j] - oi*wi[j]
+		xi[j] = ei + oi*wr[j] + or*wi[j]
+	}
+}
+
+// realPreScalar is the scalar version of BaseRealPre, from index j on.
+func realPreScalar[T hwy.FloatsNative](xr, xi, wr, wi, zr, zi []T, j int) {
+	n := len(xr)
+	for ; j < len(zr); j++ {
+		ar, ai := xr[j], xi[j]
+		br, bi := xr[n-1-j], xi[n-1-j]
+		er, ei := (ar+br)/2, (ai-bi)/2
+		fr, fi := (ar-br)/2, (ai+bi)/2
+		or := fr*wr[j] + fi*wi[j]
+		oi := fi*wr[j] - fr*wi[j]
+		zr[j] = er - oi
+		zi[j] = ei + or
+	}
+}
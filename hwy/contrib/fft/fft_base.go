@@ -0,0 +1,196 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fft
+
+//go:generate go run ../../../cmd/hwygen -input fft_base.go -output . -targets avx2,avx512,neon,fallback -dispatch fft
+
+import "github.com/ajroetker/go-highway/hwy"
+
+// BaseRadix2 applies the radix-2 decimation-in-time butterfly to the pairs
+// (a[j], b[j]) with twiddle factors w[j]:
+//
+//	a[j], b[j] = a[j] + w[j] b[j], a[j] - w[j] b[j]
+//
+// Complex values are split into real and imaginary slices, which must all
+// have the length of wr.
+func BaseRadix2[T hwy.FloatsNative](ar, ai, br, bi, wr, wi []T) {
+	m := len(wr)
+	lanes := hwy.Zero[T]().NumLanes()
+	j := 0
+	for ; j+lanes <= m; j += lanes {
+		cr := hwy.Load(wr[j:])
+		ci := hwy.Load(wi[j:])
+		yr := hwy.Load(br[j:])
+		yi := hwy.Load(bi[j:])
+		tr := hwy.Sub(hwy.Mul(yr, cr), hwy.Mul(yi, ci))
+		ti := hwy.Add(hwy.Mul(yr, ci), hwy.Mul(yi, cr))
+		xr := hwy.Load(ar[j:])
+		xi := hwy.Load(ai[j:])
+		hwy.Store(hwy.Add(xr, tr), ar[j:])
+		hwy.Store(hwy.Add(xi, ti), ai[j:])
+		hwy.Store(hwy.Sub(xr, tr), br[j:])
+		hwy.Store(hwy.Sub(xi, ti), bi[j:])
+	}
+	for ; j < m; j++ {
+		tr := br[j]*wr[j] - bi[j]*wi[j]
+		ti := br[j]*wi[j] + bi[j]*wr[j]
+		xr, xi := ar[j], ai[j]
+		ar[j], ai[j] = xr+tr, xi+ti
+		br[j], bi[j] = xr-tr, xi-ti
+	}
+}
+
+// BaseRadix4 applies two consecutive radix-2 decimation-in-time stages,
+// with half sizes m and 2m, to one block of 4m complex values in a single
+// pass. w1 holds the m twiddle factors of the first stage and w2 the first
+// m twiddle factors of the second one; its other m are -i w2.
+func BaseRadix4[T hwy.FloatsNative](re, im, w1r, w1i, w2r, w2i []T) {
+	m := len(w1r)
+	r0, r1, r2, r3 := re[:m], re[m:2*m], re[2*m:3*m], re[3*m:4*m]
+	i0, i1, i2, i3 := im[:m], im[m:2*m], im[2*m:3*m], im[3*m:4*m]
+	lanes := hwy.Zero[T]().NumLanes()
+	j := 0
+	for ; j+lanes <= m; j += lanes {
+		// First stage: (x0, x1) and (x2, x3) with w1.
+		cr := hwy.Load(w1r[j:])
+		ci := hwy.Load(w1i[j:])
+		x0r := hwy.Load(r0[j:])
+		x0i := hwy.Load(i0[j:])
+		x1r := hwy.Load(r1[j:])
+		x1i := hwy.Load(i1[j:])
+		tr := hwy.Sub(hwy.Mul(x1r, cr), hwy.Mul(x1i, ci))
+		ti := hwy.Add(hwy.Mul(x1r, ci), hwy.Mul(x1i, cr))
+		a0r := hwy.Add(x0r, tr)
+		a0i := hwy.Add(x0i, ti)
+		a1r := hwy.Sub(x0r, tr)
+		a1i := hwy.Sub(x0i, ti)
+		x2r := hwy.Load(r2[j:])
+		x2i := hwy.Load(i2[j:])
+		x3r := hwy.Load(r3[j:])
+		x3i := hwy.Load(i3[j:])
+		tr = hwy.Sub(hwy.Mul(x3r, cr), hwy.Mul(x3i, ci))
+		ti = hwy.Add(hwy.Mul(x3r, ci), hwy.Mul(x3i, cr))
+		a2r := hwy.Add(x2r, tr)
+		a2i := hwy.Add(x2i, ti)
+		a3r := hwy.Sub(x2r, tr)
+		a3i := hwy.Sub(x2i, ti)
+
+		// Second stage: (a0, a2) with w2 and (a1, a3) with -i w2.
+		cr = hwy.Load(w2r[j:])
+		ci = hwy.Load(w2i[j:])
+		tr = hwy.Sub(hwy.Mul(a2r, cr), hwy.Mul(a2i, ci))
+		ti = hwy.Add(hwy.Mul(a2r, ci), hwy.Mul(a2i, cr))
+		hwy.Store(hwy.Add(a0r, tr), r0[j:])
+		hwy.Store(hwy.Add(a0i, ti), i0[j:])
+		hwy.Store(hwy.Sub(a0r, tr), r2[j:])
+		hwy.Store(hwy.Sub(a0i, ti), i2[j:])
+		tr = hwy.Sub(hwy.Mul(a3r, cr), hwy.Mul(a3i, ci))
+		ti = hwy.Add(hwy.Mul(a3r, ci), hwy.Mul(a3i, cr))
+		hwy.Store(hwy.Add(a1r, ti), r1[j:])
+		hwy.Store(hwy.Sub(a1i, tr), i1[j:])
+		hwy.Store(hwy.Sub(a1r, ti), r3[j:])
+		hwy.Store(hwy.Add(a1i, tr), i3[j:])
+	}
+	for ; j < m; j++ {
+		cr, ci := w1r[j], w1i[j]
+		tr := r1[j]*cr - i1[j]*ci
+		ti := r1[j]*ci + i1[j]*cr
+		a0r, a0i := r0[j]+tr, i0[j]+ti
+		a1r, a1i := r0[j]-tr, i0[j]-ti
+		tr = r3[j]*cr - i3[j]*ci
+		ti = r3[j]*ci + i3[j]*cr
+		a2r, a2i := r2[j]+tr, i2[j]+ti
+		a3r, a3i := r2[j]-tr, i2[j]-ti
+
+		cr, ci = w2r[j], w2i[j]
+		tr = a2r*cr - a2i*ci
+		ti = a2r*ci + a2i*cr
+		r0[j], i0[j] = a0r+tr, a0i+ti
+		r2[j], i2[j] = a0r-tr, a0i-ti
+		tr = a3r*cr - a3i*ci
+		ti = a3r*ci + a3i*cr
+		r1[j], i1[j] = a1r+ti, a1i-tr
+		r3[j], i3[j] = a1r-ti, a1i+tr
+	}
+}
+
+// BaseRealPost turns the FFT Z of the n/2 complex values z[k] = x[2k] +
+// i x[2k+1] into the spectrum X of the n real values x. For each j it
+// combines Z[j] with Z[len(zr)-1-j], the partner of j in reverse order:
+//
+//	E = (Z[j] + conj(Z[len-1-j])) / 2
+//	O = (Z[j] - conj(Z[len-1-j])) / 2i
+//	X[j] = E + w[j] O
+//
+// The caller passes Z[1:], X[1:n/2] and w[1:], with w[k] = exp(-2πik/n);
+// X[0] and X[n/2] only depend on Z[0]. The partners are loaded with Reverse.
+func BaseRealPost[T hwy.FloatsNative](zr, zi, wr, wi, xr, xi []T) {
+	n := len(zr)
+	half := hwy.Set[T](0.5)
+	lanes := hwy.Zero[T]().NumLanes()
+	j := 0
+	//hwy:unroll 1
+	for ; j+lanes <= n; j += lanes {
+		ar := hwy.Load(zr[j:])
+		ai := hwy.Load(zi[j:])
+		br := hwy.Reverse(hwy.Load(zr[n-j-lanes:]))
+		bi := hwy.Reverse(hwy.Load(zi[n-j-lanes:]))
+		er := hwy.Mul(hwy.Add(ar, br), half)
+		ei := hwy.Mul(hwy.Sub(ai, bi), half)
+		or := hwy.Mul(hwy.Add(ai, bi), half)
+		oi := hwy.Mul(hwy.Sub(br, ar), half)
+		cr := hwy.Load(wr[j:])
+		ci := hwy.Load(wi[j:])
+		hwy.Store(hwy.Add(er, hwy.Sub(hwy.Mul(or, cr), hwy.Mul(oi, ci))), xr[j:])
+		hwy.Store(hwy.Add(ei, hwy.Add(hwy.Mul(oi, cr), hwy.Mul(or, ci))), xi[j:])
+	}
+	realPostScalar(zr, zi, wr, wi, xr, xi, j)
+}
+
+// BaseRealPre is the inverse of BaseRealPost: it turns the spectrum X of n
+// real values into the FFT Z of the n/2 complex values z[k] = x[2k] +
+// i x[2k+1]. For each j it combines X[j] with X[len(xr)-1-j]:
+//
+//	E = (X[j] + conj(X[len-1-j])) / 2
+//	O = (X[j] - conj(X[len-1-j])) / 2 * conj(w[j])
+//	Z[j] = E + i O
+//
+// The caller passes X[0:n/2+1], Z[0:n/2] and w[0:n/2], with
+// w[k] = exp(-2πik/n).
+func BaseRealPre[T hwy.FloatsNative](xr, xi, wr, wi, zr, zi []T) {
+	n := len(xr)
+	m := len(zr)
+	half := hwy.Set[T](0.5)
+	lanes := hwy.Zero[T]().NumLanes()
+	j := 0
+	//hwy:unroll 1
+	for ; j+lanes <= m; j += lanes {
+		ar := hwy.Load(xr[j:])
+		ai := hwy.Load(xi[j:])
+		br := hwy.Reverse(hwy.Load(xr[n-j-lanes:]))
+		bi := hwy.Reverse(hwy.Load(xi[n-j-lanes:]))
+		er := hwy.Mul(hwy.Add(ar, br), half)
+		ei := hwy.Mul(hwy.Sub(ai, bi), half)
+		fr := hwy.Mul(hwy.Sub(ar, br), half)
+		fi := hwy.Mul(hwy.Add(ai, bi), half)
+		cr := hwy.Load(wr[j:])
+		ci := hwy.Load(wi[j:])
+		or := hwy.Add(hwy.Mul(fr, cr), hwy.Mul(fi, ci))
+		oi := hwy.Sub(hwy.Mul(fi, cr), hwy.Mul(fr, ci))
+		hwy.Store(hwy.Sub(er, oi), zr[j:])
+		hwy.Store(hwy.Add(ei, or), zi[j:])
+	}
+	realPreScalar(xr, xi, wr, wi, zr, zi, j)
+}
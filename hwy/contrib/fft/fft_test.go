@@ -0,0 +1,165 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fft
+
+import (
+	"fmt"
+	stdmath "math"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/ajroetker/go-highway/hwy"
+)
+
+// naiveDFT returns the discrete Fourier transform of re, im, computed in
+// float64 from the definition.
+func naiveDFT[T hwy.FloatsNative](re, im []T) (outRe, outIm []float64) {
+	n := len(re)
+	outRe = make([]float64, n)
+	outIm = make([]float64, n)
+	for k := range n {
+		for j := range n {
+			s, c := stdmath.Sincos(-2 * stdmath.Pi * float64(j*k%n) / float64(n))
+			outRe[k] += float64(re[j])*c - float64(im[j])*s
+			outIm[k] += float64(re[j])*s + float64(im[j])*c
+		}
+	}
+	return outRe, outIm
+}
+
+// relError returns the RMS of the difference between got and want,
+// relative to the RMS of want.
+func relError[T hwy.FloatsNative](gotRe, gotIm []T, wantRe, wantIm []float64) float64 {
+	var diff, norm float64
+	for i := range wantRe {
+		dr, di := float64(gotRe[i])-wantRe[i], float64(gotIm[i])-wantIm[i]
+		diff += dr*dr + di*di
+		norm += wantRe[i]*wantRe[i] + wantIm[i]*wantIm[i]
+	}
+	if norm == 0 {
+		return stdmath.Sqrt(diff)
+	}
+	return stdmath.Sqrt(diff / norm)
+}
+
+func randomSignal[T hwy.FloatsNative](n int, seed uint64) []T {
+	r := rand.New(rand.NewPCG(seed, 0))
+	x := make([]T, n)
+	for i := range x {
+		x[i] = T(r.Float64()*2 - 1)
+	}
+	return x
+}
+
+var testSizes = []int{1, 2, 4, 8, 16, 32, 64, 128, 256, 512, 1024}
+
+func testPlan[T hwy.FloatsNative](tol float64) func(*testing.T) {
+	return func(t *testing.T) {
+		for _, n := range testSizes {
+			re, im := randomSignal[T](n, 1), randomSignal[T](n, 2)
+			origRe, origIm := append([]T(nil), re...), append([]T(nil), im...)
+			wantRe, wantIm := naiveDFT(re, im)
+
+			p := NewPlan[T](n)
+			p.Forward(re, im)
+			if err := relError(re, im, wantRe, wantIm); err > tol {
+				t.Errorf("n=%d: Forward error %g, want <= %g", n, err, tol)
+			}
+			p.Inverse(re, im)
+			if err := relError(re, im, toFloat64(origRe), toFloat64(origIm)); err > tol {
+				t.Errorf("n=%d: Inverse(Forward) error %g, want <= %g", n, err, tol)
+			}
+		}
+	}
+}
+
+func toFloat64[T hwy.FloatsNative](x []T) []float64 {
+	out := make([]float64, len(x))
+	for i, v := range x {
+		out[i] = float64(v)
+	}
+	return out
+}
+
+func TestPlan(t *testing.T) {
+	t.Run("float32", testPlan[float32](1e-6))
+	t.Run("float64", testPlan[float64](1e-14))
+}
+
+func testRealPlan[T hwy.FloatsNative](tol float64) func(*testing.T) {
+	return func(t *testing.T) {
+		for _, n := range testSizes[1:] {
+			x := randomSignal[T](n, 3)
+			wantRe, wantIm := naiveDFT(x, make([]T, n))
+
+			p := NewRealPlan[T](n)
+			re, im := make([]T, n/2+1), make([]T, n/2+1)
+			p.Forward(x, re, im)
+			if err := relError(re, im, wantRe[:n/2+1], wantIm[:n/2+1]); err > tol {
+				t.Errorf("n=%d: Forward error %g, want <= %g", n, err, tol)
+			}
+			got := make([]T, n)
+			p.Inverse(re, im, got)
+			if err := relError(got, make([]T, n), toFloat64(x), make([]float64, n)); err > tol {
+				t.Errorf("n=%d: Inverse(Forward) error %g, want <= %g", n, err, tol)
+			}
+		}
+	}
+}
+
+func TestRealPlan(t *testing.T) {
+	t.Run("float32", testRealPlan[float32](1e-6))
+	t.Run("float64", testRealPlan[float64](1e-14))
+}
+
+func TestNewPlanPanics(t *testing.T) {
+	for _, n := range []int{0, 3, 12, -4} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("NewPlan(%d) did not panic", n)
+				}
+			}()
+			NewPlan[float32](n)
+		}()
+	}
+}
+
+func BenchmarkForward(b *testing.B) {
+	for _, n := range []int{256, 4096, 65536} {
+		b.Run(fmt.Sprint(n), func(b *testing.B) {
+			p := NewPlan[float32](n)
+			re, im := randomSignal[float32](n, 1), randomSignal[float32](n, 2)
+			b.SetBytes(int64(8 * n))
+			for b.Loop() {
+				p.Forward(re, im)
+			}
+		})
+	}
+}
+
+func BenchmarkRealForward(b *testing.B) {
+	for _, n := range []int{256, 4096, 65536} {
+		b.Run(fmt.Sprint(n), func(b *testing.B) {
+			p := NewRealPlan[float32](n)
+			x := randomSignal[float32](n, 3)
+			re, im := make([]float32, n/2+1), make([]float32, n/2+1)
+			b.SetBytes(int64(4 * n))
+			for b.Loop() {
+				p.Forward(x, re, im)
+			}
+		})
+	}
+}
@@ -0,0 +1,31 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fft
+
+import "github.com/ajroetker/go-highway/hwy"
+
+// The hwy.Vec fallbacks of RealPost and RealPre allocate on every
+// operation: hwygen cannot scalarize Reverse. Where dispatch bound them,
+// bind the plain scalar loops instead. The file name sorts after the
+// dispatch files, so this init runs last.
+func init() {
+	if hwy.KernelImplementation("fft.RealPostFloat32") != hwy.BoundImplementation(BaseRealPost_fallback) {
+		return
+	}
+	RealPostFloat32 = func(zr, zi, wr, wi, xr, xi []float32) { realPostScalar(zr, zi, wr, wi, xr, xi, 0) }
+	RealPostFloat64 = func(zr, zi, wr, wi, xr, xi []float64) { realPostScalar(zr, zi, wr, wi, xr, xi, 0) }
+	RealPreFloat32 = func(xr, xi, wr, wi, zr, zi []float32) { realPreScalar(xr, xi, wr, wi, zr, zi, 0) }
+	RealPreFloat64 = func(xr, xi, wr, wi, zr, zi []float64) { realPreScalar(xr, xi, wr, wi, zr, zi, 0) }
+}
@@ -0,0 +1,27 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package geo provides batched geodesic distances between latitude and
+// longitude arrays, in degrees, for geo-fencing and nearest neighbors by
+// location.
+//
+//	geo.Haversine(dist, lat1, lon1, lat2, lon2, geo.EarthRadius)    // pairs
+//	geo.HaversineFrom(dist, lat, lon, lat0, lon0, geo.EarthRadius)  // one to many
+//	near := geo.AppendWithin(nil, lat, lon, lat0, lon0, geo.EarthRadius, 5000)
+//
+// The haversine kernels model the Earth as a sphere, which is off by up to
+// 0.3%, and use the SIMD sine and cosine of package math. Vincenty
+// computes the distance on the WGS 84 ellipsoid to the millimeter, a pair
+// of points at a time.
+package geo
@@ -0,0 +1,129 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package geo
+
+import (
+	"math"
+
+	"github.com/ajroetker/go-highway/hwy"
+)
+
+// EarthRadius is the mean radius of the WGS 84 ellipsoid, in meters.
+const EarthRadius = 6371008.8
+
+// WGS 84 ellipsoid, for Vincenty.
+const (
+	wgs84A = 6378137.0         // semi-major axis, in meters
+	wgs84F = 1 / 298.257223563 // flattening
+	wgs84B = wgs84A * (1 - wgs84F)
+)
+
+// chunk is the number of distances AppendWithin computes at a time.
+const chunk = 256
+
+// AppendWithin appends to dst the indices of the points (lat[i], lon[i]),
+// in degrees, whose great-circle distance from (lat0, lon0) on a sphere
+// of the given radius is at most maxDist, in the units of radius, and
+// returns the extended slice.
+func AppendWithin[T hwy.FloatsNative](dst []int, lat, lon []T, lat0, lon0, radius, maxDist T) []int {
+	n := min(len(lat), len(lon))
+	var dists [chunk]T
+	for start := 0; start < n; start += chunk {
+		m := min(chunk, n-start)
+		HaversineFrom(dists[:m], lat[start:start+m], lon[start:start+m], lat0, lon0, radius)
+		for i, d := range dists[:m] {
+			if d <= maxDist {
+				dst = append(dst, start+i)
+			}
+		}
+	}
+	return dst
+}
+
+// Vincenty returns the distance in meters between two points, in degrees,
+// on the WGS 84 ellipsoid, with Vincenty's inverse formula, accurate to
+// within a millimeter. The formula does not converge for some nearly
+// antipodal points, for which ok is false and the distance is the
+// haversine distance on the sphere of radius EarthRadius instead.
+//
+// Vincenty iterates a different number of times for each pair of points;
+// it is scalar, for when the 0.3% error of the spherical model matters.
+func Vincenty(lat1, lon1, lat2, lon2 float64) (dist float64, ok bool) {
+	const rad = math.Pi / 180
+	l := (lon2 - lon1) * rad
+	u1 := math.Atan((1 - wgs84F) * math.Tan(lat1*rad))
+	u2 := math.Atan((1 - wgs84F) * math.Tan(lat2*rad))
+	sinU1, cosU1 := math.Sincos(u1)
+	sinU2, cosU2 := math.Sincos(u2)
+
+	lambda := l
+	for range 200 {
+		sinLambda, cosLambda := math.Sincos(lambda)
+		a := cosU2 * sinLambda
+		b := cosU1*sinU2 - sinU1*cosU2*cosLambda
+		sinSigma := math.Sqrt(a*a + b*b)
+		if sinSigma == 0 {
+			return 0, true // coincident points
+		}
+		cosSigma := sinU1*sinU2 + cosU1*cosU2*cosLambda
+		sigma := math.Atan2(sinSigma, cosSigma)
+		sinAlpha := cosU1 * cosU2 * sinLambda / sinSigma
+		cos2Alpha := 1 - sinAlpha*sinAlpha
+		cos2SigmaM := 0.0 // equatorial line
+		if cos2Alpha != 0 {
+			cos2SigmaM = cosSigma - 2*sinU1*sinU2/cos2Alpha
+		}
+		c := wgs84F / 16 * cos2Alpha * (4 + wgs84F*(4-3*cos2Alpha))
+		prev := lambda
+		lambda = l + (1-c)*wgs84F*sinAlpha*(sigma+c*sinSigma*(cos2SigmaM+c*cosSigma*(-1+2*cos2SigmaM*cos2SigmaM)))
+		if math.Abs(lambda-prev) > 1e-12 {
+			continue
+		}
+
+		u2 := cos2Alpha * (wgs84A*wgs84A - wgs84B*wgs84B) / (wgs84B * wgs84B)
+		bigA := 1 + u2/16384*(4096+u2*(-768+u2*(320-175*u2)))
+		bigB := u2 / 1024 * (256 + u2*(-128+u2*(74-47*u2)))
+		deltaSigma := bigB * sinSigma * (cos2SigmaM + bigB/4*(cosSigma*(-1+2*cos2SigmaM*cos2SigmaM)-
+			bigB/6*cos2SigmaM*(-3+4*sinSigma*sinSigma)*(-3+4*cos2SigmaM*cos2SigmaM)))
+		return wgs84B * bigA * (sigma - deltaSigma), true
+	}
+	return haversineScalar(lat1, lon1, lat2, lon2, EarthRadius), false
+}
+
+// haversineScalar is the great-circle distance between two points in
+// degrees on a sphere of the given radius.
+func haversineScalar(lat1, lon1, lat2, lon2, radius float64) float64 {
+	const rad = math.Pi / 180
+	sinLat := math.Sin((lat2 - lat1) * rad / 2)
+	sinLon := math.Sin((lon2 - lon1) * rad / 2)
+	h := sinLat*sinLat + math.Cos(lat1*rad)*math.Cos(lat2*rad)*sinLon*sinLon
+	return 2 * radius * math.Asin(math.Sqrt(min(h, 1)))
+}
+
+// haversineSliceScalar is BaseHaversine with the math package.
+func haversineSliceScalar[T hwy.FloatsNative](dst, lat1, lon1, lat2, lon2 []T, radius T) {
+	n := min(len(dst), len(lat1), len(lon1), len(lat2), len(lon2))
+	for i := range n {
+		dst[i] = T(haversineScalar(float64(lat1[i]), float64(lon1[i]), float64(lat2[i]), float64(lon2[i]), float64(radius)))
+	}
+}
+
+// haversineFromScalar is BaseHaversineFrom with the math package.
+func haversineFromScalar[T hwy.FloatsNative](dst, lat, lon []T, lat0, lon0, radius T) {
+	n := min(len(dst), len(lat), len(lon))
+	for i := range n {
+		dst[i] = T(haversineScalar(float64(lat0), float64(lon0), float64(lat[i]), float64(lon[i]), float64(radius)))
+	}
+}
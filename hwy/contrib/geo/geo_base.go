@@ -0,0 +1,131 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package geo
+
+//go:generate go run ../../../cmd/hwygen -input geo_base.go -output . -targets avx2,avx512,neon,fallback -dispatch geo
+
+import (
+	stdmath "math"
+
+	"github.com/ajroetker/go-highway/hwy"
+	"github.com/ajroetker/go-highway/hwy/contrib/math"
+)
+
+// Coefficients of the Cephes asinf polynomial: asin(x) ≈ x + x*z*P(z),
+// z = x², for |x| <= 0.5.
+const (
+	asinP0 = 1.6666752422e-1
+	asinP1 = 7.4953002686e-2
+	asinP2 = 4.5470025998e-2
+	asinP3 = 2.4181311049e-2
+	asinP4 = 4.2163199048e-2
+)
+
+// BaseHaversine stores in dst the great-circle distance between the points
+// (lat1[i], lon1[i]) and (lat2[i], lon2[i]), in degrees, on a sphere of
+// the given radius:
+//
+//	h = sin²(Δlat/2) + cos(lat1) cos(lat2) sin²(Δlon/2)
+//	dst[i] = 2 * radius * asin(sqrt(h))
+//
+// It processes min of the lengths of the slices elements. The sines and
+// cosines are those of package math, whose float64 variants are accurate
+// to about 1e-8: on Earth, the distances are accurate to about 10 m in
+// float64 as well as float32. Use Vincenty for more.
+func BaseHaversine[T hwy.FloatsNative](dst, lat1, lon1, lat2, lon2 []T, radius T) {
+	n := min(len(dst), len(lat1), len(lon1), len(lat2), len(lon2))
+	lanes := hwy.MaxLanes[T]()
+	rad := hwy.Set[T](T(stdmath.Pi / 180))
+	halfRad := hwy.Set[T](T(stdmath.Pi / 360))
+	diameter := hwy.Set(2 * radius)
+	one := hwy.Set[T](1)
+	half := hwy.Set[T](0.5)
+	halfPi := hwy.Set[T](T(stdmath.Pi / 2))
+	p0 := hwy.Set[T](asinP0)
+	p1 := hwy.Set[T](asinP1)
+	p2 := hwy.Set[T](asinP2)
+	p3 := hwy.Set[T](asinP3)
+	p4 := hwy.Set[T](asinP4)
+	i := 0
+	for ; i+lanes <= n; i += lanes {
+		la1 := hwy.Load(lat1[i:])
+		la2 := hwy.Load(lat2[i:])
+		sinLat := math.BaseSinVec(hwy.Mul(hwy.Sub(la2, la1), halfRad))
+		sinLon := math.BaseSinVec(hwy.Mul(hwy.Sub(hwy.Load(lon2[i:]), hwy.Load(lon1[i:])), halfRad))
+		cosCos := hwy.Mul(math.BaseCosVec(hwy.Mul(la1, rad)), math.BaseCosVec(hwy.Mul(la2, rad)))
+		h := hwy.MulAdd(cosCos, hwy.Mul(sinLon, sinLon), hwy.Mul(sinLat, sinLat))
+
+		// asin(s) for s = sqrt(h) in [0, 1]: above 0.5, as
+		// pi/2 - 2*asin(sqrt((1-s)/2)).
+		s := hwy.Sqrt(hwy.Min(h, one))
+		big := hwy.GreaterThan(s, half)
+		z := hwy.IfThenElse(big, hwy.Mul(half, hwy.Sub(one, s)), hwy.Mul(s, s))
+		x := hwy.IfThenElse(big, hwy.Sqrt(z), s)
+		p := hwy.MulAdd(p4, z, p3)
+		p = hwy.MulAdd(p, z, p2)
+		p = hwy.MulAdd(p, z, p1)
+		p = hwy.MulAdd(p, z, p0)
+		p = hwy.MulAdd(hwy.Mul(p, z), x, x)
+		angle := hwy.IfThenElse(big, hwy.Sub(halfPi, hwy.Add(p, p)), p)
+		hwy.Store(hwy.Mul(diameter, angle), dst[i:])
+	}
+	for ; i < n; i++ {
+		dst[i] = T(haversineScalar(float64(lat1[i]), float64(lon1[i]), float64(lat2[i]), float64(lon2[i]), float64(radius)))
+	}
+}
+
+// BaseHaversineFrom stores in dst the great-circle distance from the point
+// (lat0, lon0) to each point (lat[i], lon[i]), like BaseHaversine.
+func BaseHaversineFrom[T hwy.FloatsNative](dst, lat, lon []T, lat0, lon0, radius T) {
+	n := min(len(dst), len(lat), len(lon))
+	lanes := hwy.MaxLanes[T]()
+	rad := hwy.Set[T](T(stdmath.Pi / 180))
+	halfRad := hwy.Set[T](T(stdmath.Pi / 360))
+	vLat0 := hwy.Set(lat0)
+	vLon0 := hwy.Set(lon0)
+	cos0 := hwy.Set(T(stdmath.Cos(float64(lat0) * stdmath.Pi / 180)))
+	diameter := hwy.Set(2 * radius)
+	one := hwy.Set[T](1)
+	half := hwy.Set[T](0.5)
+	halfPi := hwy.Set[T](T(stdmath.Pi / 2))
+	p0 := hwy.Set[T](asinP0)
+	p1 := hwy.Set[T](asinP1)
+	p2 := hwy.Set[T](asinP2)
+	p3 := hwy.Set[T](asinP3)
+	p4 := hwy.Set[T](asinP4)
+	i := 0
+	for ; i+lanes <= n; i += lanes {
+		la := hwy.Load(lat[i:])
+		sinLat := math.BaseSinVec(hwy.Mul(hwy.Sub(la, vLat0), halfRad))
+		sinLon := math.BaseSinVec(hwy.Mul(hwy.Sub(hwy.Load(lon[i:]), vLon0), halfRad))
+		cosCos := hwy.Mul(cos0, math.BaseCosVec(hwy.Mul(la, rad)))
+		h := hwy.MulAdd(cosCos, hwy.Mul(sinLon, sinLon), hwy.Mul(sinLat, sinLat))
+
+		s := hwy.Sqrt(hwy.Min(h, one))
+		big := hwy.GreaterThan(s, half)
+		z := hwy.IfThenElse(big, hwy.Mul(half, hwy.Sub(one, s)), hwy.Mul(s, s))
+		x := hwy.IfThenElse(big, hwy.Sqrt(z), s)
+		p := hwy.MulAdd(p4, z, p3)
+		p = hwy.MulAdd(p, z, p2)
+		p = hwy.MulAdd(p, z, p1)
+		p = hwy.MulAdd(p, z, p0)
+		p = hwy.MulAdd(hwy.Mul(p, z), x, x)
+		angle := hwy.IfThenElse(big, hwy.Sub(halfPi, hwy.Add(p, p)), p)
+		hwy.Store(hwy.Mul(diameter, angle), dst[i:])
+	}
+	for ; i < n; i++ {
+		dst[i] = T(haversineScalar(float64(lat0), float64(lon0), float64(lat[i]), float64(lon[i]), float64(radius)))
+	}
+}
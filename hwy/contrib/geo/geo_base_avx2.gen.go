@@ -0,0 +1,272 @@
+// Code generated by github.com/ajroetker/go-highway/cmd/hwygen. DO NOT EDIT.
+
+//go:build amd64 && goexperiment.simd
+
+package geo
+
+import (
+	stdmath "math"
+	"simd/archsimd"
+	"unsafe"
+
+	"github.com/ajroetker/go-highway/hwy"
+	"github.com/ajroetker/go-highway/hwy/contrib/math"
+)
+
+// Hoisted constants - pre-broadcasted at package init time
+var (
+	BaseHaversineFrom_AVX2_half_f32 = archsimd.BroadcastFloat32x8(0.5)
+	BaseHaversineFrom_AVX2_half_f64 = archsimd.BroadcastFloat64x4(0.5)
+	BaseHaversineFrom_AVX2_one_f32  = archsimd.BroadcastFloat32x8(1)
+	BaseHaversineFrom_AVX2_one_f64  = archsimd.BroadcastFloat64x4(1)
+	BaseHaversineFrom_AVX2_p0_f32   = archsimd.BroadcastFloat32x8(float32(asinP0))
+	BaseHaversineFrom_AVX2_p0_f64   = archsimd.BroadcastFloat64x4(float64(asinP0))
+	BaseHaversineFrom_AVX2_p1_f32   = archsimd.BroadcastFloat32x8(float32(asinP1))
+	BaseHaversineFrom_AVX2_p1_f64   = archsimd.BroadcastFloat64x4(float64(asinP1))
+	BaseHaversineFrom_AVX2_p2_f32   = archsimd.BroadcastFloat32x8(float32(asinP2))
+	BaseHaversineFrom_AVX2_p2_f64   = archsimd.BroadcastFloat64x4(float64(asinP2))
+	BaseHaversineFrom_AVX2_p3_f32   = archsimd.BroadcastFloat32x8(float32(asinP3))
+	BaseHaversineFrom_AVX2_p3_f64   = archsimd.BroadcastFloat64x4(float64(asinP3))
+	BaseHaversineFrom_AVX2_p4_f32   = archsimd.BroadcastFloat32x8(float32(asinP4))
+	BaseHaversineFrom_AVX2_p4_f64   = archsimd.BroadcastFloat64x4(float64(asinP4))
+	BaseHaversine_AVX2_half_f32     = archsimd.BroadcastFloat32x8(0.5)
+	BaseHaversine_AVX2_half_f64     = archsimd.BroadcastFloat64x4(0.5)
+	BaseHaversine_AVX2_one_f32      = archsimd.BroadcastFloat32x8(1)
+	BaseHaversine_AVX2_one_f64      = archsimd.BroadcastFloat64x4(1)
+	BaseHaversine_AVX2_p0_f32       = archsimd.BroadcastFloat32x8(float32(asinP0))
+	BaseHaversine_AVX2_p0_f64       = archsimd.BroadcastFloat64x4(float64(asinP0))
+	BaseHaversine_AVX2_p1_f32       = archsimd.BroadcastFloat32x8(float32(asinP1))
+	BaseHaversine_AVX2_p1_f64       = archsimd.BroadcastFloat64x4(float64(asinP1))
+	BaseHaversine_AVX2_p2_f32       = archsimd.BroadcastFloat32x8(float32(asinP2))
+	BaseHaversine_AVX2_p2_f64       = archsimd.BroadcastFloat64x4(float64(asinP2))
+	BaseHaversine_AVX2_p3_f32       = archsimd.BroadcastFloat32x8(float32(asinP3))
+	BaseHaversine_AVX2_p3_f64       = archsimd.BroadcastFloat64x4(float64(asinP3))
+	BaseHaversine_AVX2_p4_f32       = archsimd.BroadcastFloat32x8(float32(asinP4))
+	BaseHaversine_AVX2_p4_f64       = archsimd.BroadcastFloat64x4(float64(asinP4))
+)
+
+func BaseHaversine_avx2(dst []float32, lat1 []float32, lon1 []float32, lat2 []float32, lon2 []float32, radius float32) {
+	n := min(len(dst), len(lat1), len(lon1), len(lat2), len(lon2))
+	lanes := 8
+	rad := archsimd.BroadcastFloat32x8(float32(stdmath.Pi / 180))
+	halfRad := archsimd.BroadcastFloat32x8(float32(stdmath.Pi / 360))
+	diameter := archsimd.BroadcastFloat32x8(2 * radius)
+	one := BaseHaversine_AVX2_one_f32
+	half := BaseHaversine_AVX2_half_f32
+	halfPi := archsimd.BroadcastFloat32x8(float32(stdmath.Pi / 2))
+	p0 := BaseHaversine_AVX2_p0_f32
+	p1 := BaseHaversine_AVX2_p1_f32
+	p2 := BaseHaversine_AVX2_p2_f32
+	p3 := BaseHaversine_AVX2_p3_f32
+	p4 := BaseHaversine_AVX2_p4_f32
+	i := 0
+	for ; i+lanes*2 <= n; i += lanes * 2 {
+		la1 := archsimd.LoadFloat32x8((*[8]float32)(unsafe.Pointer(&lat1[i])))
+		la2 := archsimd.LoadFloat32x8((*[8]float32)(unsafe.Pointer(&lat2[i])))
+		sinLat := math.BaseSinVec_avx2(la2.Sub(la1).Mul(halfRad))
+		sinLon := math.BaseSinVec_avx2(archsimd.LoadFloat32x8((*[8]float32)(unsafe.Pointer(&lon2[i]))).Sub(archsimd.LoadFloat32x8((*[8]float32)(unsafe.Pointer(&lon1[i])))).Mul(halfRad))
+		cosCos := math.BaseCosVec_avx2(la1.Mul(rad)).Mul(math.BaseCosVec_avx2(la2.Mul(rad)))
+		h := cosCos.MulAdd(sinLon.Mul(sinLon), sinLat.Mul(sinLat))
+		s := h.Min(one).Sqrt()
+		big := s.Greater(half)
+		z := hwy.IfThenElse_AVX2_F32x8(big, half.Mul(one.Sub(s)), s.Mul(s))
+		x := hwy.IfThenElse_AVX2_F32x8(big, z.Sqrt(), s)
+		p := p4.MulAdd(z, p3)
+		p = p.MulAdd(z, p2)
+		p = p.MulAdd(z, p1)
+		p = p.MulAdd(z, p0)
+		p = p.Mul(z).MulAdd(x, x)
+		angle := hwy.IfThenElse_AVX2_F32x8(big, halfPi.Sub(p.Add(p)), p)
+		diameter.Mul(angle).Store((*[8]float32)(unsafe.Pointer(&dst[i])))
+		la11 := archsimd.LoadFloat32x8((*[8]float32)(unsafe.Pointer(&lat1[i+8])))
+		la21 := archsimd.LoadFloat32x8((*[8]float32)(unsafe.Pointer(&lat2[i+8])))
+		sinLat1 := math.BaseSinVec_avx2(la21.Sub(la11).Mul(halfRad))
+		sinLon1 := math.BaseSinVec_avx2(archsimd.LoadFloat32x8((*[8]float32)(unsafe.Pointer(&lon2[i+8]))).Sub(archsimd.LoadFloat32x8((*[8]float32)(unsafe.Pointer(&lon1[i+8])))).Mul(halfRad))
+		cosCos1 := math.BaseCosVec_avx2(la11.Mul(rad)).Mul(math.BaseCosVec_avx2(la21.Mul(rad)))
+		h1 := cosCos1.MulAdd(sinLon1.Mul(sinLon1), sinLat1.Mul(sinLat1))
+		s1 := h1.Min(one).Sqrt()
+		big1 := s1.Greater(half)
+		z1 := hwy.IfThenElse_AVX2_F32x8(big1, half.Mul(one.Sub(s1)), s1.Mul(s1))
+		x1 := hwy.IfThenElse_AVX2_F32x8(big1, z1.Sqrt(), s1)
+		p1 := p4.MulAdd(z1, p3)
+		p1 = p1.MulAdd(z1, p2)
+		p1 = p1.MulAdd(z1, p1)
+		p1 = p1.MulAdd(z1, p0)
+		p1 = p1.Mul(z1).MulAdd(x1, x1)
+		angle1 := hwy.IfThenElse_AVX2_F32x8(big1, halfPi.Sub(p1.Add(p1)), p1)
+		diameter.Mul(angle1).Store((*[8]float32)(unsafe.Pointer(&dst[i+8])))
+	}
+	for ; i < n; i++ {
+		dst[i] = float32(haversineScalar(float64(lat1[i]), float64(lon1[i]), float64(lat2[i]), float64(lon2[i]), float64(radius)))
+	}
+}
+
+func BaseHaversine_avx2_Float64(dst []float64, lat1 []float64, lon1 []float64, lat2 []float64, lon2 []float64, radius float64) {
+	n := min(len(dst), len(lat1), len(lon1), len(lat2), len(lon2))
+	lanes := 4
+	rad := archsimd.BroadcastFloat64x4(float64(stdmath.Pi / 180))
+	halfRad := archsimd.BroadcastFloat64x4(float64(stdmath.Pi / 360))
+	diameter := archsimd.BroadcastFloat64x4(2 * radius)
+	one := BaseHaversine_AVX2_one_f64
+	half := BaseHaversine_AVX2_half_f64
+	halfPi := archsimd.BroadcastFloat64x4(float64(stdmath.Pi / 2))
+	p0 := BaseHaversine_AVX2_p0_f64
+	p1 := BaseHaversine_AVX2_p1_f64
+	p2 := BaseHaversine_AVX2_p2_f64
+	p3 := BaseHaversine_AVX2_p3_f64
+	p4 := BaseHaversine_AVX2_p4_f64
+	i := 0
+	for ; i+lanes*2 <= n; i += lanes * 2 {
+		la1 := archsimd.LoadFloat64x4((*[4]float64)(unsafe.Pointer(&lat1[i])))
+		la2 := archsimd.LoadFloat64x4((*[4]float64)(unsafe.Pointer(&lat2[i])))
+		sinLat := math.BaseSinVec_avx2_Float64(la2.Sub(la1).Mul(halfRad))
+		sinLon := math.BaseSinVec_avx2_Float64(archsimd.LoadFloat64x4((*[4]float64)(unsafe.Pointer(&lon2[i]))).Sub(archsimd.LoadFloat64x4((*[4]float64)(unsafe.Pointer(&lon1[i])))).Mul(halfRad))
+		cosCos := math.BaseCosVec_avx2_Float64(la1.Mul(rad)).Mul(math.BaseCosVec_avx2_Float64(la2.Mul(rad)))
+		h := cosCos.MulAdd(sinLon.Mul(sinLon), sinLat.Mul(sinLat))
+		s := h.Min(one).Sqrt()
+		big := s.Greater(half)
+		z := hwy.IfThenElse_AVX2_F64x4(big, half.Mul(one.Sub(s)), s.Mul(s))
+		x := hwy.IfThenElse_AVX2_F64x4(big, z.Sqrt(), s)
+		p := p4.MulAdd(z, p3)
+		p = p.MulAdd(z, p2)
+		p = p.MulAdd(z, p1)
+		p = p.MulAdd(z, p0)
+		p = p.Mul(z).MulAdd(x, x)
+		angle := hwy.IfThenElse_AVX2_F64x4(big, halfPi.Sub(p.Add(p)), p)
+		diameter.Mul(angle).Store((*[4]float64)(unsafe.Pointer(&dst[i])))
+		la11 := archsimd.LoadFloat64x4((*[4]float64)(unsafe.Pointer(&lat1[i+4])))
+		la21 := archsimd.LoadFloat64x4((*[4]float64)(unsafe.Pointer(&lat2[i+4])))
+		sinLat1 := math.BaseSinVec_avx2_Float64(la21.Sub(la11).Mul(halfRad))
+		sinLon1 := math.BaseSinVec_avx2_Float64(archsimd.LoadFloat64x4((*[4]float64)(unsafe.Pointer(&lon2[i+4]))).Sub(archsimd.LoadFloat64x4((*[4]float64)(unsafe.Pointer(&lon1[i+4])))).Mul(halfRad))
+		cosCos1 := math.BaseCosVec_avx2_Float64(la11.Mul(rad)).Mul(math.BaseCosVec_avx2_Float64(la21.Mul(rad)))
+		h1 := cosCos1.MulAdd(sinLon1.Mul(sinLon1), sinLat1.Mul(sinLat1))
+		s1 := h1.Min(one).Sqrt()
+		big1 := s1.Greater(half)
+		z1 := hwy.IfThenElse_AVX2_F64x4(big1, half.Mul(one.Sub(s1)), s1.Mul(s1))
+		x1 := hwy.IfThenElse_AVX2_F64x4(big1, z1.Sqrt(), s1)
+		p1 := p4.MulAdd(z1, p3)
+		p1 = p1.MulAdd(z1, p2)
+		p1 = p1.MulAdd(z1, p1)
+		p1 = p1.MulAdd(z1, p0)
+		p1 = p1.Mul(z1).MulAdd(x1, x1)
+		angle1 := hwy.IfThenElse_AVX2_F64x4(big1, halfPi.Sub(p1.Add(p1)), p1)
+		diameter.Mul(angle1).Store((*[4]float64)(unsafe.Pointer(&dst[i+4])))
+	}
+	for ; i < n; i++ {
+		dst[i] = float64(haversineScalar(float64(lat1[i]), float64(lon1[i]), float64(lat2[i]), float64(lon2[i]), float64(radius)))
+	}
+}
+
+func BaseHaversineFrom_avx2(dst []float32, lat []float32, lon []float32, lat0 float32, lon0 float32, radius float32) {
+	n := min(len(dst), len(lat), len(lon))
+	lanes := 8
+	rad := archsimd.BroadcastFloat32x8(float32(stdmath.Pi / 180))
+	halfRad := archsimd.BroadcastFloat32x8(float32(stdmath.Pi / 360))
+	vLat0 := archsimd.BroadcastFloat32x8(lat0)
+	vLon0 := archsimd.BroadcastFloat32x8(lon0)
+	cos0 := archsimd.BroadcastFloat32x8(float32(stdmath.Cos(float64(lat0) * stdmath.Pi / 180)))
+	diameter := archsimd.BroadcastFloat32x8(2 * radius)
+	one := BaseHaversineFrom_AVX2_one_f32
+	half := BaseHaversineFrom_AVX2_half_f32
+	halfPi := archsimd.BroadcastFloat32x8(float32(stdmath.Pi / 2))
+	p0 := BaseHaversineFrom_AVX2_p0_f32
+	p1 := BaseHaversineFrom_AVX2_p1_f32
+	p2 := BaseHaversineFrom_AVX2_p2_f32
+	p3 := BaseHaversineFrom_AVX2_p3_f32
+	p4 := BaseHaversineFrom_AVX2_p4_f32
+	i := 0
+	for ; i+lanes*2 <= n; i += lanes * 2 {
+		la := archsimd.LoadFloat32x8((*[8]float32)(unsafe.Pointer(&lat[i])))
+		sinLat := math.BaseSinVec_avx2(la.Sub(vLat0).Mul(halfRad))
+		sinLon := math.BaseSinVec_avx2(archsimd.LoadFloat32x8((*[8]float32)(unsafe.Pointer(&lon[i]))).Sub(vLon0).Mul(halfRad))
+		cosCos := cos0.Mul(math.BaseCosVec_avx2(la.Mul(rad)))
+		h := cosCos.MulAdd(sinLon.Mul(sinLon), sinLat.Mul(sinLat))
+		s := h.Min(one).Sqrt()
+		big := s.Greater(half)
+		z := hwy.IfThenElse_AVX2_F32x8(big, half.Mul(one.Sub(s)), s.Mul(s))
+		x := hwy.IfThenElse_AVX2_F32x8(big, z.Sqrt(), s)
+		p := p4.MulAdd(z, p3)
+		p = p.MulAdd(z, p2)
+		p = p.MulAdd(z, p1)
+		p = p.MulAdd(z, p0)
+		p = p.Mul(z).MulAdd(x, x)
+		angle := hwy.IfThenElse_AVX2_F32x8(big, halfPi.Sub(p.Add(p)), p)
+		diameter.Mul(angle).Store((*[8]float32)(unsafe.Pointer(&dst[i])))
+		la1 := archsimd.LoadFloat32x8((*[8]float32)(unsafe.Pointer(&lat[i+8])))
+		sinLat1 := math.BaseSinVec_avx2(la1.Sub(vLat0).Mul(halfRad))
+		sinLon1 := math.BaseSinVec_avx2(archsimd.LoadFloat32x8((*[8]float32)(unsafe.Pointer(&lon[i+8]))).Sub(vLon0).Mul(halfRad))
+		cosCos1 := cos0.Mul(math.BaseCosVec_avx2(la1.Mul(rad)))
+		h1 := cosCos1.MulAdd(sinLon1.Mul(sinLon1), sinLat1.Mul(sinLat1))
+		s1 := h1.Min(one).Sqrt()
+		big1 := s1.Greater(half)
+		z1 := hwy.IfThenElse_AVX2_F32x8(big1, half.Mul(one.Sub(s1)), s1.Mul(s1))
+		x1 := hwy.IfThenElse_AVX2_F32x8(big1, z1.Sqrt(), s1)
+		p1 := p4.MulAdd(z1, p3)
+		p1 = p1.MulAdd(z1, p2)
+		p1 = p1.MulAdd(z1, p1)
+		p1 = p1.MulAdd(z1, p0)
+		p1 = p1.Mul(z1).MulAdd(x1, x1)
+		angle1 := hwy.IfThenElse_AVX2_F32x8(big1, halfPi.Sub(p1.Add(p1)), p1)
+		diameter.Mul(angle1).Store((*[8]float32)(unsafe.Pointer(&dst[i+8])))
+	}
+	for ; i < n; i++ {
+		dst[i] = float32(haversineScalar(float64(lat0), float64(lon0), float64(lat[i]), float64(lon[i]), float64(radius)))
+	}
+}
+
+func BaseHaversineFrom_avx2_Float64(dst []float64, lat []float64, lon []float64, lat0 float64, lon0 float64, radius float64) {
+	n := min(len(dst), len(lat), len(lon))
+	lanes := 4
+	rad := archsimd.BroadcastFloat64x4(float64(stdmath.Pi / 180))
+	halfRad := archsimd.BroadcastFloat64x4(float64(stdmath.Pi / 360))
+	vLat0 := archsimd.BroadcastFloat64x4(lat0)
+	vLon0 := archsimd.BroadcastFloat64x4(lon0)
+	cos0 := archsimd.BroadcastFloat64x4(float64(stdmath.Cos(float64(lat0) * stdmath.Pi / 180)))
+	diameter := archsimd.BroadcastFloat64x4(2 * radius)
+	one := BaseHaversineFrom_AVX2_one_f64
+	half := BaseHaversineFrom_AVX2_half_f64
+	halfPi := archsimd.BroadcastFloat64x4(float64(stdmath.Pi / 2))
+	p0 := BaseHaversineFrom_AVX2_p0_f64
+	p1 := BaseHaversineFrom_AVX2_p1_f64
+	p2 := BaseHaversineFrom_AVX2_p2_f64
+	p3 := BaseHaversineFrom_AVX2_p3_f64
+	p4 := BaseHaversineFrom_AVX2_p4_f64
+	i := 0
+	for ; i+lanes*2 <= n; i += lanes * 2 {
+		la := archsimd.LoadFloat64x4((*[4]float64)(unsafe.Pointer(&lat[i])))
+		sinLat := math.BaseSinVec_avx2_Float64(la.Sub(vLat0).Mul(halfRad))
+		sinLon := math.BaseSinVec_avx2_Float64(archsimd.LoadFloat64x4((*[4]float64)(unsafe.Pointer(&lon[i]))).Sub(vLon0).Mul(halfRad))
+		cosCos := cos0.Mul(math.BaseCosVec_avx2_Float64(la.Mul(rad)))
+		h := cosCos.MulAdd(sinLon.Mul(sinLon), sinLat.Mul(sinLat))
+		s := h.Min(one).Sqrt()
+		big := s.Greater(half)
+		z := hwy.IfThenElse_AVX2_F64x4(big, half.Mul(one.Sub(s)), s.Mul(s))
+		x := hwy.IfThenElse_AVX2_F64x4(big, z.Sqrt(), s)
+		p := p4.MulAdd(z, p3)
+		p = p.MulAdd(z, p2)
+		p = p.MulAdd(z, p1)
+		p = p.MulAdd(z, p0)
+		p = p.Mul(z).MulAdd(x, x)
+		angle := hwy.IfThenElse_AVX2_F64x4(big, halfPi.Sub(p.Add(p)), p)
+		diameter.Mul(angle).Store((*[4]float64)(unsafe.Pointer(&dst[i])))
+		la1 := archsimd.LoadFloat64x4((*[4]float64)(unsafe.Pointer(&lat[i+4])))
+		sinLat1 := math.BaseSinVec_avx2_Float64(la1.Sub(vLat0).Mul(halfRad))
+		sinLon1 := math.BaseSinVec_avx2_Float64(archsimd.LoadFloat64x4((*[4]float64)(unsafe.Pointer(&lon[i+4]))).Sub(vLon0).Mul(halfRad))
+		cosCos1 := cos0.Mul(math.BaseCosVec_avx2_Float64(la1.Mul(rad)))
+		h1 := cosCos1.MulAdd(sinLon1.Mul(sinLon1), sinLat1.Mul(sinLat1))
+		s1 := h1.Min(one).Sqrt()
+		big1 := s1.Greater(half)
+		z1 := hwy.IfThenElse_AVX2_F64x4(big1, half.Mul(one.Sub(s1)), s1.Mul(s1))
+		x1 := hwy.IfThenElse_AVX2_F64x4(big1, z1.Sqrt(), s1)
+		p1 := p4.MulAdd(z1, p3)
+		p1 = p1.MulAdd(z1, p2)
+		p1 = p1.MulAdd(z1, p1)
+		p1 = p1.MulAdd(z1, p0)
+		p1 = p1.Mul(z1).MulAdd(x1, x1)
+		angle1 := hwy.IfThenElse_AVX2_F64x4(big1, halfPi.Sub(p1.Add(p1)), p1)
+		diameter.Mul(angle1).Store((*[4]float64)(unsafe.Pointer(&dst[i+4])))
+	}
+	for ; i < n; i++ {
+		dst[i] = float64(haversineScalar(float64(lat0), float64(lon0), float64(lat[i]), float64(lon[i]), float64(radius)))
+	}
+}
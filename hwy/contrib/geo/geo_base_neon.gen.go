@@ -0,0 +1,271 @@
+// Code generated by github.com/ajroetker/go-highway/cmd/hwygen. DO NOT EDIT.
+
+//go:build arm64
+
+package geo
+
+import (
+	stdmath "math"
+	"unsafe"
+
+	"github.com/ajroetker/go-highway/hwy/asm"
+	"github.com/ajroetker/go-highway/hwy/contrib/math"
+)
+
+// Hoisted constants - pre-broadcasted at package init time
+var (
+	BaseHaversineFrom_NEON_half_f32 = asm.BroadcastFloat32x4(0.5)
+	BaseHaversineFrom_NEON_half_f64 = asm.BroadcastFloat64x2(0.5)
+	BaseHaversineFrom_NEON_one_f32  = asm.BroadcastFloat32x4(1)
+	BaseHaversineFrom_NEON_one_f64  = asm.BroadcastFloat64x2(1)
+	BaseHaversineFrom_NEON_p0_f32   = asm.BroadcastFloat32x4(float32(asinP0))
+	BaseHaversineFrom_NEON_p0_f64   = asm.BroadcastFloat64x2(float64(asinP0))
+	BaseHaversineFrom_NEON_p1_f32   = asm.BroadcastFloat32x4(float32(asinP1))
+	BaseHaversineFrom_NEON_p1_f64   = asm.BroadcastFloat64x2(float64(asinP1))
+	BaseHaversineFrom_NEON_p2_f32   = asm.BroadcastFloat32x4(float32(asinP2))
+	BaseHaversineFrom_NEON_p2_f64   = asm.BroadcastFloat64x2(float64(asinP2))
+	BaseHaversineFrom_NEON_p3_f32   = asm.BroadcastFloat32x4(float32(asinP3))
+	BaseHaversineFrom_NEON_p3_f64   = asm.BroadcastFloat64x2(float64(asinP3))
+	BaseHaversineFrom_NEON_p4_f32   = asm.BroadcastFloat32x4(float32(asinP4))
+	BaseHaversineFrom_NEON_p4_f64   = asm.BroadcastFloat64x2(float64(asinP4))
+	BaseHaversine_NEON_half_f32     = asm.BroadcastFloat32x4(0.5)
+	BaseHaversine_NEON_half_f64     = asm.BroadcastFloat64x2(0.5)
+	BaseHaversine_NEON_one_f32      = asm.BroadcastFloat32x4(1)
+	BaseHaversine_NEON_one_f64      = asm.BroadcastFloat64x2(1)
+	BaseHaversine_NEON_p0_f32       = asm.BroadcastFloat32x4(float32(asinP0))
+	BaseHaversine_NEON_p0_f64       = asm.BroadcastFloat64x2(float64(asinP0))
+	BaseHaversine_NEON_p1_f32       = asm.BroadcastFloat32x4(float32(asinP1))
+	BaseHaversine_NEON_p1_f64       = asm.BroadcastFloat64x2(float64(asinP1))
+	BaseHaversine_NEON_p2_f32       = asm.BroadcastFloat32x4(float32(asinP2))
+	BaseHaversine_NEON_p2_f64       = asm.BroadcastFloat64x2(float64(asinP2))
+	BaseHaversine_NEON_p3_f32       = asm.BroadcastFloat32x4(float32(asinP3))
+	BaseHaversine_NEON_p3_f64       = asm.BroadcastFloat64x2(float64(asinP3))
+	BaseHaversine_NEON_p4_f32       = asm.BroadcastFloat32x4(float32(asinP4))
+	BaseHaversine_NEON_p4_f64       = asm.BroadcastFloat64x2(float64(asinP4))
+)
+
+func BaseHaversine_neon(dst []float32, lat1 []float32, lon1 []float32, lat2 []float32, lon2 []float32, radius float32) {
+	n := min(len(dst), len(lat1), len(lon1), len(lat2), len(lon2))
+	lanes := 4
+	rad := asm.BroadcastFloat32x4(float32(stdmath.Pi / 180))
+	halfRad := asm.BroadcastFloat32x4(float32(stdmath.Pi / 360))
+	diameter := asm.BroadcastFloat32x4(2 * radius)
+	one := BaseHaversine_NEON_one_f32
+	half := BaseHaversine_NEON_half_f32
+	halfPi := asm.BroadcastFloat32x4(float32(stdmath.Pi / 2))
+	p0 := BaseHaversine_NEON_p0_f32
+	p1 := BaseHaversine_NEON_p1_f32
+	p2 := BaseHaversine_NEON_p2_f32
+	p3 := BaseHaversine_NEON_p3_f32
+	p4 := BaseHaversine_NEON_p4_f32
+	i := 0
+	for ; i+lanes*2 <= n; i += lanes * 2 {
+		la1 := asm.LoadFloat32x4((*[4]float32)(unsafe.Pointer(&lat1[i])))
+		la2 := asm.LoadFloat32x4((*[4]float32)(unsafe.Pointer(&lat2[i])))
+		sinLat := math.BaseSinVec_neon(la2.Sub(la1).Mul(halfRad))
+		sinLon := math.BaseSinVec_neon(asm.LoadFloat32x4((*[4]float32)(unsafe.Pointer(&lon2[i]))).Sub(asm.LoadFloat32x4((*[4]float32)(unsafe.Pointer(&lon1[i])))).Mul(halfRad))
+		cosCos := math.BaseCosVec_neon(la1.Mul(rad)).Mul(math.BaseCosVec_neon(la2.Mul(rad)))
+		h := cosCos.MulAdd(sinLon.Mul(sinLon), sinLat.Mul(sinLat))
+		s := h.Min(one).Sqrt()
+		big := s.GreaterThan(half)
+		z := asm.IfThenElse(big, half.Mul(one.Sub(s)), s.Mul(s))
+		x := asm.IfThenElse(big, z.Sqrt(), s)
+		p := p4.MulAdd(z, p3)
+		p = p.MulAdd(z, p2)
+		p = p.MulAdd(z, p1)
+		p = p.MulAdd(z, p0)
+		p = p.Mul(z).MulAdd(x, x)
+		angle := asm.IfThenElse(big, halfPi.Sub(p.Add(p)), p)
+		diameter.Mul(angle).Store((*[4]float32)(unsafe.Pointer(&dst[i])))
+		la11 := asm.LoadFloat32x4((*[4]float32)(unsafe.Pointer(&lat1[i+4])))
+		la21 := asm.LoadFloat32x4((*[4]float32)(unsafe.Pointer(&lat2[i+4])))
+		sinLat1 := math.BaseSinVec_neon(la21.Sub(la11).Mul(halfRad))
+		sinLon1 := math.BaseSinVec_neon(asm.LoadFloat32x4((*[4]float32)(unsafe.Pointer(&lon2[i+4]))).Sub(asm.LoadFloat32x4((*[4]float32)(unsafe.Pointer(&lon1[i+4])))).Mul(halfRad))
+		cosCos1 := math.BaseCosVec_neon(la11.Mul(rad)).Mul(math.BaseCosVec_neon(la21.Mul(rad)))
+		h1 := cosCos1.MulAdd(sinLon1.Mul(sinLon1), sinLat1.Mul(sinLat1))
+		s1 := h1.Min(one).Sqrt()
+		big1 := s1.GreaterThan(half)
+		z1 := asm.IfThenElse(big1, half.Mul(one.Sub(s1)), s1.Mul(s1))
+		x1 := asm.IfThenElse(big1, z1.Sqrt(), s1)
+		p1 := p4.MulAdd(z1, p3)
+		p1 = p1.MulAdd(z1, p2)
+		p1 = p1.MulAdd(z1, p1)
+		p1 = p1.MulAdd(z1, p0)
+		p1 = p1.Mul(z1).MulAdd(x1, x1)
+		angle1 := asm.IfThenElse(big1, halfPi.Sub(p1.Add(p1)), p1)
+		diameter.Mul(angle1).Store((*[4]float32)(unsafe.Pointer(&dst[i+4])))
+	}
+	for ; i < n; i++ {
+		dst[i] = float32(haversineScalar(float64(lat1[i]), float64(lon1[i]), float64(lat2[i]), float64(lon2[i]), float64(radius)))
+	}
+}
+
+func BaseHaversine_neon_Float64(dst []float64, lat1 []float64, lon1 []float64, lat2 []float64, lon2 []float64, radius float64) {
+	n := min(len(dst), len(lat1), len(lon1), len(lat2), len(lon2))
+	lanes := 2
+	rad := asm.BroadcastFloat64x2(float64(stdmath.Pi / 180))
+	halfRad := asm.BroadcastFloat64x2(float64(stdmath.Pi / 360))
+	diameter := asm.BroadcastFloat64x2(2 * radius)
+	one := BaseHaversine_NEON_one_f64
+	half := BaseHaversine_NEON_half_f64
+	halfPi := asm.BroadcastFloat64x2(float64(stdmath.Pi / 2))
+	p0 := BaseHaversine_NEON_p0_f64
+	p1 := BaseHaversine_NEON_p1_f64
+	p2 := BaseHaversine_NEON_p2_f64
+	p3 := BaseHaversine_NEON_p3_f64
+	p4 := BaseHaversine_NEON_p4_f64
+	i := 0
+	for ; i+lanes*2 <= n; i += lanes * 2 {
+		la1 := asm.LoadFloat64x2((*[2]float64)(unsafe.Pointer(&lat1[i])))
+		la2 := asm.LoadFloat64x2((*[2]float64)(unsafe.Pointer(&lat2[i])))
+		sinLat := math.BaseSinVec_neon_Float64(la2.Sub(la1).Mul(halfRad))
+		sinLon := math.BaseSinVec_neon_Float64(asm.LoadFloat64x2((*[2]float64)(unsafe.Pointer(&lon2[i]))).Sub(asm.LoadFloat64x2((*[2]float64)(unsafe.Pointer(&lon1[i])))).Mul(halfRad))
+		cosCos := math.BaseCosVec_neon_Float64(la1.Mul(rad)).Mul(math.BaseCosVec_neon_Float64(la2.Mul(rad)))
+		h := cosCos.MulAdd(sinLon.Mul(sinLon), sinLat.Mul(sinLat))
+		s := h.Min(one).Sqrt()
+		big := s.GreaterThan(half)
+		z := asm.IfThenElseFloat64(big, half.Mul(one.Sub(s)), s.Mul(s))
+		x := asm.IfThenElseFloat64(big, z.Sqrt(), s)
+		p := p4.MulAdd(z, p3)
+		p = p.MulAdd(z, p2)
+		p = p.MulAdd(z, p1)
+		p = p.MulAdd(z, p0)
+		p = p.Mul(z).MulAdd(x, x)
+		angle := asm.IfThenElseFloat64(big, halfPi.Sub(p.Add(p)), p)
+		diameter.Mul(angle).Store((*[2]float64)(unsafe.Pointer(&dst[i])))
+		la11 := asm.LoadFloat64x2((*[2]float64)(unsafe.Pointer(&lat1[i+2])))
+		la21 := asm.LoadFloat64x2((*[2]float64)(unsafe.Pointer(&lat2[i+2])))
+		sinLat1 := math.BaseSinVec_neon_Float64(la21.Sub(la11).Mul(halfRad))
+		sinLon1 := math.BaseSinVec_neon_Float64(asm.LoadFloat64x2((*[2]float64)(unsafe.Pointer(&lon2[i+2]))).Sub(asm.LoadFloat64x2((*[2]float64)(unsafe.Pointer(&lon1[i+2])))).Mul(halfRad))
+		cosCos1 := math.BaseCosVec_neon_Float64(la11.Mul(rad)).Mul(math.BaseCosVec_neon_Float64(la21.Mul(rad)))
+		h1 := cosCos1.MulAdd(sinLon1.Mul(sinLon1), sinLat1.Mul(sinLat1))
+		s1 := h1.Min(one).Sqrt()
+		big1 := s1.GreaterThan(half)
+		z1 := asm.IfThenElseFloat64(big1, half.Mul(one.Sub(s1)), s1.Mul(s1))
+		x1 := asm.IfThenElseFloat64(big1, z1.Sqrt(), s1)
+		p1 := p4.MulAdd(z1, p3)
+		p1 = p1.MulAdd(z1, p2)
+		p1 = p1.MulAdd(z1, p1)
+		p1 = p1.MulAdd(z1, p0)
+		p1 = p1.Mul(z1).MulAdd(x1, x1)
+		angle1 := asm.IfThenElseFloat64(big1, halfPi.Sub(p1.Add(p1)), p1)
+		diameter.Mul(angle1).Store((*[2]float64)(unsafe.Pointer(&dst[i+2])))
+	}
+	for ; i < n; i++ {
+		dst[i] = float64(haversineScalar(float64(lat1[i]), float64(lon1[i]), float64(lat2[i]), float64(lon2[i]), float64(radius)))
+	}
+}
+
+func BaseHaversineFrom_neon(dst []float32, lat []float32, lon []float32, lat0 float32, lon0 float32, radius float32) {
+	n := min(len(dst), len(lat), len(lon))
+	lanes := 4
+	rad := asm.BroadcastFloat32x4(float32(stdmath.Pi / 180))
+	halfRad := asm.BroadcastFloat32x4(float32(stdmath.Pi / 360))
+	vLat0 := asm.BroadcastFloat32x4(lat0)
+	vLon0 := asm.BroadcastFloat32x4(lon0)
+	cos0 := asm.BroadcastFloat32x4(float32(stdmath.Cos(float64(lat0) * stdmath.Pi / 180)))
+	diameter := asm.BroadcastFloat32x4(2 * radius)
+	one := BaseHaversineFrom_NEON_one_f32
+	half := BaseHaversineFrom_NEON_half_f32
+	halfPi := asm.BroadcastFloat32x4(float32(stdmath.Pi / 2))
+	p0 := BaseHaversineFrom_NEON_p0_f32
+	p1 := BaseHaversineFrom_NEON_p1_f32
+	p2 := BaseHaversineFrom_NEON_p2_f32
+	p3 := BaseHaversineFrom_NEON_p3_f32
+	p4 := BaseHaversineFrom_NEON_p4_f32
+	i := 0
+	for ; i+lanes*2 <= n; i += lanes * 2 {
+		la := asm.LoadFloat32x4((*[4]float32)(unsafe.Pointer(&lat[i])))
+		sinLat := math.BaseSinVec_neon(la.Sub(vLat0).Mul(halfRad))
+		sinLon := math.BaseSinVec_neon(asm.LoadFloat32x4((*[4]float32)(unsafe.Pointer(&lon[i]))).Sub(vLon0).Mul(halfRad))
+		cosCos := cos0.Mul(math.BaseCosVec_neon(la.Mul(rad)))
+		h := cosCos.MulAdd(sinLon.Mul(sinLon), sinLat.Mul(sinLat))
+		s := h.Min(one).Sqrt()
+		big := s.GreaterThan(half)
+		z := asm.IfThenElse(big, half.Mul(one.Sub(s)), s.Mul(s))
+		x := asm.IfThenElse(big, z.Sqrt(), s)
+		p := p4.MulAdd(z, p3)
+		p = p.MulAdd(z, p2)
+		p = p.MulAdd(z, p1)
+		p = p.MulAdd(z, p0)
+		p = p.Mul(z).MulAdd(x, x)
+		angle := asm.IfThenElse(big, halfPi.Sub(p.Add(p)), p)
+		diameter.Mul(angle).Store((*[4]float32)(unsafe.Pointer(&dst[i])))
+		la1 := asm.LoadFloat32x4((*[4]float32)(unsafe.Pointer(&lat[i+4])))
+		sinLat1 := math.BaseSinVec_neon(la1.Sub(vLat0).Mul(halfRad))
+		sinLon1 := math.BaseSinVec_neon(asm.LoadFloat32x4((*[4]float32)(unsafe.Pointer(&lon[i+4]))).Sub(vLon0).Mul(halfRad))
+		cosCos1 := cos0.Mul(math.BaseCosVec_neon(la1.Mul(rad)))
+		h1 := cosCos1.MulAdd(sinLon1.Mul(sinLon1), sinLat1.Mul(sinLat1))
+		s1 := h1.Min(one).Sqrt()
+		big1 := s1.GreaterThan(half)
+		z1 := asm.IfThenElse(big1, half.Mul(one.Sub(s1)), s1.Mul(s1))
+		x1 := asm.IfThenElse(big1, z1.Sqrt(), s1)
+		p1 := p4.MulAdd(z1, p3)
+		p1 = p1.MulAdd(z1, p2)
+		p1 = p1.MulAdd(z1, p1)
+		p1 = p1.MulAdd(z1, p0)
+		p1 = p1.Mul(z1).MulAdd(x1, x1)
+		angle1 := asm.IfThenElse(big1, halfPi.Sub(p1.Add(p1)), p1)
+		diameter.Mul(angle1).Store((*[4]float32)(unsafe.Pointer(&dst[i+4])))
+	}
+	for ; i < n; i++ {
+		dst[i] = float32(haversineScalar(float64(lat0), float64(lon0), float64(lat[i]), float64(lon[i]), float64(radius)))
+	}
+}
+
+func BaseHaversineFrom_neon_Float64(dst []float64, lat []float64, lon []float64, lat0 float64, lon0 float64, radius float64) {
+	n := min(len(dst), len(lat), len(lon))
+	lanes := 2
+	rad := asm.BroadcastFloat64x2(float64(stdmath.Pi / 180))
+	halfRad := asm.BroadcastFloat64x2(float64(stdmath.Pi / 360))
+	vLat0 := asm.BroadcastFloat64x2(lat0)
+	vLon0 := asm.BroadcastFloat64x2(lon0)
+	cos0 := asm.BroadcastFloat64x2(float64(stdmath.Cos(float64(lat0) * stdmath.Pi / 180)))
+	diameter := asm.BroadcastFloat64x2(2 * radius)
+	one := BaseHaversineFrom_NEON_one_f64
+	half := BaseHaversineFrom_NEON_half_f64
+	halfPi := asm.BroadcastFloat64x2(float64(stdmath.Pi / 2))
+	p0 := BaseHaversineFrom_NEON_p0_f64
+	p1 := BaseHaversineFrom_NEON_p1_f64
+	p2 := BaseHaversineFrom_NEON_p2_f64
+	p3 := BaseHaversineFrom_NEON_p3_f64
+	p4 := BaseHaversineFrom_NEON_p4_f64
+	i := 0
+	for ; i+lanes*2 <= n; i += lanes * 2 {
+		la := asm.LoadFloat64x2((*[2]float64)(unsafe.Pointer(&lat[i])))
+		sinLat := math.BaseSinVec_neon_Float64(la.Sub(vLat0).Mul(halfRad))
+		sinLon := math.BaseSinVec_neon_Float64(asm.LoadFloat64x2((*[2]float64)(unsafe.Pointer(&lon[i]))).Sub(vLon0).Mul(halfRad))
+		cosCos := cos0.Mul(math.BaseCosVec_neon_Float64(la.Mul(rad)))
+		h := cosCos.MulAdd(sinLon.Mul(sinLon), sinLat.Mul(sinLat))
+		s := h.Min(one).Sqrt()
+		big := s.GreaterThan(half)
+		z := asm.IfThenElseFloat64(big, half.Mul(one.Sub(s)), s.Mul(s))
+		x := asm.IfThenElseFloat64(big, z.Sqrt(), s)
+		p := p4.MulAdd(z, p3)
+		p = p.MulAdd(z, p2)
+		p = p.MulAdd(z, p1)
+		p = p.MulAdd(z, p0)
+		p = p.Mul(z).MulAdd(x, x)
+		angle := asm.IfThenElseFloat64(big, halfPi.Sub(p.Add(p)), p)
+		diameter.Mul(angle).Store((*[2]float64)(unsafe.Pointer(&dst[i])))
+		la1 := asm.LoadFloat64x2((*[2]float64)(unsafe.Pointer(&lat[i+2])))
+		sinLat1 := math.BaseSinVec_neon_Float64(la1.Sub(vLat0).Mul(halfRad))
+		sinLon1 := math.BaseSinVec_neon_Float64(asm.LoadFloat64x2((*[2]float64)(unsafe.Pointer(&lon[i+2]))).Sub(vLon0).Mul(halfRad))
+		cosCos1 := cos0.Mul(math.BaseCosVec_neon_Float64(la1.Mul(rad)))
+		h1 := cosCos1.MulAdd(sinLon1.Mul(sinLon1), sinLat1.Mul(sinLat1))
+		s1 := h1.Min(one).Sqrt()
+		big1 := s1.GreaterThan(half)
+		z1 := asm.IfThenElseFloat64(big1, half.Mul(one.Sub(s1)), s1.Mul(s1))
+		x1 := asm.IfThenElseFloat64(big1, z1.Sqrt(), s1)
+		p1 := p4.MulAdd(z1, p3)
+		p1 = p1.MulAdd(z1, p2)
+		p1 = p1.MulAdd(z1, p1)
+		p1 = p1.MulAdd(z1, p0)
+		p1 = p1.Mul(z1).MulAdd(x1, x1)
+		angle1 := asm.IfThenElseFloat64(big1, halfPi.Sub(p1.Add(p1)), p1)
+		diameter.Mul(angle1).Store((*[2]float64)(unsafe.Pointer(&dst[i+2])))
+	}
+	for ; i < n; i++ {
+		dst[i] = float64(haversineScalar(float64(lat0), float64(lon0), float64(lat[i]), float64(lon[i]), float64(radius)))
+	}
+}
@@ -0,0 +1,152 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package geo
+
+import (
+	"math"
+	"math/rand/v2"
+	"slices"
+	"testing"
+)
+
+func randomPoints(r *rand.Rand, n int) (lat, lon []float64) {
+	lat, lon = make([]float64, n), make([]float64, n)
+	for i := range n {
+		lat[i] = math.Asin(2*r.Float64()-1) * 180 / math.Pi
+		lon[i] = r.Float64()*360 - 180
+	}
+	return lat, lon
+}
+
+func toFloat32(s []float64) []float32 {
+	f := make([]float32, len(s))
+	for i, x := range s {
+		f[i] = float32(x)
+	}
+	return f
+}
+
+// TestHaversineKernels checks the SIMD kernels against the math package,
+// both where dispatch bound them and as hwy.Vec fallbacks, which the
+// scalar loops replace.
+func TestHaversineKernels(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	const n = 203
+	lat1, lon1 := randomPoints(r, n)
+	lat2, lon2 := randomPoints(r, n)
+	// Nearby points, where the asin argument is small.
+	for i := range 40 {
+		lat2[i] = lat1[i] + r.NormFloat64()*1e-3
+		lon2[i] = lon1[i] + r.NormFloat64()*1e-3
+	}
+	want := make([]float64, n)
+	haversineSliceScalar(want, lat1, lon1, lat2, lon2, EarthRadius)
+
+	check := func(name string, got []float64, tol float64) {
+		t.Helper()
+		for i := range n {
+			if err := math.Abs(got[i] - want[i]); err > tol*max(want[i], 1000) {
+				t.Errorf("%s: distance %d = %g, want %g", name, i, got[i], want[i])
+			}
+		}
+	}
+	for name, kernel := range map[string]func(dst, lat1, lon1, lat2, lon2 []float64, radius float64){
+		"Float64":          HaversineFloat64,
+		"fallback Float64": BaseHaversine_fallback_Float64,
+	} {
+		got := make([]float64, n)
+		kernel(got, lat1, lon1, lat2, lon2, EarthRadius)
+		check(name, got, 1e-6)
+	}
+	// The float32 coordinates are about a meter apart: compare with the
+	// distances between them.
+	lat1f, lon1f, lat2f, lon2f := toFloat32(lat1), toFloat32(lon1), toFloat32(lat2), toFloat32(lon2)
+	for i := range n {
+		want[i] = haversineScalar(float64(lat1f[i]), float64(lon1f[i]), float64(lat2f[i]), float64(lon2f[i]), EarthRadius)
+	}
+	for name, kernel := range map[string]func(dst, lat1, lon1, lat2, lon2 []float32, radius float32){
+		"Float32":          HaversineFloat32,
+		"fallback Float32": BaseHaversine_fallback,
+	} {
+		got := make([]float32, n)
+		kernel(got, lat1f, lon1f, lat2f, lon2f, EarthRadius)
+		got64 := make([]float64, n)
+		for i, d := range got {
+			got64[i] = float64(d)
+		}
+		check(name, got64, 1e-4)
+	}
+
+	from := make([]float64, n)
+	haversineFromScalar(want, lat1, lon1, lat2[0], lon2[0], EarthRadius)
+	for name, kernel := range map[string]func(dst, lat, lon []float64, lat0, lon0, radius float64){
+		"From Float64":          HaversineFromFloat64,
+		"fallback From Float64": BaseHaversineFrom_fallback_Float64,
+	} {
+		kernel(from, lat1, lon1, lat2[0], lon2[0], EarthRadius)
+		check(name, from, 1e-6)
+	}
+}
+
+func TestHaversine(t *testing.T) {
+	// Paris to New York, about 5837 km.
+	dst := make([]float64, 1)
+	Haversine(dst, []float64{48.8566}, []float64{2.3522}, []float64{40.7128}, []float64{-74.0060}, EarthRadius)
+	if math.Abs(dst[0]-5837e3) > 2e3 {
+		t.Errorf("Paris to New York = %g m, want about 5837 km", dst[0])
+	}
+	// Antipodes, half the circumference.
+	Haversine(dst, []float64{10}, []float64{20}, []float64{-10}, []float64{-160}, 1)
+	if math.Abs(dst[0]-math.Pi) > 1e-6 {
+		t.Errorf("antipodal distance = %g, want pi", dst[0])
+	}
+}
+
+func TestAppendWithin(t *testing.T) {
+	r := rand.New(rand.NewPCG(3, 4))
+	lat, lon := randomPoints(r, 1000)
+	const lat0, lon0, maxDist = 45.0, 7.0, 3000e3
+	got := AppendWithin(nil, lat, lon, lat0, lon0, EarthRadius, maxDist)
+	var want []int
+	for i := range lat {
+		if haversineScalar(lat0, lon0, lat[i], lon[i], EarthRadius) <= maxDist {
+			want = append(want, i)
+		}
+	}
+	if len(want) == 0 || !slices.Equal(got, want) {
+		t.Errorf("AppendWithin = %v, want %v", got, want)
+	}
+}
+
+func TestVincenty(t *testing.T) {
+	for _, tc := range []struct {
+		lat1, lon1, lat2, lon2, want float64
+	}{
+		// Vincenty's Flinders Peak to Buninyong example.
+		{-37.95103342, 144.42486789, -37.65282114, 143.92649554, 54972.271},
+		// Along the equator, a quarter of it.
+		{0, 0, 0, 90, 10018754.171},
+		{12.5, -40, 12.5, -40, 0},
+	} {
+		got, ok := Vincenty(tc.lat1, tc.lon1, tc.lat2, tc.lon2)
+		if !ok || math.Abs(got-tc.want) > 1e-3 {
+			t.Errorf("Vincenty(%g, %g, %g, %g) = %.4f, %v, want %.4f", tc.lat1, tc.lon1, tc.lat2, tc.lon2, got, ok, tc.want)
+		}
+	}
+	// Nearly antipodal points, where the iteration does not converge.
+	if got, ok := Vincenty(0, 0, 0.5, 179.7); ok || math.Abs(got-haversineScalar(0, 0, 0.5, 179.7, EarthRadius)) > 1e-6 {
+		t.Errorf("Vincenty near the antipode = %g, %v, want the haversine distance and false", got, ok)
+	}
+}
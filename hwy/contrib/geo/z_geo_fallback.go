@@ -0,0 +1,31 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package geo
+
+import "github.com/ajroetker/go-highway/hwy"
+
+// The hwy.Vec fallbacks of the geo kernels allocate on every operation,
+// in their trigonometric functions and selects. Where dispatch bound
+// them, bind scalar loops over the math package instead. The file name
+// sorts after the dispatch files, so this init runs last.
+func init() {
+	if hwy.KernelImplementation("geo.HaversineFloat32") != hwy.BoundImplementation(BaseHaversine_fallback) {
+		return
+	}
+	HaversineFloat32 = haversineSliceScalar[float32]
+	HaversineFloat64 = haversineSliceScalar[float64]
+	HaversineFromFloat32 = haversineFromScalar[float32]
+	HaversineFromFloat64 = haversineFromScalar[float64]
+}
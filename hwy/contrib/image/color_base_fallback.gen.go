@@ -401,6 +401,52 @@ func BaseForwardICT_fallback(r *Image[float32], g *Image[float32], b *Image[floa
 		cbRow := outCb.Row(row)
 		crRow := outCr.Row(row)
 		i := 0
+		for ; i+4 <= width; i += 4 {
+			{
+				vr := rRow[i]
+				vg := gRow[i]
+				vb := bRow[i]
+				vy := vr*rToYVec + (vg*gToYVec + vb*bToYVec)
+				vcb := vr*rToCbVec + (vg*gToCbVec + vb*bToCbVec)
+				vcr := vr*rToCrVec + (vg*gToCrVec + vb*bToCrVec)
+				yRow[i] = vy
+				cbRow[i] = vcb
+				crRow[i] = vcr
+			}
+			{
+				vr := rRow[i+1]
+				vg := gRow[i+1]
+				vb := bRow[i+1]
+				vy := vr*rToYVec + (vg*gToYVec + vb*bToYVec)
+				vcb := vr*rToCbVec + (vg*gToCbVec + vb*bToCbVec)
+				vcr := vr*rToCrVec + (vg*gToCrVec + vb*bToCrVec)
+				yRow[i+1] = vy
+				cbRow[i+1] = vcb
+				crRow[i+1] = vcr
+			}
+			{
+				vr := rRow[i+2]
+				vg := gRow[i+2]
+				vb := bRow[i+2]
+				vy := vr*rToYVec + (vg*gToYVec + vb*bToYVec)
+				vcb := vr*rToCbVec + (vg*gToCbVec + vb*bToCbVec)
+				vcr := vr*rToCrVec + (vg*gToCrVec + vb*bToCrVec)
+				yRow[i+2] = vy
+				cbRow[i+2] = vcb
+				crRow[i+2] = vcr
+			}
+			{
+				vr := rRow[i+3]
+				vg := gRow[i+3]
+				vb := bRow[i+3]
+				vy := vr*rToYVec + (vg*gToYVec + vb*bToYVec)
+				vcb := vr*rToCbVec + (vg*gToCbVec + vb*bToCbVec)
+				vcr := vr*rToCrVec + (vg*gToCrVec + vb*bToCrVec)
+				yRow[i+3] = vy
+				cbRow[i+3] = vcb
+				crRow[i+3] = vcr
+			}
+		}
 		for ; i < width; i++ {
 			vr := rRow[i]
 			vg := gRow[i]
@@ -465,6 +511,52 @@ func BaseForwardICT_fallback_Float64(r *Image[float64], g *Image[float64], b *Im
 		cbRow := outCb.Row(row)
 		crRow := outCr.Row(row)
 		i := 0
+		for ; i+4 <= width; i += 4 {
+			{
+				vr := rRow[i]
+				vg := gRow[i]
+				vb := bRow[i]
+				vy := vr*rToYVec + (vg*gToYVec + vb*bToYVec)
+				vcb := vr*rToCbVec + (vg*gToCbVec + vb*bToCbVec)
+				vcr := vr*rToCrVec + (vg*gToCrVec + vb*bToCrVec)
+				yRow[i] = vy
+				cbRow[i] = vcb
+				crRow[i] = vcr
+			}
+			{
+				vr := rRow[i+1]
+				vg := gRow[i+1]
+				vb := bRow[i+1]
+				vy := vr*rToYVec + (vg*gToYVec + vb*bToYVec)
+				vcb := vr*rToCbVec + (vg*gToCbVec + vb*bToCbVec)
+				vcr := vr*rToCrVec + (vg*gToCrVec + vb*bToCrVec)
+				yRow[i+1] = vy
+				cbRow[i+1] = vcb
+				crRow[i+1] = vcr
+			}
+			{
+				vr := rRow[i+2]
+				vg := gRow[i+2]
+				vb := bRow[i+2]
+				vy := vr*rToYVec + (vg*gToYVec + vb*bToYVec)
+				vcb := vr*rToCbVec + (vg*gToCbVec + vb*bToCbVec)
+				vcr := vr*rToCrVec + (vg*gToCrVec + vb*bToCrVec)
+				yRow[i+2] = vy
+				cbRow[i+2] = vcb
+				crRow[i+2] = vcr
+			}
+			{
+				vr := rRow[i+3]
+				vg := gRow[i+3]
+				vb := bRow[i+3]
+				vy := vr*rToYVec + (vg*gToYVec + vb*bToYVec)
+				vcb := vr*rToCbVec + (vg*gToCbVec + vb*bToCbVec)
+				vcr := vr*rToCrVec + (vg*gToCrVec + vb*bToCrVec)
+				yRow[i+3] = vy
+				cbRow[i+3] = vcb
+				crRow[i+3] = vcr
+			}
+		}
 		for ; i < width; i++ {
 			vr := rRow[i]
 			vg := gRow[i]
@@ -644,6 +736,52 @@ func BaseInverseICT_fallback(y *Image[float32], cb *Image[float32], cr *Image[fl
 		gRow := outG.Row(row)
 		bRow := outB.Row(row)
 		i := 0
+		for ; i+4 <= width; i += 4 {
+			{
+				vy := yRow[i]
+				vcb := cbRow[i]
+				vcr := crRow[i]
+				vr := vcr*crToRVec + vy
+				vg := vcb*cbToGVec + (vcr*crToGVec + vy)
+				vb := vcb*cbToBVec + vy
+				rRow[i] = vr
+				gRow[i] = vg
+				bRow[i] = vb
+			}
+			{
+				vy := yRow[i+1]
+				vcb := cbRow[i+1]
+				vcr := crRow[i+1]
+				vr := vcr*crToRVec + vy
+				vg := vcb*cbToGVec + (vcr*crToGVec + vy)
+				vb := vcb*cbToBVec + vy
+				rRow[i+1] = vr
+				gRow[i+1] = vg
+				bRow[i+1] = vb
+			}
+			{
+				vy := yRow[i+2]
+				vcb := cbRow[i+2]
+				vcr := crRow[i+2]
+				vr := vcr*crToRVec + vy
+				vg := vcb*cbToGVec + (vcr*crToGVec + vy)
+				vb := vcb*cbToBVec + vy
+				rRow[i+2] = vr
+				gRow[i+2] = vg
+				bRow[i+2] = vb
+			}
+			{
+				vy := yRow[i+3]
+				vcb := cbRow[i+3]
+				vcr := crRow[i+3]
+				vr := vcr*crToRVec + vy
+				vg := vcb*cbToGVec + (vcr*crToGVec + vy)
+				vb := vcb*cbToBVec + vy
+				rRow[i+3] = vr
+				gRow[i+3] = vg
+				bRow[i+3] = vb
+			}
+		}
 		for ; i < width; i++ {
 			vy := yRow[i]
 			vcb := cbRow[i]
@@ -703,6 +841,52 @@ func BaseInverseICT_fallback_Float64(y *Image[float64], cb *Image[float64], cr *
 		gRow := outG.Row(row)
 		bRow := outB.Row(row)
 		i := 0
+		for ; i+4 <= width; i += 4 {
+			{
+				vy := yRow[i]
+				vcb := cbRow[i]
+				vcr := crRow[i]
+				vr := vcr*crToRVec + vy
+				vg := vcb*cbToGVec + (vcr*crToGVec + vy)
+				vb := vcb*cbToBVec + vy
+				rRow[i] = vr
+				gRow[i] = vg
+				bRow[i] = vb
+			}
+			{
+				vy := yRow[i+1]
+				vcb := cbRow[i+1]
+				vcr := crRow[i+1]
+				vr := vcr*crToRVec + vy
+				vg := vcb*cbToGVec + (vcr*crToGVec + vy)
+				vb := vcb*cbToBVec + vy
+				rRow[i+1] = vr
+				gRow[i+1] = vg
+				bRow[i+1] = vb
+			}
+			{
+				vy := yRow[i+2]
+				vcb := cbRow[i+2]
+				vcr := crRow[i+2]
+				vr := vcr*crToRVec + vy
+				vg := vcb*cbToGVec + (vcr*crToGVec + vy)
+				vb := vcb*cbToBVec + vy
+				rRow[i+2] = vr
+				gRow[i+2] = vg
+				bRow[i+2] = vb
+			}
+			{
+				vy := yRow[i+3]
+				vcb := cbRow[i+3]
+				vcr := crRow[i+3]
+				vr := vcr*crToRVec + vy
+				vg := vcb*cbToGVec + (vcr*crToGVec + vy)
+				vb := vcb*cbToBVec + vy
+				rRow[i+3] = vr
+				gRow[i+3] = vg
+				bRow[i+3] = vb
+			}
+		}
 		for ; i < width; i++ {
 			vy := yRow[i]
 			vcb := cbRow[i]
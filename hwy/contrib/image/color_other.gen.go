@@ -124,3 +124,20 @@ func initColorFallback() {
 	InverseICTFloat32 = BaseInverseICT_fallback
 	InverseICTFloat64 = BaseInverseICT_fallback_Float64
 }
+
+func init() {
+	hwy.RegisterKernel("image.ForwardRCTInt32", &ForwardRCTInt32)
+	hwy.RegisterKernel("image.ForwardRCTInt64", &ForwardRCTInt64)
+	hwy.RegisterKernel("image.InverseRCTInt32", &InverseRCTInt32)
+	hwy.RegisterKernel("image.InverseRCTInt64", &InverseRCTInt64)
+	hwy.RegisterKernel("image.ForwardICTFloat16", &ForwardICTFloat16)
+	hwy.RegisterKernel("image.ForwardICTBFloat16", &ForwardICTBFloat16)
+	hwy.RegisterKernel("image.ForwardICTFloat32", &ForwardICTFloat32)
+	hwy.RegisterKernel("image.ForwardICTFloat64", &ForwardICTFloat64)
+	hwy.RegisterKernel("image.InverseICTFloat16", &InverseICTFloat16)
+	hwy.RegisterKernel("image.InverseICTBFloat16", &InverseICTBFloat16)
+	hwy.RegisterKernel("image.InverseICTFloat32", &InverseICTFloat32)
+	hwy.RegisterKernel("image.InverseICTFloat64", &InverseICTFloat64)
+	hwyKernels := []string{"image.ForwardRCTInt32", "image.ForwardRCTInt64", "image.InverseRCTInt32", "image.InverseRCTInt64", "image.ForwardICTFloat16", "image.ForwardICTBFloat16", "image.ForwardICTFloat32", "image.ForwardICTFloat64", "image.InverseICTFloat16", "image.InverseICTBFloat16", "image.InverseICTFloat32", "image.InverseICTFloat64"}
+	hwy.RegisterKernelTarget(hwy.DispatchScalar, initColorFallback, hwyKernels...)
+}
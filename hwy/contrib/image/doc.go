@@ -35,6 +35,16 @@
 //	out := image.NewImage[float32](1920, 1080)
 //	image.BrightnessContrast(img, out, 1.5, 0.1)
 //
+// # Tiles
+//
+// ForEachTileParallel runs a function per tile of an image on the shared
+// worker pool. Tiles start on vector-aligned columns, so SubImage views of
+// them can be handed to any operation in parallel:
+//
+//	image.ForEachTileParallel(img, 256, 64, func(tile image.Rect) {
+//	    image.ClampImage(img.SubImage(tile), out.SubImage(tile), 0, 1)
+//	})
+//
 // # Color Transforms
 //
 // JPEG 2000 color space transformations for RGB ↔ YCbCr conversion:
@@ -49,6 +59,16 @@
 //	ForwardICT(r, g, b, outY, outCb, outCr) // RGB → YCbCr
 //	InverseICT(y, cb, cr, outR, outG, outB) // YCbCr → RGB
 //
+// # YUV 4:2:0 Frames
+//
+// Frame420 holds an 8-bit NV12 or I420 video frame. YUV420ToRGB upsamples
+// its chroma and converts it to planar RGB in [0, 1] for vision models, and
+// RGBToYUV420 goes back, averaging 2x2 blocks of chroma:
+//
+//	f := image.NewFrame420(image.NV12, 1920, 1080)
+//	rgb := image.NewImage3[float32](1920, 1080)
+//	image.YUV420ToRGB(f, rgb, image.BT709) // R, G, B planes
+//
 // # Edge Handling
 //
 // Coordinate helper functions for handling out-of-bounds pixel access:
@@ -42,6 +42,7 @@ type Image[T hwy.Lanes] struct {
 	width       int
 	height      int
 	stride      int // elements per row (includes padding)
+	rowLen      int // elements returned by Row; stride except in sub-images
 	bytesPerRow int
 }
 
@@ -75,6 +76,7 @@ func NewImage[T hwy.Lanes](width, height int) *Image[T] {
 		width:       width,
 		height:      height,
 		stride:      stride,
+		rowLen:      stride,
 		bytesPerRow: bytesPerRow,
 	}
 }
@@ -107,7 +109,7 @@ func (img *Image[T]) Row(y int) []T {
 		return nil
 	}
 	start := y * img.stride
-	return img.data[start : start+img.stride]
+	return img.data[start : start+img.rowLen]
 }
 
 // dataPtr returns an unsafe pointer to the first element of the image data.
@@ -158,34 +160,61 @@ func SameSize[T, U hwy.Lanes](a *Image[T], b *Image[U]) bool {
 }
 
 // Clone creates a deep copy of the image.
+// The copy of a sub-image is a standalone image with its own rows.
 func (img *Image[T]) Clone() *Image[T] {
 	if img.data == nil {
 		return NewImage[T](0, 0)
 	}
 
-	clone := &Image[T]{
-		data:        make([]T, len(img.data)),
-		width:       img.width,
-		height:      img.height,
-		stride:      img.stride,
-		bytesPerRow: img.bytesPerRow,
+	clone := NewImage[T](img.width, img.height)
+	for y := range img.height {
+		copy(clone.Row(y), img.Row(y))
 	}
-	copy(clone.data, img.data)
 	return clone
 }
 
 // Clear sets all pixels to zero.
 func (img *Image[T]) Clear() {
-	for i := range img.data {
-		var zero T
-		img.data[i] = zero
-	}
+	var zero T
+	img.Fill(zero)
 }
 
 // Fill sets all pixels to the specified value.
 func (img *Image[T]) Fill(value T) {
-	for i := range img.data {
-		img.data[i] = value
+	for y := range img.height {
+		row := img.Row(y)
+		for i := range row {
+			row[i] = value
+		}
+	}
+}
+
+// SubImage returns a view of the pixels of img in r, sharing its memory.
+// r is clipped to the image bounds; an empty result has no data.
+//
+// The rows of the view extend past its width only up to the next multiple
+// of the vector width. Views whose X0 and width are multiples of
+// hwy.MaxLanes[T]() (or that reach the right edge), such as the tiles of
+// ForEachTileParallel, therefore never share a vector and can be written
+// concurrently by any operation, including those that store whole vectors
+// into the row padding.
+func (img *Image[T]) SubImage(r Rect) *Image[T] {
+	r = r.Intersect(img.Bounds())
+	if r.IsEmpty() || img.data == nil {
+		return NewImage[T](0, 0)
+	}
+
+	lanes := hwy.MaxLanes[T]()
+	width, height := r.Width(), r.Height()
+	rowLen := min((width+lanes-1)/lanes*lanes, img.rowLen-r.X0)
+	start := r.Y0*img.stride + r.X0
+	return &Image[T]{
+		data:        img.data[start : start+(height-1)*img.stride+rowLen],
+		width:       width,
+		height:      height,
+		stride:      img.stride,
+		rowLen:      rowLen,
+		bytesPerRow: img.bytesPerRow,
 	}
 }
 
@@ -267,6 +296,17 @@ func (img *Image3[T]) Height() int {
 	return img.planes[0].Height()
 }
 
+// SubImage returns views of the three planes in r; see Image.SubImage.
+func (img *Image3[T]) SubImage(r Rect) *Image3[T] {
+	return &Image3[T]{
+		planes: [3]*Image[T]{
+			img.planes[0].SubImage(r),
+			img.planes[1].SubImage(r),
+			img.planes[2].SubImage(r),
+		},
+	}
+}
+
 // Mirror returns the mirrored index for out-of-bounds coordinates.
 // Used for edge handling in convolution operations.
 // Given bounds [0, size), mirrors index to stay within bounds.
@@ -170,6 +170,49 @@ func TestImage_ClearFill(t *testing.T) {
 	}
 }
 
+func TestImage_SubImage(t *testing.T) {
+	img := NewImage[float32](40, 20)
+	for y := range 20 {
+		for x := range 40 {
+			img.Set(x, y, float32(100*y+x))
+		}
+	}
+
+	sub := img.SubImage(Rect{X0: 16, Y0: 5, X1: 50, Y1: 9})
+	if sub.Width() != 24 || sub.Height() != 4 {
+		t.Fatalf("SubImage dimensions: got %dx%d, want 24x4", sub.Width(), sub.Height())
+	}
+	if got := sub.At(0, 0); got != 516 {
+		t.Errorf("SubImage At(0,0) = %v, want 516", got)
+	}
+	for y := range sub.Height() {
+		if len(sub.Row(y)) < sub.Width() || len(sub.Row(y)) > img.Stride()-16 {
+			t.Errorf("SubImage Row(%d) has length %d", y, len(sub.Row(y)))
+		}
+	}
+
+	// Writes go to the parent, and only inside the view.
+	sub.Fill(-1)
+	for y := range 20 {
+		for x := range 40 {
+			inside := x >= 16 && y >= 5 && y < 9
+			if got := img.At(x, y); (got == -1) != inside {
+				t.Fatalf("after Fill, At(%d,%d) = %v", x, y, got)
+			}
+		}
+	}
+
+	clone := sub.Clone()
+	clone.Set(0, 0, 7)
+	if clone.Width() != 24 || img.At(16, 5) != -1 {
+		t.Error("Clone of a SubImage should be an independent copy")
+	}
+
+	if empty := img.SubImage(Rect{X0: 50, Y0: 0, X1: 60, Y1: 5}); empty.Width() != 0 || empty.Row(0) != nil {
+		t.Error("SubImage outside the bounds should be empty")
+	}
+}
+
 func TestImage_Bounds(t *testing.T) {
 	img := NewImage[float32](100, 50)
 	bounds := img.Bounds()
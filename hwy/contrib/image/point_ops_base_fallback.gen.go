@@ -73,6 +73,28 @@ func BaseBrightnessContrast_fallback(img *Image[float32], out *Image[float32], s
 		outRow := out.Row(y)
 		width := img.width
 		i := 0
+		for ; i+4 <= width; i += 4 {
+			{
+				v := inRow[i]
+				result := v*scaleVec + offsetVec
+				outRow[i] = result
+			}
+			{
+				v := inRow[i+1]
+				result := v*scaleVec + offsetVec
+				outRow[i+1] = result
+			}
+			{
+				v := inRow[i+2]
+				result := v*scaleVec + offsetVec
+				outRow[i+2] = result
+			}
+			{
+				v := inRow[i+3]
+				result := v*scaleVec + offsetVec
+				outRow[i+3] = result
+			}
+		}
 		for ; i < width; i++ {
 			v := inRow[i]
 			result := v*scaleVec + offsetVec
@@ -100,6 +122,28 @@ func BaseBrightnessContrast_fallback_Float64(img *Image[float64], out *Image[flo
 		outRow := out.Row(y)
 		width := img.width
 		i := 0
+		for ; i+4 <= width; i += 4 {
+			{
+				v := inRow[i]
+				result := v*scaleVec + offsetVec
+				outRow[i] = result
+			}
+			{
+				v := inRow[i+1]
+				result := v*scaleVec + offsetVec
+				outRow[i+1] = result
+			}
+			{
+				v := inRow[i+2]
+				result := v*scaleVec + offsetVec
+				outRow[i+2] = result
+			}
+			{
+				v := inRow[i+3]
+				result := v*scaleVec + offsetVec
+				outRow[i+3] = result
+			}
+		}
 		for ; i < width; i++ {
 			v := inRow[i]
 			result := v*scaleVec + offsetVec
@@ -183,6 +227,28 @@ func BaseClampImage_fallback(img *Image[float32], out *Image[float32], minVal fl
 		outRow := out.Row(y)
 		width := img.width
 		i := 0
+		for ; i+4 <= width; i += 4 {
+			{
+				v := inRow[i]
+				result := max(min(v, maxVec), minVec)
+				outRow[i] = result
+			}
+			{
+				v := inRow[i+1]
+				result := max(min(v, maxVec), minVec)
+				outRow[i+1] = result
+			}
+			{
+				v := inRow[i+2]
+				result := max(min(v, maxVec), minVec)
+				outRow[i+2] = result
+			}
+			{
+				v := inRow[i+3]
+				result := max(min(v, maxVec), minVec)
+				outRow[i+3] = result
+			}
+		}
 		for ; i < width; i++ {
 			v := inRow[i]
 			result := max(min(v, maxVec), minVec)
@@ -210,6 +276,28 @@ func BaseClampImage_fallback_Float64(img *Image[float64], out *Image[float64], m
 		outRow := out.Row(y)
 		width := img.width
 		i := 0
+		for ; i+4 <= width; i += 4 {
+			{
+				v := inRow[i]
+				result := max(min(v, maxVec), minVec)
+				outRow[i] = result
+			}
+			{
+				v := inRow[i+1]
+				result := max(min(v, maxVec), minVec)
+				outRow[i+1] = result
+			}
+			{
+				v := inRow[i+2]
+				result := max(min(v, maxVec), minVec)
+				outRow[i+2] = result
+			}
+			{
+				v := inRow[i+3]
+				result := max(min(v, maxVec), minVec)
+				outRow[i+3] = result
+			}
+		}
 		for ; i < width; i++ {
 			v := inRow[i]
 			result := max(min(v, maxVec), minVec)
@@ -414,6 +502,28 @@ func BaseInvert_fallback(img *Image[float32], out *Image[float32], maxVal float3
 		outRow := out.Row(y)
 		width := img.width
 		i := 0
+		for ; i+4 <= width; i += 4 {
+			{
+				v := inRow[i]
+				result := maxVec - v
+				outRow[i] = result
+			}
+			{
+				v := inRow[i+1]
+				result := maxVec - v
+				outRow[i+1] = result
+			}
+			{
+				v := inRow[i+2]
+				result := maxVec - v
+				outRow[i+2] = result
+			}
+			{
+				v := inRow[i+3]
+				result := maxVec - v
+				outRow[i+3] = result
+			}
+		}
 		for ; i < width; i++ {
 			v := inRow[i]
 			result := maxVec - v
@@ -440,6 +550,28 @@ func BaseInvert_fallback_Float64(img *Image[float64], out *Image[float64], maxVa
 		outRow := out.Row(y)
 		width := img.width
 		i := 0
+		for ; i+4 <= width; i += 4 {
+			{
+				v := inRow[i]
+				result := maxVec - v
+				outRow[i] = result
+			}
+			{
+				v := inRow[i+1]
+				result := maxVec - v
+				outRow[i+1] = result
+			}
+			{
+				v := inRow[i+2]
+				result := maxVec - v
+				outRow[i+2] = result
+			}
+			{
+				v := inRow[i+3]
+				result := maxVec - v
+				outRow[i+3] = result
+			}
+		}
 		for ; i < width; i++ {
 			v := inRow[i]
 			result := maxVec - v
@@ -624,6 +756,28 @@ func BaseScale_fallback(img *Image[float32], out *Image[float32], scale float32)
 		outRow := out.Row(y)
 		width := img.width
 		i := 0
+		for ; i+4 <= width; i += 4 {
+			{
+				v := inRow[i]
+				result := v * scaleVec
+				outRow[i] = result
+			}
+			{
+				v := inRow[i+1]
+				result := v * scaleVec
+				outRow[i+1] = result
+			}
+			{
+				v := inRow[i+2]
+				result := v * scaleVec
+				outRow[i+2] = result
+			}
+			{
+				v := inRow[i+3]
+				result := v * scaleVec
+				outRow[i+3] = result
+			}
+		}
 		for ; i < width; i++ {
 			v := inRow[i]
 			result := v * scaleVec
@@ -650,6 +804,28 @@ func BaseScale_fallback_Float64(img *Image[float64], out *Image[float64], scale
 		outRow := out.Row(y)
 		width := img.width
 		i := 0
+		for ; i+4 <= width; i += 4 {
+			{
+				v := inRow[i]
+				result := v * scaleVec
+				outRow[i] = result
+			}
+			{
+				v := inRow[i+1]
+				result := v * scaleVec
+				outRow[i+1] = result
+			}
+			{
+				v := inRow[i+2]
+				result := v * scaleVec
+				outRow[i+2] = result
+			}
+			{
+				v := inRow[i+3]
+				result := v * scaleVec
+				outRow[i+3] = result
+			}
+		}
 		for ; i < width; i++ {
 			v := inRow[i]
 			result := v * scaleVec
@@ -730,6 +906,28 @@ func BaseOffset_fallback(img *Image[float32], out *Image[float32], offset float3
 		outRow := out.Row(y)
 		width := img.width
 		i := 0
+		for ; i+4 <= width; i += 4 {
+			{
+				v := inRow[i]
+				result := v + offsetVec
+				outRow[i] = result
+			}
+			{
+				v := inRow[i+1]
+				result := v + offsetVec
+				outRow[i+1] = result
+			}
+			{
+				v := inRow[i+2]
+				result := v + offsetVec
+				outRow[i+2] = result
+			}
+			{
+				v := inRow[i+3]
+				result := v + offsetVec
+				outRow[i+3] = result
+			}
+		}
 		for ; i < width; i++ {
 			v := inRow[i]
 			result := v + offsetVec
@@ -756,6 +954,28 @@ func BaseOffset_fallback_Float64(img *Image[float64], out *Image[float64], offse
 		outRow := out.Row(y)
 		width := img.width
 		i := 0
+		for ; i+4 <= width; i += 4 {
+			{
+				v := inRow[i]
+				result := v + offsetVec
+				outRow[i] = result
+			}
+			{
+				v := inRow[i+1]
+				result := v + offsetVec
+				outRow[i+1] = result
+			}
+			{
+				v := inRow[i+2]
+				result := v + offsetVec
+				outRow[i+2] = result
+			}
+			{
+				v := inRow[i+3]
+				result := v + offsetVec
+				outRow[i+3] = result
+			}
+		}
 		for ; i < width; i++ {
 			v := inRow[i]
 			result := v + offsetVec
@@ -957,6 +1177,32 @@ func BaseMinImage_fallback(a *Image[float32], b *Image[float32], out *Image[floa
 		outRow := out.Row(y)
 		width := min(a.width, b.width)
 		i := 0
+		for ; i+4 <= width; i += 4 {
+			{
+				va := aRow[i]
+				vb := bRow[i]
+				result := min(va, vb)
+				outRow[i] = result
+			}
+			{
+				va := aRow[i+1]
+				vb := bRow[i+1]
+				result := min(va, vb)
+				outRow[i+1] = result
+			}
+			{
+				va := aRow[i+2]
+				vb := bRow[i+2]
+				result := min(va, vb)
+				outRow[i+2] = result
+			}
+			{
+				va := aRow[i+3]
+				vb := bRow[i+3]
+				result := min(va, vb)
+				outRow[i+3] = result
+			}
+		}
 		for ; i < width; i++ {
 			va := aRow[i]
 			vb := bRow[i]
@@ -989,6 +1235,32 @@ func BaseMinImage_fallback_Float64(a *Image[float64], b *Image[float64], out *Im
 		outRow := out.Row(y)
 		width := min(a.width, b.width)
 		i := 0
+		for ; i+4 <= width; i += 4 {
+			{
+				va := aRow[i]
+				vb := bRow[i]
+				result := min(va, vb)
+				outRow[i] = result
+			}
+			{
+				va := aRow[i+1]
+				vb := bRow[i+1]
+				result := min(va, vb)
+				outRow[i+1] = result
+			}
+			{
+				va := aRow[i+2]
+				vb := bRow[i+2]
+				result := min(va, vb)
+				outRow[i+2] = result
+			}
+			{
+				va := aRow[i+3]
+				vb := bRow[i+3]
+				result := min(va, vb)
+				outRow[i+3] = result
+			}
+		}
 		for ; i < width; i++ {
 			va := aRow[i]
 			vb := bRow[i]
@@ -1087,6 +1359,32 @@ func BaseMaxImage_fallback(a *Image[float32], b *Image[float32], out *Image[floa
 		outRow := out.Row(y)
 		width := min(a.width, b.width)
 		i := 0
+		for ; i+4 <= width; i += 4 {
+			{
+				va := aRow[i]
+				vb := bRow[i]
+				result := max(va, vb)
+				outRow[i] = result
+			}
+			{
+				va := aRow[i+1]
+				vb := bRow[i+1]
+				result := max(va, vb)
+				outRow[i+1] = result
+			}
+			{
+				va := aRow[i+2]
+				vb := bRow[i+2]
+				result := max(va, vb)
+				outRow[i+2] = result
+			}
+			{
+				va := aRow[i+3]
+				vb := bRow[i+3]
+				result := max(va, vb)
+				outRow[i+3] = result
+			}
+		}
 		for ; i < width; i++ {
 			va := aRow[i]
 			vb := bRow[i]
@@ -1119,6 +1417,32 @@ func BaseMaxImage_fallback_Float64(a *Image[float64], b *Image[float64], out *Im
 		outRow := out.Row(y)
 		width := min(a.width, b.width)
 		i := 0
+		for ; i+4 <= width; i += 4 {
+			{
+				va := aRow[i]
+				vb := bRow[i]
+				result := max(va, vb)
+				outRow[i] = result
+			}
+			{
+				va := aRow[i+1]
+				vb := bRow[i+1]
+				result := max(va, vb)
+				outRow[i+1] = result
+			}
+			{
+				va := aRow[i+2]
+				vb := bRow[i+2]
+				result := max(va, vb)
+				outRow[i+2] = result
+			}
+			{
+				va := aRow[i+3]
+				vb := bRow[i+3]
+				result := max(va, vb)
+				outRow[i+3] = result
+			}
+		}
 		for ; i < width; i++ {
 			va := aRow[i]
 			vb := bRow[i]
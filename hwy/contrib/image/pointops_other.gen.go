@@ -260,3 +260,48 @@ func initPointopsFallback() {
 	MaxImageFloat32 = BaseMaxImage_fallback
 	MaxImageFloat64 = BaseMaxImage_fallback_Float64
 }
+
+func init() {
+	hwy.RegisterKernel("image.BrightnessContrastFloat16", &BrightnessContrastFloat16)
+	hwy.RegisterKernel("image.BrightnessContrastBFloat16", &BrightnessContrastBFloat16)
+	hwy.RegisterKernel("image.BrightnessContrastFloat32", &BrightnessContrastFloat32)
+	hwy.RegisterKernel("image.BrightnessContrastFloat64", &BrightnessContrastFloat64)
+	hwy.RegisterKernel("image.ClampImageFloat16", &ClampImageFloat16)
+	hwy.RegisterKernel("image.ClampImageBFloat16", &ClampImageBFloat16)
+	hwy.RegisterKernel("image.ClampImageFloat32", &ClampImageFloat32)
+	hwy.RegisterKernel("image.ClampImageFloat64", &ClampImageFloat64)
+	hwy.RegisterKernel("image.ThresholdFloat16", &ThresholdFloat16)
+	hwy.RegisterKernel("image.ThresholdBFloat16", &ThresholdBFloat16)
+	hwy.RegisterKernel("image.ThresholdFloat32", &ThresholdFloat32)
+	hwy.RegisterKernel("image.ThresholdFloat64", &ThresholdFloat64)
+	hwy.RegisterKernel("image.InvertFloat16", &InvertFloat16)
+	hwy.RegisterKernel("image.InvertBFloat16", &InvertBFloat16)
+	hwy.RegisterKernel("image.InvertFloat32", &InvertFloat32)
+	hwy.RegisterKernel("image.InvertFloat64", &InvertFloat64)
+	hwy.RegisterKernel("image.AbsFloat16", &AbsFloat16)
+	hwy.RegisterKernel("image.AbsBFloat16", &AbsBFloat16)
+	hwy.RegisterKernel("image.AbsFloat32", &AbsFloat32)
+	hwy.RegisterKernel("image.AbsFloat64", &AbsFloat64)
+	hwy.RegisterKernel("image.ScaleFloat16", &ScaleFloat16)
+	hwy.RegisterKernel("image.ScaleBFloat16", &ScaleBFloat16)
+	hwy.RegisterKernel("image.ScaleFloat32", &ScaleFloat32)
+	hwy.RegisterKernel("image.ScaleFloat64", &ScaleFloat64)
+	hwy.RegisterKernel("image.OffsetFloat16", &OffsetFloat16)
+	hwy.RegisterKernel("image.OffsetBFloat16", &OffsetBFloat16)
+	hwy.RegisterKernel("image.OffsetFloat32", &OffsetFloat32)
+	hwy.RegisterKernel("image.OffsetFloat64", &OffsetFloat64)
+	hwy.RegisterKernel("image.GammaFloat16", &GammaFloat16)
+	hwy.RegisterKernel("image.GammaBFloat16", &GammaBFloat16)
+	hwy.RegisterKernel("image.GammaFloat32", &GammaFloat32)
+	hwy.RegisterKernel("image.GammaFloat64", &GammaFloat64)
+	hwy.RegisterKernel("image.MinImageFloat16", &MinImageFloat16)
+	hwy.RegisterKernel("image.MinImageBFloat16", &MinImageBFloat16)
+	hwy.RegisterKernel("image.MinImageFloat32", &MinImageFloat32)
+	hwy.RegisterKernel("image.MinImageFloat64", &MinImageFloat64)
+	hwy.RegisterKernel("image.MaxImageFloat16", &MaxImageFloat16)
+	hwy.RegisterKernel("image.MaxImageBFloat16", &MaxImageBFloat16)
+	hwy.RegisterKernel("image.MaxImageFloat32", &MaxImageFloat32)
+	hwy.RegisterKernel("image.MaxImageFloat64", &MaxImageFloat64)
+	hwyKernels := []string{"image.BrightnessContrastFloat16", "image.BrightnessContrastBFloat16", "image.BrightnessContrastFloat32", "image.BrightnessContrastFloat64", "image.ClampImageFloat16", "image.ClampImageBFloat16", "image.ClampImageFloat32", "image.ClampImageFloat64", "image.ThresholdFloat16", "image.ThresholdBFloat16", "image.ThresholdFloat32", "image.ThresholdFloat64", "image.InvertFloat16", "image.InvertBFloat16", "image.InvertFloat32", "image.InvertFloat64", "image.AbsFloat16", "image.AbsBFloat16", "image.AbsFloat32", "image.AbsFloat64", "image.ScaleFloat16", "image.ScaleBFloat16", "image.ScaleFloat32", "image.ScaleFloat64", "image.OffsetFloat16", "image.OffsetBFloat16", "image.OffsetFloat32", "image.OffsetFloat64", "image.GammaFloat16", "image.GammaBFloat16", "image.GammaFloat32", "image.GammaFloat64", "image.MinImageFloat16", "image.MinImageBFloat16", "image.MinImageFloat32", "image.MinImageFloat64", "image.MaxImageFloat16", "image.MaxImageBFloat16", "image.MaxImageFloat32", "image.MaxImageFloat64"}
+	hwy.RegisterKernelTarget(hwy.DispatchScalar, initPointopsFallback, hwyKernels...)
+}
@@ -0,0 +1,64 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package image
+
+import (
+	"github.com/ajroetker/go-highway/hwy"
+	"github.com/ajroetker/go-highway/hwy/contrib/workerpool"
+)
+
+// ForEachTileParallel splits img into tiles of about tileW x tileH pixels
+// and calls fn for each tile on the shared worker pool
+// (workerpool.Default()), returning once every call has returned. Workers
+// take tiles with atomic work stealing, so uneven tiles still balance.
+//
+// tileW is rounded up to a multiple of the vector width, so every tile
+// starts on a vector-aligned column and img.SubImage(tile) views of
+// different tiles never share a vector. Any operation on Image can then
+// run per tile:
+//
+//	image.ForEachTileParallel(img, 256, 64, func(tile image.Rect) {
+//	    image.BrightnessContrast(img.SubImage(tile), out.SubImage(tile), 1.5, 0.1)
+//	})
+//
+// Stencil operations can read the neighborhood of a tile from img itself
+// and write only inside the tile.
+func ForEachTileParallel[T hwy.Lanes](img *Image[T], tileW, tileH int, fn func(tile Rect)) {
+	if tileW <= 0 || tileH <= 0 {
+		panic("image: tile size must be positive")
+	}
+	width, height := img.Width(), img.Height()
+	if width == 0 || height == 0 {
+		return
+	}
+
+	lanes := hwy.MaxLanes[T]()
+	tileW = (tileW + lanes - 1) / lanes * lanes
+	tilesX := (width + tileW - 1) / tileW
+	tilesY := (height + tileH - 1) / tileH
+
+	tile := func(i int) Rect {
+		x0 := (i % tilesX) * tileW
+		y0 := (i / tilesX) * tileH
+		return Rect{X0: x0, Y0: y0, X1: min(x0+tileW, width), Y1: min(y0+tileH, height)}
+	}
+	if tilesX*tilesY == 1 {
+		fn(tile(0))
+		return
+	}
+	workerpool.Default().ParallelForAtomic(tilesX*tilesY, func(i int) {
+		fn(tile(i))
+	})
+}
@@ -0,0 +1,77 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package image
+
+import (
+	"fmt"
+	"sync/atomic"
+	"testing"
+
+	"github.com/ajroetker/go-highway/hwy"
+)
+
+func TestForEachTileParallel(t *testing.T) {
+	for _, tc := range []struct{ w, h, tileW, tileH int }{
+		{1, 1, 16, 16},
+		{100, 37, 16, 8},
+		{100, 37, 5, 3},
+		{64, 64, 64, 64},
+		{1000, 3, 1000, 1},
+	} {
+		t.Run(fmt.Sprintf("%dx%d/%dx%d", tc.w, tc.h, tc.tileW, tc.tileH), func(t *testing.T) {
+			img := NewImage[float32](tc.w, tc.h)
+			counts := make([]atomic.Int32, tc.w*tc.h)
+			ForEachTileParallel(img, tc.tileW, tc.tileH, func(tile Rect) {
+				if tile.X0%hwy.MaxLanes[float32]() != 0 {
+					t.Errorf("tile %v is not vector-aligned", tile)
+				}
+				for y := tile.Y0; y < tile.Y1; y++ {
+					for x := tile.X0; x < tile.X1; x++ {
+						counts[y*tc.w+x].Add(1)
+					}
+				}
+			})
+			for i := range counts {
+				if n := counts[i].Load(); n != 1 {
+					t.Fatalf("pixel (%d,%d) visited %d times", i%tc.w, i/tc.w, n)
+				}
+			}
+		})
+	}
+}
+
+func TestForEachTileParallelSubImage(t *testing.T) {
+	const w, h = 203, 61
+	img := NewImage[float32](w, h)
+	for y := range h {
+		for x := range w {
+			img.Set(x, y, float32(x*h+y))
+		}
+	}
+	want := NewImage[float32](w, h)
+	BrightnessContrast(img, want, 1.5, 0.25)
+
+	out := NewImage[float32](w, h)
+	ForEachTileParallel(img, 20, 7, func(tile Rect) {
+		BrightnessContrast(img.SubImage(tile), out.SubImage(tile), 1.5, 0.25)
+	})
+	for y := range h {
+		for x := range w {
+			if got := out.At(x, y); got != want.At(x, y) {
+				t.Fatalf("(%d,%d) = %v, want %v", x, y, got, want.At(x, y))
+			}
+		}
+	}
+}
@@ -0,0 +1,231 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package image
+
+import "github.com/ajroetker/go-highway/hwy"
+
+// YUVFormat is the memory layout of a YUV 4:2:0 frame.
+type YUVFormat int
+
+const (
+	// NV12 has a full-resolution Y plane and one half-resolution plane of
+	// interleaved U, V pairs, as produced by most hardware video decoders.
+	NV12 YUVFormat = iota
+
+	// I420 has a full-resolution Y plane followed by separate
+	// half-resolution U and V planes.
+	I420
+)
+
+// YUVMatrix describes the YCbCr encoding of a video stream: the luma
+// weights of red and blue, and whether samples use the full 8-bit range
+// or the limited (studio) range of Y in [16, 235] and U, V in [16, 240].
+type YUVMatrix struct {
+	Kr, Kb    float64
+	FullRange bool
+}
+
+// Standard YUV matrices, in limited range as broadcast and camera streams
+// use. Set FullRange for JPEG-style full-range content.
+var (
+	// BT601 is the standard-definition matrix (ITU-R BT.601).
+	BT601 = YUVMatrix{Kr: 0.299, Kb: 0.114}
+	// BT709 is the high-definition matrix (ITU-R BT.709).
+	BT709 = YUVMatrix{Kr: 0.2126, Kb: 0.0722}
+)
+
+// Frame420 is an 8-bit YUV 4:2:0 frame. The chroma planes have
+// (Width+1)/2 x (Height+1)/2 samples.
+type Frame420 struct {
+	Format        YUVFormat
+	Width, Height int
+
+	// Y is the luma plane, with rows YStride bytes apart.
+	Y       []uint8
+	YStride int
+
+	// U and V are the chroma planes, with rows UVStride bytes apart. For
+	// NV12, U holds the interleaved UV plane and V is unused.
+	U, V     []uint8
+	UVStride int
+}
+
+// NewFrame420 allocates a tightly packed frame in one buffer, in the
+// layout of the format: Y, then UV for NV12, or Y, U, V for I420.
+func NewFrame420(format YUVFormat, width, height int) *Frame420 {
+	cw, ch := (width+1)/2, (height+1)/2
+	f := &Frame420{Format: format, Width: width, Height: height, YStride: width}
+	ySize := width * height
+	switch format {
+	case NV12:
+		f.UVStride = 2 * cw
+		buf := make([]uint8, ySize+f.UVStride*ch)
+		f.Y, f.U = buf[:ySize], buf[ySize:]
+	case I420:
+		f.UVStride = cw
+		buf := make([]uint8, ySize+2*cw*ch)
+		f.Y, f.U, f.V = buf[:ySize], buf[ySize:ySize+cw*ch], buf[ySize+cw*ch:]
+	default:
+		panic("image: unknown YUVFormat")
+	}
+	return f
+}
+
+// chromaRow returns chroma row cy: the interleaved UV row for NV12, or
+// the U row for I420 (the V row is at the same offset in f.V).
+func (f *Frame420) chromaRow(plane []uint8, cy int) []uint8 {
+	w := (f.Width + 1) / 2
+	if f.Format == NV12 {
+		w *= 2
+	}
+	return plane[cy*f.UVStride : cy*f.UVStride+w]
+}
+
+// yuvToRGBCoeffs returns the arguments of YUVToRGBRow for m, producing RGB
+// in [0, 1].
+func yuvToRGBCoeffs(m YUVMatrix) (yScale, yOffset, vToR, uToG, vToG, uToB float32) {
+	kg := 1 - m.Kr - m.Kb
+	ys, cs, yo := 1.0, 1.0, 0.0
+	if !m.FullRange {
+		ys, cs, yo = 255.0/219, 255.0/224, 16
+	}
+	return float32(ys / 255), float32(yo),
+		float32(2 * (1 - m.Kr) * cs / 255),
+		float32(-2 * m.Kb * (1 - m.Kb) / kg * cs / 255),
+		float32(-2 * m.Kr * (1 - m.Kr) / kg * cs / 255),
+		float32(2 * (1 - m.Kb) * cs / 255)
+}
+
+// YUV420ToRGB converts a YUV 4:2:0 frame to planar RGB in [0, 1], the
+// usual input of vision models. out must have the frame's dimensions.
+//
+// Chroma is upsampled with the siting of MPEG-2, H.264 and HEVC: linear
+// interpolation horizontally between samples co-sited with the even luma
+// columns, and a 3/4, 1/4 filter vertically between samples centered
+// between luma rows. Both passes run on float rows with SIMD before the
+// per-pixel matrix.
+func YUV420ToRGB(f *Frame420, out *Image3[float32], m YUVMatrix) {
+	if f.Width != out.Width() || f.Height != out.Height() {
+		panic("image: YUV420ToRGB size mismatch")
+	}
+	w, h := f.Width, f.Height
+	if w == 0 || h == 0 {
+		return
+	}
+	cw, ch := (w+1)/2, (h+1)/2
+	yScale, yOffset, vToR, uToG, vToG, uToB := yuvToRGBCoeffs(m)
+
+	yf := make([]float32, w)
+	cur := make([]float32, 2*cw)
+	nbr := make([]float32, 2*cw)
+	uHalf := make([]float32, cw)
+	vHalf := make([]float32, cw)
+	uFull := make([]float32, w)
+	vFull := make([]float32, w)
+
+	// loadChroma converts chroma row cy to floats in dst: interleaved UV
+	// for NV12, or U followed by V for I420.
+	loadChroma := func(dst []float32, cy int) {
+		if f.Format == NV12 {
+			hwy.ConvertScaleU8ToF32(dst, f.chromaRow(f.U, cy), 1)
+			return
+		}
+		hwy.ConvertScaleU8ToF32(dst[:cw], f.chromaRow(f.U, cy), 1)
+		hwy.ConvertScaleU8ToF32(dst[cw:], f.chromaRow(f.V, cy), 1)
+	}
+
+	for y := range h {
+		cy := y / 2
+		ny := cy - 1
+		if y%2 == 1 {
+			ny = cy + 1
+		}
+		ny = min(max(ny, 0), ch-1)
+
+		loadChroma(cur, cy)
+		loadChroma(nbr, ny)
+		LerpRows(cur, nbr, cur, 0.25)
+		if f.Format == NV12 {
+			DeinterleaveRow(cur, uHalf, vHalf)
+		} else {
+			copy(uHalf, cur[:cw])
+			copy(vHalf, cur[cw:])
+		}
+		UpsampleRow2x(uHalf, uFull)
+		UpsampleRow2x(vHalf, vFull)
+
+		hwy.ConvertScaleU8ToF32(yf, f.Y[y*f.YStride:y*f.YStride+w], 1)
+		YUVToRGBRow(yf, uFull, vFull,
+			out.PlaneRow(0, y)[:w], out.PlaneRow(1, y)[:w], out.PlaneRow(2, y)[:w],
+			yScale, yOffset, vToR, uToG, vToG, uToB)
+	}
+}
+
+// RGBToYUV420 converts planar RGB in [0, 1] to a YUV 4:2:0 frame. in must
+// have the frame's dimensions. Chroma is computed at full resolution and
+// downsampled by averaging 2x2 blocks; samples are rounded to nearest and
+// saturated to [0, 255].
+func RGBToYUV420(in *Image3[float32], f *Frame420, m YUVMatrix) {
+	if f.Width != in.Width() || f.Height != in.Height() {
+		panic("image: RGBToYUV420 size mismatch")
+	}
+	w, h := f.Width, f.Height
+	if w == 0 || h == 0 {
+		return
+	}
+	cw := (w + 1) / 2
+
+	kg := 1 - m.Kr - m.Kb
+	ys, cs, yo := 255.0, 255.0, 0.0
+	if !m.FullRange {
+		ys, cs, yo = 219, 224, 16
+	}
+	// U = (B - Y')/(2(1-Kb)) and V = (R - Y')/(2(1-Kr)), Y' = Kr R + Kg G + Kb B.
+	ub, vr := cs/(2*(1-m.Kb)), cs/(2*(1-m.Kr))
+	rToY, gToY, bToY := float32(m.Kr*ys), float32(kg*ys), float32(m.Kb*ys)
+	rToU, gToU, bToU := float32(-m.Kr*ub), float32(-kg*ub), float32((1-m.Kb)*ub)
+	rToV, gToV, bToV := float32((1-m.Kr)*vr), float32(-kg*vr), float32(-m.Kb*vr)
+
+	yRow := make([]float32, w)
+	u := [2][]float32{make([]float32, w), make([]float32, w)}
+	v := [2][]float32{make([]float32, w), make([]float32, w)}
+	uHalf := make([]float32, cw)
+	vHalf := make([]float32, cw)
+	uv := make([]float32, 2*cw)
+
+	for y := 0; y < h; y += 2 {
+		for dy := range 2 {
+			// An odd last row pairs with itself.
+			row := min(y+dy, h-1)
+			RGBToYUVRow(in.PlaneRow(0, row)[:w], in.PlaneRow(1, row)[:w], in.PlaneRow(2, row)[:w],
+				yRow, u[dy], v[dy],
+				rToY, gToY, bToY, rToU, gToU, bToU, rToV, gToV, bToV, float32(yo))
+			if y+dy < h {
+				hwy.ConvertScaleF32ToU8(f.Y[row*f.YStride:row*f.YStride+w], yRow, 1)
+			}
+		}
+
+		cy := y / 2
+		DownsampleRows2x(u[0], u[1], uHalf)
+		DownsampleRows2x(v[0], v[1], vHalf)
+		if f.Format == NV12 {
+			InterleaveRows(uHalf, vHalf, uv)
+			hwy.ConvertScaleF32ToU8(f.chromaRow(f.U, cy), uv, 1)
+		} else {
+			hwy.ConvertScaleF32ToU8(f.chromaRow(f.U, cy), uHalf, 1)
+			hwy.ConvertScaleF32ToU8(f.chromaRow(f.V, cy), vHalf, 1)
+		}
+	}
+}
@@ -0,0 +1,221 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package image
+
+import "github.com/ajroetker/go-highway/hwy"
+
+//go:generate go run ../../../cmd/hwygen -input yuv_base.go -output . -targets avx2,avx512,neon,fallback -dispatch yuv
+
+// Row kernels of the YUV 4:2:0 conversions in yuv.go. Samples are floats on
+// the 8-bit scale, [0, 255] with chroma centered on 128; RGB is [0, 1].
+
+// BaseYUVToRGBRow converts one row of full-resolution Y, U, V samples to
+// RGB in [0, 1]:
+//
+//	Y' = (Y - yOffset) * yScale
+//	R  = Y' + vToR*(V-128)
+//	G  = Y' + uToG*(U-128) + vToG*(V-128)
+//	B  = Y' + uToB*(U-128)
+//
+// The results are clamped to [0, 1]. The coefficients include the 1/255
+// output scale.
+func BaseYUVToRGBRow[T hwy.FloatsNative](y, u, v, r, g, b []T, yScale, yOffset, vToR, uToG, vToG, uToB T) {
+	n := min(len(y), len(u), len(v), len(r), len(g), len(b))
+	vYScale := hwy.Set(yScale)
+	vYOffset := hwy.Set(yOffset)
+	vVToR := hwy.Set(vToR)
+	vUToG := hwy.Set(uToG)
+	vVToG := hwy.Set(vToG)
+	vUToB := hwy.Set(uToB)
+	vHalf := hwy.Set(T(128))
+	vZero := hwy.Zero[T]()
+	vOne := hwy.Set(T(1))
+	lanes := vZero.NumLanes()
+
+	var i int
+	for i = 0; i+lanes <= n; i += lanes {
+		vy := hwy.Mul(hwy.Sub(hwy.Load(y[i:]), vYOffset), vYScale)
+		vu := hwy.Sub(hwy.Load(u[i:]), vHalf)
+		vv := hwy.Sub(hwy.Load(v[i:]), vHalf)
+
+		vr := hwy.MulAdd(vVToR, vv, vy)
+		vg := hwy.MulAdd(vVToG, vv, hwy.MulAdd(vUToG, vu, vy))
+		vb := hwy.MulAdd(vUToB, vu, vy)
+
+		hwy.Store(hwy.Max(hwy.Min(vr, vOne), vZero), r[i:])
+		hwy.Store(hwy.Max(hwy.Min(vg, vOne), vZero), g[i:])
+		hwy.Store(hwy.Max(hwy.Min(vb, vOne), vZero), b[i:])
+	}
+	for ; i < n; i++ {
+		yy := (y[i] - yOffset) * yScale
+		uu := u[i] - 128
+		vv := v[i] - 128
+		r[i] = min(max(yy+vToR*vv, 0), 1)
+		g[i] = min(max(yy+uToG*uu+vToG*vv, 0), 1)
+		b[i] = min(max(yy+uToB*uu, 0), 1)
+	}
+}
+
+// BaseRGBToYUVRow converts one row of RGB in [0, 1] to full-resolution
+// Y, U, V samples on the 8-bit scale:
+//
+//	Y = yOffset + rToY*R + gToY*G + bToY*B
+//	U = 128     + rToU*R + gToU*G + bToU*B
+//	V = 128     + rToV*R + gToV*G + bToV*B
+func BaseRGBToYUVRow[T hwy.FloatsNative](r, g, b, y, u, v []T, rToY, gToY, bToY, rToU, gToU, bToU, rToV, gToV, bToV, yOffset T) {
+	n := min(len(r), len(g), len(b), len(y), len(u), len(v))
+	vRToY := hwy.Set(rToY)
+	vGToY := hwy.Set(gToY)
+	vBToY := hwy.Set(bToY)
+	vRToU := hwy.Set(rToU)
+	vGToU := hwy.Set(gToU)
+	vBToU := hwy.Set(bToU)
+	vRToV := hwy.Set(rToV)
+	vGToV := hwy.Set(gToV)
+	vBToV := hwy.Set(bToV)
+	vYOffset := hwy.Set(yOffset)
+	vHalf := hwy.Set(T(128))
+	lanes := vHalf.NumLanes()
+
+	var i int
+	for i = 0; i+lanes <= n; i += lanes {
+		vr := hwy.Load(r[i:])
+		vg := hwy.Load(g[i:])
+		vb := hwy.Load(b[i:])
+
+		vy := hwy.MulAdd(vBToY, vb, hwy.MulAdd(vGToY, vg, hwy.MulAdd(vRToY, vr, vYOffset)))
+		vu := hwy.MulAdd(vBToU, vb, hwy.MulAdd(vGToU, vg, hwy.MulAdd(vRToU, vr, vHalf)))
+		vv := hwy.MulAdd(vBToV, vb, hwy.MulAdd(vGToV, vg, hwy.MulAdd(vRToV, vr, vHalf)))
+
+		hwy.Store(vy, y[i:])
+		hwy.Store(vu, u[i:])
+		hwy.Store(vv, v[i:])
+	}
+	for ; i < n; i++ {
+		y[i] = yOffset + rToY*r[i] + gToY*g[i] + bToY*b[i]
+		u[i] = 128 + rToU*r[i] + gToU*g[i] + bToU*b[i]
+		v[i] = 128 + rToV*r[i] + gToV*g[i] + bToV*b[i]
+	}
+}
+
+// BaseUpsampleRow2x doubles the horizontal resolution of a chroma row with
+// samples co-sited with the even luma columns (MPEG-2, H.264 and HEVC
+// siting): dst[2i] = src[i] and dst[2i+1] is the mean of src[i] and
+// src[i+1], repeating the last sample at the edge. len(dst) may be
+// 2*len(src) or one less, for odd widths.
+func BaseUpsampleRow2x[T hwy.FloatsNative](src, dst []T) {
+	n := min(len(src), (len(dst)+1)/2)
+	if n == 0 {
+		return
+	}
+	vHalf := hwy.Set(T(0.5))
+	lanes := vHalf.NumLanes()
+
+	var i int
+	for i = 0; i+lanes < n && 2*(i+lanes) <= len(dst); i += lanes {
+		v0 := hwy.Load(src[i:])
+		v1 := hwy.Load(src[i+1:])
+		odd := hwy.Mul(hwy.Add(v0, v1), vHalf)
+		hwy.Store(hwy.InterleaveLower(v0, odd), dst[2*i:])
+		hwy.Store(hwy.InterleaveUpper(v0, odd), dst[2*i+lanes:])
+	}
+	for ; i < n; i++ {
+		dst[2*i] = src[i]
+		if 2*i+1 < len(dst) {
+			next := src[min(i+1, n-1)]
+			dst[2*i+1] = (src[i] + next) * 0.5
+		}
+	}
+}
+
+// BaseDownsampleRows2x averages 2x2 blocks of two full-resolution rows a
+// and b into dst: dst[i] is the mean of a[2i], a[2i+1], b[2i] and b[2i+1].
+// For odd widths the last column is repeated.
+func BaseDownsampleRows2x[T hwy.FloatsNative](a, b, dst []T) {
+	w := min(len(a), len(b))
+	n := min(len(dst), (w+1)/2)
+	vQuarter := hwy.Set(T(0.25))
+	lanes := vQuarter.NumLanes()
+
+	var i int
+	for i = 0; i+lanes <= n && 2*i+2*lanes <= w; i += lanes {
+		a0 := hwy.Load(a[2*i:])
+		a1 := hwy.Load(a[2*i+lanes:])
+		b0 := hwy.Load(b[2*i:])
+		b1 := hwy.Load(b[2*i+lanes:])
+		sum := hwy.Add(hwy.Add(hwy.ConcatEven(a0, a1), hwy.ConcatOdd(a0, a1)),
+			hwy.Add(hwy.ConcatEven(b0, b1), hwy.ConcatOdd(b0, b1)))
+		hwy.Store(hwy.Mul(sum, vQuarter), dst[i:])
+	}
+	for ; i < n; i++ {
+		x1 := min(2*i+1, w-1)
+		dst[i] = (a[2*i] + a[x1] + b[2*i] + b[x1]) * 0.25
+	}
+}
+
+// BaseLerpRows sets dst = a + t*(b-a), the vertical interpolation between
+// two chroma rows.
+func BaseLerpRows[T hwy.FloatsNative](a, b, dst []T, t T) {
+	n := min(len(a), len(b), len(dst))
+	vT := hwy.Set(t)
+	lanes := vT.NumLanes()
+
+	var i int
+	for i = 0; i+lanes <= n; i += lanes {
+		va := hwy.Load(a[i:])
+		vb := hwy.Load(b[i:])
+		hwy.Store(hwy.MulAdd(vT, hwy.Sub(vb, va), va), dst[i:])
+	}
+	for ; i < n; i++ {
+		dst[i] = a[i] + t*(b[i]-a[i])
+	}
+}
+
+// BaseInterleaveRows interleaves a and b into dst (a0, b0, a1, b1, ...),
+// the layout of the NV12 chroma plane.
+func BaseInterleaveRows[T hwy.FloatsNative](a, b, dst []T) {
+	n := min(len(a), len(b), len(dst)/2)
+	lanes := hwy.MaxLanes[T]()
+
+	var i int
+	for i = 0; i+lanes <= n; i += lanes {
+		va := hwy.Load(a[i:])
+		vb := hwy.Load(b[i:])
+		hwy.Store(hwy.InterleaveLower(va, vb), dst[2*i:])
+		hwy.Store(hwy.InterleaveUpper(va, vb), dst[2*i+lanes:])
+	}
+	for ; i < n; i++ {
+		dst[2*i] = a[i]
+		dst[2*i+1] = b[i]
+	}
+}
+
+// BaseDeinterleaveRow splits src (a0, b0, a1, b1, ...) into a and b.
+func BaseDeinterleaveRow[T hwy.FloatsNative](src, a, b []T) {
+	n := min(len(a), len(b), len(src)/2)
+	lanes := hwy.MaxLanes[T]()
+
+	var i int
+	for i = 0; i+lanes <= n; i += lanes {
+		v0 := hwy.Load(src[2*i:])
+		v1 := hwy.Load(src[2*i+lanes:])
+		hwy.Store(hwy.ConcatEven(v0, v1), a[i:])
+		hwy.Store(hwy.ConcatOdd(v0, v1), b[i:])
+	}
+	for ; i < n; i++ {
+		a[i] = src[2*i]
+		b[i] = src[2*i+1]
+	}
+}
@@ -0,0 +1,189 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package image
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"testing"
+)
+
+func TestYUV420ToRGBKnownColors(t *testing.T) {
+	// BT.601 limited-range encodings of white, black, red and blue.
+	tests := []struct {
+		name    string
+		y, u, v uint8
+		want    [3]float32
+	}{
+		{"white", 235, 128, 128, [3]float32{1, 1, 1}},
+		{"black", 16, 128, 128, [3]float32{0, 0, 0}},
+		{"red", 81, 90, 240, [3]float32{1, 0, 0}},
+		{"blue", 41, 240, 110, [3]float32{0, 0, 1}},
+	}
+	for _, format := range []YUVFormat{NV12, I420} {
+		for _, tc := range tests {
+			t.Run(fmt.Sprintf("%d/%s", format, tc.name), func(t *testing.T) {
+				const w, h = 19, 6
+				f := NewFrame420(format, w, h)
+				for i := range f.Y {
+					f.Y[i] = tc.y
+				}
+				if format == NV12 {
+					for i := range f.U {
+						f.U[i] = tc.u
+						if i%2 == 1 {
+							f.U[i] = tc.v
+						}
+					}
+				} else {
+					for i := range f.U {
+						f.U[i], f.V[i] = tc.u, tc.v
+					}
+				}
+				out := NewImage3[float32](w, h)
+				YUV420ToRGB(f, out, BT601)
+				for c := range 3 {
+					for y := range h {
+						for x, got := range out.PlaneRow(c, y)[:w] {
+							if math.Abs(float64(got-tc.want[c])) > 0.01 {
+								t.Fatalf("plane %d at (%d,%d) = %v, want %v", c, x, y, got, tc.want[c])
+							}
+						}
+					}
+				}
+			})
+		}
+	}
+}
+
+func TestYUV420RoundTrip(t *testing.T) {
+	for _, size := range [][2]int{{1, 1}, {2, 2}, {7, 5}, {37, 23}, {64, 48}} {
+		for _, format := range []YUVFormat{NV12, I420} {
+			for _, m := range []YUVMatrix{BT601, BT709, {Kr: 0.299, Kb: 0.114, FullRange: true}} {
+				w, h := size[0], size[1]
+				t.Run(fmt.Sprintf("%dx%d/%d/%v", w, h, format, m), func(t *testing.T) {
+					// A smooth image, so chroma subsampling loses little.
+					in := NewImage3[float32](w, h)
+					for y := range h {
+						for x := range w {
+							fx, fy := float64(x)/64, float64(y)/64
+							in.PlaneRow(0, y)[x] = float32(0.2 + 0.6*fx)
+							in.PlaneRow(1, y)[x] = float32(0.7 - 0.4*fy)
+							in.PlaneRow(2, y)[x] = float32(0.3 + 0.3*fx*fy)
+						}
+					}
+					f := NewFrame420(format, w, h)
+					RGBToYUV420(in, f, m)
+					out := NewImage3[float32](w, h)
+					YUV420ToRGB(f, out, m)
+
+					for c := range 3 {
+						for y := range h {
+							for x := range w {
+								got, want := out.PlaneRow(c, y)[x], in.PlaneRow(c, y)[x]
+								if math.Abs(float64(got-want)) > 0.03 {
+									t.Fatalf("plane %d at (%d,%d) = %v, want %v", c, x, y, got, want)
+								}
+							}
+						}
+					}
+				})
+			}
+		}
+	}
+}
+
+func TestYUV420FormatsAgree(t *testing.T) {
+	const w, h = 33, 17
+	in := NewImage3[float32](w, h)
+	for c := range 3 {
+		for y := range h {
+			for x := range w {
+				in.PlaneRow(c, y)[x] = float32((x*7+y*13+c*29)%97) / 96
+			}
+		}
+	}
+	nv12 := NewFrame420(NV12, w, h)
+	i420 := NewFrame420(I420, w, h)
+	RGBToYUV420(in, nv12, BT709)
+	RGBToYUV420(in, i420, BT709)
+	if !slices.Equal(nv12.Y, i420.Y) {
+		t.Fatal("NV12 and I420 luma differ")
+	}
+	for i := range i420.U {
+		if nv12.U[2*i] != i420.U[i] || nv12.U[2*i+1] != i420.V[i] {
+			t.Fatalf("chroma %d: NV12 (%d, %d), I420 (%d, %d)", i, nv12.U[2*i], nv12.U[2*i+1], i420.U[i], i420.V[i])
+		}
+	}
+
+	a := NewImage3[float32](w, h)
+	b := NewImage3[float32](w, h)
+	YUV420ToRGB(nv12, a, BT709)
+	YUV420ToRGB(i420, b, BT709)
+	for c := range 3 {
+		for y := range h {
+			if !slices.Equal(a.PlaneRow(c, y)[:w], b.PlaneRow(c, y)[:w]) {
+				t.Fatalf("plane %d row %d differs between NV12 and I420", c, y)
+			}
+		}
+	}
+}
+
+func TestChromaResampling(t *testing.T) {
+	for _, n := range []int{1, 2, 7, 8, 9, 16, 33, 100} {
+		src := make([]float32, n)
+		for i := range src {
+			src[i] = float32(i*i%17) + 0.5
+		}
+		for _, w := range []int{2*n - 1, 2 * n} {
+			dst := make([]float32, w)
+			UpsampleRow2x(src, dst)
+			for x := range w {
+				want := src[x/2]
+				if x%2 == 1 {
+					want = (src[x/2] + src[min(x/2+1, n-1)]) / 2
+				}
+				if dst[x] != want {
+					t.Fatalf("UpsampleRow2x(n=%d, w=%d)[%d] = %v, want %v", n, w, x, dst[x], want)
+				}
+			}
+
+			a, b := dst, slices.Clone(dst)
+			slices.Reverse(b)
+			half := make([]float32, (w+1)/2)
+			DownsampleRows2x(a, b, half)
+			for i := range half {
+				x1 := min(2*i+1, w-1)
+				want := (a[2*i] + a[x1] + b[2*i] + b[x1]) / 4
+				if math.Abs(float64(half[i]-want)) > 1e-5 {
+					t.Fatalf("DownsampleRows2x(w=%d)[%d] = %v, want %v", w, i, half[i], want)
+				}
+			}
+		}
+
+		other := make([]float32, n)
+		for i := range other {
+			other[i] = -float32(i)
+		}
+		uv := make([]float32, 2*n)
+		InterleaveRows(src, other, uv)
+		u, v := make([]float32, n), make([]float32, n)
+		DeinterleaveRow(uv, u, v)
+		if !slices.Equal(u, src) || !slices.Equal(v, other) || uv[1] != other[0] {
+			t.Fatalf("InterleaveRows/DeinterleaveRow(n=%d) round trip failed", n)
+		}
+	}
+}
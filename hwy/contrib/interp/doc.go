@@ -0,0 +1,29 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package interp provides linear interpolation and cubic spline evaluation
+// over slices, for calibration curves, lookup tables and envelopes.
+//
+//	interp.Lerp(dst, a, b, 0.3)        // dst = a + 0.3*(b-a)
+//	interp.Interp(dst, x, xp, fp)      // piecewise linear table lookup
+//	s := interp.NewSpline(xp, fp)      // natural cubic spline
+//	s.Eval(dst, x)
+//
+// Interp and Spline.Eval find the segment of each point with
+// SearchSorted, which gallops from the previous point's segment so that
+// increasing inputs, the common case of resampling, cost a few steps each.
+// The segments of a chunk of points are gathered into arrays that a SIMD
+// kernel then evaluates with multiply-adds. Both clamp the points outside
+// the table to its ends.
+package interp
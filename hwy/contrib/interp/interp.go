@@ -0,0 +1,199 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interp
+
+import (
+	"sort"
+
+	"github.com/ajroetker/go-highway/hwy"
+)
+
+// chunk is the number of points whose segments are gathered at a time
+// before a segment kernel evaluates them.
+const chunk = 256
+
+// SearchSorted stores in dst[i] the number of elements of sorted, in
+// increasing order, that are less than or equal to x[i]: the index of the
+// first element greater than x[i], or len(sorted). Each search starts from
+// the result of the previous point, so runs of increasing x take a few
+// steps each. It panics if dst is shorter than x.
+func SearchSorted[T hwy.FloatsNative](dst []int, sorted, x []T) {
+	if len(dst) < len(x) {
+		panic("interp: dst is too short")
+	}
+	hint := 0
+	for i, v := range x {
+		hint = upperBound(sorted, v, hint)
+		dst[i] = hint
+	}
+}
+
+// upperBound returns the index of the first element of sorted greater
+// than v, galloping from hint before a binary search.
+func upperBound[T hwy.FloatsNative](sorted []T, v T, hint int) int {
+	lo, hi := 0, len(sorted)
+	hint = min(hint, len(sorted))
+	if hint == len(sorted) || v < sorted[hint] {
+		// The result is at most hint: gallop down.
+		hi = hint
+		for step := 1; hi-step >= 0; step *= 2 {
+			if !(v < sorted[hi-step]) {
+				lo = hi - step + 1
+				break
+			}
+			hi -= step
+		}
+	} else {
+		// The result is more than hint: gallop up.
+		lo = hint + 1
+		for step := 1; lo+step-1 < len(sorted); step *= 2 {
+			if v < sorted[lo+step-1] {
+				hi = lo + step - 1
+				break
+			}
+			lo += step
+		}
+		lo = min(lo, hi)
+	}
+	return lo + sort.Search(hi-lo, func(i int) bool { return v < sorted[lo+i] })
+}
+
+// Interp stores in dst[i] the piecewise linear interpolation at x[i] of
+// the table of points (xp[j], fp[j]), like NumPy's interp: the points
+// outside [xp[0], xp[len(xp)-1]] take the value of the nearest end. xp
+// must be strictly increasing. It panics if xp is empty, if fp is shorter
+// than xp, or if dst is shorter than x.
+//
+// The segment of each point is found by SearchSorted and gathered, a
+// chunk of points at a time, for a vectorized multiply-add.
+func Interp[T hwy.FloatsNative](dst, x, xp, fp []T) {
+	n := checkTable(xp, fp)
+	if len(dst) < len(x) {
+		panic("interp: dst is too short")
+	}
+	if n == 1 {
+		for i := range x {
+			dst[i] = fp[0]
+		}
+		return
+	}
+	var xs, x0, y0, slope [chunk]T
+	hint := 0
+	for start := 0; start < len(x); start += chunk {
+		m := min(chunk, len(x)-start)
+		for i, v := range x[start : start+m] {
+			v = min(max(v, xp[0]), xp[n-1])
+			hint = upperBound(xp, v, hint)
+			s := min(max(hint-1, 0), n-2)
+			xs[i], x0[i], y0[i] = v, xp[s], fp[s]
+			slope[i] = (fp[s+1] - fp[s]) / (xp[s+1] - xp[s])
+		}
+		linearSegments(dst[start:start+m], xs[:m], x0[:m], y0[:m], slope[:m])
+	}
+}
+
+// Spline is a natural cubic spline through a table of points: a curve
+// with continuous first and second derivatives, and a zero second
+// derivative at both ends.
+type Spline[T hwy.FloatsNative] struct {
+	x          []T
+	a, b, c, d []T // coefficients of each segment, in powers of x - x[j]
+}
+
+// NewSpline returns the natural cubic spline through the points
+// (xp[j], fp[j]). xp must be strictly increasing. It panics if xp is
+// empty or if fp is shorter than xp.
+func NewSpline[T hwy.FloatsNative](xp, fp []T) *Spline[T] {
+	n := checkTable(xp, fp)
+	s := &Spline[T]{x: append([]T(nil), xp[:n]...)}
+	segs := max(n-1, 1)
+	s.a = append([]T(nil), fp[:segs]...)
+	s.b = make([]T, segs)
+	s.c = make([]T, segs)
+	s.d = make([]T, segs)
+	if n == 1 {
+		return s
+	}
+
+	// Solve the tridiagonal system of the second derivatives m[j]/2 = c[j]
+	// at the interior points with the Thomas algorithm, in float64.
+	h := make([]float64, n-1)
+	for j := range h {
+		h[j] = float64(xp[j+1]) - float64(xp[j])
+	}
+	c := make([]float64, n)
+	diag := make([]float64, n)
+	for j := 1; j < n-1; j++ {
+		rhs := 3 * ((float64(fp[j+1])-float64(fp[j]))/h[j] - (float64(fp[j])-float64(fp[j-1]))/h[j-1])
+		diag[j] = 2 * (h[j-1] + h[j])
+		if j > 1 {
+			w := h[j-1] / diag[j-1]
+			diag[j] -= w * h[j-1]
+			rhs -= w * c[j-1]
+		}
+		c[j] = rhs
+	}
+	for j := n - 2; j >= 1; j-- {
+		c[j] = (c[j] - h[j]*c[j+1]) / diag[j]
+	}
+	for j := range n - 1 {
+		dy := float64(fp[j+1]) - float64(fp[j])
+		s.b[j] = T(dy/h[j] - h[j]*(2*c[j]+c[j+1])/3)
+		s.c[j] = T(c[j])
+		s.d[j] = T((c[j+1] - c[j]) / (3 * h[j]))
+	}
+	return s
+}
+
+// Eval stores in dst[i] the value of the spline at x[i]. The points
+// outside the table take the value of the nearest end. It panics if dst
+// is shorter than x.
+func (s *Spline[T]) Eval(dst, x []T) {
+	if len(dst) < len(x) {
+		panic("interp: dst is too short")
+	}
+	n := len(s.x)
+	var xs, x0, a, b, c, d [chunk]T
+	hint := 0
+	for start := 0; start < len(x); start += chunk {
+		m := min(chunk, len(x)-start)
+		for i, v := range x[start : start+m] {
+			v = min(max(v, s.x[0]), s.x[n-1])
+			hint = upperBound(s.x, v, hint)
+			j := min(max(hint-1, 0), len(s.a)-1)
+			xs[i], x0[i] = v, s.x[j]
+			a[i], b[i], c[i], d[i] = s.a[j], s.b[j], s.c[j], s.d[j]
+		}
+		cubicSegments(dst[start:start+m], xs[:m], x0[:m], a[:m], b[:m], c[:m], d[:m])
+	}
+}
+
+// At returns the value of the spline at x.
+func (s *Spline[T]) At(x T) T {
+	var dst [1]T
+	s.Eval(dst[:], []T{x})
+	return dst[0]
+}
+
+// checkTable returns the number of points of the table xp, fp.
+func checkTable[T hwy.FloatsNative](xp, fp []T) int {
+	if len(xp) == 0 {
+		panic("interp: empty table")
+	}
+	if len(fp) < len(xp) {
+		panic("interp: fp is shorter than xp")
+	}
+	return len(xp)
+}
@@ -0,0 +1,85 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interp
+
+//go:generate go run ../../../cmd/hwygen -input interp_base.go -output . -targets avx2,avx512,neon,fallback -dispatch interp
+
+import "github.com/ajroetker/go-highway/hwy"
+
+// BaseLerp stores in dst the linear interpolation between a and b at t:
+// dst[i] = a[i] + t*(b[i]-a[i]), which is a[i] at t = 0 and b[i] at t = 1.
+// It processes min(len(dst), len(a), len(b)) elements.
+func BaseLerp[T hwy.FloatsNative](dst, a, b []T, t T) {
+	n := min(len(dst), len(a), len(b))
+	vt := hwy.Set(t)
+	lanes := hwy.MaxLanes[T]()
+	i := 0
+	for ; i+lanes <= n; i += lanes {
+		va := hwy.Load(a[i:])
+		hwy.Store(hwy.MulAdd(vt, hwy.Sub(hwy.Load(b[i:]), va), va), dst[i:])
+	}
+	for ; i < n; i++ {
+		dst[i] = a[i] + t*(b[i]-a[i])
+	}
+}
+
+// BaseLerpEach is BaseLerp with a weight per element:
+// dst[i] = a[i] + t[i]*(b[i]-a[i]).
+func BaseLerpEach[T hwy.FloatsNative](dst, a, b, t []T) {
+	n := min(len(dst), len(a), len(b), len(t))
+	lanes := hwy.MaxLanes[T]()
+	i := 0
+	for ; i+lanes <= n; i += lanes {
+		va := hwy.Load(a[i:])
+		hwy.Store(hwy.MulAdd(hwy.Load(t[i:]), hwy.Sub(hwy.Load(b[i:]), va), va), dst[i:])
+	}
+	for ; i < n; i++ {
+		dst[i] = a[i] + t[i]*(b[i]-a[i])
+	}
+}
+
+// baseLinearSegments stores in dst the value at x of the line through
+// (x0, y0) with the given slope, for the segments gathered by Interp.
+func baseLinearSegments[T hwy.FloatsNative](dst, x, x0, y0, slope []T) {
+	n := len(dst)
+	lanes := hwy.MaxLanes[T]()
+	i := 0
+	for ; i+lanes <= n; i += lanes {
+		dx := hwy.Sub(hwy.Load(x[i:]), hwy.Load(x0[i:]))
+		hwy.Store(hwy.MulAdd(dx, hwy.Load(slope[i:]), hwy.Load(y0[i:])), dst[i:])
+	}
+	for ; i < n; i++ {
+		dst[i] = y0[i] + (x[i]-x0[i])*slope[i]
+	}
+}
+
+// baseCubicSegments stores in dst the value at x of the cubic
+// a + b*dx + c*dx² + d*dx³, dx = x - x0, for the segments gathered by
+// Spline.Eval, evaluated in Horner form.
+func baseCubicSegments[T hwy.FloatsNative](dst, x, x0, a, b, c, d []T) {
+	n := len(dst)
+	lanes := hwy.MaxLanes[T]()
+	i := 0
+	for ; i+lanes <= n; i += lanes {
+		dx := hwy.Sub(hwy.Load(x[i:]), hwy.Load(x0[i:]))
+		p := hwy.MulAdd(hwy.Load(d[i:]), dx, hwy.Load(c[i:]))
+		p = hwy.MulAdd(p, dx, hwy.Load(b[i:]))
+		hwy.Store(hwy.MulAdd(p, dx, hwy.Load(a[i:])), dst[i:])
+	}
+	for ; i < n; i++ {
+		dx := x[i] - x0[i]
+		dst[i] = a[i] + dx*(b[i]+dx*(c[i]+dx*d[i]))
+	}
+}
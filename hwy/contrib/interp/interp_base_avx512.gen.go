@@ -0,0 +1,182 @@
+// Code generated by github.com/ajroetker/go-highway/cmd/hwygen. DO NOT EDIT.
+
+//go:build amd64 && goexperiment.simd
+
+package interp
+
+import (
+	"simd/archsimd"
+	"unsafe"
+)
+
+func BaseLerp_avx512(dst []float32, a []float32, b []float32, t float32) {
+	n := min(len(dst), len(a), len(b))
+	vt := archsimd.BroadcastFloat32x16(t)
+	lanes := 16
+	i := 0
+	for ; i+lanes*4 <= n; i += lanes * 4 {
+		va := archsimd.LoadFloat32x16((*[16]float32)(unsafe.Pointer(&a[i])))
+		vt.MulAdd(archsimd.LoadFloat32x16((*[16]float32)(unsafe.Pointer(&b[i]))).Sub(va), va).Store((*[16]float32)(unsafe.Pointer(&dst[i])))
+		va1 := archsimd.LoadFloat32x16((*[16]float32)(unsafe.Pointer(&a[i+16])))
+		vt.MulAdd(archsimd.LoadFloat32x16((*[16]float32)(unsafe.Pointer(&b[i+16]))).Sub(va1), va1).Store((*[16]float32)(unsafe.Pointer(&dst[i+16])))
+		va2 := archsimd.LoadFloat32x16((*[16]float32)(unsafe.Pointer(&a[i+32])))
+		vt.MulAdd(archsimd.LoadFloat32x16((*[16]float32)(unsafe.Pointer(&b[i+32]))).Sub(va2), va2).Store((*[16]float32)(unsafe.Pointer(&dst[i+32])))
+		va3 := archsimd.LoadFloat32x16((*[16]float32)(unsafe.Pointer(&a[i+48])))
+		vt.MulAdd(archsimd.LoadFloat32x16((*[16]float32)(unsafe.Pointer(&b[i+48]))).Sub(va3), va3).Store((*[16]float32)(unsafe.Pointer(&dst[i+48])))
+	}
+	for ; i < n; i++ {
+		dst[i] = a[i] + t*(b[i]-a[i])
+	}
+}
+
+func BaseLerp_avx512_Float64(dst []float64, a []float64, b []float64, t float64) {
+	n := min(len(dst), len(a), len(b))
+	vt := archsimd.BroadcastFloat64x8(t)
+	lanes := 8
+	i := 0
+	for ; i+lanes*4 <= n; i += lanes * 4 {
+		va := archsimd.LoadFloat64x8((*[8]float64)(unsafe.Pointer(&a[i])))
+		vt.MulAdd(archsimd.LoadFloat64x8((*[8]float64)(unsafe.Pointer(&b[i]))).Sub(va), va).Store((*[8]float64)(unsafe.Pointer(&dst[i])))
+		va1 := archsimd.LoadFloat64x8((*[8]float64)(unsafe.Pointer(&a[i+8])))
+		vt.MulAdd(archsimd.LoadFloat64x8((*[8]float64)(unsafe.Pointer(&b[i+8]))).Sub(va1), va1).Store((*[8]float64)(unsafe.Pointer(&dst[i+8])))
+		va2 := archsimd.LoadFloat64x8((*[8]float64)(unsafe.Pointer(&a[i+16])))
+		vt.MulAdd(archsimd.LoadFloat64x8((*[8]float64)(unsafe.Pointer(&b[i+16]))).Sub(va2), va2).Store((*[8]float64)(unsafe.Pointer(&dst[i+16])))
+		va3 := archsimd.LoadFloat64x8((*[8]float64)(unsafe.Pointer(&a[i+24])))
+		vt.MulAdd(archsimd.LoadFloat64x8((*[8]float64)(unsafe.Pointer(&b[i+24]))).Sub(va3), va3).Store((*[8]float64)(unsafe.Pointer(&dst[i+24])))
+	}
+	for ; i < n; i++ {
+		dst[i] = a[i] + t*(b[i]-a[i])
+	}
+}
+
+func BaseLerpEach_avx512(dst []float32, a []float32, b []float32, t []float32) {
+	n := min(len(dst), len(a), len(b), len(t))
+	lanes := 16
+	i := 0
+	for ; i+lanes*4 <= n; i += lanes * 4 {
+		va := archsimd.LoadFloat32x16((*[16]float32)(unsafe.Pointer(&a[i])))
+		archsimd.LoadFloat32x16((*[16]float32)(unsafe.Pointer(&t[i]))).MulAdd(archsimd.LoadFloat32x16((*[16]float32)(unsafe.Pointer(&b[i]))).Sub(va), va).Store((*[16]float32)(unsafe.Pointer(&dst[i])))
+		va1 := archsimd.LoadFloat32x16((*[16]float32)(unsafe.Pointer(&a[i+16])))
+		archsimd.LoadFloat32x16((*[16]float32)(unsafe.Pointer(&t[i+16]))).MulAdd(archsimd.LoadFloat32x16((*[16]float32)(unsafe.Pointer(&b[i+16]))).Sub(va1), va1).Store((*[16]float32)(unsafe.Pointer(&dst[i+16])))
+		va2 := archsimd.LoadFloat32x16((*[16]float32)(unsafe.Pointer(&a[i+32])))
+		archsimd.LoadFloat32x16((*[16]float32)(unsafe.Pointer(&t[i+32]))).MulAdd(archsimd.LoadFloat32x16((*[16]float32)(unsafe.Pointer(&b[i+32]))).Sub(va2), va2).Store((*[16]float32)(unsafe.Pointer(&dst[i+32])))
+		va3 := archsimd.LoadFloat32x16((*[16]float32)(unsafe.Pointer(&a[i+48])))
+		archsimd.LoadFloat32x16((*[16]float32)(unsafe.Pointer(&t[i+48]))).MulAdd(archsimd.LoadFloat32x16((*[16]float32)(unsafe.Pointer(&b[i+48]))).Sub(va3), va3).Store((*[16]float32)(unsafe.Pointer(&dst[i+48])))
+	}
+	if i < n {
+		BaseLerpEach_fallback(dst[i:n], a[i:n], b[i:n], t[i:n])
+	}
+}
+
+func BaseLerpEach_avx512_Float64(dst []float64, a []float64, b []float64, t []float64) {
+	n := min(len(dst), len(a), len(b), len(t))
+	lanes := 8
+	i := 0
+	for ; i+lanes*4 <= n; i += lanes * 4 {
+		va := archsimd.LoadFloat64x8((*[8]float64)(unsafe.Pointer(&a[i])))
+		archsimd.LoadFloat64x8((*[8]float64)(unsafe.Pointer(&t[i]))).MulAdd(archsimd.LoadFloat64x8((*[8]float64)(unsafe.Pointer(&b[i]))).Sub(va), va).Store((*[8]float64)(unsafe.Pointer(&dst[i])))
+		va1 := archsimd.LoadFloat64x8((*[8]float64)(unsafe.Pointer(&a[i+8])))
+		archsimd.LoadFloat64x8((*[8]float64)(unsafe.Pointer(&t[i+8]))).MulAdd(archsimd.LoadFloat64x8((*[8]float64)(unsafe.Pointer(&b[i+8]))).Sub(va1), va1).Store((*[8]float64)(unsafe.Pointer(&dst[i+8])))
+		va2 := archsimd.LoadFloat64x8((*[8]float64)(unsafe.Pointer(&a[i+16])))
+		archsimd.LoadFloat64x8((*[8]float64)(unsafe.Pointer(&t[i+16]))).MulAdd(archsimd.LoadFloat64x8((*[8]float64)(unsafe.Pointer(&b[i+16]))).Sub(va2), va2).Store((*[8]float64)(unsafe.Pointer(&dst[i+16])))
+		va3 := archsimd.LoadFloat64x8((*[8]float64)(unsafe.Pointer(&a[i+24])))
+		archsimd.LoadFloat64x8((*[8]float64)(unsafe.Pointer(&t[i+24]))).MulAdd(archsimd.LoadFloat64x8((*[8]float64)(unsafe.Pointer(&b[i+24]))).Sub(va3), va3).Store((*[8]float64)(unsafe.Pointer(&dst[i+24])))
+	}
+	if i < n {
+		BaseLerpEach_fallback_Float64(dst[i:n], a[i:n], b[i:n], t[i:n])
+	}
+}
+
+func baseLinearSegments_avx512(dst []float32, x []float32, x0 []float32, y0 []float32, slope []float32) {
+	n := len(dst)
+	lanes := 16
+	i := 0
+	for ; i+lanes*4 <= n; i += lanes * 4 {
+		dx := archsimd.LoadFloat32x16((*[16]float32)(unsafe.Pointer(&x[i]))).Sub(archsimd.LoadFloat32x16((*[16]float32)(unsafe.Pointer(&x0[i]))))
+		dx.MulAdd(archsimd.LoadFloat32x16((*[16]float32)(unsafe.Pointer(&slope[i]))), archsimd.LoadFloat32x16((*[16]float32)(unsafe.Pointer(&y0[i])))).Store((*[16]float32)(unsafe.Pointer(&dst[i])))
+		dx1 := archsimd.LoadFloat32x16((*[16]float32)(unsafe.Pointer(&x[i+16]))).Sub(archsimd.LoadFloat32x16((*[16]float32)(unsafe.Pointer(&x0[i+16]))))
+		dx1.MulAdd(archsimd.LoadFloat32x16((*[16]float32)(unsafe.Pointer(&slope[i+16]))), archsimd.LoadFloat32x16((*[16]float32)(unsafe.Pointer(&y0[i+16])))).Store((*[16]float32)(unsafe.Pointer(&dst[i+16])))
+		dx2 := archsimd.LoadFloat32x16((*[16]float32)(unsafe.Pointer(&x[i+32]))).Sub(archsimd.LoadFloat32x16((*[16]float32)(unsafe.Pointer(&x0[i+32]))))
+		dx2.MulAdd(archsimd.LoadFloat32x16((*[16]float32)(unsafe.Pointer(&slope[i+32]))), archsimd.LoadFloat32x16((*[16]float32)(unsafe.Pointer(&y0[i+32])))).Store((*[16]float32)(unsafe.Pointer(&dst[i+32])))
+		dx3 := archsimd.LoadFloat32x16((*[16]float32)(unsafe.Pointer(&x[i+48]))).Sub(archsimd.LoadFloat32x16((*[16]float32)(unsafe.Pointer(&x0[i+48]))))
+		dx3.MulAdd(archsimd.LoadFloat32x16((*[16]float32)(unsafe.Pointer(&slope[i+48]))), archsimd.LoadFloat32x16((*[16]float32)(unsafe.Pointer(&y0[i+48])))).Store((*[16]float32)(unsafe.Pointer(&dst[i+48])))
+	}
+	if i < n {
+		baseLinearSegments_fallback(dst[i:n], x[i:n], x0[i:n], y0[i:n], slope[i:n])
+	}
+}
+
+func baseLinearSegments_avx512_Float64(dst []float64, x []float64, x0 []float64, y0 []float64, slope []float64) {
+	n := len(dst)
+	lanes := 8
+	i := 0
+	for ; i+lanes*4 <= n; i += lanes * 4 {
+		dx := archsimd.LoadFloat64x8((*[8]float64)(unsafe.Pointer(&x[i]))).Sub(archsimd.LoadFloat64x8((*[8]float64)(unsafe.Pointer(&x0[i]))))
+		dx.MulAdd(archsimd.LoadFloat64x8((*[8]float64)(unsafe.Pointer(&slope[i]))), archsimd.LoadFloat64x8((*[8]float64)(unsafe.Pointer(&y0[i])))).Store((*[8]float64)(unsafe.Pointer(&dst[i])))
+		dx1 := archsimd.LoadFloat64x8((*[8]float64)(unsafe.Pointer(&x[i+8]))).Sub(archsimd.LoadFloat64x8((*[8]float64)(unsafe.Pointer(&x0[i+8]))))
+		dx1.MulAdd(archsimd.LoadFloat64x8((*[8]float64)(unsafe.Pointer(&slope[i+8]))), archsimd.LoadFloat64x8((*[8]float64)(unsafe.Pointer(&y0[i+8])))).Store((*[8]float64)(unsafe.Pointer(&dst[i+8])))
+		dx2 := archsimd.LoadFloat64x8((*[8]float64)(unsafe.Pointer(&x[i+16]))).Sub(archsimd.LoadFloat64x8((*[8]float64)(unsafe.Pointer(&x0[i+16]))))
+		dx2.MulAdd(archsimd.LoadFloat64x8((*[8]float64)(unsafe.Pointer(&slope[i+16]))), archsimd.LoadFloat64x8((*[8]float64)(unsafe.Pointer(&y0[i+16])))).Store((*[8]float64)(unsafe.Pointer(&dst[i+16])))
+		dx3 := archsimd.LoadFloat64x8((*[8]float64)(unsafe.Pointer(&x[i+24]))).Sub(archsimd.LoadFloat64x8((*[8]float64)(unsafe.Pointer(&x0[i+24]))))
+		dx3.MulAdd(archsimd.LoadFloat64x8((*[8]float64)(unsafe.Pointer(&slope[i+24]))), archsimd.LoadFloat64x8((*[8]float64)(unsafe.Pointer(&y0[i+24])))).Store((*[8]float64)(unsafe.Pointer(&dst[i+24])))
+	}
+	if i < n {
+		baseLinearSegments_fallback_Float64(dst[i:n], x[i:n], x0[i:n], y0[i:n], slope[i:n])
+	}
+}
+
+func baseCubicSegments_avx512(dst []float32, x []float32, x0 []float32, a []float32, b []float32, c []float32, d []float32) {
+	n := len(dst)
+	lanes := 16
+	i := 0
+	for ; i+lanes*4 <= n; i += lanes * 4 {
+		dx := archsimd.LoadFloat32x16((*[16]float32)(unsafe.Pointer(&x[i]))).Sub(archsimd.LoadFloat32x16((*[16]float32)(unsafe.Pointer(&x0[i]))))
+		p := archsimd.LoadFloat32x16((*[16]float32)(unsafe.Pointer(&d[i]))).MulAdd(dx, archsimd.LoadFloat32x16((*[16]float32)(unsafe.Pointer(&c[i]))))
+		p = p.MulAdd(dx, archsimd.LoadFloat32x16((*[16]float32)(unsafe.Pointer(&b[i]))))
+		p.MulAdd(dx, archsimd.LoadFloat32x16((*[16]float32)(unsafe.Pointer(&a[i])))).Store((*[16]float32)(unsafe.Pointer(&dst[i])))
+		dx1 := archsimd.LoadFloat32x16((*[16]float32)(unsafe.Pointer(&x[i+16]))).Sub(archsimd.LoadFloat32x16((*[16]float32)(unsafe.Pointer(&x0[i+16]))))
+		p1 := archsimd.LoadFloat32x16((*[16]float32)(unsafe.Pointer(&d[i+16]))).MulAdd(dx1, archsimd.LoadFloat32x16((*[16]float32)(unsafe.Pointer(&c[i+16]))))
+		p1 = p1.MulAdd(dx1, archsimd.LoadFloat32x16((*[16]float32)(unsafe.Pointer(&b[i+16]))))
+		p1.MulAdd(dx1, archsimd.LoadFloat32x16((*[16]float32)(unsafe.Pointer(&a[i+16])))).Store((*[16]float32)(unsafe.Pointer(&dst[i+16])))
+		dx2 := archsimd.LoadFloat32x16((*[16]float32)(unsafe.Pointer(&x[i+32]))).Sub(archsimd.LoadFloat32x16((*[16]float32)(unsafe.Pointer(&x0[i+32]))))
+		p2 := archsimd.LoadFloat32x16((*[16]float32)(unsafe.Pointer(&d[i+32]))).MulAdd(dx2, archsimd.LoadFloat32x16((*[16]float32)(unsafe.Pointer(&c[i+32]))))
+		p2 = p2.MulAdd(dx2, archsimd.LoadFloat32x16((*[16]float32)(unsafe.Pointer(&b[i+32]))))
+		p2.MulAdd(dx2, archsimd.LoadFloat32x16((*[16]float32)(unsafe.Pointer(&a[i+32])))).Store((*[16]float32)(unsafe.Pointer(&dst[i+32])))
+		dx3 := archsimd.LoadFloat32x16((*[16]float32)(unsafe.Pointer(&x[i+48]))).Sub(archsimd.LoadFloat32x16((*[16]float32)(unsafe.Pointer(&x0[i+48]))))
+		p3 := archsimd.LoadFloat32x16((*[16]float32)(unsafe.Pointer(&d[i+48]))).MulAdd(dx3, archsimd.LoadFloat32x16((*[16]float32)(unsafe.Pointer(&c[i+48]))))
+		p3 = p3.MulAdd(dx3, archsimd.LoadFloat32x16((*[16]float32)(unsafe.Pointer(&b[i+48]))))
+		p3.MulAdd(dx3, archsimd.LoadFloat32x16((*[16]float32)(unsafe.Pointer(&a[i+48])))).Store((*[16]float32)(unsafe.Pointer(&dst[i+48])))
+	}
+	for ; i < n; i++ {
+		dx := x[i] - x0[i]
+		dst[i] = a[i] + dx*(b[i]+dx*(c[i]+dx*d[i]))
+	}
+}
+
+func baseCubicSegments_avx512_Float64(dst []float64, x []float64, x0 []float64, a []float64, b []float64, c []float64, d []float64) {
+	n := len(dst)
+	lanes := 8
+	i := 0
+	for ; i+lanes*4 <= n; i += lanes * 4 {
+		dx := archsimd.LoadFloat64x8((*[8]float64)(unsafe.Pointer(&x[i]))).Sub(archsimd.LoadFloat64x8((*[8]float64)(unsafe.Pointer(&x0[i]))))
+		p := archsimd.LoadFloat64x8((*[8]float64)(unsafe.Pointer(&d[i]))).MulAdd(dx, archsimd.LoadFloat64x8((*[8]float64)(unsafe.Pointer(&c[i]))))
+		p = p.MulAdd(dx, archsimd.LoadFloat64x8((*[8]float64)(unsafe.Pointer(&b[i]))))
+		p.MulAdd(dx, archsimd.LoadFloat64x8((*[8]float64)(unsafe.Pointer(&a[i])))).Store((*[8]float64)(unsafe.Pointer(&dst[i])))
+		dx1 := archsimd.LoadFloat64x8((*[8]float64)(unsafe.Pointer(&x[i+8]))).Sub(archsimd.LoadFloat64x8((*[8]float64)(unsafe.Pointer(&x0[i+8]))))
+		p1 := archsimd.LoadFloat64x8((*[8]float64)(unsafe.Pointer(&d[i+8]))).MulAdd(dx1, archsimd.LoadFloat64x8((*[8]float64)(unsafe.Pointer(&c[i+8]))))
+		p1 = p1.MulAdd(dx1, archsimd.LoadFloat64x8((*[8]float64)(unsafe.Pointer(&b[i+8]))))
+		p1.MulAdd(dx1, archsimd.LoadFloat64x8((*[8]float64)(unsafe.Pointer(&a[i+8])))).Store((*[8]float64)(unsafe.Pointer(&dst[i+8])))
+		dx2 := archsimd.LoadFloat64x8((*[8]float64)(unsafe.Pointer(&x[i+16]))).Sub(archsimd.LoadFloat64x8((*[8]float64)(unsafe.Pointer(&x0[i+16]))))
+		p2 := archsimd.LoadFloat64x8((*[8]float64)(unsafe.Pointer(&d[i+16]))).MulAdd(dx2, archsimd.LoadFloat64x8((*[8]float64)(unsafe.Pointer(&c[i+16]))))
+		p2 = p2.MulAdd(dx2, archsimd.LoadFloat64x8((*[8]float64)(unsafe.Pointer(&b[i+16]))))
+		p2.MulAdd(dx2, archsimd.LoadFloat64x8((*[8]float64)(unsafe.Pointer(&a[i+16])))).Store((*[8]float64)(unsafe.Pointer(&dst[i+16])))
+		dx3 := archsimd.LoadFloat64x8((*[8]float64)(unsafe.Pointer(&x[i+24]))).Sub(archsimd.LoadFloat64x8((*[8]float64)(unsafe.Pointer(&x0[i+24]))))
+		p3 := archsimd.LoadFloat64x8((*[8]float64)(unsafe.Pointer(&d[i+24]))).MulAdd(dx3, archsimd.LoadFloat64x8((*[8]float64)(unsafe.Pointer(&c[i+24]))))
+		p3 = p3.MulAdd(dx3, archsimd.LoadFloat64x8((*[8]float64)(unsafe.Pointer(&b[i+24]))))
+		p3.MulAdd(dx3, archsimd.LoadFloat64x8((*[8]float64)(unsafe.Pointer(&a[i+24])))).Store((*[8]float64)(unsafe.Pointer(&dst[i+24])))
+	}
+	for ; i < n; i++ {
+		dx := x[i] - x0[i]
+		dst[i] = a[i] + dx*(b[i]+dx*(c[i]+dx*d[i]))
+	}
+}
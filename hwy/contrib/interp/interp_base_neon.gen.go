@@ -0,0 +1,183 @@
+// Code generated by github.com/ajroetker/go-highway/cmd/hwygen. DO NOT EDIT.
+
+//go:build arm64
+
+package interp
+
+import (
+	"unsafe"
+
+	"github.com/ajroetker/go-highway/hwy/asm"
+)
+
+func BaseLerp_neon(dst []float32, a []float32, b []float32, t float32) {
+	n := min(len(dst), len(a), len(b))
+	vt := asm.BroadcastFloat32x4(t)
+	lanes := 4
+	i := 0
+	for ; i+lanes*4 <= n; i += lanes * 4 {
+		va := asm.LoadFloat32x4((*[4]float32)(unsafe.Pointer(&a[i])))
+		vt.MulAdd(asm.LoadFloat32x4((*[4]float32)(unsafe.Pointer(&b[i]))).Sub(va), va).Store((*[4]float32)(unsafe.Pointer(&dst[i])))
+		va1 := asm.LoadFloat32x4((*[4]float32)(unsafe.Pointer(&a[i+4])))
+		vt.MulAdd(asm.LoadFloat32x4((*[4]float32)(unsafe.Pointer(&b[i+4]))).Sub(va1), va1).Store((*[4]float32)(unsafe.Pointer(&dst[i+4])))
+		va2 := asm.LoadFloat32x4((*[4]float32)(unsafe.Pointer(&a[i+8])))
+		vt.MulAdd(asm.LoadFloat32x4((*[4]float32)(unsafe.Pointer(&b[i+8]))).Sub(va2), va2).Store((*[4]float32)(unsafe.Pointer(&dst[i+8])))
+		va3 := asm.LoadFloat32x4((*[4]float32)(unsafe.Pointer(&a[i+12])))
+		vt.MulAdd(asm.LoadFloat32x4((*[4]float32)(unsafe.Pointer(&b[i+12]))).Sub(va3), va3).Store((*[4]float32)(unsafe.Pointer(&dst[i+12])))
+	}
+	for ; i < n; i++ {
+		dst[i] = a[i] + t*(b[i]-a[i])
+	}
+}
+
+func BaseLerp_neon_Float64(dst []float64, a []float64, b []float64, t float64) {
+	n := min(len(dst), len(a), len(b))
+	vt := asm.BroadcastFloat64x2(t)
+	lanes := 2
+	i := 0
+	for ; i+lanes*4 <= n; i += lanes * 4 {
+		va := asm.LoadFloat64x2((*[2]float64)(unsafe.Pointer(&a[i])))
+		vt.MulAdd(asm.LoadFloat64x2((*[2]float64)(unsafe.Pointer(&b[i]))).Sub(va), va).Store((*[2]float64)(unsafe.Pointer(&dst[i])))
+		va1 := asm.LoadFloat64x2((*[2]float64)(unsafe.Pointer(&a[i+2])))
+		vt.MulAdd(asm.LoadFloat64x2((*[2]float64)(unsafe.Pointer(&b[i+2]))).Sub(va1), va1).Store((*[2]float64)(unsafe.Pointer(&dst[i+2])))
+		va2 := asm.LoadFloat64x2((*[2]float64)(unsafe.Pointer(&a[i+4])))
+		vt.MulAdd(asm.LoadFloat64x2((*[2]float64)(unsafe.Pointer(&b[i+4]))).Sub(va2), va2).Store((*[2]float64)(unsafe.Pointer(&dst[i+4])))
+		va3 := asm.LoadFloat64x2((*[2]float64)(unsafe.Pointer(&a[i+6])))
+		vt.MulAdd(asm.LoadFloat64x2((*[2]float64)(unsafe.Pointer(&b[i+6]))).Sub(va3), va3).Store((*[2]float64)(unsafe.Pointer(&dst[i+6])))
+	}
+	for ; i < n; i++ {
+		dst[i] = a[i] + t*(b[i]-a[i])
+	}
+}
+
+func BaseLerpEach_neon(dst []float32, a []float32, b []float32, t []float32) {
+	n := min(len(dst), len(a), len(b), len(t))
+	lanes := 4
+	i := 0
+	for ; i+lanes*4 <= n; i += lanes * 4 {
+		va := asm.LoadFloat32x4((*[4]float32)(unsafe.Pointer(&a[i])))
+		asm.LoadFloat32x4((*[4]float32)(unsafe.Pointer(&t[i]))).MulAdd(asm.LoadFloat32x4((*[4]float32)(unsafe.Pointer(&b[i]))).Sub(va), va).Store((*[4]float32)(unsafe.Pointer(&dst[i])))
+		va1 := asm.LoadFloat32x4((*[4]float32)(unsafe.Pointer(&a[i+4])))
+		asm.LoadFloat32x4((*[4]float32)(unsafe.Pointer(&t[i+4]))).MulAdd(asm.LoadFloat32x4((*[4]float32)(unsafe.Pointer(&b[i+4]))).Sub(va1), va1).Store((*[4]float32)(unsafe.Pointer(&dst[i+4])))
+		va2 := asm.LoadFloat32x4((*[4]float32)(unsafe.Pointer(&a[i+8])))
+		asm.LoadFloat32x4((*[4]float32)(unsafe.Pointer(&t[i+8]))).MulAdd(asm.LoadFloat32x4((*[4]float32)(unsafe.Pointer(&b[i+8]))).Sub(va2), va2).Store((*[4]float32)(unsafe.Pointer(&dst[i+8])))
+		va3 := asm.LoadFloat32x4((*[4]float32)(unsafe.Pointer(&a[i+12])))
+		asm.LoadFloat32x4((*[4]float32)(unsafe.Pointer(&t[i+12]))).MulAdd(asm.LoadFloat32x4((*[4]float32)(unsafe.Pointer(&b[i+12]))).Sub(va3), va3).Store((*[4]float32)(unsafe.Pointer(&dst[i+12])))
+	}
+	if i < n {
+		BaseLerpEach_fallback(dst[i:n], a[i:n], b[i:n], t[i:n])
+	}
+}
+
+func BaseLerpEach_neon_Float64(dst []float64, a []float64, b []float64, t []float64) {
+	n := min(len(dst), len(a), len(b), len(t))
+	lanes := 2
+	i := 0
+	for ; i+lanes*4 <= n; i += lanes * 4 {
+		va := asm.LoadFloat64x2((*[2]float64)(unsafe.Pointer(&a[i])))
+		asm.LoadFloat64x2((*[2]float64)(unsafe.Pointer(&t[i]))).MulAdd(asm.LoadFloat64x2((*[2]float64)(unsafe.Pointer(&b[i]))).Sub(va), va).Store((*[2]float64)(unsafe.Pointer(&dst[i])))
+		va1 := asm.LoadFloat64x2((*[2]float64)(unsafe.Pointer(&a[i+2])))
+		asm.LoadFloat64x2((*[2]float64)(unsafe.Pointer(&t[i+2]))).MulAdd(asm.LoadFloat64x2((*[2]float64)(unsafe.Pointer(&b[i+2]))).Sub(va1), va1).Store((*[2]float64)(unsafe.Pointer(&dst[i+2])))
+		va2 := asm.LoadFloat64x2((*[2]float64)(unsafe.Pointer(&a[i+4])))
+		asm.LoadFloat64x2((*[2]float64)(unsafe.Pointer(&t[i+4]))).MulAdd(asm.LoadFloat64x2((*[2]float64)(unsafe.Pointer(&b[i+4]))).Sub(va2), va2).Store((*[2]float64)(unsafe.Pointer(&dst[i+4])))
+		va3 := asm.LoadFloat64x2((*[2]float64)(unsafe.Pointer(&a[i+6])))
+		asm.LoadFloat64x2((*[2]float64)(unsafe.Pointer(&t[i+6]))).MulAdd(asm.LoadFloat64x2((*[2]float64)(unsafe.Pointer(&b[i+6]))).Sub(va3), va3).Store((*[2]float64)(unsafe.Pointer(&dst[i+6])))
+	}
+	if i < n {
+		BaseLerpEach_fallback_Float64(dst[i:n], a[i:n], b[i:n], t[i:n])
+	}
+}
+
+func baseLinearSegments_neon(dst []float32, x []float32, x0 []float32, y0 []float32, slope []float32) {
+	n := len(dst)
+	lanes := 4
+	i := 0
+	for ; i+lanes*4 <= n; i += lanes * 4 {
+		dx := asm.LoadFloat32x4((*[4]float32)(unsafe.Pointer(&x[i]))).Sub(asm.LoadFloat32x4((*[4]float32)(unsafe.Pointer(&x0[i]))))
+		dx.MulAdd(asm.LoadFloat32x4((*[4]float32)(unsafe.Pointer(&slope[i]))), asm.LoadFloat32x4((*[4]float32)(unsafe.Pointer(&y0[i])))).Store((*[4]float32)(unsafe.Pointer(&dst[i])))
+		dx1 := asm.LoadFloat32x4((*[4]float32)(unsafe.Pointer(&x[i+4]))).Sub(asm.LoadFloat32x4((*[4]float32)(unsafe.Pointer(&x0[i+4]))))
+		dx1.MulAdd(asm.LoadFloat32x4((*[4]float32)(unsafe.Pointer(&slope[i+4]))), asm.LoadFloat32x4((*[4]float32)(unsafe.Pointer(&y0[i+4])))).Store((*[4]float32)(unsafe.Pointer(&dst[i+4])))
+		dx2 := asm.LoadFloat32x4((*[4]float32)(unsafe.Pointer(&x[i+8]))).Sub(asm.LoadFloat32x4((*[4]float32)(unsafe.Pointer(&x0[i+8]))))
+		dx2.MulAdd(asm.LoadFloat32x4((*[4]float32)(unsafe.Pointer(&slope[i+8]))), asm.LoadFloat32x4((*[4]float32)(unsafe.Pointer(&y0[i+8])))).Store((*[4]float32)(unsafe.Pointer(&dst[i+8])))
+		dx3 := asm.LoadFloat32x4((*[4]float32)(unsafe.Pointer(&x[i+12]))).Sub(asm.LoadFloat32x4((*[4]float32)(unsafe.Pointer(&x0[i+12]))))
+		dx3.MulAdd(asm.LoadFloat32x4((*[4]float32)(unsafe.Pointer(&slope[i+12]))), asm.LoadFloat32x4((*[4]float32)(unsafe.Pointer(&y0[i+12])))).Store((*[4]float32)(unsafe.Pointer(&dst[i+12])))
+	}
+	if i < n {
+		baseLinearSegments_fallback(dst[i:n], x[i:n], x0[i:n], y0[i:n], slope[i:n])
+	}
+}
+
+func baseLinearSegments_neon_Float64(dst []float64, x []float64, x0 []float64, y0 []float64, slope []float64) {
+	n := len(dst)
+	lanes := 2
+	i := 0
+	for ; i+lanes*4 <= n; i += lanes * 4 {
+		dx := asm.LoadFloat64x2((*[2]float64)(unsafe.Pointer(&x[i]))).Sub(asm.LoadFloat64x2((*[2]float64)(unsafe.Pointer(&x0[i]))))
+		dx.MulAdd(asm.LoadFloat64x2((*[2]float64)(unsafe.Pointer(&slope[i]))), asm.LoadFloat64x2((*[2]float64)(unsafe.Pointer(&y0[i])))).Store((*[2]float64)(unsafe.Pointer(&dst[i])))
+		dx1 := asm.LoadFloat64x2((*[2]float64)(unsafe.Pointer(&x[i+2]))).Sub(asm.LoadFloat64x2((*[2]float64)(unsafe.Pointer(&x0[i+2]))))
+		dx1.MulAdd(asm.LoadFloat64x2((*[2]float64)(unsafe.Pointer(&slope[i+2]))), asm.LoadFloat64x2((*[2]float64)(unsafe.Pointer(&y0[i+2])))).Store((*[2]float64)(unsafe.Pointer(&dst[i+2])))
+		dx2 := asm.LoadFloat64x2((*[2]float64)(unsafe.Pointer(&x[i+4]))).Sub(asm.LoadFloat64x2((*[2]float64)(unsafe.Pointer(&x0[i+4]))))
+		dx2.MulAdd(asm.LoadFloat64x2((*[2]float64)(unsafe.Pointer(&slope[i+4]))), asm.LoadFloat64x2((*[2]float64)(unsafe.Pointer(&y0[i+4])))).Store((*[2]float64)(unsafe.Pointer(&dst[i+4])))
+		dx3 := asm.LoadFloat64x2((*[2]float64)(unsafe.Pointer(&x[i+6]))).Sub(asm.LoadFloat64x2((*[2]float64)(unsafe.Pointer(&x0[i+6]))))
+		dx3.MulAdd(asm.LoadFloat64x2((*[2]float64)(unsafe.Pointer(&slope[i+6]))), asm.LoadFloat64x2((*[2]float64)(unsafe.Pointer(&y0[i+6])))).Store((*[2]float64)(unsafe.Pointer(&dst[i+6])))
+	}
+	if i < n {
+		baseLinearSegments_fallback_Float64(dst[i:n], x[i:n], x0[i:n], y0[i:n], slope[i:n])
+	}
+}
+
+func baseCubicSegments_neon(dst []float32, x []float32, x0 []float32, a []float32, b []float32, c []float32, d []float32) {
+	n := len(dst)
+	lanes := 4
+	i := 0
+	for ; i+lanes*4 <= n; i += lanes * 4 {
+		dx := asm.LoadFloat32x4((*[4]float32)(unsafe.Pointer(&x[i]))).Sub(asm.LoadFloat32x4((*[4]float32)(unsafe.Pointer(&x0[i]))))
+		p := asm.LoadFloat32x4((*[4]float32)(unsafe.Pointer(&d[i]))).MulAdd(dx, asm.LoadFloat32x4((*[4]float32)(unsafe.Pointer(&c[i]))))
+		p = p.MulAdd(dx, asm.LoadFloat32x4((*[4]float32)(unsafe.Pointer(&b[i]))))
+		p.MulAdd(dx, asm.LoadFloat32x4((*[4]float32)(unsafe.Pointer(&a[i])))).Store((*[4]float32)(unsafe.Pointer(&dst[i])))
+		dx1 := asm.LoadFloat32x4((*[4]float32)(unsafe.Pointer(&x[i+4]))).Sub(asm.LoadFloat32x4((*[4]float32)(unsafe.Pointer(&x0[i+4]))))
+		p1 := asm.LoadFloat32x4((*[4]float32)(unsafe.Pointer(&d[i+4]))).MulAdd(dx1, asm.LoadFloat32x4((*[4]float32)(unsafe.Pointer(&c[i+4]))))
+		p1 = p1.MulAdd(dx1, asm.LoadFloat32x4((*[4]float32)(unsafe.Pointer(&b[i+4]))))
+		p1.MulAdd(dx1, asm.LoadFloat32x4((*[4]float32)(unsafe.Pointer(&a[i+4])))).Store((*[4]float32)(unsafe.Pointer(&dst[i+4])))
+		dx2 := asm.LoadFloat32x4((*[4]float32)(unsafe.Pointer(&x[i+8]))).Sub(asm.LoadFloat32x4((*[4]float32)(unsafe.Pointer(&x0[i+8]))))
+		p2 := asm.LoadFloat32x4((*[4]float32)(unsafe.Pointer(&d[i+8]))).MulAdd(dx2, asm.LoadFloat32x4((*[4]float32)(unsafe.Pointer(&c[i+8]))))
+		p2 = p2.MulAdd(dx2, asm.LoadFloat32x4((*[4]float32)(unsafe.Pointer(&b[i+8]))))
+		p2.MulAdd(dx2, asm.LoadFloat32x4((*[4]float32)(unsafe.Pointer(&a[i+8])))).Store((*[4]float32)(unsafe.Pointer(&dst[i+8])))
+		dx3 := asm.LoadFloat32x4((*[4]float32)(unsafe.Pointer(&x[i+12]))).Sub(asm.LoadFloat32x4((*[4]float32)(unsafe.Pointer(&x0[i+12]))))
+		p3 := asm.LoadFloat32x4((*[4]float32)(unsafe.Pointer(&d[i+12]))).MulAdd(dx3, asm.LoadFloat32x4((*[4]float32)(unsafe.Pointer(&c[i+12]))))
+		p3 = p3.MulAdd(dx3, asm.LoadFloat32x4((*[4]float32)(unsafe.Pointer(&b[i+12]))))
+		p3.MulAdd(dx3, asm.LoadFloat32x4((*[4]float32)(unsafe.Pointer(&a[i+12])))).Store((*[4]float32)(unsafe.Pointer(&dst[i+12])))
+	}
+	for ; i < n; i++ {
+		dx := x[i] - x0[i]
+		dst[i] = a[i] + dx*(b[i]+dx*(c[i]+dx*d[i]))
+	}
+}
+
+func baseCubicSegments_neon_Float64(dst []float64, x []float64, x0 []float64, a []float64, b []float64, c []float64, d []float64) {
+	n := len(dst)
+	lanes := 2
+	i := 0
+	for ; i+lanes*4 <= n; i += lanes * 4 {
+		dx := asm.LoadFloat64x2((*[2]float64)(unsafe.Pointer(&x[i]))).Sub(asm.LoadFloat64x2((*[2]float64)(unsafe.Pointer(&x0[i]))))
+		p := asm.LoadFloat64x2((*[2]float64)(unsafe.Pointer(&d[i]))).MulAdd(dx, asm.LoadFloat64x2((*[2]float64)(unsafe.Pointer(&c[i]))))
+		p = p.MulAdd(dx, asm.LoadFloat64x2((*[2]float64)(unsafe.Pointer(&b[i]))))
+		p.MulAdd(dx, asm.LoadFloat64x2((*[2]float64)(unsafe.Pointer(&a[i])))).Store((*[2]float64)(unsafe.Pointer(&dst[i])))
+		dx1 := asm.LoadFloat64x2((*[2]float64)(unsafe.Pointer(&x[i+2]))).Sub(asm.LoadFloat64x2((*[2]float64)(unsafe.Pointer(&x0[i+2]))))
+		p1 := asm.LoadFloat64x2((*[2]float64)(unsafe.Pointer(&d[i+2]))).MulAdd(dx1, asm.LoadFloat64x2((*[2]float64)(unsafe.Pointer(&c[i+2]))))
+		p1 = p1.MulAdd(dx1, asm.LoadFloat64x2((*[2]float64)(unsafe.Pointer(&b[i+2]))))
+		p1.MulAdd(dx1, asm.LoadFloat64x2((*[2]float64)(unsafe.Pointer(&a[i+2])))).Store((*[2]float64)(unsafe.Pointer(&dst[i+2])))
+		dx2 := asm.LoadFloat64x2((*[2]float64)(unsafe.Pointer(&x[i+4]))).Sub(asm.LoadFloat64x2((*[2]float64)(unsafe.Pointer(&x0[i+4]))))
+		p2 := asm.LoadFloat64x2((*[2]float64)(unsafe.Pointer(&d[i+4]))).MulAdd(dx2, asm.LoadFloat64x2((*[2]float64)(unsafe.Pointer(&c[i+4]))))
+		p2 = p2.MulAdd(dx2, asm.LoadFloat64x2((*[2]float64)(unsafe.Pointer(&b[i+4]))))
+		p2.MulAdd(dx2, asm.LoadFloat64x2((*[2]float64)(unsafe.Pointer(&a[i+4])))).Store((*[2]float64)(unsafe.Pointer(&dst[i+4])))
+		dx3 := asm.LoadFloat64x2((*[2]float64)(unsafe.Pointer(&x[i+6]))).Sub(asm.LoadFloat64x2((*[2]float64)(unsafe.Pointer(&x0[i+6]))))
+		p3 := asm.LoadFloat64x2((*[2]float64)(unsafe.Pointer(&d[i+6]))).MulAdd(dx3, asm.LoadFloat64x2((*[2]float64)(unsafe.Pointer(&c[i+6]))))
+		p3 = p3.MulAdd(dx3, asm.LoadFloat64x2((*[2]float64)(unsafe.Pointer(&b[i+6]))))
+		p3.MulAdd(dx3, asm.LoadFloat64x2((*[2]float64)(unsafe.Pointer(&a[i+6])))).Store((*[2]float64)(unsafe.Pointer(&dst[i+6])))
+	}
+	for ; i < n; i++ {
+		dx := x[i] - x0[i]
+		dst[i] = a[i] + dx*(b[i]+dx*(c[i]+dx*d[i]))
+	}
+}
@@ -0,0 +1,163 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interp
+
+import (
+	"math"
+	"math/rand/v2"
+	"slices"
+	"sort"
+	"testing"
+)
+
+func TestLerp(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	for _, n := range []int{0, 1, 7, 16, 33, 100} {
+		a, b, w := make([]float32, n), make([]float32, n), make([]float32, n)
+		for i := range n {
+			a[i], b[i], w[i] = float32(r.NormFloat64()), float32(r.NormFloat64()), r.Float32()
+		}
+		dst := make([]float32, n)
+		Lerp(dst, a, b, 0.25)
+		for i := range n {
+			if want := a[i] + 0.25*(b[i]-a[i]); math.Abs(float64(dst[i]-want)) > 1e-6 {
+				t.Errorf("Lerp n=%d: dst[%d] = %g, want %g", n, i, dst[i], want)
+			}
+		}
+		LerpEach(dst, a, b, w)
+		for i := range n {
+			if want := a[i] + w[i]*(b[i]-a[i]); math.Abs(float64(dst[i]-want)) > 1e-6 {
+				t.Errorf("LerpEach n=%d: dst[%d] = %g, want %g", n, i, dst[i], want)
+			}
+		}
+	}
+}
+
+func TestSearchSorted(t *testing.T) {
+	r := rand.New(rand.NewPCG(3, 4))
+	sorted := []float64{-3, -1, -1, 0, 2, 2, 2, 5, 8, 13}
+	x := make([]float64, 500)
+	for i := range x {
+		x[i] = math.Round(r.Float64()*40-20) / 2
+	}
+	increasing := slices.Sorted(slices.Values(x))
+	decreasing := slices.Clone(increasing)
+	slices.Reverse(decreasing)
+	// Random, increasing and decreasing runs exercise every gallop.
+	for _, x := range [][]float64{x, increasing, decreasing} {
+		dst := make([]int, len(x))
+		SearchSorted(dst, sorted, x)
+		for i, v := range x {
+			if want := sort.Search(len(sorted), func(j int) bool { return v < sorted[j] }); dst[i] != want {
+				t.Fatalf("SearchSorted(%g) = %d, want %d", v, dst[i], want)
+			}
+		}
+	}
+}
+
+func TestInterp(t *testing.T) {
+	xp := []float64{-2, 0, 0.5, 3, 10}
+	fp := []float64{1, -1, 4, 4, 0}
+	ref := func(v float64) float64 {
+		if v <= xp[0] {
+			return fp[0]
+		}
+		for j := 1; j < len(xp); j++ {
+			if v <= xp[j] {
+				return fp[j-1] + (v-xp[j-1])*(fp[j]-fp[j-1])/(xp[j]-xp[j-1])
+			}
+		}
+		return fp[len(fp)-1]
+	}
+	r := rand.New(rand.NewPCG(5, 6))
+	x := make([]float64, 700)
+	for i := range x {
+		x[i] = r.Float64()*16 - 4
+	}
+	x = append(x, xp...)
+	dst := make([]float64, len(x))
+	Interp(dst, x, xp, fp)
+	for i, v := range x {
+		if want := ref(v); math.Abs(dst[i]-want) > 1e-12 {
+			t.Errorf("Interp(%g) = %g, want %g", v, dst[i], want)
+		}
+	}
+
+	Interp(dst[:3], []float64{-1, 0, 1}, []float64{2}, []float64{7})
+	if !slices.Equal(dst[:3], []float64{7, 7, 7}) {
+		t.Errorf("Interp with one point = %v, want all 7", dst[:3])
+	}
+}
+
+func TestSpline(t *testing.T) {
+	xp := make([]float64, 30)
+	fp := make([]float64, len(xp))
+	for j := range xp {
+		xp[j] = float64(j) * 0.25
+		fp[j] = math.Sin(xp[j])
+	}
+	s := NewSpline(xp, fp)
+
+	// The spline goes through the points.
+	got := make([]float64, len(xp))
+	s.Eval(got, xp)
+	for j := range xp {
+		if math.Abs(got[j]-fp[j]) > 1e-12 {
+			t.Errorf("spline at x[%d] = %g, want %g", j, got[j], fp[j])
+		}
+	}
+
+	// It approximates sin between them, away from the natural ends.
+	x := make([]float64, 1000)
+	for i := range x {
+		x[i] = 1 + 5*float64(i)/float64(len(x))
+	}
+	got = make([]float64, len(x))
+	s.Eval(got, x)
+	for i, v := range x {
+		if math.Abs(got[i]-math.Sin(v)) > 1e-4 {
+			t.Errorf("spline at %g = %g, want about %g", v, got[i], math.Sin(v))
+		}
+	}
+
+	// First and second derivatives are continuous, and the second one is
+	// zero at the ends.
+	last := len(s.a) - 1
+	for j := range last {
+		h := xp[j+1] - xp[j]
+		d1 := s.b[j] + h*(2*s.c[j]+3*h*s.d[j])
+		d2 := 2*s.c[j] + 6*h*s.d[j]
+		if math.Abs(d1-s.b[j+1]) > 1e-9 || math.Abs(d2-2*s.c[j+1]) > 1e-9 {
+			t.Errorf("derivatives not continuous at x[%d]", j+1)
+		}
+	}
+	if h := xp[last+1] - xp[last]; s.c[0] != 0 || math.Abs(2*s.c[last]+6*h*s.d[last]) > 1e-9 {
+		t.Errorf("second derivative at the ends = %g, %g, want 0", 2*s.c[0], 2*s.c[last]+6*h*s.d[last])
+	}
+
+	// Outside the table it takes the value of the ends.
+	if v := s.At(-1); v != fp[0] {
+		t.Errorf("spline at -1 = %g, want %g", v, fp[0])
+	}
+	if v := s.At(100); math.Abs(v-fp[len(fp)-1]) > 1e-12 {
+		t.Errorf("spline at 100 = %g, want %g", v, fp[len(fp)-1])
+	}
+
+	// Through two points it is the line.
+	line := NewSpline([]float32{1, 3}, []float32{2, 6})
+	if v := line.At(2.5); v != 5 {
+		t.Errorf("two-point spline at 2.5 = %g, want 5", v)
+	}
+}
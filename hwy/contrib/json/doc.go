@@ -0,0 +1,54 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package json provides the SIMD stage 1 of a simdjson-style JSON parser:
+// finding the structural characters of a document a 64-byte block at a
+// time, so Go JSON parsers can build their stage 2 on go-highway kernels.
+//
+// The package name shadows the standard library; import it under another
+// name:
+//
+//	import hjson "github.com/ajroetker/go-highway/hwy/contrib/json"
+//
+//	idx, err := hjson.Structurals(idx[:0], doc)
+//
+// # Semantics
+//
+// Structurals returns the index of every operator {}[]:, outside strings,
+// of the opening quote of every string, and of the first byte of every
+// other value. It does not validate: a stage 2 parser reads the token at
+// each index, and checks the grammar, the literals, numbers and the
+// contents of strings. The only error is text that ends inside a string.
+//
+// Classify and PrefixXor are the kernels Structurals is built on, for
+// parsers that keep their own block state.
+//
+// # Implementation
+//
+// Following Langdale and Lemire, "Parsing Gigabytes of JSON per Second":
+//
+//  1. Classify compares each vector of bytes with the quote, backslash,
+//     operator and whitespace characters, and packs the results into a
+//     64-bit bitmap per block with BitsFromMask.
+//  2. The bytes escaped by odd-length runs of backslashes are found with
+//     integer arithmetic on the bitmaps, and their quotes dropped.
+//  3. PrefixXor turns the quote bits into the bits of the string
+//     contents: the carry-less multiplication of each bitmap by all ones
+//     (CLMUL on x86, PMULL on ARM64), a vector of blocks at a time.
+//  4. The operators and the first bytes of values outside strings are the
+//     structural characters, turned into indices with TrailingZeros64.
+//
+// Steps 2 and 4 carry state from one block to the next, and are scalar.
+// Without SIMD, the kernels are scalar loops.
+package json
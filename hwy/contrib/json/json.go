@@ -0,0 +1,150 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package json
+
+import (
+	"errors"
+	"math"
+	"math/bits"
+)
+
+// ErrUnclosedString is returned by Structurals for text that ends inside
+// a string.
+var ErrUnclosedString = errors.New("json: unclosed string")
+
+// chunkBlocks is the number of 64-byte blocks Structurals classifies per
+// kernel call.
+const chunkBlocks = 64
+
+// Structurals appends to dst the index of each structural character of
+// the JSON text buf, and returns the extended slice: the operators
+// {}[]:, outside strings, the opening quote of each string and the first
+// byte of every other value, such as a number or true. It is stage 1 of a
+// simdjson-style parser; stage 2 walks the indices and validates the
+// tokens they start. It returns ErrUnclosedString, with the indices found,
+// if buf ends inside a string.
+func Structurals(dst []uint32, buf []byte) ([]uint32, error) {
+	if uint64(len(buf)) > math.MaxUint32 {
+		panic("json: buf longer than 4 GiB")
+	}
+	var (
+		quote, backslash, op, space, inString [chunkBlocks]uint64
+		pad                                   [64]byte
+		st                                    scanState
+	)
+	for start := 0; start < len(buf); start += chunkBlocks * 64 {
+		chunk := buf[start:min(len(buf), start+chunkBlocks*64)]
+		nb := len(chunk) / 64
+		Classify(chunk[:64*nb], quote[:nb], backslash[:nb], op[:nb], space[:nb])
+		if rest := chunk[64*nb:]; len(rest) > 0 {
+			// Pad the last block with whitespace.
+			copy(pad[:], rest)
+			for i := len(rest); i < len(pad); i++ {
+				pad[i] = ' '
+			}
+			Classify(pad[:], quote[nb:nb+1], backslash[nb:nb+1], op[nb:nb+1], space[nb:nb+1])
+			nb++
+		}
+		for k := range nb {
+			quote[k] &^= st.escapedBits(backslash[k])
+		}
+		PrefixXor(inString[:nb], quote[:nb])
+		for k := range nb {
+			dst = appendIndices(dst, st.structuralBits(quote[k], inString[k], op[k], space[k]), uint32(start+64*k))
+		}
+	}
+	if st.inString != 0 {
+		return dst, ErrUnclosedString
+	}
+	return dst, nil
+}
+
+// scanState carries stage 1 from one block to the next.
+type scanState struct {
+	escaped  uint64 // 1 if the first byte of the next block is escaped
+	inString uint64 // all ones if the next block starts inside a string
+	scalar   uint64 // 1 if the last byte belongs to a value other than a string
+}
+
+// escapedBits returns the bits of the bytes escaped by a backslash in a block
+// with the given backslash bits: the byte after each odd-length run.
+func (st *scanState) escapedBits(backslash uint64) uint64 {
+	const even = 0x5555555555555555
+	backslash &^= st.escaped
+	follows := backslash<<1 | st.escaped
+	// Adding the starts of runs on odd bits to the backslashes carries
+	// out of each such run; the runs left start on even bits.
+	oddStarts := backslash &^ even &^ follows
+	evenStarts, carry := bits.Add64(oddStarts, backslash, 0)
+	st.escaped = carry
+	return (even ^ evenStarts<<1) & follows
+}
+
+// structuralBits returns the structural bits of a block, given its unescaped
+// quote bits and their prefix XOR.
+func (st *scanState) structuralBits(quote, quoteXor, op, space uint64) uint64 {
+	inString := quoteXor ^ st.inString
+	st.inString = uint64(int64(inString) >> 63)
+	// A value starts at a byte that is neither an operator nor whitespace
+	// and does not follow such a byte other than a quote.
+	scalar := ^(op | space)
+	nonQuote := scalar &^ quote
+	follows := nonQuote<<1 | st.scalar
+	st.scalar = nonQuote >> 63
+	// The bytes of strings after their opening quote, through the closing
+	// one.
+	tail := inString ^ quote
+	return (op | scalar&^follows) &^ tail
+}
+
+// appendIndices appends base plus the index of each set bit of b to dst.
+func appendIndices(dst []uint32, b uint64, base uint32) []uint32 {
+	for ; b != 0; b &= b - 1 {
+		dst = append(dst, base+uint32(bits.TrailingZeros64(b)))
+	}
+	return dst
+}
+
+func classifyScalar(buf []byte, quote, backslash, op, space []uint64) {
+	for k := range quote {
+		var q, b, o, s uint64
+		for j, c := range buf[64*k : 64*k+64] {
+			bit := uint64(1) << j
+			switch c {
+			case '"':
+				q |= bit
+			case '\\':
+				b |= bit
+			case '{', '}', '[', ']', ':', ',':
+				o |= bit
+			case ' ', '\t', '\n', '\r':
+				s |= bit
+			}
+		}
+		quote[k], backslash[k], op[k], space[k] = q, b, o, s
+	}
+}
+
+func prefixXorScalar(dst, src []uint64) {
+	for i, x := range src {
+		x ^= x << 1
+		x ^= x << 2
+		x ^= x << 4
+		x ^= x << 8
+		x ^= x << 16
+		x ^= x << 32
+		dst[i] = x
+	}
+}
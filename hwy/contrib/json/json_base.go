@@ -0,0 +1,75 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package json
+
+//go:generate go run ../../../cmd/hwygen -input json_base.go -output . -targets avx2,avx512,neon,fallback -dispatch json
+
+import "github.com/ajroetker/go-highway/hwy"
+
+// BaseClassify classifies the 64-byte blocks of buf, one per element of
+// quote, backslash, op and space: bit j of element k is set when byte
+// 64k+j of buf is '"', '\\', one of the operators {}[]:, or JSON
+// whitespace. buf must hold 64 bytes per block.
+func BaseClassify(buf []byte, quote, backslash, op, space []uint64) {
+	n := len(quote)
+	vQuote := hwy.Set[uint8]('"')
+	vBackslash := hwy.Set[uint8]('\\')
+	vOpen := hwy.Set[uint8]('{')
+	vClose := hwy.Set[uint8]('}')
+	vColon := hwy.Set[uint8](':')
+	vComma := hwy.Set[uint8](',')
+	vSpace := hwy.Set[uint8](' ')
+	vTab := hwy.Set[uint8]('\t')
+	vNewline := hwy.Set[uint8]('\n')
+	vReturn := hwy.Set[uint8]('\r')
+	caseBit := hwy.Set[uint8](0x20)
+	lanes := hwy.Zero[uint8]().NumLanes()
+	for k := 0; k < n; k++ {
+		var q, b, o, s uint64
+		//hwy:unroll 1
+		for j := 0; j < 64; j += lanes {
+			v := hwy.Load(buf[64*k+j:])
+			// Setting bit 5 maps '[' and ']' to '{' and '}' and keeps ':'
+			// and ','.
+			folded := hwy.Or(v, caseBit)
+			isOp := hwy.MaskOr(hwy.MaskOr(hwy.Equal(folded, vOpen), hwy.Equal(folded, vClose)),
+				hwy.MaskOr(hwy.Equal(v, vColon), hwy.Equal(v, vComma)))
+			isSpace := hwy.MaskOr(hwy.MaskOr(hwy.Equal(v, vSpace), hwy.Equal(v, vTab)),
+				hwy.MaskOr(hwy.Equal(v, vNewline), hwy.Equal(v, vReturn)))
+			q |= hwy.BitsFromMask(hwy.Equal(v, vQuote)) << j
+			b |= hwy.BitsFromMask(hwy.Equal(v, vBackslash)) << j
+			o |= hwy.BitsFromMask(isOp) << j
+			s |= hwy.BitsFromMask(isSpace) << j
+		}
+		quote[k], backslash[k], op[k], space[k] = q, b, o, s
+	}
+}
+
+// BasePrefixXor stores the prefix XOR of each element of src in dst: bit
+// j of dst[k] is the XOR of bits 0 to j of src[k]. Applied to the quote
+// bits of a block, it sets the bits from each opening quote up to the
+// byte before the closing one. It is the carry-less product with all
+// ones, whose low half keeps the XOR of each bit with those below it.
+func BasePrefixXor(dst, src []uint64) {
+	n := len(src)
+	ones := hwy.Set[uint64](0xffffffffffffffff)
+	lanes := ones.NumLanes()
+	i := 0
+	for ; i+lanes <= n; i += lanes {
+		lo, _ := hwy.CarrylessMultiply(hwy.Load(src[i:]), ones)
+		hwy.Store(lo, dst[i:])
+	}
+	prefixXorScalar(dst[i:n], src[i:])
+}
@@ -0,0 +1,168 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package json
+
+import (
+	"bytes"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// structuralsScalar is Structurals a byte at a time.
+func structuralsScalar(buf []byte) ([]uint32, bool) {
+	var idx []uint32
+	inString, escaped, prevValue := false, false, false
+	for i, c := range buf {
+		quote := c == '"' && !escaped
+		op := strings.IndexByte("{}[]:,", c) >= 0
+		value := !op && strings.IndexByte(" \t\n\r", c) < 0
+		// Bytes after the opening quote of a string, through the closing
+		// one, are never structural.
+		if !inString && (op || value && !prevValue) {
+			idx = append(idx, uint32(i))
+		}
+		prevValue = value && !quote
+		if quote {
+			inString = !inString
+		}
+		escaped = c == '\\' && !escaped
+	}
+	return idx, !inString
+}
+
+// testDocs returns JSON-like documents, most of them valid, with strings,
+// escapes and runs of backslashes across block boundaries.
+func testDocs() [][]byte {
+	r := rand.New(rand.NewPCG(1, 2))
+	tokens := []string{
+		"{", "}", "[", "]", ":", ",", " ", "\n", "\t ", "true", "null", "-1.5e3", "42",
+		`"key"`, `"a b"`, `"{[:,]}"`, `"\""`, `"\\"`, `"\\\""`, `"x\\\\"`, `"é"`, `\`, `"`,
+	}
+	var docs [][]byte
+	for _, n := range []int{0, 1, 10, 63, 64, 65, 200, 1000, 5000, 10000} {
+		for range 4 {
+			var b []byte
+			for len(b) < n {
+				b = append(b, tokens[r.IntN(len(tokens))]...)
+			}
+			docs = append(docs, b)
+		}
+	}
+	for _, run := range []int{1, 2, 3, 63, 64, 65, 128} {
+		for _, at := range []int{0, 1, 60, 62, 63, 64, 127} {
+			doc := slices.Concat(bytes.Repeat([]byte{' '}, at), []byte(`["`),
+				bytes.Repeat([]byte{'\\'}, run), []byte(`"x", 1]`))
+			docs = append(docs, doc)
+		}
+	}
+	return append(docs,
+		[]byte(`{"a": [1, 2.5, "x"], "b": {"c": null}}`),
+		[]byte(`"`+strings.Repeat("s", 200)+`"`),
+		[]byte(`["unclosed`),
+	)
+}
+
+func TestStructurals(t *testing.T) {
+	for _, doc := range testDocs() {
+		want, closed := structuralsScalar(doc)
+		got, err := Structurals(nil, doc)
+		if !slices.Equal(got, want) {
+			t.Errorf("Structurals(%q) = %v, want %v", doc, got, want)
+		}
+		if (err == nil) != closed {
+			t.Errorf("Structurals(%q) error = %v, want closed = %v", doc, err, closed)
+		}
+	}
+}
+
+func TestStructuralsAppends(t *testing.T) {
+	got, err := Structurals([]uint32{7}, []byte(` [1,"a"]`))
+	if err != nil || !slices.Equal(got, []uint32{7, 1, 2, 3, 4, 7}) {
+		t.Errorf("Structurals = %v, %v", got, err)
+	}
+}
+
+func TestKernels(t *testing.T) {
+	r := rand.New(rand.NewPCG(3, 4))
+	for _, blocks := range []int{0, 1, 2, 3, 5, 8, 17} {
+		const alphabet = `"\{}[]:, ` + "\t\n\rax[]{}\x9b\xdb"
+		buf := make([]byte, 64*blocks)
+		for i := range buf {
+			buf[i] = alphabet[r.IntN(len(alphabet))]
+		}
+		want := make([][]uint64, 4)
+		for i := range want {
+			want[i] = make([]uint64, blocks)
+		}
+		classifyScalar(buf, want[0], want[1], want[2], want[3])
+		src := make([]uint64, blocks)
+		for i := range src {
+			src[i] = r.Uint64()
+		}
+		wantXor := make([]uint64, blocks)
+		for i, x := range src {
+			for j := range 64 {
+				wantXor[i] |= uint64(parity(x, j)) << j
+			}
+		}
+
+		for _, impl := range []struct {
+			name      string
+			classify  func(buf []byte, quote, backslash, op, space []uint64)
+			prefixXor func(dst, src []uint64)
+		}{
+			{"dispatch", Classify, PrefixXor},
+			{"base", BaseClassify, BasePrefixXor},
+		} {
+			got := make([][]uint64, 4)
+			for i := range got {
+				got[i] = make([]uint64, blocks)
+			}
+			impl.classify(buf, got[0], got[1], got[2], got[3])
+			if !slices.EqualFunc(got, want, slices.Equal) {
+				t.Errorf("%s: Classify(%q) = %x, want %x", impl.name, buf, got, want)
+			}
+			gotXor := make([]uint64, blocks)
+			impl.prefixXor(gotXor, src)
+			if !slices.Equal(gotXor, wantXor) {
+				t.Errorf("%s: PrefixXor(%x) = %x, want %x", impl.name, src, gotXor, wantXor)
+			}
+		}
+	}
+}
+
+// parity returns the XOR of bits 0 to j of x.
+func parity(x uint64, j int) int {
+	p := 0
+	for i := 0; i <= j; i++ {
+		p ^= int(x >> i & 1)
+	}
+	return p
+}
+
+func BenchmarkStructurals(b *testing.B) {
+	var doc []byte
+	for i := 0; len(doc) < 1<<20; i++ {
+		doc = fmt.Appendf(doc, `{"id": %d, "name": "item \"%d\"", "tags": ["a", "b\\c"], "price": %d.5},`+"\n", i, i, i)
+	}
+	idx := make([]uint32, 0, len(doc)/4)
+	b.SetBytes(int64(len(doc)))
+	for b.Loop() {
+		idx, _ = Structurals(idx[:0], doc)
+	}
+}
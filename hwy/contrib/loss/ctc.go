@@ -0,0 +1,152 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loss
+
+import (
+	"fmt"
+	stdmath "math"
+
+	"github.com/ajroetker/go-highway/hwy/contrib/algo"
+)
+
+// CTC computes the Connectionist Temporal Classification loss, the negative
+// log-likelihood -log p(target | input) summed over all alignments, for each
+// sequence of a batch. Class 0 is the blank.
+//
+// Parameters:
+//   - logProbs: [batch, maxInputLen, numClasses] float32 log-probabilities
+//     (log-softmax outputs); only the first inputLens[b] frames of sequence
+//     b are read
+//   - targets: [batch, maxTargetLen] int32 labels in [1, numClasses), padded;
+//     only the first targetLens[b] labels of sequence b are read
+//   - inputLens, targetLens: [batch] lengths of each sequence
+//   - numClasses: number of classes, including the blank
+//   - losses: [batch] float32 pre-allocated, receives the per-sequence losses
+//
+// batch is len(inputLens); maxInputLen and maxTargetLen follow from the
+// lengths of logProbs and targets. A target that no alignment of the input
+// can produce, e.g. one longer than its input, gets an infinite loss.
+//
+// The forward (alpha) recursion runs in log space over the 2*targetLen+1
+// states of the blank-extended target, adding the probabilities of the
+// previous states with the algo.LogAddExp and algo.LogCumSumExp kernels.
+func CTC(logProbs []float32, targets []int32, inputLens, targetLens []int, numClasses int, losses []float32) {
+	batch := len(inputLens)
+	if len(targetLens) != batch {
+		panic(fmt.Sprintf("ctc: %d target lengths for %d input lengths", len(targetLens), batch))
+	}
+	if len(losses) < batch {
+		panic(fmt.Sprintf("ctc: losses has %d elements, need %d", len(losses), batch))
+	}
+	if batch == 0 {
+		return
+	}
+	if numClasses <= 0 || len(logProbs)%(batch*numClasses) != 0 {
+		panic(fmt.Sprintf("ctc: logProbs length %d is not a multiple of batch*numClasses = %d", len(logProbs), batch*numClasses))
+	}
+	if len(targets)%batch != 0 {
+		panic(fmt.Sprintf("ctc: targets length %d is not a multiple of batch %d", len(targets), batch))
+	}
+	maxInputLen := len(logProbs) / (batch * numClasses)
+	maxTargetLen := len(targets) / batch
+
+	numStates := 2*maxTargetLen + 1
+	alpha := make([]float32, numStates)
+	next := make([]float32, numStates)
+	skip := make([]float32, numStates)
+	labels := make([]int32, numStates)
+
+	for b := range batch {
+		inputLen, targetLen := inputLens[b], targetLens[b]
+		if inputLen < 0 || inputLen > maxInputLen {
+			panic(fmt.Sprintf("ctc: inputLens[%d] = %d out of range [0, %d]", b, inputLen, maxInputLen))
+		}
+		if targetLen < 0 || targetLen > maxTargetLen {
+			panic(fmt.Sprintf("ctc: targetLens[%d] = %d out of range [0, %d]", b, targetLen, maxTargetLen))
+		}
+		lp := logProbs[b*maxInputLen*numClasses:][:inputLen*numClasses]
+		target := targets[b*maxTargetLen:][:targetLen]
+		losses[b] = ctcForward(lp, target, numClasses, alpha, next, skip, labels)
+	}
+}
+
+// ctcForward returns the CTC loss of one sequence: lp holds its
+// [inputLen, numClasses] log-probabilities. alpha, next, skip and labels
+// are scratch buffers of at least 2*len(target)+1 elements.
+func ctcForward(lp []float32, target []int32, numClasses int, alpha, next, skip []float32, labels []int32) float32 {
+	inputLen := len(lp) / numClasses
+	numStates := 2*len(target) + 1
+	if inputLen == 0 {
+		if len(target) == 0 {
+			return 0
+		}
+		return float32(stdmath.Inf(1))
+	}
+
+	// Blank-extended target: blank, target[0], blank, target[1], ..., blank.
+	labels = labels[:numStates]
+	for s := range labels {
+		labels[s] = 0
+	}
+	for i, c := range target {
+		if c <= 0 || int(c) >= numClasses {
+			panic(fmt.Sprintf("ctc: target label %d out of range [1, %d)", c, numClasses))
+		}
+		labels[2*i+1] = c
+	}
+
+	negInf := float32(stdmath.Inf(-1))
+	alpha = alpha[:numStates]
+	next = next[:numStates]
+	skip = skip[:numStates]
+	for s := range alpha {
+		alpha[s] = negInf
+	}
+	alpha[0] = lp[0]
+	if numStates > 1 {
+		alpha[1] = lp[labels[1]]
+	}
+
+	for t := 1; t < inputLen; t++ {
+		row := lp[t*numClasses : (t+1)*numClasses]
+
+		// Each state is reached from itself and the previous state and,
+		// for a label differing from the label two states back, by skipping
+		// the blank in between.
+		next[0] = alpha[0]
+		algo.LogAddExp(alpha[1:], alpha[:numStates-1], next[1:])
+		if numStates > 2 {
+			for s := 2; s < numStates; s++ {
+				if labels[s] != 0 && labels[s] != labels[s-2] {
+					skip[s] = alpha[s-2]
+				} else {
+					skip[s] = negInf
+				}
+			}
+			algo.LogAddExp(next[2:], skip[2:], next[2:])
+		}
+		for s, c := range labels {
+			next[s] += row[c]
+		}
+		alpha, next = next, alpha
+	}
+
+	// Valid alignments end on the last label or the trailing blank.
+	if numStates == 1 {
+		return -alpha[0]
+	}
+	algo.LogCumSumExp(alpha[numStates-2:])
+	return -alpha[numStates-1]
+}
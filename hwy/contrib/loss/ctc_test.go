@@ -0,0 +1,159 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loss
+
+import (
+	"math"
+	"slices"
+	"testing"
+)
+
+// bruteForceCTC computes -log p(target | input) by enumerating every path of
+// inputLen classes and summing the probabilities of those that collapse to
+// target (merge repeats, then drop blanks).
+func bruteForceCTC(lp []float32, target []int32, numClasses int) float64 {
+	inputLen := len(lp) / numClasses
+	path := make([]int32, inputLen)
+	total := 0.0
+	for {
+		var collapsed []int32
+		logP := 0.0
+		for t, c := range path {
+			logP += float64(lp[t*numClasses+int(c)])
+			if c != 0 && (t == 0 || path[t-1] != c) {
+				collapsed = append(collapsed, c)
+			}
+		}
+		if slices.Equal(collapsed, target) {
+			total += math.Exp(logP)
+		}
+
+		// Next path in lexicographic order.
+		t := inputLen - 1
+		for ; t >= 0; t-- {
+			path[t]++
+			if int(path[t]) < numClasses {
+				break
+			}
+			path[t] = 0
+		}
+		if t < 0 {
+			break
+		}
+	}
+	return -math.Log(total)
+}
+
+func randomLogProbs(batch, inputLen, numClasses int) []float32 {
+	rng := testRNG()
+	lp := make([]float32, batch*inputLen*numClasses)
+	for i := 0; i < len(lp); i += numClasses {
+		row := lp[i : i+numClasses]
+		sum := 0.0
+		for c := range row {
+			row[c] = float32(rng.NormFloat64())
+			sum += math.Exp(float64(row[c]))
+		}
+		for c := range row {
+			row[c] -= float32(math.Log(sum))
+		}
+	}
+	return lp
+}
+
+func TestCTC(t *testing.T) {
+	const (
+		numClasses   = 4
+		maxInputLen  = 6
+		maxTargetLen = 3
+	)
+	inputLens := []int{6, 5, 6, 3, 4, 0, 2}
+	targetLens := []int{3, 2, 3, 0, 1, 0, 3}
+	targets := []int32{
+		1, 2, 3,
+		2, 2, 0,
+		1, 1, 1,
+		0, 0, 0,
+		3, 0, 0,
+		0, 0, 0,
+		1, 2, 3,
+	}
+	batch := len(inputLens)
+	lp := randomLogProbs(batch, maxInputLen, numClasses)
+
+	losses := make([]float32, batch)
+	CTC(lp, targets, inputLens, targetLens, numClasses, losses)
+
+	for b := range batch {
+		seq := lp[b*maxInputLen*numClasses:][:inputLens[b]*numClasses]
+		target := targets[b*maxTargetLen:][:targetLens[b]]
+		want := bruteForceCTC(seq, target, numClasses)
+		if math.IsInf(want, 1) {
+			if !math.IsInf(float64(losses[b]), 1) {
+				t.Errorf("sequence %d: loss = %v, want +Inf", b, losses[b])
+			}
+			continue
+		}
+		if math.Abs(float64(losses[b])-want) > 1e-4*max(1, want) {
+			t.Errorf("sequence %d: loss = %v, want %v", b, losses[b], want)
+		}
+	}
+}
+
+func TestCTCLongSequence(t *testing.T) {
+	// A long input keeps the alphas far below the float32 range of exp, which
+	// a probability-space recursion could not represent.
+	const (
+		numClasses = 5
+		inputLen   = 2000
+	)
+	lp := randomLogProbs(1, inputLen, numClasses)
+	targets := make([]int32, 40)
+	for i := range targets {
+		targets[i] = int32(1 + i%(numClasses-1))
+	}
+	losses := make([]float32, 1)
+	CTC(lp, targets, []int{inputLen}, []int{len(targets)}, numClasses, losses)
+	if math.IsInf(float64(losses[0]), 0) || math.IsNaN(float64(losses[0])) || losses[0] < 0 {
+		t.Fatalf("loss = %v, want a finite positive value", losses[0])
+	}
+}
+
+func TestCTCPanics(t *testing.T) {
+	lp := randomLogProbs(1, 4, 3)
+	for name, f := range map[string]func(){
+		"label out of range": func() {
+			CTC(lp, []int32{3}, []int{4}, []int{1}, 3, make([]float32, 1))
+		},
+		"blank label": func() {
+			CTC(lp, []int32{0}, []int{4}, []int{1}, 3, make([]float32, 1))
+		},
+		"input too long": func() {
+			CTC(lp, []int32{1}, []int{5}, []int{1}, 3, make([]float32, 1))
+		},
+		"length mismatch": func() {
+			CTC(lp, []int32{1}, []int{4}, []int{1, 1}, 3, make([]float32, 1))
+		},
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s: expected panic", name)
+				}
+			}()
+			f()
+		}()
+	}
+}
@@ -19,9 +19,9 @@ package loss
 
 import (
 	stdmath "math"
-	"sync"
 
 	"github.com/ajroetker/go-highway/hwy"
+	"github.com/ajroetker/go-highway/hwy/contrib/workerpool"
 )
 
 // BaseCutCrossEntropy computes cross-entropy loss WITHOUT materializing the full logits matrix.
@@ -420,7 +420,8 @@ func BaseCutCrossEntropyWithLogits(
 //   - numPositions: number of token positions to compute loss for
 //   - hiddenDim: dimension of hidden states and embeddings
 //   - vocabSize: size of the vocabulary
-//   - numWorkers: number of parallel workers to use
+//   - numWorkers: number of shards to split the positions into; the shards run
+//     on workerpool.Default()
 //
 // Returns: scalar mean loss (float32)
 func CutCrossEntropyParallel(
@@ -452,48 +453,34 @@ func CutCrossEntropyParallel(
 		count int
 	}
 
-	results := make([]partialResult, numWorkers)
-	var wg sync.WaitGroup
-
-	for w := 0; w < numWorkers; w++ {
-		startPos := w * positionsPerWorker
-		endPos := startPos + positionsPerWorker
-		if endPos > numPositions {
-			endPos = numPositions
-		}
-		if startPos >= numPositions {
-			break
-		}
-
-		workerIdx := w
-		start := startPos
-		end := endPos
-		wg.Go(func() {
-			partialHs := hiddenStates[start*hiddenDim : end*hiddenDim]
-			partialLabels := labels[start:end]
-			numPos := end - start
-
-			// Count valid positions FIRST before computing loss
-			count := 0
-			for _, l := range partialLabels {
-				if l >= 0 && int(l) < vocabSize {
-					count++
-				}
-			}
-
-			// Only compute loss if there are valid positions
-			partialLoss := float64(0)
-			if count > 0 {
-				loss := BaseCutCrossEntropy(partialHs, embeddings, partialLabels, numPos, hiddenDim, vocabSize)
-				// loss is a mean, convert back to sum for proper aggregation
-				partialLoss = float64(loss) * float64(count)
+	numShards := (numPositions + positionsPerWorker - 1) / positionsPerWorker
+	results := make([]partialResult, numShards)
+
+	workerpool.Default().ParallelForAtomic(numShards, func(w int) {
+		start := w * positionsPerWorker
+		end := min(start+positionsPerWorker, numPositions)
+		partialHs := hiddenStates[start*hiddenDim : end*hiddenDim]
+		partialLabels := labels[start:end]
+		numPos := end - start
+
+		// Count valid positions FIRST before computing loss
+		count := 0
+		for _, l := range partialLabels {
+			if l >= 0 && int(l) < vocabSize {
+				count++
 			}
+		}
 
-			results[workerIdx] = partialResult{loss: partialLoss, count: count}
-		})
-	}
+		// Only compute loss if there are valid positions
+		partialLoss := float64(0)
+		if count > 0 {
+			loss := BaseCutCrossEntropy(partialHs, embeddings, partialLabels, numPos, hiddenDim, vocabSize)
+			// loss is a mean, convert back to sum for proper aggregation
+			partialLoss = float64(loss) * float64(count)
+		}
 
-	wg.Wait()
+		results[w] = partialResult{loss: partialLoss, count: count}
+	})
 
 	// Aggregate results
 	totalLoss := float64(0)
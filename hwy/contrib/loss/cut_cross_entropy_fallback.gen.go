@@ -94,7 +94,33 @@ func BaseCutCrossEntropyGrad_fallback(hiddenStates []float32, embeddings []float
 		lse := currentMax + stdmath.Log(sumExp)
 		labelEmbOffset := int(label) * hiddenDim
 		var d int
-		for d = 0; d < hiddenDim; d++ {
+		for d = 0; d+4 <= hiddenDim; d += 4 {
+			{
+				e := embeddings[labelEmbOffset+d]
+				neg := -e
+				scaled := neg * float32(invN)
+				gradOutput[gradBase+d] = scaled
+			}
+			{
+				e := embeddings[labelEmbOffset+(d+1)]
+				neg := -e
+				scaled := neg * float32(invN)
+				gradOutput[gradBase+(d+1)] = scaled
+			}
+			{
+				e := embeddings[labelEmbOffset+(d+2)]
+				neg := -e
+				scaled := neg * float32(invN)
+				gradOutput[gradBase+(d+2)] = scaled
+			}
+			{
+				e := embeddings[labelEmbOffset+(d+3)]
+				neg := -e
+				scaled := neg * float32(invN)
+				gradOutput[gradBase+(d+3)] = scaled
+			}
+		}
+		for ; d < hiddenDim; d++ {
 			e := embeddings[labelEmbOffset+d]
 			neg := -e
 			scaled := neg * float32(invN)
@@ -120,7 +146,33 @@ func BaseCutCrossEntropyGrad_fallback(hiddenStates []float32, embeddings []float
 			softmaxWeight := float32(stdmath.Exp(logit-lse)) * invN
 			vWeight := float32(softmaxWeight)
 			var dd int
-			for dd = 0; dd < hiddenDim; dd++ {
+			for dd = 0; dd+4 <= hiddenDim; dd += 4 {
+				{
+					g := gradOutput[gradBase+dd]
+					e := embeddings[embOffset+dd]
+					g = vWeight*e + g
+					gradOutput[gradBase+dd] = g
+				}
+				{
+					g := gradOutput[gradBase+(dd+1)]
+					e := embeddings[embOffset+(dd+1)]
+					g = vWeight*e + g
+					gradOutput[gradBase+(dd+1)] = g
+				}
+				{
+					g := gradOutput[gradBase+(dd+2)]
+					e := embeddings[embOffset+(dd+2)]
+					g = vWeight*e + g
+					gradOutput[gradBase+(dd+2)] = g
+				}
+				{
+					g := gradOutput[gradBase+(dd+3)]
+					e := embeddings[embOffset+(dd+3)]
+					g = vWeight*e + g
+					gradOutput[gradBase+(dd+3)] = g
+				}
+			}
+			for ; dd < hiddenDim; dd++ {
 				g := gradOutput[gradBase+dd]
 				e := embeddings[embOffset+dd]
 				g = vWeight*e + g
@@ -19,11 +19,11 @@ func init() {
 		initCutceFallback()
 		return
 	}
-	if archsimd.X86.AVX512() {
+	if archsimd.X86.AVX512() && hwy.TargetEnabled(hwy.DispatchAVX512) {
 		initCutceAVX512()
 		return
 	}
-	if archsimd.X86.AVX2() {
+	if archsimd.X86.AVX2() && hwy.TargetEnabled(hwy.DispatchAVX2) {
 		initCutceAVX2()
 		return
 	}
@@ -47,3 +47,13 @@ func initCutceFallback() {
 	CutCrossEntropyGrad = BaseCutCrossEntropyGrad_fallback
 	CutCrossEntropyWithLogits = BaseCutCrossEntropyWithLogits_fallback
 }
+
+func init() {
+	hwy.RegisterKernel("loss.CutCrossEntropy", &CutCrossEntropy)
+	hwy.RegisterKernel("loss.CutCrossEntropyGrad", &CutCrossEntropyGrad)
+	hwy.RegisterKernel("loss.CutCrossEntropyWithLogits", &CutCrossEntropyWithLogits)
+	hwyKernels := []string{"loss.CutCrossEntropy", "loss.CutCrossEntropyGrad", "loss.CutCrossEntropyWithLogits"}
+	hwy.RegisterKernelTarget(hwy.DispatchAVX2, initCutceAVX2, hwyKernels...)
+	hwy.RegisterKernelTarget(hwy.DispatchAVX512, initCutceAVX512, hwyKernels...)
+	hwy.RegisterKernelTarget(hwy.DispatchScalar, initCutceFallback, hwyKernels...)
+}
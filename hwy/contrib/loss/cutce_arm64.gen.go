@@ -32,3 +32,12 @@ func initCutceFallback() {
 	CutCrossEntropyGrad = BaseCutCrossEntropyGrad_fallback
 	CutCrossEntropyWithLogits = BaseCutCrossEntropyWithLogits_fallback
 }
+
+func init() {
+	hwy.RegisterKernel("loss.CutCrossEntropy", &CutCrossEntropy)
+	hwy.RegisterKernel("loss.CutCrossEntropyGrad", &CutCrossEntropyGrad)
+	hwy.RegisterKernel("loss.CutCrossEntropyWithLogits", &CutCrossEntropyWithLogits)
+	hwyKernels := []string{"loss.CutCrossEntropy", "loss.CutCrossEntropyGrad", "loss.CutCrossEntropyWithLogits"}
+	hwy.RegisterKernelTarget(hwy.DispatchNEON, initCutceNEON, hwyKernels...)
+	hwy.RegisterKernelTarget(hwy.DispatchScalar, initCutceFallback, hwyKernels...)
+}
@@ -22,4 +22,9 @@
 //
 // This is based on the Apple "Cut Your Losses" paper (ICLR 2025), which
 // showed that logits computation can consume up to 90% of training memory.
+//
+// CTC computes the Connectionist Temporal Classification loss used to train
+// and evaluate speech and handwriting recognizers, whose outputs are not
+// aligned with their transcripts. Its forward recursion stays in log space
+// with the log-sum-exp kernels of the algo package.
 package loss
@@ -85,7 +85,33 @@ func BaseBlockMulAdd_fallback(aT []float32, b []float32, c []float32, blockDim i
 			vA := float32(aik)
 			bRowStart := k * blockDim
 			var j int
-			for j = 0; j < blockDim; j++ {
+			for j = 0; j+4 <= blockDim; j += 4 {
+				{
+					vB := b[bRowStart+j]
+					vC := c[cRowStart+j]
+					vC = vA*vB + vC
+					c[cRowStart+j] = vC
+				}
+				{
+					vB := b[bRowStart+(j+1)]
+					vC := c[cRowStart+(j+1)]
+					vC = vA*vB + vC
+					c[cRowStart+(j+1)] = vC
+				}
+				{
+					vB := b[bRowStart+(j+2)]
+					vC := c[cRowStart+(j+2)]
+					vC = vA*vB + vC
+					c[cRowStart+(j+2)] = vC
+				}
+				{
+					vB := b[bRowStart+(j+3)]
+					vC := c[cRowStart+(j+3)]
+					vC = vA*vB + vC
+					c[cRowStart+(j+3)] = vC
+				}
+			}
+			for ; j < blockDim; j++ {
 				vB := b[bRowStart+j]
 				vC := c[cRowStart+j]
 				vC = vA*vB + vC
@@ -115,7 +141,33 @@ func BaseBlockMulAdd_fallback_Float64(aT []float64, b []float64, c []float64, bl
 			vA := float64(aik)
 			bRowStart := k * blockDim
 			var j int
-			for j = 0; j < blockDim; j++ {
+			for j = 0; j+4 <= blockDim; j += 4 {
+				{
+					vB := b[bRowStart+j]
+					vC := c[cRowStart+j]
+					vC = vA*vB + vC
+					c[cRowStart+j] = vC
+				}
+				{
+					vB := b[bRowStart+(j+1)]
+					vC := c[cRowStart+(j+1)]
+					vC = vA*vB + vC
+					c[cRowStart+(j+1)] = vC
+				}
+				{
+					vB := b[bRowStart+(j+2)]
+					vC := c[cRowStart+(j+2)]
+					vC = vA*vB + vC
+					c[cRowStart+(j+2)] = vC
+				}
+				{
+					vB := b[bRowStart+(j+3)]
+					vC := c[cRowStart+(j+3)]
+					vC = vA*vB + vC
+					c[cRowStart+(j+3)] = vC
+				}
+			}
+			for ; j < blockDim; j++ {
 				vB := b[bRowStart+j]
 				vC := c[cRowStart+j]
 				vC = vA*vB + vC
@@ -263,7 +315,45 @@ func BaseBlockMulAdd2_fallback(aT []float32, b []float32, c []float32, blockDim
 			vA1 := float32(a1k)
 			bRowStart := k * blockDim
 			var j int
-			for j = 0; j < blockDim; j++ {
+			for j = 0; j+4 <= blockDim; j += 4 {
+				{
+					vB := b[bRowStart+j]
+					vC0 := c[cRow0Start+j]
+					vC0 = vA0*vB + vC0
+					c[cRow0Start+j] = vC0
+					vC1 := c[cRow1Start+j]
+					vC1 = vA1*vB + vC1
+					c[cRow1Start+j] = vC1
+				}
+				{
+					vB := b[bRowStart+(j+1)]
+					vC0 := c[cRow0Start+(j+1)]
+					vC0 = vA0*vB + vC0
+					c[cRow0Start+(j+1)] = vC0
+					vC1 := c[cRow1Start+(j+1)]
+					vC1 = vA1*vB + vC1
+					c[cRow1Start+(j+1)] = vC1
+				}
+				{
+					vB := b[bRowStart+(j+2)]
+					vC0 := c[cRow0Start+(j+2)]
+					vC0 = vA0*vB + vC0
+					c[cRow0Start+(j+2)] = vC0
+					vC1 := c[cRow1Start+(j+2)]
+					vC1 = vA1*vB + vC1
+					c[cRow1Start+(j+2)] = vC1
+				}
+				{
+					vB := b[bRowStart+(j+3)]
+					vC0 := c[cRow0Start+(j+3)]
+					vC0 = vA0*vB + vC0
+					c[cRow0Start+(j+3)] = vC0
+					vC1 := c[cRow1Start+(j+3)]
+					vC1 = vA1*vB + vC1
+					c[cRow1Start+(j+3)] = vC1
+				}
+			}
+			for ; j < blockDim; j++ {
 				vB := b[bRowStart+j]
 				vC0 := c[cRow0Start+j]
 				vC0 = vA0*vB + vC0
@@ -285,7 +375,33 @@ func BaseBlockMulAdd2_fallback(aT []float32, b []float32, c []float32, blockDim
 			vA := float32(aik)
 			bRowStart := k * blockDim
 			var j int
-			for j = 0; j < blockDim; j++ {
+			for j = 0; j+4 <= blockDim; j += 4 {
+				{
+					vB := b[bRowStart+j]
+					vC := c[cRowStart+j]
+					vC = vA*vB + vC
+					c[cRowStart+j] = vC
+				}
+				{
+					vB := b[bRowStart+(j+1)]
+					vC := c[cRowStart+(j+1)]
+					vC = vA*vB + vC
+					c[cRowStart+(j+1)] = vC
+				}
+				{
+					vB := b[bRowStart+(j+2)]
+					vC := c[cRowStart+(j+2)]
+					vC = vA*vB + vC
+					c[cRowStart+(j+2)] = vC
+				}
+				{
+					vB := b[bRowStart+(j+3)]
+					vC := c[cRowStart+(j+3)]
+					vC = vA*vB + vC
+					c[cRowStart+(j+3)] = vC
+				}
+			}
+			for ; j < blockDim; j++ {
 				vB := b[bRowStart+j]
 				vC := c[cRowStart+j]
 				vC = vA*vB + vC
@@ -319,7 +435,45 @@ func BaseBlockMulAdd2_fallback_Float64(aT []float64, b []float64, c []float64, b
 			vA1 := float64(a1k)
 			bRowStart := k * blockDim
 			var j int
-			for j = 0; j < blockDim; j++ {
+			for j = 0; j+4 <= blockDim; j += 4 {
+				{
+					vB := b[bRowStart+j]
+					vC0 := c[cRow0Start+j]
+					vC0 = vA0*vB + vC0
+					c[cRow0Start+j] = vC0
+					vC1 := c[cRow1Start+j]
+					vC1 = vA1*vB + vC1
+					c[cRow1Start+j] = vC1
+				}
+				{
+					vB := b[bRowStart+(j+1)]
+					vC0 := c[cRow0Start+(j+1)]
+					vC0 = vA0*vB + vC0
+					c[cRow0Start+(j+1)] = vC0
+					vC1 := c[cRow1Start+(j+1)]
+					vC1 = vA1*vB + vC1
+					c[cRow1Start+(j+1)] = vC1
+				}
+				{
+					vB := b[bRowStart+(j+2)]
+					vC0 := c[cRow0Start+(j+2)]
+					vC0 = vA0*vB + vC0
+					c[cRow0Start+(j+2)] = vC0
+					vC1 := c[cRow1Start+(j+2)]
+					vC1 = vA1*vB + vC1
+					c[cRow1Start+(j+2)] = vC1
+				}
+				{
+					vB := b[bRowStart+(j+3)]
+					vC0 := c[cRow0Start+(j+3)]
+					vC0 = vA0*vB + vC0
+					c[cRow0Start+(j+3)] = vC0
+					vC1 := c[cRow1Start+(j+3)]
+					vC1 = vA1*vB + vC1
+					c[cRow1Start+(j+3)] = vC1
+				}
+			}
+			for ; j < blockDim; j++ {
 				vB := b[bRowStart+j]
 				vC0 := c[cRow0Start+j]
 				vC0 = vA0*vB + vC0
@@ -341,7 +495,33 @@ func BaseBlockMulAdd2_fallback_Float64(aT []float64, b []float64, c []float64, b
 			vA := float64(aik)
 			bRowStart := k * blockDim
 			var j int
-			for j = 0; j < blockDim; j++ {
+			for j = 0; j+4 <= blockDim; j += 4 {
+				{
+					vB := b[bRowStart+j]
+					vC := c[cRowStart+j]
+					vC = vA*vB + vC
+					c[cRowStart+j] = vC
+				}
+				{
+					vB := b[bRowStart+(j+1)]
+					vC := c[cRowStart+(j+1)]
+					vC = vA*vB + vC
+					c[cRowStart+(j+1)] = vC
+				}
+				{
+					vB := b[bRowStart+(j+2)]
+					vC := c[cRowStart+(j+2)]
+					vC = vA*vB + vC
+					c[cRowStart+(j+2)] = vC
+				}
+				{
+					vB := b[bRowStart+(j+3)]
+					vC := c[cRowStart+(j+3)]
+					vC = vA*vB + vC
+					c[cRowStart+(j+3)] = vC
+				}
+			}
+			for ; j < blockDim; j++ {
 				vB := b[bRowStart+j]
 				vC := c[cRowStart+j]
 				vC = vA*vB + vC
@@ -1094,7 +1274,69 @@ func BaseBlockMulAdd4_fallback(aT []float32, b []float32, c []float32, blockDim
 			vA3 := float32(a3k)
 			bRowStart := k * blockDim
 			var j int
-			for j = 0; j < blockDim; j++ {
+			for j = 0; j+4 <= blockDim; j += 4 {
+				{
+					vB := b[bRowStart+j]
+					vC0 := c[cRow0+j]
+					vC0 = vA0*vB + vC0
+					c[cRow0+j] = vC0
+					vC1 := c[cRow1+j]
+					vC1 = vA1*vB + vC1
+					c[cRow1+j] = vC1
+					vC2 := c[cRow2+j]
+					vC2 = vA2*vB + vC2
+					c[cRow2+j] = vC2
+					vC3 := c[cRow3+j]
+					vC3 = vA3*vB + vC3
+					c[cRow3+j] = vC3
+				}
+				{
+					vB := b[bRowStart+(j+1)]
+					vC0 := c[cRow0+(j+1)]
+					vC0 = vA0*vB + vC0
+					c[cRow0+(j+1)] = vC0
+					vC1 := c[cRow1+(j+1)]
+					vC1 = vA1*vB + vC1
+					c[cRow1+(j+1)] = vC1
+					vC2 := c[cRow2+(j+1)]
+					vC2 = vA2*vB + vC2
+					c[cRow2+(j+1)] = vC2
+					vC3 := c[cRow3+(j+1)]
+					vC3 = vA3*vB + vC3
+					c[cRow3+(j+1)] = vC3
+				}
+				{
+					vB := b[bRowStart+(j+2)]
+					vC0 := c[cRow0+(j+2)]
+					vC0 = vA0*vB + vC0
+					c[cRow0+(j+2)] = vC0
+					vC1 := c[cRow1+(j+2)]
+					vC1 = vA1*vB + vC1
+					c[cRow1+(j+2)] = vC1
+					vC2 := c[cRow2+(j+2)]
+					vC2 = vA2*vB + vC2
+					c[cRow2+(j+2)] = vC2
+					vC3 := c[cRow3+(j+2)]
+					vC3 = vA3*vB + vC3
+					c[cRow3+(j+2)] = vC3
+				}
+				{
+					vB := b[bRowStart+(j+3)]
+					vC0 := c[cRow0+(j+3)]
+					vC0 = vA0*vB + vC0
+					c[cRow0+(j+3)] = vC0
+					vC1 := c[cRow1+(j+3)]
+					vC1 = vA1*vB + vC1
+					c[cRow1+(j+3)] = vC1
+					vC2 := c[cRow2+(j+3)]
+					vC2 = vA2*vB + vC2
+					c[cRow2+(j+3)] = vC2
+					vC3 := c[cRow3+(j+3)]
+					vC3 = vA3*vB + vC3
+					c[cRow3+(j+3)] = vC3
+				}
+			}
+			for ; j < blockDim; j++ {
 				vB := b[bRowStart+j]
 				vC0 := c[cRow0+j]
 				vC0 = vA0*vB + vC0
@@ -1124,7 +1366,33 @@ func BaseBlockMulAdd4_fallback(aT []float32, b []float32, c []float32, blockDim
 			vA := float32(aik)
 			bRowStart := k * blockDim
 			var j int
-			for j = 0; j < blockDim; j++ {
+			for j = 0; j+4 <= blockDim; j += 4 {
+				{
+					vB := b[bRowStart+j]
+					vC := c[cRowStart+j]
+					vC = vA*vB + vC
+					c[cRowStart+j] = vC
+				}
+				{
+					vB := b[bRowStart+(j+1)]
+					vC := c[cRowStart+(j+1)]
+					vC = vA*vB + vC
+					c[cRowStart+(j+1)] = vC
+				}
+				{
+					vB := b[bRowStart+(j+2)]
+					vC := c[cRowStart+(j+2)]
+					vC = vA*vB + vC
+					c[cRowStart+(j+2)] = vC
+				}
+				{
+					vB := b[bRowStart+(j+3)]
+					vC := c[cRowStart+(j+3)]
+					vC = vA*vB + vC
+					c[cRowStart+(j+3)] = vC
+				}
+			}
+			for ; j < blockDim; j++ {
 				vB := b[bRowStart+j]
 				vC := c[cRowStart+j]
 				vC = vA*vB + vC
@@ -1165,7 +1433,69 @@ func BaseBlockMulAdd4_fallback_Float64(aT []float64, b []float64, c []float64, b
 			vA3 := float64(a3k)
 			bRowStart := k * blockDim
 			var j int
-			for j = 0; j < blockDim; j++ {
+			for j = 0; j+4 <= blockDim; j += 4 {
+				{
+					vB := b[bRowStart+j]
+					vC0 := c[cRow0+j]
+					vC0 = vA0*vB + vC0
+					c[cRow0+j] = vC0
+					vC1 := c[cRow1+j]
+					vC1 = vA1*vB + vC1
+					c[cRow1+j] = vC1
+					vC2 := c[cRow2+j]
+					vC2 = vA2*vB + vC2
+					c[cRow2+j] = vC2
+					vC3 := c[cRow3+j]
+					vC3 = vA3*vB + vC3
+					c[cRow3+j] = vC3
+				}
+				{
+					vB := b[bRowStart+(j+1)]
+					vC0 := c[cRow0+(j+1)]
+					vC0 = vA0*vB + vC0
+					c[cRow0+(j+1)] = vC0
+					vC1 := c[cRow1+(j+1)]
+					vC1 = vA1*vB + vC1
+					c[cRow1+(j+1)] = vC1
+					vC2 := c[cRow2+(j+1)]
+					vC2 = vA2*vB + vC2
+					c[cRow2+(j+1)] = vC2
+					vC3 := c[cRow3+(j+1)]
+					vC3 = vA3*vB + vC3
+					c[cRow3+(j+1)] = vC3
+				}
+				{
+					vB := b[bRowStart+(j+2)]
+					vC0 := c[cRow0+(j+2)]
+					vC0 = vA0*vB + vC0
+					c[cRow0+(j+2)] = vC0
+					vC1 := c[cRow1+(j+2)]
+					vC1 = vA1*vB + vC1
+					c[cRow1+(j+2)] = vC1
+					vC2 := c[cRow2+(j+2)]
+					vC2 = vA2*vB + vC2
+					c[cRow2+(j+2)] = vC2
+					vC3 := c[cRow3+(j+2)]
+					vC3 = vA3*vB + vC3
+					c[cRow3+(j+2)] = vC3
+				}
+				{
+					vB := b[bRowStart+(j+3)]
+					vC0 := c[cRow0+(j+3)]
+					vC0 = vA0*vB + vC0
+					c[cRow0+(j+3)] = vC0
+					vC1 := c[cRow1+(j+3)]
+					vC1 = vA1*vB + vC1
+					c[cRow1+(j+3)] = vC1
+					vC2 := c[cRow2+(j+3)]
+					vC2 = vA2*vB + vC2
+					c[cRow2+(j+3)] = vC2
+					vC3 := c[cRow3+(j+3)]
+					vC3 = vA3*vB + vC3
+					c[cRow3+(j+3)] = vC3
+				}
+			}
+			for ; j < blockDim; j++ {
 				vB := b[bRowStart+j]
 				vC0 := c[cRow0+j]
 				vC0 = vA0*vB + vC0
@@ -1195,7 +1525,33 @@ func BaseBlockMulAdd4_fallback_Float64(aT []float64, b []float64, c []float64, b
 			vA := float64(aik)
 			bRowStart := k * blockDim
 			var j int
-			for j = 0; j < blockDim; j++ {
+			for j = 0; j+4 <= blockDim; j += 4 {
+				{
+					vB := b[bRowStart+j]
+					vC := c[cRowStart+j]
+					vC = vA*vB + vC
+					c[cRowStart+j] = vC
+				}
+				{
+					vB := b[bRowStart+(j+1)]
+					vC := c[cRowStart+(j+1)]
+					vC = vA*vB + vC
+					c[cRowStart+(j+1)] = vC
+				}
+				{
+					vB := b[bRowStart+(j+2)]
+					vC := c[cRowStart+(j+2)]
+					vC = vA*vB + vC
+					c[cRowStart+(j+2)] = vC
+				}
+				{
+					vB := b[bRowStart+(j+3)]
+					vC := c[cRowStart+(j+3)]
+					vC = vA*vB + vC
+					c[cRowStart+(j+3)] = vC
+				}
+			}
+			for ; j < blockDim; j++ {
 				vB := b[bRowStart+j]
 				vC := c[cRowStart+j]
 				vC = vA*vB + vC
@@ -3,10 +3,8 @@
 package matmul
 
 import (
-	"runtime"
-	"sync"
-
 	"github.com/ajroetker/go-highway/hwy"
+	"github.com/ajroetker/go-highway/hwy/contrib/workerpool"
 )
 
 // Parallel BlockMulAdd tuning parameters
@@ -16,7 +14,7 @@ const (
 )
 
 // ParallelBlockMulAdd computes C += A^T * B for multiple independent blocks in parallel.
-// Each block is blockDim × blockDim. The blocks are processed concurrently using goroutines.
+// Each block is blockDim × blockDim. The blocks are processed concurrently on workerpool.Default().
 //
 // Parameters:
 //   - aTs: slice of pre-transposed A blocks (each blockDim × blockDim)
@@ -43,25 +41,9 @@ func ParallelBlockMulAdd[T hwy.Floats](aTs, bs, cs [][]T, blockDim int) {
 		return
 	}
 
-	numWorkers := min(runtime.GOMAXPROCS(0), numBlocks)
-
-	// Work queue of block indices
-	work := make(chan int, numBlocks)
-	for i := range numBlocks {
-		work <- i
-	}
-	close(work)
-
-	// Workers process blocks in parallel
-	var wg sync.WaitGroup
-	for range numWorkers {
-		wg.Go(func() {
-			for idx := range work {
-				BlockMulAdd(aTs[idx], bs[idx], cs[idx], blockDim)
-			}
-		})
-	}
-	wg.Wait()
+	workerpool.Default().ParallelForAtomic(numBlocks, func(idx int) {
+		BlockMulAdd(aTs[idx], bs[idx], cs[idx], blockDim)
+	})
 }
 
 // ParallelBlockMulAddFloat32 is the non-generic version for float32.
@@ -173,3 +173,25 @@ func initBlockkernelFallback() {
 	BlockMulAdd4Float32 = BaseBlockMulAdd4_fallback
 	BlockMulAdd4Float64 = BaseBlockMulAdd4_fallback_Float64
 }
+
+func init() {
+	hwy.RegisterKernel("matmul.BlockMulAddFloat16", &BlockMulAddFloat16)
+	hwy.RegisterKernel("matmul.BlockMulAddBFloat16", &BlockMulAddBFloat16)
+	hwy.RegisterKernel("matmul.BlockMulAddFloat32", &BlockMulAddFloat32)
+	hwy.RegisterKernel("matmul.BlockMulAddFloat64", &BlockMulAddFloat64)
+	hwy.RegisterKernel("matmul.BlockMulAdd2Float16", &BlockMulAdd2Float16)
+	hwy.RegisterKernel("matmul.BlockMulAdd2BFloat16", &BlockMulAdd2BFloat16)
+	hwy.RegisterKernel("matmul.BlockMulAdd2Float32", &BlockMulAdd2Float32)
+	hwy.RegisterKernel("matmul.BlockMulAdd2Float64", &BlockMulAdd2Float64)
+	hwy.RegisterKernel("matmul.BlockMulAddRegBlockedFloat16", &BlockMulAddRegBlockedFloat16)
+	hwy.RegisterKernel("matmul.BlockMulAddRegBlockedBFloat16", &BlockMulAddRegBlockedBFloat16)
+	hwy.RegisterKernel("matmul.BlockMulAddRegBlockedFloat32", &BlockMulAddRegBlockedFloat32)
+	hwy.RegisterKernel("matmul.BlockMulAddRegBlockedFloat64", &BlockMulAddRegBlockedFloat64)
+	hwy.RegisterKernel("matmul.BlockMulAdd4Float16", &BlockMulAdd4Float16)
+	hwy.RegisterKernel("matmul.BlockMulAdd4BFloat16", &BlockMulAdd4BFloat16)
+	hwy.RegisterKernel("matmul.BlockMulAdd4Float32", &BlockMulAdd4Float32)
+	hwy.RegisterKernel("matmul.BlockMulAdd4Float64", &BlockMulAdd4Float64)
+	hwyKernels := []string{"matmul.BlockMulAddFloat16", "matmul.BlockMulAddBFloat16", "matmul.BlockMulAddFloat32", "matmul.BlockMulAddFloat64", "matmul.BlockMulAdd2Float16", "matmul.BlockMulAdd2BFloat16", "matmul.BlockMulAdd2Float32", "matmul.BlockMulAdd2Float64", "matmul.BlockMulAddRegBlockedFloat16", "matmul.BlockMulAddRegBlockedBFloat16", "matmul.BlockMulAddRegBlockedFloat32", "matmul.BlockMulAddRegBlockedFloat64", "matmul.BlockMulAdd4Float16", "matmul.BlockMulAdd4BFloat16", "matmul.BlockMulAdd4Float32", "matmul.BlockMulAdd4Float64"}
+	hwy.RegisterKernelTarget(hwy.DispatchNEON, initBlockkernelNEON, hwyKernels...)
+	hwy.RegisterKernelTarget(hwy.DispatchScalar, initBlockkernelFallback, hwyKernels...)
+}
@@ -192,6 +192,9 @@ var ParallelFusedInt4MatMulSiLU func(input []float32, packed []uint8, scales []f
 // ParallelFusedInt4MatMulGELU performs parallel fused Int4 + GELU for large matrices.
 var ParallelFusedInt4MatMulGELU func(input []float32, packed []uint8, scales []float32, output []float32, M, K, N, groupSize int)
 
+// ParallelFusedInt8MatMulGELU performs parallel fused Int8 + exact GELU for large matrices.
+var ParallelFusedInt8MatMulGELU func(input []float32, weights []int8, scales []float32, output []float32, M, K, N, groupSize int)
+
 func init() {
 	// Default parallel implementations just call the serial versions.
 	// SME-enabled platforms override these in z_matmul_arm64.go init().
@@ -216,4 +219,7 @@ func init() {
 	ParallelFusedInt4MatMulGELU = func(input []float32, packed []uint8, scales []float32, output []float32, M, K, N, groupSize int) {
 		FusedInt4MatMulGELU(input, packed, scales, output, M, K, N, groupSize)
 	}
+	ParallelFusedInt8MatMulGELU = func(input []float32, weights []int8, scales []float32, output []float32, M, K, N, groupSize int) {
+		FusedInt8MatMulGELU(input, weights, scales, output, M, K, N, groupSize)
+	}
 }
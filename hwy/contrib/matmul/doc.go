@@ -29,6 +29,21 @@
 //
 //	matmul.MatMul(a, b, c, M, N, K)
 //
+// GroupedMatMul computes the independent per-group products of grouped and
+// depthwise convolutions lowered with im2col in one parallel schedule.
+//
+// BlockedMatMulStream and ParallelMatMulStream write C with non-temporal
+// stores (hwy.StoreStream) from a cache-resident staging buffer, for
+// outputs larger than the last-level cache (see StreamOutputBytes) whose
+// writes would otherwise evict the A and B panels.
+//
+// Float16 and BFloat16 products accumulate in float32 on most targets but
+// in 16 bits in the NEON Float16 kernel and the fallback, where the error
+// grows with K. MatMulHalf makes the choice explicit: AccumulateNative,
+// AccumulateF32Demote (float32 blocks rounded every HalfDemoteInterval
+// steps) or AccumulateF32 (one rounding, with float32 copies of the
+// inputs); see MatMulHalf for the trade-offs.
+//
 // The implementation automatically selects the best path:
 //   - SME (FMOPA) on Apple M4+
 //   - NEON on other ARM64
@@ -17,11 +17,11 @@ func init() {
 		initFusedint8matmulFallback()
 		return
 	}
-	if archsimd.X86.AVX512() {
+	if archsimd.X86.AVX512() && hwy.TargetEnabled(hwy.DispatchAVX512) {
 		initFusedint8matmulAVX512()
 		return
 	}
-	if archsimd.X86.AVX2() {
+	if archsimd.X86.AVX2() && hwy.TargetEnabled(hwy.DispatchAVX2) {
 		initFusedint8matmulAVX2()
 		return
 	}
@@ -39,3 +39,11 @@ func initFusedint8matmulAVX512() {
 func initFusedint8matmulFallback() {
 	FusedInt8MatMul = BaseFusedInt8MatMul_fallback
 }
+
+func init() {
+	hwy.RegisterKernel("matmul.FusedInt8MatMul", &FusedInt8MatMul)
+	hwyKernels := []string{"matmul.FusedInt8MatMul"}
+	hwy.RegisterKernelTarget(hwy.DispatchAVX2, initFusedint8matmulAVX2, hwyKernels...)
+	hwy.RegisterKernelTarget(hwy.DispatchAVX512, initFusedint8matmulAVX512, hwyKernels...)
+	hwy.RegisterKernelTarget(hwy.DispatchScalar, initFusedint8matmulFallback, hwyKernels...)
+}
@@ -26,3 +26,10 @@ func initFusedint8matmulNEON() {
 func initFusedint8matmulFallback() {
 	FusedInt8MatMul = BaseFusedInt8MatMul_fallback
 }
+
+func init() {
+	hwy.RegisterKernel("matmul.FusedInt8MatMul", &FusedInt8MatMul)
+	hwyKernels := []string{"matmul.FusedInt8MatMul"}
+	hwy.RegisterKernelTarget(hwy.DispatchNEON, initFusedint8matmulNEON, hwyKernels...)
+	hwy.RegisterKernelTarget(hwy.DispatchScalar, initFusedint8matmulFallback, hwyKernels...)
+}
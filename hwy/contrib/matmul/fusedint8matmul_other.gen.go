@@ -18,3 +18,9 @@ func init() {
 func initFusedint8matmulFallback() {
 	FusedInt8MatMul = BaseFusedInt8MatMul_fallback
 }
+
+func init() {
+	hwy.RegisterKernel("matmul.FusedInt8MatMul", &FusedInt8MatMul)
+	hwyKernels := []string{"matmul.FusedInt8MatMul"}
+	hwy.RegisterKernelTarget(hwy.DispatchScalar, initFusedint8matmulFallback, hwyKernels...)
+}
@@ -26,11 +26,11 @@ func init() {
 		initFusednf4actmatmulFallback()
 		return
 	}
-	if archsimd.X86.AVX512() {
+	if archsimd.X86.AVX512() && hwy.TargetEnabled(hwy.DispatchAVX512) {
 		initFusednf4actmatmulAVX512()
 		return
 	}
-	if archsimd.X86.AVX2() {
+	if archsimd.X86.AVX2() && hwy.TargetEnabled(hwy.DispatchAVX2) {
 		initFusednf4actmatmulAVX2()
 		return
 	}
@@ -75,3 +75,20 @@ func initFusednf4actmatmulFallback() {
 	FusedNF4MatMulSwiGLU = BaseFusedNF4MatMulSwiGLU_fallback
 	FusedInt4MatMulSwiGLU = BaseFusedInt4MatMulSwiGLU_fallback
 }
+
+func init() {
+	hwy.RegisterKernel("matmul.FusedNF4MatMulSiLU", &FusedNF4MatMulSiLU)
+	hwy.RegisterKernel("matmul.FusedNF4MatMulGELU", &FusedNF4MatMulGELU)
+	hwy.RegisterKernel("matmul.FusedNF4MatMulGELUApprox", &FusedNF4MatMulGELUApprox)
+	hwy.RegisterKernel("matmul.FusedNF4MatMulReLU", &FusedNF4MatMulReLU)
+	hwy.RegisterKernel("matmul.FusedInt4MatMulSiLU", &FusedInt4MatMulSiLU)
+	hwy.RegisterKernel("matmul.FusedInt4MatMulGELU", &FusedInt4MatMulGELU)
+	hwy.RegisterKernel("matmul.FusedInt4MatMulGELUApprox", &FusedInt4MatMulGELUApprox)
+	hwy.RegisterKernel("matmul.FusedInt4MatMulReLU", &FusedInt4MatMulReLU)
+	hwy.RegisterKernel("matmul.FusedNF4MatMulSwiGLU", &FusedNF4MatMulSwiGLU)
+	hwy.RegisterKernel("matmul.FusedInt4MatMulSwiGLU", &FusedInt4MatMulSwiGLU)
+	hwyKernels := []string{"matmul.FusedNF4MatMulSiLU", "matmul.FusedNF4MatMulGELU", "matmul.FusedNF4MatMulGELUApprox", "matmul.FusedNF4MatMulReLU", "matmul.FusedInt4MatMulSiLU", "matmul.FusedInt4MatMulGELU", "matmul.FusedInt4MatMulGELUApprox", "matmul.FusedInt4MatMulReLU", "matmul.FusedNF4MatMulSwiGLU", "matmul.FusedInt4MatMulSwiGLU"}
+	hwy.RegisterKernelTarget(hwy.DispatchAVX2, initFusednf4actmatmulAVX2, hwyKernels...)
+	hwy.RegisterKernelTarget(hwy.DispatchAVX512, initFusednf4actmatmulAVX512, hwyKernels...)
+	hwy.RegisterKernelTarget(hwy.DispatchScalar, initFusednf4actmatmulFallback, hwyKernels...)
+}
@@ -46,11 +46,11 @@ func init() {
 		initMatmulFallback()
 		return
 	}
-	if archsimd.X86.AVX512() {
+	if archsimd.X86.AVX512() && hwy.TargetEnabled(hwy.DispatchAVX512) {
 		initMatmulAVX512()
 		return
 	}
-	if archsimd.X86.AVX2() {
+	if archsimd.X86.AVX2() && hwy.TargetEnabled(hwy.DispatchAVX2) {
 		initMatmulAVX2()
 		return
 	}
@@ -77,3 +77,14 @@ func initMatmulFallback() {
 	MatMulFloat32 = BaseMatMul_fallback
 	MatMulFloat64 = BaseMatMul_fallback_Float64
 }
+
+func init() {
+	hwy.RegisterKernel("matmul.MatMulFloat16", &MatMulFloat16)
+	hwy.RegisterKernel("matmul.MatMulBFloat16", &MatMulBFloat16)
+	hwy.RegisterKernel("matmul.MatMulFloat32", &MatMulFloat32)
+	hwy.RegisterKernel("matmul.MatMulFloat64", &MatMulFloat64)
+	hwyKernels := []string{"matmul.MatMulFloat16", "matmul.MatMulBFloat16", "matmul.MatMulFloat32", "matmul.MatMulFloat64"}
+	hwy.RegisterKernelTarget(hwy.DispatchAVX2, initMatmulAVX2, hwyKernels...)
+	hwy.RegisterKernelTarget(hwy.DispatchAVX512, initMatmulAVX512, hwyKernels...)
+	hwy.RegisterKernelTarget(hwy.DispatchScalar, initMatmulFallback, hwyKernels...)
+}
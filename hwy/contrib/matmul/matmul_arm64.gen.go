@@ -61,3 +61,13 @@ func initMatmulFallback() {
 	MatMulFloat32 = BaseMatMul_fallback
 	MatMulFloat64 = BaseMatMul_fallback_Float64
 }
+
+func init() {
+	hwy.RegisterKernel("matmul.MatMulFloat16", &MatMulFloat16)
+	hwy.RegisterKernel("matmul.MatMulBFloat16", &MatMulBFloat16)
+	hwy.RegisterKernel("matmul.MatMulFloat32", &MatMulFloat32)
+	hwy.RegisterKernel("matmul.MatMulFloat64", &MatMulFloat64)
+	hwyKernels := []string{"matmul.MatMulFloat16", "matmul.MatMulBFloat16", "matmul.MatMulFloat32", "matmul.MatMulFloat64"}
+	hwy.RegisterKernelTarget(hwy.DispatchNEON, initMatmulNEON, hwyKernels...)
+	hwy.RegisterKernelTarget(hwy.DispatchScalar, initMatmulFallback, hwyKernels...)
+}
@@ -96,7 +96,13 @@ func BaseMatMul_fallback(a []float32, b []float32, c []float32, m int, n int, k
 		cRow := c[i*n : (i+1)*n]
 		vZero := float32(0)
 		var j int
-		for j = 0; j < n; j++ {
+		for j = 0; j+4 <= n; j += 4 {
+			cRow[j] = vZero
+			cRow[j+1] = vZero
+			cRow[j+2] = vZero
+			cRow[j+3] = vZero
+		}
+		for ; j < n; j++ {
 			cRow[j] = vZero
 		}
 		for ; j < n; j++ {
@@ -106,7 +112,33 @@ func BaseMatMul_fallback(a []float32, b []float32, c []float32, m int, n int, k
 			aip := a[i*k+p]
 			vA := float32(aip)
 			bRow := b[p*n : (p+1)*n]
-			for j = 0; j < n; j++ {
+			for j = 0; j+4 <= n; j += 4 {
+				{
+					vB := bRow[j]
+					vC := cRow[j]
+					vC = vA*vB + vC
+					cRow[j] = vC
+				}
+				{
+					vB := bRow[j+1]
+					vC := cRow[j+1]
+					vC = vA*vB + vC
+					cRow[j+1] = vC
+				}
+				{
+					vB := bRow[j+2]
+					vC := cRow[j+2]
+					vC = vA*vB + vC
+					cRow[j+2] = vC
+				}
+				{
+					vB := bRow[j+3]
+					vC := cRow[j+3]
+					vC = vA*vB + vC
+					cRow[j+3] = vC
+				}
+			}
+			for ; j < n; j++ {
 				vB := bRow[j]
 				vC := cRow[j]
 				vC = vA*vB + vC
@@ -133,7 +165,13 @@ func BaseMatMul_fallback_Float64(a []float64, b []float64, c []float64, m int, n
 		cRow := c[i*n : (i+1)*n]
 		vZero := float64(0)
 		var j int
-		for j = 0; j < n; j++ {
+		for j = 0; j+4 <= n; j += 4 {
+			cRow[j] = vZero
+			cRow[j+1] = vZero
+			cRow[j+2] = vZero
+			cRow[j+3] = vZero
+		}
+		for ; j < n; j++ {
 			cRow[j] = vZero
 		}
 		for ; j < n; j++ {
@@ -143,7 +181,33 @@ func BaseMatMul_fallback_Float64(a []float64, b []float64, c []float64, m int, n
 			aip := a[i*k+p]
 			vA := float64(aip)
 			bRow := b[p*n : (p+1)*n]
-			for j = 0; j < n; j++ {
+			for j = 0; j+4 <= n; j += 4 {
+				{
+					vB := bRow[j]
+					vC := cRow[j]
+					vC = vA*vB + vC
+					cRow[j] = vC
+				}
+				{
+					vB := bRow[j+1]
+					vC := cRow[j+1]
+					vC = vA*vB + vC
+					cRow[j+1] = vC
+				}
+				{
+					vB := bRow[j+2]
+					vC := cRow[j+2]
+					vC = vA*vB + vC
+					cRow[j+2] = vC
+				}
+				{
+					vB := bRow[j+3]
+					vC := cRow[j+3]
+					vC = vA*vB + vC
+					cRow[j+3] = vC
+				}
+			}
+			for ; j < n; j++ {
 				vB := bRow[j]
 				vC := cRow[j]
 				vC = vA*vB + vC
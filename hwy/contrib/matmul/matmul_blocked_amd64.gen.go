@@ -44,11 +44,11 @@ func init() {
 		initMatmul_blockedFallback()
 		return
 	}
-	if archsimd.X86.AVX512() {
+	if archsimd.X86.AVX512() && hwy.TargetEnabled(hwy.DispatchAVX512) {
 		initMatmul_blockedAVX512()
 		return
 	}
-	if archsimd.X86.AVX2() {
+	if archsimd.X86.AVX2() && hwy.TargetEnabled(hwy.DispatchAVX2) {
 		initMatmul_blockedAVX2()
 		return
 	}
@@ -75,3 +75,14 @@ func initMatmul_blockedFallback() {
 	BlockedMatMulFloat32 = BaseBlockedMatMul_fallback
 	BlockedMatMulFloat64 = BaseBlockedMatMul_fallback_Float64
 }
+
+func init() {
+	hwy.RegisterKernel("matmul.BlockedMatMulFloat16", &BlockedMatMulFloat16)
+	hwy.RegisterKernel("matmul.BlockedMatMulBFloat16", &BlockedMatMulBFloat16)
+	hwy.RegisterKernel("matmul.BlockedMatMulFloat32", &BlockedMatMulFloat32)
+	hwy.RegisterKernel("matmul.BlockedMatMulFloat64", &BlockedMatMulFloat64)
+	hwyKernels := []string{"matmul.BlockedMatMulFloat16", "matmul.BlockedMatMulBFloat16", "matmul.BlockedMatMulFloat32", "matmul.BlockedMatMulFloat64"}
+	hwy.RegisterKernelTarget(hwy.DispatchAVX2, initMatmul_blockedAVX2, hwyKernels...)
+	hwy.RegisterKernelTarget(hwy.DispatchAVX512, initMatmul_blockedAVX512, hwyKernels...)
+	hwy.RegisterKernelTarget(hwy.DispatchScalar, initMatmul_blockedFallback, hwyKernels...)
+}
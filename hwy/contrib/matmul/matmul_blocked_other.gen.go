@@ -48,3 +48,12 @@ func initMatmul_blockedFallback() {
 	BlockedMatMulFloat32 = BaseBlockedMatMul_fallback
 	BlockedMatMulFloat64 = BaseBlockedMatMul_fallback_Float64
 }
+
+func init() {
+	hwy.RegisterKernel("matmul.BlockedMatMulFloat16", &BlockedMatMulFloat16)
+	hwy.RegisterKernel("matmul.BlockedMatMulBFloat16", &BlockedMatMulBFloat16)
+	hwy.RegisterKernel("matmul.BlockedMatMulFloat32", &BlockedMatMulFloat32)
+	hwy.RegisterKernel("matmul.BlockedMatMulFloat64", &BlockedMatMulFloat64)
+	hwyKernels := []string{"matmul.BlockedMatMulFloat16", "matmul.BlockedMatMulBFloat16", "matmul.BlockedMatMulFloat32", "matmul.BlockedMatMulFloat64"}
+	hwy.RegisterKernelTarget(hwy.DispatchScalar, initMatmul_blockedFallback, hwyKernels...)
+}
@@ -0,0 +1,83 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package matmul
+
+//go:generate go run ../../../cmd/hwygen -input matmul_fused_int8_act.go -dispatch fusedint8actmatmul -output . -targets avx2,avx512,neon,fallback
+
+import (
+	stdmath "math"
+
+	"github.com/ajroetker/go-highway/hwy"
+	"github.com/ajroetker/go-highway/hwy/contrib/math"
+)
+
+// BaseFusedInt8MatMulGELU performs fused Int8 dequantization + matmul + exact GELU.
+// output[m,n] = GELU(sum_k(input[m,k] * (weights[k,n] * scale[k,groupIdx])))
+//
+// GELU is the exact form x * 0.5 * (1 + erf(x/sqrt(2))), matching ActGELU in
+// the NF4/Int4 kernels rather than the sigmoid approximation.
+func BaseFusedInt8MatMulGELU(input []float32, weights []int8, scales []float32, output []float32, M, K, N, groupSize int) {
+	if M == 0 || K == 0 || N == 0 {
+		return
+	}
+
+	numGroups := (N + groupSize - 1) / groupSize
+	lanes := hwy.Zero[float32]().NumLanes()
+	dequantBuf := make([]float32, lanes)
+
+	for m := 0; m < M; m++ {
+		inputRow := input[m*K : (m+1)*K]
+		outputRow := output[m*N : (m+1)*N]
+
+		var n int
+		for n = 0; n+lanes <= N; n += lanes {
+			acc := hwy.Zero[float32]()
+
+			for k := 0; k < K; k++ {
+				inputVal := hwy.Set(inputRow[k])
+				baseIdx := k * N
+				scaleBase := k * numGroups
+
+				for lane := 0; lane < lanes; lane++ {
+					colIdx := n + lane
+					val := float32(weights[baseIdx+colIdx])
+					groupIdx := colIdx / groupSize
+					dequantBuf[lane] = val * scales[scaleBase+groupIdx]
+				}
+
+				dequantWeights := hwy.Load(dequantBuf)
+				acc = hwy.MulAdd(inputVal, dequantWeights, acc)
+			}
+
+			invSqrt2 := hwy.Set(float32(0.7071067811865476))
+			half := hwy.Set(float32(0.5))
+			one := hwy.Set(float32(1.0))
+			scaled := hwy.Mul(acc, invSqrt2)
+			erfVal := math.BaseErfVec[float32](scaled)
+			acc = hwy.Mul(acc, hwy.Mul(half, hwy.Add(one, erfVal)))
+			hwy.Store(acc, outputRow[n:])
+		}
+
+		for ; n < N; n++ {
+			groupIdx := n / groupSize
+			sum := float32(0)
+			for k := 0; k < K; k++ {
+				val := float32(weights[k*N+n])
+				sum += inputRow[k] * (val * scales[k*numGroups+groupIdx])
+			}
+			outputRow[n] = sum * 0.5 * (1.0 + float32(stdmath.Erf(float64(sum)*0.7071067811865476)))
+		}
+	}
+}
@@ -315,3 +315,47 @@ func BenchmarkFusedInt8MatMulFallback(b *testing.B) {
 		b.ReportMetric(ops*float64(b.N)/b.Elapsed().Seconds()/1e9, "GFLOPS")
 	})
 }
+
+// TestFusedInt8MatMulGELU verifies the fused Int8 + exact GELU kernel against
+// the reference matmul followed by scalar GELU.
+func TestFusedInt8MatMulGELU(t *testing.T) {
+	rng := testRNGInt8()
+
+	for _, dims := range [][3]int{{16, 32, 48}, {17, 33, 49}} {
+		M, K, N := dims[0], dims[1], dims[2]
+		groupSize := 16
+
+		input := make([]float32, M*K)
+		for i := range input {
+			input[i] = rng.Float32()*2 - 1
+		}
+		weights := make([]int8, K*N)
+		for i := range weights {
+			weights[i] = int8(rng.Intn(256) - 128)
+		}
+		numGroups := (N + groupSize - 1) / groupSize
+		scales := make([]float32, K*numGroups)
+		for i := range scales {
+			scales[i] = rng.Float32()*0.02 + 0.001
+		}
+
+		refOutput := referenceInt8MatMul(input, weights, scales, M, K, N, groupSize)
+		for i, x := range refOutput {
+			refOutput[i] = float32(float64(x) * 0.5 * (1 + math.Erf(float64(x)/math.Sqrt2)))
+		}
+
+		for name, fn := range map[string]func([]float32, []int8, []float32, []float32, int, int, int, int){
+			"dispatch": FusedInt8MatMulGELU,
+			"parallel": ParallelFusedInt8MatMulGELU,
+			"fallback": BaseFusedInt8MatMulGELU_fallback,
+		} {
+			output := make([]float32, M*N)
+			fn(input, weights, scales, output, M, K, N, groupSize)
+			for i := range output {
+				if diff := math.Abs(float64(output[i] - refOutput[i])); diff > 1e-4 {
+					t.Fatalf("%s %dx%dx%d: output[%d] = %v, want %v", name, M, K, N, i, output[i], refOutput[i])
+				}
+			}
+		}
+	}
+}
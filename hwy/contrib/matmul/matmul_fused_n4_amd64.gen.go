@@ -18,11 +18,11 @@ func init() {
 		initMatmul_fused_n4Fallback()
 		return
 	}
-	if archsimd.X86.AVX512() {
+	if archsimd.X86.AVX512() && hwy.TargetEnabled(hwy.DispatchAVX512) {
 		initMatmul_fused_n4AVX512()
 		return
 	}
-	if archsimd.X86.AVX2() {
+	if archsimd.X86.AVX2() && hwy.TargetEnabled(hwy.DispatchAVX2) {
 		initMatmul_fused_n4AVX2()
 		return
 	}
@@ -43,3 +43,12 @@ func initMatmul_fused_n4Fallback() {
 	FusedNF4MatMul = BaseFusedNF4MatMul_fallback
 	FusedInt4MatMul = BaseFusedInt4MatMul_fallback
 }
+
+func init() {
+	hwy.RegisterKernel("matmul.FusedNF4MatMul", &FusedNF4MatMul)
+	hwy.RegisterKernel("matmul.FusedInt4MatMul", &FusedInt4MatMul)
+	hwyKernels := []string{"matmul.FusedNF4MatMul", "matmul.FusedInt4MatMul"}
+	hwy.RegisterKernelTarget(hwy.DispatchAVX2, initMatmul_fused_n4AVX2, hwyKernels...)
+	hwy.RegisterKernelTarget(hwy.DispatchAVX512, initMatmul_fused_n4AVX512, hwyKernels...)
+	hwy.RegisterKernelTarget(hwy.DispatchScalar, initMatmul_fused_n4Fallback, hwyKernels...)
+}
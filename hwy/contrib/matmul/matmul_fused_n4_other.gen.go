@@ -20,3 +20,10 @@ func initMatmul_fused_n4Fallback() {
 	FusedNF4MatMul = BaseFusedNF4MatMul_fallback
 	FusedInt4MatMul = BaseFusedInt4MatMul_fallback
 }
+
+func init() {
+	hwy.RegisterKernel("matmul.FusedNF4MatMul", &FusedNF4MatMul)
+	hwy.RegisterKernel("matmul.FusedInt4MatMul", &FusedInt4MatMul)
+	hwyKernels := []string{"matmul.FusedNF4MatMul", "matmul.FusedInt4MatMul"}
+	hwy.RegisterKernelTarget(hwy.DispatchScalar, initMatmul_fused_n4Fallback, hwyKernels...)
+}
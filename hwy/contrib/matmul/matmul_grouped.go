@@ -0,0 +1,89 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package matmul
+
+import (
+	"github.com/ajroetker/go-highway/hwy"
+	"github.com/ajroetker/go-highway/hwy/contrib/workerpool"
+)
+
+// GroupedMatMul computes groups independent products C_g = A_g * B_g that
+// share one parallel schedule, as grouped and depthwise convolutions lower
+// to after im2col, and multi-query projections with per-group weights.
+//
+// The groups are interleaved along the columns, in the channels-last
+// layout of an im2col buffer:
+//
+//   - A is M x (groups*K): A_g is columns [g*K, (g+1)*K)
+//   - B is groups x K x N: B_g is the g-th K x N matrix
+//   - C is M x (groups*N): C_g is columns [g*N, (g+1)*N)
+//
+// A depthwise convolution is the case K = kernel area and N = 1 (or the
+// channel multiplier).
+//
+// The work is split into (group, strip of RowsPerStrip rows) items taken
+// by the workers with atomic work stealing, so many small groups still
+// keep all workers busy. Each item packs its rows of A_g contiguously and
+// runs BlockedMatMul. A nil pool runs the items sequentially.
+func GroupedMatMul[T hwy.Floats](pool *workerpool.Pool, a, b, c []T, groups, m, n, k int) {
+	if len(a) < m*groups*k {
+		panic("matmul: A slice too short")
+	}
+	if len(b) < groups*k*n {
+		panic("matmul: B slice too short")
+	}
+	if len(c) < m*groups*n {
+		panic("matmul: C slice too short")
+	}
+	if groups == 0 || m == 0 || n == 0 {
+		return
+	}
+	if groups == 1 {
+		if pool == nil {
+			MatMul(a, b, c, m, n, k)
+		} else {
+			MatMulAuto(pool, a, b, c, m, n, k)
+		}
+		return
+	}
+
+	numStrips := (m + RowsPerStrip - 1) / RowsPerStrip
+	item := func(idx int) {
+		g, strip := idx/numStrips, idx%numStrips
+		rowStart := strip * RowsPerStrip
+		rows := min(RowsPerStrip, m-rowStart)
+
+		aPack := make([]T, rows*k)
+		cPack := make([]T, rows*n)
+		for r := range rows {
+			aRow := (rowStart+r)*groups*k + g*k
+			copy(aPack[r*k:(r+1)*k], a[aRow:aRow+k])
+		}
+		BlockedMatMul(aPack, b[g*k*n:(g+1)*k*n], cPack, rows, n, k)
+		for r := range rows {
+			cRow := (rowStart+r)*groups*n + g*n
+			copy(c[cRow:cRow+n], cPack[r*n:(r+1)*n])
+		}
+	}
+
+	numItems := groups * numStrips
+	if pool == nil || groups*m*n*k < MinParallelOps {
+		for idx := range numItems {
+			item(idx)
+		}
+		return
+	}
+	pool.ParallelForAtomic(numItems, item)
+}
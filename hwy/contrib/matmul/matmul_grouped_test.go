@@ -0,0 +1,70 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package matmul
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/ajroetker/go-highway/hwy/contrib/workerpool"
+)
+
+func TestGroupedMatMul(t *testing.T) {
+	pool := workerpool.New(0)
+	defer pool.Close()
+
+	rng := rand.New(rand.NewPCG(13, 14))
+	for _, tc := range []struct{ groups, m, n, k int }{
+		{1, 7, 5, 3},
+		{4, 10, 6, 8},
+		{32, 130, 1, 9}, // depthwise 3x3
+		{8, 200, 16, 24},
+		{3, 1, 33, 17},
+	} {
+		a := make([]float32, tc.m*tc.groups*tc.k)
+		b := make([]float32, tc.groups*tc.k*tc.n)
+		for _, s := range [][]float32{a, b} {
+			for i := range s {
+				s[i] = float32(rng.NormFloat64())
+			}
+		}
+		want := make([]float64, tc.m*tc.groups*tc.n)
+		for g := range tc.groups {
+			for i := range tc.m {
+				for j := range tc.n {
+					var sum float64
+					for p := range tc.k {
+						sum += float64(a[i*tc.groups*tc.k+g*tc.k+p]) * float64(b[(g*tc.k+p)*tc.n+j])
+					}
+					want[i*tc.groups*tc.n+g*tc.n+j] = sum
+				}
+			}
+		}
+
+		for _, p := range []*workerpool.Pool{nil, pool} {
+			t.Run(fmt.Sprintf("g%d_%dx%dx%d/pool=%v", tc.groups, tc.m, tc.n, tc.k, p != nil), func(t *testing.T) {
+				c := make([]float32, len(want))
+				GroupedMatMul(p, a, b, c, tc.groups, tc.m, tc.n, tc.k)
+				for i := range want {
+					if math.Abs(float64(c[i])-want[i]) > 1e-4*float64(tc.k) {
+						t.Fatalf("c[%d] = %v, want %v", i, c[i], want[i])
+					}
+				}
+			})
+		}
+	}
+}
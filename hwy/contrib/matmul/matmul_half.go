@@ -0,0 +1,138 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package matmul
+
+import "github.com/ajroetker/go-highway/hwy"
+
+// HalfAccumulation selects how MatMulHalf accumulates the partial sums of a
+// Float16 or BFloat16 product.
+type HalfAccumulation int
+
+const (
+	// AccumulateNative runs the kernel bound to MatMul for the type, whose
+	// accumulator depends on the target: float32 on AVX2, AVX-512, SME and
+	// NEON bfloat16, but Float16 on NEON (FMLA .8h) and the element type
+	// itself in the fallback, which rounds C after every K step.
+	AccumulateNative HalfAccumulation = iota
+
+	// AccumulateF32Demote sums HalfDemoteInterval K steps at a time in
+	// float32 and adds each block into C, rounding the running sum to the
+	// element type after every block. The rounding error grows with
+	// K/HalfDemoteInterval instead of K, and the float32 buffers only span
+	// one block of A and B.
+	AccumulateF32Demote
+
+	// AccumulateF32 converts A and B to float32, runs the float32 kernel
+	// and rounds C once: the result is the correctly accumulated product up
+	// to float32 error, at the cost of float32 copies of all three matrices.
+	AccumulateF32
+)
+
+// HalfDemoteInterval is the number of K steps AccumulateF32Demote sums in
+// float32 before rounding to the element type.
+const HalfDemoteInterval = 256
+
+// MatMulHalf computes C = A * B for Float16 or BFloat16 matrices (A is
+// M x K, B is K x N, C is M x N, row-major) with the accumulation given by
+// acc.
+//
+// Trade-offs, for K much larger than the vector width:
+//
+//   - AccumulateNative is the fastest and allocation free, but where the
+//     kernel accumulates in 16 bits the error grows about linearly with K:
+//     Float16 keeps 11 significant bits and BFloat16 8, so sums of a few
+//     thousand terms can lose all digits of the smaller ones.
+//   - AccumulateF32Demote costs two conversions of C per block of
+//     HalfDemoteInterval steps on top of the float32 kernel, and bounds the
+//     rounding to one per block.
+//   - AccumulateF32 matches the float32 kernel's accuracy; its conversions
+//     are O(MK + KN + MN) against O(MNK) multiply-adds, so large products
+//     run close to the float32 matmul, but it needs 4*(MK + KN + MN) bytes
+//     of scratch.
+func MatMulHalf[T hwy.Float16Types](a, b, c []T, m, n, k int, acc HalfAccumulation) {
+	if len(a) < m*k {
+		panic("matmul: A slice too short")
+	}
+	if len(b) < k*n {
+		panic("matmul: B slice too short")
+	}
+	if len(c) < m*n {
+		panic("matmul: C slice too short")
+	}
+
+	switch acc {
+	case AccumulateNative:
+		MatMul(a, b, c, m, n, k)
+	case AccumulateF32:
+		a32 := make([]float32, m*k)
+		b32 := make([]float32, k*n)
+		c32 := make([]float32, m*n)
+		halfToF32(a32, a[:m*k])
+		halfToF32(b32, b[:k*n])
+		MatMul(a32, b32, c32, m, n, k)
+		f32ToHalf(c[:m*n], c32)
+	case AccumulateF32Demote:
+		matMulHalfDemote(a, b, c, m, n, k)
+	default:
+		panic("matmul: unknown HalfAccumulation")
+	}
+}
+
+// matMulHalfDemote implements AccumulateF32Demote.
+func matMulHalfDemote[T hwy.Float16Types](a, b, c []T, m, n, k int) {
+	kc := min(k, HalfDemoteInterval)
+	sum := make([]float32, m*n)
+	part := make([]float32, m*n)
+	aBlock := make([]float32, m*kc)
+	bBlock := make([]float32, kc*n)
+
+	clear(c[:m*n])
+	for p0 := 0; p0 < k; p0 += kc {
+		kb := min(kc, k-p0)
+		for i := range m {
+			halfToF32(aBlock[i*kb:(i+1)*kb], a[i*k+p0:i*k+p0+kb])
+		}
+		halfToF32(bBlock[:kb*n], b[p0*n:(p0+kb)*n])
+		MatMul(aBlock[:m*kb], bBlock[:kb*n], part, m, n, kb)
+
+		// Demote the running sum, and read it back so the next block adds
+		// to the rounded value.
+		for i, v := range part {
+			sum[i] += v
+		}
+		f32ToHalf(c[:m*n], sum)
+		halfToF32(sum, c[:m*n])
+	}
+}
+
+// halfToF32 widens src into dst.
+func halfToF32[T hwy.Float16Types](dst []float32, src []T) {
+	switch s := any(src).(type) {
+	case []hwy.Float16:
+		hwy.ConvertF16ToF32(dst, s)
+	case []hwy.BFloat16:
+		hwy.ConvertBF16ToF32(dst, s)
+	}
+}
+
+// f32ToHalf rounds src into dst.
+func f32ToHalf[T hwy.Float16Types](dst []T, src []float32) {
+	switch d := any(dst).(type) {
+	case []hwy.Float16:
+		hwy.ConvertF32ToF16(d, src)
+	case []hwy.BFloat16:
+		hwy.ConvertF32ToBF16(d, src)
+	}
+}
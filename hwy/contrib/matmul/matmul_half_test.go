@@ -0,0 +1,102 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package matmul
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/ajroetker/go-highway/hwy"
+)
+
+// testMatMulHalf checks each accumulation mode of MatMulHalf against a
+// float64 product: AccumulateF32 may only round once, AccumulateF32Demote
+// once per block of HalfDemoteInterval steps.
+func testMatMulHalf[T hwy.Float16Types](t *testing.T, toHalf func(float32) T, toF32 func(T) float32, eps float64) {
+	rng := rand.New(rand.NewPCG(5, 6))
+	for _, tc := range []struct{ m, n, k int }{
+		{1, 1, 1},
+		{3, 9, 100},
+		{4, 17, 1000},
+		{2, 8, 2048},
+	} {
+		t.Run(fmt.Sprintf("%dx%dx%d", tc.m, tc.n, tc.k), func(t *testing.T) {
+			a := make([]T, tc.m*tc.k)
+			b := make([]T, tc.k*tc.n)
+			for _, s := range [][]T{a, b} {
+				for i := range s {
+					s[i] = toHalf(rng.Float32())
+				}
+			}
+			want := make([]float64, tc.m*tc.n)
+			for i := range tc.m {
+				for j := range tc.n {
+					for p := range tc.k {
+						want[i*tc.n+j] += float64(toF32(a[i*tc.k+p])) * float64(toF32(b[p*tc.n+j]))
+					}
+				}
+			}
+
+			blocks := float64((tc.k + HalfDemoteInterval - 1) / HalfDemoteInterval)
+			for _, mode := range []struct {
+				name   string
+				acc    HalfAccumulation
+				rounds float64
+			}{
+				{"f32", AccumulateF32, 1},
+				{"f32demote", AccumulateF32Demote, blocks},
+			} {
+				c := make([]T, tc.m*tc.n)
+				MatMulHalf(a, b, c, tc.m, tc.n, tc.k, mode.acc)
+				for i, w := range want {
+					got := float64(toF32(c[i]))
+					if tol := mode.rounds*eps*w + 1e-3; math.Abs(got-w) > tol {
+						t.Fatalf("%s: c[%d] = %v, want %v (tolerance %v)", mode.name, i, got, w, tol)
+					}
+				}
+			}
+
+			got := make([]T, tc.m*tc.n)
+			MatMulHalf(a, b, got, tc.m, tc.n, tc.k, AccumulateNative)
+			c := make([]T, tc.m*tc.n)
+			MatMul(a, b, c, tc.m, tc.n, tc.k)
+			if !slices.Equal(got, c) {
+				t.Errorf("AccumulateNative differs from MatMul")
+			}
+		})
+	}
+}
+
+func TestMatMulHalf(t *testing.T) {
+	t.Run("Float16", func(t *testing.T) {
+		testMatMulHalf(t, hwy.Float32ToFloat16, hwy.Float16ToFloat32, 0x1p-11)
+	})
+	t.Run("BFloat16", func(t *testing.T) {
+		testMatMulHalf(t, hwy.Float32ToBFloat16, hwy.BFloat16ToFloat32, 0x1p-8)
+	})
+}
+
+func TestMatMulHalfPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("MatMulHalf with unknown accumulation did not panic")
+		}
+	}()
+	c := make([]hwy.Float16, 4)
+	MatMulHalf(c, c, c, 2, 2, 2, HalfAccumulation(-1))
+}
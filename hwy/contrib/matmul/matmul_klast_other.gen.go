@@ -88,3 +88,16 @@ func initMatmul_klastFallback() {
 	MatMulKLastBlockedFloat32 = BaseMatMulKLastBlocked_fallback
 	MatMulKLastBlockedFloat64 = BaseMatMulKLastBlocked_fallback_Float64
 }
+
+func init() {
+	hwy.RegisterKernel("matmul.MatMulKLastFloat16", &MatMulKLastFloat16)
+	hwy.RegisterKernel("matmul.MatMulKLastBFloat16", &MatMulKLastBFloat16)
+	hwy.RegisterKernel("matmul.MatMulKLastFloat32", &MatMulKLastFloat32)
+	hwy.RegisterKernel("matmul.MatMulKLastFloat64", &MatMulKLastFloat64)
+	hwy.RegisterKernel("matmul.MatMulKLastBlockedFloat16", &MatMulKLastBlockedFloat16)
+	hwy.RegisterKernel("matmul.MatMulKLastBlockedBFloat16", &MatMulKLastBlockedBFloat16)
+	hwy.RegisterKernel("matmul.MatMulKLastBlockedFloat32", &MatMulKLastBlockedFloat32)
+	hwy.RegisterKernel("matmul.MatMulKLastBlockedFloat64", &MatMulKLastBlockedFloat64)
+	hwyKernels := []string{"matmul.MatMulKLastFloat16", "matmul.MatMulKLastBFloat16", "matmul.MatMulKLastFloat32", "matmul.MatMulKLastFloat64", "matmul.MatMulKLastBlockedFloat16", "matmul.MatMulKLastBlockedBFloat16", "matmul.MatMulKLastBlockedFloat32", "matmul.MatMulKLastBlockedFloat64"}
+	hwy.RegisterKernelTarget(hwy.DispatchScalar, initMatmul_klastFallback, hwyKernels...)
+}
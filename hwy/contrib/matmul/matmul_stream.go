@@ -0,0 +1,117 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package matmul
+
+import (
+	"github.com/ajroetker/go-highway/hwy"
+	"github.com/ajroetker/go-highway/hwy/contrib/workerpool"
+)
+
+// StreamOutputBytes is the size of C above which the streaming matmuls are
+// expected to pay off: about the last-level cache of current x86 server
+// parts. Below it, C fits in cache alongside the A and B panels and plain
+// stores are as fast.
+const StreamOutputBytes = 32 << 20
+
+// BlockedMatMulStream computes C = A * B like BlockedMatMul, with a
+// non-temporal store epilogue for outputs larger than the last-level cache.
+//
+// BlockedMatMul writes C in place, so for a 4096x4096 float32 product the
+// 64MB of C are read for ownership and then evicted, displacing the A and
+// B panels the next tiles reuse. Here each block of BlockSize rows is
+// computed into a cache-resident buffer and copied out with StreamCopy
+// (hwy.StoreStream), so C bypasses the cache. The result is identical to
+// BlockedMatMul.
+//
+// On targets without streaming stores (ARM64, fallback) the epilogue is a
+// plain copy and this is slightly slower than BlockedMatMul; use it when
+// m*n*sizeof(T) exceeds StreamOutputBytes on x86.
+func BlockedMatMulStream[T hwy.Floats](a, b, c []T, m, n, k int) {
+	if len(a) < m*k {
+		panic("matmul: A slice too short")
+	}
+	if len(b) < k*n {
+		panic("matmul: B slice too short")
+	}
+	if len(c) < m*n {
+		panic("matmul: C slice too short")
+	}
+	if m == 0 || n == 0 {
+		return
+	}
+	buf := make([]T, min(m, BlockSize)*n)
+	matMulStreamRows(a, b, c, buf, 0, m, n, k)
+	hwy.FlushStream()
+}
+
+// ParallelMatMulStream is ParallelMatMul with the non-temporal store
+// epilogue of BlockedMatMulStream. Each worker computes its strips of
+// RowsPerStrip rows in blocks of BlockSize rows and streams them to C.
+// A nil pool or a product smaller than MinParallelOps runs
+// BlockedMatMulStream.
+func ParallelMatMulStream[T hwy.Floats](pool *workerpool.Pool, a, b, c []T, m, n, k int) {
+	if pool == nil || m*n*k < MinParallelOps {
+		BlockedMatMulStream(a, b, c, m, n, k)
+		return
+	}
+	if len(a) < m*k {
+		panic("matmul: A slice too short")
+	}
+	if len(b) < k*n {
+		panic("matmul: B slice too short")
+	}
+	if len(c) < m*n {
+		panic("matmul: C slice too short")
+	}
+
+	numStrips := (m + RowsPerStrip - 1) / RowsPerStrip
+	pool.ParallelFor(numStrips, func(start, end int) {
+		buf := make([]T, min(m, BlockSize)*n)
+		for strip := start; strip < end; strip++ {
+			rowStart := strip * RowsPerStrip
+			rowEnd := min(rowStart+RowsPerStrip, m)
+			matMulStreamRows(a, b, c, buf, rowStart, rowEnd, n, k)
+		}
+		// Streaming stores are weakly ordered; order them before the pool
+		// reports this worker done.
+		hwy.FlushStream()
+	})
+}
+
+// matMulStreamRows computes rows [rowStart, rowEnd) of C in blocks of
+// len(buf)/n rows, staging each block in buf.
+func matMulStreamRows[T hwy.Floats](a, b, c, buf []T, rowStart, rowEnd, n, k int) {
+	rows := len(buf) / n
+	for i := rowStart; i < rowEnd; i += rows {
+		iEnd := min(i+rows, rowEnd)
+		blockM := iEnd - i
+		block := buf[:blockM*n]
+		BlockedMatMul(a[i*k:iEnd*k], b, block, blockM, n, k)
+		streamCopy(c[i*n:iEnd*n], block)
+	}
+}
+
+// streamCopy copies src to dst with streaming stores where StreamCopy
+// supports the type; Float16 and BFloat16 use a plain copy.
+func streamCopy[T hwy.Floats](dst, src []T) {
+	switch d := any(dst).(type) {
+	case []float32:
+		StreamCopy(d, any(src).([]float32))
+	case []float64:
+		StreamCopy(d, any(src).([]float64))
+	default:
+		copy(dst, src)
+	}
+}
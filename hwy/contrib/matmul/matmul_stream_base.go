@@ -0,0 +1,37 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package matmul
+
+//go:generate go run ../../../cmd/hwygen -input matmul_stream_base.go -dispatch matmul_stream -output . -targets avx2,avx512,neon,fallback
+
+import "github.com/ajroetker/go-highway/hwy"
+
+// BaseStreamCopy copies src to dst with streaming (non-temporal) stores, so
+// writing dst does not evict the working set from cache. Callers must call
+// hwy.FlushStream before dst is read by another goroutine.
+//
+// It is the epilogue of the streaming matmuls, which compute C in a small
+// cache-resident buffer and copy each finished block out with it.
+func BaseStreamCopy[T hwy.FloatsNative](dst, src []T) {
+	n := min(len(dst), len(src))
+	lanes := hwy.MaxLanes[T]()
+	var i int
+	for i = 0; i+lanes <= n; i += lanes {
+		hwy.StoreStream(hwy.Load(src[i:]), dst[i:])
+	}
+	for ; i < n; i++ {
+		dst[i] = src[i]
+	}
+}
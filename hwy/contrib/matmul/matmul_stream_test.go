@@ -0,0 +1,95 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package matmul
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/ajroetker/go-highway/hwy"
+	"github.com/ajroetker/go-highway/hwy/contrib/workerpool"
+)
+
+func TestMatMulStream(t *testing.T) {
+	pool := workerpool.New(0)
+	defer pool.Close()
+
+	rng := rand.New(rand.NewPCG(21, 22))
+	for _, tc := range []struct{ m, n, k int }{
+		{1, 1, 1},
+		{5, 7, 3},
+		{BlockSize + 3, 33, 17},
+		{200, 65, 40},
+	} {
+		a := make([]float32, tc.m*tc.k)
+		b := make([]float32, tc.k*tc.n)
+		for _, s := range [][]float32{a, b} {
+			for i := range s {
+				s[i] = float32(rng.NormFloat64())
+			}
+		}
+		want := make([]float32, tc.m*tc.n)
+		BlockedMatMul(a, b, want, tc.m, tc.n, tc.k)
+
+		t.Run(fmt.Sprintf("%dx%dx%d", tc.m, tc.n, tc.k), func(t *testing.T) {
+			c := make([]float32, len(want))
+			BlockedMatMulStream(a, b, c, tc.m, tc.n, tc.k)
+			if !slices.Equal(c, want) {
+				t.Errorf("BlockedMatMulStream differs from BlockedMatMul")
+			}
+			clear(c)
+			ParallelMatMulStream(pool, a, b, c, tc.m, tc.n, tc.k)
+			if !slices.Equal(c, want) {
+				t.Errorf("ParallelMatMulStream differs from BlockedMatMul")
+			}
+		})
+	}
+}
+
+func TestMatMulStreamFloat16(t *testing.T) {
+	const m, n, k = 50, 20, 10
+	a := make([]hwy.Float16, m*k)
+	b := make([]hwy.Float16, k*n)
+	for i := range a {
+		a[i] = hwy.Float32ToFloat16(float32(i%7) - 3)
+	}
+	for i := range b {
+		b[i] = hwy.Float32ToFloat16(float32(i%5) - 2)
+	}
+	want := make([]hwy.Float16, m*n)
+	BlockedMatMul(a, b, want, m, n, k)
+	c := make([]hwy.Float16, m*n)
+	BlockedMatMulStream(a, b, c, m, n, k)
+	if !slices.Equal(c, want) {
+		t.Errorf("BlockedMatMulStream differs from BlockedMatMul")
+	}
+}
+
+func TestStreamCopy(t *testing.T) {
+	for _, n := range []int{0, 1, 7, 16, 33, 100} {
+		src := make([]float64, n)
+		for i := range src {
+			src[i] = float64(i) + 0.5
+		}
+		dst := make([]float64, n)
+		StreamCopy(dst, src)
+		hwy.FlushStream()
+		if !slices.Equal(dst, src) {
+			t.Errorf("StreamCopy(n=%d) = %v, want %v", n, dst, src)
+		}
+	}
+}
@@ -108,11 +108,11 @@ func init() {
 		initPackage_kernelFallback()
 		return
 	}
-	if archsimd.X86.AVX512() {
+	if archsimd.X86.AVX512() && hwy.TargetEnabled(hwy.DispatchAVX512) {
 		initPackage_kernelAVX512()
 		return
 	}
-	if archsimd.X86.AVX2() {
+	if archsimd.X86.AVX2() && hwy.TargetEnabled(hwy.DispatchAVX2) {
 		initPackage_kernelAVX2()
 		return
 	}
@@ -163,3 +163,22 @@ func initPackage_kernelFallback() {
 	PackedMicroKernelPartialFloat32 = BasePackedMicroKernelPartial_fallback
 	PackedMicroKernelPartialFloat64 = BasePackedMicroKernelPartial_fallback_Float64
 }
+
+func init() {
+	hwy.RegisterKernel("matmul.PackedMicroKernelFloat16", &PackedMicroKernelFloat16)
+	hwy.RegisterKernel("matmul.PackedMicroKernelBFloat16", &PackedMicroKernelBFloat16)
+	hwy.RegisterKernel("matmul.PackedMicroKernelFloat32", &PackedMicroKernelFloat32)
+	hwy.RegisterKernel("matmul.PackedMicroKernelFloat64", &PackedMicroKernelFloat64)
+	hwy.RegisterKernel("matmul.packedMicroKernelGeneralFloat16", &packedMicroKernelGeneralFloat16)
+	hwy.RegisterKernel("matmul.packedMicroKernelGeneralBFloat16", &packedMicroKernelGeneralBFloat16)
+	hwy.RegisterKernel("matmul.packedMicroKernelGeneralFloat32", &packedMicroKernelGeneralFloat32)
+	hwy.RegisterKernel("matmul.packedMicroKernelGeneralFloat64", &packedMicroKernelGeneralFloat64)
+	hwy.RegisterKernel("matmul.PackedMicroKernelPartialFloat16", &PackedMicroKernelPartialFloat16)
+	hwy.RegisterKernel("matmul.PackedMicroKernelPartialBFloat16", &PackedMicroKernelPartialBFloat16)
+	hwy.RegisterKernel("matmul.PackedMicroKernelPartialFloat32", &PackedMicroKernelPartialFloat32)
+	hwy.RegisterKernel("matmul.PackedMicroKernelPartialFloat64", &PackedMicroKernelPartialFloat64)
+	hwyKernels := []string{"matmul.PackedMicroKernelFloat16", "matmul.PackedMicroKernelBFloat16", "matmul.PackedMicroKernelFloat32", "matmul.PackedMicroKernelFloat64", "matmul.packedMicroKernelGeneralFloat16", "matmul.packedMicroKernelGeneralBFloat16", "matmul.packedMicroKernelGeneralFloat32", "matmul.packedMicroKernelGeneralFloat64", "matmul.PackedMicroKernelPartialFloat16", "matmul.PackedMicroKernelPartialBFloat16", "matmul.PackedMicroKernelPartialFloat32", "matmul.PackedMicroKernelPartialFloat64"}
+	hwy.RegisterKernelTarget(hwy.DispatchAVX2, initPackage_kernelAVX2, hwyKernels...)
+	hwy.RegisterKernelTarget(hwy.DispatchAVX512, initPackage_kernelAVX512, hwyKernels...)
+	hwy.RegisterKernelTarget(hwy.DispatchScalar, initPackage_kernelFallback, hwyKernels...)
+}
@@ -101,3 +101,17 @@ func initPacked_kernel_v2Fallback() {
 	ZeroSliceFloat32 = BaseZeroSlice_fallback
 	ZeroSliceFloat64 = BaseZeroSlice_fallback_Float64
 }
+
+func init() {
+	hwy.RegisterKernel("matmul.PackedMicroKernel4x2Float16", &PackedMicroKernel4x2Float16)
+	hwy.RegisterKernel("matmul.PackedMicroKernel4x2BFloat16", &PackedMicroKernel4x2BFloat16)
+	hwy.RegisterKernel("matmul.PackedMicroKernel4x2Float32", &PackedMicroKernel4x2Float32)
+	hwy.RegisterKernel("matmul.PackedMicroKernel4x2Float64", &PackedMicroKernel4x2Float64)
+	hwy.RegisterKernel("matmul.ZeroSliceFloat16", &ZeroSliceFloat16)
+	hwy.RegisterKernel("matmul.ZeroSliceBFloat16", &ZeroSliceBFloat16)
+	hwy.RegisterKernel("matmul.ZeroSliceFloat32", &ZeroSliceFloat32)
+	hwy.RegisterKernel("matmul.ZeroSliceFloat64", &ZeroSliceFloat64)
+	hwyKernels := []string{"matmul.PackedMicroKernel4x2Float16", "matmul.PackedMicroKernel4x2BFloat16", "matmul.PackedMicroKernel4x2Float32", "matmul.PackedMicroKernel4x2Float64", "matmul.ZeroSliceFloat16", "matmul.ZeroSliceBFloat16", "matmul.ZeroSliceFloat32", "matmul.ZeroSliceFloat64"}
+	hwy.RegisterKernelTarget(hwy.DispatchNEON, initPacked_kernel_v2NEON, hwyKernels...)
+	hwy.RegisterKernelTarget(hwy.DispatchScalar, initPacked_kernel_v2Fallback, hwyKernels...)
+}
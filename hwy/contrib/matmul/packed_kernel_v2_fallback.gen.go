@@ -465,7 +465,14 @@ func BaseZeroSlice_fallback_BFloat16(s []hwy.BFloat16, n int) {
 func BaseZeroSlice_fallback(s []float32, n int) {
 	vZero := float32(0)
 	var idx int
-	for idx = 0; idx < n; idx++ {
+	for idx = 0; idx+4 <= n; idx += 4 {
+		s4 := s[idx : idx+4 : idx+4]
+		s4[0] = vZero
+		s4[1] = vZero
+		s4[2] = vZero
+		s4[3] = vZero
+	}
+	for ; idx < n; idx++ {
 		s[idx] = vZero
 	}
 	for ; idx < n; idx++ {
@@ -476,7 +483,14 @@ func BaseZeroSlice_fallback(s []float32, n int) {
 func BaseZeroSlice_fallback_Float64(s []float64, n int) {
 	vZero := float64(0)
 	var idx int
-	for idx = 0; idx < n; idx++ {
+	for idx = 0; idx+4 <= n; idx += 4 {
+		s4 := s[idx : idx+4 : idx+4]
+		s4[0] = vZero
+		s4[1] = vZero
+		s4[2] = vZero
+		s4[3] = vZero
+	}
+	for ; idx < n; idx++ {
 		s[idx] = vZero
 	}
 	for ; idx < n; idx++ {
@@ -122,3 +122,20 @@ func initPackedmatmulFallback() {
 	PackedMatMulStripFloat32 = BasePackedMatMulStrip_fallback
 	PackedMatMulStripFloat64 = BasePackedMatMulStrip_fallback_Float64
 }
+
+func init() {
+	hwy.RegisterKernel("matmul.PackedMatMulFloat16", &PackedMatMulFloat16)
+	hwy.RegisterKernel("matmul.PackedMatMulBFloat16", &PackedMatMulBFloat16)
+	hwy.RegisterKernel("matmul.PackedMatMulFloat32", &PackedMatMulFloat32)
+	hwy.RegisterKernel("matmul.PackedMatMulFloat64", &PackedMatMulFloat64)
+	hwy.RegisterKernel("matmul.PackedMatMulWithBuffersFloat16", &PackedMatMulWithBuffersFloat16)
+	hwy.RegisterKernel("matmul.PackedMatMulWithBuffersBFloat16", &PackedMatMulWithBuffersBFloat16)
+	hwy.RegisterKernel("matmul.PackedMatMulWithBuffersFloat32", &PackedMatMulWithBuffersFloat32)
+	hwy.RegisterKernel("matmul.PackedMatMulWithBuffersFloat64", &PackedMatMulWithBuffersFloat64)
+	hwy.RegisterKernel("matmul.PackedMatMulStripFloat16", &PackedMatMulStripFloat16)
+	hwy.RegisterKernel("matmul.PackedMatMulStripBFloat16", &PackedMatMulStripBFloat16)
+	hwy.RegisterKernel("matmul.PackedMatMulStripFloat32", &PackedMatMulStripFloat32)
+	hwy.RegisterKernel("matmul.PackedMatMulStripFloat64", &PackedMatMulStripFloat64)
+	hwyKernels := []string{"matmul.PackedMatMulFloat16", "matmul.PackedMatMulBFloat16", "matmul.PackedMatMulFloat32", "matmul.PackedMatMulFloat64", "matmul.PackedMatMulWithBuffersFloat16", "matmul.PackedMatMulWithBuffersBFloat16", "matmul.PackedMatMulWithBuffersFloat32", "matmul.PackedMatMulWithBuffersFloat64", "matmul.PackedMatMulStripFloat16", "matmul.PackedMatMulStripBFloat16", "matmul.PackedMatMulStripFloat32", "matmul.PackedMatMulStripFloat64"}
+	hwy.RegisterKernelTarget(hwy.DispatchScalar, initPackedmatmulFallback, hwyKernels...)
+}
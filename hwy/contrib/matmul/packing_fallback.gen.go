@@ -387,9 +387,30 @@ func BasePackRHSVec_fallback(b []float32, packed []float32, k int, n int, rowSta
 			baseCol := colStart + panel*nr
 			for kk := range panelK {
 				bRowStart := (rowStart + kk) * n
-				for c := 0; c < nr; c++ {
-					v := b[bRowStart+baseCol+c]
-					packed[packIdx+c] = v
+				{
+					c := 0
+					for ; c+4 <= nr; c += 4 {
+						{
+							v := b[bRowStart+baseCol+c]
+							packed[packIdx+c] = v
+						}
+						{
+							v := b[bRowStart+baseCol+(c+1)]
+							packed[packIdx+(c+1)] = v
+						}
+						{
+							v := b[bRowStart+baseCol+(c+2)]
+							packed[packIdx+(c+2)] = v
+						}
+						{
+							v := b[bRowStart+baseCol+(c+3)]
+							packed[packIdx+(c+3)] = v
+						}
+					}
+					for ; c < nr; c++ {
+						v := b[bRowStart+baseCol+c]
+						packed[packIdx+c] = v
+					}
 				}
 				packIdx += nr
 			}
@@ -426,9 +447,30 @@ func BasePackRHSVec_fallback_Float64(b []float64, packed []float64, k int, n int
 			baseCol := colStart + panel*nr
 			for kk := range panelK {
 				bRowStart := (rowStart + kk) * n
-				for c := 0; c < nr; c++ {
-					v := b[bRowStart+baseCol+c]
-					packed[packIdx+c] = v
+				{
+					c := 0
+					for ; c+4 <= nr; c += 4 {
+						{
+							v := b[bRowStart+baseCol+c]
+							packed[packIdx+c] = v
+						}
+						{
+							v := b[bRowStart+baseCol+(c+1)]
+							packed[packIdx+(c+1)] = v
+						}
+						{
+							v := b[bRowStart+baseCol+(c+2)]
+							packed[packIdx+(c+2)] = v
+						}
+						{
+							v := b[bRowStart+baseCol+(c+3)]
+							packed[packIdx+(c+3)] = v
+						}
+					}
+					for ; c < nr; c++ {
+						v := b[bRowStart+baseCol+c]
+						packed[packIdx+c] = v
+					}
 				}
 				packIdx += nr
 			}
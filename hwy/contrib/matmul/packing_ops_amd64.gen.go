@@ -139,11 +139,11 @@ func init() {
 		initPacking_opsFallback()
 		return
 	}
-	if archsimd.X86.AVX512() {
+	if archsimd.X86.AVX512() && hwy.TargetEnabled(hwy.DispatchAVX512) {
 		initPacking_opsAVX512()
 		return
 	}
-	if archsimd.X86.AVX2() {
+	if archsimd.X86.AVX2() && hwy.TargetEnabled(hwy.DispatchAVX2) {
 		initPacking_opsAVX2()
 		return
 	}
@@ -206,3 +206,26 @@ func initPacking_opsFallback() {
 	ApplyPackedOutputAccumFloat32 = BaseApplyPackedOutputAccum_fallback
 	ApplyPackedOutputAccumFloat64 = BaseApplyPackedOutputAccum_fallback_Float64
 }
+
+func init() {
+	hwy.RegisterKernel("matmul.PackRHSFastFloat16", &PackRHSFastFloat16)
+	hwy.RegisterKernel("matmul.PackRHSFastBFloat16", &PackRHSFastBFloat16)
+	hwy.RegisterKernel("matmul.PackRHSFastFloat32", &PackRHSFastFloat32)
+	hwy.RegisterKernel("matmul.PackRHSFastFloat64", &PackRHSFastFloat64)
+	hwy.RegisterKernel("matmul.ApplyPackedOutputFloat16", &ApplyPackedOutputFloat16)
+	hwy.RegisterKernel("matmul.ApplyPackedOutputBFloat16", &ApplyPackedOutputBFloat16)
+	hwy.RegisterKernel("matmul.ApplyPackedOutputFloat32", &ApplyPackedOutputFloat32)
+	hwy.RegisterKernel("matmul.ApplyPackedOutputFloat64", &ApplyPackedOutputFloat64)
+	hwy.RegisterKernel("matmul.ApplyPackedOutputSimpleFloat16", &ApplyPackedOutputSimpleFloat16)
+	hwy.RegisterKernel("matmul.ApplyPackedOutputSimpleBFloat16", &ApplyPackedOutputSimpleBFloat16)
+	hwy.RegisterKernel("matmul.ApplyPackedOutputSimpleFloat32", &ApplyPackedOutputSimpleFloat32)
+	hwy.RegisterKernel("matmul.ApplyPackedOutputSimpleFloat64", &ApplyPackedOutputSimpleFloat64)
+	hwy.RegisterKernel("matmul.ApplyPackedOutputAccumFloat16", &ApplyPackedOutputAccumFloat16)
+	hwy.RegisterKernel("matmul.ApplyPackedOutputAccumBFloat16", &ApplyPackedOutputAccumBFloat16)
+	hwy.RegisterKernel("matmul.ApplyPackedOutputAccumFloat32", &ApplyPackedOutputAccumFloat32)
+	hwy.RegisterKernel("matmul.ApplyPackedOutputAccumFloat64", &ApplyPackedOutputAccumFloat64)
+	hwyKernels := []string{"matmul.PackRHSFastFloat16", "matmul.PackRHSFastBFloat16", "matmul.PackRHSFastFloat32", "matmul.PackRHSFastFloat64", "matmul.ApplyPackedOutputFloat16", "matmul.ApplyPackedOutputBFloat16", "matmul.ApplyPackedOutputFloat32", "matmul.ApplyPackedOutputFloat64", "matmul.ApplyPackedOutputSimpleFloat16", "matmul.ApplyPackedOutputSimpleBFloat16", "matmul.ApplyPackedOutputSimpleFloat32", "matmul.ApplyPackedOutputSimpleFloat64", "matmul.ApplyPackedOutputAccumFloat16", "matmul.ApplyPackedOutputAccumBFloat16", "matmul.ApplyPackedOutputAccumFloat32", "matmul.ApplyPackedOutputAccumFloat64"}
+	hwy.RegisterKernelTarget(hwy.DispatchAVX2, initPacking_opsAVX2, hwyKernels...)
+	hwy.RegisterKernelTarget(hwy.DispatchAVX512, initPacking_opsAVX512, hwyKernels...)
+	hwy.RegisterKernelTarget(hwy.DispatchScalar, initPacking_opsFallback, hwyKernels...)
+}
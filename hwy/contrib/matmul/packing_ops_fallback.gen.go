@@ -76,9 +76,30 @@ func BasePackRHSFast_fallback(b []float32, packed []float32, n int, rowStart int
 		if validCols == nr && nr >= 1 && nr%1 == 0 {
 			for kk := range panelK {
 				srcIdx := (rowStart+kk)*n + baseCol
-				for c := 0; c < nr; c++ {
-					v := b[srcIdx+c]
-					packed[dstIdx+c] = v
+				{
+					c := 0
+					for ; c+4 <= nr; c += 4 {
+						{
+							v := b[srcIdx+c]
+							packed[dstIdx+c] = v
+						}
+						{
+							v := b[srcIdx+(c+1)]
+							packed[dstIdx+(c+1)] = v
+						}
+						{
+							v := b[srcIdx+(c+2)]
+							packed[dstIdx+(c+2)] = v
+						}
+						{
+							v := b[srcIdx+(c+3)]
+							packed[dstIdx+(c+3)] = v
+						}
+					}
+					for ; c < nr; c++ {
+						v := b[srcIdx+c]
+						packed[dstIdx+c] = v
+					}
 				}
 				dstIdx += nr
 			}
@@ -106,9 +127,30 @@ func BasePackRHSFast_fallback_Float64(b []float64, packed []float64, n int, rowS
 		if validCols == nr && nr >= 1 && nr%1 == 0 {
 			for kk := range panelK {
 				srcIdx := (rowStart+kk)*n + baseCol
-				for c := 0; c < nr; c++ {
-					v := b[srcIdx+c]
-					packed[dstIdx+c] = v
+				{
+					c := 0
+					for ; c+4 <= nr; c += 4 {
+						{
+							v := b[srcIdx+c]
+							packed[dstIdx+c] = v
+						}
+						{
+							v := b[srcIdx+(c+1)]
+							packed[dstIdx+(c+1)] = v
+						}
+						{
+							v := b[srcIdx+(c+2)]
+							packed[dstIdx+(c+2)] = v
+						}
+						{
+							v := b[srcIdx+(c+3)]
+							packed[dstIdx+(c+3)] = v
+						}
+					}
+					for ; c < nr; c++ {
+						v := b[srcIdx+c]
+						packed[dstIdx+c] = v
+					}
 				}
 				dstIdx += nr
 			}
@@ -179,6 +221,36 @@ func BaseApplyPackedOutput_fallback(packedOutput []float32, output []float32, al
 		packedIdx := r * packedStride
 		outputIdx := (outputRowOffset+r)*outputStride + outputColOffset
 		c := 0
+		for ; c+4 <= width; c += 4 {
+			{
+				packedVal := packedOutput[packedIdx+c]
+				outputVal := output[outputIdx+c]
+				scaledOutput := outputVal * betaVec
+				newVal := packedVal*alphaVec + scaledOutput
+				output[outputIdx+c] = newVal
+			}
+			{
+				packedVal := packedOutput[packedIdx+(c+1)]
+				outputVal := output[outputIdx+(c+1)]
+				scaledOutput := outputVal * betaVec
+				newVal := packedVal*alphaVec + scaledOutput
+				output[outputIdx+(c+1)] = newVal
+			}
+			{
+				packedVal := packedOutput[packedIdx+(c+2)]
+				outputVal := output[outputIdx+(c+2)]
+				scaledOutput := outputVal * betaVec
+				newVal := packedVal*alphaVec + scaledOutput
+				output[outputIdx+(c+2)] = newVal
+			}
+			{
+				packedVal := packedOutput[packedIdx+(c+3)]
+				outputVal := output[outputIdx+(c+3)]
+				scaledOutput := outputVal * betaVec
+				newVal := packedVal*alphaVec + scaledOutput
+				output[outputIdx+(c+3)] = newVal
+			}
+		}
 		for ; c < width; c++ {
 			packedVal := packedOutput[packedIdx+c]
 			outputVal := output[outputIdx+c]
@@ -200,6 +272,36 @@ func BaseApplyPackedOutput_fallback_Float64(packedOutput []float64, output []flo
 		packedIdx := r * packedStride
 		outputIdx := (outputRowOffset+r)*outputStride + outputColOffset
 		c := 0
+		for ; c+4 <= width; c += 4 {
+			{
+				packedVal := packedOutput[packedIdx+c]
+				outputVal := output[outputIdx+c]
+				scaledOutput := outputVal * betaVec
+				newVal := packedVal*alphaVec + scaledOutput
+				output[outputIdx+c] = newVal
+			}
+			{
+				packedVal := packedOutput[packedIdx+(c+1)]
+				outputVal := output[outputIdx+(c+1)]
+				scaledOutput := outputVal * betaVec
+				newVal := packedVal*alphaVec + scaledOutput
+				output[outputIdx+(c+1)] = newVal
+			}
+			{
+				packedVal := packedOutput[packedIdx+(c+2)]
+				outputVal := output[outputIdx+(c+2)]
+				scaledOutput := outputVal * betaVec
+				newVal := packedVal*alphaVec + scaledOutput
+				output[outputIdx+(c+2)] = newVal
+			}
+			{
+				packedVal := packedOutput[packedIdx+(c+3)]
+				outputVal := output[outputIdx+(c+3)]
+				scaledOutput := outputVal * betaVec
+				newVal := packedVal*alphaVec + scaledOutput
+				output[outputIdx+(c+3)] = newVal
+			}
+		}
 		for ; c < width; c++ {
 			packedVal := packedOutput[packedIdx+c]
 			outputVal := output[outputIdx+c]
@@ -251,6 +353,24 @@ func BaseApplyPackedOutputSimple_fallback(packedOutput []float32, output []float
 		packedIdx := r * packedStride
 		outputIdx := (outputRowOffset+r)*outputStride + outputColOffset
 		c := 0
+		for ; c+4 <= width; c += 4 {
+			{
+				v := packedOutput[packedIdx+c]
+				output[outputIdx+c] = v
+			}
+			{
+				v := packedOutput[packedIdx+(c+1)]
+				output[outputIdx+(c+1)] = v
+			}
+			{
+				v := packedOutput[packedIdx+(c+2)]
+				output[outputIdx+(c+2)] = v
+			}
+			{
+				v := packedOutput[packedIdx+(c+3)]
+				output[outputIdx+(c+3)] = v
+			}
+		}
 		for ; c < width; c++ {
 			v := packedOutput[packedIdx+c]
 			output[outputIdx+c] = v
@@ -266,6 +386,24 @@ func BaseApplyPackedOutputSimple_fallback_Float64(packedOutput []float64, output
 		packedIdx := r * packedStride
 		outputIdx := (outputRowOffset+r)*outputStride + outputColOffset
 		c := 0
+		for ; c+4 <= width; c += 4 {
+			{
+				v := packedOutput[packedIdx+c]
+				output[outputIdx+c] = v
+			}
+			{
+				v := packedOutput[packedIdx+(c+1)]
+				output[outputIdx+(c+1)] = v
+			}
+			{
+				v := packedOutput[packedIdx+(c+2)]
+				output[outputIdx+(c+2)] = v
+			}
+			{
+				v := packedOutput[packedIdx+(c+3)]
+				output[outputIdx+(c+3)] = v
+			}
+		}
 		for ; c < width; c++ {
 			v := packedOutput[packedIdx+c]
 			output[outputIdx+c] = v
@@ -317,6 +455,32 @@ func BaseApplyPackedOutputAccum_fallback(packedOutput []float32, output []float3
 		packedIdx := r * packedStride
 		outputIdx := (outputRowOffset+r)*outputStride + outputColOffset
 		c := 0
+		for ; c+4 <= width; c += 4 {
+			{
+				packedVal := packedOutput[packedIdx+c]
+				outputVal := output[outputIdx+c]
+				newVal := outputVal + packedVal
+				output[outputIdx+c] = newVal
+			}
+			{
+				packedVal := packedOutput[packedIdx+(c+1)]
+				outputVal := output[outputIdx+(c+1)]
+				newVal := outputVal + packedVal
+				output[outputIdx+(c+1)] = newVal
+			}
+			{
+				packedVal := packedOutput[packedIdx+(c+2)]
+				outputVal := output[outputIdx+(c+2)]
+				newVal := outputVal + packedVal
+				output[outputIdx+(c+2)] = newVal
+			}
+			{
+				packedVal := packedOutput[packedIdx+(c+3)]
+				outputVal := output[outputIdx+(c+3)]
+				newVal := outputVal + packedVal
+				output[outputIdx+(c+3)] = newVal
+			}
+		}
 		for ; c < width; c++ {
 			packedVal := packedOutput[packedIdx+c]
 			outputVal := output[outputIdx+c]
@@ -334,6 +498,32 @@ func BaseApplyPackedOutputAccum_fallback_Float64(packedOutput []float64, output
 		packedIdx := r * packedStride
 		outputIdx := (outputRowOffset+r)*outputStride + outputColOffset
 		c := 0
+		for ; c+4 <= width; c += 4 {
+			{
+				packedVal := packedOutput[packedIdx+c]
+				outputVal := output[outputIdx+c]
+				newVal := outputVal + packedVal
+				output[outputIdx+c] = newVal
+			}
+			{
+				packedVal := packedOutput[packedIdx+(c+1)]
+				outputVal := output[outputIdx+(c+1)]
+				newVal := outputVal + packedVal
+				output[outputIdx+(c+1)] = newVal
+			}
+			{
+				packedVal := packedOutput[packedIdx+(c+2)]
+				outputVal := output[outputIdx+(c+2)]
+				newVal := outputVal + packedVal
+				output[outputIdx+(c+2)] = newVal
+			}
+			{
+				packedVal := packedOutput[packedIdx+(c+3)]
+				outputVal := output[outputIdx+(c+3)]
+				newVal := outputVal + packedVal
+				output[outputIdx+(c+3)] = newVal
+			}
+		}
 		for ; c < width; c++ {
 			packedVal := packedOutput[packedIdx+c]
 			outputVal := output[outputIdx+c]
@@ -170,3 +170,24 @@ func initPackingFallback() {
 	PackRHSVecFloat32 = BasePackRHSVec_fallback
 	PackRHSVecFloat64 = BasePackRHSVec_fallback_Float64
 }
+
+func init() {
+	hwy.RegisterKernel("matmul.PackLHSFloat16", &PackLHSFloat16)
+	hwy.RegisterKernel("matmul.PackLHSBFloat16", &PackLHSBFloat16)
+	hwy.RegisterKernel("matmul.PackLHSFloat32", &PackLHSFloat32)
+	hwy.RegisterKernel("matmul.PackLHSFloat64", &PackLHSFloat64)
+	hwy.RegisterKernel("matmul.PackRHSFloat16", &PackRHSFloat16)
+	hwy.RegisterKernel("matmul.PackRHSBFloat16", &PackRHSBFloat16)
+	hwy.RegisterKernel("matmul.PackRHSFloat32", &PackRHSFloat32)
+	hwy.RegisterKernel("matmul.PackRHSFloat64", &PackRHSFloat64)
+	hwy.RegisterKernel("matmul.PackLHSVecFloat16", &PackLHSVecFloat16)
+	hwy.RegisterKernel("matmul.PackLHSVecBFloat16", &PackLHSVecBFloat16)
+	hwy.RegisterKernel("matmul.PackLHSVecFloat32", &PackLHSVecFloat32)
+	hwy.RegisterKernel("matmul.PackLHSVecFloat64", &PackLHSVecFloat64)
+	hwy.RegisterKernel("matmul.PackRHSVecFloat16", &PackRHSVecFloat16)
+	hwy.RegisterKernel("matmul.PackRHSVecBFloat16", &PackRHSVecBFloat16)
+	hwy.RegisterKernel("matmul.PackRHSVecFloat32", &PackRHSVecFloat32)
+	hwy.RegisterKernel("matmul.PackRHSVecFloat64", &PackRHSVecFloat64)
+	hwyKernels := []string{"matmul.PackLHSFloat16", "matmul.PackLHSBFloat16", "matmul.PackLHSFloat32", "matmul.PackLHSFloat64", "matmul.PackRHSFloat16", "matmul.PackRHSBFloat16", "matmul.PackRHSFloat32", "matmul.PackRHSFloat64", "matmul.PackLHSVecFloat16", "matmul.PackLHSVecBFloat16", "matmul.PackLHSVecFloat32", "matmul.PackLHSVecFloat64", "matmul.PackRHSVecFloat16", "matmul.PackRHSVecBFloat16", "matmul.PackRHSVecFloat32", "matmul.PackRHSVecFloat64"}
+	hwy.RegisterKernelTarget(hwy.DispatchScalar, initPackingFallback, hwyKernels...)
+}
@@ -70,3 +70,16 @@ func initTransposeFallback() {
 	Transpose2DFloat32 = BaseTranspose2D_fallback
 	Transpose2DFloat64 = BaseTranspose2D_fallback_Float64
 }
+
+func init() {
+	hwy.RegisterKernel("matmul.Transpose2DStridedFloat16", &Transpose2DStridedFloat16)
+	hwy.RegisterKernel("matmul.Transpose2DStridedBFloat16", &Transpose2DStridedBFloat16)
+	hwy.RegisterKernel("matmul.Transpose2DStridedFloat32", &Transpose2DStridedFloat32)
+	hwy.RegisterKernel("matmul.Transpose2DStridedFloat64", &Transpose2DStridedFloat64)
+	hwy.RegisterKernel("matmul.Transpose2DFloat16", &Transpose2DFloat16)
+	hwy.RegisterKernel("matmul.Transpose2DBFloat16", &Transpose2DBFloat16)
+	hwy.RegisterKernel("matmul.Transpose2DFloat32", &Transpose2DFloat32)
+	hwy.RegisterKernel("matmul.Transpose2DFloat64", &Transpose2DFloat64)
+	hwyKernels := []string{"matmul.Transpose2DStridedFloat16", "matmul.Transpose2DStridedBFloat16", "matmul.Transpose2DStridedFloat32", "matmul.Transpose2DStridedFloat64", "matmul.Transpose2DFloat16", "matmul.Transpose2DBFloat16", "matmul.Transpose2DFloat32", "matmul.Transpose2DFloat64"}
+	hwy.RegisterKernelTarget(hwy.DispatchScalar, initTransposeFallback, hwyKernels...)
+}
@@ -24,11 +24,11 @@
 package matmul
 
 import (
-	"runtime"
 	"sync"
 
 	"github.com/ajroetker/go-highway/hwy"
 	"github.com/ajroetker/go-highway/hwy/contrib/matmul/asm"
+	"github.com/ajroetker/go-highway/hwy/contrib/workerpool"
 )
 
 // =============================================================================
@@ -1763,39 +1763,28 @@ func parallelFusedNF4MatMulSME(
 	// Zero output (strided kernel writes to sub-columns, each tile writes independent columns)
 	clear(output[:M*N])
 
-	// Setup work queue of N-tile indices
-	work := make(chan int, numTiles)
-	for nTile := 0; nTile < N; nTile += 16 {
-		work <- nTile
-	}
-	close(work)
-
-	// Launch workers
-	numWorkers := min(runtime.GOMAXPROCS(0), numTiles)
-	var wg sync.WaitGroup
-	for range numWorkers {
-		wg.Go(func() {
-			// Pin goroutine to OS thread for SME streaming mode safety
-			defer hwy.SMEGuard()()
-
-			// Get thread-local tile buffer from pool
-			tileBuf := fusedTilePool.Get().([]float32)
-			tileSize := K * 16
-			if cap(tileBuf) < tileSize {
-				tileBuf = make([]float32, tileSize)
-			} else {
-				tileBuf = tileBuf[:tileSize]
-			}
-			clear(tileBuf)
-			defer fusedTilePool.Put(tileBuf)
+	// Split the N tiles across the shared worker pool; each worker sets up
+	// SME streaming mode and its tile buffer once per run of tiles.
+	workerpool.Default().ParallelFor(numTiles, func(start, end int) {
+		// Pin goroutine to OS thread for SME streaming mode safety
+		defer hwy.SMEGuard()()
+
+		// Get thread-local tile buffer from pool
+		tileBuf := fusedTilePool.Get().([]float32)
+		tileSize := K * 16
+		if cap(tileBuf) < tileSize {
+			tileBuf = make([]float32, tileSize)
+		} else {
+			tileBuf = tileBuf[:tileSize]
+		}
+		clear(tileBuf)
+		defer fusedTilePool.Put(tileBuf)
 
-			for nTile := range work {
-				processFusedNF4Tile(inputT, packed, scales, output, tileBuf,
-					nTile, M, K, N, numGroups, groupSize)
-			}
-		})
-	}
-	wg.Wait()
+		for nTile := start * 16; nTile < end*16; nTile += 16 {
+			processFusedNF4Tile(inputT, packed, scales, output, tileBuf,
+				nTile, M, K, N, numGroups, groupSize)
+		}
+	})
 }
 
 // parallelFusedInt4MatMulSME performs fused Int4 matmul with parallel N-tile processing.
@@ -1838,38 +1827,28 @@ func parallelFusedInt4MatMulSME(
 	// Zero output (strided kernel writes to sub-columns, each tile writes independent columns)
 	clear(output[:M*N])
 
-	// Setup work queue of N-tile indices
-	work := make(chan int, numTiles)
-	for nTile := 0; nTile < N; nTile += 16 {
-		work <- nTile
-	}
-	close(work)
-
-	numWorkers := min(runtime.GOMAXPROCS(0), numTiles)
-	var wg sync.WaitGroup
-	for range numWorkers {
-		wg.Go(func() {
-			// Pin goroutine to OS thread for SME streaming mode safety
-			defer hwy.SMEGuard()()
-
-			// Get thread-local tile buffer from pool
-			tileBuf := fusedTilePool.Get().([]float32)
-			tileSize := K * 16
-			if cap(tileBuf) < tileSize {
-				tileBuf = make([]float32, tileSize)
-			} else {
-				tileBuf = tileBuf[:tileSize]
-			}
-			clear(tileBuf)
-			defer fusedTilePool.Put(tileBuf)
+	// Split the N tiles across the shared worker pool; each worker sets up
+	// SME streaming mode and its tile buffer once per run of tiles.
+	workerpool.Default().ParallelFor(numTiles, func(start, end int) {
+		// Pin goroutine to OS thread for SME streaming mode safety
+		defer hwy.SMEGuard()()
+
+		// Get thread-local tile buffer from pool
+		tileBuf := fusedTilePool.Get().([]float32)
+		tileSize := K * 16
+		if cap(tileBuf) < tileSize {
+			tileBuf = make([]float32, tileSize)
+		} else {
+			tileBuf = tileBuf[:tileSize]
+		}
+		clear(tileBuf)
+		defer fusedTilePool.Put(tileBuf)
 
-			for nTile := range work {
-				processFusedInt4Tile(inputT, packed, scales, output, tileBuf,
-					nTile, M, K, N, numGroups, groupSize)
-			}
-		})
-	}
-	wg.Wait()
+		for nTile := start * 16; nTile < end*16; nTile += 16 {
+			processFusedInt4Tile(inputT, packed, scales, output, tileBuf,
+				nTile, M, K, N, numGroups, groupSize)
+		}
+	})
 }
 
 // =============================================================================
@@ -2031,43 +2010,34 @@ func parallelFusedInt8MatMulSME(
 	transposeMatrix(input, M, K, inputT)
 	defer transposePool32.Put(inputT)
 
-	work := make(chan int, numTiles)
-	for nTile := 0; nTile < N; nTile += 16 {
-		work <- nTile
-	}
-	close(work)
-
-	numWorkers := min(runtime.GOMAXPROCS(0), numTiles)
-	var wg sync.WaitGroup
-	for range numWorkers {
-		wg.Go(func() {
-			defer hwy.SMEGuard()()
+	// Split the N tiles across the shared worker pool; each worker sets up
+	// SME streaming mode and its tile buffer once per run of tiles.
+	workerpool.Default().ParallelFor(numTiles, func(start, end int) {
+		defer hwy.SMEGuard()()
 
-			tileBuf := fusedInt8TilePool.Get().([]float32)
-			tileSize := K * 16
-			if cap(tileBuf) < tileSize {
-				tileBuf = make([]float32, tileSize)
-			} else {
-				tileBuf = tileBuf[:tileSize]
-			}
-			defer fusedInt8TilePool.Put(tileBuf)
+		tileBuf := fusedInt8TilePool.Get().([]float32)
+		tileSize := K * 16
+		if cap(tileBuf) < tileSize {
+			tileBuf = make([]float32, tileSize)
+		} else {
+			tileBuf = tileBuf[:tileSize]
+		}
+		defer fusedInt8TilePool.Put(tileBuf)
 
-			outputTile := fusedOutputTilePool.Get().([]float32)
-			outputTileSize := M * 16
-			if cap(outputTile) < outputTileSize {
-				outputTile = make([]float32, outputTileSize)
-			} else {
-				outputTile = outputTile[:outputTileSize]
-			}
-			defer fusedOutputTilePool.Put(outputTile)
+		outputTile := fusedOutputTilePool.Get().([]float32)
+		outputTileSize := M * 16
+		if cap(outputTile) < outputTileSize {
+			outputTile = make([]float32, outputTileSize)
+		} else {
+			outputTile = outputTile[:outputTileSize]
+		}
+		defer fusedOutputTilePool.Put(outputTile)
 
-			for nTile := range work {
-				processFusedInt8Tile(inputT, weights, scales, output, tileBuf, outputTile,
-					nTile, M, K, N, numGroups, groupSize)
-			}
-		})
-	}
-	wg.Wait()
+		for nTile := start * 16; nTile < end*16; nTile += 16 {
+			processFusedInt8Tile(inputT, weights, scales, output, tileBuf, outputTile,
+				nTile, M, K, N, numGroups, groupSize)
+		}
+	})
 }
 
 // =============================================================================
@@ -2304,35 +2274,26 @@ func parallelFusedNF4MatMulActSME(
 
 	clear(output[:M*N])
 
-	work := make(chan int, numTiles)
-	for nTile := 0; nTile < N; nTile += 16 {
-		work <- nTile
-	}
-	close(work)
+	// Split the N tiles across the shared worker pool; each worker sets up
+	// SME streaming mode and its tile buffer once per run of tiles.
+	workerpool.Default().ParallelFor(numTiles, func(start, end int) {
+		defer hwy.SMEGuard()()
 
-	numWorkers := min(runtime.GOMAXPROCS(0), numTiles)
-	var wg sync.WaitGroup
-	for range numWorkers {
-		wg.Go(func() {
-			defer hwy.SMEGuard()()
-
-			tileBuf := fusedTilePool.Get().([]float32)
-			tileSize := K * 16
-			if cap(tileBuf) < tileSize {
-				tileBuf = make([]float32, tileSize)
-			} else {
-				tileBuf = tileBuf[:tileSize]
-			}
-			clear(tileBuf)
-			defer fusedTilePool.Put(tileBuf)
+		tileBuf := fusedTilePool.Get().([]float32)
+		tileSize := K * 16
+		if cap(tileBuf) < tileSize {
+			tileBuf = make([]float32, tileSize)
+		} else {
+			tileBuf = tileBuf[:tileSize]
+		}
+		clear(tileBuf)
+		defer fusedTilePool.Put(tileBuf)
 
-			for nTile := range work {
-				processFusedNF4TileWithAct(inputT, packed, scales, output, tileBuf,
-					nTile, M, K, N, numGroups, groupSize, act)
-			}
-		})
-	}
-	wg.Wait()
+		for nTile := start * 16; nTile < end*16; nTile += 16 {
+			processFusedNF4TileWithAct(inputT, packed, scales, output, tileBuf,
+				nTile, M, K, N, numGroups, groupSize, act)
+		}
+	})
 }
 
 func parallelFusedInt4MatMulSiLUSME(input []float32, packed []uint8, scales []float32, output []float32, M, K, N, groupSize int) {
@@ -2372,35 +2333,26 @@ func parallelFusedInt4MatMulActSME(
 
 	clear(output[:M*N])
 
-	work := make(chan int, numTiles)
-	for nTile := 0; nTile < N; nTile += 16 {
-		work <- nTile
-	}
-	close(work)
-
-	numWorkers := min(runtime.GOMAXPROCS(0), numTiles)
-	var wg sync.WaitGroup
-	for range numWorkers {
-		wg.Go(func() {
-			defer hwy.SMEGuard()()
+	// Split the N tiles across the shared worker pool; each worker sets up
+	// SME streaming mode and its tile buffer once per run of tiles.
+	workerpool.Default().ParallelFor(numTiles, func(start, end int) {
+		defer hwy.SMEGuard()()
 
-			tileBuf := fusedTilePool.Get().([]float32)
-			tileSize := K * 16
-			if cap(tileBuf) < tileSize {
-				tileBuf = make([]float32, tileSize)
-			} else {
-				tileBuf = tileBuf[:tileSize]
-			}
-			clear(tileBuf)
-			defer fusedTilePool.Put(tileBuf)
+		tileBuf := fusedTilePool.Get().([]float32)
+		tileSize := K * 16
+		if cap(tileBuf) < tileSize {
+			tileBuf = make([]float32, tileSize)
+		} else {
+			tileBuf = tileBuf[:tileSize]
+		}
+		clear(tileBuf)
+		defer fusedTilePool.Put(tileBuf)
 
-			for nTile := range work {
-				processFusedInt4TileWithAct(inputT, packed, scales, output, tileBuf,
-					nTile, M, K, N, numGroups, groupSize, act)
-			}
-		})
-	}
-	wg.Wait()
+		for nTile := start * 16; nTile < end*16; nTile += 16 {
+			processFusedInt4TileWithAct(inputT, packed, scales, output, tileBuf,
+				nTile, M, K, N, numGroups, groupSize, act)
+		}
+	})
 }
 
 // =============================================================================
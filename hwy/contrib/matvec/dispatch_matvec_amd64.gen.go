@@ -61,11 +61,11 @@ func init() {
 		initMatvecFallback()
 		return
 	}
-	if archsimd.X86.AVX512() {
+	if archsimd.X86.AVX512() && hwy.TargetEnabled(hwy.DispatchAVX512) {
 		initMatvecAVX512()
 		return
 	}
-	if archsimd.X86.AVX2() {
+	if archsimd.X86.AVX2() && hwy.TargetEnabled(hwy.DispatchAVX2) {
 		initMatvecAVX2()
 		return
 	}
@@ -92,3 +92,14 @@ func initMatvecFallback() {
 	MatVecFloat32 = BaseMatVec_fallback
 	MatVecFloat64 = BaseMatVec_fallback_Float64
 }
+
+func init() {
+	hwy.RegisterKernel("matvec.MatVecFloat16", &MatVecFloat16)
+	hwy.RegisterKernel("matvec.MatVecBFloat16", &MatVecBFloat16)
+	hwy.RegisterKernel("matvec.MatVecFloat32", &MatVecFloat32)
+	hwy.RegisterKernel("matvec.MatVecFloat64", &MatVecFloat64)
+	hwyKernels := []string{"matvec.MatVecFloat16", "matvec.MatVecBFloat16", "matvec.MatVecFloat32", "matvec.MatVecFloat64"}
+	hwy.RegisterKernelTarget(hwy.DispatchAVX2, initMatvecAVX2, hwyKernels...)
+	hwy.RegisterKernelTarget(hwy.DispatchAVX512, initMatvecAVX512, hwyKernels...)
+	hwy.RegisterKernelTarget(hwy.DispatchScalar, initMatvecFallback, hwyKernels...)
+}
@@ -65,3 +65,12 @@ func initMatvecFallback() {
 	MatVecFloat32 = BaseMatVec_fallback
 	MatVecFloat64 = BaseMatVec_fallback_Float64
 }
+
+func init() {
+	hwy.RegisterKernel("matvec.MatVecFloat16", &MatVecFloat16)
+	hwy.RegisterKernel("matvec.MatVecBFloat16", &MatVecBFloat16)
+	hwy.RegisterKernel("matvec.MatVecFloat32", &MatVecFloat32)
+	hwy.RegisterKernel("matvec.MatVecFloat64", &MatVecFloat64)
+	hwyKernels := []string{"matvec.MatVecFloat16", "matvec.MatVecBFloat16", "matvec.MatVecFloat32", "matvec.MatVecFloat64"}
+	hwy.RegisterKernelTarget(hwy.DispatchScalar, initMatvecFallback, hwyKernels...)
+}
@@ -58,6 +58,17 @@
 //	// 70 = 5*1 + 6*2 + 7*3 + 8*4
 //	// 20 = 9*1 + 0*2 + 1*3 + 2*4
 //
+// # Rank-1 Updates
+//
+// The package also provides the outer product updates, for online
+// learning and Kalman filter covariance updates:
+//   - Ger(alpha, x, y, a, rows, cols) - A += alpha * x * yᵀ
+//   - GerBatch(alpha, x, y, a, k, rows, cols) - A += Σₚ alpha[p] * xₚ * yₚᵀ
+//
+// Both broadcast a coefficient per row of A and add the scaled rows of y
+// with fused multiply-adds; GerBatch applies its k updates to each vector
+// of A while it is in a register.
+//
 // # Performance
 //
 // Matrix-vector operations use the optimized dot product internally,
@@ -0,0 +1,132 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package matvec
+
+//go:generate go run ../../../cmd/hwygen -input ger_base.go -output . -targets avx2,avx512,neon,fallback -dispatch ger
+
+import "github.com/ajroetker/go-highway/hwy"
+
+// BaseGer performs the rank-1 update A += alpha * x * yᵀ, the BLAS GER
+// operation.
+//
+// Parameters:
+//   - alpha: scale of the outer product
+//   - x: vector of length rows
+//   - y: vector of length cols
+//   - a: matrix in row-major order with shape [rows, cols], updated in place
+//   - rows: number of rows in the matrix
+//   - cols: number of columns in the matrix
+//
+// Row i of A gets y scaled by alpha*x[i], broadcast to a vector and added
+// with fused multiply-adds. As in the reference BLAS, rows whose alpha*x[i]
+// is zero are left untouched.
+//
+// Panics if:
+//   - len(a) < rows * cols
+//   - len(x) < rows
+//   - len(y) < cols
+//
+// Example:
+//
+//	a := []float32{1, 1, 1, 1, 1, 1} // 2x3
+//	Ger(2, []float32{1, 2}, []float32{1, 0, 3}, a, 2, 3)
+//	// a = [3 1 7]
+//	//     [5 1 13]
+func BaseGer[T hwy.FloatsNative](alpha T, x, y, a []T, rows, cols int) {
+	if len(a) < rows*cols {
+		panic("matrix slice too small")
+	}
+	if len(x) < rows {
+		panic("x slice too small")
+	}
+	if len(y) < cols {
+		panic("y slice too small")
+	}
+	lanes := hwy.MaxLanes[T]()
+	for i := range rows {
+		s := alpha * x[i]
+		if s == 0 {
+			continue
+		}
+		vs := hwy.Set(s)
+		row := a[i*cols : (i+1)*cols]
+		j := 0
+		for ; j+lanes <= cols; j += lanes {
+			hwy.Store(hwy.MulAdd(vs, hwy.Load(y[j:]), hwy.Load(row[j:])), row[j:])
+		}
+		for ; j < cols; j++ {
+			row[j] += s * y[j]
+		}
+	}
+}
+
+// BaseGerBatch performs k rank-1 updates at once:
+// A += Σₚ alpha[p] * xₚ * yₚᵀ, which is A += Xᵀ diag(alpha) Y.
+//
+// Parameters:
+//   - alpha: the k scales of the outer products
+//   - x: the k vectors xₚ of length rows, one after the other
+//   - y: the k vectors yₚ of length cols, one after the other
+//   - a: matrix in row-major order with shape [rows, cols], updated in place
+//   - k: number of updates
+//   - rows: number of rows in the matrix
+//   - cols: number of columns in the matrix
+//
+// Each vector of a row of A is loaded once, receives the k updates in a
+// register, and is stored once, so that a batch of updates, such as the
+// examples of an online learning step, costs one pass over A instead of k.
+//
+// Panics if:
+//   - len(a) < rows * cols
+//   - len(alpha) < k
+//   - len(x) < k * rows
+//   - len(y) < k * cols
+func BaseGerBatch[T hwy.FloatsNative](alpha, x, y, a []T, k, rows, cols int) {
+	if len(a) < rows*cols {
+		panic("matrix slice too small")
+	}
+	if len(alpha) < k {
+		panic("alpha slice too small")
+	}
+	if len(x) < k*rows {
+		panic("x slice too small")
+	}
+	if len(y) < k*cols {
+		panic("y slice too small")
+	}
+	lanes := hwy.MaxLanes[T]()
+	coef := make([]T, k)
+	for i := range rows {
+		for p := range k {
+			coef[p] = alpha[p] * x[p*rows+i]
+		}
+		row := a[i*cols : (i+1)*cols]
+		j := 0
+		for ; j+lanes <= cols; j += lanes {
+			acc := hwy.Load(row[j:])
+			for p := range k {
+				acc = hwy.MulAdd(hwy.Set(coef[p]), hwy.Load(y[p*cols+j:]), acc)
+			}
+			hwy.Store(acc, row[j:])
+		}
+		for ; j < cols; j++ {
+			acc := row[j]
+			for p := range k {
+				acc += coef[p] * y[p*cols+j]
+			}
+			row[j] = acc
+		}
+	}
+}
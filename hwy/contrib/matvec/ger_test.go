@@ -0,0 +1,90 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package matvec
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+)
+
+func TestGer(t *testing.T) {
+	a := []float32{1, 1, 1, 1, 1, 1}
+	Ger(2, []float32{1, 2}, []float32{1, 0, 3}, a, 2, 3)
+	want := []float32{3, 1, 7, 5, 1, 13}
+	for i := range want {
+		if a[i] != want[i] {
+			t.Fatalf("Ger = %v, want %v", a, want)
+		}
+	}
+
+	r := rand.New(rand.NewPCG(1, 2))
+	for _, dims := range [][2]int{{1, 1}, {3, 17}, {16, 16}, {9, 33}} {
+		rows, cols := dims[0], dims[1]
+		x := randomSlice(r, rows)
+		y := randomSlice(r, cols)
+		a := randomSlice(r, rows*cols)
+		wantA := make([]float64, len(a))
+		for i := range rows {
+			for j := range cols {
+				wantA[i*cols+j] = a[i*cols+j] + 0.5*x[i]*y[j]
+			}
+		}
+		Ger(0.5, x, y, a, rows, cols)
+		for i := range a {
+			if math.Abs(a[i]-wantA[i]) > 1e-12 {
+				t.Fatalf("%dx%d: Ger[%d] = %g, want %g", rows, cols, i, a[i], wantA[i])
+			}
+		}
+	}
+}
+
+func TestGerBatch(t *testing.T) {
+	r := rand.New(rand.NewPCG(3, 4))
+	for _, dims := range [][3]int{{1, 1, 1}, {4, 3, 17}, {7, 16, 16}, {2, 9, 33}} {
+		k, rows, cols := dims[0], dims[1], dims[2]
+		alpha := randomSlice(r, k)
+		x := randomSlice(r, k*rows)
+		y := randomSlice(r, k*cols)
+		a := randomSlice(r, rows*cols)
+		want := append([]float64(nil), a...)
+		for p := range k {
+			Ger(alpha[p], x[p*rows:(p+1)*rows], y[p*cols:(p+1)*cols], want, rows, cols)
+		}
+		GerBatch(alpha, x, y, a, k, rows, cols)
+		for i := range a {
+			if math.Abs(a[i]-want[i]) > 1e-12 {
+				t.Fatalf("k=%d %dx%d: GerBatch[%d] = %g, want %g", k, rows, cols, i, a[i], want[i])
+			}
+		}
+	}
+}
+
+func TestGerPanics(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Error("expected panic for small x")
+		}
+	}()
+	Ger(1, []float32{1}, []float32{1, 2}, make([]float32, 4), 2, 2)
+}
+
+func randomSlice(r *rand.Rand, n int) []float64 {
+	s := make([]float64, n)
+	for i := range s {
+		s[i] = r.NormFloat64()
+	}
+	return s
+}
@@ -20,7 +20,8 @@ type ActivationType int
 const (
 	// ActivationNone applies no activation (identity).
 	ActivationNone ActivationType = iota
-	// ActivationGelu applies the Gaussian Error Linear Unit activation.
+	// ActivationGelu applies the exact Gaussian Error Linear Unit activation,
+	// x * 0.5 * (1 + erf(x/sqrt(2))), not the sigmoid approximation.
 	ActivationGelu
 	// ActivationRelu applies the Rectified Linear Unit activation.
 	ActivationRelu
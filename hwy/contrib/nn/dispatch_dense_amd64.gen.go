@@ -44,11 +44,11 @@ func init() {
 		initDenseFallback()
 		return
 	}
-	if archsimd.X86.AVX512() {
+	if archsimd.X86.AVX512() && hwy.TargetEnabled(hwy.DispatchAVX512) {
 		initDenseAVX512()
 		return
 	}
-	if archsimd.X86.AVX2() {
+	if archsimd.X86.AVX2() && hwy.TargetEnabled(hwy.DispatchAVX2) {
 		initDenseAVX2()
 		return
 	}
@@ -75,3 +75,14 @@ func initDenseFallback() {
 	DenseFloat32 = BaseDense_fallback
 	DenseFloat64 = BaseDense_fallback_Float64
 }
+
+func init() {
+	hwy.RegisterKernel("nn.DenseFloat16", &DenseFloat16)
+	hwy.RegisterKernel("nn.DenseBFloat16", &DenseBFloat16)
+	hwy.RegisterKernel("nn.DenseFloat32", &DenseFloat32)
+	hwy.RegisterKernel("nn.DenseFloat64", &DenseFloat64)
+	hwyKernels := []string{"nn.DenseFloat16", "nn.DenseBFloat16", "nn.DenseFloat32", "nn.DenseFloat64"}
+	hwy.RegisterKernelTarget(hwy.DispatchAVX2, initDenseAVX2, hwyKernels...)
+	hwy.RegisterKernelTarget(hwy.DispatchAVX512, initDenseAVX512, hwyKernels...)
+	hwy.RegisterKernelTarget(hwy.DispatchScalar, initDenseFallback, hwyKernels...)
+}
@@ -59,3 +59,13 @@ func initDenseFallback() {
 	DenseFloat32 = BaseDense_fallback
 	DenseFloat64 = BaseDense_fallback_Float64
 }
+
+func init() {
+	hwy.RegisterKernel("nn.DenseFloat16", &DenseFloat16)
+	hwy.RegisterKernel("nn.DenseBFloat16", &DenseBFloat16)
+	hwy.RegisterKernel("nn.DenseFloat32", &DenseFloat32)
+	hwy.RegisterKernel("nn.DenseFloat64", &DenseFloat64)
+	hwyKernels := []string{"nn.DenseFloat16", "nn.DenseBFloat16", "nn.DenseFloat32", "nn.DenseFloat64"}
+	hwy.RegisterKernelTarget(hwy.DispatchNEON, initDenseNEON, hwyKernels...)
+	hwy.RegisterKernelTarget(hwy.DispatchScalar, initDenseFallback, hwyKernels...)
+}
@@ -44,11 +44,11 @@ func init() {
 		initLayernormFallback()
 		return
 	}
-	if archsimd.X86.AVX512() {
+	if archsimd.X86.AVX512() && hwy.TargetEnabled(hwy.DispatchAVX512) {
 		initLayernormAVX512()
 		return
 	}
-	if archsimd.X86.AVX2() {
+	if archsimd.X86.AVX2() && hwy.TargetEnabled(hwy.DispatchAVX2) {
 		initLayernormAVX2()
 		return
 	}
@@ -75,3 +75,14 @@ func initLayernormFallback() {
 	LayerNormFloat32 = BaseLayerNorm_fallback
 	LayerNormFloat64 = BaseLayerNorm_fallback_Float64
 }
+
+func init() {
+	hwy.RegisterKernel("nn.LayerNormFloat16", &LayerNormFloat16)
+	hwy.RegisterKernel("nn.LayerNormBFloat16", &LayerNormBFloat16)
+	hwy.RegisterKernel("nn.LayerNormFloat32", &LayerNormFloat32)
+	hwy.RegisterKernel("nn.LayerNormFloat64", &LayerNormFloat64)
+	hwyKernels := []string{"nn.LayerNormFloat16", "nn.LayerNormBFloat16", "nn.LayerNormFloat32", "nn.LayerNormFloat64"}
+	hwy.RegisterKernelTarget(hwy.DispatchAVX2, initLayernormAVX2, hwyKernels...)
+	hwy.RegisterKernelTarget(hwy.DispatchAVX512, initLayernormAVX512, hwyKernels...)
+	hwy.RegisterKernelTarget(hwy.DispatchScalar, initLayernormFallback, hwyKernels...)
+}
@@ -48,3 +48,12 @@ func initLayernormFallback() {
 	LayerNormFloat32 = BaseLayerNorm_fallback
 	LayerNormFloat64 = BaseLayerNorm_fallback_Float64
 }
+
+func init() {
+	hwy.RegisterKernel("nn.LayerNormFloat16", &LayerNormFloat16)
+	hwy.RegisterKernel("nn.LayerNormBFloat16", &LayerNormBFloat16)
+	hwy.RegisterKernel("nn.LayerNormFloat32", &LayerNormFloat32)
+	hwy.RegisterKernel("nn.LayerNormFloat64", &LayerNormFloat64)
+	hwyKernels := []string{"nn.LayerNormFloat16", "nn.LayerNormBFloat16", "nn.LayerNormFloat32", "nn.LayerNormFloat64"}
+	hwy.RegisterKernelTarget(hwy.DispatchScalar, initLayernormFallback, hwyKernels...)
+}
@@ -50,11 +50,11 @@ func init() {
 		initQkvdenseFallback()
 		return
 	}
-	if archsimd.X86.AVX512() {
+	if archsimd.X86.AVX512() && hwy.TargetEnabled(hwy.DispatchAVX512) {
 		initQkvdenseAVX512()
 		return
 	}
-	if archsimd.X86.AVX2() {
+	if archsimd.X86.AVX2() && hwy.TargetEnabled(hwy.DispatchAVX2) {
 		initQkvdenseAVX2()
 		return
 	}
@@ -81,3 +81,14 @@ func initQkvdenseFallback() {
 	QKVDenseFloat32 = BaseQKVDense_fallback
 	QKVDenseFloat64 = BaseQKVDense_fallback_Float64
 }
+
+func init() {
+	hwy.RegisterKernel("nn.QKVDenseFloat16", &QKVDenseFloat16)
+	hwy.RegisterKernel("nn.QKVDenseBFloat16", &QKVDenseBFloat16)
+	hwy.RegisterKernel("nn.QKVDenseFloat32", &QKVDenseFloat32)
+	hwy.RegisterKernel("nn.QKVDenseFloat64", &QKVDenseFloat64)
+	hwyKernels := []string{"nn.QKVDenseFloat16", "nn.QKVDenseBFloat16", "nn.QKVDenseFloat32", "nn.QKVDenseFloat64"}
+	hwy.RegisterKernelTarget(hwy.DispatchAVX2, initQkvdenseAVX2, hwyKernels...)
+	hwy.RegisterKernelTarget(hwy.DispatchAVX512, initQkvdenseAVX512, hwyKernels...)
+	hwy.RegisterKernelTarget(hwy.DispatchScalar, initQkvdenseFallback, hwyKernels...)
+}
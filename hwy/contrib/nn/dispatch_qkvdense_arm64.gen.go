@@ -65,3 +65,13 @@ func initQkvdenseFallback() {
 	QKVDenseFloat32 = BaseQKVDense_fallback
 	QKVDenseFloat64 = BaseQKVDense_fallback_Float64
 }
+
+func init() {
+	hwy.RegisterKernel("nn.QKVDenseFloat16", &QKVDenseFloat16)
+	hwy.RegisterKernel("nn.QKVDenseBFloat16", &QKVDenseBFloat16)
+	hwy.RegisterKernel("nn.QKVDenseFloat32", &QKVDenseFloat32)
+	hwy.RegisterKernel("nn.QKVDenseFloat64", &QKVDenseFloat64)
+	hwyKernels := []string{"nn.QKVDenseFloat16", "nn.QKVDenseBFloat16", "nn.QKVDenseFloat32", "nn.QKVDenseFloat64"}
+	hwy.RegisterKernelTarget(hwy.DispatchNEON, initQkvdenseNEON, hwyKernels...)
+	hwy.RegisterKernelTarget(hwy.DispatchScalar, initQkvdenseFallback, hwyKernels...)
+}
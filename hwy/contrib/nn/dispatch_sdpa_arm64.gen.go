@@ -93,3 +93,17 @@ func initSdpaFallback() {
 	SDPACausalFloat32 = BaseSDPACausal_fallback
 	SDPACausalFloat64 = BaseSDPACausal_fallback_Float64
 }
+
+func init() {
+	hwy.RegisterKernel("nn.SDPAFloat16", &SDPAFloat16)
+	hwy.RegisterKernel("nn.SDPABFloat16", &SDPABFloat16)
+	hwy.RegisterKernel("nn.SDPAFloat32", &SDPAFloat32)
+	hwy.RegisterKernel("nn.SDPAFloat64", &SDPAFloat64)
+	hwy.RegisterKernel("nn.SDPACausalFloat16", &SDPACausalFloat16)
+	hwy.RegisterKernel("nn.SDPACausalBFloat16", &SDPACausalBFloat16)
+	hwy.RegisterKernel("nn.SDPACausalFloat32", &SDPACausalFloat32)
+	hwy.RegisterKernel("nn.SDPACausalFloat64", &SDPACausalFloat64)
+	hwyKernels := []string{"nn.SDPAFloat16", "nn.SDPABFloat16", "nn.SDPAFloat32", "nn.SDPAFloat64", "nn.SDPACausalFloat16", "nn.SDPACausalBFloat16", "nn.SDPACausalFloat32", "nn.SDPACausalFloat64"}
+	hwy.RegisterKernelTarget(hwy.DispatchNEON, initSdpaNEON, hwyKernels...)
+	hwy.RegisterKernelTarget(hwy.DispatchScalar, initSdpaFallback, hwyKernels...)
+}
@@ -179,3 +179,32 @@ func initSoftmaxFallback() {
 	SoftmaxWithTemperatureFloat32 = BaseSoftmaxWithTemperature_fallback
 	SoftmaxWithTemperatureFloat64 = BaseSoftmaxWithTemperature_fallback_Float64
 }
+
+func init() {
+	hwy.RegisterKernel("nn.SoftmaxFloat16", &SoftmaxFloat16)
+	hwy.RegisterKernel("nn.SoftmaxBFloat16", &SoftmaxBFloat16)
+	hwy.RegisterKernel("nn.SoftmaxFloat32", &SoftmaxFloat32)
+	hwy.RegisterKernel("nn.SoftmaxFloat64", &SoftmaxFloat64)
+	hwy.RegisterKernel("nn.SoftmaxInPlaceFloat16", &SoftmaxInPlaceFloat16)
+	hwy.RegisterKernel("nn.SoftmaxInPlaceBFloat16", &SoftmaxInPlaceBFloat16)
+	hwy.RegisterKernel("nn.SoftmaxInPlaceFloat32", &SoftmaxInPlaceFloat32)
+	hwy.RegisterKernel("nn.SoftmaxInPlaceFloat64", &SoftmaxInPlaceFloat64)
+	hwy.RegisterKernel("nn.LogSoftmaxFloat16", &LogSoftmaxFloat16)
+	hwy.RegisterKernel("nn.LogSoftmaxBFloat16", &LogSoftmaxBFloat16)
+	hwy.RegisterKernel("nn.LogSoftmaxFloat32", &LogSoftmaxFloat32)
+	hwy.RegisterKernel("nn.LogSoftmaxFloat64", &LogSoftmaxFloat64)
+	hwy.RegisterKernel("nn.LogSoftmaxInPlaceFloat16", &LogSoftmaxInPlaceFloat16)
+	hwy.RegisterKernel("nn.LogSoftmaxInPlaceBFloat16", &LogSoftmaxInPlaceBFloat16)
+	hwy.RegisterKernel("nn.LogSoftmaxInPlaceFloat32", &LogSoftmaxInPlaceFloat32)
+	hwy.RegisterKernel("nn.LogSoftmaxInPlaceFloat64", &LogSoftmaxInPlaceFloat64)
+	hwy.RegisterKernel("nn.SoftmaxScalarFloat16", &SoftmaxScalarFloat16)
+	hwy.RegisterKernel("nn.SoftmaxScalarBFloat16", &SoftmaxScalarBFloat16)
+	hwy.RegisterKernel("nn.SoftmaxScalarFloat32", &SoftmaxScalarFloat32)
+	hwy.RegisterKernel("nn.SoftmaxScalarFloat64", &SoftmaxScalarFloat64)
+	hwy.RegisterKernel("nn.SoftmaxWithTemperatureFloat16", &SoftmaxWithTemperatureFloat16)
+	hwy.RegisterKernel("nn.SoftmaxWithTemperatureBFloat16", &SoftmaxWithTemperatureBFloat16)
+	hwy.RegisterKernel("nn.SoftmaxWithTemperatureFloat32", &SoftmaxWithTemperatureFloat32)
+	hwy.RegisterKernel("nn.SoftmaxWithTemperatureFloat64", &SoftmaxWithTemperatureFloat64)
+	hwyKernels := []string{"nn.SoftmaxFloat16", "nn.SoftmaxBFloat16", "nn.SoftmaxFloat32", "nn.SoftmaxFloat64", "nn.SoftmaxInPlaceFloat16", "nn.SoftmaxInPlaceBFloat16", "nn.SoftmaxInPlaceFloat32", "nn.SoftmaxInPlaceFloat64", "nn.LogSoftmaxFloat16", "nn.LogSoftmaxBFloat16", "nn.LogSoftmaxFloat32", "nn.LogSoftmaxFloat64", "nn.LogSoftmaxInPlaceFloat16", "nn.LogSoftmaxInPlaceBFloat16", "nn.LogSoftmaxInPlaceFloat32", "nn.LogSoftmaxInPlaceFloat64", "nn.SoftmaxScalarFloat16", "nn.SoftmaxScalarBFloat16", "nn.SoftmaxScalarFloat32", "nn.SoftmaxScalarFloat64", "nn.SoftmaxWithTemperatureFloat16", "nn.SoftmaxWithTemperatureBFloat16", "nn.SoftmaxWithTemperatureFloat32", "nn.SoftmaxWithTemperatureFloat64"}
+	hwy.RegisterKernelTarget(hwy.DispatchScalar, initSoftmaxFallback, hwyKernels...)
+}
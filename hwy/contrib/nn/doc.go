@@ -21,6 +21,7 @@
 //   - Softmax - Softmax normalization over a slice
 //   - LogSoftmax - Log of softmax (more numerically stable for NLL loss)
 //   - LayerNorm - Layer normalization with optional affine transform
+//   - RMSNorm / RMSNormBatch - Root mean square normalization (LLaMA-style)
 //
 // Dense (fully-connected) layer operations:
 //   - Dense - SIMD dot-product based dense layer (hwygen dispatch)
@@ -36,10 +37,21 @@
 //   - SDPACausal - Causal variant with lower-triangular mask
 //   - SDPAAuto / SDPACausalAuto - Auto-dispatched with internal scratch buffer
 //   - MultiHeadSDPAAuto - Multi-head attention with GQA (grouped-query) support
+//   - BlockSparseSDPAAuto - Attention computed only on listed (query, key) block tiles
+//   - QuantizedSoftmax - Scale + mask + softmax of int32 scores from an int8 Q@K^T
+//   - QuantizedSoftmaxInt8 - Same, producing int8 probabilities (scale 1/127)
+//
+// KV cache layout conversion:
+//   - KVToHeadMajor / KVFromHeadMajor - [seq, heads, dim] <-> [heads, dim, seq]
+//   - PagedKVToHeadMajor / PagedKVFromHeadMajor - Same, for caches stored in
+//     pages addressed by a block table
+//
+// Sequence decoding:
+//   - Viterbi - Max-sum decoding of linear-chain CRFs and HMMs
+//   - MonotonicAlignmentSearch - Text-to-frame alignment of Glow-TTS/VITS
 //
 // Future operations (planned):
 //   - BatchNorm - Batch normalization
-//   - RMSNorm - Root mean square normalization
 //
 // # Example Usage
 //
@@ -0,0 +1,135 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nn
+
+import (
+	"github.com/ajroetker/go-highway/hwy"
+	"github.com/ajroetker/go-highway/hwy/contrib/matmul"
+)
+
+// KV cache layout conversion.
+//
+// The token-major layout [seq, heads, dim] appends one token at a time and
+// is what SDPA consumes; the head-major layout [heads, dim, seq] keeps each
+// head's keys as contiguous dim×seq panels, as other runtimes store K for
+// Q@K^T. Viewing [seq, heads, dim] as a seq×(heads*dim) matrix, one layout
+// is the transpose of the other, so the conversions run the tiled SIMD
+// transpose of the matmul package.
+
+// KVToHeadMajor converts a [seq, heads, dim] KV cache to [heads, dim, seq].
+func KVToHeadMajor[T hwy.Floats](src []T, seq, heads, dim int, dst []T) {
+	n := seq * heads * dim
+	if len(src) < n {
+		panic("kvlayout: src slice too short")
+	}
+	if len(dst) < n {
+		panic("kvlayout: dst slice too short")
+	}
+	if n == 0 {
+		return
+	}
+	matmul.Transpose2D(src, seq, heads*dim, dst)
+}
+
+// KVFromHeadMajor converts a [heads, dim, seq] KV cache to [seq, heads, dim].
+func KVFromHeadMajor[T hwy.Floats](src []T, seq, heads, dim int, dst []T) {
+	n := seq * heads * dim
+	if len(src) < n {
+		panic("kvlayout: src slice too short")
+	}
+	if len(dst) < n {
+		panic("kvlayout: dst slice too short")
+	}
+	if n == 0 {
+		return
+	}
+	matmul.Transpose2D(src, heads*dim, seq, dst)
+}
+
+// PagedKVToHeadMajor gathers the first seq tokens of a paged KV cache into a
+// [heads, dim, seq] tensor.
+//
+//   - pages: [numPages, pageSize, heads, dim] physical pages
+//   - blockTable: physical page of each logical page of pageSize tokens;
+//     token t is at row t%pageSize of page blockTable[t/pageSize]
+//   - dst: [heads, dim, seq]
+//
+// Each page is transposed straight into its columns of dst.
+func PagedKVToHeadMajor[T hwy.Floats](pages []T, blockTable []int32, pageSize, seq, heads, dim int, dst []T) {
+	rowLen := heads * dim
+	pageLen := pageSize * rowLen
+	checkPagedKV(len(pages), blockTable, pageSize, seq, pageLen)
+	if len(dst) < seq*rowLen {
+		panic("kvlayout: dst slice too short")
+	}
+	if seq == 0 || rowLen == 0 {
+		return
+	}
+	for p := range (seq + pageSize - 1) / pageSize {
+		t0 := p * pageSize
+		rows := min(pageSize, seq-t0)
+		page := pages[int(blockTable[p])*pageLen:][:rows*rowLen]
+		matmul.Transpose2DStrided(page, 0, rows, rowLen, seq, dst[t0:])
+	}
+}
+
+// PagedKVFromHeadMajor scatters a [heads, dim, seq] tensor into the pages of
+// a paged KV cache laid out as described for PagedKVToHeadMajor. Pages past
+// the last token and the rows past seq in the last page are left unchanged.
+//
+// The columns of each page are packed into a page-sized scratch buffer and
+// transposed from there.
+func PagedKVFromHeadMajor[T hwy.Floats](src []T, blockTable []int32, pageSize, seq, heads, dim int, pages []T) {
+	rowLen := heads * dim
+	pageLen := pageSize * rowLen
+	checkPagedKV(len(pages), blockTable, pageSize, seq, pageLen)
+	if len(src) < seq*rowLen {
+		panic("kvlayout: src slice too short")
+	}
+	if seq == 0 || rowLen == 0 {
+		return
+	}
+	packed := make([]T, pageLen)
+	for p := range (seq + pageSize - 1) / pageSize {
+		t0 := p * pageSize
+		rows := min(pageSize, seq-t0)
+		for r := range rowLen {
+			copy(packed[r*rows:(r+1)*rows], src[r*seq+t0:])
+		}
+		page := pages[int(blockTable[p])*pageLen:][:rows*rowLen]
+		matmul.Transpose2D(packed[:rows*rowLen], rowLen, rows, page)
+	}
+}
+
+// checkPagedKV validates the block table of a paged KV cache holding seq
+// tokens in pages of pageLen elements.
+func checkPagedKV(numElems int, blockTable []int32, pageSize, seq, pageLen int) {
+	if pageSize <= 0 {
+		panic("kvlayout: page size must be positive")
+	}
+	numLogical := (seq + pageSize - 1) / pageSize
+	if len(blockTable) < numLogical {
+		panic("kvlayout: block table too short")
+	}
+	if pageLen == 0 {
+		return
+	}
+	numPages := numElems / pageLen
+	for _, b := range blockTable[:numLogical] {
+		if b < 0 || int(b) >= numPages {
+			panic("kvlayout: block table entry out of range")
+		}
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nn
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestKVLayout(t *testing.T) {
+	for _, tc := range []struct{ seq, heads, dim int }{
+		{1, 1, 1}, {5, 2, 3}, {17, 4, 8}, {64, 8, 64}, {33, 3, 40},
+	} {
+		t.Run(fmt.Sprintf("%dx%dx%d", tc.seq, tc.heads, tc.dim), func(t *testing.T) {
+			n := tc.seq * tc.heads * tc.dim
+			src := make([]float32, n)
+			for i := range src {
+				src[i] = float32(i)
+			}
+			headMajor := make([]float32, n)
+			KVToHeadMajor(src, tc.seq, tc.heads, tc.dim, headMajor)
+			for s := range tc.seq {
+				for h := range tc.heads {
+					for d := range tc.dim {
+						got := headMajor[(h*tc.dim+d)*tc.seq+s]
+						if want := src[(s*tc.heads+h)*tc.dim+d]; got != want {
+							t.Fatalf("[%d,%d,%d] = %v, want %v", h, d, s, got, want)
+						}
+					}
+				}
+			}
+
+			back := make([]float32, n)
+			KVFromHeadMajor(headMajor, tc.seq, tc.heads, tc.dim, back)
+			for i := range src {
+				if back[i] != src[i] {
+					t.Fatalf("round trip [%d] = %v, want %v", i, back[i], src[i])
+				}
+			}
+		})
+	}
+}
+
+func TestPagedKVLayout(t *testing.T) {
+	const (
+		heads    = 2
+		dim      = 24
+		pageSize = 16
+		numPages = 6
+	)
+	rowLen := heads * dim
+	blockTable := []int32{4, 0, 5, 2}
+	for _, seq := range []int{0, 1, 15, 16, 37, 64} {
+		t.Run(fmt.Sprint(seq), func(t *testing.T) {
+			// Token-major reference and the same tokens scattered in pages.
+			tokens := make([]float32, seq*rowLen)
+			pages := make([]float32, numPages*pageSize*rowLen)
+			for i := range tokens {
+				tokens[i] = float32(i + 1)
+				s, r := i/rowLen, i%rowLen
+				pages[(int(blockTable[s/pageSize])*pageSize+s%pageSize)*rowLen+r] = tokens[i]
+			}
+
+			want := make([]float32, seq*rowLen)
+			KVToHeadMajor(tokens, seq, heads, dim, want)
+			got := make([]float32, seq*rowLen)
+			PagedKVToHeadMajor(pages, blockTable, pageSize, seq, heads, dim, got)
+			for i := range want {
+				if got[i] != want[i] {
+					t.Fatalf("PagedKVToHeadMajor[%d] = %v, want %v", i, got[i], want[i])
+				}
+			}
+
+			scattered := make([]float32, len(pages))
+			for i := range scattered {
+				scattered[i] = -1
+			}
+			PagedKVFromHeadMajor(want, blockTable, pageSize, seq, heads, dim, scattered)
+			for i := range pages {
+				wantPage := pages[i]
+				page, row := i/(pageSize*rowLen), i/rowLen%pageSize
+				logical := -1
+				for p, b := range blockTable {
+					if int(b) == page {
+						logical = p
+					}
+				}
+				if logical < 0 || logical*pageSize+row >= seq {
+					wantPage = -1 // untouched
+				}
+				if scattered[i] != wantPage {
+					t.Fatalf("PagedKVFromHeadMajor page %d row %d = %v, want %v", page, row, scattered[i], wantPage)
+				}
+			}
+		})
+	}
+}
@@ -208,6 +208,46 @@ func BaseLayerNorm_fallback(input []float32, output []float32, normSize int, gam
 		vInvStd := float32(invStd)
 		if gamma != nil && beta != nil {
 			ii = 0
+			for ; ii+4 <= normSize; ii += 4 {
+				gamma4 := gamma[ii : ii+4 : ii+4]
+				beta4 := beta[ii : ii+4 : ii+4]
+				{
+					x := input[off+ii]
+					diff := x - vMean
+					normed := diff * vInvStd
+					g := gamma4[0]
+					b := beta4[0]
+					result := normed*g + b
+					output[off+ii] = result
+				}
+				{
+					x := input[off+(ii+1)]
+					diff := x - vMean
+					normed := diff * vInvStd
+					g := gamma4[1]
+					b := beta4[1]
+					result := normed*g + b
+					output[off+(ii+1)] = result
+				}
+				{
+					x := input[off+(ii+2)]
+					diff := x - vMean
+					normed := diff * vInvStd
+					g := gamma4[2]
+					b := beta4[2]
+					result := normed*g + b
+					output[off+(ii+2)] = result
+				}
+				{
+					x := input[off+(ii+3)]
+					diff := x - vMean
+					normed := diff * vInvStd
+					g := gamma4[3]
+					b := beta4[3]
+					result := normed*g + b
+					output[off+(ii+3)] = result
+				}
+			}
 			for ; ii < normSize; ii++ {
 				x := input[off+ii]
 				diff := x - vMean
@@ -288,6 +328,46 @@ func BaseLayerNorm_fallback_Float64(input []float64, output []float64, normSize
 		vInvStd := float64(invStd)
 		if gamma != nil && beta != nil {
 			ii = 0
+			for ; ii+4 <= normSize; ii += 4 {
+				gamma4 := gamma[ii : ii+4 : ii+4]
+				beta4 := beta[ii : ii+4 : ii+4]
+				{
+					x := input[off+ii]
+					diff := x - vMean
+					normed := diff * vInvStd
+					g := gamma4[0]
+					b := beta4[0]
+					result := normed*g + b
+					output[off+ii] = result
+				}
+				{
+					x := input[off+(ii+1)]
+					diff := x - vMean
+					normed := diff * vInvStd
+					g := gamma4[1]
+					b := beta4[1]
+					result := normed*g + b
+					output[off+(ii+1)] = result
+				}
+				{
+					x := input[off+(ii+2)]
+					diff := x - vMean
+					normed := diff * vInvStd
+					g := gamma4[2]
+					b := beta4[2]
+					result := normed*g + b
+					output[off+(ii+2)] = result
+				}
+				{
+					x := input[off+(ii+3)]
+					diff := x - vMean
+					normed := diff * vInvStd
+					g := gamma4[3]
+					b := beta4[3]
+					result := normed*g + b
+					output[off+(ii+3)] = result
+				}
+			}
 			for ; ii < normSize; ii++ {
 				x := input[off+ii]
 				diff := x - vMean
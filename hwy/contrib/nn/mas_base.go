@@ -0,0 +1,90 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nn
+
+import "github.com/ajroetker/go-highway/hwy"
+
+//go:generate go run ../../../cmd/hwygen -input mas_base.go -output . -targets avx2,avx512,neon,fallback
+
+// BaseMonotonicAlignmentSearch finds the monotonic alignment of text tokens
+// to spectrogram frames with the highest total log-likelihood, as in the
+// training of Glow-TTS and VITS:
+//
+//   - value: [melLen, textLen] log-likelihood of frame y given token x
+//     (row-major), overwritten with the cumulative scores
+//   - path: [melLen] receives the token aligned with each frame
+//
+// The alignment assigns every frame one token and every token at least one
+// frame: path starts at 0, ends at textLen-1 and grows by 0 or 1 per frame,
+// so melLen must be at least textLen. On ties the earlier frames stay on
+// the later token.
+//
+// Frame y only depends on frame y-1, so each row of the dynamic program
+// is one vectorized pass: value[y, x] += max(value[y-1, x-1], value[y-1, x]).
+func BaseMonotonicAlignmentSearch(value []float32, path []int32, melLen, textLen int) {
+	if melLen == 0 || textLen == 0 {
+		return
+	}
+	if textLen > melLen {
+		panic("mas: more text tokens than frames")
+	}
+	if len(value) < melLen*textLen {
+		panic("mas: value slice too short")
+	}
+	if len(path) < melLen {
+		panic("mas: path slice too short")
+	}
+
+	lanes := hwy.Zero[float32]().NumLanes()
+	for y := 1; y < melLen; y++ {
+		prev := value[(y-1)*textLen : y*textLen]
+		cur := value[y*textLen : (y+1)*textLen]
+
+		// Token x is reachable at frame y for x in [lo, hi): early enough to
+		// be reached one token per frame, late enough to still reach the
+		// last token by the last frame.
+		lo := max(0, textLen+y-melLen)
+		hi := min(textLen, y+1)
+		x := lo
+		if x == 0 {
+			// The first token can only be stayed on.
+			cur[0] += prev[0]
+			x = 1
+		}
+		// The diagonal token x == y can only be advanced to.
+		end := hi
+		if hi == y+1 {
+			end = y
+		}
+		for ; x+lanes <= end; x += lanes {
+			best := hwy.Max(hwy.Load(prev[x-1:]), hwy.Load(prev[x:]))
+			hwy.Store(hwy.Add(hwy.Load(cur[x:]), best), cur[x:])
+		}
+		for ; x < end; x++ {
+			cur[x] += max(prev[x-1], prev[x])
+		}
+		if end < hi {
+			cur[y] += prev[y-1]
+		}
+	}
+
+	index := textLen - 1
+	for y := melLen - 1; y >= 0; y-- {
+		path[y] = int32(index)
+		if index != 0 && (index == y || value[(y-1)*textLen+index] < value[(y-1)*textLen+index-1]) {
+			index--
+		}
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nn
+
+import (
+	"math/rand/v2"
+	"testing"
+)
+
+// bestAlignmentScore enumerates every monotonic alignment of textLen tokens
+// to melLen frames and returns the highest total score.
+func bestAlignmentScore(value []float64, melLen, textLen int) float64 {
+	var rec func(y, x int) float64
+	rec = func(y, x int) float64 {
+		s := value[y*textLen+x]
+		if y == melLen-1 {
+			if x != textLen-1 {
+				return -1e300
+			}
+			return s
+		}
+		best := rec(y+1, x)
+		if x+1 < textLen {
+			best = max(best, rec(y+1, x+1))
+		}
+		return s + best
+	}
+	return rec(0, 0)
+}
+
+func TestMonotonicAlignmentSearch(t *testing.T) {
+	rng := rand.New(rand.NewPCG(9, 10))
+	for _, tc := range []struct{ melLen, textLen int }{
+		{1, 1}, {5, 1}, {4, 4}, {7, 3}, {12, 5}, {16, 9}, {20, 13}, {14, 14},
+	} {
+		value := make([]float32, tc.melLen*tc.textLen)
+		ref := make([]float64, len(value))
+		for i := range value {
+			value[i] = float32(rng.NormFloat64())
+			ref[i] = float64(value[i])
+		}
+		path := make([]int32, tc.melLen)
+		MonotonicAlignmentSearch(value, path, tc.melLen, tc.textLen)
+
+		if path[0] != 0 || path[tc.melLen-1] != int32(tc.textLen-1) {
+			t.Fatalf("%dx%d: path %v does not span all tokens", tc.melLen, tc.textLen, path)
+		}
+		score := ref[0]
+		for y := 1; y < tc.melLen; y++ {
+			if d := path[y] - path[y-1]; d != 0 && d != 1 {
+				t.Fatalf("%dx%d: path %v is not monotonic", tc.melLen, tc.textLen, path)
+			}
+			score += ref[y*tc.textLen+int(path[y])]
+		}
+		if want := bestAlignmentScore(ref, tc.melLen, tc.textLen); score < want-1e-4 {
+			t.Errorf("%dx%d: path %v scores %v, best alignment scores %v", tc.melLen, tc.textLen, path, score, want)
+		}
+	}
+}
+
+func TestMonotonicAlignmentSearchPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("expected panic for more tokens than frames")
+		}
+	}()
+	MonotonicAlignmentSearch(make([]float32, 6), make([]int32, 2), 2, 3)
+}
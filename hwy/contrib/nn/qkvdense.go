@@ -65,9 +65,11 @@ func QKVDenseAuto[T hwy.Floats](
 		return
 	}
 
-	// Get temp buffer from pool
-	temp := getTempSlice[T](batchSize * totalOut)
-	defer putTempSlice(temp)
+	// Get temp buffer from the scratch pool
+	arenas := scratchPool[T]()
+	scratch := arenas.Get()
+	defer arenas.Put(scratch)
+	temp := scratch.Get(batchSize * totalOut)
 
 	// Fused matmul: temp = x @ wQKV^T
 	matmul.MatMulKLastAuto(pool, x, wQKV, temp, batchSize, totalOut, inFeatures)
@@ -158,6 +160,31 @@ var tempPoolF64 = sync.Pool{
 	New: func() any { return &[]float64{} },
 }
 
+// Scratch arenas for the *Auto functions, one pool per element type.
+var (
+	scratchPoolF16  hwy.ScratchPool[hwy.Float16]
+	scratchPoolBF16 hwy.ScratchPool[hwy.BFloat16]
+	scratchPoolF32  hwy.ScratchPool[float32]
+	scratchPoolF64  hwy.ScratchPool[float64]
+)
+
+// scratchPool returns the shared scratch arena pool for T.
+func scratchPool[T hwy.Floats]() *hwy.ScratchPool[T] {
+	var zero T
+	switch any(zero).(type) {
+	case hwy.Float16:
+		return any(&scratchPoolF16).(*hwy.ScratchPool[T])
+	case hwy.BFloat16:
+		return any(&scratchPoolBF16).(*hwy.ScratchPool[T])
+	case float32:
+		return any(&scratchPoolF32).(*hwy.ScratchPool[T])
+	case float64:
+		return any(&scratchPoolF64).(*hwy.ScratchPool[T])
+	default:
+		return &hwy.ScratchPool[T]{}
+	}
+}
+
 // getTempSlice gets a temporary slice of at least the given size from a pool.
 func getTempSlice[T hwy.Floats](size int) []T {
 	var zero T
@@ -165,14 +192,14 @@ func getTempSlice[T hwy.Floats](size int) []T {
 	case float32:
 		p := tempPoolF32.Get().(*[]float32)
 		if cap(*p) < size {
-			*p = make([]float32, size)
+			*p = hwy.AllocAligned[float32](size)
 		}
 		*p = (*p)[:size]
 		return any(*p).([]T)
 	case float64:
 		p := tempPoolF64.Get().(*[]float64)
 		if cap(*p) < size {
-			*p = make([]float64, size)
+			*p = hwy.AllocAligned[float64](size)
 		}
 		*p = (*p)[:size]
 		return any(*p).([]T)
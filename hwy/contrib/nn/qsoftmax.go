@@ -0,0 +1,59 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nn
+
+import (
+	"unsafe"
+
+	"github.com/ajroetker/go-highway/hwy"
+)
+
+// QuantizedSoftmaxInt8Scale is the dequantization scale of the probabilities
+// produced by QuantizedSoftmaxInt8: a probability p is stored as
+// round(p / QuantizedSoftmaxInt8Scale), so 1.0 maps to 127.
+const QuantizedSoftmaxInt8Scale = 1.0 / 127
+
+// QuantizedSoftmaxInt8 is QuantizedSoftmax with int8 output, for feeding the
+// attention probabilities straight into an int8 probs@V product. Each
+// probability is rounded to the nearest multiple of QuantizedSoftmaxInt8Scale
+// and lies in [0, 127]. Only a single row of float32 scratch is allocated.
+func QuantizedSoftmaxInt8(scores []int32, mask []float32, probs []int8, rows, cols int, scale float32) {
+	if rows == 0 || cols == 0 {
+		return
+	}
+	if len(probs) < rows*cols {
+		panic("qsoftmax: probs slice too short")
+	}
+	if len(scores) < rows*cols {
+		panic("qsoftmax: scores slice too short")
+	}
+	if mask != nil && len(mask) < rows*cols {
+		panic("qsoftmax: mask slice too short")
+	}
+
+	row := make([]float32, cols)
+	out := unsafe.Slice((*uint8)(unsafe.Pointer(&probs[0])), rows*cols)
+	for r := range rows {
+		off := r * cols
+		var mRow []float32
+		if mask != nil {
+			mRow = mask[off : off+cols]
+		}
+		QuantizedSoftmax(scores[off:off+cols], mRow, row, 1, cols, scale)
+		// Probabilities are in [0, 1], so the uint8 result is below 128 and
+		// has the same bits as the int8 one.
+		hwy.ConvertScaleF32ToU8(out[off:off+cols], row, 1/QuantizedSoftmaxInt8Scale)
+	}
+}
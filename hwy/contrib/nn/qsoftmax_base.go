@@ -0,0 +1,117 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nn
+
+import (
+	stdmath "math"
+
+	"github.com/ajroetker/go-highway/hwy"
+	"github.com/ajroetker/go-highway/hwy/contrib/math"
+)
+
+//go:generate go run ../../../cmd/hwygen -input qsoftmax_base.go -output . -targets avx2,avx512,neon,fallback
+
+// BaseQuantizedSoftmax computes row-wise attention probabilities directly
+// from the int32 accumulators of an int8 Q@K^T product, fusing the
+// dequantization scale, the additive mask and the softmax:
+//
+//	probs[i, j] = softmax_j(float32(scores[i, j]) * scale + mask[i, j])
+//
+// where:
+//   - scores: [rows, cols] int32 attention scores (row-major)
+//   - mask:   [rows, cols] additive mask (-Inf excludes a key), nil for no mask
+//   - probs:  [rows, cols] output probabilities
+//   - scale:  product of the query and key quantization scales, typically
+//     also multiplied by 1/sqrt(headDim)
+//
+// A row whose entries are all masked with -Inf produces all zeros.
+func BaseQuantizedSoftmax(scores []int32, mask, probs []float32, rows, cols int, scale float32) {
+	if rows == 0 || cols == 0 {
+		return
+	}
+	if len(scores) < rows*cols {
+		panic("qsoftmax: scores slice too short")
+	}
+	if mask != nil && len(mask) < rows*cols {
+		panic("qsoftmax: mask slice too short")
+	}
+	if len(probs) < rows*cols {
+		panic("qsoftmax: probs slice too short")
+	}
+
+	vScale := hwy.Set[float32](scale)
+	lanes := hwy.Zero[float32]().NumLanes()
+	negInf := float32(stdmath.Inf(-1))
+
+	for r := range rows {
+		off := r * cols
+		end := off + cols
+		tail := end - cols%lanes
+
+		// Pass 1: dequantize, add the mask and track the row maximum.
+		vMax := hwy.Set[float32](negInf)
+		var i int
+		if mask != nil {
+			for i = off; i+lanes <= end; i += lanes {
+				x := hwy.MulAdd(hwy.ConvertToFloat32(hwy.Load(scores[i:])), vScale, hwy.Load(mask[i:]))
+				hwy.Store(x, probs[i:])
+				vMax = hwy.Max(vMax, x)
+			}
+			for ; i < end; i++ {
+				probs[i] = float32(scores[i])*scale + mask[i]
+			}
+		} else {
+			for i = off; i+lanes <= end; i += lanes {
+				x := hwy.Mul(hwy.ConvertToFloat32(hwy.Load(scores[i:])), vScale)
+				hwy.Store(x, probs[i:])
+				vMax = hwy.Max(vMax, x)
+			}
+			for ; i < end; i++ {
+				probs[i] = float32(scores[i]) * scale
+			}
+		}
+		maxVal := hwy.ReduceMax(vMax)
+		for j := tail; j < end; j++ {
+			maxVal = max(maxVal, probs[j])
+		}
+		if maxVal == negInf {
+			clear(probs[off:end])
+			continue
+		}
+
+		// Pass 2: exponentiate relative to the maximum and sum.
+		vMaxVal := hwy.Set[float32](maxVal)
+		vSum := hwy.Zero[float32]()
+		for i = off; i+lanes <= end; i += lanes {
+			e := math.BaseExpVec(hwy.Sub(hwy.Load(probs[i:]), vMaxVal))
+			hwy.Store(e, probs[i:])
+			vSum = hwy.Add(vSum, e)
+		}
+		sum := hwy.ReduceSum(vSum)
+		for ; i < end; i++ {
+			probs[i] = float32(stdmath.Exp(float64(probs[i] - maxVal)))
+			sum += probs[i]
+		}
+
+		// Pass 3: normalize.
+		vInv := hwy.Set[float32](1 / sum)
+		for i = off; i+lanes <= end; i += lanes {
+			hwy.Store(hwy.Mul(hwy.Load(probs[i:]), vInv), probs[i:])
+		}
+		for ; i < end; i++ {
+			probs[i] /= sum
+		}
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nn
+
+import (
+	"fmt"
+	stdmath "math"
+	"testing"
+)
+
+// quantizedSoftmaxRef computes QuantizedSoftmax in float64.
+func quantizedSoftmaxRef(scores []int32, mask []float32, rows, cols int, scale float32) []float64 {
+	out := make([]float64, rows*cols)
+	for r := range rows {
+		row := out[r*cols : (r+1)*cols]
+		maxVal := stdmath.Inf(-1)
+		for j := range row {
+			row[j] = float64(scores[r*cols+j]) * float64(scale)
+			if mask != nil {
+				row[j] += float64(mask[r*cols+j])
+			}
+			maxVal = max(maxVal, row[j])
+		}
+		if stdmath.IsInf(maxVal, -1) {
+			clear(row)
+			continue
+		}
+		var sum float64
+		for j := range row {
+			row[j] = stdmath.Exp(row[j] - maxVal)
+			sum += row[j]
+		}
+		for j := range row {
+			row[j] /= sum
+		}
+	}
+	return out
+}
+
+func TestQuantizedSoftmax(t *testing.T) {
+	negInf := float32(stdmath.Inf(-1))
+	for _, cols := range []int{1, 3, 8, 17, 64} {
+		for _, masked := range []bool{false, true} {
+			t.Run(fmt.Sprintf("cols=%d/mask=%v", cols, masked), func(t *testing.T) {
+				const rows = 5
+				scores := make([]int32, rows*cols)
+				for i := range scores {
+					scores[i] = int32((i*7919)%20001 - 10000)
+				}
+				var mask []float32
+				if masked {
+					// Causal mask, with the last row fully masked.
+					mask = make([]float32, rows*cols)
+					for r := range rows {
+						for j := range cols {
+							if j > r || r == rows-1 {
+								mask[r*cols+j] = negInf
+							}
+						}
+					}
+				}
+				scale := float32(1.0 / 2000)
+				want := quantizedSoftmaxRef(scores, mask, rows, cols, scale)
+
+				probs := make([]float32, rows*cols)
+				QuantizedSoftmax(scores, mask, probs, rows, cols, scale)
+				for i := range probs {
+					if stdmath.Abs(float64(probs[i])-want[i]) > 1e-5 {
+						t.Fatalf("probs[%d] = %v, want %v", i, probs[i], want[i])
+					}
+				}
+
+				q := make([]int8, rows*cols)
+				QuantizedSoftmaxInt8(scores, mask, q, rows, cols, scale)
+				for i := range q {
+					got := float64(q[i]) * QuantizedSoftmaxInt8Scale
+					if q[i] < 0 || stdmath.Abs(got-want[i]) > 0.5*QuantizedSoftmaxInt8Scale+1e-5 {
+						t.Fatalf("int8 probs[%d] = %d, want %v", i, q[i], want[i]/QuantizedSoftmaxInt8Scale)
+					}
+				}
+			})
+		}
+	}
+}
+
+func TestQuantizedSoftmaxPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("QuantizedSoftmax with short probs did not panic")
+		}
+	}()
+	QuantizedSoftmax(make([]int32, 8), nil, make([]float32, 7), 2, 4, 1)
+}
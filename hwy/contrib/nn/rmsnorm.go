@@ -0,0 +1,53 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nn
+
+import (
+	stdmath "math"
+
+	"github.com/ajroetker/go-highway/hwy"
+)
+
+// RMSNorm computes root mean square normalization of the vector x:
+//
+//	out[i] = x[i] / sqrt(mean(x^2) + eps) * weight[i]
+//
+// weight is optional (pass nil to skip the scale). For a [rows, dim]
+// batch, such as the hidden states of a sequence, use RMSNormBatch.
+func RMSNorm[T hwy.Floats](x, weight, out []T, eps T) {
+	RMSNormBatch(x, weight, out, 1, len(x), eps)
+}
+
+// RMSNormScalar is a scalar reference implementation for comparison and testing.
+func RMSNormScalar[T hwy.Floats](x, weight, out []T, rows, dim int, eps T) {
+	for r := range rows {
+		off := r * dim
+
+		var sumSq float64
+		for i := range dim {
+			v := float64(x[off+i])
+			sumSq += v * v
+		}
+		invRMS := 1.0 / stdmath.Sqrt(sumSq/float64(dim)+float64(eps))
+
+		for i := range dim {
+			normed := float64(x[off+i]) * invRMS
+			if weight != nil {
+				normed *= float64(weight[i])
+			}
+			out[off+i] = T(normed)
+		}
+	}
+}
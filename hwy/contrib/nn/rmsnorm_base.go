@@ -0,0 +1,92 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nn
+
+import (
+	stdmath "math"
+
+	"github.com/ajroetker/go-highway/hwy"
+)
+
+//go:generate go run ../../../cmd/hwygen -input rmsnorm_base.go -output . -targets avx2,avx512,neon,fallback -dispatch rmsnorm
+
+// BaseRMSNormBatch computes root mean square normalization of each of the
+// rows rows of dim contiguous elements in x:
+//
+//	out[i] = x[i] / sqrt(mean(x[row]^2) + eps) * weight[i%dim]
+//
+// weight is optional (pass nil to skip the scale). Unlike LayerNorm, the
+// mean is not subtracted and there is no bias, as in LLaMA-family models.
+// out may alias x.
+func BaseRMSNormBatch[T hwy.Floats](x, weight, out []T, rows, dim int, eps T) {
+	if rows == 0 || dim <= 0 {
+		return
+	}
+	if len(x) < rows*dim {
+		panic("rmsnorm: x slice too short")
+	}
+	if weight != nil && len(weight) < dim {
+		panic("rmsnorm: weight slice too short")
+	}
+	if len(out) < rows*dim {
+		panic("rmsnorm: out slice too short")
+	}
+
+	invN := T(1.0) / T(dim)
+	lanes := hwy.MaxLanes[T]()
+
+	for r := range rows {
+		off := r * dim
+
+		// Pass 1: Sum of squares using SIMD multiply-accumulate
+		sqAcc := hwy.Zero[T]()
+		ii := 0
+		for ; ii+lanes <= dim; ii += lanes {
+			v := hwy.Load(x[off+ii:])
+			sqAcc = hwy.MulAdd(v, v, sqAcc)
+		}
+		sumSq := hwy.ReduceSum(sqAcc)
+		for i := ii; i < dim; i++ {
+			sumSq += x[off+i] * x[off+i]
+		}
+
+		// Compute inverse root mean square
+		invRMS := T(1.0 / stdmath.Sqrt(float64(sumSq*invN+eps)))
+		vInvRMS := hwy.Set(invRMS)
+
+		// Pass 2: Scale and optionally apply the weight
+		if weight != nil {
+			ii = 0
+			for ; ii+lanes <= dim; ii += lanes {
+				v := hwy.Load(x[off+ii:])
+				w := hwy.Load(weight[ii:])
+				result := hwy.Mul(hwy.Mul(v, vInvRMS), w)
+				hwy.Store(result, out[off+ii:])
+			}
+			for i := ii; i < dim; i++ {
+				out[off+i] = x[off+i] * invRMS * weight[i]
+			}
+		} else {
+			ii = 0
+			for ; ii+lanes <= dim; ii += lanes {
+				v := hwy.Load(x[off+ii:])
+				hwy.Store(hwy.Mul(v, vInvRMS), out[off+ii:])
+			}
+			for i := ii; i < dim; i++ {
+				out[off+i] = x[off+i] * invRMS
+			}
+		}
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nn
+
+import (
+	"fmt"
+	stdmath "math"
+	"testing"
+)
+
+func TestRMSNormBatch(t *testing.T) {
+	for _, dim := range []int{1, 4, 7, 16, 64, 257} {
+		for _, useWeight := range []bool{false, true} {
+			t.Run(fmt.Sprintf("dim=%d/weight=%v", dim, useWeight), func(t *testing.T) {
+				const rows = 3
+				x := make([]float32, rows*dim)
+				for i := range x {
+					x[i] = float32(i%13)*0.3 - 1.7
+				}
+				var weight []float32
+				if useWeight {
+					weight = make([]float32, dim)
+					for i := range weight {
+						weight[i] = 1 + float32(i)*0.01
+					}
+				}
+
+				want := make([]float32, rows*dim)
+				RMSNormScalar(x, weight, want, rows, dim, 1e-6)
+				got := make([]float32, rows*dim)
+				RMSNormBatch(x, weight, got, rows, dim, 1e-6)
+				for i := range got {
+					if stdmath.Abs(float64(got[i]-want[i])) > 1e-5 {
+						t.Fatalf("out[%d] = %v, want %v", i, got[i], want[i])
+					}
+				}
+
+				// In place.
+				RMSNormBatch(x, weight, x, rows, dim, 1e-6)
+				for i := range x {
+					if x[i] != got[i] {
+						t.Fatalf("in-place out[%d] = %v, want %v", i, x[i], got[i])
+					}
+				}
+			})
+		}
+	}
+}
+
+func TestRMSNorm(t *testing.T) {
+	x := []float64{3, -4, 0, 0}
+	out := make([]float64, len(x))
+	RMSNorm(x, nil, out, 0)
+	// RMS = sqrt(25/4) = 2.5.
+	want := []float64{1.2, -1.6, 0, 0}
+	for i := range out {
+		if stdmath.Abs(out[i]-want[i]) > 1e-12 {
+			t.Errorf("out[%d] = %v, want %v", i, out[i], want[i])
+		}
+	}
+
+	// All zeros stay zero thanks to eps.
+	zeros := make([]float32, 8)
+	RMSNorm(zeros, nil, zeros, 1e-6)
+	for i, v := range zeros {
+		if v != 0 {
+			t.Errorf("zeros[%d] = %v, want 0", i, v)
+		}
+	}
+}
+
+func TestRMSNormPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("RMSNormBatch with short out did not panic")
+		}
+	}()
+	RMSNormBatch(make([]float32, 8), nil, make([]float32, 7), 2, 4, 1e-6)
+}
@@ -29,13 +29,16 @@ import (
 //   - output: [seqLen, headDim] (result)
 //   - scale:  typically 1/sqrt(headDim)
 //
-// This allocates a scratch buffer for attention scores internally.
+// The attention scores live in a pooled, aligned scratch arena, so repeated
+// calls do not allocate.
 func SDPAAuto[T hwy.Floats](
 	q, k, v, mask, output []T,
 	seqLen, kvLen, headDim int, scale T,
 ) {
-	scores := getTempSlice[T](seqLen * kvLen)
-	defer putTempSlice(scores)
+	arenas := scratchPool[T]()
+	scratch := arenas.Get()
+	defer arenas.Put(scratch)
+	scores := scratch.Get(seqLen * kvLen)
 
 	SDPA(q, k, v, mask, scores, output, seqLen, kvLen, headDim, scale)
 }
@@ -48,8 +51,10 @@ func SDPACausalAuto[T hwy.Floats](
 	q, k, v, output []T,
 	seqLen, kvLen, headDim int, scale T,
 ) {
-	scores := getTempSlice[T](seqLen * kvLen)
-	defer putTempSlice(scores)
+	arenas := scratchPool[T]()
+	scratch := arenas.Get()
+	defer arenas.Put(scratch)
+	scores := scratch.Get(seqLen * kvLen)
 
 	SDPACausal(q, k, v, scores, output, seqLen, kvLen, headDim, scale)
 }
@@ -182,14 +187,18 @@ func MultiHeadSDPAStridedAuto[T hwy.Floats](
 	headsPerKVHead := numHeads / numKVHeads
 	maskSliceLen := seqLen * kvLen
 	totalHeads := batchSize * numHeads
+	arenas := scratchPool[T]()
 
 	doHead := func(idx int) {
 		b := idx / numHeads
 		h := idx % numHeads
 		kvHead := h / headsPerKVHead
 
+		scratch := arenas.Get()
+		defer arenas.Put(scratch)
+
 		// Gather Q into contiguous temp buffer.
-		qTemp := getTempSlice[T](seqLen * headDim)
+		qTemp := scratch.Get(seqLen * headDim)
 		qBase := b*qBatchStride + h*qHeadStride
 		for s := range seqLen {
 			src := qBase + s*qSeqStride
@@ -197,7 +206,7 @@ func MultiHeadSDPAStridedAuto[T hwy.Floats](
 		}
 
 		// Gather K into contiguous temp buffer.
-		kTemp := getTempSlice[T](kvLen * headDim)
+		kTemp := scratch.Get(kvLen * headDim)
 		kBase := b*kvBatchStride + kvHead*kvHeadStride
 		for s := range kvLen {
 			src := kBase + s*kvSeqStride
@@ -205,7 +214,7 @@ func MultiHeadSDPAStridedAuto[T hwy.Floats](
 		}
 
 		// Gather V into contiguous temp buffer.
-		vTemp := getTempSlice[T](kvLen * headDim)
+		vTemp := scratch.Get(kvLen * headDim)
 		vBase := kBase // V uses same layout as K.
 		for s := range kvLen {
 			src := vBase + s*kvSeqStride
@@ -213,7 +222,7 @@ func MultiHeadSDPAStridedAuto[T hwy.Floats](
 		}
 
 		// Output temp buffer.
-		oTemp := getTempSlice[T](seqLen * headDim)
+		oTemp := scratch.Get(seqLen * headDim)
 
 		// Run single-head SDPA on contiguous data.
 		if causal {
@@ -235,11 +244,6 @@ func MultiHeadSDPAStridedAuto[T hwy.Floats](
 			dst := oBase + s*qSeqStride
 			copy(output[dst:dst+headDim], oTemp[s*headDim:(s+1)*headDim])
 		}
-
-		putTempSlice(qTemp)
-		putTempSlice(kTemp)
-		putTempSlice(vTemp)
-		putTempSlice(oTemp)
 	}
 
 	if pool != nil {
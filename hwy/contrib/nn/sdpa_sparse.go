@@ -0,0 +1,122 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nn
+
+import (
+	"github.com/ajroetker/go-highway/hwy"
+	"github.com/ajroetker/go-highway/hwy/contrib/vec"
+)
+
+// SDPABlock is one tile of a block-sparse attention pattern: the queries of
+// block QBlock attend to the keys of block KBlock.
+type SDPABlock struct {
+	QBlock, KBlock int
+}
+
+// BlockSparseSDPAAuto computes single-head scaled dot-product attention in
+// which each query only attends to the key blocks paired with its query
+// block, as in the local, strided and global patterns of long-document
+// models. Tiles missing from blocks are never computed.
+//
+//   - q:         [seqLen, headDim] (queries)
+//   - k:         [kvLen, headDim] (keys)
+//   - v:         [kvLen, headDim] (values)
+//   - output:    [seqLen, headDim] (result)
+//   - blocks:    the (query block, key block) tiles to compute, each at most
+//     once; block b covers positions [b*blockSize, (b+1)*blockSize)
+//   - blockSize: side of the square tiles; the last blocks may be partial
+//   - scale:     typically 1/sqrt(headDim)
+//
+// Each query row gathers its scores over its key blocks with vec.BatchDot,
+// normalizes them with SoftmaxInPlace and accumulates the values with
+// vec.MulConstAddTo. Rows of query blocks without tiles are set to zero.
+// The scores live in a pooled scratch arena.
+func BlockSparseSDPAAuto[T hwy.Floats](
+	q, k, v, output []T,
+	blocks []SDPABlock, blockSize int,
+	seqLen, kvLen, headDim int, scale T,
+) {
+	if seqLen == 0 || kvLen == 0 || headDim == 0 {
+		return
+	}
+	if blockSize <= 0 {
+		panic("sdpa: block size must be positive")
+	}
+	if len(q) < seqLen*headDim || len(output) < seqLen*headDim {
+		panic("sdpa: q or output slice too short")
+	}
+	if len(k) < kvLen*headDim || len(v) < kvLen*headDim {
+		panic("sdpa: k or v slice too short")
+	}
+	numQBlocks := (seqLen + blockSize - 1) / blockSize
+	numKBlocks := (kvLen + blockSize - 1) / blockSize
+
+	// Group the key blocks by query block with a counting sort, keeping
+	// their order in the pattern.
+	start := make([]int, numQBlocks+1)
+	for _, b := range blocks {
+		if b.QBlock < 0 || b.QBlock >= numQBlocks || b.KBlock < 0 || b.KBlock >= numKBlocks {
+			panic("sdpa: block out of range")
+		}
+		start[b.QBlock+1]++
+	}
+	maxKeys := 0
+	for qb := range numQBlocks {
+		maxKeys = max(maxKeys, start[qb+1]*blockSize)
+		start[qb+1] += start[qb]
+	}
+	kBlocks := make([]int, len(blocks))
+	next := append([]int(nil), start[:numQBlocks]...)
+	for _, b := range blocks {
+		kBlocks[next[b.QBlock]] = b.KBlock
+		next[b.QBlock]++
+	}
+
+	arenas := scratchPool[T]()
+	scratch := arenas.Get()
+	defer arenas.Put(scratch)
+	scores := scratch.Get(maxKeys)
+
+	clear(output[:seqLen*headDim])
+	for qb := range numQBlocks {
+		ks := kBlocks[start[qb]:start[qb+1]]
+		if len(ks) == 0 {
+			continue
+		}
+		for i := qb * blockSize; i < min(seqLen, (qb+1)*blockSize); i++ {
+			qRow := q[i*headDim : (i+1)*headDim]
+			n := 0
+			for _, kb := range ks {
+				j0 := kb * blockSize
+				rows := min(blockSize, kvLen-j0)
+				vec.BatchDot(qRow, k[j0*headDim:(j0+rows)*headDim], scores[n:n+rows], rows, headDim)
+				n += rows
+			}
+			s := scores[:n]
+			vec.Scale(scale, s)
+			SoftmaxInPlace(s)
+
+			out := output[i*headDim : (i+1)*headDim]
+			n = 0
+			for _, kb := range ks {
+				j0 := kb * blockSize
+				for j := j0; j < min(kvLen, j0+blockSize); j++ {
+					vec.MulConstAddTo(out, s[n], v[j*headDim:(j+1)*headDim])
+					n++
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nn
+
+import (
+	"fmt"
+	stdmath "math"
+	"math/rand/v2"
+	"testing"
+)
+
+func TestBlockSparseSDPA(t *testing.T) {
+	tests := []struct {
+		seqLen, kvLen, headDim, blockSize int
+		blocks                            []SDPABlock
+	}{
+		// Local band plus a global first key block, with a partial last block.
+		{37, 37, 16, 8, []SDPABlock{
+			{0, 0}, {1, 0}, {1, 1}, {2, 0}, {2, 1}, {2, 2}, {3, 0}, {3, 2}, {3, 3}, {4, 0}, {4, 3}, {4, 4},
+		}},
+		// Strided pattern in arbitrary order; query block 1 has no tiles.
+		{32, 48, 8, 16, []SDPABlock{{0, 2}, {0, 0}}},
+		{4, 4, 3, 4, []SDPABlock{{0, 0}}},
+	}
+	rng := rand.New(rand.NewPCG(11, 12))
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%dx%dx%d/b%d", tt.seqLen, tt.kvLen, tt.headDim, tt.blockSize), func(t *testing.T) {
+			q := make([]float32, tt.seqLen*tt.headDim)
+			k := make([]float32, tt.kvLen*tt.headDim)
+			v := make([]float32, tt.kvLen*tt.headDim)
+			for _, s := range [][]float32{q, k, v} {
+				for i := range s {
+					s[i] = float32(rng.NormFloat64())
+				}
+			}
+			scale := float32(1 / stdmath.Sqrt(float64(tt.headDim)))
+
+			// Dense reference: the missing tiles are masked out.
+			mask := make([]float32, tt.seqLen*tt.kvLen)
+			for i := range mask {
+				mask[i] = float32(stdmath.Inf(-1))
+			}
+			hasTile := make([]bool, tt.seqLen)
+			for _, b := range tt.blocks {
+				for i := b.QBlock * tt.blockSize; i < min(tt.seqLen, (b.QBlock+1)*tt.blockSize); i++ {
+					hasTile[i] = true
+					for j := b.KBlock * tt.blockSize; j < min(tt.kvLen, (b.KBlock+1)*tt.blockSize); j++ {
+						mask[i*tt.kvLen+j] = 0
+					}
+				}
+			}
+			want := make([]float32, tt.seqLen*tt.headDim)
+			scores := make([]float32, tt.seqLen*tt.kvLen)
+			SDPAScalar(q, k, v, mask, scores, want, tt.seqLen, tt.kvLen, tt.headDim, scale)
+
+			got := make([]float32, tt.seqLen*tt.headDim)
+			for i := range got {
+				got[i] = 42
+			}
+			BlockSparseSDPAAuto(q, k, v, got, tt.blocks, tt.blockSize, tt.seqLen, tt.kvLen, tt.headDim, scale)
+			for i := range tt.seqLen {
+				for d := range tt.headDim {
+					w := want[i*tt.headDim+d]
+					if !hasTile[i] {
+						w = 0
+					}
+					if g := got[i*tt.headDim+d]; stdmath.Abs(float64(g-w)) > 1e-5 {
+						t.Fatalf("output[%d][%d] = %v, want %v", i, d, g, w)
+					}
+				}
+			}
+		})
+	}
+}
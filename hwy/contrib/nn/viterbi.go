@@ -0,0 +1,92 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nn
+
+import (
+	"github.com/ajroetker/go-highway/hwy"
+	"github.com/ajroetker/go-highway/hwy/contrib/vec"
+)
+
+// Viterbi finds the highest-scoring state sequence of a linear-chain CRF or
+// HMM (max-sum decoding) and returns its score.
+//
+//   - emissions: [steps, states] per-step state scores (e.g. log-probabilities)
+//   - transitions: [states, states], transitions[i*states+j] is the score of
+//     moving from state i to state j
+//   - start: [states] scores of the first state, or nil for none
+//   - path: [steps] receives the best state of each step
+//
+// The score of a sequence s is start[s_0] + emissions[0, s_0] plus, for each
+// later step t, transitions[s_{t-1}, s_t] + emissions[t, s_t]. Ties go to
+// the lowest state.
+//
+// Each step adds the previous scores to one column of the transitions with
+// vec.AddTo and picks the best predecessor with vec.Argmax, the row-wise
+// max/argmax over a transposed copy of transitions, so a step costs states
+// SIMD passes of length states.
+func Viterbi[T hwy.FloatsNative](emissions, transitions, start []T, steps, states int, path []int32) T {
+	if steps == 0 || states == 0 {
+		return 0
+	}
+	if len(emissions) < steps*states {
+		panic("viterbi: emissions slice too short")
+	}
+	if len(transitions) < states*states {
+		panic("viterbi: transitions slice too short")
+	}
+	if start != nil && len(start) < states {
+		panic("viterbi: start slice too short")
+	}
+	if len(path) < steps {
+		panic("viterbi: path slice too short")
+	}
+
+	// transT[j*states+i] = transitions[i*states+j]: the scores of reaching j.
+	transT := make([]T, states*states)
+	for i := range states {
+		for j := range states {
+			transT[j*states+i] = transitions[i*states+j]
+		}
+	}
+
+	delta := make([]T, states)
+	next := make([]T, states)
+	cand := make([]T, states)
+	backptr := make([]int32, steps*states)
+
+	copy(delta, emissions[:states])
+	if start != nil {
+		vec.Add(delta, start[:states])
+	}
+	for t := 1; t < steps; t++ {
+		emit := emissions[t*states : (t+1)*states]
+		bp := backptr[t*states : (t+1)*states]
+		for j := range states {
+			vec.AddTo(cand, delta, transT[j*states:(j+1)*states])
+			best := vec.Argmax(cand)
+			bp[j] = int32(best)
+			next[j] = cand[best] + emit[j]
+		}
+		delta, next = next, delta
+	}
+
+	last := vec.Argmax(delta)
+	score := delta[last]
+	path[steps-1] = int32(last)
+	for t := steps - 1; t > 0; t-- {
+		path[t-1] = backptr[t*states+int(path[t])]
+	}
+	return score
+}
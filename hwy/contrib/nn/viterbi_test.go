@@ -0,0 +1,112 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nn
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+)
+
+// bruteForceViterbi scores every state sequence and returns the best one.
+func bruteForceViterbi(emissions, transitions, start []float64, steps, states int) ([]int32, float64) {
+	seq := make([]int32, steps)
+	best := make([]int32, steps)
+	bestScore := math.Inf(-1)
+	for {
+		score := emissions[seq[0]]
+		if start != nil {
+			score += start[seq[0]]
+		}
+		for t := 1; t < steps; t++ {
+			score += transitions[int(seq[t-1])*states+int(seq[t])] + emissions[t*states+int(seq[t])]
+		}
+		if score > bestScore {
+			bestScore = score
+			copy(best, seq)
+		}
+
+		t := steps - 1
+		for ; t >= 0; t-- {
+			seq[t]++
+			if int(seq[t]) < states {
+				break
+			}
+			seq[t] = 0
+		}
+		if t < 0 {
+			return best, bestScore
+		}
+	}
+}
+
+func TestViterbi(t *testing.T) {
+	rng := rand.New(rand.NewPCG(7, 8))
+	for _, tc := range []struct{ steps, states int }{{1, 1}, {1, 5}, {4, 3}, {5, 4}, {3, 9}, {6, 2}} {
+		emissions := make([]float64, tc.steps*tc.states)
+		transitions := make([]float64, tc.states*tc.states)
+		start := make([]float64, tc.states)
+		for _, s := range [][]float64{emissions, transitions, start} {
+			for i := range s {
+				s[i] = rng.NormFloat64()
+			}
+		}
+		// A forbidden transition, as in BIO tagging.
+		if tc.states > 2 {
+			transitions[0*tc.states+2] = math.Inf(-1)
+		}
+
+		for _, st := range [][]float64{nil, start} {
+			want, wantScore := bruteForceViterbi(emissions, transitions, st, tc.steps, tc.states)
+			path := make([]int32, tc.steps)
+			score := Viterbi(emissions, transitions, st, tc.steps, tc.states, path)
+			if math.Abs(score-wantScore) > 1e-12 {
+				t.Errorf("steps=%d states=%d: score = %v, want %v", tc.steps, tc.states, score, wantScore)
+			}
+			for i := range path {
+				if path[i] != want[i] {
+					t.Errorf("steps=%d states=%d: path = %v, want %v", tc.steps, tc.states, path, want)
+					break
+				}
+			}
+		}
+	}
+}
+
+func TestViterbiFloat32(t *testing.T) {
+	// Staying in a state costs more than any emission gains, so the best
+	// path alternates, starting from the state that collects steps 1 to 3.
+	emissions := []float32{
+		1, 0,
+		1, 0,
+		0, 1,
+		1, 0,
+	}
+	transitions := []float32{
+		-5, 0,
+		0, -5,
+	}
+	path := make([]int32, 4)
+	score := Viterbi(emissions, transitions, nil, 4, 2, path)
+	want := []int32{1, 0, 1, 0}
+	for i := range want {
+		if path[i] != want[i] {
+			t.Fatalf("path = %v, want %v", path, want)
+		}
+	}
+	if score != 3 {
+		t.Errorf("score = %v, want 3", score)
+	}
+}
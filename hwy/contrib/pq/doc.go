@@ -0,0 +1,33 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package pq provides product quantization (PQ) for approximate nearest
+// neighbor search: encoding vectors with trained codebooks, and scanning
+// the codes with asymmetric distance computation (ADC), the query kept in
+// full precision.
+//
+//	EncodePQ(codes, vectors, codebooks, n, dims, m)
+//	ComputeLUT(lut, query, codebooks, dims, m)
+//	ADCScan(dists, lut, codes, n, m)
+//
+// Training is left to the caller, for example KMeans of package cluster
+// on each subspace. EncodePQ and ComputeLUT use the batched squared L2
+// distance kernel of package vec.
+//
+// For codebooks of 16 centroids per subspace, FastScan replaces the table
+// lookups of ADCScan by byte shuffles (TableLookupBytes): the tables are
+// quantized to bytes by QuantizeLUT, and the codes transposed into blocks
+// by PackCodes4, so that one shuffle looks up a code of a whole vector of
+// rows. Package rabitq provides 1-bit codes with error bounds instead.
+package pq
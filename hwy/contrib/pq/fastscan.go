@@ -0,0 +1,139 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pq
+
+import (
+	"math"
+
+	"github.com/ajroetker/go-highway/hwy"
+)
+
+// FastScan is ADCScan for codebooks of 16 centroids per subspace, with the
+// lookup tables quantized to bytes so that TableLookupBytes looks up the
+// codes of a whole vector of rows at once (the "fast scan" of Faiss and
+// Quicker ADC):
+//
+//	ComputeLUT(lut, query, codebooks, dims, m)
+//	scale, bias := QuantizeLUT(qlut, lut, m)
+//	FastScan(sums, qlut, packed, n, m)
+//	// distance of row i ≈ bias + scale*sums[i]
+//
+// The codes are stored transposed by PackCodes4, in blocks of 64 rows, one
+// byte per code.
+
+// blockSize is the number of rows of a block of packed codes, and the
+// length of a quantized table: a multiple of the widest vector of bytes.
+const blockSize = 64
+
+// PackedLen returns the length of the packed codes of n rows of m codes.
+func PackedLen(n, m int) int {
+	return (n + blockSize - 1) / blockSize * m * blockSize
+}
+
+// PackCodes4 stores in packed, of PackedLen(n, m) bytes, the codes of n
+// rows of m codes below 16, n×m row-major, in the layout of FastScan:
+// code j of row i is at byte (i/64*m + j)*64 + i%64. The rows of the last
+// block past n are zero. It panics if a code is 16 or more or if a slice
+// is too short.
+func PackCodes4(packed, codes []uint8, n, m int) {
+	checkLen(codes, n*m, "codes")
+	checkLen(packed, PackedLen(n, m), "packed")
+	clear(packed[:PackedLen(n, m)])
+	for i := range n {
+		block := packed[i/blockSize*m*blockSize:]
+		for j, c := range codes[i*m : (i+1)*m] {
+			if c >= 16 {
+				panic("pq: code out of range")
+			}
+			block[j*blockSize+i%blockSize] = c
+		}
+	}
+}
+
+// QuantizeLUT stores in qlut, m×64 bytes, the lookup table lut, m×16 as
+// returned by ComputeLUT for 16 centroids per subspace, quantized for
+// FastScan, and returns how to map the sums back: a sum s of quantized
+// entries approximates the sum of the entries bias + scale*s, within
+// m*scale/2. Each table is offset by its minimum and all share the scale
+// that maps the widest range to [0, 255]; the 16 entries of a table are
+// repeated to fill its 64 bytes. It panics if a slice is too short.
+func QuantizeLUT[T hwy.FloatsNative](qlut []uint8, lut []T, m int) (scale, bias T) {
+	checkLen(lut, m*16, "lut")
+	checkLen(qlut, m*blockSize, "qlut")
+	var width T
+	for j := range m {
+		t := lut[j*16 : (j+1)*16]
+		lo, hi := t[0], t[0]
+		for _, x := range t[1:] {
+			lo = min(lo, x)
+			hi = max(hi, x)
+		}
+		bias += lo
+		width = max(width, hi-lo)
+	}
+	scale = width / 255
+	if scale == 0 {
+		scale = 1
+	}
+	for j := range m {
+		t := lut[j*16 : (j+1)*16]
+		lo := t[0]
+		for _, x := range t[1:] {
+			lo = min(lo, x)
+		}
+		q := qlut[j*blockSize : (j+1)*blockSize]
+		for c, x := range t {
+			q[c] = uint8(min(math.Round(float64((x-lo)/scale)), 255))
+		}
+		for k := 16; k < blockSize; k += 16 {
+			copy(q[k:k+16], q[:16])
+		}
+	}
+	return scale, bias
+}
+
+// FastScan stores in out[i] the sum of the entries of the quantized tables
+// qlut, from QuantizeLUT, selected by the codes of row i of packed, from
+// PackCodes4. It panics if m is not in [1, 256] or if a slice is too short.
+func FastScan(out []uint16, qlut, packed []uint8, n, m int) {
+	if m <= 0 || m > 256 {
+		panic("pq: FastScan needs 1 to 256 subspaces")
+	}
+	checkLen(qlut, m*blockSize, "qlut")
+	checkLen(packed, PackedLen(n, m), "packed")
+	checkLen(out, n, "out")
+	full := n / blockSize
+	scan4(out, qlut, packed, full, m)
+	if rest := n - full*blockSize; rest > 0 {
+		var tail [blockSize]uint16
+		scan4(tail[:], qlut, packed[full*m*blockSize:], 1, m)
+		copy(out[full*blockSize:n], tail[:rest])
+	}
+}
+
+// scan4Scalar is baseScan4 a row at a time.
+func scan4Scalar(out []uint16, qlut, packed []uint8, blocks, m int) {
+	for b := range blocks {
+		codes := packed[b*m*blockSize : (b+1)*m*blockSize]
+		sums := out[b*blockSize : (b+1)*blockSize]
+		clear(sums)
+		for j := range m {
+			t := qlut[j*blockSize : j*blockSize+16]
+			for v, c := range codes[j*blockSize : (j+1)*blockSize] {
+				sums[v] += uint16(t[c&15])
+			}
+		}
+	}
+}
@@ -0,0 +1,143 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pq
+
+import (
+	"github.com/ajroetker/go-highway/hwy"
+	"github.com/ajroetker/go-highway/hwy/contrib/vec"
+)
+
+// A product quantizer splits vectors of dims elements into m subvectors of
+// dims/m elements, and encodes each subvector as the index of its nearest
+// centroid among the ksub of its subspace. The codebooks are m×ksub
+// centroids of dims/m elements, subspace after subspace, so ksub is
+// len(codebooks)/dims; it is at most 256, so that a code fits in a byte.
+// They can be trained with package cluster, running KMeans on each
+// subspace.
+
+// EncodePQ stores in codes, n×m row-major, the product quantization codes
+// of the n rows of vectors, n×dims: codes[i*m+j] is the index of the
+// centroid of subspace j nearest to subvector j of row i, ties going to
+// the lowest index. It panics if dims is not a multiple of m, if the
+// codebooks do not hold 1 to 256 centroids per subspace, or if a slice is
+// too short.
+func EncodePQ[T hwy.FloatsNative](codes []uint8, vectors, codebooks []T, n, dims, m int) {
+	ksub, dsub := checkCodebooks(len(codebooks), dims, m)
+	checkLen(vectors, n*dims, "vectors")
+	checkLen(codes, n*m, "codes")
+	d := make([]T, ksub)
+	for i := range n {
+		row := vectors[i*dims : (i+1)*dims]
+		for j := range m {
+			vec.BatchL2SquaredDistance(row[j*dsub:(j+1)*dsub], codebooks[j*ksub*dsub:], d, ksub, dsub)
+			codes[i*m+j] = uint8(vec.Argmin(d))
+		}
+	}
+}
+
+// DecodePQ stores in vectors, n×dims, the reconstruction of the n rows of
+// codes, n×m: the concatenation of the centroids they select. It panics
+// like EncodePQ, or if a code is not below ksub.
+func DecodePQ[T hwy.FloatsNative](vectors []T, codes []uint8, codebooks []T, n, dims, m int) {
+	ksub, dsub := checkCodebooks(len(codebooks), dims, m)
+	checkLen(codes, n*m, "codes")
+	checkLen(vectors, n*dims, "vectors")
+	for i := range n {
+		for j := range m {
+			c := int(codes[i*m+j])
+			if c >= ksub {
+				panic("pq: code out of range")
+			}
+			src := codebooks[(j*ksub+c)*dsub:]
+			copy(vectors[i*dims+j*dsub:i*dims+(j+1)*dsub], src[:dsub])
+		}
+	}
+}
+
+// ComputeLUT stores in lut, m×ksub row-major, the squared Euclidean
+// distance between each subvector of query and each centroid of its
+// subspace, the lookup table of asymmetric distance computation (ADC). It
+// panics like EncodePQ.
+func ComputeLUT[T hwy.FloatsNative](lut, query, codebooks []T, dims, m int) {
+	ksub, dsub := checkCodebooks(len(codebooks), dims, m)
+	checkLen(query, dims, "query")
+	checkLen(lut, m*ksub, "lut")
+	for j := range m {
+		vec.BatchL2SquaredDistance(query[j*dsub:(j+1)*dsub], codebooks[j*ksub*dsub:], lut[j*ksub:(j+1)*ksub], ksub, dsub)
+	}
+}
+
+// ADCScan stores in out[i] the approximate squared distance between the
+// query of lut, m×ksub as returned by ComputeLUT, and the vector encoded
+// by row i of codes, n×m: the sum over j of lut[j*ksub+codes[i*m+j]]. It
+// panics if a slice is too short, or if a code is not below ksub.
+//
+// The lookups are gathers from tables of up to 256 entries, which are
+// done a row at a time; for 16-entry tables, FastScan does them with byte
+// shuffles instead, many rows at once.
+func ADCScan[T hwy.FloatsNative](out, lut []T, codes []uint8, n, m int) {
+	if m <= 0 {
+		panic("pq: no subspaces")
+	}
+	ksub := len(lut) / m
+	checkLen(codes, n*m, "codes")
+	checkLen(out, n, "out")
+	for i := range n {
+		row := codes[i*m : (i+1)*m]
+		// Four independent sums, so that the loads of the tables are not
+		// serialized behind one chain of additions.
+		var s0, s1, s2, s3 T
+		j := 0
+		for ; j+4 <= m; j += 4 {
+			s0 += lut[j*ksub+lookup(row[j], ksub)]
+			s1 += lut[(j+1)*ksub+lookup(row[j+1], ksub)]
+			s2 += lut[(j+2)*ksub+lookup(row[j+2], ksub)]
+			s3 += lut[(j+3)*ksub+lookup(row[j+3], ksub)]
+		}
+		for ; j < m; j++ {
+			s0 += lut[j*ksub+lookup(row[j], ksub)]
+		}
+		out[i] = (s0 + s1) + (s2 + s3)
+	}
+}
+
+// lookup returns code as an index into a table of ksub entries.
+func lookup(code uint8, ksub int) int {
+	c := int(code)
+	if c >= ksub {
+		panic("pq: code out of range")
+	}
+	return c
+}
+
+// checkCodebooks returns the number of centroids per subspace and the
+// length of a subvector of codebooks of size elements.
+func checkCodebooks(size, dims, m int) (ksub, dsub int) {
+	if m <= 0 || dims <= 0 || dims%m != 0 {
+		panic("pq: dims is not a positive multiple of m")
+	}
+	ksub = size / dims
+	if ksub < 1 || ksub > 256 {
+		panic("pq: codebooks must hold 1 to 256 centroids per subspace")
+	}
+	return ksub, dims / m
+}
+
+// checkLen panics if s holds fewer than n elements.
+func checkLen[T any](s []T, n int, name string) {
+	if len(s) < n {
+		panic("pq: " + name + " is too short")
+	}
+}
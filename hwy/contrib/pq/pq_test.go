@@ -0,0 +1,172 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pq
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+)
+
+func randomMatrix(r *rand.Rand, rows, cols int) []float32 {
+	m := make([]float32, rows*cols)
+	for i := range m {
+		m[i] = float32(r.NormFloat64())
+	}
+	return m
+}
+
+func squaredDistance(a, b []float32) float64 {
+	s := 0.0
+	for i := range a {
+		d := float64(a[i]) - float64(b[i])
+		s += d * d
+	}
+	return s
+}
+
+func TestEncodeDecode(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	const n, dims, m, ksub = 50, 24, 6, 37
+	dsub := dims / m
+	vectors := randomMatrix(r, n, dims)
+	codebooks := randomMatrix(r, m*ksub, dsub)
+	codes := make([]uint8, n*m)
+	EncodePQ(codes, vectors, codebooks, n, dims, m)
+	for i := range n {
+		for j := range m {
+			sub := vectors[i*dims+j*dsub : i*dims+(j+1)*dsub]
+			best, bestDist := 0, math.Inf(1)
+			for c := range ksub {
+				if d := squaredDistance(sub, codebooks[(j*ksub+c)*dsub:(j*ksub+c+1)*dsub]); d < bestDist {
+					best, bestDist = c, d
+				}
+			}
+			if int(codes[i*m+j]) != best {
+				t.Errorf("codes[%d,%d] = %d, want %d", i, j, codes[i*m+j], best)
+			}
+		}
+	}
+
+	decoded := make([]float32, n*dims)
+	DecodePQ(decoded, codes, codebooks, n, dims, m)
+	for i := range n {
+		for j := range m {
+			c := int(codes[i*m+j])
+			want := codebooks[(j*ksub+c)*dsub : (j*ksub+c+1)*dsub]
+			got := decoded[i*dims+j*dsub : i*dims+(j+1)*dsub]
+			for d := range dsub {
+				if got[d] != want[d] {
+					t.Fatalf("decoded row %d subspace %d = %v, want %v", i, j, got, want)
+				}
+			}
+		}
+	}
+}
+
+func TestADCScan(t *testing.T) {
+	r := rand.New(rand.NewPCG(3, 4))
+	for _, shape := range [][4]int{{1, 1, 1, 1}, {9, 8, 2, 16}, {40, 30, 5, 256}, {33, 28, 7, 3}} {
+		n, dims, m, ksub := shape[0], shape[1], shape[2], shape[3]
+		vectors := randomMatrix(r, n, dims)
+		codebooks := randomMatrix(r, m*ksub, dims/m)
+		query := randomMatrix(r, 1, dims)
+		codes := make([]uint8, n*m)
+		EncodePQ(codes, vectors, codebooks, n, dims, m)
+		decoded := make([]float32, n*dims)
+		DecodePQ(decoded, codes, codebooks, n, dims, m)
+
+		lut := make([]float32, m*ksub)
+		ComputeLUT(lut, query, codebooks, dims, m)
+		out := make([]float32, n)
+		ADCScan(out, lut, codes, n, m)
+		for i := range n {
+			want := squaredDistance(query, decoded[i*dims:(i+1)*dims])
+			if got := float64(out[i]); math.Abs(got-want) > 1e-4*max(1, want) {
+				t.Errorf("%v: out[%d] = %g, want %g", shape, i, got, want)
+			}
+		}
+	}
+}
+
+func TestFastScan(t *testing.T) {
+	r := rand.New(rand.NewPCG(5, 6))
+	for _, shape := range [][3]int{{1, 16, 1}, {64, 32, 8}, {100, 48, 16}, {200, 64, 64}} {
+		n, dims, m := shape[0], shape[1], shape[2]
+		vectors := randomMatrix(r, n, dims)
+		codebooks := randomMatrix(r, m*16, dims/m)
+		query := randomMatrix(r, 1, dims)
+		codes := make([]uint8, n*m)
+		EncodePQ(codes, vectors, codebooks, n, dims, m)
+		lut := make([]float32, m*16)
+		ComputeLUT(lut, query, codebooks, dims, m)
+		exact := make([]float32, n)
+		ADCScan(exact, lut, codes, n, m)
+
+		qlut := make([]uint8, m*blockSize)
+		scale, bias := QuantizeLUT(qlut, lut, m)
+		packed := make([]uint8, PackedLen(n, m))
+		PackCodes4(packed, codes, n, m)
+		sums := make([]uint16, n)
+		FastScan(sums, qlut, packed, n, m)
+		for i := range n {
+			want := 0
+			for j := range m {
+				want += int(qlut[j*blockSize+int(codes[i*m+j])])
+			}
+			if int(sums[i]) != want {
+				t.Fatalf("%v: sums[%d] = %d, want %d", shape, i, sums[i], want)
+			}
+			approx := float64(bias) + float64(scale)*float64(sums[i])
+			if tol := float64(m)*float64(scale)/2 + 1e-3; math.Abs(approx-float64(exact[i])) > tol {
+				t.Errorf("%v: approximate distance %d = %g, want %g within %g", shape, i, approx, exact[i], tol)
+			}
+		}
+	}
+}
+
+// TestScan4Kernels checks the hwy.Vec kernel and the scalar loop that
+// replaces it against each other, whichever of them dispatch bound.
+func TestScan4Kernels(t *testing.T) {
+	r := rand.New(rand.NewPCG(7, 8))
+	const blocks, m = 3, 40
+	qlut := make([]uint8, m*blockSize)
+	for j := range m {
+		for c := range 16 {
+			qlut[j*blockSize+c] = uint8(r.IntN(256))
+		}
+		for k := 16; k < blockSize; k += 16 {
+			copy(qlut[j*blockSize+k:j*blockSize+k+16], qlut[j*blockSize:j*blockSize+16])
+		}
+	}
+	packed := make([]uint8, blocks*m*blockSize)
+	for i := range packed {
+		packed[i] = uint8(r.IntN(16))
+	}
+	want := make([]uint16, blocks*blockSize)
+	scan4Scalar(want, qlut, packed, blocks, m)
+	for name, kernel := range map[string]func([]uint16, []uint8, []uint8, int, int){
+		"dispatch": scan4,
+		"fallback": baseScan4_fallback,
+	} {
+		got := make([]uint16, blocks*blockSize)
+		kernel(got, qlut, packed, blocks, m)
+		for i := range got {
+			if got[i] != want[i] {
+				t.Fatalf("%s: sum %d = %d, want %d", name, i, got[i], want[i])
+			}
+		}
+	}
+}
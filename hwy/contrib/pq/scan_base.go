@@ -0,0 +1,52 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pq
+
+//go:generate go run ../../../cmd/hwygen -input scan_base.go -output . -targets avx2,avx512,neon,fallback -dispatch scan
+
+import "github.com/ajroetker/go-highway/hwy"
+
+// baseScan4 adds up, for the blocks of FastScan vectors in packed, the
+// entries of the quantized lookup tables in qlut selected by their codes,
+// and stores the sums in out, blockSize per block.
+//
+// Each code of a block selects one byte of a 16-entry table with
+// TableLookupBytes, the lanes of a whole block at once. The tables are
+// stored repeated to blockSize bytes, which fills a vector of any width;
+// the 8-bit entries are widened to 16 bits before they are added, so
+// m tables of entries up to 255 cannot overflow for m <= 257.
+func baseScan4(out []uint16, qlut, packed []uint8, blocks, m int) {
+	lanes := hwy.MaxLanes[uint8]()
+	half := hwy.MaxLanes[uint16]()
+	//hwy:unroll 1
+	for b := 0; b < blocks; b++ {
+		base := b * blockSize
+		//hwy:unroll 1
+		for v := 0; v < blockSize; v += lanes {
+			lo := hwy.Zero[uint16]()
+			hi := hwy.Zero[uint16]()
+			//hwy:unroll 1
+			for j := 0; j < m; j++ {
+				tbl := hwy.Load(qlut[j*blockSize:])
+				idx := hwy.Load(packed[(b*m+j)*blockSize+v:])
+				vals := hwy.TableLookupBytes(tbl, idx)
+				lo = hwy.Add(lo, hwy.PromoteLowerU8ToU16(vals))
+				hi = hwy.Add(hi, hwy.PromoteUpperU8ToU16(vals))
+			}
+			hwy.Store(lo, out[base+v:])
+			hwy.Store(hi, out[base+v+half:])
+		}
+	}
+}
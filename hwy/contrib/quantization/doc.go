@@ -57,6 +57,9 @@
 //	matmul.BaseFusedInt4MatMulReLU(input, packed, scales, output, M, K, N, groupSize)
 //	matmul.BaseFusedInt4MatMulSwiGLU(input, gatePacked, gateScales, upPacked, upScales, output, M, K, N, groupSize)
 //
+//	// Int8 with exact (erf-based) GELU
+//	matmul.FusedInt8MatMulGELU(input, weights, scales, output, M, K, N, groupSize)
+//
 // SwiGLU is commonly used in LLaMA, Mistral, and other modern architectures.
 // It computes SiLU(gate_projection) * up_projection in a single fused pass.
 //
@@ -61,6 +61,27 @@
 //	// During search, compute bit product for distance estimation
 //	bitProduct := rabitq.BitProduct(dataCode, queryQ1, queryQ2, queryQ3, queryQ4)
 //
+// # Search
+//
+// Quantizer ties the kernels into a RaBitQ pipeline. It holds the centroid
+// and a random orthogonal rotation; Encode normalizes, rotates and
+// quantizes the data vectors, and PrepareQuery rotates the query and
+// quantizes it to 4 bits per dimension, the bit planes BitProduct takes.
+// Query.EstimateDistances turns the bit products into squared Euclidean
+// distance estimates with the estimator of the paper:
+//
+//	qz := rabitq.NewQuantizer(centroid, seed)
+//	codes := qz.Encode(vectors, n)
+//	hits := qz.Search(query, codes, 10)
+//
+// SearchRerank shortlists more candidates by estimated distance and
+// reorders them with a caller-supplied exact distance, such as one
+// computed from the full-precision vectors:
+//
+//	hits := qz.SearchRerank(query, codes, 10, 100, func(id int) float32 {
+//	    return vec.L2SquaredDistance(query, vectors[id*dims:(id+1)*dims])
+//	})
+//
 // # SIMD Acceleration
 //
 // Operations automatically use the best available SIMD instructions:
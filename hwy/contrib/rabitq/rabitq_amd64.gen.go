@@ -18,11 +18,11 @@ func init() {
 		initRabitqFallback()
 		return
 	}
-	if archsimd.X86.AVX512() {
+	if archsimd.X86.AVX512() && hwy.TargetEnabled(hwy.DispatchAVX512) {
 		initRabitqAVX512()
 		return
 	}
-	if archsimd.X86.AVX2() {
+	if archsimd.X86.AVX2() && hwy.TargetEnabled(hwy.DispatchAVX2) {
 		initRabitqAVX2()
 		return
 	}
@@ -43,3 +43,12 @@ func initRabitqFallback() {
 	BitProduct = BaseBitProduct_fallback
 	QuantizeVectors = BaseQuantizeVectors_fallback
 }
+
+func init() {
+	hwy.RegisterKernel("rabitq.BitProduct", &BitProduct)
+	hwy.RegisterKernel("rabitq.QuantizeVectors", &QuantizeVectors)
+	hwyKernels := []string{"rabitq.BitProduct", "rabitq.QuantizeVectors"}
+	hwy.RegisterKernelTarget(hwy.DispatchAVX2, initRabitqAVX2, hwyKernels...)
+	hwy.RegisterKernelTarget(hwy.DispatchAVX512, initRabitqAVX512, hwyKernels...)
+	hwy.RegisterKernelTarget(hwy.DispatchScalar, initRabitqFallback, hwyKernels...)
+}
@@ -5,6 +5,7 @@
 package rabitq
 
 import (
+	stdmath "math"
 	"math/bits"
 	"simd/archsimd"
 
@@ -87,7 +88,7 @@ func BaseQuantizeVectors_avx2(unitVectors []float32, codes []uint64, dotProducts
 			dotProduct += float64(hwy.ReduceSum_AVX2_F32x8(prodVec))
 			for j := range lanes {
 				element := hwy.GetLane_AVX2_F32x8(vecData, j)
-				signBit := getSignBit(element)
+				signBit := (stdmath.Float32bits(element) >> 31)
 				codeBits = (codeBits << 1) | uint64(1-signBit)
 				bitPos++
 				if bitPos == 64 {
@@ -102,7 +103,7 @@ func BaseQuantizeVectors_avx2(unitVectors []float32, codes []uint64, dotProducts
 		}
 		for ; dim < dims; dim++ {
 			element := vec[dim]
-			signBit := getSignBit(element)
+			signBit := (stdmath.Float32bits(element) >> 31)
 			var mult float32
 			if signBit == 1 {
 				mult = negSqrtDimsInv
@@ -3,6 +3,7 @@
 package rabitq
 
 import (
+	stdmath "math"
 	"math/bits"
 
 	"github.com/ajroetker/go-highway/hwy"
@@ -84,7 +85,7 @@ func BaseQuantizeVectors_fallback(unitVectors []float32, codes []uint64, dotProd
 			dotProduct += float64(hwy.ReduceSum(prodVec))
 			for j := range lanes {
 				element := hwy.GetLane(vecData, j)
-				signBit := getSignBit(element)
+				signBit := (stdmath.Float32bits(element) >> 31)
 				codeBits = (codeBits << 1) | uint64(1-signBit)
 				bitPos++
 				if bitPos == 64 {
@@ -99,7 +100,7 @@ func BaseQuantizeVectors_fallback(unitVectors []float32, codes []uint64, dotProd
 		}
 		for ; dim < dims; dim++ {
 			element := vec[dim]
-			signBit := getSignBit(element)
+			signBit := (stdmath.Float32bits(element) >> 31)
 			var mult float32
 			if signBit == 1 {
 				mult = negSqrtDimsInv
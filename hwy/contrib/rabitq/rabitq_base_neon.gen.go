@@ -5,6 +5,7 @@
 package rabitq
 
 import (
+	stdmath "math"
 	"math/bits"
 
 	"github.com/ajroetker/go-highway/hwy"
@@ -87,7 +88,7 @@ func BaseQuantizeVectors_neon(unitVectors []float32, codes []uint64, dotProducts
 			dotProduct += float64(prodVec.ReduceSum())
 			for j := range lanes {
 				element := vecData.Get(j)
-				signBit := getSignBit(element)
+				signBit := (stdmath.Float32bits(element) >> 31)
 				codeBits = (codeBits << 1) | uint64(1-signBit)
 				bitPos++
 				if bitPos == 64 {
@@ -102,7 +103,7 @@ func BaseQuantizeVectors_neon(unitVectors []float32, codes []uint64, dotProducts
 		}
 		for ; dim < dims; dim++ {
 			element := vec[dim]
-			signBit := getSignBit(element)
+			signBit := (stdmath.Float32bits(element) >> 31)
 			var mult float32
 			if signBit == 1 {
 				mult = negSqrtDimsInv
@@ -20,3 +20,10 @@ func initRabitqFallback() {
 	BitProduct = BaseBitProduct_fallback
 	QuantizeVectors = BaseQuantizeVectors_fallback
 }
+
+func init() {
+	hwy.RegisterKernel("rabitq.BitProduct", &BitProduct)
+	hwy.RegisterKernel("rabitq.QuantizeVectors", &QuantizeVectors)
+	hwyKernels := []string{"rabitq.BitProduct", "rabitq.QuantizeVectors"}
+	hwy.RegisterKernelTarget(hwy.DispatchScalar, initRabitqFallback, hwyKernels...)
+}
@@ -0,0 +1,283 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rabitq
+
+import (
+	"math"
+	"math/rand/v2"
+
+	"github.com/ajroetker/go-highway/hwy/contrib/matvec"
+	"github.com/ajroetker/go-highway/hwy/contrib/sort"
+	"github.com/ajroetker/go-highway/hwy/contrib/vec"
+)
+
+// Search pipeline.
+//
+// A data vector o_r is stored as the code of the unit vector
+// o = P(o_r - c)/||o_r - c||, where c is the centroid and P a random
+// rotation, together with ||o_r - c||, the inverted <ō,o> from
+// QuantizeVectors and the popcount of the code. A query is rotated and
+// normalized the same way, then scalar-quantized to 4 bits per dimension,
+// q ≈ lower + delta*q̄, whose bit planes are the q1..q4 of BitProduct.
+// With ō = (2x̄-1)/√D, section 3.3 of the paper gives
+//
+//	<ō,q> ≈ (2·delta/√D)·<x̄,q̄> + (2·lower/√D)·Σx̄ - (delta/√D)·Σq̄ - √D·lower
+//	<o,q> ≈ <ō,q> / <ō,o>
+//	||o_r - q_r||² ≈ ||o_r - c||² + ||q_r - c||² - 2·||o_r - c||·||q_r - c||·<o,q>
+//
+// where <x̄,q̄> is BitProduct and Σx̄ the code's popcount.
+
+// QueryBits is the number of bits per dimension of a prepared query.
+const QueryBits = 4
+
+// Quantizer holds the centroid and the random rotation that data vectors
+// and queries share.
+type Quantizer struct {
+	dims     int
+	width    int
+	centroid []float32
+	rotation []float32 // dims×dims, orthogonal
+}
+
+// NewQuantizer returns a Quantizer for vectors of len(centroid) dimensions,
+// normalized around centroid (typically the mean of the data, or of its
+// cluster in an IVF index). The rotation is drawn from seed, so encoding
+// and search must use Quantizers built from the same centroid and seed.
+//
+// The rotation is a dense dims×dims matrix, orthonormalized once with
+// Gram-Schmidt in O(dims³).
+func NewQuantizer(centroid []float32, seed uint64) *Quantizer {
+	dims := len(centroid)
+	if dims == 0 {
+		panic("rabitq: empty centroid")
+	}
+	return &Quantizer{
+		dims:     dims,
+		width:    CodeWidth(dims),
+		centroid: append([]float32(nil), centroid...),
+		rotation: randomRotation(dims, seed),
+	}
+}
+
+// Dims returns the number of dimensions of the vectors.
+func (qz *Quantizer) Dims() int { return qz.dims }
+
+// randomRotation returns a random dims×dims orthogonal matrix: Gaussian rows
+// orthonormalized with modified Gram-Schmidt.
+func randomRotation(dims int, seed uint64) []float32 {
+	rng := rand.New(rand.NewPCG(seed, seed^0x9E3779B97F4A7C15))
+	m := make([]float64, dims*dims)
+	for i := range m {
+		m[i] = rng.NormFloat64()
+	}
+	for i := range dims {
+		row := m[i*dims : (i+1)*dims]
+		for j := range i {
+			prev := m[j*dims : (j+1)*dims]
+			vec.MulConstAddTo(row, -vec.Dot(row, prev), prev)
+		}
+		vec.Scale(1/vec.Norm(row), row)
+	}
+	r := make([]float32, dims*dims)
+	for i, v := range m {
+		r[i] = float32(v)
+	}
+	return r
+}
+
+// rotate stores in dst the unit vector P(v - c)/||v - c|| and returns
+// ||v - c||. dst is zero if v is the centroid.
+func (qz *Quantizer) rotate(dst, v, tmp []float32) float32 {
+	vec.SubTo(tmp, v[:qz.dims], qz.centroid)
+	norm := vec.Norm(tmp)
+	if norm == 0 {
+		clear(dst)
+		return 0
+	}
+	vec.Scale(1/norm, tmp)
+	matvec.MatVec(qz.rotation, qz.dims, qz.dims, tmp, dst)
+	return norm
+}
+
+// Codes holds the RaBitQ codes of N data vectors with the per-vector
+// factors the distance estimator needs.
+type Codes struct {
+	N     int
+	Width int // uint64 words per code, CodeWidth(dims)
+
+	Codes       []uint64  // N×Width sign codes
+	DotProducts []float32 // 1/<ō,o>, 0 for a vector at the centroid
+	CodeCounts  []uint32  // popcount of each code
+	Norms       []float32 // ||o_r - c||
+}
+
+// Encode quantizes the n vectors of vectors, n×Dims row-major.
+func (qz *Quantizer) Encode(vectors []float32, n int) *Codes {
+	if len(vectors) < n*qz.dims {
+		panic("rabitq: vectors slice too short")
+	}
+	c := &Codes{
+		N:           n,
+		Width:       qz.width,
+		Codes:       make([]uint64, n*qz.width),
+		DotProducts: make([]float32, n),
+		CodeCounts:  make([]uint32, n),
+		Norms:       make([]float32, n),
+	}
+	units := make([]float32, n*qz.dims)
+	tmp := make([]float32, qz.dims)
+	for i := range n {
+		c.Norms[i] = qz.rotate(units[i*qz.dims:(i+1)*qz.dims], vectors[i*qz.dims:], tmp)
+	}
+	sqrtDimsInv := float32(1 / math.Sqrt(float64(qz.dims)))
+	QuantizeVectors(units, c.Codes, c.DotProducts, c.CodeCounts, sqrtDimsInv, n, qz.dims, qz.width)
+	return c
+}
+
+// Query is a query prepared for distance estimation against Codes.
+type Query struct {
+	planes   [QueryBits][]uint64 // planes[j] holds bit j of each q̄, MSB-first like the codes
+	lower    float32
+	delta    float32
+	sum      float32 // Σq̄
+	norm     float32 // ||q_r - c||
+	sqrtDims float32
+}
+
+// PrepareQuery rotates and normalizes query like the data vectors and
+// quantizes it to QueryBits bits per dimension, rounding to nearest
+// between its minimum and maximum coordinates.
+func (qz *Quantizer) PrepareQuery(query []float32) *Query {
+	if len(query) < qz.dims {
+		panic("rabitq: query slice too short")
+	}
+	unit := make([]float32, qz.dims)
+	q := &Query{sqrtDims: float32(math.Sqrt(float64(qz.dims)))}
+	q.norm = qz.rotate(unit, query, make([]float32, qz.dims))
+	for j := range q.planes {
+		q.planes[j] = make([]uint64, qz.width)
+	}
+
+	lo, hi := vec.MinMax(unit)
+	q.lower = lo
+	q.delta = (hi - lo) / (1<<QueryBits - 1)
+	if q.delta == 0 {
+		return q
+	}
+	invDelta := 1 / q.delta
+	var sum uint32
+	for d, v := range unit {
+		level := min(uint32((v-lo)*invDelta+0.5), 1<<QueryBits-1)
+		sum += level
+		bit := uint64(1) << (63 - d%64)
+		for j := range q.planes {
+			if level>>j&1 != 0 {
+				q.planes[j][d/64] |= bit
+			}
+		}
+	}
+	q.sum = float32(sum)
+	return q
+}
+
+// EstimateDistances stores in out[i] the estimated squared Euclidean
+// distance between the query and data vector i of codes.
+func (q *Query) EstimateDistances(codes *Codes, out []float32) {
+	if len(out) < codes.N {
+		panic("rabitq: out slice too short")
+	}
+	if codes.Width != len(q.planes[0]) {
+		panic("rabitq: codes and query have different widths")
+	}
+	scale := 2 * q.delta / q.sqrtDims
+	countScale := 2 * q.lower / q.sqrtDims
+	bias := -q.delta/q.sqrtDims*q.sum - q.sqrtDims*q.lower
+	qNormSq := q.norm * q.norm
+
+	for i := range codes.N {
+		code := codes.Codes[i*codes.Width : (i+1)*codes.Width]
+		ip := float32(BitProduct(code, q.planes[0], q.planes[1], q.planes[2], q.planes[3]))
+		obq := scale*ip + countScale*float32(codes.CodeCounts[i]) + bias
+		oq := obq * codes.DotProducts[i]
+		oNorm := codes.Norms[i]
+		out[i] = oNorm*oNorm + qNormSq - 2*oNorm*q.norm*oq
+	}
+}
+
+// Result is a search hit: the index of a data vector and its distance.
+type Result struct {
+	ID       int
+	Distance float32
+}
+
+// Search returns the k data vectors of codes with the smallest estimated
+// squared distance to query, nearest first.
+func (qz *Quantizer) Search(query []float32, codes *Codes, k int) []Result {
+	dists := make([]float32, codes.N)
+	qz.PrepareQuery(query).EstimateDistances(codes, dists)
+	return nearest(dists, nil, k)
+}
+
+// SearchRerank is Search with re-ranking: it shortlists the candidates
+// vectors with the smallest estimated distance, then calls exact for each
+// of them, typically the squared distance to the full-precision vector,
+// and returns the k nearest by exact distance. candidates is raised to k;
+// a few times k is usually enough to recover the exact top k.
+func (qz *Quantizer) SearchRerank(query []float32, codes *Codes, k, candidates int, exact func(id int) float32) []Result {
+	shortlist := qz.Search(query, codes, max(candidates, k))
+	dists := make([]float32, len(shortlist))
+	ids := make([]int, len(shortlist))
+	for i, r := range shortlist {
+		ids[i] = r.ID
+		dists[i] = exact(r.ID)
+	}
+	return nearest(dists, ids, k)
+}
+
+// nearest returns the k smallest dists in ascending order, with the ids
+// ids[i] (or i if ids is nil). The distances ride in the upper half of a
+// uint64 above their index, so that sort.PartialSort selects them.
+func nearest(dists []float32, ids []int, k int) []Result {
+	k = min(k, len(dists))
+	if k <= 0 {
+		return nil
+	}
+	if len(dists) > math.MaxUint32 {
+		panic("rabitq: too many vectors")
+	}
+	packed := make([]uint64, len(dists))
+	for i, d := range dists {
+		packed[i] = uint64(orderedBits(d))<<32 | uint64(i)
+	}
+	sort.PartialSort(packed, k)
+	results := make([]Result, k)
+	for i, p := range packed[:k] {
+		idx := int(uint32(p))
+		results[i] = Result{ID: idx, Distance: dists[idx]}
+		if ids != nil {
+			results[i].ID = ids[idx]
+		}
+	}
+	return results
+}
+
+// orderedBits maps f to a uint32 with the same order.
+func orderedBits(f float32) uint32 {
+	b := math.Float32bits(f)
+	if b>>31 != 0 {
+		return ^b
+	}
+	return b | 1<<31
+}
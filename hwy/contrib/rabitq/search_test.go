@@ -0,0 +1,153 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rabitq
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+)
+
+func exactSqDist(a, b []float32) float32 {
+	var s float32
+	for i := range a {
+		d := a[i] - b[i]
+		s += d * d
+	}
+	return s
+}
+
+func randomVectors(rng *rand.Rand, n, dims int) []float32 {
+	v := make([]float32, n*dims)
+	for i := range v {
+		v[i] = float32(rng.NormFloat64())
+	}
+	return v
+}
+
+func TestNewQuantizer_Orthogonal(t *testing.T) {
+	const dims = 37
+	qz := NewQuantizer(make([]float32, dims), 1)
+	for i := range dims {
+		for j := range dims {
+			var dot float64
+			for d := range dims {
+				dot += float64(qz.rotation[i*dims+d]) * float64(qz.rotation[j*dims+d])
+			}
+			want := 0.0
+			if i == j {
+				want = 1
+			}
+			if math.Abs(dot-want) > 1e-5 {
+				t.Fatalf("rows %d and %d: dot = %v, want %v", i, j, dot, want)
+			}
+		}
+	}
+}
+
+func TestEstimateDistances(t *testing.T) {
+	const n, dims = 500, 100 // dims not a multiple of 64
+	rng := rand.New(rand.NewPCG(1, 2))
+	// Vectors spread along one direction u, so that the angle term of
+	// the distance matters.
+	u := randomVectors(rng, 1, dims)
+	data := randomVectors(rng, n, dims)
+	for i := range n {
+		a := float32(rng.NormFloat64())
+		for d := range dims {
+			data[i*dims+d] = a*u[d] + 0.3*data[i*dims+d]
+		}
+	}
+	centroid := make([]float32, dims)
+	for i := range n {
+		for d := range dims {
+			centroid[d] += data[i*dims+d] / n
+		}
+	}
+	qz := NewQuantizer(centroid, 42)
+	codes := qz.Encode(data, n)
+
+	query := randomVectors(rng, 1, dims)
+	for d := range query {
+		query[d] = 0.8*u[d] + 0.3*query[d]
+	}
+	est := make([]float32, n)
+	qz.PrepareQuery(query).EstimateDistances(codes, est)
+
+	// Compare with the baseline that ignores the angle, <o,q> = 0.
+	var errEst, errBase float64
+	qNorm := exactSqDist(query, centroid)
+	for i := range n {
+		row := data[i*dims : (i+1)*dims]
+		exact := exactSqDist(query, row)
+		errEst += math.Abs(float64(est[i] - exact))
+		errBase += math.Abs(float64(exactSqDist(row, centroid) + qNorm - exact))
+	}
+	if errEst > errBase/10 {
+		t.Errorf("mean error %.3f, want below a tenth of the baseline %.3f", errEst/n, errBase/n)
+	}
+
+	// The centroid itself is at distance ||q - c||^2 from every query.
+	atCentroid := qz.Encode(centroid, 1)
+	qz.PrepareQuery(query).EstimateDistances(atCentroid, est[:1])
+	if want := exactSqDist(query, centroid); math.Abs(float64(est[0]-want)) > 1e-3*float64(want) {
+		t.Errorf("distance to centroid = %v, want %v", est[0], want)
+	}
+}
+
+func TestSearch(t *testing.T) {
+	const n, dims, k = 2000, 128, 10
+	rng := rand.New(rand.NewPCG(3, 4))
+	data := randomVectors(rng, n, dims)
+	qz := NewQuantizer(make([]float32, dims), 7)
+	codes := qz.Encode(data, n)
+
+	// Query near a known vector.
+	query := make([]float32, dims)
+	for d := range query {
+		query[d] = data[123*dims+d] + 0.05*float32(rng.NormFloat64())
+	}
+
+	results := qz.Search(query, codes, k)
+	if len(results) != k {
+		t.Fatalf("Search returned %d results, want %d", len(results), k)
+	}
+	if results[0].ID != 123 {
+		t.Errorf("Search nearest = %d, want 123", results[0].ID)
+	}
+	for i := 1; i < k; i++ {
+		if results[i].Distance < results[i-1].Distance {
+			t.Fatalf("Search results not sorted: %v", results)
+		}
+	}
+
+	// Re-ranking a shortlist with exact distances recovers the exact top k.
+	exact := func(id int) float32 { return exactSqDist(query, data[id*dims:(id+1)*dims]) }
+	reranked := qz.SearchRerank(query, codes, k, 20*k, exact)
+	all := make([]float32, n)
+	for i := range all {
+		all[i] = exact(i)
+	}
+	want := nearest(all, nil, k)
+	for i := range want {
+		if reranked[i] != want[i] {
+			t.Errorf("SearchRerank[%d] = %v, want %v", i, reranked[i], want[i])
+		}
+	}
+
+	if got := qz.Search(query, codes, n+5); len(got) != n {
+		t.Errorf("Search with k > n returned %d results, want %d", len(got), n)
+	}
+}
@@ -0,0 +1,41 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package rand provides SIMD pseudo-random number generators that fill
+// whole slices at a time, for dropout masks, sampling and Monte-Carlo code
+// that needs millions of values per call.
+//
+// Xoshiro runs Streams xoshiro256++ generators side by side, one per vector
+// lane, and Philox is the counter-based Philox4x32-10 generator. Both fill
+// []uint64 with random bits, []float32 with values uniform in [0, 1), and
+// []float32 with standard normal values using the Box-Muller transform:
+//
+//	r := rand.NewXoshiro(42)
+//	noise := make([]float32, 1<<20)
+//	r.NormFloat32s(noise)
+//
+// The output of a generator depends only on its seed, not on the SIMD
+// target, so results reproduce across machines. Generators are not safe
+// for concurrent use; give each goroutine its own, e.g. NewPhilox with the
+// same seed and different streams.
+//
+// # Build Requirements
+//
+// The SIMD implementations require:
+//   - GOEXPERIMENT=simd build flag
+//   - AMD64 architecture with AVX2 or AVX-512 support, or ARM64 with NEON
+//
+// Philox is pure Go: its 32x32->64-bit multiplies are not among the
+// portable vector operations yet.
+package rand
@@ -0,0 +1,94 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rand
+
+import "math/bits"
+
+// Philox4x32-10 constants.
+const (
+	philoxM0 = 0xD2511F53
+	philoxM1 = 0xCD9E8D57
+	philoxW0 = 0x9E3779B9
+	philoxW1 = 0xBB67AE85
+)
+
+// Philox generates random numbers with the counter-based Philox4x32-10
+// generator of Salmon et al., "Parallel Random Numbers: As Easy as 1, 2,
+// 3": output block c is a keyed bijection of the 128-bit counter c, so any
+// position of the sequence can be reached in constant time with Seek, and
+// generators with the same seed but different streams are independent.
+type Philox struct {
+	key     [2]uint32
+	counter [4]uint32
+	floatBuffers
+}
+
+// NewPhilox returns a Philox generator for the given seed and stream. The
+// stream selects the high 64 bits of the counter, so streams of the same
+// seed never overlap.
+func NewPhilox(seed, stream uint64) *Philox {
+	return &Philox{
+		key:     [2]uint32{uint32(seed), uint32(seed >> 32)},
+		counter: [4]uint32{0, 0, uint32(stream), uint32(stream >> 32)},
+	}
+}
+
+// Seek moves the generator to block n of its stream. Each block yields two
+// uint64 values, so after Seek(n) Uint64s continues with output 2n.
+func (p *Philox) Seek(n uint64) {
+	p.counter[0], p.counter[1] = uint32(n), uint32(n>>32)
+}
+
+// Uint64s fills dst with uniformly distributed random bits. When len(dst)
+// is odd, the second half of the last block is discarded.
+func (p *Philox) Uint64s(dst []uint64) {
+	for i := 0; i < len(dst); i += 2 {
+		x := philox4x32(p.counter, p.key)
+		p.counter[0]++
+		if p.counter[0] == 0 {
+			p.counter[1]++
+		}
+		dst[i] = uint64(x[0]) | uint64(x[1])<<32
+		if i+1 < len(dst) {
+			dst[i+1] = uint64(x[2]) | uint64(x[3])<<32
+		}
+	}
+}
+
+// Float32s fills dst with values uniformly distributed in [0, 1), with 24
+// bits of precision.
+func (p *Philox) Float32s(dst []float32) {
+	p.uniform(p.Uint64s, dst)
+}
+
+// NormFloat32s fills dst with normally distributed values with mean 0 and
+// standard deviation 1.
+func (p *Philox) NormFloat32s(dst []float32) {
+	p.normal(p.Uint64s, dst)
+}
+
+// philox4x32 returns the Philox4x32-10 block of counter c under key k.
+func philox4x32(c [4]uint32, k [2]uint32) [4]uint32 {
+	for round := range 10 {
+		if round > 0 {
+			k[0] += philoxW0
+			k[1] += philoxW1
+		}
+		hi0, lo0 := bits.Mul32(philoxM0, c[0])
+		hi1, lo1 := bits.Mul32(philoxM1, c[2])
+		c = [4]uint32{hi1 ^ c[1] ^ k[0], lo1, hi0 ^ c[3] ^ k[1], lo0}
+	}
+	return c
+}
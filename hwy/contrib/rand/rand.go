@@ -0,0 +1,77 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rand
+
+import (
+	stdmath "math"
+	"unsafe"
+
+	"github.com/ajroetker/go-highway/hwy"
+)
+
+// floatBuffers holds the scratch space of the float methods of a generator.
+type floatBuffers struct {
+	bits   []uint64
+	floats []float32
+}
+
+// uniform fills dst with values uniform in [0, 1), using fill to draw the
+// random bits: each uint64 yields two values.
+func (b *floatBuffers) uniform(fill func([]uint64), dst []float32) {
+	if len(dst) == 0 {
+		return
+	}
+	words := (len(dst) + 1) / 2
+	if cap(b.bits) < words {
+		b.bits = make([]uint64, words)
+	}
+	bits := b.bits[:words]
+	fill(bits)
+	UnitFloat32(unsafe.Slice((*int32)(unsafe.Pointer(&bits[0])), len(dst)), dst)
+}
+
+// normal fills dst with standard normal values, using fill to draw the
+// random bits.
+func (b *floatBuffers) normal(fill func([]uint64), dst []float32) {
+	if len(dst) == 0 {
+		return
+	}
+	half := (len(dst) + 1) / 2
+	if cap(b.floats) < 2*half {
+		b.floats = make([]float32, 2*half)
+	}
+	u := b.floats[:2*half]
+	b.uniform(fill, u)
+	u1, u2 := u[:half], u[half:]
+	BoxMullerFloat32(u1, u2, u1, u2)
+	copy(dst, u)
+}
+
+// unitFloat32Scalar is the scalar version of BaseUnitFloat32.
+func unitFloat32Scalar(bits []int32, dst []float32) {
+	for i := range min(len(bits), len(dst)) {
+		dst[i] = float32(uint32(bits[i])>>8) * (1.0 / (1 << 24))
+	}
+}
+
+// boxMullerScalar is the scalar version of BaseBoxMuller.
+func boxMullerScalar[T hwy.FloatsNative](u1, u2, cosOut, sinOut []T) {
+	for i := range u1 {
+		r := stdmath.Sqrt(-2 * stdmath.Log(1-float64(u1[i])))
+		sin, cos := stdmath.Sincos(2 * stdmath.Pi * float64(u2[i]))
+		cosOut[i] = T(r * cos)
+		sinOut[i] = T(r * sin)
+	}
+}
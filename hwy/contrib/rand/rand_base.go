@@ -0,0 +1,105 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rand
+
+//go:generate go run ../../../cmd/hwygen -input rand_base.go -output . -targets avx2,avx512,neon,fallback -dispatch rand
+
+import (
+	stdmath "math"
+
+	"github.com/ajroetker/go-highway/hwy"
+	"github.com/ajroetker/go-highway/hwy/contrib/math"
+)
+
+// BaseXoshiroFill fills dst with the outputs of the Streams xoshiro256++
+// generators in state and advances them. state holds the four state words
+// of every stream, word-major: state[w*Streams+s] is word w of stream s.
+// dst receives the outputs round by round, dst[k*Streams+s] being the k-th
+// output of stream s; when len(dst) is not a multiple of Streams, only the
+// first len(dst)%Streams streams produce a last output.
+//
+// Each lane of a vector runs one stream, so the output does not depend on
+// the vector width.
+func BaseXoshiroFill(state []uint64, dst []uint64) {
+	lanes := hwy.Zero[uint64]().NumLanes()
+	rounds := len(dst) / Streams * Streams
+	//hwy:unroll 1
+	for j := 0; j < Streams; j += lanes {
+		s0 := hwy.Load(state[j:])
+		s1 := hwy.Load(state[Streams+j:])
+		s2 := hwy.Load(state[2*Streams+j:])
+		s3 := hwy.Load(state[3*Streams+j:])
+		for i := j; i < rounds; i += Streams {
+			// result = rotl(s0 + s3, 23) + s0
+			sum := hwy.Add(s0, s3)
+			result := hwy.Add(hwy.Or(hwy.ShiftLeft(sum, 23), hwy.ShiftRight(sum, 41)), s0)
+			hwy.Store(result, dst[i:])
+
+			t := hwy.ShiftLeft(s1, 17)
+			s2 = hwy.Xor(s2, s0)
+			s3 = hwy.Xor(s3, s1)
+			s1 = hwy.Xor(s1, s2)
+			s0 = hwy.Xor(s0, s3)
+			s2 = hwy.Xor(s2, t)
+			s3 = hwy.Or(hwy.ShiftLeft(s3, 45), hwy.ShiftRight(s3, 19))
+		}
+		hwy.Store(s0, state[j:])
+		hwy.Store(s1, state[Streams+j:])
+		hwy.Store(s2, state[2*Streams+j:])
+		hwy.Store(s3, state[3*Streams+j:])
+	}
+
+	// Last partial round
+	xoshiroFillScalar(state, dst[rounds:])
+}
+
+// BaseUnitFloat32 converts random bits to float32 values uniformly
+// distributed in [0, 1): the high 24 bits of each bits[i] become the
+// mantissa of dst[i].
+func BaseUnitFloat32(bits []int32, dst []float32) {
+	n := min(len(bits), len(dst))
+	lanes := hwy.Zero[float32]().NumLanes()
+	mask := hwy.Set[int32](1<<24 - 1)
+	scale := hwy.Set[float32](1.0 / (1 << 24))
+	var i int
+	for i = 0; i+lanes <= n; i += lanes {
+		v := hwy.And(hwy.ShiftRight(hwy.Load(bits[i:]), 8), mask)
+		hwy.Store(hwy.Mul(hwy.ConvertToFloat32(v), scale), dst[i:])
+	}
+	unitFloat32Scalar(bits[i:n], dst[i:n])
+}
+
+// BaseBoxMuller turns pairs of uniform values in [0, 1) into pairs of
+// independent standard normal values with the Box-Muller transform:
+//
+//	r = sqrt(-2 ln(1-u1)), cosOut = r cos(2π u2), sinOut = r sin(2π u2)
+//
+// u1, u2, cosOut and sinOut must have the same length. cosOut and sinOut
+// may alias u1 and u2.
+func BaseBoxMuller[T hwy.FloatsNative](u1, u2, cosOut, sinOut []T) {
+	n := len(u1)
+	lanes := hwy.Zero[T]().NumLanes()
+	one := hwy.Set[T](1)
+	minusTwo := hwy.Set[T](-2)
+	twoPi := hwy.Set[T](2 * stdmath.Pi)
+	var i int
+	for i = 0; i+lanes <= n; i += lanes {
+		r := hwy.Sqrt(hwy.Mul(minusTwo, math.BaseLogVec(hwy.Sub(one, hwy.Load(u1[i:])))))
+		theta := hwy.Mul(twoPi, hwy.Load(u2[i:]))
+		hwy.Store(hwy.Mul(r, math.BaseCosVec(theta)), cosOut[i:])
+		hwy.Store(hwy.Mul(r, math.BaseSinVec(theta)), sinOut[i:])
+	}
+	boxMullerScalar(u1[i:n], u2[i:n], cosOut[i:n], sinOut[i:n])
+}
@@ -0,0 +1,175 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rand
+
+import (
+	stdmath "math"
+	"testing"
+)
+
+func TestXoshiroStep(t *testing.T) {
+	// Reference outputs of xoshiro256++ from the state {1, 2, 3, 4}.
+	s := [4]uint64{1, 2, 3, 4}
+	want := []uint64{41943041, 58720359, 3588806011781223, 3591011842654386}
+	for i, w := range want {
+		if got := xoshiroStep(&s); got != w {
+			t.Errorf("output %d = %d, want %d", i, got, w)
+		}
+	}
+}
+
+func TestXoshiroUint64s(t *testing.T) {
+	x := NewXoshiro(42)
+	var ref [Streams][4]uint64
+	for s := range Streams {
+		for w := range 4 {
+			ref[s][w] = x.state[w*Streams+s]
+		}
+	}
+	if ref[0] == ref[1] {
+		t.Fatal("streams 0 and 1 have the same state")
+	}
+
+	for _, n := range []int{0, 1, 7, 8, 9, 64, 100, 1000} {
+		got := make([]uint64, n)
+		x.Uint64s(got)
+		for i := range n {
+			if want := xoshiroStep(&ref[i%Streams]); got[i] != want {
+				t.Fatalf("n=%d: output %d = %#x, want %#x", n, i, got[i], want)
+			}
+		}
+	}
+}
+
+func TestPhilox(t *testing.T) {
+	// Known-answer tests from the Random123 distribution.
+	for _, tc := range []struct {
+		c    [4]uint32
+		k    [2]uint32
+		want [4]uint32
+	}{
+		{[4]uint32{}, [2]uint32{}, [4]uint32{0x6627e8d5, 0xe169c58d, 0xbc57ac4c, 0x9b00dbd8}},
+		{
+			[4]uint32{0xffffffff, 0xffffffff, 0xffffffff, 0xffffffff}, [2]uint32{0xffffffff, 0xffffffff},
+			[4]uint32{0x408f276d, 0x41c83b0e, 0xa20bc7c6, 0x6d5451fd},
+		},
+		{
+			[4]uint32{0x243f6a88, 0x85a308d3, 0x13198a2e, 0x03707344}, [2]uint32{0xa4093822, 0x299f31d0},
+			[4]uint32{0xd16cfe09, 0x94fdcceb, 0x5001e420, 0x24126ea1},
+		},
+	} {
+		if got := philox4x32(tc.c, tc.k); got != tc.want {
+			t.Errorf("philox4x32(%#x, %#x) = %#x, want %#x", tc.c, tc.k, got, tc.want)
+		}
+	}
+
+	p := NewPhilox(7, 3)
+	all := make([]uint64, 10)
+	p.Uint64s(all)
+	p.Seek(2)
+	tail := make([]uint64, 6)
+	p.Uint64s(tail)
+	for i := range tail {
+		if tail[i] != all[4+i] {
+			t.Errorf("after Seek(2), output %d = %#x, want %#x", i, tail[i], all[4+i])
+		}
+	}
+
+	other := make([]uint64, 10)
+	NewPhilox(7, 4).Uint64s(other)
+	if other[0] == all[0] {
+		t.Error("streams 3 and 4 start with the same output")
+	}
+}
+
+// generator is implemented by Xoshiro and Philox.
+type generator interface {
+	Float32s(dst []float32)
+	NormFloat32s(dst []float32)
+}
+
+func TestFloat32s(t *testing.T) {
+	for name, g := range map[string]generator{"Xoshiro": NewXoshiro(1), "Philox": NewPhilox(1, 0)} {
+		t.Run(name, func(t *testing.T) {
+			u := make([]float32, 1<<16+3)
+			g.Float32s(u)
+			var sum float64
+			for i, v := range u {
+				if v < 0 || v >= 1 {
+					t.Fatalf("u[%d] = %v, not in [0, 1)", i, v)
+				}
+				sum += float64(v)
+			}
+			if mean := sum / float64(len(u)); stdmath.Abs(mean-0.5) > 0.01 {
+				t.Errorf("mean of uniform values = %v, want 0.5", mean)
+			}
+
+			z := make([]float32, 1<<16+1)
+			g.NormFloat32s(z)
+			var sum2 float64
+			sum = 0
+			for i, v := range z {
+				if stdmath.IsNaN(float64(v)) || stdmath.IsInf(float64(v), 0) {
+					t.Fatalf("z[%d] = %v", i, v)
+				}
+				sum += float64(v)
+				sum2 += float64(v) * float64(v)
+			}
+			mean := sum / float64(len(z))
+			variance := sum2/float64(len(z)) - mean*mean
+			if stdmath.Abs(mean) > 0.02 || stdmath.Abs(variance-1) > 0.03 {
+				t.Errorf("normal values have mean %v and variance %v, want 0 and 1", mean, variance)
+			}
+		})
+	}
+}
+
+func TestBoxMuller(t *testing.T) {
+	n := 37
+	u1 := make([]float32, n)
+	u2 := make([]float32, n)
+	for i := range n {
+		u1[i] = float32(i) / float32(n)
+		u2[i] = float32(n-1-i) / float32(n)
+	}
+	c := make([]float32, n)
+	s := make([]float32, n)
+	BoxMullerFloat32(u1, u2, c, s)
+	for i := range n {
+		r := stdmath.Sqrt(-2 * stdmath.Log(1-float64(u1[i])))
+		sin, cos := stdmath.Sincos(2 * stdmath.Pi * float64(u2[i]))
+		if stdmath.Abs(float64(c[i])-r*cos) > 1e-4 || stdmath.Abs(float64(s[i])-r*sin) > 1e-4 {
+			t.Errorf("BoxMuller(%v, %v) = %v, %v, want %v, %v", u1[i], u2[i], c[i], s[i], r*cos, r*sin)
+		}
+	}
+}
+
+func BenchmarkXoshiroUint64s(b *testing.B) {
+	x := NewXoshiro(1)
+	dst := make([]uint64, 4096)
+	b.SetBytes(int64(len(dst) * 8))
+	for b.Loop() {
+		x.Uint64s(dst)
+	}
+}
+
+func BenchmarkXoshiroNormFloat32s(b *testing.B) {
+	x := NewXoshiro(1)
+	dst := make([]float32, 4096)
+	b.SetBytes(int64(len(dst) * 4))
+	for b.Loop() {
+		x.NormFloat32s(dst)
+	}
+}
@@ -0,0 +1,124 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rand
+
+// Streams is the number of independent xoshiro256++ streams a Xoshiro
+// generator interleaves. It is a multiple of the lane count of every
+// target, so every target produces the same sequence.
+const Streams = 8
+
+// Xoshiro generates random numbers with Streams xoshiro256++ generators,
+// one per vector lane, whose outputs are interleaved: output k*Streams+s is
+// the k-th output of stream s. The streams are 2^128 outputs apart in the
+// sequence of the first, so they never overlap in practice.
+type Xoshiro struct {
+	state [4 * Streams]uint64 // word-major, see BaseXoshiroFill
+	floatBuffers
+}
+
+// NewXoshiro returns a Xoshiro generator seeded with seed, expanded to the
+// state of the first stream with SplitMix64 as recommended by the authors
+// of xoshiro256++.
+func NewXoshiro(seed uint64) *Xoshiro {
+	var s [4]uint64
+	for w := range s {
+		seed, s[w] = splitMix64(seed)
+	}
+	x := &Xoshiro{}
+	for stream := range Streams {
+		for w := range s {
+			x.state[w*Streams+stream] = s[w]
+		}
+		s = xoshiroJump(s)
+	}
+	return x
+}
+
+// Uint64s fills dst with uniformly distributed random bits.
+func (x *Xoshiro) Uint64s(dst []uint64) {
+	XoshiroFill(x.state[:], dst)
+}
+
+// Float32s fills dst with values uniformly distributed in [0, 1), with 24
+// bits of precision.
+func (x *Xoshiro) Float32s(dst []float32) {
+	x.uniform(x.Uint64s, dst)
+}
+
+// NormFloat32s fills dst with normally distributed values with mean 0 and
+// standard deviation 1.
+func (x *Xoshiro) NormFloat32s(dst []float32) {
+	x.normal(x.Uint64s, dst)
+}
+
+// xoshiroFillScalar is the scalar version of BaseXoshiroFill.
+func xoshiroFillScalar(state []uint64, dst []uint64) {
+	for i := range dst {
+		dst[i] = xoshiroNext(state, i%Streams)
+	}
+}
+
+// xoshiroNext returns the next output of stream s of state, see
+// BaseXoshiroFill, and advances it.
+func xoshiroNext(state []uint64, s int) uint64 {
+	w := [4]uint64{state[s], state[Streams+s], state[2*Streams+s], state[3*Streams+s]}
+	result := xoshiroStep(&w)
+	state[s], state[Streams+s], state[2*Streams+s], state[3*Streams+s] = w[0], w[1], w[2], w[3]
+	return result
+}
+
+// xoshiroStep returns the next output of the xoshiro256++ state s and
+// advances it.
+func xoshiroStep(s *[4]uint64) uint64 {
+	result := rotl(s[0]+s[3], 23) + s[0]
+	t := s[1] << 17
+	s[2] ^= s[0]
+	s[3] ^= s[1]
+	s[1] ^= s[2]
+	s[0] ^= s[3]
+	s[2] ^= t
+	s[3] = rotl(s[3], 45)
+	return result
+}
+
+// xoshiroJump returns s advanced by 2^128 outputs.
+func xoshiroJump(s [4]uint64) [4]uint64 {
+	jump := [4]uint64{0x180ec6d33cfd0aba, 0xd5a61266f0c9392c, 0xa9582618e03fc9aa, 0x39abdc4529b1661c}
+	var out [4]uint64
+	for _, j := range jump {
+		for b := range 64 {
+			if j&(1<<b) != 0 {
+				for w := range out {
+					out[w] ^= s[w]
+				}
+			}
+			xoshiroStep(&s)
+		}
+	}
+	return out
+}
+
+// splitMix64 returns the next state and output of the SplitMix64 generator.
+func splitMix64(state uint64) (next, out uint64) {
+	next = state + 0x9e3779b97f4a7c15
+	z := next
+	z = (z ^ z>>30) * 0xbf58476d1ce4e5b9
+	z = (z ^ z>>27) * 0x94d049bb133111eb
+	return next, z ^ z>>31
+}
+
+func rotl(x uint64, k int) uint64 {
+	return x<<k | x>>(64-k)
+}
@@ -0,0 +1,31 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rand
+
+import "github.com/ajroetker/go-highway/hwy"
+
+// The hwy.Vec fallbacks of the rand kernels allocate on every operation:
+// hwygen cannot scalarize their shifts, bitwise operations and square
+// roots. Where dispatch bound them, bind the plain scalar loops instead.
+// The file name sorts after the dispatch files, so this init runs last.
+func init() {
+	if hwy.KernelImplementation("rand.XoshiroFill") != hwy.BoundImplementation(BaseXoshiroFill_fallback) {
+		return
+	}
+	XoshiroFill = xoshiroFillScalar
+	UnitFloat32 = unitFloat32Scalar
+	BoxMullerFloat32 = boxMullerScalar[float32]
+	BoxMullerFloat64 = boxMullerScalar[float64]
+}
@@ -46,6 +46,31 @@ func BaseCompressPartition3Way[T hwy.Lanes](data []T, pivot T) (int, int) {
 	return lt, gt
 }
 
+// BaseCompressPartition3WayDescending partitions data into three regions
+// around pivot, larger elements first, for descending sorts.
+// Returns (gt, lt) where:
+//   - data[0:gt] > pivot
+//   - data[gt:lt] == pivot
+//   - data[lt:n] < pivot
+func BaseCompressPartition3WayDescending[T hwy.Lanes](data []T, pivot T) (int, int) {
+	gt := 0
+	lt := len(data)
+	i := 0
+	for i < lt {
+		if data[i] > pivot {
+			data[gt], data[i] = data[i], data[gt]
+			gt++
+			i++
+		} else if data[i] < pivot {
+			lt--
+			data[i], data[lt] = data[lt], data[i]
+		} else {
+			i++
+		}
+	}
+	return gt, lt
+}
+
 // BaseCompressPartition partitions data using Highway's double-store technique.
 // Returns idx where data[0:idx] < pivot and data[idx:n] >= pivot.
 // This is an in-place O(1) space algorithm.
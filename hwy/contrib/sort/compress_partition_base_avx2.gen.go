@@ -125,6 +125,120 @@ func BaseCompressPartition3Way_avx2_Uint64(data []uint64, pivot uint64) (int, in
 	return lt, gt
 }
 
+func BaseCompressPartition3WayDescending_avx2(data []float32, pivot float32) (int, int) {
+	gt := 0
+	lt := len(data)
+	i := 0
+	for i < lt {
+		if data[i] > pivot {
+			data[gt], data[i] = data[i], data[gt]
+			gt++
+			i++
+		} else if data[i] < pivot {
+			lt--
+			data[i], data[lt] = data[lt], data[i]
+		} else {
+			i++
+		}
+	}
+	return gt, lt
+}
+
+func BaseCompressPartition3WayDescending_avx2_Float64(data []float64, pivot float64) (int, int) {
+	gt := 0
+	lt := len(data)
+	i := 0
+	for i < lt {
+		if data[i] > pivot {
+			data[gt], data[i] = data[i], data[gt]
+			gt++
+			i++
+		} else if data[i] < pivot {
+			lt--
+			data[i], data[lt] = data[lt], data[i]
+		} else {
+			i++
+		}
+	}
+	return gt, lt
+}
+
+func BaseCompressPartition3WayDescending_avx2_Int32(data []int32, pivot int32) (int, int) {
+	gt := 0
+	lt := len(data)
+	i := 0
+	for i < lt {
+		if data[i] > pivot {
+			data[gt], data[i] = data[i], data[gt]
+			gt++
+			i++
+		} else if data[i] < pivot {
+			lt--
+			data[i], data[lt] = data[lt], data[i]
+		} else {
+			i++
+		}
+	}
+	return gt, lt
+}
+
+func BaseCompressPartition3WayDescending_avx2_Int64(data []int64, pivot int64) (int, int) {
+	gt := 0
+	lt := len(data)
+	i := 0
+	for i < lt {
+		if data[i] > pivot {
+			data[gt], data[i] = data[i], data[gt]
+			gt++
+			i++
+		} else if data[i] < pivot {
+			lt--
+			data[i], data[lt] = data[lt], data[i]
+		} else {
+			i++
+		}
+	}
+	return gt, lt
+}
+
+func BaseCompressPartition3WayDescending_avx2_Uint32(data []uint32, pivot uint32) (int, int) {
+	gt := 0
+	lt := len(data)
+	i := 0
+	for i < lt {
+		if data[i] > pivot {
+			data[gt], data[i] = data[i], data[gt]
+			gt++
+			i++
+		} else if data[i] < pivot {
+			lt--
+			data[i], data[lt] = data[lt], data[i]
+		} else {
+			i++
+		}
+	}
+	return gt, lt
+}
+
+func BaseCompressPartition3WayDescending_avx2_Uint64(data []uint64, pivot uint64) (int, int) {
+	gt := 0
+	lt := len(data)
+	i := 0
+	for i < lt {
+		if data[i] > pivot {
+			data[gt], data[i] = data[i], data[gt]
+			gt++
+			i++
+		} else if data[i] < pivot {
+			lt--
+			data[i], data[lt] = data[lt], data[i]
+		} else {
+			i++
+		}
+	}
+	return gt, lt
+}
+
 func BaseCompressPartition_avx2(data []float32, pivot float32) int {
 	n := len(data)
 	if n == 0 {
@@ -14,6 +14,12 @@ var CompressPartition3WayInt32 func(data []int32, pivot int32) (int, int)
 var CompressPartition3WayInt64 func(data []int64, pivot int64) (int, int)
 var CompressPartition3WayUint32 func(data []uint32, pivot uint32) (int, int)
 var CompressPartition3WayUint64 func(data []uint64, pivot uint64) (int, int)
+var CompressPartition3WayDescendingFloat32 func(data []float32, pivot float32) (int, int)
+var CompressPartition3WayDescendingFloat64 func(data []float64, pivot float64) (int, int)
+var CompressPartition3WayDescendingInt32 func(data []int32, pivot int32) (int, int)
+var CompressPartition3WayDescendingInt64 func(data []int64, pivot int64) (int, int)
+var CompressPartition3WayDescendingUint32 func(data []uint32, pivot uint32) (int, int)
+var CompressPartition3WayDescendingUint64 func(data []uint64, pivot uint64) (int, int)
 var CompressPartitionFloat32 func(data []float32, pivot float32) int
 var CompressPartitionFloat64 func(data []float64, pivot float64) int
 var CompressPartitionInt32 func(data []int32, pivot int32) int
@@ -48,6 +54,32 @@ func CompressPartition3Way[T hwy.Lanes](data []T, pivot T) (int, int) {
 	panic("unreachable")
 }
 
+// CompressPartition3WayDescending partitions data into three regions
+// around pivot, larger elements first, for descending sorts.
+// Returns (gt, lt) where:
+//   - data[0:gt] > pivot
+//   - data[gt:lt] == pivot
+//   - data[lt:n] < pivot
+//
+// This function dispatches to the appropriate SIMD implementation at runtime.
+func CompressPartition3WayDescending[T hwy.Lanes](data []T, pivot T) (int, int) {
+	switch any(data).(type) {
+	case []float32:
+		return CompressPartition3WayDescendingFloat32(any(data).([]float32), any(pivot).(float32))
+	case []float64:
+		return CompressPartition3WayDescendingFloat64(any(data).([]float64), any(pivot).(float64))
+	case []int32:
+		return CompressPartition3WayDescendingInt32(any(data).([]int32), any(pivot).(int32))
+	case []int64:
+		return CompressPartition3WayDescendingInt64(any(data).([]int64), any(pivot).(int64))
+	case []uint32:
+		return CompressPartition3WayDescendingUint32(any(data).([]uint32), any(pivot).(uint32))
+	case []uint64:
+		return CompressPartition3WayDescendingUint64(any(data).([]uint64), any(pivot).(uint64))
+	}
+	panic("unreachable")
+}
+
 // CompressPartition partitions data using Highway's double-store technique.
 // Returns idx where data[0:idx] < pivot and data[idx:n] >= pivot.
 // This is an in-place O(1) space algorithm.
@@ -83,6 +115,12 @@ func initCompress_partitionFallback() {
 	CompressPartition3WayInt64 = BaseCompressPartition3Way_fallback_Int64
 	CompressPartition3WayUint32 = BaseCompressPartition3Way_fallback_Uint32
 	CompressPartition3WayUint64 = BaseCompressPartition3Way_fallback_Uint64
+	CompressPartition3WayDescendingFloat32 = BaseCompressPartition3WayDescending_fallback
+	CompressPartition3WayDescendingFloat64 = BaseCompressPartition3WayDescending_fallback_Float64
+	CompressPartition3WayDescendingInt32 = BaseCompressPartition3WayDescending_fallback_Int32
+	CompressPartition3WayDescendingInt64 = BaseCompressPartition3WayDescending_fallback_Int64
+	CompressPartition3WayDescendingUint32 = BaseCompressPartition3WayDescending_fallback_Uint32
+	CompressPartition3WayDescendingUint64 = BaseCompressPartition3WayDescending_fallback_Uint64
 	CompressPartitionFloat32 = BaseCompressPartition_fallback
 	CompressPartitionFloat64 = BaseCompressPartition_fallback_Float64
 	CompressPartitionInt32 = BaseCompressPartition_fallback_Int32
@@ -90,3 +128,26 @@ func initCompress_partitionFallback() {
 	CompressPartitionUint32 = BaseCompressPartition_fallback_Uint32
 	CompressPartitionUint64 = BaseCompressPartition_fallback_Uint64
 }
+
+func init() {
+	hwy.RegisterKernel("sort.CompressPartition3WayFloat32", &CompressPartition3WayFloat32)
+	hwy.RegisterKernel("sort.CompressPartition3WayFloat64", &CompressPartition3WayFloat64)
+	hwy.RegisterKernel("sort.CompressPartition3WayInt32", &CompressPartition3WayInt32)
+	hwy.RegisterKernel("sort.CompressPartition3WayInt64", &CompressPartition3WayInt64)
+	hwy.RegisterKernel("sort.CompressPartition3WayUint32", &CompressPartition3WayUint32)
+	hwy.RegisterKernel("sort.CompressPartition3WayUint64", &CompressPartition3WayUint64)
+	hwy.RegisterKernel("sort.CompressPartition3WayDescendingFloat32", &CompressPartition3WayDescendingFloat32)
+	hwy.RegisterKernel("sort.CompressPartition3WayDescendingFloat64", &CompressPartition3WayDescendingFloat64)
+	hwy.RegisterKernel("sort.CompressPartition3WayDescendingInt32", &CompressPartition3WayDescendingInt32)
+	hwy.RegisterKernel("sort.CompressPartition3WayDescendingInt64", &CompressPartition3WayDescendingInt64)
+	hwy.RegisterKernel("sort.CompressPartition3WayDescendingUint32", &CompressPartition3WayDescendingUint32)
+	hwy.RegisterKernel("sort.CompressPartition3WayDescendingUint64", &CompressPartition3WayDescendingUint64)
+	hwy.RegisterKernel("sort.CompressPartitionFloat32", &CompressPartitionFloat32)
+	hwy.RegisterKernel("sort.CompressPartitionFloat64", &CompressPartitionFloat64)
+	hwy.RegisterKernel("sort.CompressPartitionInt32", &CompressPartitionInt32)
+	hwy.RegisterKernel("sort.CompressPartitionInt64", &CompressPartitionInt64)
+	hwy.RegisterKernel("sort.CompressPartitionUint32", &CompressPartitionUint32)
+	hwy.RegisterKernel("sort.CompressPartitionUint64", &CompressPartitionUint64)
+	hwyKernels := []string{"sort.CompressPartition3WayFloat32", "sort.CompressPartition3WayFloat64", "sort.CompressPartition3WayInt32", "sort.CompressPartition3WayInt64", "sort.CompressPartition3WayUint32", "sort.CompressPartition3WayUint64", "sort.CompressPartition3WayDescendingFloat32", "sort.CompressPartition3WayDescendingFloat64", "sort.CompressPartition3WayDescendingInt32", "sort.CompressPartition3WayDescendingInt64", "sort.CompressPartition3WayDescendingUint32", "sort.CompressPartition3WayDescendingUint64", "sort.CompressPartitionFloat32", "sort.CompressPartitionFloat64", "sort.CompressPartitionInt32", "sort.CompressPartitionInt64", "sort.CompressPartitionUint32", "sort.CompressPartitionUint64"}
+	hwy.RegisterKernelTarget(hwy.DispatchScalar, initCompress_partitionFallback, hwyKernels...)
+}
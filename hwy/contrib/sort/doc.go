@@ -31,6 +31,13 @@
 // The sort functions support these numeric types:
 //   - float32, float64 (floating point)
 //   - int32, int64 (signed integers)
+//   - uint32, uint64 (unsigned integers, e.g. packed IDs and timestamps)
+//   - int16, uint16 (scalar networks and partitioning)
+//
+// hwy has no 16-bit compress or mask operations on AVX2 and AVX-512, so
+// VQSort sorts int16 and uint16 with the same introsort but scalar kernels,
+// and Sort uses a counting sort for them. IsSorted and SortSmall only cover
+// the 32- and 64-bit types.
 //
 // # Example Usage
 //
@@ -44,6 +51,40 @@
 //	    return sort.IsSorted(data)
 //	}
 //
+// # Sort order
+//
+// VQSortOrder takes an Order, Ascending or Descending, and SortDescending
+// is its descending shorthand. A descending sort partitions the larger
+// elements to the front instead of sorting ascending and reversing, so it
+// makes no extra pass over the data.
+//
+// # Selection
+//
+// NthElement, PartialSort and TopK only partition as far as needed to place
+// the requested elements: PartialSort(data, k) sorts the k smallest into
+// data[:k] and TopK(data, k) the k largest, in descending order, without
+// sorting the rest. Selecting the top 64 of a large slice of scores costs
+// little more than a single partitioning pass.
+//
+// Select returns the k-th smallest element itself, for medians and
+// percentiles over large buffers:
+//
+//	p99 := sort.Select(latencies, len(latencies)*99/100)
+//
+// # Key-value sorting
+//
+// SortKV sorts 32-bit keys and moves a uint32 payload with them, and
+// ArgSort returns the stable sorting permutation of a slice. For 32-bit
+// keys both pack key and payload (or index) into one uint64 and sort that
+// with VQSort, as Highway does for its key-value sorts:
+//
+//	idx := sort.ArgSort(scores) // scores[idx[0]] is the smallest
+//
+// SortStable applies that permutation to keys and to a slice of rows of any
+// type, so rows with equal keys keep their order:
+//
+//	sort.SortStable(timestamps, rows)
+//
 // # Performance
 //
 // VQSort typically achieves 2-4x speedup over standard library sort for:
@@ -49,6 +49,18 @@ func MaxValue[T hwy.Lanes]() T {
 		maxVal = T(any(int32(2147483647)).(int32))
 	case int64:
 		maxVal = T(any(int64(9223372036854775807)).(int64))
+	case int16:
+		maxVal = T(any(int16(32767)).(int16))
+	case int8:
+		maxVal = T(any(int8(127)).(int8))
+	case uint64:
+		maxVal = T(any(uint64(18446744073709551615)).(uint64))
+	case uint32:
+		maxVal = T(any(uint32(4294967295)).(uint32))
+	case uint16:
+		maxVal = T(any(uint16(65535)).(uint16))
+	case uint8:
+		maxVal = T(any(uint8(255)).(uint8))
 	}
 	return maxVal
 }
@@ -163,6 +175,29 @@ func scalarPartition3Way[T hwy.Lanes](data []T, pivot T) (int, int) {
 	return lt, gt
 }
 
+// scalarPartition3WayDescending is scalarPartition3Way with the regions
+// mirrored: data[:gt] > pivot and data[lt:] < pivot.
+func scalarPartition3WayDescending[T hwy.Lanes](data []T, pivot T) (int, int) {
+	gt := 0
+	lt := len(data)
+	i := 0
+
+	for i < lt {
+		if data[i] > pivot {
+			data[gt], data[i] = data[i], data[gt]
+			gt++
+			i++
+		} else if data[i] < pivot {
+			lt--
+			data[i], data[lt] = data[lt], data[i]
+		} else {
+			i++
+		}
+	}
+
+	return gt, lt
+}
+
 // SortTwoVectors sorts elements that fit in two vectors using bitonic merge.
 func SortTwoVectors[T hwy.Lanes](data []T) {
 	n := len(data)
@@ -251,6 +286,71 @@ func RadixSort[T hwy.SignedInts](data []T) {
 		RadixPass16(temp, data, 16)
 		RadixPass16(data, temp, 32)
 		RadixPass16Signed(temp, data, 48)
+	case int16:
+		countingSort(any(data).([]int16))
+	case int8:
+		countingSort(any(data).([]int8))
+	}
+}
+
+// RadixSortUint32 sorts uint32 data using LSD radix sort.
+func RadixSortUint32(data []uint32) {
+	n := len(data)
+	if n <= 1 {
+		return
+	}
+
+	if n < radixSortThreshold {
+		slices.Sort(data)
+		return
+	}
+
+	temp := make([]uint32, n)
+	radixPassU32(data, temp, 0)
+	radixPassU32(temp, data, 8)
+	radixPassU32(data, temp, 16)
+	radixPassU32(temp, data, 24)
+}
+
+// RadixSortUint64 sorts uint64 data using LSD radix sort.
+func RadixSortUint64(data []uint64) {
+	n := len(data)
+	if n <= 1 {
+		return
+	}
+
+	if n < radixSortThreshold {
+		slices.Sort(data)
+		return
+	}
+
+	temp := make([]uint64, n)
+	radixPass16U64(data, temp, 0)
+	radixPass16U64(temp, data, 16)
+	radixPass16U64(data, temp, 32)
+	radixPass16U64(temp, data, 48)
+}
+
+// countingSort sorts 8- and 16-bit data by counting the occurrences of each
+// value between the minimum and the maximum, at most 64K counters.
+func countingSort[T ~int8 | ~int16 | ~uint8 | ~uint16](data []T) {
+	if len(data) <= 1 {
+		return
+	}
+
+	lo, hi := slices.Min(data), slices.Max(data)
+	counts := make([]int, int(hi)-int(lo)+1)
+	for _, v := range data {
+		counts[int(v)-int(lo)]++
+	}
+
+	i := 0
+	for d, c := range counts {
+		v := T(int(lo) + d)
+		for range c {
+			data[i] = v
+			i++
+		}
 	}
 }
 
@@ -0,0 +1,166 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sort
+
+import (
+	"math"
+	"slices"
+
+	"github.com/ajroetker/go-highway/hwy"
+)
+
+// Key-value sorting.
+//
+// As in Highway's 128-bit key-value sort, a 32-bit key and its 32-bit
+// payload are packed into one uint64, key in the upper half, so that VQSort
+// moves them together and the packed order is the key order. Keys are first
+// mapped to unsigned integers with the same order.
+
+// SortKV sorts keys in ascending order and permutes values along with them:
+// values[i] stays paired with keys[i]. Elements with equal keys are ordered
+// by value. keys and values must have the same length.
+//
+// float32 keys are ordered by their IEEE 754 total order: -0 before +0 and
+// NaNs after +Inf (or before -Inf for negative NaNs).
+func SortKV[K float32 | int32 | uint32](keys []K, values []uint32) {
+	if len(keys) != len(values) {
+		panic("sort: keys and values have different lengths")
+	}
+	n := len(keys)
+	if n <= 1 {
+		return
+	}
+	packed := make([]uint64, n)
+	for i, k := range keys {
+		packed[i] = uint64(keyBits(k))<<32 | uint64(values[i])
+	}
+	VQSort(packed)
+	for i, p := range packed {
+		keys[i] = keyFromBits[K](uint32(p >> 32))
+		values[i] = uint32(p)
+	}
+}
+
+// ArgSort returns the permutation that sorts data in ascending order:
+// data[idx[0]] <= data[idx[1]] <= ... Equal elements keep their order,
+// so the sort is stable. data is not modified.
+//
+// For float32, int32 and uint32 the indices ride along with the keys
+// through VQSort as in SortKV; 64-bit types fall back to a scalar stable
+// sort of the indices.
+func ArgSort[T hwy.Lanes](data []T) []int32 {
+	n := len(data)
+	if n > math.MaxInt32 {
+		panic("sort: ArgSort slice too long for int32 indices")
+	}
+	idx := make([]int32, n)
+	switch d := any(data).(type) {
+	case []float32:
+		argSortPacked(d, idx)
+	case []int32:
+		argSortPacked(d, idx)
+	case []uint32:
+		argSortPacked(d, idx)
+	default:
+		for i := range idx {
+			idx[i] = int32(i)
+		}
+		slices.SortStableFunc(idx, func(a, b int32) int {
+			switch {
+			case data[a] < data[b]:
+				return -1
+			case data[a] > data[b]:
+				return 1
+			}
+			return 0
+		})
+	}
+	return idx
+}
+
+// SortStable sorts keys in ascending order and permutes values along with
+// them, keeping elements with equal keys in their original order, as
+// database-style multi-pass sorts require. values may be nil to sort the
+// keys alone; otherwise it must have the same length as keys.
+//
+// It applies the permutation from ArgSort, so float32, int32 and uint32 keys
+// are sorted with VQSort with the indices packed under them, and float32
+// keys follow the same total order as SortKV.
+func SortStable[K hwy.Lanes, V any](keys []K, values []V) {
+	if values != nil && len(keys) != len(values) {
+		panic("sort: keys and values have different lengths")
+	}
+	if len(keys) <= 1 {
+		return
+	}
+	idx := ArgSort(keys)
+	permute(keys, idx)
+	if values != nil {
+		permute(values, idx)
+	}
+}
+
+// permute rearranges data so that data[i] becomes the old data[idx[i]].
+func permute[T any](data []T, idx []int32) {
+	src := slices.Clone(data)
+	for i, j := range idx {
+		data[i] = src[j]
+	}
+}
+
+// argSortPacked stores in idx the stable sorting permutation of the 32-bit
+// keys, packing each index under its key.
+func argSortPacked[K float32 | int32 | uint32](keys []K, idx []int32) {
+	packed := make([]uint64, len(keys))
+	for i, k := range keys {
+		packed[i] = uint64(keyBits(k))<<32 | uint64(i)
+	}
+	VQSort(packed)
+	for i, p := range packed {
+		idx[i] = int32(uint32(p))
+	}
+}
+
+// keyBits maps a 32-bit key to a uint32 with the same order.
+func keyBits[K float32 | int32 | uint32](k K) uint32 {
+	switch v := any(k).(type) {
+	case float32:
+		b := math.Float32bits(v)
+		if b>>31 != 0 {
+			return ^b
+		}
+		return b | 1<<31
+	case int32:
+		return uint32(v) ^ 1<<31
+	default:
+		return uint32(k)
+	}
+}
+
+// keyFromBits inverts keyBits.
+func keyFromBits[K float32 | int32 | uint32](b uint32) K {
+	var k K
+	switch any(k).(type) {
+	case float32:
+		if b>>31 != 0 {
+			return K(math.Float32frombits(b &^ (1 << 31)))
+		}
+		return K(math.Float32frombits(^b))
+	case int32:
+		return K(int32(b ^ 1<<31))
+	default:
+		return K(b)
+	}
+}
@@ -71,11 +71,11 @@ func init() {
 		initNetworkFallback()
 		return
 	}
-	if archsimd.X86.AVX512() {
+	if archsimd.X86.AVX512() && hwy.TargetEnabled(hwy.DispatchAVX512) {
 		initNetworkAVX512()
 		return
 	}
-	if archsimd.X86.AVX2() {
+	if archsimd.X86.AVX2() && hwy.TargetEnabled(hwy.DispatchAVX2) {
 		initNetworkAVX2()
 		return
 	}
@@ -126,3 +126,22 @@ func initNetworkFallback() {
 	IsSortedUint32 = BaseIsSorted_fallback_Uint32
 	IsSortedUint64 = BaseIsSorted_fallback_Uint64
 }
+
+func init() {
+	hwy.RegisterKernel("sort.SortSmallFloat32", &SortSmallFloat32)
+	hwy.RegisterKernel("sort.SortSmallFloat64", &SortSmallFloat64)
+	hwy.RegisterKernel("sort.SortSmallInt32", &SortSmallInt32)
+	hwy.RegisterKernel("sort.SortSmallInt64", &SortSmallInt64)
+	hwy.RegisterKernel("sort.SortSmallUint32", &SortSmallUint32)
+	hwy.RegisterKernel("sort.SortSmallUint64", &SortSmallUint64)
+	hwy.RegisterKernel("sort.IsSortedFloat32", &IsSortedFloat32)
+	hwy.RegisterKernel("sort.IsSortedFloat64", &IsSortedFloat64)
+	hwy.RegisterKernel("sort.IsSortedInt32", &IsSortedInt32)
+	hwy.RegisterKernel("sort.IsSortedInt64", &IsSortedInt64)
+	hwy.RegisterKernel("sort.IsSortedUint32", &IsSortedUint32)
+	hwy.RegisterKernel("sort.IsSortedUint64", &IsSortedUint64)
+	hwyKernels := []string{"sort.SortSmallFloat32", "sort.SortSmallFloat64", "sort.SortSmallInt32", "sort.SortSmallInt64", "sort.SortSmallUint32", "sort.SortSmallUint64", "sort.IsSortedFloat32", "sort.IsSortedFloat64", "sort.IsSortedInt32", "sort.IsSortedInt64", "sort.IsSortedUint32", "sort.IsSortedUint64"}
+	hwy.RegisterKernelTarget(hwy.DispatchAVX2, initNetworkAVX2, hwyKernels...)
+	hwy.RegisterKernelTarget(hwy.DispatchAVX512, initNetworkAVX512, hwyKernels...)
+	hwy.RegisterKernelTarget(hwy.DispatchScalar, initNetworkFallback, hwyKernels...)
+}
@@ -106,3 +106,21 @@ func initPartitionFallback() {
 	PartitionUint32 = BasePartition_fallback_Uint32
 	PartitionUint64 = BasePartition_fallback_Uint64
 }
+
+func init() {
+	hwy.RegisterKernel("sort.Partition3WayFloat32", &Partition3WayFloat32)
+	hwy.RegisterKernel("sort.Partition3WayFloat64", &Partition3WayFloat64)
+	hwy.RegisterKernel("sort.Partition3WayInt32", &Partition3WayInt32)
+	hwy.RegisterKernel("sort.Partition3WayInt64", &Partition3WayInt64)
+	hwy.RegisterKernel("sort.Partition3WayUint32", &Partition3WayUint32)
+	hwy.RegisterKernel("sort.Partition3WayUint64", &Partition3WayUint64)
+	hwy.RegisterKernel("sort.PartitionFloat32", &PartitionFloat32)
+	hwy.RegisterKernel("sort.PartitionFloat64", &PartitionFloat64)
+	hwy.RegisterKernel("sort.PartitionInt32", &PartitionInt32)
+	hwy.RegisterKernel("sort.PartitionInt64", &PartitionInt64)
+	hwy.RegisterKernel("sort.PartitionUint32", &PartitionUint32)
+	hwy.RegisterKernel("sort.PartitionUint64", &PartitionUint64)
+	hwyKernels := []string{"sort.Partition3WayFloat32", "sort.Partition3WayFloat64", "sort.Partition3WayInt32", "sort.Partition3WayInt64", "sort.Partition3WayUint32", "sort.Partition3WayUint64", "sort.PartitionFloat32", "sort.PartitionFloat64", "sort.PartitionInt32", "sort.PartitionInt64", "sort.PartitionUint32", "sort.PartitionUint64"}
+	hwy.RegisterKernelTarget(hwy.DispatchNEON, initPartitionNEON, hwyKernels...)
+	hwy.RegisterKernelTarget(hwy.DispatchScalar, initPartitionFallback, hwyKernels...)
+}
@@ -78,11 +78,11 @@ func init() {
 		initRadixFallback()
 		return
 	}
-	if archsimd.X86.AVX512() {
+	if archsimd.X86.AVX512() && hwy.TargetEnabled(hwy.DispatchAVX512) {
 		initRadixAVX512()
 		return
 	}
-	if archsimd.X86.AVX2() {
+	if archsimd.X86.AVX2() && hwy.TargetEnabled(hwy.DispatchAVX2) {
 		initRadixAVX2()
 		return
 	}
@@ -121,3 +121,18 @@ func initRadixFallback() {
 	RadixPassSignedInt32 = BaseRadixPassSigned_fallback_Int32
 	RadixPassSignedInt64 = BaseRadixPassSigned_fallback_Int64
 }
+
+func init() {
+	hwy.RegisterKernel("sort.RadixPassInt32", &RadixPassInt32)
+	hwy.RegisterKernel("sort.RadixPassInt64", &RadixPassInt64)
+	hwy.RegisterKernel("sort.RadixPass16Int32", &RadixPass16Int32)
+	hwy.RegisterKernel("sort.RadixPass16Int64", &RadixPass16Int64)
+	hwy.RegisterKernel("sort.RadixPass16SignedInt32", &RadixPass16SignedInt32)
+	hwy.RegisterKernel("sort.RadixPass16SignedInt64", &RadixPass16SignedInt64)
+	hwy.RegisterKernel("sort.RadixPassSignedInt32", &RadixPassSignedInt32)
+	hwy.RegisterKernel("sort.RadixPassSignedInt64", &RadixPassSignedInt64)
+	hwyKernels := []string{"sort.RadixPassInt32", "sort.RadixPassInt64", "sort.RadixPass16Int32", "sort.RadixPass16Int64", "sort.RadixPass16SignedInt32", "sort.RadixPass16SignedInt64", "sort.RadixPassSignedInt32", "sort.RadixPassSignedInt64"}
+	hwy.RegisterKernelTarget(hwy.DispatchAVX2, initRadixAVX2, hwyKernels...)
+	hwy.RegisterKernelTarget(hwy.DispatchAVX512, initRadixAVX512, hwyKernels...)
+	hwy.RegisterKernelTarget(hwy.DispatchScalar, initRadixFallback, hwyKernels...)
+}
@@ -57,11 +57,11 @@ func init() {
 		initRadix_floatFallback()
 		return
 	}
-	if archsimd.X86.AVX512() {
+	if archsimd.X86.AVX512() && hwy.TargetEnabled(hwy.DispatchAVX512) {
 		initRadix_floatAVX512()
 		return
 	}
-	if archsimd.X86.AVX2() {
+	if archsimd.X86.AVX2() && hwy.TargetEnabled(hwy.DispatchAVX2) {
 		initRadix_floatAVX2()
 		return
 	}
@@ -100,3 +100,18 @@ func initRadix_floatFallback() {
 	SortableToFloatFloat32 = BaseSortableToFloat_fallback
 	SortableToFloatFloat64 = BaseSortableToFloat_fallback_Float64
 }
+
+func init() {
+	hwy.RegisterKernel("sort.FloatToSortableFloat16", &FloatToSortableFloat16)
+	hwy.RegisterKernel("sort.FloatToSortableBFloat16", &FloatToSortableBFloat16)
+	hwy.RegisterKernel("sort.FloatToSortableFloat32", &FloatToSortableFloat32)
+	hwy.RegisterKernel("sort.FloatToSortableFloat64", &FloatToSortableFloat64)
+	hwy.RegisterKernel("sort.SortableToFloatFloat16", &SortableToFloatFloat16)
+	hwy.RegisterKernel("sort.SortableToFloatBFloat16", &SortableToFloatBFloat16)
+	hwy.RegisterKernel("sort.SortableToFloatFloat32", &SortableToFloatFloat32)
+	hwy.RegisterKernel("sort.SortableToFloatFloat64", &SortableToFloatFloat64)
+	hwyKernels := []string{"sort.FloatToSortableFloat16", "sort.FloatToSortableBFloat16", "sort.FloatToSortableFloat32", "sort.FloatToSortableFloat64", "sort.SortableToFloatFloat16", "sort.SortableToFloatBFloat16", "sort.SortableToFloatFloat32", "sort.SortableToFloatFloat64"}
+	hwy.RegisterKernelTarget(hwy.DispatchAVX2, initRadix_floatAVX2, hwyKernels...)
+	hwy.RegisterKernelTarget(hwy.DispatchAVX512, initRadix_floatAVX512, hwyKernels...)
+	hwy.RegisterKernelTarget(hwy.DispatchScalar, initRadix_floatFallback, hwyKernels...)
+}
@@ -80,3 +80,17 @@ func initRadix_floatFallback() {
 	SortableToFloatFloat32 = BaseSortableToFloat_fallback
 	SortableToFloatFloat64 = BaseSortableToFloat_fallback_Float64
 }
+
+func init() {
+	hwy.RegisterKernel("sort.FloatToSortableFloat16", &FloatToSortableFloat16)
+	hwy.RegisterKernel("sort.FloatToSortableBFloat16", &FloatToSortableBFloat16)
+	hwy.RegisterKernel("sort.FloatToSortableFloat32", &FloatToSortableFloat32)
+	hwy.RegisterKernel("sort.FloatToSortableFloat64", &FloatToSortableFloat64)
+	hwy.RegisterKernel("sort.SortableToFloatFloat16", &SortableToFloatFloat16)
+	hwy.RegisterKernel("sort.SortableToFloatBFloat16", &SortableToFloatBFloat16)
+	hwy.RegisterKernel("sort.SortableToFloatFloat32", &SortableToFloatFloat32)
+	hwy.RegisterKernel("sort.SortableToFloatFloat64", &SortableToFloatFloat64)
+	hwyKernels := []string{"sort.FloatToSortableFloat16", "sort.FloatToSortableBFloat16", "sort.FloatToSortableFloat32", "sort.FloatToSortableFloat64", "sort.SortableToFloatFloat16", "sort.SortableToFloatBFloat16", "sort.SortableToFloatFloat32", "sort.SortableToFloatFloat64"}
+	hwy.RegisterKernelTarget(hwy.DispatchNEON, initRadix_floatNEON, hwyKernels...)
+	hwy.RegisterKernelTarget(hwy.DispatchScalar, initRadix_floatFallback, hwyKernels...)
+}
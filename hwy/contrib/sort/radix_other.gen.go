@@ -86,3 +86,16 @@ func initRadixFallback() {
 	RadixPassSignedInt32 = BaseRadixPassSigned_fallback_Int32
 	RadixPassSignedInt64 = BaseRadixPassSigned_fallback_Int64
 }
+
+func init() {
+	hwy.RegisterKernel("sort.RadixPassInt32", &RadixPassInt32)
+	hwy.RegisterKernel("sort.RadixPassInt64", &RadixPassInt64)
+	hwy.RegisterKernel("sort.RadixPass16Int32", &RadixPass16Int32)
+	hwy.RegisterKernel("sort.RadixPass16Int64", &RadixPass16Int64)
+	hwy.RegisterKernel("sort.RadixPass16SignedInt32", &RadixPass16SignedInt32)
+	hwy.RegisterKernel("sort.RadixPass16SignedInt64", &RadixPass16SignedInt64)
+	hwy.RegisterKernel("sort.RadixPassSignedInt32", &RadixPassSignedInt32)
+	hwy.RegisterKernel("sort.RadixPassSignedInt64", &RadixPassSignedInt64)
+	hwyKernels := []string{"sort.RadixPassInt32", "sort.RadixPassInt64", "sort.RadixPass16Int32", "sort.RadixPass16Int64", "sort.RadixPass16SignedInt32", "sort.RadixPass16SignedInt64", "sort.RadixPassSignedInt32", "sort.RadixPassSignedInt64"}
+	hwy.RegisterKernelTarget(hwy.DispatchScalar, initRadixFallback, hwyKernels...)
+}
@@ -14,7 +14,11 @@
 
 package sort
 
-import "github.com/ajroetker/go-highway/hwy"
+import (
+	"slices"
+
+	"github.com/ajroetker/go-highway/hwy"
+)
 
 // Thresholds for different sorting strategies.
 const (
@@ -25,9 +29,19 @@ const (
 	sortInsertionThreshold = 64
 )
 
+// Order is the direction of a sort.
+type Order int
+
+const (
+	// Ascending sorts smallest first.
+	Ascending Order = iota
+	// Descending sorts largest first.
+	Descending
+)
+
 // Sort sorts data in-place using the best algorithm for the type:
-//   - Signed integers (int32, int64): SIMD radix sort for large arrays, stdlib for small
-//   - Floats (float32, float64): VQSort (vectorized quicksort)
+//   - 32- and 64-bit integers and floats: radix sort for large arrays, stdlib for small
+//   - 8- and 16-bit integers: counting sort
 //
 // For explicit algorithm selection, use VQSort or RadixSort directly.
 func Sort[T hwy.Lanes](data []T) {
@@ -47,6 +61,20 @@ func Sort[T hwy.Lanes](data []T) {
 		RadixSort(any(data).([]int32))
 	case int64:
 		RadixSort(any(data).([]int64))
+	case uint32:
+		RadixSortUint32(any(data).([]uint32))
+	case uint64:
+		RadixSortUint64(any(data).([]uint64))
+	case int16:
+		countingSort(any(data).([]int16))
+	case uint16:
+		countingSort(any(data).([]uint16))
+	case int8:
+		countingSort(any(data).([]int8))
+	case uint8:
+		countingSort(any(data).([]uint8))
+	default:
+		VQSort(data)
 	}
 }
 
@@ -56,8 +84,18 @@ func Sort[T hwy.Lanes](data []T) {
 //   - Vectorized quicksort partitioning for larger arrays
 //   - Heapsort fallback for worst-case guarantee
 //
-// Supported types: float32, float64, int32, int64
+// The sorting networks and partition kernels are vectorized for float32,
+// float64, int32, int64, uint32 and uint64. Other types, including int16 and
+// uint16, use the same introsort with scalar networks and partitioning.
 func VQSort[T hwy.Lanes](data []T) {
+	VQSortOrder(data, Ascending)
+}
+
+// VQSortOrder sorts data in-place with VQSort in the given order.
+//
+// Descending partitions larger elements to the front directly, so it costs
+// the same as an ascending sort rather than a sort plus a reversal pass.
+func VQSortOrder[T hwy.Lanes](data []T, order Order) {
 	n := len(data)
 	if n <= 1 {
 		return
@@ -70,56 +108,95 @@ func VQSort[T hwy.Lanes](data []T) {
 	}
 	maxDepth *= 2
 
-	sortImpl(data, maxDepth)
+	sortImpl(data, maxDepth, order == Descending)
+}
+
+// SortDescending sorts data in-place in descending order.
+// It is VQSortOrder(data, Descending).
+func SortDescending[T hwy.Lanes](data []T) {
+	VQSortOrder(data, Descending)
 }
 
-// sortImpl is the recursive implementation of VQSort.
-func sortImpl[T hwy.Lanes](data []T, depthLimit int) {
+// sortImpl is the recursive implementation of VQSortOrder.
+func sortImpl[T hwy.Lanes](data []T, depthLimit int, desc bool) {
 	n := len(data)
 
 	if n <= 1 {
 		return
 	}
 
-	// Use sorting network for very small arrays
-	if n <= sortNetworkThreshold {
+	// Use sorting network for very small arrays; reversing them is cheap
+	// while they are in cache.
+	if n <= sortNetworkThreshold && hasKernels[T]() {
 		SortSmall(data)
+		if desc {
+			slices.Reverse(data)
+		}
 		return
 	}
 
 	// Use insertion sort for small arrays
 	if n <= sortInsertionThreshold {
-		sortInsertion(data)
+		sortInsertion(data, desc)
 		return
 	}
 
 	// Fallback to heapsort if recursion too deep
 	if depthLimit == 0 {
-		sortHeap(data)
+		sortHeap(data, desc)
 		return
 	}
 
 	// Select pivot using sampled median
 	pivot := PivotSampled(data)
 
-	// Partition using vectorized 3-way partition
-	lt, gt := CompressPartition3Way(data, pivot)
+	// Partition using vectorized 3-way partition. For descending order
+	// the regions are mirrored: data[:lo] > pivot and data[hi:] < pivot.
+	lo, hi := partition3Way(data, pivot, desc)
 
 	// Recurse on partitions
-	if lt > 0 {
-		sortImpl(data[:lt], depthLimit-1)
+	if lo > 0 {
+		sortImpl(data[:lo], depthLimit-1, desc)
+	}
+	if hi < n {
+		sortImpl(data[hi:], depthLimit-1, desc)
+	}
+}
+
+// hasKernels reports whether the generated sorting network and partition
+// kernels cover T. hwy has no 16-bit compress or mask operations on AVX2 and
+// AVX-512, so the 8- and 16-bit types are sorted with the scalar helpers.
+func hasKernels[T hwy.Lanes]() bool {
+	var zero T
+	switch any(zero).(type) {
+	case float32, float64, int32, int64, uint32, uint64:
+		return true
+	}
+	return false
+}
+
+// partition3Way partitions data around pivot with CompressPartition3Way,
+// or its descending variant, and falls back to the scalar partition for
+// types without kernels.
+func partition3Way[T hwy.Lanes](data []T, pivot T, desc bool) (int, int) {
+	if !hasKernels[T]() {
+		if desc {
+			return scalarPartition3WayDescending(data, pivot)
+		}
+		return scalarPartition3Way(data, pivot)
 	}
-	if gt < n {
-		sortImpl(data[gt:], depthLimit-1)
+	if desc {
+		return CompressPartition3WayDescending(data, pivot)
 	}
+	return CompressPartition3Way(data, pivot)
 }
 
 // sortInsertion is insertion sort for small arrays.
-func sortInsertion[T hwy.Lanes](data []T) {
+func sortInsertion[T hwy.Lanes](data []T, desc bool) {
 	for i := 1; i < len(data); i++ {
 		key := data[i]
 		j := i - 1
-		for j >= 0 && data[j] > key {
+		for j >= 0 && outOfOrder(data[j], key, desc) {
 			data[j+1] = data[j]
 			j--
 		}
@@ -128,34 +205,34 @@ func sortInsertion[T hwy.Lanes](data []T) {
 }
 
 // sortHeap is heapsort for O(n log n) worst-case guarantee.
-func sortHeap[T hwy.Lanes](data []T) {
+func sortHeap[T hwy.Lanes](data []T, desc bool) {
 	n := len(data)
 	if n <= 1 {
 		return
 	}
 
-	// Build max-heap
+	// Build max-heap (min-heap for descending order)
 	for i := n/2 - 1; i >= 0; i-- {
-		siftDown(data, i, n)
+		siftDown(data, i, n, desc)
 	}
 
 	// Extract elements
 	for i := n - 1; i > 0; i-- {
 		data[0], data[i] = data[i], data[0]
-		siftDown(data, 0, i)
+		siftDown(data, 0, i, desc)
 	}
 }
 
-func siftDown[T hwy.Lanes](data []T, i, n int) {
+func siftDown[T hwy.Lanes](data []T, i, n int, desc bool) {
 	for {
 		largest := i
 		left := 2*i + 1
 		right := 2*i + 2
 
-		if left < n && data[left] > data[largest] {
+		if left < n && outOfOrder(data[left], data[largest], desc) {
 			largest = left
 		}
-		if right < n && data[right] > data[largest] {
+		if right < n && outOfOrder(data[right], data[largest], desc) {
 			largest = right
 		}
 
@@ -168,6 +245,15 @@ func siftDown[T hwy.Lanes](data []T, i, n int) {
 	}
 }
 
+// outOfOrder reports whether a belongs after b: a > b, or a < b for
+// descending order.
+func outOfOrder[T hwy.Lanes](a, b T, desc bool) bool {
+	if desc {
+		return a < b
+	}
+	return a > b
+}
+
 // NthElement rearranges data such that the element at index k
 // is the element that would be at that position if data were sorted.
 // Elements before k are <= data[k], elements after are >= data[k].
@@ -187,6 +273,18 @@ func NthElement[T hwy.Lanes](data []T, k int) {
 	nthElementImpl(data, k, maxDepth)
 }
 
+// Select returns the k-th smallest element of data (0-based), such as the
+// median at k = len(data)/2 or a percentile, without sorting the whole
+// slice. It rearranges data as NthElement does, in O(n) expected time, and
+// panics if k is out of range.
+func Select[T hwy.Lanes](data []T, k int) T {
+	if k < 0 || k >= len(data) {
+		panic("sort: Select index out of range")
+	}
+	NthElement(data, k)
+	return data[k]
+}
+
 func nthElementImpl[T hwy.Lanes](data []T, k, depthLimit int) {
 	n := len(data)
 	if n <= 1 {
@@ -199,7 +297,7 @@ func nthElementImpl[T hwy.Lanes](data []T, k, depthLimit int) {
 	}
 
 	pivot := PivotSampled(data)
-	lt, gt := CompressPartition3Way(data, pivot)
+	lt, gt := partition3Way(data, pivot, false)
 
 	if k < lt {
 		nthElementImpl(data[:lt], k, depthLimit-1)
@@ -208,3 +306,48 @@ func nthElementImpl[T hwy.Lanes](data []T, k, depthLimit int) {
 	}
 	// If lt <= k < gt, k is in the equal partition - done
 }
+
+// PartialSort rearranges data so that data[:k] holds its k smallest
+// elements in ascending order; the order of the rest is unspecified.
+// k >= len(data) sorts the whole slice.
+//
+// It selects with the same vectorized partitioning as NthElement and only
+// sorts the first k elements, in O(n + k log k) expected time instead of
+// the O(n log n) of a full sort.
+func PartialSort[T hwy.Lanes](data []T, k int) {
+	n := len(data)
+	if k <= 0 || n <= 1 {
+		return
+	}
+	if k < n {
+		NthElement(data, k-1)
+	}
+	VQSort(data[:min(k, n)])
+}
+
+// TopK rearranges data so that data[:k] holds its k largest elements in
+// descending order, as for ranking scores; the order of the rest is
+// unspecified. k >= len(data) sorts the whole slice in descending order.
+//
+// Like PartialSort, it partitions around the k-th largest element and
+// then only sorts the k largest.
+func TopK[T hwy.Lanes](data []T, k int) {
+	n := len(data)
+	if k <= 0 || n <= 1 {
+		return
+	}
+	k = min(k, n)
+	if k < n {
+		NthElement(data, n-k)
+	}
+	VQSort(data[n-k:])
+
+	// Move the k largest to the front, largest first.
+	for i := range k {
+		j := n - 1 - i
+		if j <= i {
+			break
+		}
+		data[i], data[j] = data[j], data[i]
+	}
+}
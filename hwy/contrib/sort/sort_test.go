@@ -15,6 +15,7 @@
 package sort
 
 import (
+	"cmp"
 	"math/rand"
 	"slices"
 	"testing"
@@ -238,6 +239,317 @@ func TestNthElement(t *testing.T) {
 	}
 }
 
+// TestSelect tests Select against a sorted copy, including the panic on an
+// out-of-range index.
+func TestSelect(t *testing.T) {
+	for _, n := range []int{1, 10, 65, 1000, 10000} {
+		data := make([]float64, n)
+		for i := range data {
+			data[i] = float64(rand.Intn(n))
+		}
+		want := slices.Clone(data)
+		slices.Sort(want)
+		for _, k := range []int{0, n / 2, n * 99 / 100, n - 1} {
+			if got := Select(slices.Clone(data), k); got != want[k] {
+				t.Errorf("Select(n=%d, k=%d) = %v, want %v", n, k, got, want[k])
+			}
+		}
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Select with k == len(data) did not panic")
+		}
+	}()
+	Select([]int32{1, 2, 3}, 3)
+}
+
+// TestPartialSort tests that PartialSort leaves the k smallest elements sorted
+// in front and keeps the other elements.
+func TestPartialSort(t *testing.T) {
+	for _, n := range []int{0, 1, 10, 100, 1000, 5000} {
+		for _, k := range []int{0, 1, 7, 64, n / 2, n, n + 3} {
+			data := make([]float32, n)
+			for i := range data {
+				data[i] = float32(rand.Intn(n/2 + 1))
+			}
+			want := slices.Clone(data)
+			slices.Sort(want)
+
+			PartialSort(data, k)
+
+			m := min(max(k, 0), n)
+			if !slices.Equal(data[:m], want[:m]) {
+				t.Fatalf("PartialSort(n=%d, k=%d): prefix %v, want %v", n, k, data[:m], want[:m])
+			}
+			slices.Sort(data)
+			if !slices.Equal(data, want) {
+				t.Fatalf("PartialSort(n=%d, k=%d) lost elements", n, k)
+			}
+		}
+	}
+}
+
+// TestTopK tests that TopK leaves the k largest elements in descending order
+// in front and keeps the other elements.
+func TestTopK(t *testing.T) {
+	for _, n := range []int{0, 1, 2, 5, 100, 1000, 5000} {
+		for _, k := range []int{0, 1, 3, 64, n / 2, n - 1, n, n + 3} {
+			data := make([]int32, n)
+			for i := range data {
+				data[i] = int32(rand.Intn(2*n+1) - n)
+			}
+			want := slices.Clone(data)
+			slices.Sort(want)
+			slices.Reverse(want)
+
+			TopK(data, k)
+
+			m := min(max(k, 0), n)
+			if !slices.Equal(data[:m], want[:m]) {
+				t.Fatalf("TopK(n=%d, k=%d): prefix %v, want %v", n, k, data[:m], want[:m])
+			}
+			slices.Sort(data)
+			slices.Reverse(data)
+			if !slices.Equal(data, want) {
+				t.Fatalf("TopK(n=%d, k=%d) lost elements", n, k)
+			}
+		}
+	}
+}
+
+// TestSortDescending tests descending VQSort across the network, insertion
+// and partitioning paths, with duplicates.
+func TestSortDescending(t *testing.T) {
+	for _, n := range []int{0, 1, 2, 7, 32, 33, 64, 65, 100, 1000, 10000} {
+		data := make([]float64, n)
+		for i := range data {
+			data[i] = float64(rand.Intn(n/2 + 1))
+		}
+		want := slices.Clone(data)
+		slices.Sort(want)
+		slices.Reverse(want)
+
+		SortDescending(data)
+		if !slices.Equal(data, want) {
+			t.Fatalf("SortDescending(n=%d) = %v, want %v", n, data, want)
+		}
+	}
+}
+
+// TestVQSortOrder tests both orders on all supported types.
+func TestVQSortOrder(t *testing.T) {
+	t.Run("int32", func(t *testing.T) { testVQSortOrder(t, func() int32 { return rand.Int31n(2000) - 1000 }) })
+	t.Run("int64", func(t *testing.T) { testVQSortOrder(t, func() int64 { return rand.Int63n(2000) - 1000 }) })
+	t.Run("uint32", func(t *testing.T) { testVQSortOrder(t, func() uint32 { return rand.Uint32() % 2000 }) })
+	t.Run("uint64", func(t *testing.T) { testVQSortOrder(t, func() uint64 { return rand.Uint64() }) })
+	t.Run("int16", func(t *testing.T) { testVQSortOrder(t, func() int16 { return int16(rand.Int31n(2000) - 1000) }) })
+	t.Run("uint16", func(t *testing.T) { testVQSortOrder(t, func() uint16 { return uint16(rand.Uint32()) }) })
+	t.Run("float32", func(t *testing.T) { testVQSortOrder(t, func() float32 { return rand.Float32() - 0.5 }) })
+}
+
+func testVQSortOrder[T hwy.Lanes](t *testing.T, gen func() T) {
+	for _, order := range []Order{Ascending, Descending} {
+		data := make([]T, 5000)
+		for i := range data {
+			data[i] = gen()
+		}
+		want := slices.Clone(data)
+		slices.Sort(want)
+		if order == Descending {
+			slices.Reverse(want)
+		}
+		VQSortOrder(data, order)
+		if !slices.Equal(data, want) {
+			t.Errorf("VQSortOrder(order=%d) produced wrong order", order)
+		}
+	}
+}
+
+// TestSortUnsignedAnd16Bit tests Sort and NthElement on the unsigned and
+// 16-bit types, below and above the radix sort threshold.
+func TestSortUnsignedAnd16Bit(t *testing.T) {
+	t.Run("uint32", func(t *testing.T) { testSortTyped(t, rand.Uint32) })
+	t.Run("uint64", func(t *testing.T) { testSortTyped(t, rand.Uint64) })
+	t.Run("int16", func(t *testing.T) { testSortTyped(t, func() int16 { return int16(rand.Uint32()) }) })
+	t.Run("uint16", func(t *testing.T) { testSortTyped(t, func() uint16 { return uint16(rand.Uint32() % 300) }) })
+}
+
+func testSortTyped[T hwy.Lanes](t *testing.T, gen func() T) {
+	for _, n := range []int{0, 1, 5, 17, 33, 100, 1000, 20000} {
+		data := make([]T, n)
+		for i := range data {
+			data[i] = gen()
+		}
+		want := slices.Clone(data)
+		slices.Sort(want)
+
+		got := slices.Clone(data)
+		Sort(got)
+		if !slices.Equal(got, want) {
+			t.Errorf("Sort(n=%d) produced wrong order", n)
+		}
+
+		got = slices.Clone(data)
+		VQSort(got)
+		if !slices.Equal(got, want) {
+			t.Errorf("VQSort(n=%d) produced wrong order", n)
+		}
+
+		if n > 0 {
+			k := n / 3
+			got = slices.Clone(data)
+			NthElement(got, k)
+			if got[k] != want[k] {
+				t.Errorf("NthElement(n=%d, k=%d) = %v, want %v", n, k, got[k], want[k])
+			}
+		}
+	}
+}
+
+// TestSortHeapDescending tests the heapsort fallback in descending order.
+func TestSortHeapDescending(t *testing.T) {
+	data := make([]int32, 500)
+	for i := range data {
+		data[i] = rand.Int31n(100)
+	}
+	sortHeap(data, true)
+	if !slices.IsSortedFunc(data, func(a, b int32) int { return int(b - a) }) {
+		t.Errorf("sortHeap(desc) produced unsorted result: %v", data)
+	}
+}
+
+// TestSortKV tests that SortKV sorts the keys and keeps each value with its key.
+func TestSortKV(t *testing.T) {
+	for _, n := range []int{0, 1, 2, 7, 100, 5000} {
+		keys := make([]float32, n)
+		values := make([]uint32, n)
+		for i := range keys {
+			keys[i] = float32(rand.Intn(2*n+1)-n) / 4
+			values[i] = uint32(i)
+		}
+		orig := slices.Clone(keys)
+
+		SortKV(keys, values)
+
+		if !slices.IsSorted(keys) {
+			t.Fatalf("SortKV(n=%d): keys not sorted", n)
+		}
+		for i := range keys {
+			if orig[values[i]] != keys[i] {
+				t.Fatalf("SortKV(n=%d): value %d paired with key %v, want %v", n, values[i], keys[i], orig[values[i]])
+			}
+			if i > 0 && keys[i] == keys[i-1] && values[i] < values[i-1] {
+				t.Fatalf("SortKV(n=%d): equal keys not ordered by value at %d", n, i)
+			}
+		}
+	}
+}
+
+// TestSortKVInt32 tests the signed key mapping, including the extremes.
+func TestSortKVInt32(t *testing.T) {
+	keys := []int32{3, -1, 2147483647, -2147483648, 0, -1}
+	values := []uint32{0, 1, 2, 3, 4, 5}
+	SortKV(keys, values)
+	if want := []int32{-2147483648, -1, -1, 0, 3, 2147483647}; !slices.Equal(keys, want) {
+		t.Errorf("keys = %v, want %v", keys, want)
+	}
+	if want := []uint32{3, 1, 5, 4, 0, 2}; !slices.Equal(values, want) {
+		t.Errorf("values = %v, want %v", values, want)
+	}
+}
+
+// testArgSort checks ArgSort against a stable sort of the indices.
+func testArgSort[T hwy.Lanes](t *testing.T, gen func(n int) T) {
+	for _, n := range []int{0, 1, 2, 7, 100, 5000} {
+		data := make([]T, n)
+		for i := range data {
+			data[i] = gen(n)
+		}
+		orig := slices.Clone(data)
+
+		idx := ArgSort(data)
+
+		if !slices.Equal(data, orig) {
+			t.Fatalf("ArgSort(n=%d) modified its input", n)
+		}
+		want := make([]int32, n)
+		for i := range want {
+			want[i] = int32(i)
+		}
+		slices.SortStableFunc(want, func(a, b int32) int {
+			switch {
+			case data[a] < data[b]:
+				return -1
+			case data[a] > data[b]:
+				return 1
+			}
+			return 0
+		})
+		if !slices.Equal(idx, want) {
+			t.Fatalf("ArgSort(n=%d) = %v, want %v", n, idx, want)
+		}
+	}
+}
+
+// TestArgSort tests ArgSort for the packed 32-bit and scalar 64-bit paths.
+func TestArgSort(t *testing.T) {
+	t.Run("float32", func(t *testing.T) {
+		testArgSort(t, func(n int) float32 { return float32(rand.Intn(n+1)) - float32(n)/2 })
+	})
+	t.Run("int32", func(t *testing.T) {
+		testArgSort(t, func(n int) int32 { return int32(rand.Intn(n+1) - n/2) })
+	})
+	t.Run("uint32", func(t *testing.T) {
+		testArgSort(t, func(n int) uint32 { return uint32(rand.Intn(n + 1)) })
+	})
+	t.Run("float64", func(t *testing.T) {
+		testArgSort(t, func(n int) float64 { return float64(rand.Intn(n+1)) - float64(n)/2 })
+	})
+	t.Run("int64", func(t *testing.T) {
+		testArgSort(t, func(n int) int64 { return int64(rand.Intn(n+1) - n/2) })
+	})
+}
+
+// TestSortStable tests that SortStable keeps rows with equal keys in their
+// original order, for both the packed and the scalar ArgSort paths.
+func TestSortStable(t *testing.T) {
+	t.Run("int32", func(t *testing.T) { testSortStable(t, func() int32 { return rand.Int31n(50) - 25 }) })
+	t.Run("float32", func(t *testing.T) { testSortStable(t, func() float32 { return float32(rand.Intn(50)) }) })
+	t.Run("int64", func(t *testing.T) { testSortStable(t, func() int64 { return rand.Int63n(50) }) })
+	t.Run("uint16", func(t *testing.T) { testSortStable(t, func() uint16 { return uint16(rand.Intn(50)) }) })
+}
+
+func testSortStable[T hwy.Lanes](t *testing.T, gen func() T) {
+	type row struct {
+		key T
+		id  int
+	}
+	for _, n := range []int{0, 1, 7, 100, 3000} {
+		keys := make([]T, n)
+		rows := make([]row, n)
+		for i := range keys {
+			keys[i] = gen()
+			rows[i] = row{keys[i], i}
+		}
+		want := slices.Clone(rows)
+		slices.SortStableFunc(want, func(a, b row) int { return cmp.Compare(a.key, b.key) })
+
+		SortStable(keys, rows)
+		for i := range rows {
+			if keys[i] != want[i].key || rows[i] != want[i] {
+				t.Fatalf("n=%d: element %d = (%v, %v), want %v", n, i, keys[i], rows[i], want[i])
+			}
+		}
+	}
+
+	keys := []T{3, 1, 2}
+	SortStable[T, int](keys, nil)
+	if !slices.Equal(keys, []T{1, 2, 3}) {
+		t.Errorf("SortStable(keys, nil) = %v, want [1 2 3]", keys)
+	}
+}
+
 // TestIsSorted tests the IsSorted function
 func TestIsSorted(t *testing.T) {
 	tests := []struct {
@@ -0,0 +1,66 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package stats provides SIMD-accelerated descriptive statistics: the
+// moments of a sample, covariance and correlation, and exact and
+// streaming quantiles, and rolling window statistics of a series.
+//
+//	mean, variance := stats.MeanVariance(x)
+//	s := stats.Describe(x) // N, Mean, Variance, StdDev, Min, Max, Skewness, Kurtosis
+//	r := stats.Correlation(x, y)
+//	stats.CovarianceMatrix(cov, data, rows, cols)
+//	median := stats.Quantile(x, 0.5) // reorders x
+//
+//	d := stats.NewDigest(100)
+//	stats.AddSlice(d, batch)
+//	p99 := d.Quantile(0.99)
+//
+//	stats.RollingMean(ma, prices, 20) // len(prices)-19 windows
+//	stats.EMA(smooth, prices, 0.1)
+//
+// # Moments
+//
+// Mean, MeanVariance, Variance, StdDev, Skewness, Kurtosis, Covariance and
+// Correlation make a single pass over their input with Welford's method,
+// which updates the mean and the sums of powers of the deviations from it
+// per element, instead of summing powers of the raw values, whose
+// difference loses the precision of data far from zero. Each lane of a
+// vector accumulates its own elements; the lanes are combined at the end
+// with the formulas for the moments of a union (Chan et al., and Pébay
+// for the third and fourth moments).
+//
+// CovarianceMatrix accumulates the outer products of the centered
+// observations with the AXPY kernel of package vec.
+//
+// # Quantiles
+//
+// Quantile is exact, selecting the order statistics with the SIMD
+// partitioning of package sort. P2 estimates one quantile of a stream in
+// constant memory, an observation at a time. Digest, a merging t-digest,
+// estimates every quantile in bounded memory and merges across shards;
+// AddSlice feeds it batches, which are sorted with the radix sort of
+// package sort.
+//
+// # Rolling windows
+//
+// RollingMean, RollingVariance, RollingStd and RollingMeanStd take each
+// window's sums from prefix sums of the series, as the difference of two
+// of them, in float64 and restarted every 1024 windows from a local
+// origin, so that the cost is independent of the window length.
+// RollingMin and RollingMax use the van Herk/Gil-Werman algorithm, whose
+// block prefix and suffix extremes give every window's extreme in one
+// comparison, a vector at a time. EMA evaluates the recurrence of an
+// exponential moving average as a parallel scan within each vector,
+// carrying the last average from one vector to the next.
+package stats
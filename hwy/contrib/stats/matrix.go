@@ -0,0 +1,85 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stats
+
+import (
+	stdmath "math"
+
+	"github.com/ajroetker/go-highway/hwy"
+	"github.com/ajroetker/go-highway/hwy/contrib/vec"
+)
+
+// CovarianceMatrix stores in dst, cols×cols row-major, the sample
+// covariance matrix of the rows×cols row-major data, whose rows are
+// observations and columns variables. It divides by rows-1; with fewer
+// than two rows, dst is all NaN. It panics if data or dst is too short.
+//
+// The centered rows are accumulated as rank-one updates of the upper
+// triangle, a vector of columns at a time, which is then mirrored.
+func CovarianceMatrix[T hwy.FloatsNative](dst, data []T, rows, cols int) {
+	if len(data) < rows*cols {
+		panic("stats: data shorter than rows*cols")
+	}
+	if len(dst) < cols*cols {
+		panic("stats: dst shorter than cols*cols")
+	}
+	dst = dst[:cols*cols]
+	if rows < 2 {
+		for i := range dst {
+			dst[i] = T(stdmath.NaN())
+		}
+		return
+	}
+	means := make([]T, 2*cols)
+	means, centered := means[:cols], means[cols:]
+	for r := range rows {
+		vec.Add(means, data[r*cols:(r+1)*cols])
+	}
+	vec.Scale(1/T(rows), means)
+
+	clear(dst)
+	for r := range rows {
+		vec.SubTo(centered, data[r*cols:(r+1)*cols], means)
+		for i, c := range centered {
+			vec.MulConstAddTo(dst[i*cols+i:(i+1)*cols], c, centered[i:])
+		}
+	}
+	scale := 1 / T(rows-1)
+	for i := range cols {
+		vec.Scale(scale, dst[i*cols+i:(i+1)*cols])
+		for j := i + 1; j < cols; j++ {
+			dst[j*cols+i] = dst[i*cols+j]
+		}
+	}
+}
+
+// CorrelationMatrix stores in dst, cols×cols row-major, the Pearson
+// correlation matrix of the rows×cols row-major data, as
+// CovarianceMatrix.
+func CorrelationMatrix[T hwy.FloatsNative](dst, data []T, rows, cols int) {
+	CovarianceMatrix(dst, data, rows, cols)
+	if rows < 2 {
+		return
+	}
+	scale := make([]T, cols)
+	for i := range cols {
+		scale[i] = T(1 / stdmath.Sqrt(float64(dst[i*cols+i])))
+	}
+	for i := range cols {
+		row := dst[i*cols : (i+1)*cols]
+		vec.Mul(row, scale)
+		vec.Scale(scale[i], row)
+	}
+}
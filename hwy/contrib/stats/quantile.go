@@ -0,0 +1,324 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stats
+
+import (
+	stdmath "math"
+	"slices"
+
+	"github.com/ajroetker/go-highway/hwy"
+	hsort "github.com/ajroetker/go-highway/hwy/contrib/sort"
+	"github.com/ajroetker/go-highway/hwy/contrib/vec"
+)
+
+// Quantile returns the exact q-quantile of x, interpolating linearly
+// between the order statistics (R's type 7, NumPy's default). It reorders
+// x, selecting with the SIMD partitioning of package sort instead of
+// sorting. It panics if x is empty or q is outside [0, 1].
+func Quantile[T hwy.FloatsNative](x []T, q float64) T {
+	if len(x) == 0 {
+		panic("stats: Quantile of an empty slice")
+	}
+	if !(q >= 0 && q <= 1) {
+		panic("stats: quantile outside [0, 1]")
+	}
+	h := q * float64(len(x)-1)
+	k := int(h)
+	hsort.NthElement(x, k)
+	if k == len(x)-1 {
+		return x[k]
+	}
+	// x[k+1:] holds the elements above x[k].
+	next := vec.Min(x[k+1:])
+	return x[k] + T(h-float64(k))*(next-x[k])
+}
+
+// P2 estimates a quantile of a stream in constant memory with the P²
+// algorithm of Jain and Chlamtac, which keeps five markers whose heights
+// track the minimum, the quantile, the maximum and the points halfway
+// between, adjusted with piecewise-parabolic interpolation.
+type P2 struct {
+	p       float64
+	count   int
+	heights [5]float64
+	pos     [5]float64 // actual marker positions, from 0
+	want    [5]float64 // desired marker positions
+	step    [5]float64 // increments of want per observation
+}
+
+// NewP2 returns an estimator of the p-quantile. It panics if p is outside
+// [0, 1].
+func NewP2(p float64) *P2 {
+	if !(p >= 0 && p <= 1) {
+		panic("stats: quantile outside [0, 1]")
+	}
+	return &P2{p: p}
+}
+
+// Add adds an observation.
+func (e *P2) Add(x float64) {
+	if e.count < 5 {
+		e.heights[e.count] = x
+		e.count++
+		if e.count == 5 {
+			slices.Sort(e.heights[:])
+			p := e.p
+			e.pos = [5]float64{0, 1, 2, 3, 4}
+			e.want = [5]float64{0, 2 * p, 4 * p, 2 + 2*p, 4}
+			e.step = [5]float64{0, p / 2, p, (1 + p) / 2, 1}
+		}
+		return
+	}
+	e.count++
+
+	// Find the cell of x, extending the extremes.
+	h := &e.heights
+	var k int
+	switch {
+	case x < h[0]:
+		h[0] = x
+		k = 0
+	case x >= h[4]:
+		h[4] = x
+		k = 3
+	default:
+		for k = 0; x >= h[k+1]; k++ {
+		}
+	}
+	for i := k + 1; i < 5; i++ {
+		e.pos[i]++
+	}
+	for i := range e.want {
+		e.want[i] += e.step[i]
+	}
+
+	// Move the middle markers that are off by one position or more.
+	n := &e.pos
+	for i := 1; i < 4; i++ {
+		d := e.want[i] - n[i]
+		if d >= 1 && n[i+1]-n[i] > 1 || d <= -1 && n[i-1]-n[i] < -1 {
+			s := stdmath.Copysign(1, d)
+			qp := h[i] + s/(n[i+1]-n[i-1])*((n[i]-n[i-1]+s)*(h[i+1]-h[i])/(n[i+1]-n[i])+
+				(n[i+1]-n[i]-s)*(h[i]-h[i-1])/(n[i]-n[i-1]))
+			if h[i-1] < qp && qp < h[i+1] {
+				h[i] = qp
+			} else {
+				j := i + int(s)
+				h[i] += s * (h[j] - h[i]) / (n[j] - n[i])
+			}
+			n[i] += s
+		}
+	}
+}
+
+// Count returns the number of observations added.
+func (e *P2) Count() int {
+	return e.count
+}
+
+// Value returns the estimate of the quantile: exact for up to five
+// observations, NaN for none.
+func (e *P2) Value() float64 {
+	if e.count == 0 {
+		return stdmath.NaN()
+	}
+	if e.count < 5 {
+		x := slices.Clone(e.heights[:e.count])
+		return Quantile(x, e.p)
+	}
+	return e.heights[2]
+}
+
+// Digest is a t-digest (Dunning and Ertl), the merging variant: a sketch
+// of a distribution in a few hundred centroids that estimates any
+// quantile, most accurately in the tails, and merges with other digests.
+//
+// Points are buffered and fed in batches: each batch is sorted with the
+// radix sort of package sort, merged with the centroids, and compressed
+// so that a centroid covers at most one unit of the arcsine scale
+// function k₁(q) = δ/2π · asin(2q-1), for compression δ.
+type Digest struct {
+	compression float64
+	centroids   []centroid // sorted by mean
+	scratch     []centroid
+	buf         []float64
+	total       float64 // weight of the centroids
+	min, max    float64
+}
+
+type centroid struct {
+	mean, weight float64
+}
+
+// NewDigest returns an empty digest with the given compression, typically
+// 100: the number of centroids stays below about twice that. It panics if
+// compression is below 10.
+func NewDigest(compression float64) *Digest {
+	if !(compression >= 10) {
+		panic("stats: Digest compression below 10")
+	}
+	return &Digest{
+		compression: compression,
+		buf:         make([]float64, 0, 5*int(compression)),
+		min:         stdmath.Inf(1),
+		max:         stdmath.Inf(-1),
+	}
+}
+
+// Add adds a point, which must not be NaN.
+func (d *Digest) Add(x float64) {
+	d.buf = append(d.buf, x)
+	d.min = min(d.min, x)
+	d.max = max(d.max, x)
+	if len(d.buf) == cap(d.buf) {
+		d.flush()
+	}
+}
+
+// AddSlice adds the points of x, which must not be NaN, to d.
+func AddSlice[T hwy.FloatsNative](d *Digest, x []T) {
+	if len(x) == 0 {
+		return
+	}
+	lo, hi := vec.MinMax(x)
+	d.min = min(d.min, float64(lo))
+	d.max = max(d.max, float64(hi))
+	for len(x) > 0 {
+		n := min(len(x), cap(d.buf)-len(d.buf))
+		for _, v := range x[:n] {
+			d.buf = append(d.buf, float64(v))
+		}
+		x = x[n:]
+		if len(d.buf) == cap(d.buf) {
+			d.flush()
+		}
+	}
+}
+
+// Merge adds the points of o to d.
+func (d *Digest) Merge(o *Digest) {
+	o.flush()
+	d.flush()
+	if len(o.centroids) == 0 {
+		return
+	}
+	d.min = min(d.min, o.min)
+	d.max = max(d.max, o.max)
+	items := append(append(d.scratch[:0], d.centroids...), o.centroids...)
+	slices.SortFunc(items, func(a, b centroid) int {
+		switch {
+		case a.mean < b.mean:
+			return -1
+		case a.mean > b.mean:
+			return 1
+		}
+		return 0
+	})
+	d.scratch = d.compress(items)
+}
+
+// Count returns the number of points added.
+func (d *Digest) Count() int {
+	return int(d.total) + len(d.buf)
+}
+
+// Quantile returns the estimate of the q-quantile, for q in [0, 1], or
+// NaN if d is empty.
+func (d *Digest) Quantile(q float64) float64 {
+	d.flush()
+	if len(d.centroids) == 0 || !(q >= 0 && q <= 1) {
+		return stdmath.NaN()
+	}
+	if q == 0 {
+		return d.min
+	}
+	if q == 1 {
+		return d.max
+	}
+	// Interpolate between the centers of the centroids, taking the
+	// extremes as the ends.
+	t := q * d.total
+	cum := 0.0
+	prevMean, prevCenter := d.min, 0.0
+	for _, c := range d.centroids {
+		center := cum + c.weight/2
+		if t < center {
+			return prevMean + (c.mean-prevMean)*(t-prevCenter)/(center-prevCenter)
+		}
+		prevMean, prevCenter = c.mean, center
+		cum += c.weight
+	}
+	if d.total == prevCenter {
+		return d.max
+	}
+	return prevMean + (d.max-prevMean)*(t-prevCenter)/(d.total-prevCenter)
+}
+
+// flush merges the buffered points into the centroids.
+func (d *Digest) flush() {
+	if len(d.buf) == 0 {
+		return
+	}
+	hsort.Sort(d.buf)
+	items := d.scratch[:0]
+	i, j := 0, 0
+	for i < len(d.buf) || j < len(d.centroids) {
+		if j == len(d.centroids) || i < len(d.buf) && d.buf[i] < d.centroids[j].mean {
+			items = append(items, centroid{d.buf[i], 1})
+			i++
+		} else {
+			items = append(items, d.centroids[j])
+			j++
+		}
+	}
+	d.buf = d.buf[:0]
+	d.scratch = d.compress(items)
+}
+
+// compress replaces the centroids with items, sorted by mean, merging
+// neighbors while they fit the size limit of the scale function. It
+// returns the slice of items for reuse.
+func (d *Digest) compress(items []centroid) []centroid {
+	total := 0.0
+	for _, c := range items {
+		total += c.weight
+	}
+	// The weight up to which a centroid starting after done may grow:
+	// one unit of k₁ further.
+	norm := d.compression / (2 * stdmath.Pi)
+	limit := func(done float64) float64 {
+		k := norm*stdmath.Asin(2*done/total-1) + 1
+		return total * (stdmath.Sin(min(k/norm, stdmath.Pi/2)) + 1) / 2
+	}
+
+	out := d.centroids[:0]
+	cur := items[0]
+	done := 0.0
+	end := limit(done)
+	for _, c := range items[1:] {
+		if done+cur.weight+c.weight <= end {
+			cur.weight += c.weight
+			cur.mean += (c.mean - cur.mean) * c.weight / cur.weight
+			continue
+		}
+		out = append(out, cur)
+		done += cur.weight
+		end = limit(done)
+		cur = c
+	}
+	d.centroids = append(out, cur)
+	d.total = total
+	return items[:0]
+}
@@ -0,0 +1,204 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stats
+
+import (
+	stdmath "math"
+
+	"github.com/ajroetker/go-highway/hwy"
+	"github.com/ajroetker/go-highway/hwy/contrib/algo"
+)
+
+// The rolling functions store in dst[i] a statistic of the window
+// x[i:i+w], for the len(x)-w+1 windows of w elements, and nothing if x is
+// shorter than w. They panic if w is too small or dst too short.
+
+// rollingChunk is the number of windows whose sums come from one set of
+// prefix sums. Each chunk restarts them, from its first element as an
+// origin, so that rounding errors do not build up along a long series and
+// the sums of squares do not cancel for data far from zero.
+const rollingChunk = 1024
+
+// RollingMean stores in dst the means of the windows of w elements of x.
+func RollingMean[T hwy.FloatsNative](dst, x []T, w int) {
+	rollingMoments(dst, nil, x, w, false)
+}
+
+// RollingVariance stores in dst the sample variances, dividing by w-1, of
+// the windows of w >= 2 elements of x.
+func RollingVariance[T hwy.FloatsNative](dst, x []T, w int) {
+	rollingMoments(nil, dst, x, w, false)
+}
+
+// RollingStd stores in dst the sample standard deviations of the windows
+// of w >= 2 elements of x.
+func RollingStd[T hwy.FloatsNative](dst, x []T, w int) {
+	rollingMoments(nil, dst, x, w, true)
+}
+
+// RollingMeanStd stores in mean and std the means and the sample standard
+// deviations of the windows of w >= 2 elements of x, in one pass.
+func RollingMeanStd[T hwy.FloatsNative](mean, std, x []T, w int) {
+	rollingMoments(mean, std, x, w, true)
+}
+
+// rollingMoments stores the window means in mean and the variances, or
+// with sqrt the standard deviations, in spread, unless they are nil. The
+// windows are summed in float64 from prefix sums, see rollingChunk.
+func rollingMoments[T hwy.FloatsNative](mean, spread, x []T, w int, sqrt bool) {
+	minW := 1
+	if spread != nil {
+		minW = 2
+	}
+	m := windows(len(x), w, minW)
+	if mean != nil {
+		checkDst(mean, m)
+	}
+	if spread != nil {
+		checkDst(spread, m)
+	}
+	if m == 0 {
+		return
+	}
+	chunk := min(max(rollingChunk, w), m)
+	s1 := make([]float64, chunk+w)
+	s2 := make([]float64, chunk+w)
+	means := make([]float64, chunk)
+	variances := make([]float64, chunk)
+	for start := 0; start < m; start += chunk {
+		c := min(chunk, m-start)
+		xs := x[start : start+c+w-1]
+		shift := float64(xs[0])
+		for k, v := range xs {
+			d := float64(v) - shift
+			s1[k+1] = d
+			s2[k+1] = d * d
+		}
+		algo.PrefixSum(s1[1 : len(xs)+1])
+		algo.PrefixSum(s2[1 : len(xs)+1])
+		windowMoments(means[:c], variances[:c], s1, s2, w, shift)
+		if mean != nil {
+			for k, v := range means[:c] {
+				mean[start+k] = T(v)
+			}
+		}
+		if spread != nil {
+			for k, v := range variances[:c] {
+				if sqrt {
+					v = stdmath.Sqrt(v)
+				}
+				spread[start+k] = T(v)
+			}
+		}
+	}
+}
+
+// RollingMax stores in dst the maximums of the windows of w elements of x,
+// with the van Herk/Gil-Werman algorithm: three comparisons per element
+// whatever w, one of them in a SIMD pass. The result is unspecified for
+// windows holding NaN.
+func RollingMax[T hwy.FloatsNative](dst, x []T, w int) {
+	m := windows(len(x), w, 1)
+	checkDst(dst, m)
+	if m == 0 {
+		return
+	}
+	suffix, prefix := blockExtremes(x, w, true)
+	windowMax(dst[:m], suffix, prefix, w)
+}
+
+// RollingMin stores in dst the minimums of the windows of w elements of x,
+// like RollingMax.
+func RollingMin[T hwy.FloatsNative](dst, x []T, w int) {
+	m := windows(len(x), w, 1)
+	checkDst(dst, m)
+	if m == 0 {
+		return
+	}
+	suffix, prefix := blockExtremes(x, w, false)
+	windowMin(dst[:m], suffix, prefix, w)
+}
+
+// blockExtremes returns the suffix and prefix maximums, or minimums,
+// within each block of w elements of x.
+func blockExtremes[T hwy.FloatsNative](x []T, w int, isMax bool) (suffix, prefix []T) {
+	n := len(x)
+	suffix = make([]T, n)
+	prefix = make([]T, n)
+	for b := 0; b < n; b += w {
+		e := min(b+w, n)
+		prefix[b] = x[b]
+		suffix[e-1] = x[e-1]
+		if isMax {
+			for i := b + 1; i < e; i++ {
+				prefix[i] = max(prefix[i-1], x[i])
+			}
+			for i := e - 2; i >= b; i-- {
+				suffix[i] = max(suffix[i+1], x[i])
+			}
+		} else {
+			for i := b + 1; i < e; i++ {
+				prefix[i] = min(prefix[i-1], x[i])
+			}
+			for i := e - 2; i >= b; i-- {
+				suffix[i] = min(suffix[i+1], x[i])
+			}
+		}
+	}
+	return suffix, prefix
+}
+
+// EMA stores in dst the exponential moving average of x with smoothing
+// factor alpha in (0, 1]: dst[0] = x[0], then
+//
+//	dst[i] = alpha*x[i] + (1-alpha)*dst[i-1]
+//
+// dst may be x. The recurrence is evaluated a vector at a time with a
+// scan, see expMovingAverage. It panics if alpha is outside (0, 1] or dst
+// is shorter than x.
+func EMA[T hwy.FloatsNative](dst, x []T, alpha T) {
+	if !(alpha > 0 && alpha <= 1) {
+		panic("stats: EMA alpha outside (0, 1]")
+	}
+	checkDst(dst, len(x))
+	if len(x) == 0 {
+		return
+	}
+	// d¹ to d¹⁶, for the lanes of the widest vector.
+	var powers [16]T
+	p := T(1)
+	for k := range powers {
+		p *= 1 - alpha
+		powers[k] = p
+	}
+	dst[0] = x[0]
+	expMovingAverage(dst[1:len(x)], x[1:], alpha, x[0], powers[:])
+}
+
+// windows returns the number of windows of w elements in n, and panics if
+// w is less than minW.
+func windows(n, w, minW int) int {
+	if w < minW {
+		panic("stats: window too small")
+	}
+	return max(n-w+1, 0)
+}
+
+// checkDst panics if dst holds fewer than n elements.
+func checkDst[T any](dst []T, n int) {
+	if len(dst) < n {
+		panic("stats: dst is too short")
+	}
+}
@@ -0,0 +1,124 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stats
+
+//go:generate go run ../../../cmd/hwygen -input rolling_base.go -output . -targets avx2,avx512,neon,fallback -dispatch rolling
+
+import "github.com/ajroetker/go-highway/hwy"
+
+// baseWindowMoments stores in mean and variance the moments of the
+// windows of w elements whose shifted prefix sums are s1, of x-shift, and
+// s2, of (x-shift)²: window i sums s1[i+w]-s1[i]. The variance divides by
+// w-1, and is clamped to 0 against rounding; it is meaningless for w = 1.
+func baseWindowMoments(mean, variance, s1, s2 []float64, w int, shift float64) {
+	n := min(len(mean), len(variance))
+	invW := 1 / float64(w)
+	invW1 := 1 / float64(max(w-1, 1))
+	vInvW := hwy.Set(invW)
+	vInvW1 := hwy.Set(invW1)
+	vShift := hwy.Set(shift)
+	zero := hwy.Zero[float64]()
+	lanes := hwy.MaxLanes[float64]()
+	i := 0
+	for ; i+lanes <= n; i += lanes {
+		s := hwy.Sub(hwy.Load(s1[i+w:]), hwy.Load(s1[i:]))
+		q := hwy.Sub(hwy.Load(s2[i+w:]), hwy.Load(s2[i:]))
+		hwy.Store(hwy.MulAdd(s, vInvW, vShift), mean[i:])
+		m2 := hwy.Sub(q, hwy.Mul(hwy.Mul(s, s), vInvW))
+		hwy.Store(hwy.Max(hwy.Mul(m2, vInvW1), zero), variance[i:])
+	}
+	for ; i < n; i++ {
+		s := s1[i+w] - s1[i]
+		q := s2[i+w] - s2[i]
+		mean[i] = shift + s*invW
+		variance[i] = max((q-s*s*invW)*invW1, 0)
+	}
+}
+
+// baseWindowMax stores in dst the maximums of the windows of w elements
+// from the van Herk/Gil-Werman arrays of their blocks of w: the suffix
+// maximums within each block, and the prefix maximums. A window starting
+// at i spans the suffix of its block from i and the prefix of the next
+// one to i+w-1, so dst[i] = max(suffix[i], prefix[i+w-1]).
+func baseWindowMax[T hwy.FloatsNative](dst, suffix, prefix []T, w int) {
+	n := len(dst)
+	lanes := hwy.MaxLanes[T]()
+	i := 0
+	for ; i+lanes <= n; i += lanes {
+		hwy.Store(hwy.Max(hwy.Load(suffix[i:]), hwy.Load(prefix[i+w-1:])), dst[i:])
+	}
+	for ; i < n; i++ {
+		dst[i] = max(suffix[i], prefix[i+w-1])
+	}
+}
+
+// baseWindowMin is baseWindowMax for minimums.
+func baseWindowMin[T hwy.FloatsNative](dst, suffix, prefix []T, w int) {
+	n := len(dst)
+	lanes := hwy.MaxLanes[T]()
+	i := 0
+	for ; i+lanes <= n; i += lanes {
+		hwy.Store(hwy.Min(hwy.Load(suffix[i:]), hwy.Load(prefix[i+w-1:])), dst[i:])
+	}
+	for ; i < n; i++ {
+		dst[i] = min(suffix[i], prefix[i+w-1])
+	}
+}
+
+// baseExpMovingAverage stores in dst the exponential moving average
+// y[i] = alpha*x[i] + d*y[i-1], d = 1-alpha, starting from y[-1] = prev,
+// and returns the last value. powers holds d¹, d², ... for the lanes of a
+// vector.
+//
+// Within a vector the recurrence is a scan, like BasePrefixSumVec of
+// package algo with each shifted term scaled by d to the shift: after the
+// steps of shifts 1, 2, 4 and 8, lane j holds Σ d^k alpha*x[j-k]. The value
+// carried in from the previous vector adds d^(j+1) y[-1].
+func baseExpMovingAverage[T hwy.FloatsNative](dst, x []T, alpha, prev T, powers []T) T {
+	n := min(len(dst), len(x))
+	d := 1 - alpha
+	vAlpha := hwy.Set(alpha)
+	d1 := hwy.Set(d)
+	d2 := hwy.Set(d * d)
+	d4 := hwy.Set(d * d * d * d)
+	d8 := hwy.Set(d * d * d * d * d * d * d * d)
+	vPowers := hwy.Load(powers)
+	lanes := hwy.MaxLanes[T]()
+	i := 0
+	//hwy:unroll 1
+	for ; i+lanes <= n; i += lanes {
+		v := hwy.Mul(vAlpha, hwy.Load(x[i:]))
+		if lanes >= 2 {
+			v = hwy.MulAdd(d1, hwy.SlideUpLanes(v, 1), v)
+		}
+		if lanes >= 4 {
+			v = hwy.MulAdd(d2, hwy.SlideUpLanes(v, 2), v)
+		}
+		if lanes >= 8 {
+			v = hwy.MulAdd(d4, hwy.SlideUpLanes(v, 4), v)
+		}
+		if lanes >= 16 {
+			v = hwy.MulAdd(d8, hwy.SlideUpLanes(v, 8), v)
+		}
+		v = hwy.MulAdd(vPowers, hwy.Set(prev), v)
+		hwy.Store(v, dst[i:])
+		prev = hwy.GetLane(v, lanes-1)
+	}
+	for ; i < n; i++ {
+		prev = alpha*x[i] + d*prev
+		dst[i] = prev
+	}
+	return prev
+}
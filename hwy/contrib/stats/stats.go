@@ -0,0 +1,183 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stats
+
+import (
+	stdmath "math"
+
+	"github.com/ajroetker/go-highway/hwy"
+	"github.com/ajroetker/go-highway/hwy/contrib/vec"
+)
+
+// Mean returns the arithmetic mean of x, or NaN if x is empty.
+func Mean[T hwy.FloatsNative](x []T) T {
+	if len(x) == 0 {
+		return T(stdmath.NaN())
+	}
+	mean, _ := meanM2(x)
+	return mean
+}
+
+// MeanVariance returns the mean and the sample variance of x, in one pass.
+// The variance divides by n-1; it is NaN for fewer than two elements, as
+// both are for an empty x.
+func MeanVariance[T hwy.FloatsNative](x []T) (mean, variance T) {
+	if len(x) == 0 {
+		return T(stdmath.NaN()), T(stdmath.NaN())
+	}
+	mean, m2 := meanM2(x)
+	if len(x) < 2 {
+		return mean, T(stdmath.NaN())
+	}
+	return mean, m2 / T(len(x)-1)
+}
+
+// Variance returns the sample variance of x, dividing by n-1, or NaN for
+// fewer than two elements.
+func Variance[T hwy.FloatsNative](x []T) T {
+	_, variance := MeanVariance(x)
+	return variance
+}
+
+// StdDev returns the sample standard deviation of x, the square root of
+// Variance.
+func StdDev[T hwy.FloatsNative](x []T) T {
+	return T(stdmath.Sqrt(float64(Variance(x))))
+}
+
+// Skewness returns the sample skewness of x, g₁ = m₃ / m₂^(3/2) with the
+// central moments mₖ dividing by n. It is NaN for an empty or constant x.
+func Skewness[T hwy.FloatsNative](x []T) T {
+	_, m2, m3, _ := moments(x)
+	n := float64(len(x))
+	return T(stdmath.Sqrt(n) * float64(m3) / stdmath.Pow(float64(m2), 1.5))
+}
+
+// Kurtosis returns the sample excess kurtosis of x, g₂ = m₄ / m₂² - 3 with
+// the central moments mₖ dividing by n. It is NaN for an empty or constant
+// x.
+func Kurtosis[T hwy.FloatsNative](x []T) T {
+	_, m2, _, m4 := moments(x)
+	n := float64(len(x))
+	return T(n*float64(m4)/(float64(m2)*float64(m2)) - 3)
+}
+
+// Summary describes a sample.
+type Summary[T hwy.FloatsNative] struct {
+	N        int
+	Mean     T
+	Variance T // sample variance, dividing by n-1
+	StdDev   T
+	Min, Max T
+	Skewness T // g₁, see Skewness
+	Kurtosis T // excess g₂, see Kurtosis
+}
+
+// Describe returns the summary statistics of x, in two passes: one for
+// the moments and one for the extremes. The statistics x is too short for
+// are NaN.
+func Describe[T hwy.FloatsNative](x []T) Summary[T] {
+	nan := T(stdmath.NaN())
+	s := Summary[T]{N: len(x), Mean: nan, Variance: nan, StdDev: nan, Min: nan, Max: nan, Skewness: nan, Kurtosis: nan}
+	if len(x) == 0 {
+		return s
+	}
+	mean, m2, m3, m4 := moments(x)
+	n := float64(len(x))
+	s.Mean = mean
+	if len(x) > 1 {
+		s.Variance = m2 / T(len(x)-1)
+		s.StdDev = T(stdmath.Sqrt(float64(s.Variance)))
+	}
+	s.Skewness = T(stdmath.Sqrt(n) * float64(m3) / stdmath.Pow(float64(m2), 1.5))
+	s.Kurtosis = T(n*float64(m4)/(float64(m2)*float64(m2)) - 3)
+	s.Min, s.Max = vec.MinMax(x)
+	return s
+}
+
+// Covariance returns the sample covariance of x and y, dividing by n-1,
+// or NaN for fewer than two elements. It panics if x and y differ in
+// length.
+func Covariance[T hwy.FloatsNative](x, y []T) T {
+	if len(x) != len(y) {
+		panic("stats: x and y differ in length")
+	}
+	if len(x) < 2 {
+		return T(stdmath.NaN())
+	}
+	_, _, _, _, cxy := coMoments(x, y)
+	return cxy / T(len(x)-1)
+}
+
+// Correlation returns the Pearson correlation coefficient of x and y, or
+// NaN for fewer than two elements or if either is constant. It panics if
+// x and y differ in length.
+func Correlation[T hwy.FloatsNative](x, y []T) T {
+	if len(x) != len(y) {
+		panic("stats: x and y differ in length")
+	}
+	if len(x) < 2 {
+		return T(stdmath.NaN())
+	}
+	_, _, m2x, m2y, cxy := coMoments(x, y)
+	return T(float64(cxy) / stdmath.Sqrt(float64(m2x)*float64(m2y)))
+}
+
+// meanM2Scalar continues baseMeanM2 from the mean and M2 of n elements
+// with the elements of x.
+func meanM2Scalar[T hwy.FloatsNative](mean, m2 T, n int, x []T) (T, T) {
+	for _, v := range x {
+		n++
+		delta := v - mean
+		mean += delta / T(n)
+		m2 += delta * (v - mean)
+	}
+	return mean, m2
+}
+
+// momentsScalar continues baseMoments from the moments of n elements with
+// the elements of x.
+func momentsScalar[T hwy.FloatsNative](mean, m2, m3, m4 T, n int, x []T) (T, T, T, T) {
+	for _, v := range x {
+		n++
+		fn := T(n)
+		delta := v - mean
+		dn := delta / fn
+		dn2 := dn * dn
+		term := delta * dn * (fn - 1)
+		mean += dn
+		m4 += term*dn2*(fn*fn-3*fn+3) + 6*dn2*m2 - 4*dn*m3
+		m3 += term*dn*(fn-2) - 3*dn*m2
+		m2 += term
+	}
+	return mean, m2, m3, m4
+}
+
+// coMomentsScalar continues baseCoMoments from the co-moments of n
+// elements with the elements of x and y.
+func coMomentsScalar[T hwy.FloatsNative](meanX, meanY, m2x, m2y, cxy T, n int, x, y []T) (T, T, T, T, T) {
+	for i, vx := range x {
+		vy := y[i]
+		n++
+		dx := vx - meanX
+		dy := vy - meanY
+		meanX += dx / T(n)
+		meanY += dy / T(n)
+		m2x += dx * (vx - meanX)
+		m2y += dy * (vy - meanY)
+		cxy += dx * (vy - meanY)
+	}
+	return meanX, meanY, m2x, m2y, cxy
+}
@@ -0,0 +1,139 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stats
+
+//go:generate go run ../../../cmd/hwygen -input stats_base.go -output . -targets avx2,avx512,neon,fallback -dispatch stats
+
+import "github.com/ajroetker/go-highway/hwy"
+
+// The kernels accumulate with Welford's method in each lane, so every
+// lane holds the moments of k elements, and combine the lanes at the end:
+// the central moment sums of a union add terms in the deviations d of the
+// lane means from the overall mean,
+//
+//	M2 = Σ M2ₗ + k Σ d²
+//	M3 = Σ M3ₗ + 3 Σ d M2ₗ + k Σ d³
+//	M4 = Σ M4ₗ + 4 Σ d M3ₗ + 6 Σ d² M2ₗ + k Σ d⁴
+//
+// The elements after the last full vector are added one at a time.
+
+// baseMeanM2 returns the mean of x and the sum of the squared deviations
+// from it.
+func baseMeanM2[T hwy.FloatsNative](x []T) (mean, m2 T) {
+	n := len(x)
+	vMean := hwy.Zero[T]()
+	vM2 := hwy.Zero[T]()
+	lanes := vMean.NumLanes()
+	k := 0
+	i := 0
+	//hwy:unroll 1
+	for ; i+lanes <= n; i += lanes {
+		k++
+		v := hwy.Load(x[i:])
+		delta := hwy.Sub(v, vMean)
+		vMean = hwy.MulAdd(delta, hwy.Set(1/T(k)), vMean)
+		vM2 = hwy.MulAdd(delta, hwy.Sub(v, vMean), vM2)
+	}
+	if k > 0 {
+		mean = hwy.ReduceSum(vMean) / T(lanes)
+		d := hwy.Sub(vMean, hwy.Set(mean))
+		m2 = hwy.ReduceSum(vM2) + T(k)*hwy.ReduceSum(hwy.Mul(d, d))
+	}
+	return meanM2Scalar(mean, m2, i, x[i:])
+}
+
+// baseMoments returns the mean of x and the sums of the second, third and
+// fourth powers of the deviations from it.
+func baseMoments[T hwy.FloatsNative](x []T) (mean, m2, m3, m4 T) {
+	n := len(x)
+	vMean := hwy.Zero[T]()
+	vM2 := hwy.Zero[T]()
+	vM3 := hwy.Zero[T]()
+	vM4 := hwy.Zero[T]()
+	lanes := vMean.NumLanes()
+	k := 0
+	i := 0
+	//hwy:unroll 1
+	for ; i+lanes <= n; i += lanes {
+		k++
+		fk := T(k)
+		v := hwy.Load(x[i:])
+		delta := hwy.Sub(v, vMean)
+		dn := hwy.Mul(delta, hwy.Set(1/fk))
+		dn2 := hwy.Mul(dn, dn)
+		term := hwy.Mul(hwy.Mul(delta, dn), hwy.Set(fk-1))
+		vMean = hwy.Add(vMean, dn)
+		// M4 += term dn² (k²-3k+3) + 6 dn² M2 - 4 dn M3, from the old M2, M3.
+		m4Step := hwy.Mul(hwy.Mul(term, dn2), hwy.Set(fk*fk-3*fk+3))
+		m4Step = hwy.MulAdd(hwy.Mul(dn2, vM2), hwy.Set(T(6)), m4Step)
+		vM4 = hwy.Add(vM4, hwy.MulAdd(hwy.Mul(dn, vM3), hwy.Set(T(-4)), m4Step))
+		// M3 += term dn (k-2) - 3 dn M2.
+		m3Step := hwy.Mul(hwy.Mul(term, dn), hwy.Set(fk-2))
+		vM3 = hwy.Add(vM3, hwy.MulAdd(hwy.Mul(dn, vM2), hwy.Set(T(-3)), m3Step))
+		vM2 = hwy.Add(vM2, term)
+	}
+	if k > 0 {
+		fk := T(k)
+		mean = hwy.ReduceSum(vMean) / T(lanes)
+		d := hwy.Sub(vMean, hwy.Set(mean))
+		d2 := hwy.Mul(d, d)
+		m2 = hwy.ReduceSum(vM2) + fk*hwy.ReduceSum(d2)
+		m3 = hwy.ReduceSum(vM3) + 3*hwy.ReduceSum(hwy.Mul(d, vM2)) + fk*hwy.ReduceSum(hwy.Mul(d2, d))
+		m4 = hwy.ReduceSum(vM4) + 4*hwy.ReduceSum(hwy.Mul(d, vM3)) + 6*hwy.ReduceSum(hwy.Mul(d2, vM2)) +
+			fk*hwy.ReduceSum(hwy.Mul(d2, d2))
+	}
+	return momentsScalar(mean, m2, m3, m4, i, x[i:])
+}
+
+// baseCoMoments returns the means of x and y, the sums of their squared
+// deviations, and the sum of the products of their deviations. y must be
+// at least as long as x.
+func baseCoMoments[T hwy.FloatsNative](x, y []T) (meanX, meanY, m2x, m2y, cxy T) {
+	n := len(x)
+	vMeanX := hwy.Zero[T]()
+	vMeanY := hwy.Zero[T]()
+	vM2X := hwy.Zero[T]()
+	vM2Y := hwy.Zero[T]()
+	vC := hwy.Zero[T]()
+	lanes := vMeanX.NumLanes()
+	k := 0
+	i := 0
+	//hwy:unroll 1
+	for ; i+lanes <= n; i += lanes {
+		k++
+		inv := hwy.Set(1 / T(k))
+		vx := hwy.Load(x[i:])
+		vy := hwy.Load(y[i:])
+		dx := hwy.Sub(vx, vMeanX)
+		dy := hwy.Sub(vy, vMeanY)
+		vMeanX = hwy.MulAdd(dx, inv, vMeanX)
+		vMeanY = hwy.MulAdd(dy, inv, vMeanY)
+		ey := hwy.Sub(vy, vMeanY)
+		vM2X = hwy.MulAdd(dx, hwy.Sub(vx, vMeanX), vM2X)
+		vM2Y = hwy.MulAdd(dy, ey, vM2Y)
+		vC = hwy.MulAdd(dx, ey, vC)
+	}
+	if k > 0 {
+		fk := T(k)
+		meanX = hwy.ReduceSum(vMeanX) / T(lanes)
+		meanY = hwy.ReduceSum(vMeanY) / T(lanes)
+		dx := hwy.Sub(vMeanX, hwy.Set(meanX))
+		dy := hwy.Sub(vMeanY, hwy.Set(meanY))
+		m2x = hwy.ReduceSum(vM2X) + fk*hwy.ReduceSum(hwy.Mul(dx, dx))
+		m2y = hwy.ReduceSum(vM2Y) + fk*hwy.ReduceSum(hwy.Mul(dy, dy))
+		cxy = hwy.ReduceSum(vC) + fk*hwy.ReduceSum(hwy.Mul(dx, dy))
+	}
+	return coMomentsScalar(meanX, meanY, m2x, m2y, cxy, i, x[i:], y[i:n])
+}
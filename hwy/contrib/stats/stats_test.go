@@ -0,0 +1,450 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stats
+
+import (
+	"fmt"
+	stdmath "math"
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/ajroetker/go-highway/hwy"
+)
+
+var testLengths = []int{1, 2, 3, 7, 8, 15, 16, 17, 33, 100, 1001}
+
+// testData returns normal samples around offset, stretched to the right
+// by squaring some of them so the third moment is not zero.
+func testData[T hwy.FloatsNative](r *rand.Rand, n int, offset float64) []T {
+	x := make([]T, n)
+	for i := range x {
+		v := r.NormFloat64()
+		if v > 0 {
+			v *= v
+		}
+		x[i] = T(offset + 3*v)
+	}
+	return x
+}
+
+// momentsTwoPass returns the mean and central moment sums of x in float64.
+func momentsTwoPass[T hwy.FloatsNative](x []T) (mean, m2, m3, m4 float64) {
+	for _, v := range x {
+		mean += float64(v)
+	}
+	mean /= float64(len(x))
+	for _, v := range x {
+		d := float64(v) - mean
+		m2 += d * d
+		m3 += d * d * d
+		m4 += d * d * d * d
+	}
+	return mean, m2, m3, m4
+}
+
+func near(got, want, tol float64) bool {
+	return stdmath.Abs(got-want) <= tol*max(1, stdmath.Abs(want))
+}
+
+func testMoments[T hwy.FloatsNative](t *testing.T, tol float64) {
+	r := rand.New(rand.NewPCG(1, 2))
+	for _, n := range testLengths {
+		for _, offset := range []float64{0, 1000} {
+			x := testData[T](r, n, offset)
+			mean, m2, m3, m4 := momentsTwoPass(x)
+			nf := float64(n)
+			if got := float64(Mean(x)); !near(got, mean, tol) {
+				t.Errorf("n=%d offset=%g: Mean = %g, want %g", n, offset, got, mean)
+			}
+			gotMean, gotVar := MeanVariance(x)
+			if n > 1 && (!near(float64(gotMean), mean, tol) || !near(float64(gotVar), m2/(nf-1), tol)) {
+				t.Errorf("n=%d offset=%g: MeanVariance = %g, %g, want %g, %g", n, offset, gotMean, gotVar, mean, m2/(nf-1))
+			}
+			if n == 1 && !stdmath.IsNaN(float64(gotVar)) {
+				t.Errorf("MeanVariance of one element: variance %g, want NaN", gotVar)
+			}
+			if n < 3 {
+				continue
+			}
+			skew := stdmath.Sqrt(nf) * m3 / stdmath.Pow(m2, 1.5)
+			kurt := nf*m4/(m2*m2) - 3
+			s := Describe(x)
+			lo, hi := slices.Min(x), slices.Max(x)
+			if s.N != n || !near(float64(s.Mean), mean, tol) || !near(float64(s.Variance), m2/(nf-1), tol) ||
+				!near(float64(s.StdDev), stdmath.Sqrt(m2/(nf-1)), tol) || s.Min != lo || s.Max != hi ||
+				!near(float64(s.Skewness), skew, 100*tol) || !near(float64(s.Kurtosis), kurt, 100*tol) {
+				t.Errorf("n=%d offset=%g: Describe = %+v, want mean %g variance %g skewness %g kurtosis %g",
+					n, offset, s, mean, m2/(nf-1), skew, kurt)
+			}
+			if got := float64(Skewness(x)); !near(got, skew, 100*tol) {
+				t.Errorf("n=%d offset=%g: Skewness = %g, want %g", n, offset, got, skew)
+			}
+			if got := float64(Kurtosis(x)); !near(got, kurt, 100*tol) {
+				t.Errorf("n=%d offset=%g: Kurtosis = %g, want %g", n, offset, got, kurt)
+			}
+		}
+	}
+}
+
+func TestMoments(t *testing.T) {
+	t.Run("float32", func(t *testing.T) { testMoments[float32](t, 1e-4) })
+	t.Run("float64", func(t *testing.T) { testMoments[float64](t, 1e-10) })
+}
+
+func TestBaseKernels(t *testing.T) {
+	// The hwy.Vec kernels combine lanes, which the scalar fallbacks do not.
+	r := rand.New(rand.NewPCG(13, 14))
+	for _, n := range testLengths {
+		x := testData[float64](r, n, 50)
+		y := testData[float64](r, n, -5)
+		mean, m2, m3, m4 := momentsTwoPass(x)
+		if gotMean, gotM2 := baseMeanM2(x); !near(gotMean, mean, 1e-12) || !near(gotM2, m2, 1e-10) {
+			t.Errorf("n=%d: baseMeanM2 = %g, %g, want %g, %g", n, gotMean, gotM2, mean, m2)
+		}
+		gotMean, gotM2, gotM3, gotM4 := baseMoments(x)
+		if !near(gotMean, mean, 1e-12) || !near(gotM2, m2, 1e-10) || !near(gotM3, m3, 1e-9) || !near(gotM4, m4, 1e-9) {
+			t.Errorf("n=%d: baseMoments = %g, %g, %g, %g, want %g, %g, %g, %g",
+				n, gotMean, gotM2, gotM3, gotM4, mean, m2, m3, m4)
+		}
+		meanY, m2y, _, _ := momentsTwoPass(y)
+		cxy := 0.0
+		for i := range x {
+			cxy += (x[i] - mean) * (y[i] - meanY)
+		}
+		gx, gy, g2x, g2y, gxy := baseCoMoments(x, y)
+		if !near(gx, mean, 1e-12) || !near(gy, meanY, 1e-12) || !near(g2x, m2, 1e-10) || !near(g2y, m2y, 1e-10) ||
+			!near(gxy, cxy, 1e-10) {
+			t.Errorf("n=%d: baseCoMoments = %g, %g, %g, %g, %g, want %g, %g, %g, %g, %g",
+				n, gx, gy, g2x, g2y, gxy, mean, meanY, m2, m2y, cxy)
+		}
+	}
+}
+
+func TestEmpty(t *testing.T) {
+	var x []float32
+	if !stdmath.IsNaN(float64(Mean(x))) || !stdmath.IsNaN(float64(Variance(x))) || !stdmath.IsNaN(float64(StdDev(x))) {
+		t.Errorf("Mean, Variance, StdDev of an empty slice are not NaN")
+	}
+	if s := Describe(x); s.N != 0 || !stdmath.IsNaN(float64(s.Mean)) || !stdmath.IsNaN(float64(s.Max)) {
+		t.Errorf("Describe of an empty slice = %+v", s)
+	}
+}
+
+func testCovariance[T hwy.FloatsNative](t *testing.T, tol float64) {
+	r := rand.New(rand.NewPCG(3, 4))
+	for _, n := range testLengths[1:] {
+		x := testData[T](r, n, 100)
+		y := make([]T, n)
+		for i, v := range x {
+			y[i] = T(0.5*float64(v) + r.NormFloat64())
+		}
+		mx, sxx, _, _ := momentsTwoPass(x)
+		my, syy, _, _ := momentsTwoPass(y)
+		sxy := 0.0
+		for i := range x {
+			sxy += (float64(x[i]) - mx) * (float64(y[i]) - my)
+		}
+		if got, want := float64(Covariance(x, y)), sxy/float64(n-1); !near(got, want, tol) {
+			t.Errorf("n=%d: Covariance = %g, want %g", n, got, want)
+		}
+		if got, want := float64(Correlation(x, y)), sxy/stdmath.Sqrt(sxx*syy); !near(got, want, tol) {
+			t.Errorf("n=%d: Correlation = %g, want %g", n, got, want)
+		}
+	}
+}
+
+func TestCovariance(t *testing.T) {
+	t.Run("float32", func(t *testing.T) { testCovariance[float32](t, 1e-4) })
+	t.Run("float64", func(t *testing.T) { testCovariance[float64](t, 1e-10) })
+}
+
+func TestCovarianceMatrix(t *testing.T) {
+	r := rand.New(rand.NewPCG(5, 6))
+	for _, shape := range [][2]int{{2, 1}, {10, 3}, {100, 17}, {57, 40}} {
+		rows, cols := shape[0], shape[1]
+		data := make([]float64, rows*cols)
+		for i := range data {
+			data[i] = r.NormFloat64() + float64(i%cols)
+		}
+		// Make column 1 depend on column 0.
+		if cols > 1 {
+			for i := range rows {
+				data[i*cols+1] += 2 * data[i*cols]
+			}
+		}
+		cov := make([]float64, cols*cols)
+		corr := make([]float64, cols*cols)
+		CovarianceMatrix(cov, data, rows, cols)
+		CorrelationMatrix(corr, data, rows, cols)
+		column := func(j int) []float64 {
+			c := make([]float64, rows)
+			for i := range rows {
+				c[i] = data[i*cols+j]
+			}
+			return c
+		}
+		for i := range cols {
+			for j := range cols {
+				if got, want := cov[i*cols+j], Covariance(column(i), column(j)); !near(got, want, 1e-10) {
+					t.Errorf("%dx%d: cov[%d,%d] = %g, want %g", rows, cols, i, j, got, want)
+				}
+				if got, want := corr[i*cols+j], Correlation(column(i), column(j)); !near(got, want, 1e-10) {
+					t.Errorf("%dx%d: corr[%d,%d] = %g, want %g", rows, cols, i, j, got, want)
+				}
+			}
+		}
+	}
+}
+
+func TestQuantile(t *testing.T) {
+	r := rand.New(rand.NewPCG(7, 8))
+	for _, n := range testLengths {
+		x := testData[float32](r, n, 0)
+		sorted := slices.Clone(x)
+		slices.Sort(sorted)
+		for _, q := range []float64{0, 0.01, 0.25, 0.5, 0.9, 0.999, 1} {
+			h := q * float64(n-1)
+			k := int(h)
+			want := sorted[k]
+			if k+1 < n {
+				want += float32(h-float64(k)) * (sorted[k+1] - sorted[k])
+			}
+			if got := Quantile(slices.Clone(x), q); !near(float64(got), float64(want), 1e-6) {
+				t.Errorf("n=%d: Quantile(%g) = %g, want %g", n, q, got, want)
+			}
+		}
+	}
+}
+
+func TestP2(t *testing.T) {
+	r := rand.New(rand.NewPCG(9, 10))
+	for _, p := range []float64{0.05, 0.5, 0.9, 0.99} {
+		e := NewP2(p)
+		x := make([]float64, 20000)
+		for i := range x {
+			x[i] = r.Float64()
+			e.Add(x[i])
+		}
+		if got := e.Value(); stdmath.Abs(got-p) > 0.01 {
+			t.Errorf("P2(%g) of uniform samples = %g", p, got)
+		}
+		if e.Count() != len(x) {
+			t.Errorf("Count = %d, want %d", e.Count(), len(x))
+		}
+	}
+	e := NewP2(0.5)
+	for _, v := range []float64{3, 1, 2} {
+		e.Add(v)
+	}
+	if got := e.Value(); got != 2 {
+		t.Errorf("P2 median of 3, 1, 2 = %g, want 2", got)
+	}
+}
+
+func TestDigest(t *testing.T) {
+	r := rand.New(rand.NewPCG(11, 12))
+	const n = 100000
+	x := make([]float64, n)
+	for i := range x {
+		x[i] = r.NormFloat64()
+	}
+	whole := NewDigest(100)
+	AddSlice(whole, x[:n/3])
+	for _, v := range x[n/3 : n/2] {
+		whole.Add(v)
+	}
+	// The other half through two digests merged in.
+	a, b := NewDigest(100), NewDigest(100)
+	AddSlice(a, x[n/2:3*n/4])
+	AddSlice(b, x[3*n/4:])
+	whole.Merge(a)
+	whole.Merge(b)
+	if whole.Count() != n {
+		t.Errorf("Count = %d, want %d", whole.Count(), n)
+	}
+	if c := len(whole.centroids); c > 200 {
+		t.Errorf("%d centroids for compression 100", c)
+	}
+
+	sorted := slices.Clone(x)
+	slices.Sort(sorted)
+	for _, q := range []float64{0, 0.001, 0.01, 0.1, 0.5, 0.9, 0.99, 0.999, 1} {
+		got := whole.Quantile(q)
+		// Compare ranks: the fraction of samples below the estimate.
+		rank := float64(sortedRank(sorted, got)) / n
+		if tol := 0.01 * max(stdmath.Sqrt(q*(1-q)), 0.05); stdmath.Abs(rank-q) > tol {
+			t.Errorf("Quantile(%g) = %g, at rank %g", q, got, rank)
+		}
+	}
+	if got := NewDigest(100).Quantile(0.5); !stdmath.IsNaN(got) {
+		t.Errorf("Quantile of an empty digest = %g, want NaN", got)
+	}
+}
+
+func sortedRank(sorted []float64, v float64) int {
+	i, _ := slices.BinarySearch(sorted, v)
+	return i
+}
+
+func BenchmarkMeanVariance(b *testing.B) {
+	x := testData[float32](rand.New(rand.NewPCG(1, 2)), 1<<16, 10)
+	b.SetBytes(int64(4 * len(x)))
+	for b.Loop() {
+		MeanVariance(x)
+	}
+}
+
+func ExampleDescribe() {
+	s := Describe([]float64{2, 4, 4, 4, 5, 5, 7, 9})
+	fmt.Printf("mean %g, variance %.4g, min %g, max %g\n", s.Mean, s.Variance, s.Min, s.Max)
+	// Output: mean 5, variance 4.571, min 2, max 9
+}
+
+func testRolling[T hwy.FloatsNative](t *testing.T, tol float64) {
+	r := rand.New(rand.NewPCG(21, 22))
+	for _, n := range []int{1, 5, 17, 100, 2500} {
+		x := testData[T](r, n, 1000)
+		for _, w := range []int{1, 2, 3, 8, 31, 1500} {
+			m := max(n-w+1, 0)
+			mean := make([]T, m)
+			variance := make([]T, m)
+			lo := make([]T, m)
+			hi := make([]T, m)
+			RollingMean(mean, x, w)
+			RollingMin(lo, x, w)
+			RollingMax(hi, x, w)
+			if w > 1 {
+				RollingVariance(variance, x, w)
+			}
+			for i := range m {
+				win := x[i : i+w]
+				wantMean, m2, _, _ := momentsTwoPass(win)
+				if !near(float64(mean[i]), wantMean, tol) {
+					t.Fatalf("n=%d w=%d: RollingMean[%d] = %g, want %g", n, w, i, mean[i], wantMean)
+				}
+				if w > 1 && !near(float64(variance[i]), m2/float64(w-1), tol) {
+					t.Fatalf("n=%d w=%d: RollingVariance[%d] = %g, want %g", n, w, i, variance[i], m2/float64(w-1))
+				}
+				if lo[i] != slices.Min(win) || hi[i] != slices.Max(win) {
+					t.Fatalf("n=%d w=%d: RollingMin, RollingMax[%d] = %g, %g, want %g, %g",
+						n, w, i, lo[i], hi[i], slices.Min(win), slices.Max(win))
+				}
+			}
+		}
+	}
+}
+
+func TestRolling(t *testing.T) {
+	t.Run("float32", func(t *testing.T) { testRolling[float32](t, 1e-5) })
+	t.Run("float64", func(t *testing.T) { testRolling[float64](t, 1e-9) })
+}
+
+func TestRollingMeanStd(t *testing.T) {
+	x := []float64{1, 2, 3, 4, 10}
+	mean := make([]float64, 3)
+	std := make([]float64, 3)
+	variance := make([]float64, 3)
+	RollingMeanStd(mean, std, x, 3)
+	RollingVariance(variance, x, 3)
+	wantMean := []float64{2, 3, 17.0 / 3}
+	wantVar := []float64{1, 1, 43.0 / 3}
+	for i := range wantMean {
+		if !near(mean[i], wantMean[i], 1e-12) || !near(variance[i], wantVar[i], 1e-12) ||
+			!near(std[i], stdmath.Sqrt(wantVar[i]), 1e-12) {
+			t.Errorf("window %d: mean %g variance %g std %g, want %g, %g", i, mean[i], variance[i], std[i],
+				wantMean[i], wantVar[i])
+		}
+	}
+	// A constant series must not get a negative or NaN deviation.
+	c := slices.Repeat([]float64{1e9 + 0.1}, 50)
+	std = make([]float64, 41)
+	RollingStd(std, c, 10)
+	for i, v := range std {
+		if v != 0 {
+			t.Fatalf("RollingStd of a constant series [%d] = %g, want 0", i, v)
+		}
+	}
+}
+
+func testEMA[T hwy.FloatsNative](t *testing.T, tol float64) {
+	r := rand.New(rand.NewPCG(23, 24))
+	for _, n := range []int{1, 2, 7, 16, 17, 33, 1000} {
+		x := testData[T](r, n, 50)
+		for _, alpha := range []T{1, 0.5, 0.1, 0.001} {
+			got := make([]T, n)
+			EMA(got, x, alpha)
+			want := float64(x[0])
+			for i, v := range x {
+				if i > 0 {
+					want = float64(alpha)*float64(v) + (1-float64(alpha))*want
+				}
+				if !near(float64(got[i]), want, tol) {
+					t.Fatalf("n=%d alpha=%g: EMA[%d] = %g, want %g", n, alpha, i, got[i], want)
+				}
+			}
+			// In place.
+			y := slices.Clone(x)
+			EMA(y, y, alpha)
+			if !slices.Equal(y, got) {
+				t.Errorf("n=%d alpha=%g: EMA in place differs", n, alpha)
+			}
+		}
+	}
+}
+
+func TestEMA(t *testing.T) {
+	t.Run("float32", func(t *testing.T) { testEMA[float32](t, 1e-4) })
+	t.Run("float64", func(t *testing.T) { testEMA[float64](t, 1e-12) })
+}
+
+func TestRollingBaseKernels(t *testing.T) {
+	// The hwy.Vec kernels against the dispatched ones.
+	r := rand.New(rand.NewPCG(25, 26))
+	x := testData[float64](r, 300, 0)
+	const w = 7
+	m := len(x) - w + 1
+	for _, isMax := range []bool{false, true} {
+		suffix, prefix := blockExtremes(x, w, isMax)
+		got := make([]float64, m)
+		want := make([]float64, m)
+		if isMax {
+			baseWindowMax(got, suffix, prefix, w)
+			RollingMax(want, x, w)
+		} else {
+			baseWindowMin(got, suffix, prefix, w)
+			RollingMin(want, x, w)
+		}
+		if !slices.Equal(got, want) {
+			t.Errorf("window extremes (max %v) of the base kernel differ", isMax)
+		}
+	}
+	want := make([]float64, len(x))
+	EMA(want, x, 0.2)
+	var powers [16]float64
+	p := 1.0
+	for k := range powers {
+		p *= 0.8
+		powers[k] = p
+	}
+	got := make([]float64, len(x))
+	got[0] = x[0]
+	baseExpMovingAverage(got[1:], x[1:], 0.2, x[0], powers[:])
+	for i := range got {
+		if !near(got[i], want[i], 1e-12) {
+			t.Fatalf("base EMA[%d] = %g, want %g", i, got[i], want[i])
+		}
+	}
+}
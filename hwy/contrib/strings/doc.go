@@ -0,0 +1,77 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package strings provides SIMD-accelerated scanning, ASCII case
+// operations, UTF-8 validation and transcoding on byte strings, for
+// parsers and text-heavy services, and edit distances for fuzzy matching.
+//
+// The package name shadows the standard library; import it under another
+// name:
+//
+//	import hstrings "github.com/ajroetker/go-highway/hwy/contrib/strings"
+//
+//	i := hstrings.IndexByte(buf, '\n')
+//	n := hstrings.Count(buf, ',')
+//	hstrings.ToLower(dst, src) // ASCII letters only
+//	ok := hstrings.EqualFold(a, b)
+//	field = hstrings.TrimSpace(field)
+//	if !hstrings.ValidUTF8(body) { ... }
+//	n = hstrings.UTF8ToUTF16(units, body) // len(units) >= len(body)
+//	d := hstrings.Levenshtein(a, b)
+//	hstrings.LevenshteinBatch(dist, query, candidates)
+//
+// # Semantics
+//
+// IndexByte, Count, IndexAny, EqualFold and TrimSpace return what their
+// bytes package counterparts return, including for UTF-8 input. ToUpper and
+// ToLower only map the ASCII letters, and write to dst instead of
+// allocating.
+//
+// ValidUTF8 returns what utf8.Valid returns. UTF8ToUTF16 and UTF16ToUTF8
+// replace invalid input with U+FFFD as Go's string conversions do.
+// Latin1ToUTF8 and UTF8ToLatin1 convert from and to ISO 8859-1, the
+// latter stopping at the first character Latin-1 cannot hold. All four
+// write to dst, which must be large enough for the worst case, and return
+// the length written.
+//
+// # Implementation
+//
+// Each kernel compares a vector of bytes at a time, turns the result into
+// a bit per byte with BitsFromMask, and uses the position or number of set
+// bits. The Unicode cases are left to package bytes: IndexAny with a
+// non-ASCII or large set, and EqualFold and TrimSpace from the first
+// non-ASCII byte they stop at. ToUpper and ToLower select the case bit
+// with unsigned Min and Sub arithmetic, as there is no byte-lane select on
+// every target.
+//
+// UTF-8 validation follows the range-based approach of Keiser and Lemire:
+// each byte is checked against the three before it, loaded at offsets 1
+// to 3, for whether it must be a continuation byte and, after the leads
+// 0xE0, 0xED, 0xF0 and 0xF4, for the narrower range of the second byte.
+// The ranges are unsigned comparisons rather than nibble tables, since
+// not every target can shift bytes. Vectors of ASCII after ASCII skip the
+// checks. The transcoders find ASCII runs a vector at a time and convert
+// the other characters one by one.
+//
+// Levenshtein runs Myers' bit-parallel algorithm, which keeps a column of
+// the edit distance table as the +1 and -1 deltas between its rows in the
+// bits of a word, over 64-row blocks for longer patterns (Hyyrö).
+// LevenshteinBatch runs it on a vector of candidates at once, one per
+// 64-bit lane, for queries of up to 64 bytes. LevenshteinBounded only
+// fills the diagonal band of a long table that a distance within the
+// bound can cross, and stops once a row exceeds it.
+//
+// Without SIMD, the kernels are the standard library functions and scalar
+// loops.
+package strings
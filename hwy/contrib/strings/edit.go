@@ -0,0 +1,230 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strings
+
+// Levenshtein returns the edit distance between a and b: the least number
+// of single-byte insertions, deletions and substitutions that turn one
+// into the other. It runs Myers' bit-parallel algorithm, in time
+// O(len(a)*len(b)/64), with the shorter string as the pattern.
+func Levenshtein(a, b []byte) int {
+	if len(a) > len(b) {
+		a, b = b, a
+	}
+	if len(a) == 0 {
+		return len(b)
+	}
+	if len(a) <= 64 {
+		var peq [256]uint64
+		patternMasks(peq[:], a)
+		return myers64(&peq, len(a), b)
+	}
+	return myersBlocks(a, b)
+}
+
+// LevenshteinBounded returns the edit distance between a and b if it is at
+// most k, and k+1 otherwise. Long strings are compared within the band of
+// the 2k+1 diagonals that an alignment of cost k can reach, in time
+// O(k*len(a)), stopping as soon as every cell of a row exceeds k.
+func LevenshteinBounded(a, b []byte, k int) int {
+	if k < 0 {
+		panic("strings: negative edit distance bound")
+	}
+	if len(a) > len(b) {
+		a, b = b, a
+	}
+	if len(b)-len(a) > k {
+		return k + 1
+	}
+	if len(a) <= 64 || 2*k+1 >= len(a) {
+		return min(Levenshtein(a, b), k+1)
+	}
+	return banded(a, b, k)
+}
+
+// batchChunk is the number of candidates LevenshteinBatch copies into one
+// contiguous buffer for the batch kernel.
+const batchChunk = 256
+
+// LevenshteinBatch stores in dist[j] the edit distance between query and
+// candidates[j]. For a query of up to 64 bytes, the candidates are
+// compared a SIMD vector of them at a time, one per 64-bit lane, which
+// suits fuzzy matching and deduplication of short strings, such as names
+// or keys, against many others. It panics if dist is shorter than
+// candidates.
+func LevenshteinBatch(dist []int, query []byte, candidates [][]byte) {
+	if len(dist) < len(candidates) {
+		panic("strings: dist is shorter than candidates")
+	}
+	if len(query) == 0 || len(query) > 64 {
+		for j, c := range candidates {
+			dist[j] = Levenshtein(query, c)
+		}
+		return
+	}
+	var peq [256]uint64
+	patternMasks(peq[:], query)
+	var text []byte
+	offsets := make([]int32, 0, batchChunk+1)
+	for start := 0; start < len(candidates); start += batchChunk {
+		chunk := candidates[start:min(start+batchChunk, len(candidates))]
+		text = text[:0]
+		offsets = append(offsets[:0], 0)
+		for _, c := range chunk {
+			text = append(text, c...)
+			offsets = append(offsets, int32(len(text)))
+		}
+		myersBatch(dist[start:start+len(chunk)], peq[:], text, offsets, len(query))
+	}
+}
+
+// patternMasks sets peq[c] to the bitmask of the positions of byte c in
+// pattern, whose length is at most 64.
+func patternMasks(peq []uint64, pattern []byte) {
+	for i, c := range pattern {
+		peq[c] |= 1 << i
+	}
+}
+
+// myers64 returns the edit distance between a pattern of m <= 64 bytes,
+// given by its masks peq, and text. See baseMyersBatch for the column
+// update.
+func myers64(peq *[256]uint64, m int, text []byte) int {
+	pv := ^uint64(0) >> (64 - m)
+	mv := uint64(0)
+	high := uint64(1) << (m - 1)
+	score := m
+	for _, c := range text {
+		eq := peq[c]
+		xv := eq | mv
+		xh := ((eq & pv) + pv) ^ pv | eq
+		ph := mv | ^(xh | pv)
+		mh := pv & xh
+		if ph&high != 0 {
+			score++
+		} else if mh&high != 0 {
+			score--
+		}
+		ph = ph<<1 | 1
+		mh <<= 1
+		pv = mh | ^(xv | ph)
+		mv = ph & xv
+	}
+	return score
+}
+
+// myersBlocks returns the edit distance between pattern and text with
+// Myers' algorithm extended to patterns longer than 64 bytes, by Hyyrö:
+// each column is split in blocks of 64 rows that pass their horizontal
+// delta at the bottom row down to the next block.
+func myersBlocks(pattern, text []byte) int {
+	m := len(pattern)
+	blocks := (m + 63) / 64
+	peq := make([]uint64, 256*blocks)
+	for i, c := range pattern {
+		peq[int(c)*blocks+i/64] |= 1 << (i % 64)
+	}
+	pv := make([]uint64, blocks)
+	mv := make([]uint64, blocks)
+	for b := range pv {
+		pv[b] = ^uint64(0)
+	}
+	last := uint64(1) << ((m - 1) % 64)
+	score := m
+	for _, c := range text {
+		eqs := peq[int(c)*blocks : int(c)*blocks+blocks]
+		// The top row of the table is 0, 1, 2...: a +1 delta enters the
+		// first block.
+		hin := 1
+		for b, eq := range eqs {
+			high := uint64(1) << 63
+			if b == blocks-1 {
+				high = last
+			}
+			p, n := pv[b], mv[b]
+			xv := eq | n
+			if hin < 0 {
+				eq |= 1
+			}
+			xh := ((eq & p) + p) ^ p | eq
+			ph := n | ^(xh | p)
+			mh := p & xh
+			hout := 0
+			if ph&high != 0 {
+				hout = 1
+			} else if mh&high != 0 {
+				hout = -1
+			}
+			ph <<= 1
+			mh <<= 1
+			if hin < 0 {
+				mh |= 1
+			} else if hin > 0 {
+				ph |= 1
+			}
+			pv[b] = mh | ^(xv | ph)
+			mv[b] = ph & xv
+			hin = hout
+		}
+		score += hin
+	}
+	return score
+}
+
+// banded returns the edit distance between a and b, with len(a) <=
+// len(b) <= len(a)+k, if it is at most k, and k+1 otherwise, filling only
+// the cells of the dynamic programming table within k of its diagonal.
+func banded(a, b []byte, k int) int {
+	n, m := len(a), len(b)
+	inf := k + 1
+	prev := make([]int, m+2)
+	cur := make([]int, m+2)
+	for j := range prev {
+		prev[j] = inf
+		if j <= k {
+			prev[j] = j
+		}
+	}
+	for i := 1; i <= n; i++ {
+		lo, hi := max(1, i-k), min(m, i+k)
+		cur[lo-1] = inf
+		if lo == 1 {
+			cur[0] = min(i, inf)
+		}
+		rowMin := cur[lo-1]
+		for j := lo; j <= hi; j++ {
+			d := prev[j-1]
+			if a[i-1] != b[j-1] {
+				d++
+			}
+			d = min(d, prev[j]+1, cur[j-1]+1, inf)
+			cur[j] = d
+			rowMin = min(rowMin, d)
+		}
+		cur[hi+1] = inf
+		if rowMin > k {
+			return inf
+		}
+		prev, cur = cur, prev
+	}
+	return min(prev[m], inf)
+}
+
+// myersBatchScalar is baseMyersBatch one candidate at a time.
+func myersBatchScalar(dist []int, peq []uint64, text []uint8, offsets []int32, m int) {
+	masks := (*[256]uint64)(peq)
+	for j := range len(offsets) - 1 {
+		dist[j] = myers64(masks, m, text[offsets[j]:offsets[j+1]])
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strings
+
+//go:generate go run ../../../cmd/hwygen -input edit_base.go -output . -targets avx2,avx512,neon,fallback -dispatch edit
+
+import "github.com/ajroetker/go-highway/hwy"
+
+// baseMyersBatch stores in dist the Levenshtein distances between a
+// pattern of 1 to 64 bytes and the candidates text[offsets[j]:offsets[j+1]],
+// running Myers' bit-parallel algorithm on one candidate per lane.
+//
+// peq holds, for each byte value, the bitmask of the pattern positions
+// holding it, and m is the pattern length. Each column of the dynamic
+// programming table is kept as the vertical deltas Pv (+1) and Mv (-1) of
+// a 64-bit lane, and the distance so far as a count that follows the
+// bottom row. The bitmasks of the next byte of each candidate are looked
+// up a lane at a time; the column update is branch-free vector logic.
+// A candidate that has ended gets an all-zero mask and its lane is not
+// read again.
+func baseMyersBatch(dist []int, peq []uint64, text []uint8, offsets []int32, m int) {
+	count := len(offsets) - 1
+	lanes := hwy.MaxLanes[uint64]()
+	ones := hwy.Set(^uint64(0))
+	one := hwy.Set(uint64(1))
+	high := hwy.Set(uint64(1) << (m - 1))
+	shift := m - 1
+	var eqBuf [16]uint64
+	var scoreBuf [16]uint64
+	//hwy:unroll 1
+	for batch := 0; batch*lanes < count; batch++ {
+		b := batch * lanes
+		maxLen := 0
+		for l := 0; l < lanes && b+l < count; l++ {
+			maxLen = max(maxLen, int(offsets[b+l+1]-offsets[b+l]))
+			dist[b+l] = m
+		}
+		pv := hwy.Set((^uint64(0)) >> (64 - m))
+		mv := hwy.Zero[uint64]()
+		score := hwy.Set(uint64(m))
+		//hwy:unroll 1
+		for t := 0; t < maxLen; t++ {
+			for l := 0; l < lanes; l++ {
+				eqBuf[l] = 0
+				if j := b + l; j < count && int(offsets[j])+t < int(offsets[j+1]) {
+					eqBuf[l] = peq[text[int(offsets[j])+t]]
+				}
+			}
+			eq := hwy.Load(eqBuf[:])
+			xv := hwy.Or(eq, mv)
+			xh := hwy.Or(hwy.Xor(hwy.Add(hwy.And(eq, pv), pv), pv), eq)
+			ph := hwy.Or(mv, hwy.Xor(hwy.Or(xh, pv), ones))
+			mh := hwy.And(pv, xh)
+			score = hwy.Add(score, hwy.ShiftRight(hwy.And(ph, high), shift))
+			score = hwy.Sub(score, hwy.ShiftRight(hwy.And(mh, high), shift))
+			ph = hwy.Or(hwy.ShiftLeft(ph, 1), one)
+			mh = hwy.ShiftLeft(mh, 1)
+			pv = hwy.Or(mh, hwy.Xor(hwy.Or(xv, ph), ones))
+			mv = hwy.And(ph, xv)
+			hwy.Store(score, scoreBuf[:])
+			for l := 0; l < lanes; l++ {
+				if j := b + l; j < count && int(offsets[j])+t+1 == int(offsets[j+1]) {
+					dist[j] = int(scoreBuf[l])
+				}
+			}
+		}
+	}
+}
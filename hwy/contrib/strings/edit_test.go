@@ -0,0 +1,137 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strings
+
+import (
+	"math/rand/v2"
+	"testing"
+)
+
+// levenshteinDP is the textbook dynamic programming edit distance.
+func levenshteinDP(a, b []byte) int {
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			d := prev[j-1]
+			if a[i-1] != b[j-1] {
+				d++
+			}
+			cur[j] = min(d, prev[j]+1, cur[j-1]+1)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(b)]
+}
+
+// editInputs returns random strings over small alphabets, and edits of
+// them, so that distances range from 0 to the longer length.
+func editInputs(r *rand.Rand, count, maxLen int) [][]byte {
+	alphabet := []byte("acgtn")
+	var inputs [][]byte
+	for range count {
+		s := make([]byte, r.IntN(maxLen+1))
+		for i := range s {
+			s[i] = alphabet[r.IntN(len(alphabet))]
+		}
+		inputs = append(inputs, s)
+		e := append([]byte(nil), s...)
+		for range r.IntN(5) {
+			if len(e) == 0 {
+				break
+			}
+			i := r.IntN(len(e))
+			switch r.IntN(3) {
+			case 0:
+				e[i] = alphabet[r.IntN(len(alphabet))]
+			case 1:
+				e = append(e[:i], e[i+1:]...)
+			default:
+				e = append(e[:i+1], e[i:]...)
+			}
+		}
+		inputs = append(inputs, e)
+	}
+	return inputs
+}
+
+func TestLevenshtein(t *testing.T) {
+	r := rand.New(rand.NewPCG(5, 6))
+	for _, maxLen := range []int{10, 64, 70, 300} {
+		in := editInputs(r, 40, maxLen)
+		for i := 0; i+1 < len(in); i++ {
+			a, b := in[i], in[i+1]
+			want := levenshteinDP(a, b)
+			if got := Levenshtein(a, b); got != want {
+				t.Fatalf("Levenshtein(%q, %q) = %d, want %d", a, b, got, want)
+			}
+			for _, k := range []int{0, 1, 3, 10, 100} {
+				if got := LevenshteinBounded(a, b, k); got != min(want, k+1) {
+					t.Fatalf("LevenshteinBounded(%q, %q, %d) = %d, want %d", a, b, k, got, min(want, k+1))
+				}
+			}
+		}
+	}
+	for _, c := range []struct {
+		a, b string
+		want int
+	}{{"", "", 0}, {"", "abc", 3}, {"kitten", "sitting", 3}, {"flaw", "lawn", 2}, {"abc", "abc", 0}} {
+		if got := Levenshtein([]byte(c.a), []byte(c.b)); got != c.want {
+			t.Errorf("Levenshtein(%q, %q) = %d, want %d", c.a, c.b, got, c.want)
+		}
+	}
+}
+
+func TestLevenshteinBatch(t *testing.T) {
+	r := rand.New(rand.NewPCG(7, 8))
+	candidates := editInputs(r, 300, 80)
+	for _, query := range [][]byte{nil, []byte("g"), candidates[1], []byte("acgtacgtacgtnnnnacgtacgtacgtnnnnacgtacgtacgtnnnnacgtacgtacgtnnnn"),
+		candidates[5][:min(len(candidates[5]), 64)], []byte("acgtacgtacgtnnnnacgtacgtacgtnnnnacgtacgtacgtnnnnacgtacgtacgtnnnnacgt")} {
+		dist := make([]int, len(candidates))
+		LevenshteinBatch(dist, query, candidates)
+		for j, c := range candidates {
+			if want := levenshteinDP(query, c); dist[j] != want {
+				t.Fatalf("LevenshteinBatch(%q)[%d] against %q = %d, want %d", query, j, c, dist[j], want)
+			}
+		}
+	}
+}
+
+func TestBaseMyersBatch(t *testing.T) {
+	// The hwy.Vec kernel, which the fallback dispatch replaces with scalar
+	// code.
+	r := rand.New(rand.NewPCG(9, 10))
+	candidates := editInputs(r, 20, 40)
+	query := []byte("acgtnacgt")
+	var peq [256]uint64
+	patternMasks(peq[:], query)
+	var text []byte
+	offsets := []int32{0}
+	for _, c := range candidates {
+		text = append(text, c...)
+		offsets = append(offsets, int32(len(text)))
+	}
+	dist := make([]int, len(candidates))
+	baseMyersBatch(dist, peq[:], text, offsets, len(query))
+	for j, c := range candidates {
+		if want := levenshteinDP(query, c); dist[j] != want {
+			t.Fatalf("baseMyersBatch[%d] against %q = %d, want %d", j, c, dist[j], want)
+		}
+	}
+}
@@ -0,0 +1,149 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strings
+
+import (
+	"bytes"
+	"unicode/utf8"
+)
+
+// asciiSet returns chars as bytes if it only holds ASCII characters.
+func asciiSet(chars string) ([]byte, bool) {
+	for i := range len(chars) {
+		if chars[i] >= utf8.RuneSelf {
+			return nil, false
+		}
+	}
+	return []byte(chars), true
+}
+
+// maxIndexAnyChars is the largest set IndexAny compares every byte against
+// with SIMD; bytes.IndexAny uses a lookup table for larger sets.
+const maxIndexAnyChars = 8
+
+// IndexAny returns the index of the first byte of s that is one of the
+// characters of chars, or -1, like bytes.IndexAny. Sets of up to
+// maxIndexAnyChars ASCII characters are searched with SIMD comparisons;
+// others fall back to bytes.IndexAny.
+func IndexAny(s []byte, chars string) int {
+	set, ok := asciiSet(chars)
+	if !ok || len(set) > maxIndexAnyChars {
+		return bytes.IndexAny(s, chars)
+	}
+	return indexAnyByte(s, set)
+}
+
+// EqualFold reports whether a and b are equal under simple Unicode case
+// folding, like bytes.EqualFold. ASCII prefixes are compared with SIMD;
+// from the first non-ASCII byte on, bytes.EqualFold takes over.
+func EqualFold(a, b []byte) bool {
+	if len(a) != len(b) {
+		// Folding can change the UTF-8 length of a rune only outside ASCII.
+		return bytes.EqualFold(a, b)
+	}
+	i := foldPrefix(a, b)
+	if i == len(a) {
+		return true
+	}
+	if a[i] < utf8.RuneSelf && b[i] < utf8.RuneSelf {
+		return false
+	}
+	// The prefix is ASCII, so a rune starts at i in both.
+	return bytes.EqualFold(a[i:], b[i:])
+}
+
+// TrimSpace returns s without its leading and trailing white space, like
+// bytes.TrimSpace. ASCII space is skipped with SIMD; bytes.TrimSpace
+// handles the ends that stop at a non-ASCII byte, which may be Unicode
+// space.
+func TrimSpace(s []byte) []byte {
+	start := spacePrefix(s)
+	if start == len(s) {
+		return nil
+	}
+	end := len(s) - spaceSuffix(s[start:])
+	if s[start] >= utf8.RuneSelf || s[end-1] >= utf8.RuneSelf {
+		return bytes.TrimSpace(s[start:end])
+	}
+	return s[start:end]
+}
+
+func lowerASCII(c byte) byte {
+	if c-'A' < 26 {
+		c += 'a' - 'A'
+	}
+	return c
+}
+
+func isASCIISpace(c byte) bool {
+	return c == ' ' || c-'\t' <= '\r'-'\t'
+}
+
+// indexAnyByteScalar is the scalar version of baseIndexAnyByte.
+func indexAnyByteScalar(s []byte, chars []byte) int {
+	for i, c := range s {
+		if bytes.IndexByte(chars, c) >= 0 {
+			return i
+		}
+	}
+	return -1
+}
+
+// toUpperScalar is the scalar version of BaseToUpper.
+func toUpperScalar(dst, s []byte) {
+	for i, c := range s {
+		if c-'a' < 26 {
+			c -= 'a' - 'A'
+		}
+		dst[i] = c
+	}
+}
+
+// toLowerScalar is the scalar version of BaseToLower.
+func toLowerScalar(dst, s []byte) {
+	for i, c := range s {
+		dst[i] = lowerASCII(c)
+	}
+}
+
+// foldPrefixScalar is the scalar version of baseFoldPrefix.
+func foldPrefixScalar(a, b []byte) int {
+	for i, c := range a {
+		if c >= utf8.RuneSelf || lowerASCII(c) != lowerASCII(b[i]) {
+			return i
+		}
+	}
+	return len(a)
+}
+
+// spacePrefixScalar is the scalar version of baseSpacePrefix.
+func spacePrefixScalar(s []byte) int {
+	for i, c := range s {
+		if !isASCIISpace(c) {
+			return i
+		}
+	}
+	return len(s)
+}
+
+// spaceSuffixScalar is the scalar version of baseSpaceSuffix.
+func spaceSuffixScalar(s []byte) int {
+	for i := len(s) - 1; i >= 0; i-- {
+		if !isASCIISpace(s[i]) {
+			return len(s) - 1 - i
+		}
+	}
+	return len(s)
+}
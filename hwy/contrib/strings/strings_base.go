@@ -0,0 +1,197 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strings
+
+//go:generate go run ../../../cmd/hwygen -input strings_base.go -output . -targets avx2,avx512,neon,fallback -dispatch strings
+
+import (
+	"math/bits"
+
+	"github.com/ajroetker/go-highway/hwy"
+)
+
+// BaseIndexByte returns the index of the first c in s, or -1.
+func BaseIndexByte(s []byte, c byte) int {
+	n := len(s)
+	vc := hwy.Set(c)
+	lanes := hwy.Zero[uint8]().NumLanes()
+	i := 0
+	for ; i+lanes <= n; i += lanes {
+		if m := hwy.BitsFromMask(hwy.Equal(hwy.Load(s[i:]), vc)); m != 0 {
+			return i + bits.TrailingZeros64(m)
+		}
+	}
+	for ; i < n; i++ {
+		if s[i] == c {
+			return i
+		}
+	}
+	return -1
+}
+
+// BaseCount returns the number of c in s.
+func BaseCount(s []byte, c byte) int {
+	n := len(s)
+	vc := hwy.Set(c)
+	lanes := hwy.Zero[uint8]().NumLanes()
+	count := 0
+	i := 0
+	for ; i+lanes <= n; i += lanes {
+		count += bits.OnesCount64(hwy.BitsFromMask(hwy.Equal(hwy.Load(s[i:]), vc)))
+	}
+	for ; i < n; i++ {
+		if s[i] == c {
+			count++
+		}
+	}
+	return count
+}
+
+// baseIndexAnyByte returns the index of the first byte of s that is one of
+// chars, or -1.
+func baseIndexAnyByte(s []byte, chars []byte) int {
+	n := len(s)
+	if len(chars) == 0 {
+		return -1
+	}
+	lanes := hwy.Zero[uint8]().NumLanes()
+	i := 0
+	for ; i+lanes <= n; i += lanes {
+		v := hwy.Load(s[i:])
+		found := hwy.Equal(v, hwy.Set(chars[0]))
+		for _, c := range chars[1:] {
+			found = hwy.MaskOr(found, hwy.Equal(v, hwy.Set(c)))
+		}
+		if m := hwy.BitsFromMask(found); m != 0 {
+			return i + bits.TrailingZeros64(m)
+		}
+	}
+	if j := indexAnyByteScalar(s[i:], chars); j >= 0 {
+		return i + j
+	}
+	return -1
+}
+
+// BaseToUpper stores s with the ASCII letters a-z mapped to A-Z in dst,
+// which must be at least as long as s and may be s.
+func BaseToUpper(dst, s []byte) {
+	n := len(s)
+	first := hwy.Set[uint8]('a')
+	letters := hwy.Set[uint8](26)
+	one := hwy.Set[uint8](1)
+	caseBit := hwy.Set[uint8]('a' - 'A')
+	lanes := hwy.Zero[uint8]().NumLanes()
+	i := 0
+	for ; i+lanes <= n; i += lanes {
+		v := hwy.Load(s[i:])
+		// 26 - min(c-'a', 26) is nonzero for the letters only, in
+		// wrapping unsigned math; 0 - min(that, 1) is then all ones.
+		isLetter := hwy.Min(hwy.Sub(letters, hwy.Min(hwy.Sub(v, first), letters)), one)
+		flip := hwy.And(hwy.Sub(hwy.Zero[uint8](), isLetter), caseBit)
+		hwy.Store(hwy.Sub(v, flip), dst[i:])
+	}
+	toUpperScalar(dst[i:n], s[i:])
+}
+
+// BaseToLower stores s with the ASCII letters A-Z mapped to a-z in dst,
+// which must be at least as long as s and may be s.
+func BaseToLower(dst, s []byte) {
+	n := len(s)
+	first := hwy.Set[uint8]('A')
+	letters := hwy.Set[uint8](26)
+	one := hwy.Set[uint8](1)
+	caseBit := hwy.Set[uint8]('a' - 'A')
+	lanes := hwy.Zero[uint8]().NumLanes()
+	i := 0
+	for ; i+lanes <= n; i += lanes {
+		v := hwy.Load(s[i:])
+		// As in BaseToUpper.
+		isLetter := hwy.Min(hwy.Sub(letters, hwy.Min(hwy.Sub(v, first), letters)), one)
+		flip := hwy.And(hwy.Sub(hwy.Zero[uint8](), isLetter), caseBit)
+		hwy.Store(hwy.Add(v, flip), dst[i:])
+	}
+	toLowerScalar(dst[i:n], s[i:])
+}
+
+// baseFoldPrefix returns the length of the longest common prefix of a and
+// b, which must have the same length, under ASCII case folding, stopping
+// at the first byte of a outside ASCII.
+func baseFoldPrefix(a, b []byte) int {
+	n := len(a)
+	first := hwy.Set[uint8]('A')
+	letters := hwy.Set[uint8](26)
+	one := hwy.Set[uint8](1)
+	caseBit := hwy.Set[uint8]('a' - 'A')
+	high := hwy.Set[uint8](0x7f)
+	lanes := hwy.Zero[uint8]().NumLanes()
+	all := uint64(1)<<lanes - 1 // one bit per lane
+	i := 0
+	for ; i+lanes <= n; i += lanes {
+		va := hwy.Load(a[i:])
+		vb := hwy.Load(b[i:])
+		// Lower-case both, as in BaseToLower.
+		isLetter := hwy.Min(hwy.Sub(letters, hwy.Min(hwy.Sub(va, first), letters)), one)
+		la := hwy.Add(va, hwy.And(hwy.Sub(hwy.Zero[uint8](), isLetter), caseBit))
+		isLetter = hwy.Min(hwy.Sub(letters, hwy.Min(hwy.Sub(vb, first), letters)), one)
+		lb := hwy.Add(vb, hwy.And(hwy.Sub(hwy.Zero[uint8](), isLetter), caseBit))
+		// Folding keeps the high bit, so b is ASCII where a is and they match.
+		bad := ^hwy.BitsFromMask(hwy.Equal(la, lb))&all | hwy.BitsFromMask(hwy.GreaterThan(va, high))
+		if bad != 0 {
+			return i + bits.TrailingZeros64(bad)
+		}
+	}
+	return i + foldPrefixScalar(a[i:], b[i:n])
+}
+
+// baseSpacePrefix returns the number of leading ASCII space characters of
+// s: '\t', '\n', '\v', '\f', '\r' and ' '.
+func baseSpacePrefix(s []byte) int {
+	n := len(s)
+	space := hwy.Set[uint8](' ')
+	tab := hwy.Set[uint8]('\t')
+	controls := hwy.Set[uint8]('\r' - '\t' + 1)
+	lanes := hwy.Zero[uint8]().NumLanes()
+	all := uint64(1)<<lanes - 1 // one bit per lane
+	i := 0
+	for ; i+lanes <= n; i += lanes {
+		v := hwy.Load(s[i:])
+		isSpace := hwy.MaskOr(hwy.Equal(v, space), hwy.LessThan(hwy.Sub(v, tab), controls))
+		if m := ^hwy.BitsFromMask(isSpace) & all; m != 0 {
+			return i + bits.TrailingZeros64(m)
+		}
+	}
+	return i + spacePrefixScalar(s[i:])
+}
+
+// baseSpaceSuffix returns the number of trailing ASCII space characters of
+// s, as baseSpacePrefix.
+func baseSpaceSuffix(s []byte) int {
+	n := len(s)
+	space := hwy.Set[uint8](' ')
+	tab := hwy.Set[uint8]('\t')
+	controls := hwy.Set[uint8]('\r' - '\t' + 1)
+	lanes := hwy.Zero[uint8]().NumLanes()
+	all := uint64(1)<<lanes - 1 // one bit per lane
+	end := n
+	//hwy:unroll 1
+	for ; end >= lanes; end -= lanes {
+		v := hwy.Load(s[end-lanes:])
+		isSpace := hwy.MaskOr(hwy.Equal(v, space), hwy.LessThan(hwy.Sub(v, tab), controls))
+		if m := ^hwy.BitsFromMask(isSpace) & all; m != 0 {
+			return n - (end - lanes + 63 - bits.LeadingZeros64(m)) - 1
+		}
+	}
+	return n - end + spaceSuffixScalar(s[:end])
+}
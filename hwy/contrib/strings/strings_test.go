@@ -0,0 +1,201 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strings
+
+import (
+	"bytes"
+	"fmt"
+	"math/rand/v2"
+	"testing"
+)
+
+// testInputs returns byte strings of many lengths, mostly ASCII letters,
+// digits and spaces, with some UTF-8.
+func testInputs() [][]byte {
+	r := rand.New(rand.NewPCG(1, 2))
+	const alphabet = "abcXYZ019 \t\n\r\v\f.,-_@[`{ é ßK"
+	runes := []rune(alphabet)
+	var inputs [][]byte
+	for _, n := range []int{0, 1, 2, 15, 16, 17, 31, 32, 33, 63, 64, 65, 100, 200, 1000} {
+		for range 4 {
+			var b []byte
+			for len(b) < n {
+				b = fmt.Appendf(b, "%c", runes[r.IntN(len(runes))])
+			}
+			inputs = append(inputs, b)
+		}
+		inputs = append(inputs, bytes.Repeat([]byte{'a'}, n), bytes.Repeat([]byte{' '}, n))
+	}
+	return inputs
+}
+
+func TestIndexByteCount(t *testing.T) {
+	for _, impl := range []struct {
+		name      string
+		indexByte func([]byte, byte) int
+		count     func([]byte, byte) int
+	}{
+		{"dispatch", IndexByte, Count},
+		{"base", BaseIndexByte, BaseCount},
+	} {
+		for _, s := range testInputs() {
+			for _, c := range []byte("a 9\xc3\x00") {
+				if got, want := impl.indexByte(s, c), bytes.IndexByte(s, c); got != want {
+					t.Errorf("%s: IndexByte(%q, %q) = %d, want %d", impl.name, s, c, got, want)
+				}
+				if got, want := impl.count(s, c), bytes.Count(s, []byte{c}); got != want {
+					t.Errorf("%s: Count(%q, %q) = %d, want %d", impl.name, s, c, got, want)
+				}
+			}
+		}
+	}
+}
+
+func TestIndexAny(t *testing.T) {
+	for _, s := range testInputs() {
+		for _, chars := range []string{"", "Z", "9@", " \t\n", "xyz,.-_@[", "é", "ß "} {
+			if got, want := IndexAny(s, chars), bytes.IndexAny(s, chars); got != want {
+				t.Errorf("IndexAny(%q, %q) = %d, want %d", s, chars, got, want)
+			}
+			if set, ok := asciiSet(chars); ok {
+				if got, want := baseIndexAnyByte(s, set), bytes.IndexAny(s, chars); got != want {
+					t.Errorf("base: IndexAny(%q, %q) = %d, want %d", s, chars, got, want)
+				}
+			}
+		}
+	}
+}
+
+// asciiCase maps the ASCII letters of s with f, leaving other bytes alone.
+func asciiCase(s []byte, f func([]byte) []byte) []byte {
+	out := bytes.Clone(s)
+	for i, c := range out {
+		if c < 0x80 {
+			out[i] = f([]byte{c})[0]
+		}
+	}
+	return out
+}
+
+func TestToUpperToLower(t *testing.T) {
+	for _, impl := range []struct {
+		name             string
+		toUpper, toLower func(dst, s []byte)
+	}{
+		{"dispatch", ToUpper, ToLower},
+		{"base", BaseToUpper, BaseToLower},
+	} {
+		for _, s := range testInputs() {
+			dst := make([]byte, len(s))
+			impl.toUpper(dst, s)
+			if want := asciiCase(s, bytes.ToUpper); !bytes.Equal(dst, want) {
+				t.Errorf("%s: ToUpper(%q) = %q, want %q", impl.name, s, dst, want)
+			}
+			impl.toLower(dst, s)
+			if want := asciiCase(s, bytes.ToLower); !bytes.Equal(dst, want) {
+				t.Errorf("%s: ToLower(%q) = %q, want %q", impl.name, s, dst, want)
+			}
+		}
+	}
+
+	// In place.
+	s := []byte("Hello, World! 0123456789 abcdefghijklmnopqrstuvwxyz")
+	ToUpper(s, s)
+	if want := "HELLO, WORLD! 0123456789 ABCDEFGHIJKLMNOPQRSTUVWXYZ"; string(s) != want {
+		t.Errorf("ToUpper in place = %q, want %q", s, want)
+	}
+}
+
+func TestEqualFold(t *testing.T) {
+	inputs := testInputs()
+	for i, a := range inputs {
+		variants := [][]byte{
+			a,
+			bytes.ToUpper(a),
+			bytes.ToLower(a),
+			asciiCase(a, bytes.ToUpper),
+			inputs[(i+1)%len(inputs)],
+		}
+		if len(a) > 0 {
+			changed := bytes.Clone(a)
+			changed[len(a)/2] ^= 0x01
+			variants = append(variants, changed)
+		}
+		for _, b := range variants {
+			if got, want := EqualFold(a, b), bytes.EqualFold(a, b); got != want {
+				t.Errorf("EqualFold(%q, %q) = %v, want %v", a, b, got, want)
+			}
+			if len(a) == len(b) {
+				want := len(a)
+				for j := range a {
+					if a[j] >= 0x80 || lowerASCII(a[j]) != lowerASCII(b[j]) {
+						want = j
+						break
+					}
+				}
+				if got := baseFoldPrefix(a, b); got != want {
+					t.Errorf("base: foldPrefix(%q, %q) = %d, want %d", a, b, got, want)
+				}
+			}
+		}
+	}
+}
+
+func TestTrimSpace(t *testing.T) {
+	inputs := testInputs()
+	inputs = append(inputs,
+		[]byte("  \t hello world \n\r "),
+		[]byte("  unicode space  "),
+		[]byte(" \u0085x"),
+	)
+	for _, s := range inputs {
+		if got, want := TrimSpace(s), bytes.TrimSpace(s); !bytes.Equal(got, want) {
+			t.Errorf("TrimSpace(%q) = %q, want %q", s, got, want)
+		}
+		if got, want := baseSpacePrefix(s), spacePrefixScalar(s); got != want {
+			t.Errorf("base: spacePrefix(%q) = %d, want %d", s, got, want)
+		}
+		if got, want := baseSpaceSuffix(s), spaceSuffixScalar(s); got != want {
+			t.Errorf("base: spaceSuffix(%q) = %d, want %d", s, got, want)
+		}
+	}
+}
+
+func BenchmarkIndexByte(b *testing.B) {
+	s := bytes.Repeat([]byte("abcdefgh"), 1<<12)
+	s[len(s)-1] = 'z'
+	b.SetBytes(int64(len(s)))
+	for b.Loop() {
+		IndexByte(s, 'z')
+	}
+}
+
+func BenchmarkEqualFold(b *testing.B) {
+	s := bytes.Repeat([]byte("Hello, World! "), 1<<10)
+	u := bytes.ToUpper(s)
+	b.SetBytes(int64(len(s)))
+	for b.Loop() {
+		EqualFold(s, u)
+	}
+}
+
+func BenchmarkToLower(b *testing.B) {
+	s := bytes.Repeat([]byte("Hello, World! "), 1<<10)
+	dst := make([]byte, len(s))
+	b.SetBytes(int64(len(s)))
+	for b.Loop() {
+		ToLower(dst, s)
+	}
+}
@@ -0,0 +1,201 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strings
+
+import (
+	"unicode/utf16"
+	"unicode/utf8"
+	"unsafe"
+)
+
+// ValidUTF8 reports whether s is entirely valid UTF-8, as utf8.Valid.
+func ValidUTF8(s []byte) bool {
+	if len(s) < 4 {
+		return utf8.Valid(s)
+	}
+	// checkedUTF8 checks each byte from the fourth on against the three
+	// before it: check the characters holding the first three here.
+	for i := 0; i < 3; {
+		r, size := utf8.DecodeRune(s[i:])
+		if r == utf8.RuneError && size == 1 {
+			return false
+		}
+		i += size
+	}
+	i := checkedUTF8(s)
+	if i < 0 {
+		return false
+	}
+	// Finish from the start of the character holding byte i-1, which the
+	// bytes before it were checked to expect.
+	k := i - 1
+	for k > 0 && !utf8.RuneStart(s[k]) {
+		k--
+	}
+	return utf8.Valid(s[k:])
+}
+
+// UTF8ToUTF16 stores s converted to UTF-16 in dst, which must be at least
+// as long as s, and returns the number of code units stored. Invalid
+// UTF-8 becomes U+FFFD, one per byte, as when converting to []rune.
+func UTF8ToUTF16(dst []uint16, s []byte) int {
+	if len(dst) < len(s) {
+		panic("strings: dst shorter than s")
+	}
+	n := 0
+	for i := 0; i < len(s); {
+		if s[i] < utf8.RuneSelf {
+			k := leadingASCII(s[i:])
+			for j, c := range s[i : i+k] {
+				dst[n+j] = uint16(c)
+			}
+			i += k
+			n += k
+			continue
+		}
+		r, size := utf8.DecodeRune(s[i:])
+		i += size
+		if r >= 0x10000 {
+			r1, r2 := utf16.EncodeRune(r)
+			dst[n], dst[n+1] = uint16(r1), uint16(r2)
+			n += 2
+			continue
+		}
+		dst[n] = uint16(r)
+		n++
+	}
+	return n
+}
+
+// UTF16ToUTF8 stores s converted to UTF-8 in dst, which must be at least
+// three times as long as s, and returns the number of bytes stored.
+// Unpaired surrogates become U+FFFD, as with utf16.Decode.
+func UTF16ToUTF8(dst []byte, s []uint16) int {
+	if len(dst) < 3*len(s) {
+		panic("strings: dst shorter than 3*len(s)")
+	}
+	n := 0
+	for i := 0; i < len(s); {
+		if s[i] < utf8.RuneSelf {
+			k := leadingASCIIUnits(s[i:])
+			for j, c := range s[i : i+k] {
+				dst[n+j] = byte(c)
+			}
+			i += k
+			n += k
+			continue
+		}
+		r := rune(s[i])
+		i++
+		if utf16.IsSurrogate(r) {
+			r2 := utf8.RuneError
+			if i < len(s) {
+				r2 = rune(s[i])
+			}
+			if r = utf16.DecodeRune(r, r2); r != utf8.RuneError {
+				i++
+			}
+		}
+		n += utf8.EncodeRune(dst[n:], r)
+	}
+	return n
+}
+
+// Latin1ToUTF8 stores the Latin-1 (ISO 8859-1) text s converted to UTF-8
+// in dst, which must be at least twice as long as s, and returns the
+// number of bytes stored.
+func Latin1ToUTF8(dst, s []byte) int {
+	if len(dst) < 2*len(s) {
+		panic("strings: dst shorter than 2*len(s)")
+	}
+	n := 0
+	for i := 0; i < len(s); {
+		if c := s[i]; c >= utf8.RuneSelf {
+			dst[n], dst[n+1] = 0xc0|c>>6, 0x80|c&0x3f
+			i++
+			n += 2
+			continue
+		}
+		k := leadingASCII(s[i:])
+		copy(dst[n:], s[i:i+k])
+		i += k
+		n += k
+	}
+	return n
+}
+
+// UTF8ToLatin1 stores s converted to Latin-1 in dst, which must be at
+// least as long as s, and returns the number of bytes stored. It stops
+// with false at the first character of s that is invalid UTF-8 or above
+// U+00FF.
+func UTF8ToLatin1(dst, s []byte) (int, bool) {
+	if len(dst) < len(s) {
+		panic("strings: dst shorter than s")
+	}
+	n := 0
+	for i := 0; i < len(s); {
+		if s[i] < utf8.RuneSelf {
+			k := leadingASCII(s[i:])
+			copy(dst[n:], s[i:i+k])
+			i += k
+			n += k
+			continue
+		}
+		r, size := utf8.DecodeRune(s[i:])
+		if r > 0xff {
+			return n, false
+		}
+		dst[n] = byte(r)
+		i += size
+		n++
+	}
+	return n, true
+}
+
+// leadingASCIIUnits returns the number of leading code units of s below
+// 0x80, scanning pairs of them as aligned uint32 words.
+func leadingASCIIUnits(s []uint16) int {
+	i := 0
+	if len(s) > 0 && uintptr(unsafe.Pointer(&s[0]))%4 != 0 {
+		if s[0] >= utf8.RuneSelf {
+			return 0
+		}
+		i = 1
+	}
+	if words := (len(s) - i) / 2; words > 0 {
+		i += 2 * leadingASCIIPairs(unsafe.Slice((*uint32)(unsafe.Pointer(&s[i])), words))
+	}
+	for ; i < len(s) && s[i] < utf8.RuneSelf; i++ {
+	}
+	return i
+}
+
+func leadingASCIIScalar(s []byte) int {
+	for i, c := range s {
+		if c >= utf8.RuneSelf {
+			return i
+		}
+	}
+	return len(s)
+}
+
+func leadingASCIIPairsScalar(w []uint32) int {
+	for i, x := range w {
+		if x&0xff80ff80 != 0 {
+			return i
+		}
+	}
+	return len(w)
+}
@@ -0,0 +1,111 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strings
+
+//go:generate go run ../../../cmd/hwygen -input utf8_base.go -output . -targets avx2,avx512,neon,fallback -dispatch utf8
+
+import (
+	"math/bits"
+
+	"github.com/ajroetker/go-highway/hwy"
+)
+
+// baseCheckedUTF8 checks every byte of s from the fourth on against the
+// three before it, and returns -1 at the first that breaks a rule of
+// UTF-8. Otherwise it returns the index up to which it checked, leaving
+// the rest to the caller. s must be longer than three bytes.
+//
+// The rules are byte ranges: a byte is a continuation byte (0x80-0xBF)
+// exactly when one of the three before it leads a sequence long enough to
+// reach it, the leads 0xC0, 0xC1 and 0xF5-0xFF never occur, and the byte
+// after the leads 0xE0, 0xED, 0xF0 and 0xF4 is in the narrower range that
+// excludes overlong forms, surrogates and code points above U+10FFFF.
+func baseCheckedUTF8(s []byte) int {
+	n := len(s)
+	x7f := hwy.Set[uint8](0x7f)
+	x8f := hwy.Set[uint8](0x8f)
+	x90 := hwy.Set[uint8](0x90)
+	x9f := hwy.Set[uint8](0x9f)
+	xa0 := hwy.Set[uint8](0xa0)
+	xbf := hwy.Set[uint8](0xbf)
+	xc2 := hwy.Set[uint8](0xc2)
+	xdf := hwy.Set[uint8](0xdf)
+	xe0 := hwy.Set[uint8](0xe0)
+	xed := hwy.Set[uint8](0xed)
+	xef := hwy.Set[uint8](0xef)
+	xf0 := hwy.Set[uint8](0xf0)
+	xf4 := hwy.Set[uint8](0xf4)
+	lanes := hwy.Zero[uint8]().NumLanes()
+	prevHigh := uint64(0)
+	i := 3
+	//hwy:unroll 1
+	for ; i+lanes <= n; i += lanes {
+		v := hwy.Load(s[i:])
+		high := hwy.BitsFromMask(hwy.GreaterThan(v, x7f))
+		// ASCII after ASCII needs no other check.
+		if high|prevHigh != 0 {
+			p1 := hwy.Load(s[i-1:])
+			p2 := hwy.Load(s[i-2:])
+			p3 := hwy.Load(s[i-3:])
+			lead := hwy.BitsFromMask(hwy.GreaterThan(v, xbf))
+			want := hwy.BitsFromMask(hwy.GreaterThan(p1, xbf)) |
+				hwy.BitsFromMask(hwy.GreaterThan(p2, xdf)) |
+				hwy.BitsFromMask(hwy.GreaterThan(p3, xef))
+			bad := (high &^ lead) ^ want
+			bad |= lead&hwy.BitsFromMask(hwy.LessThan(v, xc2)) | hwy.BitsFromMask(hwy.GreaterThan(v, xf4))
+			bad |= hwy.BitsFromMask(hwy.Equal(p1, xe0)) & hwy.BitsFromMask(hwy.LessThan(v, xa0))
+			bad |= hwy.BitsFromMask(hwy.Equal(p1, xed)) & hwy.BitsFromMask(hwy.GreaterThan(v, x9f))
+			bad |= hwy.BitsFromMask(hwy.Equal(p1, xf0)) & hwy.BitsFromMask(hwy.LessThan(v, x90))
+			bad |= hwy.BitsFromMask(hwy.Equal(p1, xf4)) & hwy.BitsFromMask(hwy.GreaterThan(v, x8f))
+			if bad != 0 {
+				return -1
+			}
+		}
+		prevHigh = high
+	}
+	return i
+}
+
+// baseLeadingASCII returns the number of leading ASCII bytes of s.
+func baseLeadingASCII(s []byte) int {
+	n := len(s)
+	x7f := hwy.Set[uint8](0x7f)
+	lanes := hwy.Zero[uint8]().NumLanes()
+	i := 0
+	for ; i+lanes <= n; i += lanes {
+		if m := hwy.BitsFromMask(hwy.GreaterThan(hwy.Load(s[i:]), x7f)); m != 0 {
+			return i + bits.TrailingZeros64(m)
+		}
+	}
+	return i + leadingASCIIScalar(s[i:])
+}
+
+// baseLeadingASCIIPairs returns the number of leading words of w whose
+// two UTF-16 code units are both below 0x80.
+func baseLeadingASCIIPairs(w []uint32) int {
+	n := len(w)
+	high := hwy.Set[uint32](0xff80ff80)
+	zero := hwy.Zero[uint32]()
+	lanes := zero.NumLanes()
+	all := uint64(1)<<lanes - 1 // one bit per lane
+	i := 0
+	for ; i+lanes <= n; i += lanes {
+		ascii := hwy.Equal(hwy.And(hwy.Load(w[i:]), high), zero)
+		if m := ^hwy.BitsFromMask(ascii) & all; m != 0 {
+			return i + bits.TrailingZeros64(m)
+		}
+	}
+	return i + leadingASCIIPairsScalar(w[i:])
+}
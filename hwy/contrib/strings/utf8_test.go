@@ -0,0 +1,178 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strings
+
+import (
+	"bytes"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"testing"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// utf8Inputs returns UTF-8 strings of many lengths, from ASCII to mostly
+// multi-byte, some of them invalid.
+func utf8Inputs() [][]byte {
+	r := rand.New(rand.NewPCG(3, 4))
+	alphabets := []string{"abc 019", "abcé€", "é€𝄞中߿￿\U0010ffff", "aé\xff\xc3\xed\xa0\x80"}
+	var inputs [][]byte
+	for _, n := range []int{0, 1, 3, 4, 15, 16, 17, 19, 31, 32, 35, 63, 64, 67, 100, 1000} {
+		for _, alphabet := range alphabets {
+			runes := []rune(alphabet)
+			var b []byte
+			for len(b) < n {
+				b = fmt.Appendf(b, "%c", runes[r.IntN(len(runes))])
+			}
+			inputs = append(inputs, b)
+			if alphabet == alphabets[3] {
+				continue
+			}
+			// Corrupt a byte of valid input.
+			if len(b) > 0 {
+				c := slices.Clone(b)
+				c[r.IntN(len(c))] = byte(r.IntN(256))
+				inputs = append(inputs, c, c[:len(c)-1])
+			}
+		}
+	}
+	return inputs
+}
+
+func TestValidUTF8(t *testing.T) {
+	for _, s := range utf8Inputs() {
+		if got, want := ValidUTF8(s), utf8.Valid(s); got != want {
+			t.Errorf("ValidUTF8(%q) = %v, want %v", s, got, want)
+		}
+	}
+}
+
+func TestValidUTF8Sequences(t *testing.T) {
+	// Sequences at the edges of each rule, at every position relative to
+	// the vectors.
+	for _, seq := range []string{
+		"\x7f", "\x80", "\xbf", "\xc0\x80", "\xc1\xbf", "\xc2\x80", "\xdf\xbf", "\xc2", "\xc2\xc2\x80",
+		"\xe0\x9f\xbf", "\xe0\xa0\x80", "\xe1\x80\x80", "\xed\x9f\xbf", "\xed\xa0\x80", "\xef\xbf\xbf", "\xe2\x82",
+		"\xe2\x82\xac\xac", "\xf0\x8f\xbf\xbf", "\xf0\x90\x80\x80", "\xf4\x8f\xbf\xbf", "\xf4\x90\x80\x80",
+		"\xf3\xbf\xbf", "\xf5\x80\x80\x80", "\xff", "\xef\xbf\xbd",
+	} {
+		for before := range 70 {
+			for _, after := range []int{0, 1, 2, 3, 40} {
+				s := slices.Concat(bytes.Repeat([]byte{'a'}, before), []byte(seq), bytes.Repeat([]byte{'b'}, after))
+				if got, want := ValidUTF8(s), utf8.Valid(s); got != want {
+					t.Fatalf("ValidUTF8(%q) = %v, want %v", s, got, want)
+				}
+			}
+		}
+	}
+}
+
+func TestUTF8ToUTF16(t *testing.T) {
+	for _, s := range utf8Inputs() {
+		dst := make([]uint16, len(s))
+		n := UTF8ToUTF16(dst, s)
+		if want := utf16.Encode([]rune(string(s))); !slices.Equal(dst[:n], want) {
+			t.Errorf("UTF8ToUTF16(%q) = %x, want %x", s, dst[:n], want)
+		}
+	}
+}
+
+func TestUTF16ToUTF8(t *testing.T) {
+	for _, s := range utf8Inputs() {
+		u := utf16.Encode([]rune(string(s)))
+		// Unpaired surrogates, and every alignment.
+		u = append(u, 0xd800, 'a', 0xdc00)
+		for _, u := range [][]uint16{u, u[1:], u[:len(u)/2]} {
+			dst := make([]byte, 3*len(u))
+			n := UTF16ToUTF8(dst, u)
+			if got, want := string(dst[:n]), string(utf16.Decode(u)); got != want {
+				t.Errorf("UTF16ToUTF8(%x) = %q, want %q", u, got, want)
+			}
+		}
+	}
+}
+
+func TestLatin1(t *testing.T) {
+	r := rand.New(rand.NewPCG(5, 6))
+	for _, n := range []int{0, 1, 15, 16, 17, 33, 100, 1000} {
+		for _, maxByte := range []int{0x80, 0x100} {
+			s := make([]byte, n)
+			for i := range s {
+				s[i] = byte(r.IntN(maxByte))
+			}
+			runes := make([]rune, n)
+			for i, c := range s {
+				runes[i] = rune(c)
+			}
+			want := string(runes)
+
+			dst := make([]byte, 2*n)
+			m := Latin1ToUTF8(dst, s)
+			if got := string(dst[:m]); got != want {
+				t.Fatalf("Latin1ToUTF8(%q) = %q, want %q", s, got, want)
+			}
+			back := make([]byte, m)
+			k, ok := UTF8ToLatin1(back, dst[:m])
+			if !ok || !bytes.Equal(back[:k], s) {
+				t.Fatalf("UTF8ToLatin1(%q) = %q, %v, want %q", dst[:m], back[:k], ok, s)
+			}
+		}
+	}
+	for _, tc := range []struct {
+		s    string
+		want string
+	}{
+		{"abc€d", "abc"},
+		{"aé\xffb", "a\xe9"},
+		{"aé\xc3", "a\xe9"},
+	} {
+		dst := make([]byte, len(tc.s))
+		if n, ok := UTF8ToLatin1(dst, []byte(tc.s)); ok || string(dst[:n]) != tc.want {
+			t.Errorf("UTF8ToLatin1(%q) = %q, %v, want %q, false", tc.s, dst[:n], ok, tc.want)
+		}
+	}
+}
+
+func TestUTF8BaseKernels(t *testing.T) {
+	// Run the tests above on the hwy.Vec kernels, which dispatch replaces
+	// by SIMD or scalar code.
+	checked, leading, pairs := checkedUTF8, leadingASCII, leadingASCIIPairs
+	checkedUTF8, leadingASCII, leadingASCIIPairs = baseCheckedUTF8, baseLeadingASCII, baseLeadingASCIIPairs
+	defer func() { checkedUTF8, leadingASCII, leadingASCIIPairs = checked, leading, pairs }()
+	t.Run("ValidUTF8", TestValidUTF8)
+	t.Run("ValidUTF8Sequences", TestValidUTF8Sequences)
+	t.Run("UTF8ToUTF16", TestUTF8ToUTF16)
+	t.Run("UTF16ToUTF8", TestUTF16ToUTF8)
+	t.Run("Latin1", TestLatin1)
+}
+
+func BenchmarkValidUTF8(b *testing.B) {
+	s := bytes.Repeat([]byte("The quick brown fox jumps over the lazy dog, naïve café 中文. "), 1000)
+	b.SetBytes(int64(len(s)))
+	for _, impl := range []struct {
+		name  string
+		valid func([]byte) bool
+	}{
+		{"hwy", ValidUTF8},
+		{"utf8", utf8.Valid},
+	} {
+		b.Run(impl.name, func(b *testing.B) {
+			for b.Loop() {
+				impl.valid(s)
+			}
+		})
+	}
+}
@@ -0,0 +1,45 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package strings
+
+import (
+	"bytes"
+
+	"github.com/ajroetker/go-highway/hwy"
+)
+
+// The hwy.Vec fallbacks of the strings kernels allocate on every
+// operation: hwygen cannot scalarize BitsFromMask and IfThenElse. Where
+// dispatch bound them, bind the standard library, whose IndexByte and
+// Count are assembly, and plain scalar loops instead. The file name sorts
+// after the dispatch files, so this init runs last.
+func init() {
+	if hwy.KernelImplementation("strings.IndexByte") != hwy.BoundImplementation(BaseIndexByte_fallback) {
+		return
+	}
+	IndexByte = bytes.IndexByte
+	Count = func(s []byte, c byte) int { return bytes.Count(s, []byte{c}) }
+	indexAnyByte = indexAnyByteScalar
+	ToUpper = func(dst, s []byte) { toUpperScalar(dst[:len(s)], s) }
+	ToLower = func(dst, s []byte) { toLowerScalar(dst[:len(s)], s) }
+	foldPrefix = foldPrefixScalar
+	spacePrefix = spacePrefixScalar
+	spaceSuffix = spaceSuffixScalar
+	// Leave all of ValidUTF8 but the first three bytes to utf8.Valid.
+	checkedUTF8 = func([]byte) int { return 3 }
+	leadingASCII = leadingASCIIScalar
+	leadingASCIIPairs = leadingASCIIPairsScalar
+	myersBatch = myersBatchScalar
+}
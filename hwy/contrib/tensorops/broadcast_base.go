@@ -0,0 +1,215 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tensorops
+
+//go:generate go run ../../../cmd/hwygen -input broadcast_base.go -output . -targets avx2,avx512,neon,fallback -dispatch broadcast
+
+import "github.com/ajroetker/go-highway/hwy"
+
+// BaseAddRow stores in dst the matrix a, of shape [rows, cols] in
+// row-major order, with the row vector v of length cols adding
+// each of its rows: dst[i,j] = a[i,j] + v[j]. dst may be a.
+func BaseAddRow[T hwy.FloatsNative](dst, a, v []T, rows, cols int) {
+	checkRow(dst, a, v, rows, cols)
+	lanes := hwy.MaxLanes[T]()
+	for i := range rows {
+		off := i * cols
+		j := 0
+		for ; j+lanes <= cols; j += lanes {
+			hwy.Store(hwy.Add(hwy.Load(a[off+j:]), hwy.Load(v[j:])), dst[off+j:])
+		}
+		for ; j < cols; j++ {
+			dst[off+j] = a[off+j] + v[j]
+		}
+	}
+}
+
+// BaseAddCol stores in dst the matrix a, of shape [rows, cols] in
+// row-major order, with the column vector v of length rows adding
+// each of its columns: dst[i,j] = a[i,j] + v[i]. dst may be a.
+func BaseAddCol[T hwy.FloatsNative](dst, a, v []T, rows, cols int) {
+	checkCol(dst, a, v, rows, cols)
+	lanes := hwy.MaxLanes[T]()
+	for i := range rows {
+		off := i * cols
+		s := v[i]
+		vs := hwy.Set(s)
+		j := 0
+		for ; j+lanes <= cols; j += lanes {
+			hwy.Store(hwy.Add(hwy.Load(a[off+j:]), vs), dst[off+j:])
+		}
+		for ; j < cols; j++ {
+			dst[off+j] = a[off+j] + s
+		}
+	}
+}
+
+// BaseSubRow stores in dst the matrix a, of shape [rows, cols] in
+// row-major order, with the row vector v of length cols subtracting
+// each of its rows: dst[i,j] = a[i,j] - v[j]. dst may be a.
+func BaseSubRow[T hwy.FloatsNative](dst, a, v []T, rows, cols int) {
+	checkRow(dst, a, v, rows, cols)
+	lanes := hwy.MaxLanes[T]()
+	for i := range rows {
+		off := i * cols
+		j := 0
+		for ; j+lanes <= cols; j += lanes {
+			hwy.Store(hwy.Sub(hwy.Load(a[off+j:]), hwy.Load(v[j:])), dst[off+j:])
+		}
+		for ; j < cols; j++ {
+			dst[off+j] = a[off+j] - v[j]
+		}
+	}
+}
+
+// BaseSubCol stores in dst the matrix a, of shape [rows, cols] in
+// row-major order, with the column vector v of length rows subtracting
+// each of its columns: dst[i,j] = a[i,j] - v[i]. dst may be a.
+func BaseSubCol[T hwy.FloatsNative](dst, a, v []T, rows, cols int) {
+	checkCol(dst, a, v, rows, cols)
+	lanes := hwy.MaxLanes[T]()
+	for i := range rows {
+		off := i * cols
+		s := v[i]
+		vs := hwy.Set(s)
+		j := 0
+		for ; j+lanes <= cols; j += lanes {
+			hwy.Store(hwy.Sub(hwy.Load(a[off+j:]), vs), dst[off+j:])
+		}
+		for ; j < cols; j++ {
+			dst[off+j] = a[off+j] - s
+		}
+	}
+}
+
+// BaseMulRow stores in dst the matrix a, of shape [rows, cols] in
+// row-major order, with the row vector v of length cols multiplying by
+// each of its rows: dst[i,j] = a[i,j] * v[j]. dst may be a.
+func BaseMulRow[T hwy.FloatsNative](dst, a, v []T, rows, cols int) {
+	checkRow(dst, a, v, rows, cols)
+	lanes := hwy.MaxLanes[T]()
+	for i := range rows {
+		off := i * cols
+		j := 0
+		for ; j+lanes <= cols; j += lanes {
+			hwy.Store(hwy.Mul(hwy.Load(a[off+j:]), hwy.Load(v[j:])), dst[off+j:])
+		}
+		for ; j < cols; j++ {
+			dst[off+j] = a[off+j] * v[j]
+		}
+	}
+}
+
+// BaseMulCol stores in dst the matrix a, of shape [rows, cols] in
+// row-major order, with the column vector v of length rows multiplying by
+// each of its columns: dst[i,j] = a[i,j] * v[i]. dst may be a.
+func BaseMulCol[T hwy.FloatsNative](dst, a, v []T, rows, cols int) {
+	checkCol(dst, a, v, rows, cols)
+	lanes := hwy.MaxLanes[T]()
+	for i := range rows {
+		off := i * cols
+		s := v[i]
+		vs := hwy.Set(s)
+		j := 0
+		for ; j+lanes <= cols; j += lanes {
+			hwy.Store(hwy.Mul(hwy.Load(a[off+j:]), vs), dst[off+j:])
+		}
+		for ; j < cols; j++ {
+			dst[off+j] = a[off+j] * s
+		}
+	}
+}
+
+// BaseDivRow stores in dst the matrix a, of shape [rows, cols] in
+// row-major order, with the row vector v of length cols dividing by
+// each of its rows: dst[i,j] = a[i,j] / v[j]. dst may be a.
+func BaseDivRow[T hwy.FloatsNative](dst, a, v []T, rows, cols int) {
+	checkRow(dst, a, v, rows, cols)
+	lanes := hwy.MaxLanes[T]()
+	for i := range rows {
+		off := i * cols
+		j := 0
+		for ; j+lanes <= cols; j += lanes {
+			hwy.Store(hwy.Div(hwy.Load(a[off+j:]), hwy.Load(v[j:])), dst[off+j:])
+		}
+		for ; j < cols; j++ {
+			dst[off+j] = a[off+j] / v[j]
+		}
+	}
+}
+
+// BaseDivCol stores in dst the matrix a, of shape [rows, cols] in
+// row-major order, with the column vector v of length rows dividing by
+// each of its columns: dst[i,j] = a[i,j] / v[i]. dst may be a.
+func BaseDivCol[T hwy.FloatsNative](dst, a, v []T, rows, cols int) {
+	checkCol(dst, a, v, rows, cols)
+	lanes := hwy.MaxLanes[T]()
+	for i := range rows {
+		off := i * cols
+		s := v[i]
+		vs := hwy.Set(s)
+		j := 0
+		for ; j+lanes <= cols; j += lanes {
+			hwy.Store(hwy.Div(hwy.Load(a[off+j:]), vs), dst[off+j:])
+		}
+		for ; j < cols; j++ {
+			dst[off+j] = a[off+j] / s
+		}
+	}
+}
+
+// BaseMulAddRow stores in dst the matrix a, of shape [rows, cols] in
+// row-major order, scaled and shifted per column by the row vectors scale
+// and shift of length cols: dst[i,j] = a[i,j]*scale[j] + shift[j], with
+// fused multiply-adds. It is the affine step of a normalization layer, or
+// a dequantization, in one pass. dst may be a.
+func BaseMulAddRow[T hwy.FloatsNative](dst, a, scale, shift []T, rows, cols int) {
+	checkRow(dst, a, scale, rows, cols)
+	checkRow(dst, a, shift, rows, cols)
+	lanes := hwy.MaxLanes[T]()
+	for i := range rows {
+		off := i * cols
+		j := 0
+		for ; j+lanes <= cols; j += lanes {
+			hwy.Store(hwy.MulAdd(hwy.Load(a[off+j:]), hwy.Load(scale[j:]), hwy.Load(shift[j:])), dst[off+j:])
+		}
+		for ; j < cols; j++ {
+			dst[off+j] = a[off+j]*scale[j] + shift[j]
+		}
+	}
+}
+
+// BaseMulAddCol stores in dst the matrix a, of shape [rows, cols] in
+// row-major order, scaled and shifted per row by the column vectors scale
+// and shift of length rows: dst[i,j] = a[i,j]*scale[i] + shift[i], with
+// fused multiply-adds. It standardizes the rows of a given their means
+// and deviations, as scale = 1/std and shift = -mean/std. dst may be a.
+func BaseMulAddCol[T hwy.FloatsNative](dst, a, scale, shift []T, rows, cols int) {
+	checkCol(dst, a, scale, rows, cols)
+	checkCol(dst, a, shift, rows, cols)
+	lanes := hwy.MaxLanes[T]()
+	for i := range rows {
+		off := i * cols
+		s, b := scale[i], shift[i]
+		vs, vb := hwy.Set(s), hwy.Set(b)
+		j := 0
+		for ; j+lanes <= cols; j += lanes {
+			hwy.Store(hwy.MulAdd(hwy.Load(a[off+j:]), vs, vb), dst[off+j:])
+		}
+		for ; j < cols; j++ {
+			dst[off+j] = a[off+j]*s + b
+		}
+	}
+}
@@ -0,0 +1,126 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tensorops
+
+import (
+	"math/rand/v2"
+	"slices"
+	"testing"
+
+	"github.com/ajroetker/go-highway/hwy"
+)
+
+func testBroadcast[T hwy.FloatsNative](t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	ops := []struct {
+		name     string
+		row, col func(dst, a, v []T, rows, cols int)
+		op       func(x, y T) T
+	}{
+		{"Add", AddRow[T], AddCol[T], func(x, y T) T { return x + y }},
+		{"Sub", SubRow[T], SubCol[T], func(x, y T) T { return x - y }},
+		{"Mul", MulRow[T], MulCol[T], func(x, y T) T { return x * y }},
+		{"Div", DivRow[T], DivCol[T], func(x, y T) T { return x / y }},
+	}
+	for _, dims := range [][2]int{{1, 1}, {3, 7}, {4, 16}, {5, 33}, {17, 64}} {
+		rows, cols := dims[0], dims[1]
+		a := make([]T, rows*cols)
+		for i := range a {
+			a[i] = T(r.NormFloat64())
+		}
+		rowV := make([]T, cols)
+		for i := range rowV {
+			rowV[i] = T(1 + r.Float64())
+		}
+		colV := make([]T, rows)
+		for i := range colV {
+			colV[i] = T(1 + r.Float64())
+		}
+		for _, o := range ops {
+			got := make([]T, len(a))
+			o.row(got, a, rowV, rows, cols)
+			inPlace := slices.Clone(a)
+			o.row(inPlace, inPlace, rowV, rows, cols)
+			for i := range rows {
+				for j := range cols {
+					if want := o.op(a[i*cols+j], rowV[j]); !near(got[i*cols+j], want) {
+						t.Fatalf("%dx%d: %sRow[%d,%d] = %g, want %g", rows, cols, o.name, i, j, got[i*cols+j], want)
+					}
+				}
+			}
+			if !slices.Equal(inPlace, got) {
+				t.Errorf("%dx%d: %sRow in place differs", rows, cols, o.name)
+			}
+			o.col(got, a, colV, rows, cols)
+			for i := range rows {
+				for j := range cols {
+					if want := o.op(a[i*cols+j], colV[i]); !near(got[i*cols+j], want) {
+						t.Fatalf("%dx%d: %sCol[%d,%d] = %g, want %g", rows, cols, o.name, i, j, got[i*cols+j], want)
+					}
+				}
+			}
+		}
+		got := make([]T, len(a))
+		shift := make([]T, cols)
+		for i := range shift {
+			shift[i] = T(r.NormFloat64())
+		}
+		MulAddRow(got, a, rowV, shift, rows, cols)
+		for i := range rows {
+			for j := range cols {
+				if want := a[i*cols+j]*rowV[j] + shift[j]; !near(got[i*cols+j], want) {
+					t.Fatalf("%dx%d: MulAddRow[%d,%d] = %g, want %g", rows, cols, i, j, got[i*cols+j], want)
+				}
+			}
+		}
+		MulAddCol(got, a, colV, colV, rows, cols)
+		for i := range rows {
+			for j := range cols {
+				if want := a[i*cols+j]*colV[i] + colV[i]; !near(got[i*cols+j], want) {
+					t.Fatalf("%dx%d: MulAddCol[%d,%d] = %g, want %g", rows, cols, i, j, got[i*cols+j], want)
+				}
+			}
+		}
+	}
+}
+
+// near allows for the rounding of a fused multiply-add, or of a division
+// done as a multiply by a reciprocal.
+func near[T hwy.FloatsNative](got, want T) bool {
+	d := got - want
+	return d <= 1e-6*max(1, want, -want) && -d <= 1e-6*max(1, want, -want)
+}
+
+func TestBroadcast(t *testing.T) {
+	t.Run("float32", testBroadcast[float32])
+	t.Run("float64", testBroadcast[float64])
+}
+
+func TestBroadcastPanics(t *testing.T) {
+	for name, f := range map[string]func(){
+		"short row":    func() { AddRow(make([]float32, 6), make([]float32, 6), make([]float32, 2), 2, 3) },
+		"short col":    func() { MulCol(make([]float32, 6), make([]float32, 6), make([]float32, 1), 2, 3) },
+		"short matrix": func() { SubRow(make([]float32, 5), make([]float32, 6), make([]float32, 3), 2, 3) },
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s: expected panic", name)
+				}
+			}()
+			f()
+		}()
+	}
+}
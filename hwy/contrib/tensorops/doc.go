@@ -0,0 +1,45 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package tensorops provides elementwise operations between a matrix and
+// a vector broadcast over its rows or its columns, in one pass, for the
+// bias additions, scalings and normalizations that matmul epilogues do
+// not cover, reductions of the rows of a matrix by segment, and one-hot
+// encoding and decoding.
+//
+// Matrices are row-major slices of shape [rows, cols]. The Row functions
+// broadcast a row vector of length cols to every row, the Col functions a
+// column vector of length rows to every column:
+//
+//	tensorops.AddRow(out, out, bias, batch, features)      // out[i,j] += bias[j]
+//	tensorops.DivCol(probs, scores, sums, batch, classes)  // probs[i,j] = scores[i,j] / sums[i]
+//	tensorops.MulAddRow(y, x, gamma, beta, batch, features) // y[i,j] = x[i,j]*gamma[j] + beta[j]
+//
+// A Row operation combines each vector of a row with the matching vector
+// of the broadcast row; a Col operation combines a row with its element of
+// the column, set in every lane. The destination may be the source matrix.
+//
+// SegmentSum, SegmentMean and SegmentMax reduce consecutive runs of rows,
+// given by offsets, to one row each, for pooling the tokens of a ragged
+// batch or aggregating the messages to the nodes of a graph:
+//
+//	// Rows offsets[s] to offsets[s+1]-1 of tokens pool into row s of pooled.
+//	tensorops.SegmentMean(tokens, offsets, hidden, pooled)
+//
+// They accumulate a vector of columns down the rows of a segment in a
+// register, or, for a single column, a vector of rows along the segment.
+//
+// OneHot encodes class ids as rows of a matrix, and ArgmaxRows decodes
+// one-hot rows, or scores, back to the ids of their largest columns.
+package tensorops
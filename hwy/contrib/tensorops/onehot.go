@@ -0,0 +1,63 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tensorops
+
+import (
+	"github.com/ajroetker/go-highway/hwy"
+	"github.com/ajroetker/go-highway/hwy/contrib/vec"
+)
+
+// OneHot stores in out, a row-major matrix of shape [len(ids), numClasses],
+// the one-hot encoding of ids: row i is 1 in column ids[i] and 0 elsewhere.
+// An id outside [0, numClasses) gives a row of zeros. It panics if out is
+// too short.
+//
+// The rows are cleared with the runtime's vector stores and the ones
+// written one per row, which beats comparing every column with the id.
+func OneHot[T hwy.FloatsNative](ids []int32, numClasses int, out []T) {
+	if numClasses < 0 {
+		panic("tensorops: negative number of classes")
+	}
+	n := len(ids) * numClasses
+	if len(out) < n {
+		panic("tensorops: out is too short for the one-hot rows")
+	}
+	clear(out[:n])
+	for i, id := range ids {
+		if id >= 0 && int(id) < numClasses {
+			out[i*numClasses+int(id)] = 1
+		}
+	}
+}
+
+// ArgmaxRows stores in ids[i] the column of the largest value of row i of
+// m, a row-major matrix of shape [rows, cols] with cols >= 1: it decodes
+// one-hot rows, or scores and probabilities, back to class ids. Ties go to
+// the first column, and NaN is smaller than any number, as in vec.Argmax,
+// which searches each row. It panics if m or ids are too short.
+func ArgmaxRows[T hwy.Floats](m []T, rows, cols int, ids []int32) {
+	if rows < 0 || cols <= 0 {
+		panic("tensorops: argmax of an empty row")
+	}
+	if len(m) < rows*cols {
+		panic("tensorops: matrix slice too small")
+	}
+	if len(ids) < rows {
+		panic("tensorops: ids is too short for the rows")
+	}
+	for i := range rows {
+		ids[i] = int32(vec.Argmax(m[i*cols : (i+1)*cols]))
+	}
+}
@@ -0,0 +1,63 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tensorops
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestOneHot(t *testing.T) {
+	ids := []int32{2, 0, -1, 3, 1}
+	out := make([]float32, len(ids)*3)
+	for i := range out {
+		out[i] = 7
+	}
+	OneHot(ids, 3, out)
+	want := []float32{
+		0, 0, 1,
+		1, 0, 0,
+		0, 0, 0,
+		0, 0, 0,
+		0, 1, 0,
+	}
+	if !slices.Equal(out, want) {
+		t.Errorf("OneHot = %v, want %v", out, want)
+	}
+}
+
+func TestArgmaxRows(t *testing.T) {
+	// Decoding one-hot rows gives back the ids, for widths on both sides of
+	// a vector.
+	for _, classes := range []int{1, 3, 16, 37} {
+		ids := make([]int32, 50)
+		for i := range ids {
+			ids[i] = int32(i * 7 % classes)
+		}
+		m := make([]float64, len(ids)*classes)
+		OneHot(ids, classes, m)
+		got := make([]int32, len(ids))
+		ArgmaxRows(m, len(ids), classes, got)
+		if !slices.Equal(got, ids) {
+			t.Errorf("%d classes: ArgmaxRows of one-hot rows = %v, want %v", classes, got, ids)
+		}
+	}
+	m := []float32{0.1, 0.7, 0.7, -2, -1, -3}
+	got := make([]int32, 2)
+	ArgmaxRows(m, 2, 3, got)
+	if !slices.Equal(got, []int32{1, 1}) {
+		t.Errorf("ArgmaxRows = %v, want [1 1]", got)
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tensorops
+
+import "github.com/ajroetker/go-highway/hwy"
+
+// The segment functions reduce the rows of values, a row-major matrix with
+// dims columns, by segment: segment s is the rows offsets[s] to
+// offsets[s+1]-1, and its reduction goes to row s of out, which must hold
+// (len(offsets)-1)*dims elements. Segments are consecutive runs of rows,
+// such as the tokens of each sequence of a ragged batch, or the messages
+// to each node of a graph sorted by destination. An empty segment reduces
+// to zeros. They panic if offsets decrease or reach past the rows of
+// values.
+
+// SegmentSum stores in out the sums of the rows of each segment of values.
+func SegmentSum[T hwy.FloatsNative](values []T, offsets []int, dims int, out []T) {
+	checkSegments(values, offsets, dims, out)
+	segmentSum(out, values, offsets, dims, false)
+}
+
+// SegmentMean stores in out the means of the rows of each segment of
+// values.
+func SegmentMean[T hwy.FloatsNative](values []T, offsets []int, dims int, out []T) {
+	checkSegments(values, offsets, dims, out)
+	segmentSum(out, values, offsets, dims, true)
+}
+
+// SegmentMax stores in out the elementwise maximums of the rows of each
+// segment of values.
+func SegmentMax[T hwy.FloatsNative](values []T, offsets []int, dims int, out []T) {
+	checkSegments(values, offsets, dims, out)
+	segmentMax(out, values, offsets, dims)
+}
+
+func checkSegments[T any](values []T, offsets []int, dims int, out []T) {
+	if dims <= 0 {
+		panic("tensorops: segment dims must be positive")
+	}
+	if len(offsets) == 0 {
+		return
+	}
+	if offsets[0] < 0 {
+		panic("tensorops: negative segment offset")
+	}
+	for s := 1; s < len(offsets); s++ {
+		if offsets[s] < offsets[s-1] {
+			panic("tensorops: segment offsets decrease")
+		}
+	}
+	if offsets[len(offsets)-1] > len(values)/dims {
+		panic("tensorops: segment offsets past the rows of values")
+	}
+	if len(out) < (len(offsets)-1)*dims {
+		panic("tensorops: out is too short for the segments")
+	}
+}
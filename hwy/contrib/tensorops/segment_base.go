@@ -0,0 +1,116 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tensorops
+
+//go:generate go run ../../../cmd/hwygen -input segment_base.go -output . -targets avx2,avx512,neon,fallback -dispatch segment
+
+import "github.com/ajroetker/go-highway/hwy"
+
+// baseSegmentSum stores in row s of out the sum, or with mean the mean, of
+// the rows offsets[s] to offsets[s+1]-1 of values, a row-major matrix with
+// dims columns. An empty segment gets zeros.
+//
+// Each vector of columns is summed down the rows of a segment in a
+// register. Rows of a single value are summed along the segment instead.
+func baseSegmentSum[T hwy.FloatsNative](out, values []T, offsets []int, dims int, mean bool) {
+	segments := len(offsets) - 1
+	lanes := hwy.MaxLanes[T]()
+	for s := 0; s < segments; s++ {
+		start, end := offsets[s], offsets[s+1]
+		scale := T(1)
+		if mean && end > start {
+			scale = 1 / T(end-start)
+		}
+		vScale := hwy.Set(scale)
+		if dims == 1 {
+			acc := hwy.Zero[T]()
+			i := start
+			for ; i+lanes <= end; i += lanes {
+				acc = hwy.Add(acc, hwy.Load(values[i:]))
+			}
+			sum := hwy.ReduceSum(acc)
+			for ; i < end; i++ {
+				sum += values[i]
+			}
+			out[s] = sum * scale
+			continue
+		}
+		j := 0
+		for ; j+lanes <= dims; j += lanes {
+			acc := hwy.Zero[T]()
+			for r := start; r < end; r++ {
+				acc = hwy.Add(acc, hwy.Load(values[r*dims+j:]))
+			}
+			hwy.Store(hwy.Mul(acc, vScale), out[s*dims+j:])
+		}
+		for ; j < dims; j++ {
+			sum := T(0)
+			for r := start; r < end; r++ {
+				sum += values[r*dims+j]
+			}
+			out[s*dims+j] = sum * scale
+		}
+	}
+}
+
+// baseSegmentMax stores in row s of out the elementwise maximum of the
+// rows offsets[s] to offsets[s+1]-1 of values, a row-major matrix with
+// dims columns. An empty segment gets zeros. Like baseSegmentSum, it
+// reduces vectors of columns down the rows, or a single column along the
+// segment.
+func baseSegmentMax[T hwy.FloatsNative](out, values []T, offsets []int, dims int) {
+	segments := len(offsets) - 1
+	lanes := hwy.MaxLanes[T]()
+	for s := 0; s < segments; s++ {
+		start, end := offsets[s], offsets[s+1]
+		if end == start {
+			for j := 0; j < dims; j++ {
+				out[s*dims+j] = 0
+			}
+			continue
+		}
+		if dims == 1 {
+			m := values[start]
+			i := start
+			if end-start >= lanes {
+				acc := hwy.Load(values[start:])
+				for i = start + lanes; i+lanes <= end; i += lanes {
+					acc = hwy.Max(acc, hwy.Load(values[i:]))
+				}
+				m = hwy.ReduceMax(acc)
+			}
+			for ; i < end; i++ {
+				m = max(m, values[i])
+			}
+			out[s] = m
+			continue
+		}
+		j := 0
+		for ; j+lanes <= dims; j += lanes {
+			acc := hwy.Load(values[start*dims+j:])
+			for r := start + 1; r < end; r++ {
+				acc = hwy.Max(acc, hwy.Load(values[r*dims+j:]))
+			}
+			hwy.Store(acc, out[s*dims+j:])
+		}
+		for ; j < dims; j++ {
+			m := values[start*dims+j]
+			for r := start + 1; r < end; r++ {
+				m = max(m, values[r*dims+j])
+			}
+			out[s*dims+j] = m
+		}
+	}
+}
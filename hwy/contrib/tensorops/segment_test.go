@@ -0,0 +1,91 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tensorops
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/ajroetker/go-highway/hwy"
+)
+
+func testSegments[T hwy.FloatsNative](t *testing.T, tol float64) {
+	r := rand.New(rand.NewPCG(3, 4))
+	for _, dims := range []int{1, 3, 8, 17, 64} {
+		// Segments of 0 to 40 rows, including empty ones.
+		offsets := []int{0}
+		for range 12 {
+			offsets = append(offsets, offsets[len(offsets)-1]+r.IntN(41)*min(1, r.IntN(4)))
+		}
+		segments := len(offsets) - 1
+		values := make([]T, offsets[segments]*dims)
+		for i := range values {
+			values[i] = T(r.NormFloat64())
+		}
+		sum := make([]T, segments*dims)
+		mean := make([]T, segments*dims)
+		maxes := make([]T, segments*dims)
+		SegmentSum(values, offsets, dims, sum)
+		SegmentMean(values, offsets, dims, mean)
+		SegmentMax(values, offsets, dims, maxes)
+		for s := range segments {
+			n := offsets[s+1] - offsets[s]
+			for j := range dims {
+				wantSum, wantMax := 0.0, 0.0
+				for row := offsets[s]; row < offsets[s+1]; row++ {
+					v := float64(values[row*dims+j])
+					wantSum += v
+					if row == offsets[s] || v > wantMax {
+						wantMax = v
+					}
+				}
+				wantMean := 0.0
+				if n > 0 {
+					wantMean = wantSum / float64(n)
+				}
+				k := s*dims + j
+				if math.Abs(float64(sum[k])-wantSum) > tol || math.Abs(float64(mean[k])-wantMean) > tol || float64(maxes[k]) != wantMax {
+					t.Fatalf("dims=%d segment %d of %d rows, column %d: sum, mean, max = %g, %g, %g, want %g, %g, %g",
+						dims, s, n, j, sum[k], mean[k], maxes[k], wantSum, wantMean, wantMax)
+				}
+			}
+		}
+	}
+}
+
+func TestSegments(t *testing.T) {
+	t.Run("float32", func(t *testing.T) { testSegments[float32](t, 1e-5) })
+	t.Run("float64", func(t *testing.T) { testSegments[float64](t, 1e-12) })
+}
+
+func TestSegmentPanics(t *testing.T) {
+	values := make([]float32, 12)
+	out := make([]float32, 6)
+	for name, offsets := range map[string][]int{
+		"decreasing": {0, 2, 1},
+		"past end":   {0, 2, 5},
+		"negative":   {-1, 2},
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s offsets: expected panic", name)
+				}
+			}()
+			SegmentSum(values, offsets, 3, out)
+		}()
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tensorops
+
+// checkRow panics unless a and dst hold a [rows, cols] matrix and v a row
+// of it.
+func checkRow[T any](dst, a, v []T, rows, cols int) {
+	checkMatrix(dst, a, rows, cols)
+	if len(v) < cols {
+		panic("tensorops: row vector shorter than cols")
+	}
+}
+
+// checkCol panics unless a and dst hold a [rows, cols] matrix and v a
+// column of it.
+func checkCol[T any](dst, a, v []T, rows, cols int) {
+	checkMatrix(dst, a, rows, cols)
+	if len(v) < rows {
+		panic("tensorops: column vector shorter than rows")
+	}
+}
+
+func checkMatrix[T any](dst, a []T, rows, cols int) {
+	if rows < 0 || cols < 0 {
+		panic("tensorops: negative dimension")
+	}
+	if len(a) < rows*cols || len(dst) < rows*cols {
+		panic("tensorops: matrix slice too small")
+	}
+}
@@ -18,11 +18,11 @@ func init() {
 		initGroupvarintFallback()
 		return
 	}
-	if archsimd.X86.AVX512() {
+	if archsimd.X86.AVX512() && hwy.TargetEnabled(hwy.DispatchAVX512) {
 		initGroupvarintAVX512()
 		return
 	}
-	if archsimd.X86.AVX2() {
+	if archsimd.X86.AVX2() && hwy.TargetEnabled(hwy.DispatchAVX2) {
 		initGroupvarintAVX2()
 		return
 	}
@@ -43,3 +43,12 @@ func initGroupvarintFallback() {
 	DecodeGroupVarint32 = BaseDecodeGroupVarint32_fallback
 	DecodeGroupVarint64 = BaseDecodeGroupVarint64_fallback
 }
+
+func init() {
+	hwy.RegisterKernel("varint.DecodeGroupVarint32", &DecodeGroupVarint32)
+	hwy.RegisterKernel("varint.DecodeGroupVarint64", &DecodeGroupVarint64)
+	hwyKernels := []string{"varint.DecodeGroupVarint32", "varint.DecodeGroupVarint64"}
+	hwy.RegisterKernelTarget(hwy.DispatchAVX2, initGroupvarintAVX2, hwyKernels...)
+	hwy.RegisterKernelTarget(hwy.DispatchAVX512, initGroupvarintAVX512, hwyKernels...)
+	hwy.RegisterKernelTarget(hwy.DispatchScalar, initGroupvarintFallback, hwyKernels...)
+}
@@ -29,3 +29,11 @@ func initGroupvarintFallback() {
 	DecodeGroupVarint32 = BaseDecodeGroupVarint32_fallback
 	DecodeGroupVarint64 = BaseDecodeGroupVarint64_fallback
 }
+
+func init() {
+	hwy.RegisterKernel("varint.DecodeGroupVarint32", &DecodeGroupVarint32)
+	hwy.RegisterKernel("varint.DecodeGroupVarint64", &DecodeGroupVarint64)
+	hwyKernels := []string{"varint.DecodeGroupVarint32", "varint.DecodeGroupVarint64"}
+	hwy.RegisterKernelTarget(hwy.DispatchNEON, initGroupvarintNEON, hwyKernels...)
+	hwy.RegisterKernelTarget(hwy.DispatchScalar, initGroupvarintFallback, hwyKernels...)
+}
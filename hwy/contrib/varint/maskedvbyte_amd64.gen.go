@@ -21,11 +21,11 @@ func init() {
 		initMaskedvbyteFallback()
 		return
 	}
-	if archsimd.X86.AVX512() {
+	if archsimd.X86.AVX512() && hwy.TargetEnabled(hwy.DispatchAVX512) {
 		initMaskedvbyteAVX512()
 		return
 	}
-	if archsimd.X86.AVX2() {
+	if archsimd.X86.AVX2() && hwy.TargetEnabled(hwy.DispatchAVX2) {
 		initMaskedvbyteAVX2()
 		return
 	}
@@ -55,3 +55,15 @@ func initMaskedvbyteFallback() {
 	MaskedVByteDecodeBatch64 = BaseMaskedVByteDecodeBatch64_fallback
 	maskedVByteDecodeOne64 = baseMaskedVByteDecodeOne64_fallback
 }
+
+func init() {
+	hwy.RegisterKernel("varint.MaskedVByteDecodeBatch32", &MaskedVByteDecodeBatch32)
+	hwy.RegisterKernel("varint.MaskedVByteDecodeGroup", &MaskedVByteDecodeGroup)
+	hwy.RegisterKernel("varint.maskedVByteDecodeOne32", &maskedVByteDecodeOne32)
+	hwy.RegisterKernel("varint.MaskedVByteDecodeBatch64", &MaskedVByteDecodeBatch64)
+	hwy.RegisterKernel("varint.maskedVByteDecodeOne64", &maskedVByteDecodeOne64)
+	hwyKernels := []string{"varint.MaskedVByteDecodeBatch32", "varint.MaskedVByteDecodeGroup", "varint.maskedVByteDecodeOne32", "varint.MaskedVByteDecodeBatch64", "varint.maskedVByteDecodeOne64"}
+	hwy.RegisterKernelTarget(hwy.DispatchAVX2, initMaskedvbyteAVX2, hwyKernels...)
+	hwy.RegisterKernelTarget(hwy.DispatchAVX512, initMaskedvbyteAVX512, hwyKernels...)
+	hwy.RegisterKernelTarget(hwy.DispatchScalar, initMaskedvbyteFallback, hwyKernels...)
+}
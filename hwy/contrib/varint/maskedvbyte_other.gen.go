@@ -26,3 +26,13 @@ func initMaskedvbyteFallback() {
 	MaskedVByteDecodeBatch64 = BaseMaskedVByteDecodeBatch64_fallback
 	maskedVByteDecodeOne64 = baseMaskedVByteDecodeOne64_fallback
 }
+
+func init() {
+	hwy.RegisterKernel("varint.MaskedVByteDecodeBatch32", &MaskedVByteDecodeBatch32)
+	hwy.RegisterKernel("varint.MaskedVByteDecodeGroup", &MaskedVByteDecodeGroup)
+	hwy.RegisterKernel("varint.maskedVByteDecodeOne32", &maskedVByteDecodeOne32)
+	hwy.RegisterKernel("varint.MaskedVByteDecodeBatch64", &MaskedVByteDecodeBatch64)
+	hwy.RegisterKernel("varint.maskedVByteDecodeOne64", &maskedVByteDecodeOne64)
+	hwyKernels := []string{"varint.MaskedVByteDecodeBatch32", "varint.MaskedVByteDecodeGroup", "varint.maskedVByteDecodeOne32", "varint.MaskedVByteDecodeBatch64", "varint.maskedVByteDecodeOne64"}
+	hwy.RegisterKernelTarget(hwy.DispatchScalar, initMaskedvbyteFallback, hwyKernels...)
+}
@@ -23,11 +23,11 @@ func init() {
 		initStreamvbyteFallback()
 		return
 	}
-	if archsimd.X86.AVX512() {
+	if archsimd.X86.AVX512() && hwy.TargetEnabled(hwy.DispatchAVX512) {
 		initStreamvbyteAVX512()
 		return
 	}
-	if archsimd.X86.AVX2() {
+	if archsimd.X86.AVX2() && hwy.TargetEnabled(hwy.DispatchAVX2) {
 		initStreamvbyteAVX2()
 		return
 	}
@@ -63,3 +63,17 @@ func initStreamvbyteFallback() {
 	EncodeStreamVByte32GroupSIMD = BaseEncodeStreamVByte32GroupSIMD_fallback
 	EncodeStreamVByte32GroupSIMDInto = BaseEncodeStreamVByte32GroupSIMDInto_fallback
 }
+
+func init() {
+	hwy.RegisterKernel("varint.DecodeStreamVByte32", &DecodeStreamVByte32)
+	hwy.RegisterKernel("varint.DecodeStreamVByte32Into", &DecodeStreamVByte32Into)
+	hwy.RegisterKernel("varint.DecodeStreamVByte32GroupSIMD", &DecodeStreamVByte32GroupSIMD)
+	hwy.RegisterKernel("varint.EncodeStreamVByte32", &EncodeStreamVByte32)
+	hwy.RegisterKernel("varint.EncodeStreamVByte32Into", &EncodeStreamVByte32Into)
+	hwy.RegisterKernel("varint.EncodeStreamVByte32GroupSIMD", &EncodeStreamVByte32GroupSIMD)
+	hwy.RegisterKernel("varint.EncodeStreamVByte32GroupSIMDInto", &EncodeStreamVByte32GroupSIMDInto)
+	hwyKernels := []string{"varint.DecodeStreamVByte32", "varint.DecodeStreamVByte32Into", "varint.DecodeStreamVByte32GroupSIMD", "varint.EncodeStreamVByte32", "varint.EncodeStreamVByte32Into", "varint.EncodeStreamVByte32GroupSIMD", "varint.EncodeStreamVByte32GroupSIMDInto"}
+	hwy.RegisterKernelTarget(hwy.DispatchAVX2, initStreamvbyteAVX2, hwyKernels...)
+	hwy.RegisterKernelTarget(hwy.DispatchAVX512, initStreamvbyteAVX512, hwyKernels...)
+	hwy.RegisterKernelTarget(hwy.DispatchScalar, initStreamvbyteFallback, hwyKernels...)
+}
@@ -44,3 +44,16 @@ func initStreamvbyteFallback() {
 	EncodeStreamVByte32GroupSIMD = BaseEncodeStreamVByte32GroupSIMD_fallback
 	EncodeStreamVByte32GroupSIMDInto = BaseEncodeStreamVByte32GroupSIMDInto_fallback
 }
+
+func init() {
+	hwy.RegisterKernel("varint.DecodeStreamVByte32", &DecodeStreamVByte32)
+	hwy.RegisterKernel("varint.DecodeStreamVByte32Into", &DecodeStreamVByte32Into)
+	hwy.RegisterKernel("varint.DecodeStreamVByte32GroupSIMD", &DecodeStreamVByte32GroupSIMD)
+	hwy.RegisterKernel("varint.EncodeStreamVByte32", &EncodeStreamVByte32)
+	hwy.RegisterKernel("varint.EncodeStreamVByte32Into", &EncodeStreamVByte32Into)
+	hwy.RegisterKernel("varint.EncodeStreamVByte32GroupSIMD", &EncodeStreamVByte32GroupSIMD)
+	hwy.RegisterKernel("varint.EncodeStreamVByte32GroupSIMDInto", &EncodeStreamVByte32GroupSIMDInto)
+	hwyKernels := []string{"varint.DecodeStreamVByte32", "varint.DecodeStreamVByte32Into", "varint.DecodeStreamVByte32GroupSIMD", "varint.EncodeStreamVByte32", "varint.EncodeStreamVByte32Into", "varint.EncodeStreamVByte32GroupSIMD", "varint.EncodeStreamVByte32GroupSIMDInto"}
+	hwy.RegisterKernelTarget(hwy.DispatchNEON, initStreamvbyteNEON, hwyKernels...)
+	hwy.RegisterKernelTarget(hwy.DispatchScalar, initStreamvbyteFallback, hwyKernels...)
+}
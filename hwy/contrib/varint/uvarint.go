@@ -0,0 +1,194 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package varint
+
+import (
+	"encoding/binary"
+	"math/bits"
+
+	"github.com/ajroetker/go-highway/hwy"
+)
+
+// Whole-buffer LEB128 codec for []uint64, the format of protobuf varints
+// and packed repeated fields, as written by encoding/binary.AppendUvarint.
+//
+// DecodeUvarints finds the varint boundaries of 32 bytes at a time with
+// the SIMD FindVarintEnds mask and assembles each value of up to 8 bytes
+// from a single 8-byte load, compacting the 7-bit groups with shifts and
+// masks instead of a loop over the bytes. A window of 32 single-byte
+// values is widened directly. Without SIMD the mask is computed from four
+// 8-byte words instead, which beats the scalar FindVarintEnds fallback.
+
+// DecodeUvarints decodes LEB128 varints from src into dst until dst is full
+// or src is exhausted. Returns (values decoded, bytes consumed).
+//
+// Decoding stops at a truncated varint at the end of src or at a malformed
+// one (longer than 10 bytes or overflowing uint64), so consumed <
+// len(src) with room left in dst means src[consumed:] is not a valid
+// varint.
+//
+// Example:
+//
+//	dst := make([]uint64, count)
+//	decoded, consumed := DecodeUvarints(packedField, dst)
+func DecodeUvarints(src []byte, dst []uint64) (decoded int, consumed int) {
+	pos := 0
+	for decoded < len(dst) && len(src)-pos >= 32 {
+		window := src[pos : pos+32]
+		mask := findEnds32(window)
+		if mask == 0 {
+			// 32 continuation bytes cannot be a valid varint.
+			return decoded, pos
+		}
+		if mask == 0xFFFFFFFF && len(dst)-decoded >= 32 {
+			out := dst[decoded : decoded+32]
+			for i, b := range window {
+				out[i] = uint64(b)
+			}
+			decoded += 32
+			pos += 32
+			continue
+		}
+
+		// Decode every varint that ends in this window; one that runs
+		// past it starts the next window.
+		rest := src[pos:]
+		start := 0
+		for mask != 0 && decoded < len(dst) {
+			end := bits.TrailingZeros32(mask)
+			mask &= mask - 1
+			n := end - start + 1
+
+			var v uint64
+			if n <= 8 && start+8 <= len(rest) {
+				v = assembleUvarint(binary.LittleEndian.Uint64(rest[start:]), n)
+			} else {
+				var read int
+				v, read = decodeOneUvarint64(rest[start:])
+				if read != n {
+					return decoded, pos + start
+				}
+			}
+			dst[decoded] = v
+			decoded++
+			start = end + 1
+		}
+		pos += start
+	}
+
+	// Tail shorter than a window.
+	for decoded < len(dst) && pos < len(src) {
+		v, read := decodeOneUvarint64(src[pos:])
+		if read == 0 {
+			break
+		}
+		dst[decoded] = v
+		decoded++
+		pos += read
+	}
+	return decoded, pos
+}
+
+// findEnds32 computes the varint end mask of a 32-byte window.
+var findEnds32 = varintEnds32
+
+func init() {
+	if hwy.HasSIMD() {
+		findEnds32 = FindVarintEnds
+	}
+}
+
+// varintEnds32 is FindVarintEnds for exactly 32 bytes, testing the high
+// bits of 8 bytes per word and gathering them with a multiply.
+func varintEnds32(w []byte) uint32 {
+	return uint32(ends8(binary.LittleEndian.Uint64(w))) |
+		uint32(ends8(binary.LittleEndian.Uint64(w[8:])))<<8 |
+		uint32(ends8(binary.LittleEndian.Uint64(w[16:])))<<16 |
+		uint32(ends8(binary.LittleEndian.Uint64(w[24:])))<<24
+}
+
+// ends8 returns the 8-bit end mask of the little-endian word w.
+func ends8(w uint64) uint64 {
+	t := (^w & 0x8080808080808080) >> 7
+	return (t * 0x0102040810204080) >> 56
+}
+
+// assembleUvarint decodes the n-byte varint (1 <= n <= 8) in the low bytes
+// of the little-endian word w, merging the 7-bit groups pairwise in three
+// steps as PEXT would in one.
+func assembleUvarint(w uint64, n int) uint64 {
+	w &= varintByteMasks[n&7]
+	w = w&0x007f007f007f007f | w>>1&0x3f803f803f803f80
+	w = w&0x00003fff00003fff | w>>2&0x0fffc0000fffc000
+	return w&0x000000000fffffff | w>>4&0x00fffffff0000000
+}
+
+// varintByteMasks[n&7] keeps the low n bytes of a word, all 8 for n = 8.
+var varintByteMasks = [8]uint64{
+	^uint64(0), 0xff, 0xffff, 0xffffff, 0xffffffff,
+	0xffffffffff, 0xffffffffffff, 0xffffffffffffff,
+}
+
+// spreadUvarint is the inverse of assembleUvarint: it returns the n-byte
+// varint encoding (n <= 8) of v in the low bytes of a little-endian word.
+func spreadUvarint(v uint64, n int) uint64 {
+	w := v&0x7f |
+		v<<1&(0x7f<<8) |
+		v<<2&(0x7f<<16) |
+		v<<3&(0x7f<<24) |
+		v<<4&(0x7f<<32) |
+		v<<5&(0x7f<<40) |
+		v<<6&(0x7f<<48) |
+		v<<7&(0x7f<<56)
+	// Continuation bits on every byte but the last.
+	return w | 0x8080808080808080&(1<<(8*(n-1))-1)
+}
+
+// uvarintLen returns the encoded length of v in bytes.
+func uvarintLen(v uint64) int {
+	return (bits.Len64(v|1) + 6) / 7
+}
+
+// UvarintsLen returns the number of bytes AppendUvarints writes for values.
+func UvarintsLen(values []uint64) int {
+	total := 0
+	for _, v := range values {
+		total += uvarintLen(v)
+	}
+	return total
+}
+
+// AppendUvarints appends the LEB128 encoding of values to dst and returns
+// the extended slice. The output is byte-for-byte what calling
+// binary.AppendUvarint for each value produces.
+//
+// Values below 2^56 are spread into their varint bytes with shifts and
+// masks and written with one 8-byte store.
+func AppendUvarints(dst []byte, values []uint64) []byte {
+	for _, v := range values {
+		if v < 0x80 {
+			dst = append(dst, byte(v))
+			continue
+		}
+		n := uvarintLen(v)
+		if n > 8 {
+			dst = binary.AppendUvarint(dst, v)
+			continue
+		}
+		end := len(dst) + n
+		dst = binary.LittleEndian.AppendUint64(dst, spreadUvarint(v, n))[:end]
+	}
+	return dst
+}
@@ -21,11 +21,11 @@ func init() {
 		initVarintFallback()
 		return
 	}
-	if archsimd.X86.AVX512() {
+	if archsimd.X86.AVX512() && hwy.TargetEnabled(hwy.DispatchAVX512) {
 		initVarintAVX512()
 		return
 	}
-	if archsimd.X86.AVX2() {
+	if archsimd.X86.AVX2() && hwy.TargetEnabled(hwy.DispatchAVX2) {
 		initVarintAVX2()
 		return
 	}
@@ -55,3 +55,15 @@ func initVarintFallback() {
 	Decode5Uvarint64 = BaseDecode5Uvarint64_fallback
 	DecodeUvarint64BatchWithMask = BaseDecodeUvarint64BatchWithMask_fallback
 }
+
+func init() {
+	hwy.RegisterKernel("varint.FindVarintEnds", &FindVarintEnds)
+	hwy.RegisterKernel("varint.DecodeUvarint64Batch", &DecodeUvarint64Batch)
+	hwy.RegisterKernel("varint.Decode2Uvarint64", &Decode2Uvarint64)
+	hwy.RegisterKernel("varint.Decode5Uvarint64", &Decode5Uvarint64)
+	hwy.RegisterKernel("varint.DecodeUvarint64BatchWithMask", &DecodeUvarint64BatchWithMask)
+	hwyKernels := []string{"varint.FindVarintEnds", "varint.DecodeUvarint64Batch", "varint.Decode2Uvarint64", "varint.Decode5Uvarint64", "varint.DecodeUvarint64BatchWithMask"}
+	hwy.RegisterKernelTarget(hwy.DispatchAVX2, initVarintAVX2, hwyKernels...)
+	hwy.RegisterKernelTarget(hwy.DispatchAVX512, initVarintAVX512, hwyKernels...)
+	hwy.RegisterKernelTarget(hwy.DispatchScalar, initVarintFallback, hwyKernels...)
+}
@@ -36,6 +36,10 @@
 //
 //	// Decode location fields (5 varints)
 //	loc, n := varint.Decode5Uvarint64(data)
+//
+//	// Encode and decode a whole buffer
+//	buf := varint.AppendUvarints(nil, values)
+//	decoded, consumed = varint.DecodeUvarints(buf, values)
 package varint
 
 import "github.com/ajroetker/go-highway/hwy"
@@ -26,3 +26,13 @@ func initVarintFallback() {
 	Decode5Uvarint64 = BaseDecode5Uvarint64_fallback
 	DecodeUvarint64BatchWithMask = BaseDecodeUvarint64BatchWithMask_fallback
 }
+
+func init() {
+	hwy.RegisterKernel("varint.FindVarintEnds", &FindVarintEnds)
+	hwy.RegisterKernel("varint.DecodeUvarint64Batch", &DecodeUvarint64Batch)
+	hwy.RegisterKernel("varint.Decode2Uvarint64", &Decode2Uvarint64)
+	hwy.RegisterKernel("varint.Decode5Uvarint64", &Decode5Uvarint64)
+	hwy.RegisterKernel("varint.DecodeUvarint64BatchWithMask", &DecodeUvarint64BatchWithMask)
+	hwyKernels := []string{"varint.FindVarintEnds", "varint.DecodeUvarint64Batch", "varint.Decode2Uvarint64", "varint.Decode5Uvarint64", "varint.DecodeUvarint64BatchWithMask"}
+	hwy.RegisterKernelTarget(hwy.DispatchScalar, initVarintFallback, hwyKernels...)
+}
@@ -15,7 +15,10 @@
 package varint
 
 import (
+	"bytes"
+	"encoding/binary"
 	"math"
+	"slices"
 	"testing"
 )
 
@@ -1539,3 +1542,134 @@ func BenchmarkCompareVarintVsMaskedVByte(b *testing.B) {
 		}
 	})
 }
+
+// ============================================================================
+// Tests for uvarint.go
+// ============================================================================
+
+// makeMixedWidths returns n values covering every varint length from 1 to
+// 10 bytes, with runs of single-byte values.
+func makeMixedWidths(n int) []uint64 {
+	values := make([]uint64, n)
+	x := uint64(0x9E3779B97F4A7C15)
+	for i := range values {
+		x ^= x << 13
+		x ^= x >> 7
+		x ^= x << 17
+		if i%100 < 40 {
+			values[i] = x & 0x7f
+		} else {
+			values[i] = x >> (x % 64)
+		}
+	}
+	return values
+}
+
+func TestVarintEnds32(t *testing.T) {
+	for _, input := range [][]byte{
+		make32ByteTerminators(),
+		make32ByteContinuations(),
+		makeAlternating32(),
+		AppendUvarints(nil, makeMixedWidths(20))[:32],
+	} {
+		if got, want := varintEnds32(input), BaseFindVarintEnds(input); got != want {
+			t.Errorf("varintEnds32 = %032b, want %032b", got, want)
+		}
+	}
+}
+
+func TestAppendUvarints(t *testing.T) {
+	for _, n := range []int{0, 1, 31, 32, 33, 1000} {
+		values := makeMixedWidths(n)
+		want := []byte{0xAA}
+		for _, v := range values {
+			want = binary.AppendUvarint(want, v)
+		}
+		got := AppendUvarints([]byte{0xAA}, values)
+		if !bytes.Equal(got, want) {
+			t.Fatalf("n=%d: AppendUvarints differs from binary.AppendUvarint", n)
+		}
+		if l := UvarintsLen(values); l != len(want)-1 {
+			t.Errorf("n=%d: UvarintsLen = %d, want %d", n, l, len(want)-1)
+		}
+	}
+}
+
+func TestDecodeUvarints(t *testing.T) {
+	for _, n := range []int{0, 1, 31, 32, 33, 100, 1000} {
+		values := makeMixedWidths(n)
+		encoded := AppendUvarints(nil, values)
+
+		dst := make([]uint64, n)
+		decoded, consumed := DecodeUvarints(encoded, dst)
+		if decoded != n || consumed != len(encoded) {
+			t.Fatalf("n=%d: got (%d, %d), want (%d, %d)", n, decoded, consumed, n, len(encoded))
+		}
+		for i := range dst {
+			if dst[i] != values[i] {
+				t.Fatalf("n=%d: value %d = %d, want %d", n, i, dst[i], values[i])
+			}
+		}
+
+		// A short dst stops after len(dst) values.
+		if n > 10 {
+			decoded, consumed = DecodeUvarints(encoded, dst[:n/2])
+			if want := UvarintsLen(values[:n/2]); decoded != n/2 || consumed != want {
+				t.Errorf("n=%d: short dst got (%d, %d), want (%d, %d)", n, decoded, consumed, n/2, want)
+			}
+		}
+	}
+
+	// All single-byte values take the widening fast path.
+	ones := make([]byte, 64)
+	for i := range ones {
+		ones[i] = byte(i)
+	}
+	dst := make([]uint64, 64)
+	if decoded, consumed := DecodeUvarints(ones, dst); decoded != 64 || consumed != 64 || dst[63] != 63 {
+		t.Errorf("single-byte values: got (%d, %d, %d)", decoded, consumed, dst[63])
+	}
+}
+
+func TestDecodeUvarints_Malformed(t *testing.T) {
+	prefix := AppendUvarints(nil, makeMixedWidths(40))
+	tests := []struct {
+		name string
+		bad  []byte
+	}{
+		{"truncated", []byte{0x80, 0x80}},
+		{"too_long", bytes.Repeat([]byte{0x80}, 11)},
+		{"overflow", append(bytes.Repeat([]byte{0xFF}, 9), 0x02)},
+		{"continuations_32", append(bytes.Repeat([]byte{0x80}, 40), 0x01)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			src := append(slices.Clone(prefix), tt.bad...)
+			dst := make([]uint64, 100)
+			decoded, consumed := DecodeUvarints(src, dst)
+			if decoded != 40 || consumed != len(prefix) {
+				t.Errorf("got (%d, %d), want (40, %d)", decoded, consumed, len(prefix))
+			}
+		})
+	}
+}
+
+func BenchmarkDecodeUvarints(b *testing.B) {
+	values := makeMixedWidths(1000)
+	encoded := AppendUvarints(nil, values)
+	dst := make([]uint64, len(values))
+
+	b.Run("DecodeUvarints", func(b *testing.B) {
+		b.SetBytes(int64(len(encoded)))
+		for i := 0; i < b.N; i++ {
+			DecodeUvarints(encoded, dst)
+		}
+	})
+
+	b.Run("DecodeUvarint64Batch", func(b *testing.B) {
+		b.SetBytes(int64(len(encoded)))
+		for i := 0; i < b.N; i++ {
+			DecodeUvarint64Batch(encoded, dst, len(dst))
+		}
+	})
+}
@@ -88,3 +88,16 @@ func initArgmaxFallback() {
 	ArgminFloat32 = BaseArgmin_fallback
 	ArgminFloat64 = BaseArgmin_fallback_Float64
 }
+
+func init() {
+	hwy.RegisterKernel("vec.ArgmaxFloat16", &ArgmaxFloat16)
+	hwy.RegisterKernel("vec.ArgmaxBFloat16", &ArgmaxBFloat16)
+	hwy.RegisterKernel("vec.ArgmaxFloat32", &ArgmaxFloat32)
+	hwy.RegisterKernel("vec.ArgmaxFloat64", &ArgmaxFloat64)
+	hwy.RegisterKernel("vec.ArgminFloat16", &ArgminFloat16)
+	hwy.RegisterKernel("vec.ArgminBFloat16", &ArgminBFloat16)
+	hwy.RegisterKernel("vec.ArgminFloat32", &ArgminFloat32)
+	hwy.RegisterKernel("vec.ArgminFloat64", &ArgminFloat64)
+	hwyKernels := []string{"vec.ArgmaxFloat16", "vec.ArgmaxBFloat16", "vec.ArgmaxFloat32", "vec.ArgmaxFloat64", "vec.ArgminFloat16", "vec.ArgminBFloat16", "vec.ArgminFloat32", "vec.ArgminFloat64"}
+	hwy.RegisterKernelTarget(hwy.DispatchScalar, initArgmaxFallback, hwyKernels...)
+}
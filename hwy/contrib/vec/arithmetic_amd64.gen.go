@@ -398,11 +398,11 @@ func init() {
 		initArithmeticFallback()
 		return
 	}
-	if archsimd.X86.AVX512() {
+	if archsimd.X86.AVX512() && hwy.TargetEnabled(hwy.DispatchAVX512) {
 		initArithmeticAVX512()
 		return
 	}
-	if archsimd.X86.AVX2() {
+	if archsimd.X86.AVX2() && hwy.TargetEnabled(hwy.DispatchAVX2) {
 		initArithmeticAVX2()
 		return
 	}
@@ -561,3 +561,58 @@ func initArithmeticFallback() {
 	MulConstAddToFloat32 = BaseMulConstAddTo_fallback
 	MulConstAddToFloat64 = BaseMulConstAddTo_fallback_Float64
 }
+
+func init() {
+	hwy.RegisterKernel("vec.AddFloat16", &AddFloat16)
+	hwy.RegisterKernel("vec.AddBFloat16", &AddBFloat16)
+	hwy.RegisterKernel("vec.AddFloat32", &AddFloat32)
+	hwy.RegisterKernel("vec.AddFloat64", &AddFloat64)
+	hwy.RegisterKernel("vec.AddToFloat16", &AddToFloat16)
+	hwy.RegisterKernel("vec.AddToBFloat16", &AddToBFloat16)
+	hwy.RegisterKernel("vec.AddToFloat32", &AddToFloat32)
+	hwy.RegisterKernel("vec.AddToFloat64", &AddToFloat64)
+	hwy.RegisterKernel("vec.SubFloat16", &SubFloat16)
+	hwy.RegisterKernel("vec.SubBFloat16", &SubBFloat16)
+	hwy.RegisterKernel("vec.SubFloat32", &SubFloat32)
+	hwy.RegisterKernel("vec.SubFloat64", &SubFloat64)
+	hwy.RegisterKernel("vec.SubToFloat16", &SubToFloat16)
+	hwy.RegisterKernel("vec.SubToBFloat16", &SubToBFloat16)
+	hwy.RegisterKernel("vec.SubToFloat32", &SubToFloat32)
+	hwy.RegisterKernel("vec.SubToFloat64", &SubToFloat64)
+	hwy.RegisterKernel("vec.MulFloat16", &MulFloat16)
+	hwy.RegisterKernel("vec.MulBFloat16", &MulBFloat16)
+	hwy.RegisterKernel("vec.MulFloat32", &MulFloat32)
+	hwy.RegisterKernel("vec.MulFloat64", &MulFloat64)
+	hwy.RegisterKernel("vec.MulToFloat16", &MulToFloat16)
+	hwy.RegisterKernel("vec.MulToBFloat16", &MulToBFloat16)
+	hwy.RegisterKernel("vec.MulToFloat32", &MulToFloat32)
+	hwy.RegisterKernel("vec.MulToFloat64", &MulToFloat64)
+	hwy.RegisterKernel("vec.DivFloat16", &DivFloat16)
+	hwy.RegisterKernel("vec.DivBFloat16", &DivBFloat16)
+	hwy.RegisterKernel("vec.DivFloat32", &DivFloat32)
+	hwy.RegisterKernel("vec.DivFloat64", &DivFloat64)
+	hwy.RegisterKernel("vec.DivToFloat16", &DivToFloat16)
+	hwy.RegisterKernel("vec.DivToBFloat16", &DivToBFloat16)
+	hwy.RegisterKernel("vec.DivToFloat32", &DivToFloat32)
+	hwy.RegisterKernel("vec.DivToFloat64", &DivToFloat64)
+	hwy.RegisterKernel("vec.ScaleFloat16", &ScaleFloat16)
+	hwy.RegisterKernel("vec.ScaleBFloat16", &ScaleBFloat16)
+	hwy.RegisterKernel("vec.ScaleFloat32", &ScaleFloat32)
+	hwy.RegisterKernel("vec.ScaleFloat64", &ScaleFloat64)
+	hwy.RegisterKernel("vec.ScaleToFloat16", &ScaleToFloat16)
+	hwy.RegisterKernel("vec.ScaleToBFloat16", &ScaleToBFloat16)
+	hwy.RegisterKernel("vec.ScaleToFloat32", &ScaleToFloat32)
+	hwy.RegisterKernel("vec.ScaleToFloat64", &ScaleToFloat64)
+	hwy.RegisterKernel("vec.AddConstFloat16", &AddConstFloat16)
+	hwy.RegisterKernel("vec.AddConstBFloat16", &AddConstBFloat16)
+	hwy.RegisterKernel("vec.AddConstFloat32", &AddConstFloat32)
+	hwy.RegisterKernel("vec.AddConstFloat64", &AddConstFloat64)
+	hwy.RegisterKernel("vec.MulConstAddToFloat16", &MulConstAddToFloat16)
+	hwy.RegisterKernel("vec.MulConstAddToBFloat16", &MulConstAddToBFloat16)
+	hwy.RegisterKernel("vec.MulConstAddToFloat32", &MulConstAddToFloat32)
+	hwy.RegisterKernel("vec.MulConstAddToFloat64", &MulConstAddToFloat64)
+	hwyKernels := []string{"vec.AddFloat16", "vec.AddBFloat16", "vec.AddFloat32", "vec.AddFloat64", "vec.AddToFloat16", "vec.AddToBFloat16", "vec.AddToFloat32", "vec.AddToFloat64", "vec.SubFloat16", "vec.SubBFloat16", "vec.SubFloat32", "vec.SubFloat64", "vec.SubToFloat16", "vec.SubToBFloat16", "vec.SubToFloat32", "vec.SubToFloat64", "vec.MulFloat16", "vec.MulBFloat16", "vec.MulFloat32", "vec.MulFloat64", "vec.MulToFloat16", "vec.MulToBFloat16", "vec.MulToFloat32", "vec.MulToFloat64", "vec.DivFloat16", "vec.DivBFloat16", "vec.DivFloat32", "vec.DivFloat64", "vec.DivToFloat16", "vec.DivToBFloat16", "vec.DivToFloat32", "vec.DivToFloat64", "vec.ScaleFloat16", "vec.ScaleBFloat16", "vec.ScaleFloat32", "vec.ScaleFloat64", "vec.ScaleToFloat16", "vec.ScaleToBFloat16", "vec.ScaleToFloat32", "vec.ScaleToFloat64", "vec.AddConstFloat16", "vec.AddConstBFloat16", "vec.AddConstFloat32", "vec.AddConstFloat64", "vec.MulConstAddToFloat16", "vec.MulConstAddToBFloat16", "vec.MulConstAddToFloat32", "vec.MulConstAddToFloat64"}
+	hwy.RegisterKernelTarget(hwy.DispatchAVX2, initArithmeticAVX2, hwyKernels...)
+	hwy.RegisterKernelTarget(hwy.DispatchAVX512, initArithmeticAVX512, hwyKernels...)
+	hwy.RegisterKernelTarget(hwy.DispatchScalar, initArithmeticFallback, hwyKernels...)
+}
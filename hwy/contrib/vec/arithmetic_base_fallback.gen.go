@@ -48,7 +48,35 @@ func BaseAdd_fallback(dst []float32, s []float32) {
 	}
 	n := min(len(dst), len(s))
 	var i int
-	for i = 0; i < n; i++ {
+	for i = 0; i+4 <= n; i += 4 {
+		dst4 := dst[i : i+4 : i+4]
+		s4 := s[i : i+4 : i+4]
+		{
+			vd := dst4[0]
+			vs := s4[0]
+			result := vd + vs
+			dst4[0] = result
+		}
+		{
+			vd := dst4[1]
+			vs := s4[1]
+			result := vd + vs
+			dst4[1] = result
+		}
+		{
+			vd := dst4[2]
+			vs := s4[2]
+			result := vd + vs
+			dst4[2] = result
+		}
+		{
+			vd := dst4[3]
+			vs := s4[3]
+			result := vd + vs
+			dst4[3] = result
+		}
+	}
+	for ; i < n; i++ {
 		vd := dst[i]
 		vs := s[i]
 		result := vd + vs
@@ -65,7 +93,35 @@ func BaseAdd_fallback_Float64(dst []float64, s []float64) {
 	}
 	n := min(len(dst), len(s))
 	var i int
-	for i = 0; i < n; i++ {
+	for i = 0; i+4 <= n; i += 4 {
+		dst4 := dst[i : i+4 : i+4]
+		s4 := s[i : i+4 : i+4]
+		{
+			vd := dst4[0]
+			vs := s4[0]
+			result := vd + vs
+			dst4[0] = result
+		}
+		{
+			vd := dst4[1]
+			vs := s4[1]
+			result := vd + vs
+			dst4[1] = result
+		}
+		{
+			vd := dst4[2]
+			vs := s4[2]
+			result := vd + vs
+			dst4[2] = result
+		}
+		{
+			vd := dst4[3]
+			vs := s4[3]
+			result := vd + vs
+			dst4[3] = result
+		}
+	}
+	for ; i < n; i++ {
 		vd := dst[i]
 		vs := s[i]
 		result := vd + vs
@@ -118,7 +174,36 @@ func BaseAddTo_fallback(dst []float32, a []float32, b []float32) {
 	}
 	n := min(len(dst), min(len(a), len(b)))
 	var i int
-	for i = 0; i < n; i++ {
+	for i = 0; i+4 <= n; i += 4 {
+		a4 := a[i : i+4 : i+4]
+		b4 := b[i : i+4 : i+4]
+		dst4 := dst[i : i+4 : i+4]
+		{
+			va := a4[0]
+			vb := b4[0]
+			result := va + vb
+			dst4[0] = result
+		}
+		{
+			va := a4[1]
+			vb := b4[1]
+			result := va + vb
+			dst4[1] = result
+		}
+		{
+			va := a4[2]
+			vb := b4[2]
+			result := va + vb
+			dst4[2] = result
+		}
+		{
+			va := a4[3]
+			vb := b4[3]
+			result := va + vb
+			dst4[3] = result
+		}
+	}
+	for ; i < n; i++ {
 		va := a[i]
 		vb := b[i]
 		result := va + vb
@@ -135,7 +220,36 @@ func BaseAddTo_fallback_Float64(dst []float64, a []float64, b []float64) {
 	}
 	n := min(len(dst), min(len(a), len(b)))
 	var i int
-	for i = 0; i < n; i++ {
+	for i = 0; i+4 <= n; i += 4 {
+		a4 := a[i : i+4 : i+4]
+		b4 := b[i : i+4 : i+4]
+		dst4 := dst[i : i+4 : i+4]
+		{
+			va := a4[0]
+			vb := b4[0]
+			result := va + vb
+			dst4[0] = result
+		}
+		{
+			va := a4[1]
+			vb := b4[1]
+			result := va + vb
+			dst4[1] = result
+		}
+		{
+			va := a4[2]
+			vb := b4[2]
+			result := va + vb
+			dst4[2] = result
+		}
+		{
+			va := a4[3]
+			vb := b4[3]
+			result := va + vb
+			dst4[3] = result
+		}
+	}
+	for ; i < n; i++ {
 		va := a[i]
 		vb := b[i]
 		result := va + vb
@@ -188,7 +302,35 @@ func BaseSub_fallback(dst []float32, s []float32) {
 	}
 	n := min(len(dst), len(s))
 	var i int
-	for i = 0; i < n; i++ {
+	for i = 0; i+4 <= n; i += 4 {
+		dst4 := dst[i : i+4 : i+4]
+		s4 := s[i : i+4 : i+4]
+		{
+			vd := dst4[0]
+			vs := s4[0]
+			result := vd - vs
+			dst4[0] = result
+		}
+		{
+			vd := dst4[1]
+			vs := s4[1]
+			result := vd - vs
+			dst4[1] = result
+		}
+		{
+			vd := dst4[2]
+			vs := s4[2]
+			result := vd - vs
+			dst4[2] = result
+		}
+		{
+			vd := dst4[3]
+			vs := s4[3]
+			result := vd - vs
+			dst4[3] = result
+		}
+	}
+	for ; i < n; i++ {
 		vd := dst[i]
 		vs := s[i]
 		result := vd - vs
@@ -205,7 +347,35 @@ func BaseSub_fallback_Float64(dst []float64, s []float64) {
 	}
 	n := min(len(dst), len(s))
 	var i int
-	for i = 0; i < n; i++ {
+	for i = 0; i+4 <= n; i += 4 {
+		dst4 := dst[i : i+4 : i+4]
+		s4 := s[i : i+4 : i+4]
+		{
+			vd := dst4[0]
+			vs := s4[0]
+			result := vd - vs
+			dst4[0] = result
+		}
+		{
+			vd := dst4[1]
+			vs := s4[1]
+			result := vd - vs
+			dst4[1] = result
+		}
+		{
+			vd := dst4[2]
+			vs := s4[2]
+			result := vd - vs
+			dst4[2] = result
+		}
+		{
+			vd := dst4[3]
+			vs := s4[3]
+			result := vd - vs
+			dst4[3] = result
+		}
+	}
+	for ; i < n; i++ {
 		vd := dst[i]
 		vs := s[i]
 		result := vd - vs
@@ -258,7 +428,36 @@ func BaseSubTo_fallback(dst []float32, a []float32, b []float32) {
 	}
 	n := min(len(dst), min(len(a), len(b)))
 	var i int
-	for i = 0; i < n; i++ {
+	for i = 0; i+4 <= n; i += 4 {
+		a4 := a[i : i+4 : i+4]
+		b4 := b[i : i+4 : i+4]
+		dst4 := dst[i : i+4 : i+4]
+		{
+			va := a4[0]
+			vb := b4[0]
+			result := va - vb
+			dst4[0] = result
+		}
+		{
+			va := a4[1]
+			vb := b4[1]
+			result := va - vb
+			dst4[1] = result
+		}
+		{
+			va := a4[2]
+			vb := b4[2]
+			result := va - vb
+			dst4[2] = result
+		}
+		{
+			va := a4[3]
+			vb := b4[3]
+			result := va - vb
+			dst4[3] = result
+		}
+	}
+	for ; i < n; i++ {
 		va := a[i]
 		vb := b[i]
 		result := va - vb
@@ -275,7 +474,36 @@ func BaseSubTo_fallback_Float64(dst []float64, a []float64, b []float64) {
 	}
 	n := min(len(dst), min(len(a), len(b)))
 	var i int
-	for i = 0; i < n; i++ {
+	for i = 0; i+4 <= n; i += 4 {
+		a4 := a[i : i+4 : i+4]
+		b4 := b[i : i+4 : i+4]
+		dst4 := dst[i : i+4 : i+4]
+		{
+			va := a4[0]
+			vb := b4[0]
+			result := va - vb
+			dst4[0] = result
+		}
+		{
+			va := a4[1]
+			vb := b4[1]
+			result := va - vb
+			dst4[1] = result
+		}
+		{
+			va := a4[2]
+			vb := b4[2]
+			result := va - vb
+			dst4[2] = result
+		}
+		{
+			va := a4[3]
+			vb := b4[3]
+			result := va - vb
+			dst4[3] = result
+		}
+	}
+	for ; i < n; i++ {
 		va := a[i]
 		vb := b[i]
 		result := va - vb
@@ -328,7 +556,35 @@ func BaseMul_fallback(dst []float32, s []float32) {
 	}
 	n := min(len(dst), len(s))
 	var i int
-	for i = 0; i < n; i++ {
+	for i = 0; i+4 <= n; i += 4 {
+		dst4 := dst[i : i+4 : i+4]
+		s4 := s[i : i+4 : i+4]
+		{
+			vd := dst4[0]
+			vs := s4[0]
+			result := vd * vs
+			dst4[0] = result
+		}
+		{
+			vd := dst4[1]
+			vs := s4[1]
+			result := vd * vs
+			dst4[1] = result
+		}
+		{
+			vd := dst4[2]
+			vs := s4[2]
+			result := vd * vs
+			dst4[2] = result
+		}
+		{
+			vd := dst4[3]
+			vs := s4[3]
+			result := vd * vs
+			dst4[3] = result
+		}
+	}
+	for ; i < n; i++ {
 		vd := dst[i]
 		vs := s[i]
 		result := vd * vs
@@ -345,7 +601,35 @@ func BaseMul_fallback_Float64(dst []float64, s []float64) {
 	}
 	n := min(len(dst), len(s))
 	var i int
-	for i = 0; i < n; i++ {
+	for i = 0; i+4 <= n; i += 4 {
+		dst4 := dst[i : i+4 : i+4]
+		s4 := s[i : i+4 : i+4]
+		{
+			vd := dst4[0]
+			vs := s4[0]
+			result := vd * vs
+			dst4[0] = result
+		}
+		{
+			vd := dst4[1]
+			vs := s4[1]
+			result := vd * vs
+			dst4[1] = result
+		}
+		{
+			vd := dst4[2]
+			vs := s4[2]
+			result := vd * vs
+			dst4[2] = result
+		}
+		{
+			vd := dst4[3]
+			vs := s4[3]
+			result := vd * vs
+			dst4[3] = result
+		}
+	}
+	for ; i < n; i++ {
 		vd := dst[i]
 		vs := s[i]
 		result := vd * vs
@@ -398,7 +682,36 @@ func BaseMulTo_fallback(dst []float32, a []float32, b []float32) {
 	}
 	n := min(len(dst), min(len(a), len(b)))
 	var i int
-	for i = 0; i < n; i++ {
+	for i = 0; i+4 <= n; i += 4 {
+		a4 := a[i : i+4 : i+4]
+		b4 := b[i : i+4 : i+4]
+		dst4 := dst[i : i+4 : i+4]
+		{
+			va := a4[0]
+			vb := b4[0]
+			result := va * vb
+			dst4[0] = result
+		}
+		{
+			va := a4[1]
+			vb := b4[1]
+			result := va * vb
+			dst4[1] = result
+		}
+		{
+			va := a4[2]
+			vb := b4[2]
+			result := va * vb
+			dst4[2] = result
+		}
+		{
+			va := a4[3]
+			vb := b4[3]
+			result := va * vb
+			dst4[3] = result
+		}
+	}
+	for ; i < n; i++ {
 		va := a[i]
 		vb := b[i]
 		result := va * vb
@@ -415,7 +728,36 @@ func BaseMulTo_fallback_Float64(dst []float64, a []float64, b []float64) {
 	}
 	n := min(len(dst), min(len(a), len(b)))
 	var i int
-	for i = 0; i < n; i++ {
+	for i = 0; i+4 <= n; i += 4 {
+		a4 := a[i : i+4 : i+4]
+		b4 := b[i : i+4 : i+4]
+		dst4 := dst[i : i+4 : i+4]
+		{
+			va := a4[0]
+			vb := b4[0]
+			result := va * vb
+			dst4[0] = result
+		}
+		{
+			va := a4[1]
+			vb := b4[1]
+			result := va * vb
+			dst4[1] = result
+		}
+		{
+			va := a4[2]
+			vb := b4[2]
+			result := va * vb
+			dst4[2] = result
+		}
+		{
+			va := a4[3]
+			vb := b4[3]
+			result := va * vb
+			dst4[3] = result
+		}
+	}
+	for ; i < n; i++ {
 		va := a[i]
 		vb := b[i]
 		result := va * vb
@@ -468,7 +810,35 @@ func BaseDiv_fallback(dst []float32, s []float32) {
 	}
 	n := min(len(dst), len(s))
 	var i int
-	for i = 0; i < n; i++ {
+	for i = 0; i+4 <= n; i += 4 {
+		dst4 := dst[i : i+4 : i+4]
+		s4 := s[i : i+4 : i+4]
+		{
+			vd := dst4[0]
+			vs := s4[0]
+			result := vd / vs
+			dst4[0] = result
+		}
+		{
+			vd := dst4[1]
+			vs := s4[1]
+			result := vd / vs
+			dst4[1] = result
+		}
+		{
+			vd := dst4[2]
+			vs := s4[2]
+			result := vd / vs
+			dst4[2] = result
+		}
+		{
+			vd := dst4[3]
+			vs := s4[3]
+			result := vd / vs
+			dst4[3] = result
+		}
+	}
+	for ; i < n; i++ {
 		vd := dst[i]
 		vs := s[i]
 		result := vd / vs
@@ -485,7 +855,35 @@ func BaseDiv_fallback_Float64(dst []float64, s []float64) {
 	}
 	n := min(len(dst), len(s))
 	var i int
-	for i = 0; i < n; i++ {
+	for i = 0; i+4 <= n; i += 4 {
+		dst4 := dst[i : i+4 : i+4]
+		s4 := s[i : i+4 : i+4]
+		{
+			vd := dst4[0]
+			vs := s4[0]
+			result := vd / vs
+			dst4[0] = result
+		}
+		{
+			vd := dst4[1]
+			vs := s4[1]
+			result := vd / vs
+			dst4[1] = result
+		}
+		{
+			vd := dst4[2]
+			vs := s4[2]
+			result := vd / vs
+			dst4[2] = result
+		}
+		{
+			vd := dst4[3]
+			vs := s4[3]
+			result := vd / vs
+			dst4[3] = result
+		}
+	}
+	for ; i < n; i++ {
 		vd := dst[i]
 		vs := s[i]
 		result := vd / vs
@@ -538,7 +936,36 @@ func BaseDivTo_fallback(dst []float32, a []float32, b []float32) {
 	}
 	n := min(len(dst), min(len(a), len(b)))
 	var i int
-	for i = 0; i < n; i++ {
+	for i = 0; i+4 <= n; i += 4 {
+		a4 := a[i : i+4 : i+4]
+		b4 := b[i : i+4 : i+4]
+		dst4 := dst[i : i+4 : i+4]
+		{
+			va := a4[0]
+			vb := b4[0]
+			result := va / vb
+			dst4[0] = result
+		}
+		{
+			va := a4[1]
+			vb := b4[1]
+			result := va / vb
+			dst4[1] = result
+		}
+		{
+			va := a4[2]
+			vb := b4[2]
+			result := va / vb
+			dst4[2] = result
+		}
+		{
+			va := a4[3]
+			vb := b4[3]
+			result := va / vb
+			dst4[3] = result
+		}
+	}
+	for ; i < n; i++ {
 		va := a[i]
 		vb := b[i]
 		result := va / vb
@@ -555,7 +982,36 @@ func BaseDivTo_fallback_Float64(dst []float64, a []float64, b []float64) {
 	}
 	n := min(len(dst), min(len(a), len(b)))
 	var i int
-	for i = 0; i < n; i++ {
+	for i = 0; i+4 <= n; i += 4 {
+		a4 := a[i : i+4 : i+4]
+		b4 := b[i : i+4 : i+4]
+		dst4 := dst[i : i+4 : i+4]
+		{
+			va := a4[0]
+			vb := b4[0]
+			result := va / vb
+			dst4[0] = result
+		}
+		{
+			va := a4[1]
+			vb := b4[1]
+			result := va / vb
+			dst4[1] = result
+		}
+		{
+			va := a4[2]
+			vb := b4[2]
+			result := va / vb
+			dst4[2] = result
+		}
+		{
+			va := a4[3]
+			vb := b4[3]
+			result := va / vb
+			dst4[3] = result
+		}
+	}
+	for ; i < n; i++ {
 		va := a[i]
 		vb := b[i]
 		result := va / vb
@@ -609,7 +1065,30 @@ func BaseScale_fallback(c float32, dst []float32) {
 	n := len(dst)
 	vc := float32(c)
 	var i int
-	for i = 0; i < n; i++ {
+	for i = 0; i+4 <= n; i += 4 {
+		dst4 := dst[i : i+4 : i+4]
+		{
+			vd := dst4[0]
+			result := vd * vc
+			dst4[0] = result
+		}
+		{
+			vd := dst4[1]
+			result := vd * vc
+			dst4[1] = result
+		}
+		{
+			vd := dst4[2]
+			result := vd * vc
+			dst4[2] = result
+		}
+		{
+			vd := dst4[3]
+			result := vd * vc
+			dst4[3] = result
+		}
+	}
+	for ; i < n; i++ {
 		vd := dst[i]
 		result := vd * vc
 		dst[i] = result
@@ -626,7 +1105,30 @@ func BaseScale_fallback_Float64(c float64, dst []float64) {
 	n := len(dst)
 	vc := float64(c)
 	var i int
-	for i = 0; i < n; i++ {
+	for i = 0; i+4 <= n; i += 4 {
+		dst4 := dst[i : i+4 : i+4]
+		{
+			vd := dst4[0]
+			result := vd * vc
+			dst4[0] = result
+		}
+		{
+			vd := dst4[1]
+			result := vd * vc
+			dst4[1] = result
+		}
+		{
+			vd := dst4[2]
+			result := vd * vc
+			dst4[2] = result
+		}
+		{
+			vd := dst4[3]
+			result := vd * vc
+			dst4[3] = result
+		}
+	}
+	for ; i < n; i++ {
 		vd := dst[i]
 		result := vd * vc
 		dst[i] = result
@@ -679,7 +1181,31 @@ func BaseScaleTo_fallback(dst []float32, c float32, s []float32) {
 	n := min(len(dst), len(s))
 	vc := float32(c)
 	var i int
-	for i = 0; i < n; i++ {
+	for i = 0; i+4 <= n; i += 4 {
+		s4 := s[i : i+4 : i+4]
+		dst4 := dst[i : i+4 : i+4]
+		{
+			vs := s4[0]
+			result := vc * vs
+			dst4[0] = result
+		}
+		{
+			vs := s4[1]
+			result := vc * vs
+			dst4[1] = result
+		}
+		{
+			vs := s4[2]
+			result := vc * vs
+			dst4[2] = result
+		}
+		{
+			vs := s4[3]
+			result := vc * vs
+			dst4[3] = result
+		}
+	}
+	for ; i < n; i++ {
 		vs := s[i]
 		result := vc * vs
 		dst[i] = result
@@ -696,7 +1222,31 @@ func BaseScaleTo_fallback_Float64(dst []float64, c float64, s []float64) {
 	n := min(len(dst), len(s))
 	vc := float64(c)
 	var i int
-	for i = 0; i < n; i++ {
+	for i = 0; i+4 <= n; i += 4 {
+		s4 := s[i : i+4 : i+4]
+		dst4 := dst[i : i+4 : i+4]
+		{
+			vs := s4[0]
+			result := vc * vs
+			dst4[0] = result
+		}
+		{
+			vs := s4[1]
+			result := vc * vs
+			dst4[1] = result
+		}
+		{
+			vs := s4[2]
+			result := vc * vs
+			dst4[2] = result
+		}
+		{
+			vs := s4[3]
+			result := vc * vs
+			dst4[3] = result
+		}
+	}
+	for ; i < n; i++ {
 		vs := s[i]
 		result := vc * vs
 		dst[i] = result
@@ -749,7 +1299,30 @@ func BaseAddConst_fallback(c float32, dst []float32) {
 	n := len(dst)
 	vc := float32(c)
 	var i int
-	for i = 0; i < n; i++ {
+	for i = 0; i+4 <= n; i += 4 {
+		dst4 := dst[i : i+4 : i+4]
+		{
+			vd := dst4[0]
+			result := vd + vc
+			dst4[0] = result
+		}
+		{
+			vd := dst4[1]
+			result := vd + vc
+			dst4[1] = result
+		}
+		{
+			vd := dst4[2]
+			result := vd + vc
+			dst4[2] = result
+		}
+		{
+			vd := dst4[3]
+			result := vd + vc
+			dst4[3] = result
+		}
+	}
+	for ; i < n; i++ {
 		vd := dst[i]
 		result := vd + vc
 		dst[i] = result
@@ -766,7 +1339,30 @@ func BaseAddConst_fallback_Float64(c float64, dst []float64) {
 	n := len(dst)
 	vc := float64(c)
 	var i int
-	for i = 0; i < n; i++ {
+	for i = 0; i+4 <= n; i += 4 {
+		dst4 := dst[i : i+4 : i+4]
+		{
+			vd := dst4[0]
+			result := vd + vc
+			dst4[0] = result
+		}
+		{
+			vd := dst4[1]
+			result := vd + vc
+			dst4[1] = result
+		}
+		{
+			vd := dst4[2]
+			result := vd + vc
+			dst4[2] = result
+		}
+		{
+			vd := dst4[3]
+			result := vd + vc
+			dst4[3] = result
+		}
+	}
+	for ; i < n; i++ {
 		vd := dst[i]
 		result := vd + vc
 		dst[i] = result
@@ -821,7 +1417,35 @@ func BaseMulConstAddTo_fallback(dst []float32, a float32, x []float32) {
 	n := min(len(dst), len(x))
 	va := float32(a)
 	var i int
-	for i = 0; i < n; i++ {
+	for i = 0; i+4 <= n; i += 4 {
+		dst4 := dst[i : i+4 : i+4]
+		x4 := x[i : i+4 : i+4]
+		{
+			vd := dst4[0]
+			vx := x4[0]
+			result := va*vx + vd
+			dst4[0] = result
+		}
+		{
+			vd := dst4[1]
+			vx := x4[1]
+			result := va*vx + vd
+			dst4[1] = result
+		}
+		{
+			vd := dst4[2]
+			vx := x4[2]
+			result := va*vx + vd
+			dst4[2] = result
+		}
+		{
+			vd := dst4[3]
+			vx := x4[3]
+			result := va*vx + vd
+			dst4[3] = result
+		}
+	}
+	for ; i < n; i++ {
 		vd := dst[i]
 		vx := x[i]
 		result := va*vx + vd
@@ -839,7 +1463,35 @@ func BaseMulConstAddTo_fallback_Float64(dst []float64, a float64, x []float64) {
 	n := min(len(dst), len(x))
 	va := float64(a)
 	var i int
-	for i = 0; i < n; i++ {
+	for i = 0; i+4 <= n; i += 4 {
+		dst4 := dst[i : i+4 : i+4]
+		x4 := x[i : i+4 : i+4]
+		{
+			vd := dst4[0]
+			vx := x4[0]
+			result := va*vx + vd
+			dst4[0] = result
+		}
+		{
+			vd := dst4[1]
+			vx := x4[1]
+			result := va*vx + vd
+			dst4[1] = result
+		}
+		{
+			vd := dst4[2]
+			vx := x4[2]
+			result := va*vx + vd
+			dst4[2] = result
+		}
+		{
+			vd := dst4[3]
+			vx := x4[3]
+			result := va*vx + vd
+			dst4[3] = result
+		}
+	}
+	for ; i < n; i++ {
 		vd := dst[i]
 		vx := x[i]
 		result := va*vx + vd
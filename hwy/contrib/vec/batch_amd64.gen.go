@@ -122,11 +122,11 @@ func init() {
 		initBatchFallback()
 		return
 	}
-	if archsimd.X86.AVX512() {
+	if archsimd.X86.AVX512() && hwy.TargetEnabled(hwy.DispatchAVX512) {
 		initBatchAVX512()
 		return
 	}
-	if archsimd.X86.AVX2() {
+	if archsimd.X86.AVX2() && hwy.TargetEnabled(hwy.DispatchAVX2) {
 		initBatchAVX2()
 		return
 	}
@@ -165,3 +165,18 @@ func initBatchFallback() {
 	BatchDotFloat32 = BaseBatchDot_fallback
 	BatchDotFloat64 = BaseBatchDot_fallback_Float64
 }
+
+func init() {
+	hwy.RegisterKernel("vec.BatchL2SquaredDistanceFloat16", &BatchL2SquaredDistanceFloat16)
+	hwy.RegisterKernel("vec.BatchL2SquaredDistanceBFloat16", &BatchL2SquaredDistanceBFloat16)
+	hwy.RegisterKernel("vec.BatchL2SquaredDistanceFloat32", &BatchL2SquaredDistanceFloat32)
+	hwy.RegisterKernel("vec.BatchL2SquaredDistanceFloat64", &BatchL2SquaredDistanceFloat64)
+	hwy.RegisterKernel("vec.BatchDotFloat16", &BatchDotFloat16)
+	hwy.RegisterKernel("vec.BatchDotBFloat16", &BatchDotBFloat16)
+	hwy.RegisterKernel("vec.BatchDotFloat32", &BatchDotFloat32)
+	hwy.RegisterKernel("vec.BatchDotFloat64", &BatchDotFloat64)
+	hwyKernels := []string{"vec.BatchL2SquaredDistanceFloat16", "vec.BatchL2SquaredDistanceBFloat16", "vec.BatchL2SquaredDistanceFloat32", "vec.BatchL2SquaredDistanceFloat64", "vec.BatchDotFloat16", "vec.BatchDotBFloat16", "vec.BatchDotFloat32", "vec.BatchDotFloat64"}
+	hwy.RegisterKernelTarget(hwy.DispatchAVX2, initBatchAVX2, hwyKernels...)
+	hwy.RegisterKernelTarget(hwy.DispatchAVX512, initBatchAVX512, hwyKernels...)
+	hwy.RegisterKernelTarget(hwy.DispatchScalar, initBatchFallback, hwyKernels...)
+}
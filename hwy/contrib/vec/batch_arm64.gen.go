@@ -145,3 +145,17 @@ func initBatchFallback() {
 	BatchDotFloat32 = BaseBatchDot_fallback
 	BatchDotFloat64 = BaseBatchDot_fallback_Float64
 }
+
+func init() {
+	hwy.RegisterKernel("vec.BatchL2SquaredDistanceFloat16", &BatchL2SquaredDistanceFloat16)
+	hwy.RegisterKernel("vec.BatchL2SquaredDistanceBFloat16", &BatchL2SquaredDistanceBFloat16)
+	hwy.RegisterKernel("vec.BatchL2SquaredDistanceFloat32", &BatchL2SquaredDistanceFloat32)
+	hwy.RegisterKernel("vec.BatchL2SquaredDistanceFloat64", &BatchL2SquaredDistanceFloat64)
+	hwy.RegisterKernel("vec.BatchDotFloat16", &BatchDotFloat16)
+	hwy.RegisterKernel("vec.BatchDotBFloat16", &BatchDotBFloat16)
+	hwy.RegisterKernel("vec.BatchDotFloat32", &BatchDotFloat32)
+	hwy.RegisterKernel("vec.BatchDotFloat64", &BatchDotFloat64)
+	hwyKernels := []string{"vec.BatchL2SquaredDistanceFloat16", "vec.BatchL2SquaredDistanceBFloat16", "vec.BatchL2SquaredDistanceFloat32", "vec.BatchL2SquaredDistanceFloat64", "vec.BatchDotFloat16", "vec.BatchDotBFloat16", "vec.BatchDotFloat32", "vec.BatchDotFloat64"}
+	hwy.RegisterKernelTarget(hwy.DispatchNEON, initBatchNEON, hwyKernels...)
+	hwy.RegisterKernelTarget(hwy.DispatchScalar, initBatchFallback, hwyKernels...)
+}
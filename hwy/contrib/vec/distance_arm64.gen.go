@@ -107,3 +107,17 @@ func initDistanceFallback() {
 	L2DistanceFloat32 = BaseL2Distance_fallback
 	L2DistanceFloat64 = BaseL2Distance_fallback_Float64
 }
+
+func init() {
+	hwy.RegisterKernel("vec.L2SquaredDistanceFloat16", &L2SquaredDistanceFloat16)
+	hwy.RegisterKernel("vec.L2SquaredDistanceBFloat16", &L2SquaredDistanceBFloat16)
+	hwy.RegisterKernel("vec.L2SquaredDistanceFloat32", &L2SquaredDistanceFloat32)
+	hwy.RegisterKernel("vec.L2SquaredDistanceFloat64", &L2SquaredDistanceFloat64)
+	hwy.RegisterKernel("vec.L2DistanceFloat16", &L2DistanceFloat16)
+	hwy.RegisterKernel("vec.L2DistanceBFloat16", &L2DistanceBFloat16)
+	hwy.RegisterKernel("vec.L2DistanceFloat32", &L2DistanceFloat32)
+	hwy.RegisterKernel("vec.L2DistanceFloat64", &L2DistanceFloat64)
+	hwyKernels := []string{"vec.L2SquaredDistanceFloat16", "vec.L2SquaredDistanceBFloat16", "vec.L2SquaredDistanceFloat32", "vec.L2SquaredDistanceFloat64", "vec.L2DistanceFloat16", "vec.L2DistanceBFloat16", "vec.L2DistanceFloat32", "vec.L2DistanceFloat64"}
+	hwy.RegisterKernelTarget(hwy.DispatchNEON, initDistanceNEON, hwyKernels...)
+	hwy.RegisterKernelTarget(hwy.DispatchScalar, initDistanceFallback, hwyKernels...)
+}
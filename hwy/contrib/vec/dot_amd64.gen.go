@@ -50,11 +50,11 @@ func init() {
 		initDotFallback()
 		return
 	}
-	if archsimd.X86.AVX512() {
+	if archsimd.X86.AVX512() && hwy.TargetEnabled(hwy.DispatchAVX512) {
 		initDotAVX512()
 		return
 	}
-	if archsimd.X86.AVX2() {
+	if archsimd.X86.AVX2() && hwy.TargetEnabled(hwy.DispatchAVX2) {
 		initDotAVX2()
 		return
 	}
@@ -81,3 +81,14 @@ func initDotFallback() {
 	DotFloat32 = BaseDot_fallback
 	DotFloat64 = BaseDot_fallback_Float64
 }
+
+func init() {
+	hwy.RegisterKernel("vec.DotFloat16", &DotFloat16)
+	hwy.RegisterKernel("vec.DotBFloat16", &DotBFloat16)
+	hwy.RegisterKernel("vec.DotFloat32", &DotFloat32)
+	hwy.RegisterKernel("vec.DotFloat64", &DotFloat64)
+	hwyKernels := []string{"vec.DotFloat16", "vec.DotBFloat16", "vec.DotFloat32", "vec.DotFloat64"}
+	hwy.RegisterKernelTarget(hwy.DispatchAVX2, initDotAVX2, hwyKernels...)
+	hwy.RegisterKernelTarget(hwy.DispatchAVX512, initDotAVX512, hwyKernels...)
+	hwy.RegisterKernelTarget(hwy.DispatchScalar, initDotFallback, hwyKernels...)
+}
@@ -65,3 +65,13 @@ func initDotFallback() {
 	DotFloat32 = BaseDot_fallback
 	DotFloat64 = BaseDot_fallback_Float64
 }
+
+func init() {
+	hwy.RegisterKernel("vec.DotFloat16", &DotFloat16)
+	hwy.RegisterKernel("vec.DotBFloat16", &DotBFloat16)
+	hwy.RegisterKernel("vec.DotFloat32", &DotFloat32)
+	hwy.RegisterKernel("vec.DotFloat64", &DotFloat64)
+	hwyKernels := []string{"vec.DotFloat16", "vec.DotBFloat16", "vec.DotFloat32", "vec.DotFloat64"}
+	hwy.RegisterKernelTarget(hwy.DispatchNEON, initDotNEON, hwyKernels...)
+	hwy.RegisterKernelTarget(hwy.DispatchScalar, initDotFallback, hwyKernels...)
+}
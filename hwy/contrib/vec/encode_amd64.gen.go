@@ -20,11 +20,11 @@ func init() {
 		initEncodeFallback()
 		return
 	}
-	if archsimd.X86.AVX512() {
+	if archsimd.X86.AVX512() && hwy.TargetEnabled(hwy.DispatchAVX512) {
 		initEncodeAVX512()
 		return
 	}
-	if archsimd.X86.AVX2() {
+	if archsimd.X86.AVX2() && hwy.TargetEnabled(hwy.DispatchAVX2) {
 		initEncodeAVX2()
 		return
 	}
@@ -51,3 +51,14 @@ func initEncodeFallback() {
 	EncodeFloat64s = BaseEncodeFloat64s_fallback
 	DecodeFloat64s = BaseDecodeFloat64s_fallback
 }
+
+func init() {
+	hwy.RegisterKernel("vec.EncodeFloat32s", &EncodeFloat32s)
+	hwy.RegisterKernel("vec.DecodeFloat32s", &DecodeFloat32s)
+	hwy.RegisterKernel("vec.EncodeFloat64s", &EncodeFloat64s)
+	hwy.RegisterKernel("vec.DecodeFloat64s", &DecodeFloat64s)
+	hwyKernels := []string{"vec.EncodeFloat32s", "vec.DecodeFloat32s", "vec.EncodeFloat64s", "vec.DecodeFloat64s"}
+	hwy.RegisterKernelTarget(hwy.DispatchAVX2, initEncodeAVX2, hwyKernels...)
+	hwy.RegisterKernelTarget(hwy.DispatchAVX512, initEncodeAVX512, hwyKernels...)
+	hwy.RegisterKernelTarget(hwy.DispatchScalar, initEncodeFallback, hwyKernels...)
+}
@@ -16,6 +16,25 @@ func BaseEncodeFloat32s_fallback(dst []byte, src []float32) {
 	}
 	srcBytes := unsafe.Slice((*byte)(unsafe.Pointer(&src[0])), totalBytes)
 	i := 0
+	for ; i+4 <= totalBytes; i += 4 {
+		dst4 := dst[i : i+4 : i+4]
+		{
+			v := srcBytes[i]
+			dst4[0] = v
+		}
+		{
+			v := srcBytes[i+1]
+			dst4[1] = v
+		}
+		{
+			v := srcBytes[i+2]
+			dst4[2] = v
+		}
+		{
+			v := srcBytes[i+3]
+			dst4[3] = v
+		}
+	}
 	for ; i < totalBytes; i++ {
 		v := srcBytes[i]
 		dst[i] = v
@@ -35,6 +54,25 @@ func BaseDecodeFloat32s_fallback(dst []float32, src []byte) {
 	}
 	dstBytes := unsafe.Slice((*byte)(unsafe.Pointer(&dst[0])), totalBytes)
 	i := 0
+	for ; i+4 <= totalBytes; i += 4 {
+		src4 := src[i : i+4 : i+4]
+		{
+			v := src4[0]
+			dstBytes[i] = v
+		}
+		{
+			v := src4[1]
+			dstBytes[i+1] = v
+		}
+		{
+			v := src4[2]
+			dstBytes[i+2] = v
+		}
+		{
+			v := src4[3]
+			dstBytes[i+3] = v
+		}
+	}
 	for ; i < totalBytes; i++ {
 		v := src[i]
 		dstBytes[i] = v
@@ -54,6 +92,25 @@ func BaseEncodeFloat64s_fallback(dst []byte, src []float64) {
 	}
 	srcBytes := unsafe.Slice((*byte)(unsafe.Pointer(&src[0])), totalBytes)
 	i := 0
+	for ; i+4 <= totalBytes; i += 4 {
+		dst4 := dst[i : i+4 : i+4]
+		{
+			v := srcBytes[i]
+			dst4[0] = v
+		}
+		{
+			v := srcBytes[i+1]
+			dst4[1] = v
+		}
+		{
+			v := srcBytes[i+2]
+			dst4[2] = v
+		}
+		{
+			v := srcBytes[i+3]
+			dst4[3] = v
+		}
+	}
 	for ; i < totalBytes; i++ {
 		v := srcBytes[i]
 		dst[i] = v
@@ -73,6 +130,25 @@ func BaseDecodeFloat64s_fallback(dst []float64, src []byte) {
 	}
 	dstBytes := unsafe.Slice((*byte)(unsafe.Pointer(&dst[0])), totalBytes)
 	i := 0
+	for ; i+4 <= totalBytes; i += 4 {
+		src4 := src[i : i+4 : i+4]
+		{
+			v := src4[0]
+			dstBytes[i] = v
+		}
+		{
+			v := src4[1]
+			dstBytes[i+1] = v
+		}
+		{
+			v := src4[2]
+			dstBytes[i+2] = v
+		}
+		{
+			v := src4[3]
+			dstBytes[i+3] = v
+		}
+	}
 	for ; i < totalBytes; i++ {
 		v := src[i]
 		dstBytes[i] = v
@@ -24,3 +24,12 @@ func initEncodeFallback() {
 	EncodeFloat64s = BaseEncodeFloat64s_fallback
 	DecodeFloat64s = BaseDecodeFloat64s_fallback
 }
+
+func init() {
+	hwy.RegisterKernel("vec.EncodeFloat32s", &EncodeFloat32s)
+	hwy.RegisterKernel("vec.DecodeFloat32s", &DecodeFloat32s)
+	hwy.RegisterKernel("vec.EncodeFloat64s", &EncodeFloat64s)
+	hwy.RegisterKernel("vec.DecodeFloat64s", &DecodeFloat64s)
+	hwyKernels := []string{"vec.EncodeFloat32s", "vec.DecodeFloat32s", "vec.EncodeFloat64s", "vec.DecodeFloat64s"}
+	hwy.RegisterKernelTarget(hwy.DispatchScalar, initEncodeFallback, hwyKernels...)
+}
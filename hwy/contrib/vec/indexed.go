@@ -0,0 +1,44 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vec
+
+import "unsafe"
+
+// IndexedL2SquaredDistanceInt8 computes the L2 squared distance from a
+// single int8 query vector to the rows of data selected by ids, like
+// IndexedL2SquaredDistance. The distances are exact for dims < 66051.
+func IndexedL2SquaredDistanceInt8(query, data []int8, ids []int32, distances []int32, dims int) {
+	indexedL2SquaredDistanceInt8(int8Bytes(query), int8Bytes(data), ids, distances, dims)
+}
+
+// IndexedDotInt8 computes the dot product of a single int8 query vector
+// with the rows of data selected by ids, like IndexedDot. The products are
+// exact for dims < 66051.
+func IndexedDotInt8(query, data []int8, ids []int32, dots []int32, dims int) {
+	if dims <= 0 || len(query) < dims {
+		return
+	}
+	// The kernel works on the values biased by 128.
+	querySum := int64(128 * dims)
+	for _, q := range query[:dims] {
+		querySum += int64(q)
+	}
+	indexedDotInt8(int8Bytes(query), int8Bytes(data), ids, dots, querySum, dims)
+}
+
+// int8Bytes reinterprets s as its bytes.
+func int8Bytes(s []int8) []uint8 {
+	return unsafe.Slice((*uint8)(unsafe.Pointer(unsafe.SliceData(s))), len(s))
+}
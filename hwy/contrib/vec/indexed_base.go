@@ -0,0 +1,161 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vec
+
+//go:generate go run ../../../cmd/hwygen -input indexed_base.go -output . -targets avx2,avx512,neon,fallback -dispatch indexed
+
+import "github.com/ajroetker/go-highway/hwy"
+
+// BaseIndexedL2SquaredDistance computes the L2 squared distance from a single
+// query vector to the rows of data selected by ids, the candidate lists of
+// graph (HNSW) and inverted file (IVF) indexes.
+//
+// For each k in [0, len(ids)):
+//
+//	distances[k] = sum((query[j] - data[ids[k]*dims + j])^2 for j in [0, dims))
+//
+// The rows are read in place, without gathering them into a batch first.
+// It returns immediately if dims <= 0 or if query or distances is too short,
+// and panics if a row is outside data.
+func BaseIndexedL2SquaredDistance[T hwy.Floats](query, data []T, ids []int32, distances []T, dims int) {
+	if dims <= 0 || len(query) < dims || len(distances) < len(ids) {
+		return
+	}
+	sum := hwy.Zero[T]()
+	lanes := sum.NumLanes()
+	for k := range len(ids) {
+		row := data[int(ids[k])*dims : (int(ids[k])+1)*dims]
+		sum = hwy.Zero[T]()
+		var j int
+		for j = 0; j+lanes <= dims; j += lanes {
+			diff := hwy.Sub(hwy.Load(query[j:]), hwy.Load(row[j:]))
+			sum = hwy.MulAdd(diff, diff, sum)
+		}
+		result := hwy.ReduceSum(sum)
+		for ; j < dims; j++ {
+			diff := query[j] - row[j]
+			result += diff * diff
+		}
+		distances[k] = result
+	}
+}
+
+// BaseIndexedDot computes the dot product of a single query vector with the
+// rows of data selected by ids, like BaseIndexedL2SquaredDistance:
+//
+//	dots[k] = sum(query[j] * data[ids[k]*dims + j] for j in [0, dims))
+func BaseIndexedDot[T hwy.Floats](query, data []T, ids []int32, dots []T, dims int) {
+	if dims <= 0 || len(query) < dims || len(dots) < len(ids) {
+		return
+	}
+	sum := hwy.Zero[T]()
+	lanes := sum.NumLanes()
+	for k := range len(ids) {
+		row := data[int(ids[k])*dims : (int(ids[k])+1)*dims]
+		sum = hwy.Zero[T]()
+		var j int
+		for j = 0; j+lanes <= dims; j += lanes {
+			sum = hwy.MulAdd(hwy.Load(query[j:]), hwy.Load(row[j:]), sum)
+		}
+		result := hwy.ReduceSum(sum)
+		for ; j < dims; j++ {
+			result += query[j] * row[j]
+		}
+		dots[k] = result
+	}
+}
+
+// baseIndexedL2SquaredDistanceInt8 is BaseIndexedL2SquaredDistance for
+// int8 vectors, passed as their bytes. NEON has no signed 8 or 16-bit
+// vectors, so the bytes are biased to v+128 by flipping their sign bit,
+// which leaves differences unchanged, and widened to 32 bits, where the
+// squares and their sums are exact for dims < 66051.
+func baseIndexedL2SquaredDistanceInt8(query, data []uint8, ids []int32, distances []int32, dims int) {
+	if dims <= 0 || len(query) < dims || len(distances) < len(ids) {
+		return
+	}
+	bias := hwy.Set[uint8](0x80)
+	lanes := hwy.MaxLanes[uint8]()
+	for k := range len(ids) {
+		row := data[int(ids[k])*dims : (int(ids[k])+1)*dims]
+		sum := hwy.Zero[uint32]()
+		var j int
+		for j = 0; j+lanes <= dims; j += lanes {
+			q := hwy.Xor(hwy.Load(query[j:]), bias)
+			d := hwy.Xor(hwy.Load(row[j:]), bias)
+			qlo := hwy.PromoteLowerU8ToU16(q)
+			qhi := hwy.PromoteUpperU8ToU16(q)
+			dlo := hwy.PromoteLowerU8ToU16(d)
+			dhi := hwy.PromoteUpperU8ToU16(d)
+			d0 := hwy.Sub(hwy.PromoteLowerU16ToU32(qlo), hwy.PromoteLowerU16ToU32(dlo))
+			d1 := hwy.Sub(hwy.PromoteUpperU16ToU32(qlo), hwy.PromoteUpperU16ToU32(dlo))
+			d2 := hwy.Sub(hwy.PromoteLowerU16ToU32(qhi), hwy.PromoteLowerU16ToU32(dhi))
+			d3 := hwy.Sub(hwy.PromoteUpperU16ToU32(qhi), hwy.PromoteUpperU16ToU32(dhi))
+			sum = hwy.Add(sum, hwy.Add(hwy.Mul(d0, d0), hwy.Mul(d1, d1)))
+			sum = hwy.Add(sum, hwy.Add(hwy.Mul(d2, d2), hwy.Mul(d3, d3)))
+		}
+		result := int32(hwy.ReduceSum(sum))
+		for ; j < dims; j++ {
+			diff := int32(int8(query[j])) - int32(int8(row[j]))
+			result += diff * diff
+		}
+		distances[k] = result
+	}
+}
+
+// baseIndexedDotInt8 is BaseIndexedDot for int8 vectors, passed as their
+// bytes and biased like in baseIndexedL2SquaredDistanceInt8. With q and d
+// the biased values,
+//
+//	sum((q-128) * (d-128)) = sum(q*d) - 128*sum(q) - 128*sum(d) + 16384*dims
+//
+// querySum is sum(q); the products and the sums of d are accumulated in
+// 32 bits, exact for dims < 66051.
+func baseIndexedDotInt8(query, data []uint8, ids []int32, dots []int32, querySum int64, dims int) {
+	if dims <= 0 || len(query) < dims || len(dots) < len(ids) {
+		return
+	}
+	bias := hwy.Set[uint8](0x80)
+	lanes := hwy.MaxLanes[uint8]()
+	for k := range len(ids) {
+		row := data[int(ids[k])*dims : (int(ids[k])+1)*dims]
+		prods := hwy.Zero[uint32]()
+		sums := hwy.Zero[uint32]()
+		var j int
+		for j = 0; j+lanes <= dims; j += lanes {
+			q := hwy.Xor(hwy.Load(query[j:]), bias)
+			d := hwy.Xor(hwy.Load(row[j:]), bias)
+			qlo := hwy.PromoteLowerU8ToU16(q)
+			qhi := hwy.PromoteUpperU8ToU16(q)
+			dlo := hwy.PromoteLowerU8ToU16(d)
+			dhi := hwy.PromoteUpperU8ToU16(d)
+			d0 := hwy.PromoteLowerU16ToU32(dlo)
+			d1 := hwy.PromoteUpperU16ToU32(dlo)
+			d2 := hwy.PromoteLowerU16ToU32(dhi)
+			d3 := hwy.PromoteUpperU16ToU32(dhi)
+			p01 := hwy.Add(hwy.Mul(hwy.PromoteLowerU16ToU32(qlo), d0), hwy.Mul(hwy.PromoteUpperU16ToU32(qlo), d1))
+			p23 := hwy.Add(hwy.Mul(hwy.PromoteLowerU16ToU32(qhi), d2), hwy.Mul(hwy.PromoteUpperU16ToU32(qhi), d3))
+			prods = hwy.Add(prods, hwy.Add(p01, p23))
+			sums = hwy.Add(sums, hwy.Add(hwy.Add(d0, d1), hwy.Add(d2, d3)))
+		}
+		dotSum := int64(hwy.ReduceSum(prods))
+		rowSum := int64(hwy.ReduceSum(sums))
+		for ; j < dims; j++ {
+			dotSum += int64(query[j]^0x80) * int64(row[j]^0x80)
+			rowSum += int64(row[j] ^ 0x80)
+		}
+		dots[k] = int32(dotSum - 128*querySum - 128*rowSum + 16384*int64(dims))
+	}
+}
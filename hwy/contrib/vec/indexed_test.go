@@ -0,0 +1,114 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vec
+
+import (
+	"math"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/ajroetker/go-highway/hwy"
+)
+
+func TestIndexedDistances(t *testing.T) {
+	r := rand.New(rand.NewPCG(1, 2))
+	for _, dims := range []int{1, 3, 8, 17, 64, 100} {
+		const rows = 40
+		query := make([]float32, dims)
+		data := make([]float32, rows*dims)
+		for i := range query {
+			query[i] = float32(r.NormFloat64())
+		}
+		for i := range data {
+			data[i] = float32(r.NormFloat64())
+		}
+		ids := []int32{5, 0, 39, 5, 12, 7, 33}
+		distances := make([]float32, len(ids))
+		dots := make([]float32, len(ids))
+		IndexedL2SquaredDistance(query, data, ids, distances, dims)
+		IndexedDot(query, data, ids, dots, dims)
+
+		query16 := make([]hwy.Float16, dims)
+		data16 := make([]hwy.Float16, len(data))
+		for i, x := range query {
+			query16[i] = hwy.Float32ToFloat16(x)
+		}
+		for i, x := range data {
+			data16[i] = hwy.Float32ToFloat16(x)
+		}
+		distances16 := make([]hwy.Float16, len(ids))
+		IndexedL2SquaredDistance(query16, data16, ids, distances16, dims)
+
+		for k, id := range ids {
+			row := data[int(id)*dims : (int(id)+1)*dims]
+			var l2, dot float64
+			for j := range dims {
+				d := float64(query[j]) - float64(row[j])
+				l2 += d * d
+				dot += float64(query[j]) * float64(row[j])
+			}
+			if math.Abs(float64(distances[k])-l2) > 1e-4*max(1, l2) {
+				t.Errorf("dims %d: distances[%d] = %g, want %g", dims, k, distances[k], l2)
+			}
+			if math.Abs(float64(dots[k])-dot) > 1e-4*max(1, math.Abs(dot)) {
+				t.Errorf("dims %d: dots[%d] = %g, want %g", dims, k, dots[k], dot)
+			}
+			if got := float64(distances16[k].Float32()); math.Abs(got-l2) > 2e-2*max(1, l2) {
+				t.Errorf("dims %d: Float16 distances[%d] = %g, want %g", dims, k, got, l2)
+			}
+		}
+	}
+}
+
+func TestIndexedDistancesInt8(t *testing.T) {
+	r := rand.New(rand.NewPCG(3, 4))
+	for _, dims := range []int{1, 15, 16, 33, 128, 1000} {
+		const rows = 20
+		query := make([]int8, dims)
+		data := make([]int8, rows*dims)
+		for i := range query {
+			query[i] = int8(r.IntN(256) - 128)
+		}
+		for i := range data {
+			data[i] = int8(r.IntN(256) - 128)
+		}
+		// Extreme rows, where biasing and widening matter most.
+		for j := range dims {
+			data[j] = -128
+			data[dims+j] = 127
+			query[j%len(query)] = []int8{-128, 127}[j%2]
+		}
+		ids := []int32{0, 1, 19, 2, 0, 11}
+		distances := make([]int32, len(ids))
+		dots := make([]int32, len(ids))
+		IndexedL2SquaredDistanceInt8(query, data, ids, distances, dims)
+		IndexedDotInt8(query, data, ids, dots, dims)
+		for k, id := range ids {
+			row := data[int(id)*dims : (int(id)+1)*dims]
+			var l2, dot int32
+			for j := range dims {
+				d := int32(query[j]) - int32(row[j])
+				l2 += d * d
+				dot += int32(query[j]) * int32(row[j])
+			}
+			if distances[k] != l2 {
+				t.Errorf("dims %d: distances[%d] = %d, want %d", dims, k, distances[k], l2)
+			}
+			if dots[k] != dot {
+				t.Errorf("dims %d: dots[%d] = %d, want %d", dims, k, dots[k], dot)
+			}
+		}
+	}
+}
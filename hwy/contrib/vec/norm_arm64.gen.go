@@ -105,3 +105,17 @@ func initNormFallback() {
 	NormFloat32 = BaseNorm_fallback
 	NormFloat64 = BaseNorm_fallback_Float64
 }
+
+func init() {
+	hwy.RegisterKernel("vec.SquaredNormFloat16", &SquaredNormFloat16)
+	hwy.RegisterKernel("vec.SquaredNormBFloat16", &SquaredNormBFloat16)
+	hwy.RegisterKernel("vec.SquaredNormFloat32", &SquaredNormFloat32)
+	hwy.RegisterKernel("vec.SquaredNormFloat64", &SquaredNormFloat64)
+	hwy.RegisterKernel("vec.NormFloat16", &NormFloat16)
+	hwy.RegisterKernel("vec.NormBFloat16", &NormBFloat16)
+	hwy.RegisterKernel("vec.NormFloat32", &NormFloat32)
+	hwy.RegisterKernel("vec.NormFloat64", &NormFloat64)
+	hwyKernels := []string{"vec.SquaredNormFloat16", "vec.SquaredNormBFloat16", "vec.SquaredNormFloat32", "vec.SquaredNormFloat64", "vec.NormFloat16", "vec.NormBFloat16", "vec.NormFloat32", "vec.NormFloat64"}
+	hwy.RegisterKernelTarget(hwy.DispatchNEON, initNormNEON, hwyKernels...)
+	hwy.RegisterKernelTarget(hwy.DispatchScalar, initNormFallback, hwyKernels...)
+}
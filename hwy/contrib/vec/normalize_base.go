@@ -122,3 +122,80 @@ func BaseNormalizeTo[T hwy.Floats](dst, src []T) {
 		dst[i] = src[i] * scale
 	}
 }
+
+// BaseNormalizeRowsL2 normalizes each row of the row-major [rows, cols]
+// matrix m in-place to unit L2 norm, the usual preprocessing before
+// cosine-similarity search over embeddings.
+//
+// If norms is non-nil, it must hold at least rows elements and receives the
+// L2 norm of each row before normalization. Rows with zero norm are left
+// unchanged and get a norm of 0.
+//
+// The reciprocal norms are computed for a whole vector of rows at once with
+// the hardware reciprocal square root estimate followed by Newton-Raphson
+// refinement, instead of a square root and division per row.
+//
+// Example:
+//
+//	m := []float32{3, 4, 0, 2}
+//	norms := make([]float32, 2)
+//	BaseNormalizeRowsL2(m, 2, 2, norms)  // m is [0.6, 0.8, 0, 1], norms is [5, 2]
+func BaseNormalizeRowsL2[T hwy.FloatsNative](m []T, rows, cols int, norms []T) {
+	if rows == 0 || cols == 0 {
+		return
+	}
+	if len(m) < rows*cols {
+		panic("normalize: matrix slice too short")
+	}
+	if norms != nil && len(norms) < rows {
+		panic("normalize: norms slice too short")
+	}
+	if norms == nil {
+		norms = make([]T, rows)
+	}
+	inv := make([]T, rows)
+
+	for r := range rows {
+		norms[r] = BaseSquaredNorm(m[r*cols : (r+1)*cols])
+	}
+
+	// inv = 1/sqrt(x) and norm = x/sqrt(x), both zeroed for zero rows.
+	// RSqrtNewtonRaphson refines the estimate once; the second step below
+	// brings float32 to full precision.
+	vZero := hwy.Zero[T]()
+	vHalf := hwy.Set(T(0.5))
+	vThreeHalf := hwy.Set(T(1.5))
+	lanes := vZero.NumLanes()
+	var i int
+	for i = 0; i+lanes <= rows; i += lanes {
+		x := hwy.Load(norms[i:])
+		y := hwy.RSqrtNewtonRaphson(x)
+		y = hwy.Mul(y, hwy.Sub(vThreeHalf, hwy.Mul(hwy.Mul(vHalf, x), hwy.Mul(y, y))))
+		nonZero := hwy.Greater(x, vZero)
+		hwy.Store(hwy.Merge(y, vZero, nonZero), inv[i:])
+		hwy.Store(hwy.Merge(hwy.Mul(x, y), vZero, nonZero), norms[i:])
+	}
+	for ; i < rows; i++ {
+		if norms[i] > 0 {
+			norm := T(stdmath.Sqrt(float64(norms[i])))
+			inv[i] = 1 / norm
+			norms[i] = norm
+		}
+	}
+
+	for r := range rows {
+		if inv[r] == 0 {
+			continue
+		}
+		scale := hwy.Set(inv[r])
+		off := r * cols
+		end := off + cols
+		var j int
+		for j = off; j+lanes <= end; j += lanes {
+			hwy.Store(hwy.Mul(hwy.Load(m[j:]), scale), m[j:])
+		}
+		for ; j < end; j++ {
+			m[j] *= inv[r]
+		}
+	}
+}
@@ -13,6 +13,14 @@ import (
 	"github.com/ajroetker/go-highway/hwy/asm"
 )
 
+// Hoisted constants - pre-broadcasted at package init time
+var (
+	BaseNormalizeRowsL2_AVX2_vHalf_f32      = archsimd.BroadcastFloat32x8(float32(0.5))
+	BaseNormalizeRowsL2_AVX2_vHalf_f64      = archsimd.BroadcastFloat64x4(float64(0.5))
+	BaseNormalizeRowsL2_AVX2_vThreeHalf_f32 = archsimd.BroadcastFloat32x8(float32(1.5))
+	BaseNormalizeRowsL2_AVX2_vThreeHalf_f64 = archsimd.BroadcastFloat64x4(float64(1.5))
+)
+
 func BaseNormalize_avx2_Float16(dst []hwy.Float16) {
 	if len(dst) == 0 {
 		return
@@ -236,3 +244,125 @@ func BaseNormalizeTo_avx2_Float64(dst []float64, src []float64) {
 		dst[i] = src[i] * scale
 	}
 }
+
+func BaseNormalizeRowsL2_avx2(m []float32, rows int, cols int, norms []float32) {
+	if rows == 0 || cols == 0 {
+		return
+	}
+	if len(m) < rows*cols {
+		panic("normalize: matrix slice too short")
+	}
+	if norms != nil && len(norms) < rows {
+		panic("normalize: norms slice too short")
+	}
+	if norms == nil {
+		norms = make([]float32, rows)
+	}
+	inv := make([]float32, rows)
+	for r := range rows {
+		norms[r] = BaseSquaredNorm_avx2(m[r*cols : (r+1)*cols])
+	}
+	vZero := archsimd.BroadcastFloat32x8(0)
+	vHalf := BaseNormalizeRowsL2_AVX2_vHalf_f32
+	vThreeHalf := BaseNormalizeRowsL2_AVX2_vThreeHalf_f32
+	lanes := 8
+	var i int
+	i = 0
+	for ; i+lanes*2 <= rows; i += lanes * 2 {
+		x := archsimd.LoadFloat32x8((*[8]float32)(unsafe.Pointer(&norms[i])))
+		y := hwy.RSqrtNewtonRaphson_AVX2_F32x8(x)
+		y = y.Mul(vThreeHalf.Sub(vHalf.Mul(x).Mul(y.Mul(y))))
+		nonZero := x.Greater(vZero)
+		y.Merge(vZero, nonZero).Store((*[8]float32)(unsafe.Pointer(&inv[i])))
+		x.Mul(y).Merge(vZero, nonZero).Store((*[8]float32)(unsafe.Pointer(&norms[i])))
+		x1 := archsimd.LoadFloat32x8((*[8]float32)(unsafe.Pointer(&norms[i+8])))
+		y1 := hwy.RSqrtNewtonRaphson_AVX2_F32x8(x1)
+		y1 = y1.Mul(vThreeHalf.Sub(vHalf.Mul(x1).Mul(y1.Mul(y1))))
+		nonZero1 := x1.Greater(vZero)
+		y1.Merge(vZero, nonZero1).Store((*[8]float32)(unsafe.Pointer(&inv[i+8])))
+		x1.Mul(y1).Merge(vZero, nonZero1).Store((*[8]float32)(unsafe.Pointer(&norms[i+8])))
+	}
+	for ; i < rows; i++ {
+		if norms[i] > 0 {
+			norm := float32(stdmath.Sqrt(float64(norms[i])))
+			inv[i] = 1 / norm
+			norms[i] = norm
+		}
+	}
+	for r := range rows {
+		if inv[r] == 0 {
+			continue
+		}
+		scale := archsimd.BroadcastFloat32x8(inv[r])
+		off := r * cols
+		end := off + cols
+		var j int
+		for j = off; j+lanes <= end; j += lanes {
+			archsimd.LoadFloat32x8((*[8]float32)(unsafe.Pointer(&m[j]))).Mul(scale).Store((*[8]float32)(unsafe.Pointer(&m[j])))
+		}
+		for ; j < end; j++ {
+			m[j] *= inv[r]
+		}
+	}
+}
+
+func BaseNormalizeRowsL2_avx2_Float64(m []float64, rows int, cols int, norms []float64) {
+	if rows == 0 || cols == 0 {
+		return
+	}
+	if len(m) < rows*cols {
+		panic("normalize: matrix slice too short")
+	}
+	if norms != nil && len(norms) < rows {
+		panic("normalize: norms slice too short")
+	}
+	if norms == nil {
+		norms = make([]float64, rows)
+	}
+	inv := make([]float64, rows)
+	for r := range rows {
+		norms[r] = BaseSquaredNorm_avx2_Float64(m[r*cols : (r+1)*cols])
+	}
+	vZero := archsimd.BroadcastFloat64x4(0)
+	vHalf := BaseNormalizeRowsL2_AVX2_vHalf_f64
+	vThreeHalf := BaseNormalizeRowsL2_AVX2_vThreeHalf_f64
+	lanes := 4
+	var i int
+	i = 0
+	for ; i+lanes*2 <= rows; i += lanes * 2 {
+		x := archsimd.LoadFloat64x4((*[4]float64)(unsafe.Pointer(&norms[i])))
+		y := hwy.RSqrtNewtonRaphson_AVX2_F64x4(x)
+		y = y.Mul(vThreeHalf.Sub(vHalf.Mul(x).Mul(y.Mul(y))))
+		nonZero := x.Greater(vZero)
+		y.Merge(vZero, nonZero).Store((*[4]float64)(unsafe.Pointer(&inv[i])))
+		x.Mul(y).Merge(vZero, nonZero).Store((*[4]float64)(unsafe.Pointer(&norms[i])))
+		x1 := archsimd.LoadFloat64x4((*[4]float64)(unsafe.Pointer(&norms[i+4])))
+		y1 := hwy.RSqrtNewtonRaphson_AVX2_F64x4(x1)
+		y1 = y1.Mul(vThreeHalf.Sub(vHalf.Mul(x1).Mul(y1.Mul(y1))))
+		nonZero1 := x1.Greater(vZero)
+		y1.Merge(vZero, nonZero1).Store((*[4]float64)(unsafe.Pointer(&inv[i+4])))
+		x1.Mul(y1).Merge(vZero, nonZero1).Store((*[4]float64)(unsafe.Pointer(&norms[i+4])))
+	}
+	for ; i < rows; i++ {
+		if norms[i] > 0 {
+			norm := float64(stdmath.Sqrt(float64(norms[i])))
+			inv[i] = 1 / norm
+			norms[i] = norm
+		}
+	}
+	for r := range rows {
+		if inv[r] == 0 {
+			continue
+		}
+		scale := archsimd.BroadcastFloat64x4(inv[r])
+		off := r * cols
+		end := off + cols
+		var j int
+		for j = off; j+lanes <= end; j += lanes {
+			archsimd.LoadFloat64x4((*[4]float64)(unsafe.Pointer(&m[j]))).Mul(scale).Store((*[4]float64)(unsafe.Pointer(&m[j])))
+		}
+		for ; j < end; j++ {
+			m[j] *= inv[r]
+		}
+	}
+}
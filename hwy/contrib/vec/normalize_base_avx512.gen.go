@@ -7,13 +7,33 @@ package vec
 import (
 	stdmath "math"
 	"simd/archsimd"
+	"sync"
 	"unsafe"
 
 	"github.com/ajroetker/go-highway/hwy"
 	"github.com/ajroetker/go-highway/hwy/asm"
 )
 
+// Hoisted constants - lazily initialized on first use to avoid init-time crashes
+var (
+	BaseNormalizeRowsL2_AVX512_vHalf_f32      archsimd.Float32x16
+	BaseNormalizeRowsL2_AVX512_vHalf_f64      archsimd.Float64x8
+	BaseNormalizeRowsL2_AVX512_vThreeHalf_f32 archsimd.Float32x16
+	BaseNormalizeRowsL2_AVX512_vThreeHalf_f64 archsimd.Float64x8
+	_normalizeBaseHoistOnce                   sync.Once
+)
+
+func _normalizeBaseInitHoistedConstants() {
+	_normalizeBaseHoistOnce.Do(func() {
+		BaseNormalizeRowsL2_AVX512_vHalf_f32 = archsimd.BroadcastFloat32x16(float32(0.5))
+		BaseNormalizeRowsL2_AVX512_vHalf_f64 = archsimd.BroadcastFloat64x8(float64(0.5))
+		BaseNormalizeRowsL2_AVX512_vThreeHalf_f32 = archsimd.BroadcastFloat32x16(float32(1.5))
+		BaseNormalizeRowsL2_AVX512_vThreeHalf_f64 = archsimd.BroadcastFloat64x8(float64(1.5))
+	})
+}
+
 func BaseNormalize_avx512_Float16(dst []hwy.Float16) {
+	_normalizeBaseInitHoistedConstants()
 	if len(dst) == 0 {
 		return
 	}
@@ -41,6 +61,7 @@ func BaseNormalize_avx512_Float16(dst []hwy.Float16) {
 }
 
 func BaseNormalize_avx512_BFloat16(dst []hwy.BFloat16) {
+	_normalizeBaseInitHoistedConstants()
 	if len(dst) == 0 {
 		return
 	}
@@ -68,6 +89,7 @@ func BaseNormalize_avx512_BFloat16(dst []hwy.BFloat16) {
 }
 
 func BaseNormalize_avx512(dst []float32) {
+	_normalizeBaseInitHoistedConstants()
 	if len(dst) == 0 {
 		return
 	}
@@ -95,6 +117,7 @@ func BaseNormalize_avx512(dst []float32) {
 }
 
 func BaseNormalize_avx512_Float64(dst []float64) {
+	_normalizeBaseInitHoistedConstants()
 	if len(dst) == 0 {
 		return
 	}
@@ -122,6 +145,7 @@ func BaseNormalize_avx512_Float64(dst []float64) {
 }
 
 func BaseNormalizeTo_avx512_Float16(dst []hwy.Float16, src []hwy.Float16) {
+	_normalizeBaseInitHoistedConstants()
 	n := min(len(dst), len(src))
 	if n == 0 {
 		return
@@ -151,6 +175,7 @@ func BaseNormalizeTo_avx512_Float16(dst []hwy.Float16, src []hwy.Float16) {
 }
 
 func BaseNormalizeTo_avx512_BFloat16(dst []hwy.BFloat16, src []hwy.BFloat16) {
+	_normalizeBaseInitHoistedConstants()
 	n := min(len(dst), len(src))
 	if n == 0 {
 		return
@@ -180,6 +205,7 @@ func BaseNormalizeTo_avx512_BFloat16(dst []hwy.BFloat16, src []hwy.BFloat16) {
 }
 
 func BaseNormalizeTo_avx512(dst []float32, src []float32) {
+	_normalizeBaseInitHoistedConstants()
 	n := min(len(dst), len(src))
 	if n == 0 {
 		return
@@ -209,6 +235,7 @@ func BaseNormalizeTo_avx512(dst []float32, src []float32) {
 }
 
 func BaseNormalizeTo_avx512_Float64(dst []float64, src []float64) {
+	_normalizeBaseInitHoistedConstants()
 	n := min(len(dst), len(src))
 	if n == 0 {
 		return
@@ -236,3 +263,127 @@ func BaseNormalizeTo_avx512_Float64(dst []float64, src []float64) {
 		dst[i] = src[i] * scale
 	}
 }
+
+func BaseNormalizeRowsL2_avx512(m []float32, rows int, cols int, norms []float32) {
+	_normalizeBaseInitHoistedConstants()
+	if rows == 0 || cols == 0 {
+		return
+	}
+	if len(m) < rows*cols {
+		panic("normalize: matrix slice too short")
+	}
+	if norms != nil && len(norms) < rows {
+		panic("normalize: norms slice too short")
+	}
+	if norms == nil {
+		norms = make([]float32, rows)
+	}
+	inv := make([]float32, rows)
+	for r := range rows {
+		norms[r] = BaseSquaredNorm_avx512(m[r*cols : (r+1)*cols])
+	}
+	vZero := archsimd.BroadcastFloat32x16(0)
+	vHalf := BaseNormalizeRowsL2_AVX512_vHalf_f32
+	vThreeHalf := BaseNormalizeRowsL2_AVX512_vThreeHalf_f32
+	lanes := 16
+	var i int
+	i = 0
+	for ; i+lanes*2 <= rows; i += lanes * 2 {
+		x := archsimd.LoadFloat32x16((*[16]float32)(unsafe.Pointer(&norms[i])))
+		y := hwy.RSqrtNewtonRaphson_AVX512_F32x16(x)
+		y = y.Mul(vThreeHalf.Sub(vHalf.Mul(x).Mul(y.Mul(y))))
+		nonZero := x.Greater(vZero)
+		y.Merge(vZero, nonZero).Store((*[16]float32)(unsafe.Pointer(&inv[i])))
+		x.Mul(y).Merge(vZero, nonZero).Store((*[16]float32)(unsafe.Pointer(&norms[i])))
+		x1 := archsimd.LoadFloat32x16((*[16]float32)(unsafe.Pointer(&norms[i+16])))
+		y1 := hwy.RSqrtNewtonRaphson_AVX512_F32x16(x1)
+		y1 = y1.Mul(vThreeHalf.Sub(vHalf.Mul(x1).Mul(y1.Mul(y1))))
+		nonZero1 := x1.Greater(vZero)
+		y1.Merge(vZero, nonZero1).Store((*[16]float32)(unsafe.Pointer(&inv[i+16])))
+		x1.Mul(y1).Merge(vZero, nonZero1).Store((*[16]float32)(unsafe.Pointer(&norms[i+16])))
+	}
+	for ; i < rows; i++ {
+		if norms[i] > 0 {
+			norm := float32(stdmath.Sqrt(float64(norms[i])))
+			inv[i] = 1 / norm
+			norms[i] = norm
+		}
+	}
+	for r := range rows {
+		if inv[r] == 0 {
+			continue
+		}
+		scale := archsimd.BroadcastFloat32x16(inv[r])
+		off := r * cols
+		end := off + cols
+		var j int
+		for j = off; j+lanes <= end; j += lanes {
+			archsimd.LoadFloat32x16((*[16]float32)(unsafe.Pointer(&m[j]))).Mul(scale).Store((*[16]float32)(unsafe.Pointer(&m[j])))
+		}
+		for ; j < end; j++ {
+			m[j] *= inv[r]
+		}
+	}
+}
+
+func BaseNormalizeRowsL2_avx512_Float64(m []float64, rows int, cols int, norms []float64) {
+	_normalizeBaseInitHoistedConstants()
+	if rows == 0 || cols == 0 {
+		return
+	}
+	if len(m) < rows*cols {
+		panic("normalize: matrix slice too short")
+	}
+	if norms != nil && len(norms) < rows {
+		panic("normalize: norms slice too short")
+	}
+	if norms == nil {
+		norms = make([]float64, rows)
+	}
+	inv := make([]float64, rows)
+	for r := range rows {
+		norms[r] = BaseSquaredNorm_avx512_Float64(m[r*cols : (r+1)*cols])
+	}
+	vZero := archsimd.BroadcastFloat64x8(0)
+	vHalf := BaseNormalizeRowsL2_AVX512_vHalf_f64
+	vThreeHalf := BaseNormalizeRowsL2_AVX512_vThreeHalf_f64
+	lanes := 8
+	var i int
+	i = 0
+	for ; i+lanes*2 <= rows; i += lanes * 2 {
+		x := archsimd.LoadFloat64x8((*[8]float64)(unsafe.Pointer(&norms[i])))
+		y := hwy.RSqrtNewtonRaphson_AVX512_F64x8(x)
+		y = y.Mul(vThreeHalf.Sub(vHalf.Mul(x).Mul(y.Mul(y))))
+		nonZero := x.Greater(vZero)
+		y.Merge(vZero, nonZero).Store((*[8]float64)(unsafe.Pointer(&inv[i])))
+		x.Mul(y).Merge(vZero, nonZero).Store((*[8]float64)(unsafe.Pointer(&norms[i])))
+		x1 := archsimd.LoadFloat64x8((*[8]float64)(unsafe.Pointer(&norms[i+8])))
+		y1 := hwy.RSqrtNewtonRaphson_AVX512_F64x8(x1)
+		y1 = y1.Mul(vThreeHalf.Sub(vHalf.Mul(x1).Mul(y1.Mul(y1))))
+		nonZero1 := x1.Greater(vZero)
+		y1.Merge(vZero, nonZero1).Store((*[8]float64)(unsafe.Pointer(&inv[i+8])))
+		x1.Mul(y1).Merge(vZero, nonZero1).Store((*[8]float64)(unsafe.Pointer(&norms[i+8])))
+	}
+	for ; i < rows; i++ {
+		if norms[i] > 0 {
+			norm := float64(stdmath.Sqrt(float64(norms[i])))
+			inv[i] = 1 / norm
+			norms[i] = norm
+		}
+	}
+	for r := range rows {
+		if inv[r] == 0 {
+			continue
+		}
+		scale := archsimd.BroadcastFloat64x8(inv[r])
+		off := r * cols
+		end := off + cols
+		var j int
+		for j = off; j+lanes <= end; j += lanes {
+			archsimd.LoadFloat64x8((*[8]float64)(unsafe.Pointer(&m[j]))).Mul(scale).Store((*[8]float64)(unsafe.Pointer(&m[j])))
+		}
+		for ; j < end; j++ {
+			m[j] *= inv[r]
+		}
+	}
+}
@@ -66,7 +66,30 @@ func BaseNormalize_fallback(dst []float32) {
 	scale := float32(1) / norm
 	scaleVec := float32(scale)
 	var i int
-	for i = 0; i < len(dst); i++ {
+	for i = 0; i+4 <= len(dst); i += 4 {
+		dst4 := dst[i : i+4 : i+4]
+		{
+			vec := dst4[0]
+			result := vec * scaleVec
+			dst4[0] = result
+		}
+		{
+			vec := dst4[1]
+			result := vec * scaleVec
+			dst4[1] = result
+		}
+		{
+			vec := dst4[2]
+			result := vec * scaleVec
+			dst4[2] = result
+		}
+		{
+			vec := dst4[3]
+			result := vec * scaleVec
+			dst4[3] = result
+		}
+	}
+	for ; i < len(dst); i++ {
 		vec := dst[i]
 		result := vec * scaleVec
 		dst[i] = result
@@ -88,7 +111,30 @@ func BaseNormalize_fallback_Float64(dst []float64) {
 	scale := float64(1) / norm
 	scaleVec := float64(scale)
 	var i int
-	for i = 0; i < len(dst); i++ {
+	for i = 0; i+4 <= len(dst); i += 4 {
+		dst4 := dst[i : i+4 : i+4]
+		{
+			vec := dst4[0]
+			result := vec * scaleVec
+			dst4[0] = result
+		}
+		{
+			vec := dst4[1]
+			result := vec * scaleVec
+			dst4[1] = result
+		}
+		{
+			vec := dst4[2]
+			result := vec * scaleVec
+			dst4[2] = result
+		}
+		{
+			vec := dst4[3]
+			result := vec * scaleVec
+			dst4[3] = result
+		}
+	}
+	for ; i < len(dst); i++ {
 		vec := dst[i]
 		result := vec * scaleVec
 		dst[i] = result
@@ -162,7 +208,31 @@ func BaseNormalizeTo_fallback(dst []float32, src []float32) {
 	scale := float32(1) / norm
 	scaleVec := float32(scale)
 	var i int
-	for i = 0; i < n; i++ {
+	for i = 0; i+4 <= n; i += 4 {
+		src4 := src[i : i+4 : i+4]
+		dst4 := dst[i : i+4 : i+4]
+		{
+			vec := src4[0]
+			result := vec * scaleVec
+			dst4[0] = result
+		}
+		{
+			vec := src4[1]
+			result := vec * scaleVec
+			dst4[1] = result
+		}
+		{
+			vec := src4[2]
+			result := vec * scaleVec
+			dst4[2] = result
+		}
+		{
+			vec := src4[3]
+			result := vec * scaleVec
+			dst4[3] = result
+		}
+	}
+	for ; i < n; i++ {
 		vec := src[i]
 		result := vec * scaleVec
 		dst[i] = result
@@ -186,7 +256,31 @@ func BaseNormalizeTo_fallback_Float64(dst []float64, src []float64) {
 	scale := float64(1) / norm
 	scaleVec := float64(scale)
 	var i int
-	for i = 0; i < n; i++ {
+	for i = 0; i+4 <= n; i += 4 {
+		src4 := src[i : i+4 : i+4]
+		dst4 := dst[i : i+4 : i+4]
+		{
+			vec := src4[0]
+			result := vec * scaleVec
+			dst4[0] = result
+		}
+		{
+			vec := src4[1]
+			result := vec * scaleVec
+			dst4[1] = result
+		}
+		{
+			vec := src4[2]
+			result := vec * scaleVec
+			dst4[2] = result
+		}
+		{
+			vec := src4[3]
+			result := vec * scaleVec
+			dst4[3] = result
+		}
+	}
+	for ; i < n; i++ {
 		vec := src[i]
 		result := vec * scaleVec
 		dst[i] = result
@@ -195,3 +289,111 @@ func BaseNormalizeTo_fallback_Float64(dst []float64, src []float64) {
 		dst[i] = src[i] * scale
 	}
 }
+
+func BaseNormalizeRowsL2_fallback(m []float32, rows int, cols int, norms []float32) {
+	if rows == 0 || cols == 0 {
+		return
+	}
+	if len(m) < rows*cols {
+		panic("normalize: matrix slice too short")
+	}
+	if norms != nil && len(norms) < rows {
+		panic("normalize: norms slice too short")
+	}
+	if norms == nil {
+		norms = make([]float32, rows)
+	}
+	inv := make([]float32, rows)
+	for r := range rows {
+		norms[r] = BaseSquaredNorm_fallback(m[r*cols : (r+1)*cols])
+	}
+	vZero := hwy.Zero[float32]()
+	vHalf := hwy.Set(float32(0.5))
+	vThreeHalf := hwy.Set(float32(1.5))
+	lanes := vZero.NumLanes()
+	var i int
+	for i = 0; i+lanes <= rows; i += lanes {
+		x := hwy.Load(norms[i:])
+		y := hwy.RSqrtNewtonRaphson(x)
+		y = hwy.Mul(y, hwy.Sub(vThreeHalf, hwy.Mul(hwy.Mul(vHalf, x), hwy.Mul(y, y))))
+		nonZero := hwy.Greater(x, vZero)
+		hwy.Store(hwy.Merge(y, vZero, nonZero), inv[i:])
+		hwy.Store(hwy.Merge(hwy.Mul(x, y), vZero, nonZero), norms[i:])
+	}
+	for ; i < rows; i++ {
+		if norms[i] > 0 {
+			norm := float32(stdmath.Sqrt(float64(norms[i])))
+			inv[i] = 1 / norm
+			norms[i] = norm
+		}
+	}
+	for r := range rows {
+		if inv[r] == 0 {
+			continue
+		}
+		scale := hwy.Set(inv[r])
+		off := r * cols
+		end := off + cols
+		var j int
+		for j = off; j+lanes <= end; j += lanes {
+			hwy.Store(hwy.Mul(hwy.Load(m[j:]), scale), m[j:])
+		}
+		for ; j < end; j++ {
+			m[j] *= inv[r]
+		}
+	}
+}
+
+func BaseNormalizeRowsL2_fallback_Float64(m []float64, rows int, cols int, norms []float64) {
+	if rows == 0 || cols == 0 {
+		return
+	}
+	if len(m) < rows*cols {
+		panic("normalize: matrix slice too short")
+	}
+	if norms != nil && len(norms) < rows {
+		panic("normalize: norms slice too short")
+	}
+	if norms == nil {
+		norms = make([]float64, rows)
+	}
+	inv := make([]float64, rows)
+	for r := range rows {
+		norms[r] = BaseSquaredNorm_fallback_Float64(m[r*cols : (r+1)*cols])
+	}
+	vZero := hwy.Zero[float64]()
+	vHalf := hwy.Set(float64(0.5))
+	vThreeHalf := hwy.Set(float64(1.5))
+	lanes := vZero.NumLanes()
+	var i int
+	for i = 0; i+lanes <= rows; i += lanes {
+		x := hwy.Load(norms[i:])
+		y := hwy.RSqrtNewtonRaphson(x)
+		y = hwy.Mul(y, hwy.Sub(vThreeHalf, hwy.Mul(hwy.Mul(vHalf, x), hwy.Mul(y, y))))
+		nonZero := hwy.Greater(x, vZero)
+		hwy.Store(hwy.Merge(y, vZero, nonZero), inv[i:])
+		hwy.Store(hwy.Merge(hwy.Mul(x, y), vZero, nonZero), norms[i:])
+	}
+	for ; i < rows; i++ {
+		if norms[i] > 0 {
+			norm := float64(stdmath.Sqrt(float64(norms[i])))
+			inv[i] = 1 / norm
+			norms[i] = norm
+		}
+	}
+	for r := range rows {
+		if inv[r] == 0 {
+			continue
+		}
+		scale := hwy.Set(inv[r])
+		off := r * cols
+		end := off + cols
+		var j int
+		for j = off; j+lanes <= end; j += lanes {
+			hwy.Store(hwy.Mul(hwy.Load(m[j:]), scale), m[j:])
+		}
+		for ; j < end; j++ {
+			m[j] *= inv[r]
+		}
+	}
+}
@@ -16,6 +16,8 @@ var NormalizeToFloat16 func(dst []hwy.Float16, src []hwy.Float16)
 var NormalizeToBFloat16 func(dst []hwy.BFloat16, src []hwy.BFloat16)
 var NormalizeToFloat32 func(dst []float32, src []float32)
 var NormalizeToFloat64 func(dst []float64, src []float64)
+var NormalizeRowsL2Float32 func(m []float32, rows int, cols int, norms []float32)
+var NormalizeRowsL2Float64 func(m []float64, rows int, cols int, norms []float64)
 
 // Normalize normalizes a vector in-place to unit length (L2 norm = 1).
 // The L2 norm is defined as sqrt(sum of squares): ||v|| = sqrt(Σ v[i]^2).
@@ -75,6 +77,34 @@ func NormalizeTo[T hwy.Floats](dst []T, src []T) {
 	}
 }
 
+// NormalizeRowsL2 normalizes each row of the row-major [rows, cols]
+// matrix m in-place to unit L2 norm, the usual preprocessing before
+// cosine-similarity search over embeddings.
+//
+// If norms is non-nil, it must hold at least rows elements and receives the
+// L2 norm of each row before normalization. Rows with zero norm are left
+// unchanged and get a norm of 0.
+//
+// The reciprocal norms are computed for a whole vector of rows at once with
+// the hardware reciprocal square root estimate followed by Newton-Raphson
+// refinement, instead of a square root and division per row.
+//
+// Example:
+//
+//	m := []float32{3, 4, 0, 2}
+//	norms := make([]float32, 2)
+//	BaseNormalizeRowsL2(m, 2, 2, norms)  // m is [0.6, 0.8, 0, 1], norms is [5, 2]
+//
+// This function dispatches to the appropriate SIMD implementation at runtime.
+func NormalizeRowsL2[T hwy.FloatsNative](m []T, rows int, cols int, norms []T) {
+	switch any(m).(type) {
+	case []float32:
+		NormalizeRowsL2Float32(any(m).([]float32), rows, cols, any(norms).([]float32))
+	case []float64:
+		NormalizeRowsL2Float64(any(m).([]float64), rows, cols, any(norms).([]float64))
+	}
+}
+
 func init() {
 	_ = hwy.NoSimdEnv // silence unused import
 	initNormalizeFallback()
@@ -89,4 +119,21 @@ func initNormalizeFallback() {
 	NormalizeToBFloat16 = BaseNormalizeTo_fallback_BFloat16
 	NormalizeToFloat32 = BaseNormalizeTo_fallback
 	NormalizeToFloat64 = BaseNormalizeTo_fallback_Float64
+	NormalizeRowsL2Float32 = BaseNormalizeRowsL2_fallback
+	NormalizeRowsL2Float64 = BaseNormalizeRowsL2_fallback_Float64
+}
+
+func init() {
+	hwy.RegisterKernel("vec.NormalizeFloat16", &NormalizeFloat16)
+	hwy.RegisterKernel("vec.NormalizeBFloat16", &NormalizeBFloat16)
+	hwy.RegisterKernel("vec.NormalizeFloat32", &NormalizeFloat32)
+	hwy.RegisterKernel("vec.NormalizeFloat64", &NormalizeFloat64)
+	hwy.RegisterKernel("vec.NormalizeToFloat16", &NormalizeToFloat16)
+	hwy.RegisterKernel("vec.NormalizeToBFloat16", &NormalizeToBFloat16)
+	hwy.RegisterKernel("vec.NormalizeToFloat32", &NormalizeToFloat32)
+	hwy.RegisterKernel("vec.NormalizeToFloat64", &NormalizeToFloat64)
+	hwy.RegisterKernel("vec.NormalizeRowsL2Float32", &NormalizeRowsL2Float32)
+	hwy.RegisterKernel("vec.NormalizeRowsL2Float64", &NormalizeRowsL2Float64)
+	hwyKernels := []string{"vec.NormalizeFloat16", "vec.NormalizeBFloat16", "vec.NormalizeFloat32", "vec.NormalizeFloat64", "vec.NormalizeToFloat16", "vec.NormalizeToBFloat16", "vec.NormalizeToFloat32", "vec.NormalizeToFloat64", "vec.NormalizeRowsL2Float32", "vec.NormalizeRowsL2Float64"}
+	hwy.RegisterKernelTarget(hwy.DispatchScalar, initNormalizeFallback, hwyKernels...)
 }
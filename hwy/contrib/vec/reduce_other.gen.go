@@ -181,3 +181,26 @@ func initReduceFallback() {
 	MinMaxFloat32 = BaseMinMax_fallback
 	MinMaxFloat64 = BaseMinMax_fallback_Float64
 }
+
+func init() {
+	hwy.RegisterKernel("vec.SumFloat16", &SumFloat16)
+	hwy.RegisterKernel("vec.SumBFloat16", &SumBFloat16)
+	hwy.RegisterKernel("vec.SumFloat32", &SumFloat32)
+	hwy.RegisterKernel("vec.SumFloat64", &SumFloat64)
+	hwy.RegisterKernel("vec.MinFloat16", &MinFloat16)
+	hwy.RegisterKernel("vec.MinBFloat16", &MinBFloat16)
+	hwy.RegisterKernel("vec.MinFloat32", &MinFloat32)
+	hwy.RegisterKernel("vec.MinFloat64", &MinFloat64)
+	hwy.RegisterKernel("vec.MaxFloat32", &MaxFloat32)
+	hwy.RegisterKernel("vec.MaxFloat64", &MaxFloat64)
+	hwy.RegisterKernel("vec.MaxInt32", &MaxInt32)
+	hwy.RegisterKernel("vec.MaxInt64", &MaxInt64)
+	hwy.RegisterKernel("vec.MaxUint32", &MaxUint32)
+	hwy.RegisterKernel("vec.MaxUint64", &MaxUint64)
+	hwy.RegisterKernel("vec.MinMaxFloat16", &MinMaxFloat16)
+	hwy.RegisterKernel("vec.MinMaxBFloat16", &MinMaxBFloat16)
+	hwy.RegisterKernel("vec.MinMaxFloat32", &MinMaxFloat32)
+	hwy.RegisterKernel("vec.MinMaxFloat64", &MinMaxFloat64)
+	hwyKernels := []string{"vec.SumFloat16", "vec.SumBFloat16", "vec.SumFloat32", "vec.SumFloat64", "vec.MinFloat16", "vec.MinBFloat16", "vec.MinFloat32", "vec.MinFloat64", "vec.MaxFloat32", "vec.MaxFloat64", "vec.MaxInt32", "vec.MaxInt64", "vec.MaxUint32", "vec.MaxUint64", "vec.MinMaxFloat16", "vec.MinMaxBFloat16", "vec.MinMaxFloat32", "vec.MinMaxFloat64"}
+	hwy.RegisterKernelTarget(hwy.DispatchScalar, initReduceFallback, hwyKernels...)
+}
@@ -317,6 +317,64 @@ func TestBaseNormalizeTo(t *testing.T) {
 	}
 }
 
+func TestNormalizeRowsL2(t *testing.T) {
+	for _, rows := range []int{1, 3, 8, 17} {
+		for _, cols := range []int{1, 5, 16} {
+			t.Run(fmt.Sprintf("%dx%d", rows, cols), func(t *testing.T) {
+				m32 := make([]float32, rows*cols)
+				m64 := make([]float64, rows*cols)
+				for i := range m32 {
+					m32[i] = float32((i*37)%23-11) * 0.25
+					m64[i] = float64(m32[i])
+				}
+				// Row 1, if present, is all zeros.
+				if rows > 1 {
+					clear(m32[cols : 2*cols])
+					clear(m64[cols : 2*cols])
+				}
+				orig := append([]float64(nil), m64...)
+
+				norms32 := make([]float32, rows)
+				norms64 := make([]float64, rows)
+				NormalizeRowsL2(m32, rows, cols, norms32)
+				NormalizeRowsL2(m64, rows, cols, norms64)
+				for r := range rows {
+					row := orig[r*cols : (r+1)*cols]
+					var sq float64
+					for _, x := range row {
+						sq += x * x
+					}
+					want := math.Sqrt(sq)
+					if !approxEqual64(norms64[r], want, 1e-12*max(want, 1)) {
+						t.Errorf("float64 norms[%d] = %v, want %v", r, norms64[r], want)
+					}
+					if !approxEqual32(norms32[r], float32(want), 1e-5*max(float32(want), 1)) {
+						t.Errorf("float32 norms[%d] = %v, want %v", r, norms32[r], want)
+					}
+					for j, x := range row {
+						if want > 0 {
+							x /= want
+						}
+						if !approxEqual64(m64[r*cols+j], x, 1e-12) {
+							t.Errorf("float64 m[%d][%d] = %v, want %v", r, j, m64[r*cols+j], x)
+						}
+						if !approxEqual32(m32[r*cols+j], float32(x), 1e-5) {
+							t.Errorf("float32 m[%d][%d] = %v, want %v", r, j, m32[r*cols+j], x)
+						}
+					}
+				}
+
+				// Without norms the rows are normalized the same way.
+				again := append([]float64(nil), orig...)
+				NormalizeRowsL2(again, rows, cols, nil)
+				if !sliceApproxEqual64(again, m64, 0) {
+					t.Errorf("NormalizeRowsL2 without norms = %v, want %v", again, m64)
+				}
+			})
+		}
+	}
+}
+
 // ============================================================================
 // BaseL2SquaredDistance Tests
 // ============================================================================
@@ -246,3 +246,37 @@ func initLiftingFallback() {
 	DeinterleaveUint32 = BaseDeinterleave_fallback_Uint32
 	DeinterleaveUint64 = BaseDeinterleave_fallback_Uint64
 }
+
+func init() {
+	hwy.RegisterKernel("wavelet.LiftUpdate53Int32", &LiftUpdate53Int32)
+	hwy.RegisterKernel("wavelet.LiftUpdate53Int64", &LiftUpdate53Int64)
+	hwy.RegisterKernel("wavelet.LiftPredict53Int32", &LiftPredict53Int32)
+	hwy.RegisterKernel("wavelet.LiftPredict53Int64", &LiftPredict53Int64)
+	hwy.RegisterKernel("wavelet.LiftStep97Float16", &LiftStep97Float16)
+	hwy.RegisterKernel("wavelet.LiftStep97BFloat16", &LiftStep97BFloat16)
+	hwy.RegisterKernel("wavelet.LiftStep97Float32", &LiftStep97Float32)
+	hwy.RegisterKernel("wavelet.LiftStep97Float64", &LiftStep97Float64)
+	hwy.RegisterKernel("wavelet.ScaleSliceFloat16", &ScaleSliceFloat16)
+	hwy.RegisterKernel("wavelet.ScaleSliceBFloat16", &ScaleSliceBFloat16)
+	hwy.RegisterKernel("wavelet.ScaleSliceFloat32", &ScaleSliceFloat32)
+	hwy.RegisterKernel("wavelet.ScaleSliceFloat64", &ScaleSliceFloat64)
+	hwy.RegisterKernel("wavelet.InterleaveFloat32", &InterleaveFloat32)
+	hwy.RegisterKernel("wavelet.InterleaveFloat64", &InterleaveFloat64)
+	hwy.RegisterKernel("wavelet.InterleaveInt32", &InterleaveInt32)
+	hwy.RegisterKernel("wavelet.InterleaveInt64", &InterleaveInt64)
+	hwy.RegisterKernel("wavelet.InterleaveUint32", &InterleaveUint32)
+	hwy.RegisterKernel("wavelet.InterleaveUint64", &InterleaveUint64)
+	hwy.RegisterKernel("wavelet.Synthesize53CoreInt32", &Synthesize53CoreInt32)
+	hwy.RegisterKernel("wavelet.Synthesize53CoreInt64", &Synthesize53CoreInt64)
+	hwy.RegisterKernel("wavelet.Synthesize53CoreColsInt32", &Synthesize53CoreColsInt32)
+	hwy.RegisterKernel("wavelet.Synthesize53CoreColsInt64", &Synthesize53CoreColsInt64)
+	hwy.RegisterKernel("wavelet.DeinterleaveFloat32", &DeinterleaveFloat32)
+	hwy.RegisterKernel("wavelet.DeinterleaveFloat64", &DeinterleaveFloat64)
+	hwy.RegisterKernel("wavelet.DeinterleaveInt32", &DeinterleaveInt32)
+	hwy.RegisterKernel("wavelet.DeinterleaveInt64", &DeinterleaveInt64)
+	hwy.RegisterKernel("wavelet.DeinterleaveUint32", &DeinterleaveUint32)
+	hwy.RegisterKernel("wavelet.DeinterleaveUint64", &DeinterleaveUint64)
+	hwyKernels := []string{"wavelet.LiftUpdate53Int32", "wavelet.LiftUpdate53Int64", "wavelet.LiftPredict53Int32", "wavelet.LiftPredict53Int64", "wavelet.LiftStep97Float16", "wavelet.LiftStep97BFloat16", "wavelet.LiftStep97Float32", "wavelet.LiftStep97Float64", "wavelet.ScaleSliceFloat16", "wavelet.ScaleSliceBFloat16", "wavelet.ScaleSliceFloat32", "wavelet.ScaleSliceFloat64", "wavelet.InterleaveFloat32", "wavelet.InterleaveFloat64", "wavelet.InterleaveInt32", "wavelet.InterleaveInt64", "wavelet.InterleaveUint32", "wavelet.InterleaveUint64", "wavelet.Synthesize53CoreInt32", "wavelet.Synthesize53CoreInt64", "wavelet.Synthesize53CoreColsInt32", "wavelet.Synthesize53CoreColsInt64", "wavelet.DeinterleaveFloat32", "wavelet.DeinterleaveFloat64", "wavelet.DeinterleaveInt32", "wavelet.DeinterleaveInt64", "wavelet.DeinterleaveUint32", "wavelet.DeinterleaveUint64"}
+	hwy.RegisterKernelTarget(hwy.DispatchNEON, initLiftingNEON, hwyKernels...)
+	hwy.RegisterKernelTarget(hwy.DispatchScalar, initLiftingFallback, hwyKernels...)
+}
@@ -598,6 +598,29 @@ func BaseScaleSlice_fallback(data []float32, n int, scale float32) {
 	}
 	scaleVec := float32(scale)
 	i := 0
+	for ; i+4 <= n; i += 4 {
+		data4 := data[i : i+4 : i+4]
+		{
+			v := data4[0]
+			result := v * scaleVec
+			data4[0] = result
+		}
+		{
+			v := data4[1]
+			result := v * scaleVec
+			data4[1] = result
+		}
+		{
+			v := data4[2]
+			result := v * scaleVec
+			data4[2] = result
+		}
+		{
+			v := data4[3]
+			result := v * scaleVec
+			data4[3] = result
+		}
+	}
 	for ; i < n; i++ {
 		v := data[i]
 		result := v * scaleVec
@@ -614,6 +637,29 @@ func BaseScaleSlice_fallback_Float64(data []float64, n int, scale float64) {
 	}
 	scaleVec := float64(scale)
 	i := 0
+	for ; i+4 <= n; i += 4 {
+		data4 := data[i : i+4 : i+4]
+		{
+			v := data4[0]
+			result := v * scaleVec
+			data4[0] = result
+		}
+		{
+			v := data4[1]
+			result := v * scaleVec
+			data4[1] = result
+		}
+		{
+			v := data4[2]
+			result := v * scaleVec
+			data4[2] = result
+		}
+		{
+			v := data4[3]
+			result := v * scaleVec
+			data4[3] = result
+		}
+	}
 	for ; i < n; i++ {
 		v := data[i]
 		result := v * scaleVec
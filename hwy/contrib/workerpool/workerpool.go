@@ -19,56 +19,219 @@
 //	        processRows(start, end)
 //	    })
 //	}
+//
+// Every contrib function taking a *Pool accepts one bound to a context with
+// WithContext, which makes it cancellable:
+//
+//	p := pool.WithContext(ctx)
+//	matmul.ParallelMatMul(p, a, b, c, m, n, k)
+//	if err := ctx.Err(); err != nil {
+//	    return err // c is only partially computed
+//	}
 package workerpool
 
 import (
+	"context"
+	"errors"
 	"runtime"
 	"sync"
 	"sync/atomic"
 )
 
+// ErrClosed is returned by Submit on a closed pool.
+var ErrClosed = errors.New("workerpool: pool is closed")
+
 // Pool is a persistent worker pool that can be reused across many parallel
 // operations. Workers are spawned once at creation and reused.
+//
+// A Pool returned by WithContext shares the workers of its parent, and
+// stops scheduling work once its context is done.
 type Pool struct {
+	*workers
+	ctx context.Context
+}
+
+// workers is the state shared by a pool and the pools derived from it.
+type workers struct {
 	numWorkers int
 	workC      chan workItem
-	closeOnce  sync.Once
-	closed     atomic.Bool
+
+	// A sender checks closed and joins sending under mu.RLock, then
+	// releases it before the blocking send, which also selects on done.
+	// Close sets closed and closes done under mu.Lock, so it never waits
+	// behind a sender stuck on a full queue, and closes workC once the
+	// senders that got in have left.
+	mu      sync.RWMutex
+	closed  atomic.Bool
+	done    chan struct{}
+	sending sync.WaitGroup
 }
 
 // workItem represents a single parallel operation to execute.
 type workItem struct {
 	fn      func()
-	barrier *sync.WaitGroup
+	barrier *sync.WaitGroup // nil for Submit
+}
+
+// run executes the item and releases its barrier.
+func (item workItem) run() {
+	item.fn()
+	if item.barrier != nil {
+		item.barrier.Done()
+	}
+}
+
+// options holds the configuration set by Options.
+type options struct {
+	queueSize    int
+	lockOSThread bool
+	workerInit   func(worker int)
+}
+
+// Option configures a Pool created by New.
+type Option func(*options)
+
+// WithQueueSize sets how many work items can be queued before submitting
+// blocks. The default, twice the number of workers, lets every worker have
+// one item pending; 0 hands each item directly to an idle worker.
+func WithQueueSize(n int) Option {
+	return func(o *options) { o.queueSize = max(n, 0) }
+}
+
+// WithLockOSThread locks every worker goroutine to its own OS thread, so
+// that thread-level state such as CPU affinity set by WithWorkerInit stays
+// with the worker.
+func WithLockOSThread() Option {
+	return func(o *options) { o.lockOSThread = true }
+}
+
+// WithWorkerInit calls init(worker), with worker in [0, numWorkers), on
+// each worker goroutine before it runs any work. Combined with
+// WithLockOSThread it can pin the workers to CPUs, e.g. with
+// unix.SchedSetaffinity on Linux.
+func WithWorkerInit(init func(worker int)) Option {
+	return func(o *options) { o.workerInit = init }
 }
 
 // New creates a new worker pool with the specified number of workers.
 // Workers are spawned immediately and persist until Close is called.
 // If numWorkers <= 0, uses GOMAXPROCS.
-func New(numWorkers int) *Pool {
+func New(numWorkers int, opts ...Option) *Pool {
 	if numWorkers <= 0 {
 		numWorkers = runtime.GOMAXPROCS(0)
 	}
+	// Buffer enough for all workers to have pending work
+	o := options{queueSize: numWorkers * 2}
+	for _, opt := range opts {
+		opt(&o)
+	}
 
-	p := &Pool{
+	p := &Pool{workers: &workers{
 		numWorkers: numWorkers,
-		// Buffer enough for all workers to have pending work
-		workC: make(chan workItem, numWorkers*2),
-	}
+		workC:      make(chan workItem, o.queueSize),
+		done:       make(chan struct{}),
+	}}
 
 	// Spawn persistent workers
-	for range numWorkers {
-		go p.worker()
+	var started sync.WaitGroup
+	started.Add(numWorkers)
+	for i := range numWorkers {
+		go p.worker(i, o, &started)
 	}
+	started.Wait()
 
 	return p
 }
 
+var defaultPool = sync.OnceValue(func() *Pool { return New(0) })
+
+// Default returns a process-wide pool with GOMAXPROCS workers, created on
+// first use, for the contrib functions that parallelize without taking a
+// pool. It must not be closed.
+func Default() *Pool {
+	return defaultPool()
+}
+
 // worker is the main loop for each persistent worker goroutine.
-func (p *Pool) worker() {
-	for item := range p.workC {
-		item.fn()
-		item.barrier.Done()
+func (w *workers) worker(i int, o options, started *sync.WaitGroup) {
+	if o.lockOSThread {
+		runtime.LockOSThread()
+	}
+	if o.workerInit != nil {
+		o.workerInit(i)
+	}
+	started.Done()
+	for item := range w.workC {
+		item.run()
+	}
+}
+
+// send queues item for a worker. If the pool has been closed, it runs item
+// on the calling goroutine instead so that the operation still completes.
+func (w *workers) send(item workItem) {
+	w.mu.RLock()
+	if w.closed.Load() {
+		w.mu.RUnlock()
+		item.run()
+		return
+	}
+	w.sending.Add(1)
+	w.mu.RUnlock()
+	select {
+	case w.workC <- item:
+		w.sending.Done()
+	case <-w.done:
+		w.sending.Done()
+		item.run()
+	}
+}
+
+// WithContext returns a pool sharing the workers of p whose parallel
+// operations stop handing out work once ctx is done. Work already started
+// runs to completion, so an operation returns promptly only if it is split
+// into many pieces; check ctx.Err() afterwards to know whether it finished.
+// Closing the returned pool closes p.
+func (p *Pool) WithContext(ctx context.Context) *Pool {
+	return &Pool{workers: p.workers, ctx: ctx}
+}
+
+// Context returns the context of p, or context.Background() if it has
+// none.
+func (p *Pool) Context() context.Context {
+	if p.ctx == nil {
+		return context.Background()
+	}
+	return p.ctx
+}
+
+// cancelled reports whether the context of p is done.
+func (p *Pool) cancelled() bool {
+	return p.ctx != nil && p.ctx.Err() != nil
+}
+
+// Submit queues fn to run on a worker, without waiting for it to run. It
+// blocks while the queue is full, and returns ctx.Err() if ctx is done
+// first, or ErrClosed if the pool is closed.
+func (p *Pool) Submit(ctx context.Context, fn func()) error {
+	p.mu.RLock()
+	if p.closed.Load() {
+		p.mu.RUnlock()
+		return ErrClosed
+	}
+	if err := ctx.Err(); err != nil {
+		p.mu.RUnlock()
+		return err
+	}
+	p.sending.Add(1)
+	p.mu.RUnlock()
+	defer p.sending.Done()
+	select {
+	case p.workC <- workItem{fn: fn}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-p.done:
+		return ErrClosed
 	}
 }
 
@@ -78,12 +241,22 @@ func (p *Pool) NumWorkers() int {
 }
 
 // Close shuts down the worker pool. All pending work will complete.
+// Closing a pool returned by WithContext closes the pool it derives from.
 // Calling Close multiple times is safe.
 func (p *Pool) Close() {
-	p.closeOnce.Do(func() {
-		p.closed.Store(true)
-		close(p.workC)
-	})
+	p.mu.Lock()
+	if p.closed.Load() {
+		p.mu.Unlock()
+		return
+	}
+	p.closed.Store(true)
+	close(p.done)
+	p.mu.Unlock()
+
+	// Senders still in flight either queue their item or give up on done,
+	// so this does not wait for the queue to drain.
+	p.sending.Wait()
+	close(p.workC)
 }
 
 // ParallelFor executes fn for each index in [0, n) using the worker pool.
@@ -96,6 +269,10 @@ func (p *Pool) ParallelFor(n int, fn func(start, end int)) {
 		return
 	}
 
+	if p.cancelled() {
+		return
+	}
+
 	if p.closed.Load() {
 		// Fallback to sequential if pool is closed
 		fn(0, n)
@@ -126,12 +303,14 @@ func (p *Pool) ParallelFor(n int, fn func(start, end int)) {
 			continue
 		}
 
-		p.workC <- workItem{
+		p.send(workItem{
 			fn: func() {
-				fn(start, end)
+				if !p.cancelled() {
+					fn(start, end)
+				}
 			},
 			barrier: &wg,
-		}
+		})
 	}
 
 	wg.Wait()
@@ -147,18 +326,14 @@ func (p *Pool) ParallelForAtomic(n int, fn func(i int)) {
 		return
 	}
 
-	if p.closed.Load() {
-		// Fallback to sequential if pool is closed
-		for i := range n {
-			fn(i)
-		}
-		return
-	}
-
 	workers := min(p.numWorkers, n)
 
-	if workers == 1 {
+	if workers == 1 || p.closed.Load() {
+		// Sequential for a single item or a closed pool
 		for i := range n {
+			if p.cancelled() {
+				return
+			}
 			fn(i)
 		}
 		return
@@ -169,18 +344,18 @@ func (p *Pool) ParallelForAtomic(n int, fn func(i int)) {
 	wg.Add(workers)
 
 	for range workers {
-		p.workC <- workItem{
+		p.send(workItem{
 			fn: func() {
 				for {
 					idx := int(nextIdx.Add(1)) - 1
-					if idx >= n {
+					if idx >= n || p.cancelled() {
 						return
 					}
 					fn(idx)
 				}
 			},
 			barrier: &wg,
-		}
+		})
 	}
 
 	wg.Wait()
@@ -201,6 +376,10 @@ func (p *Pool) ParallelForAtomicBatched(n int, batchSize int, fn func(start, end
 		batchSize = 1
 	}
 
+	if p.cancelled() {
+		return
+	}
+
 	if p.closed.Load() {
 		fn(0, n)
 		return
@@ -220,12 +399,12 @@ func (p *Pool) ParallelForAtomicBatched(n int, batchSize int, fn func(start, end
 	wg.Add(workers)
 
 	for range workers {
-		p.workC <- workItem{
+		p.send(workItem{
 			fn: func() {
 				for {
 					batch := int(nextBatch.Add(1)) - 1
 					start := batch * batchSize
-					if start >= n {
+					if start >= n || p.cancelled() {
 						return
 					}
 					end := min(start+batchSize, n)
@@ -233,7 +412,7 @@ func (p *Pool) ParallelForAtomicBatched(n int, batchSize int, fn func(start, end
 				}
 			},
 			barrier: &wg,
-		}
+		})
 	}
 
 	wg.Wait()
@@ -3,9 +3,13 @@
 package workerpool
 
 import (
+	"context"
+	"errors"
 	"runtime"
+	"sync"
 	"sync/atomic"
 	"testing"
+	"time"
 )
 
 func TestNew(t *testing.T) {
@@ -142,6 +146,172 @@ func TestClosedPoolFallback(t *testing.T) {
 	}
 }
 
+func TestOptions(t *testing.T) {
+	var mu sync.Mutex
+	seen := make(map[int]bool)
+	pool := New(3, WithQueueSize(0), WithLockOSThread(), WithWorkerInit(func(worker int) {
+		mu.Lock()
+		seen[worker] = true
+		mu.Unlock()
+	}))
+	defer pool.Close()
+
+	if len(seen) != 3 || !seen[0] || !seen[1] || !seen[2] {
+		t.Errorf("WithWorkerInit called for workers %v, want 0, 1 and 2", seen)
+	}
+
+	var count atomic.Int32
+	pool.ParallelForAtomic(100, func(i int) {
+		count.Add(1)
+	})
+	if count.Load() != 100 {
+		t.Errorf("count = %d, want 100", count.Load())
+	}
+}
+
+func TestWithContext(t *testing.T) {
+	pool := New(4)
+	defer pool.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	p := pool.WithContext(ctx)
+	if p.Context() != ctx || pool.Context() != context.Background() {
+		t.Error("Context() does not return the pool's context")
+	}
+
+	var count atomic.Int32
+	p.ParallelForAtomic(1000, func(i int) {
+		if count.Add(1) == 10 {
+			cancel()
+		}
+	})
+	// Workers stop grabbing items once cancelled; at most one item per
+	// worker can still be in flight.
+	if got := count.Load(); got > 10+4 {
+		t.Errorf("cancelled ParallelForAtomic ran %d items", got)
+	}
+
+	count.Store(0)
+	p.ParallelFor(100, func(start, end int) { count.Add(1) })
+	p.ParallelForAtomicBatched(100, 10, func(start, end int) { count.Add(1) })
+	if count.Load() != 0 {
+		t.Errorf("operations on a cancelled pool ran %d pieces", count.Load())
+	}
+
+	// The parent pool is not affected.
+	pool.ParallelFor(100, func(start, end int) { count.Add(int32(end - start)) })
+	if count.Load() != 100 {
+		t.Errorf("count = %d, want 100", count.Load())
+	}
+}
+
+func TestSubmit(t *testing.T) {
+	pool := New(2)
+
+	done := make(chan int)
+	if err := pool.Submit(context.Background(), func() { done <- 42 }); err != nil {
+		t.Fatalf("Submit: %v", err)
+	}
+	if got := <-done; got != 42 {
+		t.Errorf("submitted func sent %d, want 42", got)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := pool.Submit(ctx, func() {}); !errors.Is(err, context.Canceled) {
+		t.Errorf("Submit with a cancelled context = %v, want context.Canceled", err)
+	}
+
+	pool.Close()
+	if err := pool.Submit(context.Background(), func() {}); !errors.Is(err, ErrClosed) {
+		t.Errorf("Submit on a closed pool = %v, want ErrClosed", err)
+	}
+}
+
+func TestCloseConcurrentWithSubmit(t *testing.T) {
+	for range 100 {
+		pool := New(2, WithQueueSize(0))
+		var submitted atomic.Int32
+		var wg sync.WaitGroup
+		for range 4 {
+			wg.Go(func() {
+				for {
+					err := pool.Submit(context.Background(), func() { submitted.Add(1) })
+					if errors.Is(err, ErrClosed) {
+						return
+					}
+				}
+			})
+			wg.Go(func() {
+				var count atomic.Int32
+				pool.ParallelFor(8, func(start, end int) { count.Add(int32(end - start)) })
+				if count.Load() != 8 {
+					t.Errorf("ParallelFor racing Close covered %d items, want 8", count.Load())
+				}
+			})
+		}
+		for submitted.Load() < 10 {
+			runtime.Gosched()
+		}
+		pool.Close()
+		wg.Wait()
+	}
+}
+
+func TestCloseConcurrentWithNestedSend(t *testing.T) {
+	for range 20 {
+		pool := New(2, WithQueueSize(0))
+		release := make(chan struct{})
+		var busy sync.WaitGroup
+		busy.Add(2)
+		var count atomic.Int32
+		var wg sync.WaitGroup
+
+		// Both workers run an outer item that sends nested work once
+		// released.
+		wg.Go(func() {
+			pool.ParallelFor(2, func(start, end int) {
+				busy.Done()
+				<-release
+				pool.ParallelFor(4, func(start, end int) { count.Add(int32(end - start)) })
+			})
+		})
+		busy.Wait()
+
+		// This send blocks on the full queue, and Close starts while it does.
+		wg.Go(func() {
+			pool.ParallelFor(2, func(start, end int) { count.Add(int32(end - start)) })
+		})
+		time.Sleep(time.Millisecond)
+		wg.Go(pool.Close)
+		time.Sleep(time.Millisecond)
+		close(release)
+
+		finished := make(chan struct{})
+		go func() {
+			wg.Wait()
+			close(finished)
+		}()
+		select {
+		case <-finished:
+		case <-time.After(10 * time.Second):
+			t.Fatal("Close racing nested sends deadlocked")
+		}
+		if count.Load() != 2*4+2 {
+			t.Errorf("covered %d items, want %d", count.Load(), 2*4+2)
+		}
+	}
+}
+
+func TestDefault(t *testing.T) {
+	if Default() != Default() {
+		t.Error("Default() returned different pools")
+	}
+	if Default().NumWorkers() != runtime.GOMAXPROCS(0) {
+		t.Errorf("Default().NumWorkers() = %d, want %d", Default().NumWorkers(), runtime.GOMAXPROCS(0))
+	}
+}
+
 func BenchmarkParallelFor(b *testing.B) {
 	pool := New(0) // Use GOMAXPROCS
 	defer pool.Close()
@@ -0,0 +1,94 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hwy
+
+import "unsafe"
+
+// This file provides bulk slice conversions between float32 and the 16-bit
+// float formats, for the boundaries of mixed-precision pipelines (loading
+// half-precision weights, storing half-precision activations).
+//
+// Each function converts min(len(dst), len(src)) elements. The kernel is
+// chosen on every call from the current target, so ForceTarget takes effect
+// immediately: F16C or AVX-512 FP16/BF16 on x86, NEON on ARM64, and the
+// scalar conversions otherwise. All kernels round to nearest even and match
+// the scalar conversions for every non-NaN input.
+
+// ConvertF16ToF32 converts Float16 values in src to float32 values in dst.
+func ConvertF16ToF32(dst []float32, src []Float16) {
+	n := min(len(dst), len(src))
+	if n == 0 {
+		return
+	}
+	if promoteF16Native(dst[:n], float16Bits(src[:n])) {
+		return
+	}
+	for i := range n {
+		dst[i] = Float16ToFloat32(src[i])
+	}
+}
+
+// ConvertF32ToF16 converts float32 values in src to Float16 values in dst.
+// Values outside the Float16 range become infinity.
+func ConvertF32ToF16(dst []Float16, src []float32) {
+	n := min(len(dst), len(src))
+	if n == 0 {
+		return
+	}
+	if demoteF16Native(float16Bits(dst[:n]), src[:n]) {
+		return
+	}
+	for i := range n {
+		dst[i] = Float32ToFloat16(src[i])
+	}
+}
+
+// ConvertBF16ToF32 converts BFloat16 values in src to float32 values in dst.
+func ConvertBF16ToF32(dst []float32, src []BFloat16) {
+	n := min(len(dst), len(src))
+	if n == 0 {
+		return
+	}
+	if promoteBF16Native(dst[:n], bfloat16Bits(src[:n])) {
+		return
+	}
+	for i := range n {
+		dst[i] = BFloat16ToFloat32(src[i])
+	}
+}
+
+// ConvertF32ToBF16 converts float32 values in src to BFloat16 values in dst.
+func ConvertF32ToBF16(dst []BFloat16, src []float32) {
+	n := min(len(dst), len(src))
+	if n == 0 {
+		return
+	}
+	if demoteBF16Native(bfloat16Bits(dst[:n]), src[:n]) {
+		return
+	}
+	for i := range n {
+		dst[i] = Float32ToBFloat16(src[i])
+	}
+}
+
+// float16Bits reinterprets a non-empty Float16 slice as its uint16 bit patterns.
+func float16Bits(s []Float16) []uint16 {
+	return unsafe.Slice((*uint16)(unsafe.Pointer(&s[0])), len(s))
+}
+
+// bfloat16Bits reinterprets a non-empty BFloat16 slice as its uint16 bit patterns.
+func bfloat16Bits(s []BFloat16) []uint16 {
+	return unsafe.Slice((*uint16)(unsafe.Pointer(&s[0])), len(s))
+}
@@ -0,0 +1,64 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build amd64 && goexperiment.simd
+
+package hwy
+
+import "github.com/ajroetker/go-highway/hwy/asm"
+
+// This file selects the x86 kernels for the bulk Float16/BFloat16
+// conversions in convert_f16.go. Each helper reports whether it handled
+// the conversion; false means the caller should use the scalar loop.
+// AVX2 has no BF16 instructions, so BF16 needs AVX-512 BF16.
+
+func promoteF16Native(dst []float32, src []uint16) bool {
+	switch {
+	case HasAVX512FP16():
+		asm.PromoteF16ToF32AVX512(src, dst)
+	case currentLevel >= DispatchAVX2 && HasF16C():
+		asm.PromoteF16ToF32F16C(src, dst)
+	default:
+		return false
+	}
+	return true
+}
+
+func demoteF16Native(dst []uint16, src []float32) bool {
+	switch {
+	case HasAVX512FP16():
+		asm.DemoteF32ToF16AVX512(src, dst)
+	case currentLevel >= DispatchAVX2 && HasF16C():
+		asm.DemoteF32ToF16F16C(src, dst)
+	default:
+		return false
+	}
+	return true
+}
+
+func promoteBF16Native(dst []float32, src []uint16) bool {
+	if !HasAVX512BF16() {
+		return false
+	}
+	asm.PromoteBF16ToF32AVX512(src, dst)
+	return true
+}
+
+func demoteBF16Native(dst []uint16, src []float32) bool {
+	if !HasAVX512BF16() {
+		return false
+	}
+	asm.DemoteF32ToBF16AVX512(src, dst)
+	return true
+}
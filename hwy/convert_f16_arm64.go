@@ -0,0 +1,56 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !noasm && arm64
+
+package hwy
+
+import "github.com/ajroetker/go-highway/hwy/asm"
+
+// This file selects the NEON kernels (FCVTL/FCVTN for Float16, shifts with
+// round-to-nearest-even for BFloat16) for the bulk conversions in
+// convert_f16.go. NEON is baseline on ARM64, so only a scalar target
+// override disables them.
+
+func promoteF16Native(dst []float32, src []uint16) bool {
+	if currentLevel == DispatchScalar {
+		return false
+	}
+	asm.PromoteF16ToF32NEON(src, dst)
+	return true
+}
+
+func demoteF16Native(dst []uint16, src []float32) bool {
+	if currentLevel == DispatchScalar {
+		return false
+	}
+	asm.DemoteF32ToF16NEON(src, dst)
+	return true
+}
+
+func promoteBF16Native(dst []float32, src []uint16) bool {
+	if currentLevel == DispatchScalar {
+		return false
+	}
+	asm.PromoteBF16ToF32NEON(src, dst)
+	return true
+}
+
+func demoteBF16Native(dst []uint16, src []float32) bool {
+	if currentLevel == DispatchScalar {
+		return false
+	}
+	asm.DemoteF32ToBF16NEON(src, dst)
+	return true
+}
@@ -0,0 +1,28 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !(amd64 && goexperiment.simd) && !(arm64 && !noasm)
+
+package hwy
+
+// Without SIMD kernels the bulk Float16/BFloat16 conversions in
+// convert_f16.go always use the scalar loop.
+
+func promoteF16Native(dst []float32, src []uint16) bool { return false }
+
+func demoteF16Native(dst []uint16, src []float32) bool { return false }
+
+func promoteBF16Native(dst []float32, src []uint16) bool { return false }
+
+func demoteBF16Native(dst []uint16, src []float32) bool { return false }
@@ -0,0 +1,117 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hwy
+
+import (
+	"math"
+	"testing"
+)
+
+// convertF32Inputs returns an odd-length mix of normal, subnormal, rounding
+// boundary, overflow and special float32 values, so both the vector body
+// and the tail of each kernel are exercised.
+func convertF32Inputs() []float32 {
+	src := []float32{
+		0, float32(math.Copysign(0, -1)), 1, -1, 0.5, 65504, -65504, 65520, 1e6, -1e6,
+		6.1035156e-05, 5.9604645e-08, 2.9802322e-08, 1e-10, 1.00048828125, 1.001953125,
+		3.3895314e38, float32(math.Inf(1)), float32(math.Inf(-1)),
+	}
+	for i := range 1000 {
+		src = append(src, float32(i-500)*0.37, float32(math.Ldexp(1.3, i%60-30)))
+	}
+	return src
+}
+
+func TestConvertF16ToF32(t *testing.T) {
+	var src []Float16
+	for bits := range 1 << 16 {
+		if h := Float16(bits); !h.IsNaN() {
+			src = append(src, h)
+		}
+	}
+	dst := make([]float32, len(src))
+	ConvertF16ToF32(dst, src)
+	for i, h := range src {
+		if want := Float16ToFloat32(h); math.Float32bits(dst[i]) != math.Float32bits(want) {
+			t.Fatalf("ConvertF16ToF32(%#04x) = %v, want %v", uint16(h), dst[i], want)
+		}
+	}
+}
+
+func TestConvertF32ToF16(t *testing.T) {
+	src := convertF32Inputs()
+	dst := make([]Float16, len(src))
+	ConvertF32ToF16(dst, src)
+	for i, f := range src {
+		if want := Float32ToFloat16(f); dst[i] != want {
+			t.Fatalf("ConvertF32ToF16(%v) = %#04x, want %#04x", f, uint16(dst[i]), uint16(want))
+		}
+	}
+}
+
+func TestConvertBF16ToF32(t *testing.T) {
+	var src []BFloat16
+	for bits := range 1 << 16 {
+		if b := BFloat16(bits); !b.IsNaN() {
+			src = append(src, b)
+		}
+	}
+	dst := make([]float32, len(src))
+	ConvertBF16ToF32(dst, src)
+	for i, b := range src {
+		if want := BFloat16ToFloat32(b); math.Float32bits(dst[i]) != math.Float32bits(want) {
+			t.Fatalf("ConvertBF16ToF32(%#04x) = %v, want %v", uint16(b), dst[i], want)
+		}
+	}
+}
+
+func TestConvertF32ToBF16(t *testing.T) {
+	src := convertF32Inputs()
+	dst := make([]BFloat16, len(src))
+	ConvertF32ToBF16(dst, src)
+	for i, f := range src {
+		if want := Float32ToBFloat16(f); dst[i] != want {
+			t.Fatalf("ConvertF32ToBF16(%v) = %#04x, want %#04x", f, uint16(dst[i]), uint16(want))
+		}
+	}
+}
+
+func TestConvertF16Lengths(t *testing.T) {
+	defer saveTarget()()
+
+	for _, target := range []string{"", "scalar"} {
+		if target != "" {
+			if err := ForceTarget(target); err != nil {
+				t.Fatal(err)
+			}
+		}
+		// Only min(len(dst), len(src)) elements are converted.
+		src := []float32{1, 2, 3, 4, 5}
+		dst := make([]Float16, 3)
+		ConvertF32ToF16(dst, src)
+		back := make([]float32, 8)
+		ConvertF16ToF32(back, dst)
+		want := []float32{1, 2, 3, 0, 0, 0, 0, 0}
+		for i := range want {
+			if back[i] != want[i] {
+				t.Errorf("target %q: round trip lane %d = %v, want %v", target, i, back[i], want[i])
+			}
+		}
+
+		ConvertF16ToF32(nil, dst)
+		ConvertF32ToBF16(nil, src)
+		ConvertBF16ToF32(back, nil)
+	}
+}
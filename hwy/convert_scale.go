@@ -0,0 +1,84 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hwy
+
+import "math"
+
+// This file provides bulk slice conversions between integer samples and
+// float32 with a scale factor, for the boundaries of audio and image
+// pipelines: int16 PCM to [-1, 1) floats with scale 1/32768, 8-bit pixels to
+// [0, 1] with scale 1/255, and back.
+//
+// Each function converts min(len(dst), len(src)) elements. As with the
+// Float16 conversions, the kernel is chosen on every call from the current
+// target: AVX2 on x86 handles the float arithmetic eight lanes at a time and
+// every other target uses the scalar loop. Both produce identical results.
+//
+// Conversions to integers multiply by scale in float32, clamp to the range of
+// the destination type and round to nearest even. NaN converts to an
+// unspecified value.
+
+// ConvertScaleI16ToF32 sets dst[i] = float32(src[i]) * scale.
+func ConvertScaleI16ToF32(dst []float32, src []int16, scale float32) {
+	n := min(len(dst), len(src))
+	if n == 0 {
+		return
+	}
+	for i := convertScaleI16ToF32Native(dst[:n], src[:n], scale); i < n; i++ {
+		dst[i] = float32(src[i]) * scale
+	}
+}
+
+// ConvertScaleU8ToF32 sets dst[i] = float32(src[i]) * scale.
+func ConvertScaleU8ToF32(dst []float32, src []uint8, scale float32) {
+	n := min(len(dst), len(src))
+	if n == 0 {
+		return
+	}
+	for i := convertScaleU8ToF32Native(dst[:n], src[:n], scale); i < n; i++ {
+		dst[i] = float32(src[i]) * scale
+	}
+}
+
+// ConvertScaleF32ToI16 sets dst[i] to src[i] * scale rounded to nearest even
+// and saturated to [-32768, 32767].
+func ConvertScaleF32ToI16(dst []int16, src []float32, scale float32) {
+	n := min(len(dst), len(src))
+	if n == 0 {
+		return
+	}
+	for i := convertScaleF32ToI16Native(dst[:n], src[:n], scale); i < n; i++ {
+		dst[i] = int16(scaleClampRound(src[i], scale, math.MinInt16, math.MaxInt16))
+	}
+}
+
+// ConvertScaleF32ToU8 sets dst[i] to src[i] * scale rounded to nearest even
+// and clamped to [0, 255].
+func ConvertScaleF32ToU8(dst []uint8, src []float32, scale float32) {
+	n := min(len(dst), len(src))
+	if n == 0 {
+		return
+	}
+	for i := convertScaleF32ToU8Native(dst[:n], src[:n], scale); i < n; i++ {
+		dst[i] = uint8(scaleClampRound(src[i], scale, 0, math.MaxUint8))
+	}
+}
+
+// scaleClampRound returns x*scale clamped to [lo, hi] and rounded to nearest
+// even, in the same order of operations as the vector kernels.
+func scaleClampRound(x, scale, lo, hi float32) int32 {
+	v := min(max(x*scale, lo), hi)
+	return int32(math.RoundToEven(float64(v)))
+}
@@ -0,0 +1,98 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build amd64 && goexperiment.simd
+
+package hwy
+
+import "simd/archsimd"
+
+// This file provides the AVX2 kernels for the scaled conversions in
+// convert_scale.go. AVX2 cannot narrow or widen 8- and 16-bit lanes to and
+// from int32 in a single instruction, so each block of eight lanes is staged
+// through an [8]int32 while the conversion, scaling, clamping and rounding
+// run in vector registers. Each helper returns the number of elements it
+// converted; the caller finishes the remainder with the scalar loop.
+
+func convertScaleI16ToF32Native(dst []float32, src []int16, scale float32) int {
+	if currentLevel < DispatchAVX2 {
+		return 0
+	}
+	vScale := archsimd.BroadcastFloat32x8(scale)
+	var wide [8]int32
+	i := 0
+	for ; i+8 <= len(dst); i += 8 {
+		for j, s := range src[i : i+8] {
+			wide[j] = int32(s)
+		}
+		v := archsimd.LoadInt32x8Slice(wide[:]).ConvertToFloat32().Mul(vScale)
+		v.Store((*[8]float32)(dst[i:]))
+	}
+	return i
+}
+
+func convertScaleU8ToF32Native(dst []float32, src []uint8, scale float32) int {
+	if currentLevel < DispatchAVX2 {
+		return 0
+	}
+	vScale := archsimd.BroadcastFloat32x8(scale)
+	var wide [8]int32
+	i := 0
+	for ; i+8 <= len(dst); i += 8 {
+		for j, s := range src[i : i+8] {
+			wide[j] = int32(s)
+		}
+		v := archsimd.LoadInt32x8Slice(wide[:]).ConvertToFloat32().Mul(vScale)
+		v.Store((*[8]float32)(dst[i:]))
+	}
+	return i
+}
+
+func convertScaleF32ToI16Native(dst []int16, src []float32, scale float32) int {
+	if currentLevel < DispatchAVX2 {
+		return 0
+	}
+	vScale := archsimd.BroadcastFloat32x8(scale)
+	lo := archsimd.BroadcastFloat32x8(-32768)
+	hi := archsimd.BroadcastFloat32x8(32767)
+	var narrow [8]int32
+	i := 0
+	for ; i+8 <= len(dst); i += 8 {
+		v := archsimd.LoadFloat32x8Slice(src[i:]).Mul(vScale).Max(lo).Min(hi)
+		v.RoundToEven().ConvertToInt32().Store(&narrow)
+		for j, x := range narrow {
+			dst[i+j] = int16(x)
+		}
+	}
+	return i
+}
+
+func convertScaleF32ToU8Native(dst []uint8, src []float32, scale float32) int {
+	if currentLevel < DispatchAVX2 {
+		return 0
+	}
+	vScale := archsimd.BroadcastFloat32x8(scale)
+	lo := archsimd.BroadcastFloat32x8(0)
+	hi := archsimd.BroadcastFloat32x8(255)
+	var narrow [8]int32
+	i := 0
+	for ; i+8 <= len(dst); i += 8 {
+		v := archsimd.LoadFloat32x8Slice(src[i:]).Mul(vScale).Max(lo).Min(hi)
+		v.RoundToEven().ConvertToInt32().Store(&narrow)
+		for j, x := range narrow {
+			dst[i+j] = uint8(x)
+		}
+	}
+	return i
+}
@@ -0,0 +1,28 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !(amd64 && goexperiment.simd)
+
+package hwy
+
+// Without SIMD kernels the scaled conversions in convert_scale.go always use
+// the scalar loop. Each helper returns the number of elements it converted.
+
+func convertScaleI16ToF32Native(dst []float32, src []int16, scale float32) int { return 0 }
+
+func convertScaleU8ToF32Native(dst []float32, src []uint8, scale float32) int { return 0 }
+
+func convertScaleF32ToI16Native(dst []int16, src []float32, scale float32) int { return 0 }
+
+func convertScaleF32ToU8Native(dst []uint8, src []float32, scale float32) int { return 0 }
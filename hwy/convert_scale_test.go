@@ -0,0 +1,128 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hwy
+
+import (
+	"math"
+	"testing"
+)
+
+func TestConvertScaleToF32(t *testing.T) {
+	defer saveTarget()()
+
+	i16 := []int16{math.MinInt16, -1, 0, 1, math.MaxInt16}
+	for i := range 1000 {
+		i16 = append(i16, int16(i*131-65000))
+	}
+	u8 := make([]uint8, 1003)
+	for i := range u8 {
+		u8[i] = uint8(i * 7)
+	}
+	for _, target := range []string{"", "scalar"} {
+		if target != "" {
+			if err := ForceTarget(target); err != nil {
+				t.Fatal(err)
+			}
+		}
+		f := make([]float32, len(i16))
+		ConvertScaleI16ToF32(f, i16, 1.0/32768)
+		for i, x := range i16 {
+			if want := float32(x) / 32768; f[i] != want {
+				t.Fatalf("target %q: ConvertScaleI16ToF32(%d) = %v, want %v", target, x, f[i], want)
+			}
+		}
+		f = make([]float32, len(u8))
+		ConvertScaleU8ToF32(f, u8, 0.5)
+		for i, x := range u8 {
+			if want := float32(x) * 0.5; f[i] != want {
+				t.Fatalf("target %q: ConvertScaleU8ToF32(%d) = %v, want %v", target, x, f[i], want)
+			}
+		}
+	}
+}
+
+func TestConvertScaleFromF32(t *testing.T) {
+	defer saveTarget()()
+
+	src := []float32{0, -0.5, 0.5, 1.5, 2.5, -1.5, -2.5, 254.5, 255.5, 1e9, -1e9,
+		float32(math.Inf(1)), float32(math.Inf(-1))}
+	for i := range 1000 {
+		src = append(src, float32(i-500)*0.731)
+	}
+	tests := []struct {
+		scale float32
+		i16   []int16
+		u8    []uint8
+	}{
+		{scale: 1, i16: []int16{0, 0, 0, 2, 2, -2, -2, 254, 256, 32767, -32768, 32767, -32768},
+			u8: []uint8{0, 0, 0, 2, 2, 0, 0, 254, 255, 255, 0, 255, 0}},
+		{scale: 100},
+	}
+	for _, target := range []string{"", "scalar"} {
+		if target != "" {
+			if err := ForceTarget(target); err != nil {
+				t.Fatal(err)
+			}
+		}
+		for _, tt := range tests {
+			i16 := make([]int16, len(src))
+			ConvertScaleF32ToI16(i16, src, tt.scale)
+			u8 := make([]uint8, len(src))
+			ConvertScaleF32ToU8(u8, src, tt.scale)
+			for i, x := range src {
+				v := math.RoundToEven(float64(x * tt.scale))
+				if want := int16(max(min(v, math.MaxInt16), math.MinInt16)); i16[i] != want {
+					t.Fatalf("target %q: ConvertScaleF32ToI16(%v, %v) = %d, want %d", target, x, tt.scale, i16[i], want)
+				}
+				if want := uint8(max(min(v, 255), 0)); u8[i] != want {
+					t.Fatalf("target %q: ConvertScaleF32ToU8(%v, %v) = %d, want %d", target, x, tt.scale, u8[i], want)
+				}
+			}
+			for i, want := range tt.i16 {
+				if i16[i] != want {
+					t.Errorf("target %q: ConvertScaleF32ToI16(%v) = %d, want %d", target, src[i], i16[i], want)
+				}
+			}
+			for i, want := range tt.u8 {
+				if u8[i] != want {
+					t.Errorf("target %q: ConvertScaleF32ToU8(%v) = %d, want %d", target, src[i], u8[i], want)
+				}
+			}
+		}
+	}
+}
+
+func TestConvertScaleLengths(t *testing.T) {
+	// Only min(len(dst), len(src)) elements are converted.
+	src := []int16{100, 200, 300, 400, 500, 600, 700, 800, 900, 1000}
+	f := make([]float32, 9)
+	ConvertScaleI16ToF32(f, src, 0.5)
+	back := make([]int16, 12)
+	ConvertScaleF32ToI16(back, f, 2)
+	for i := range back {
+		want := int16(0)
+		if i < 9 {
+			want = src[i]
+		}
+		if back[i] != want {
+			t.Errorf("round trip lane %d = %d, want %d", i, back[i], want)
+		}
+	}
+
+	ConvertScaleI16ToF32(nil, src, 1)
+	ConvertScaleU8ToF32(f, nil, 1)
+	ConvertScaleF32ToI16(nil, f, 1)
+	ConvertScaleF32ToU8(nil, f, 1)
+}
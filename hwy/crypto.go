@@ -0,0 +1,158 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hwy
+
+import "github.com/ajroetker/go-highway/hwy/asm"
+
+// This file provides the AES round and carry-less multiply primitives used
+// by hashes (AES-based mixing like aHash/MeowHash), GHASH/POLYVAL for GCM,
+// CRC folding and AES-CTR style random number generators.
+//
+// The operations follow the x86 instruction semantics on every target so
+// kernels are portable: AESRound is AESENC and AESLastRound is AESENCLAST,
+// each applied independently to every 16-byte block of the vector. They
+// use AES-NI/PCLMULQDQ on x86 and the ARMv8 crypto extensions (AESE/AESMC,
+// PMULL) on ARM64 when present, and a table-driven scalar path otherwise or
+// when the scalar target is forced. These are building blocks, not a cipher:
+// the table path is not constant time, so use crypto/aes for secrets.
+
+// AESRound performs one AES encryption round on each 16-byte block of
+// state: ShiftRows, SubBytes and MixColumns, then XOR with roundKey.
+// Lanes beyond the last whole block are copied from state unchanged.
+func AESRound(state, roundKey Vec[uint8]) Vec[uint8] {
+	n := min(len(state.data), len(roundKey.data))
+	result := make([]uint8, n)
+	copy(result, state.data[:n])
+	aesRoundBlocks(result, state.data[:n], roundKey.data[:n])
+	return Vec[uint8]{data: result}
+}
+
+// AESLastRound performs the final AES encryption round on each 16-byte
+// block of state: like AESRound but without MixColumns.
+func AESLastRound(state, roundKey Vec[uint8]) Vec[uint8] {
+	n := min(len(state.data), len(roundKey.data))
+	result := make([]uint8, n)
+	copy(result, state.data[:n])
+	aesLastRoundBlocks(result, state.data[:n], roundKey.data[:n])
+	return Vec[uint8]{data: result}
+}
+
+// CarrylessMultiply returns the 128-bit carry-less (polynomial over GF(2))
+// product of each pair of lanes, split into low and high halves. It is the
+// per-lane form of PCLMULQDQ/PMULL.
+func CarrylessMultiply(a, b Vec[uint64]) (lo, hi Vec[uint64]) {
+	n := min(len(a.data), len(b.data))
+	loData := make([]uint64, n)
+	hiData := make([]uint64, n)
+	clmulLanes(a.data[:n], b.data[:n], loData, hiData)
+	return Vec[uint64]{data: loData}, Vec[uint64]{data: hiData}
+}
+
+// aesRoundBlocks writes AESRound of each whole 16-byte block of state to dst.
+func aesRoundBlocks(dst, state, key []uint8) {
+	if asm.HasAES && currentLevel != DispatchScalar {
+		asm.AESRound(dst, state, key)
+		return
+	}
+	for b := 0; b+16 <= len(dst); b += 16 {
+		aesRoundScalar((*[16]uint8)(dst[b:]), (*[16]uint8)(state[b:]), (*[16]uint8)(key[b:]), true)
+	}
+}
+
+// aesLastRoundBlocks writes AESLastRound of each whole 16-byte block of state to dst.
+func aesLastRoundBlocks(dst, state, key []uint8) {
+	if asm.HasAES && currentLevel != DispatchScalar {
+		asm.AESLastRound(dst, state, key)
+		return
+	}
+	for b := 0; b+16 <= len(dst); b += 16 {
+		aesRoundScalar((*[16]uint8)(dst[b:]), (*[16]uint8)(state[b:]), (*[16]uint8)(key[b:]), false)
+	}
+}
+
+// clmulLanes writes the carry-less product of a[i] and b[i] to (lo[i], hi[i]).
+func clmulLanes(a, b, lo, hi []uint64) {
+	if asm.HasCLMUL && currentLevel != DispatchScalar {
+		asm.CarrylessMultiply(a, b, lo, hi)
+		return
+	}
+	for i := range a {
+		lo[i], hi[i] = clmulScalar(a[i], b[i])
+	}
+}
+
+// aesRoundScalar is the table-driven AESENC (mix=true) or AESENCLAST
+// (mix=false) on one block. The state is column-major: byte 4*c+r is row r
+// of column c.
+func aesRoundScalar(dst, state, key *[16]uint8, mix bool) {
+	var t [16]uint8
+	for c := range 4 {
+		for r := range 4 {
+			// ShiftRows rotates row r left by r columns.
+			t[4*c+r] = aesSBox[state[4*((c+r)%4)+r]]
+		}
+	}
+	if mix {
+		for c := range 4 {
+			a0, a1, a2, a3 := t[4*c], t[4*c+1], t[4*c+2], t[4*c+3]
+			all := a0 ^ a1 ^ a2 ^ a3
+			t[4*c] = a0 ^ all ^ aesXtime(a0^a1)
+			t[4*c+1] = a1 ^ all ^ aesXtime(a1^a2)
+			t[4*c+2] = a2 ^ all ^ aesXtime(a2^a3)
+			t[4*c+3] = a3 ^ all ^ aesXtime(a3^a0)
+		}
+	}
+	for i := range 16 {
+		dst[i] = t[i] ^ key[i]
+	}
+}
+
+// aesXtime multiplies by x in GF(2^8) modulo the AES polynomial.
+func aesXtime(b uint8) uint8 {
+	return b<<1 ^ (b>>7)*0x1b
+}
+
+// clmulScalar returns the 128-bit carry-less product of a and b.
+func clmulScalar(a, b uint64) (lo, hi uint64) {
+	for i := range 64 {
+		if b&(1<<i) != 0 {
+			lo ^= a << i
+			if i > 0 {
+				hi ^= a >> (64 - i)
+			}
+		}
+	}
+	return lo, hi
+}
+
+// aesSBox is the AES SubBytes substitution table (FIPS 197, Figure 7).
+var aesSBox = [256]uint8{
+	0x63, 0x7c, 0x77, 0x7b, 0xf2, 0x6b, 0x6f, 0xc5, 0x30, 0x01, 0x67, 0x2b, 0xfe, 0xd7, 0xab, 0x76,
+	0xca, 0x82, 0xc9, 0x7d, 0xfa, 0x59, 0x47, 0xf0, 0xad, 0xd4, 0xa2, 0xaf, 0x9c, 0xa4, 0x72, 0xc0,
+	0xb7, 0xfd, 0x93, 0x26, 0x36, 0x3f, 0xf7, 0xcc, 0x34, 0xa5, 0xe5, 0xf1, 0x71, 0xd8, 0x31, 0x15,
+	0x04, 0xc7, 0x23, 0xc3, 0x18, 0x96, 0x05, 0x9a, 0x07, 0x12, 0x80, 0xe2, 0xeb, 0x27, 0xb2, 0x75,
+	0x09, 0x83, 0x2c, 0x1a, 0x1b, 0x6e, 0x5a, 0xa0, 0x52, 0x3b, 0xd6, 0xb3, 0x29, 0xe3, 0x2f, 0x84,
+	0x53, 0xd1, 0x00, 0xed, 0x20, 0xfc, 0xb1, 0x5b, 0x6a, 0xcb, 0xbe, 0x39, 0x4a, 0x4c, 0x58, 0xcf,
+	0xd0, 0xef, 0xaa, 0xfb, 0x43, 0x4d, 0x33, 0x85, 0x45, 0xf9, 0x02, 0x7f, 0x50, 0x3c, 0x9f, 0xa8,
+	0x51, 0xa3, 0x40, 0x8f, 0x92, 0x9d, 0x38, 0xf5, 0xbc, 0xb6, 0xda, 0x21, 0x10, 0xff, 0xf3, 0xd2,
+	0xcd, 0x0c, 0x13, 0xec, 0x5f, 0x97, 0x44, 0x17, 0xc4, 0xa7, 0x7e, 0x3d, 0x64, 0x5d, 0x19, 0x73,
+	0x60, 0x81, 0x4f, 0xdc, 0x22, 0x2a, 0x90, 0x88, 0x46, 0xee, 0xb8, 0x14, 0xde, 0x5e, 0x0b, 0xdb,
+	0xe0, 0x32, 0x3a, 0x0a, 0x49, 0x06, 0x24, 0x5c, 0xc2, 0xd3, 0xac, 0x62, 0x91, 0x95, 0xe4, 0x79,
+	0xe7, 0xc8, 0x37, 0x6d, 0x8d, 0xd5, 0x4e, 0xa9, 0x6c, 0x56, 0xf4, 0xea, 0x65, 0x7a, 0xae, 0x08,
+	0xba, 0x78, 0x25, 0x2e, 0x1c, 0xa6, 0xb4, 0xc6, 0xe8, 0xdd, 0x74, 0x1f, 0x4b, 0xbd, 0x8b, 0x8a,
+	0x70, 0x3e, 0xb5, 0x66, 0x48, 0x03, 0xf6, 0x0e, 0x61, 0x35, 0x57, 0xb9, 0x86, 0xc1, 0x1d, 0x9e,
+	0xe1, 0xf8, 0x98, 0x11, 0x69, 0xd9, 0x8e, 0x94, 0x9b, 0x1e, 0x87, 0xe9, 0xce, 0x55, 0x28, 0xdf,
+	0x8c, 0xa1, 0x89, 0x0d, 0xbf, 0xe6, 0x42, 0x68, 0x41, 0x99, 0x2d, 0x0f, 0xb0, 0x54, 0xbb, 0x16,
+}
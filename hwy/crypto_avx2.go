@@ -0,0 +1,50 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build amd64 && goexperiment.simd
+
+package hwy
+
+import "simd/archsimd"
+
+// This file provides AVX2 wrappers for the AES and carry-less multiply ops.
+// archsimd does not expose AESENC or PCLMULQDQ, so the blocks are spilled
+// and run through the AES-NI/PCLMULQDQ kernels in hwy/asm.
+
+// AESRound_AVX2_Uint8x32 applies one AES encryption round (AESENC) to each 16-byte block.
+func AESRound_AVX2_Uint8x32(state, roundKey archsimd.Uint8x32) archsimd.Uint8x32 {
+	var s, k, result [32]uint8
+	state.Store(&s)
+	roundKey.Store(&k)
+	aesRoundBlocks(result[:], s[:], k[:])
+	return archsimd.LoadUint8x32(&result)
+}
+
+// AESLastRound_AVX2_Uint8x32 applies the final AES encryption round (AESENCLAST) to each 16-byte block.
+func AESLastRound_AVX2_Uint8x32(state, roundKey archsimd.Uint8x32) archsimd.Uint8x32 {
+	var s, k, result [32]uint8
+	state.Store(&s)
+	roundKey.Store(&k)
+	aesLastRoundBlocks(result[:], s[:], k[:])
+	return archsimd.LoadUint8x32(&result)
+}
+
+// CarrylessMultiply_AVX2_Uint64x4 returns the low and high halves of each 128-bit carry-less product.
+func CarrylessMultiply_AVX2_Uint64x4(a, b archsimd.Uint64x4) (lo, hi archsimd.Uint64x4) {
+	var aData, bData, loData, hiData [4]uint64
+	a.Store(&aData)
+	b.Store(&bData)
+	clmulLanes(aData[:], bData[:], loData[:], hiData[:])
+	return archsimd.LoadUint64x4(&loData), archsimd.LoadUint64x4(&hiData)
+}
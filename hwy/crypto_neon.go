@@ -0,0 +1,50 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build arm64
+
+package hwy
+
+import "github.com/ajroetker/go-highway/hwy/asm"
+
+// This file provides NEON wrappers for the AES and carry-less multiply ops,
+// backed by the AESE/AESMC and PMULL kernels in hwy/asm when the CPU has
+// the ARMv8 crypto extensions.
+
+// AESRound_NEON_Uint8x16 applies one AES encryption round (AESENC) to each 16-byte block.
+func AESRound_NEON_Uint8x16(state, roundKey asm.Uint8x16) asm.Uint8x16 {
+	var s, k, result [16]uint8
+	state.Store(&s)
+	roundKey.Store(&k)
+	aesRoundBlocks(result[:], s[:], k[:])
+	return asm.LoadUint8x16(&result)
+}
+
+// AESLastRound_NEON_Uint8x16 applies the final AES encryption round (AESENCLAST) to each 16-byte block.
+func AESLastRound_NEON_Uint8x16(state, roundKey asm.Uint8x16) asm.Uint8x16 {
+	var s, k, result [16]uint8
+	state.Store(&s)
+	roundKey.Store(&k)
+	aesLastRoundBlocks(result[:], s[:], k[:])
+	return asm.LoadUint8x16(&result)
+}
+
+// CarrylessMultiply_NEON_Uint64x2 returns the low and high halves of each 128-bit carry-less product.
+func CarrylessMultiply_NEON_Uint64x2(a, b asm.Uint64x2) (lo, hi asm.Uint64x2) {
+	var aData, bData, loData, hiData [2]uint64
+	a.Store(&aData)
+	b.Store(&bData)
+	clmulLanes(aData[:], bData[:], loData[:], hiData[:])
+	return asm.LoadUint64x2(&loData), asm.LoadUint64x2(&hiData)
+}
@@ -0,0 +1,144 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hwy
+
+import (
+	"bytes"
+	"crypto/aes"
+	"math/rand/v2"
+	"testing"
+)
+
+// aesExpandKey128 is the AES-128 key schedule (FIPS 197, Section 5.2).
+func aesExpandKey128(key [16]uint8) [11][16]uint8 {
+	var rk [11][16]uint8
+	rk[0] = key
+	rcon := uint8(1)
+	for r := 1; r <= 10; r++ {
+		prev := rk[r-1]
+		t := [4]uint8{aesSBox[prev[13]] ^ rcon, aesSBox[prev[14]], aesSBox[prev[15]], aesSBox[prev[12]]}
+		for c := range 4 {
+			for i := range 4 {
+				t[i] ^= prev[4*c+i]
+				rk[r][4*c+i] = t[i]
+			}
+		}
+		rcon = aesXtime(rcon)
+	}
+	return rk
+}
+
+// forEachCryptoTarget runs fn on the detected target and on the scalar target.
+func forEachCryptoTarget(t *testing.T, fn func(t *testing.T)) {
+	t.Run("default", fn)
+	t.Run("scalar", func(t *testing.T) {
+		defer saveTarget()()
+		if err := ForceTarget("scalar"); err != nil {
+			t.Fatal(err)
+		}
+		fn(t)
+	})
+}
+
+func TestAESRoundMatchesCryptoAES(t *testing.T) {
+	forEachCryptoTarget(t, func(t *testing.T) {
+		rng := rand.New(rand.NewPCG(1, 2))
+		for range 20 {
+			var key [16]uint8
+			plain := make([]uint8, 32) // two independent blocks
+			for i := range key {
+				key[i] = uint8(rng.Uint32())
+			}
+			for i := range plain {
+				plain[i] = uint8(rng.Uint32())
+			}
+
+			rk := aesExpandKey128(key)
+			roundKey := func(r int) Vec[uint8] {
+				return Vec[uint8]{data: append(rk[r][:], rk[r][:]...)}
+			}
+			state := Xor(Vec[uint8]{data: plain}, roundKey(0))
+			for r := 1; r < 10; r++ {
+				state = AESRound(state, roundKey(r))
+			}
+			state = AESLastRound(state, roundKey(10))
+
+			block, err := aes.NewCipher(key[:])
+			if err != nil {
+				t.Fatal(err)
+			}
+			want := make([]uint8, 32)
+			block.Encrypt(want[:16], plain[:16])
+			block.Encrypt(want[16:], plain[16:])
+			if !bytes.Equal(state.data, want) {
+				t.Fatalf("AES-128 via AESRound = %x, want %x", state.data, want)
+			}
+		}
+	})
+}
+
+func TestAESRoundPartialBlock(t *testing.T) {
+	state := Vec[uint8]{data: make([]uint8, 20)}
+	for i := range state.data {
+		state.data[i] = uint8(i)
+	}
+	key := Vec[uint8]{data: make([]uint8, 20)}
+	result := AESRound(state, key)
+	// Lanes past the last whole block are copied through.
+	if !bytes.Equal(result.data[16:], state.data[16:]) {
+		t.Errorf("AESRound tail = %v, want %v", result.data[16:], state.data[16:])
+	}
+}
+
+func TestCarrylessMultiply(t *testing.T) {
+	forEachCryptoTarget(t, func(t *testing.T) {
+		tests := []struct {
+			a, b, lo, hi uint64
+		}{
+			{3, 3, 5, 0}, // (x+1)^2 = x^2+1
+			{0xDEADBEEF, 1, 0xDEADBEEF, 0},
+			{1 << 63, 1 << 63, 0, 1 << 62},
+			{^uint64(0), 2, ^uint64(1), 1},
+		}
+		a := Vec[uint64]{data: make([]uint64, len(tests))}
+		b := Vec[uint64]{data: make([]uint64, len(tests))}
+		for i, tt := range tests {
+			a.data[i], b.data[i] = tt.a, tt.b
+		}
+		lo, hi := CarrylessMultiply(a, b)
+		for i, tt := range tests {
+			if lo.data[i] != tt.lo || hi.data[i] != tt.hi {
+				t.Errorf("CarrylessMultiply(%#x, %#x) = (%#x, %#x), want (%#x, %#x)",
+					tt.a, tt.b, lo.data[i], hi.data[i], tt.lo, tt.hi)
+			}
+		}
+
+		// Cross-check against the scalar reference on random inputs.
+		rng := rand.New(rand.NewPCG(3, 4))
+		a.data = make([]uint64, 64)
+		b.data = make([]uint64, 64)
+		for i := range a.data {
+			a.data[i], b.data[i] = rng.Uint64(), rng.Uint64()
+		}
+		lo, hi = CarrylessMultiply(a, b)
+		for i := range a.data {
+			wantLo, wantHi := clmulScalar(a.data[i], b.data[i])
+			if lo.data[i] != wantLo || hi.data[i] != wantHi {
+				t.Fatalf("CarrylessMultiply(%#x, %#x) = (%#x, %#x), want (%#x, %#x)",
+					a.data[i], b.data[i], lo.data[i], hi.data[i], wantLo, wantHi)
+			}
+		}
+	})
+}
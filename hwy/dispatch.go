@@ -45,6 +45,10 @@ const (
 	// DispatchSME indicates ARM SME instructions (scalable matrix).
 	// SME provides dedicated matrix multiplication hardware with ZA tile registers.
 	DispatchSME
+
+	// DispatchRVV indicates RISC-V Vector extension (RVV 1.0) instructions
+	// (scalable vector).
+	DispatchRVV
 )
 
 // String returns a human-readable name for the dispatch level.
@@ -64,6 +68,8 @@ func (d DispatchLevel) String() string {
 		return "sve"
 	case DispatchSME:
 		return "sme"
+	case DispatchRVV:
+		return "rvv"
 	default:
 		return "unknown"
 	}
@@ -103,10 +109,14 @@ func HasSIMD() bool {
 	return currentLevel != DispatchScalar
 }
 
-// NoSimdEnv checks if the HWY_NO_SIMD environment variable is set.
+// NoSimdEnv checks if the HWY_NO_SIMD environment variable is set, or if
+// HWY_TARGET requests the scalar fallback.
 // When set, Highway will use scalar fallback regardless of CPU capabilities.
 // This is useful for testing and debugging.
 func NoSimdEnv() bool {
+	if level, ok := targetEnvLevel(); ok && level == DispatchScalar {
+		return true
+	}
 	val := os.Getenv("HWY_NO_SIMD")
 	if val == "" {
 		return false
@@ -74,6 +74,7 @@ func detectFP16BF16Features() {
 func setScalarMode() {
 	currentLevel = DispatchScalar
 	currentWidth = 16 // Use 16-byte vectors even in scalar mode for consistency
+	availableLevels = []DispatchLevel{DispatchScalar}
 }
 
 // HasF16C returns true if the CPU supports F16C instructions.
@@ -87,14 +88,26 @@ func HasF16C() bool {
 // AVX-512 FP16 provides native float16 arithmetic operations.
 // Present on Intel Sapphire Rapids+ CPUs (2023+).
 func HasAVX512FP16() bool {
-	return hasAVX512FP16
+	return hasAVX512FP16 && currentLevel == DispatchAVX512
 }
 
 // HasAVX512BF16 returns true if the CPU supports AVX-512 BF16 instructions.
 // AVX-512 BF16 provides bfloat16 dot product operations.
 // Present on Intel Cooper Lake+ and AMD Zen 4+ CPUs.
 func HasAVX512BF16() bool {
-	return hasAVX512BF16
+	return hasAVX512BF16 && currentLevel == DispatchAVX512
+}
+
+// HasAVX512VNNI returns false without GOEXPERIMENT=simd, since the dispatch
+// level is never AVX-512.
+func HasAVX512VNNI() bool {
+	return false
+}
+
+// HasAVX512VPOPCNTDQ returns false without GOEXPERIMENT=simd, since the
+// dispatch level is never AVX-512.
+func HasAVX512VPOPCNTDQ() bool {
+	return false
 }
 
 // HasARMFP16 returns false on x86 (ARM FP16 is ARM-specific).
@@ -19,6 +19,7 @@ package hwy
 import (
 	"simd/archsimd"
 
+	"github.com/ajroetker/go-highway/hwy/asm"
 	"golang.org/x/sys/cpu"
 )
 
@@ -35,8 +36,18 @@ var (
 	// hasAVX512BF16 indicates AVX-512 BF16 support: bfloat16 dot products (Cooper Lake+)
 	// Available from golang.org/x/sys/cpu
 	hasAVX512BF16 bool
+
+	// hasAVX512VNNI indicates AVX-512 VNNI support: int8/int16 dot products
+	// (VPDPBUSD/VPDPWSSD, Cascade Lake+ and AMD Zen 4+)
+	hasAVX512VNNI bool
+
+	// hasAVX512VPOPCNTDQ indicates native popcount on 32/64-bit lanes (Ice Lake+, Zen 4+)
+	hasAVX512VPOPCNTDQ bool
 )
 
+// cpuidAVX512FP16 is the AVX512-FP16 bit in CPUID leaf 7, subleaf 0, EDX.
+const cpuidAVX512FP16 = 1 << 23
+
 func init() {
 	// Check if SIMD is disabled via environment variable
 	if NoSimdEnv() {
@@ -46,6 +57,8 @@ func init() {
 
 	detectCPUFeatures()
 	detectFP16BF16Features()
+	detectAVX512Subsets()
+	applyTargetEnv()
 }
 
 func detectCPUFeatures() {
@@ -53,17 +66,21 @@ func detectCPUFeatures() {
 	if archsimd.X86.AVX512() {
 		currentLevel = DispatchAVX512
 		currentWidth = 64
+		availableLevels = []DispatchLevel{DispatchAVX512, DispatchAVX2, DispatchSSE2, DispatchScalar}
 	} else if archsimd.X86.AVX2() {
 		currentLevel = DispatchAVX2
 		currentWidth = 32
+		availableLevels = []DispatchLevel{DispatchAVX2, DispatchSSE2, DispatchScalar}
 	} else if archsimd.X86.AVX() {
 		// AVX without AVX2 - use 256-bit but limited ops
 		currentLevel = DispatchSSE2 // Treat as SSE2 for safety
 		currentWidth = 16
+		availableLevels = []DispatchLevel{DispatchSSE2, DispatchScalar}
 	} else {
 		// SSE2 is baseline for amd64
 		currentLevel = DispatchSSE2
 		currentWidth = 16
+		availableLevels = []DispatchLevel{DispatchSSE2, DispatchScalar}
 	}
 }
 
@@ -84,17 +101,32 @@ func detectFP16BF16Features() {
 	}
 
 	// AVX-512 FP16 detection
-	// Note: golang.org/x/sys/cpu doesn't have AVX512FP16 yet
-	// AVX-512 FP16 is very new (Sapphire Rapids, 2023) and requires:
-	// - CPUID leaf 7, subleaf 0, EDX bit 23
-	// For now, we leave this as false until x/sys/cpu adds support
-	// or we add direct CPUID detection
-	hasAVX512FP16 = false
+	// golang.org/x/sys/cpu doesn't have AVX512FP16 yet, so read
+	// CPUID leaf 7, subleaf 0, EDX bit 23 directly. x/sys/cpu has already
+	// checked that the OS saves the ZMM state when HasAVX512BW is set.
+	if cpu.X86.HasAVX512BW {
+		if maxLeaf, _, _, _ := asm.CPUID(0, 0); maxLeaf >= 7 {
+			_, _, _, edx := asm.CPUID(7, 0)
+			hasAVX512FP16 = edx&cpuidAVX512FP16 != 0
+		}
+	}
+}
+
+// detectAVX512Subsets detects the optional AVX-512 extensions. The
+// DispatchAVX512 level itself requires F, CD, BW, DQ and VL, so kernels
+// compiled for it can use those unconditionally.
+func detectAVX512Subsets() {
+	if !cpu.X86.HasAVX512 {
+		return
+	}
+	hasAVX512VNNI = cpu.X86.HasAVX512VNNI
+	hasAVX512VPOPCNTDQ = cpu.X86.HasAVX512VPOPCNTDQ
 }
 
 func setScalarMode() {
 	currentLevel = DispatchScalar
 	currentWidth = 16 // Use 16-byte vectors even in scalar mode for consistency
+	availableLevels = []DispatchLevel{DispatchScalar}
 }
 
 // HasF16C returns true if the CPU supports F16C instructions.
@@ -107,15 +139,33 @@ func HasF16C() bool {
 // HasAVX512FP16 returns true if the CPU supports AVX-512 FP16 instructions.
 // AVX-512 FP16 provides native float16 arithmetic operations.
 // Present on Intel Sapphire Rapids+ CPUs (2023+).
+//
+// Like the other AVX-512 subset queries, it only reports true while AVX-512
+// is the dispatch target, so callers fall back cleanly under HWY_NO_SIMD,
+// HWY_TARGET=avx2 or ForceTarget.
 func HasAVX512FP16() bool {
-	return hasAVX512FP16
+	return hasAVX512FP16 && currentLevel == DispatchAVX512
 }
 
 // HasAVX512BF16 returns true if the CPU supports AVX-512 BF16 instructions.
 // AVX-512 BF16 provides bfloat16 dot product operations.
 // Present on Intel Cooper Lake+ and AMD Zen 4+ CPUs.
 func HasAVX512BF16() bool {
-	return hasAVX512BF16
+	return hasAVX512BF16 && currentLevel == DispatchAVX512
+}
+
+// HasAVX512VNNI returns true if the CPU supports AVX-512 VNNI instructions.
+// AVX-512 VNNI provides int8 and int16 dot products accumulating into int32.
+// Present on Intel Cascade Lake+ and AMD Zen 4+ CPUs.
+func HasAVX512VNNI() bool {
+	return hasAVX512VNNI && currentLevel == DispatchAVX512
+}
+
+// HasAVX512VPOPCNTDQ returns true if the CPU supports AVX-512 VPOPCNTDQ.
+// VPOPCNTDQ provides per-lane popcount for 32-bit and 64-bit elements.
+// Present on Intel Ice Lake+ and AMD Zen 4+ CPUs.
+func HasAVX512VPOPCNTDQ() bool {
+	return hasAVX512VPOPCNTDQ && currentLevel == DispatchAVX512
 }
 
 // HasARMFP16 returns false on x86 (ARM FP16 is ARM-specific).
@@ -41,42 +41,53 @@ func init() {
 	if NoSimdEnv() {
 		currentLevel = DispatchScalar
 		currentWidth = 16
+		availableLevels = []DispatchLevel{DispatchScalar}
 		return
 	}
 
 	// ARM64 (AArch64) always has NEON (ASIMD) available.
 	// It's part of the ARMv8-A base architecture.
-	// We still check the cpu package for future SVE support.
 
 	// Note: cpu.ARM64.HasASIMD is always true for ARMv8+
-	// We check it for consistency and to enable SVE detection later.
+	// We check it for consistency.
+	availableLevels = []DispatchLevel{DispatchScalar}
 	if cpu.ARM64.HasASIMD {
 		currentLevel = DispatchNEON
 		currentWidth = 16 // NEON is 128-bit (16 bytes)
+		availableLevels = []DispatchLevel{DispatchNEON, DispatchScalar}
 	} else {
 		// Fallback to scalar (should never happen on ARMv8+)
 		currentLevel = DispatchScalar
 		currentWidth = 16
 	}
 
+	// SVE support (Graviton3+, Neoverse V1/V2/N2, A64FX)
+	// Check for HWY_NO_SVE environment variable to disable SVE.
+	// Compiled SVE kernels are bound by the z_c_*.gen.go files via HasSVE,
+	// so SVE is only recorded as available here. The *_arm64.gen.go
+	// dispatchers bind 128-bit NEON kernels, and MaxLanes must match them,
+	// so the current level and width stay at NEON even on 256-bit SVE.
+	if hasSVE && os.Getenv("HWY_NO_SVE") == "" {
+		availableLevels = append([]DispatchLevel{DispatchSVE}, availableLevels...)
+	}
+
 	// SME support (Apple M4+)
 	// Check for HWY_NO_SME environment variable to disable SME
 	if hasSME && os.Getenv("HWY_NO_SME") == "" {
 		currentLevel = DispatchSME
+		currentWidth = 16
+		availableLevels = append([]DispatchLevel{DispatchSME}, availableLevels...)
 		// Keep currentWidth at NEON width (16 bytes) until hwygen generates
 		// SVE/SME-width kernels. MaxLanes must match the dispatched kernel
 		// width, and all current arm64 kernels target NEON (128-bit).
 		// Packages that need SME use hwy.HasSME() for explicit dispatch.
 	}
 
-	// Future: SVE support (without SME streaming mode)
-	// if cpu.ARM64.HasSVE {
-	//     currentLevel = DispatchSVE
-	//     currentWidth = ... // SVE width is variable
-	// }
-
 	// Detect FP16/BF16 features
 	detectARMFP16BF16Features()
+
+	// Apply HWY_TARGET, e.g. HWY_TARGET=neon to skip SME kernels
+	applyTargetEnv()
 }
 
 func detectARMFP16BF16Features() {
@@ -124,3 +135,13 @@ func HasAVX512FP16() bool {
 func HasAVX512BF16() bool {
 	return false
 }
+
+// HasAVX512VNNI returns false on ARM64 (AVX-512 is x86-specific).
+func HasAVX512VNNI() bool {
+	return false
+}
+
+// HasAVX512VPOPCNTDQ returns false on ARM64 (AVX-512 is x86-specific).
+func HasAVX512VPOPCNTDQ() bool {
+	return false
+}
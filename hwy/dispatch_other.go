@@ -16,15 +16,23 @@
 
 package hwy
 
+import "os"
+
 func init() {
-	// Non-amd64 architectures fall back to scalar mode for now.
+	// Other architectures run the portable Vec[T] code in scalar mode.
+	// On linux/riscv64 with the V extension, hwygen's asm/C kernels
+	// (rvv target) override dispatch vars in their own init functions,
+	// so RVV is listed as available without changing the current level.
 	// Future implementations will add:
-	// - arm64: NEON and SVE support
 	// - wasm: SIMD128 support
-	// - riscv64: Vector extension support
 
 	currentLevel = DispatchScalar
 	currentWidth = 16 // Use 16-byte vectors even in scalar mode for consistency
+	availableLevels = []DispatchLevel{DispatchScalar}
+	if hasRVV && !NoSimdEnv() && os.Getenv("HWY_NO_RVV") == "" {
+		availableLevels = append([]DispatchLevel{DispatchRVV}, availableLevels...)
+	}
+	applyTargetEnv()
 }
 
 // HasF16C returns false on non-x86 platforms (F16C is an x86-specific feature).
@@ -42,6 +50,16 @@ func HasAVX512BF16() bool {
 	return false
 }
 
+// HasAVX512VNNI returns false on non-x86 platforms (AVX-512 is x86-specific).
+func HasAVX512VNNI() bool {
+	return false
+}
+
+// HasAVX512VPOPCNTDQ returns false on non-x86 platforms (AVX-512 is x86-specific).
+func HasAVX512VPOPCNTDQ() bool {
+	return false
+}
+
 // HasARMFP16 returns false on non-ARM64 platforms (ARM FP16 is ARM-specific).
 func HasARMFP16() bool {
 	return false
@@ -0,0 +1,187 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hwy
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+
+	"golang.org/x/sys/cpu"
+)
+
+// Runtime report.
+//
+// Features and KernelBindings describe what the process detected and which
+// implementation every dispatched function ended up bound to. Report
+// combines both into text meant to be pasted into bug reports; the structs
+// marshal to JSON for fleet telemetry:
+//
+//	fmt.Fprint(os.Stderr, hwy.Report())
+
+// CPUFeatures describes the CPU features detected at startup and the
+// dispatch target chosen from them. The feature fields describe the
+// hardware: they are not affected by HWY_NO_SIMD, HWY_TARGET or
+// ForceTarget, which only change Target and Width.
+type CPUFeatures struct {
+	Arch      string   `json:"arch"`      // runtime.GOARCH
+	Target    string   `json:"target"`    // see CurrentName
+	Width     int      `json:"width"`     // see CurrentWidth, in bytes
+	Available []string `json:"available"` // see AvailableTargets
+
+	// x86.
+	AVX2            bool `json:"avx2,omitempty"`
+	FMA             bool `json:"fma,omitempty"`
+	F16C            bool `json:"f16c,omitempty"`
+	AVXVNNI         bool `json:"avx_vnni,omitempty"`
+	AVX512F         bool `json:"avx512f,omitempty"`
+	AVX512DQ        bool `json:"avx512dq,omitempty"`
+	AVX512BW        bool `json:"avx512bw,omitempty"`
+	AVX512VL        bool `json:"avx512vl,omitempty"`
+	AVX512VNNI      bool `json:"avx512vnni,omitempty"`
+	AVX512VBMI      bool `json:"avx512vbmi,omitempty"`
+	AVX512VBMI2     bool `json:"avx512vbmi2,omitempty"`
+	AVX512BITALG    bool `json:"avx512bitalg,omitempty"`
+	AVX512VPOPCNTDQ bool `json:"avx512vpopcntdq,omitempty"`
+	AVX512BF16      bool `json:"avx512bf16,omitempty"`
+	AVX512FP16      bool `json:"avx512fp16,omitempty"`
+
+	// arm64.
+	NEON     bool `json:"neon,omitempty"`
+	FP16     bool `json:"fp16,omitempty"` // FEAT_FP16, half-precision arithmetic
+	BF16     bool `json:"bf16,omitempty"` // FEAT_BF16, only detected on darwin
+	DotProd  bool `json:"dotprod,omitempty"`
+	I8MM     bool `json:"i8mm,omitempty"`
+	SVE      bool `json:"sve,omitempty"`
+	SVE2     bool `json:"sve2,omitempty"`
+	SVEBytes int  `json:"sve_bytes,omitempty"` // see SVEVectorBytes
+	SME      bool `json:"sme,omitempty"`       // only detected on darwin
+
+	// riscv64.
+	RVV bool `json:"rvv,omitempty"`
+}
+
+// Features returns the CPU features of this process and its dispatch
+// target.
+func Features() CPUFeatures {
+	f := CPUFeatures{
+		Arch:   runtime.GOARCH,
+		Target: CurrentName(),
+		Width:  CurrentWidth(),
+
+		AVX2:            cpu.X86.HasAVX2,
+		FMA:             cpu.X86.HasFMA,
+		F16C:            cpu.X86.HasAVX && cpu.X86.HasFMA, // as detected by dispatch
+		AVXVNNI:         cpu.X86.HasAVXVNNI,
+		AVX512F:         cpu.X86.HasAVX512F,
+		AVX512DQ:        cpu.X86.HasAVX512DQ,
+		AVX512BW:        cpu.X86.HasAVX512BW,
+		AVX512VL:        cpu.X86.HasAVX512VL,
+		AVX512VNNI:      cpu.X86.HasAVX512VNNI,
+		AVX512VBMI:      cpu.X86.HasAVX512VBMI,
+		AVX512VBMI2:     cpu.X86.HasAVX512VBMI2,
+		AVX512BITALG:    cpu.X86.HasAVX512BITALG,
+		AVX512VPOPCNTDQ: cpu.X86.HasAVX512VPOPCNTDQ,
+		AVX512BF16:      cpu.X86.HasAVX512BF16,
+		AVX512FP16:      avx512FP16Detected(),
+
+		NEON:     cpu.ARM64.HasASIMD,
+		FP16:     cpu.ARM64.HasFPHP && cpu.ARM64.HasASIMDHP,
+		BF16:     hasBF16Darwin,
+		DotProd:  cpu.ARM64.HasASIMDDP,
+		I8MM:     cpu.ARM64.HasI8MM,
+		SVE:      hasSVE,
+		SVE2:     hasSVE && cpu.ARM64.HasSVE2,
+		SVEBytes: sveVectorBytes,
+		SME:      hasSME,
+
+		RVV: hasRVV,
+	}
+	for _, level := range AvailableTargets() {
+		f.Available = append(f.Available, level.String())
+	}
+	return f
+}
+
+// Flags returns the names of the detected features, in the order of the
+// CPUFeatures fields, e.g. ["neon", "fp16", "dotprod"].
+func (f CPUFeatures) Flags() []string {
+	var flags []string
+	for _, feature := range []struct {
+		name string
+		has  bool
+	}{
+		{"avx2", f.AVX2}, {"fma", f.FMA}, {"f16c", f.F16C}, {"avx_vnni", f.AVXVNNI},
+		{"avx512f", f.AVX512F}, {"avx512dq", f.AVX512DQ}, {"avx512bw", f.AVX512BW},
+		{"avx512vl", f.AVX512VL}, {"avx512vnni", f.AVX512VNNI}, {"avx512vbmi", f.AVX512VBMI},
+		{"avx512vbmi2", f.AVX512VBMI2}, {"avx512bitalg", f.AVX512BITALG},
+		{"avx512vpopcntdq", f.AVX512VPOPCNTDQ}, {"avx512bf16", f.AVX512BF16},
+		{"avx512fp16", f.AVX512FP16},
+		{"neon", f.NEON}, {"fp16", f.FP16}, {"bf16", f.BF16}, {"dotprod", f.DotProd},
+		{"i8mm", f.I8MM}, {"sve", f.SVE}, {"sve2", f.SVE2}, {"sme", f.SME},
+		{"rvv", f.RVV},
+	} {
+		if feature.has {
+			flags = append(flags, feature.name)
+		}
+	}
+	return flags
+}
+
+// String formats f on one line, e.g.
+// "arm64 target=neon width=16 available=neon,scalar features=neon,fp16,sve sve_bytes=32".
+func (f CPUFeatures) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s target=%s width=%d available=%s features=%s",
+		f.Arch, f.Target, f.Width, strings.Join(f.Available, ","), strings.Join(f.Flags(), ","))
+	if f.SVEBytes > 0 {
+		fmt.Fprintf(&b, " sve_bytes=%d", f.SVEBytes)
+	}
+	return b.String()
+}
+
+// KernelBinding pairs a registered dispatched function with the
+// implementation it is currently bound to.
+type KernelBinding struct {
+	Name string `json:"name"` // as registered with RegisterKernel, e.g. "vec.DotFloat32"
+	Impl string `json:"impl"` // see KernelImplementation
+}
+
+// KernelBindings returns every registered dispatched function with its
+// bound implementation, sorted by name.
+func KernelBindings() []KernelBinding {
+	names := Kernels()
+	bindings := make([]KernelBinding, 0, len(names))
+	for _, name := range names {
+		bindings = append(bindings, KernelBinding{Name: name, Impl: KernelImplementation(name)})
+	}
+	return bindings
+}
+
+// Report returns Features followed by KernelBindings, one kernel per line.
+// Only kernels of packages linked into the binary are listed.
+func Report() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "hwy: %s\n", Features())
+	bindings := KernelBindings()
+	width := 0
+	for _, k := range bindings {
+		width = max(width, len(k.Name))
+	}
+	for _, k := range bindings {
+		fmt.Fprintf(&b, "%-*s  %s\n", width, k.Name, k.Impl)
+	}
+	return b.String()
+}
@@ -0,0 +1,75 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hwy
+
+import (
+	"encoding/json"
+	"runtime"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestFeatures(t *testing.T) {
+	f := Features()
+	if f.Arch != runtime.GOARCH || f.Target != CurrentName() || f.Width != CurrentWidth() {
+		t.Errorf("Features() = %+v, want arch %s target %s width %d", f, runtime.GOARCH, CurrentName(), CurrentWidth())
+	}
+	if len(f.Available) == 0 || f.Available[len(f.Available)-1] != "scalar" {
+		t.Errorf("Available = %v, want a list ending with scalar", f.Available)
+	}
+	if f.SVE2 && !f.SVE {
+		t.Error("SVE2 reported without SVE")
+	}
+
+	var decoded CPUFeatures
+	data, err := json.Marshal(f)
+	if err != nil {
+		t.Fatalf("json.Marshal: %v", err)
+	}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("json.Unmarshal: %v", err)
+	}
+	if decoded.String() != f.String() {
+		t.Errorf("JSON round trip changed %q to %q", f, decoded)
+	}
+	for _, flag := range f.Flags() {
+		if !strings.Contains(f.String(), flag) {
+			t.Errorf("String() = %q, missing %s", f, flag)
+		}
+	}
+}
+
+func TestKernelBindings(t *testing.T) {
+	testSumFloat32 = sumFallback
+	RegisterKernel("hwy.testSumFloat32", &testSumFloat32)
+	defer func() {
+		kernelsMu.Lock()
+		delete(kernels, "hwy.testSumFloat32")
+		kernelsMu.Unlock()
+	}()
+
+	want := KernelBinding{Name: "hwy.testSumFloat32", Impl: "hwy.sumFallback"}
+	if !slices.Contains(KernelBindings(), want) {
+		t.Errorf("KernelBindings() = %v, missing %+v", KernelBindings(), want)
+	}
+	report := Report()
+	if !strings.HasPrefix(report, "hwy: "+Features().String()+"\n") {
+		t.Errorf("Report() does not start with the features:\n%s", report)
+	}
+	if !strings.Contains(report, "hwy.testSumFloat32  hwy.sumFallback\n") {
+		t.Errorf("Report() does not list hwy.testSumFloat32:\n%s", report)
+	}
+}
@@ -15,8 +15,8 @@
 package hwy
 
 // This file provides pure Go (scalar) implementations of gather and scatter operations.
-// When SIMD implementations are available (gather_avx2.go, gather_avx512.go),
-// they can be used for higher performance on supported hardware.
+// When SIMD implementations are available (gather_avx2.go, gather_avx512.go,
+// gather_neon.go), they can be used for higher performance on supported hardware.
 
 // GatherIndex loads elements from non-contiguous memory locations specified by indices.
 // For each lane i in the index vector, it loads src[indices[i]].
@@ -96,6 +96,39 @@ func GatherIndexOffset[T Lanes, I ~int32 | ~int64](src []T, base int, indices Ve
 	return Vec[T]{data: result}
 }
 
+// LoadStrided loads a vector from every stride-th element of src:
+// lane i holds src[i*stride]. Lanes whose index is past the end of src are
+// zero. This expresses column walks (transposed matvec, image columns)
+// without building an index vector; stride 1 is equivalent to LoadSlice.
+//
+// Example:
+//
+//	// Load column c of a row-major matrix with `cols` columns, starting at row r.
+//	v := hwy.LoadStrided(m[r*cols+c:], cols)
+func LoadStrided[T Lanes](src []T, stride int) Vec[T] {
+	n := MaxLanes[T]()
+	result := make([]T, n)
+	for i := range n {
+		idx := i * stride
+		if idx >= 0 && idx < len(src) {
+			result[i] = src[idx]
+		}
+		// else: leave as zero value
+	}
+	return Vec[T]{data: result}
+}
+
+// StoreStrided stores lane i of v to dst[i*stride]. Stores whose index is
+// past the end of dst are skipped. It is the inverse of LoadStrided.
+func StoreStrided[T Lanes](v Vec[T], dst []T, stride int) {
+	for i, val := range v.data {
+		idx := i * stride
+		if idx >= 0 && idx < len(dst) {
+			dst[idx] = val
+		}
+	}
+}
+
 // IndicesFromFunc creates an index vector by calling a function for each lane.
 // This is useful for creating custom gather patterns.
 func IndicesFromFunc[I ~int32 | ~int64](numLanes int, f func(lane int) I) Vec[I] {
@@ -267,3 +267,107 @@ func IndicesStride_AVX2_I64x4(start, stride int64) archsimd.Int64x4 {
 	}
 	return archsimd.LoadInt64x4Slice(result[:])
 }
+
+// LoadStrided_AVX2_F32x8 loads src[0], src[stride], ..., src[7*stride].
+// Lanes past the end of src are zero.
+func LoadStrided_AVX2_F32x8(src []float32, stride int) archsimd.Float32x8 {
+	var result [8]float32
+	for i := 0; i < 8; i++ {
+		idx := i * stride
+		if idx >= 0 && idx < len(src) {
+			result[i] = src[idx]
+		}
+	}
+	return archsimd.LoadFloat32x8Slice(result[:])
+}
+
+// StoreStrided_AVX2_F32x8 stores lane i of v to dst[i*stride].
+// Stores past the end of dst are skipped.
+func StoreStrided_AVX2_F32x8(v archsimd.Float32x8, dst []float32, stride int) {
+	var data [8]float32
+	v.Store(&data)
+	for i := 0; i < 8; i++ {
+		idx := i * stride
+		if idx >= 0 && idx < len(dst) {
+			dst[idx] = data[i]
+		}
+	}
+}
+
+// LoadStrided_AVX2_F64x4 loads src[0], src[stride], ..., src[3*stride].
+// Lanes past the end of src are zero.
+func LoadStrided_AVX2_F64x4(src []float64, stride int) archsimd.Float64x4 {
+	var result [4]float64
+	for i := 0; i < 4; i++ {
+		idx := i * stride
+		if idx >= 0 && idx < len(src) {
+			result[i] = src[idx]
+		}
+	}
+	return archsimd.LoadFloat64x4Slice(result[:])
+}
+
+// StoreStrided_AVX2_F64x4 stores lane i of v to dst[i*stride].
+// Stores past the end of dst are skipped.
+func StoreStrided_AVX2_F64x4(v archsimd.Float64x4, dst []float64, stride int) {
+	var data [4]float64
+	v.Store(&data)
+	for i := 0; i < 4; i++ {
+		idx := i * stride
+		if idx >= 0 && idx < len(dst) {
+			dst[idx] = data[i]
+		}
+	}
+}
+
+// LoadStrided_AVX2_I32x8 loads src[0], src[stride], ..., src[7*stride].
+// Lanes past the end of src are zero.
+func LoadStrided_AVX2_I32x8(src []int32, stride int) archsimd.Int32x8 {
+	var result [8]int32
+	for i := 0; i < 8; i++ {
+		idx := i * stride
+		if idx >= 0 && idx < len(src) {
+			result[i] = src[idx]
+		}
+	}
+	return archsimd.LoadInt32x8Slice(result[:])
+}
+
+// StoreStrided_AVX2_I32x8 stores lane i of v to dst[i*stride].
+// Stores past the end of dst are skipped.
+func StoreStrided_AVX2_I32x8(v archsimd.Int32x8, dst []int32, stride int) {
+	var data [8]int32
+	v.Store(&data)
+	for i := 0; i < 8; i++ {
+		idx := i * stride
+		if idx >= 0 && idx < len(dst) {
+			dst[idx] = data[i]
+		}
+	}
+}
+
+// LoadStrided_AVX2_I64x4 loads src[0], src[stride], ..., src[3*stride].
+// Lanes past the end of src are zero.
+func LoadStrided_AVX2_I64x4(src []int64, stride int) archsimd.Int64x4 {
+	var result [4]int64
+	for i := 0; i < 4; i++ {
+		idx := i * stride
+		if idx >= 0 && idx < len(src) {
+			result[i] = src[idx]
+		}
+	}
+	return archsimd.LoadInt64x4Slice(result[:])
+}
+
+// StoreStrided_AVX2_I64x4 stores lane i of v to dst[i*stride].
+// Stores past the end of dst are skipped.
+func StoreStrided_AVX2_I64x4(v archsimd.Int64x4, dst []int64, stride int) {
+	var data [4]int64
+	v.Store(&data)
+	for i := 0; i < 4; i++ {
+		idx := i * stride
+		if idx >= 0 && idx < len(dst) {
+			dst[idx] = data[i]
+		}
+	}
+}
@@ -259,3 +259,107 @@ func IndicesStride_AVX512_I64x8(start, stride int64) archsimd.Int64x8 {
 	}
 	return archsimd.LoadInt64x8Slice(result[:])
 }
+
+// LoadStrided_AVX512_F32x16 loads src[0], src[stride], ..., src[15*stride].
+// Lanes past the end of src are zero.
+func LoadStrided_AVX512_F32x16(src []float32, stride int) archsimd.Float32x16 {
+	var result [16]float32
+	for i := 0; i < 16; i++ {
+		idx := i * stride
+		if idx >= 0 && idx < len(src) {
+			result[i] = src[idx]
+		}
+	}
+	return archsimd.LoadFloat32x16Slice(result[:])
+}
+
+// StoreStrided_AVX512_F32x16 stores lane i of v to dst[i*stride].
+// Stores past the end of dst are skipped.
+func StoreStrided_AVX512_F32x16(v archsimd.Float32x16, dst []float32, stride int) {
+	var data [16]float32
+	v.Store(&data)
+	for i := 0; i < 16; i++ {
+		idx := i * stride
+		if idx >= 0 && idx < len(dst) {
+			dst[idx] = data[i]
+		}
+	}
+}
+
+// LoadStrided_AVX512_F64x8 loads src[0], src[stride], ..., src[7*stride].
+// Lanes past the end of src are zero.
+func LoadStrided_AVX512_F64x8(src []float64, stride int) archsimd.Float64x8 {
+	var result [8]float64
+	for i := 0; i < 8; i++ {
+		idx := i * stride
+		if idx >= 0 && idx < len(src) {
+			result[i] = src[idx]
+		}
+	}
+	return archsimd.LoadFloat64x8Slice(result[:])
+}
+
+// StoreStrided_AVX512_F64x8 stores lane i of v to dst[i*stride].
+// Stores past the end of dst are skipped.
+func StoreStrided_AVX512_F64x8(v archsimd.Float64x8, dst []float64, stride int) {
+	var data [8]float64
+	v.Store(&data)
+	for i := 0; i < 8; i++ {
+		idx := i * stride
+		if idx >= 0 && idx < len(dst) {
+			dst[idx] = data[i]
+		}
+	}
+}
+
+// LoadStrided_AVX512_I32x16 loads src[0], src[stride], ..., src[15*stride].
+// Lanes past the end of src are zero.
+func LoadStrided_AVX512_I32x16(src []int32, stride int) archsimd.Int32x16 {
+	var result [16]int32
+	for i := 0; i < 16; i++ {
+		idx := i * stride
+		if idx >= 0 && idx < len(src) {
+			result[i] = src[idx]
+		}
+	}
+	return archsimd.LoadInt32x16Slice(result[:])
+}
+
+// StoreStrided_AVX512_I32x16 stores lane i of v to dst[i*stride].
+// Stores past the end of dst are skipped.
+func StoreStrided_AVX512_I32x16(v archsimd.Int32x16, dst []int32, stride int) {
+	var data [16]int32
+	v.Store(&data)
+	for i := 0; i < 16; i++ {
+		idx := i * stride
+		if idx >= 0 && idx < len(dst) {
+			dst[idx] = data[i]
+		}
+	}
+}
+
+// LoadStrided_AVX512_I64x8 loads src[0], src[stride], ..., src[7*stride].
+// Lanes past the end of src are zero.
+func LoadStrided_AVX512_I64x8(src []int64, stride int) archsimd.Int64x8 {
+	var result [8]int64
+	for i := 0; i < 8; i++ {
+		idx := i * stride
+		if idx >= 0 && idx < len(src) {
+			result[i] = src[idx]
+		}
+	}
+	return archsimd.LoadInt64x8Slice(result[:])
+}
+
+// StoreStrided_AVX512_I64x8 stores lane i of v to dst[i*stride].
+// Stores past the end of dst are skipped.
+func StoreStrided_AVX512_I64x8(v archsimd.Int64x8, dst []int64, stride int) {
+	var data [8]int64
+	v.Store(&data)
+	for i := 0; i < 8; i++ {
+		idx := i * stride
+		if idx >= 0 && idx < len(dst) {
+			dst[idx] = data[i]
+		}
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build arm64
+
+package hwy
+
+import "github.com/ajroetker/go-highway/hwy/asm"
+
+// This file provides NEON implementations of strided loads and stores.
+// NEON has no gather or scatter, so lanes are assembled through a stack
+// array with the store/scalar/load pattern.
+
+// LoadStrided_NEON_F32x4 loads src[0], src[stride], ..., src[3*stride].
+// Lanes past the end of src are zero.
+func LoadStrided_NEON_F32x4(src []float32, stride int) asm.Float32x4 {
+	var result [4]float32
+	for i := 0; i < 4; i++ {
+		idx := i * stride
+		if idx >= 0 && idx < len(src) {
+			result[i] = src[idx]
+		}
+	}
+	return asm.LoadFloat32x4(&result)
+}
+
+// StoreStrided_NEON_F32x4 stores lane i of v to dst[i*stride].
+// Stores past the end of dst are skipped.
+func StoreStrided_NEON_F32x4(v asm.Float32x4, dst []float32, stride int) {
+	var data [4]float32
+	v.Store(&data)
+	for i := 0; i < 4; i++ {
+		idx := i * stride
+		if idx >= 0 && idx < len(dst) {
+			dst[idx] = data[i]
+		}
+	}
+}
+
+// LoadStrided_NEON_F64x2 loads src[0], src[stride], ..., src[1*stride].
+// Lanes past the end of src are zero.
+func LoadStrided_NEON_F64x2(src []float64, stride int) asm.Float64x2 {
+	var result [2]float64
+	for i := 0; i < 2; i++ {
+		idx := i * stride
+		if idx >= 0 && idx < len(src) {
+			result[i] = src[idx]
+		}
+	}
+	return asm.LoadFloat64x2(&result)
+}
+
+// StoreStrided_NEON_F64x2 stores lane i of v to dst[i*stride].
+// Stores past the end of dst are skipped.
+func StoreStrided_NEON_F64x2(v asm.Float64x2, dst []float64, stride int) {
+	var data [2]float64
+	v.Store(&data)
+	for i := 0; i < 2; i++ {
+		idx := i * stride
+		if idx >= 0 && idx < len(dst) {
+			dst[idx] = data[i]
+		}
+	}
+}
+
+// LoadStrided_NEON_I32x4 loads src[0], src[stride], ..., src[3*stride].
+// Lanes past the end of src are zero.
+func LoadStrided_NEON_I32x4(src []int32, stride int) asm.Int32x4 {
+	var result [4]int32
+	for i := 0; i < 4; i++ {
+		idx := i * stride
+		if idx >= 0 && idx < len(src) {
+			result[i] = src[idx]
+		}
+	}
+	return asm.LoadInt32x4(&result)
+}
+
+// StoreStrided_NEON_I32x4 stores lane i of v to dst[i*stride].
+// Stores past the end of dst are skipped.
+func StoreStrided_NEON_I32x4(v asm.Int32x4, dst []int32, stride int) {
+	var data [4]int32
+	v.Store(&data)
+	for i := 0; i < 4; i++ {
+		idx := i * stride
+		if idx >= 0 && idx < len(dst) {
+			dst[idx] = data[i]
+		}
+	}
+}
+
+// LoadStrided_NEON_I64x2 loads src[0], src[stride], ..., src[1*stride].
+// Lanes past the end of src are zero.
+func LoadStrided_NEON_I64x2(src []int64, stride int) asm.Int64x2 {
+	var result [2]int64
+	for i := 0; i < 2; i++ {
+		idx := i * stride
+		if idx >= 0 && idx < len(src) {
+			result[i] = src[idx]
+		}
+	}
+	return asm.LoadInt64x2(&result)
+}
+
+// StoreStrided_NEON_I64x2 stores lane i of v to dst[i*stride].
+// Stores past the end of dst are skipped.
+func StoreStrided_NEON_I64x2(v asm.Int64x2, dst []int64, stride int) {
+	var data [2]int64
+	v.Store(&data)
+	for i := 0; i < 2; i++ {
+		idx := i * stride
+		if idx >= 0 && idx < len(dst) {
+			dst[idx] = data[i]
+		}
+	}
+}
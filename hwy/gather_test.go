@@ -405,3 +405,55 @@ func BenchmarkScatterIndex(b *testing.B) {
 		ScatterIndex(v, dst, indices)
 	}
 }
+
+func TestLoadStrided(t *testing.T) {
+	const cols = 3
+	lanes := MaxLanes[float32]()
+	m := make([]float32, lanes*cols)
+	for i := range m {
+		m[i] = float32(i)
+	}
+
+	// Column 1 of a row-major matrix.
+	v := LoadStrided(m[1:], cols)
+	for i := range lanes {
+		if want := float32(i*cols + 1); v.data[i] != want {
+			t.Errorf("LoadStrided lane %d: got %v, want %v", i, v.data[i], want)
+		}
+	}
+
+	// Lanes past the end of src are zero.
+	short := LoadStrided(m[:4], 2)
+	want := []float32{0, 2}
+	for i := range lanes {
+		w := float32(0)
+		if i < len(want) {
+			w = want[i]
+		}
+		if short.data[i] != w {
+			t.Errorf("LoadStrided short lane %d: got %v, want %v", i, short.data[i], w)
+		}
+	}
+}
+
+func TestStoreStrided(t *testing.T) {
+	lanes := MaxLanes[int32]()
+	dst := make([]int32, 2*lanes-1)
+	StoreStrided(IndicesIota[int32](lanes), dst, 2)
+	for i := range dst {
+		want := int32(0)
+		if i%2 == 0 {
+			want = int32(i / 2)
+		}
+		if dst[i] != want {
+			t.Errorf("StoreStrided dst[%d]: got %v, want %v", i, dst[i], want)
+		}
+	}
+
+	// Stores past the end of dst are skipped.
+	tiny := make([]int32, 3)
+	StoreStrided(Set[int32](7), tiny, 2)
+	if tiny[0] != 7 || tiny[1] != 0 || tiny[2] != 7 {
+		t.Errorf("StoreStrided short: got %v, want [7 0 7]", tiny)
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hwy
+
+// This file provides half- and quarter-width vector operations, mirroring
+// Highway's Half<D>/Quarter<D> descriptors.
+//
+// A half vector holds the lower or upper half of the lanes of a full vector
+// (e.g. the lower 128 bits of an AVX2 register). Widening a half vector with
+// PromoteF32ToF64, PromoteI16ToI32, etc. yields a full-width vector, so
+// conversions no longer need to go through Lower/Upper pairs or scalar code.
+// Quarter vectors do the same for 4x widening (int8 -> int32).
+//
+// In the portable API a half vector is simply a Vec[T] with half the lanes.
+// Per-target wrappers return the native narrower register type
+// (archsimd.Float32x4 for AVX2 Float32x8, asm.Float32x2 for NEON Float32x4).
+// A byte quarter that is narrower than 128 bits is carried in the lower lanes
+// of a 128-bit vector (archsimd.Int8x16, asm.Int8x16).
+
+// LowerHalf returns the lower half of the lanes of v.
+func LowerHalf[T Lanes](v Vec[T]) Vec[T] {
+	n := len(v.data) / 2
+	result := make([]T, n)
+	copy(result, v.data[:n])
+	return Vec[T]{data: result}
+}
+
+// UpperHalf returns the upper half of the lanes of v.
+func UpperHalf[T Lanes](v Vec[T]) Vec[T] {
+	half := len(v.data) / 2
+	result := make([]T, len(v.data)-half)
+	copy(result, v.data[half:])
+	return Vec[T]{data: result}
+}
+
+// LowerQuarter returns the lowest quarter of the lanes of v.
+// Combined with PromoteI8ToI32 or PromoteU8ToU32 it widens the first
+// quarter of a byte vector into a full int32 vector.
+func LowerQuarter[T Lanes](v Vec[T]) Vec[T] {
+	n := len(v.data) / 4
+	result := make([]T, n)
+	copy(result, v.data[:n])
+	return Vec[T]{data: result}
+}
+
+// Combine concatenates two half vectors into a full vector, with lo in the
+// lower lanes and hi in the upper lanes. The argument order (hi, lo)
+// matches Highway's Combine.
+func Combine[T Lanes](hi, lo Vec[T]) Vec[T] {
+	result := make([]T, len(lo.data)+len(hi.data))
+	copy(result, lo.data)
+	copy(result[len(lo.data):], hi.data)
+	return Vec[T]{data: result}
+}
+
+// ZeroExtendVector widens a half vector to a full vector whose upper half
+// is zero.
+func ZeroExtendVector[T Lanes](lo Vec[T]) Vec[T] {
+	result := make([]T, 2*len(lo.data))
+	copy(result, lo.data)
+	return Vec[T]{data: result}
+}
+
+// PromoteI8ToI32 widens each int8 lane to int32.
+// Typically applied to a quarter vector from LowerQuarter.
+func PromoteI8ToI32(v Vec[int8]) Vec[int32] {
+	result := make([]int32, len(v.data))
+	for i, x := range v.data {
+		result[i] = int32(x)
+	}
+	return Vec[int32]{data: result}
+}
+
+// PromoteU8ToU32 widens each uint8 lane to uint32.
+// Typically applied to a quarter vector from LowerQuarter.
+func PromoteU8ToU32(v Vec[uint8]) Vec[uint32] {
+	result := make([]uint32, len(v.data))
+	for i, x := range v.data {
+		result[i] = uint32(x)
+	}
+	return Vec[uint32]{data: result}
+}
@@ -0,0 +1,173 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build amd64 && goexperiment.simd
+
+package hwy
+
+import "simd/archsimd"
+
+// This file provides AVX2 half-vector operations. The half of a 256-bit
+// vector is the corresponding 128-bit archsimd type, accessed with
+// GetLo/GetHi/SetLo/SetHi (VEXTRACTF128/VINSERTF128).
+
+// LowerHalf_AVX2_F32x8 returns the lower 4 lanes of v.
+func LowerHalf_AVX2_F32x8(v archsimd.Float32x8) archsimd.Float32x4 {
+	return v.GetLo()
+}
+
+// UpperHalf_AVX2_F32x8 returns the upper 4 lanes of v.
+func UpperHalf_AVX2_F32x8(v archsimd.Float32x8) archsimd.Float32x4 {
+	return v.GetHi()
+}
+
+// Combine_AVX2_F32x8 concatenates lo (lower lanes) and hi (upper lanes).
+func Combine_AVX2_F32x8(hi, lo archsimd.Float32x4) archsimd.Float32x8 {
+	var result archsimd.Float32x8
+	return result.SetLo(lo).SetHi(hi)
+}
+
+// ZeroExtendVector_AVX2_F32x8 widens lo to a full vector with zero upper lanes.
+func ZeroExtendVector_AVX2_F32x8(lo archsimd.Float32x4) archsimd.Float32x8 {
+	var result archsimd.Float32x8
+	return result.SetLo(lo)
+}
+
+// LowerHalf_AVX2_F64x4 returns the lower 2 lanes of v.
+func LowerHalf_AVX2_F64x4(v archsimd.Float64x4) archsimd.Float64x2 {
+	return v.GetLo()
+}
+
+// UpperHalf_AVX2_F64x4 returns the upper 2 lanes of v.
+func UpperHalf_AVX2_F64x4(v archsimd.Float64x4) archsimd.Float64x2 {
+	return v.GetHi()
+}
+
+// Combine_AVX2_F64x4 concatenates lo (lower lanes) and hi (upper lanes).
+func Combine_AVX2_F64x4(hi, lo archsimd.Float64x2) archsimd.Float64x4 {
+	var result archsimd.Float64x4
+	return result.SetLo(lo).SetHi(hi)
+}
+
+// ZeroExtendVector_AVX2_F64x4 widens lo to a full vector with zero upper lanes.
+func ZeroExtendVector_AVX2_F64x4(lo archsimd.Float64x2) archsimd.Float64x4 {
+	var result archsimd.Float64x4
+	return result.SetLo(lo)
+}
+
+// LowerHalf_AVX2_I32x8 returns the lower 4 lanes of v.
+func LowerHalf_AVX2_I32x8(v archsimd.Int32x8) archsimd.Int32x4 {
+	return v.GetLo()
+}
+
+// UpperHalf_AVX2_I32x8 returns the upper 4 lanes of v.
+func UpperHalf_AVX2_I32x8(v archsimd.Int32x8) archsimd.Int32x4 {
+	return v.GetHi()
+}
+
+// Combine_AVX2_I32x8 concatenates lo (lower lanes) and hi (upper lanes).
+func Combine_AVX2_I32x8(hi, lo archsimd.Int32x4) archsimd.Int32x8 {
+	var result archsimd.Int32x8
+	return result.SetLo(lo).SetHi(hi)
+}
+
+// ZeroExtendVector_AVX2_I32x8 widens lo to a full vector with zero upper lanes.
+func ZeroExtendVector_AVX2_I32x8(lo archsimd.Int32x4) archsimd.Int32x8 {
+	var result archsimd.Int32x8
+	return result.SetLo(lo)
+}
+
+// LowerHalf_AVX2_I64x4 returns the lower 2 lanes of v.
+func LowerHalf_AVX2_I64x4(v archsimd.Int64x4) archsimd.Int64x2 {
+	return v.GetLo()
+}
+
+// UpperHalf_AVX2_I64x4 returns the upper 2 lanes of v.
+func UpperHalf_AVX2_I64x4(v archsimd.Int64x4) archsimd.Int64x2 {
+	return v.GetHi()
+}
+
+// Combine_AVX2_I64x4 concatenates lo (lower lanes) and hi (upper lanes).
+func Combine_AVX2_I64x4(hi, lo archsimd.Int64x2) archsimd.Int64x4 {
+	var result archsimd.Int64x4
+	return result.SetLo(lo).SetHi(hi)
+}
+
+// ZeroExtendVector_AVX2_I64x4 widens lo to a full vector with zero upper lanes.
+func ZeroExtendVector_AVX2_I64x4(lo archsimd.Int64x2) archsimd.Int64x4 {
+	var result archsimd.Int64x4
+	return result.SetLo(lo)
+}
+
+// PromoteF32ToF64_AVX2 promotes a half vector of 4 float32 lanes to a full vector of 4 float64 lanes.
+func PromoteF32ToF64_AVX2(v archsimd.Float32x4) archsimd.Float64x4 {
+	var data [4]float32
+	v.Store(&data)
+
+	var result [4]float64
+	for i := 0; i < 4; i++ {
+		result[i] = float64(data[i])
+	}
+	return archsimd.LoadFloat64x4Slice(result[:])
+}
+
+// PromoteI32ToI64_AVX2 promotes a half vector of 4 int32 lanes to a full vector of 4 int64 lanes.
+func PromoteI32ToI64_AVX2(v archsimd.Int32x4) archsimd.Int64x4 {
+	var data [4]int32
+	v.Store(&data)
+
+	var result [4]int64
+	for i := 0; i < 4; i++ {
+		result[i] = int64(data[i])
+	}
+	return archsimd.LoadInt64x4Slice(result[:])
+}
+
+// LowerQuarter_AVX2_Int8x32 returns the lower 8 lanes of v in the lower
+// lanes of an Int8x16; the upper 8 lanes are unspecified.
+func LowerQuarter_AVX2_Int8x32(v archsimd.Int8x32) archsimd.Int8x16 {
+	return v.GetLo()
+}
+
+// LowerQuarter_AVX2_Uint8x32 returns the lower 8 lanes of v in the lower
+// lanes of a Uint8x16; the upper 8 lanes are unspecified.
+func LowerQuarter_AVX2_Uint8x32(v archsimd.Uint8x32) archsimd.Uint8x16 {
+	return v.GetLo()
+}
+
+// PromoteI8ToI32_AVX2_Int8x32 promotes the quarter vector of 8 int8 lanes
+// returned by LowerQuarter_AVX2_Int8x32 to a full vector of 8 int32 lanes.
+func PromoteI8ToI32_AVX2_Int8x32(v archsimd.Int8x16) archsimd.Int32x8 {
+	var data [16]int8
+	v.Store(&data)
+
+	var result [8]int32
+	for i := 0; i < 8; i++ {
+		result[i] = int32(data[i])
+	}
+	return archsimd.LoadInt32x8Slice(result[:])
+}
+
+// PromoteU8ToU32_AVX2_Uint8x32 promotes the quarter vector of 8 uint8 lanes
+// returned by LowerQuarter_AVX2_Uint8x32 to a full vector of 8 uint32 lanes.
+func PromoteU8ToU32_AVX2_Uint8x32(v archsimd.Uint8x16) archsimd.Uint32x8 {
+	var data [16]uint8
+	v.Store(&data)
+
+	var result [8]uint32
+	for i := 0; i < 8; i++ {
+		result[i] = uint32(data[i])
+	}
+	return archsimd.LoadUint32x8Slice(result[:])
+}
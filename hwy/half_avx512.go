@@ -0,0 +1,171 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build amd64 && goexperiment.simd
+
+package hwy
+
+import "simd/archsimd"
+
+// This file provides AVX-512 half-vector operations. The half of a 512-bit
+// vector is the corresponding 256-bit archsimd type, accessed with
+// GetLo/GetHi/SetLo/SetHi (VEXTRACTF64X4/VINSERTF64X4).
+
+// LowerHalf_AVX512_F32x16 returns the lower 8 lanes of v.
+func LowerHalf_AVX512_F32x16(v archsimd.Float32x16) archsimd.Float32x8 {
+	return v.GetLo()
+}
+
+// UpperHalf_AVX512_F32x16 returns the upper 8 lanes of v.
+func UpperHalf_AVX512_F32x16(v archsimd.Float32x16) archsimd.Float32x8 {
+	return v.GetHi()
+}
+
+// Combine_AVX512_F32x16 concatenates lo (lower lanes) and hi (upper lanes).
+func Combine_AVX512_F32x16(hi, lo archsimd.Float32x8) archsimd.Float32x16 {
+	var result archsimd.Float32x16
+	return result.SetLo(lo).SetHi(hi)
+}
+
+// ZeroExtendVector_AVX512_F32x16 widens lo to a full vector with zero upper lanes.
+func ZeroExtendVector_AVX512_F32x16(lo archsimd.Float32x8) archsimd.Float32x16 {
+	var result archsimd.Float32x16
+	return result.SetLo(lo)
+}
+
+// LowerHalf_AVX512_F64x8 returns the lower 4 lanes of v.
+func LowerHalf_AVX512_F64x8(v archsimd.Float64x8) archsimd.Float64x4 {
+	return v.GetLo()
+}
+
+// UpperHalf_AVX512_F64x8 returns the upper 4 lanes of v.
+func UpperHalf_AVX512_F64x8(v archsimd.Float64x8) archsimd.Float64x4 {
+	return v.GetHi()
+}
+
+// Combine_AVX512_F64x8 concatenates lo (lower lanes) and hi (upper lanes).
+func Combine_AVX512_F64x8(hi, lo archsimd.Float64x4) archsimd.Float64x8 {
+	var result archsimd.Float64x8
+	return result.SetLo(lo).SetHi(hi)
+}
+
+// ZeroExtendVector_AVX512_F64x8 widens lo to a full vector with zero upper lanes.
+func ZeroExtendVector_AVX512_F64x8(lo archsimd.Float64x4) archsimd.Float64x8 {
+	var result archsimd.Float64x8
+	return result.SetLo(lo)
+}
+
+// LowerHalf_AVX512_I32x16 returns the lower 8 lanes of v.
+func LowerHalf_AVX512_I32x16(v archsimd.Int32x16) archsimd.Int32x8 {
+	return v.GetLo()
+}
+
+// UpperHalf_AVX512_I32x16 returns the upper 8 lanes of v.
+func UpperHalf_AVX512_I32x16(v archsimd.Int32x16) archsimd.Int32x8 {
+	return v.GetHi()
+}
+
+// Combine_AVX512_I32x16 concatenates lo (lower lanes) and hi (upper lanes).
+func Combine_AVX512_I32x16(hi, lo archsimd.Int32x8) archsimd.Int32x16 {
+	var result archsimd.Int32x16
+	return result.SetLo(lo).SetHi(hi)
+}
+
+// ZeroExtendVector_AVX512_I32x16 widens lo to a full vector with zero upper lanes.
+func ZeroExtendVector_AVX512_I32x16(lo archsimd.Int32x8) archsimd.Int32x16 {
+	var result archsimd.Int32x16
+	return result.SetLo(lo)
+}
+
+// LowerHalf_AVX512_I64x8 returns the lower 4 lanes of v.
+func LowerHalf_AVX512_I64x8(v archsimd.Int64x8) archsimd.Int64x4 {
+	return v.GetLo()
+}
+
+// UpperHalf_AVX512_I64x8 returns the upper 4 lanes of v.
+func UpperHalf_AVX512_I64x8(v archsimd.Int64x8) archsimd.Int64x4 {
+	return v.GetHi()
+}
+
+// Combine_AVX512_I64x8 concatenates lo (lower lanes) and hi (upper lanes).
+func Combine_AVX512_I64x8(hi, lo archsimd.Int64x4) archsimd.Int64x8 {
+	var result archsimd.Int64x8
+	return result.SetLo(lo).SetHi(hi)
+}
+
+// ZeroExtendVector_AVX512_I64x8 widens lo to a full vector with zero upper lanes.
+func ZeroExtendVector_AVX512_I64x8(lo archsimd.Int64x4) archsimd.Int64x8 {
+	var result archsimd.Int64x8
+	return result.SetLo(lo)
+}
+
+// PromoteF32ToF64_AVX512 promotes a half vector of 8 float32 lanes to a full vector of 8 float64 lanes.
+func PromoteF32ToF64_AVX512(v archsimd.Float32x8) archsimd.Float64x8 {
+	var data [8]float32
+	v.Store(&data)
+
+	var result [8]float64
+	for i := 0; i < 8; i++ {
+		result[i] = float64(data[i])
+	}
+	return archsimd.LoadFloat64x8Slice(result[:])
+}
+
+// PromoteI32ToI64_AVX512 promotes a half vector of 8 int32 lanes to a full vector of 8 int64 lanes.
+func PromoteI32ToI64_AVX512(v archsimd.Int32x8) archsimd.Int64x8 {
+	var data [8]int32
+	v.Store(&data)
+
+	var result [8]int64
+	for i := 0; i < 8; i++ {
+		result[i] = int64(data[i])
+	}
+	return archsimd.LoadInt64x8Slice(result[:])
+}
+
+// LowerQuarter_AVX512_Int8x64 returns the lower 16 lanes of v.
+func LowerQuarter_AVX512_Int8x64(v archsimd.Int8x64) archsimd.Int8x16 {
+	return v.GetLo().GetLo()
+}
+
+// LowerQuarter_AVX512_Uint8x64 returns the lower 16 lanes of v.
+func LowerQuarter_AVX512_Uint8x64(v archsimd.Uint8x64) archsimd.Uint8x16 {
+	return v.GetLo().GetLo()
+}
+
+// PromoteI8ToI32_AVX512_Int8x64 promotes a quarter vector of 16 int8 lanes
+// to a full vector of 16 int32 lanes.
+func PromoteI8ToI32_AVX512_Int8x64(v archsimd.Int8x16) archsimd.Int32x16 {
+	var data [16]int8
+	v.Store(&data)
+
+	var result [16]int32
+	for i := 0; i < 16; i++ {
+		result[i] = int32(data[i])
+	}
+	return archsimd.LoadInt32x16Slice(result[:])
+}
+
+// PromoteU8ToU32_AVX512_Uint8x64 promotes a quarter vector of 16 uint8 lanes
+// to a full vector of 16 uint32 lanes.
+func PromoteU8ToU32_AVX512_Uint8x64(v archsimd.Uint8x16) archsimd.Uint32x16 {
+	var data [16]uint8
+	v.Store(&data)
+
+	var result [16]uint32
+	for i := 0; i < 16; i++ {
+		result[i] = uint32(data[i])
+	}
+	return archsimd.LoadUint32x16Slice(result[:])
+}
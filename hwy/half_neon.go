@@ -0,0 +1,119 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build arm64
+
+package hwy
+
+import "github.com/ajroetker/go-highway/hwy/asm"
+
+// This file provides NEON half-vector operations. The half of a 128-bit
+// vector is a 64-bit D register (asm.Float32x2, asm.Int32x2). The asm
+// vector types are byte arrays, so halves are plain sub-array copies.
+// 64-bit lanes have no half vector type on NEON. Byte quarters have no
+// 32-bit vector type either, so they are carried in the lower lanes of a
+// full Int8x16/Uint8x16.
+
+// LowerHalf_NEON_F32x4 returns the lower 2 lanes of v.
+func LowerHalf_NEON_F32x4(v asm.Float32x4) asm.Float32x2 {
+	return asm.Float32x2(v[:8])
+}
+
+// UpperHalf_NEON_F32x4 returns the upper 2 lanes of v.
+func UpperHalf_NEON_F32x4(v asm.Float32x4) asm.Float32x2 {
+	return asm.Float32x2(v[8:])
+}
+
+// Combine_NEON_F32x4 concatenates lo (lower lanes) and hi (upper lanes).
+func Combine_NEON_F32x4(hi, lo asm.Float32x2) asm.Float32x4 {
+	var result asm.Float32x4
+	copy(result[:8], lo[:])
+	copy(result[8:], hi[:])
+	return result
+}
+
+// ZeroExtendVector_NEON_F32x4 widens lo to a full vector with zero upper lanes.
+func ZeroExtendVector_NEON_F32x4(lo asm.Float32x2) asm.Float32x4 {
+	var result asm.Float32x4
+	copy(result[:8], lo[:])
+	return result
+}
+
+// LowerHalf_NEON_I32x4 returns the lower 2 lanes of v.
+func LowerHalf_NEON_I32x4(v asm.Int32x4) asm.Int32x2 {
+	return asm.Int32x2(v[:8])
+}
+
+// UpperHalf_NEON_I32x4 returns the upper 2 lanes of v.
+func UpperHalf_NEON_I32x4(v asm.Int32x4) asm.Int32x2 {
+	return asm.Int32x2(v[8:])
+}
+
+// Combine_NEON_I32x4 concatenates lo (lower lanes) and hi (upper lanes).
+func Combine_NEON_I32x4(hi, lo asm.Int32x2) asm.Int32x4 {
+	var result asm.Int32x4
+	copy(result[:8], lo[:])
+	copy(result[8:], hi[:])
+	return result
+}
+
+// ZeroExtendVector_NEON_I32x4 widens lo to a full vector with zero upper lanes.
+func ZeroExtendVector_NEON_I32x4(lo asm.Int32x2) asm.Int32x4 {
+	var result asm.Int32x4
+	copy(result[:8], lo[:])
+	return result
+}
+
+// PromoteF32ToF64_NEON promotes a half vector of 2 float32 lanes to a full
+// vector of 2 float64 lanes (FCVTL).
+func PromoteF32ToF64_NEON(v asm.Float32x2) asm.Float64x2 {
+	return v.ConvertToFloat64x2()
+}
+
+// PromoteI32ToI64_NEON promotes a half vector of 2 int32 lanes to a full
+// vector of 2 int64 lanes.
+func PromoteI32ToI64_NEON(v asm.Int32x2) asm.Int64x2 {
+	result := [2]int64{int64(v.Get(0)), int64(v.Get(1))}
+	return asm.LoadInt64x2(&result)
+}
+
+// LowerQuarter_NEON_Int8x16 returns the lower 4 lanes of v in the lower
+// lanes of an Int8x16 whose upper lanes are zero.
+func LowerQuarter_NEON_Int8x16(v asm.Int8x16) asm.Int8x16 {
+	var result asm.Int8x16
+	copy(result[:4], v[:4])
+	return result
+}
+
+// LowerQuarter_NEON_Uint8x16 returns the lower 4 lanes of v in the lower
+// lanes of a Uint8x16 whose upper lanes are zero.
+func LowerQuarter_NEON_Uint8x16(v asm.Uint8x16) asm.Uint8x16 {
+	var result asm.Uint8x16
+	copy(result[:4], v[:4])
+	return result
+}
+
+// PromoteI8ToI32_NEON_Int8x16 promotes the quarter vector of 4 int8 lanes
+// returned by LowerQuarter_NEON_Int8x16 to a full vector of 4 int32 lanes.
+func PromoteI8ToI32_NEON_Int8x16(v asm.Int8x16) asm.Int32x4 {
+	result := [4]int32{int32(v.Get(0)), int32(v.Get(1)), int32(v.Get(2)), int32(v.Get(3))}
+	return asm.LoadInt32x4(&result)
+}
+
+// PromoteU8ToU32_NEON_Uint8x16 promotes the quarter vector of 4 uint8 lanes
+// returned by LowerQuarter_NEON_Uint8x16 to a full vector of 4 uint32 lanes.
+func PromoteU8ToU32_NEON_Uint8x16(v asm.Uint8x16) asm.Uint32x4 {
+	result := [4]uint32{uint32(v.Get(0)), uint32(v.Get(1)), uint32(v.Get(2)), uint32(v.Get(3))}
+	return asm.LoadUint32x4(&result)
+}
@@ -0,0 +1,80 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hwy
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLowerUpperHalf(t *testing.T) {
+	v := Vec[float32]{data: []float32{0, 1, 2, 3, 4, 5, 6, 7}}
+
+	lo := LowerHalf(v)
+	if want := []float32{0, 1, 2, 3}; !reflect.DeepEqual(lo.data, want) {
+		t.Errorf("LowerHalf() = %v, want %v", lo.data, want)
+	}
+	hi := UpperHalf(v)
+	if want := []float32{4, 5, 6, 7}; !reflect.DeepEqual(hi.data, want) {
+		t.Errorf("UpperHalf() = %v, want %v", hi.data, want)
+	}
+
+	if got := Combine(hi, lo); !reflect.DeepEqual(got.data, v.data) {
+		t.Errorf("Combine(UpperHalf, LowerHalf) = %v, want %v", got.data, v.data)
+	}
+
+	// The halves must not alias v.
+	lo.data[0] = 42
+	if v.data[0] != 0 {
+		t.Error("LowerHalf() aliases its input")
+	}
+}
+
+func TestZeroExtendVector(t *testing.T) {
+	lo := Vec[int32]{data: []int32{-1, 2}}
+	got := ZeroExtendVector(lo)
+	if want := []int32{-1, 2, 0, 0}; !reflect.DeepEqual(got.data, want) {
+		t.Errorf("ZeroExtendVector() = %v, want %v", got.data, want)
+	}
+}
+
+func TestPromoteHalfVector(t *testing.T) {
+	v := Vec[float32]{data: []float32{1.5, -2.5, 3.5, -4.5, 5, 6, 7, 8}}
+	got := PromoteF32ToF64(LowerHalf(v))
+	want := PromoteLowerF32ToF64(v)
+	if !reflect.DeepEqual(got.data, want.data) {
+		t.Errorf("PromoteF32ToF64(LowerHalf()) = %v, want %v", got.data, want.data)
+	}
+	got = PromoteF32ToF64(UpperHalf(v))
+	want = PromoteUpperF32ToF64(v)
+	if !reflect.DeepEqual(got.data, want.data) {
+		t.Errorf("PromoteF32ToF64(UpperHalf()) = %v, want %v", got.data, want.data)
+	}
+}
+
+func TestPromoteQuarterVector(t *testing.T) {
+	v := Vec[int8]{data: []int8{-128, -1, 0, 127, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12}}
+	q := LowerQuarter(v)
+	got := PromoteI8ToI32(q)
+	if want := []int32{-128, -1, 0, 127}; !reflect.DeepEqual(got.data, want) {
+		t.Errorf("PromoteI8ToI32(LowerQuarter()) = %v, want %v", got.data, want)
+	}
+
+	u := Vec[uint8]{data: []uint8{255, 128, 1, 0, 9, 9, 9, 9}}
+	gotU := PromoteU8ToU32(LowerQuarter(u))
+	if want := []uint32{255, 128}; !reflect.DeepEqual(gotU.data, want) {
+		t.Errorf("PromoteU8ToU32(LowerQuarter()) = %v, want %v", gotU.data, want)
+	}
+}
@@ -0,0 +1,156 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hwy
+
+import (
+	"encoding/json"
+	"slices"
+	"sync"
+	"sync/atomic"
+)
+
+// Kernel instrumentation.
+//
+// Packages generated with hwygen -instrument contain a
+// z_instrument_*.gen.go file, compiled only with -tags hwy_instrument, that
+// wraps every dispatched function with a call to KernelStats.Record. Builds
+// without the tag run the kernels unwrapped and pay nothing. Publish the
+// counters with expvar:
+//
+//	expvar.Publish("hwy_kernels", hwy.KernelStatsVar{})
+
+// KernelCounters is a snapshot of the counters of an instrumented kernel.
+type KernelCounters struct {
+	Impl        string `json:"impl"`         // implementation bound when instrumented, see BoundImplementation
+	Calls       uint64 `json:"calls"`        // number of calls
+	Bytes       uint64 `json:"bytes"`        // total size of the slice arguments
+	VectorElems uint64 `json:"vector_elems"` // elements processed by full vectors
+	TailElems   uint64 `json:"tail_elems"`   // elements left over for the tail
+	TailCalls   uint64 `json:"tail_calls"`   // calls that ran the tail
+}
+
+// KernelStats holds the counters of one instrumented kernel. It is safe for
+// concurrent use.
+type KernelStats struct {
+	impl        atomic.Value // string
+	calls       atomic.Uint64
+	bytes       atomic.Uint64
+	vectorElems atomic.Uint64
+	tailElems   atomic.Uint64
+	tailCalls   atomic.Uint64
+}
+
+var (
+	kernelStatsMu sync.Mutex
+	kernelStats   = make(map[string]*KernelStats)
+)
+
+// InstrumentKernel returns the counters of the named kernel, creating them
+// if needed, and records impl as the implementation they describe.
+// Generated instrumentation calls it from init with the dispatch variable's
+// name, as registered with RegisterKernel, and its bound implementation.
+func InstrumentKernel(name string, impl any) *KernelStats {
+	kernelStatsMu.Lock()
+	defer kernelStatsMu.Unlock()
+	s, ok := kernelStats[name]
+	if !ok {
+		s = &KernelStats{}
+		kernelStats[name] = s
+	}
+	s.impl.Store(BoundImplementation(impl))
+	return s
+}
+
+// Record counts one call processing bytes bytes of slice arguments and a
+// loop over n elements, lanes at a time. A lanes of 0 leaves the vector
+// and tail counters alone.
+func (s *KernelStats) Record(bytes, n, lanes int) {
+	s.calls.Add(1)
+	s.bytes.Add(uint64(bytes))
+	if lanes <= 0 || n <= 0 {
+		return
+	}
+	tail := n % lanes
+	s.vectorElems.Add(uint64(n - tail))
+	if tail > 0 {
+		s.tailElems.Add(uint64(tail))
+		s.tailCalls.Add(1)
+	}
+}
+
+// Counters returns a snapshot of the counters.
+func (s *KernelStats) Counters() KernelCounters {
+	impl, _ := s.impl.Load().(string)
+	return KernelCounters{
+		Impl:        impl,
+		Calls:       s.calls.Load(),
+		Bytes:       s.bytes.Load(),
+		VectorElems: s.vectorElems.Load(),
+		TailElems:   s.tailElems.Load(),
+		TailCalls:   s.tailCalls.Load(),
+	}
+}
+
+// Reset zeroes the counters.
+func (s *KernelStats) Reset() {
+	s.calls.Store(0)
+	s.bytes.Store(0)
+	s.vectorElems.Store(0)
+	s.tailElems.Store(0)
+	s.tailCalls.Store(0)
+}
+
+// String returns the counters as JSON, so a KernelStats is an expvar.Var.
+func (s *KernelStats) String() string {
+	b, _ := json.Marshal(s.Counters())
+	return string(b)
+}
+
+// InstrumentedKernels returns the names of the instrumented kernels, sorted.
+// It is empty unless the program was built with -tags hwy_instrument.
+func InstrumentedKernels() []string {
+	kernelStatsMu.Lock()
+	defer kernelStatsMu.Unlock()
+	names := make([]string, 0, len(kernelStats))
+	for name := range kernelStats {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// KernelStatsFor returns the counters of the named kernel, or nil if it is
+// not instrumented.
+func KernelStatsFor(name string) *KernelStats {
+	kernelStatsMu.Lock()
+	defer kernelStatsMu.Unlock()
+	return kernelStats[name]
+}
+
+// KernelStatsVar is an expvar.Var reporting the counters of all
+// instrumented kernels as a JSON object keyed by kernel name.
+type KernelStatsVar struct{}
+
+// String implements expvar.Var.
+func (KernelStatsVar) String() string {
+	kernelStatsMu.Lock()
+	all := make(map[string]KernelCounters, len(kernelStats))
+	for name, s := range kernelStats {
+		all[name] = s.Counters()
+	}
+	kernelStatsMu.Unlock()
+	b, _ := json.Marshal(all)
+	return string(b)
+}
@@ -0,0 +1,65 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hwy
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+)
+
+func TestInstrumentKernel(t *testing.T) {
+	s := InstrumentKernel("hwy.testSumFloat32", sumFallback)
+	defer func() {
+		kernelStatsMu.Lock()
+		delete(kernelStats, "hwy.testSumFloat32")
+		kernelStatsMu.Unlock()
+	}()
+
+	if !slices.Contains(InstrumentedKernels(), "hwy.testSumFloat32") {
+		t.Fatalf("InstrumentedKernels() = %v, missing hwy.testSumFloat32", InstrumentedKernels())
+	}
+	if KernelStatsFor("hwy.testSumFloat32") != s {
+		t.Error("KernelStatsFor returned different counters")
+	}
+
+	s.Record(64, 16, 8) // vectors only
+	s.Record(76, 19, 8) // 16 by vectors, 3 in the tail
+	s.Record(0, 0, 0)
+	want := KernelCounters{
+		Impl:        "hwy.sumFallback",
+		Calls:       3,
+		Bytes:       140,
+		VectorElems: 32,
+		TailElems:   3,
+		TailCalls:   1,
+	}
+	if got := s.Counters(); got != want {
+		t.Errorf("Counters() = %+v, want %+v", got, want)
+	}
+
+	var all map[string]KernelCounters
+	if err := json.Unmarshal([]byte(KernelStatsVar{}.String()), &all); err != nil {
+		t.Fatalf("KernelStatsVar is not JSON: %v", err)
+	}
+	if all["hwy.testSumFloat32"] != want {
+		t.Errorf("KernelStatsVar[hwy.testSumFloat32] = %+v, want %+v", all["hwy.testSumFloat32"], want)
+	}
+
+	s.Reset()
+	if got := s.Counters(); got.Calls != 0 || got.Bytes != 0 || got.TailElems != 0 {
+		t.Errorf("Counters() after Reset = %+v", got)
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hwy
+
+import "math/bits"
+
+// This file provides double-word (128-bit) integer arithmetic on uint64
+// lanes: the full 64x64->128 product and add/subtract with an explicit
+// carry chain. They are the building blocks of multiply-mix hashes
+// (wyhash, MUM), 128-bit LCG/PCG generators and multi-limb big-integer
+// kernels.
+//
+// x86 and NEON have no 64x64->128 vector multiply, so the SIMD wrappers
+// (int128_avx2.go, int128_avx512.go, int128_neon.go) use math/bits per
+// lane; the compiler lowers bits.Mul64 to a single MUL/UMULH.
+//
+// Carries and borrows are carried in lanes holding 0 or 1, matching
+// bits.Add64 and bits.Sub64.
+
+// MulHigh64 returns the upper 64 bits of each 128-bit product a * b.
+// It is MulHigh specialized to uint64 lanes.
+func MulHigh64(a, b Vec[uint64]) Vec[uint64] {
+	n := min(len(a.data), len(b.data))
+	result := make([]uint64, n)
+	for i := range n {
+		result[i], _ = bits.Mul64(a.data[i], b.data[i])
+	}
+	return Vec[uint64]{data: result}
+}
+
+// Mul128 returns the full 128-bit product of each pair of lanes as separate
+// low and high halves: a[i] * b[i] == hi[i]<<64 | lo[i].
+func Mul128(a, b Vec[uint64]) (lo, hi Vec[uint64]) {
+	n := min(len(a.data), len(b.data))
+	loData := make([]uint64, n)
+	hiData := make([]uint64, n)
+	for i := range n {
+		hiData[i], loData[i] = bits.Mul64(a.data[i], b.data[i])
+	}
+	return Vec[uint64]{data: loData}, Vec[uint64]{data: hiData}
+}
+
+// AddWithCarry returns a + b + carry and the carry out of each lane.
+// carry lanes must be 0 or 1; carryOut lanes are 0 or 1.
+//
+// Example (128-bit addition, limbs in separate vectors):
+//
+//	lo, c := hwy.AddWithCarry(aLo, bLo, hwy.Zero[uint64]())
+//	hi, _ := hwy.AddWithCarry(aHi, bHi, c)
+func AddWithCarry(a, b, carry Vec[uint64]) (sum, carryOut Vec[uint64]) {
+	n := min(len(a.data), len(b.data), len(carry.data))
+	sumData := make([]uint64, n)
+	carryData := make([]uint64, n)
+	for i := range n {
+		sumData[i], carryData[i] = bits.Add64(a.data[i], b.data[i], carry.data[i])
+	}
+	return Vec[uint64]{data: sumData}, Vec[uint64]{data: carryData}
+}
+
+// SubWithBorrow returns a - b - borrow and the borrow out of each lane.
+// borrow lanes must be 0 or 1; borrowOut lanes are 0 or 1.
+func SubWithBorrow(a, b, borrow Vec[uint64]) (diff, borrowOut Vec[uint64]) {
+	n := min(len(a.data), len(b.data), len(borrow.data))
+	diffData := make([]uint64, n)
+	borrowData := make([]uint64, n)
+	for i := range n {
+		diffData[i], borrowData[i] = bits.Sub64(a.data[i], b.data[i], borrow.data[i])
+	}
+	return Vec[uint64]{data: diffData}, Vec[uint64]{data: borrowData}
+}
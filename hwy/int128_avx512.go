@@ -0,0 +1,74 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build amd64 && goexperiment.simd
+
+package hwy
+
+import (
+	"math/bits"
+
+	"simd/archsimd"
+)
+
+// This file provides AVX-512 implementations of the double-word integer ops.
+// VPMULLQ only returns the low half of a 64-bit product (and AVX512-IFMA's
+// 52-bit multiplies do not cover full limbs), so lanes go through the
+// store/scalar/load pattern with math/bits.
+
+// MulHigh64_AVX512_Uint64x8 returns the upper 64 bits of each 128-bit product a * b.
+func MulHigh64_AVX512_Uint64x8(a, b archsimd.Uint64x8) archsimd.Uint64x8 {
+	var aData, bData, result [8]uint64
+	a.Store(&aData)
+	b.Store(&bData)
+	for i := 0; i < 8; i++ {
+		result[i], _ = bits.Mul64(aData[i], bData[i])
+	}
+	return archsimd.LoadUint64x8(&result)
+}
+
+// Mul128_AVX512_Uint64x8 returns the low and high halves of each 128-bit product a * b.
+func Mul128_AVX512_Uint64x8(a, b archsimd.Uint64x8) (lo, hi archsimd.Uint64x8) {
+	var aData, bData, loData, hiData [8]uint64
+	a.Store(&aData)
+	b.Store(&bData)
+	for i := 0; i < 8; i++ {
+		hiData[i], loData[i] = bits.Mul64(aData[i], bData[i])
+	}
+	return archsimd.LoadUint64x8(&loData), archsimd.LoadUint64x8(&hiData)
+}
+
+// AddWithCarry_AVX512_Uint64x8 returns a + b + carry and the 0/1 carry out of each lane.
+func AddWithCarry_AVX512_Uint64x8(a, b, carry archsimd.Uint64x8) (sum, carryOut archsimd.Uint64x8) {
+	var aData, bData, cData, sumData, outData [8]uint64
+	a.Store(&aData)
+	b.Store(&bData)
+	carry.Store(&cData)
+	for i := 0; i < 8; i++ {
+		sumData[i], outData[i] = bits.Add64(aData[i], bData[i], cData[i])
+	}
+	return archsimd.LoadUint64x8(&sumData), archsimd.LoadUint64x8(&outData)
+}
+
+// SubWithBorrow_AVX512_Uint64x8 returns a - b - borrow and the 0/1 borrow out of each lane.
+func SubWithBorrow_AVX512_Uint64x8(a, b, borrow archsimd.Uint64x8) (diff, borrowOut archsimd.Uint64x8) {
+	var aData, bData, bwData, diffData, outData [8]uint64
+	a.Store(&aData)
+	b.Store(&bData)
+	borrow.Store(&bwData)
+	for i := 0; i < 8; i++ {
+		diffData[i], outData[i] = bits.Sub64(aData[i], bData[i], bwData[i])
+	}
+	return archsimd.LoadUint64x8(&diffData), archsimd.LoadUint64x8(&outData)
+}
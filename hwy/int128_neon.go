@@ -0,0 +1,73 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build arm64
+
+package hwy
+
+import (
+	"math/bits"
+
+	"github.com/ajroetker/go-highway/hwy/asm"
+)
+
+// This file provides NEON implementations of the double-word integer ops.
+// NEON has no 64x64 multiply at all, so both lanes go through UMULH/MUL
+// via math/bits with the store/scalar/load pattern.
+
+// MulHigh64_NEON_Uint64x2 returns the upper 64 bits of each 128-bit product a * b.
+func MulHigh64_NEON_Uint64x2(a, b asm.Uint64x2) asm.Uint64x2 {
+	var aData, bData, result [2]uint64
+	a.Store(&aData)
+	b.Store(&bData)
+	for i := 0; i < 2; i++ {
+		result[i], _ = bits.Mul64(aData[i], bData[i])
+	}
+	return asm.LoadUint64x2(&result)
+}
+
+// Mul128_NEON_Uint64x2 returns the low and high halves of each 128-bit product a * b.
+func Mul128_NEON_Uint64x2(a, b asm.Uint64x2) (lo, hi asm.Uint64x2) {
+	var aData, bData, loData, hiData [2]uint64
+	a.Store(&aData)
+	b.Store(&bData)
+	for i := 0; i < 2; i++ {
+		hiData[i], loData[i] = bits.Mul64(aData[i], bData[i])
+	}
+	return asm.LoadUint64x2(&loData), asm.LoadUint64x2(&hiData)
+}
+
+// AddWithCarry_NEON_Uint64x2 returns a + b + carry and the 0/1 carry out of each lane.
+func AddWithCarry_NEON_Uint64x2(a, b, carry asm.Uint64x2) (sum, carryOut asm.Uint64x2) {
+	var aData, bData, cData, sumData, outData [2]uint64
+	a.Store(&aData)
+	b.Store(&bData)
+	carry.Store(&cData)
+	for i := 0; i < 2; i++ {
+		sumData[i], outData[i] = bits.Add64(aData[i], bData[i], cData[i])
+	}
+	return asm.LoadUint64x2(&sumData), asm.LoadUint64x2(&outData)
+}
+
+// SubWithBorrow_NEON_Uint64x2 returns a - b - borrow and the 0/1 borrow out of each lane.
+func SubWithBorrow_NEON_Uint64x2(a, b, borrow asm.Uint64x2) (diff, borrowOut asm.Uint64x2) {
+	var aData, bData, bwData, diffData, outData [2]uint64
+	a.Store(&aData)
+	b.Store(&bData)
+	borrow.Store(&bwData)
+	for i := 0; i < 2; i++ {
+		diffData[i], outData[i] = bits.Sub64(aData[i], bData[i], bwData[i])
+	}
+	return asm.LoadUint64x2(&diffData), asm.LoadUint64x2(&outData)
+}
@@ -0,0 +1,77 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hwy
+
+import (
+	"math"
+	"math/bits"
+	"testing"
+)
+
+func TestMul128(t *testing.T) {
+	a := Vec[uint64]{data: []uint64{0, 1, math.MaxUint64, math.MaxUint64, 0x9E3779B97F4A7C15}}
+	b := Vec[uint64]{data: []uint64{5, math.MaxUint64, math.MaxUint64, 2, 0xBF58476D1CE4E5B9}}
+	lo, hi := Mul128(a, b)
+	high := MulHigh64(a, b)
+	for i := range a.data {
+		wantHi, wantLo := bits.Mul64(a.data[i], b.data[i])
+		if lo.data[i] != wantLo || hi.data[i] != wantHi {
+			t.Errorf("Mul128 lane %d: got (%#x, %#x), want (%#x, %#x)", i, lo.data[i], hi.data[i], wantLo, wantHi)
+		}
+		if high.data[i] != wantHi {
+			t.Errorf("MulHigh64 lane %d: got %#x, want %#x", i, high.data[i], wantHi)
+		}
+		if generic := MulHigh(a, b); generic.data[i] != wantHi {
+			t.Errorf("MulHigh[uint64] lane %d: got %#x, want %#x", i, generic.data[i], wantHi)
+		}
+	}
+}
+
+func TestAddWithCarry(t *testing.T) {
+	// 128-bit addition: (aHi:aLo) + (bHi:bLo) with limbs in separate vectors.
+	aLo := Vec[uint64]{data: []uint64{math.MaxUint64, 1, math.MaxUint64}}
+	aHi := Vec[uint64]{data: []uint64{0, 2, math.MaxUint64}}
+	bLo := Vec[uint64]{data: []uint64{1, 2, math.MaxUint64}}
+	bHi := Vec[uint64]{data: []uint64{0, 3, 0}}
+
+	lo, c := AddWithCarry(aLo, bLo, Vec[uint64]{data: []uint64{0, 0, 1}})
+	hi, carryOut := AddWithCarry(aHi, bHi, c)
+
+	wantLo := []uint64{0, 3, math.MaxUint64}
+	wantHi := []uint64{1, 5, 0}
+	wantCarry := []uint64{0, 0, 1}
+	for i := range wantLo {
+		if lo.data[i] != wantLo[i] || hi.data[i] != wantHi[i] || carryOut.data[i] != wantCarry[i] {
+			t.Errorf("lane %d: got (%#x, %#x, carry %d), want (%#x, %#x, carry %d)",
+				i, hi.data[i], lo.data[i], carryOut.data[i], wantHi[i], wantLo[i], wantCarry[i])
+		}
+	}
+}
+
+func TestSubWithBorrow(t *testing.T) {
+	a := Vec[uint64]{data: []uint64{5, 0, 0, math.MaxUint64}}
+	b := Vec[uint64]{data: []uint64{3, 1, 0, math.MaxUint64}}
+	borrow := Vec[uint64]{data: []uint64{0, 0, 1, 1}}
+	diff, out := SubWithBorrow(a, b, borrow)
+
+	wantDiff := []uint64{2, math.MaxUint64, math.MaxUint64, math.MaxUint64}
+	wantOut := []uint64{0, 1, 1, 1}
+	for i := range wantDiff {
+		if diff.data[i] != wantDiff[i] || out.data[i] != wantOut[i] {
+			t.Errorf("lane %d: got (%#x, borrow %d), want (%#x, borrow %d)",
+				i, diff.data[i], out.data[i], wantDiff[i], wantOut[i])
+		}
+	}
+}
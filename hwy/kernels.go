@@ -0,0 +1,193 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hwy
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"sync"
+)
+
+// kernels holds the dispatched function variables registered by generated
+// dispatchers, keyed by "<package>.<variable>", e.g. "vec.DotFloat32".
+var (
+	kernelsMu sync.Mutex
+	kernels   = make(map[string]reflect.Value)
+
+	// kernelTargets holds the implementation of each kernel for every
+	// target its package was generated for, see RegisterKernelTarget.
+	kernelTargets = make(map[string]map[DispatchLevel]reflect.Value)
+)
+
+// RegisterKernel records the dispatched function variable ptr points to
+// under name, e.g. RegisterKernel("vec.DotFloat32", &DotFloat32).
+// Generated dispatchers call it from init for every function variable they
+// declare, so applications can list and override kernels. It panics if ptr
+// is not a pointer to a func variable.
+func RegisterKernel(name string, ptr any) {
+	v := reflect.ValueOf(ptr)
+	if v.Kind() != reflect.Pointer || v.IsNil() || v.Elem().Kind() != reflect.Func {
+		panic(fmt.Sprintf("hwy: RegisterKernel(%q): %T is not a pointer to a func variable", name, ptr))
+	}
+	kernelsMu.Lock()
+	defer kernelsMu.Unlock()
+	kernels[name] = v.Elem()
+}
+
+// Kernels returns the names of all registered dispatched functions, sorted.
+// Use KernelImplementation to see which implementation each is bound to.
+func Kernels() []string {
+	kernelsMu.Lock()
+	defer kernelsMu.Unlock()
+	names := make([]string, 0, len(kernels))
+	for name := range kernels {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// KernelImplementation returns the name of the function currently bound to
+// the named kernel, as reported by BoundImplementation, or "" if no kernel
+// of that name is registered.
+func KernelImplementation(name string) string {
+	kernelsMu.Lock()
+	defer kernelsMu.Unlock()
+	v, ok := kernels[name]
+	if !ok {
+		return ""
+	}
+	return BoundImplementation(v.Interface())
+}
+
+// OverrideKernel binds the named kernel to fn, e.g. a hand-tuned assembly
+// version or the package's Base*_fallback function when debugging. fn must
+// have exactly the kernel's func type. It returns a function that restores
+// the previous implementation.
+//
+// Dispatched functions are plain variables, so OverrideKernel must not race
+// with calls to the kernel: call it during initialization or from tests.
+func OverrideKernel(name string, fn any) (restore func(), err error) {
+	kernelsMu.Lock()
+	defer kernelsMu.Unlock()
+	v, ok := kernels[name]
+	if !ok {
+		return nil, fmt.Errorf("hwy: unknown kernel %q", name)
+	}
+	fv := reflect.ValueOf(fn)
+	if !fv.IsValid() || fv.Type() != v.Type() {
+		return nil, fmt.Errorf("hwy: kernel %q has type %s, got %T", name, v.Type(), fn)
+	}
+	if fv.IsNil() {
+		return nil, fmt.Errorf("hwy: nil implementation for kernel %q", name)
+	}
+	prev := reflect.ValueOf(v.Interface())
+	v.Set(fv)
+	return func() {
+		kernelsMu.Lock()
+		defer kernelsMu.Unlock()
+		v.Set(prev)
+	}, nil
+}
+
+// RegisterKernelTarget records the implementations the named kernels have
+// for level. init is the package's function binding its dispatch variables
+// for level, e.g. initDotAVX2: RegisterKernelTarget runs it, records the
+// implementations it bound and restores the previous bindings. Generated
+// dispatchers call it from init, after RegisterKernel, for every target
+// they were generated for, whether or not the CPU supports it.
+func RegisterKernelTarget(level DispatchLevel, init func(), names ...string) {
+	kernelsMu.Lock()
+	defer kernelsMu.Unlock()
+	prev := make([]reflect.Value, len(names))
+	for i, name := range names {
+		v, ok := kernels[name]
+		if !ok {
+			panic(fmt.Sprintf("hwy: RegisterKernelTarget: kernel %q is not registered", name))
+		}
+		prev[i] = reflect.ValueOf(v.Interface())
+	}
+	init()
+	for i, name := range names {
+		v := kernels[name]
+		if kernelTargets[name] == nil {
+			kernelTargets[name] = make(map[DispatchLevel]reflect.Value)
+		}
+		kernelTargets[name][level] = reflect.ValueOf(v.Interface())
+		v.Set(prev[i])
+	}
+}
+
+// KernelSet gives access to the implementations of the registered kernels
+// for one target, bypassing the dispatch variables. Use it to compare
+// targets in benchmarks, or to run workers pinned to different
+// microarchitectures with different targets:
+//
+//	avx2, err := hwy.ForTarget(hwy.DispatchAVX2)
+//	...
+//	dot, err := hwy.TargetKernel[func(a, b []float32) float32](avx2, "vec.DotFloat32")
+//
+// The implementations are the ones hwygen generated for the target:
+// OverrideKernel and the assembly a package binds over its dispatch
+// variables do not affect them.
+type KernelSet struct {
+	level DispatchLevel
+}
+
+// ForTarget returns the KernelSet of level. It returns an error if level is
+// not in AvailableTargets, since its code may not run on this CPU.
+// DispatchScalar, the pure Go fallbacks, is always available.
+func ForTarget(level DispatchLevel) (KernelSet, error) {
+	if !slices.Contains(availableLevels, level) {
+		return KernelSet{}, fmt.Errorf("hwy: target %s is not available on this CPU", level)
+	}
+	return KernelSet{level: level}, nil
+}
+
+// Level returns the target of s.
+func (s KernelSet) Level() DispatchLevel {
+	return s.level
+}
+
+// Kernel returns the implementation of the named kernel for the target of
+// s, or an error if the kernel is unknown or was not generated for it.
+func (s KernelSet) Kernel(name string) (any, error) {
+	kernelsMu.Lock()
+	defer kernelsMu.Unlock()
+	if _, ok := kernels[name]; !ok {
+		return nil, fmt.Errorf("hwy: unknown kernel %q", name)
+	}
+	fn, ok := kernelTargets[name][s.level]
+	if !ok {
+		return nil, fmt.Errorf("hwy: kernel %q has no %s implementation", name, s.level)
+	}
+	return fn.Interface(), nil
+}
+
+// TargetKernel is like s.Kernel, but returns the implementation as a value
+// of the kernel's func type F.
+func TargetKernel[F any](s KernelSet, name string) (F, error) {
+	var zero F
+	fn, err := s.Kernel(name)
+	if err != nil {
+		return zero, err
+	}
+	f, ok := fn.(F)
+	if !ok {
+		return zero, fmt.Errorf("hwy: kernel %q has type %T, not %T", name, fn, zero)
+	}
+	return f, nil
+}
@@ -0,0 +1,117 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hwy
+
+import (
+	"slices"
+	"testing"
+)
+
+// testSumFloat32 stands in for a generated dispatch variable.
+var testSumFloat32 func([]float32) float32
+
+func TestOverrideKernel(t *testing.T) {
+	testSumFloat32 = sumFallback
+	RegisterKernel("hwy.testSumFloat32", &testSumFloat32)
+	defer func() {
+		kernelsMu.Lock()
+		delete(kernels, "hwy.testSumFloat32")
+		kernelsMu.Unlock()
+	}()
+
+	if !slices.Contains(Kernels(), "hwy.testSumFloat32") {
+		t.Fatalf("Kernels() = %v, missing hwy.testSumFloat32", Kernels())
+	}
+	if got, want := KernelImplementation("hwy.testSumFloat32"), "hwy.sumFallback"; got != want {
+		t.Errorf("KernelImplementation = %q, want %q", got, want)
+	}
+
+	restore, err := OverrideKernel("hwy.testSumFloat32", func([]float32) float32 { return 42 })
+	if err != nil {
+		t.Fatalf("OverrideKernel: %v", err)
+	}
+	if got := testSumFloat32([]float32{1, 2}); got != 42 {
+		t.Errorf("overridden kernel returned %v, want 42", got)
+	}
+	restore()
+	if got := testSumFloat32([]float32{1, 2}); got != 3 {
+		t.Errorf("restored kernel returned %v, want 3", got)
+	}
+
+	if _, err := OverrideKernel("hwy.testSumFloat32", func([]float64) float64 { return 0 }); err == nil {
+		t.Error("OverrideKernel accepted a func of the wrong type")
+	}
+	if _, err := OverrideKernel("hwy.testSumFloat32", (func([]float32) float32)(nil)); err == nil {
+		t.Error("OverrideKernel accepted a nil func")
+	}
+	if _, err := OverrideKernel("hwy.noSuchKernel", sumFallback); err == nil {
+		t.Error("OverrideKernel accepted an unknown kernel")
+	}
+	if got := KernelImplementation("hwy.noSuchKernel"); got != "" {
+		t.Errorf("KernelImplementation(unknown) = %q, want empty", got)
+	}
+}
+
+func TestForTarget(t *testing.T) {
+	testSumFloat32 = sumFallback
+	RegisterKernel("hwy.testSumFloat32", &testSumFloat32)
+	RegisterKernelTarget(DispatchScalar, func() { testSumFloat32 = sumFallback }, "hwy.testSumFloat32")
+	RegisterKernelTarget(DispatchAVX2, func() { testSumFloat32 = func([]float32) float32 { return 42 } }, "hwy.testSumFloat32")
+	defer func() {
+		kernelsMu.Lock()
+		delete(kernels, "hwy.testSumFloat32")
+		delete(kernelTargets, "hwy.testSumFloat32")
+		kernelsMu.Unlock()
+	}()
+
+	if got, want := KernelImplementation("hwy.testSumFloat32"), "hwy.sumFallback"; got != want {
+		t.Errorf("RegisterKernelTarget left the kernel bound to %q, want %q", got, want)
+	}
+
+	scalar, err := ForTarget(DispatchScalar)
+	if err != nil {
+		t.Fatalf("ForTarget(DispatchScalar): %v", err)
+	}
+	sum, err := TargetKernel[func([]float32) float32](scalar, "hwy.testSumFloat32")
+	if err != nil {
+		t.Fatalf("TargetKernel: %v", err)
+	}
+	if got := sum([]float32{1, 2}); got != 3 {
+		t.Errorf("scalar kernel returned %v, want 3", got)
+	}
+	if _, err := TargetKernel[func([]float64) float64](scalar, "hwy.testSumFloat32"); err == nil {
+		t.Error("TargetKernel accepted a func of the wrong type")
+	}
+	if _, err := scalar.Kernel("hwy.noSuchKernel"); err == nil {
+		t.Error("Kernel accepted an unknown kernel")
+	}
+
+	if avx2, err := ForTarget(DispatchAVX2); err != nil {
+		if slices.Contains(AvailableTargets(), DispatchAVX2) {
+			t.Errorf("ForTarget(DispatchAVX2): %v", err)
+		}
+	} else {
+		sum, err := TargetKernel[func([]float32) float32](avx2, "hwy.testSumFloat32")
+		if err != nil {
+			t.Fatalf("TargetKernel: %v", err)
+		}
+		if got := sum([]float32{1, 2}); got != 42 {
+			t.Errorf("avx2 kernel returned %v, want 42", got)
+		}
+	}
+	if _, err := ForTarget(DispatchRVV + 1); err == nil {
+		t.Error("ForTarget accepted an unknown target")
+	}
+}
@@ -0,0 +1,143 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build amd64 && goexperiment.simd
+
+package hwy
+
+import "simd/archsimd"
+
+// This file provides AVX2 implementations of the masked arithmetic ops.
+// AVX2 has no mask registers, so these compute the full result and blend
+// it with VBLENDVPS/VPBLENDVB; they exist so hwygen can lower MaskedAdd and
+// friends uniformly across targets.
+
+// MaskedAdd_AVX2_F32x8 returns a + b where mask is true, a otherwise.
+func MaskedAdd_AVX2_F32x8(mask archsimd.Mask32x8, a, b archsimd.Float32x8) archsimd.Float32x8 {
+	return a.Add(b).Merge(a, mask)
+}
+
+// MaskedSub_AVX2_F32x8 returns a - b where mask is true, a otherwise.
+func MaskedSub_AVX2_F32x8(mask archsimd.Mask32x8, a, b archsimd.Float32x8) archsimd.Float32x8 {
+	return a.Sub(b).Merge(a, mask)
+}
+
+// MaskedMul_AVX2_F32x8 returns a * b where mask is true, a otherwise.
+func MaskedMul_AVX2_F32x8(mask archsimd.Mask32x8, a, b archsimd.Float32x8) archsimd.Float32x8 {
+	return a.Mul(b).Merge(a, mask)
+}
+
+// IfThenElseZero_AVX2_F32x8 returns a where mask is true, zero otherwise.
+func IfThenElseZero_AVX2_F32x8(mask archsimd.Mask32x8, a archsimd.Float32x8) archsimd.Float32x8 {
+	return a.Merge(archsimd.BroadcastFloat32x8(0), mask)
+}
+
+// IfThenZeroElse_AVX2_F32x8 returns zero where mask is true, b otherwise.
+func IfThenZeroElse_AVX2_F32x8(mask archsimd.Mask32x8, b archsimd.Float32x8) archsimd.Float32x8 {
+	return archsimd.BroadcastFloat32x8(0).Merge(b, mask)
+}
+
+// ZeroIfNegative_AVX2_F32x8 returns zero for negative lanes, v otherwise.
+func ZeroIfNegative_AVX2_F32x8(v archsimd.Float32x8) archsimd.Float32x8 {
+	zero := archsimd.BroadcastFloat32x8(0)
+	return v.Merge(zero, v.GreaterEqual(zero))
+}
+
+// MaskedAdd_AVX2_F64x4 returns a + b where mask is true, a otherwise.
+func MaskedAdd_AVX2_F64x4(mask archsimd.Mask64x4, a, b archsimd.Float64x4) archsimd.Float64x4 {
+	return a.Add(b).Merge(a, mask)
+}
+
+// MaskedSub_AVX2_F64x4 returns a - b where mask is true, a otherwise.
+func MaskedSub_AVX2_F64x4(mask archsimd.Mask64x4, a, b archsimd.Float64x4) archsimd.Float64x4 {
+	return a.Sub(b).Merge(a, mask)
+}
+
+// MaskedMul_AVX2_F64x4 returns a * b where mask is true, a otherwise.
+func MaskedMul_AVX2_F64x4(mask archsimd.Mask64x4, a, b archsimd.Float64x4) archsimd.Float64x4 {
+	return a.Mul(b).Merge(a, mask)
+}
+
+// IfThenElseZero_AVX2_F64x4 returns a where mask is true, zero otherwise.
+func IfThenElseZero_AVX2_F64x4(mask archsimd.Mask64x4, a archsimd.Float64x4) archsimd.Float64x4 {
+	return a.Merge(archsimd.BroadcastFloat64x4(0), mask)
+}
+
+// IfThenZeroElse_AVX2_F64x4 returns zero where mask is true, b otherwise.
+func IfThenZeroElse_AVX2_F64x4(mask archsimd.Mask64x4, b archsimd.Float64x4) archsimd.Float64x4 {
+	return archsimd.BroadcastFloat64x4(0).Merge(b, mask)
+}
+
+// ZeroIfNegative_AVX2_F64x4 returns zero for negative lanes, v otherwise.
+func ZeroIfNegative_AVX2_F64x4(v archsimd.Float64x4) archsimd.Float64x4 {
+	zero := archsimd.BroadcastFloat64x4(0)
+	return v.Merge(zero, v.GreaterEqual(zero))
+}
+
+// MaskedAdd_AVX2_I32x8 returns a + b where mask is true, a otherwise.
+func MaskedAdd_AVX2_I32x8(mask archsimd.Mask32x8, a, b archsimd.Int32x8) archsimd.Int32x8 {
+	return a.Add(b).Merge(a, mask)
+}
+
+// MaskedSub_AVX2_I32x8 returns a - b where mask is true, a otherwise.
+func MaskedSub_AVX2_I32x8(mask archsimd.Mask32x8, a, b archsimd.Int32x8) archsimd.Int32x8 {
+	return a.Sub(b).Merge(a, mask)
+}
+
+// MaskedMul_AVX2_I32x8 returns a * b where mask is true, a otherwise.
+func MaskedMul_AVX2_I32x8(mask archsimd.Mask32x8, a, b archsimd.Int32x8) archsimd.Int32x8 {
+	return a.Mul(b).Merge(a, mask)
+}
+
+// IfThenElseZero_AVX2_I32x8 returns a where mask is true, zero otherwise.
+func IfThenElseZero_AVX2_I32x8(mask archsimd.Mask32x8, a archsimd.Int32x8) archsimd.Int32x8 {
+	return a.Merge(archsimd.BroadcastInt32x8(0), mask)
+}
+
+// IfThenZeroElse_AVX2_I32x8 returns zero where mask is true, b otherwise.
+func IfThenZeroElse_AVX2_I32x8(mask archsimd.Mask32x8, b archsimd.Int32x8) archsimd.Int32x8 {
+	return archsimd.BroadcastInt32x8(0).Merge(b, mask)
+}
+
+// ZeroIfNegative_AVX2_I32x8 returns zero for negative lanes, v otherwise.
+func ZeroIfNegative_AVX2_I32x8(v archsimd.Int32x8) archsimd.Int32x8 {
+	zero := archsimd.BroadcastInt32x8(0)
+	return v.Merge(zero, v.GreaterEqual(zero))
+}
+
+// MaskedAdd_AVX2_I64x4 returns a + b where mask is true, a otherwise.
+func MaskedAdd_AVX2_I64x4(mask archsimd.Mask64x4, a, b archsimd.Int64x4) archsimd.Int64x4 {
+	return a.Add(b).Merge(a, mask)
+}
+
+// MaskedSub_AVX2_I64x4 returns a - b where mask is true, a otherwise.
+func MaskedSub_AVX2_I64x4(mask archsimd.Mask64x4, a, b archsimd.Int64x4) archsimd.Int64x4 {
+	return a.Sub(b).Merge(a, mask)
+}
+
+// IfThenElseZero_AVX2_I64x4 returns a where mask is true, zero otherwise.
+func IfThenElseZero_AVX2_I64x4(mask archsimd.Mask64x4, a archsimd.Int64x4) archsimd.Int64x4 {
+	return a.Merge(archsimd.BroadcastInt64x4(0), mask)
+}
+
+// IfThenZeroElse_AVX2_I64x4 returns zero where mask is true, b otherwise.
+func IfThenZeroElse_AVX2_I64x4(mask archsimd.Mask64x4, b archsimd.Int64x4) archsimd.Int64x4 {
+	return archsimd.BroadcastInt64x4(0).Merge(b, mask)
+}
+
+// ZeroIfNegative_AVX2_I64x4 returns zero for negative lanes, v otherwise.
+func ZeroIfNegative_AVX2_I64x4(v archsimd.Int64x4) archsimd.Int64x4 {
+	zero := archsimd.BroadcastInt64x4(0)
+	return v.Merge(zero, v.GreaterEqual(zero))
+}
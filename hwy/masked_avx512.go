@@ -0,0 +1,148 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build amd64 && goexperiment.simd
+
+package hwy
+
+import "simd/archsimd"
+
+// This file provides AVX-512 implementations of the masked arithmetic ops.
+// AVX-512 comparisons produce K-register masks, and Merge selects on the
+// mask register directly (VBLENDMPS/VPBLENDMD), so no vector mask is
+// materialized as it is for the blend-after-compute AVX2 path.
+
+// MaskedAdd_AVX512_F32x16 returns a + b where mask is true, a otherwise.
+func MaskedAdd_AVX512_F32x16(mask archsimd.Mask32x16, a, b archsimd.Float32x16) archsimd.Float32x16 {
+	return a.Add(b).Merge(a, mask)
+}
+
+// MaskedSub_AVX512_F32x16 returns a - b where mask is true, a otherwise.
+func MaskedSub_AVX512_F32x16(mask archsimd.Mask32x16, a, b archsimd.Float32x16) archsimd.Float32x16 {
+	return a.Sub(b).Merge(a, mask)
+}
+
+// MaskedMul_AVX512_F32x16 returns a * b where mask is true, a otherwise.
+func MaskedMul_AVX512_F32x16(mask archsimd.Mask32x16, a, b archsimd.Float32x16) archsimd.Float32x16 {
+	return a.Mul(b).Merge(a, mask)
+}
+
+// IfThenElseZero_AVX512_F32x16 returns a where mask is true, zero otherwise.
+func IfThenElseZero_AVX512_F32x16(mask archsimd.Mask32x16, a archsimd.Float32x16) archsimd.Float32x16 {
+	return a.Merge(archsimd.BroadcastFloat32x16(0), mask)
+}
+
+// IfThenZeroElse_AVX512_F32x16 returns zero where mask is true, b otherwise.
+func IfThenZeroElse_AVX512_F32x16(mask archsimd.Mask32x16, b archsimd.Float32x16) archsimd.Float32x16 {
+	return archsimd.BroadcastFloat32x16(0).Merge(b, mask)
+}
+
+// ZeroIfNegative_AVX512_F32x16 returns zero for negative lanes, v otherwise.
+func ZeroIfNegative_AVX512_F32x16(v archsimd.Float32x16) archsimd.Float32x16 {
+	zero := archsimd.BroadcastFloat32x16(0)
+	return v.Merge(zero, v.GreaterEqual(zero))
+}
+
+// MaskedAdd_AVX512_F64x8 returns a + b where mask is true, a otherwise.
+func MaskedAdd_AVX512_F64x8(mask archsimd.Mask64x8, a, b archsimd.Float64x8) archsimd.Float64x8 {
+	return a.Add(b).Merge(a, mask)
+}
+
+// MaskedSub_AVX512_F64x8 returns a - b where mask is true, a otherwise.
+func MaskedSub_AVX512_F64x8(mask archsimd.Mask64x8, a, b archsimd.Float64x8) archsimd.Float64x8 {
+	return a.Sub(b).Merge(a, mask)
+}
+
+// MaskedMul_AVX512_F64x8 returns a * b where mask is true, a otherwise.
+func MaskedMul_AVX512_F64x8(mask archsimd.Mask64x8, a, b archsimd.Float64x8) archsimd.Float64x8 {
+	return a.Mul(b).Merge(a, mask)
+}
+
+// IfThenElseZero_AVX512_F64x8 returns a where mask is true, zero otherwise.
+func IfThenElseZero_AVX512_F64x8(mask archsimd.Mask64x8, a archsimd.Float64x8) archsimd.Float64x8 {
+	return a.Merge(archsimd.BroadcastFloat64x8(0), mask)
+}
+
+// IfThenZeroElse_AVX512_F64x8 returns zero where mask is true, b otherwise.
+func IfThenZeroElse_AVX512_F64x8(mask archsimd.Mask64x8, b archsimd.Float64x8) archsimd.Float64x8 {
+	return archsimd.BroadcastFloat64x8(0).Merge(b, mask)
+}
+
+// ZeroIfNegative_AVX512_F64x8 returns zero for negative lanes, v otherwise.
+func ZeroIfNegative_AVX512_F64x8(v archsimd.Float64x8) archsimd.Float64x8 {
+	zero := archsimd.BroadcastFloat64x8(0)
+	return v.Merge(zero, v.GreaterEqual(zero))
+}
+
+// MaskedAdd_AVX512_I32x16 returns a + b where mask is true, a otherwise.
+func MaskedAdd_AVX512_I32x16(mask archsimd.Mask32x16, a, b archsimd.Int32x16) archsimd.Int32x16 {
+	return a.Add(b).Merge(a, mask)
+}
+
+// MaskedSub_AVX512_I32x16 returns a - b where mask is true, a otherwise.
+func MaskedSub_AVX512_I32x16(mask archsimd.Mask32x16, a, b archsimd.Int32x16) archsimd.Int32x16 {
+	return a.Sub(b).Merge(a, mask)
+}
+
+// MaskedMul_AVX512_I32x16 returns a * b where mask is true, a otherwise.
+func MaskedMul_AVX512_I32x16(mask archsimd.Mask32x16, a, b archsimd.Int32x16) archsimd.Int32x16 {
+	return a.Mul(b).Merge(a, mask)
+}
+
+// IfThenElseZero_AVX512_I32x16 returns a where mask is true, zero otherwise.
+func IfThenElseZero_AVX512_I32x16(mask archsimd.Mask32x16, a archsimd.Int32x16) archsimd.Int32x16 {
+	return a.Merge(archsimd.BroadcastInt32x16(0), mask)
+}
+
+// IfThenZeroElse_AVX512_I32x16 returns zero where mask is true, b otherwise.
+func IfThenZeroElse_AVX512_I32x16(mask archsimd.Mask32x16, b archsimd.Int32x16) archsimd.Int32x16 {
+	return archsimd.BroadcastInt32x16(0).Merge(b, mask)
+}
+
+// ZeroIfNegative_AVX512_I32x16 returns zero for negative lanes, v otherwise.
+func ZeroIfNegative_AVX512_I32x16(v archsimd.Int32x16) archsimd.Int32x16 {
+	zero := archsimd.BroadcastInt32x16(0)
+	return v.Merge(zero, v.GreaterEqual(zero))
+}
+
+// MaskedAdd_AVX512_I64x8 returns a + b where mask is true, a otherwise.
+func MaskedAdd_AVX512_I64x8(mask archsimd.Mask64x8, a, b archsimd.Int64x8) archsimd.Int64x8 {
+	return a.Add(b).Merge(a, mask)
+}
+
+// MaskedSub_AVX512_I64x8 returns a - b where mask is true, a otherwise.
+func MaskedSub_AVX512_I64x8(mask archsimd.Mask64x8, a, b archsimd.Int64x8) archsimd.Int64x8 {
+	return a.Sub(b).Merge(a, mask)
+}
+
+// MaskedMul_AVX512_I64x8 returns a * b where mask is true, a otherwise.
+func MaskedMul_AVX512_I64x8(mask archsimd.Mask64x8, a, b archsimd.Int64x8) archsimd.Int64x8 {
+	return a.Mul(b).Merge(a, mask)
+}
+
+// IfThenElseZero_AVX512_I64x8 returns a where mask is true, zero otherwise.
+func IfThenElseZero_AVX512_I64x8(mask archsimd.Mask64x8, a archsimd.Int64x8) archsimd.Int64x8 {
+	return a.Merge(archsimd.BroadcastInt64x8(0), mask)
+}
+
+// IfThenZeroElse_AVX512_I64x8 returns zero where mask is true, b otherwise.
+func IfThenZeroElse_AVX512_I64x8(mask archsimd.Mask64x8, b archsimd.Int64x8) archsimd.Int64x8 {
+	return archsimd.BroadcastInt64x8(0).Merge(b, mask)
+}
+
+// ZeroIfNegative_AVX512_I64x8 returns zero for negative lanes, v otherwise.
+func ZeroIfNegative_AVX512_I64x8(v archsimd.Int64x8) archsimd.Int64x8 {
+	zero := archsimd.BroadcastInt64x8(0)
+	return v.Merge(zero, v.GreaterEqual(zero))
+}
@@ -0,0 +1,147 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build arm64
+
+package hwy
+
+import "github.com/ajroetker/go-highway/hwy/asm"
+
+// This file provides NEON implementations of the masked arithmetic ops.
+// NEON has no predicate registers, so these compute the full result and
+// select with BSL against the all-ones/all-zeros lane mask.
+
+// MaskedAdd_NEON_F32x4 returns a + b where mask is true, a otherwise.
+func MaskedAdd_NEON_F32x4(mask asm.Int32x4, a, b asm.Float32x4) asm.Float32x4 {
+	return asm.IfThenElse(mask, a.Add(b), a)
+}
+
+// MaskedSub_NEON_F32x4 returns a - b where mask is true, a otherwise.
+func MaskedSub_NEON_F32x4(mask asm.Int32x4, a, b asm.Float32x4) asm.Float32x4 {
+	return asm.IfThenElse(mask, a.Sub(b), a)
+}
+
+// MaskedMul_NEON_F32x4 returns a * b where mask is true, a otherwise.
+func MaskedMul_NEON_F32x4(mask asm.Int32x4, a, b asm.Float32x4) asm.Float32x4 {
+	return asm.IfThenElse(mask, a.Mul(b), a)
+}
+
+// IfThenElseZero_NEON_F32x4 returns a where mask is true, zero otherwise.
+func IfThenElseZero_NEON_F32x4(mask asm.Int32x4, a asm.Float32x4) asm.Float32x4 {
+	return asm.IfThenElse(mask, a, asm.Float32x4{})
+}
+
+// IfThenZeroElse_NEON_F32x4 returns zero where mask is true, b otherwise.
+func IfThenZeroElse_NEON_F32x4(mask asm.Int32x4, b asm.Float32x4) asm.Float32x4 {
+	return asm.IfThenElse(mask, asm.Float32x4{}, b)
+}
+
+// ZeroIfNegative_NEON_F32x4 returns zero for negative lanes, v otherwise.
+func ZeroIfNegative_NEON_F32x4(v asm.Float32x4) asm.Float32x4 {
+	zero := asm.Float32x4{}
+	return asm.IfThenElse(v.GreaterEqual(zero), v, zero)
+}
+
+// MaskedAdd_NEON_F64x2 returns a + b where mask is true, a otherwise.
+func MaskedAdd_NEON_F64x2(mask asm.Int64x2, a, b asm.Float64x2) asm.Float64x2 {
+	return asm.IfThenElseFloat64(mask, a.Add(b), a)
+}
+
+// MaskedSub_NEON_F64x2 returns a - b where mask is true, a otherwise.
+func MaskedSub_NEON_F64x2(mask asm.Int64x2, a, b asm.Float64x2) asm.Float64x2 {
+	return asm.IfThenElseFloat64(mask, a.Sub(b), a)
+}
+
+// MaskedMul_NEON_F64x2 returns a * b where mask is true, a otherwise.
+func MaskedMul_NEON_F64x2(mask asm.Int64x2, a, b asm.Float64x2) asm.Float64x2 {
+	return asm.IfThenElseFloat64(mask, a.Mul(b), a)
+}
+
+// IfThenElseZero_NEON_F64x2 returns a where mask is true, zero otherwise.
+func IfThenElseZero_NEON_F64x2(mask asm.Int64x2, a asm.Float64x2) asm.Float64x2 {
+	return asm.IfThenElseFloat64(mask, a, asm.Float64x2{})
+}
+
+// IfThenZeroElse_NEON_F64x2 returns zero where mask is true, b otherwise.
+func IfThenZeroElse_NEON_F64x2(mask asm.Int64x2, b asm.Float64x2) asm.Float64x2 {
+	return asm.IfThenElseFloat64(mask, asm.Float64x2{}, b)
+}
+
+// ZeroIfNegative_NEON_F64x2 returns zero for negative lanes, v otherwise.
+func ZeroIfNegative_NEON_F64x2(v asm.Float64x2) asm.Float64x2 {
+	zero := asm.Float64x2{}
+	return asm.IfThenElseFloat64(v.GreaterEqual(zero), v, zero)
+}
+
+// MaskedAdd_NEON_I32x4 returns a + b where mask is true, a otherwise.
+func MaskedAdd_NEON_I32x4(mask asm.Int32x4, a, b asm.Int32x4) asm.Int32x4 {
+	return asm.IfThenElseInt32(mask, a.Add(b), a)
+}
+
+// MaskedSub_NEON_I32x4 returns a - b where mask is true, a otherwise.
+func MaskedSub_NEON_I32x4(mask asm.Int32x4, a, b asm.Int32x4) asm.Int32x4 {
+	return asm.IfThenElseInt32(mask, a.Sub(b), a)
+}
+
+// MaskedMul_NEON_I32x4 returns a * b where mask is true, a otherwise.
+func MaskedMul_NEON_I32x4(mask asm.Int32x4, a, b asm.Int32x4) asm.Int32x4 {
+	return asm.IfThenElseInt32(mask, a.Mul(b), a)
+}
+
+// IfThenElseZero_NEON_I32x4 returns a where mask is true, zero otherwise.
+func IfThenElseZero_NEON_I32x4(mask asm.Int32x4, a asm.Int32x4) asm.Int32x4 {
+	return asm.IfThenElseInt32(mask, a, asm.Int32x4{})
+}
+
+// IfThenZeroElse_NEON_I32x4 returns zero where mask is true, b otherwise.
+func IfThenZeroElse_NEON_I32x4(mask asm.Int32x4, b asm.Int32x4) asm.Int32x4 {
+	return asm.IfThenElseInt32(mask, asm.Int32x4{}, b)
+}
+
+// ZeroIfNegative_NEON_I32x4 returns zero for negative lanes, v otherwise.
+func ZeroIfNegative_NEON_I32x4(v asm.Int32x4) asm.Int32x4 {
+	zero := asm.Int32x4{}
+	return asm.IfThenElseInt32(v.GreaterEqual(zero), v, zero)
+}
+
+// MaskedAdd_NEON_I64x2 returns a + b where mask is true, a otherwise.
+func MaskedAdd_NEON_I64x2(mask asm.Int64x2, a, b asm.Int64x2) asm.Int64x2 {
+	return asm.IfThenElseInt64(mask, a.Add(b), a)
+}
+
+// MaskedSub_NEON_I64x2 returns a - b where mask is true, a otherwise.
+func MaskedSub_NEON_I64x2(mask asm.Int64x2, a, b asm.Int64x2) asm.Int64x2 {
+	return asm.IfThenElseInt64(mask, a.Sub(b), a)
+}
+
+// MaskedMul_NEON_I64x2 returns a * b where mask is true, a otherwise.
+func MaskedMul_NEON_I64x2(mask asm.Int64x2, a, b asm.Int64x2) asm.Int64x2 {
+	return asm.IfThenElseInt64(mask, a.Mul(b), a)
+}
+
+// IfThenElseZero_NEON_I64x2 returns a where mask is true, zero otherwise.
+func IfThenElseZero_NEON_I64x2(mask asm.Int64x2, a asm.Int64x2) asm.Int64x2 {
+	return asm.IfThenElseInt64(mask, a, asm.Int64x2{})
+}
+
+// IfThenZeroElse_NEON_I64x2 returns zero where mask is true, b otherwise.
+func IfThenZeroElse_NEON_I64x2(mask asm.Int64x2, b asm.Int64x2) asm.Int64x2 {
+	return asm.IfThenElseInt64(mask, asm.Int64x2{}, b)
+}
+
+// ZeroIfNegative_NEON_I64x2 returns zero for negative lanes, v otherwise.
+func ZeroIfNegative_NEON_I64x2(v asm.Int64x2) asm.Int64x2 {
+	zero := asm.Int64x2{}
+	return asm.IfThenElseInt64(v.GreaterEqual(zero), v, zero)
+}
@@ -62,6 +62,30 @@ func ReduceSum_AVX2_Uint64x4(v archsimd.Uint64x4) uint64 {
 	return e0 + e1 + e2 + e3
 }
 
+// ReduceSum_AVX2_I32x8 returns the sum of all 8 int32 elements.
+func ReduceSum_AVX2_I32x8(v archsimd.Int32x8) int32 {
+	lo := v.GetLo() // Int32x4
+	hi := v.GetHi() // Int32x4
+	sum4 := lo.Add(hi)
+	e0 := sum4.GetElem(0)
+	e1 := sum4.GetElem(1)
+	e2 := sum4.GetElem(2)
+	e3 := sum4.GetElem(3)
+	return e0 + e1 + e2 + e3
+}
+
+// ReduceSum_AVX2_Uint32x8 returns the sum of all 8 uint32 elements.
+func ReduceSum_AVX2_Uint32x8(v archsimd.Uint32x8) uint32 {
+	lo := v.GetLo() // Uint32x4
+	hi := v.GetHi() // Uint32x4
+	sum4 := lo.Add(hi)
+	e0 := sum4.GetElem(0)
+	e1 := sum4.GetElem(1)
+	e2 := sum4.GetElem(2)
+	e3 := sum4.GetElem(3)
+	return e0 + e1 + e2 + e3
+}
+
 // Sqrt_AVX2_F32x8 computes sqrt(x) for a single Float32x8 vector.
 // Uses the hardware VSQRTPS instruction which provides correctly rounded results.
 func Sqrt_AVX2_F32x8(x archsimd.Float32x8) archsimd.Float32x8 {
@@ -48,6 +48,22 @@ func ReduceSum_AVX512_Uint64x8(v archsimd.Uint64x8) uint64 {
 	return ReduceSum_AVX2_Uint64x4(lo) + ReduceSum_AVX2_Uint64x4(hi)
 }
 
+// ReduceSum_AVX512_I32x16 returns the sum of all 16 int32 elements.
+func ReduceSum_AVX512_I32x16(v archsimd.Int32x16) int32 {
+	lo := v.GetLo() // Int32x8
+	hi := v.GetHi() // Int32x8
+	sum8 := lo.Add(hi)
+	return ReduceSum_AVX2_I32x8(sum8)
+}
+
+// ReduceSum_AVX512_Uint32x16 returns the sum of all 16 uint32 elements.
+func ReduceSum_AVX512_Uint32x16(v archsimd.Uint32x16) uint32 {
+	lo := v.GetLo() // Uint32x8
+	hi := v.GetHi() // Uint32x8
+	sum8 := lo.Add(hi)
+	return ReduceSum_AVX2_Uint32x8(sum8)
+}
+
 // Sqrt_AVX512_F32x16 computes sqrt(x) for a single Float32x16 vector.
 // Uses the hardware VSQRTPS instruction which provides correctly rounded results.
 func Sqrt_AVX512_F32x16(x archsimd.Float32x16) archsimd.Float32x16 {
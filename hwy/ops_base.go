@@ -620,6 +620,21 @@ func FMA[T Floats](a, b, c Vec[T]) Vec[T] {
 
 // ReduceSum sums all lanes.
 func ReduceSum[T Lanes](v Vec[T]) T {
+	// Half-precision lanes are added as float32: T's + would add their bits.
+	switch data := any(v.data).(type) {
+	case []Float16:
+		var sum float32
+		for _, x := range data {
+			sum += x.Float32()
+		}
+		return any(Float32ToFloat16(sum)).(T)
+	case []BFloat16:
+		var sum float32
+		for _, x := range data {
+			sum += x.Float32()
+		}
+		return any(Float32ToBFloat16(sum)).(T)
+	}
 	var sum T
 	for i := 0; i < len(v.data); i++ {
 		sum += v.data[i]
@@ -864,6 +879,50 @@ func ZeroIfNegative[T Lanes](v Vec[T]) Vec[T] {
 	return Vec[T]{data: result}
 }
 
+// MaskedAdd returns a + b where mask is true, a otherwise.
+// This is the conditional accumulation step acc = MaskedAdd(m, acc, x);
+// on AVX-512 it maps to a merge-masked add rather than add-then-blend.
+func MaskedAdd[T Lanes](mask Mask[T], a, b Vec[T]) Vec[T] {
+	n := min(len(b.data), min(len(a.data), len(mask.bits)))
+	result := make([]T, n)
+	for i := range n {
+		if mask.bits[i] {
+			result[i] = addHelper(a.data[i], b.data[i])
+		} else {
+			result[i] = a.data[i]
+		}
+	}
+	return Vec[T]{data: result}
+}
+
+// MaskedSub returns a - b where mask is true, a otherwise.
+func MaskedSub[T Lanes](mask Mask[T], a, b Vec[T]) Vec[T] {
+	n := min(len(b.data), min(len(a.data), len(mask.bits)))
+	result := make([]T, n)
+	for i := range n {
+		if mask.bits[i] {
+			result[i] = subHelper(a.data[i], b.data[i])
+		} else {
+			result[i] = a.data[i]
+		}
+	}
+	return Vec[T]{data: result}
+}
+
+// MaskedMul returns a * b where mask is true, a otherwise.
+func MaskedMul[T Lanes](mask Mask[T], a, b Vec[T]) Vec[T] {
+	n := min(len(b.data), min(len(a.data), len(mask.bits)))
+	result := make([]T, n)
+	for i := range n {
+		if mask.bits[i] {
+			result[i] = mulHelper(a.data[i], b.data[i])
+		} else {
+			result[i] = a.data[i]
+		}
+	}
+	return Vec[T]{data: result}
+}
+
 // MaskLoad loads data from a slice only for lanes where the mask is true.
 func MaskLoad[T Lanes](mask Mask[T], src []T) Vec[T] {
 	n := min(len(src), len(mask.bits))
@@ -16,6 +16,7 @@ package hwy
 
 import (
 	"math"
+	"sync"
 	"testing"
 )
 
@@ -421,6 +422,133 @@ func TestProcessWithTail(t *testing.T) {
 	}
 }
 
+func TestWhileLessThan(t *testing.T) {
+	lanes := MaxLanes[float32]()
+	tests := []struct {
+		i, n, want int
+	}{
+		{0, 100, lanes},
+		{0, lanes - 1, lanes - 1},
+		{10, 12, 2},
+		{12, 12, 0},
+		{20, 12, 0},
+	}
+	for _, tt := range tests {
+		mask := WhileLessThan[float32](tt.i, tt.n)
+		if mask.NumLanes() != lanes {
+			t.Errorf("WhileLessThan(%d, %d): %d lanes, want %d", tt.i, tt.n, mask.NumLanes(), lanes)
+		}
+		if got := CountTrue(mask); got != tt.want {
+			t.Errorf("WhileLessThan(%d, %d): %d active lanes, want %d", tt.i, tt.n, got, tt.want)
+		}
+		for k := range tt.want {
+			if !mask.GetBit(k) {
+				t.Errorf("WhileLessThan(%d, %d): lane %d should be active", tt.i, tt.n, k)
+			}
+		}
+	}
+}
+
+func TestProcessPredicated(t *testing.T) {
+	for _, size := range []int{0, 1, MaxLanes[float32](), 100} {
+		data := make([]float32, size)
+		for i := range data {
+			data[i] = float32(i)
+		}
+		output := make([]float32, size)
+
+		ProcessPredicated(size, func(offset int, mask Mask[float32]) {
+			v := MaskLoad(mask, data[offset:])
+			MaskStore(mask, Add(v, v), output[offset:])
+		})
+
+		for i, val := range output {
+			if expected := float32(i) * 2; val != expected {
+				t.Errorf("ProcessPredicated(size=%d): output[%d]: got %v, want %v", size, i, val, expected)
+			}
+		}
+	}
+}
+
+func TestProcessUnrolled4(t *testing.T) {
+	lanes := MaxLanes[float32]()
+	for _, size := range []int{0, 3, lanes, 4 * lanes, 4*lanes + lanes + 1, 100} {
+		data := make([]float32, size)
+		for i := range data {
+			data[i] = float32(i)
+		}
+		output := make([]float32, size)
+		blocks, fulls, tails := 0, 0, 0
+
+		ProcessUnrolled4[float32](size,
+			func(offset int) {
+				blocks++
+				v0, v1, v2, v3 := Load4(data[offset:])
+				Store(Add(v0, v0), output[offset:])
+				Store(Add(v1, v1), output[offset+lanes:])
+				Store(Add(v2, v2), output[offset+2*lanes:])
+				Store(Add(v3, v3), output[offset+3*lanes:])
+			},
+			func(offset int) {
+				fulls++
+				v := Load(data[offset:])
+				Store(Add(v, v), output[offset:])
+			},
+			func(offset, count int) {
+				tails++
+				mask := TailMask[float32](count)
+				v := MaskLoad(mask, data[offset:])
+				MaskStore(mask, Add(v, v), output[offset:])
+			},
+		)
+
+		if want := size / (4 * lanes); blocks != want {
+			t.Errorf("ProcessUnrolled4(size=%d): %d unrolled blocks, want %d", size, blocks, want)
+		}
+		if want := (size % (4 * lanes)) / lanes; fulls != want {
+			t.Errorf("ProcessUnrolled4(size=%d): %d single vectors, want %d", size, fulls, want)
+		}
+		if want := min(size%lanes, 1); tails != want {
+			t.Errorf("ProcessUnrolled4(size=%d): %d tails, want %d", size, tails, want)
+		}
+		for i, val := range output {
+			if expected := float32(i) * 2; val != expected {
+				t.Errorf("ProcessUnrolled4(size=%d): output[%d]: got %v, want %v", size, i, val, expected)
+			}
+		}
+	}
+}
+
+func TestProcessParallel(t *testing.T) {
+	block := 4 * MaxLanes[float32]()
+	for _, tt := range []struct{ n, grain int }{
+		{0, 16}, {5, 16}, {1000, 1}, {1000, 100}, {100_000, 0}, {100_000, 4096},
+	} {
+		seen := make([]int32, tt.n)
+		var mu sync.Mutex
+		var chunks [][2]int
+		ProcessParallel[float32](tt.n, tt.grain, func(start, end int) {
+			for i := start; i < end; i++ {
+				seen[i]++
+			}
+			mu.Lock()
+			chunks = append(chunks, [2]int{start, end})
+			mu.Unlock()
+		})
+
+		for i, c := range seen {
+			if c != 1 {
+				t.Fatalf("ProcessParallel(n=%d, grain=%d): index %d visited %d times", tt.n, tt.grain, i, c)
+			}
+		}
+		for _, c := range chunks {
+			if c[1] != tt.n && (c[1]-c[0])%block != 0 {
+				t.Errorf("ProcessParallel(n=%d, grain=%d): chunk %v is not a multiple of %d", tt.n, tt.grain, c, block)
+			}
+		}
+	}
+}
+
 func TestAlignedSize(t *testing.T) {
 	maxLanes := MaxLanes[float32]()
 
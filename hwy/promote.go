@@ -261,6 +261,31 @@ func PromoteUpperU32ToU64(v Vec[uint32]) Vec[uint64] {
 	return Vec[uint64]{data: result}
 }
 
+// PromoteLowerTo promotes the lower half of v's lanes to the wider integer
+// type U, e.g. PromoteLowerTo[int32](v) for a Vec[int16]. It is the generic
+// form of PromoteLowerI16ToI32 and friends, for kernels generic over both
+// their input and accumulator types; hwygen lowers it to those ops.
+func PromoteLowerTo[U, T Integers](v Vec[T]) Vec[U] {
+	n := len(v.data) / 2
+	result := make([]U, n)
+	for i := range n {
+		result[i] = U(v.data[i])
+	}
+	return Vec[U]{data: result}
+}
+
+// PromoteUpperTo promotes the upper half of v's lanes to the wider integer
+// type U. See PromoteLowerTo.
+func PromoteUpperTo[U, T Integers](v Vec[T]) Vec[U] {
+	half := len(v.data) / 2
+	n := len(v.data) - half
+	result := make([]U, n)
+	for i := range n {
+		result[i] = U(v.data[half+i])
+	}
+	return Vec[U]{data: result}
+}
+
 // DemoteI16ToI8 narrows int16 to int8 (saturating).
 // Values outside int8 range are clamped to [-128, 127].
 func DemoteI16ToI8(v Vec[int16]) Vec[int8] {
@@ -275,3 +275,99 @@ func TruncateU64ToU32_AVX2(v archsimd.Int64x4) archsimd.Int32x8 {
 	}
 	return archsimd.LoadInt32x8Slice(result[:])
 }
+
+// PromoteLowerU8ToU16_AVX2_Uint8x32 promotes the lower 16 uint8 lanes of v to 16 uint16 lanes.
+func PromoteLowerU8ToU16_AVX2_Uint8x32(v archsimd.Uint8x32) archsimd.Uint16x16 {
+	var data [32]uint8
+	v.Store(&data)
+
+	var result [16]uint16
+	for i := 0; i < 16; i++ {
+		result[i] = uint16(data[i])
+	}
+	return archsimd.LoadUint16x16Slice(result[:])
+}
+
+// PromoteUpperU8ToU16_AVX2_Uint8x32 promotes the upper 16 uint8 lanes of v to 16 uint16 lanes.
+func PromoteUpperU8ToU16_AVX2_Uint8x32(v archsimd.Uint8x32) archsimd.Uint16x16 {
+	var data [32]uint8
+	v.Store(&data)
+
+	var result [16]uint16
+	for i := 0; i < 16; i++ {
+		result[i] = uint16(data[16+i])
+	}
+	return archsimd.LoadUint16x16Slice(result[:])
+}
+
+// PromoteLowerI8ToI16_AVX2_Int8x32 promotes the lower 16 int8 lanes of v to 16 int16 lanes.
+func PromoteLowerI8ToI16_AVX2_Int8x32(v archsimd.Int8x32) archsimd.Int16x16 {
+	var data [32]int8
+	v.Store(&data)
+
+	var result [16]int16
+	for i := 0; i < 16; i++ {
+		result[i] = int16(data[i])
+	}
+	return archsimd.LoadInt16x16Slice(result[:])
+}
+
+// PromoteUpperI8ToI16_AVX2_Int8x32 promotes the upper 16 int8 lanes of v to 16 int16 lanes.
+func PromoteUpperI8ToI16_AVX2_Int8x32(v archsimd.Int8x32) archsimd.Int16x16 {
+	var data [32]int8
+	v.Store(&data)
+
+	var result [16]int16
+	for i := 0; i < 16; i++ {
+		result[i] = int16(data[16+i])
+	}
+	return archsimd.LoadInt16x16Slice(result[:])
+}
+
+// PromoteLowerU16ToU32_AVX2_Uint16x16 promotes the lower 8 uint16 lanes of v to 8 uint32 lanes.
+func PromoteLowerU16ToU32_AVX2_Uint16x16(v archsimd.Uint16x16) archsimd.Uint32x8 {
+	var data [16]uint16
+	v.Store(&data)
+
+	var result [8]uint32
+	for i := 0; i < 8; i++ {
+		result[i] = uint32(data[i])
+	}
+	return archsimd.LoadUint32x8Slice(result[:])
+}
+
+// PromoteUpperU16ToU32_AVX2_Uint16x16 promotes the upper 8 uint16 lanes of v to 8 uint32 lanes.
+func PromoteUpperU16ToU32_AVX2_Uint16x16(v archsimd.Uint16x16) archsimd.Uint32x8 {
+	var data [16]uint16
+	v.Store(&data)
+
+	var result [8]uint32
+	for i := 0; i < 8; i++ {
+		result[i] = uint32(data[8+i])
+	}
+	return archsimd.LoadUint32x8Slice(result[:])
+}
+
+// PromoteLowerI16ToI32_AVX2_Int16x16 promotes the lower 8 int16 lanes of v to 8 int32 lanes.
+func PromoteLowerI16ToI32_AVX2_Int16x16(v archsimd.Int16x16) archsimd.Int32x8 {
+	var data [16]int16
+	v.Store(&data)
+
+	var result [8]int32
+	for i := 0; i < 8; i++ {
+		result[i] = int32(data[i])
+	}
+	return archsimd.LoadInt32x8Slice(result[:])
+}
+
+// PromoteUpperI16ToI32_AVX2_Int16x16 promotes the upper 8 int16 lanes of v to 8 int32 lanes.
+func PromoteUpperI16ToI32_AVX2_Int16x16(v archsimd.Int16x16) archsimd.Int32x8 {
+	var data [16]int16
+	v.Store(&data)
+
+	var result [8]int32
+	for i := 0; i < 8; i++ {
+		result[i] = int32(data[8+i])
+	}
+	return archsimd.LoadInt32x8Slice(result[:])
+}
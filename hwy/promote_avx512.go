@@ -255,3 +255,99 @@ func TruncateU64ToU32_AVX512(v archsimd.Int64x8) archsimd.Int32x16 {
 	}
 	return archsimd.LoadInt32x16Slice(result[:])
 }
+
+// PromoteLowerU8ToU16_AVX512_Uint8x64 promotes the lower 32 uint8 lanes of v to 32 uint16 lanes.
+func PromoteLowerU8ToU16_AVX512_Uint8x64(v archsimd.Uint8x64) archsimd.Uint16x32 {
+	var data [64]uint8
+	v.Store(&data)
+
+	var result [32]uint16
+	for i := 0; i < 32; i++ {
+		result[i] = uint16(data[i])
+	}
+	return archsimd.LoadUint16x32Slice(result[:])
+}
+
+// PromoteUpperU8ToU16_AVX512_Uint8x64 promotes the upper 32 uint8 lanes of v to 32 uint16 lanes.
+func PromoteUpperU8ToU16_AVX512_Uint8x64(v archsimd.Uint8x64) archsimd.Uint16x32 {
+	var data [64]uint8
+	v.Store(&data)
+
+	var result [32]uint16
+	for i := 0; i < 32; i++ {
+		result[i] = uint16(data[32+i])
+	}
+	return archsimd.LoadUint16x32Slice(result[:])
+}
+
+// PromoteLowerI8ToI16_AVX512_Int8x64 promotes the lower 32 int8 lanes of v to 32 int16 lanes.
+func PromoteLowerI8ToI16_AVX512_Int8x64(v archsimd.Int8x64) archsimd.Int16x32 {
+	var data [64]int8
+	v.Store(&data)
+
+	var result [32]int16
+	for i := 0; i < 32; i++ {
+		result[i] = int16(data[i])
+	}
+	return archsimd.LoadInt16x32Slice(result[:])
+}
+
+// PromoteUpperI8ToI16_AVX512_Int8x64 promotes the upper 32 int8 lanes of v to 32 int16 lanes.
+func PromoteUpperI8ToI16_AVX512_Int8x64(v archsimd.Int8x64) archsimd.Int16x32 {
+	var data [64]int8
+	v.Store(&data)
+
+	var result [32]int16
+	for i := 0; i < 32; i++ {
+		result[i] = int16(data[32+i])
+	}
+	return archsimd.LoadInt16x32Slice(result[:])
+}
+
+// PromoteLowerU16ToU32_AVX512_Uint16x32 promotes the lower 16 uint16 lanes of v to 16 uint32 lanes.
+func PromoteLowerU16ToU32_AVX512_Uint16x32(v archsimd.Uint16x32) archsimd.Uint32x16 {
+	var data [32]uint16
+	v.Store(&data)
+
+	var result [16]uint32
+	for i := 0; i < 16; i++ {
+		result[i] = uint32(data[i])
+	}
+	return archsimd.LoadUint32x16Slice(result[:])
+}
+
+// PromoteUpperU16ToU32_AVX512_Uint16x32 promotes the upper 16 uint16 lanes of v to 16 uint32 lanes.
+func PromoteUpperU16ToU32_AVX512_Uint16x32(v archsimd.Uint16x32) archsimd.Uint32x16 {
+	var data [32]uint16
+	v.Store(&data)
+
+	var result [16]uint32
+	for i := 0; i < 16; i++ {
+		result[i] = uint32(data[16+i])
+	}
+	return archsimd.LoadUint32x16Slice(result[:])
+}
+
+// PromoteLowerI16ToI32_AVX512_Int16x32 promotes the lower 16 int16 lanes of v to 16 int32 lanes.
+func PromoteLowerI16ToI32_AVX512_Int16x32(v archsimd.Int16x32) archsimd.Int32x16 {
+	var data [32]int16
+	v.Store(&data)
+
+	var result [16]int32
+	for i := 0; i < 16; i++ {
+		result[i] = int32(data[i])
+	}
+	return archsimd.LoadInt32x16Slice(result[:])
+}
+
+// PromoteUpperI16ToI32_AVX512_Int16x32 promotes the upper 16 int16 lanes of v to 16 int32 lanes.
+func PromoteUpperI16ToI32_AVX512_Int16x32(v archsimd.Int16x32) archsimd.Int32x16 {
+	var data [32]int16
+	v.Store(&data)
+
+	var result [16]int32
+	for i := 0; i < 16; i++ {
+		result[i] = int32(data[16+i])
+	}
+	return archsimd.LoadInt32x16Slice(result[:])
+}
@@ -0,0 +1,59 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build arm64
+
+package hwy
+
+import "github.com/ajroetker/go-highway/hwy/asm"
+
+// This file provides NEON implementations of the 8/16-bit unsigned promotions
+// used by hwygen-generated byte-oriented kernels. The asm package has no
+// signed 8/16-bit vector types, so only unsigned lanes are covered.
+
+// PromoteLowerU8ToU16_NEON_Uint8x16 promotes the lower 8 uint8 lanes of v to 8 uint16 lanes.
+func PromoteLowerU8ToU16_NEON_Uint8x16(v asm.Uint8x16) asm.Uint16x8 {
+	var result [8]uint16
+	for i := range result {
+		result[i] = uint16(v.Get(i))
+	}
+	return asm.LoadUint16x8(&result)
+}
+
+// PromoteUpperU8ToU16_NEON_Uint8x16 promotes the upper 8 uint8 lanes of v to 8 uint16 lanes.
+func PromoteUpperU8ToU16_NEON_Uint8x16(v asm.Uint8x16) asm.Uint16x8 {
+	var result [8]uint16
+	for i := range result {
+		result[i] = uint16(v.Get(8 + i))
+	}
+	return asm.LoadUint16x8(&result)
+}
+
+// PromoteLowerU16ToU32_NEON_Uint16x8 promotes the lower 4 uint16 lanes of v to 4 uint32 lanes.
+func PromoteLowerU16ToU32_NEON_Uint16x8(v asm.Uint16x8) asm.Uint32x4 {
+	var result [4]uint32
+	for i := range result {
+		result[i] = uint32(v.Get(i))
+	}
+	return asm.LoadUint32x4(&result)
+}
+
+// PromoteUpperU16ToU32_NEON_Uint16x8 promotes the upper 4 uint16 lanes of v to 4 uint32 lanes.
+func PromoteUpperU16ToU32_NEON_Uint16x8(v asm.Uint16x8) asm.Uint32x4 {
+	var result [4]uint32
+	for i := range result {
+		result[i] = uint32(v.Get(4 + i))
+	}
+	return asm.LoadUint32x4(&result)
+}
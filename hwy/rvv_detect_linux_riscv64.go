@@ -0,0 +1,38 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build linux && riscv64
+
+package hwy
+
+import (
+	"os"
+
+	"golang.org/x/sys/cpu"
+)
+
+// hasRVV indicates if the RISC-V Vector extension (RVV 1.0) is available,
+// as reported by the kernel through hwprobe (e.g. SpacemiT K1, SiFive X280).
+var hasRVV = cpu.RISCV64.HasV
+
+// HasRVV returns true if the CPU supports RISC-V Vector instructions and
+// RVV has not been disabled via environment variables.
+// Returns false when HWY_NO_SIMD or HWY_NO_RVV is set, or when HWY_TARGET
+// selects a lower target.
+func HasRVV() bool {
+	if NoSimdEnv() || os.Getenv("HWY_NO_RVV") != "" || !TargetEnabled(DispatchRVV) {
+		return false
+	}
+	return hasRVV
+}
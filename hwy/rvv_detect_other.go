@@ -0,0 +1,26 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !linux || !riscv64
+
+package hwy
+
+// hasRVV is false on platforms other than linux/riscv64.
+var hasRVV = false
+
+// HasRVV returns true if the CPU supports RISC-V Vector instructions.
+// Outside linux/riscv64, this always returns false.
+func HasRVV() bool {
+	return false
+}
@@ -400,3 +400,59 @@ func TestZeroIfNegativeInt(t *testing.T) {
 		}
 	}
 }
+
+func TestMaskedArithmetic(t *testing.T) {
+	a := LoadSlice([]float32{10, 20, 30, 40})
+	b := LoadSlice([]float32{1, 2, 3, 4})
+	mask := GreaterThan(b, LoadSlice([]float32{0, 2, 2, 5}))
+
+	tests := []struct {
+		name string
+		got  Vec[float32]
+		want []float32
+	}{
+		{"MaskedAdd", MaskedAdd(mask, a, b), []float32{11, 20, 33, 40}},
+		{"MaskedSub", MaskedSub(mask, a, b), []float32{9, 20, 27, 40}},
+		{"MaskedMul", MaskedMul(mask, a, b), []float32{10, 20, 90, 40}},
+	}
+	for _, tt := range tests {
+		for i, want := range tt.want {
+			if tt.got.data[i] != want {
+				t.Errorf("%s: lane %d: got %v, want %v", tt.name, i, tt.got.data[i], want)
+			}
+		}
+	}
+}
+
+func TestMaskedAddAccumulate(t *testing.T) {
+	// Sum only the positive elements: acc = MaskedAdd(v > 0, acc, v).
+	data := []int32{3, -1, 4, -1, 5, -9, 2, 6}
+	lanes := MaxLanes[int32]()
+	acc := Zero[int32]()
+	zero := Zero[int32]()
+	for i := 0; i+lanes <= len(data); i += lanes {
+		v := LoadSlice(data[i:])
+		acc = MaskedAdd(GreaterThan(v, zero), acc, v)
+	}
+	full := len(data) / lanes * lanes
+	var want int32
+	for _, x := range data[:full] {
+		want += max(x, 0)
+	}
+	if got := ReduceSum(acc); got != want {
+		t.Errorf("MaskedAdd accumulate: got %d, want %d", got, want)
+	}
+}
+
+func TestMaskedArithmeticFloat16(t *testing.T) {
+	a := LoadSlice([]Float16{Float32ToFloat16(1.5), Float32ToFloat16(2.5)})
+	b := LoadSlice([]Float16{Float32ToFloat16(0.25), Float32ToFloat16(0.25)})
+	mask := Mask[Float16]{bits: []bool{true, false}}
+	result := MaskedAdd(mask, a, b)
+	if got := result.data[0].Float32(); got != 1.75 {
+		t.Errorf("MaskedAdd Float16: lane 0: got %v, want 1.75", got)
+	}
+	if got := result.data[1].Float32(); got != 2.5 {
+		t.Errorf("MaskedAdd Float16: lane 1: got %v, want 2.5", got)
+	}
+}
@@ -28,6 +28,24 @@ func Reverse[T Lanes](v Vec[T]) Vec[T] {
 	return Vec[T]{data: result}
 }
 
+// RotateLanes rotates all lanes down (toward lower indices) by n, wrapping
+// the lanes that slide out back in at the top. Negative n rotates up.
+// [0,1,2,3,4,5,6,7] with n=2 -> [2,3,4,5,6,7,0,1]
+func RotateLanes[T Lanes](v Vec[T], n int) Vec[T] {
+	lanes := len(v.data)
+	result := make([]T, lanes)
+	if lanes == 0 {
+		return Vec[T]{data: result}
+	}
+	n %= lanes
+	if n < 0 {
+		n += lanes
+	}
+	copy(result, v.data[n:])
+	copy(result[lanes-n:], v.data[:n])
+	return Vec[T]{data: result}
+}
+
 // Broadcast broadcasts a single lane to all lanes in the vector.
 func Broadcast[T Lanes](v Vec[T], lane int) Vec[T] {
 	n := len(v.data)
@@ -337,6 +355,33 @@ func ZipUpper[T Lanes](a, b Vec[T]) Vec[T] {
 	return InterleaveUpper(a, b)
 }
 
+// ConcatEven concatenates the even lanes of a with the even lanes of b.
+// This de-interleaves pairs, undoing ZipLower/ZipUpper.
+// [a0,a1,a2,a3], [b0,b1,b2,b3] -> [a0,a2,b0,b2]
+func ConcatEven[T Lanes](a, b Vec[T]) Vec[T] {
+	n := min(len(b.data), len(a.data))
+	half := n / 2
+	result := make([]T, n)
+	for i := range half {
+		result[i] = a.data[2*i]
+		result[half+i] = b.data[2*i]
+	}
+	return Vec[T]{data: result}
+}
+
+// ConcatOdd concatenates the odd lanes of a with the odd lanes of b.
+// [a0,a1,a2,a3], [b0,b1,b2,b3] -> [a1,a3,b1,b3]
+func ConcatOdd[T Lanes](a, b Vec[T]) Vec[T] {
+	n := min(len(b.data), len(a.data))
+	half := n / 2
+	result := make([]T, n)
+	for i := range half {
+		result[i] = a.data[2*i+1]
+		result[half+i] = b.data[2*i+1]
+	}
+	return Vec[T]{data: result}
+}
+
 // Shuffle0123 shuffles 4 lanes according to the given indices.
 // Each index specifies which lane from the source to place in that position.
 // For example: Shuffle0123(v, 3, 2, 1, 0) reverses a 4-lane vector.
@@ -64,6 +64,25 @@ func Reverse2_AVX2_F64x4(v archsimd.Float64x4) archsimd.Float64x4 {
 	return archsimd.LoadFloat64x4(&data)
 }
 
+// Reverse2_AVX2_I32x8 reverses pairs of lanes.
+func Reverse2_AVX2_I32x8(v archsimd.Int32x8) archsimd.Int32x8 {
+	var data [8]int32
+	v.Store(&data)
+	for i := 0; i < 8; i += 2 {
+		data[i], data[i+1] = data[i+1], data[i]
+	}
+	return archsimd.LoadInt32x8(&data)
+}
+
+// Reverse2_AVX2_I64x4 reverses pairs of lanes.
+func Reverse2_AVX2_I64x4(v archsimd.Int64x4) archsimd.Int64x4 {
+	var data [4]int64
+	v.Store(&data)
+	data[0], data[1] = data[1], data[0]
+	data[2], data[3] = data[3], data[2]
+	return archsimd.LoadInt64x4(&data)
+}
+
 // Reverse4_AVX2_F32x8 reverses groups of 4 lanes.
 // [0,1,2,3,4,5,6,7] -> [3,2,1,0,7,6,5,4]
 func Reverse4_AVX2_F32x8(v archsimd.Float32x8) archsimd.Float32x8 {
@@ -81,6 +100,24 @@ func Reverse4_AVX2_F64x4(v archsimd.Float64x4) archsimd.Float64x4 {
 	return Reverse_AVX2_F64x4(v)
 }
 
+// Reverse4_AVX2_I32x8 reverses groups of 4 lanes.
+func Reverse4_AVX2_I32x8(v archsimd.Int32x8) archsimd.Int32x8 {
+	var data [8]int32
+	v.Store(&data)
+	for i := 0; i < 8; i += 4 {
+		data[i], data[i+1], data[i+2], data[i+3] = data[i+3], data[i+2], data[i+1], data[i]
+	}
+	return archsimd.LoadInt32x8(&data)
+}
+
+// Reverse4_AVX2_I64x4 reverses all 4 lanes.
+func Reverse4_AVX2_I64x4(v archsimd.Int64x4) archsimd.Int64x4 {
+	var data [4]int64
+	v.Store(&data)
+	result := [4]int64{data[3], data[2], data[1], data[0]}
+	return archsimd.LoadInt64x4(&result)
+}
+
 // InsertLane_AVX2_F32x8 inserts a value at the given lane.
 func InsertLane_AVX2_F32x8(v archsimd.Float32x8, idx int, val float32) archsimd.Float32x8 {
 	if idx < 0 || idx >= 8 {
@@ -216,6 +253,27 @@ func ConcatLowerLower_AVX2_F64x4(a, b archsimd.Float64x4) archsimd.Float64x4 {
 	return archsimd.LoadFloat64x4(&result)
 }
 
+// ConcatLowerLower_AVX2_I32x8 concatenates lower halves.
+func ConcatLowerLower_AVX2_I32x8(a, b archsimd.Int32x8) archsimd.Int32x8 {
+	var dataA, dataB [8]int32
+	a.Store(&dataA)
+	b.Store(&dataB)
+	result := [8]int32{
+		dataA[0], dataA[1], dataA[2], dataA[3],
+		dataB[0], dataB[1], dataB[2], dataB[3],
+	}
+	return archsimd.LoadInt32x8(&result)
+}
+
+// ConcatLowerLower_AVX2_I64x4 concatenates lower halves.
+func ConcatLowerLower_AVX2_I64x4(a, b archsimd.Int64x4) archsimd.Int64x4 {
+	var dataA, dataB [4]int64
+	a.Store(&dataA)
+	b.Store(&dataB)
+	result := [4]int64{dataA[0], dataA[1], dataB[0], dataB[1]}
+	return archsimd.LoadInt64x4(&result)
+}
+
 // OddEven_AVX2_F32x8 combines odd lanes from a with even lanes from b.
 // [a0,a1,a2,a3,a4,a5,a6,a7], [b0,b1,b2,b3,b4,b5,b6,b7] -> [b0,a1,b2,a3,b4,a5,b6,a7]
 func OddEven_AVX2_F32x8(a, b archsimd.Float32x8) archsimd.Float32x8 {
@@ -301,6 +359,25 @@ func SwapAdjacentBlocks_AVX2_F64x4(v archsimd.Float64x4) archsimd.Float64x4 {
 	return archsimd.LoadFloat64x4(&result)
 }
 
+// SwapAdjacentBlocks_AVX2_I32x8 swaps the two 128-bit halves.
+func SwapAdjacentBlocks_AVX2_I32x8(v archsimd.Int32x8) archsimd.Int32x8 {
+	var data [8]int32
+	v.Store(&data)
+	result := [8]int32{
+		data[4], data[5], data[6], data[7],
+		data[0], data[1], data[2], data[3],
+	}
+	return archsimd.LoadInt32x8(&result)
+}
+
+// SwapAdjacentBlocks_AVX2_I64x4 swaps the two 128-bit halves.
+func SwapAdjacentBlocks_AVX2_I64x4(v archsimd.Int64x4) archsimd.Int64x4 {
+	var data [4]int64
+	v.Store(&data)
+	result := [4]int64{data[2], data[3], data[0], data[1]}
+	return archsimd.LoadInt64x4(&result)
+}
+
 // Slide operations
 
 // SlideUpLanes_AVX2_F32x8 shifts lanes up by offset, filling low lanes with zeros.
@@ -453,3 +530,295 @@ func Slide1Down_AVX2_F64x4(v archsimd.Float64x4) archsimd.Float64x4 {
 func TableLookupBytes_AVX2_Uint8x16(v, indices archsimd.Uint8x16) archsimd.Uint8x16 {
 	return v.PermuteOrZero(indices.AsInt8x16())
 }
+
+// TableLookupBytes_AVX2_Uint8x32 performs byte shuffling within each 128-bit
+// block: an index selects a byte of the same block of v, so a 16-byte table
+// must be repeated in every block. If the high bit of an index is set, the
+// result byte is zero (VPSHUFB semantics).
+func TableLookupBytes_AVX2_Uint8x32(v, indices archsimd.Uint8x32) archsimd.Uint8x32 {
+	return v.PermuteOrZeroGrouped(indices.AsInt8x32())
+}
+
+// Lane rotation and variable permutation
+
+// RotateLanes_AVX2_F32x8 rotates lanes down by n, wrapping around.
+// [0,1,2,3,...] with n=1 -> [1,2,3,...,0]
+func RotateLanes_AVX2_F32x8(v archsimd.Float32x8, n int) archsimd.Float32x8 {
+	n %= 8
+	if n < 0 {
+		n += 8
+	}
+	if n == 0 {
+		return v
+	}
+	var data, result [8]float32
+	v.Store(&data)
+	copy(result[:], data[n:])
+	copy(result[8-n:], data[:n])
+	return archsimd.LoadFloat32x8(&result)
+}
+
+// RotateLanes_AVX2_F64x4 rotates lanes down by n, wrapping around.
+// [0,1,2,3,...] with n=1 -> [1,2,3,...,0]
+func RotateLanes_AVX2_F64x4(v archsimd.Float64x4, n int) archsimd.Float64x4 {
+	n %= 4
+	if n < 0 {
+		n += 4
+	}
+	if n == 0 {
+		return v
+	}
+	var data, result [4]float64
+	v.Store(&data)
+	copy(result[:], data[n:])
+	copy(result[4-n:], data[:n])
+	return archsimd.LoadFloat64x4(&result)
+}
+
+// RotateLanes_AVX2_I32x8 rotates lanes down by n, wrapping around.
+// [0,1,2,3,...] with n=1 -> [1,2,3,...,0]
+func RotateLanes_AVX2_I32x8(v archsimd.Int32x8, n int) archsimd.Int32x8 {
+	n %= 8
+	if n < 0 {
+		n += 8
+	}
+	if n == 0 {
+		return v
+	}
+	var data, result [8]int32
+	v.Store(&data)
+	copy(result[:], data[n:])
+	copy(result[8-n:], data[:n])
+	return archsimd.LoadInt32x8(&result)
+}
+
+// RotateLanes_AVX2_I64x4 rotates lanes down by n, wrapping around.
+// [0,1,2,3,...] with n=1 -> [1,2,3,...,0]
+func RotateLanes_AVX2_I64x4(v archsimd.Int64x4, n int) archsimd.Int64x4 {
+	n %= 4
+	if n < 0 {
+		n += 4
+	}
+	if n == 0 {
+		return v
+	}
+	var data, result [4]int64
+	v.Store(&data)
+	copy(result[:], data[n:])
+	copy(result[4-n:], data[:n])
+	return archsimd.LoadInt64x4(&result)
+}
+
+// TableLookupLanes_AVX2_F32x8 selects lanes of tbl by index.
+// Out-of-range indices produce zero lanes.
+func TableLookupLanes_AVX2_F32x8(tbl archsimd.Float32x8, idx archsimd.Int32x8) archsimd.Float32x8 {
+	var data, result [8]float32
+	var indices [8]int32
+	tbl.Store(&data)
+	idx.Store(&indices)
+	for i, j := range indices {
+		if j >= 0 && j < 8 {
+			result[i] = data[j]
+		}
+	}
+	return archsimd.LoadFloat32x8(&result)
+}
+
+// TableLookupLanes_AVX2_F64x4 selects lanes of tbl by index.
+// Out-of-range indices produce zero lanes.
+func TableLookupLanes_AVX2_F64x4(tbl archsimd.Float64x4, idx archsimd.Int64x4) archsimd.Float64x4 {
+	var data, result [4]float64
+	var indices [4]int64
+	tbl.Store(&data)
+	idx.Store(&indices)
+	for i, j := range indices {
+		if j >= 0 && j < 4 {
+			result[i] = data[j]
+		}
+	}
+	return archsimd.LoadFloat64x4(&result)
+}
+
+// TableLookupLanes_AVX2_I32x8 selects lanes of tbl by index.
+// Out-of-range indices produce zero lanes.
+func TableLookupLanes_AVX2_I32x8(tbl archsimd.Int32x8, idx archsimd.Int32x8) archsimd.Int32x8 {
+	var data, result [8]int32
+	var indices [8]int32
+	tbl.Store(&data)
+	idx.Store(&indices)
+	for i, j := range indices {
+		if j >= 0 && j < 8 {
+			result[i] = data[j]
+		}
+	}
+	return archsimd.LoadInt32x8(&result)
+}
+
+// TableLookupLanes_AVX2_I64x4 selects lanes of tbl by index.
+// Out-of-range indices produce zero lanes.
+func TableLookupLanes_AVX2_I64x4(tbl archsimd.Int64x4, idx archsimd.Int64x4) archsimd.Int64x4 {
+	var data, result [4]int64
+	var indices [4]int64
+	tbl.Store(&data)
+	idx.Store(&indices)
+	for i, j := range indices {
+		if j >= 0 && j < 4 {
+			result[i] = data[j]
+		}
+	}
+	return archsimd.LoadInt64x4(&result)
+}
+
+// Zip and de-interleave operations
+
+// ZipLower_AVX2_F32x8 interleaves the lower halves of a and b.
+// This is the same as InterleaveLower but named for consistency with C++ Highway.
+func ZipLower_AVX2_F32x8(a, b archsimd.Float32x8) archsimd.Float32x8 {
+	return InterleaveLower_AVX2_F32x8(a, b)
+}
+
+// ZipUpper_AVX2_F32x8 interleaves the upper halves of a and b.
+// This is the same as InterleaveUpper but named for consistency with C++ Highway.
+func ZipUpper_AVX2_F32x8(a, b archsimd.Float32x8) archsimd.Float32x8 {
+	return InterleaveUpper_AVX2_F32x8(a, b)
+}
+
+// ZipLower_AVX2_F64x4 interleaves the lower halves of a and b.
+// This is the same as InterleaveLower but named for consistency with C++ Highway.
+func ZipLower_AVX2_F64x4(a, b archsimd.Float64x4) archsimd.Float64x4 {
+	return InterleaveLower_AVX2_F64x4(a, b)
+}
+
+// ZipUpper_AVX2_F64x4 interleaves the upper halves of a and b.
+// This is the same as InterleaveUpper but named for consistency with C++ Highway.
+func ZipUpper_AVX2_F64x4(a, b archsimd.Float64x4) archsimd.Float64x4 {
+	return InterleaveUpper_AVX2_F64x4(a, b)
+}
+
+// ZipLower_AVX2_I32x8 interleaves the lower halves of a and b.
+// This is the same as InterleaveLower but named for consistency with C++ Highway.
+func ZipLower_AVX2_I32x8(a, b archsimd.Int32x8) archsimd.Int32x8 {
+	return InterleaveLower_AVX2_I32x8(a, b)
+}
+
+// ZipUpper_AVX2_I32x8 interleaves the upper halves of a and b.
+// This is the same as InterleaveUpper but named for consistency with C++ Highway.
+func ZipUpper_AVX2_I32x8(a, b archsimd.Int32x8) archsimd.Int32x8 {
+	return InterleaveUpper_AVX2_I32x8(a, b)
+}
+
+// ZipLower_AVX2_I64x4 interleaves the lower halves of a and b.
+// This is the same as InterleaveLower but named for consistency with C++ Highway.
+func ZipLower_AVX2_I64x4(a, b archsimd.Int64x4) archsimd.Int64x4 {
+	return InterleaveLower_AVX2_I64x4(a, b)
+}
+
+// ZipUpper_AVX2_I64x4 interleaves the upper halves of a and b.
+// This is the same as InterleaveUpper but named for consistency with C++ Highway.
+func ZipUpper_AVX2_I64x4(a, b archsimd.Int64x4) archsimd.Int64x4 {
+	return InterleaveUpper_AVX2_I64x4(a, b)
+}
+
+// ConcatEven_AVX2_F32x8 concatenates the even lanes of a and b.
+// [a0,a1,a2,a3,...], [b0,b1,b2,b3,...] -> [a0,a2,...,b0,b2,...]
+func ConcatEven_AVX2_F32x8(a, b archsimd.Float32x8) archsimd.Float32x8 {
+	var dataA, dataB, result [8]float32
+	a.Store(&dataA)
+	b.Store(&dataB)
+	for i := range 4 {
+		result[i] = dataA[2*i]
+		result[4+i] = dataB[2*i]
+	}
+	return archsimd.LoadFloat32x8(&result)
+}
+
+// ConcatOdd_AVX2_F32x8 concatenates the odd lanes of a and b.
+// [a0,a1,a2,a3,...], [b0,b1,b2,b3,...] -> [a1,a3,...,b1,b3,...]
+func ConcatOdd_AVX2_F32x8(a, b archsimd.Float32x8) archsimd.Float32x8 {
+	var dataA, dataB, result [8]float32
+	a.Store(&dataA)
+	b.Store(&dataB)
+	for i := range 4 {
+		result[i] = dataA[2*i+1]
+		result[4+i] = dataB[2*i+1]
+	}
+	return archsimd.LoadFloat32x8(&result)
+}
+
+// ConcatEven_AVX2_F64x4 concatenates the even lanes of a and b.
+// [a0,a1,a2,a3,...], [b0,b1,b2,b3,...] -> [a0,a2,...,b0,b2,...]
+func ConcatEven_AVX2_F64x4(a, b archsimd.Float64x4) archsimd.Float64x4 {
+	var dataA, dataB, result [4]float64
+	a.Store(&dataA)
+	b.Store(&dataB)
+	for i := range 2 {
+		result[i] = dataA[2*i]
+		result[2+i] = dataB[2*i]
+	}
+	return archsimd.LoadFloat64x4(&result)
+}
+
+// ConcatOdd_AVX2_F64x4 concatenates the odd lanes of a and b.
+// [a0,a1,a2,a3,...], [b0,b1,b2,b3,...] -> [a1,a3,...,b1,b3,...]
+func ConcatOdd_AVX2_F64x4(a, b archsimd.Float64x4) archsimd.Float64x4 {
+	var dataA, dataB, result [4]float64
+	a.Store(&dataA)
+	b.Store(&dataB)
+	for i := range 2 {
+		result[i] = dataA[2*i+1]
+		result[2+i] = dataB[2*i+1]
+	}
+	return archsimd.LoadFloat64x4(&result)
+}
+
+// ConcatEven_AVX2_I32x8 concatenates the even lanes of a and b.
+// [a0,a1,a2,a3,...], [b0,b1,b2,b3,...] -> [a0,a2,...,b0,b2,...]
+func ConcatEven_AVX2_I32x8(a, b archsimd.Int32x8) archsimd.Int32x8 {
+	var dataA, dataB, result [8]int32
+	a.Store(&dataA)
+	b.Store(&dataB)
+	for i := range 4 {
+		result[i] = dataA[2*i]
+		result[4+i] = dataB[2*i]
+	}
+	return archsimd.LoadInt32x8(&result)
+}
+
+// ConcatOdd_AVX2_I32x8 concatenates the odd lanes of a and b.
+// [a0,a1,a2,a3,...], [b0,b1,b2,b3,...] -> [a1,a3,...,b1,b3,...]
+func ConcatOdd_AVX2_I32x8(a, b archsimd.Int32x8) archsimd.Int32x8 {
+	var dataA, dataB, result [8]int32
+	a.Store(&dataA)
+	b.Store(&dataB)
+	for i := range 4 {
+		result[i] = dataA[2*i+1]
+		result[4+i] = dataB[2*i+1]
+	}
+	return archsimd.LoadInt32x8(&result)
+}
+
+// ConcatEven_AVX2_I64x4 concatenates the even lanes of a and b.
+// [a0,a1,a2,a3,...], [b0,b1,b2,b3,...] -> [a0,a2,...,b0,b2,...]
+func ConcatEven_AVX2_I64x4(a, b archsimd.Int64x4) archsimd.Int64x4 {
+	var dataA, dataB, result [4]int64
+	a.Store(&dataA)
+	b.Store(&dataB)
+	for i := range 2 {
+		result[i] = dataA[2*i]
+		result[2+i] = dataB[2*i]
+	}
+	return archsimd.LoadInt64x4(&result)
+}
+
+// ConcatOdd_AVX2_I64x4 concatenates the odd lanes of a and b.
+// [a0,a1,a2,a3,...], [b0,b1,b2,b3,...] -> [a1,a3,...,b1,b3,...]
+func ConcatOdd_AVX2_I64x4(a, b archsimd.Int64x4) archsimd.Int64x4 {
+	var dataA, dataB, result [4]int64
+	a.Store(&dataA)
+	b.Store(&dataB)
+	for i := range 2 {
+		result[i] = dataA[2*i+1]
+		result[2+i] = dataB[2*i+1]
+	}
+	return archsimd.LoadInt64x4(&result)
+}
@@ -66,6 +66,26 @@ func Reverse2_AVX512_F64x8(v archsimd.Float64x8) archsimd.Float64x8 {
 	return archsimd.LoadFloat64x8Slice(data[:])
 }
 
+// Reverse2_AVX512_I32x16 reverses pairs of lanes.
+func Reverse2_AVX512_I32x16(v archsimd.Int32x16) archsimd.Int32x16 {
+	var data [16]int32
+	v.Store(&data)
+	for i := 0; i < 16; i += 2 {
+		data[i], data[i+1] = data[i+1], data[i]
+	}
+	return archsimd.LoadInt32x16(&data)
+}
+
+// Reverse2_AVX512_I64x8 reverses pairs of lanes.
+func Reverse2_AVX512_I64x8(v archsimd.Int64x8) archsimd.Int64x8 {
+	var data [8]int64
+	v.Store(&data)
+	for i := 0; i < 8; i += 2 {
+		data[i], data[i+1] = data[i+1], data[i]
+	}
+	return archsimd.LoadInt64x8(&data)
+}
+
 // Reverse4_AVX512_F32x16 reverses groups of 4 lanes.
 func Reverse4_AVX512_F32x16(v archsimd.Float32x16) archsimd.Float32x16 {
 	var data [16]float32
@@ -86,6 +106,26 @@ func Reverse4_AVX512_F64x8(v archsimd.Float64x8) archsimd.Float64x8 {
 	return archsimd.LoadFloat64x8Slice(data[:])
 }
 
+// Reverse4_AVX512_I32x16 reverses groups of 4 lanes.
+func Reverse4_AVX512_I32x16(v archsimd.Int32x16) archsimd.Int32x16 {
+	var data [16]int32
+	v.Store(&data)
+	for i := 0; i < 16; i += 4 {
+		data[i], data[i+1], data[i+2], data[i+3] = data[i+3], data[i+2], data[i+1], data[i]
+	}
+	return archsimd.LoadInt32x16(&data)
+}
+
+// Reverse4_AVX512_I64x8 reverses groups of 4 lanes.
+func Reverse4_AVX512_I64x8(v archsimd.Int64x8) archsimd.Int64x8 {
+	var data [8]int64
+	v.Store(&data)
+	for i := 0; i < 8; i += 4 {
+		data[i], data[i+1], data[i+2], data[i+3] = data[i+3], data[i+2], data[i+1], data[i]
+	}
+	return archsimd.LoadInt64x8(&data)
+}
+
 // Reverse8_AVX512_F32x16 reverses groups of 8 lanes.
 func Reverse8_AVX512_F32x16(v archsimd.Float32x16) archsimd.Float32x16 {
 	var data [16]float32
@@ -291,6 +331,28 @@ func ConcatLowerLower_AVX512_F64x8(a, b archsimd.Float64x8) archsimd.Float64x8 {
 	return archsimd.LoadFloat64x8Slice(result[:])
 }
 
+// ConcatLowerLower_AVX512_I32x16 concatenates lower halves.
+func ConcatLowerLower_AVX512_I32x16(a, b archsimd.Int32x16) archsimd.Int32x16 {
+	var dataA, dataB [16]int32
+	a.Store(&dataA)
+	b.Store(&dataB)
+	var result [16]int32
+	copy(result[:8], dataA[:8])
+	copy(result[8:], dataB[:8])
+	return archsimd.LoadInt32x16(&result)
+}
+
+// ConcatLowerLower_AVX512_I64x8 concatenates lower halves.
+func ConcatLowerLower_AVX512_I64x8(a, b archsimd.Int64x8) archsimd.Int64x8 {
+	var dataA, dataB [8]int64
+	a.Store(&dataA)
+	b.Store(&dataB)
+	var result [8]int64
+	copy(result[:4], dataA[:4])
+	copy(result[4:], dataB[:4])
+	return archsimd.LoadInt64x8(&result)
+}
+
 // OddEven_AVX512_F32x16 combines odd lanes from a with even lanes from b.
 func OddEven_AVX512_F32x16(a, b archsimd.Float32x16) archsimd.Float32x16 {
 	var dataA, dataB [16]float32
@@ -399,6 +461,30 @@ func SwapAdjacentBlocks_AVX512_F64x8(v archsimd.Float64x8) archsimd.Float64x8 {
 	return archsimd.LoadFloat64x8Slice(result[:])
 }
 
+// SwapAdjacentBlocks_AVX512_I32x16 swaps adjacent 128-bit blocks.
+func SwapAdjacentBlocks_AVX512_I32x16(v archsimd.Int32x16) archsimd.Int32x16 {
+	var data [16]int32
+	v.Store(&data)
+	var result [16]int32
+	copy(result[0:4], data[4:8])
+	copy(result[4:8], data[0:4])
+	copy(result[8:12], data[12:16])
+	copy(result[12:16], data[8:12])
+	return archsimd.LoadInt32x16(&result)
+}
+
+// SwapAdjacentBlocks_AVX512_I64x8 swaps adjacent 128-bit blocks.
+func SwapAdjacentBlocks_AVX512_I64x8(v archsimd.Int64x8) archsimd.Int64x8 {
+	var data [8]int64
+	v.Store(&data)
+	var result [8]int64
+	copy(result[0:2], data[2:4])
+	copy(result[2:4], data[0:2])
+	copy(result[4:6], data[6:8])
+	copy(result[6:8], data[4:6])
+	return archsimd.LoadInt64x8(&result)
+}
+
 // Slide operations
 
 // SlideUpLanes_AVX512_F32x16 shifts lanes up by offset, filling low lanes with zeros.
@@ -547,3 +633,295 @@ func Slide1Down_AVX512_F64x8(v archsimd.Float64x8) archsimd.Float64x8 {
 func TableLookupBytes_AVX512_Uint8x16(v, indices archsimd.Uint8x16) archsimd.Uint8x16 {
 	return v.PermuteOrZero(indices.AsInt8x16())
 }
+
+// TableLookupBytes_AVX512_Uint8x64 performs byte shuffling within each 128-bit
+// block: an index selects a byte of the same block of v, so a 16-byte table
+// must be repeated in every block. If the high bit of an index is set, the
+// result byte is zero (VPSHUFB semantics).
+func TableLookupBytes_AVX512_Uint8x64(v, indices archsimd.Uint8x64) archsimd.Uint8x64 {
+	return v.PermuteOrZeroGrouped(indices.AsInt8x64())
+}
+
+// Lane rotation and variable permutation
+
+// RotateLanes_AVX512_F32x16 rotates lanes down by n, wrapping around.
+// [0,1,2,3,...] with n=1 -> [1,2,3,...,0]
+func RotateLanes_AVX512_F32x16(v archsimd.Float32x16, n int) archsimd.Float32x16 {
+	n %= 16
+	if n < 0 {
+		n += 16
+	}
+	if n == 0 {
+		return v
+	}
+	var data, result [16]float32
+	v.Store(&data)
+	copy(result[:], data[n:])
+	copy(result[16-n:], data[:n])
+	return archsimd.LoadFloat32x16(&result)
+}
+
+// RotateLanes_AVX512_F64x8 rotates lanes down by n, wrapping around.
+// [0,1,2,3,...] with n=1 -> [1,2,3,...,0]
+func RotateLanes_AVX512_F64x8(v archsimd.Float64x8, n int) archsimd.Float64x8 {
+	n %= 8
+	if n < 0 {
+		n += 8
+	}
+	if n == 0 {
+		return v
+	}
+	var data, result [8]float64
+	v.Store(&data)
+	copy(result[:], data[n:])
+	copy(result[8-n:], data[:n])
+	return archsimd.LoadFloat64x8(&result)
+}
+
+// RotateLanes_AVX512_I32x16 rotates lanes down by n, wrapping around.
+// [0,1,2,3,...] with n=1 -> [1,2,3,...,0]
+func RotateLanes_AVX512_I32x16(v archsimd.Int32x16, n int) archsimd.Int32x16 {
+	n %= 16
+	if n < 0 {
+		n += 16
+	}
+	if n == 0 {
+		return v
+	}
+	var data, result [16]int32
+	v.Store(&data)
+	copy(result[:], data[n:])
+	copy(result[16-n:], data[:n])
+	return archsimd.LoadInt32x16(&result)
+}
+
+// RotateLanes_AVX512_I64x8 rotates lanes down by n, wrapping around.
+// [0,1,2,3,...] with n=1 -> [1,2,3,...,0]
+func RotateLanes_AVX512_I64x8(v archsimd.Int64x8, n int) archsimd.Int64x8 {
+	n %= 8
+	if n < 0 {
+		n += 8
+	}
+	if n == 0 {
+		return v
+	}
+	var data, result [8]int64
+	v.Store(&data)
+	copy(result[:], data[n:])
+	copy(result[8-n:], data[:n])
+	return archsimd.LoadInt64x8(&result)
+}
+
+// TableLookupLanes_AVX512_F32x16 selects lanes of tbl by index.
+// Out-of-range indices produce zero lanes.
+func TableLookupLanes_AVX512_F32x16(tbl archsimd.Float32x16, idx archsimd.Int32x16) archsimd.Float32x16 {
+	var data, result [16]float32
+	var indices [16]int32
+	tbl.Store(&data)
+	idx.Store(&indices)
+	for i, j := range indices {
+		if j >= 0 && j < 16 {
+			result[i] = data[j]
+		}
+	}
+	return archsimd.LoadFloat32x16(&result)
+}
+
+// TableLookupLanes_AVX512_F64x8 selects lanes of tbl by index.
+// Out-of-range indices produce zero lanes.
+func TableLookupLanes_AVX512_F64x8(tbl archsimd.Float64x8, idx archsimd.Int64x8) archsimd.Float64x8 {
+	var data, result [8]float64
+	var indices [8]int64
+	tbl.Store(&data)
+	idx.Store(&indices)
+	for i, j := range indices {
+		if j >= 0 && j < 8 {
+			result[i] = data[j]
+		}
+	}
+	return archsimd.LoadFloat64x8(&result)
+}
+
+// TableLookupLanes_AVX512_I32x16 selects lanes of tbl by index.
+// Out-of-range indices produce zero lanes.
+func TableLookupLanes_AVX512_I32x16(tbl archsimd.Int32x16, idx archsimd.Int32x16) archsimd.Int32x16 {
+	var data, result [16]int32
+	var indices [16]int32
+	tbl.Store(&data)
+	idx.Store(&indices)
+	for i, j := range indices {
+		if j >= 0 && j < 16 {
+			result[i] = data[j]
+		}
+	}
+	return archsimd.LoadInt32x16(&result)
+}
+
+// TableLookupLanes_AVX512_I64x8 selects lanes of tbl by index.
+// Out-of-range indices produce zero lanes.
+func TableLookupLanes_AVX512_I64x8(tbl archsimd.Int64x8, idx archsimd.Int64x8) archsimd.Int64x8 {
+	var data, result [8]int64
+	var indices [8]int64
+	tbl.Store(&data)
+	idx.Store(&indices)
+	for i, j := range indices {
+		if j >= 0 && j < 8 {
+			result[i] = data[j]
+		}
+	}
+	return archsimd.LoadInt64x8(&result)
+}
+
+// Zip and de-interleave operations
+
+// ZipLower_AVX512_F32x16 interleaves the lower halves of a and b.
+// This is the same as InterleaveLower but named for consistency with C++ Highway.
+func ZipLower_AVX512_F32x16(a, b archsimd.Float32x16) archsimd.Float32x16 {
+	return InterleaveLower_AVX512_F32x16(a, b)
+}
+
+// ZipUpper_AVX512_F32x16 interleaves the upper halves of a and b.
+// This is the same as InterleaveUpper but named for consistency with C++ Highway.
+func ZipUpper_AVX512_F32x16(a, b archsimd.Float32x16) archsimd.Float32x16 {
+	return InterleaveUpper_AVX512_F32x16(a, b)
+}
+
+// ZipLower_AVX512_F64x8 interleaves the lower halves of a and b.
+// This is the same as InterleaveLower but named for consistency with C++ Highway.
+func ZipLower_AVX512_F64x8(a, b archsimd.Float64x8) archsimd.Float64x8 {
+	return InterleaveLower_AVX512_F64x8(a, b)
+}
+
+// ZipUpper_AVX512_F64x8 interleaves the upper halves of a and b.
+// This is the same as InterleaveUpper but named for consistency with C++ Highway.
+func ZipUpper_AVX512_F64x8(a, b archsimd.Float64x8) archsimd.Float64x8 {
+	return InterleaveUpper_AVX512_F64x8(a, b)
+}
+
+// ZipLower_AVX512_I32x16 interleaves the lower halves of a and b.
+// This is the same as InterleaveLower but named for consistency with C++ Highway.
+func ZipLower_AVX512_I32x16(a, b archsimd.Int32x16) archsimd.Int32x16 {
+	return InterleaveLower_AVX512_I32x16(a, b)
+}
+
+// ZipUpper_AVX512_I32x16 interleaves the upper halves of a and b.
+// This is the same as InterleaveUpper but named for consistency with C++ Highway.
+func ZipUpper_AVX512_I32x16(a, b archsimd.Int32x16) archsimd.Int32x16 {
+	return InterleaveUpper_AVX512_I32x16(a, b)
+}
+
+// ZipLower_AVX512_I64x8 interleaves the lower halves of a and b.
+// This is the same as InterleaveLower but named for consistency with C++ Highway.
+func ZipLower_AVX512_I64x8(a, b archsimd.Int64x8) archsimd.Int64x8 {
+	return InterleaveLower_AVX512_I64x8(a, b)
+}
+
+// ZipUpper_AVX512_I64x8 interleaves the upper halves of a and b.
+// This is the same as InterleaveUpper but named for consistency with C++ Highway.
+func ZipUpper_AVX512_I64x8(a, b archsimd.Int64x8) archsimd.Int64x8 {
+	return InterleaveUpper_AVX512_I64x8(a, b)
+}
+
+// ConcatEven_AVX512_F32x16 concatenates the even lanes of a and b.
+// [a0,a1,a2,a3,...], [b0,b1,b2,b3,...] -> [a0,a2,...,b0,b2,...]
+func ConcatEven_AVX512_F32x16(a, b archsimd.Float32x16) archsimd.Float32x16 {
+	var dataA, dataB, result [16]float32
+	a.Store(&dataA)
+	b.Store(&dataB)
+	for i := range 8 {
+		result[i] = dataA[2*i]
+		result[8+i] = dataB[2*i]
+	}
+	return archsimd.LoadFloat32x16(&result)
+}
+
+// ConcatOdd_AVX512_F32x16 concatenates the odd lanes of a and b.
+// [a0,a1,a2,a3,...], [b0,b1,b2,b3,...] -> [a1,a3,...,b1,b3,...]
+func ConcatOdd_AVX512_F32x16(a, b archsimd.Float32x16) archsimd.Float32x16 {
+	var dataA, dataB, result [16]float32
+	a.Store(&dataA)
+	b.Store(&dataB)
+	for i := range 8 {
+		result[i] = dataA[2*i+1]
+		result[8+i] = dataB[2*i+1]
+	}
+	return archsimd.LoadFloat32x16(&result)
+}
+
+// ConcatEven_AVX512_F64x8 concatenates the even lanes of a and b.
+// [a0,a1,a2,a3,...], [b0,b1,b2,b3,...] -> [a0,a2,...,b0,b2,...]
+func ConcatEven_AVX512_F64x8(a, b archsimd.Float64x8) archsimd.Float64x8 {
+	var dataA, dataB, result [8]float64
+	a.Store(&dataA)
+	b.Store(&dataB)
+	for i := range 4 {
+		result[i] = dataA[2*i]
+		result[4+i] = dataB[2*i]
+	}
+	return archsimd.LoadFloat64x8(&result)
+}
+
+// ConcatOdd_AVX512_F64x8 concatenates the odd lanes of a and b.
+// [a0,a1,a2,a3,...], [b0,b1,b2,b3,...] -> [a1,a3,...,b1,b3,...]
+func ConcatOdd_AVX512_F64x8(a, b archsimd.Float64x8) archsimd.Float64x8 {
+	var dataA, dataB, result [8]float64
+	a.Store(&dataA)
+	b.Store(&dataB)
+	for i := range 4 {
+		result[i] = dataA[2*i+1]
+		result[4+i] = dataB[2*i+1]
+	}
+	return archsimd.LoadFloat64x8(&result)
+}
+
+// ConcatEven_AVX512_I32x16 concatenates the even lanes of a and b.
+// [a0,a1,a2,a3,...], [b0,b1,b2,b3,...] -> [a0,a2,...,b0,b2,...]
+func ConcatEven_AVX512_I32x16(a, b archsimd.Int32x16) archsimd.Int32x16 {
+	var dataA, dataB, result [16]int32
+	a.Store(&dataA)
+	b.Store(&dataB)
+	for i := range 8 {
+		result[i] = dataA[2*i]
+		result[8+i] = dataB[2*i]
+	}
+	return archsimd.LoadInt32x16(&result)
+}
+
+// ConcatOdd_AVX512_I32x16 concatenates the odd lanes of a and b.
+// [a0,a1,a2,a3,...], [b0,b1,b2,b3,...] -> [a1,a3,...,b1,b3,...]
+func ConcatOdd_AVX512_I32x16(a, b archsimd.Int32x16) archsimd.Int32x16 {
+	var dataA, dataB, result [16]int32
+	a.Store(&dataA)
+	b.Store(&dataB)
+	for i := range 8 {
+		result[i] = dataA[2*i+1]
+		result[8+i] = dataB[2*i+1]
+	}
+	return archsimd.LoadInt32x16(&result)
+}
+
+// ConcatEven_AVX512_I64x8 concatenates the even lanes of a and b.
+// [a0,a1,a2,a3,...], [b0,b1,b2,b3,...] -> [a0,a2,...,b0,b2,...]
+func ConcatEven_AVX512_I64x8(a, b archsimd.Int64x8) archsimd.Int64x8 {
+	var dataA, dataB, result [8]int64
+	a.Store(&dataA)
+	b.Store(&dataB)
+	for i := range 4 {
+		result[i] = dataA[2*i]
+		result[4+i] = dataB[2*i]
+	}
+	return archsimd.LoadInt64x8(&result)
+}
+
+// ConcatOdd_AVX512_I64x8 concatenates the odd lanes of a and b.
+// [a0,a1,a2,a3,...], [b0,b1,b2,b3,...] -> [a1,a3,...,b1,b3,...]
+func ConcatOdd_AVX512_I64x8(a, b archsimd.Int64x8) archsimd.Int64x8 {
+	var dataA, dataB, result [8]int64
+	a.Store(&dataA)
+	b.Store(&dataB)
+	for i := range 4 {
+		result[i] = dataA[2*i+1]
+		result[4+i] = dataB[2*i+1]
+	}
+	return archsimd.LoadInt64x8(&result)
+}
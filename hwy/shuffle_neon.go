@@ -102,3 +102,487 @@ func InterleaveUpper_NEON_I64x2(a, b asm.Int64x2) asm.Int64x2 {
 	result := [2]int64{dataA[1], dataB[1]}
 	return asm.LoadInt64x2(&result)
 }
+
+// Reverse_NEON_F32x4 reverses all lanes.
+// [0,1,2,3] -> [3,2,1,0]
+func Reverse_NEON_F32x4(v asm.Float32x4) asm.Float32x4 {
+	var data [4]float32
+	v.Store(&data)
+	result := [4]float32{data[3], data[2], data[1], data[0]}
+	return asm.LoadFloat32x4(&result)
+}
+
+// Reverse_NEON_F64x2 reverses all lanes.
+// [0,1] -> [1,0]
+func Reverse_NEON_F64x2(v asm.Float64x2) asm.Float64x2 {
+	var data [2]float64
+	v.Store(&data)
+	result := [2]float64{data[1], data[0]}
+	return asm.LoadFloat64x2(&result)
+}
+
+// Reverse2_NEON_F32x4 reverses pairs of lanes.
+// [0,1,2,3] -> [1,0,3,2]
+func Reverse2_NEON_F32x4(v asm.Float32x4) asm.Float32x4 {
+	var data [4]float32
+	v.Store(&data)
+	result := [4]float32{data[1], data[0], data[3], data[2]}
+	return asm.LoadFloat32x4(&result)
+}
+
+// Reverse2_NEON_F64x2 reverses the pair of lanes (same as Reverse for F64x2).
+func Reverse2_NEON_F64x2(v asm.Float64x2) asm.Float64x2 {
+	return Reverse_NEON_F64x2(v)
+}
+
+// Reverse2_NEON_I32x4 reverses pairs of lanes.
+func Reverse2_NEON_I32x4(v asm.Int32x4) asm.Int32x4 {
+	var data [4]int32
+	v.Store(&data)
+	result := [4]int32{data[1], data[0], data[3], data[2]}
+	return asm.LoadInt32x4(&result)
+}
+
+// Reverse2_NEON_I64x2 reverses the pair of lanes.
+func Reverse2_NEON_I64x2(v asm.Int64x2) asm.Int64x2 {
+	var data [2]int64
+	v.Store(&data)
+	result := [2]int64{data[1], data[0]}
+	return asm.LoadInt64x2(&result)
+}
+
+// Reverse4_NEON_F32x4 reverses all 4 lanes (same as Reverse for F32x4).
+func Reverse4_NEON_F32x4(v asm.Float32x4) asm.Float32x4 {
+	return Reverse_NEON_F32x4(v)
+}
+
+// Reverse4_NEON_F64x2 reverses the partial group of 2 lanes.
+func Reverse4_NEON_F64x2(v asm.Float64x2) asm.Float64x2 {
+	return Reverse_NEON_F64x2(v)
+}
+
+// Reverse4_NEON_I32x4 reverses all 4 lanes.
+func Reverse4_NEON_I32x4(v asm.Int32x4) asm.Int32x4 {
+	var data [4]int32
+	v.Store(&data)
+	result := [4]int32{data[3], data[2], data[1], data[0]}
+	return asm.LoadInt32x4(&result)
+}
+
+// Reverse4_NEON_I64x2 reverses the partial group of 2 lanes.
+func Reverse4_NEON_I64x2(v asm.Int64x2) asm.Int64x2 {
+	return Reverse2_NEON_I64x2(v)
+}
+
+// ConcatLowerLower_NEON_F32x4 concatenates lower halves.
+// [a0,a1,a2,a3], [b0,b1,b2,b3] -> [a0,a1,b0,b1]
+func ConcatLowerLower_NEON_F32x4(a, b asm.Float32x4) asm.Float32x4 {
+	var dataA, dataB [4]float32
+	a.Store(&dataA)
+	b.Store(&dataB)
+	result := [4]float32{dataA[0], dataA[1], dataB[0], dataB[1]}
+	return asm.LoadFloat32x4(&result)
+}
+
+// ConcatLowerLower_NEON_F64x2 concatenates lower halves.
+// [a0,a1], [b0,b1] -> [a0,b0]
+func ConcatLowerLower_NEON_F64x2(a, b asm.Float64x2) asm.Float64x2 {
+	return InterleaveLower_NEON_F64x2(a, b)
+}
+
+// ConcatLowerLower_NEON_I32x4 concatenates lower halves.
+func ConcatLowerLower_NEON_I32x4(a, b asm.Int32x4) asm.Int32x4 {
+	var dataA, dataB [4]int32
+	a.Store(&dataA)
+	b.Store(&dataB)
+	result := [4]int32{dataA[0], dataA[1], dataB[0], dataB[1]}
+	return asm.LoadInt32x4(&result)
+}
+
+// ConcatLowerLower_NEON_I64x2 concatenates lower halves.
+func ConcatLowerLower_NEON_I64x2(a, b asm.Int64x2) asm.Int64x2 {
+	return InterleaveLower_NEON_I64x2(a, b)
+}
+
+// ConcatUpperUpper_NEON_F32x4 concatenates upper halves.
+// [a0,a1,a2,a3], [b0,b1,b2,b3] -> [a2,a3,b2,b3]
+func ConcatUpperUpper_NEON_F32x4(a, b asm.Float32x4) asm.Float32x4 {
+	var dataA, dataB [4]float32
+	a.Store(&dataA)
+	b.Store(&dataB)
+	result := [4]float32{dataA[2], dataA[3], dataB[2], dataB[3]}
+	return asm.LoadFloat32x4(&result)
+}
+
+// ConcatLowerUpper_NEON_F32x4 concatenates lower half of a with upper half of b.
+// [a0,a1,a2,a3], [b0,b1,b2,b3] -> [a0,a1,b2,b3]
+func ConcatLowerUpper_NEON_F32x4(a, b asm.Float32x4) asm.Float32x4 {
+	var dataA, dataB [4]float32
+	a.Store(&dataA)
+	b.Store(&dataB)
+	result := [4]float32{dataA[0], dataA[1], dataB[2], dataB[3]}
+	return asm.LoadFloat32x4(&result)
+}
+
+// ConcatUpperLower_NEON_F32x4 concatenates upper half of a with lower half of b.
+// [a0,a1,a2,a3], [b0,b1,b2,b3] -> [a2,a3,b0,b1]
+func ConcatUpperLower_NEON_F32x4(a, b asm.Float32x4) asm.Float32x4 {
+	var dataA, dataB [4]float32
+	a.Store(&dataA)
+	b.Store(&dataB)
+	result := [4]float32{dataA[2], dataA[3], dataB[0], dataB[1]}
+	return asm.LoadFloat32x4(&result)
+}
+
+// OddEven_NEON_F32x4 combines odd lanes from a with even lanes from b.
+// [a0,a1,a2,a3], [b0,b1,b2,b3] -> [b0,a1,b2,a3]
+func OddEven_NEON_F32x4(a, b asm.Float32x4) asm.Float32x4 {
+	var dataA, dataB [4]float32
+	a.Store(&dataA)
+	b.Store(&dataB)
+	result := [4]float32{dataB[0], dataA[1], dataB[2], dataA[3]}
+	return asm.LoadFloat32x4(&result)
+}
+
+// OddEven_NEON_F64x2 combines the odd lane from a with the even lane from b.
+// [a0,a1], [b0,b1] -> [b0,a1]
+func OddEven_NEON_F64x2(a, b asm.Float64x2) asm.Float64x2 {
+	var dataA, dataB [2]float64
+	a.Store(&dataA)
+	b.Store(&dataB)
+	result := [2]float64{dataB[0], dataA[1]}
+	return asm.LoadFloat64x2(&result)
+}
+
+// DupEven_NEON_F32x4 duplicates even lanes.
+// [a0,a1,a2,a3] -> [a0,a0,a2,a2]
+func DupEven_NEON_F32x4(v asm.Float32x4) asm.Float32x4 {
+	var data [4]float32
+	v.Store(&data)
+	result := [4]float32{data[0], data[0], data[2], data[2]}
+	return asm.LoadFloat32x4(&result)
+}
+
+// DupEven_NEON_F64x2 duplicates the even lane.
+// [a0,a1] -> [a0,a0]
+func DupEven_NEON_F64x2(v asm.Float64x2) asm.Float64x2 {
+	var data [2]float64
+	v.Store(&data)
+	result := [2]float64{data[0], data[0]}
+	return asm.LoadFloat64x2(&result)
+}
+
+// DupOdd_NEON_F32x4 duplicates odd lanes.
+// [a0,a1,a2,a3] -> [a1,a1,a3,a3]
+func DupOdd_NEON_F32x4(v asm.Float32x4) asm.Float32x4 {
+	var data [4]float32
+	v.Store(&data)
+	result := [4]float32{data[1], data[1], data[3], data[3]}
+	return asm.LoadFloat32x4(&result)
+}
+
+// DupOdd_NEON_F64x2 duplicates the odd lane.
+// [a0,a1] -> [a1,a1]
+func DupOdd_NEON_F64x2(v asm.Float64x2) asm.Float64x2 {
+	var data [2]float64
+	v.Store(&data)
+	result := [2]float64{data[1], data[1]}
+	return asm.LoadFloat64x2(&result)
+}
+
+// SwapAdjacentBlocks_NEON_F32x4 returns v unchanged: a NEON vector is a
+// single 128-bit block.
+func SwapAdjacentBlocks_NEON_F32x4(v asm.Float32x4) asm.Float32x4 { return v }
+
+// SwapAdjacentBlocks_NEON_F64x2 returns v unchanged.
+func SwapAdjacentBlocks_NEON_F64x2(v asm.Float64x2) asm.Float64x2 { return v }
+
+// SwapAdjacentBlocks_NEON_I32x4 returns v unchanged.
+func SwapAdjacentBlocks_NEON_I32x4(v asm.Int32x4) asm.Int32x4 { return v }
+
+// SwapAdjacentBlocks_NEON_I64x2 returns v unchanged.
+func SwapAdjacentBlocks_NEON_I64x2(v asm.Int64x2) asm.Int64x2 { return v }
+
+// Lane rotation and variable permutation
+
+// RotateLanes_NEON_F32x4 rotates lanes down by n, wrapping around (EXT).
+// [0,1,...] with n=1 -> [1,...,0]
+func RotateLanes_NEON_F32x4(v asm.Float32x4, n int) asm.Float32x4 {
+	n %= 4
+	if n < 0 {
+		n += 4
+	}
+	if n == 0 {
+		return v
+	}
+	var data, result [4]float32
+	v.Store(&data)
+	copy(result[:], data[n:])
+	copy(result[4-n:], data[:n])
+	return asm.LoadFloat32x4(&result)
+}
+
+// RotateLanes_NEON_F64x2 rotates lanes down by n, wrapping around (EXT).
+// [0,1,...] with n=1 -> [1,...,0]
+func RotateLanes_NEON_F64x2(v asm.Float64x2, n int) asm.Float64x2 {
+	n %= 2
+	if n < 0 {
+		n += 2
+	}
+	if n == 0 {
+		return v
+	}
+	var data, result [2]float64
+	v.Store(&data)
+	copy(result[:], data[n:])
+	copy(result[2-n:], data[:n])
+	return asm.LoadFloat64x2(&result)
+}
+
+// RotateLanes_NEON_I32x4 rotates lanes down by n, wrapping around (EXT).
+// [0,1,...] with n=1 -> [1,...,0]
+func RotateLanes_NEON_I32x4(v asm.Int32x4, n int) asm.Int32x4 {
+	n %= 4
+	if n < 0 {
+		n += 4
+	}
+	if n == 0 {
+		return v
+	}
+	var data, result [4]int32
+	v.Store(&data)
+	copy(result[:], data[n:])
+	copy(result[4-n:], data[:n])
+	return asm.LoadInt32x4(&result)
+}
+
+// RotateLanes_NEON_I64x2 rotates lanes down by n, wrapping around (EXT).
+// [0,1,...] with n=1 -> [1,...,0]
+func RotateLanes_NEON_I64x2(v asm.Int64x2, n int) asm.Int64x2 {
+	n %= 2
+	if n < 0 {
+		n += 2
+	}
+	if n == 0 {
+		return v
+	}
+	var data, result [2]int64
+	v.Store(&data)
+	copy(result[:], data[n:])
+	copy(result[2-n:], data[:n])
+	return asm.LoadInt64x2(&result)
+}
+
+// TableLookupLanes_NEON_F32x4 selects lanes of tbl by index (TBL).
+// Out-of-range indices produce zero lanes.
+func TableLookupLanes_NEON_F32x4(tbl asm.Float32x4, idx asm.Int32x4) asm.Float32x4 {
+	var data, result [4]float32
+	var indices [4]int32
+	tbl.Store(&data)
+	idx.Store(&indices)
+	for i, j := range indices {
+		if j >= 0 && j < 4 {
+			result[i] = data[j]
+		}
+	}
+	return asm.LoadFloat32x4(&result)
+}
+
+// TableLookupLanes_NEON_F64x2 selects lanes of tbl by index (TBL).
+// Out-of-range indices produce zero lanes.
+func TableLookupLanes_NEON_F64x2(tbl asm.Float64x2, idx asm.Int64x2) asm.Float64x2 {
+	var data, result [2]float64
+	var indices [2]int64
+	tbl.Store(&data)
+	idx.Store(&indices)
+	for i, j := range indices {
+		if j >= 0 && j < 2 {
+			result[i] = data[j]
+		}
+	}
+	return asm.LoadFloat64x2(&result)
+}
+
+// TableLookupLanes_NEON_I32x4 selects lanes of tbl by index (TBL).
+// Out-of-range indices produce zero lanes.
+func TableLookupLanes_NEON_I32x4(tbl asm.Int32x4, idx asm.Int32x4) asm.Int32x4 {
+	var data, result [4]int32
+	var indices [4]int32
+	tbl.Store(&data)
+	idx.Store(&indices)
+	for i, j := range indices {
+		if j >= 0 && j < 4 {
+			result[i] = data[j]
+		}
+	}
+	return asm.LoadInt32x4(&result)
+}
+
+// TableLookupLanes_NEON_I64x2 selects lanes of tbl by index (TBL).
+// Out-of-range indices produce zero lanes.
+func TableLookupLanes_NEON_I64x2(tbl asm.Int64x2, idx asm.Int64x2) asm.Int64x2 {
+	var data, result [2]int64
+	var indices [2]int64
+	tbl.Store(&data)
+	idx.Store(&indices)
+	for i, j := range indices {
+		if j >= 0 && j < 2 {
+			result[i] = data[j]
+		}
+	}
+	return asm.LoadInt64x2(&result)
+}
+
+// Zip and de-interleave operations
+
+// ZipLower_NEON_F32x4 interleaves the lower halves of a and b.
+// This is the same as InterleaveLower but named for consistency with C++ Highway.
+func ZipLower_NEON_F32x4(a, b asm.Float32x4) asm.Float32x4 {
+	return InterleaveLower_NEON_F32x4(a, b)
+}
+
+// ZipUpper_NEON_F32x4 interleaves the upper halves of a and b.
+// This is the same as InterleaveUpper but named for consistency with C++ Highway.
+func ZipUpper_NEON_F32x4(a, b asm.Float32x4) asm.Float32x4 {
+	return InterleaveUpper_NEON_F32x4(a, b)
+}
+
+// ZipLower_NEON_F64x2 interleaves the lower halves of a and b.
+// This is the same as InterleaveLower but named for consistency with C++ Highway.
+func ZipLower_NEON_F64x2(a, b asm.Float64x2) asm.Float64x2 {
+	return InterleaveLower_NEON_F64x2(a, b)
+}
+
+// ZipUpper_NEON_F64x2 interleaves the upper halves of a and b.
+// This is the same as InterleaveUpper but named for consistency with C++ Highway.
+func ZipUpper_NEON_F64x2(a, b asm.Float64x2) asm.Float64x2 {
+	return InterleaveUpper_NEON_F64x2(a, b)
+}
+
+// ZipLower_NEON_I32x4 interleaves the lower halves of a and b.
+// This is the same as InterleaveLower but named for consistency with C++ Highway.
+func ZipLower_NEON_I32x4(a, b asm.Int32x4) asm.Int32x4 {
+	return InterleaveLower_NEON_I32x4(a, b)
+}
+
+// ZipUpper_NEON_I32x4 interleaves the upper halves of a and b.
+// This is the same as InterleaveUpper but named for consistency with C++ Highway.
+func ZipUpper_NEON_I32x4(a, b asm.Int32x4) asm.Int32x4 {
+	return InterleaveUpper_NEON_I32x4(a, b)
+}
+
+// ZipLower_NEON_I64x2 interleaves the lower halves of a and b.
+// This is the same as InterleaveLower but named for consistency with C++ Highway.
+func ZipLower_NEON_I64x2(a, b asm.Int64x2) asm.Int64x2 {
+	return InterleaveLower_NEON_I64x2(a, b)
+}
+
+// ZipUpper_NEON_I64x2 interleaves the upper halves of a and b.
+// This is the same as InterleaveUpper but named for consistency with C++ Highway.
+func ZipUpper_NEON_I64x2(a, b asm.Int64x2) asm.Int64x2 {
+	return InterleaveUpper_NEON_I64x2(a, b)
+}
+
+// ConcatEven_NEON_F32x4 concatenates the even lanes of a and b (UZP1).
+// [a0,a1,a2,a3,...], [b0,b1,b2,b3,...] -> [a0,a2,...,b0,b2,...]
+func ConcatEven_NEON_F32x4(a, b asm.Float32x4) asm.Float32x4 {
+	var dataA, dataB, result [4]float32
+	a.Store(&dataA)
+	b.Store(&dataB)
+	for i := range 2 {
+		result[i] = dataA[2*i]
+		result[2+i] = dataB[2*i]
+	}
+	return asm.LoadFloat32x4(&result)
+}
+
+// ConcatOdd_NEON_F32x4 concatenates the odd lanes of a and b (UZP2).
+// [a0,a1,a2,a3,...], [b0,b1,b2,b3,...] -> [a1,a3,...,b1,b3,...]
+func ConcatOdd_NEON_F32x4(a, b asm.Float32x4) asm.Float32x4 {
+	var dataA, dataB, result [4]float32
+	a.Store(&dataA)
+	b.Store(&dataB)
+	for i := range 2 {
+		result[i] = dataA[2*i+1]
+		result[2+i] = dataB[2*i+1]
+	}
+	return asm.LoadFloat32x4(&result)
+}
+
+// ConcatEven_NEON_F64x2 concatenates the even lanes of a and b (UZP1).
+// [a0,a1,a2,a3,...], [b0,b1,b2,b3,...] -> [a0,a2,...,b0,b2,...]
+func ConcatEven_NEON_F64x2(a, b asm.Float64x2) asm.Float64x2 {
+	var dataA, dataB, result [2]float64
+	a.Store(&dataA)
+	b.Store(&dataB)
+	for i := range 1 {
+		result[i] = dataA[2*i]
+		result[1+i] = dataB[2*i]
+	}
+	return asm.LoadFloat64x2(&result)
+}
+
+// ConcatOdd_NEON_F64x2 concatenates the odd lanes of a and b (UZP2).
+// [a0,a1,a2,a3,...], [b0,b1,b2,b3,...] -> [a1,a3,...,b1,b3,...]
+func ConcatOdd_NEON_F64x2(a, b asm.Float64x2) asm.Float64x2 {
+	var dataA, dataB, result [2]float64
+	a.Store(&dataA)
+	b.Store(&dataB)
+	for i := range 1 {
+		result[i] = dataA[2*i+1]
+		result[1+i] = dataB[2*i+1]
+	}
+	return asm.LoadFloat64x2(&result)
+}
+
+// ConcatEven_NEON_I32x4 concatenates the even lanes of a and b (UZP1).
+// [a0,a1,a2,a3,...], [b0,b1,b2,b3,...] -> [a0,a2,...,b0,b2,...]
+func ConcatEven_NEON_I32x4(a, b asm.Int32x4) asm.Int32x4 {
+	var dataA, dataB, result [4]int32
+	a.Store(&dataA)
+	b.Store(&dataB)
+	for i := range 2 {
+		result[i] = dataA[2*i]
+		result[2+i] = dataB[2*i]
+	}
+	return asm.LoadInt32x4(&result)
+}
+
+// ConcatOdd_NEON_I32x4 concatenates the odd lanes of a and b (UZP2).
+// [a0,a1,a2,a3,...], [b0,b1,b2,b3,...] -> [a1,a3,...,b1,b3,...]
+func ConcatOdd_NEON_I32x4(a, b asm.Int32x4) asm.Int32x4 {
+	var dataA, dataB, result [4]int32
+	a.Store(&dataA)
+	b.Store(&dataB)
+	for i := range 2 {
+		result[i] = dataA[2*i+1]
+		result[2+i] = dataB[2*i+1]
+	}
+	return asm.LoadInt32x4(&result)
+}
+
+// ConcatEven_NEON_I64x2 concatenates the even lanes of a and b (UZP1).
+// [a0,a1,a2,a3,...], [b0,b1,b2,b3,...] -> [a0,a2,...,b0,b2,...]
+func ConcatEven_NEON_I64x2(a, b asm.Int64x2) asm.Int64x2 {
+	var dataA, dataB, result [2]int64
+	a.Store(&dataA)
+	b.Store(&dataB)
+	for i := range 1 {
+		result[i] = dataA[2*i]
+		result[1+i] = dataB[2*i]
+	}
+	return asm.LoadInt64x2(&result)
+}
+
+// ConcatOdd_NEON_I64x2 concatenates the odd lanes of a and b (UZP2).
+// [a0,a1,a2,a3,...], [b0,b1,b2,b3,...] -> [a1,a3,...,b1,b3,...]
+func ConcatOdd_NEON_I64x2(a, b asm.Int64x2) asm.Int64x2 {
+	var dataA, dataB, result [2]int64
+	a.Store(&dataA)
+	b.Store(&dataB)
+	for i := range 1 {
+		result[i] = dataA[2*i+1]
+		result[1+i] = dataB[2*i+1]
+	}
+	return asm.LoadInt64x2(&result)
+}
@@ -593,6 +593,30 @@ func TestTableLookupLanes(t *testing.T) {
 	}
 }
 
+func TestRotateLanes(t *testing.T) {
+	v := Vec[float32]{data: []float32{0, 1, 2, 3, 4, 5, 6, 7}}
+	tests := []struct {
+		name   string
+		n      int
+		expect []float32
+	}{
+		{"zero", 0, []float32{0, 1, 2, 3, 4, 5, 6, 7}},
+		{"by 2", 2, []float32{2, 3, 4, 5, 6, 7, 0, 1}},
+		{"full turn", 8, []float32{0, 1, 2, 3, 4, 5, 6, 7}},
+		{"wraps", 11, []float32{3, 4, 5, 6, 7, 0, 1, 2}},
+		{"negative", -1, []float32{7, 0, 1, 2, 3, 4, 5, 6}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := RotateLanes(v, tt.n)
+			if !reflect.DeepEqual(result.data, tt.expect) {
+				t.Errorf("RotateLanes(%d) = %v, want %v", tt.n, result.data, tt.expect)
+			}
+		})
+	}
+}
+
 func TestTableLookupLanesOutOfBounds(t *testing.T) {
 	tbl := Vec[float32]{data: []float32{10, 20, 30, 40}}
 	idx := Vec[int32]{data: []int32{0, 10, 2, -1}} // 10 and -1 are out of bounds
@@ -640,6 +664,38 @@ func TestZipUpper(t *testing.T) {
 	}
 }
 
+func TestConcatEvenOdd(t *testing.T) {
+	a := Vec[float32]{data: []float32{0, 1, 2, 3, 4, 5, 6, 7}}
+	b := Vec[float32]{data: []float32{10, 11, 12, 13, 14, 15, 16, 17}}
+
+	even := ConcatEven(a, b)
+	expectEven := []float32{0, 2, 4, 6, 10, 12, 14, 16}
+	if !reflect.DeepEqual(even.data, expectEven) {
+		t.Errorf("ConcatEven() = %v, want %v", even.data, expectEven)
+	}
+
+	odd := ConcatOdd(a, b)
+	expectOdd := []float32{1, 3, 5, 7, 11, 13, 15, 17}
+	if !reflect.DeepEqual(odd.data, expectOdd) {
+		t.Errorf("ConcatOdd() = %v, want %v", odd.data, expectOdd)
+	}
+}
+
+func TestZipConcatRoundTrip(t *testing.T) {
+	// AoS -> SoA -> AoS: ConcatEven/ConcatOdd undo ZipLower/ZipUpper.
+	x := Vec[int32]{data: []int32{0, 1, 2, 3}}
+	y := Vec[int32]{data: []int32{10, 11, 12, 13}}
+
+	lo := ZipLower(x, y)
+	hi := ZipUpper(x, y)
+	if got := ConcatEven(lo, hi); !reflect.DeepEqual(got.data, x.data) {
+		t.Errorf("ConcatEven(ZipLower, ZipUpper) = %v, want %v", got.data, x.data)
+	}
+	if got := ConcatOdd(lo, hi); !reflect.DeepEqual(got.data, y.data) {
+		t.Errorf("ConcatOdd(ZipLower, ZipUpper) = %v, want %v", got.data, y.data)
+	}
+}
+
 func TestShuffle0123(t *testing.T) {
 	v := Vec[float32]{data: []float32{0, 1, 2, 3, 4, 5, 6, 7}}
 
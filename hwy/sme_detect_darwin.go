@@ -36,9 +36,10 @@ func detectSME() bool {
 
 // HasSME returns true if the CPU supports ARM SME instructions and
 // SME has not been disabled via environment variables.
-// Returns false when HWY_NO_SIMD or HWY_NO_SME is set.
+// Returns false when HWY_NO_SIMD or HWY_NO_SME is set, or when HWY_TARGET
+// selects a lower target.
 func HasSME() bool {
-	if NoSimdEnv() || os.Getenv("HWY_NO_SME") != "" {
+	if NoSimdEnv() || os.Getenv("HWY_NO_SME") != "" || !TargetEnabled(DispatchSME) {
 		return false
 	}
 	return hasSME
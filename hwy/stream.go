@@ -0,0 +1,67 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hwy
+
+import (
+	"unsafe"
+
+	"github.com/ajroetker/go-highway/hwy/asm"
+)
+
+// This file provides cache-control memory operations: streaming
+// (non-temporal) stores, software prefetch, and the fence that orders
+// streaming stores. They are hints for large, write-once outputs (image
+// rows, matmul C tiles) that would otherwise evict the working set from
+// cache; results are identical to Store and plain loads.
+
+// StoreStream stores v to dst using non-temporal stores where the target
+// has them (MOVNTI on x86), bypassing the cache for the destination.
+// On ARM64 and other platforms it is an ordinary store.
+//
+// Streaming stores are weakly ordered: call FlushStream after the last
+// StoreStream and before the data is consumed elsewhere.
+func StoreStream[T Lanes](v Vec[T], dst []T) {
+	n := min(len(dst), len(v.data))
+	if n == 0 {
+		return
+	}
+	asm.StreamCopy(unsafe.Pointer(&dst[0]), unsafe.Pointer(&v.data[0]), n*int(unsafe.Sizeof(dst[0])))
+}
+
+// Prefetch hints that src[distance] will be read soon, so its cache line
+// should be brought into L1 (PREFETCHT0 on x86, PRFM PLDL1KEEP on ARM64).
+// distance is in elements, typically a few vectors ahead of the current
+// load. Out-of-range distances are ignored.
+//
+// Example:
+//
+//	for i := 0; i+lanes <= len(src); i += lanes {
+//	    hwy.Prefetch(src[i:], 8*lanes)
+//	    v := hwy.Load(src[i:])
+//	    ...
+//	}
+func Prefetch[T Lanes](src []T, distance int) {
+	if distance < 0 || distance >= len(src) {
+		return
+	}
+	asm.Prefetch(unsafe.Pointer(&src[distance]))
+}
+
+// FlushStream orders all preceding StoreStream calls before any later
+// stores (SFENCE on x86). It is a no-op on targets whose StoreStream uses
+// ordinary stores.
+func FlushStream() {
+	asm.StreamFence()
+}
@@ -0,0 +1,73 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build amd64 && goexperiment.simd
+
+package hwy
+
+import (
+	"simd/archsimd"
+	"unsafe"
+
+	"github.com/ajroetker/go-highway/hwy/asm"
+)
+
+// This file provides AVX2 implementations of streaming (non-temporal) stores.
+//
+// archsimd has no non-temporal store, so the vector is spilled to the stack
+// and written with a single VMOVNTPS/VMOVNTPD/VMOVNTDQ. Those fault on an
+// unaligned destination; dst that is not vector-aligned is written with
+// MOVNTI instead. Call FlushStream after the last streaming store.
+
+// streamStore writes the size bytes at src to dst with store, a single
+// non-temporal vector store, or with MOVNTI if dst is not size-aligned.
+func streamStore(dst, src unsafe.Pointer, size uintptr, store func(dst, src unsafe.Pointer)) {
+	if uintptr(dst)%size != 0 {
+		asm.StreamCopy(dst, src, int(size))
+		return
+	}
+	store(dst, src)
+}
+
+// StoreStream_AVX2_F32x8 stores v to dst[:8] with non-temporal stores.
+func StoreStream_AVX2_F32x8(v archsimd.Float32x8, dst []float32) {
+	_ = dst[7]
+	var data [8]float32
+	v.Store(&data)
+	streamStore(unsafe.Pointer(&dst[0]), unsafe.Pointer(&data[0]), unsafe.Sizeof(data), asm.StreamStorePS256)
+}
+
+// StoreStream_AVX2_F64x4 stores v to dst[:4] with non-temporal stores.
+func StoreStream_AVX2_F64x4(v archsimd.Float64x4, dst []float64) {
+	_ = dst[3]
+	var data [4]float64
+	v.Store(&data)
+	streamStore(unsafe.Pointer(&dst[0]), unsafe.Pointer(&data[0]), unsafe.Sizeof(data), asm.StreamStorePD256)
+}
+
+// StoreStream_AVX2_I32x8 stores v to dst[:8] with non-temporal stores.
+func StoreStream_AVX2_I32x8(v archsimd.Int32x8, dst []int32) {
+	_ = dst[7]
+	var data [8]int32
+	v.Store(&data)
+	streamStore(unsafe.Pointer(&dst[0]), unsafe.Pointer(&data[0]), unsafe.Sizeof(data), asm.StreamStoreDQ256)
+}
+
+// StoreStream_AVX2_I64x4 stores v to dst[:4] with non-temporal stores.
+func StoreStream_AVX2_I64x4(v archsimd.Int64x4, dst []int64) {
+	_ = dst[3]
+	var data [4]int64
+	v.Store(&data)
+	streamStore(unsafe.Pointer(&dst[0]), unsafe.Pointer(&data[0]), unsafe.Sizeof(data), asm.StreamStoreDQ256)
+}
@@ -0,0 +1,59 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build amd64 && goexperiment.simd
+
+package hwy
+
+import (
+	"simd/archsimd"
+	"unsafe"
+
+	"github.com/ajroetker/go-highway/hwy/asm"
+)
+
+// This file provides AVX-512 implementations of streaming (non-temporal)
+// stores. See stream_avx2.go for the approach.
+
+// StoreStream_AVX512_F32x16 stores v to dst[:16] with non-temporal stores.
+func StoreStream_AVX512_F32x16(v archsimd.Float32x16, dst []float32) {
+	_ = dst[15]
+	var data [16]float32
+	v.Store(&data)
+	streamStore(unsafe.Pointer(&dst[0]), unsafe.Pointer(&data[0]), unsafe.Sizeof(data), asm.StreamStorePS512)
+}
+
+// StoreStream_AVX512_F64x8 stores v to dst[:8] with non-temporal stores.
+func StoreStream_AVX512_F64x8(v archsimd.Float64x8, dst []float64) {
+	_ = dst[7]
+	var data [8]float64
+	v.Store(&data)
+	streamStore(unsafe.Pointer(&dst[0]), unsafe.Pointer(&data[0]), unsafe.Sizeof(data), asm.StreamStorePD512)
+}
+
+// StoreStream_AVX512_I32x16 stores v to dst[:16] with non-temporal stores.
+func StoreStream_AVX512_I32x16(v archsimd.Int32x16, dst []int32) {
+	_ = dst[15]
+	var data [16]int32
+	v.Store(&data)
+	streamStore(unsafe.Pointer(&dst[0]), unsafe.Pointer(&data[0]), unsafe.Sizeof(data), asm.StreamStoreDQ512)
+}
+
+// StoreStream_AVX512_I64x8 stores v to dst[:8] with non-temporal stores.
+func StoreStream_AVX512_I64x8(v archsimd.Int64x8, dst []int64) {
+	_ = dst[7]
+	var data [8]int64
+	v.Store(&data)
+	streamStore(unsafe.Pointer(&dst[0]), unsafe.Pointer(&data[0]), unsafe.Sizeof(data), asm.StreamStoreDQ512)
+}
@@ -0,0 +1,45 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build arm64
+
+package hwy
+
+import "github.com/ajroetker/go-highway/hwy/asm"
+
+// This file provides NEON implementations of streaming stores.
+//
+// NEON's STNP is only a hint and is no faster than an ordinary store for
+// single vectors, so StoreStream is a plain store on ARM64 (as in C++
+// Highway).
+
+// StoreStream_NEON_F32x4 stores v to dst[:4].
+func StoreStream_NEON_F32x4(v asm.Float32x4, dst []float32) {
+	v.StoreSlice(dst)
+}
+
+// StoreStream_NEON_F64x2 stores v to dst[:2].
+func StoreStream_NEON_F64x2(v asm.Float64x2, dst []float64) {
+	v.StoreSlice(dst)
+}
+
+// StoreStream_NEON_I32x4 stores v to dst[:4].
+func StoreStream_NEON_I32x4(v asm.Int32x4, dst []int32) {
+	v.StoreSlice(dst)
+}
+
+// StoreStream_NEON_I64x2 stores v to dst[:2].
+func StoreStream_NEON_I64x2(v asm.Int64x2, dst []int64) {
+	v.StoreSlice(dst)
+}
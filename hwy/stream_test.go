@@ -0,0 +1,52 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hwy
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestStoreStream(t *testing.T) {
+	v := Vec[float32]{data: []float32{1, 2, 3, 4, 5, 6, 7, 8, 9}}
+	dst := make([]float32, 9)
+	StoreStream(v, dst)
+	FlushStream()
+	if !reflect.DeepEqual(dst, v.data) {
+		t.Errorf("StoreStream() = %v, want %v", dst, v.data)
+	}
+
+	// A short destination receives only the lanes that fit.
+	short := make([]int16, 3)
+	StoreStream(Vec[int16]{data: []int16{-1, 2, -3, 4, -5}}, short)
+	FlushStream()
+	if want := []int16{-1, 2, -3}; !reflect.DeepEqual(short, want) {
+		t.Errorf("StoreStream() short dst = %v, want %v", short, want)
+	}
+
+	StoreStream(v, nil)
+}
+
+func TestPrefetch(t *testing.T) {
+	src := []float64{1, 2, 3, 4}
+	// Prefetch is a hint and must tolerate any distance.
+	for _, d := range []int{-1, 0, 3, 4, 1 << 20} {
+		Prefetch(src, d)
+	}
+	Prefetch[float64](nil, 0)
+	if want := []float64{1, 2, 3, 4}; !reflect.DeepEqual(src, want) {
+		t.Errorf("Prefetch modified src: %v", src)
+	}
+}
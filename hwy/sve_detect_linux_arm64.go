@@ -19,6 +19,7 @@ package hwy
 import (
 	"os"
 
+	"github.com/ajroetker/go-highway/hwy/asm"
 	"golang.org/x/sys/cpu"
 )
 
@@ -27,12 +28,41 @@ import (
 // and other ARMv8.2-A+ implementations with SVE support.
 var hasSVE = cpu.ARM64.HasSVE
 
+// hasSVE2 indicates if ARM SVE2 is available (Neoverse N2/V2, Graviton4).
+var hasSVE2 = hasSVE && cpu.ARM64.HasSVE2
+
+// sveVectorBytes is the SVE vector length in bytes, or 0 without SVE.
+// Implementations pick a power of two between 16 and 256 bytes, e.g.
+// 32 on Neoverse V1 (Graviton3) and 16 on Neoverse V2 (Graviton4).
+var sveVectorBytes = detectSVEVectorBytes()
+
+func detectSVEVectorBytes() int {
+	if !hasSVE {
+		return 0
+	}
+	return asm.SVEVectorBytes()
+}
+
 // HasSVE returns true if the CPU supports ARM SVE instructions and
 // SVE has not been disabled via environment variables.
-// Returns false when HWY_NO_SIMD or HWY_NO_SVE is set.
+// Returns false when HWY_NO_SIMD or HWY_NO_SVE is set, or when HWY_TARGET
+// selects a lower target.
 func HasSVE() bool {
-	if NoSimdEnv() || os.Getenv("HWY_NO_SVE") != "" {
+	if NoSimdEnv() || os.Getenv("HWY_NO_SVE") != "" || !TargetEnabled(DispatchSVE) {
 		return false
 	}
 	return hasSVE
 }
+
+// HasSVE2 returns true if the CPU supports ARM SVE2 instructions and SVE
+// has not been disabled (see HasSVE).
+func HasSVE2() bool {
+	return hasSVE2 && HasSVE()
+}
+
+// SVEVectorBytes returns the SVE vector length in bytes, or 0 if SVE is
+// not available. Unlike HasSVE it ignores the environment overrides, since
+// it describes the hardware.
+func SVEVectorBytes() int {
+	return sveVectorBytes
+}
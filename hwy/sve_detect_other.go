@@ -20,8 +20,23 @@ package hwy
 // SVE is currently only detected on Linux ARM64 via golang.org/x/sys/cpu.
 var hasSVE = false
 
+// sveVectorBytes is 0 on platforms without SVE detection.
+var sveVectorBytes = 0
+
 // HasSVE returns true if the CPU supports ARM SVE instructions.
 // On non-linux platforms, this always returns false.
 func HasSVE() bool {
 	return false
 }
+
+// HasSVE2 returns true if the CPU supports ARM SVE2 instructions.
+// On non-linux platforms, this always returns false.
+func HasSVE2() bool {
+	return false
+}
+
+// SVEVectorBytes returns the SVE vector length in bytes.
+// On non-linux platforms, this always returns 0.
+func SVEVectorBytes() int {
+	return 0
+}
@@ -14,6 +14,12 @@
 
 package hwy
 
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
 // TailMask creates a mask with the first 'count' lanes active.
 // This is useful for handling the tail (remainder) of an array
 // when the size is not a multiple of the vector width.
@@ -44,6 +50,43 @@ func TailMask[T Lanes](count int) Mask[T] {
 	return Mask[T]{bits: bits}
 }
 
+// WhileLessThan creates a mask where lane k is active if i+k < n.
+// This mirrors SVE's WHILELT predicate: inside a loop over [0, n) it is
+// all-true for full vectors and covers exactly the remainder on the last
+// iteration, so the same loop body handles the tail.
+//
+// Example:
+//
+//	for i := 0; i < len(data); i += hwy.MaxLanes[float32]() {
+//	    mask := hwy.WhileLessThan[float32](i, len(data))
+//	    v := hwy.MaskLoad(mask, data[i:])
+//	    hwy.MaskStore(mask, hwy.Add(v, v), output[i:])
+//	}
+func WhileLessThan[T Lanes](i, n int) Mask[T] {
+	return TailMask[T](n - i)
+}
+
+// ProcessPredicated calls fn(offset, mask) for each vector-sized chunk of
+// [0, size), where mask is WhileLessThan(offset, size). Unlike
+// ProcessWithTail, a single body handles both full vectors and the tail,
+// which matches how predicated SVE loops are written.
+//
+// Example:
+//
+//	hwy.ProcessPredicated(len(data), func(offset int, mask hwy.Mask[float32]) {
+//	    v := hwy.MaskLoad(mask, data[offset:])
+//	    hwy.MaskStore(mask, hwy.Add(v, v), output[offset:])
+//	})
+func ProcessPredicated[T Lanes](size int, fn func(offset int, mask Mask[T])) {
+	maxLanes := MaxLanes[T]()
+	if maxLanes == 0 {
+		return
+	}
+	for offset := 0; offset < size; offset += maxLanes {
+		fn(offset, WhileLessThan[T](offset, size))
+	}
+}
+
 // ProcessWithTail is a helper for processing arrays with SIMD that handles
 // both full vectors and the tail (remainder) automatically.
 //
@@ -84,6 +127,103 @@ func ProcessWithTail[T Lanes](size int, fullFn func(offset int), tailFn func(off
 	}
 }
 
+// ProcessUnrolled4 is like ProcessWithTail but unrolls the main loop 4x to
+// match Load4: it calls
+//   - fn4(offset) for each block of 4 full vectors (4*MaxLanes elements)
+//   - fullFn(offset) for each remaining full vector
+//   - tailFn(offset, count) once for the tail if size is not a multiple of vector width
+//
+// Example:
+//
+//	hwy.ProcessUnrolled4[float32](len(data),
+//	    func(offset int) {
+//	        v0, v1, v2, v3 := hwy.Load4(data[offset:])
+//	        lanes := hwy.MaxLanes[float32]()
+//	        hwy.Store(hwy.Add(v0, v0), output[offset:])
+//	        hwy.Store(hwy.Add(v1, v1), output[offset+lanes:])
+//	        hwy.Store(hwy.Add(v2, v2), output[offset+2*lanes:])
+//	        hwy.Store(hwy.Add(v3, v3), output[offset+3*lanes:])
+//	    },
+//	    func(offset int) {
+//	        v := hwy.Load(data[offset:])
+//	        hwy.Store(hwy.Add(v, v), output[offset:])
+//	    },
+//	    func(offset, count int) {
+//	        mask := hwy.TailMask[float32](count)
+//	        v := hwy.MaskLoad(mask, data[offset:])
+//	        hwy.MaskStore(mask, hwy.Add(v, v), output[offset:])
+//	    },
+//	)
+func ProcessUnrolled4[T Lanes](size int, fn4 func(offset int), fullFn func(offset int), tailFn func(offset, count int)) {
+	maxLanes := MaxLanes[T]()
+	if maxLanes == 0 || size <= 0 {
+		return
+	}
+
+	offset := 0
+	for ; offset+4*maxLanes <= size; offset += 4 * maxLanes {
+		fn4(offset)
+	}
+	for ; offset+maxLanes <= size; offset += maxLanes {
+		fullFn(offset)
+	}
+	if offset < size {
+		tailFn(offset, size-offset)
+	}
+}
+
+// ProcessParallel splits [0, n) into contiguous chunks and calls fn(start, end)
+// for each chunk on up to GOMAXPROCS goroutines, blocking until all complete.
+//
+// Chunks hold at least grain elements, rounded up to a multiple of
+// 4*MaxLanes[T]() so every chunk except the last can be processed with
+// ProcessUnrolled4 without a tail. If grain <= 0, n is split evenly across
+// GOMAXPROCS. When there is only one chunk, fn runs on the calling goroutine.
+//
+// ProcessParallel starts new goroutines on every call, so it is best suited
+// to calls large enough to amortize that cost.
+//
+// Example:
+//
+//	hwy.ProcessParallel[float32](len(data), 64*1024, func(start, end int) {
+//	    scaleKernel(data[start:end], output[start:end])
+//	})
+func ProcessParallel[T Lanes](n, grain int, fn func(start, end int)) {
+	if n <= 0 {
+		return
+	}
+	procs := runtime.GOMAXPROCS(0)
+	if grain <= 0 {
+		grain = (n + procs - 1) / procs
+	}
+	if block := 4 * MaxLanes[T](); block > 0 {
+		grain = (grain + block - 1) / block * block
+	}
+
+	numChunks := (n + grain - 1) / grain
+	workers := min(procs, numChunks)
+	if workers <= 1 {
+		fn(0, n)
+		return
+	}
+
+	var nextChunk atomic.Int64
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Go(func() {
+			for {
+				chunk := int(nextChunk.Add(1)) - 1
+				start := chunk * grain
+				if start >= n {
+					return
+				}
+				fn(start, min(start+grain, n))
+			}
+		})
+	}
+	wg.Wait()
+}
+
 // ProcessWithTailNoMask is similar to ProcessWithTail but doesn't require
 // a tail function. Instead, it processes overlapping vectors for the tail.
 // This is simpler but may do redundant work for the last few elements.
@@ -0,0 +1,160 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hwy
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"runtime"
+	"slices"
+	"strings"
+)
+
+// TargetEnv is the environment variable that caps the SIMD target used for
+// dispatch. It is read once during package initialization and accepts the
+// same names as ForceTarget, e.g. HWY_TARGET=avx2 on an AVX-512 machine or
+// HWY_TARGET=fallback to force the pure Go implementations.
+//
+// Unknown names and targets the CPU does not support are ignored, leaving
+// the detected target in place.
+const TargetEnv = "HWY_TARGET"
+
+// availableLevels lists the dispatch levels supported by this process,
+// best first. Set by init() in dispatch_*.go files.
+var availableLevels []DispatchLevel
+
+// targetCap is the highest level dispatch may use when targetCapped is set,
+// either through HWY_TARGET or ForceTarget.
+var (
+	targetCap    DispatchLevel
+	targetCapped bool
+)
+
+// ParseTarget returns the DispatchLevel for a target name as returned by
+// DispatchLevel.String. "fallback" is accepted as an alias for "scalar".
+func ParseTarget(name string) (DispatchLevel, bool) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if name == "fallback" {
+		return DispatchScalar, true
+	}
+	for level := DispatchScalar; level <= DispatchRVV; level++ {
+		if level.String() == name {
+			return level, true
+		}
+	}
+	return DispatchScalar, false
+}
+
+// AvailableTargets returns the dispatch levels this CPU supports, best
+// first. The list always ends with DispatchScalar.
+//
+// The list reflects hardware support and HWY_NO_SIMD, not HWY_TARGET or
+// ForceTarget, so it can be used to enumerate targets to test against.
+func AvailableTargets() []DispatchLevel {
+	return slices.Clone(availableLevels)
+}
+
+// ForceTarget caps dispatch at the named target, e.g. "avx2", "neon" or
+// "fallback". CurrentLevel, CurrentWidth and MaxLanes report the forced
+// target from then on. It returns an error if the name is unknown or the
+// target is not in AvailableTargets.
+//
+// Generated dispatchers bind their implementations in package init and
+// register them with RegisterKernel, so ForceTarget cannot affect them once
+// that has happened: it panics if any kernel is registered. Set HWY_TARGET
+// to choose the target of a program that imports such packages, and use
+// ForTarget to call another target's kernels. ForceTarget does not
+// synchronize with readers of CurrentLevel or MaxLanes, so it must not be
+// called concurrently with code using hwy; it is meant for tests and for
+// the initialization of programs without dispatched kernels.
+func ForceTarget(name string) error {
+	level, ok := ParseTarget(name)
+	if !ok {
+		return fmt.Errorf("hwy: unknown target %q", name)
+	}
+	if !slices.Contains(availableLevels, level) {
+		return fmt.Errorf("hwy: target %q is not available on this CPU", name)
+	}
+	kernelsMu.Lock()
+	defer kernelsMu.Unlock()
+	if len(kernels) > 0 {
+		panic(fmt.Sprintf("hwy: ForceTarget(%q) called after dispatched kernels were bound; set %s instead", name, TargetEnv))
+	}
+	currentLevel = level
+	currentWidth = levelWidth(level)
+	targetCap = level
+	targetCapped = true
+	return nil
+}
+
+// TargetEnabled reports whether dispatch may select implementations for
+// level, i.e. it is not above the target forced via HWY_TARGET or
+// ForceTarget. It does not check CPU support, which callers test
+// separately. Generated dispatchers use it to honor HWY_TARGET.
+func TargetEnabled(level DispatchLevel) bool {
+	return !targetCapped || level <= targetCap
+}
+
+// BoundImplementation returns the name of the function currently bound to
+// a dispatched function variable, e.g. "algo.BaseFind_avx2_Int32" for
+// algo.FindInt32 on an AVX2 machine. It returns "" for a nil function or a
+// non-function value. This is useful for logging which kernels a process
+// actually runs when investigating performance differences across machines.
+func BoundImplementation(fn any) string {
+	v := reflect.ValueOf(fn)
+	if v.Kind() != reflect.Func || v.IsNil() {
+		return ""
+	}
+	f := runtime.FuncForPC(v.Pointer())
+	if f == nil {
+		return ""
+	}
+	name := f.Name()
+	if i := strings.LastIndex(name, "/"); i >= 0 {
+		name = name[i+1:]
+	}
+	return name
+}
+
+// targetEnvLevel returns the level requested via HWY_TARGET, if any.
+func targetEnvLevel() (DispatchLevel, bool) {
+	val := os.Getenv(TargetEnv)
+	if val == "" {
+		return DispatchScalar, false
+	}
+	return ParseTarget(val)
+}
+
+// applyTargetEnv applies HWY_TARGET after CPU detection.
+func applyTargetEnv() {
+	if val := os.Getenv(TargetEnv); val != "" {
+		_ = ForceTarget(val)
+	}
+}
+
+// levelWidth returns the vector width in bytes used for level.
+func levelWidth(level DispatchLevel) int {
+	switch level {
+	case DispatchAVX512:
+		return 64
+	case DispatchAVX2:
+		return 32
+	default:
+		// Scalar uses 16-byte vectors for consistency, and SVE/SME keep the
+		// NEON width since all dispatched arm64 kernels target 128 bits.
+		return 16
+	}
+}
@@ -0,0 +1,217 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hwy
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+// saveTarget returns a function restoring the dispatch state changed by ForceTarget.
+func saveTarget() func() {
+	level, width, cap, capped := currentLevel, currentWidth, targetCap, targetCapped
+	return func() {
+		currentLevel, currentWidth, targetCap, targetCapped = level, width, cap, capped
+	}
+}
+
+func TestParseTarget(t *testing.T) {
+	tests := []struct {
+		name string
+		want DispatchLevel
+		ok   bool
+	}{
+		{"scalar", DispatchScalar, true},
+		{"fallback", DispatchScalar, true},
+		{"avx2", DispatchAVX2, true},
+		{"AVX512", DispatchAVX512, true},
+		{" neon ", DispatchNEON, true},
+		{"sme", DispatchSME, true},
+		{"rvv", DispatchRVV, true},
+		{"avx3", DispatchScalar, false},
+		{"", DispatchScalar, false},
+	}
+	for _, tt := range tests {
+		got, ok := ParseTarget(tt.name)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("ParseTarget(%q) = %v, %v; want %v, %v", tt.name, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestAvailableTargets(t *testing.T) {
+	targets := AvailableTargets()
+	if len(targets) == 0 || targets[len(targets)-1] != DispatchScalar {
+		t.Fatalf("AvailableTargets() = %v, want list ending in scalar", targets)
+	}
+	if !slices.Contains(targets, CurrentLevel()) {
+		t.Errorf("AvailableTargets() = %v does not contain current level %v", targets, CurrentLevel())
+	}
+
+	// The result is a copy.
+	targets[0] = DispatchRVV + 1
+	if AvailableTargets()[0] == DispatchRVV+1 {
+		t.Error("AvailableTargets() returned the internal slice")
+	}
+}
+
+func TestForceTarget(t *testing.T) {
+	defer saveTarget()()
+
+	if err := ForceTarget("avx3"); err == nil {
+		t.Error("ForceTarget(avx3) succeeded, want error for unknown target")
+	}
+	available := AvailableTargets()
+	for level := DispatchScalar; level <= DispatchRVV; level++ {
+		if !slices.Contains(available, level) {
+			if err := ForceTarget(level.String()); err == nil {
+				t.Errorf("ForceTarget(%v) succeeded, want error for unavailable target", level)
+			}
+		}
+	}
+
+	for _, level := range AvailableTargets() {
+		if err := ForceTarget(level.String()); err != nil {
+			t.Fatalf("ForceTarget(%v): %v", level, err)
+		}
+		if CurrentLevel() != level {
+			t.Errorf("after ForceTarget(%v), CurrentLevel() = %v", level, CurrentLevel())
+		}
+		if CurrentWidth() != levelWidth(level) {
+			t.Errorf("after ForceTarget(%v), CurrentWidth() = %d, want %d", level, CurrentWidth(), levelWidth(level))
+		}
+	}
+
+	if err := ForceTarget("fallback"); err != nil {
+		t.Fatalf("ForceTarget(fallback): %v", err)
+	}
+	if HasSIMD() {
+		t.Error("HasSIMD() = true after ForceTarget(fallback)")
+	}
+	if MaxLanes[float32]() != 4 {
+		t.Errorf("MaxLanes[float32]() = %d after ForceTarget(fallback), want 4", MaxLanes[float32]())
+	}
+	if !TargetEnabled(DispatchScalar) || TargetEnabled(DispatchAVX2) || TargetEnabled(DispatchNEON) {
+		t.Error("TargetEnabled should only allow scalar after ForceTarget(fallback)")
+	}
+}
+
+func TestForceTargetAfterBinding(t *testing.T) {
+	defer saveTarget()()
+	testSumFloat32 = sumFallback
+	RegisterKernel("hwy.testSumFloat32", &testSumFloat32)
+	defer func() {
+		kernelsMu.Lock()
+		delete(kernels, "hwy.testSumFloat32")
+		kernelsMu.Unlock()
+	}()
+
+	level := CurrentLevel()
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Error("ForceTarget with a registered kernel did not panic")
+			}
+		}()
+		_ = ForceTarget("fallback")
+	}()
+	if CurrentLevel() != level {
+		t.Errorf("CurrentLevel() = %v after the panicking ForceTarget, want %v", CurrentLevel(), level)
+	}
+}
+
+func TestBoundImplementation(t *testing.T) {
+	fn := func(x int) int { return x }
+	if got := BoundImplementation(fn); !strings.HasPrefix(got, "hwy.TestBoundImplementation") {
+		t.Errorf("BoundImplementation(closure) = %q", got)
+	}
+	var reduce func([]float32) float32 = sumFallback
+	if got, want := BoundImplementation(reduce), "hwy.sumFallback"; got != want {
+		t.Errorf("BoundImplementation = %q, want %q", got, want)
+	}
+	var nilFn func()
+	if got := BoundImplementation(nilFn); got != "" {
+		t.Errorf("BoundImplementation(nil) = %q, want empty", got)
+	}
+	if got := BoundImplementation(42); got != "" {
+		t.Errorf("BoundImplementation(42) = %q, want empty", got)
+	}
+}
+
+// sumFallback stands in for a generated fallback implementation.
+func sumFallback(v []float32) float32 {
+	var sum float32
+	for _, x := range v {
+		sum += x
+	}
+	return sum
+}
+
+func TestAVX512SubsetsFollowTarget(t *testing.T) {
+	defer saveTarget()()
+
+	if err := ForceTarget("fallback"); err != nil {
+		t.Fatalf("ForceTarget(fallback): %v", err)
+	}
+	if HasAVX512FP16() || HasAVX512BF16() || HasAVX512VNNI() || HasAVX512VPOPCNTDQ() {
+		t.Error("AVX-512 subset queries should report false when not dispatching to AVX-512")
+	}
+}
+
+func TestHasAVXFollowsTarget(t *testing.T) {
+	defer saveTarget()()
+
+	if HasAVX512() && !HasAVX2() {
+		t.Error("HasAVX512() without HasAVX2()")
+	}
+	if err := ForceTarget("avx2"); err == nil && HasAVX512() {
+		t.Error("HasAVX512() should report false when capped at AVX2")
+	}
+	if err := ForceTarget("fallback"); err != nil {
+		t.Fatalf("ForceTarget(fallback): %v", err)
+	}
+	if HasAVX2() || HasAVX512() {
+		t.Error("HasAVX2/HasAVX512 should report false when dispatching to fallback")
+	}
+}
+
+func TestHasRVVFollowsTarget(t *testing.T) {
+	defer saveTarget()()
+
+	if HasRVV() != slices.Contains(AvailableTargets(), DispatchRVV) {
+		t.Errorf("HasRVV() = %v, but AvailableTargets() = %v", HasRVV(), AvailableTargets())
+	}
+	if err := ForceTarget("fallback"); err != nil {
+		t.Fatalf("ForceTarget(fallback): %v", err)
+	}
+	if HasRVV() {
+		t.Error("HasRVV() should report false when dispatching to fallback")
+	}
+}
+
+func TestSVEVectorBytes(t *testing.T) {
+	n := SVEVectorBytes()
+	if n != 0 && (n < 16 || n > 256 || n&(n-1) != 0) {
+		t.Errorf("SVEVectorBytes() = %d, want a power of two in [16, 256]", n)
+	}
+	// Dispatched arm64 kernels are 128-bit NEON, whatever the SVE length.
+	if n != 0 && CurrentWidth() != 16 {
+		t.Errorf("CurrentWidth() = %d with SVE, want the NEON width 16", CurrentWidth())
+	}
+	if HasSVE2() && !HasSVE() {
+		t.Error("HasSVE2() without HasSVE()")
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hwytest
+
+import (
+	"fmt"
+	"testing"
+	"unsafe"
+)
+
+// BenchSizes are the element counts BenchmarkUnary and BenchmarkBinary use
+// when no sizes are given: from less than one vector to larger than L1.
+var BenchSizes = []int{16, 64, 256, 1024, 4096, 16384}
+
+// BenchmarkUnary runs kernel as a sub-benchmark named size_N for each size,
+// reporting allocations and throughput in bytes read plus written. Inputs
+// are RandomValues in [-1, 1), so kernels see neither special values nor
+// denormal slow paths.
+func BenchmarkUnary[T Float](b *testing.B, kernel func(in, out []T), sizes ...int) {
+	if len(sizes) == 0 {
+		sizes = BenchSizes
+	}
+	var zero T
+	for _, size := range sizes {
+		in := RandomValues[T](size, -1, 1, uint64(size))
+		out := make([]T, size)
+		b.Run(fmt.Sprintf("size_%d", size), func(b *testing.B) {
+			b.SetBytes(int64(2 * size * int(unsafe.Sizeof(zero))))
+			b.ReportAllocs()
+			for b.Loop() {
+				kernel(in, out)
+			}
+		})
+	}
+}
+
+// BenchmarkBinary is BenchmarkUnary for kernels of two inputs.
+func BenchmarkBinary[T Float](b *testing.B, kernel func(a, b, out []T), sizes ...int) {
+	if len(sizes) == 0 {
+		sizes = BenchSizes
+	}
+	var zero T
+	for _, size := range sizes {
+		x := RandomValues[T](size, -1, 1, uint64(size))
+		y := RandomValues[T](size, -1, 1, uint64(size)+1)
+		out := make([]T, size)
+		b.Run(fmt.Sprintf("size_%d", size), func(b *testing.B) {
+			b.SetBytes(int64(3 * size * int(unsafe.Sizeof(zero))))
+			b.ReportAllocs()
+			for b.Loop() {
+				kernel(x, y, out)
+			}
+		})
+	}
+}
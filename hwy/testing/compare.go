@@ -0,0 +1,125 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hwytest
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+// tailLengths is how many of the shortest prefix lengths CheckUnary and
+// CheckBinary run the kernel on. It covers every tail of a 512-bit vector of
+// float32 plus one full vector and a tail.
+const tailLengths = 33
+
+// Result summarizes a comparison between a kernel and its reference.
+type Result struct {
+	// MaxULP is the largest ULP distance seen, 0 if every lane matched.
+	MaxULP uint64
+	// Index is the position of the worst lane, or -1 if there were no inputs.
+	Index int
+	// Inputs holds the kernel inputs at Index: one value for unary kernels,
+	// two for binary ones.
+	Inputs []float64
+	// Got and Want are the kernel output and reference value at Index.
+	Got, Want float64
+}
+
+// String formats r for test failure messages.
+func (r Result) String() string {
+	if r.Index < 0 {
+		return "no inputs"
+	}
+	ulp := fmt.Sprint(r.MaxULP)
+	if r.MaxULP == math.MaxUint64 {
+		ulp = "NaN mismatch"
+	}
+	return fmt.Sprintf("max error %s ULP at index %d: inputs %v, got %v, want %v",
+		ulp, r.Index, r.Inputs, r.Got, r.Want)
+}
+
+// MeasureUnary runs kernel over in and compares each output with ref,
+// evaluated in float64 and rounded to T. It returns the worst lane.
+func MeasureUnary[T Float](kernel func(in, out []T), ref func(float64) float64, in []T) Result {
+	out := make([]T, len(in))
+	kernel(in, out)
+	r := Result{Index: -1}
+	for i, x := range in {
+		want := T(ref(float64(x)))
+		if d := ULPDistance(out[i], want); r.Index < 0 || d > r.MaxULP {
+			r = Result{MaxULP: d, Index: i, Inputs: []float64{float64(x)},
+				Got: float64(out[i]), Want: float64(want)}
+		}
+	}
+	return r
+}
+
+// MeasureBinary is MeasureUnary for kernels of two inputs. a and b must have
+// the same length.
+func MeasureBinary[T Float](kernel func(a, b, out []T), ref func(a, b float64) float64, a, b []T) Result {
+	if len(a) != len(b) {
+		panic("hwytest: MeasureBinary inputs differ in length")
+	}
+	out := make([]T, len(a))
+	kernel(a, b, out)
+	r := Result{Index: -1}
+	for i := range a {
+		want := T(ref(float64(a[i]), float64(b[i])))
+		if d := ULPDistance(out[i], want); r.Index < 0 || d > r.MaxULP {
+			r = Result{MaxULP: d, Index: i, Inputs: []float64{float64(a[i]), float64(b[i])},
+				Got: float64(out[i]), Want: float64(want)}
+		}
+	}
+	return r
+}
+
+// CheckUnary reports a test error if kernel is more than maxULP away from
+// ref on any of in. It checks the full slice and every prefix shorter than
+// tailLengths, so tail handling is covered, and logs the worst error seen.
+func CheckUnary[T Float](t testing.TB, name string, kernel func(in, out []T), ref func(float64) float64, in []T, maxULP uint64) Result {
+	t.Helper()
+	worst := MeasureUnary(kernel, ref, in)
+	for n := 1; n < tailLengths && n < len(in); n++ {
+		if r := MeasureUnary(kernel, ref, in[:n]); r.MaxULP > maxULP {
+			t.Errorf("%s (len %d): %v, tolerance %d ULP", name, n, r, maxULP)
+			break
+		}
+	}
+	if worst.MaxULP > maxULP {
+		t.Errorf("%s: %v, tolerance %d ULP", name, worst, maxULP)
+	} else {
+		t.Logf("%s: %v", name, worst)
+	}
+	return worst
+}
+
+// CheckBinary is CheckUnary for kernels of two inputs.
+func CheckBinary[T Float](t testing.TB, name string, kernel func(a, b, out []T), ref func(a, b float64) float64, a, b []T, maxULP uint64) Result {
+	t.Helper()
+	worst := MeasureBinary(kernel, ref, a, b)
+	for n := 1; n < tailLengths && n < len(a); n++ {
+		if r := MeasureBinary(kernel, ref, a[:n], b[:n]); r.MaxULP > maxULP {
+			t.Errorf("%s (len %d): %v, tolerance %d ULP", name, n, r, maxULP)
+			break
+		}
+	}
+	if worst.MaxULP > maxULP {
+		t.Errorf("%s: %v, tolerance %d ULP", name, worst, maxULP)
+	} else {
+		t.Logf("%s: %v", name, worst)
+	}
+	return worst
+}
@@ -0,0 +1,40 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package hwytest provides helpers for testing and benchmarking SIMD
+// kernels against scalar references.
+//
+// Every contrib package needs the same correctness harness: run the
+// dispatched kernel over randomized and special-value inputs, compare each
+// lane against a float64 reference, and report the worst error in units in
+// the last place (ULP). This package provides that harness, plus benchmark
+// scaffolding that sweeps the usual sizes and reports throughput.
+//
+//	import hwytest "github.com/ajroetker/go-highway/hwy/testing"
+//
+//	func TestExp(t *testing.T) {
+//	    inputs := append(hwytest.SpecialValues[float32](),
+//	        hwytest.RandomValues[float32](4096, -80, 80, 1)...)
+//	    hwytest.CheckUnary(t, "Exp", algo.ExpTransform[float32], math.Exp, inputs, 4)
+//	}
+//
+//	func BenchmarkExp(b *testing.B) {
+//	    hwytest.BenchmarkUnary(b, algo.ExpTransform[float32])
+//	}
+//
+// Kernels follow the repo's transform signature, func(in, out []T), and
+// must handle any length including ones that are not a multiple of the
+// vector width; CheckUnary and CheckBinary also run every short length to
+// exercise tail handling.
+package hwytest
@@ -0,0 +1,116 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hwytest
+
+import (
+	"math"
+	"testing"
+)
+
+func TestULPDistance(t *testing.T) {
+	one := float32(1)
+	next := math.Nextafter32(one, 2)
+	tests := []struct {
+		name string
+		a, b float32
+		want uint64
+	}{
+		{"equal", 1, 1, 0},
+		{"adjacent", one, next, 1},
+		{"symmetric", next, one, 1},
+		{"signed zeros", 0, float32(math.Copysign(0, -1)), 0},
+		{"across zero", math.SmallestNonzeroFloat32, -math.SmallestNonzeroFloat32, 2},
+		{"max to inf", math.MaxFloat32, float32(math.Inf(1)), 1},
+		{"both NaN", float32(math.NaN()), float32(math.NaN()), 0},
+		{"NaN vs number", float32(math.NaN()), 1, math.MaxUint64},
+	}
+	for _, tt := range tests {
+		if got := ULPDistance(tt.a, tt.b); got != tt.want {
+			t.Errorf("%s: ULPDistance(%v, %v) = %d, want %d", tt.name, tt.a, tt.b, got, tt.want)
+		}
+	}
+
+	if got := ULPDistance(1.0, math.Nextafter(1, 2)); got != 1 {
+		t.Errorf("float64 adjacent: got %d, want 1", got)
+	}
+	if got := ULPDistance(-math.MaxFloat64, math.Inf(1)); got != 2*(math.Float64bits(math.MaxFloat64))+1 {
+		t.Errorf("float64 full range: got %d", got)
+	}
+}
+
+func TestRandomValues(t *testing.T) {
+	a := RandomValues[float64](100, -2, 3, 7)
+	b := RandomValues[float64](100, -2, 3, 7)
+	for i := range a {
+		if a[i] != b[i] {
+			t.Fatalf("same seed differs at %d: %v vs %v", i, a[i], b[i])
+		}
+		if a[i] < -2 || a[i] >= 3 {
+			t.Errorf("value %v out of range [-2, 3)", a[i])
+		}
+	}
+}
+
+func TestMeasureUnary(t *testing.T) {
+	in := append(SpecialValues[float32](), RandomValues[float32](100, -10, 10, 1)...)
+	exact := func(in, out []float32) {
+		for i, x := range in {
+			out[i] = -x
+		}
+	}
+	if r := CheckUnary(t, "Neg", exact, func(x float64) float64 { return -x }, in, 0); r.MaxULP != 0 {
+		t.Errorf("exact kernel: %v", r)
+	}
+
+	// A kernel that is off by one ULP on a single lane.
+	const bad = 20
+	offByOne := func(in, out []float32) {
+		exact(in, out)
+		if len(out) > bad {
+			out[bad] = math.Nextafter32(out[bad], float32(math.Inf(1)))
+		}
+	}
+	r := MeasureUnary(offByOne, func(x float64) float64 { return -x }, in)
+	if r.MaxULP != 1 || r.Index != bad {
+		t.Errorf("off-by-one kernel: %v, want 1 ULP at index %d", r, bad)
+	}
+}
+
+func TestMeasureBinary(t *testing.T) {
+	a := RandomValues[float64](50, -1, 1, 1)
+	b := RandomValues[float64](50, -1, 1, 2)
+	add := func(a, b, out []float64) {
+		for i := range out {
+			out[i] = a[i] + b[i]
+		}
+	}
+	CheckBinary(t, "Add", add, func(a, b float64) float64 { return a + b }, a, b, 0)
+
+	nan := func(a, b, out []float64) {
+		add(a, b, out)
+		out[3] = math.NaN()
+	}
+	if r := MeasureBinary(nan, func(a, b float64) float64 { return a + b }, a, b); r.MaxULP != math.MaxUint64 || r.Index != 3 {
+		t.Errorf("NaN kernel: %v, want NaN mismatch at index 3", r)
+	}
+}
+
+func BenchmarkHarnessUnary(b *testing.B) {
+	BenchmarkUnary(b, func(in, out []float32) {
+		for i, x := range in {
+			out[i] = x * x
+		}
+	}, 1024)
+}
@@ -0,0 +1,55 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hwytest
+
+import (
+	"math"
+	"math/rand/v2"
+)
+
+// SpecialValues returns the edge-case inputs every float kernel should be
+// checked against: signed zeros, ±1, the smallest subnormal and normal
+// values, the largest finite value, ±Inf and NaN.
+func SpecialValues[T Float]() []T {
+	var zero T
+	if _, ok := any(zero).(float32); ok {
+		return []T{
+			0, T(math.Copysign(0, -1)), 1, -1, 0.5, -0.5, 2, -2,
+			T(math.SmallestNonzeroFloat32), -T(math.SmallestNonzeroFloat32),
+			T(math.Float32frombits(0x00800000)), -T(math.Float32frombits(0x00800000)),
+			T(math.MaxFloat32), -T(math.MaxFloat32),
+			T(math.Inf(1)), T(math.Inf(-1)), T(math.NaN()),
+		}
+	}
+	// Constants beyond float32 range cannot be converted to T directly.
+	tiny, normal, huge := math.Float64frombits(1), math.Float64frombits(0x0010000000000000), math.Float64frombits(0x7fefffffffffffff)
+	return []T{
+		0, T(math.Copysign(0, -1)), 1, -1, 0.5, -0.5, 2, -2,
+		T(tiny), -T(tiny), T(normal), -T(normal), T(huge), -T(huge),
+		T(math.Inf(1)), T(math.Inf(-1)), T(math.NaN()),
+	}
+}
+
+// RandomValues returns n values drawn uniformly from [lo, hi). The same seed
+// always produces the same values, so failures are reproducible.
+func RandomValues[T Float](n int, lo, hi T, seed uint64) []T {
+	rng := rand.New(rand.NewPCG(seed, seed^0x9e3779b97f4a7c15))
+	out := make([]T, n)
+	span := float64(hi) - float64(lo)
+	for i := range out {
+		out[i] = T(float64(lo) + rng.Float64()*span)
+	}
+	return out
+}
@@ -0,0 +1,69 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hwytest
+
+import "math"
+
+// Float is the set of element types the harness compares.
+type Float interface {
+	~float32 | ~float64
+}
+
+// ULPDistance returns the number of representable values of type T between
+// a and b. +0 and -0 are 0 ULP apart, as are two NaNs of any payload. A NaN
+// compared with a non-NaN is math.MaxUint64 ULP away, so it always fails a
+// tolerance check. Infinities are one ULP beyond the largest finite value.
+func ULPDistance[T Float](a, b T) uint64 {
+	fa, fb := float64(a), float64(b)
+	aNaN, bNaN := math.IsNaN(fa), math.IsNaN(fb)
+	if aNaN || bNaN {
+		if aNaN && bNaN {
+			return 0
+		}
+		return math.MaxUint64
+	}
+	var ia, ib int64
+	switch any(a).(type) {
+	case float32:
+		ia, ib = orderedBits32(float32(a)), orderedBits32(float32(b))
+	default:
+		ia, ib = orderedBits64(fa), orderedBits64(fb)
+	}
+	if ia > ib {
+		return uint64(ia) - uint64(ib)
+	}
+	return uint64(ib) - uint64(ia)
+}
+
+// orderedBits32 maps x to an integer whose order matches the order of the
+// floats, with adjacent floats mapping to adjacent integers and both zeros
+// mapping to 0.
+func orderedBits32(x float32) int64 {
+	bits := math.Float32bits(x)
+	if bits&(1<<31) != 0 {
+		return -int64(bits &^ (1 << 31))
+	}
+	return int64(bits)
+}
+
+// orderedBits64 is orderedBits32 for float64. Magnitudes fit in 63 bits, so
+// negation never overflows.
+func orderedBits64(x float64) int64 {
+	bits := math.Float64bits(x)
+	if bits&(1<<63) != 0 {
+		return -int64(bits &^ (1 << 63))
+	}
+	return int64(bits)
+}
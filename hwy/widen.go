@@ -0,0 +1,158 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hwy
+
+// This file provides pure Go (scalar) implementations of widening multiply
+// operations. These are the building blocks of integer dot products and
+// quantized matmuls: products are computed at double width so they cannot
+// overflow, and adjacent pairs (or quads) are summed in the wider lanes.
+//
+// Like the promote/demote operations, these use concrete type-specific names
+// because Go generics cannot express "U is twice as wide as T".
+
+// MulEvenI32ToI64 multiplies the even int32 lanes of a and b, producing
+// full 64-bit products (PMULDQ on x86).
+// [a0,a1,a2,a3], [b0,b1,b2,b3] -> [a0*b0, a2*b2]
+func MulEvenI32ToI64(a, b Vec[int32]) Vec[int64] {
+	n := min(len(a.data), len(b.data)) / 2
+	result := make([]int64, n)
+	for i := range n {
+		result[i] = int64(a.data[2*i]) * int64(b.data[2*i])
+	}
+	return Vec[int64]{data: result}
+}
+
+// MulOddI32ToI64 multiplies the odd int32 lanes of a and b, producing
+// full 64-bit products.
+// [a0,a1,a2,a3], [b0,b1,b2,b3] -> [a1*b1, a3*b3]
+func MulOddI32ToI64(a, b Vec[int32]) Vec[int64] {
+	n := min(len(a.data), len(b.data)) / 2
+	result := make([]int64, n)
+	for i := range n {
+		result[i] = int64(a.data[2*i+1]) * int64(b.data[2*i+1])
+	}
+	return Vec[int64]{data: result}
+}
+
+// MulEvenU32ToU64 multiplies the even uint32 lanes of a and b, producing
+// full 64-bit products (PMULUDQ on x86).
+func MulEvenU32ToU64(a, b Vec[uint32]) Vec[uint64] {
+	n := min(len(a.data), len(b.data)) / 2
+	result := make([]uint64, n)
+	for i := range n {
+		result[i] = uint64(a.data[2*i]) * uint64(b.data[2*i])
+	}
+	return Vec[uint64]{data: result}
+}
+
+// MulOddU32ToU64 multiplies the odd uint32 lanes of a and b, producing
+// full 64-bit products.
+func MulOddU32ToU64(a, b Vec[uint32]) Vec[uint64] {
+	n := min(len(a.data), len(b.data)) / 2
+	result := make([]uint64, n)
+	for i := range n {
+		result[i] = uint64(a.data[2*i+1]) * uint64(b.data[2*i+1])
+	}
+	return Vec[uint64]{data: result}
+}
+
+// WidenMulPairwiseAddI16ToI32 multiplies int16 lanes at int32 precision and
+// adds adjacent pairs of products (PMADDWD on x86, SMULL+ADDP on NEON).
+// [a0,a1,a2,a3], [b0,b1,b2,b3] -> [a0*b0+a1*b1, a2*b2+a3*b3]
+//
+// The only input that overflows is a pair of (-32768 * -32768) products,
+// which wraps just as PMADDWD does.
+func WidenMulPairwiseAddI16ToI32(a, b Vec[int16]) Vec[int32] {
+	n := min(len(a.data), len(b.data)) / 2
+	result := make([]int32, n)
+	for i := range n {
+		p0 := int32(a.data[2*i]) * int32(b.data[2*i])
+		p1 := int32(a.data[2*i+1]) * int32(b.data[2*i+1])
+		result[i] = p0 + p1
+	}
+	return Vec[int32]{data: result}
+}
+
+// ReorderWidenMulAccumulateI16ToI32 multiplies int16 lanes at int32 precision
+// and accumulates the products into sum0 and *sum1. The assignment of
+// products to the two accumulators is target-specific; only the total
+// RearrangeToOddPlusEvenI32(sum0, *sum1) is portable.
+//
+// Splitting the accumulation across two vectors lets targets avoid a
+// horizontal add per iteration, so this is the preferred form for the inner
+// loop of an int16 dot product:
+//
+//	sum0, sum1 := hwy.Zero[int32](), hwy.Zero[int32]()
+//	for ... {
+//		sum0 = hwy.ReorderWidenMulAccumulateI16ToI32(a, b, sum0, &sum1)
+//	}
+//	dot := hwy.ReduceSum(hwy.RearrangeToOddPlusEvenI32(sum0, sum1))
+func ReorderWidenMulAccumulateI16ToI32(a, b Vec[int16], sum0 Vec[int32], sum1 *Vec[int32]) Vec[int32] {
+	n := min(len(a.data), len(b.data)) / 2
+	n = min(n, len(sum0.data), len(sum1.data))
+	result0 := make([]int32, len(sum0.data))
+	result1 := make([]int32, len(sum1.data))
+	copy(result0, sum0.data)
+	copy(result1, sum1.data)
+	for i := range n {
+		result0[i] += int32(a.data[2*i]) * int32(b.data[2*i])
+		result1[i] += int32(a.data[2*i+1]) * int32(b.data[2*i+1])
+	}
+	*sum1 = Vec[int32]{data: result1}
+	return Vec[int32]{data: result0}
+}
+
+// RearrangeToOddPlusEvenI32 combines the two accumulators produced by
+// ReorderWidenMulAccumulateI16ToI32 so that each lane holds the sum of an
+// adjacent pair of products, matching WidenMulPairwiseAddI16ToI32.
+func RearrangeToOddPlusEvenI32(sum0, sum1 Vec[int32]) Vec[int32] {
+	return Add(sum0, sum1)
+}
+
+// SumOfMulQuadAccumulateI8ToI32 multiplies int8 lanes at int32 precision,
+// sums each group of four adjacent products and adds the result to sum
+// (SDOT on NEON, VPDPBSSD on AVX-VNNI-INT8).
+// lane i of the result = sum[i] + a[4i]*b[4i] + ... + a[4i+3]*b[4i+3]
+func SumOfMulQuadAccumulateI8ToI32(a, b Vec[int8], sum Vec[int32]) Vec[int32] {
+	n := min(min(len(a.data), len(b.data))/4, len(sum.data))
+	result := make([]int32, len(sum.data))
+	copy(result, sum.data)
+	for i := range n {
+		acc := result[i]
+		for j := 4 * i; j < 4*i+4; j++ {
+			acc += int32(a.data[j]) * int32(b.data[j])
+		}
+		result[i] = acc
+	}
+	return Vec[int32]{data: result}
+}
+
+// SumOfMulQuadAccumulateU8ToU32 multiplies uint8 lanes at uint32 precision,
+// sums each group of four adjacent products and adds the result to sum
+// (UDOT on NEON).
+// lane i of the result = sum[i] + a[4i]*b[4i] + ... + a[4i+3]*b[4i+3]
+func SumOfMulQuadAccumulateU8ToU32(a, b Vec[uint8], sum Vec[uint32]) Vec[uint32] {
+	n := min(min(len(a.data), len(b.data))/4, len(sum.data))
+	result := make([]uint32, len(sum.data))
+	copy(result, sum.data)
+	for i := range n {
+		acc := result[i]
+		for j := 4 * i; j < 4*i+4; j++ {
+			acc += uint32(a.data[j]) * uint32(b.data[j])
+		}
+		result[i] = acc
+	}
+	return Vec[uint32]{data: result}
+}
@@ -0,0 +1,110 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build amd64 && goexperiment.simd
+
+package hwy
+
+import "simd/archsimd"
+
+// This file provides AVX-512 SIMD implementations of widening multiply operations.
+//
+// AVX-512 has VPMULDQ (MulEven), VPMADDWD (WidenMulPairwiseAdd) and, with
+// VNNI, VPDPWSSD/VPDPBUSD. Since archsimd does not expose these yet, we use
+// the store/scalar/load pattern.
+
+// MulEven_AVX512_I32x16 multiplies the even int32 lanes, producing 8 int64 products.
+func MulEven_AVX512_I32x16(a, b archsimd.Int32x16) archsimd.Int64x8 {
+	var dataA, dataB [16]int32
+	a.Store(&dataA)
+	b.Store(&dataB)
+	var result [8]int64
+	for i := range 8 {
+		result[i] = int64(dataA[2*i]) * int64(dataB[2*i])
+	}
+	return archsimd.LoadInt64x8(&result)
+}
+
+// MulOdd_AVX512_I32x16 multiplies the odd int32 lanes, producing 8 int64 products.
+func MulOdd_AVX512_I32x16(a, b archsimd.Int32x16) archsimd.Int64x8 {
+	var dataA, dataB [16]int32
+	a.Store(&dataA)
+	b.Store(&dataB)
+	var result [8]int64
+	for i := range 8 {
+		result[i] = int64(dataA[2*i+1]) * int64(dataB[2*i+1])
+	}
+	return archsimd.LoadInt64x8(&result)
+}
+
+// WidenMulPairwiseAddI16ToI32_AVX512_Int16x32 multiplies 32 int16 lanes and adds
+// adjacent pairs of products into 16 int32 lanes.
+func WidenMulPairwiseAddI16ToI32_AVX512_Int16x32(a, b archsimd.Int16x32) archsimd.Int32x16 {
+	var dataA, dataB [32]int16
+	a.Store(&dataA)
+	b.Store(&dataB)
+
+	var result [16]int32
+	for i := range 16 {
+		result[i] = int32(dataA[2*i])*int32(dataB[2*i]) + int32(dataA[2*i+1])*int32(dataB[2*i+1])
+	}
+	return archsimd.LoadInt32x16(&result)
+}
+
+// ReorderWidenMulAccumulateI16ToI32_AVX512_Int16x32 adds the pairwise products of
+// a and b to sum0. As in Highway's x86 implementation *sum1 is left
+// unchanged, since the pairwise add is free.
+func ReorderWidenMulAccumulateI16ToI32_AVX512_Int16x32(a, b archsimd.Int16x32, sum0 archsimd.Int32x16, sum1 *archsimd.Int32x16) archsimd.Int32x16 {
+	return sum0.Add(WidenMulPairwiseAddI16ToI32_AVX512_Int16x32(a, b))
+}
+
+// RearrangeToOddPlusEvenI32_AVX512_I32x16 combines the accumulators of
+// ReorderWidenMulAccumulateI16ToI32_AVX512_Int16x32.
+func RearrangeToOddPlusEvenI32_AVX512_I32x16(sum0, sum1 archsimd.Int32x16) archsimd.Int32x16 {
+	return sum0.Add(sum1)
+}
+
+// SumOfMulQuadAccumulateI8ToI32_AVX512_Int8x64 multiplies 64 int8 lanes, sums each
+// quad of products and adds it to sum.
+func SumOfMulQuadAccumulateI8ToI32_AVX512_Int8x64(a, b archsimd.Int8x64, sum archsimd.Int32x16) archsimd.Int32x16 {
+	var dataA, dataB [64]int8
+	var result [16]int32
+	a.Store(&dataA)
+	b.Store(&dataB)
+	sum.Store(&result)
+
+	for i := range 16 {
+		for j := 4 * i; j < 4*i+4; j++ {
+			result[i] += int32(dataA[j]) * int32(dataB[j])
+		}
+	}
+	return archsimd.LoadInt32x16(&result)
+}
+
+// SumOfMulQuadAccumulateU8ToU32_AVX512_Uint8x64 multiplies 64 uint8 lanes, sums
+// each quad of products and adds it to sum.
+func SumOfMulQuadAccumulateU8ToU32_AVX512_Uint8x64(a, b archsimd.Uint8x64, sum archsimd.Uint32x16) archsimd.Uint32x16 {
+	var dataA, dataB [64]uint8
+	var result [16]uint32
+	a.Store(&dataA)
+	b.Store(&dataB)
+	sum.Store(&result)
+
+	for i := range 16 {
+		for j := 4 * i; j < 4*i+4; j++ {
+			result[i] += uint32(dataA[j]) * uint32(dataB[j])
+		}
+	}
+	return archsimd.LoadUint32x16(&result)
+}
@@ -0,0 +1,111 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build arm64
+
+package hwy
+
+import "github.com/ajroetker/go-highway/hwy/asm"
+
+// This file provides NEON implementations of widening multiply operations.
+//
+// The int16 ops use SMULL/SMLAL followed by ADDP, and the int8 quad ops
+// SDOT/UDOT when the dot-product extension is present. MulEven/MulOdd and
+// the quad ops without SDOT use the store/scalar/load pattern.
+
+// MulEven_NEON_I32x4 multiplies the even int32 lanes, producing 2 int64 products.
+func MulEven_NEON_I32x4(a, b asm.Int32x4) asm.Int64x2 {
+	var dataA, dataB [4]int32
+	a.Store(&dataA)
+	b.Store(&dataB)
+	var result [2]int64
+	for i := range 2 {
+		result[i] = int64(dataA[2*i]) * int64(dataB[2*i])
+	}
+	return asm.LoadInt64x2(&result)
+}
+
+// MulOdd_NEON_I32x4 multiplies the odd int32 lanes, producing 2 int64 products.
+func MulOdd_NEON_I32x4(a, b asm.Int32x4) asm.Int64x2 {
+	var dataA, dataB [4]int32
+	a.Store(&dataA)
+	b.Store(&dataB)
+	var result [2]int64
+	for i := range 2 {
+		result[i] = int64(dataA[2*i+1]) * int64(dataB[2*i+1])
+	}
+	return asm.LoadInt64x2(&result)
+}
+
+// WidenMulPairwiseAddI16ToI32_NEON_Int16x8 multiplies 8 int16 lanes and adds
+// adjacent pairs of products into 4 int32 lanes (SMULL, SMULL2, ADDP).
+func WidenMulPairwiseAddI16ToI32_NEON_Int16x8(a, b asm.Int16x8) asm.Int32x4 {
+	return a.MulWidenPairwiseAdd(b)
+}
+
+// ReorderWidenMulAccumulateI16ToI32_NEON_Int16x8 multiplies 8 int16 lanes,
+// adding the products of the lower 4 lanes to sum0 and those of the upper 4
+// to *sum1 (SMLAL, SMLAL2), as in Highway's NEON implementation.
+func ReorderWidenMulAccumulateI16ToI32_NEON_Int16x8(a, b asm.Int16x8, sum0 asm.Int32x4, sum1 *asm.Int32x4) asm.Int32x4 {
+	lo, hi := a.MulWidenAcc(b, sum0, *sum1)
+	*sum1 = hi
+	return lo
+}
+
+// RearrangeToOddPlusEvenI32_NEON_I32x4 combines the accumulators of
+// ReorderWidenMulAccumulateI16ToI32_NEON_Int16x8 into the pairwise sums of
+// WidenMulPairwiseAddI16ToI32_NEON_Int16x8 (ADDP).
+func RearrangeToOddPlusEvenI32_NEON_I32x4(sum0, sum1 asm.Int32x4) asm.Int32x4 {
+	return sum0.PairwiseAdd(sum1)
+}
+
+// SumOfMulQuadAccumulateI8ToI32_NEON_Int8x16 multiplies 16 int8 lanes, sums
+// each quad of products and adds it to sum (SDOT when available).
+func SumOfMulQuadAccumulateI8ToI32_NEON_Int8x16(a, b asm.Int8x16, sum asm.Int32x4) asm.Int32x4 {
+	if asm.HasDotProd {
+		return a.Dot(b, sum)
+	}
+	var dataA, dataB [16]int8
+	var result [4]int32
+	a.Store(&dataA)
+	b.Store(&dataB)
+	sum.Store(&result)
+
+	for i := range 4 {
+		for j := 4 * i; j < 4*i+4; j++ {
+			result[i] += int32(dataA[j]) * int32(dataB[j])
+		}
+	}
+	return asm.LoadInt32x4(&result)
+}
+
+// SumOfMulQuadAccumulateU8ToU32_NEON_Uint8x16 multiplies 16 uint8 lanes, sums
+// each quad of products and adds it to sum (UDOT when available).
+func SumOfMulQuadAccumulateU8ToU32_NEON_Uint8x16(a, b asm.Uint8x16, sum asm.Uint32x4) asm.Uint32x4 {
+	if asm.HasDotProd {
+		return a.Dot(b, sum)
+	}
+	var dataA, dataB [16]uint8
+	var result [4]uint32
+	a.Store(&dataA)
+	b.Store(&dataB)
+	sum.Store(&result)
+
+	for i := range 4 {
+		for j := 4 * i; j < 4*i+4; j++ {
+			result[i] += uint32(dataA[j]) * uint32(dataB[j])
+		}
+	}
+	return asm.LoadUint32x4(&result)
+}
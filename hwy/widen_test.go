@@ -0,0 +1,123 @@
+// Copyright 2025 go-highway Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hwy
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestMulEvenOddI32ToI64(t *testing.T) {
+	a := Vec[int32]{data: []int32{math.MaxInt32, 2, -3, math.MinInt32}}
+	b := Vec[int32]{data: []int32{math.MaxInt32, 5, 7, math.MinInt32}}
+
+	even := MulEvenI32ToI64(a, b)
+	wantEven := []int64{int64(math.MaxInt32) * math.MaxInt32, -21}
+	if !reflect.DeepEqual(even.data, wantEven) {
+		t.Errorf("MulEvenI32ToI64() = %v, want %v", even.data, wantEven)
+	}
+
+	odd := MulOddI32ToI64(a, b)
+	wantOdd := []int64{10, int64(math.MinInt32) * math.MinInt32}
+	if !reflect.DeepEqual(odd.data, wantOdd) {
+		t.Errorf("MulOddI32ToI64() = %v, want %v", odd.data, wantOdd)
+	}
+}
+
+func TestMulEvenOddU32ToU64(t *testing.T) {
+	a := Vec[uint32]{data: []uint32{math.MaxUint32, 1, 3, math.MaxUint32}}
+	b := Vec[uint32]{data: []uint32{math.MaxUint32, 1, 4, 2}}
+
+	even := MulEvenU32ToU64(a, b)
+	wantEven := []uint64{uint64(math.MaxUint32) * math.MaxUint32, 12}
+	if !reflect.DeepEqual(even.data, wantEven) {
+		t.Errorf("MulEvenU32ToU64() = %v, want %v", even.data, wantEven)
+	}
+
+	odd := MulOddU32ToU64(a, b)
+	wantOdd := []uint64{1, 2 * uint64(math.MaxUint32)}
+	if !reflect.DeepEqual(odd.data, wantOdd) {
+		t.Errorf("MulOddU32ToU64() = %v, want %v", odd.data, wantOdd)
+	}
+}
+
+func TestWidenMulPairwiseAddI16ToI32(t *testing.T) {
+	a := Vec[int16]{data: []int16{1, 2, 3, 4, math.MaxInt16, math.MaxInt16, -5, 6}}
+	b := Vec[int16]{data: []int16{10, 20, 30, 40, math.MaxInt16, math.MaxInt16, 7, 8}}
+
+	result := WidenMulPairwiseAddI16ToI32(a, b)
+	want := []int32{50, 250, 2 * math.MaxInt16 * math.MaxInt16, 13}
+	if !reflect.DeepEqual(result.data, want) {
+		t.Errorf("WidenMulPairwiseAddI16ToI32() = %v, want %v", result.data, want)
+	}
+}
+
+func TestReorderWidenMulAccumulateI16ToI32(t *testing.T) {
+	a := make([]int16, 64)
+	b := make([]int16, 64)
+	var want int32
+	for i := range a {
+		a[i] = int16(i - 32)
+		b[i] = int16(3*i + 1)
+		want += int32(a[i]) * int32(b[i])
+	}
+
+	// Accumulate 8 int16 lanes at a time, as a 128-bit target would.
+	sum0 := Vec[int32]{data: make([]int32, 4)}
+	sum1 := Vec[int32]{data: make([]int32, 4)}
+	for i := 0; i < len(a); i += 8 {
+		va := Vec[int16]{data: a[i : i+8]}
+		vb := Vec[int16]{data: b[i : i+8]}
+		sum0 = ReorderWidenMulAccumulateI16ToI32(va, vb, sum0, &sum1)
+	}
+
+	got := ReduceSum(RearrangeToOddPlusEvenI32(sum0, sum1))
+	if got != want {
+		t.Errorf("dot product = %d, want %d", got, want)
+	}
+
+	pairwise := WidenMulPairwiseAddI16ToI32(Vec[int16]{data: a[:8]}, Vec[int16]{data: b[:8]})
+	s0 := Vec[int32]{data: make([]int32, 4)}
+	s1 := Vec[int32]{data: make([]int32, 4)}
+	s0 = ReorderWidenMulAccumulateI16ToI32(Vec[int16]{data: a[:8]}, Vec[int16]{data: b[:8]}, s0, &s1)
+	if got := RearrangeToOddPlusEvenI32(s0, s1); !reflect.DeepEqual(got.data, pairwise.data) {
+		t.Errorf("RearrangeToOddPlusEvenI32() = %v, want %v", got.data, pairwise.data)
+	}
+}
+
+func TestSumOfMulQuadAccumulateI8ToI32(t *testing.T) {
+	a := Vec[int8]{data: []int8{1, 2, 3, 4, -128, -128, -128, -128}}
+	b := Vec[int8]{data: []int8{1, 1, 1, 1, -128, -128, -128, -128}}
+	sum := Vec[int32]{data: []int32{100, -1}}
+
+	result := SumOfMulQuadAccumulateI8ToI32(a, b, sum)
+	want := []int32{110, 4*128*128 - 1}
+	if !reflect.DeepEqual(result.data, want) {
+		t.Errorf("SumOfMulQuadAccumulateI8ToI32() = %v, want %v", result.data, want)
+	}
+}
+
+func TestSumOfMulQuadAccumulateU8ToU32(t *testing.T) {
+	a := Vec[uint8]{data: []uint8{255, 255, 255, 255, 1, 2, 3, 4}}
+	b := Vec[uint8]{data: []uint8{255, 255, 255, 255, 5, 6, 7, 8}}
+	sum := Vec[uint32]{data: []uint32{1, 0}}
+
+	result := SumOfMulQuadAccumulateU8ToU32(a, b, sum)
+	want := []uint32{4*255*255 + 1, 70}
+	if !reflect.DeepEqual(result.data, want) {
+		t.Errorf("SumOfMulQuadAccumulateU8ToU32() = %v, want %v", result.data, want)
+	}
+}
@@ -33,6 +33,7 @@ func main() {
 	fmt.Printf("Highway dispatch level: %s\n", hwy.CurrentLevel())
 	fmt.Printf("Highway dispatch width: %d bytes\n", hwy.CurrentWidth())
 	fmt.Printf("Highway dispatch name: %s\n", hwy.CurrentName())
+	fmt.Printf("Highway available targets: %v\n", hwy.AvailableTargets())
 	fmt.Println()
 
 	switch runtime.GOARCH {
@@ -45,6 +46,11 @@ func main() {
 	fmt.Println()
 	fmt.Printf("Highway HasARMFP16: %v\n", hwy.HasARMFP16())
 	fmt.Printf("Highway HasARMBF16: %v\n", hwy.HasARMBF16())
+	fmt.Printf("Highway HasF16C: %v\n", hwy.HasF16C())
+	fmt.Printf("Highway HasAVX512FP16: %v\n", hwy.HasAVX512FP16())
+	fmt.Printf("Highway HasAVX512BF16: %v\n", hwy.HasAVX512BF16())
+	fmt.Printf("Highway HasAVX512VNNI: %v\n", hwy.HasAVX512VNNI())
+	fmt.Printf("Highway HasAVX512VPOPCNTDQ: %v\n", hwy.HasAVX512VPOPCNTDQ())
 }
 
 func printARM64Features() {
@@ -74,6 +80,10 @@ func printAMD64Features() {
 	fmt.Printf("  HasAVX512F: %v\n", cpu.X86.HasAVX512F)
 	fmt.Printf("  HasAVX512BW: %v\n", cpu.X86.HasAVX512BW)
 	fmt.Printf("  HasAVX512VL: %v\n", cpu.X86.HasAVX512VL)
+	fmt.Printf("  HasAVX512DQ: %v\n", cpu.X86.HasAVX512DQ)
+	fmt.Printf("  HasAVX512VNNI: %v\n", cpu.X86.HasAVX512VNNI)
+	fmt.Printf("  HasAVX512VPOPCNTDQ: %v\n", cpu.X86.HasAVX512VPOPCNTDQ)
+	fmt.Printf("  HasAVX512BF16: %v\n", cpu.X86.HasAVX512BF16)
 	fmt.Printf("  HasFMA:     %v\n", cpu.X86.HasFMA)
 	fmt.Printf("  HasSSE2:    %v\n", cpu.X86.HasSSE2)
 	fmt.Printf("  HasSSE41:   %v\n", cpu.X86.HasSSE41)